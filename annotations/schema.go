@@ -0,0 +1,285 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package annotations lets tools built on ThriftRW declare the annotations
+// they understand with typed schemas instead of matching strings by hand.
+//
+// Each annotation is declared with its name, the type of its value, and the
+// kinds of definitions it may be placed on.
+//
+//   var (
+//     timeout = annotations.Int{Name: "rpc.timeout_ms", Targets: annotations.Function}
+//     secret  = annotations.Bool{Name: "log.redact", Targets: annotations.Field}
+//   )
+//
+//   schema, err := annotations.NewSchema(timeout, secret)
+//
+// Validate reports every occurrence of a declared annotation which has an
+// invalid value or is placed on the wrong kind of definition.
+//
+//   module, err := compile.Compile("service.thrift")
+//   ...
+//   if err := schema.Validate(module); err != nil {
+//     return err
+//   }
+//
+// Afterwards, the values may be read with the typed getters of the
+// annotations from the compiled specs, or from the annotations passed to
+// plugins.
+//
+//   if ms, ok := timeout.Get(function.Annotations); ok {
+//     ...
+//   }
+package annotations
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.uber.org/multierr"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Target is a set of kinds of definitions on which an annotation may be
+// placed.
+type Target uint
+
+// Kinds of definitions on which annotations may be placed.
+const (
+	Struct Target = 1 << iota
+	Union
+	Exception
+	Enum
+	EnumItem
+	Typedef
+	Field // fields of structs, unions, and exceptions
+	Service
+	Function
+	Param // arguments of functions and the exceptions they throw
+	Namespace
+
+	// Type is any type definition.
+	Type = Struct | Union | Exception | Enum | Typedef
+
+	// Any is any kind of definition.
+	Any = Type | EnumItem | Field | Service | Function | Param | Namespace
+)
+
+var _targetNames = []string{
+	"struct",
+	"union",
+	"exception",
+	"enum",
+	"enum item",
+	"typedef",
+	"field",
+	"service",
+	"function",
+	"parameter",
+	"namespace",
+}
+
+// String returns the names of the kinds of definitions in this Target,
+// separated by commas.
+func (t Target) String() string {
+	var names []string
+	for i, name := range _targetNames {
+		if t&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	if rest := t &^ Any; rest != 0 {
+		names = append(names, fmt.Sprintf("Target(%d)", uint(rest)))
+	}
+	return strings.Join(names, ", ")
+}
+
+// Definition is an annotation declared in a Schema. It's implemented by
+// Bool, String, Int, and Float.
+type Definition interface {
+	// AnnotationName is the name of the annotation, e.g. "rpc.timeout_ms".
+	AnnotationName() string
+
+	// AnnotationTargets is the set of kinds of definitions on which the
+	// annotation may be placed.
+	AnnotationTargets() Target
+
+	// check returns an error if the given value of the annotation is
+	// invalid.
+	check(value string) error
+}
+
+// Schema is a set of annotations understood by a tool.
+type Schema struct {
+	definitions map[string]Definition
+}
+
+// NewSchema builds a Schema from the given annotations. Names of
+// annotations must be unique and every annotation must have at least one
+// target.
+func NewSchema(definitions ...Definition) (*Schema, error) {
+	s := &Schema{definitions: make(map[string]Definition, len(definitions))}
+	for _, d := range definitions {
+		name := d.AnnotationName()
+		switch {
+		case name == "":
+			return nil, fmt.Errorf("annotations must have a name")
+		case d.AnnotationTargets()&Any == 0:
+			return nil, fmt.Errorf("annotation %q must have at least one target", name)
+		}
+		if _, ok := s.definitions[name]; ok {
+			return nil, fmt.Errorf("annotation %q is declared more than once", name)
+		}
+		s.definitions[name] = d
+	}
+	return s, nil
+}
+
+// Lookup returns the annotation with the given name, or nil if it isn't a
+// part of this Schema.
+func (s *Schema) Lookup(name string) Definition {
+	return s.definitions[name]
+}
+
+// Validate checks the annotations declared in this Schema on all
+// definitions in the given module. Annotations which aren't part of the
+// Schema are ignored. Included modules are not validated; use Module.Walk
+// to validate them as well.
+func (s *Schema) Validate(m *compile.Module) error {
+	v := validator{schema: s, file: m.ThriftPath}
+
+	for _, scope := range sortedKeys(m.Namespaces) {
+		v.check(Namespace, scope, m.Namespaces[scope].Annotations)
+	}
+
+	for _, name := range sortedKeys(m.Types) {
+		v.visitType(m.Types[name])
+	}
+
+	for _, name := range sortedKeys(m.Services) {
+		v.visitService(m.Services[name])
+	}
+
+	return v.err
+}
+
+// validator accumulates problems found with the annotations of a module.
+type validator struct {
+	schema *Schema
+	file   string
+	err    error
+}
+
+func (v *validator) visitType(spec compile.TypeSpec) {
+	switch spec := spec.(type) {
+	case *compile.StructSpec:
+		target := Struct
+		switch spec.Type {
+		case ast.UnionType:
+			target = Union
+		case ast.ExceptionType:
+			target = Exception
+		}
+		v.check(target, spec.Name, spec.Annotations)
+		v.visitFields(Field, spec.Name, spec.Fields)
+	case *compile.EnumSpec:
+		v.check(Enum, spec.Name, spec.Annotations)
+		for _, item := range spec.Items {
+			v.check(EnumItem, spec.Name+"."+item.Name, item.Annotations)
+		}
+	case *compile.TypedefSpec:
+		v.check(Typedef, spec.Name, spec.Annotations)
+	}
+}
+
+func (v *validator) visitService(spec *compile.ServiceSpec) {
+	v.check(Service, spec.Name, spec.Annotations)
+	for _, name := range sortedKeys(spec.Functions) {
+		f := spec.Functions[name]
+		fname := spec.Name + "." + f.Name
+		v.check(Function, fname, f.Annotations)
+		v.visitFields(Param, fname, compile.FieldGroup(f.ArgsSpec))
+		if f.ResultSpec != nil {
+			v.visitFields(Param, fname, f.ResultSpec.Exceptions)
+		}
+	}
+}
+
+func (v *validator) visitFields(target Target, parent string, fields compile.FieldGroup) {
+	for _, f := range fields {
+		v.check(target, parent+"."+f.Name, f.Annotations)
+	}
+}
+
+// check validates the annotations of a single definition.
+func (v *validator) check(target Target, name string, annotations compile.Annotations) {
+	for _, key := range sortedKeys(annotations) {
+		d, ok := v.schema.definitions[key]
+		if !ok {
+			continue
+		}
+
+		var reason error
+		if d.AnnotationTargets()&target == 0 {
+			reason = fmt.Errorf("may only be placed on: %v", d.AnnotationTargets()&Any)
+		} else {
+			reason = d.check(annotations[key])
+		}
+
+		if reason != nil {
+			v.err = multierr.Append(v.err, annotationError{
+				File:       v.file,
+				Target:     target,
+				Name:       name,
+				Annotation: key,
+				Reason:     reason,
+			})
+		}
+	}
+}
+
+// annotationError is a problem with an annotation on a definition.
+type annotationError struct {
+	File       string
+	Target     Target
+	Name       string
+	Annotation string
+	Reason     error
+}
+
+func (e annotationError) Error() string {
+	return fmt.Sprintf("%v: invalid annotation %q on %v %q: %v",
+		e.File, e.Annotation, e.Target, e.Name, e.Reason)
+}
+
+// sortedKeys returns the keys of the given map, which must have string
+// keys, in sorted order.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.String()
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package annotations
+
+import (
+	"testing"
+
+	"go.uber.org/multierr"
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_timeout  = Int{Name: "rpc.timeout_ms", Targets: Function | Service}
+	_redact   = Bool{Name: "log.redact", Targets: Field | Param}
+	_encoding = String{Name: "rpc.encoding", Targets: Function, Values: []string{"json", "thrift"}}
+	_scale    = Float{Name: "metrics.scale", Targets: Field}
+)
+
+func TestNewSchemaErrors(t *testing.T) {
+	tests := []struct {
+		desc        string
+		definitions []Definition
+		wantError   string
+	}{
+		{
+			desc:        "no name",
+			definitions: []Definition{Bool{Targets: Any}},
+			wantError:   "annotations must have a name",
+		},
+		{
+			desc:        "no targets",
+			definitions: []Definition{Bool{Name: "foo"}},
+			wantError:   `annotation "foo" must have at least one target`,
+		},
+		{
+			desc: "duplicate",
+			definitions: []Definition{
+				Bool{Name: "foo", Targets: Field},
+				Int{Name: "foo", Targets: Struct},
+			},
+			wantError: `annotation "foo" is declared more than once`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := NewSchema(tt.definitions...)
+			assert.EqualError(t, err, tt.wantError)
+		})
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := NewSchema(_timeout, _redact, _encoding, _scale)
+	require.NoError(t, err)
+	assert.Equal(t, _timeout, schema.Lookup("rpc.timeout_ms"))
+	assert.Nil(t, schema.Lookup("go.name"))
+
+	t.Run("valid", func(t *testing.T) {
+		m := idltest.Compile(t, map[string]string{"test.thrift": `
+			struct User {
+				1: required string name (go.name = "FullName")
+				2: optional string password (log.redact)
+				3: optional double score (metrics.scale = "0.5")
+			}
+
+			service Users {
+				User getUser(1: string token (log.redact = "false"))
+					(rpc.timeout_ms = "500", rpc.encoding = "json")
+			} (rpc.timeout_ms = "1000")
+		`}, "test.thrift")
+		assert.NoError(t, schema.Validate(m))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m := idltest.Compile(t, map[string]string{"test.thrift": `
+			namespace go foo (rpc.timeout_ms = "1")
+
+			struct User {
+				1: optional string password (log.redact = "yes")
+				2: optional double score (metrics.scale = "high")
+			} (log.redact)
+
+			enum Role {
+				ADMIN (rpc.encoding = "json")
+			}
+
+			service Users {
+				User getUser(1: string token (metrics.scale = "1"))
+					(rpc.timeout_ms = "soon", rpc.encoding = "xml")
+			}
+		`}, "test.thrift")
+		err := schema.Validate(m)
+		require.Error(t, err)
+
+		var msgs []string
+		for _, e := range multierr.Errors(err) {
+			msgs = append(msgs, e.Error())
+		}
+		want := []string{
+			`invalid annotation "rpc.timeout_ms" on namespace "go": may only be placed on: service, function`,
+			`invalid annotation "rpc.encoding" on enum item "Role.ADMIN": may only be placed on: function`,
+			`invalid annotation "log.redact" on struct "User": may only be placed on: field, parameter`,
+			`invalid annotation "log.redact" on field "User.password": expected "true" or "false", got "yes"`,
+			`invalid annotation "metrics.scale" on field "User.score": expected a number, got "high"`,
+			`invalid annotation "rpc.encoding" on function "Users.getUser": expected one of "json, thrift", got "xml"`,
+			`invalid annotation "rpc.timeout_ms" on function "Users.getUser": expected an integer, got "soon"`,
+			`invalid annotation "metrics.scale" on parameter "Users.getUser.token": may only be placed on: field`,
+		}
+		require.Len(t, msgs, len(want))
+		for i, msg := range msgs {
+			assert.Equal(t, m.ThriftPath+": "+want[i], msg)
+		}
+	})
+}
+
+func TestGetters(t *testing.T) {
+	annotations := map[string]string{
+		"rpc.timeout_ms": "500",
+		"log.redact":     "",
+		"rpc.encoding":   "xml",
+		"metrics.scale":  "0.25",
+	}
+
+	timeout, ok := _timeout.Get(annotations)
+	assert.True(t, ok)
+	assert.Equal(t, int64(500), timeout)
+
+	redact, ok := _redact.Get(annotations)
+	assert.True(t, ok)
+	assert.True(t, redact)
+
+	_, ok = _encoding.Get(annotations)
+	assert.False(t, ok, "values outside of Values must be rejected")
+
+	scale, ok := _scale.Get(annotations)
+	assert.True(t, ok)
+	assert.Equal(t, 0.25, scale)
+
+	_, ok = _timeout.Get(nil)
+	assert.False(t, ok)
+
+	redact, ok = _redact.Get(map[string]string{"log.redact": "false"})
+	assert.True(t, ok)
+	assert.False(t, redact)
+}
+
+func TestTargetString(t *testing.T) {
+	assert.Equal(t, "struct, union, exception, enum, typedef", Type.String())
+	assert.Equal(t, "enum item", EnumItem.String())
+	assert.Equal(t, "field, Target(4096)", (Field | 1<<12).String())
+}
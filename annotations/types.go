@@ -0,0 +1,204 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package annotations
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Bool is an annotation with a boolean value. It's true if it's present
+// without a value or with the value "true", and false if its value is
+// "false".
+//
+//   struct User {
+//     1: required string password (log.redact)
+//   }
+type Bool struct {
+	Name    string
+	Targets Target
+}
+
+var _ Definition = Bool{}
+
+// AnnotationName is the name of this annotation.
+func (a Bool) AnnotationName() string { return a.Name }
+
+// AnnotationTargets is the set of kinds of definitions on which this
+// annotation may be placed.
+func (a Bool) AnnotationTargets() Target { return a.Targets }
+
+func (a Bool) check(v string) error {
+	_, err := parseBool(v)
+	return err
+}
+
+// Get returns the value of this annotation in the given annotations. ok is
+// false if the annotation is absent or its value is invalid.
+func (a Bool) Get(annotations map[string]string) (value bool, ok bool) {
+	v, ok := annotations[a.Name]
+	if !ok {
+		return false, false
+	}
+	value, err := parseBool(v)
+	return value, err == nil
+}
+
+func parseBool(v string) (bool, error) {
+	switch v {
+	case "", "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, fmt.Errorf("expected \"true\" or \"false\", got %q", v)
+	}
+}
+
+// String is an annotation with a string value. If Values is non-empty, the
+// value must be one of them.
+//
+//   service Users {
+//     User getUser(1: string id) (rpc.encoding = "json")
+//   }
+type String struct {
+	Name    string
+	Targets Target
+	Values  []string
+}
+
+var _ Definition = String{}
+
+// AnnotationName is the name of this annotation.
+func (a String) AnnotationName() string { return a.Name }
+
+// AnnotationTargets is the set of kinds of definitions on which this
+// annotation may be placed.
+func (a String) AnnotationTargets() Target { return a.Targets }
+
+func (a String) check(v string) error {
+	if len(a.Values) == 0 {
+		return nil
+	}
+	for _, allowed := range a.Values {
+		if v == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("expected one of %q, got %q", strings.Join(a.Values, ", "), v)
+}
+
+// Get returns the value of this annotation in the given annotations. ok is
+// false if the annotation is absent or its value is invalid.
+func (a String) Get(annotations map[string]string) (value string, ok bool) {
+	v, ok := annotations[a.Name]
+	if !ok || a.check(v) != nil {
+		return "", false
+	}
+	return v, true
+}
+
+// Int is an annotation with a 64-bit integer value.
+//
+//   service Users {
+//     User getUser(1: string id) (rpc.timeout_ms = "500")
+//   }
+type Int struct {
+	Name    string
+	Targets Target
+}
+
+var _ Definition = Int{}
+
+// AnnotationName is the name of this annotation.
+func (a Int) AnnotationName() string { return a.Name }
+
+// AnnotationTargets is the set of kinds of definitions on which this
+// annotation may be placed.
+func (a Int) AnnotationTargets() Target { return a.Targets }
+
+func (a Int) check(v string) error {
+	_, err := parseInt(v)
+	return err
+}
+
+// Get returns the value of this annotation in the given annotations. ok is
+// false if the annotation is absent or its value is invalid.
+func (a Int) Get(annotations map[string]string) (value int64, ok bool) {
+	v, ok := annotations[a.Name]
+	if !ok {
+		return 0, false
+	}
+	value, err := parseInt(v)
+	return value, err == nil
+}
+
+func parseInt(v string) (int64, error) {
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected an integer, got %q", v)
+	}
+	return i, nil
+}
+
+// Float is an annotation with a 64-bit floating point value.
+//
+//   struct Sample {
+//     1: required double value (metrics.scale = "0.001")
+//   }
+type Float struct {
+	Name    string
+	Targets Target
+}
+
+var _ Definition = Float{}
+
+// AnnotationName is the name of this annotation.
+func (a Float) AnnotationName() string { return a.Name }
+
+// AnnotationTargets is the set of kinds of definitions on which this
+// annotation may be placed.
+func (a Float) AnnotationTargets() Target { return a.Targets }
+
+func (a Float) check(v string) error {
+	_, err := parseFloat(v)
+	return err
+}
+
+// Get returns the value of this annotation in the given annotations. ok is
+// false if the annotation is absent or its value is invalid.
+func (a Float) Get(annotations map[string]string) (value float64, ok bool) {
+	v, ok := annotations[a.Name]
+	if !ok {
+		return 0, false
+	}
+	value, err := parseFloat(v)
+	return value, err == nil
+}
+
+func parseFloat(v string) (float64, error) {
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected a number, got %q", v)
+	}
+	return f, nil
+}
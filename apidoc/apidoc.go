@@ -0,0 +1,429 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apidoc
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Format is the format in which documentation is written.
+type Format int
+
+// The different documentation formats.
+const (
+	// Markdown writes documentation as GitHub-flavored Markdown.
+	Markdown Format = iota + 1
+
+	// HTML writes documentation as a standalone HTML page.
+	HTML
+)
+
+func (f Format) String() string {
+	switch f {
+	case Markdown:
+		return "markdown"
+	case HTML:
+		return "html"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// ParseFormat parses the name of a documentation format. The names
+// "markdown", "md", and "html" are recognized.
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "markdown", "md":
+		return Markdown, nil
+	case "html":
+		return HTML, nil
+	default:
+		return 0, fmt.Errorf("unknown documentation format %q", name)
+	}
+}
+
+// Write writes documentation for the given module and all the modules it
+// includes to w in the given format. The given module is documented first,
+// followed by its dependencies in alphabetical order.
+func Write(w io.Writer, m *compile.Module, f Format) error {
+	var r renderer
+	switch f {
+	case Markdown:
+		r = markdownRenderer{}
+	case HTML:
+		r = htmlRenderer{}
+	default:
+		return fmt.Errorf("unknown documentation format %v", f)
+	}
+
+	g := newGenerator(m, r)
+	return r.Execute(w, g)
+}
+
+// renderer renders documentation in a specific format.
+type renderer interface {
+	// Escape escapes the given text for inclusion in the document.
+	Escape(s string) string
+
+	// Link returns a link to the given anchor with the given escaped text.
+	Link(anchor, text string) string
+
+	// Execute writes the document for the given generator.
+	Execute(w io.Writer, g *generator) error
+}
+
+// module is a module being documented.
+type module struct {
+	// Name of the module. This is unique across all documented modules and
+	// is used as the prefix for the anchors of its definitions.
+	Name string
+
+	// Path to the Thrift file relative to the directory of the root
+	// module.
+	Path string
+
+	Includes  []*module
+	Constants []*compile.Constant
+	Types     []compile.TypeSpec
+	Services  []*compile.ServiceSpec
+}
+
+// scoped pairs a value with the module from which it is referenced. This is
+// used to pass both to nested templates.
+type scoped struct {
+	Module *module
+	Value  interface{}
+}
+
+func newScoped(m *module, v interface{}) scoped {
+	return scoped{Module: m, Value: v}
+}
+
+// generator holds the state needed by the documentation templates.
+type generator struct {
+	r renderer
+
+	// Modules in the order in which they are documented.
+	Modules []*module
+
+	// Modules indexed by the paths to their Thrift files.
+	byPath map[string]*module
+}
+
+func newGenerator(root *compile.Module, r renderer) *generator {
+	var modules []*compile.Module
+	root.Walk(func(m *compile.Module) error {
+		if m != root {
+			modules = append(modules, m)
+		}
+		return nil
+	})
+	sort.Slice(modules, func(i, j int) bool {
+		if modules[i].Name == modules[j].Name {
+			return modules[i].ThriftPath < modules[j].ThriftPath
+		}
+		return modules[i].Name < modules[j].Name
+	})
+	modules = append([]*compile.Module{root}, modules...)
+
+	g := &generator{r: r, byPath: make(map[string]*module, len(modules))}
+	names := make(map[string]struct{}, len(modules))
+	baseDir := filepath.Dir(root.ThriftPath)
+	for _, m := range modules {
+		name := m.Name
+		for i := 2; ; i++ {
+			if _, taken := names[name]; !taken {
+				break
+			}
+			name = fmt.Sprintf("%v_%d", m.Name, i)
+		}
+		names[name] = struct{}{}
+
+		path, err := filepath.Rel(baseDir, m.ThriftPath)
+		if err != nil {
+			path = m.ThriftPath
+		}
+
+		dm := &module{Name: name, Path: filepath.ToSlash(path)}
+		g.Modules = append(g.Modules, dm)
+		g.byPath[m.ThriftPath] = dm
+	}
+
+	for i, m := range modules {
+		dm := g.Modules[i]
+
+		for _, inc := range m.Includes {
+			dm.Includes = append(dm.Includes, g.byPath[inc.Module.ThriftPath])
+		}
+		sort.Slice(dm.Includes, func(i, j int) bool {
+			return dm.Includes[i].Name < dm.Includes[j].Name
+		})
+
+		for _, c := range m.Constants {
+			dm.Constants = append(dm.Constants, c)
+		}
+		sort.Slice(dm.Constants, func(i, j int) bool {
+			return dm.Constants[i].Name < dm.Constants[j].Name
+		})
+
+		for _, t := range m.Types {
+			dm.Types = append(dm.Types, t)
+		}
+		sort.Slice(dm.Types, func(i, j int) bool {
+			return dm.Types[i].ThriftName() < dm.Types[j].ThriftName()
+		})
+
+		for _, s := range m.Services {
+			dm.Services = append(dm.Services, s)
+		}
+		sort.Slice(dm.Services, func(i, j int) bool {
+			return dm.Services[i].Name < dm.Services[j].Name
+		})
+	}
+
+	return g
+}
+
+// Anchor returns the anchor for the given definition. Definitions may be
+// modules, constants, named types, services, or functions of the given
+// service.
+func (g *generator) Anchor(v interface{}, rest ...interface{}) string {
+	switch v := v.(type) {
+	case *module:
+		return v.Name
+	case *compile.Constant:
+		return g.qualify(v.File, v.Name)
+	case *compile.ServiceSpec:
+		anchor := g.qualify(v.File, v.Name)
+		for _, f := range rest {
+			anchor += "." + f.(*compile.FunctionSpec).Name
+		}
+		return anchor
+	case compile.TypeSpec:
+		return g.qualify(v.ThriftFile(), v.ThriftName())
+	default:
+		panic(fmt.Sprintf("cannot build an anchor for %T", v))
+	}
+}
+
+func (g *generator) qualify(file, name string) string {
+	if m, ok := g.byPath[file]; ok {
+		return m.Name + "." + name
+	}
+	return name
+}
+
+// displayName returns the name with which a definition declared in the given
+// file is referred to from the given module.
+func (g *generator) displayName(from *module, file, name string) string {
+	if m, ok := g.byPath[file]; ok && m != from {
+		return m.Name + "." + name
+	}
+	return name
+}
+
+// TypeReference renders a reference to the given type from the given
+// module. References to named types link to their definitions.
+func (g *generator) TypeReference(from *module, t compile.TypeSpec) string {
+	switch t := t.(type) {
+	case *compile.MapSpec:
+		return g.r.Escape("map<") + g.TypeReference(from, t.KeySpec) +
+			g.r.Escape(", ") + g.TypeReference(from, t.ValueSpec) + g.r.Escape(">")
+	case *compile.ListSpec:
+		return g.r.Escape("list<") + g.TypeReference(from, t.ValueSpec) + g.r.Escape(">")
+	case *compile.SetSpec:
+		return g.r.Escape("set<") + g.TypeReference(from, t.ValueSpec) + g.r.Escape(">")
+	}
+
+	if t.ThriftFile() == "" {
+		return g.r.Escape(t.ThriftName())
+	}
+
+	name := g.displayName(from, t.ThriftFile(), t.ThriftName())
+	return g.r.Link(g.Anchor(t), g.r.Escape(name))
+}
+
+// ServiceReference renders a reference to the given service from the given
+// module, linking to its definition.
+func (g *generator) ServiceReference(from *module, s *compile.ServiceSpec) string {
+	name := g.displayName(from, s.File, s.Name)
+	return g.r.Link(g.Anchor(s), g.r.Escape(name))
+}
+
+// ResultType renders the return type of the given function.
+func (g *generator) ResultType(from *module, f *compile.FunctionSpec) string {
+	var (
+		prefix string
+		typ    compile.TypeSpec
+	)
+	if f.OneWay {
+		prefix = "oneway "
+	}
+	if f.ResultSpec != nil {
+		typ = f.ResultSpec.ReturnType
+	}
+
+	if typ == nil {
+		return g.r.Escape(prefix + "void")
+	}
+	if f.Streaming {
+		return g.r.Escape(prefix+"stream<") + g.TypeReference(from, typ) + g.r.Escape(">")
+	}
+	return g.r.Escape(prefix) + g.TypeReference(from, typ)
+}
+
+// Exceptions returns the exceptions raised by the given function.
+func (g *generator) Exceptions(f *compile.FunctionSpec) compile.FieldGroup {
+	if f.ResultSpec == nil {
+		return nil
+	}
+	return f.ResultSpec.Exceptions
+}
+
+// ConstantValue renders the given constant value as it would appear in a
+// Thrift file. References to other constants and enum items link to their
+// definitions.
+func (g *generator) ConstantValue(from *module, v compile.ConstantValue) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case compile.ConstantBool:
+		return strconv.FormatBool(bool(v))
+	case compile.ConstantInt:
+		return strconv.FormatInt(int64(v), 10)
+	case compile.ConstantDouble:
+		s := strconv.FormatFloat(float64(v), 'g', -1, 64)
+		if !strings.ContainsAny(s, ".eEn") {
+			s += ".0"
+		}
+		return s
+	case compile.ConstantString:
+		return g.r.Escape(strconv.Quote(string(v)))
+	case *compile.ConstantStruct:
+		names := make([]string, 0, len(v.Fields))
+		for name := range v.Fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		items := make([]string, len(names))
+		for i, name := range names {
+			items[i] = g.r.Escape(strconv.Quote(name)+": ") +
+				g.ConstantValue(from, v.Fields[name])
+		}
+		return g.r.Escape("{") + strings.Join(items, g.r.Escape(", ")) + g.r.Escape("}")
+	case compile.ConstantMap:
+		items := make([]string, len(v))
+		for i, pair := range v {
+			items[i] = g.ConstantValue(from, pair.Key) + g.r.Escape(": ") +
+				g.ConstantValue(from, pair.Value)
+		}
+		return g.r.Escape("{") + strings.Join(items, g.r.Escape(", ")) + g.r.Escape("}")
+	case compile.ConstantSet:
+		return g.constantList(from, []compile.ConstantValue(v))
+	case compile.ConstantList:
+		return g.constantList(from, []compile.ConstantValue(v))
+	case compile.ConstReference:
+		name := g.displayName(from, v.Target.File, v.Target.Name)
+		return g.r.Link(g.Anchor(v.Target), g.r.Escape(name))
+	case compile.EnumItemReference:
+		name := g.displayName(from, v.Enum.File, v.Enum.Name) + "." + v.Item.Name
+		return g.r.Link(g.Anchor(v.Enum), g.r.Escape(name))
+	case compile.ServiceReference:
+		name := g.displayName(from, v.Service.File, v.Service.Name)
+		return g.r.Link(g.Anchor(v.Service), g.r.Escape(name))
+	default:
+		return g.r.Escape(fmt.Sprint(v))
+	}
+}
+
+func (g *generator) constantList(from *module, values []compile.ConstantValue) string {
+	items := make([]string, len(values))
+	for i, v := range values {
+		items[i] = g.ConstantValue(from, v)
+	}
+	return g.r.Escape("[") + strings.Join(items, g.r.Escape(", ")) + g.r.Escape("]")
+}
+
+// Kind returns the keyword with which the given named type is declared.
+func (g *generator) Kind(t compile.TypeSpec) string {
+	switch t := t.(type) {
+	case *compile.StructSpec:
+		switch t.Type {
+		case ast.UnionType:
+			return "union"
+		case ast.ExceptionType:
+			return "exception"
+		default:
+			return "struct"
+		}
+	case *compile.EnumSpec:
+		return "enum"
+	case *compile.TypedefSpec:
+		return "typedef"
+	default:
+		return ""
+	}
+}
+
+// Functions returns the functions of the given service sorted by name.
+func (g *generator) Functions(s *compile.ServiceSpec) []*compile.FunctionSpec {
+	functions := make([]*compile.FunctionSpec, 0, len(s.Functions))
+	for _, f := range s.Functions {
+		functions = append(functions, f)
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+	return functions
+}
+
+// Annotations renders the given annotations sorted by name.
+func (g *generator) Annotations(annotations compile.Annotations) []string {
+	names := make([]string, 0, len(annotations))
+	for name := range annotations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]string, len(names))
+	for i, name := range names {
+		items[i] = g.r.Escape(fmt.Sprintf("%v = %q", name, annotations[name]))
+	}
+	return items
+}
+
+// Requiredness returns "required" or "optional" depending on whether the
+// given field is required.
+func (g *generator) Requiredness(f *compile.FieldSpec) string {
+	if f.Required {
+		return "required"
+	}
+	return "optional"
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apidoc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		give    string
+		want    Format
+		wantErr bool
+	}{
+		{give: "markdown", want: Markdown},
+		{give: "md", want: Markdown},
+		{give: "HTML", want: HTML},
+		{give: "pdf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			got, err := ParseFormat(tt.give)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+var _testFiles = map[string]string{
+	"shared.thrift": `
+		/** A unique identifier. */
+		typedef string UUID
+
+		enum Role {
+			/** Can read and write. */
+			ADMIN = 1,
+			GUEST = 2 (deprecated = "true")
+		}
+
+		const Role DEFAULT_ROLE = Role.GUEST
+	`,
+	"users.thrift": `
+		include "./shared.thrift"
+
+		/**
+		 * A registered user.
+		 *
+		 * Users may have at most one role.
+		 */
+		struct User {
+			/** Identifies the user. */
+			1: required shared.UUID id
+			2: optional shared.Role role = shared.DEFAULT_ROLE
+			3: optional map<string, list<i32>> scores (go.tag = 'json:"scores"')
+		}
+
+		exception NotFound {}
+
+		/** Stores users. */
+		service Users {
+			/** Looks up a user. */
+			User get(1: shared.UUID id) throws (1: NotFound notFound)
+			oneway void ping()
+		}
+	`,
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	m := idltest.Compile(t, _testFiles, "users.thrift")
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, m, Markdown))
+	out := buf.String()
+
+	for _, want := range []string{
+		"## Module users\n\nDefined in `users.thrift`.\n\nIncludes [shared](#shared).\n",
+		"<a id=\"users.User\"></a>\n### struct User\n\nA registered user.\n\nUsers may have at most one role.\n",
+		"| 1 | id | [shared.UUID](#shared.UUID) | required |  | Identifies the user. |\n",
+		"| 2 | role | [shared.Role](#shared.Role) | optional | [shared.DEFAULT_ROLE](#shared.DEFAULT_ROLE) |  |\n",
+		`| 3 | scores | map\<string, list\<i32\>\> | optional |  | go.tag = "json:\\"scores\\"" |` + "\n",
+		"### exception NotFound\n\n<a id=\"users.User\"></a>",
+		"### service Users\n\nStores users.\n",
+		"<a id=\"users.Users.get\"></a>\n#### Users.get\n\n" +
+			"[User](#users.User) get(1: [shared.UUID](#shared.UUID) id) throws (1: [NotFound](#users.NotFound) notFound)\n\n" +
+			"Looks up a user.\n",
+		"oneway void ping()\n",
+		"## Module shared\n\nDefined in `shared.thrift`.\n",
+		"### typedef UUID\n\nA unique identifier.\n\ntypedef string UUID\n",
+		"| ADMIN | 1 | Can read and write. |\n",
+		`| GUEST | 2 | deprecated = "true" |` + "\n",
+		"const [Role](#shared.Role) DEFAULT_ROLE = [Role.GUEST](#shared.Role)\n",
+	} {
+		assert.Contains(t, out, want)
+	}
+
+	assert.NotContains(t, out, "\n\n\n", "blocks must be separated by a single blank line")
+	assert.True(t,
+		bytes.Index(buf.Bytes(), []byte("## Module users")) <
+			bytes.Index(buf.Bytes(), []byte("## Module shared")),
+		"root module must be documented first")
+}
+
+func TestWriteHTML(t *testing.T) {
+	m := idltest.Compile(t, _testFiles, "users.thrift")
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, m, HTML))
+	out := buf.String()
+
+	for _, want := range []string{
+		`<section id="users">`,
+		`<h3 id="users.User">struct User</h3>`,
+		"<p>A registered user.</p>\n<p>Users may have at most one role.</p>",
+		`<td><a href="#shared.UUID">shared.UUID</a></td>`,
+		`<td>map&lt;string, list&lt;i32&gt;&gt;</td>`,
+		`<td><code>go.tag = &#34;json:\&#34;scores\&#34;&#34;</code></td>`,
+		`<h4 id="users.Users.get">Users.get</h4>`,
+		`<p class="signature"><a href="#users.User">User</a> get(1: <a href="#shared.UUID">shared.UUID</a> id) throws (1: <a href="#users.NotFound">NotFound</a> notFound)</p>`,
+		`<p class="signature">const <a href="#shared.Role">Role</a> DEFAULT_ROLE = <a href="#shared.Role">Role.GUEST</a></p>`,
+	} {
+		assert.Contains(t, out, want)
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	m := idltest.Compile(t, _testFiles, "users.thrift")
+	assert.Error(t, Write(ioutil.Discard, m, Format(42)))
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apidoc
+
+import (
+	"html/template"
+	"io"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+type htmlRenderer struct{}
+
+func (htmlRenderer) Escape(s string) string {
+	return template.HTMLEscapeString(s)
+}
+
+func (htmlRenderer) Link(anchor, text string) string {
+	return `<a href="#` + template.HTMLEscapeString(anchor) + `">` + text + "</a>"
+}
+
+func (htmlRenderer) Execute(w io.Writer, g *generator) error {
+	tmpl, err := template.New("html").Funcs(template.FuncMap{
+		"anchor": g.Anchor,
+		"typeRef": func(m *module, t compile.TypeSpec) template.HTML {
+			return template.HTML(g.TypeReference(m, t))
+		},
+		"serviceRef": func(m *module, s *compile.ServiceSpec) template.HTML {
+			return template.HTML(g.ServiceReference(m, s))
+		},
+		"resultType": func(m *module, f *compile.FunctionSpec) template.HTML {
+			return template.HTML(g.ResultType(m, f))
+		},
+		"constValue": func(m *module, v compile.ConstantValue) template.HTML {
+			return template.HTML(g.ConstantValue(m, v))
+		},
+		"annotations": func(a compile.Annotations) []template.HTML {
+			items := g.Annotations(a)
+			html := make([]template.HTML, len(items))
+			for i, item := range items {
+				html[i] = template.HTML(item)
+			}
+			return html
+		},
+		"exceptions": g.Exceptions,
+		"kind":       g.Kind,
+		"functions":  g.Functions,
+		"required":   g.Requiredness,
+		"scoped":     newScoped,
+		"paragraphs": paragraphs,
+	}).Parse(_htmlTemplate)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, g)
+}
+
+// paragraphs splits a doc comment into paragraphs separated by blank lines.
+func paragraphs(doc string) []string {
+	var (
+		paras   []string
+		current []string
+	)
+	for _, line := range strings.Split(doc, "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				paras = append(paras, strings.Join(current, "\n"))
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		paras = append(paras, strings.Join(current, "\n"))
+	}
+	return paras
+}
+
+const _htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>API Reference</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 60em; }
+code, .signature { font-family: monospace; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; text-align: left; vertical-align: top; }
+</style>
+</head>
+<body>
+<h1>API Reference</h1>
+{{range $m := .Modules}}
+<section id="{{anchor $m}}">
+<h2>Module {{$m.Name}}</h2>
+<p>Defined in <code>{{$m.Path}}</code>.</p>
+{{- with $m.Includes}}
+<p>Includes {{range $i, $inc := .}}{{if $i}}, {{end}}<a href="#{{anchor $inc}}">{{$inc.Name}}</a>{{end}}.</p>
+{{- end}}
+{{- range $m.Constants}}
+<h3 id="{{anchor .}}">const {{.Name}}</h3>
+{{- template "doc" .Doc}}
+<p class="signature">const {{typeRef $m .Type}} {{.Name}} = {{constValue $m .Value}}</p>
+{{- end}}
+{{- range $t := $m.Types}}
+<h3 id="{{anchor $t}}">{{kind $t}} {{$t.ThriftName}}</h3>
+{{- template "doc" $t.Doc}}
+{{- template "annotations" $t.Annotations}}
+{{- if eq (kind $t) "typedef"}}
+<p class="signature">typedef {{typeRef $m $t.Target}} {{$t.Name}}</p>
+{{- else if eq (kind $t) "enum"}}
+<table>
+<tr><th>Name</th><th>Value</th><th>Description</th></tr>
+{{- range $t.Items}}
+<tr><td>{{.Name}}</td><td>{{.Value}}</td><td>{{template "cell" .}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+{{- with $t.Fields}}{{template "fields" scoped $m .}}{{end}}
+{{- end}}
+{{- end}}
+{{- range $s := $m.Services}}
+<h3 id="{{anchor $s}}">service {{$s.Name}}</h3>
+{{- with $s.Parent}}
+<p>Extends {{serviceRef $m .}}.</p>
+{{- end}}
+{{- template "doc" $s.Doc}}
+{{- template "annotations" $s.Annotations}}
+{{- range $f := functions $s}}
+<h4 id="{{anchor $s $f}}">{{$s.Name}}.{{$f.Name}}</h4>
+<p class="signature">{{resultType $m $f}} {{$f.Name}}({{template "params" scoped $m $f.ArgsSpec}})
+{{- with exceptions $f}} throws ({{template "params" scoped $m .}}){{end}}</p>
+{{- template "doc" $f.Doc}}
+{{- template "annotations" $f.Annotations}}
+{{- with $f.ArgsSpec}}
+<h5>Parameters</h5>
+{{- template "fields" scoped $m .}}
+{{- end}}
+{{- with exceptions $f}}
+<h5>Exceptions</h5>
+{{- template "fields" scoped $m .}}
+{{- end}}
+{{- end}}
+{{- end}}
+</section>
+{{- end}}
+</body>
+</html>
+
+{{- define "doc"}}
+{{- range paragraphs .}}
+<p>{{.}}</p>
+{{- end}}
+{{- end}}
+
+{{- define "annotations"}}
+{{- with annotations .}}
+<p>Annotations: {{range $i, $a := .}}{{if $i}}, {{end}}<code>{{$a}}</code>{{end}}</p>
+{{- end}}
+{{- end}}
+
+{{- define "cell"}}
+{{- range $i, $p := paragraphs .Doc}}{{if $i}}<br>{{end}}{{$p}}{{end}}
+{{- with annotations .Annotations}}{{if $.Doc}}<br>{{end}}{{range $i, $a := .}}{{if $i}}, {{end}}<code>{{$a}}</code>{{end}}{{end}}
+{{- end}}
+
+{{- define "params"}}
+{{- $m := .Module}}
+{{- range $i, $f := .Value}}{{if $i}}, {{end}}{{$f.ID}}: {{typeRef $m $f.Type}} {{$f.Name}}{{end}}
+{{- end}}
+
+{{- define "fields"}}
+{{- $m := .Module}}
+<table>
+<tr><th>ID</th><th>Name</th><th>Type</th><th>Requiredness</th><th>Default</th><th>Description</th></tr>
+{{- range .Value}}
+<tr><td>{{.ID}}</td><td>{{.Name}}</td><td>{{typeRef $m .Type}}</td><td>{{required .}}</td><td>{{constValue $m .Default}}</td><td>{{template "cell" .}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+`
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apidoc
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+var _markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`|`, `\|`,
+)
+
+// _markdownCellReplacer makes text safe for use inside a table cell.
+var _markdownCellReplacer = strings.NewReplacer(
+	"\r\n", "<br>",
+	"\n", "<br>",
+	"|", `\|`,
+)
+
+type markdownRenderer struct{}
+
+func (markdownRenderer) Escape(s string) string {
+	return _markdownEscaper.Replace(s)
+}
+
+func (markdownRenderer) Link(anchor, text string) string {
+	return "[" + text + "](#" + anchor + ")"
+}
+
+func (markdownRenderer) Execute(w io.Writer, g *generator) error {
+	tmpl, err := template.New("markdown").Funcs(template.FuncMap{
+		"anchor":      g.Anchor,
+		"typeRef":     g.TypeReference,
+		"serviceRef":  g.ServiceReference,
+		"resultType":  g.ResultType,
+		"exceptions":  g.Exceptions,
+		"constValue":  g.ConstantValue,
+		"kind":        g.Kind,
+		"functions":   g.Functions,
+		"annotations": g.Annotations,
+		"required":    g.Requiredness,
+		"scoped":      newScoped,
+		"escape":      g.r.Escape,
+		"cell":        _markdownCellReplacer.Replace,
+		"join":        strings.Join,
+	}).Parse(_markdownTemplate)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, g); err != nil {
+		return err
+	}
+
+	// The template separates blocks liberally. Collapse consecutive blank
+	// lines so that blocks are separated by exactly one.
+	out := _blankLines.ReplaceAll(bytes.TrimSpace(buf.Bytes()), []byte("\n\n"))
+	out = append(out, '\n')
+	_, err = w.Write(out)
+	return err
+}
+
+var _blankLines = regexp.MustCompile(`\n{3,}`)
+
+const _markdownTemplate = `# API Reference
+{{range $m := .Modules}}
+
+<a id="{{anchor $m}}"></a>
+## Module {{escape $m.Name}}
+
+Defined in ` + "`{{$m.Path}}`" + `.
+{{with $m.Includes}}
+Includes {{range $i, $inc := .}}{{if $i}}, {{end}}[{{escape $inc.Name}}](#{{anchor $inc}}){{end}}.
+{{end}}
+
+{{range $m.Constants}}
+
+<a id="{{anchor .}}"></a>
+### const {{escape .Name}}
+
+{{.Doc}}
+
+const {{typeRef $m .Type}} {{escape .Name}} = {{constValue $m .Value}}
+
+{{end}}
+
+{{range $t := $m.Types}}
+
+<a id="{{anchor $t}}"></a>
+### {{kind $t}} {{escape $t.ThriftName}}
+
+{{$t.Doc}}
+
+{{template "annotations" $t.Annotations}}
+
+{{if eq (kind $t) "typedef"}}
+typedef {{typeRef $m $t.Target}} {{escape $t.Name}}
+{{else if eq (kind $t) "enum"}}
+| Name | Value | Description |
+| --- | --- | --- |
+{{range $t.Items}}| {{escape .Name}} | {{.Value}} | {{template "cell" .}} |
+{{end}}
+{{else}}
+{{with $t.Fields}}{{template "fields" scoped $m .}}{{end}}
+{{end}}
+
+{{end}}
+
+{{range $s := $m.Services}}
+
+<a id="{{anchor $s}}"></a>
+### service {{escape $s.Name}}
+
+{{with $s.Parent}}Extends {{serviceRef $m .}}.{{end}}
+
+{{$s.Doc}}
+
+{{template "annotations" $s.Annotations}}
+
+{{range $f := functions $s}}
+
+<a id="{{anchor $s $f}}"></a>
+#### {{escape $s.Name}}.{{escape $f.Name}}
+
+{{resultType $m $f}} {{escape $f.Name}}({{template "params" scoped $m $f.ArgsSpec}})
+{{- with exceptions $f}} throws ({{template "params" scoped $m .}}){{end}}
+
+{{$f.Doc}}
+
+{{template "annotations" $f.Annotations}}
+
+{{with $f.ArgsSpec}}
+**Parameters**
+
+{{template "fields" scoped $m .}}
+{{end}}
+
+{{with exceptions $f}}
+**Exceptions**
+
+{{template "fields" scoped $m .}}
+{{end}}
+
+{{end}}
+
+{{end}}
+
+{{end}}
+
+{{- define "annotations"}}
+{{- with annotations .}}Annotations: {{join . ", "}}{{end}}
+{{- end}}
+
+{{- define "cell"}}
+{{- cell .Doc}}
+{{- with annotations .Annotations}}{{if $.Doc}}<br>{{end}}{{cell (join . ", ")}}{{end}}
+{{- end}}
+
+{{- define "params"}}
+{{- $m := .Module}}
+{{- range $i, $f := .Value}}{{if $i}}, {{end}}{{$f.ID}}: {{typeRef $m $f.Type}} {{escape $f.Name}}{{end}}
+{{- end}}
+
+{{- define "fields"}}
+{{- $m := .Module -}}
+| ID | Name | Type | Requiredness | Default | Description |
+| --- | --- | --- | --- | --- | --- |
+{{range .Value}}| {{.ID}} | {{escape .Name}} | {{typeRef $m .Type}} | {{required .}} | {{constValue $m .Default}} | {{template "cell" .}} |
+{{end}}
+{{- end}}
+`
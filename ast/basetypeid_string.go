@@ -36,11 +36,12 @@ func _() {
 	_ = x[DoubleTypeID-6]
 	_ = x[StringTypeID-7]
 	_ = x[BinaryTypeID-8]
+	_ = x[SlistTypeID-9]
 }
 
-const _BaseTypeID_name = "BoolTypeIDI8TypeIDI16TypeIDI32TypeIDI64TypeIDDoubleTypeIDStringTypeIDBinaryTypeID"
+const _BaseTypeID_name = "BoolTypeIDI8TypeIDI16TypeIDI32TypeIDI64TypeIDDoubleTypeIDStringTypeIDBinaryTypeIDSlistTypeID"
 
-var _BaseTypeID_index = [...]uint8{0, 10, 18, 27, 36, 45, 57, 69, 81}
+var _BaseTypeID_index = [...]uint8{0, 10, 18, 27, 36, 45, 57, 69, 81, 92}
 
 func (i BaseTypeID) String() string {
 	i -= 1
@@ -20,6 +20,8 @@
 
 package ast
 
+import "math/big"
+
 // ConstantValue unifies the different types representing constant values in
 // Thrift files.
 type ConstantValue interface {
@@ -28,27 +30,37 @@ type ConstantValue interface {
 	constantValue()
 }
 
-func (ConstantBoolean) node()   {}
-func (ConstantInteger) node()   {}
-func (ConstantString) node()    {}
-func (ConstantDouble) node()    {}
-func (ConstantReference) node() {}
-func (ConstantMap) node()       {}
-func (ConstantList) node()      {}
-
-func (ConstantBoolean) visitChildren(nodeStack, visitor)   {}
-func (ConstantInteger) visitChildren(nodeStack, visitor)   {}
-func (ConstantString) visitChildren(nodeStack, visitor)    {}
-func (ConstantDouble) visitChildren(nodeStack, visitor)    {}
-func (ConstantReference) visitChildren(nodeStack, visitor) {}
-
-func (ConstantBoolean) constantValue()   {}
-func (ConstantInteger) constantValue()   {}
-func (ConstantString) constantValue()    {}
-func (ConstantDouble) constantValue()    {}
-func (ConstantReference) constantValue() {}
-func (ConstantMap) constantValue()       {}
-func (ConstantList) constantValue()      {}
+func (ConstantBoolean) node()    {}
+func (ConstantInteger) node()    {}
+func (ConstantBigInteger) node() {}
+func (ConstantString) node()     {}
+func (ConstantDouble) node()     {}
+func (ConstantReference) node()  {}
+func (ConstantMap) node()        {}
+func (ConstantList) node()       {}
+
+func (ConstantBoolean) visitChildren(nodeStack, visitor)    {}
+func (ConstantInteger) visitChildren(nodeStack, visitor)    {}
+func (ConstantBigInteger) visitChildren(nodeStack, visitor) {}
+func (ConstantString) visitChildren(nodeStack, visitor)     {}
+func (ConstantDouble) visitChildren(nodeStack, visitor)     {}
+func (ConstantReference) visitChildren(nodeStack, visitor)  {}
+
+func (v ConstantBoolean) rewriteChildren(nodeStack, rewriter) Node    { return v }
+func (v ConstantInteger) rewriteChildren(nodeStack, rewriter) Node    { return v }
+func (v ConstantBigInteger) rewriteChildren(nodeStack, rewriter) Node { return v }
+func (v ConstantString) rewriteChildren(nodeStack, rewriter) Node     { return v }
+func (v ConstantDouble) rewriteChildren(nodeStack, rewriter) Node     { return v }
+func (v ConstantReference) rewriteChildren(nodeStack, rewriter) Node  { return v }
+
+func (ConstantBoolean) constantValue()    {}
+func (ConstantInteger) constantValue()    {}
+func (ConstantBigInteger) constantValue() {}
+func (ConstantString) constantValue()     {}
+func (ConstantDouble) constantValue()     {}
+func (ConstantReference) constantValue()  {}
+func (ConstantMap) constantValue()        {}
+func (ConstantList) constantValue()       {}
 
 func (l ConstantList) visitChildren(ss nodeStack, v visitor) {
 	for _, item := range l.Items {
@@ -67,10 +79,46 @@ func (i ConstantMapItem) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, i.Value)
 }
 
-func (m ConstantMap) lineNumber() int       { return m.Line }
-func (i ConstantMapItem) lineNumber() int   { return i.Line }
-func (l ConstantList) lineNumber() int      { return l.Line }
-func (r ConstantReference) lineNumber() int { return r.Line }
+func (l ConstantList) rewriteChildren(ss nodeStack, r rewriter) Node {
+	l.Items = r.rewriteConstantValues(ss, l.Items)
+	return l
+}
+
+func (m ConstantMap) rewriteChildren(ss nodeStack, r rewriter) Node {
+	m.Items = r.rewriteConstantMapItems(ss, m.Items)
+	return m
+}
+
+func (i ConstantMapItem) rewriteChildren(ss nodeStack, r rewriter) Node {
+	i.Key = r.rewriteConstantValue(ss, i.Key)
+	i.Value = r.rewriteConstantValue(ss, i.Value)
+	return i
+}
+
+func (m ConstantMap) pos() Position       { return Position{Line: m.Line, Column: m.Column} }
+func (i ConstantMapItem) pos() Position   { return Position{Line: i.Line, Column: i.Column} }
+func (l ConstantList) pos() Position      { return Position{Line: l.Line, Column: l.Column} }
+func (r ConstantReference) pos() Position { return Position{Line: r.Line, Column: r.Column} }
+
+func (m ConstantMap) withPos(p Position) Node {
+	m.Line, m.Column = p.Line, p.Column
+	return m
+}
+
+func (i ConstantMapItem) withPos(p Position) Node {
+	i.Line, i.Column = p.Line, p.Column
+	return i
+}
+
+func (l ConstantList) withPos(p Position) Node {
+	l.Line, l.Column = p.Line, p.Column
+	return l
+}
+
+func (r ConstantReference) withPos(p Position) Node {
+	r.Line, r.Column = p.Line, p.Column
+	return r
+}
 
 // ConstantBoolean is a boolean value specified in the Thrift file.
 //
@@ -81,11 +129,24 @@ type ConstantBoolean bool
 // ConstantInteger is an integer value specified in the Thrift file.
 //
 //   42
+//   0x2a
+//   0o52
+//   0b101010
 type ConstantInteger int64
 
+// ConstantBigInteger is an integer value specified in the Thrift file which
+// does not fit into an int64.
+//
+//   0xffffffffffffffff
+type ConstantBigInteger struct {
+	Value *big.Int
+}
+
 // ConstantString is a string literal specified in the Thrift file.
 //
 //   "hello world"
+//   `raw
+//   string`
 type ConstantString string
 
 // ConstantDouble is a floating point value specified in the Thrift file.
@@ -99,14 +160,16 @@ type ConstantDouble float64
 //
 // Note that map literals can also be used to build structs.
 type ConstantMap struct {
-	Items []ConstantMapItem
-	Line  int
+	Items  []ConstantMapItem
+	Line   int
+	Column int
 }
 
 // ConstantMapItem is a single item in a ConstantMap.
 type ConstantMapItem struct {
 	Key, Value ConstantValue
 	Line       int
+	Column     int
 }
 
 func (ConstantMapItem) node() {}
@@ -115,8 +178,9 @@ func (ConstantMapItem) node() {}
 //
 // 	[1, 2, 3]
 type ConstantList struct {
-	Items []ConstantValue
-	Line  int
+	Items  []ConstantValue
+	Line   int
+	Column int
 }
 
 // ConstantReference is a reference to another constant value defined in the
@@ -128,5 +192,6 @@ type ConstantReference struct {
 	Name string
 
 	// Line number on which this reference was made.
-	Line int
+	Line   int
+	Column int
 }
@@ -23,8 +23,9 @@ package ast
 // DefinitionInfo provides a common way to access name and line information
 // for definitions.
 type DefinitionInfo struct {
-	Name string
-	Line int
+	Name   string
+	Line   int
+	Column int
 }
 
 // Definition unifies the different types representing items defined in the
@@ -40,26 +41,38 @@ type Definition interface {
 //
 // 	const i32 foo = 42
 type Constant struct {
-	Name  string
-	Type  Type
-	Value ConstantValue
-	Line  int
-	Doc   string
+	Name   string
+	Type   Type
+	Value  ConstantValue
+	Line   int
+	Column int
+	Doc    string
 }
 
 func (*Constant) node()       {}
 func (*Constant) definition() {}
 
-func (c *Constant) lineNumber() int { return c.Line }
+func (c *Constant) pos() Position { return Position{Line: c.Line, Column: c.Column} }
 
 func (c *Constant) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, c.Type)
 	v.visit(ss, c.Value)
 }
 
+func (c *Constant) rewriteChildren(ss nodeStack, r rewriter) Node {
+	c.Type = r.rewriteType(ss, c.Type)
+	c.Value = r.rewriteConstantValue(ss, c.Value)
+	return c
+}
+
+func (c *Constant) withPos(p Position) Node {
+	c.Line, c.Column = p.Line, p.Column
+	return c
+}
+
 // Info for Constant
 func (c *Constant) Info() DefinitionInfo {
-	return DefinitionInfo{Name: c.Name, Line: c.Line}
+	return DefinitionInfo{Name: c.Name, Line: c.Line, Column: c.Column}
 }
 
 // Typedef is used to define an alias for another type.
@@ -71,6 +84,7 @@ type Typedef struct {
 	Type        Type
 	Annotations []*Annotation
 	Line        int
+	Column      int
 	Doc         string
 }
 
@@ -78,7 +92,7 @@ type Typedef struct {
 func (*Typedef) node()       {}
 func (*Typedef) definition() {}
 
-func (t *Typedef) lineNumber() int { return t.Line }
+func (t *Typedef) pos() Position { return Position{Line: t.Line, Column: t.Column} }
 
 func (t *Typedef) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, t.Type)
@@ -87,9 +101,20 @@ func (t *Typedef) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (t *Typedef) rewriteChildren(ss nodeStack, r rewriter) Node {
+	t.Type = r.rewriteType(ss, t.Type)
+	t.Annotations = r.rewriteAnnotations(ss, t.Annotations)
+	return t
+}
+
+func (t *Typedef) withPos(p Position) Node {
+	t.Line, t.Column = p.Line, p.Column
+	return t
+}
+
 // Info for Typedef.
 func (t *Typedef) Info() DefinitionInfo {
-	return DefinitionInfo{Name: t.Name, Line: t.Line}
+	return DefinitionInfo{Name: t.Name, Line: t.Line, Column: t.Column}
 }
 
 // Enum is a set of named integer values.
@@ -106,13 +131,16 @@ type Enum struct {
 	Items       []*EnumItem
 	Annotations []*Annotation
 	Line        int
-	Doc         string
+	Column      int
+	// Line on which the closing brace of the definition appears.
+	EndLine int
+	Doc     string
 }
 
 func (*Enum) node()       {}
 func (*Enum) definition() {}
 
-func (e *Enum) lineNumber() int { return e.Line }
+func (e *Enum) pos() Position { return Position{Line: e.Line, Column: e.Column} }
 
 func (e *Enum) visitChildren(ss nodeStack, v visitor) {
 	for _, item := range e.Items {
@@ -124,9 +152,20 @@ func (e *Enum) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (e *Enum) rewriteChildren(ss nodeStack, r rewriter) Node {
+	e.Items = r.rewriteEnumItems(ss, e.Items)
+	e.Annotations = r.rewriteAnnotations(ss, e.Annotations)
+	return e
+}
+
+func (e *Enum) withPos(p Position) Node {
+	e.Line, e.Column = p.Line, p.Column
+	return e
+}
+
 // Info for Enum.
 func (e *Enum) Info() DefinitionInfo {
-	return DefinitionInfo{Name: e.Name, Line: e.Line}
+	return DefinitionInfo{Name: e.Name, Line: e.Line, Column: e.Column}
 }
 
 // EnumItem is a single item in an Enum definition.
@@ -136,12 +175,13 @@ type EnumItem struct {
 	Value       *int
 	Annotations []*Annotation
 	Line        int
+	Column      int
 	Doc         string
 }
 
 func (*EnumItem) node() {}
 
-func (i *EnumItem) lineNumber() int { return i.Line }
+func (i *EnumItem) pos() Position { return Position{Line: i.Line, Column: i.Column} }
 
 func (i *EnumItem) visitChildren(ss nodeStack, v visitor) {
 	for _, ann := range i.Annotations {
@@ -149,6 +189,55 @@ func (i *EnumItem) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (i *EnumItem) rewriteChildren(ss nodeStack, r rewriter) Node {
+	i.Annotations = r.rewriteAnnotations(ss, i.Annotations)
+	return i
+}
+
+func (i *EnumItem) withPos(p Position) Node {
+	i.Line, i.Column = p.Line, p.Column
+	return i
+}
+
+// Senum is the deprecated string enum. It is equivalent to a typedef of
+// string whose values are not checked.
+//
+// 	senum Color { "red", "green", "blue" }
+type Senum struct {
+	Name        string
+	Values      []string
+	Annotations []*Annotation
+	Line        int
+	Column      int
+	Doc         string
+}
+
+func (*Senum) node()       {}
+func (*Senum) definition() {}
+
+func (s *Senum) pos() Position { return Position{Line: s.Line, Column: s.Column} }
+
+func (s *Senum) visitChildren(ss nodeStack, v visitor) {
+	for _, ann := range s.Annotations {
+		v.visit(ss, ann)
+	}
+}
+
+func (s *Senum) rewriteChildren(ss nodeStack, r rewriter) Node {
+	s.Annotations = r.rewriteAnnotations(ss, s.Annotations)
+	return s
+}
+
+func (s *Senum) withPos(p Position) Node {
+	s.Line, s.Column = p.Line, p.Column
+	return s
+}
+
+// Info for Senum.
+func (s *Senum) Info() DefinitionInfo {
+	return DefinitionInfo{Name: s.Name, Line: s.Line, Column: s.Column}
+}
+
 // StructureType specifies whether a struct-like type is a struct, union, or
 // exception.
 type StructureType int
@@ -186,13 +275,16 @@ type Struct struct {
 	Fields      []*Field
 	Annotations []*Annotation
 	Line        int
-	Doc         string
+	Column      int
+	// Line on which the closing brace of the definition appears.
+	EndLine int
+	Doc     string
 }
 
 func (*Struct) node()       {}
 func (*Struct) definition() {}
 
-func (s *Struct) lineNumber() int { return s.Line }
+func (s *Struct) pos() Position { return Position{Line: s.Line, Column: s.Column} }
 
 func (s *Struct) visitChildren(ss nodeStack, v visitor) {
 	for _, field := range s.Fields {
@@ -203,9 +295,20 @@ func (s *Struct) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (s *Struct) rewriteChildren(ss nodeStack, r rewriter) Node {
+	s.Fields = r.rewriteFields(ss, s.Fields)
+	s.Annotations = r.rewriteAnnotations(ss, s.Annotations)
+	return s
+}
+
+func (s *Struct) withPos(p Position) Node {
+	s.Line, s.Column = p.Line, p.Column
+	return s
+}
+
 // Info for Struct.
 func (s *Struct) Info() DefinitionInfo {
-	return DefinitionInfo{Name: s.Name, Line: s.Line}
+	return DefinitionInfo{Name: s.Name, Line: s.Line, Column: s.Column}
 }
 
 // Service is a collection of functions.
@@ -222,13 +325,16 @@ type Service struct {
 	Parent      *ServiceReference
 	Annotations []*Annotation
 	Line        int
-	Doc         string
+	Column      int
+	// Line on which the closing brace of the definition appears.
+	EndLine int
+	Doc     string
 }
 
 func (*Service) node()       {}
 func (*Service) definition() {}
 
-func (s *Service) lineNumber() int { return s.Line }
+func (s *Service) pos() Position { return Position{Line: s.Line, Column: s.Column} }
 
 func (s *Service) visitChildren(ss nodeStack, v visitor) {
 	for _, function := range s.Functions {
@@ -239,9 +345,20 @@ func (s *Service) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (s *Service) rewriteChildren(ss nodeStack, r rewriter) Node {
+	s.Functions = r.rewriteFunctions(ss, s.Functions)
+	s.Annotations = r.rewriteAnnotations(ss, s.Annotations)
+	return s
+}
+
+func (s *Service) withPos(p Position) Node {
+	s.Line, s.Column = p.Line, p.Column
+	return s
+}
+
 // Info for Service.
 func (s *Service) Info() DefinitionInfo {
-	return DefinitionInfo{Name: s.Name, Line: s.Line}
+	return DefinitionInfo{Name: s.Name, Line: s.Line, Column: s.Column}
 }
 
 // Function is a single function inside a service.
@@ -250,20 +367,27 @@ func (s *Service) Info() DefinitionInfo {
 // 		throws (1: KeyNotFoundError notFound) (
 // 			ttl.milliseconds = "250"
 // 		)
+//
+// Functions which stream their responses have a return type of the form
+// stream<T>. For these, ReturnType is T and Streaming is true.
+//
+// 	stream<Event> subscribe(1: string topic)
 type Function struct {
 	Name        string
 	Parameters  []*Field
 	ReturnType  Type
 	Exceptions  []*Field
 	OneWay      bool
+	Streaming   bool
 	Annotations []*Annotation
 	Line        int
+	Column      int
 	Doc         string
 }
 
 func (*Function) node() {}
 
-func (n *Function) lineNumber() int { return n.Line }
+func (n *Function) pos() Position { return Position{Line: n.Line, Column: n.Column} }
 
 func (n *Function) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, n.ReturnType)
@@ -278,6 +402,19 @@ func (n *Function) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (n *Function) rewriteChildren(ss nodeStack, r rewriter) Node {
+	n.ReturnType = r.rewriteType(ss, n.ReturnType)
+	n.Parameters = r.rewriteFields(ss, n.Parameters)
+	n.Exceptions = r.rewriteFields(ss, n.Exceptions)
+	n.Annotations = r.rewriteAnnotations(ss, n.Annotations)
+	return n
+}
+
+func (n *Function) withPos(p Position) Node {
+	n.Line, n.Column = p.Line, p.Column
+	return n
+}
+
 // Requiredness represents whether a field was marked as required or optional,
 // or if the user did not specify either.
 type Requiredness int
@@ -294,22 +431,30 @@ const (
 //
 // 	1: required i32 foo = 0
 // 	2: optional binary (max_length = "4096") bar
-// 	3: i64 baz (go.name = "qux")
+// 	3: i64 baz (go.name = "Qux")
 //
 type Field struct {
-	ID           int
-	Name         string
-	Type         Type
+	ID int
+	// ImplicitID is true if the field was declared without an ID. Like
+	// Apache Thrift, such fields are assigned IDs -1, -2, and so on in the
+	// order in which they appear.
+	ImplicitID bool
+	Name       string
+	Type       Type
+	// Reference is true if the field was declared with the deprecated '&'
+	// marker, which Apache Thrift uses to generate C++ fields as pointers.
+	Reference    bool
 	Requiredness Requiredness
 	Default      ConstantValue
 	Annotations  []*Annotation
 	Line         int
+	Column       int
 	Doc          string
 }
 
 func (*Field) node() {}
 
-func (n *Field) lineNumber() int { return n.Line }
+func (n *Field) pos() Position { return Position{Line: n.Line, Column: n.Column} }
 
 func (n *Field) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, n.Type)
@@ -319,8 +464,21 @@ func (n *Field) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (n *Field) rewriteChildren(ss nodeStack, r rewriter) Node {
+	n.Type = r.rewriteType(ss, n.Type)
+	n.Default = r.rewriteConstantValue(ss, n.Default)
+	n.Annotations = r.rewriteAnnotations(ss, n.Annotations)
+	return n
+}
+
+func (n *Field) withPos(p Position) Node {
+	n.Line, n.Column = p.Line, p.Column
+	return n
+}
+
 // ServiceReference is a reference to another service.
 type ServiceReference struct {
-	Name string
-	Line int
+	Name   string
+	Line   int
+	Column int
 }
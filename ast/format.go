@@ -0,0 +1,635 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ast
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// _formatIndent is used to indent the contents of definitions in formatted
+// documents.
+const _formatIndent = "    "
+
+// Format writes the given program to w as a canonically formatted Thrift
+// document.
+//
+// Definitions are separated by blank lines and their contents are indented
+// with four spaces. Field IDs inside a definition are right-aligned, and
+// annotations are ordered by name. Docstrings are written in a consistent
+// style, while other comments recorded in the Comments field of the Program
+// are written verbatim next to the nodes they appeared next to in the
+// original document.
+func Format(w io.Writer, p *Program) error {
+	f := formatter{comments: p.Comments}
+	f.program(p)
+	_, err := w.Write(f.buf.Bytes())
+	return err
+}
+
+type formatter struct {
+	buf    bytes.Buffer
+	indent int
+
+	// Comments which have not yet been written.
+	comments []*Comment
+
+	// Line in the original document of the last node or comment written.
+	last int
+}
+
+func (f *formatter) program(p *Program) {
+	for i, h := range p.Headers {
+		if i > 0 && fmt.Sprintf("%T", h) != fmt.Sprintf("%T", p.Headers[i-1]) {
+			f.blank()
+		}
+		f.node(Pos(h), "", formatHeader(h))
+	}
+
+	for i, d := range p.Definitions {
+		if i == 0 || !adjacentDefinitions(p.Definitions[i-1], d) {
+			f.blank()
+		}
+		f.definition(d)
+	}
+
+	if n := len(f.comments); n > 0 {
+		f.blank()
+		f.leading(f.comments[n-1].Line+1, "", false)
+	}
+}
+
+// adjacentDefinitions returns true if the two definitions may be written
+// without a blank line between them.
+//
+// Consecutive constants or typedefs which were on adjacent lines in the
+// original document are kept together.
+func adjacentDefinitions(prev, d Definition) bool {
+	switch d := d.(type) {
+	case *Constant:
+		_, ok := prev.(*Constant)
+		return ok && d.Doc == "" && d.Line == prev.Info().Line+1
+	case *Typedef:
+		_, ok := prev.(*Typedef)
+		return ok && d.Doc == "" && d.Line == prev.Info().Line+1
+	default:
+		return false
+	}
+}
+
+func (f *formatter) definition(d Definition) {
+	switch d := d.(type) {
+	case *Constant:
+		f.node(d.pos(), d.Doc, fmt.Sprintf("const %v %v = %v",
+			formatType(d.Type), d.Name, formatConstantValue(d.Value)))
+
+	case *Typedef:
+		f.node(d.pos(), d.Doc, fmt.Sprintf("typedef %v %v%v",
+			formatType(d.Type), d.Name, formatAnnotations(d.Annotations)))
+
+	case *Senum:
+		values := make([]string, len(d.Values))
+		for i, v := range d.Values {
+			values[i] = strconv.Quote(v)
+		}
+		f.node(d.pos(), d.Doc, fmt.Sprintf("senum %v { %v }%v",
+			d.Name, strings.Join(values, ", "), formatAnnotations(d.Annotations)))
+
+	case *Enum:
+		var first int
+		if len(d.Items) > 0 {
+			first = d.Items[0].Line
+		}
+
+		f.block(d.pos(), first, d.EndLine, d.Doc, "enum "+d.Name, d.Annotations, func() {
+			for _, item := range d.Items {
+				s := item.Name
+				if item.Value != nil {
+					s += fmt.Sprintf(" = %d", *item.Value)
+				}
+				f.node(item.pos(), item.Doc, s+formatAnnotations(item.Annotations))
+			}
+		})
+
+	case *Struct:
+		var kind string
+		switch d.Type {
+		case StructType:
+			kind = "struct"
+		case UnionType:
+			kind = "union"
+		case ExceptionType:
+			kind = "exception"
+		default:
+			panic(fmt.Sprintf("unknown structure type %v", d.Type))
+		}
+
+		var first int
+		if len(d.Fields) > 0 {
+			first = d.Fields[0].Line
+		}
+
+		f.block(d.pos(), first, d.EndLine, d.Doc, kind+" "+d.Name, d.Annotations, func() {
+			f.fields(d.Fields)
+		})
+
+	case *Service:
+		name := "service " + d.Name
+		if d.Parent != nil {
+			name += " extends " + d.Parent.Name
+		}
+
+		var first int
+		if len(d.Functions) > 0 {
+			first = d.Functions[0].Line
+		}
+
+		f.block(d.pos(), first, d.EndLine, d.Doc, name, d.Annotations, func() {
+			for _, fn := range d.Functions {
+				f.function(fn)
+			}
+		})
+
+	default:
+		panic(fmt.Sprintf("unknown definition %T", d))
+	}
+}
+
+// block writes a definition whose contents are enclosed in braces. first
+// is the line of the first item inside the braces, or zero if there are no
+// items. The items are written by the given function.
+func (f *formatter) block(pos Position, first, endLine int, doc, name string, anns []*Annotation, contents func()) {
+	if first == 0 && !f.hasCommentsBefore(endLine) {
+		f.node(pos, doc, name+" {}"+formatAnnotations(anns))
+		return
+	}
+
+	f.leading(pos.Line, doc, f.takeInlineDoc(pos, doc))
+	if first == pos.Line {
+		// Comments at the end of the line belong to the first item.
+		f.writeIndent()
+		f.writeInline(pos)
+		f.buf.WriteString(name + " {")
+		f.trailing(0)
+	} else {
+		f.write(pos, name+" {")
+	}
+
+	f.indent++
+	contents()
+	f.leading(endLine, "", false)
+	f.indent--
+	f.node(Position{Line: endLine}, "", "}"+formatAnnotations(anns))
+}
+
+// fields writes the given fields one per line with their IDs, types, and
+// names aligned.
+func (f *formatter) fields(fields []*Field) {
+	var w fieldWidths
+	for _, field := range fields {
+		if !field.ImplicitID {
+			if n := len(strconv.Itoa(field.ID)); n > w.ID {
+				w.ID = n
+			}
+		}
+		if n := len(formatRequiredness(field.Requiredness)); n > w.Requiredness {
+			w.Requiredness = n
+		}
+		if n := len(formatFieldType(field)); n > w.Type {
+			w.Type = n
+		}
+	}
+
+	for _, field := range fields {
+		f.node(field.pos(), field.Doc, formatField(field, w))
+	}
+}
+
+func (f *formatter) function(fn *Function) {
+	var s string
+	if fn.OneWay {
+		s = "oneway "
+	}
+
+	switch {
+	case fn.ReturnType == nil:
+		s += "void"
+	case fn.Streaming:
+		s += "stream<" + formatType(fn.ReturnType) + ">"
+	default:
+		s += formatType(fn.ReturnType)
+	}
+	s += " " + fn.Name
+
+	// Parameters and exceptions are written on separate lines only if they
+	// have docstrings.
+	if !hasDocs(fn.Parameters) && !hasDocs(fn.Exceptions) {
+		s += "(" + formatFieldList(fn.Parameters) + ")"
+		if len(fn.Exceptions) > 0 {
+			s += " throws (" + formatFieldList(fn.Exceptions) + ")"
+		}
+		f.node(fn.pos(), fn.Doc, s+formatAnnotations(fn.Annotations))
+		return
+	}
+
+	if len(fn.Parameters) == 0 {
+		f.node(fn.pos(), fn.Doc, s+"() throws (")
+	} else {
+		f.node(fn.pos(), fn.Doc, s+"(")
+		f.indent++
+		f.fields(fn.Parameters)
+		f.indent--
+		if len(fn.Exceptions) == 0 {
+			f.writeLine(")" + formatAnnotations(fn.Annotations))
+			return
+		}
+		f.writeLine(") throws (")
+	}
+
+	f.indent++
+	f.fields(fn.Exceptions)
+	f.indent--
+	f.writeLine(")" + formatAnnotations(fn.Annotations))
+}
+
+// node writes a node which starts at the given position of the original
+// document, preceded by its docstring and any comments before it, and
+// followed by any comments after it on the same line.
+func (f *formatter) node(pos Position, doc, s string) {
+	f.leading(pos.Line, doc, f.takeInlineDoc(pos, doc))
+	f.write(pos, s)
+}
+
+// takeInlineDoc drops the docstring of a node which starts at the given
+// position if the docstring is on the same line, right before the node. It
+// returns true if it did.
+func (f *formatter) takeInlineDoc(pos Position, doc string) bool {
+	if doc == "" {
+		return false
+	}
+
+	last := -1
+	for i, c := range f.comments {
+		if c.Line > pos.Line {
+			break
+		}
+		if isInline(c, pos) {
+			last = i
+		}
+	}
+	if last < 0 || !isDocstring(f.comments[last].Text) {
+		return false
+	}
+
+	f.comments = append(f.comments[:last:last], f.comments[last+1:]...)
+	return true
+}
+
+// isInline returns true if the given comment appears before a node which
+// starts at the given position, on the same line.
+func isInline(c *Comment, pos Position) bool {
+	return c.Line == pos.Line && c.Column > 0 && c.Column < pos.Column
+}
+
+// write writes the given line of output, preceded by any comments found
+// before the given position on the same line of the original document, and
+// followed by any comments found after it.
+func (f *formatter) write(pos Position, s string) {
+	f.writeIndent()
+	f.writeInline(pos)
+	f.buf.WriteString(s)
+	f.trailing(pos.Line)
+}
+
+// trailing writes the comments found at the end of the given line of the
+// original document and ends the line of output.
+func (f *formatter) trailing(line int) {
+	for len(f.comments) > 0 && line > 0 && f.comments[0].Line == line {
+		c := f.comments[0]
+		f.comments = f.comments[1:]
+
+		f.buf.WriteString(" ")
+		f.writeComment(c.Text)
+	}
+	f.buf.WriteString("\n")
+	if line > 0 {
+		f.last = line
+	}
+}
+
+// writeInline writes the comments found before the given position on the
+// same line of the original document.
+func (f *formatter) writeInline(pos Position) {
+	for len(f.comments) > 0 && isInline(f.comments[0], pos) {
+		f.writeComment(f.comments[0].Text)
+		f.buf.WriteString(" ")
+		f.comments = f.comments[1:]
+	}
+}
+
+// leading writes the comments found before the given line of the original
+// document, followed by the given docstring. docTaken is true if the
+// docstring's comment was already dropped because it was on the same line
+// as the node.
+func (f *formatter) leading(line int, doc string, docTaken bool) {
+	n := 0
+	for n < len(f.comments) && f.comments[n].Line < line {
+		n++
+	}
+	pending := f.comments[:n]
+	f.comments = f.comments[n:]
+
+	// The docstring of the node is the last comment before it. It's
+	// written separately in a consistent style.
+	next := line
+	if doc != "" && !docTaken && n > 0 && isDocstring(pending[n-1].Text) {
+		next = pending[n-1].Line
+		pending = pending[:n-1]
+	}
+
+	for i, c := range pending {
+		// Keep comments which were separated from the preceding node by a
+		// blank line apart from it.
+		if i == 0 && f.last > 0 && c.Line > f.last+1 {
+			f.blank()
+		}
+
+		f.writeIndent()
+		f.writeComment(c.Text)
+		f.buf.WriteString("\n")
+
+		end := c.Line + strings.Count(c.Text, "\n")
+		f.last = end
+
+		following := next
+		if i+1 < len(pending) {
+			following = pending[i+1].Line
+		}
+		if following > end+1 {
+			f.blank()
+		}
+	}
+
+	if doc != "" {
+		f.writeDoc(doc)
+	}
+}
+
+func (f *formatter) hasCommentsBefore(line int) bool {
+	return len(f.comments) > 0 && f.comments[0].Line < line
+}
+
+func (f *formatter) writeDoc(doc string) {
+	lines := strings.Split(doc, "\n")
+	if len(lines) == 1 {
+		f.writeLine("/** " + doc + " */")
+		return
+	}
+
+	f.writeLine("/**")
+	for _, l := range lines {
+		if l == "" {
+			f.writeLine(" *")
+		} else {
+			f.writeLine(" * " + l)
+		}
+	}
+	f.writeLine(" */")
+}
+
+// writeComment writes the text of a comment. Block comments in which every
+// line starts with a "*" are re-indented to match the current indentation.
+// Other comments are written verbatim.
+func (f *formatter) writeComment(text string) {
+	lines := strings.Split(text, "\n")
+	rest := lines[1:]
+	for _, l := range rest {
+		if l = strings.TrimLeft(l, " \t"); l != "" && l[0] != '*' {
+			f.buf.WriteString(text)
+			return
+		}
+	}
+
+	f.buf.WriteString(lines[0])
+	for _, l := range rest {
+		f.buf.WriteString("\n")
+		if l = strings.TrimLeft(l, " \t"); l != "" {
+			// Align the "*" at the start of each line with the "*" in "/*".
+			f.writeIndent()
+			f.buf.WriteString(" ")
+			f.buf.WriteString(l)
+		}
+	}
+}
+
+// blank writes a blank line unless the output is empty, already ends with a
+// blank line, or is at the start of a block.
+func (f *formatter) blank() {
+	b := f.buf.Bytes()
+	if len(b) == 0 || bytes.HasSuffix(b, []byte("\n\n")) ||
+		bytes.HasSuffix(b, []byte("{\n")) || bytes.HasSuffix(b, []byte("(\n")) {
+		return
+	}
+	f.buf.WriteString("\n")
+}
+
+func (f *formatter) writeLine(s string) {
+	f.writeIndent()
+	f.buf.WriteString(s)
+	f.buf.WriteString("\n")
+}
+
+func (f *formatter) writeIndent() {
+	for i := 0; i < f.indent; i++ {
+		f.buf.WriteString(_formatIndent)
+	}
+}
+
+func formatHeader(h Header) string {
+	switch h := h.(type) {
+	case *Include:
+		if h.Name != "" {
+			return fmt.Sprintf("include %q as %v", h.Path, h.Name)
+		}
+		return fmt.Sprintf("include %q", h.Path)
+	case *Namespace:
+		return fmt.Sprintf("namespace %v %v%v", h.Scope, h.Name, formatAnnotations(h.Annotations))
+	default:
+		panic(fmt.Sprintf("unknown header %T", h))
+	}
+}
+
+// fieldWidths are the widths of the columns in which the IDs, requiredness,
+// and types of fields are aligned.
+type fieldWidths struct {
+	ID, Requiredness, Type int
+}
+
+// formatField formats a field with its ID right-aligned and its
+// requiredness and type left-aligned to the given widths.
+func formatField(field *Field, w fieldWidths) string {
+	var buf bytes.Buffer
+	switch {
+	case !field.ImplicitID:
+		fmt.Fprintf(&buf, "%*d: ", w.ID, field.ID)
+	case w.ID > 0:
+		buf.WriteString(strings.Repeat(" ", w.ID+2))
+	}
+
+	if r := formatRequiredness(field.Requiredness); w.Requiredness > 0 {
+		fmt.Fprintf(&buf, "%-*s ", w.Requiredness, r)
+	}
+
+	fmt.Fprintf(&buf, "%-*s ", w.Type, formatFieldType(field))
+	buf.WriteString(field.Name)
+	if field.Default != nil {
+		buf.WriteString(" = ")
+		buf.WriteString(formatConstantValue(field.Default))
+	}
+	buf.WriteString(formatAnnotations(field.Annotations))
+	return buf.String()
+}
+
+func formatFieldList(fields []*Field) string {
+	fs := make([]string, len(fields))
+	for i, field := range fields {
+		// Fields on the same line are not aligned.
+		fs[i] = formatField(field, fieldWidths{
+			Requiredness: len(formatRequiredness(field.Requiredness)),
+		})
+	}
+	return strings.Join(fs, ", ")
+}
+
+func formatRequiredness(r Requiredness) string {
+	switch r {
+	case Required:
+		return "required"
+	case Optional:
+		return "optional"
+	default:
+		return ""
+	}
+}
+
+// formatFieldType formats the type of a field, including the "&" marking
+// it as a reference.
+func formatFieldType(field *Field) string {
+	if field.Reference {
+		return formatType(field.Type) + " &"
+	}
+	return formatType(field.Type)
+}
+
+func formatType(t Type) string {
+	switch t := t.(type) {
+	case BaseType:
+		return BaseType{ID: t.ID}.String() + formatAnnotations(t.Annotations)
+	case MapType:
+		return fmt.Sprintf("map<%v, %v>", formatType(t.KeyType), formatType(t.ValueType)) +
+			formatAnnotations(t.Annotations)
+	case ListType:
+		return fmt.Sprintf("list<%v>", formatType(t.ValueType)) + formatAnnotations(t.Annotations)
+	case SetType:
+		return fmt.Sprintf("set<%v>", formatType(t.ValueType)) + formatAnnotations(t.Annotations)
+	case TypeReference:
+		return t.Name
+	default:
+		panic(fmt.Sprintf("unknown type %T", t))
+	}
+}
+
+func formatConstantValue(v ConstantValue) string {
+	switch v := v.(type) {
+	case ConstantBoolean:
+		return strconv.FormatBool(bool(v))
+	case ConstantInteger:
+		return strconv.FormatInt(int64(v), 10)
+	case ConstantBigInteger:
+		return v.Value.String()
+	case ConstantString:
+		return strconv.Quote(string(v))
+	case ConstantDouble:
+		return formatDouble(float64(v))
+	case ConstantReference:
+		return v.Name
+	case ConstantList:
+		items := make([]string, len(v.Items))
+		for i, item := range v.Items {
+			items[i] = formatConstantValue(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case ConstantMap:
+		items := make([]string, len(v.Items))
+		for i, item := range v.Items {
+			items[i] = formatConstantValue(item.Key) + ": " + formatConstantValue(item.Value)
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		panic(fmt.Sprintf("unknown constant value %T", v))
+	}
+}
+
+// formatDouble formats a double so that it is not mistaken for an integer.
+// Thrift requires a decimal point before any exponent.
+func formatDouble(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	mantissa, exp := s, ""
+	if i := strings.IndexByte(s, 'e'); i >= 0 {
+		mantissa, exp = s[:i], s[i:]
+	}
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+	return mantissa + exp
+}
+
+// formatAnnotations formats the given annotations, ordered by name, with a
+// leading space. An empty string is returned if there are no annotations.
+func formatAnnotations(anns []*Annotation) string {
+	if len(anns) == 0 {
+		return ""
+	}
+
+	sorted := make([]*Annotation, len(anns))
+	copy(sorted, anns)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return " " + FormatAnnotations(sorted)
+}
+
+func hasDocs(fields []*Field) bool {
+	for _, field := range fields {
+		if field.Doc != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func isDocstring(text string) bool {
+	return strings.HasPrefix(text, "/**") && len(text) > len("/**/")
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ast_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want string
+	}{
+		{desc: "empty"},
+		{
+			desc: "headers",
+			give: `
+				namespace py foo.bar
+				include "shared.thrift"
+				include t "types.thrift"
+				include "./common/types.thrift" as common
+				namespace   go   foo   (go.package="example.com/foo")
+			`,
+			want: `
+namespace py foo.bar
+
+include "shared.thrift"
+include "types.thrift" as t
+include "./common/types.thrift" as common
+
+namespace go foo (go.package = "example.com/foo")
+`,
+		},
+		{
+			desc: "constants and typedefs",
+			give: `
+				typedef string UUID
+				typedef i64 (js.type = "Long", cpp.type = "int64_t") Timestamp (unit = "ms")
+				const list<double> ratios = [1, 2.5, 1.0e10];
+				const map<string, bool> flags = {'a': true, "b\"": false}
+				/** Default UUID. */
+				const UUID defaultUUID = "00000000"
+			`,
+			want: `
+typedef string UUID
+typedef i64 (cpp.type = "int64_t", js.type = "Long") Timestamp (unit = "ms")
+
+const list<double> ratios = [1, 2.5, 1.0e+10]
+const map<string, bool> flags = {"a": true, "b\"": false}
+
+/** Default UUID. */
+const UUID defaultUUID = "00000000"
+`,
+		},
+		{
+			desc: "enum",
+			give: `
+				enum Role { User, Admin = 10 (go.name = "Administrator"), } (b = "2", a = "1")
+				enum Empty {}
+			`,
+			want: `
+enum Role {
+    User
+    Admin = 10 (go.name = "Administrator")
+} (a = "1", b = "2")
+
+enum Empty {}
+`,
+		},
+		{
+			desc: "deprecated types",
+			give: `
+				senum Color { "red", 'green'; "blue" } (a = "1")
+				typedef slist Names
+			`,
+			want: `
+senum Color { "red", "green", "blue" } (a = "1")
+
+typedef slist Names
+`,
+		},
+		{
+			desc: "struct",
+			give: `
+				/**
+				 * A user.
+				 *
+				 *   With details.
+				 */
+				struct User {
+					1: required string name (min_length = "3");
+					/** Status of the user. */
+					10: optional Status status = Status.Enabled,
+					100: map<string, list<binary>> attributes
+				}
+				union U { 1: string a 2: i32 b }
+				exception E {}
+			`,
+			want: `
+/**
+ * A user.
+ *
+ *   With details.
+ */
+struct User {
+      1: required string                    name (min_length = "3")
+    /** Status of the user. */
+     10: optional Status                    status = Status.Enabled
+    100:          map<string, list<binary>> attributes
+}
+
+union U {
+    1: string a
+    2: i32    b
+}
+
+exception E {}
+`,
+		},
+		{
+			desc: "implicit field IDs and references",
+			give: `struct T { optional i32 a; 10: T & b; string c }`,
+			want: `
+struct T {
+        optional i32    a
+    10:          T &    b
+                 string c
+}
+`,
+		},
+		{
+			desc: "service",
+			give: `
+				service Base {}
+				service KeyValue extends Base {
+					void setValue(1: string key, 2: binary value) throws (1: Error err) (ttl = "10")
+					oneway void forget(1: string key)
+					stream<binary> watch(
+						/** Key to watch. */
+						1: string key)
+					bool ping() throws (
+						/** The server is unavailable. */
+						1: Unavailable unavailable
+					)
+				}
+			`,
+			want: `
+service Base {}
+
+service KeyValue extends Base {
+    void setValue(1: string key, 2: binary value) throws (1: Error err) (ttl = "10")
+    oneway void forget(1: string key)
+    stream<binary> watch(
+        /** Key to watch. */
+        1: string key
+    )
+    bool ping() throws (
+        /** The server is unavailable. */
+        1: Unavailable unavailable
+    )
+}
+`,
+		},
+		{
+			desc: "leading block comments",
+			give: `
+				/* Deprecated. */ struct Old {
+					/* Unused. */ 1: string name
+					2: i32 age /* years */
+				}
+				/** Default age. */ const i32 defaultAge = 18
+				/* Inline. */ struct Empty {}
+				/* One line. */ struct Point { 1: i32 x; 2: i32 y } // point
+			`,
+			want: `
+/* Deprecated. */ struct Old {
+    /* Unused. */ 1: string name
+    2: i32    age /* years */
+}
+
+/** Default age. */
+const i32 defaultAge = 18
+
+/* Inline. */ struct Empty {}
+
+/* One line. */ struct Point {
+    1: i32 x // point
+    2: i32 y
+}
+`,
+		},
+		{
+			desc: "integer and raw string literals",
+			give: "const i64 a = 0x2a\n" +
+				"const i64 b = 0xffffffffffffffff\n" +
+				"const string c = `line 1\n" +
+				"line 2`",
+			want: `
+const i64 a = 42
+const i64 b = 18446744073709551615
+const string c = "line 1\nline 2"
+`,
+		},
+		{
+			desc: "comments",
+			give: `
+// License header.
+
+include "shared.thrift" # trailing
+
+/* Block
+   comment */
+struct Foo { // opening
+	// Leading comment.
+	1: string a // trailing
+
+	/*
+	 * Removed field.
+	 */
+	// 2: string b
+} // closing
+
+/** Orphan docstring. */
+
+/** Not attached to the definition. */
+// Between docstring and definition.
+enum Bar {
+	A
+}
+
+// End of file.`,
+			want: `
+// License header.
+
+include "shared.thrift" # trailing
+
+/* Block
+   comment */
+struct Foo { // opening
+    // Leading comment.
+    1: string a // trailing
+
+    /*
+     * Removed field.
+     */
+    // 2: string b
+} // closing
+
+/** Orphan docstring. */
+
+/** Not attached to the definition. */
+// Between docstring and definition.
+enum Bar {
+    A
+}
+
+// End of file.
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			want := strings.TrimPrefix(tt.want, "\n")
+			got := format(t, tt.give)
+			assert.Equal(t, want, got)
+
+			// Formatting is idempotent.
+			assert.Equal(t, got, format(t, got), "formatting is not idempotent")
+		})
+	}
+}
+
+func TestFormatDoubles(t *testing.T) {
+	prog := &ast.Program{Definitions: []ast.Definition{
+		&ast.Constant{
+			Name: "a",
+			Type: ast.BaseType{ID: ast.DoubleTypeID},
+			Value: ast.ConstantList{Items: []ast.ConstantValue{
+				ast.ConstantDouble(1),
+				ast.ConstantDouble(-0.5),
+				ast.ConstantDouble(1e21),
+				ast.ConstantDouble(1.5e-10),
+			}},
+		},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, ast.Format(&buf, prog))
+	assert.Equal(t, "const double a = [1.0, -0.5, 1.0e+21, 1.5e-10]\n", buf.String())
+
+	// The output must be parseable with the same values.
+	parsed, err := idl.Parse(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t,
+		prog.Definitions[0].(*ast.Constant).Value.(ast.ConstantList).Items,
+		parsed.Definitions[0].(*ast.Constant).Value.(ast.ConstantList).Items)
+}
+
+func format(t *testing.T, s string) string {
+	prog, err := (&idl.Config{Comments: true}).Parse([]byte(s))
+	require.NoError(t, err, "failed to parse:\n%s", s)
+
+	var buf bytes.Buffer
+	require.NoError(t, ast.Format(&buf, prog))
+	return buf.String()
+}
@@ -22,7 +22,8 @@ package ast
 
 // HeaderInfo provides a common way to access the line for a header.
 type HeaderInfo struct {
-	Line int
+	Line   int
+	Column int
 }
 
 // Header unifies types representing header in the AST.
@@ -37,46 +38,75 @@ type Header interface {
 //
 // 	include "shared.thrift"
 //
-// thriftrw's custom Include-As syntax may be used to change the name under
-// which the file is imported.
+// The Include-As syntax may be used to change the name under which the file
+// is imported. This allows including multiple files with the same name.
+//
+// 	include "shared.thrift" as t
+//
+// thriftrw also supports an older form of this syntax.
 //
 // 	include t "shared.thrift"
 type Include struct {
-	Path string
-	Name string
-	Line int
+	Path   string
+	Name   string
+	Line   int
+	Column int
 }
 
 func (*Include) node()   {}
 func (*Include) header() {}
 
-func (i *Include) lineNumber() int { return i.Line }
+func (i *Include) pos() Position { return Position{Line: i.Line, Column: i.Column} }
 
 func (*Include) visitChildren(nodeStack, visitor) {}
 
+func (i *Include) rewriteChildren(nodeStack, rewriter) Node { return i }
+
+func (i *Include) withPos(p Position) Node {
+	i.Line, i.Column = p.Line, p.Column
+	return i
+}
+
 // Info for Include.
 func (i *Include) Info() HeaderInfo {
-	return HeaderInfo{Line: i.Line}
+	return HeaderInfo{Line: i.Line, Column: i.Column}
 }
 
 // Namespace statements allow users to choose the package name used by the
 // generated code in certain languages.
 //
 // 	namespace py foo.bar
+// 	namespace go foo.bar (go.package = "example.com/foo/bar")
 type Namespace struct {
-	Scope string
-	Name  string
-	Line  int
+	Scope       string
+	Name        string
+	Annotations []*Annotation
+	Line        int
+	Column      int
 }
 
 func (*Namespace) node()   {}
 func (*Namespace) header() {}
 
-func (n *Namespace) lineNumber() int { return n.Line }
+func (n *Namespace) pos() Position { return Position{Line: n.Line, Column: n.Column} }
 
-func (*Namespace) visitChildren(nodeStack, visitor) {}
+func (n *Namespace) visitChildren(ss nodeStack, v visitor) {
+	for _, ann := range n.Annotations {
+		v.visit(ss, ann)
+	}
+}
+
+func (n *Namespace) rewriteChildren(ss nodeStack, r rewriter) Node {
+	n.Annotations = r.rewriteAnnotations(ss, n.Annotations)
+	return n
+}
+
+func (n *Namespace) withPos(p Position) Node {
+	n.Line, n.Column = p.Line, p.Column
+	return n
+}
 
 // Info for Namespace.
 func (n *Namespace) Info() HeaderInfo {
-	return HeaderInfo{Line: n.Line}
+	return HeaderInfo{Line: n.Line, Column: n.Column}
 }
@@ -20,21 +20,55 @@
 
 package ast
 
-// Nodes which know the line number they were defined on can implement this
+import (
+	"fmt"
+	"strconv"
+)
+
+// Position is a location inside a Thrift document.
+type Position struct {
+	// Line number, starting at 1.
+	Line int
+
+	// Column number, starting at 1. This is a byte offset into the line so
+	// a tab counts as a single column.
+	Column int
+}
+
+// String returns the position in the form "line:column", or just "line" if
+// the column is unknown.
+func (p Position) String() string {
+	if p.Column > 0 {
+		return fmt.Sprintf("%d:%d", p.Line, p.Column)
+	}
+	return strconv.Itoa(p.Line)
+}
+
+// Nodes which know the position they were defined at can implement this
 // interface.
 type nodeWithLine interface {
 	Node
 
-	lineNumber() int
+	pos() Position
+
+	// withPos returns the node moved to the given position. Nodes which
+	// are pointers are modified in place; others return a copy.
+	withPos(Position) Node
 }
 
 // LineNumber returns the line in the file at which the given node was defined
 // or 0 if the Node does not record its line number.
 func LineNumber(n Node) int {
+	return Pos(n).Line
+}
+
+// Pos returns the position in the file at which the given node was defined
+// or the zero Position if the Node does not record its position.
+func Pos(n Node) Position {
 	if nl, ok := n.(nodeWithLine); ok {
-		return nl.lineNumber()
+		return nl.pos()
 	}
-	return 0
+	return Position{}
 }
 
 var _ nodeWithLine = (*Annotation)(nil)
@@ -52,6 +86,7 @@ var _ nodeWithLine = (*Include)(nil)
 var _ nodeWithLine = ListType{}
 var _ nodeWithLine = MapType{}
 var _ nodeWithLine = (*Namespace)(nil)
+var _ nodeWithLine = (*Senum)(nil)
 var _ nodeWithLine = (*Service)(nil)
 var _ nodeWithLine = SetType{}
 var _ nodeWithLine = (*Struct)(nil)
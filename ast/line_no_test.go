@@ -63,6 +63,7 @@ func TestLineNumber(t *testing.T) {
 		{give: ListType{Line: 18}, want: 18},
 		{give: SetType{Line: 19}, want: 19},
 		{give: TypeReference{Line: 20}, want: 20},
+		{give: &Senum{Line: 21}, want: 21},
 	}
 
 	for _, tt := range tests {
@@ -71,3 +72,30 @@ func TestLineNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestPos(t *testing.T) {
+	tests := []struct {
+		give Node
+		want Position
+	}{
+		{give: ConstantString("foo"), want: Position{}},
+		{give: &Program{}, want: Position{}},
+		{give: &Annotation{Line: 1, Column: 2}, want: Position{Line: 1, Column: 2}},
+		{give: ConstantReference{Line: 5, Column: 9}, want: Position{Line: 5, Column: 9}},
+		{give: &Struct{Line: 10, Column: 1}, want: Position{Line: 10, Column: 1}},
+		{give: &Field{Line: 13, Column: 5}, want: Position{Line: 13, Column: 5}},
+		{give: &Include{Line: 14, Column: 1}, want: Position{Line: 14, Column: 1}},
+		{give: MapType{Line: 17, Column: 12}, want: Position{Line: 17, Column: 12}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%T", tt.give), func(t *testing.T) {
+			assert.Equal(t, tt.want, Pos(tt.give))
+		})
+	}
+}
+
+func TestPositionString(t *testing.T) {
+	assert.Equal(t, "3:14", Position{Line: 3, Column: 14}.String())
+	assert.Equal(t, "3", Position{Line: 3}.String())
+}
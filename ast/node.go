@@ -32,11 +32,19 @@ type Node interface {
 	//
 	// This is needed to be able to walk the AST with ast.Walk
 	visitChildren(nodeStack, visitor)
+
+	// Nodes must replace each child node n with rewriter.rewrite(nodeStack,
+	// n), or one of its typed variants, and return themselves with the
+	// rewritten children. Nodes which are not pointers return a copy.
+	//
+	// This is needed to be able to rewrite the AST with ast.Rewrite
+	rewriteChildren(nodeStack, rewriter) Node
 }
 
 var _ Node = (*Annotation)(nil)
 var _ Node = BaseType{}
 var _ Node = (*Constant)(nil)
+var _ Node = ConstantBigInteger{}
 var _ Node = ConstantBoolean(true)
 var _ Node = ConstantDouble(1.0)
 var _ Node = ConstantInteger(1)
@@ -54,6 +62,7 @@ var _ Node = ListType{}
 var _ Node = MapType{}
 var _ Node = (*Namespace)(nil)
 var _ Node = (*Program)(nil)
+var _ Node = (*Senum)(nil)
 var _ Node = (*Service)(nil)
 var _ Node = SetType{}
 var _ Node = (*Struct)(nil)
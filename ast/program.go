@@ -24,6 +24,11 @@ package ast
 type Program struct {
 	Headers     []Header
 	Definitions []Definition
+
+	// Comments in the file, including docstrings, in the order in which
+	// they appear. This is populated only if the parser was asked to
+	// record comments.
+	Comments []*Comment
 }
 
 func (*Program) node() {}
@@ -36,3 +41,26 @@ func (p *Program) visitChildren(ss nodeStack, v visitor) {
 		v.visit(ss, d)
 	}
 }
+
+func (p *Program) rewriteChildren(ss nodeStack, r rewriter) Node {
+	p.Headers = r.rewriteHeaders(ss, p.Headers)
+	p.Definitions = r.rewriteDefinitions(ss, p.Definitions)
+	return p
+}
+
+// Comment is a comment in a Thrift file.
+//
+// 	// line comment
+// 	# line comment
+// 	/* block comment */
+// 	/** docstring */
+type Comment struct {
+	// Text of the comment, including the comment markers.
+	Text string
+
+	// Line on which the comment starts.
+	Line int
+
+	// Column at which the comment starts, or 0 if unknown.
+	Column int
+}
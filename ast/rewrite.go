@@ -0,0 +1,225 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ast
+
+import "fmt"
+
+// RewriteFunc is called by Rewrite for each node of the AST. It returns the
+// node which should take the place of the given node, or the given node
+// itself to keep it.
+//
+// Returning nil removes the node from the list that holds it, for example
+// a field from its struct, or clears the attribute that holds it, for
+// example the default value of a field.
+type RewriteFunc func(w Walker, n Node) Node
+
+// Rewrite walks the AST depth-first starting at the given node and replaces
+// each node with the result of calling f on it. Children are rewritten
+// before their parents so f sees nodes with their rewritten children. The
+// rewritten root node is returned.
+//
+// 	// Rename the struct Foo to Bar, along with all references to it.
+// 	prog = ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+// 		switch n := n.(type) {
+// 		case *ast.Struct:
+// 			if n.Name == "Foo" {
+// 				n.Name = "Bar"
+// 			}
+// 		case ast.TypeReference:
+// 			if n.Name == "Foo" {
+// 				n.Name = "Bar"
+// 				return n
+// 			}
+// 		}
+// 		return n
+// 	}).(*ast.Program)
+//
+// Nodes which replace other nodes but don't have a position of their own,
+// that is, their Line is 0, are moved to the position of the node they
+// replace so that errors and tools which work with positions still point
+// to the right place in the Thrift file.
+//
+// Rewrite modifies the AST in place: the attributes of nodes which are
+// pointers, such as *Struct, are updated directly. Parse the Thrift file
+// again if the original AST is needed afterwards.
+//
+// Rewrite panics if f replaces a node with one that cannot take its place,
+// for example a Type with a *Field.
+func Rewrite(n Node, f RewriteFunc) Node {
+	return rewriter{f: f}.rewrite(nil, n)
+}
+
+// rewriter adapts a user-provided RewriteFunc so that we can use the
+// internal rewriteChildren method on nodes.
+type rewriter struct {
+	f RewriteFunc
+}
+
+func (r rewriter) rewrite(ss nodeStack, n Node) Node {
+	if n == nil {
+		return nil
+	}
+
+	pos := Pos(n)
+	n = n.rewriteChildren(append(ss, n), r)
+	n = r.f(ss, n)
+	if n == nil || pos == (Position{}) || Pos(n).Line != 0 {
+		return n
+	}
+	if nl, ok := n.(nodeWithLine); ok {
+		n = nl.withPos(pos)
+	}
+	return n
+}
+
+func (r rewriter) rewriteType(ss nodeStack, t Type) Type {
+	switch n := r.rewrite(ss, t).(type) {
+	case nil:
+		return nil
+	case Type:
+		return n
+	default:
+		panic(rewriteMismatch("Type", t, n))
+	}
+}
+
+func (r rewriter) rewriteConstantValue(ss nodeStack, v ConstantValue) ConstantValue {
+	switch n := r.rewrite(ss, v).(type) {
+	case nil:
+		return nil
+	case ConstantValue:
+		return n
+	default:
+		panic(rewriteMismatch("ConstantValue", v, n))
+	}
+}
+
+func (r rewriter) rewriteConstantValues(ss nodeStack, vs []ConstantValue) []ConstantValue {
+	out := vs[:0]
+	for _, v := range vs {
+		if v = r.rewriteConstantValue(ss, v); v != nil {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (r rewriter) rewriteConstantMapItems(ss nodeStack, items []ConstantMapItem) []ConstantMapItem {
+	out := items[:0]
+	for _, item := range items {
+		switch n := r.rewrite(ss, item).(type) {
+		case nil:
+		case ConstantMapItem:
+			out = append(out, n)
+		default:
+			panic(rewriteMismatch("ConstantMapItem", item, n))
+		}
+	}
+	return out
+}
+
+func (r rewriter) rewriteAnnotations(ss nodeStack, anns []*Annotation) []*Annotation {
+	out := anns[:0]
+	for _, ann := range anns {
+		switch n := r.rewrite(ss, ann).(type) {
+		case nil:
+		case *Annotation:
+			out = append(out, n)
+		default:
+			panic(rewriteMismatch("*Annotation", ann, n))
+		}
+	}
+	return out
+}
+
+func (r rewriter) rewriteFields(ss nodeStack, fields []*Field) []*Field {
+	out := fields[:0]
+	for _, field := range fields {
+		switch n := r.rewrite(ss, field).(type) {
+		case nil:
+		case *Field:
+			out = append(out, n)
+		default:
+			panic(rewriteMismatch("*Field", field, n))
+		}
+	}
+	return out
+}
+
+func (r rewriter) rewriteEnumItems(ss nodeStack, items []*EnumItem) []*EnumItem {
+	out := items[:0]
+	for _, item := range items {
+		switch n := r.rewrite(ss, item).(type) {
+		case nil:
+		case *EnumItem:
+			out = append(out, n)
+		default:
+			panic(rewriteMismatch("*EnumItem", item, n))
+		}
+	}
+	return out
+}
+
+func (r rewriter) rewriteFunctions(ss nodeStack, functions []*Function) []*Function {
+	out := functions[:0]
+	for _, function := range functions {
+		switch n := r.rewrite(ss, function).(type) {
+		case nil:
+		case *Function:
+			out = append(out, n)
+		default:
+			panic(rewriteMismatch("*Function", function, n))
+		}
+	}
+	return out
+}
+
+func (r rewriter) rewriteHeaders(ss nodeStack, headers []Header) []Header {
+	out := headers[:0]
+	for _, h := range headers {
+		switch n := r.rewrite(ss, h).(type) {
+		case nil:
+		case Header:
+			out = append(out, n)
+		default:
+			panic(rewriteMismatch("Header", h, n))
+		}
+	}
+	return out
+}
+
+func (r rewriter) rewriteDefinitions(ss nodeStack, defs []Definition) []Definition {
+	out := defs[:0]
+	for _, d := range defs {
+		switch n := r.rewrite(ss, d).(type) {
+		case nil:
+		case Definition:
+			out = append(out, n)
+		default:
+			panic(rewriteMismatch("Definition", d, n))
+		}
+	}
+	return out
+}
+
+func rewriteMismatch(want string, old, new Node) string {
+	return fmt.Sprintf("ast.Rewrite: cannot replace %T with %T: expected a %v", old, new, want)
+}
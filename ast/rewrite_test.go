@@ -0,0 +1,173 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrite(t *testing.T) {
+	prog, err := idl.Parse([]byte(`namespace go foo
+
+typedef Foo Alias (deprecated = "use Foo")
+
+struct Foo {
+    1: optional map<string, Foo> children (deprecated = "")
+    2: optional list<i32> values = [1, 2, 3]
+}
+
+service Svc {
+    Foo get(1: Foo foo) (deprecated = "")
+}
+`))
+	require.NoError(t, err)
+
+	var parents []ast.Node
+	got := ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+		switch n := n.(type) {
+		case *ast.Struct:
+			n.Name = "Bar"
+		case ast.TypeReference:
+			if n.Name == "Foo" {
+				n.Name = "Bar"
+				return n
+			}
+		case *ast.Annotation:
+			if n.Name == "deprecated" {
+				parents = append(parents, w.Parent())
+				return nil
+			}
+		case ast.ConstantInteger:
+			if n == 2 {
+				return nil
+			}
+		case ast.BaseType:
+			if n.ID == ast.I32TypeID {
+				return ast.BaseType{ID: ast.I64TypeID}
+			}
+		}
+		return n
+	})
+	require.Equal(t, prog, got, "pointer nodes must be rewritten in place")
+	assert.Len(t, parents, 3)
+
+	var buf bytes.Buffer
+	require.NoError(t, ast.Format(&buf, prog))
+	assert.Equal(t, `namespace go foo
+
+typedef Bar Alias
+
+struct Bar {
+    1: optional map<string, Bar> children
+    2: optional list<i64>        values = [1, 3]
+}
+
+service Svc {
+    Bar get(1: Bar foo)
+}
+`, buf.String())
+}
+
+func TestRewritePositions(t *testing.T) {
+	prog, err := idl.Parse([]byte(`struct Foo {
+    1: optional string bar
+    2: optional string baz
+}`))
+	require.NoError(t, err)
+
+	ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+		if bt, ok := n.(ast.BaseType); ok {
+			if _, ok := w.Parent().(*ast.Field); ok && w.Parent().(*ast.Field).Name == "baz" {
+				// Nodes with positions keep them.
+				return ast.BaseType{ID: ast.BinaryTypeID, Line: 10, Column: 3}
+			}
+			return ast.BaseType{ID: bt.ID, Annotations: []*ast.Annotation{{Name: "a", Value: "b"}}}
+		}
+		return n
+	})
+
+	fields := prog.Definitions[0].(*ast.Struct).Fields
+	assert.Equal(t, ast.Position{Line: 2, Column: 17}, ast.Pos(fields[0].Type))
+	assert.Equal(t, ast.Position{Line: 10, Column: 3}, ast.Pos(fields[1].Type))
+}
+
+func TestRewriteMismatch(t *testing.T) {
+	prog, err := idl.Parse([]byte(`struct Foo { 1: optional string bar }`))
+	require.NoError(t, err)
+
+	assert.PanicsWithValue(t,
+		"ast.Rewrite: cannot replace ast.BaseType with *ast.Field: expected a Type",
+		func() {
+			ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+				if _, ok := n.(ast.BaseType); ok {
+					return &ast.Field{Name: "bar"}
+				}
+				return n
+			})
+		})
+}
+
+func TestTypedVisitor(t *testing.T) {
+	prog, err := idl.Parse([]byte(`
+include "shared.thrift"
+
+const map<string, i32> Values = {"a": 1}
+
+struct Foo {
+    1: optional shared.Bar bar
+    2: optional list<Foo> foos
+}
+`))
+	require.NoError(t, err)
+
+	var (
+		structs, fields []string
+		refs            []string
+		items           int
+	)
+	ast.Walk(&ast.TypedVisitor{
+		Struct: func(w ast.Walker, s *ast.Struct) {
+			structs = append(structs, s.Name)
+		},
+		Field: func(w ast.Walker, f *ast.Field) {
+			assert.Equal(t, "Foo", w.Parent().(*ast.Struct).Name)
+			fields = append(fields, f.Name)
+		},
+		TypeReference: func(w ast.Walker, ref ast.TypeReference) {
+			refs = append(refs, ref.Name)
+		},
+		ConstantMapItem: func(ast.Walker, ast.ConstantMapItem) {
+			items++
+		},
+	}, prog)
+
+	assert.Equal(t, []string{"Foo"}, structs)
+	assert.Equal(t, []string{"bar", "foos"}, fields)
+	assert.Equal(t, []string{"shared.Bar", "Foo"}, refs)
+	assert.Equal(t, 1, items)
+}
@@ -45,6 +45,10 @@ const (
 	DoubleTypeID                       // double
 	StringTypeID                       // string
 	BinaryTypeID                       // binary
+
+	// SlistTypeID is the deprecated slist type. It is equivalent to
+	// string.
+	SlistTypeID // slist
 )
 
 // BaseType is a reference to a Thrift base type.
@@ -62,12 +66,13 @@ type BaseType struct {
 	// Type annotations associated with this reference.
 	Annotations []*Annotation
 	Line        int
+	Column      int
 }
 
 func (BaseType) node()      {}
 func (BaseType) fieldType() {}
 
-func (bt BaseType) lineNumber() int { return bt.Line }
+func (bt BaseType) pos() Position { return Position{Line: bt.Line, Column: bt.Column} }
 
 func (bt BaseType) visitChildren(ss nodeStack, v visitor) {
 	for _, ann := range bt.Annotations {
@@ -75,6 +80,16 @@ func (bt BaseType) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (bt BaseType) rewriteChildren(ss nodeStack, r rewriter) Node {
+	bt.Annotations = r.rewriteAnnotations(ss, bt.Annotations)
+	return bt
+}
+
+func (bt BaseType) withPos(p Position) Node {
+	bt.Line, bt.Column = p.Line, p.Column
+	return bt
+}
+
 func (bt BaseType) String() string {
 	var name string
 
@@ -95,6 +110,8 @@ func (bt BaseType) String() string {
 		name = "string"
 	case BinaryTypeID:
 		name = "binary"
+	case SlistTypeID:
+		name = "slist"
 	default:
 		panic(fmt.Sprintf("unknown base type %v", bt.ID))
 	}
@@ -113,12 +130,13 @@ type MapType struct {
 	KeyType, ValueType Type
 	Annotations        []*Annotation
 	Line               int
+	Column             int
 }
 
 func (MapType) node()      {}
 func (MapType) fieldType() {}
 
-func (mt MapType) lineNumber() int { return mt.Line }
+func (mt MapType) pos() Position { return Position{Line: mt.Line, Column: mt.Column} }
 
 func (mt MapType) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, mt.KeyType)
@@ -128,6 +146,18 @@ func (mt MapType) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (mt MapType) rewriteChildren(ss nodeStack, r rewriter) Node {
+	mt.KeyType = r.rewriteType(ss, mt.KeyType)
+	mt.ValueType = r.rewriteType(ss, mt.ValueType)
+	mt.Annotations = r.rewriteAnnotations(ss, mt.Annotations)
+	return mt
+}
+
+func (mt MapType) withPos(p Position) Node {
+	mt.Line, mt.Column = p.Line, p.Column
+	return mt
+}
+
 func (mt MapType) String() string {
 	return appendAnnotations(
 		fmt.Sprintf("map<%s, %s>", mt.KeyType, mt.ValueType),
@@ -146,12 +176,13 @@ type ListType struct {
 	ValueType   Type
 	Annotations []*Annotation
 	Line        int
+	Column      int
 }
 
 func (ListType) node()      {}
 func (ListType) fieldType() {}
 
-func (lt ListType) lineNumber() int { return lt.Line }
+func (lt ListType) pos() Position { return Position{Line: lt.Line, Column: lt.Column} }
 
 func (lt ListType) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, lt.ValueType)
@@ -160,6 +191,17 @@ func (lt ListType) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (lt ListType) rewriteChildren(ss nodeStack, r rewriter) Node {
+	lt.ValueType = r.rewriteType(ss, lt.ValueType)
+	lt.Annotations = r.rewriteAnnotations(ss, lt.Annotations)
+	return lt
+}
+
+func (lt ListType) withPos(p Position) Node {
+	lt.Line, lt.Column = p.Line, p.Column
+	return lt
+}
+
 func (lt ListType) String() string {
 	return appendAnnotations(
 		fmt.Sprintf("list<%s>", lt.ValueType.String()),
@@ -178,12 +220,13 @@ type SetType struct {
 	ValueType   Type
 	Annotations []*Annotation
 	Line        int
+	Column      int
 }
 
 func (SetType) node()      {}
 func (SetType) fieldType() {}
 
-func (st SetType) lineNumber() int { return st.Line }
+func (st SetType) pos() Position { return Position{Line: st.Line, Column: st.Column} }
 
 func (st SetType) visitChildren(ss nodeStack, v visitor) {
 	v.visit(ss, st.ValueType)
@@ -192,6 +235,17 @@ func (st SetType) visitChildren(ss nodeStack, v visitor) {
 	}
 }
 
+func (st SetType) rewriteChildren(ss nodeStack, r rewriter) Node {
+	st.ValueType = r.rewriteType(ss, st.ValueType)
+	st.Annotations = r.rewriteAnnotations(ss, st.Annotations)
+	return st
+}
+
+func (st SetType) withPos(p Position) Node {
+	st.Line, st.Column = p.Line, p.Column
+	return st
+}
+
 func (st SetType) String() string {
 	return appendAnnotations(
 		fmt.Sprintf("set<%s>", st.ValueType.String()),
@@ -201,17 +255,25 @@ func (st SetType) String() string {
 
 // TypeReference references a user-defined type.
 type TypeReference struct {
-	Name string
-	Line int
+	Name   string
+	Line   int
+	Column int
 }
 
 func (TypeReference) node()      {}
 func (TypeReference) fieldType() {}
 
-func (tr TypeReference) lineNumber() int { return tr.Line }
+func (tr TypeReference) pos() Position { return Position{Line: tr.Line, Column: tr.Column} }
 
 func (TypeReference) visitChildren(nodeStack, visitor) {}
 
+func (tr TypeReference) rewriteChildren(nodeStack, rewriter) Node { return tr }
+
+func (tr TypeReference) withPos(p Position) Node {
+	tr.Line, tr.Column = p.Line, p.Column
+	return tr
+}
+
 func (tr TypeReference) String() string {
 	return tr.Name
 }
@@ -66,3 +66,160 @@ func (vs multiVisitor) Visit(w Walker, n Node) Visitor {
 	}
 	return newVS
 }
+
+// TypedVisitor is a Visitor which calls the function matching the type of
+// each node of the AST. Nodes whose functions are nil are skipped, but their
+// children are still visited.
+//
+// 	ast.Walk(&ast.TypedVisitor{
+// 		Struct: func(w ast.Walker, s *ast.Struct) {
+// 			fmt.Println("struct", s.Name)
+// 		},
+// 		TypeReference: func(w ast.Walker, ref ast.TypeReference) {
+// 			fmt.Println("reference to", ref.Name)
+// 		},
+// 	}, prog)
+type TypedVisitor struct {
+	Annotation         func(Walker, *Annotation)
+	BaseType           func(Walker, BaseType)
+	Constant           func(Walker, *Constant)
+	ConstantBigInteger func(Walker, ConstantBigInteger)
+	ConstantBoolean    func(Walker, ConstantBoolean)
+	ConstantDouble     func(Walker, ConstantDouble)
+	ConstantInteger    func(Walker, ConstantInteger)
+	ConstantList       func(Walker, ConstantList)
+	ConstantMap        func(Walker, ConstantMap)
+	ConstantMapItem    func(Walker, ConstantMapItem)
+	ConstantReference  func(Walker, ConstantReference)
+	ConstantString     func(Walker, ConstantString)
+	Enum               func(Walker, *Enum)
+	EnumItem           func(Walker, *EnumItem)
+	Field              func(Walker, *Field)
+	Function           func(Walker, *Function)
+	Include            func(Walker, *Include)
+	ListType           func(Walker, ListType)
+	MapType            func(Walker, MapType)
+	Namespace          func(Walker, *Namespace)
+	Program            func(Walker, *Program)
+	Senum              func(Walker, *Senum)
+	Service            func(Walker, *Service)
+	SetType            func(Walker, SetType)
+	Struct             func(Walker, *Struct)
+	TypeReference      func(Walker, TypeReference)
+	Typedef            func(Walker, *Typedef)
+}
+
+// Visit calls the function for the type of the given node, if any.
+func (tv *TypedVisitor) Visit(w Walker, n Node) Visitor {
+	switch n := n.(type) {
+	case *Annotation:
+		if tv.Annotation != nil {
+			tv.Annotation(w, n)
+		}
+	case BaseType:
+		if tv.BaseType != nil {
+			tv.BaseType(w, n)
+		}
+	case *Constant:
+		if tv.Constant != nil {
+			tv.Constant(w, n)
+		}
+	case ConstantBigInteger:
+		if tv.ConstantBigInteger != nil {
+			tv.ConstantBigInteger(w, n)
+		}
+	case ConstantBoolean:
+		if tv.ConstantBoolean != nil {
+			tv.ConstantBoolean(w, n)
+		}
+	case ConstantDouble:
+		if tv.ConstantDouble != nil {
+			tv.ConstantDouble(w, n)
+		}
+	case ConstantInteger:
+		if tv.ConstantInteger != nil {
+			tv.ConstantInteger(w, n)
+		}
+	case ConstantList:
+		if tv.ConstantList != nil {
+			tv.ConstantList(w, n)
+		}
+	case ConstantMap:
+		if tv.ConstantMap != nil {
+			tv.ConstantMap(w, n)
+		}
+	case ConstantMapItem:
+		if tv.ConstantMapItem != nil {
+			tv.ConstantMapItem(w, n)
+		}
+	case ConstantReference:
+		if tv.ConstantReference != nil {
+			tv.ConstantReference(w, n)
+		}
+	case ConstantString:
+		if tv.ConstantString != nil {
+			tv.ConstantString(w, n)
+		}
+	case *Enum:
+		if tv.Enum != nil {
+			tv.Enum(w, n)
+		}
+	case *EnumItem:
+		if tv.EnumItem != nil {
+			tv.EnumItem(w, n)
+		}
+	case *Field:
+		if tv.Field != nil {
+			tv.Field(w, n)
+		}
+	case *Function:
+		if tv.Function != nil {
+			tv.Function(w, n)
+		}
+	case *Include:
+		if tv.Include != nil {
+			tv.Include(w, n)
+		}
+	case ListType:
+		if tv.ListType != nil {
+			tv.ListType(w, n)
+		}
+	case MapType:
+		if tv.MapType != nil {
+			tv.MapType(w, n)
+		}
+	case *Namespace:
+		if tv.Namespace != nil {
+			tv.Namespace(w, n)
+		}
+	case *Program:
+		if tv.Program != nil {
+			tv.Program(w, n)
+		}
+	case *Senum:
+		if tv.Senum != nil {
+			tv.Senum(w, n)
+		}
+	case *Service:
+		if tv.Service != nil {
+			tv.Service(w, n)
+		}
+	case SetType:
+		if tv.SetType != nil {
+			tv.SetType(w, n)
+		}
+	case *Struct:
+		if tv.Struct != nil {
+			tv.Struct(w, n)
+		}
+	case TypeReference:
+		if tv.TypeReference != nil {
+			tv.TypeReference(w, n)
+		}
+	case *Typedef:
+		if tv.Typedef != nil {
+			tv.Typedef(w, n)
+		}
+	}
+	return tv
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"text/tabwriter"
+
+	"go.uber.org/thriftrw/bench"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// defaultBenchThreshold is the percentage by which benchmarks may be slower
+// than their baseline if --threshold was not given.
+const defaultBenchThreshold = 10
+
+type benchOptions struct {
+	Baseline  string  `long:"baseline" value-name:"FILE" description:"JSON file with results of an earlier run. Benchmarks that are slower than the baseline by more than the threshold or allocate more often are reported as regressions."`
+	Save      string  `long:"save" value-name:"FILE" description:"Write the results as JSON to FILE for use as a baseline."`
+	Threshold float64 `long:"threshold" value-name:"PERCENT" description:"Percentage by which a benchmark may be slower than its baseline. Defaults to 10."`
+	Input     string  `long:"input" value-name:"FILE" description:"Read the output of 'go test -bench' from FILE instead of running the benchmarks. Use - for stdin."`
+}
+
+// runBench runs the benchmarks of the Go packages in args, or ./... if no
+// packages were given, and compares them against a baseline.
+func runBench(args []string, stdin io.Reader, out io.Writer) error {
+	var opts benchOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw bench"
+	parser.Usage = "[OPTIONS] [PACKAGE...]"
+
+	pkgs, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if opts.Input != "" && len(pkgs) > 0 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	threshold := opts.Threshold
+	if threshold == 0 {
+		threshold = defaultBenchThreshold
+	}
+	if threshold < 0 {
+		return fmt.Errorf("invalid threshold %v: must be positive", threshold)
+	}
+
+	var baseline []bench.Result
+	if opts.Baseline != "" {
+		b, err := ioutil.ReadFile(opts.Baseline)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, &baseline); err != nil {
+			return fmt.Errorf("could not parse baseline %q: %v", opts.Baseline, err)
+		}
+	}
+
+	var output []byte
+	switch opts.Input {
+	case "":
+		output, err = runGoBenchmarks(pkgs)
+	case "-":
+		output, err = ioutil.ReadAll(stdin)
+	default:
+		output, err = ioutil.ReadFile(opts.Input)
+	}
+	if err != nil {
+		return err
+	}
+
+	results, err := bench.Parse(bytes.NewReader(output))
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return errors.New("no benchmark results found")
+	}
+
+	if opts.Save != "" {
+		b, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(opts.Save, append(b, '\n'), 0644); err != nil {
+			return err
+		}
+	}
+
+	if opts.Baseline == "" {
+		return writeBenchResults(out, results)
+	}
+
+	comparisons := bench.Compare(baseline, results, threshold)
+	if err := writeBenchComparisons(out, comparisons); err != nil {
+		return err
+	}
+
+	var regressions int
+	for _, c := range comparisons {
+		if c.Regressed {
+			regressions++
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("found %d benchmark regression(s)", regressions)
+	}
+	return nil
+}
+
+// runGoBenchmarks runs the benchmarks of the given packages with "go test"
+// and returns its output.
+func runGoBenchmarks(pkgs []string) ([]byte, error) {
+	if len(pkgs) == 0 {
+		pkgs = []string{"./..."}
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command("go", append([]string{"test", "-run", "^$", "-bench", ".", "-benchmem"}, pkgs...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("could not run benchmarks: %v\n%s", err, stdout.Bytes())
+	}
+	return stdout.Bytes(), nil
+}
+
+func writeBenchResults(out io.Writer, results []bench.Result) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "benchmark\tns/op\tB/op\tallocs/op")
+	for _, r := range results {
+		fmt.Fprintf(w, "%v\t%.1f\t%d\t%d\n", benchName(r), r.NsPerOp, r.BytesPerOp, r.AllocsPerOp)
+	}
+	return w.Flush()
+}
+
+func writeBenchComparisons(out io.Writer, comparisons []bench.Comparison) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "benchmark\told ns/op\tnew ns/op\tdelta\told allocs/op\tnew allocs/op\t")
+	for _, c := range comparisons {
+		var mark string
+		if c.Regressed {
+			mark = "REGRESSED"
+		}
+		fmt.Fprintf(w, "%v\t%.1f\t%.1f\t%+.1f%%\t%d\t%d\t%v\n",
+			benchName(c.Current), c.Baseline.NsPerOp, c.Current.NsPerOp, c.Delta(),
+			c.Baseline.AllocsPerOp, c.Current.AllocsPerOp, mark)
+	}
+	return w.Flush()
+}
+
+// benchName is the name of the benchmark qualified by the base name of its
+// package.
+func benchName(r bench.Result) string {
+	if r.Package == "" {
+		return r.Name
+	}
+	return path.Base(r.Package) + "." + r.Name
+}
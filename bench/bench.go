@@ -0,0 +1,184 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Result is the result of a single benchmark.
+type Result struct {
+	// Import path of the package which holds the benchmark, if known.
+	Package string `json:"package,omitempty"`
+
+	// Name of the benchmark without the GOMAXPROCS suffix. For example,
+	// "BenchmarkFoo_ToWire".
+	Name string `json:"name"`
+
+	NsPerOp     float64 `json:"nsPerOp"`
+	BytesPerOp  int64   `json:"bytesPerOp"`
+	AllocsPerOp int64   `json:"allocsPerOp"`
+}
+
+// key identifies the benchmark across runs.
+func (r Result) key() string {
+	if r.Package == "" {
+		return r.Name
+	}
+	return r.Package + "." + r.Name
+}
+
+// Parse parses the output of "go test -bench". Lines other than benchmark
+// results are ignored.
+//
+// If a benchmark was run multiple times (with -count), the fastest run is
+// kept. Results are sorted by package and name.
+func Parse(r io.Reader) ([]Result, error) {
+	var (
+		pkg     string
+		results []Result
+		index   = make(map[string]int)
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "pkg: ") {
+			pkg = strings.TrimSpace(strings.TrimPrefix(line, "pkg: "))
+			continue
+		}
+
+		result, ok, err := parseLine(line)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		result.Package = pkg
+
+		if i, ok := index[result.key()]; ok {
+			if result.NsPerOp < results[i].NsPerOp {
+				results[i] = result
+			}
+			continue
+		}
+		index[result.key()] = len(results)
+		results = append(results, result)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].key() < results[j].key()
+	})
+	return results, nil
+}
+
+// parseLine parses a line in the form,
+//
+//   BenchmarkFoo-8   1000   1234 ns/op   56 B/op   7 allocs/op
+//
+// Returns false if the line is not a benchmark result.
+func parseLine(line string) (r Result, ok bool, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return r, false, nil
+	}
+
+	// Lines such as "BenchmarkFoo --- FAIL" are not results.
+	if _, err := strconv.Atoi(fields[1]); err != nil {
+		return r, false, nil
+	}
+
+	r.Name = fields[0]
+	if i := strings.LastIndexByte(r.Name, '-'); i > 0 {
+		if _, err := strconv.Atoi(r.Name[i+1:]); err == nil {
+			r.Name = r.Name[:i]
+		}
+	}
+
+	var hasNsPerOp bool
+	for i := 2; i+1 < len(fields); i += 2 {
+		value, unit := fields[i], fields[i+1]
+		switch unit {
+		case "ns/op":
+			r.NsPerOp, err = strconv.ParseFloat(value, 64)
+			hasNsPerOp = true
+		case "B/op":
+			r.BytesPerOp, err = strconv.ParseInt(value, 10, 64)
+		case "allocs/op":
+			r.AllocsPerOp, err = strconv.ParseInt(value, 10, 64)
+		}
+		if err != nil {
+			return r, false, fmt.Errorf("invalid %v in %q: %v", unit, line, err)
+		}
+	}
+	return r, hasNsPerOp, nil
+}
+
+// Comparison is the result of a benchmark compared against its baseline.
+type Comparison struct {
+	Baseline, Current Result
+
+	// Regressed is true if the benchmark became slower by more than the
+	// threshold or allocates more often than the baseline.
+	Regressed bool
+}
+
+// Delta is the change in ns/op relative to the baseline as a percentage.
+func (c Comparison) Delta() float64 {
+	if c.Baseline.NsPerOp == 0 {
+		return 0
+	}
+	return (c.Current.NsPerOp - c.Baseline.NsPerOp) / c.Baseline.NsPerOp * 100
+}
+
+// Compare compares the current results against the baseline. Benchmarks
+// which are missing from either set of results are not compared.
+//
+// threshold is the percentage by which a benchmark may be slower than its
+// baseline before it is considered to have regressed.
+func Compare(baseline, current []Result, threshold float64) []Comparison {
+	base := make(map[string]Result, len(baseline))
+	for _, r := range baseline {
+		base[r.key()] = r
+	}
+
+	var comparisons []Comparison
+	for _, r := range current {
+		b, ok := base[r.key()]
+		if !ok {
+			continue
+		}
+
+		c := Comparison{Baseline: b, Current: r}
+		c.Regressed = c.Delta() > threshold || r.AllocsPerOp > b.AllocsPerOp
+		comparisons = append(comparisons, c)
+	}
+	return comparisons
+}
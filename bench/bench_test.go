@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	output := `goos: linux
+goarch: amd64
+pkg: example.com/foo
+BenchmarkFoo_ToWire-8     	 1000000	      1042 ns/op	     312 B/op	       2 allocs/op
+BenchmarkFoo_ToWire-8     	 1000000	       998.5 ns/op	     312 B/op	       2 allocs/op
+BenchmarkFoo_FromWire-8   	  500000	      2210 ns/op
+BenchmarkFoo_Decode-8     	--- FAIL: BenchmarkFoo_Decode-8
+PASS
+ok  	example.com/foo	3.012s
+pkg: example.com/bar
+BenchmarkBar-v2-4         	  100	      15 ns/op	       0 B/op	       0 allocs/op
+`
+
+	results, err := Parse(strings.NewReader(output))
+	require.NoError(t, err)
+	assert.Equal(t, []Result{
+		{Package: "example.com/bar", Name: "BenchmarkBar-v2", NsPerOp: 15},
+		{Package: "example.com/foo", Name: "BenchmarkFoo_FromWire", NsPerOp: 2210},
+		{Package: "example.com/foo", Name: "BenchmarkFoo_ToWire", NsPerOp: 998.5, BytesPerOp: 312, AllocsPerOp: 2},
+	}, results)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("BenchmarkFoo-8 100 1.5 ns/op 2.5 allocs/op\n"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid allocs/op")
+}
+
+func TestCompare(t *testing.T) {
+	baseline := []Result{
+		{Name: "BenchmarkSame", NsPerOp: 100, AllocsPerOp: 2},
+		{Name: "BenchmarkSlower", NsPerOp: 100, AllocsPerOp: 2},
+		{Name: "BenchmarkSlightlySlower", NsPerOp: 100, AllocsPerOp: 2},
+		{Name: "BenchmarkMoreAllocs", NsPerOp: 100, AllocsPerOp: 2},
+		{Name: "BenchmarkRemoved", NsPerOp: 100},
+	}
+	current := []Result{
+		{Name: "BenchmarkSame", NsPerOp: 100, AllocsPerOp: 2},
+		{Name: "BenchmarkSlower", NsPerOp: 150, AllocsPerOp: 2},
+		{Name: "BenchmarkSlightlySlower", NsPerOp: 105, AllocsPerOp: 2},
+		{Name: "BenchmarkMoreAllocs", NsPerOp: 90, AllocsPerOp: 3},
+		{Name: "BenchmarkAdded", NsPerOp: 100},
+	}
+
+	regressed := make(map[string]bool)
+	deltas := make(map[string]float64)
+	for _, c := range Compare(baseline, current, 10) {
+		regressed[c.Current.Name] = c.Regressed
+		deltas[c.Current.Name] = c.Delta()
+	}
+
+	assert.Equal(t, map[string]bool{
+		"BenchmarkSame":           false,
+		"BenchmarkSlower":         true,
+		"BenchmarkSlightlySlower": false,
+		"BenchmarkMoreAllocs":     true,
+	}, regressed)
+	assert.Equal(t, map[string]float64{
+		"BenchmarkSame":           0,
+		"BenchmarkSlower":         50,
+		"BenchmarkSlightlySlower": 5,
+		"BenchmarkMoreAllocs":     -10,
+	}, deltas)
+}
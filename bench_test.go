@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/bench"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBench(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-bench")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	const output = `pkg: example.com/foo
+BenchmarkFoo_ToWire-8   	 1000000	      1000 ns/op	     312 B/op	       2 allocs/op
+BenchmarkFoo_FromWire-8 	  500000	      2000 ns/op	     400 B/op	       5 allocs/op
+`
+
+	baselineFile := filepath.Join(dir, "baseline.json")
+	var stdout bytes.Buffer
+	require.NoError(t, runBench([]string{"--input", "-", "--save", baselineFile}, strings.NewReader(output), &stdout))
+	assert.Contains(t, stdout.String(), "foo.BenchmarkFoo_ToWire")
+
+	b, err := ioutil.ReadFile(baselineFile)
+	require.NoError(t, err)
+	var baseline []bench.Result
+	require.NoError(t, json.Unmarshal(b, &baseline))
+	assert.Equal(t, []bench.Result{
+		{Package: "example.com/foo", Name: "BenchmarkFoo_FromWire", NsPerOp: 2000, BytesPerOp: 400, AllocsPerOp: 5},
+		{Package: "example.com/foo", Name: "BenchmarkFoo_ToWire", NsPerOp: 1000, BytesPerOp: 312, AllocsPerOp: 2},
+	}, baseline)
+
+	tests := []struct {
+		desc      string
+		args      []string
+		input     string
+		wantOut   []string
+		wantError string
+	}{
+		{
+			desc:    "no regressions",
+			args:    []string{"--baseline", baselineFile},
+			input:   strings.Replace(output, "2000 ns/op", "2100 ns/op", 1),
+			wantOut: []string{"foo.BenchmarkFoo_FromWire", "+5.0%"},
+		},
+		{
+			desc:      "slower",
+			args:      []string{"--baseline", baselineFile},
+			input:     strings.Replace(output, "1000 ns/op", "1200 ns/op", 1),
+			wantOut:   []string{"+20.0%", "REGRESSED"},
+			wantError: "found 1 benchmark regression(s)",
+		},
+		{
+			desc:    "slower within threshold",
+			args:    []string{"--baseline", baselineFile, "--threshold", "25"},
+			input:   strings.Replace(output, "1000 ns/op", "1200 ns/op", 1),
+			wantOut: []string{"+20.0%"},
+		},
+		{
+			desc:      "more allocations",
+			args:      []string{"--baseline", baselineFile},
+			input:     strings.Replace(output, "5 allocs/op", "6 allocs/op", 1),
+			wantError: "found 1 benchmark regression(s)",
+		},
+		{
+			desc:      "no results",
+			input:     "PASS\n",
+			wantError: "no benchmark results found",
+		},
+		{
+			desc:      "input and packages",
+			args:      []string{"./..."},
+			wantError: "Usage:",
+		},
+		{
+			desc:      "negative threshold",
+			args:      []string{"--threshold=-5"},
+			input:     output,
+			wantError: "invalid threshold -5: must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var stdout bytes.Buffer
+			args := append([]string{"--input", "-"}, tt.args...)
+			err := runBench(args, strings.NewReader(tt.input), &stdout)
+			if tt.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, stdout.String(), want)
+			}
+		})
+	}
+}
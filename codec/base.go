@@ -0,0 +1,243 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package codec
+
+import "go.uber.org/thriftrw/wire"
+
+func alwaysSet(interface{}) bool { return true }
+
+// Bool converts bool values.
+var Bool = &Codec{
+	Type:  wire.TBool,
+	IsSet: alwaysSet,
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueBool(*p.(*bool)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*bool) = w.GetBool()
+		return nil
+	},
+}
+
+// BoolPtr converts optional bool values stored as *bool.
+var BoolPtr = &Codec{
+	Type: wire.TBool,
+	IsSet: func(p interface{}) bool {
+		return *p.(**bool) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueBool(**p.(**bool)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		x := w.GetBool()
+		*p.(**bool) = &x
+		return nil
+	},
+}
+
+// Int8 converts int8 values.
+var Int8 = &Codec{
+	Type:  wire.TI8,
+	IsSet: alwaysSet,
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI8(*p.(*int8)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*int8) = w.GetI8()
+		return nil
+	},
+}
+
+// Int8Ptr converts optional int8 values stored as *int8.
+var Int8Ptr = &Codec{
+	Type: wire.TI8,
+	IsSet: func(p interface{}) bool {
+		return *p.(**int8) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI8(**p.(**int8)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		x := w.GetI8()
+		*p.(**int8) = &x
+		return nil
+	},
+}
+
+// Int16 converts int16 values.
+var Int16 = &Codec{
+	Type:  wire.TI16,
+	IsSet: alwaysSet,
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI16(*p.(*int16)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*int16) = w.GetI16()
+		return nil
+	},
+}
+
+// Int16Ptr converts optional int16 values stored as *int16.
+var Int16Ptr = &Codec{
+	Type: wire.TI16,
+	IsSet: func(p interface{}) bool {
+		return *p.(**int16) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI16(**p.(**int16)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		x := w.GetI16()
+		*p.(**int16) = &x
+		return nil
+	},
+}
+
+// Int32 converts int32 values.
+var Int32 = &Codec{
+	Type:  wire.TI32,
+	IsSet: alwaysSet,
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI32(*p.(*int32)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*int32) = w.GetI32()
+		return nil
+	},
+}
+
+// Int32Ptr converts optional int32 values stored as *int32.
+var Int32Ptr = &Codec{
+	Type: wire.TI32,
+	IsSet: func(p interface{}) bool {
+		return *p.(**int32) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI32(**p.(**int32)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		x := w.GetI32()
+		*p.(**int32) = &x
+		return nil
+	},
+}
+
+// Int64 converts int64 values.
+var Int64 = &Codec{
+	Type:  wire.TI64,
+	IsSet: alwaysSet,
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI64(*p.(*int64)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*int64) = w.GetI64()
+		return nil
+	},
+}
+
+// Int64Ptr converts optional int64 values stored as *int64.
+var Int64Ptr = &Codec{
+	Type: wire.TI64,
+	IsSet: func(p interface{}) bool {
+		return *p.(**int64) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueI64(**p.(**int64)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		x := w.GetI64()
+		*p.(**int64) = &x
+		return nil
+	},
+}
+
+// Double converts float64 values.
+var Double = &Codec{
+	Type:  wire.TDouble,
+	IsSet: alwaysSet,
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueDouble(*p.(*float64)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*float64) = w.GetDouble()
+		return nil
+	},
+}
+
+// DoublePtr converts optional float64 values stored as *float64.
+var DoublePtr = &Codec{
+	Type: wire.TDouble,
+	IsSet: func(p interface{}) bool {
+		return *p.(**float64) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueDouble(**p.(**float64)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		x := w.GetDouble()
+		*p.(**float64) = &x
+		return nil
+	},
+}
+
+// String converts string values.
+var String = &Codec{
+	Type:  wire.TBinary,
+	IsSet: alwaysSet,
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueString(*p.(*string)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*string) = w.GetString()
+		return nil
+	},
+}
+
+// StringPtr converts optional string values stored as *string.
+var StringPtr = &Codec{
+	Type: wire.TBinary,
+	IsSet: func(p interface{}) bool {
+		return *p.(**string) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueString(**p.(**string)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		x := w.GetString()
+		*p.(**string) = &x
+		return nil
+	},
+}
+
+// Binary converts []byte values. Nil slices are not set.
+var Binary = &Codec{
+	Type: wire.TBinary,
+	IsSet: func(p interface{}) bool {
+		return *p.(*[]byte) != nil
+	},
+	ToWire: func(p interface{}) (wire.Value, error) {
+		return wire.NewValueBinary(*p.(*[]byte)), nil
+	},
+	FromWire: func(p interface{}, w wire.Value) error {
+		*p.(*[]byte) = w.GetBinary()
+		return nil
+	},
+}
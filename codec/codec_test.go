@@ -0,0 +1,225 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/wire"
+)
+
+type user struct {
+	Name  string
+	Age   *int32
+	Admin *bool
+	Photo []byte
+}
+
+var userStruct = &Struct{
+	Name: "User",
+	Fields: []Field{
+		{
+			ID:       1,
+			Name:     "Name",
+			Required: true,
+			Codec:    String,
+			Ptr:      func(v interface{}) interface{} { return &v.(*user).Name },
+		},
+		{
+			ID:    2,
+			Name:  "Age",
+			Codec: Int32Ptr,
+			Ptr:   func(v interface{}) interface{} { return &v.(*user).Age },
+		},
+		{
+			ID:    3,
+			Name:  "Admin",
+			Codec: BoolPtr,
+			Ptr:   func(v interface{}) interface{} { return &v.(*user).Admin },
+		},
+		{
+			ID:    4,
+			Name:  "Photo",
+			Codec: Binary,
+			Ptr:   func(v interface{}) interface{} { return &v.(*user).Photo },
+		},
+	},
+}
+
+type contact struct {
+	Email *string
+	Phone *int64
+}
+
+func contactUnion(allowEmpty bool) *Struct {
+	return &Struct{
+		Name:            "Contact",
+		IsUnion:         true,
+		AllowEmptyUnion: allowEmpty,
+		Fields: []Field{
+			{
+				ID:    1,
+				Name:  "Email",
+				Codec: StringPtr,
+				Ptr:   func(v interface{}) interface{} { return &v.(*contact).Email },
+			},
+			{
+				ID:    2,
+				Name:  "Phone",
+				Codec: Int64Ptr,
+				Ptr:   func(v interface{}) interface{} { return &v.(*contact).Phone },
+			},
+		},
+	}
+}
+
+func TestStructRoundTrip(t *testing.T) {
+	age := int32(42)
+	admin := false
+
+	tests := []struct {
+		desc string
+		give user
+		want wire.Value
+	}{
+		{
+			desc: "required only",
+			give: user{Name: "foo"},
+			want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+			}}),
+		},
+		{
+			desc: "all fields",
+			give: user{Name: "foo", Age: &age, Admin: &admin, Photo: []byte("bar")},
+			want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+				{ID: 2, Value: wire.NewValueI32(42)},
+				{ID: 3, Value: wire.NewValueBool(false)},
+				{ID: 4, Value: wire.NewValueBinary([]byte("bar"))},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w, err := userStruct.ToWire(&tt.give)
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(tt.want, w), "%v != %v", tt.want, w)
+
+			var got user
+			require.NoError(t, userStruct.FromWire(&got, w))
+			assert.Equal(t, tt.give, got)
+		})
+	}
+}
+
+func TestStructFromWireSkipsUnknownFields(t *testing.T) {
+	var got user
+	err := userStruct.FromWire(&got, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueString("not an i32")},
+		{ID: 5, Value: wire.NewValueI64(1)},
+	}}))
+	require.NoError(t, err)
+	assert.Equal(t, user{Name: "foo"}, got)
+}
+
+func TestStructRequiredField(t *testing.T) {
+	_, err := (&Struct{
+		Name: "Foo",
+		Fields: []Field{{
+			ID:       1,
+			Name:     "Bar",
+			Required: true,
+			Codec:    Binary,
+			Ptr:      func(v interface{}) interface{} { return v },
+		}},
+	}).ToWire(new([]byte))
+	assert.EqualError(t, err, "field Bar of Foo is required")
+
+	var got user
+	err = userStruct.FromWire(&got, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: wire.NewValueI32(42)},
+	}}))
+	assert.EqualError(t, err, "field Name of User is required")
+}
+
+func TestStructUnion(t *testing.T) {
+	email := "foo@example.com"
+	phone := int64(42)
+
+	tests := []struct {
+		desc       string
+		allowEmpty bool
+		give       contact
+		wantErr    string
+	}{
+		{desc: "one field", give: contact{Email: &email}},
+		{
+			desc:    "empty",
+			wantErr: "Contact should have exactly one field: got 0 fields",
+		},
+		{
+			desc:    "two fields",
+			give:    contact{Email: &email, Phone: &phone},
+			wantErr: "Contact should have exactly one field: got 2 fields",
+		},
+		{desc: "empty allowed", allowEmpty: true},
+		{
+			desc:       "two fields with empty allowed",
+			allowEmpty: true,
+			give:       contact{Email: &email, Phone: &phone},
+			wantErr:    "Contact should have at most one field: got 2 fields",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			s := contactUnion(tt.allowEmpty)
+
+			var fields []wire.Field
+			if tt.give.Email != nil {
+				fields = append(fields, wire.Field{ID: 1, Value: wire.NewValueString(*tt.give.Email)})
+			}
+			if tt.give.Phone != nil {
+				fields = append(fields, wire.Field{ID: 2, Value: wire.NewValueI64(*tt.give.Phone)})
+			}
+			want := wire.NewValueStruct(wire.Struct{Fields: fields})
+
+			w, err := s.ToWire(&tt.give)
+			var got contact
+			fromErr := s.FromWire(&got, want)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.EqualError(t, fromErr, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, fromErr)
+			assert.True(t, wire.ValuesAreEqual(want, w), "%v != %v", want, w)
+			assert.Equal(t, tt.give, got)
+		})
+	}
+}
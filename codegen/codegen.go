@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package codegen runs the code generation pipeline of ThriftRW from Go
+// programs, for build tools and tests which would otherwise run the
+// thriftrw binary.
+//
+// Generate parses and compiles a Thrift file and the files it includes,
+// and returns the generated Go files without writing them to disk.
+//
+//   files, err := codegen.Generate("idl/kv.thrift", &codegen.Options{
+//     Options: gen.Options{PackagePrefix: "example.com/kv/gen"},
+//   })
+//   if err != nil {
+//     return err
+//   }
+//
+//   for path, contents := range files {
+//     // path is relative to the output directory, e.g. "kv/kv.go".
+//   }
+package codegen
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+)
+
+// Options configures Generate.
+type Options struct {
+	// Options for code generation. PackagePrefix is required.
+	//
+	// OutputDir and ThriftRoot may be relative to the current directory.
+	// OutputDir defaults to the current directory, and ThriftRoot to the
+	// deepest common ancestor directory of the Thrift files, as with the
+	// thriftrw binary. Writer is ignored.
+	gen.Options
+
+	// Options for compiling the Thrift files, e.g. compile.IncludeDirs.
+	CompileOptions []compile.Option
+}
+
+// Generate compiles the Thrift file at the given path and generates code
+// for it with the given options. The generated files are returned mapped
+// from their paths relative to the output directory to their contents.
+func Generate(path string, opts *Options) (map[string][]byte, error) {
+	if opts == nil || opts.PackagePrefix == "" {
+		return nil, fmt.Errorf("a PackagePrefix is required to generate code for %q", path)
+	}
+	o := *opts
+
+	module, err := compile.Compile(path, o.CompileOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile %q: %v", path, err)
+	}
+
+	if o.ThriftRoot == "" {
+		o.ThriftRoot, err = FindCommonAncestor(module)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine the Thrift root: %v", err)
+		}
+	} else {
+		o.ThriftRoot, err = filepath.Abs(o.ThriftRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err := VerifyAncestry(module, o.ThriftRoot); err != nil {
+			return nil, err
+		}
+	}
+
+	if o.OutputDir == "" {
+		o.OutputDir = "."
+	}
+	o.OutputDir, err = filepath.Abs(o.OutputDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(fileMap)
+	o.Writer = files
+	if err := gen.Generate(module, &o.Options); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// fileMap is a gen.FileWriter which holds the files in memory.
+type fileMap map[string][]byte
+
+func (m fileMap) WriteFile(path string, contents []byte) error {
+	m[path] = contents
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package codegen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-codegen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	idltest.WriteFiles(t, dir, map[string]string{
+		"idl/kv.thrift": `
+			include "./shared/types.thrift"
+
+			service KeyValue {
+				types.Value get(1: string key)
+			}
+		`,
+		"idl/shared/types.thrift": `struct Value { 1: optional string value }`,
+		"include/common.thrift":   `typedef string UUID`,
+	})
+
+	files, err := Generate(filepath.Join(dir, "idl/kv.thrift"), &Options{
+		Options: gen.Options{
+			OutputDir:     filepath.Join(dir, "out"),
+			PackagePrefix: "example.com/idl",
+		},
+	})
+	require.NoError(t, err)
+
+	var paths []string
+	for path := range files {
+		paths = append(paths, path)
+	}
+	assert.ElementsMatch(t, []string{"kv/kv.go", "shared/types/types.go"}, paths)
+	assert.Contains(t, string(files["kv/kv.go"]), `"example.com/idl/shared/types"`)
+
+	_, err = os.Stat(filepath.Join(dir, "out"))
+	assert.True(t, os.IsNotExist(err), "files must not be written to disk")
+
+	t.Run("compile options", func(t *testing.T) {
+		idltest.WriteFiles(t, dir, map[string]string{
+			"idl/user.thrift": `
+				include "common.thrift"
+				struct User { 1: required common.UUID id }
+			`,
+		})
+
+		files, err := Generate(filepath.Join(dir, "idl/user.thrift"), &Options{
+			Options: gen.Options{
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    dir,
+				NoRecurse:     true,
+			},
+			CompileOptions: []compile.Option{compile.IncludeDirs(filepath.Join(dir, "include"))},
+		})
+		require.NoError(t, err)
+		assert.Contains(t, files, "idl/user/user.go")
+		assert.Len(t, files, 1)
+	})
+}
+
+func TestGenerateErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-codegen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	idltest.WriteFiles(t, dir, map[string]string{
+		"foo.thrift": `struct Foo { 1: optional Bar bar }`,
+		"bar.thrift": `struct Bar {}`,
+	})
+
+	tests := []struct {
+		desc    string
+		path    string
+		opts    *Options
+		wantErr string
+	}{
+		{
+			desc:    "no options",
+			path:    "bar.thrift",
+			wantErr: "a PackagePrefix is required",
+		},
+		{
+			desc:    "compile error",
+			path:    "foo.thrift",
+			opts:    &Options{Options: gen.Options{PackagePrefix: "example.com/idl"}},
+			wantErr: "failed to compile",
+		},
+		{
+			desc: "file outside of ThriftRoot",
+			path: "bar.thrift",
+			opts: &Options{Options: gen.Options{
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    filepath.Join(dir, "sub"),
+			}},
+			wantErr: "is not contained in the",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Generate(filepath.Join(dir, tt.path), tt.opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
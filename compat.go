@@ -0,0 +1,75 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"go.uber.org/thriftrw/compat"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// runCompat compares two versions of a Thrift file given in args and writes
+// the changes found to out. An error is returned if any of them are
+// backwards-incompatible.
+func runCompat(args []string, out io.Writer) error {
+	var opts struct{}
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw compat"
+	parser.Usage = "OLD_FILE NEW_FILE"
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) != 2 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	changes, err := compat.CheckFiles(files[0], files[1])
+	if err != nil {
+		return err
+	}
+
+	var breaking int
+	for _, c := range changes {
+		fmt.Fprintln(out, c)
+		if c.Kind.Breaking() {
+			breaking++
+		}
+	}
+
+	if breaking > 0 {
+		return fmt.Errorf("found %d backwards-incompatible change(s)", breaking)
+	}
+	return nil
+}
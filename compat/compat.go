@@ -0,0 +1,438 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compat
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Kind is the kind of a change. Most kinds of changes are
+// backwards-incompatible; see Breaking.
+type Kind int
+
+// The different kinds of changes.
+const (
+	// RemovedType indicates that a type was removed or replaced with a
+	// different kind of type.
+	RemovedType Kind = iota + 1
+
+	// RemovedField indicates that a field of a struct, union, or exception,
+	// or an argument or exception of a function was removed.
+	RemovedField
+
+	// ChangedFieldType indicates that the type of a field was changed.
+	ChangedFieldType
+
+	// RenumberedField indicates that the ID of a field was changed.
+	RenumberedField
+
+	// RemovedEnumItem indicates that an enum item was removed or its value
+	// was changed.
+	RemovedEnumItem
+
+	// RemovedService indicates that a service was removed.
+	RemovedService
+
+	// ChangedFunction indicates that a function was removed from a service
+	// or its signature was changed.
+	ChangedFunction
+
+	// ReusedFieldID indicates that the ID of a field is now used by a
+	// different field: one with a different type, or one which had a
+	// different ID before.
+	ReusedFieldID
+
+	// RequiredField indicates that an optional field was made required, or
+	// that a required field was added.
+	RequiredField
+
+	// RenamedField indicates that a field was renamed without changing its
+	// ID or type. This doesn't change the wire representation, so it's
+	// reported for information only.
+	RenamedField
+)
+
+// Breaking reports whether changes of this kind are backwards-incompatible.
+func (k Kind) Breaking() bool {
+	return k != RenamedField
+}
+
+func (k Kind) String() string {
+	switch k {
+	case RemovedType:
+		return "removed-type"
+	case RemovedField:
+		return "removed-field"
+	case ChangedFieldType:
+		return "changed-field-type"
+	case RenumberedField:
+		return "renumbered-field"
+	case RemovedEnumItem:
+		return "removed-enum-item"
+	case RemovedService:
+		return "removed-service"
+	case ChangedFunction:
+		return "changed-function"
+	case ReusedFieldID:
+		return "reused-field-id"
+	case RequiredField:
+		return "required-field"
+	case RenamedField:
+		return "renamed-field"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Change is a change between two versions of a Thrift file. Changes are
+// backwards-incompatible if their Kind is Breaking.
+type Change struct {
+	Kind    Kind
+	Message string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%v (%v)", c.Message, c.Kind)
+}
+
+// CheckFiles compiles the Thrift files at the two paths and reports the
+// changes made to go from the first to the second.
+// The options are passed to the compiler for both files.
+func CheckFiles(from, to string, opts ...compile.Option) ([]Change, error) {
+	fromModule, err := compile.Compile(from, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	toModule, err := compile.Compile(to, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Check(fromModule, toModule), nil
+}
+
+// Check reports the changes made to go from one version of a module to
+// another which are backwards-incompatible, along with informational
+// changes like renamed fields. Changes are sorted by the name of the type or
+// service they affect.
+//
+// Only the types and services defined in the modules themselves are
+// compared; included modules are compared only as far as they are
+// referenced by those types and services.
+func Check(from, to *compile.Module) []Change {
+	c := checker{from: newNamer(from), to: newNamer(to)}
+
+	for _, name := range sortedTypeNames(from.Types) {
+		c.checkType(from.Types[name], to.Types[name])
+	}
+
+	for _, name := range sortedServiceNames(from.Services) {
+		c.checkService(from.Services[name], to.Services[name])
+	}
+
+	return c.changes
+}
+
+type checker struct {
+	from, to namer
+	changes  []Change
+}
+
+func (c *checker) report(k Kind, format string, args ...interface{}) {
+	c.changes = append(c.changes, Change{Kind: k, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *checker) checkType(from, to compile.TypeSpec) {
+	name := from.ThriftName()
+	if to == nil {
+		c.report(RemovedType, "type %q was removed", name)
+		return
+	}
+
+	switch from := from.(type) {
+	case *compile.StructSpec:
+		to, ok := to.(*compile.StructSpec)
+		kind := structureKind(from.Type)
+		if !ok || from.Type != to.Type {
+			c.report(RemovedType, "%v %q was replaced with a different kind of type", kind, name)
+			return
+		}
+		c.checkFields(fmt.Sprintf("%v %q", kind, name), from.Fields, to.Fields)
+
+	case *compile.EnumSpec:
+		to, ok := to.(*compile.EnumSpec)
+		if !ok {
+			c.report(RemovedType, "enum %q was replaced with a different kind of type", name)
+			return
+		}
+		c.checkEnum(from, to)
+
+	case *compile.TypedefSpec:
+		if !c.compatible(from, to) {
+			c.report(RemovedType, "typedef %q changed from %v to %v", name, c.from.typeName(from), c.to.typeName(to))
+		}
+	}
+}
+
+// checkFields compares two versions of a group of fields. Fields are matched
+// by ID because that's how they're identified on the wire.
+func (c *checker) checkFields(owner string, from, to compile.FieldGroup) {
+	fromIDs := fieldsByID(from)
+	toIDs := fieldsByID(to)
+
+	for _, f := range from {
+		t, ok := toIDs[f.ID]
+		if !ok {
+			if t, err := to.FindByName(f.Name); err == nil {
+				c.report(RenumberedField, "field %q of %v changed ID from %d to %d", f.Name, owner, f.ID, t.ID)
+			} else {
+				c.report(RemovedField, "field %q of %v was removed", f.Name, owner)
+			}
+			continue
+		}
+
+		fromType, toType := c.from.typeName(f.Type), c.to.typeName(t.Type)
+		compatible := c.compatible(f.Type, t.Type)
+		if f.Name != t.Name {
+			// A field that had a different ID before was moved into the
+			// place of this one.
+			_, err := from.FindByName(t.Name)
+			if !compatible || err == nil {
+				c.report(ReusedFieldID, "ID %d of %v changed from field %q (%v) to field %q (%v)",
+					f.ID, owner, f.Name, fromType, t.Name, toType)
+				continue
+			}
+			c.report(RenamedField, "field %q of %v was renamed to %q", f.Name, owner, t.Name)
+		} else if !compatible {
+			c.report(ChangedFieldType, "field %q of %v changed type from %v to %v", f.Name, owner, fromType, toType)
+		}
+
+		if !f.Required && t.Required {
+			c.report(RequiredField, "field %q of %v changed from optional to required", t.Name, owner)
+		}
+	}
+
+	for _, t := range to {
+		if _, ok := fromIDs[t.ID]; ok || !t.Required {
+			continue
+		}
+		if _, err := from.FindByName(t.Name); err == nil {
+			// Renumbered fields were reported above.
+			continue
+		}
+		c.report(RequiredField, "required field %q was added to %v", t.Name, owner)
+	}
+}
+
+func (c *checker) checkEnum(from, to *compile.EnumSpec) {
+	for _, item := range from.Items {
+		t, ok := to.LookupItem(item.Name)
+		if !ok {
+			c.report(RemovedEnumItem, "item %q of enum %q was removed", item.Name, from.Name)
+			continue
+		}
+
+		if item.Value != t.Value {
+			c.report(RemovedEnumItem, "item %q of enum %q changed value from %d to %d",
+				item.Name, from.Name, item.Value, t.Value)
+		}
+	}
+}
+
+func (c *checker) checkService(from, to *compile.ServiceSpec) {
+	if to == nil {
+		c.report(RemovedService, "service %q was removed", from.Name)
+		return
+	}
+
+	for _, name := range sortedFunctionNames(from.Functions) {
+		c.checkFunction(from.Name, from.Functions[name], to.Functions[name])
+	}
+}
+
+func (c *checker) checkFunction(service string, from, to *compile.FunctionSpec) {
+	owner := fmt.Sprintf("function %q of service %q", from.Name, service)
+	if to == nil {
+		c.report(ChangedFunction, "%v was removed", owner)
+		return
+	}
+
+	if from.OneWay != to.OneWay {
+		if from.OneWay {
+			c.report(ChangedFunction, "%v is no longer oneway", owner)
+		} else {
+			c.report(ChangedFunction, "%v is now oneway", owner)
+		}
+		return
+	}
+
+	if from.Streaming != to.Streaming {
+		if from.Streaming {
+			c.report(ChangedFunction, "%v no longer returns a stream", owner)
+		} else {
+			c.report(ChangedFunction, "%v now returns a stream", owner)
+		}
+		return
+	}
+
+	c.checkFields(owner, compile.FieldGroup(from.ArgsSpec), compile.FieldGroup(to.ArgsSpec))
+
+	if from.ResultSpec == nil || to.ResultSpec == nil {
+		return
+	}
+
+	if !c.compatibleReturnTypes(from.ResultSpec, to.ResultSpec) {
+		c.report(ChangedFunction, "%v changed return type from %v to %v",
+			owner, c.from.returnTypeName(from.ResultSpec), c.to.returnTypeName(to.ResultSpec))
+	}
+
+	c.checkFields("exceptions of "+owner, from.ResultSpec.Exceptions, to.ResultSpec.Exceptions)
+}
+
+// compatible reports whether a value of the type from can be read as the
+// type to. Types are compatible if they have the same name, or if an i32
+// was changed to an enum since enums are encoded as i32s on the wire.
+func (c *checker) compatible(from, to compile.TypeSpec) bool {
+	from, to = compile.RootTypeSpec(from), compile.RootTypeSpec(to)
+	switch f := from.(type) {
+	case *compile.MapSpec:
+		t, ok := to.(*compile.MapSpec)
+		return ok && c.compatible(f.KeySpec, t.KeySpec) && c.compatible(f.ValueSpec, t.ValueSpec)
+	case *compile.ListSpec:
+		t, ok := to.(*compile.ListSpec)
+		return ok && c.compatible(f.ValueSpec, t.ValueSpec)
+	case *compile.SetSpec:
+		t, ok := to.(*compile.SetSpec)
+		return ok && c.compatible(f.ValueSpec, t.ValueSpec)
+	case *compile.I32Spec:
+		if _, ok := to.(*compile.EnumSpec); ok {
+			return true
+		}
+	}
+	return c.from.typeName(from) == c.to.typeName(to)
+}
+
+func (c *checker) compatibleReturnTypes(from, to *compile.ResultSpec) bool {
+	if from.ReturnType == nil || to.ReturnType == nil {
+		return from.ReturnType == to.ReturnType
+	}
+	return c.compatible(from.ReturnType, to.ReturnType)
+}
+
+// namer names types relative to a module. Types defined in other files are
+// qualified with the name under which they're included.
+type namer struct {
+	root     string
+	includes map[string]string // ThriftPath -> include name
+}
+
+func newNamer(m *compile.Module) namer {
+	includes := make(map[string]string, len(m.Includes))
+	for name, inc := range m.Includes {
+		includes[inc.Module.ThriftPath] = name
+	}
+	return namer{root: m.ThriftPath, includes: includes}
+}
+
+// typeName returns a name for the given type which identifies it on the
+// wire. Typedefs are resolved to their targets.
+func (n namer) typeName(t compile.TypeSpec) string {
+	switch t := compile.RootTypeSpec(t).(type) {
+	case *compile.MapSpec:
+		return fmt.Sprintf("map<%v, %v>", n.typeName(t.KeySpec), n.typeName(t.ValueSpec))
+	case *compile.ListSpec:
+		return fmt.Sprintf("list<%v>", n.typeName(t.ValueSpec))
+	case *compile.SetSpec:
+		return fmt.Sprintf("set<%v>", n.typeName(t.ValueSpec))
+	default:
+		file := t.ThriftFile()
+		if file == "" || file == n.root {
+			return t.ThriftName()
+		}
+
+		include, ok := n.includes[file]
+		if !ok {
+			// Not included directly by the module.
+			include = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		}
+		return include + "." + t.ThriftName()
+	}
+}
+
+func (n namer) returnTypeName(rs *compile.ResultSpec) string {
+	if rs.ReturnType == nil {
+		return "void"
+	}
+	return n.typeName(rs.ReturnType)
+}
+
+func structureKind(t ast.StructureType) string {
+	switch t {
+	case ast.UnionType:
+		return "union"
+	case ast.ExceptionType:
+		return "exception"
+	default:
+		return "struct"
+	}
+}
+
+func fieldsByID(fields compile.FieldGroup) map[int16]*compile.FieldSpec {
+	m := make(map[int16]*compile.FieldSpec, len(fields))
+	for _, f := range fields {
+		m[f.ID] = f
+	}
+	return m
+}
+
+func sortedTypeNames(m map[string]compile.TypeSpec) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedServiceNames(m map[string]*compile.ServiceSpec) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedFunctionNames(m map[string]*compile.FunctionSpec) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
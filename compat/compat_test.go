@@ -0,0 +1,304 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compat
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFiles(t *testing.T) {
+	tests := []struct {
+		desc string
+		from string
+		to   string
+		want []Change
+
+		// Other files which may be included by from and to.
+		files map[string]string
+	}{
+		{
+			desc: "compatible changes",
+			from: `
+				typedef i64 Timestamp
+				enum Status { ON, OFF }
+				struct Foo {
+					1: required string a
+					2: optional Timestamp b
+				}
+				service S {
+					void f(1: string x)
+				}
+			`,
+			to: `
+				typedef i64 Time
+				typedef Time Timestamp
+				enum Status { ON, OFF, UNKNOWN }
+				struct Foo {
+					1: required string a
+					2: optional i64 b
+					3: optional string c
+				}
+				struct Bar {}
+				service S {
+					void f(1: string x, 2: string y)
+					void g()
+				}
+				service T {}
+			`,
+		},
+		{
+			desc: "removed and renumbered fields",
+			from: `
+				struct Foo {
+					1: optional string a
+					2: optional string b
+					3: optional list<string> c
+				}
+			`,
+			to: `
+				struct Foo {
+					2: optional string a
+					3: optional list<binary> c
+				}
+			`,
+			want: []Change{
+				{Kind: RenumberedField, Message: `field "a" of struct "Foo" changed ID from 1 to 2`},
+				{Kind: ReusedFieldID, Message: `ID 2 of struct "Foo" changed from field "b" (string) to field "a" (string)`},
+				{Kind: ChangedFieldType, Message: `field "c" of struct "Foo" changed type from list<string> to list<binary>`},
+			},
+		},
+		{
+			desc: "renamed fields",
+			from: `
+				struct Foo {
+					1: optional string a
+					2: optional i32 b
+				}
+				service S {
+					void f(1: string x)
+				}
+			`,
+			to: `
+				struct Foo {
+					1: optional string c
+					2: optional i64 d
+				}
+				service S {
+					void f(1: string y)
+				}
+			`,
+			want: []Change{
+				{Kind: RenamedField, Message: `field "a" of struct "Foo" was renamed to "c"`},
+				{Kind: ReusedFieldID, Message: `ID 2 of struct "Foo" changed from field "b" (i32) to field "d" (i64)`},
+				{Kind: RenamedField, Message: `field "x" of function "f" of service "S" was renamed to "y"`},
+			},
+		},
+		{
+			desc: "required fields",
+			from: `
+				struct Foo {
+					1: optional string a
+					2: optional string b
+					3: required string c
+					4: optional string d
+				}
+				service S {
+					void f(1: string x)
+				}
+			`,
+			to: `
+				struct Foo {
+					1: required string a
+					2: required string renamed
+					3: required string c
+					5: required string d
+					6: required string e
+					7: optional string f
+				}
+				service S {
+					void f(1: string x, 2: required string y)
+				}
+			`,
+			want: []Change{
+				{Kind: RequiredField, Message: `field "a" of struct "Foo" changed from optional to required`},
+				{Kind: RenamedField, Message: `field "b" of struct "Foo" was renamed to "renamed"`},
+				{Kind: RequiredField, Message: `field "renamed" of struct "Foo" changed from optional to required`},
+				{Kind: RenumberedField, Message: `field "d" of struct "Foo" changed ID from 4 to 5`},
+				{Kind: RequiredField, Message: `required field "e" was added to struct "Foo"`},
+				{Kind: RequiredField, Message: `required field "y" was added to function "f" of service "S"`},
+			},
+		},
+		{
+			desc: "changed types",
+			from: `
+				typedef string UUID
+				struct Foo {}
+				exception Bar {}
+				union Baz {}
+				union Qux {}
+			`,
+			to: `
+				typedef binary UUID
+				union Foo {}
+				exception Bar {}
+				enum Baz {}
+			`,
+			want: []Change{
+				{Kind: RemovedType, Message: `union "Baz" was replaced with a different kind of type`},
+				{Kind: RemovedType, Message: `struct "Foo" was replaced with a different kind of type`},
+				{Kind: RemovedType, Message: `type "Qux" was removed`},
+				{Kind: RemovedType, Message: `typedef "UUID" changed from string to binary`},
+			},
+		},
+		{
+			desc: "included types and enums",
+			files: map[string]string{
+				"a.thrift": "struct Foo {}",
+				"b.thrift": "struct Foo {}",
+			},
+			from: `
+				include "./a.thrift"
+				enum Status { ON, OFF }
+				struct S {
+					1: optional a.Foo foo
+					2: optional i32 status
+					3: optional list<i32> statuses
+					4: optional Status legacy
+				}
+				service X {
+					i32 get()
+				}
+			`,
+			to: `
+				include "./b.thrift"
+				enum Status { ON, OFF }
+				typedef Status State
+				struct S {
+					1: optional b.Foo foo
+					2: optional Status status
+					3: optional list<State> statuses
+					4: optional i32 legacy
+				}
+				service X {
+					Status get()
+				}
+			`,
+			want: []Change{
+				{Kind: ChangedFieldType, Message: `field "foo" of struct "S" changed type from a.Foo to b.Foo`},
+				{Kind: ChangedFieldType, Message: `field "legacy" of struct "S" changed type from Status to i32`},
+			},
+		},
+		{
+			desc: "removed enum items",
+			from: `enum Status { ON, OFF = 3, UNKNOWN }`,
+			to:   `enum Status { ON, OFF = 4 }`,
+			want: []Change{
+				{Kind: RemovedEnumItem, Message: `item "OFF" of enum "Status" changed value from 3 to 4`},
+				{Kind: RemovedEnumItem, Message: `item "UNKNOWN" of enum "Status" was removed`},
+			},
+		},
+		{
+			desc: "changed functions",
+			from: `
+				exception Err {}
+				service S {
+					void a()
+					oneway void b()
+					i32 c(1: string x, 2: string y) throws (1: Err err)
+					void d()
+					stream<i32> e()
+					i32 f()
+				}
+				service T {}
+			`,
+			to: `
+				exception Err {}
+				service S {
+					oneway void a()
+					void b()
+					i64 c(1: binary x) throws (2: Err err)
+					i32 e()
+					stream<i32> f()
+				}
+			`,
+			want: []Change{
+				{Kind: ChangedFunction, Message: `function "a" of service "S" is now oneway`},
+				{Kind: ChangedFunction, Message: `function "b" of service "S" is no longer oneway`},
+				{Kind: ChangedFieldType, Message: `field "x" of function "c" of service "S" changed type from string to binary`},
+				{Kind: RemovedField, Message: `field "y" of function "c" of service "S" was removed`},
+				{Kind: ChangedFunction, Message: `function "c" of service "S" changed return type from i32 to i64`},
+				{Kind: RenumberedField, Message: `field "err" of exceptions of function "c" of service "S" changed ID from 1 to 2`},
+				{Kind: ChangedFunction, Message: `function "d" of service "S" was removed`},
+				{Kind: ChangedFunction, Message: `function "e" of service "S" no longer returns a stream`},
+				{Kind: ChangedFunction, Message: `function "f" of service "S" now returns a stream`},
+				{Kind: RemovedService, Message: `service "T" was removed`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "thriftrw-compat")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			idltest.WriteFiles(t, dir, tt.files)
+
+			from := filepath.Join(dir, "from.thrift")
+			require.NoError(t, ioutil.WriteFile(from, []byte(tt.from), 0644))
+
+			to := filepath.Join(dir, "to.thrift")
+			require.NoError(t, ioutil.WriteFile(to, []byte(tt.to), 0644))
+
+			changes, err := CheckFiles(from, to)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, changes)
+		})
+	}
+}
+
+func TestCheckFilesCompileError(t *testing.T) {
+	_, err := CheckFiles("does_not_exist.thrift", "does_not_exist.thrift")
+	assert.Error(t, err)
+}
+
+func TestChangeString(t *testing.T) {
+	c := Change{Kind: RemovedField, Message: `field "a" of struct "Foo" was removed`}
+	assert.Equal(t, `field "a" of struct "Foo" was removed (removed-field)`, c.String())
+	assert.Equal(t, "Kind(42)", Kind(42).String())
+}
+
+func TestKindBreaking(t *testing.T) {
+	for _, k := range []Kind{
+		RemovedType, RemovedField, ChangedFieldType, RenumberedField, RemovedEnumItem,
+		RemovedService, ChangedFunction, ReusedFieldID, RequiredField,
+	} {
+		assert.True(t, k.Breaking(), "%v must be breaking", k)
+	}
+	assert.False(t, RenamedField.Breaking(), "renames must be informational")
+}
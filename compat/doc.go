@@ -0,0 +1,40 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package compat finds backwards-incompatible changes between two versions
+// of a Thrift file.
+//
+// Check compares two compiled modules and reports changes that would break
+// existing clients or servers: removed fields, fields whose types or IDs
+// changed, field IDs reused by other fields, fields which became required,
+// removed enum items, and services whose methods were removed or changed
+// signatures. Fields are matched by ID, so renamed fields are reported too,
+// but only for information since renames don't change the wire
+// representation. For the same reason, changing an i32 to an enum is not
+// reported. Types from included files are named with their include prefix.
+//
+//   changes, err := compat.CheckFiles("old/service.thrift", "new/service.thrift")
+//   if err != nil {
+//     return err
+//   }
+//   for _, c := range changes {
+//     fmt.Println(c)
+//   }
+package compat
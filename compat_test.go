@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCompat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-compat")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	v1 := filepath.Join(dir, "v1.thrift")
+	require.NoError(t, ioutil.WriteFile(v1, []byte(`
+		struct Foo {
+			1: optional string a
+		}
+	`), 0644))
+
+	v2 := filepath.Join(dir, "v2.thrift")
+	require.NoError(t, ioutil.WriteFile(v2, []byte(`
+		struct Foo {
+			1: optional string a
+			2: optional string b
+		}
+	`), 0644))
+
+	v3 := filepath.Join(dir, "v3.thrift")
+	require.NoError(t, ioutil.WriteFile(v3, []byte(`
+		struct Foo {
+			1: optional string c
+		}
+	`), 0644))
+
+	tests := []struct {
+		desc      string
+		args      []string
+		wantOut   string
+		wantError string
+	}{
+		{
+			desc: "compatible",
+			args: []string{v1, v2},
+		},
+		{
+			desc:      "incompatible",
+			args:      []string{v2, v1},
+			wantOut:   `field "b" of struct "Foo" was removed (removed-field)` + "\n",
+			wantError: "found 1 backwards-incompatible change(s)",
+		},
+		{
+			desc:    "informational",
+			args:    []string{v1, v3},
+			wantOut: `field "a" of struct "Foo" was renamed to "c" (renamed-field)` + "\n",
+		},
+		{
+			desc:      "missing file",
+			args:      []string{v1, filepath.Join(dir, "v4.thrift")},
+			wantError: "v4.thrift",
+		},
+		{
+			desc:      "wrong number of files",
+			args:      []string{v1},
+			wantError: "thriftrw compat OLD_FILE NEW_FILE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runCompat(tt.args, &out)
+			assert.Equal(t, tt.wantOut, out.String())
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantError)
+			}
+		})
+	}
+}
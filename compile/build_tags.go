@@ -0,0 +1,205 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// buildTagsAnnotation limits the builds in which a type, service, field, enum
+// item, or service function is compiled. Its value is a comma-separated list
+// of build tags which must all be enabled with the BuildTags option. Tags
+// prefixed with "!" must not be enabled.
+//
+// 	struct AuditLog {
+// 		1: required string user
+// 		2: optional string debugInfo (build.tags = "internal,!release")
+// 	} (build.tags = "internal")
+//
+// Definitions which are excluded from a build don't claim their names so
+// alternative definitions of the same type may be provided for different
+// builds.
+const buildTagsAnnotation = "build.tags"
+
+// buildTagsMatch returns the value of the build.tags annotation among the
+// given annotations and whether the entity they belong to is included in
+// this build.
+func (c compiler) buildTagsMatch(annotations []*ast.Annotation) (tags string, included bool, err error) {
+	for _, a := range annotations {
+		if a.Name != buildTagsAnnotation {
+			continue
+		}
+
+		included = true
+		for _, term := range strings.Split(a.Value, ",") {
+			term = strings.TrimSpace(term)
+			tag := strings.TrimPrefix(term, "!")
+			if !isBuildTag(tag) {
+				return "", false, buildTagsError{Tags: a.Value, Term: term}
+			}
+
+			_, enabled := c.buildTags[tag]
+			if negated := tag != term; enabled == negated {
+				included = false
+			}
+		}
+		return a.Value, included, nil
+	}
+	return "", true, nil
+}
+
+// sortedBuildTags returns the given build tags in sorted order, or nil if
+// there are none.
+func sortedBuildTags(tags map[string]struct{}) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	sorted := make([]string, 0, len(tags))
+	for tag := range tags {
+		sorted = append(sorted, tag)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// isBuildTag returns true if the given string may be used as a build tag.
+func isBuildTag(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for _, c := range s {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// definitionAnnotations returns the annotations of the given definition.
+// Constants don't have annotations.
+func definitionAnnotations(d ast.Definition) []*ast.Annotation {
+	switch d := d.(type) {
+	case *ast.Typedef:
+		return d.Annotations
+	case *ast.Enum:
+		return d.Annotations
+	case *ast.Senum:
+		return d.Annotations
+	case *ast.Struct:
+		return d.Annotations
+	case *ast.Service:
+		return d.Annotations
+	}
+	return nil
+}
+
+// excludeMembers returns a copy of the given definition without the fields,
+// enum items, and service functions, parameters, and exceptions which are
+// excluded from this build. The definition is returned as-is if none of
+// them are excluded.
+func (c compiler) excludeMembers(d ast.Definition) (ast.Definition, error) {
+	switch d := d.(type) {
+	case *ast.Struct:
+		fields, err := c.excludeFields(d.Fields)
+		if err != nil || len(fields) == len(d.Fields) {
+			return d, err
+		}
+		s := *d
+		s.Fields = fields
+		return &s, nil
+
+	case *ast.Enum:
+		items := make([]*ast.EnumItem, 0, len(d.Items))
+		for _, item := range d.Items {
+			_, included, err := c.buildTagsMatch(item.Annotations)
+			if err != nil {
+				return nil, compileError{Target: item.Name, Line: item.Line, Column: item.Column, Reason: err}
+			}
+			if included {
+				items = append(items, item)
+			}
+		}
+		if len(items) == len(d.Items) {
+			return d, nil
+		}
+		e := *d
+		e.Items = items
+		return &e, nil
+
+	case *ast.Service:
+		functions := make([]*ast.Function, 0, len(d.Functions))
+		changed := false
+		for _, f := range d.Functions {
+			_, included, err := c.buildTagsMatch(f.Annotations)
+			if err != nil {
+				return nil, compileError{Target: f.Name, Line: f.Line, Column: f.Column, Reason: err}
+			}
+			if !included {
+				changed = true
+				continue
+			}
+
+			params, err := c.excludeFields(f.Parameters)
+			if err != nil {
+				return nil, compileError{Target: f.Name, Line: f.Line, Column: f.Column, Reason: err}
+			}
+			exceptions, err := c.excludeFields(f.Exceptions)
+			if err != nil {
+				return nil, compileError{Target: f.Name, Line: f.Line, Column: f.Column, Reason: err}
+			}
+			if len(params) != len(f.Parameters) || len(exceptions) != len(f.Exceptions) {
+				fc := *f
+				fc.Parameters = params
+				fc.Exceptions = exceptions
+				f = &fc
+				changed = true
+			}
+			functions = append(functions, f)
+		}
+		if !changed {
+			return d, nil
+		}
+		s := *d
+		s.Functions = functions
+		return &s, nil
+	}
+	return d, nil
+}
+
+// excludeFields returns the fields which are included in this build.
+func (c compiler) excludeFields(fields []*ast.Field) ([]*ast.Field, error) {
+	included := make([]*ast.Field, 0, len(fields))
+	for _, f := range fields {
+		_, ok, err := c.buildTagsMatch(f.Annotations)
+		if err != nil {
+			return nil, compileError{Target: f.Name, Line: f.Line, Column: f.Column, Reason: err}
+		}
+		if ok {
+			included = append(included, f)
+		}
+	}
+	return included, nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const buildTagsThrift = `
+	struct Config {
+		1: required string name
+		2: optional string debugInfo (build.tags = "internal")
+		3: optional string publicInfo (build.tags = "!internal")
+	} (build.tags = "!legacy")
+
+	struct Config {
+		1: required string name
+	} (build.tags = "legacy")
+
+	struct AuditLog {
+		1: required string user
+	} (build.tags = "internal")
+
+	enum Level {
+		INFO
+		TRACE (build.tags = "internal,debug")
+	}
+
+	service Admin {
+		Config getConfig()
+		AuditLog getAuditLog() (build.tags = "internal")
+		void reset(1: string name, 2: bool force (build.tags = "internal"))
+	}
+`
+
+func TestCompileBuildTags(t *testing.T) {
+	tests := []struct {
+		desc string
+		tags []string
+
+		wantTypes        []string
+		wantConfigFields []string
+		wantLevelItems   []string
+		wantFunctions    []string
+		wantResetArgs    []string
+	}{
+		{
+			desc:             "no tags",
+			wantTypes:        []string{"Config", "Level"},
+			wantConfigFields: []string{"name", "publicInfo"},
+			wantLevelItems:   []string{"INFO"},
+			wantFunctions:    []string{"getConfig", "reset"},
+			wantResetArgs:    []string{"name"},
+		},
+		{
+			desc:             "internal",
+			tags:             []string{"internal"},
+			wantTypes:        []string{"AuditLog", "Config", "Level"},
+			wantConfigFields: []string{"name", "debugInfo"},
+			wantLevelItems:   []string{"INFO"},
+			wantFunctions:    []string{"getAuditLog", "getConfig", "reset"},
+			wantResetArgs:    []string{"name", "force"},
+		},
+		{
+			desc:             "internal debug",
+			tags:             []string{"internal", "debug"},
+			wantTypes:        []string{"AuditLog", "Config", "Level"},
+			wantConfigFields: []string{"name", "debugInfo"},
+			wantLevelItems:   []string{"INFO", "TRACE"},
+			wantFunctions:    []string{"getAuditLog", "getConfig", "reset"},
+			wantResetArgs:    []string{"name", "force"},
+		},
+		{
+			desc:             "legacy",
+			tags:             []string{"legacy"},
+			wantTypes:        []string{"Config", "Level"},
+			wantConfigFields: []string{"name"},
+			wantLevelItems:   []string{"INFO"},
+			wantFunctions:    []string{"getConfig", "reset"},
+			wantResetArgs:    []string{"name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/some/prefix/", map[string]string{
+				"/some/prefix/main.thrift": buildTagsThrift,
+			}}
+
+			m, err := Compile("main.thrift", Filesystem(fs), BuildTags(tt.tags...))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.tags, m.BuildTags, "build tags")
+
+			var types []string
+			for name := range m.Types {
+				types = append(types, name)
+			}
+			assert.ElementsMatch(t, tt.wantTypes, types, "types")
+
+			var fields []string
+			for _, f := range m.Types["Config"].(*StructSpec).Fields {
+				fields = append(fields, f.Name)
+			}
+			assert.Equal(t, tt.wantConfigFields, fields, "fields of Config")
+
+			var items []string
+			for _, item := range m.Types["Level"].(*EnumSpec).Items {
+				items = append(items, item.Name)
+			}
+			assert.Equal(t, tt.wantLevelItems, items, "items of Level")
+
+			admin := m.Services["Admin"]
+			var functions []string
+			for name := range admin.Functions {
+				functions = append(functions, name)
+			}
+			assert.ElementsMatch(t, tt.wantFunctions, functions, "functions of Admin")
+
+			var args []string
+			for _, arg := range admin.Functions["reset"].ArgsSpec {
+				args = append(args, arg.Name)
+			}
+			assert.Equal(t, tt.wantResetArgs, args, "arguments of reset")
+		})
+	}
+}
+
+func TestCompileBuildTagsErrors(t *testing.T) {
+	tests := []struct {
+		desc     string
+		tags     []string
+		main     string
+		wantErrs []string
+	}{
+		{
+			desc: "reference to excluded type",
+			main: `
+				struct AuditLog {
+					1: required string user
+				} (build.tags = "internal")
+
+				struct Report {
+					1: optional AuditLog log
+				}
+			`,
+			wantErrs: []string{
+				`could not resolve reference "AuditLog"`,
+				`"AuditLog" is excluded from this build by (build.tags = "internal")`,
+			},
+		},
+		{
+			desc: "reference to excluded service",
+			tags: []string{"legacy"},
+			main: `
+				service Base {} (build.tags = "!legacy")
+				service Admin extends Base {}
+			`,
+			wantErrs: []string{`"Base" is excluded from this build by (build.tags = "!legacy")`},
+		},
+		{
+			desc: "conflicting definitions",
+			main: `
+				struct Config {} (build.tags = "!legacy")
+				struct Config {}
+			`,
+			wantErrs: []string{`cannot define "Config"`},
+		},
+		{
+			desc:     "invalid tag",
+			main:     `struct Config {} (build.tags = "internal,,debug")`,
+			wantErrs: []string{`invalid build.tags annotation "internal,,debug": "" is not a valid build tag`},
+		},
+		{
+			desc: "invalid tag on field",
+			main: `
+				struct Config {
+					1: optional string debugInfo (build.tags = "!")
+				}
+			`,
+			wantErrs: []string{
+				`cannot compile "debugInfo" on line 3`,
+				`"!" is not a valid build tag`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/some/prefix/", map[string]string{
+				"/some/prefix/main.thrift": tt.main,
+			}}
+
+			_, err := Compile("main.thrift", Filesystem(fs), BuildTags(tt.tags...))
+			require.Error(t, err)
+			for _, msg := range tt.wantErrs {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
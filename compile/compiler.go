@@ -21,10 +21,12 @@
 package compile
 
 import (
+	"errors"
 	"path/filepath"
 
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/idl"
+	"go.uber.org/thriftrw/internal/goast"
 )
 
 // Compile parses and compiles the Thrift file at the given path and any other
@@ -42,10 +44,10 @@ func Compile(path string, opts ...Option) (*Module, error) {
 
 	err = m.Walk(func(m *Module) error {
 		if err := c.link(m); err != nil {
-			return compileError{
+			return withSource(m.ThriftPath, m.Raw, compileError{
 				Target: m.ThriftPath,
 				Reason: err,
-			}
+			})
 		}
 		return nil
 	})
@@ -58,14 +60,23 @@ type compiler struct {
 	fs FS
 	// nonStrict will compile Thrift files that do not pass strict validation.
 	nonStrict bool
+	// keywordFieldNames allows Thrift keywords to be used as field names.
+	keywordFieldNames bool
+	// includeDirs are searched for included files which are not found
+	// relative to the file including them.
+	includeDirs []string
+	// buildTags are the enabled build tags. Definitions whose build.tags
+	// annotations aren't satisfied by them are excluded.
+	buildTags map[string]struct{}
 	// Map from file path to Module representing that file.
 	Modules map[string]*Module
 }
 
 func newCompiler() compiler {
 	return compiler{
-		fs:      realFS{},
-		Modules: make(map[string]*Module),
+		fs:        realFS{},
+		buildTags: make(map[string]struct{}),
+		Modules:   make(map[string]*Module),
 	}
 }
 
@@ -110,7 +121,8 @@ func (c compiler) link(m *Module) error {
 		}
 	}
 
-	return nil
+	// Find structs which can't be constructed
+	return findUninhabitedStructs(types)
 }
 
 // load populates the compiler with information from the given Thrift file.
@@ -132,7 +144,7 @@ func (c compiler) load(p string) (*Module, error) {
 		return nil, fileReadError{Path: p, Reason: err}
 	}
 
-	prog, err := idl.Parse(s)
+	prog, err := (&idl.Config{KeywordFieldNames: c.keywordFieldNames}).Parse(s)
 	if err != nil {
 		return nil, parseError{Path: p, Reason: err}
 	}
@@ -144,15 +156,18 @@ func (c compiler) load(p string) (*Module, error) {
 		Constants:  make(map[string]*Constant),
 		Types:      make(map[string]TypeSpec),
 		Services:   make(map[string]*ServiceSpec),
+		Namespaces: make(map[string]*Namespace),
+		excluded:   make(map[string]string),
 	}
 
 	m.Raw = s
+	m.BuildTags = sortedBuildTags(c.buildTags)
 	c.Modules[p] = m
 	// the module is added to the map before processing includes to break
 	// cyclic includes.
 
 	if err := c.gather(m, prog); err != nil {
-		return nil, fileCompileError{Path: p, Reason: err}
+		return nil, withSource(p, s, fileCompileError{Path: p, Reason: err})
 	}
 	return m, nil
 }
@@ -171,6 +186,34 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 	// names and possibly allow overriding them with annotations.
 	thriftNS := newNamespace(caseSensitive)
 
+	// Go names of the types defined in the Thrift file.
+	goTypes := newGoNames(goast.GoCase)
+
+	for _, h := range prog.Headers {
+		header, ok := h.(*ast.Namespace)
+		if !ok {
+			continue
+		}
+
+		if _, ok := m.Namespaces[header.Scope]; ok {
+			return namespaceError{
+				Namespace: header,
+				Reason:    errors.New("the scope already has a namespace"),
+			}
+		}
+
+		annotations, err := compileAnnotations(header.Annotations)
+		if err != nil {
+			return namespaceError{Namespace: header, Reason: err}
+		}
+
+		m.Namespaces[header.Scope] = &Namespace{
+			Scope:       header.Scope,
+			Name:        header.Name,
+			Annotations: annotations,
+		}
+	}
+
 	// Process all included modules first.
 	for _, h := range prog.Headers {
 		header, ok := h.(*ast.Include)
@@ -194,6 +237,21 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 	}
 
 	for _, d := range prog.Definitions {
+		tags, included, err := c.buildTagsMatch(definitionAnnotations(d))
+		if err != nil {
+			return definitionError{Definition: d, Reason: err}
+		}
+		if !included {
+			m.excluded[d.Info().Name] = tags
+			continue
+		}
+
+		withMembers, err := c.excludeMembers(d)
+		if err != nil {
+			return definitionError{Definition: d, Reason: err}
+		}
+		d = withMembers
+
 		if err := thriftNS.claim(d.Info().Name, d.Info().Line); err != nil {
 			return definitionError{Definition: d, Reason: err}
 		}
@@ -211,6 +269,12 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 				return definitionError{Definition: d, Reason: err}
 			}
 			m.Types[typedef.ThriftName()] = typedef
+		case *ast.Senum:
+			typedef, err := compileSenum(m.ThriftPath, definition)
+			if err != nil {
+				return definitionError{Definition: d, Reason: err}
+			}
+			m.Types[typedef.ThriftName()] = typedef
 		case *ast.Enum:
 			enum, err := compileEnum(m.ThriftPath, definition)
 			if err != nil {
@@ -234,6 +298,13 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 			}
 			m.Services[service.Name] = service
 		}
+
+		// Only types may be renamed with go.name.
+		if spec, ok := m.Types[d.Info().Name]; ok {
+			if err := goTypes.claim(spec.ThriftName(), spec.ThriftAnnotations(), d.Info().Line); err != nil {
+				return definitionError{Definition: d, Reason: err}
+			}
+		}
 	}
 
 	return nil
@@ -241,21 +312,49 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 
 // include loads the file specified by the given include in the given Module.
 //
-// The path to the file is relative to the ThriftPath of the given module.
+// The path to the file is relative to the ThriftPath of the given module or,
+// failing that, to one of the include directories.
 func (c compiler) include(m *Module, include *ast.Include) (*IncludedModule, error) {
-	if len(include.Name) > 0 {
-		// TODO(abg): Add support for include-as flag somewhere.
-		return nil, includeError{
-			Include: include,
-			Reason:  includeAsDisabledError{},
-		}
+	ipath, err := c.resolveInclude(filepath.Dir(m.ThriftPath), include.Path)
+	if err != nil {
+		return nil, includeError{Include: include, Reason: err}
 	}
 
-	ipath := filepath.Join(filepath.Dir(m.ThriftPath), include.Path)
 	incM, err := c.load(ipath)
 	if err != nil {
 		return nil, includeError{Include: include, Reason: err}
 	}
 
-	return &IncludedModule{Name: fileBaseName(include.Path), Module: incM}, nil
+	name := include.Name
+	if name == "" {
+		name = fileBaseName(include.Path)
+	}
+	return &IncludedModule{Name: name, Module: incM}, nil
+}
+
+// resolveInclude returns the path to the included file at the given path
+// relative to the directory of the file including it or, if it doesn't
+// exist there, to the first include directory in which it does.
+func (c compiler) resolveInclude(dir, rel string) (string, error) {
+	p := filepath.Join(dir, rel)
+	if len(c.includeDirs) == 0 || filepath.IsAbs(rel) {
+		// Errors reading the file are reported when it's loaded.
+		return p, nil
+	}
+
+	searched := append([]string{dir}, c.includeDirs...)
+	for _, d := range searched {
+		candidate := filepath.Join(d, rel)
+		abs, err := c.fs.Abs(candidate)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := c.Modules[abs]; ok {
+			return candidate, nil
+		}
+		if _, err := c.fs.Read(abs); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", includeNotFoundError{Path: rel, Searched: searched}
 }
@@ -21,11 +21,14 @@
 package compile
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
 	"go.uber.org/thriftrw/wire"
 )
 
@@ -54,6 +57,335 @@ func TestFilesystem(t *testing.T) {
 	assert.Equal(t, wire.TStruct, sType.TypeCode(), "Type mismatch")
 }
 
+func TestCompileIncludeDirs(t *testing.T) {
+	files := map[string]string{
+		"/src/idl/main.thrift": `
+			include "common.thrift"
+			include "shared/shared.thrift"
+			include "local.thrift"
+
+			struct S {
+				1: optional common.ID id
+				2: optional shared.UUID uuid
+				3: optional local.Name name
+			}
+		`,
+		"/src/idl/local.thrift":          `typedef string Name`,
+		"/vendor/a/common.thrift":        `typedef i64 ID`,
+		"/vendor/b/common.thrift":        `typedef string ID`,
+		"/vendor/b/local.thrift":         `typedef i32 Name`,
+		"/vendor/b/shared/shared.thrift": `typedef string UUID`,
+	}
+
+	module, err := Compile("/src/idl/main.thrift",
+		Filesystem(dummyFS{"/", files}),
+		IncludeDirs("/vendor/a", "/vendor/b"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "/vendor/a/common.thrift", module.Includes["common"].Module.ThriftPath,
+		"include directories must be searched in order")
+	assert.Equal(t, "/vendor/b/shared/shared.thrift", module.Includes["shared"].Module.ThriftPath)
+	assert.Equal(t, "/src/idl/local.thrift", module.Includes["local"].Module.ThriftPath,
+		"files relative to the including file must take precedence")
+
+	_, err = Compile("/src/idl/main.thrift", Filesystem(dummyFS{"/", files}))
+	require.Error(t, err, "includes must not be found without include directories")
+	assert.Contains(t, err.Error(), "/src/idl/common.thrift")
+
+	_, err = Compile("/src/idl/main.thrift",
+		Filesystem(dummyFS{"/", files}),
+		IncludeDirs("/vendor/c", "/vendor/d"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `cannot include "common.thrift"`)
+	assert.Contains(t, err.Error(), `could not find "common.thrift" in any of the directories `+
+		`searched, in order: /src/idl, /vendor/c, /vendor/d`)
+}
+
+func TestCompileIncludeAs(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			include "./foo/shared.thrift" as fooshared
+			include "./bar/shared.thrift" as barshared
+
+			struct S {
+				1: optional fooshared.UUID fooID
+				2: optional barshared.UUID barID
+			}
+		`,
+		"/some/prefix/foo/shared.thrift": `
+			typedef string UUID
+		`,
+		"/some/prefix/bar/shared.thrift": `
+			typedef binary UUID
+		`,
+	}
+
+	fs := dummyFS{"/some/prefix/", files}
+
+	module, err := Compile("main.thrift", Filesystem(fs))
+	require.NoError(t, err, "Compile failed")
+
+	require.Len(t, module.Includes, 2)
+	if inc := module.Includes["fooshared"]; assert.NotNil(t, inc) {
+		assert.Equal(t, "fooshared", inc.Name)
+		assert.Equal(t, "shared", inc.Module.Name)
+		assert.Equal(t, "/some/prefix/foo/shared.thrift", inc.Module.ThriftPath)
+	}
+	if inc := module.Includes["barshared"]; assert.NotNil(t, inc) {
+		assert.Equal(t, "barshared", inc.Name)
+		assert.Equal(t, "shared", inc.Module.Name)
+		assert.Equal(t, "/some/prefix/bar/shared.thrift", inc.Module.ThriftPath)
+	}
+
+	sType, err := module.LookupType("S")
+	require.NoError(t, err, "Lookup S failed")
+
+	fields := sType.(*StructSpec).Fields
+	fooID, err := fields.FindByName("fooID")
+	require.NoError(t, err)
+	assert.Equal(t, wire.TBinary, fooID.Type.TypeCode())
+	assert.Equal(t, "/some/prefix/foo/shared.thrift", fooID.Type.ThriftFile())
+
+	barID, err := fields.FindByName("barID")
+	require.NoError(t, err)
+	assert.Equal(t, "/some/prefix/bar/shared.thrift", barID.Type.ThriftFile())
+}
+
+func TestCompileIncludeConflict(t *testing.T) {
+	tests := []struct {
+		desc    string
+		main    string
+		wantErr string
+	}{
+		{
+			desc: "same base name",
+			main: `
+				include "./foo/shared.thrift"
+				include "./bar/shared.thrift"
+			`,
+			wantErr: `cannot include "./bar/shared.thrift"`,
+		},
+		{
+			desc: "alias conflicts with another include",
+			main: `
+				include "./foo/shared.thrift"
+				include "./bar/shared.thrift" as shared
+			`,
+			wantErr: `cannot include "./bar/shared.thrift" as "shared"`,
+		},
+		{
+			desc: "alias conflicts with a definition",
+			main: `
+				include "./foo/shared.thrift" as S
+				struct S {}
+			`,
+			wantErr: `"S"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/some/prefix/", map[string]string{
+				"/some/prefix/main.thrift":       tt.main,
+				"/some/prefix/foo/shared.thrift": `typedef string UUID`,
+				"/some/prefix/bar/shared.thrift": `typedef string UUID`,
+			}}
+
+			_, err := Compile("main.thrift", Filesystem(fs))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCompileNamespaces(t *testing.T) {
+	fs := dummyFS{"/some/prefix/", map[string]string{
+		"/some/prefix/main.thrift": `
+			namespace go foo.bar (go.package = "example.com/foo/bar")
+			namespace py foo
+		`,
+	}}
+
+	module, err := Compile("main.thrift", Filesystem(fs))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*Namespace{
+		"go": {
+			Scope:       "go",
+			Name:        "foo.bar",
+			Annotations: Annotations{"go.package": "example.com/foo/bar"},
+		},
+		"py": {Scope: "py", Name: "foo"},
+	}, module.Namespaces)
+}
+
+func TestCompileNamespaceErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		main    string
+		wantErr string
+	}{
+		{
+			desc: "same scope",
+			main: `
+				namespace go foo
+				namespace go bar
+			`,
+			wantErr: `cannot compile namespace "bar" for "go" on line 3: the scope already has a namespace`,
+		},
+		{
+			desc: "annotation conflict",
+			main: `
+				namespace go foo (go.package = "a", go.package = "b")
+			`,
+			wantErr: `cannot compile namespace "foo" for "go" on line 2`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/some/prefix/", map[string]string{
+				"/some/prefix/main.thrift": tt.main,
+			}}
+
+			_, err := Compile("main.thrift", Filesystem(fs))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCompileErrorSnippet(t *testing.T) {
+	tests := []struct {
+		desc    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			desc: "unknown type",
+			files: map[string]string{
+				"/some/prefix/main.thrift": "struct Foo {\n" +
+					"\t1: optional Bar bar\n" +
+					"}\n",
+			},
+			wantErr: `could not resolve reference "Bar" on line 2 in "main": unknown identifier "Bar"` + "\n" +
+				"  2 | \t1: optional Bar bar\n" +
+				"    | \t            ^",
+		},
+		{
+			desc: "missing requiredness",
+			files: map[string]string{
+				"/some/prefix/main.thrift": "struct Foo {\n" +
+					"  1: string bar\n" +
+					"}\n",
+			},
+			wantErr: `field "bar" on line 2 is not marked required or optional` + "\n" +
+				"  2 |   1: string bar\n" +
+				"    |   ^",
+		},
+		{
+			desc: "error in included file",
+			files: map[string]string{
+				"/some/prefix/main.thrift": `include "./other.thrift"`,
+				"/some/prefix/other.thrift": "\n" +
+					"const i32 foo = 1\n" +
+					"const string foo = 'a'\n",
+			},
+			wantErr: `cannot define "foo" on line 3: the name "foo" has already been used on line 2` + "\n" +
+				"  3 | const string foo = 'a'\n" +
+				"    | ^",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", tt.files}))
+			require.Error(t, err)
+			assert.True(t, strings.HasSuffix(err.Error(), tt.wantErr),
+				"error must end with %q:\n%v", tt.wantErr, err)
+		})
+	}
+}
+
+func TestErrorLocation(t *testing.T) {
+	tests := []struct {
+		desc       string
+		files      map[string]string
+		wantPath   string
+		wantPos    ast.Position
+		wantReason string
+	}{
+		{
+			desc: "unknown type",
+			files: map[string]string{
+				"/some/prefix/main.thrift": "struct Foo {\n" +
+					"\t1: optional Bar bar\n" +
+					"}\n",
+			},
+			wantPath:   "/some/prefix/main.thrift",
+			wantPos:    ast.Position{Line: 2, Column: 14},
+			wantReason: `cannot compile "Foo": could not resolve reference "Bar" on line 2`,
+		},
+		{
+			desc: "error in included file",
+			files: map[string]string{
+				"/some/prefix/main.thrift": `include "./other.thrift"`,
+				"/some/prefix/other.thrift": "\n" +
+					"const i32 foo = 1\n" +
+					"const string foo = 'a'\n",
+			},
+			wantPath:   "/some/prefix/other.thrift",
+			wantPos:    ast.Position{Line: 3, Column: 1},
+			wantReason: `cannot define "foo" on line 3: the name "foo" has already been used on line 2`,
+		},
+		{
+			desc: "missing include",
+			files: map[string]string{
+				"/some/prefix/main.thrift": "\ninclude \"./other.thrift\"",
+			},
+			wantPath:   "/some/prefix/main.thrift",
+			wantPos:    ast.Position{Line: 2, Column: 1},
+			wantReason: `cannot include "./other.thrift"`,
+		},
+		{
+			desc: "parse error in included file",
+			files: map[string]string{
+				"/some/prefix/main.thrift":  `include "./other.thrift"`,
+				"/some/prefix/other.thrift": "struct {}",
+			},
+			wantPath:   "/some/prefix/other.thrift",
+			wantReason: "parse error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", tt.files}))
+			require.Error(t, err)
+
+			path, pos, reason := ErrorLocation(err)
+			assert.Equal(t, tt.wantPath, path, "path")
+			assert.Equal(t, tt.wantPos, pos, "position")
+			assert.True(t, strings.HasPrefix(reason.Error(), tt.wantReason),
+				"reason must start with %q:\n%v", tt.wantReason, reason)
+		})
+	}
+}
+
+func TestErrorLocationParseError(t *testing.T) {
+	_, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", map[string]string{
+		"/some/prefix/main.thrift": "struct {}",
+	}}))
+	require.Error(t, err)
+
+	path, _, reason := ErrorLocation(err)
+	assert.Equal(t, "/some/prefix/main.thrift", path)
+
+	pe, ok := reason.(*idl.ParseError)
+	require.True(t, ok, "reason must be a *idl.ParseError, got %T", reason)
+	require.Len(t, pe.Errors, 1)
+	assert.Equal(t, ast.Position{Line: 1, Column: 8}, pe.Errors[0].Pos)
+}
+
 func TestCompile(t *testing.T) {
 	module, err := Compile("../gen/internal/tests/thrift/services.thrift")
 	require.NoError(t, err, "Compile failed")
@@ -86,3 +418,259 @@ func TestCompileNonStrict(t *testing.T) {
 	require.NoError(t, err, "Failed to find UUID field in struct")
 	assert.False(t, uuidField.Required, "Unspecified requiredness should be treated as optional")
 }
+
+func TestCompileKeywordFieldNames(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			struct S {
+				1: required string optional
+				2: optional bool required
+			}
+		`,
+	}
+
+	fs := dummyFS{"/some/prefix/", files}
+
+	module, err := Compile("main.thrift", Filesystem(fs), KeywordFieldNames())
+	require.NoError(t, err, "Compile failed")
+
+	sType, err := module.LookupType("S")
+	require.NoError(t, err, "Lookup S failed")
+
+	field, err := sType.(*StructSpec).Fields.FindByName("optional")
+	require.NoError(t, err)
+	assert.True(t, field.Required)
+
+	field, err = sType.(*StructSpec).Fields.FindByName("required")
+	require.NoError(t, err)
+	assert.False(t, field.Required)
+
+	_, err = Compile("main.thrift", Filesystem(fs))
+	require.Error(t, err, "keywords must not be allowed as field names by default")
+	assert.Contains(t, err.Error(), `"optional" is a keyword and cannot be used as a field name`)
+}
+
+func TestCompileRecursiveTypes(t *testing.T) {
+	fs := dummyFS{"/some/prefix/", map[string]string{
+		"/some/prefix/main.thrift": `
+			include "./other.thrift"
+
+			typedef list<Node> Nodes
+			typedef Nodes NodeList
+
+			struct Node {
+				1: optional NodeList children
+				2: optional map<string, Node> byName
+				3: optional other.Edge edge
+			}
+
+			union Tree {
+				1: Tree left
+				2: i32 leaf
+			}
+
+			struct Graph {
+				1: required list<Graph> subgraphs
+				2: required Tree tree
+			}
+		`,
+		"/some/prefix/other.thrift": `
+			include "./main.thrift"
+
+			struct Edge {
+				1: required main.Node to
+			}
+		`,
+	}}
+
+	module, err := Compile("main.thrift", Filesystem(fs))
+	require.NoError(t, err)
+
+	for _, name := range []string{"Nodes", "NodeList"} {
+		typ, err := module.LookupType(name)
+		require.NoError(t, err)
+		assert.IsType(t, &ListSpec{}, RootTypeSpec(typ), "root of %v", name)
+	}
+}
+
+func TestCompileUninhabitedStructs(t *testing.T) {
+	tests := []struct {
+		desc    string
+		main    string
+		wantErr string
+	}{
+		{
+			desc:    "self-referential required field",
+			main:    `struct Node { 1: required Node tail }`,
+			wantErr: `"Node" can never hold a value: its required fields lead to a cycle of required fields`,
+		},
+		{
+			desc: "mutually recursive required fields",
+			main: `
+				typedef Bar Baz
+				struct Foo { 1: required Baz bar }
+				struct Bar { 1: required Foo foo }
+			`,
+			wantErr: `"Bar" can never hold a value`,
+		},
+		{
+			desc: "required field of an uninhabited struct",
+			main: `
+				struct Foo { 1: required Bar bar }
+				exception Bar { 1: required Bar bar }
+			`,
+			wantErr: `"Bar" can never hold a value`,
+		},
+		{
+			desc:    "union",
+			main:    `union Loop { 1: Loop loop }`,
+			wantErr: `union "Loop" can never hold a value: each of its fields leads to a cycle of required fields`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/some/prefix/", map[string]string{
+				"/some/prefix/main.thrift": tt.main,
+			}}
+
+			_, err := Compile("main.thrift", Filesystem(fs))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCompileConstantCycles(t *testing.T) {
+	tests := []struct {
+		desc    string
+		main    string
+		wantErr string
+	}{
+		{
+			desc:    "self-reference",
+			main:    `const i32 a = a`,
+			wantErr: `the value of constant "a" refers to itself`,
+		},
+		{
+			desc: "mutual references",
+			main: `
+				const i32 a = b
+				const i32 b = a
+			`,
+			wantErr: `refers to itself`,
+		},
+		{
+			desc: "inside a container",
+			main: `
+				struct Node { 1: optional list<Node> children }
+				const Node root = {"children": [leaf]}
+				const Node leaf = {"children": [root]}
+			`,
+			wantErr: `refers to itself`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/some/prefix/", map[string]string{
+				"/some/prefix/main.thrift": tt.main,
+			}}
+
+			_, err := Compile("main.thrift", Filesystem(fs))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCompileConstantReferences(t *testing.T) {
+	fs := dummyFS{"/some/prefix/", map[string]string{
+		"/some/prefix/main.thrift": `
+			struct Node { 1: optional list<Node> children }
+			const Node leaf = {}
+			const Node root = {"children": [leaf, leaf]}
+			const list<Node> roots = [root, leaf]
+		`,
+	}}
+
+	_, err := Compile("main.thrift", Filesystem(fs))
+	assert.NoError(t, err, "constants may be referenced more than once")
+}
+
+func TestCompileIncludedFieldDefaults(t *testing.T) {
+	fs := dummyFS{"/some/prefix/", map[string]string{
+		"/some/prefix/main.thrift": `
+			include "./shared.thrift"
+			include "./shared.thrift" as common
+
+			struct S {
+				1: optional shared.Color color = shared.Color.GREEN
+				2: optional shared.Color defaultColor = shared.DEFAULT_COLOR
+				3: optional string name = common.DEFAULT_NAME
+				4: optional list<shared.Color> colors = [common.Color.RED, shared.DEFAULT_COLOR]
+			}
+		`,
+		"/some/prefix/shared.thrift": `
+			enum Color { RED, GREEN }
+			const Color DEFAULT_COLOR = Color.RED
+			const string DEFAULT_NAME = "foo"
+		`,
+	}}
+
+	module, err := Compile("main.thrift", Filesystem(fs))
+	require.NoError(t, err, "Compile failed")
+
+	shared := module.Includes["shared"].Module
+	color, err := shared.LookupType("Color")
+	require.NoError(t, err)
+	enum := color.(*EnumSpec)
+
+	s, err := module.LookupType("S")
+	require.NoError(t, err)
+	fields := s.(*StructSpec).Fields
+
+	enumItem := func(name string) EnumItemReference {
+		item, ok := enum.LookupItem(name)
+		require.True(t, ok, "unknown item %q", name)
+		return EnumItemReference{Enum: enum, Item: item}
+	}
+
+	tests := []struct {
+		field string
+		want  ConstantValue
+	}{
+		{"color", enumItem("GREEN")},
+		{"defaultColor", ConstReference{Target: shared.Constants["DEFAULT_COLOR"]}},
+		{"name", ConstReference{Target: shared.Constants["DEFAULT_NAME"]}},
+		{"colors", ConstantList{
+			enumItem("RED"),
+			ConstReference{Target: shared.Constants["DEFAULT_COLOR"]},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			f, err := fields.FindByName(tt.field)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Default)
+		})
+	}
+}
+
+func TestCompileIncludedFieldDefaultsUnknown(t *testing.T) {
+	fs := dummyFS{"/some/prefix/", map[string]string{
+		"/some/prefix/main.thrift": `
+			include "./shared.thrift"
+
+			struct S {
+				1: optional string name = shared.MISSING
+			}
+		`,
+		"/some/prefix/shared.thrift": `const string DEFAULT_NAME = "foo"`,
+	}}
+
+	_, err := Compile("main.thrift", Filesystem(fs))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `could not resolve reference "shared.MISSING"`)
+}
@@ -35,6 +35,10 @@ type Constant struct {
 	Doc   string
 	Type  TypeSpec
 	Value ConstantValue
+
+	// linking is true while the value of this constant is being linked so
+	// that references from the value back to the constant may be detected.
+	linking bool
 }
 
 // compileConstant builds a Constant from the given AST constant.
@@ -59,6 +63,9 @@ func (c *Constant) Link(scope Scope) (err error) {
 		return nil
 	}
 
+	c.linking = true
+	defer func() { c.linking = false }()
+
 	if c.Type, err = c.Type.Link(scope); err != nil {
 		return compileError{Target: c.Name, Reason: err}
 	}
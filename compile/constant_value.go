@@ -23,6 +23,9 @@ package compile
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"reflect"
 
 	"go.uber.org/thriftrw/ast"
 )
@@ -53,6 +56,8 @@ func compileConstantValue(v ast.ConstantValue) ConstantValue {
 		return ConstantBool(src)
 	case ast.ConstantInteger:
 		return ConstantInt(src)
+	case ast.ConstantBigInteger:
+		return constantBigInt{Value: src.Value}
 	case ast.ConstantString:
 		return ConstantString(src)
 	case ast.ConstantDouble:
@@ -124,6 +129,48 @@ func (c ConstantInt) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	// include them in the error messages.
 }
 
+// constantBigInt is an integer constant which doesn't fit into an int64.
+//
+// It never outlives linking: it links to an i64 as the ConstantInt with the
+// same bits if it fits into a uint64, and to a double if the value is exactly
+// representable as one.
+type constantBigInt struct {
+	Value *big.Int
+}
+
+func (c constantBigInt) String() string {
+	return c.Value.String()
+}
+
+// Link for constantBigInt.
+func (c constantBigInt) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
+	switch RootTypeSpec(t).(type) {
+	case *I64Spec:
+		if c.Value.Sign() >= 0 && c.Value.IsUint64() {
+			// Unsigned 64-bit values are sent over the wire with the same
+			// bits as an i64.
+			return ConstantInt(int64(c.Value.Uint64())), nil
+		}
+		return nil, constantValueCastError{
+			Value:  c,
+			Type:   t,
+			Reason: errors.New("the value does not fit into 64 bits"),
+		}
+	case *DoubleSpec:
+		f, acc := new(big.Float).SetInt(c.Value).Float64()
+		if acc == big.Exact && !math.IsInf(f, 0) {
+			return ConstantDouble(f).Link(scope, t)
+		}
+		return nil, constantValueCastError{
+			Value:  c,
+			Type:   t,
+			Reason: errors.New("the value cannot be represented exactly"),
+		}
+	}
+
+	return nil, constantValueCastError{Value: c, Type: t}
+}
+
 // Link for ConstantString.
 func (c ConstantString) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	// TODO(abg): Are binary literals a thing?
@@ -239,6 +286,13 @@ func (c ConstantMap) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 
 	m, ok := rt.(*MapSpec)
 	if !ok {
+		switch rt.(type) {
+		case *ListSpec, *SetSpec:
+			// Like Apache Thrift, {} may be used as an empty list or set.
+			if len(c) == 0 {
+				return ConstantList(nil).Link(scope, t)
+			}
+		}
 		return nil, constantValueCastError{Value: c, Type: t}
 	}
 
@@ -272,19 +326,56 @@ func (c ConstantSet) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	}
 
 	// TODO(abg): Track whether things are linked so that we don't re-link here
-	// TODO(abg): Fail for duplicates
-	values := make([]ConstantValue, len(c))
-	for i, v := range c {
+	values := make([]ConstantValue, 0, len(c))
+	for _, v := range c {
 		value, err := v.Link(scope, s.ValueSpec)
 		if err != nil {
 			return nil, err
 		}
-		values[i] = value
+
+		// Like Apache Thrift, repeated items are dropped.
+		if !containsConstantValue(values, value) {
+			values = append(values, value)
+		}
 	}
 
 	return ConstantSet(values), nil
 }
 
+// containsConstantValue returns true if the given list has an item that is
+// known to be equal to the given linked value.
+func containsConstantValue(values []ConstantValue, v ConstantValue) bool {
+	v = referencedValue(v)
+	for _, item := range values {
+		switch item := referencedValue(item).(type) {
+		case ConstantBool, ConstantInt, ConstantString, ConstantDouble:
+			if item == v {
+				return true
+			}
+		case EnumItemReference:
+			if e, ok := v.(EnumItemReference); ok && e.Enum == item.Enum && e.Item.Value == item.Item.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// referencedValue follows references to other constants and returns the
+// value they point to. References to services are resolved to their names.
+func referencedValue(v ConstantValue) ConstantValue {
+	for {
+		switch r := v.(type) {
+		case ConstReference:
+			v = r.Target.Value
+		case ServiceReference:
+			return ConstantString(r.Service.Name)
+		default:
+			return v
+		}
+	}
+}
+
 // ConstantList represents a list of constant values from the Thrift file.
 type ConstantList []ConstantValue
 
@@ -327,11 +418,40 @@ type ConstReference struct {
 }
 
 // Link for ConstReference.
+//
+// The reference is retained if the constant has the same underlying type as
+// t, for example, if either is a typedef of the other. Otherwise the value of
+// the constant is cast to t.
 func (c ConstReference) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	if t == c.Target.Type {
 		return c, nil
 	}
-	return c.Target.Value.Link(scope, t)
+
+	v, err := c.Target.Value.Link(scope, t)
+	if err != nil {
+		return nil, err
+	}
+	if sameRootType(t, c.Target.Type) {
+		return c, nil
+	}
+	return v, nil
+}
+
+// sameRootType returns true if the two types resolve to the same type after
+// following typedefs.
+func sameRootType(l, r TypeSpec) bool {
+	l, r = RootTypeSpec(l), RootTypeSpec(r)
+	if l == r {
+		return true
+	}
+
+	// Primitive types don't have a single TypeSpec.
+	switch l.(type) {
+	case *BoolSpec, *I8Spec, *I16Spec, *I32Spec, *I64Spec, *DoubleSpec,
+		*StringSpec, *BinarySpec:
+		return reflect.TypeOf(l) == reflect.TypeOf(r)
+	}
+	return false
 }
 
 // EnumItemReference represents a reference to an item of an enum defined in the
@@ -349,20 +469,69 @@ func (e EnumItemReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 	return e, nil
 }
 
+// ServiceReference represents a reference to a service in a constant of
+// type string, for example, in a list of services to which requests may be
+// routed. The value of the reference is the name of the service.
+//
+// 	const list<string> ROUTED_SERVICES = [KeyValue, shared.Health]
+type ServiceReference struct {
+	Service *ServiceSpec
+}
+
+// Link for ServiceReference.
+func (s ServiceReference) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
+	if _, ok := RootTypeSpec(t).(*StringSpec); !ok {
+		return nil, constantValueCastError{
+			Value:  s,
+			Type:   t,
+			Reason: errors.New("services may only be referenced by strings"),
+		}
+	}
+	return s, nil
+}
+
+func (s ServiceReference) String() string {
+	return fmt.Sprintf("service %v", s.Service.Name)
+}
+
 // constantReference represents a reference to another constant.
 //
-// This gets resolved to a ConstReference or an EnumItemReference during the
-// link stage.
+// This gets resolved to a ConstReference, an EnumItemReference, or a
+// ServiceReference during the link stage.
 type constantReference ast.ConstantReference
 
 // Link a constantReference.
 //
-// This resolves the reference to a ConstReference or an EnumItemReference.
+// This resolves the reference to a ConstReference, an EnumItemReference, or
+// a ServiceReference.
 func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	src := ast.ConstantReference(r)
 
+	if s, serr := scope.LookupService(src.Name); serr == nil {
+		value, err := ServiceReference{Service: s}.Link(scope, t)
+		if err != nil {
+			return nil, referenceError{
+				Target:    src.Name,
+				Line:      src.Line,
+				Column:    src.Column,
+				ScopeName: scope.GetName(),
+				Reason:    err,
+			}
+		}
+		return value, nil
+	}
+
 	c, err := scope.LookupConstant(src.Name)
 	if err == nil {
+		if c.linking {
+			return nil, referenceError{
+				Target:    src.Name,
+				Line:      src.Line,
+				Column:    src.Column,
+				ScopeName: scope.GetName(),
+				Reason:    constantReferenceCycleError{Name: c.Name},
+			}
+		}
 		if err := c.Link(scope); err != nil {
 			return nil, err
 		}
@@ -374,6 +543,7 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
@@ -390,6 +560,7 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason: unrecognizedEnumItemError{
 				EnumName: mname,
@@ -403,6 +574,7 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
@@ -413,6 +585,7 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
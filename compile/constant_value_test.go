@@ -21,6 +21,8 @@
 package compile
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,6 +52,8 @@ func TestLinkConstantReference(t *testing.T) {
 		Value: ConstantString("anonymous"),
 	}
 
+	health := &ServiceSpec{Name: "Health"}
+
 	tests := []struct {
 		desc  string
 		scope Scope
@@ -92,6 +96,20 @@ func TestLinkConstantReference(t *testing.T) {
 			},
 			role,
 		},
+		{
+			"service lookup",
+			scope("Health", health),
+			"Health",
+			ServiceReference{Service: health},
+			&StringSpec{},
+		},
+		{
+			"included service lookup as a typedef of string",
+			scope("shared", scope("Health", health)),
+			"shared.Health",
+			ServiceReference{Service: health},
+			&TypedefSpec{Name: "ServiceName", Target: &StringSpec{}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -334,18 +352,87 @@ func TestCastConstants(t *testing.T) {
 			},
 			wantError: "100 is not a string: all keys must be strings",
 		},
+		{
+			desc: "constantBigInt: i64",
+			typ:  &I64Spec{},
+			give: constantBigInt{Value: new(big.Int).SetUint64(math.MaxUint64)},
+			want: ConstantInt(-1),
+		},
+		{
+			desc: "constantBigInt: double",
+			typ:  &DoubleSpec{},
+			give: constantBigInt{Value: new(big.Int).Lsh(big.NewInt(1), 100)},
+			want: ConstantDouble(math.Pow(2, 100)),
+		},
+		{
+			desc:      "constantBigInt: too large for i64",
+			typ:       &I64Spec{},
+			give:      constantBigInt{Value: new(big.Int).Lsh(big.NewInt(1), 64)},
+			wantError: `cannot cast 18446744073709551616 to "i64": the value does not fit into 64 bits`,
+		},
+		{
+			desc:      "constantBigInt: negative",
+			typ:       &I64Spec{},
+			give:      constantBigInt{Value: new(big.Int).Sub(big.NewInt(math.MinInt64), big.NewInt(1))},
+			wantError: `cannot cast -9223372036854775809 to "i64"`,
+		},
+		{
+			desc:      "constantBigInt: not exact for double",
+			typ:       &DoubleSpec{},
+			give:      constantBigInt{Value: new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 100), big.NewInt(1))},
+			wantError: "the value cannot be represented exactly",
+		},
+		{
+			desc:      "constantBigInt: i32",
+			typ:       &I32Spec{},
+			give:      constantBigInt{Value: new(big.Int).SetUint64(math.MaxUint64)},
+			wantError: `cannot cast 18446744073709551615 to "i32"`,
+		},
 		{
 			desc: "ConstantSet",
 			typ:  &SetSpec{ValueSpec: &I32Spec{}},
 			give: ConstantSet{ConstantInt(1), ConstantInt(2), ConstantInt(3)},
 			want: ConstantSet{ConstantInt(1), ConstantInt(2), ConstantInt(3)},
 		},
+		{
+			desc: "ConstantSet: duplicates",
+			typ:  &SetSpec{ValueSpec: &I32Spec{}},
+			give: ConstantSet{ConstantInt(1), ConstantInt(2), ConstantInt(1)},
+			want: ConstantSet{ConstantInt(1), ConstantInt(2)},
+		},
+		{
+			desc: "ConstantSet: duplicate enum items",
+			typ:  &SetSpec{ValueSpec: role},
+			give: ConstantSet{ConstantInt(1), ConstantInt(-1), ConstantInt(1)},
+			want: ConstantSet{
+				EnumItemReference{Enum: role, Item: &role.Items[1]},
+				EnumItemReference{Enum: role, Item: &role.Items[0]},
+			},
+		},
 		{
 			desc: "ConstantList",
 			typ:  &ListSpec{ValueSpec: &I32Spec{}},
 			give: ConstantList{ConstantInt(1), ConstantInt(2), ConstantInt(3)},
 			want: ConstantList{ConstantInt(1), ConstantInt(2), ConstantInt(3)},
 		},
+		{
+			desc: "ConstantMap: empty list",
+			typ:  &ListSpec{ValueSpec: &I32Spec{}},
+			give: ConstantMap{},
+			want: ConstantList{},
+		},
+		{
+			desc: "ConstantMap: empty set",
+			typ:  &SetSpec{ValueSpec: &I32Spec{}},
+			give: ConstantMap{},
+			want: ConstantSet{},
+		},
+		{
+			desc:      "ConstantMap: non-empty list",
+			typ:       &ListSpec{ValueSpec: &I32Spec{}},
+			give:      ConstantMap{{Key: ConstantInt(1), Value: ConstantInt(2)}},
+			wantError: "cannot cast",
+		},
 		{
 			desc: "ConstantReference",
 			typ:  &I32Spec{},
@@ -370,6 +457,39 @@ func TestCastConstants(t *testing.T) {
 			}},
 			want: ConstantDouble(42.0),
 		},
+		{
+			desc: "ConstantReference: typedef",
+			typ:  &TypedefSpec{Name: "Timeout", Target: &I32Spec{}},
+			give: ConstReference{Target: &Constant{
+				Name:  "Version",
+				Type:  &I32Spec{},
+				Value: ConstantInt(42),
+			}},
+			want: ConstReference{Target: &Constant{
+				Name:  "Version",
+				Type:  &I32Spec{},
+				Value: ConstantInt(42),
+			}},
+		},
+		{
+			desc: "ConstantSet: duplicate references",
+			typ:  &SetSpec{ValueSpec: &I32Spec{}},
+			give: ConstantSet{
+				ConstReference{Target: &Constant{
+					Name:  "Version",
+					Type:  &I32Spec{},
+					Value: ConstantInt(42),
+				}},
+				ConstantInt(42),
+			},
+			want: ConstantSet{
+				ConstReference{Target: &Constant{
+					Name:  "Version",
+					Type:  &I32Spec{},
+					Value: ConstantInt(42),
+				}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -450,6 +570,16 @@ func TestLinkConstantReferenceFailure(t *testing.T) {
 			},
 			foo,
 		},
+		{
+			"service referenced by a non-string",
+			scope("foo", "shared", scope("shared", "Health", &ServiceSpec{Name: "Health"})),
+			"shared.Health",
+			[]string{
+				`could not resolve reference "shared.Health" in "foo"`,
+				`cannot cast service Health to "i32": services may only be referenced by strings`,
+			},
+			&I32Spec{},
+		},
 	}
 
 	for _, tt := range tests {
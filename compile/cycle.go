@@ -20,6 +20,12 @@
 
 package compile
 
+import (
+	"sort"
+
+	"go.uber.org/thriftrw/ast"
+)
+
 // findTypeCycles look for invalid type reference cycles in the given
 // TypeSpec.
 func findTypeCycles(t TypeSpec) error {
@@ -67,3 +73,98 @@ func (f typeCycleFinder) Visit(s TypeSpec) error {
 
 	return s.ForEachTypeReference(f.cloneWithPart(s).Visit)
 }
+
+// findUninhabitedStructs looks for structs among the given types which can
+// never hold a value because every value of them would have to contain
+// another value of the same type, like a struct with a required field of
+// its own type. Recursive references through optional fields, containers,
+// or unions with another choice are valid.
+func findUninhabitedStructs(types map[string]TypeSpec) error {
+	var structs []*StructSpec
+	seen := make(map[TypeSpec]struct{})
+	var collect func(TypeSpec) error
+	collect = func(t TypeSpec) error {
+		switch t.(type) {
+		case *StructSpec, *TypedefSpec:
+			if _, ok := seen[t]; ok {
+				return nil
+			}
+			seen[t] = struct{}{}
+		}
+		if s, ok := t.(*StructSpec); ok {
+			structs = append(structs, s)
+		}
+		return t.ForEachTypeReference(collect)
+	}
+	for _, name := range sortedTypeNames(types) {
+		collect(types[name])
+	}
+
+	// Structs are inhabited if a value can be built for them from values
+	// of the structs that are already known to be inhabited. Repeat until
+	// no more are found.
+	inhabited := make(map[*StructSpec]struct{})
+	for changed := true; changed; {
+		changed = false
+		for _, s := range structs {
+			if _, ok := inhabited[s]; ok {
+				continue
+			}
+			if structInhabited(s, inhabited) {
+				inhabited[s] = struct{}{}
+				changed = true
+			}
+		}
+	}
+
+	for _, name := range sortedTypeNames(types) {
+		s, ok := types[name].(*StructSpec)
+		if !ok {
+			continue
+		}
+		if _, ok := inhabited[s]; !ok {
+			return compileError{Target: name, Reason: uninhabitedStructError{Struct: s}}
+		}
+	}
+	return nil
+}
+
+func structInhabited(s *StructSpec, inhabited map[*StructSpec]struct{}) bool {
+	hasValue := func(t TypeSpec) bool {
+		s, ok := RootTypeSpec(t).(*StructSpec)
+		if !ok {
+			// Everything else has a value: containers may be empty.
+			return true
+		}
+		_, ok = inhabited[s]
+		return ok
+	}
+
+	if s.Type == ast.UnionType {
+		if len(s.Fields) == 0 {
+			return true
+		}
+		for _, f := range s.Fields {
+			if hasValue(f.Type) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, f := range s.Fields {
+		if f.Required && !hasValue(f.Type) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedTypeNames(types map[string]TypeSpec) []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -45,6 +45,7 @@ type EnumItem struct {
 // compileEnum compiles the given Enum AST into an EnumSpec.
 func compileEnum(file string, src *ast.Enum) (*EnumSpec, error) {
 	enumNS := newNamespace(caseInsensitive)
+	goItems := newGoNames(goEnumItemName)
 	prev := -1
 
 	var items []EnumItem
@@ -53,6 +54,7 @@ func compileEnum(file string, src *ast.Enum) (*EnumSpec, error) {
 			return nil, compileError{
 				Target: src.Name + "." + astItem.Name,
 				Line:   astItem.Line,
+				Column: astItem.Column,
 				Reason: err,
 			}
 		}
@@ -67,9 +69,19 @@ func compileEnum(file string, src *ast.Enum) (*EnumSpec, error) {
 			return nil, compileError{
 				Target: src.Name + "." + astItem.Name,
 				Line:   astItem.Line,
+				Column: astItem.Column,
 				Reason: err,
 			}
 		}
+		if err := goItems.claim(astItem.Name, itemAnnotations, astItem.Line); err != nil {
+			return nil, compileError{
+				Target: src.Name + "." + astItem.Name,
+				Line:   astItem.Line,
+				Column: astItem.Column,
+				Reason: err,
+			}
+		}
+
 		// TODO bounds check for value
 		item := EnumItem{
 			Name:        astItem.Name,
@@ -85,6 +97,7 @@ func compileEnum(file string, src *ast.Enum) (*EnumSpec, error) {
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
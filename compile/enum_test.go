@@ -94,6 +94,23 @@ func TestCompileEnumSuccess(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Item annotations
+			`enum Status { ACTIVE, SUSPENDED (deprecated = "Use DISABLED instead."), DISABLED }`,
+			&EnumSpec{
+				Name: "Status",
+				File: "test.thrift",
+				Items: []EnumItem{
+					{Name: "ACTIVE", Value: 0},
+					{
+						Name:        "SUSPENDED",
+						Value:       1,
+						Annotations: Annotations{"deprecated": "Use DISABLED instead."},
+					},
+					{Name: "DISABLED", Value: 2},
+				},
+			},
+		},
 		{
 			// Same values
 			"enum bar { A, B = 0, C, D = 0, E }",
@@ -139,6 +156,17 @@ func TestCompileEnumFailure(t *testing.T) {
 				`the name "A" has already been used`,
 			},
 		},
+		{
+			`enum Foo { FOO_BAR, Baz (go.name = "FooBar") }`,
+			[]string{
+				`cannot compile "Foo.Baz"`,
+				`the Go name "FooBar" has already been used by "FOO_BAR"`,
+			},
+		},
+		{
+			`enum Foo { A (go.name = "") }`,
+			[]string{`go.name "" is empty`},
+		},
 	}
 
 	for _, tt := range tests {
@@ -25,14 +25,126 @@ import (
 	"strings"
 
 	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/internal/source"
 )
 
+// positionedError is implemented by errors which know the position in the
+// Thrift file at which they occurred.
+type positionedError interface {
+	error
+
+	pos() ast.Position
+}
+
+// errorPosition returns the position of the innermost error in the chain
+// that knows where it occurred.
+func errorPosition(err error) (pos ast.Position, ok bool) {
+	for err != nil {
+		if pe, isPositioned := err.(positionedError); isPositioned && pe.pos().Line > 0 {
+			pos, ok = pe.pos(), true
+		}
+
+		wrapper, isWrapper := err.(interface{ Unwrap() error })
+		if !isWrapper {
+			break
+		}
+		err = wrapper.Unwrap()
+	}
+	return pos, ok
+}
+
+// ErrorLocation returns the path to the Thrift file in which the given error
+// returned by Compile occurred, and the position in that file at which it
+// occurred. The position is zero if it is unknown.
+//
+// reason describes the error without naming the file, and without the
+// excerpt of the file included in the message of err. If the Thrift file
+// could not be parsed, reason is an *idl.ParseError listing each of the
+// problems found.
+//
+// path is empty and reason is err if the error did not occur in a Thrift
+// file, for example because the file could not be read.
+func ErrorLocation(err error) (path string, pos ast.Position, reason error) {
+	reason = err
+	for e := err; e != nil; {
+		switch e := e.(type) {
+		case sourceError:
+			path, pos, reason = e.Path, ast.Position{}, e.Reason
+			switch r := e.Reason.(type) {
+			case fileCompileError:
+				reason = r.Reason
+			case compileError:
+				if r.Target == e.Path {
+					reason = r.Reason
+				}
+			}
+		case parseError:
+			path, pos, reason = e.Path, ast.Position{}, e.Reason
+		}
+
+		if pe, ok := e.(positionedError); ok && pe.pos().Line > 0 {
+			pos = pe.pos()
+		}
+
+		wrapper, ok := e.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		e = wrapper.Unwrap()
+	}
+	return path, pos, reason
+}
+
+// sourceError attaches the contents of a Thrift file to an error raised
+// while compiling it so that the error can show the offending line.
+type sourceError struct {
+	Path   string
+	Source []byte
+	Reason error
+}
+
+func (e sourceError) Unwrap() error { return e.Reason }
+
+func (e sourceError) Error() string {
+	msg := e.Reason.Error()
+	pos, ok := errorPosition(e.Reason)
+	if !ok {
+		return msg
+	}
+
+	snippet := source.Snippet(e.Source, pos, "  ")
+	if snippet == "" {
+		return msg
+	}
+	return msg + "\n" + strings.TrimSuffix(snippet, "\n")
+}
+
+// withSource attaches the given Thrift file and its contents to err unless
+// the error came from an included file. Errors from included files already
+// point at their own source.
+func withSource(path string, src []byte, err error) error {
+	for e := err; e != nil; {
+		switch e.(type) {
+		case sourceError, parseError:
+			return err
+		}
+		wrapper, ok := e.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		e = wrapper.Unwrap()
+	}
+	return sourceError{Path: path, Source: src, Reason: err}
+}
+
 // fileReadError is raised when there's an error reading a file.
 type fileReadError struct {
 	Path   string
 	Reason error
 }
 
+func (e fileReadError) Unwrap() error { return e.Reason }
+
 func (e fileReadError) Error() string {
 	return fmt.Sprintf("could not read file %q: %v", e.Path, e.Reason)
 }
@@ -43,6 +155,8 @@ type parseError struct {
 	Reason error
 }
 
+func (e parseError) Unwrap() error { return e.Reason }
+
 func (e parseError) Error() string {
 	return fmt.Sprintf("could not parse file %q: %v", e.Path, e.Reason)
 }
@@ -52,18 +166,12 @@ type fileCompileError struct {
 	Reason error
 }
 
+func (e fileCompileError) Unwrap() error { return e.Reason }
+
 func (e fileCompileError) Error() string {
 	return fmt.Sprintf("could not compile file %q: %v", e.Path, e.Reason)
 }
 
-// includeAsDisabledError is raised when the user attempts to use the include-as
-// syntax without explicitly enabling it.
-type includeAsDisabledError struct{}
-
-func (e includeAsDisabledError) Error() string {
-	return "include-as syntax is currently disabled"
-}
-
 // includeError is raised when there is an error including another Thrift
 // file.
 type includeError struct {
@@ -71,6 +179,10 @@ type includeError struct {
 	Reason  error
 }
 
+func (e includeError) pos() ast.Position { return ast.Pos(e.Include) }
+
+func (e includeError) Unwrap() error { return e.Reason }
+
 func (e includeError) Error() string {
 	return fmt.Sprintf(
 		"cannot include %q as %q on line %d: %v",
@@ -78,6 +190,37 @@ func (e includeError) Error() string {
 	)
 }
 
+// includeNotFoundError is raised when an included file is not found in any
+// of the directories in which it was searched for.
+type includeNotFoundError struct {
+	Path     string
+	Searched []string
+}
+
+func (e includeNotFoundError) Error() string {
+	return fmt.Sprintf(
+		"could not find %q in any of the directories searched, in order: %v",
+		e.Path, strings.Join(e.Searched, ", "))
+}
+
+// namespaceError is raised when there is an error compiling a namespace
+// statement.
+type namespaceError struct {
+	Namespace *ast.Namespace
+	Reason    error
+}
+
+func (e namespaceError) pos() ast.Position { return ast.Pos(e.Namespace) }
+
+func (e namespaceError) Unwrap() error { return e.Reason }
+
+func (e namespaceError) Error() string {
+	return fmt.Sprintf(
+		"cannot compile namespace %q for %q on line %d: %v",
+		e.Namespace.Name, e.Namespace.Scope, e.Namespace.Line, e.Reason,
+	)
+}
+
 // definitionError is raised when there was an error compiling a definition
 // from the Thrift file.
 type definitionError struct {
@@ -85,6 +228,13 @@ type definitionError struct {
 	Reason     error
 }
 
+func (e definitionError) pos() ast.Position {
+	info := e.Definition.Info()
+	return ast.Position{Line: info.Line, Column: info.Column}
+}
+
+func (e definitionError) Unwrap() error { return e.Reason }
+
 func (e definitionError) Error() string {
 	return fmt.Sprintf(
 		"cannot define %q on line %d: %v",
@@ -97,9 +247,16 @@ func (e definitionError) Error() string {
 type compileError struct {
 	Target string
 	Line   int
+	Column int
 	Reason error
 }
 
+func (e compileError) pos() ast.Position {
+	return ast.Position{Line: e.Line, Column: e.Column}
+}
+
+func (e compileError) Unwrap() error { return e.Reason }
+
 func (e compileError) Error() string {
 	msg := fmt.Sprintf("cannot compile %q", e.Target)
 	if e.Line > 0 {
@@ -115,10 +272,17 @@ func (e compileError) Error() string {
 type referenceError struct {
 	Target    string
 	Line      int
+	Column    int
 	ScopeName string
 	Reason    error
 }
 
+func (e referenceError) pos() ast.Position {
+	return ast.Position{Line: e.Line, Column: e.Column}
+}
+
+func (e referenceError) Unwrap() error { return e.Reason }
+
 func (e referenceError) Error() string {
 	msg := fmt.Sprintf("could not resolve reference %q", e.Target)
 	if e.Line > 0 {
@@ -138,6 +302,8 @@ type unrecognizedModuleError struct {
 	Reason error
 }
 
+func (e unrecognizedModuleError) Unwrap() error { return e.Reason }
+
 func (e unrecognizedModuleError) Error() string {
 	msg := fmt.Sprintf("unknown module %q", e.Name)
 	if e.Reason != nil {
@@ -157,6 +323,18 @@ func (e unrecognizedEnumItemError) Error() string {
 	)
 }
 
+// serviceTypeError is raised when a service is referenced where a type is
+// expected.
+type serviceTypeError struct {
+	Name string
+}
+
+func (e serviceTypeError) Error() string {
+	return fmt.Sprintf(
+		"%q is a service, not a type: services may only be referenced by "+
+			"constants of type string, which hold their names", e.Name)
+}
+
 // lookupError is raised by Module if the Lookup* functions are called with
 // unknown values.
 type lookupError struct {
@@ -170,6 +348,11 @@ func (e lookupError) Error() string {
 type requirednessRequiredError struct {
 	FieldName string
 	Line      int
+	Column    int
+}
+
+func (e requirednessRequiredError) pos() ast.Position {
+	return ast.Position{Line: e.Line, Column: e.Column}
 }
 
 func (e requirednessRequiredError) Error() string {
@@ -182,6 +365,11 @@ func (e requirednessRequiredError) Error() string {
 type cannotBeRequiredError struct {
 	FieldName string
 	Line      int
+	Column    int
+}
+
+func (e cannotBeRequiredError) pos() ast.Position {
+	return ast.Position{Line: e.Line, Column: e.Column}
 }
 
 func (e cannotBeRequiredError) Error() string {
@@ -194,6 +382,11 @@ func (e cannotBeRequiredError) Error() string {
 type defaultValueNotAllowedError struct {
 	FieldName string
 	Line      int
+	Column    int
+}
+
+func (e defaultValueNotAllowedError) pos() ast.Position {
+	return ast.Position{Line: e.Line, Column: e.Column}
 }
 
 func (e defaultValueNotAllowedError) Error() string {
@@ -233,6 +426,64 @@ func (e oneWayCannotReturnError) Error() string {
 	)
 }
 
+type serviceInheritanceCycleError struct {
+	Services []*ServiceSpec
+}
+
+func (e serviceInheritanceCycleError) Error() string {
+	names := make([]string, len(e.Services))
+	for i, s := range e.Services {
+		names[i] = s.Name
+	}
+	return fmt.Sprintf(
+		"service %q inherits from itself: %v",
+		e.Services[0].Name, strings.Join(names, " -> "),
+	)
+}
+
+type constantReferenceCycleError struct {
+	Name string
+}
+
+func (e constantReferenceCycleError) Error() string {
+	return fmt.Sprintf("the value of constant %q refers to itself", e.Name)
+}
+
+type buildTagsError struct {
+	Tags string
+	Term string
+}
+
+func (e buildTagsError) Error() string {
+	return fmt.Sprintf("invalid %v annotation %q: %q is not a valid build tag",
+		buildTagsAnnotation, e.Tags, e.Term)
+}
+
+// excludedError is returned when looking up a definition which was excluded
+// from this build by its build.tags annotation.
+type excludedError struct {
+	Name string
+	Tags string
+}
+
+func (e excludedError) Error() string {
+	return fmt.Sprintf("%q is excluded from this build by (%v = %q)",
+		e.Name, buildTagsAnnotation, e.Tags)
+}
+
+type inheritedFunctionConflictError struct {
+	Name       string
+	ParentName string
+	Parent     string
+}
+
+func (e inheritedFunctionConflictError) Error() string {
+	return fmt.Sprintf(
+		"function %q conflicts with function %q inherited from %q",
+		e.Name, e.ParentName, e.Parent,
+	)
+}
+
 type notAnExceptionError struct {
 	TypeName  string
 	FieldName string
@@ -288,6 +539,23 @@ func (e typeReferenceCycleError) Error() string {
 	return strings.Join(lines, "\n")
 }
 
+// A struct which can never hold a value because its values would have to
+// contain themselves.
+type uninhabitedStructError struct {
+	Struct *StructSpec
+}
+
+func (e uninhabitedStructError) Error() string {
+	if e.Struct.Type == ast.UnionType {
+		return fmt.Sprintf(
+			"union %q can never hold a value: each of its fields leads to a cycle of required fields",
+			e.Struct.Name)
+	}
+	return fmt.Sprintf(
+		"%q can never hold a value: its required fields lead to a cycle of required fields",
+		e.Struct.Name)
+}
+
 // Failure to cast a Constantvalue to a specific type.
 type constantValueCastError struct {
 	Value  ConstantValue
@@ -330,3 +598,24 @@ type annotationConflictError struct {
 func (e annotationConflictError) Error() string {
 	return fmt.Sprintf("annotation conflict: %v", e.Reason)
 }
+
+type invalidGoNameError struct {
+	Name   string
+	Reason string
+}
+
+func (e invalidGoNameError) Error() string {
+	return fmt.Sprintf("%v %q %v", goNameAnnotation, e.Name, e.Reason)
+}
+
+type goNameConflictError struct {
+	GoName     string
+	ThriftName string
+	Line       int
+}
+
+func (e goNameConflictError) Error() string {
+	return fmt.Sprintf(
+		"the Go name %q has already been used by %q on line %d",
+		e.GoName, e.ThriftName, e.Line)
+}
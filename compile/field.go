@@ -25,6 +25,7 @@ import (
 	"math"
 
 	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/internal/goast"
 )
 
 // fieldRequiredness controls how fields treat required/optional specifiers.
@@ -60,6 +61,7 @@ func (r fieldRequiredness) isRequired(src *ast.Field) (bool, error) {
 			return false, requirednessRequiredError{
 				FieldName: src.Name,
 				Line:      src.Line,
+				Column:    src.Column,
 			}
 		}
 	case noRequiredFields:
@@ -67,6 +69,7 @@ func (r fieldRequiredness) isRequired(src *ast.Field) (bool, error) {
 			return false, cannotBeRequiredError{
 				FieldName: src.Name,
 				Line:      src.Line,
+				Column:    src.Column,
 			}
 		}
 	default:
@@ -103,7 +106,9 @@ type FieldSpec struct {
 
 // compileField compiles the given Field source into a FieldSpec.
 func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
-	if src.ID < 1 || src.ID > math.MaxInt16 {
+	// Fields declared without an ID were already assigned negative IDs by
+	// the parser.
+	if !src.ImplicitID && (src.ID < 1 || src.ID > math.MaxInt16) {
 		return nil, fieldIDOutOfBoundsError{ID: src.ID, Name: src.Name}
 	}
 
@@ -116,6 +121,7 @@ func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
 		return nil, defaultValueNotAllowedError{
 			FieldName: src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 		}
 	}
 
@@ -124,6 +130,7 @@ func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
@@ -133,6 +140,7 @@ func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
@@ -176,6 +184,7 @@ type FieldGroup []*FieldSpec
 // compileFields compiles a collection of AST fields into a FieldGroup.
 func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 	fieldsNS := newNamespace(caseInsensitive)
+	goFields := newGoNames(goast.GoCase)
 	usedIDs := make(map[int16]string)
 
 	fields := make([]*FieldSpec, 0, len(src))
@@ -184,6 +193,7 @@ func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 			return nil, compileError{
 				Target: astField.Name,
 				Line:   astField.Line,
+				Column: astField.Column,
 				Reason: err,
 			}
 		}
@@ -193,6 +203,16 @@ func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 			return nil, compileError{
 				Target: astField.Name,
 				Line:   astField.Line,
+				Column: astField.Column,
+				Reason: err,
+			}
+		}
+
+		if err := goFields.claim(field.Name, field.Annotations, astField.Line); err != nil {
+			return nil, compileError{
+				Target: astField.Name,
+				Line:   astField.Line,
+				Column: astField.Column,
 				Reason: err,
 			}
 		}
@@ -201,6 +221,7 @@ func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 			return nil, compileError{
 				Target: astField.Name,
 				Line:   astField.Line,
+				Column: astField.Column,
 				Reason: fieldIDConflictError{
 					ID:   field.ID,
 					Name: conflictingField,
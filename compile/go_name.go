@@ -0,0 +1,111 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.uber.org/thriftrw/internal/goast"
+)
+
+// goNameAnnotation overrides the Go name of a type, field, or enum item
+// without changing its name in the Thrift file or on the wire.
+const goNameAnnotation = "go.name"
+
+// goNames tracks the Go names of the declarations in a scope so that
+// go.name annotations which would give two declarations the same Go name
+// are rejected.
+//
+// Only conflicts involving at least one go.name annotation are reported.
+// Declarations whose Go names are derived from their Thrift names are left
+// to the code generator so that Thrift files which are never used to
+// generate Go code aren't rejected.
+type goNames struct {
+	// defaultName derives the Go name of a declaration without a go.name
+	// annotation from its Thrift name.
+	defaultName func(string) string
+	names       map[string]goNameClaim
+}
+
+type goNameClaim struct {
+	ThriftName string
+	Line       int
+	Annotated  bool
+}
+
+func newGoNames(defaultName func(string) string) goNames {
+	return goNames{
+		defaultName: defaultName,
+		names:       make(map[string]goNameClaim),
+	}
+}
+
+// goEnumItemName derives the Go name of an enum item, without the prefix
+// of the name of the enum, the same way the code generator does. Types and
+// fields use goast.GoCase.
+func goEnumItemName(name string) string {
+	return goast.PascalCase(false /* all caps */, strings.Split(name, "_")...)
+}
+
+// claim records the Go name of the declaration with the given Thrift name
+// and annotations, defined on the given line.
+func (n goNames) claim(thriftName string, annotations Annotations, line int) error {
+	name, annotated := annotations[goNameAnnotation]
+	if annotated {
+		if err := validateGoName(name); err != nil {
+			return err
+		}
+	} else {
+		name = n.defaultName(thriftName)
+	}
+
+	if other, ok := n.names[name]; ok && (annotated || other.Annotated) {
+		return goNameConflictError{
+			GoName:     name,
+			ThriftName: other.ThriftName,
+			Line:       other.Line,
+		}
+	}
+	n.names[name] = goNameClaim{ThriftName: thriftName, Line: line, Annotated: annotated}
+	return nil
+}
+
+// validateGoName verifies that the value of a go.name annotation may be
+// used as the name of an exported Go declaration.
+func validateGoName(name string) error {
+	if len(name) == 0 {
+		return invalidGoNameError{Name: name, Reason: "is empty"}
+	}
+
+	for i, c := range name {
+		if !unicode.IsLetter(c) && c != '_' && (i == 0 || !unicode.IsDigit(c)) {
+			return invalidGoNameError{Name: name, Reason: "is not a Go identifier"}
+		}
+	}
+
+	// Go keywords are all lower case so exported names can't be keywords.
+	if c, _ := utf8.DecodeRuneInString(name); !unicode.IsUpper(c) {
+		return invalidGoNameError{Name: name, Reason: "is not exported"}
+	}
+	return nil
+}
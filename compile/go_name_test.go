@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileGoNames(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc: "renamed types",
+			src: `
+				typedef string user_id (go.name = "UserID")
+				struct User {
+					1: required user_id id (go.name = "UserID")
+				} (go.name = "Account")
+				enum Role { ADMIN (go.name = "Administrator") } (go.name = "AccountRole")
+			`,
+		},
+		{
+			desc: "Go names that only differ without go.name",
+			src: `
+				typedef string user_id
+				struct UserID {}
+			`,
+		},
+		{
+			desc: "typedef conflicts with struct",
+			src: `
+				struct UserID {}
+				typedef string user_id (go.name = "UserID")
+			`,
+			wantErr: `cannot define "user_id" on line 3: the Go name "UserID" has already been used by "UserID" on line 2`,
+		},
+		{
+			desc: "enum conflicts with renamed struct",
+			src: `
+				struct Foo {} (go.name = "Status")
+				enum Status { OK }
+			`,
+			wantErr: `cannot define "Status" on line 3: the Go name "Status" has already been used by "Foo" on line 2`,
+		},
+		{
+			desc:    "invalid go.name",
+			src:     `struct Foo {} (go.name = "1Foo")`,
+			wantErr: `go.name "1Foo" is not a Go identifier`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/some/prefix/", map[string]string{"/some/prefix/main.thrift": tt.src}}
+			_, err := Compile("main.thrift", Filesystem(fs))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -41,7 +41,27 @@ type Module struct {
 	Types     map[string]TypeSpec
 	Services  map[string]*ServiceSpec
 
+	// Mapping from the scope of a namespace statement to the namespace. For
+	// example, "go" for "namespace go foo.bar".
+	Namespaces map[string]*Namespace
+
 	Raw []byte // The raw IDL input.
+
+	// BuildTags enabled when the module was compiled, in sorted order.
+	BuildTags []string
+
+	// Names of the definitions excluded from this build by their build.tags
+	// annotations, mapped to the values of those annotations.
+	excluded map[string]string
+}
+
+// Namespace is a namespace statement in a Thrift file.
+//
+// 	namespace go foo.bar (go.package = "example.com/foo/bar")
+type Namespace struct {
+	Scope       string
+	Name        string
+	Annotations Annotations
 }
 
 // GetName for Module
@@ -49,13 +69,22 @@ func (m *Module) GetName() string {
 	return m.Name
 }
 
+// notFound returns the error for a definition with the given name which
+// could not be found.
+func (m *Module) notFound(name string) error {
+	if tags, ok := m.excluded[name]; ok {
+		return excludedError{Name: name, Tags: tags}
+	}
+	return lookupError{Name: name}
+}
+
 // LookupType for Module.
 func (m *Module) LookupType(name string) (TypeSpec, error) {
 	if t, ok := m.Types[name]; ok {
 		return t, nil
 	}
 
-	return nil, lookupError{Name: name}
+	return nil, m.notFound(name)
 }
 
 // LookupConstant for Module.
@@ -64,7 +93,7 @@ func (m *Module) LookupConstant(name string) (*Constant, error) {
 		return c, nil
 	}
 
-	return nil, lookupError{Name: name}
+	return nil, m.notFound(name)
 }
 
 // LookupService for Module.
@@ -73,7 +102,7 @@ func (m *Module) LookupService(name string) (*ServiceSpec, error) {
 		return s, nil
 	}
 
-	return nil, lookupError{Name: name}
+	return nil, m.notFound(name)
 }
 
 // LookupInclude for Module.
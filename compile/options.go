@@ -55,6 +55,31 @@ func Filesystem(fs FS) Option {
 	}
 }
 
+// IncludeDirs specifies directories in which included Thrift files are
+// searched for if they're not found relative to the file including them.
+// Directories are searched in the given order.
+func IncludeDirs(dirs ...string) Option {
+	return func(c *compiler) {
+		c.includeDirs = append(c.includeDirs, dirs...)
+	}
+}
+
+// BuildTags enables the given build tags. Types, services, fields, enum
+// items, and service functions annotated with build.tags are compiled only if
+// the tags listed in the annotation are enabled, and those prefixed with "!"
+// are not.
+//
+// 	struct AuditLog {
+// 		1: required string user
+// 	} (build.tags = "internal")
+func BuildTags(tags ...string) Option {
+	return func(c *compiler) {
+		for _, tag := range tags {
+			c.buildTags[tag] = struct{}{}
+		}
+	}
+}
+
 // NonStrict disables strict validation of the Thrift file. This allows
 // struct fields which are not marked as optional or required.
 func NonStrict() Option {
@@ -62,3 +87,13 @@ func NonStrict() Option {
 		c.nonStrict = true
 	}
 }
+
+// KeywordFieldNames allows Thrift keywords like required and optional to be
+// used as the names of struct fields and function parameters. This helps
+// compile Thrift files produced by other systems which don't treat these as
+// keywords.
+func KeywordFieldNames() Option {
+	return func(c *compiler) {
+		c.keywordFieldNames = true
+	}
+}
@@ -143,7 +143,7 @@ func compileBaseType(t ast.BaseType) (TypeSpec, error) {
 		return &I64Spec{Annotations: annots}, nil
 	case ast.DoubleTypeID:
 		return &DoubleSpec{Annotations: annots}, nil
-	case ast.StringTypeID:
+	case ast.StringTypeID, ast.SlistTypeID:
 		return &StringSpec{Annotations: annots}, nil
 	case ast.BinaryTypeID:
 		return &BinarySpec{Annotations: annots}, nil
@@ -75,6 +75,11 @@ func TestCompileBaseType(t *testing.T) {
 			give: ast.BaseType{ID: ast.BinaryTypeID},
 			want: &BinarySpec{},
 		},
+		{
+			desc: "slist",
+			give: ast.BaseType{ID: ast.SlistTypeID},
+			want: &StringSpec{},
+		},
 
 		// With annotations (success)
 		{
@@ -21,6 +21,8 @@
 package compile
 
 import (
+	"strings"
+
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/wire"
 )
@@ -34,6 +36,7 @@ type ServiceSpec struct {
 	Parent      *ServiceSpec
 	Functions   map[string]*FunctionSpec
 	Annotations Annotations
+	Doc         string
 
 	parentSrc *ast.ServiceReference
 }
@@ -47,6 +50,7 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 			return nil, compileError{
 				Target: src.Name + "." + astFunction.Name,
 				Line:   astFunction.Line,
+				Column: astFunction.Column,
 				Reason: err,
 			}
 		}
@@ -56,6 +60,7 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 			return nil, compileError{
 				Target: src.Name + "." + astFunction.Name,
 				Line:   astFunction.Line,
+				Column: astFunction.Column,
 				Reason: err,
 			}
 		}
@@ -68,6 +73,7 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
@@ -77,6 +83,7 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 		File:        file,
 		Functions:   functions,
 		Annotations: annotations,
+		Doc:         src.Doc,
 		parentSrc:   src.Parent,
 	}, nil
 }
@@ -94,6 +101,7 @@ func resolveService(src ast.ServiceReference, scope Scope) (*ServiceSpec, error)
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
@@ -104,6 +112,7 @@ func resolveService(src ast.ServiceReference, scope Scope) (*ServiceSpec, error)
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
@@ -126,6 +135,7 @@ func (s *ServiceSpec) Link(scope Scope) error {
 				Reason: referenceError{
 					Target:    s.parentSrc.Name,
 					Line:      s.parentSrc.Line,
+					Column:    s.parentSrc.Column,
 					ScopeName: scope.GetName(),
 					Reason:    err,
 				},
@@ -138,6 +148,10 @@ func (s *ServiceSpec) Link(scope Scope) error {
 
 		s.Parent = parent
 		s.parentSrc = nil
+
+		if err := s.checkInheritance(); err != nil {
+			return compileError{Target: s.Name, Reason: err}
+		}
 	}
 
 	for _, function := range s.Functions {
@@ -152,6 +166,33 @@ func (s *ServiceSpec) Link(scope Scope) error {
 	return nil
 }
 
+// checkInheritance verifies that the given service doesn't inherit from
+// itself and that its functions don't conflict with the functions it
+// inherits. Names of functions are compared case-insensitively because
+// they're used as method names in generated code.
+func (s *ServiceSpec) checkInheritance() error {
+	chain := []*ServiceSpec{s}
+	for parent := s.Parent; parent != nil; parent = parent.Parent {
+		chain = append(chain, parent)
+		if parent == s {
+			return serviceInheritanceCycleError{Services: chain}
+		}
+
+		for name := range s.Functions {
+			for parentName := range parent.Functions {
+				if strings.EqualFold(name, parentName) {
+					return inheritedFunctionConflictError{
+						Name:       name,
+						ParentName: parentName,
+						Parent:     parent.Name,
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // ThriftFile is the Thrift file in which this service was defined.
 func (s *ServiceSpec) ThriftFile() string {
 	return s.File
@@ -166,6 +207,11 @@ type FunctionSpec struct {
 	ResultSpec  *ResultSpec // nil if OneWay is true
 	OneWay      bool
 	Annotations Annotations
+	Doc         string
+
+	// Streaming is true if the function returns a stream of values. The
+	// ReturnType of its ResultSpec is the type of each value in the stream.
+	Streaming bool
 }
 
 func compileFunction(src *ast.Function) (*FunctionSpec, error) {
@@ -174,6 +220,7 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
@@ -190,6 +237,7 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 			return nil, compileError{
 				Target: src.Name,
 				Line:   src.Line,
+				Column: src.Column,
 				Reason: err,
 			}
 		}
@@ -200,6 +248,7 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
@@ -210,6 +259,8 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 		ResultSpec:  result,
 		Annotations: annotations,
 		OneWay:      src.OneWay,
+		Streaming:   src.Streaming,
+		Doc:         src.Doc,
 	}, nil
 }
 
@@ -210,6 +210,69 @@ func TestCompileService(t *testing.T) {
 				},
 			},
 		},
+		{
+			"streaming function",
+			`
+				service Events {
+					stream<binary> subscribe(1: string topic)
+						throws (1: KeyDoesNotExist doesNotExist)
+				}
+			`,
+			scope("KeyDoesNotExist", keyDoesNotExistSpec),
+			&ServiceSpec{
+				Name: "Events",
+				File: "test.thrift",
+				Functions: map[string]*FunctionSpec{
+					"subscribe": {
+						Name: "subscribe",
+						ArgsSpec: ArgsSpec{
+							{
+								ID:   1,
+								Name: "topic",
+								Type: &StringSpec{},
+							},
+						},
+						ResultSpec: &ResultSpec{
+							ReturnType: &BinarySpec{},
+							Exceptions: FieldGroup{
+								{
+									ID:   1,
+									Name: "doesNotExist",
+									Type: keyDoesNotExistSpec,
+								},
+							},
+						},
+						Streaming: true,
+					},
+				},
+			},
+		},
+		{
+			"docs",
+			`
+				/** Health checks. */
+				service Health {
+					/**
+					 * Returns true if the service is healthy.
+					 */
+					bool healthy()
+				}
+			`,
+			nil,
+			&ServiceSpec{
+				Name: "Health",
+				File: "test.thrift",
+				Doc:  "Health checks.",
+				Functions: map[string]*FunctionSpec{
+					"healthy": {
+						Name:       "healthy",
+						ArgsSpec:   ArgsSpec{},
+						ResultSpec: &ResultSpec{ReturnType: &BoolSpec{}},
+						Doc:        "Returns true if the service is healthy.",
+					},
+				},
+			},
+		},
 		{
 			"included service inheritance",
 			"service AnotherKeyValue extends shared.KeyValue {}",
@@ -309,6 +372,11 @@ func TestCompileServiceFailure(t *testing.T) {
 			"service Foo { oneway i32 bar() }",
 			[]string{`function "bar" cannot return values`},
 		},
+		{
+			"oneway cannot stream",
+			"service Foo { oneway stream<i32> bar() }",
+			[]string{`function "bar" cannot return values`},
+		},
 		{
 			"oneway cannot raise",
 			`
@@ -404,6 +472,46 @@ func TestLinkServiceFailure(t *testing.T) {
 				`could not resolve reference "Baz"`,
 			},
 		},
+		{
+			"inherit from itself",
+			"service Foo extends Bar {}",
+			scope(
+				"Foo", &ServiceSpec{
+					Name:      "Foo",
+					Functions: make(map[string]*FunctionSpec),
+					parentSrc: &ast.ServiceReference{Name: "Bar"},
+				},
+				"Bar", &ServiceSpec{
+					Name:      "Bar",
+					Functions: make(map[string]*FunctionSpec),
+					parentSrc: &ast.ServiceReference{Name: "Foo"},
+				},
+			),
+			[]string{
+				`cannot compile "Foo"`,
+				`service "Bar" inherits from itself: Bar -> Foo -> Bar`,
+			},
+		},
+		{
+			"function conflicts with inherited function",
+			"service Foo extends Bar { void GetValue() }",
+			scope(
+				"Bar", &ServiceSpec{
+					Name: "Bar",
+					Functions: map[string]*FunctionSpec{
+						"getValue": {
+							Name:       "getValue",
+							ArgsSpec:   ArgsSpec{},
+							ResultSpec: &ResultSpec{},
+						},
+					},
+				},
+			),
+			[]string{
+				`cannot compile "Foo"`,
+				`function "GetValue" conflicts with function "getValue" inherited from "Bar"`,
+			},
+		},
 		{
 			"can throw exceptions only",
 			`
@@ -51,6 +51,7 @@ func compileStruct(file string, src *ast.Struct, requiredness fieldRequiredness)
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
@@ -60,6 +61,7 @@ func compileStruct(file string, src *ast.Struct, requiredness fieldRequiredness)
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
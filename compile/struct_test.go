@@ -210,6 +210,32 @@ func TestCompileStructFailure(t *testing.T) {
 			}`,
 			[]string{`the name "bar" has already been used`},
 		},
+		{
+			"go.name conflicts with field name",
+			`struct Foo {
+				1: optional string user_id (go.name = "ID")
+				2: optional string id
+			}`,
+			[]string{`cannot compile "id"`, `the Go name "ID" has already been used by "user_id" on line 2`},
+		},
+		{
+			"go.name conflict",
+			`struct Foo {
+				1: optional string a (go.name = "Value")
+				2: optional string b (go.name = "Value")
+			}`,
+			[]string{`the Go name "Value" has already been used by "a" on line 2`},
+		},
+		{
+			"go.name not exported",
+			`struct Foo { 1: optional string bar (go.name = "baz") }`,
+			[]string{`go.name "baz" is not exported`},
+		},
+		{
+			"go.name not an identifier",
+			`struct Foo { 1: optional string bar (go.name = "Foo-Bar") }`,
+			[]string{`go.name "Foo-Bar" is not a Go identifier`},
+		},
 		{
 			"field ID conflict",
 			`struct Foo {
@@ -68,11 +68,44 @@ type TypeSpec interface {
 // TypeSpec of the Typedef's target.
 func RootTypeSpec(s TypeSpec) TypeSpec {
 	if t, ok := s.(*TypedefSpec); ok {
+		if t.root == nil {
+			// Typedefs that were reached through a recursive struct while
+			// their target was still being linked don't know their root
+			// yet.
+			t.root = typedefRoot(t)
+		}
 		return t.root
 	}
 	return s
 }
 
+// typedefRoot follows the targets of the given typedef to its root. It
+// returns nil if the typedef is part of a cycle or if one of the typedefs
+// along the way has not been linked yet.
+func typedefRoot(t *TypedefSpec) TypeSpec {
+	seen := make(map[*TypedefSpec]struct{})
+	var s TypeSpec = t
+	for {
+		t, ok := s.(*TypedefSpec)
+		if !ok {
+			break
+		}
+		if t.root != nil {
+			return t.root
+		}
+		if _, ok := seen[t]; ok {
+			return nil
+		}
+		seen[t] = struct{}{}
+		s = t.Target
+	}
+
+	if _, ok := s.(typeSpecReference); ok {
+		return nil
+	}
+	return s
+}
+
 // nativeThriftType is the common parent for all TypeSpecs that are native
 // Thrift types.
 type nativeThriftType struct{}
@@ -91,12 +124,16 @@ func (r typeSpecReference) Link(scope Scope) (TypeSpec, error) {
 	if err == nil {
 		return t.Link(scope)
 	}
+	if _, serr := scope.LookupService(src.Name); serr == nil {
+		err = serviceTypeError{Name: src.Name}
+	}
 
 	mname, iname := splitInclude(src.Name)
 	if len(mname) == 0 {
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
@@ -107,6 +144,7 @@ func (r typeSpecReference) Link(scope Scope) (TypeSpec, error) {
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
@@ -117,6 +155,7 @@ func (r typeSpecReference) Link(scope Scope) (TypeSpec, error) {
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
+			Column:    src.Column,
 			ScopeName: scope.GetName(),
 			Reason:    err,
 		}
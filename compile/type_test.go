@@ -150,6 +150,15 @@ func TestLinkTypeReferenceFailure(t *testing.T) {
 				`could not resolve reference "UUID" in "shared"`,
 			},
 		},
+		{
+			"service",
+			scope("foo", "shared", scope("shared", "Health", &ServiceSpec{Name: "Health"})),
+			"shared.Health",
+			[]string{
+				`could not resolve reference "shared.Health" in "foo"`,
+				`"Health" is a service, not a type`,
+			},
+		},
 		{
 			"unknown identifier in included module of included module",
 			scope("foo", "bar", scope("bar", "baz", scope("baz"))),
@@ -259,3 +268,17 @@ func TestRootTypeSpec(t *testing.T) {
 		}
 	}
 }
+
+func TestRootTypeSpecOfPartiallyLinkedTypedef(t *testing.T) {
+	list := &ListSpec{ValueSpec: &I32Spec{}}
+	nodes := &TypedefSpec{Name: "Nodes", Target: list, root: list}
+
+	// NodeList was linked while Nodes was still being linked, so it
+	// doesn't know its root yet.
+	nodeList := &TypedefSpec{Name: "NodeList", Target: nodes}
+	assert.Equal(t, list, RootTypeSpec(nodeList))
+
+	loop := &TypedefSpec{Name: "Loop"}
+	loop.Target = loop
+	assert.Nil(t, RootTypeSpec(loop))
+}
@@ -50,6 +50,7 @@ func compileTypedef(file string, src *ast.Typedef) (*TypedefSpec, error) {
 		return nil, compileError{
 			Target: src.Name,
 			Line:   src.Line,
+			Column: src.Column,
 			Reason: err,
 		}
 	}
@@ -63,6 +64,28 @@ func compileTypedef(file string, src *ast.Typedef) (*TypedefSpec, error) {
 	}, nil
 }
 
+// compileSenum compiles the given Senum AST into a TypedefSpec of string.
+// The values of the senum are not enforced.
+func compileSenum(file string, src *ast.Senum) (*TypedefSpec, error) {
+	annotations, err := compileAnnotations(src.Annotations)
+	if err != nil {
+		return nil, compileError{
+			Target: src.Name,
+			Line:   src.Line,
+			Column: src.Column,
+			Reason: err,
+		}
+	}
+
+	return &TypedefSpec{
+		Name:        src.Name,
+		File:        file,
+		Target:      &StringSpec{},
+		Annotations: annotations,
+		Doc:         src.Doc,
+	}, nil
+}
+
 // TypeCode gets the wire type for the typedef.
 func (t *TypedefSpec) TypeCode() wire.Type {
 	return t.Target.TypeCode()
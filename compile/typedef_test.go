@@ -141,3 +141,27 @@ func TestCompileTypedefFailure(t *testing.T) {
 		}
 	}
 }
+
+func TestCompileSenum(t *testing.T) {
+	prog, err := idl.Parse([]byte(`senum Color { "red", "green" } (foo = "bar")`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	src := prog.Definitions[0].(*ast.Senum)
+	typedefSpec, err := compileSenum("test.thrift", src)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	spec, err := typedefSpec.Link(defaultScope)
+	if assert.NoError(t, err) {
+		assert.Equal(t, wire.TBinary, spec.TypeCode())
+		assert.Equal(t, mustLink(t, &TypedefSpec{
+			Name:        "Color",
+			File:        "test.thrift",
+			Target:      &StringSpec{},
+			Annotations: Annotations{"foo": "bar"},
+		}, defaultScope), spec)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCompile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-compile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+		include "bar.thrift"
+
+		struct Foo { 1: optional bar.Bar bar }
+	`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "bar.thrift"), []byte(`
+		typedef string Bar
+	`), 0644))
+
+	tests := []struct {
+		desc      string
+		args      []string
+		wantOut   string
+		wantError string
+	}{
+		{
+			desc:    "json",
+			args:    []string{file},
+			wantOut: `"root": "foo.thrift"`,
+		},
+		{
+			desc:    "json with thrift root",
+			args:    []string{"--format", "json", "--thrift-root", filepath.Dir(dir), file},
+			wantOut: `"module": "` + filepath.Base(dir) + `/bar.thrift"`,
+		},
+		{
+			desc:    "yaml",
+			args:    []string{"--format", "yaml", file},
+			wantOut: "  - path: \"bar.thrift\"\n    name: \"bar\"\n",
+		},
+		{
+			desc:      "unknown format",
+			args:      []string{"--format", "xml", file},
+			wantError: `unknown format "xml"`,
+		},
+		{
+			desc:      "missing file",
+			args:      []string{filepath.Join(dir, "baz.thrift")},
+			wantError: "baz.thrift",
+		},
+		{
+			desc:      "no files",
+			wantError: "thriftrw compile [OPTIONS] FILE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runCompile(tt.args, &out)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantError)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tt.wantOut)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dump"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type decodeOptions struct {
+	Protocol   string `short:"p" long:"protocol" value-name:"PROTOCOL" description:"Protocol with which the payload was encoded: binary or compact. Defaults to binary."`
+	Envelope   bool   `long:"envelope" description:"The payload is wrapped in an envelope."`
+	ThriftFile string `short:"t" long:"thrift-file" value-name:"FILE" description:"Thrift file used to name fields. Requires --type."`
+	Type       string `long:"type" value-name:"NAME" description:"Name of the struct, union, or exception in the Thrift file which the payload holds. Types of included files may be referenced as INCLUDE.NAME."`
+}
+
+// runDecode decodes the Thrift payload in the file given in args, or stdin
+// if no file was given, and writes a readable representation of it to out.
+func runDecode(args []string, stdin io.Reader, out io.Writer) error {
+	var opts decodeOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw decode"
+	parser.Usage = "[OPTIONS] [FILE]"
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) > 1 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	var proto protocol.Protocol
+	switch opts.Protocol {
+	case "", "binary":
+		proto = protocol.Binary
+	case "compact":
+		proto = protocol.Compact
+	default:
+		return fmt.Errorf("unknown protocol %q: expected binary or compact", opts.Protocol)
+	}
+
+	if (opts.ThriftFile == "") != (opts.Type == "") {
+		return errors.New("--thrift-file and --type must be used together")
+	}
+
+	var spec compile.TypeSpec
+	if opts.ThriftFile != "" {
+		module, err := compile.Compile(opts.ThriftFile)
+		if err != nil {
+			return err
+		}
+
+		spec, err = lookupDecodeType(module, opts.Type)
+		if err != nil {
+			return err
+		}
+	}
+
+	var payload []byte
+	if len(files) == 0 || files[0] == "-" {
+		payload, err = ioutil.ReadAll(stdin)
+	} else {
+		payload, err = ioutil.ReadFile(files[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.Envelope {
+		e, err := proto.DecodeEnveloped(bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("could not decode envelope: %v", err)
+		}
+		return dump.Envelope(out, e, spec)
+	}
+
+	v, err := proto.Decode(bytes.NewReader(payload), wire.TStruct)
+	if err != nil {
+		return fmt.Errorf("could not decode struct: %v", err)
+	}
+	return dump.Value(out, v, spec)
+}
+
+// lookupDecodeType looks up the struct with the given name in the module.
+// Names of the form INCLUDE.NAME refer to types of included modules.
+func lookupDecodeType(m *compile.Module, name string) (compile.TypeSpec, error) {
+	scope := compile.Scope(m)
+	typeName := name
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		include, err := m.LookupInclude(name[:i])
+		if err != nil {
+			return nil, fmt.Errorf("unknown include %q in type %q", name[:i], name)
+		}
+		scope, typeName = include, name[i+1:]
+	}
+
+	spec, err := scope.LookupType(typeName)
+	if err != nil {
+		return nil, fmt.Errorf("unknown type %q", name)
+	}
+
+	if _, ok := compile.RootTypeSpec(spec).(*compile.StructSpec); !ok {
+		return nil, fmt.Errorf("type %q is not a struct, union, or exception", name)
+	}
+	return spec, nil
+}
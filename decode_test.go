@@ -0,0 +1,171 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunDecode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-decode")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "types.thrift"), []byte(`
+		struct User {
+			1: required string name
+		}
+	`), 0644))
+	thriftFile := filepath.Join(dir, "api.thrift")
+	require.NoError(t, ioutil.WriteFile(thriftFile, []byte(`
+		include "types.thrift"
+
+		typedef types.User Person
+		enum Role { User }
+	`), 0644))
+
+	value := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+	}})
+	encode := func(p protocol.Protocol) []byte {
+		var buf bytes.Buffer
+		require.NoError(t, p.Encode(value, &buf))
+		return buf.Bytes()
+	}
+
+	var enveloped bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  "getUser",
+		Type:  wire.Reply,
+		SeqID: 1,
+		Value: value,
+	}, &enveloped))
+
+	binaryFile := filepath.Join(dir, "payload.bin")
+	require.NoError(t, ioutil.WriteFile(binaryFile, encode(protocol.Binary), 0644))
+
+	tests := []struct {
+		desc      string
+		args      []string
+		stdin     []byte
+		wantOut   string
+		wantError string
+	}{
+		{
+			desc:    "file",
+			args:    []string{binaryFile},
+			wantOut: "struct {\n  1: binary \"foo\"\n}\n",
+		},
+		{
+			desc:    "stdin",
+			stdin:   encode(protocol.Binary),
+			wantOut: "struct {\n  1: binary \"foo\"\n}\n",
+		},
+		{
+			desc:    "compact",
+			args:    []string{"--protocol", "compact", "-"},
+			stdin:   encode(protocol.Compact),
+			wantOut: "struct {\n  1: binary \"foo\"\n}\n",
+		},
+		{
+			desc:    "envelope",
+			args:    []string{"--envelope"},
+			stdin:   enveloped.Bytes(),
+			wantOut: "Reply \"getUser\" (seqid: 1)\nstruct {\n  1: binary \"foo\"\n}\n",
+		},
+		{
+			desc:    "type",
+			args:    []string{"--thrift-file", thriftFile, "--type", "types.User", binaryFile},
+			wantOut: "struct {\n  1 name: binary \"foo\"\n}\n",
+		},
+		{
+			desc:    "typedef",
+			args:    []string{"--thrift-file", thriftFile, "--type", "Person", binaryFile},
+			wantOut: "struct {\n  1 name: binary \"foo\"\n}\n",
+		},
+		{
+			desc:      "unknown type",
+			args:      []string{"--thrift-file", thriftFile, "--type", "Foo", binaryFile},
+			wantError: `unknown type "Foo"`,
+		},
+		{
+			desc:      "unknown include",
+			args:      []string{"--thrift-file", thriftFile, "--type", "foo.User", binaryFile},
+			wantError: `unknown include "foo" in type "foo.User"`,
+		},
+		{
+			desc:      "not a struct",
+			args:      []string{"--thrift-file", thriftFile, "--type", "Role", binaryFile},
+			wantError: `type "Role" is not a struct, union, or exception`,
+		},
+		{
+			desc:      "type without file",
+			args:      []string{"--type", "User", binaryFile},
+			wantError: "--thrift-file and --type must be used together",
+		},
+		{
+			desc:      "unknown protocol",
+			args:      []string{"--protocol", "json", binaryFile},
+			wantError: `unknown protocol "json"`,
+		},
+		{
+			desc:      "invalid payload",
+			stdin:     []byte{0x08, 0x00},
+			wantError: "could not decode struct",
+		},
+		{
+			desc:      "missing file",
+			args:      []string{filepath.Join(dir, "missing.bin")},
+			wantError: "missing.bin",
+		},
+		{
+			desc:      "too many files",
+			args:      []string{binaryFile, binaryFile},
+			wantError: "thriftrw decode [OPTIONS] [FILE]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runDecode(tt.args, bytes.NewReader(tt.stdin), &out)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantError)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/idl"
+	"go.uber.org/thriftrw/lint"
+)
+
+// Severities of diagnostics. Problems which prevent code generation are
+// errors; lint problems are warnings.
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+// diagnostic is a problem found in a Thrift file, in the form in which it's
+// written by --diagnostics=json.
+type diagnostic struct {
+	// Path to the Thrift file.
+	File string `json:"file"`
+
+	// Range of the Thrift file in which the problem was found. Its start is
+	// zero if the position is unknown.
+	Range diagnosticRange `json:"range"`
+
+	Severity string `json:"severity"`
+
+	// Code identifies the kind of problem. This is "parse" for syntax errors,
+	// "compile" for other errors, and the name of the rule which found the
+	// problem for lint and plugin problems. Problems reported by plugins
+	// without a rule use "plugin".
+	Code string `json:"code"`
+
+	Message string `json:"message"`
+
+	// Suggested fix for the problem, if any.
+	Fix string `json:"fix,omitempty"`
+}
+
+// diagnosticRange is a range of a Thrift file. Only the start of the range
+// is known for now.
+type diagnosticRange struct {
+	Start diagnosticPosition `json:"start"`
+}
+
+// diagnosticPosition is a position in a Thrift file. Lines and columns
+// start at 1.
+type diagnosticPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// parseDiagnosticsFormat parses the value of a --diagnostics option and
+// returns whether diagnostics are written as JSON.
+func parseDiagnosticsFormat(format string) (bool, error) {
+	switch format {
+	case "", "text":
+		return false, nil
+	case "json":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown diagnostics format %q: expected text or json", format)
+	}
+}
+
+// compileDiagnostics builds diagnostics for an error returned by
+// compile.Compile for the given file. Parse errors produce a diagnostic for
+// each problem found by the parser.
+func compileDiagnostics(file string, err error) []diagnostic {
+	path, pos, reason := compile.ErrorLocation(err)
+	if path == "" {
+		path = file
+	}
+
+	if perr, ok := reason.(*idl.ParseError); ok {
+		ds := make([]diagnostic, len(perr.Errors))
+		for i, e := range perr.Errors {
+			ds[i] = diagnostic{
+				File:     path,
+				Range:    diagnosticRange{Start: diagnosticPosition{Line: e.Pos.Line, Column: e.Pos.Column}},
+				Severity: severityError,
+				Code:     "parse",
+				Message:  e.Message,
+			}
+		}
+		return ds
+	}
+
+	return []diagnostic{{
+		File:     path,
+		Range:    diagnosticRange{Start: diagnosticPosition{Line: pos.Line, Column: pos.Column}},
+		Severity: severityError,
+		Code:     "compile",
+		Message:  reason.Error(),
+	}}
+}
+
+// problemDiagnostics builds diagnostics with the given severity for
+// problems found by the linter or by plugins.
+func problemDiagnostics(severity string, problems []lint.Problem) []diagnostic {
+	ds := make([]diagnostic, len(problems))
+	for i, p := range problems {
+		code := p.Rule
+		if code == "" {
+			code = "plugin"
+		}
+		ds[i] = diagnostic{
+			File:     p.File,
+			Range:    diagnosticRange{Start: diagnosticPosition{Line: p.Line, Column: p.Column}},
+			Severity: severity,
+			Code:     code,
+			Message:  p.Message,
+			Fix:      p.Fix,
+		}
+	}
+	return ds
+}
+
+// writeDiagnostics writes the given diagnostics to out as a JSON object
+// with a "diagnostics" list.
+func writeDiagnostics(out io.Writer, ds []diagnostic) error {
+	if ds == nil {
+		ds = []diagnostic{}
+	}
+	b, err := json.MarshalIndent(struct {
+		Diagnostics []diagnostic `json:"diagnostics"`
+	}{Diagnostics: ds}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(b, '\n'))
+	return err
+}
+
+// reportDiagnostics writes the given diagnostics to out as JSON and returns
+// an error summarizing them.
+func reportDiagnostics(out io.Writer, ds []diagnostic) error {
+	if err := writeDiagnostics(out, ds); err != nil {
+		return err
+	}
+	return fmt.Errorf("found %d problem(s)", len(ds))
+}
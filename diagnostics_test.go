@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestParseDiagnosticsFormat(t *testing.T) {
+	for _, format := range []string{"", "text"} {
+		json, err := parseDiagnosticsFormat(format)
+		assert.NoError(t, err, format)
+		assert.False(t, json, format)
+	}
+
+	json, err := parseDiagnosticsFormat("json")
+	assert.NoError(t, err)
+	assert.True(t, json)
+
+	_, err = parseDiagnosticsFormat("xml")
+	assert.EqualError(t, err, `unknown diagnostics format "xml": expected text or json`)
+}
+
+func TestCompileDiagnostics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-diagnostics")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(
+		"struct Foo {\n"+
+			"  1: optional Bar bar\n"+
+			"}\n"), 0644))
+
+	_, err = compile.Compile(path)
+	require.Error(t, err)
+
+	assert.Equal(t, []diagnostic{{
+		File:     path,
+		Range:    diagnosticRange{Start: diagnosticPosition{Line: 2, Column: 15}},
+		Severity: severityError,
+		Code:     "compile",
+		Message: `cannot compile "Foo": could not resolve reference "Bar" on line 2 ` +
+			`in "foo": unknown identifier "Bar"`,
+	}}, compileDiagnostics(path, err))
+}
+
+func TestWriteDiagnosticsEmpty(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, writeDiagnostics(&out, nil))
+	assert.JSONEq(t, `{"diagnostics": []}`, out.String())
+}
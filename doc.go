@@ -0,0 +1,88 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+
+	"go.uber.org/thriftrw/apidoc"
+	"go.uber.org/thriftrw/compile"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type docOptions struct {
+	Format     string `short:"f" long:"format" value-name:"FORMAT" default:"markdown" description:"Format of the generated documentation. Must be one of markdown or html."`
+	OutputFile string `short:"o" long:"output" value-name:"FILE" description:"Write the documentation to FILE instead of printing it."`
+}
+
+// runDoc generates documentation for the Thrift file in args and all the
+// files it includes. The documentation is written to out unless the
+// --output option was provided.
+func runDoc(args []string, out io.Writer) error {
+	var opts docOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw doc"
+	parser.Usage = "[OPTIONS] FILE"
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) != 1 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	format, err := apidoc.ParseFormat(opts.Format)
+	if err != nil {
+		return err
+	}
+
+	module, err := compile.Compile(files[0])
+	if err != nil {
+		return err
+	}
+
+	if opts.OutputFile == "" {
+		return apidoc.Write(out, module, format)
+	}
+
+	f, err := os.Create(opts.OutputFile)
+	if err != nil {
+		return err
+	}
+
+	if err := apidoc.Write(f, module, format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,309 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dump
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Value writes a readable representation of v to w.
+//
+// spec is the type of the value, or nil if it's unknown. Parts of the value
+// which don't match spec are written as if their type was unknown.
+func Value(w io.Writer, v wire.Value, spec compile.TypeSpec) error {
+	p := printer{w: w}
+	p.printf("%v ", typeName(v))
+	p.value(v, spec)
+	p.printf("\n")
+	return p.err
+}
+
+// Envelope writes a readable representation of e to w.
+//
+// spec is the type of the enveloped value, or nil if it's unknown.
+func Envelope(w io.Writer, e wire.Envelope, spec compile.TypeSpec) error {
+	p := printer{w: w}
+	p.printf("%v %q (seqid: %d)\n", e.Type, e.Name, e.SeqID)
+	p.printf("%v ", typeName(e.Value))
+	p.value(e.Value, spec)
+	p.printf("\n")
+	return p.err
+}
+
+// printer writes values to a writer, keeping track of the first error.
+type printer struct {
+	w      io.Writer
+	indent int
+	err    error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+// startLine writes the indentation for a new line.
+func (p *printer) startLine() {
+	p.printf("%s", strings.Repeat("  ", p.indent))
+}
+
+// value writes v starting at the current position. Containers are written
+// over multiple lines, ending at the closing bracket.
+func (p *printer) value(v wire.Value, spec compile.TypeSpec) {
+	spec = matchSpec(v.Type(), spec)
+
+	switch v.Type() {
+	case wire.TBool:
+		p.printf("%v", v.GetBool())
+	case wire.TI8:
+		p.printf("%d", v.GetI8())
+	case wire.TDouble:
+		p.printf("%v", strconv.FormatFloat(v.GetDouble(), 'g', -1, 64))
+	case wire.TI16:
+		p.printf("%d", v.GetI16())
+	case wire.TI32:
+		p.printf("%d", v.GetI32())
+		if enum, ok := spec.(*compile.EnumSpec); ok {
+			for _, item := range enum.Items {
+				if item.Value == v.GetI32() {
+					p.printf(" (%v)", item.Name)
+					break
+				}
+			}
+		}
+	case wire.TI64:
+		p.printf("%d", v.GetI64())
+	case wire.TBinary:
+		p.binary(v.GetBinary(), spec)
+	case wire.TStruct:
+		p.structure(v.GetStruct(), spec)
+	case wire.TMap:
+		p.mapItems(v.GetMap(), spec)
+	case wire.TSet:
+		var valueSpec compile.TypeSpec
+		if s, ok := spec.(*compile.SetSpec); ok {
+			valueSpec = s.ValueSpec
+		}
+		p.list(v.GetSet(), valueSpec)
+	case wire.TList:
+		var valueSpec compile.TypeSpec
+		if l, ok := spec.(*compile.ListSpec); ok {
+			valueSpec = l.ValueSpec
+		}
+		p.list(v.GetList(), valueSpec)
+	default:
+		p.printf("<unknown type %v>", v.Type())
+	}
+}
+
+// binary writes b as a quoted string if it's a string or looks like one,
+// and in hexadecimal otherwise.
+func (p *printer) binary(b []byte, spec compile.TypeSpec) {
+	_, isString := spec.(*compile.StringSpec)
+	_, isBinary := spec.(*compile.BinarySpec)
+	if isString || (!isBinary && isText(b)) {
+		p.printf("%q", b)
+		return
+	}
+
+	if len(b) == 0 {
+		p.printf(`""`)
+		return
+	}
+	p.printf("0x%v", hex.EncodeToString(b))
+}
+
+func (p *printer) structure(s wire.Struct, spec compile.TypeSpec) {
+	if len(s.Fields) == 0 {
+		p.printf("{}")
+		return
+	}
+
+	var fields compile.FieldGroup
+	if s, ok := spec.(*compile.StructSpec); ok {
+		fields = s.Fields
+	}
+
+	p.printf("{\n")
+	p.indent++
+	for _, f := range s.Fields {
+		p.startLine()
+
+		var fieldSpec compile.TypeSpec
+		if field := findField(fields, f.ID); field != nil {
+			p.printf("%d %v: ", f.ID, field.Name)
+			fieldSpec = field.Type
+		} else {
+			p.printf("%d: ", f.ID)
+		}
+
+		p.printf("%v ", typeName(f.Value))
+		p.value(f.Value, fieldSpec)
+		p.printf("\n")
+	}
+	p.indent--
+	p.startLine()
+	p.printf("}")
+}
+
+func (p *printer) mapItems(m wire.MapItemList, spec compile.TypeSpec) {
+	defer m.Close()
+	if m.Size() == 0 {
+		p.printf("{}")
+		return
+	}
+
+	var keySpec, valueSpec compile.TypeSpec
+	if s, ok := spec.(*compile.MapSpec); ok {
+		keySpec, valueSpec = s.KeySpec, s.ValueSpec
+	}
+
+	p.printf("{\n")
+	p.indent++
+	err := m.ForEach(func(item wire.MapItem) error {
+		p.startLine()
+		p.value(item.Key, keySpec)
+		p.printf(": ")
+		p.value(item.Value, valueSpec)
+		p.printf("\n")
+		return p.err
+	})
+	if p.err == nil {
+		p.err = err
+	}
+	p.indent--
+	p.startLine()
+	p.printf("}")
+}
+
+func (p *printer) list(l wire.ValueList, valueSpec compile.TypeSpec) {
+	defer l.Close()
+	if l.Size() == 0 {
+		p.printf("[]")
+		return
+	}
+
+	p.printf("[\n")
+	p.indent++
+	err := l.ForEach(func(v wire.Value) error {
+		p.startLine()
+		p.value(v, valueSpec)
+		p.printf("\n")
+		return p.err
+	})
+	if p.err == nil {
+		p.err = err
+	}
+	p.indent--
+	p.startLine()
+	p.printf("]")
+}
+
+// typeName returns the name of the wire type of v. Containers include the
+// types of their items.
+func typeName(v wire.Value) string {
+	switch v.Type() {
+	case wire.TMap:
+		m := v.GetMap()
+		return fmt.Sprintf("map<%v, %v>", wireTypeName(m.KeyType()), wireTypeName(m.ValueType()))
+	case wire.TSet:
+		return fmt.Sprintf("set<%v>", wireTypeName(v.GetSet().ValueType()))
+	case wire.TList:
+		return fmt.Sprintf("list<%v>", wireTypeName(v.GetList().ValueType()))
+	default:
+		return wireTypeName(v.Type())
+	}
+}
+
+func wireTypeName(t wire.Type) string {
+	switch t {
+	case wire.TBool:
+		return "bool"
+	case wire.TI8:
+		return "i8"
+	case wire.TDouble:
+		return "double"
+	case wire.TI16:
+		return "i16"
+	case wire.TI32:
+		return "i32"
+	case wire.TI64:
+		return "i64"
+	case wire.TBinary:
+		return "binary"
+	case wire.TStruct:
+		return "struct"
+	case wire.TMap:
+		return "map"
+	case wire.TSet:
+		return "set"
+	case wire.TList:
+		return "list"
+	default:
+		return t.String()
+	}
+}
+
+// matchSpec returns the root of spec if it matches the wire type t, and nil
+// otherwise.
+func matchSpec(t wire.Type, spec compile.TypeSpec) compile.TypeSpec {
+	if spec == nil {
+		return nil
+	}
+	spec = compile.RootTypeSpec(spec)
+	if spec.TypeCode() != t {
+		return nil
+	}
+	return spec
+}
+
+func findField(fields compile.FieldGroup, id int16) *compile.FieldSpec {
+	for _, f := range fields {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+// isText returns true if b is valid UTF-8 made up of printable characters
+// and whitespace.
+func isText(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dump
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/idltest"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleValue() wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueI32(2)},
+		{ID: 3, Value: wire.NewValueBinary([]byte{0x00, 0xff})},
+		{ID: 4, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueDouble(1.5)},
+			}}),
+			wire.NewValueStruct(wire.Struct{}),
+		}))},
+		{ID: 5, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TBool, []wire.MapItem{
+			{Key: wire.NewValueString("a"), Value: wire.NewValueBool(true)},
+		}))},
+		{ID: 6, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI64, nil))},
+		{ID: 7, Value: wire.NewValueI64(-1)},
+		{ID: 8, Value: wire.NewValueString("bar")},
+	}})
+}
+
+func TestValue(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Value(&buf, sampleValue(), nil))
+	assert.Equal(t, strings.Join([]string{
+		`struct {`,
+		`  1: binary "foo"`,
+		`  2: i32 2`,
+		`  3: binary 0x00ff`,
+		`  4: list<struct> [`,
+		`    {`,
+		`      1: double 1.5`,
+		`    }`,
+		`    {}`,
+		`  ]`,
+		`  5: map<binary, bool> {`,
+		`    "a": true`,
+		`  }`,
+		`  6: set<i64> []`,
+		`  7: i64 -1`,
+		`  8: binary "bar"`,
+		`}`,
+		``,
+	}, "\n"), buf.String())
+}
+
+func TestValueWithSpec(t *testing.T) {
+	m := idltest.Compile(t, map[string]string{"test.thrift": `
+		enum Role { User, Admin }
+
+		struct Point {
+			1: required double x
+		}
+
+		typedef list<Point> Points
+
+		struct Request {
+			1: required string name
+			2: optional Role role
+			3: optional binary data
+			4: optional Points points
+			5: optional map<string, bool> flags
+			// Fields 6 and 7 are unknown.
+			8: optional i32 mismatched
+		}
+	`}, "test.thrift")
+	spec, err := m.LookupType("Request")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, Value(&buf, sampleValue(), spec))
+	assert.Equal(t, strings.Join([]string{
+		`struct {`,
+		`  1 name: binary "foo"`,
+		`  2 role: i32 2`,
+		`  3 data: binary 0x00ff`,
+		`  4 points: list<struct> [`,
+		`    {`,
+		`      1 x: double 1.5`,
+		`    }`,
+		`    {}`,
+		`  ]`,
+		`  5 flags: map<binary, bool> {`,
+		`    "a": true`,
+		`  }`,
+		`  6: set<i64> []`,
+		`  7: i64 -1`,
+		`  8 mismatched: binary "bar"`,
+		`}`,
+		``,
+	}, "\n"), buf.String())
+
+	t.Run("enum", func(t *testing.T) {
+		v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 2, Value: wire.NewValueI32(1)},
+		}})
+
+		var buf bytes.Buffer
+		require.NoError(t, Value(&buf, v, spec))
+		assert.Equal(t, "struct {\n  2 role: i32 1 (Admin)\n}\n", buf.String())
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 3, Value: wire.NewValueBinary([]byte("abc"))},
+		}})
+
+		var buf bytes.Buffer
+		require.NoError(t, Value(&buf, v, spec))
+		assert.Equal(t, "struct {\n  3 data: binary 0x616263\n}\n", buf.String())
+	})
+}
+
+func TestEnvelope(t *testing.T) {
+	e := wire.Envelope{
+		Name:  "getValue",
+		Type:  wire.Call,
+		SeqID: 42,
+		Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("key")},
+		}}),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Envelope(&buf, e, nil))
+	assert.Equal(t, strings.Join([]string{
+		`Call "getValue" (seqid: 42)`,
+		`struct {`,
+		`  1: binary "key"`,
+		`}`,
+		``,
+	}, "\n"), buf.String())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("great sadness")
+}
+
+func TestValueWriteError(t *testing.T) {
+	err := Value(failingWriter{}, sampleValue(), nil)
+	assert.EqualError(t, err, "great sadness")
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dynamic operates on Thrift structs generically, based on
+// descriptions of their types which are available at runtime.
+//
+// Code generated with --descriptors describes each struct with a
+// StructType, which is returned by its ThriftDescriptor method. A StructValue
+// holds the values of the fields of such a type without a generated Go
+// type, so that generic middleware, diffing tools, and scripts may build,
+// inspect, and serialize values of any struct.
+//
+// 	s, err := dynamic.ValueOf(user)
+// 	if err != nil {
+// 		return err
+// 	}
+// 	name, _ := s.Get("name")
+//
+// Values of fields are represented with the following Go types.
+//
+// 	bool     bool
+// 	byte     int8
+// 	i16      int16
+// 	i32      int32 (also used for enums)
+// 	i64      int64
+// 	double   float64
+// 	string   string
+// 	binary   []byte
+// 	struct   *StructValue
+// 	list<T>  []interface{}
+// 	set<T>   []interface{}
+// 	map<K,V> []MapItem
+package dynamic
+
+import (
+	"fmt"
+	"sync"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Kind identifies the Thrift type of a value.
+type Kind int
+
+// Kinds of Thrift types. Enums are described as I32 and typedefs as the
+// type they refer to.
+const (
+	Bool Kind = iota + 1
+	I8
+	I16
+	I32
+	I64
+	Double
+	String
+	Binary
+	Struct
+	Map
+	Set
+	List
+)
+
+var kindNames = map[Kind]string{
+	Bool:   "bool",
+	I8:     "byte",
+	I16:    "i16",
+	I32:    "i32",
+	I64:    "i64",
+	Double: "double",
+	String: "string",
+	Binary: "binary",
+	Struct: "struct",
+	Map:    "map",
+	Set:    "set",
+	List:   "list",
+}
+
+func (k Kind) String() string {
+	if s, ok := kindNames[k]; ok {
+		return s
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// WireType returns the type with which values of this kind are sent over
+// the wire.
+func (k Kind) WireType() wire.Type {
+	switch k {
+	case Bool:
+		return wire.TBool
+	case I8:
+		return wire.TI8
+	case I16:
+		return wire.TI16
+	case I32:
+		return wire.TI32
+	case I64:
+		return wire.TI64
+	case Double:
+		return wire.TDouble
+	case String, Binary:
+		return wire.TBinary
+	case Struct:
+		return wire.TStruct
+	case Map:
+		return wire.TMap
+	case Set:
+		return wire.TSet
+	case List:
+		return wire.TList
+	default:
+		return wire.Type(0)
+	}
+}
+
+// Type describes a Thrift type.
+type Type struct {
+	Kind Kind
+
+	// Key is the type of the keys of maps.
+	Key *Type
+
+	// Value is the type of the values of maps and of the items of sets and
+	// lists.
+	Value *Type
+
+	// Struct describes the fields of structs.
+	Struct *StructType
+}
+
+func (t *Type) String() string {
+	switch t.Kind {
+	case Struct:
+		return t.Struct.Name
+	case Map:
+		return fmt.Sprintf("map<%v, %v>", t.Key, t.Value)
+	case Set, List:
+		return fmt.Sprintf("%v<%v>", t.Kind, t.Value)
+	default:
+		return t.Kind.String()
+	}
+}
+
+// Field describes a field of a struct.
+type Field struct {
+	ID   int16
+	Name string // name of the field in the Thrift file
+
+	// Required fields must be set for the struct to be serialized.
+	Required bool
+
+	Type Type
+}
+
+// StructType describes a struct, union, or exception.
+type StructType struct {
+	Name string // name of the struct in the Thrift file
+
+	// If IsUnion is set, exactly one field must be set. If AllowEmptyUnion
+	// is also set, at most one field must be set.
+	IsUnion         bool
+	AllowEmptyUnion bool
+
+	IsException bool
+
+	// Fields of the struct in the order in which they were declared.
+	//
+	// Generated code populates this in an init function because fields may
+	// refer back to the struct.
+	Fields []Field
+
+	indexOnce sync.Once
+	byID      map[int16]int
+	byName    map[string]int
+}
+
+func (s *StructType) buildIndex() {
+	s.byID = make(map[int16]int, len(s.Fields))
+	s.byName = make(map[string]int, len(s.Fields))
+	for i, f := range s.Fields {
+		s.byID[f.ID] = i
+		s.byName[f.Name] = i
+	}
+}
+
+// FieldByID returns the field of this struct with the given ID, or nil if
+// there isn't one.
+func (s *StructType) FieldByID(id int16) *Field {
+	s.indexOnce.Do(s.buildIndex)
+	if i, ok := s.byID[id]; ok {
+		return &s.Fields[i]
+	}
+	return nil
+}
+
+// FieldByName returns the field of this struct with the given name as
+// written in the Thrift file, or nil if there isn't one.
+func (s *StructType) FieldByName(name string) *Field {
+	s.indexOnce.Do(s.buildIndex)
+	if i, ok := s.byName[name]; ok {
+		return &s.Fields[i]
+	}
+	return nil
+}
+
+// Describer is implemented by generated structs which describe their type
+// at runtime.
+type Describer interface {
+	ThriftDescriptor() *StructType
+}
+
+// Describe returns the type of the given value if it's a generated struct
+// which describes itself.
+func Describe(v interface{}) (*StructType, bool) {
+	d, ok := v.(Describer)
+	if !ok {
+		return nil, false
+	}
+	return d.ThriftDescriptor(), true
+}
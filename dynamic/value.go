@@ -0,0 +1,407 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamic
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// MapItem is a key-value pair of a map.
+type MapItem struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// StructValue holds the values of the fields of a struct. The types of
+// values are listed in the package documentation.
+type StructValue struct {
+	Type *StructType
+
+	values map[int16]interface{}
+}
+
+// New builds an empty value of the given struct.
+func New(t *StructType) *StructValue {
+	return &StructValue{Type: t, values: make(map[int16]interface{})}
+}
+
+// Get returns the value of the field with the given name and whether it's
+// set.
+func (s *StructValue) Get(name string) (interface{}, bool) {
+	f := s.Type.FieldByName(name)
+	if f == nil {
+		return nil, false
+	}
+	v, ok := s.values[f.ID]
+	return v, ok
+}
+
+// Set sets the field with the given name to the given value. It fails if
+// the struct doesn't have such a field or if the value doesn't match the
+// type of the field.
+func (s *StructValue) Set(name string, v interface{}) error {
+	f := s.Type.FieldByName(name)
+	if f == nil {
+		return fmt.Errorf("%v does not have a field %q", s.Type.Name, name)
+	}
+	if err := check(&f.Type, v); err != nil {
+		return fmt.Errorf("cannot set field %q of %v: %v", name, s.Type.Name, err)
+	}
+	s.values[f.ID] = v
+	return nil
+}
+
+// Unset clears the field with the given name.
+func (s *StructValue) Unset(name string) {
+	if f := s.Type.FieldByName(name); f != nil {
+		delete(s.values, f.ID)
+	}
+}
+
+// ForEach calls the given function with the fields of this struct which
+// are set and their values, in the order in which the fields were
+// declared. Iteration stops at the first error, which is returned.
+func (s *StructValue) ForEach(fn func(*Field, interface{}) error) error {
+	for i := range s.Type.Fields {
+		f := &s.Type.Fields[i]
+		if v, ok := s.values[f.ID]; ok {
+			if err := fn(f, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ToWire translates this struct into a Thrift-level intermediate
+// representation. It fails if required fields are missing or, for unions,
+// if the wrong number of fields is set.
+func (s *StructValue) ToWire() (wire.Value, error) {
+	fields := make([]wire.Field, 0, len(s.values))
+	for _, f := range s.Type.Fields {
+		v, ok := s.values[f.ID]
+		if !ok {
+			if f.Required {
+				return wire.Value{}, s.missingField(f)
+			}
+			continue
+		}
+
+		w, err := toWire(&f.Type, v)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		fields = append(fields, wire.Field{ID: f.ID, Value: w})
+	}
+
+	if err := s.checkUnion(len(fields)); err != nil {
+		return wire.Value{}, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// FromWire reads a value of the given struct from its Thrift-level
+// representation. Unrecognized fields and fields with unexpected types are
+// ignored.
+func FromWire(t *StructType, w wire.Value) (*StructValue, error) {
+	if w.Type() != wire.TStruct {
+		return nil, fmt.Errorf("cannot read %v from a %v", t.Name, w.Type())
+	}
+
+	s := New(t)
+	for _, field := range w.GetStruct().Fields {
+		f := t.FieldByID(field.ID)
+		if f == nil || field.Value.Type() != f.Type.Kind.WireType() {
+			continue
+		}
+
+		v, err := fromWire(&f.Type, field.Value)
+		if err != nil {
+			return nil, err
+		}
+		s.values[f.ID] = v
+	}
+
+	for _, f := range t.Fields {
+		if _, ok := s.values[f.ID]; f.Required && !ok {
+			return nil, s.missingField(f)
+		}
+	}
+	return s, s.checkUnion(len(s.values))
+}
+
+// Wire is implemented by generated structs.
+type Wire interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+}
+
+// ValueOf returns a StructValue which holds the same values as the given
+// generated struct. The code for the struct must have been generated with
+// --descriptors.
+func ValueOf(v Wire) (*StructValue, error) {
+	t, ok := Describe(v)
+	if !ok {
+		return nil, fmt.Errorf("%T does not describe its type: "+
+			"its code must be generated with --descriptors", v)
+	}
+
+	w, err := v.ToWire()
+	if err != nil {
+		return nil, err
+	}
+	return FromWire(t, w)
+}
+
+// CopyTo copies the values of this struct into the given generated struct,
+// which must describe the same Thrift type if it describes its type.
+func (s *StructValue) CopyTo(v Wire) error {
+	if t, ok := Describe(v); ok && t != s.Type {
+		return fmt.Errorf("cannot copy %v into %T: it holds a %v", s.Type.Name, v, t.Name)
+	}
+
+	w, err := s.ToWire()
+	if err != nil {
+		return err
+	}
+	return v.FromWire(w)
+}
+
+// String returns a readable representation of this struct, in the same
+// format as the String methods of generated structs.
+func (s *StructValue) String() string {
+	if s == nil {
+		return "<nil>"
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(s.Type.Name)
+	buf.WriteByte('{')
+	i := 0
+	_ = s.ForEach(func(f *Field, v interface{}) error {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		i++
+		fmt.Fprintf(&buf, "%v: %v", f.Name, v)
+		return nil
+	})
+	buf.WriteByte('}')
+	return buf.String()
+}
+
+func (s *StructValue) missingField(f Field) error {
+	return errors.New("field " + f.Name + " of " + s.Type.Name + " is required")
+}
+
+func (s *StructValue) checkUnion(count int) error {
+	t := s.Type
+	if !t.IsUnion || len(t.Fields) == 0 {
+		return nil
+	}
+
+	if t.AllowEmptyUnion {
+		if count > 1 {
+			return fmt.Errorf("%v should have at most one field: got %v fields", t.Name, count)
+		}
+	} else if count != 1 {
+		return fmt.Errorf("%v should have exactly one field: got %v fields", t.Name, count)
+	}
+	return nil
+}
+
+// check verifies that the given value is represented with the Go type used
+// for values of the given Thrift type.
+func check(t *Type, v interface{}) error {
+	ok := true
+	switch t.Kind {
+	case Bool:
+		_, ok = v.(bool)
+	case I8:
+		_, ok = v.(int8)
+	case I16:
+		_, ok = v.(int16)
+	case I32:
+		_, ok = v.(int32)
+	case I64:
+		_, ok = v.(int64)
+	case Double:
+		_, ok = v.(float64)
+	case String:
+		_, ok = v.(string)
+	case Binary:
+		_, ok = v.([]byte)
+	case Struct:
+		var s *StructValue
+		s, ok = v.(*StructValue)
+		if ok && s == nil {
+			return fmt.Errorf("expected a %v, got nil", t)
+		}
+		if ok && s.Type != t.Struct {
+			return fmt.Errorf("expected a %v, got a %v", t, s.Type.Name)
+		}
+	case Set, List:
+		var items []interface{}
+		items, ok = v.([]interface{})
+		for _, item := range items {
+			if err := check(t.Value, item); err != nil {
+				return err
+			}
+		}
+	case Map:
+		var items []MapItem
+		items, ok = v.([]MapItem)
+		for _, item := range items {
+			if err := check(t.Key, item.Key); err != nil {
+				return err
+			}
+			if err := check(t.Value, item.Value); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unknown kind %v", t.Kind)
+	}
+
+	if !ok {
+		return fmt.Errorf("expected a %v, got %T", t, v)
+	}
+	return nil
+}
+
+func toWire(t *Type, v interface{}) (wire.Value, error) {
+	if err := check(t, v); err != nil {
+		return wire.Value{}, err
+	}
+
+	switch t.Kind {
+	case Bool:
+		return wire.NewValueBool(v.(bool)), nil
+	case I8:
+		return wire.NewValueI8(v.(int8)), nil
+	case I16:
+		return wire.NewValueI16(v.(int16)), nil
+	case I32:
+		return wire.NewValueI32(v.(int32)), nil
+	case I64:
+		return wire.NewValueI64(v.(int64)), nil
+	case Double:
+		return wire.NewValueDouble(v.(float64)), nil
+	case String:
+		return wire.NewValueString(v.(string)), nil
+	case Binary:
+		return wire.NewValueBinary(v.([]byte)), nil
+	case Struct:
+		return v.(*StructValue).ToWire()
+	case Set, List:
+		items := v.([]interface{})
+		ws := make([]wire.Value, len(items))
+		for i, item := range items {
+			w, err := toWire(t.Value, item)
+			if err != nil {
+				return wire.Value{}, err
+			}
+			ws[i] = w
+		}
+		l := wire.ValueListFromSlice(t.Value.Kind.WireType(), ws)
+		if t.Kind == Set {
+			return wire.NewValueSet(l), nil
+		}
+		return wire.NewValueList(l), nil
+	default: // Map
+		items := v.([]MapItem)
+		ws := make([]wire.MapItem, len(items))
+		for i, item := range items {
+			k, err := toWire(t.Key, item.Key)
+			if err != nil {
+				return wire.Value{}, err
+			}
+			v, err := toWire(t.Value, item.Value)
+			if err != nil {
+				return wire.Value{}, err
+			}
+			ws[i] = wire.MapItem{Key: k, Value: v}
+		}
+		return wire.NewValueMap(wire.MapItemListFromSlice(
+			t.Key.Kind.WireType(), t.Value.Kind.WireType(), ws)), nil
+	}
+}
+
+func fromWire(t *Type, w wire.Value) (interface{}, error) {
+	if w.Type() != t.Kind.WireType() {
+		return nil, fmt.Errorf("expected a %v, got a %v", t, w.Type())
+	}
+
+	switch t.Kind {
+	case Bool:
+		return w.GetBool(), nil
+	case I8:
+		return w.GetI8(), nil
+	case I16:
+		return w.GetI16(), nil
+	case I32:
+		return w.GetI32(), nil
+	case I64:
+		return w.GetI64(), nil
+	case Double:
+		return w.GetDouble(), nil
+	case String:
+		return w.GetString(), nil
+	case Binary:
+		return w.GetBinary(), nil
+	case Struct:
+		return FromWire(t.Struct, w)
+	case Set, List:
+		l := w.GetList()
+		if t.Kind == Set {
+			l = w.GetSet()
+		}
+		items := make([]interface{}, 0, l.Size())
+		err := l.ForEach(func(w wire.Value) error {
+			v, err := fromWire(t.Value, w)
+			if err == nil {
+				items = append(items, v)
+			}
+			return err
+		})
+		return items, err
+	default: // Map
+		m := w.GetMap()
+		items := make([]MapItem, 0, m.Size())
+		err := m.ForEach(func(w wire.MapItem) error {
+			k, err := fromWire(t.Key, w.Key)
+			if err != nil {
+				return err
+			}
+			v, err := fromWire(t.Value, w.Value)
+			if err != nil {
+				return err
+			}
+			items = append(items, MapItem{Key: k, Value: v})
+			return nil
+		})
+		return items, err
+	}
+}
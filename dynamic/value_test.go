@@ -0,0 +1,275 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/wire"
+)
+
+var (
+	nodeType = &StructType{Name: "Node"}
+	idType   = &StructType{
+		Name:    "ID",
+		IsUnion: true,
+		Fields: []Field{
+			{ID: 1, Name: "num", Type: Type{Kind: I64}},
+			{ID: 2, Name: "uuid", Type: Type{Kind: Binary}},
+		},
+	}
+)
+
+func init() {
+	nodeType.Fields = []Field{
+		{ID: 1, Name: "name", Required: true, Type: Type{Kind: String}},
+		{ID: 2, Name: "weight", Type: Type{Kind: Double}},
+		{ID: 3, Name: "children", Type: Type{Kind: List, Value: &Type{Kind: Struct, Struct: nodeType}}},
+		{ID: 4, Name: "tags", Type: Type{Kind: Set, Value: &Type{Kind: String}}},
+		{ID: 5, Name: "counts", Type: Type{Kind: Map, Key: &Type{Kind: I8}, Value: &Type{Kind: I16}}},
+		{ID: 6, Name: "id", Type: Type{Kind: Struct, Struct: idType}},
+		{ID: 7, Name: "hidden", Type: Type{Kind: Bool}},
+		{ID: 8, Name: "level", Type: Type{Kind: I32}},
+	}
+}
+
+func newNode(t *testing.T, fields map[string]interface{}) *StructValue {
+	s := New(nodeType)
+	for name, v := range fields {
+		require.NoError(t, s.Set(name, v), "failed to set %q", name)
+	}
+	return s
+}
+
+func TestStructValueRoundTrip(t *testing.T) {
+	id := New(idType)
+	require.NoError(t, id.Set("num", int64(42)))
+
+	node := newNode(t, map[string]interface{}{
+		"name":   "root",
+		"weight": 1.5,
+		"children": []interface{}{
+			newNode(t, map[string]interface{}{"name": "leaf"}),
+		},
+		"tags":   []interface{}{"a", "b"},
+		"counts": []MapItem{{Key: int8(1), Value: int16(2)}},
+		"id":     id,
+		"hidden": true,
+		"level":  int32(3),
+	})
+
+	w, err := node.ToWire()
+	require.NoError(t, err)
+
+	assert.Equal(t, wire.TStruct, w.Type())
+	assert.Len(t, w.GetStruct().Fields, 8)
+
+	got, err := FromWire(nodeType, w)
+	require.NoError(t, err)
+	assert.Equal(t, node.String(), got.String())
+
+	name, ok := got.Get("name")
+	assert.True(t, ok)
+	assert.Equal(t, "root", name)
+
+	children, ok := got.Get("children")
+	require.True(t, ok)
+	require.Len(t, children, 1)
+	child := children.([]interface{})[0].(*StructValue)
+	assert.Equal(t, "Node{name: leaf}", child.String())
+
+	_, ok = child.Get("weight")
+	assert.False(t, ok, "weight must not be set")
+}
+
+func TestStructValueSet(t *testing.T) {
+	tests := []struct {
+		desc    string
+		field   string
+		value   interface{}
+		wantErr string
+	}{
+		{
+			desc:    "unknown field",
+			field:   "color",
+			value:   "red",
+			wantErr: `Node does not have a field "color"`,
+		},
+		{
+			desc:    "wrong type",
+			field:   "level",
+			value:   3,
+			wantErr: `cannot set field "level" of Node: expected a i32, got int`,
+		},
+		{
+			desc:    "wrong item type",
+			field:   "tags",
+			value:   []interface{}{"a", 1},
+			wantErr: "expected a string, got int",
+		},
+		{
+			desc:    "wrong map value type",
+			field:   "counts",
+			value:   []MapItem{{Key: int8(1), Value: "x"}},
+			wantErr: "expected a i16, got string",
+		},
+		{
+			desc:    "wrong struct",
+			field:   "id",
+			value:   New(nodeType),
+			wantErr: "expected a ID, got a Node",
+		},
+		{
+			desc:    "nil struct",
+			field:   "id",
+			value:   (*StructValue)(nil),
+			wantErr: "expected a ID, got nil",
+		},
+		{
+			desc:    "not a map",
+			field:   "counts",
+			value:   map[int8]int16{1: 2},
+			wantErr: "expected a map<byte, i16>, got map[int8]int16",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := New(nodeType).Set(tt.field, tt.value)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestStructValueUnset(t *testing.T) {
+	node := newNode(t, map[string]interface{}{"name": "foo", "level": int32(1)})
+	node.Unset("level")
+	node.Unset("unknown")
+
+	var names []string
+	require.NoError(t, node.ForEach(func(f *Field, v interface{}) error {
+		names = append(names, f.Name)
+		return nil
+	}))
+	assert.Equal(t, []string{"name"}, names)
+}
+
+func TestStructValueToWireErrors(t *testing.T) {
+	t.Run("missing required field", func(t *testing.T) {
+		_, err := New(nodeType).ToWire()
+		assert.EqualError(t, err, "field name of Node is required")
+	})
+
+	t.Run("empty union", func(t *testing.T) {
+		_, err := New(idType).ToWire()
+		assert.EqualError(t, err, "ID should have exactly one field: got 0 fields")
+	})
+}
+
+func TestFromWire(t *testing.T) {
+	tests := []struct {
+		desc    string
+		typ     *StructType
+		give    wire.Value
+		want    string
+		wantErr string
+	}{
+		{
+			desc: "unknown fields and mismatched types are ignored",
+			typ:  nodeType,
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+				{ID: 2, Value: wire.NewValueString("heavy")},
+				{ID: 42, Value: wire.NewValueI32(1)},
+			}}),
+			want: "Node{name: foo}",
+		},
+		{
+			desc:    "not a struct",
+			typ:     nodeType,
+			give:    wire.NewValueI32(1),
+			wantErr: "cannot read Node from a TI32",
+		},
+		{
+			desc:    "missing required field",
+			typ:     nodeType,
+			give:    wire.NewValueStruct(wire.Struct{}),
+			wantErr: "field name of Node is required",
+		},
+		{
+			desc: "too many union fields",
+			typ:  idType,
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueI64(1)},
+				{ID: 2, Value: wire.NewValueBinary([]byte("x"))},
+			}}),
+			wantErr: "ID should have exactly one field: got 2 fields",
+		},
+		{
+			desc: "list item of the wrong type",
+			typ:  nodeType,
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+				{ID: 3, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+					wire.NewValueI32(1),
+				}))},
+			}}),
+			wantErr: "expected a Node, got a TI32",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := FromWire(tt.typ, tt.give)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestStructTypeFields(t *testing.T) {
+	assert.Equal(t, "weight", nodeType.FieldByID(2).Name)
+	assert.Nil(t, nodeType.FieldByID(42))
+	assert.Equal(t, int16(3), nodeType.FieldByName("children").ID)
+	assert.Nil(t, nodeType.FieldByName("parent"))
+
+	assert.Equal(t, "list<Node>", nodeType.FieldByName("children").Type.String())
+	assert.Equal(t, "Kind(42)", Kind(42).String())
+}
+
+type undescribed struct{}
+
+func (undescribed) ToWire() (wire.Value, error) { return wire.Value{}, nil }
+func (undescribed) FromWire(wire.Value) error   { return nil }
+
+func TestValueOfUndescribed(t *testing.T) {
+	_, err := ValueOf(undescribed{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dynamic.undescribed does not describe its type")
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Request is an enveloped request to a Thrift function.
+type Request struct {
+	// Service is the name of the Thrift service which declares the
+	// function.
+	Service string
+
+	// Method is the method name of the function, as it appears in the
+	// envelope.
+	Method string
+
+	// Type is wire.Call for requests to functions which produce a response
+	// and wire.OneWay for requests to oneway functions.
+	Type wire.EnvelopeType
+
+	// Body is the Thrift-level representation of the arguments of the
+	// function.
+	Body wire.Value
+}
+
+// UnaryHandler handles enveloped requests on the server side.
+type UnaryHandler interface {
+	// Handle handles the given request and returns the body of the
+	// response to it. The response is ignored for oneway requests.
+	Handle(ctx context.Context, req *Request) (wire.Value, error)
+}
+
+// UnaryHandlerFunc is a UnaryHandler implemented as a function.
+type UnaryHandlerFunc func(context.Context, *Request) (wire.Value, error)
+
+// Handle calls f.
+func (f UnaryHandlerFunc) Handle(ctx context.Context, req *Request) (wire.Value, error) {
+	return f(ctx, req)
+}
+
+// UnaryInbound is middleware for requests received by a server.
+type UnaryInbound interface {
+	// Handle handles the given request. Implementations call h to pass the
+	// request on to the next middleware or the handler itself. They may
+	// instead reject the request by returning an error without calling h.
+	Handle(ctx context.Context, req *Request, h UnaryHandler) (wire.Value, error)
+}
+
+// UnaryInboundFunc is a UnaryInbound implemented as a function.
+type UnaryInboundFunc func(context.Context, *Request, UnaryHandler) (wire.Value, error)
+
+// Handle calls f.
+func (f UnaryInboundFunc) Handle(ctx context.Context, req *Request, h UnaryHandler) (wire.Value, error) {
+	return f(ctx, req, h)
+}
+
+// ApplyInbound returns a UnaryHandler which passes requests through the
+// given middleware, in order, before handling them with h.
+func ApplyInbound(h UnaryHandler, inbound ...UnaryInbound) UnaryHandler {
+	for i := len(inbound) - 1; i >= 0; i-- {
+		h = inboundHandler{mw: inbound[i], next: h}
+	}
+	return h
+}
+
+type inboundHandler struct {
+	mw   UnaryInbound
+	next UnaryHandler
+}
+
+func (h inboundHandler) Handle(ctx context.Context, req *Request) (wire.Value, error) {
+	return h.mw.Handle(ctx, req, h.next)
+}
+
+// UnaryCaller sends enveloped requests on the client side.
+type UnaryCaller interface {
+	// Call sends the given request and returns the body of the response to
+	// it. The response is empty for oneway requests.
+	Call(ctx context.Context, req *Request) (wire.Value, error)
+}
+
+// UnaryCallerFunc is a UnaryCaller implemented as a function.
+type UnaryCallerFunc func(context.Context, *Request) (wire.Value, error)
+
+// Call calls f.
+func (f UnaryCallerFunc) Call(ctx context.Context, req *Request) (wire.Value, error) {
+	return f(ctx, req)
+}
+
+// UnaryOutbound is middleware for requests sent by a client.
+type UnaryOutbound interface {
+	// Call sends the given request. Implementations call c to pass the
+	// request on to the next middleware or the client itself.
+	Call(ctx context.Context, req *Request, c UnaryCaller) (wire.Value, error)
+}
+
+// UnaryOutboundFunc is a UnaryOutbound implemented as a function.
+type UnaryOutboundFunc func(context.Context, *Request, UnaryCaller) (wire.Value, error)
+
+// Call calls f.
+func (f UnaryOutboundFunc) Call(ctx context.Context, req *Request, c UnaryCaller) (wire.Value, error) {
+	return f(ctx, req, c)
+}
+
+// ApplyOutbound returns a UnaryCaller which passes requests through the
+// given middleware, in order, before sending them with c.
+func ApplyOutbound(c UnaryCaller, outbound ...UnaryOutbound) UnaryCaller {
+	for i := len(outbound) - 1; i >= 0; i-- {
+		c = outboundCaller{mw: outbound[i], next: c}
+	}
+	return c
+}
+
+type outboundCaller struct {
+	mw   UnaryOutbound
+	next UnaryCaller
+}
+
+func (c outboundCaller) Call(ctx context.Context, req *Request) (wire.Value, error) {
+	return c.mw.Call(ctx, req, c.next)
+}
+
+// NewClient builds an rpc.Client which passes requests for the given
+// service through the given middleware before sending them with c.
+// Generated clients use it to apply the middleware they are built with.
+//
+// Requests to streaming functions are sent with c directly. c is returned
+// as-is if no middleware is given.
+func NewClient(service string, c rpc.Client, outbound ...UnaryOutbound) rpc.Client {
+	if len(outbound) == 0 {
+		return c
+	}
+
+	oc := outboundClient{service: service, c: c}
+	oc.caller = ApplyOutbound(UnaryCallerFunc(oc.send), outbound...)
+	return oc
+}
+
+type outboundClient struct {
+	service string
+	c       rpc.Client
+	caller  UnaryCaller
+}
+
+func (c outboundClient) send(ctx context.Context, req *Request) (wire.Value, error) {
+	if req.Type == wire.OneWay {
+		return wire.Value{}, c.c.CallOneway(ctx, req.Method, req.Body)
+	}
+	return c.c.Call(ctx, req.Method, req.Body)
+}
+
+func (c outboundClient) Call(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	return c.caller.Call(ctx, &Request{
+		Service: c.service,
+		Method:  method,
+		Type:    wire.Call,
+		Body:    body,
+	})
+}
+
+func (c outboundClient) CallOneway(ctx context.Context, method string, body wire.Value) error {
+	_, err := c.caller.Call(ctx, &Request{
+		Service: c.service,
+		Method:  method,
+		Type:    wire.OneWay,
+		Body:    body,
+	})
+	return err
+}
+
+func (c outboundClient) CallStream(ctx context.Context, method string, body wire.Value) (rpc.Stream, error) {
+	return c.c.CallStream(ctx, method, body)
+}
+
+// Recover is UnaryInbound middleware which turns panics in the handlers
+// that follow it into errors. rpc.Server sends these to the client as
+// internal errors instead of crashing the process.
+//
+// Place Recover first to recover from panics in other middleware too.
+var Recover UnaryInbound = UnaryInboundFunc(recoverInbound)
+
+func recoverInbound(ctx context.Context, req *Request, h UnaryHandler) (_ wire.Value, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = &PanicError{
+				Service: req.Service,
+				Method:  req.Method,
+				Value:   v,
+				Stack:   debug.Stack(),
+			}
+		}
+	}()
+	return h.Handle(ctx, req)
+}
+
+// PanicError is returned by Recover when a handler panics.
+type PanicError struct {
+	// Service and Method identify the request which caused the panic.
+	Service string
+	Method  string
+
+	// Value is the value the handler panicked with.
+	Value interface{}
+
+	// Stack is the stack trace of the goroutine at the time of the panic.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic while handling %v.%v: %v", e.Service, e.Method, e.Value)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestApplyInbound(t *testing.T) {
+	var calls []string
+	mw := func(name string) UnaryInbound {
+		return UnaryInboundFunc(func(ctx context.Context, req *Request, h UnaryHandler) (wire.Value, error) {
+			calls = append(calls, name+" before")
+			defer func() { calls = append(calls, name+" after") }()
+			return h.Handle(ctx, req)
+		})
+	}
+
+	h := ApplyInbound(UnaryHandlerFunc(func(ctx context.Context, req *Request) (wire.Value, error) {
+		calls = append(calls, "handler "+req.Method)
+		return wire.NewValueI32(42), nil
+	}), mw("a"), mw("b"))
+
+	res, err := h.Handle(context.Background(), &Request{Service: "Foo", Method: "bar", Type: wire.Call})
+	require.NoError(t, err)
+	assert.Equal(t, wire.NewValueI32(42), res)
+	assert.Equal(t, []string{"a before", "b before", "handler bar", "b after", "a after"}, calls)
+}
+
+func TestApplyOutbound(t *testing.T) {
+	var calls []string
+	mw := func(name string) UnaryOutbound {
+		return UnaryOutboundFunc(func(ctx context.Context, req *Request, c UnaryCaller) (wire.Value, error) {
+			calls = append(calls, name)
+			return c.Call(ctx, req)
+		})
+	}
+
+	c := ApplyOutbound(UnaryCallerFunc(func(ctx context.Context, req *Request) (wire.Value, error) {
+		calls = append(calls, "caller "+req.Method)
+		return wire.Value{}, errors.New("great sadness")
+	}), mw("a"), mw("b"))
+
+	_, err := c.Call(context.Background(), &Request{Service: "Foo", Method: "bar", Type: wire.Call})
+	assert.EqualError(t, err, "great sadness")
+	assert.Equal(t, []string{"a", "b", "caller bar"}, calls)
+}
+
+// fakeClient is an rpc.Client which records the requests sent through it.
+type fakeClient struct{ calls []string }
+
+func (c *fakeClient) Call(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	c.calls = append(c.calls, "call "+method)
+	return wire.NewValueString("ok"), nil
+}
+
+func (c *fakeClient) CallOneway(ctx context.Context, method string, body wire.Value) error {
+	c.calls = append(c.calls, "oneway "+method)
+	return nil
+}
+
+func (c *fakeClient) CallStream(ctx context.Context, method string, body wire.Value) (rpc.Stream, error) {
+	c.calls = append(c.calls, "stream "+method)
+	return nil, nil
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("no middleware", func(t *testing.T) {
+		var fake fakeClient
+		assert.Equal(t, &fake, NewClient("Foo", &fake))
+	})
+
+	var (
+		fake fakeClient
+		reqs []Request
+	)
+	c := NewClient("Foo", &fake, UnaryOutboundFunc(
+		func(ctx context.Context, req *Request, c UnaryCaller) (wire.Value, error) {
+			reqs = append(reqs, *req)
+			return c.Call(ctx, req)
+		}))
+	ctx := context.Background()
+
+	res, err := c.Call(ctx, "bar", wire.NewValueI32(1))
+	require.NoError(t, err)
+	assert.Equal(t, wire.NewValueString("ok"), res)
+
+	require.NoError(t, c.CallOneway(ctx, "baz", wire.NewValueI32(2)))
+
+	_, err = c.CallStream(ctx, "qux", wire.NewValueI32(3))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"call bar", "oneway baz", "stream qux"}, fake.calls)
+	assert.Equal(t, []Request{
+		{Service: "Foo", Method: "bar", Type: wire.Call, Body: wire.NewValueI32(1)},
+		{Service: "Foo", Method: "baz", Type: wire.OneWay, Body: wire.NewValueI32(2)},
+	}, reqs, "streaming requests must not pass through middleware")
+}
+
+func TestRecover(t *testing.T) {
+	h := ApplyInbound(UnaryHandlerFunc(func(ctx context.Context, req *Request) (wire.Value, error) {
+		panic("great sadness")
+	}), Recover)
+
+	_, err := h.Handle(context.Background(), &Request{Service: "Foo", Method: "bar"})
+	require.Error(t, err)
+	assert.EqualError(t, err, "panic while handling Foo.bar: great sadness")
+
+	perr, ok := err.(*PanicError)
+	require.True(t, ok, "expected a PanicError, got %T", err)
+	assert.Equal(t, "great sadness", perr.Value)
+	assert.NotEmpty(t, perr.Stack)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dispatch defines middleware which runs around the requests
+// dispatched by generated service handlers and clients.
+//
+// Middleware sees each request as the method name, message type, and body
+// of its envelope, together with the name of the service it is for. This
+// lets users add logging, authentication, metrics, and panic recovery to
+// services generated with --service-stubs without a separate RPC framework.
+//
+// UnaryInbound middleware runs on the server side. It is passed to the
+// generated NewFooHandler constructor and wraps every call to the FooServer
+// implementation.
+//
+//   handler := keyvalue.NewKeyValueHandler(impl, dispatch.Recover, logRequests)
+//   server := rpc.NewServer(protocol.Binary, handler)
+//
+// UnaryOutbound middleware runs on the client side. It is passed to the
+// generated NewFooClient constructor and wraps every request sent by the
+// client.
+//
+//   client := keyvalue.NewKeyValueClient(rpc.NewClient(protocol.Binary, transport), addAuth)
+//
+// Middleware is called in the order it is given, so the first middleware
+// sees the request first and the response last.
+//
+// Only requests to unary and oneway functions pass through middleware.
+// Requests to streaming functions, and requests for methods the handler does
+// not recognize, do not.
+package dispatch
@@ -26,6 +26,7 @@ import (
 
 	"go.uber.org/thriftrw/internal/envelope/exception"
 	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/stream"
 	"go.uber.org/thriftrw/wire"
 )
 
@@ -37,8 +38,27 @@ type Enveloper interface {
 	ToWire() (wire.Value, error)
 }
 
+// StreamEnveloper is the interface implemented by a type that can be
+// written with an envelope directly to a stream.Writer. Types generated with
+// the --stream-encode option implement it.
+type StreamEnveloper interface {
+	MethodName() string
+	EnvelopeType() wire.EnvelopeType
+	Encode(stream.Writer) error
+}
+
 // Write writes an Envelope to the given writer.
+//
+// If the protocol is also a stream.Protocol, like protocol.Binary, and e is
+// also a StreamEnveloper, the body is encoded directly to the writer with
+// WriteStream instead of being built into a wire.Value first.
 func Write(p protocol.Protocol, w io.Writer, seqID int32, e Enveloper) error {
+	if sp, ok := p.(stream.Protocol); ok {
+		if se, ok := e.(StreamEnveloper); ok {
+			return WriteStream(sp, w, seqID, se)
+		}
+	}
+
 	body, err := e.ToWire()
 	if err != nil {
 		return err
@@ -51,6 +71,26 @@ func Write(p protocol.Protocol, w io.Writer, seqID int32, e Enveloper) error {
 	}, w)
 }
 
+// WriteStream writes an Envelope to the given writer, encoding its body
+// directly with the given streaming protocol.
+func WriteStream(p stream.Protocol, w io.Writer, seqID int32, e StreamEnveloper) error {
+	sw := p.Writer(w)
+	err := sw.WriteEnvelopeBegin(stream.EnvelopeHeader{
+		Name:  e.MethodName(),
+		Type:  e.EnvelopeType(),
+		SeqID: seqID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := e.Encode(sw); err != nil {
+		return err
+	}
+
+	return sw.WriteEnvelopeEnd()
+}
+
 // ReadReply reads enveloped responses from the given reader.
 func ReadReply(p protocol.Protocol, r io.ReaderAt) (_ wire.Value, seqID int32, _ error) {
 	envelope, err := p.DecodeEnveloped(r)
@@ -30,6 +30,7 @@ import (
 	. "go.uber.org/thriftrw/envelope"
 
 	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/stream"
 	"go.uber.org/thriftrw/wire"
 
 	"github.com/golang/mock/gomock"
@@ -101,6 +102,74 @@ func TestWrite(t *testing.T) {
 	})
 }
 
+// fakeStreamEnveloper is an Enveloper which can also be encoded directly to
+// a stream.Writer.
+type fakeStreamEnveloper struct {
+	fakeEnveloper
+
+	// Number of times Encode was called.
+	encoded *int
+}
+
+var _ StreamEnveloper = fakeStreamEnveloper{}
+
+func (e fakeStreamEnveloper) Encode(sw stream.Writer) error {
+	*e.encoded++
+	if e.Err != nil {
+		return e.Err
+	}
+	return stream.WriteValue(sw, e.Value)
+}
+
+func TestWriteStream(t *testing.T) {
+	value := wire.NewValueStruct(wire.Struct{
+		Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("foo")},
+			{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+				wire.NewValueI32(1), wire.NewValueI32(2),
+			}))},
+		},
+	})
+
+	tests := []struct {
+		desc        string
+		protocol    protocol.Protocol
+		wantEncoded int
+	}{
+		{desc: "binary", protocol: protocol.Binary, wantEncoded: 1},
+		{desc: "non-strict binary", protocol: protocol.NonStrictBinary, wantEncoded: 1},
+		{desc: "compact", protocol: protocol.Compact},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var encoded int
+			enveloper := fakeStreamEnveloper{
+				fakeEnveloper: fakeEnveloper{Name: "getValue", Type: wire.Call, Value: value},
+				encoded:       &encoded,
+			}
+
+			var want, got bytes.Buffer
+			require.NoError(t, Write(tt.protocol, &want, 1234, enveloper.fakeEnveloper))
+			require.NoError(t, Write(tt.protocol, &got, 1234, enveloper))
+			assert.Equal(t, want.Bytes(), got.Bytes())
+			assert.Equal(t, tt.wantEncoded, encoded,
+				"Encode must be used only if the protocol supports streaming")
+		})
+	}
+
+	t.Run("failure", func(t *testing.T) {
+		var encoded int
+		enveloper := fakeStreamEnveloper{
+			fakeEnveloper: fakeEnveloper{Name: "getValue", Type: wire.Call, Err: fmt.Errorf("great sadness")},
+			encoded:       &encoded,
+		}
+
+		var buff bytes.Buffer
+		assert.Error(t, WriteStream(protocol.BinaryStreamer, &buff, 1234, enveloper))
+	})
+}
+
 func TestReadReply(t *testing.T) {
 	tests := []struct {
 		desc      string
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type formatOptions struct {
+	Write bool `short:"w" long:"write" description:"Write the formatted result back to each file instead of printing it."`
+}
+
+// runFormat formats the Thrift files in args. The formatted files are
+// written to out unless the --write option was provided.
+func runFormat(args []string, out io.Writer) error {
+	var opts formatOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw format"
+	parser.Usage = "[OPTIONS] FILE..."
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	for _, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		prog, err := (&idl.Config{Comments: true}).Parse(src)
+		if err != nil {
+			return fmt.Errorf("could not parse %q: %v", file, err)
+		}
+
+		var buf bytes.Buffer
+		if err := ast.Format(&buf, prog); err != nil {
+			return err
+		}
+
+		if !opts.Write {
+			if _, err := out.Write(buf.Bytes()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if bytes.Equal(src, buf.Bytes()) {
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, buf.Bytes(), info.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
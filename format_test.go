@@ -0,0 +1,103 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-format")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	const (
+		unformatted = "struct Foo {1: optional string a (b = \"c\", a = \"b\") // a\n}\n"
+		formatted   = "struct Foo {\n    1: optional string a (a = \"b\", b = \"c\") // a\n}\n"
+	)
+
+	file := filepath.Join(dir, "foo.thrift")
+	invalid := filepath.Join(dir, "invalid.thrift")
+	require.NoError(t, ioutil.WriteFile(invalid, []byte("struct {"), 0644))
+
+	tests := []struct {
+		desc      string
+		args      []string
+		wantOut   string
+		wantFile  string
+		wantError string
+	}{
+		{
+			desc:     "stdout",
+			args:     []string{file},
+			wantOut:  formatted,
+			wantFile: unformatted,
+		},
+		{
+			desc:     "write",
+			args:     []string{"-w", file},
+			wantFile: formatted,
+		},
+		{
+			desc:      "invalid file",
+			args:      []string{invalid},
+			wantFile:  unformatted,
+			wantError: `could not parse "` + invalid + `"`,
+		},
+		{
+			desc:      "missing file",
+			args:      []string{filepath.Join(dir, "bar.thrift")},
+			wantFile:  unformatted,
+			wantError: "bar.thrift",
+		},
+		{
+			desc:      "no files",
+			wantFile:  unformatted,
+			wantError: "thriftrw format [OPTIONS] FILE...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			require.NoError(t, ioutil.WriteFile(file, []byte(unformatted), 0644))
+
+			var out bytes.Buffer
+			err := runFormat(tt.args, &out)
+			assert.Equal(t, tt.wantOut, out.String())
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantError)
+			}
+
+			got, err := ioutil.ReadFile(file)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFile, string(got))
+		})
+	}
+}
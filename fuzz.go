@@ -0,0 +1,178 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// defaultFuzzTime is how long each fuzz test runs if --fuzztime was not
+// given.
+const defaultFuzzTime = 10 * time.Second
+
+type fuzzOptions struct {
+	FuzzTime string `long:"fuzztime" value-name:"DURATION" description:"How long to run each fuzz test, for example 30s or 5m. Defaults to 10s."`
+	Match    string `long:"match" value-name:"REGEXP" description:"Run only the fuzz tests whose names match REGEXP."`
+	List     bool   `long:"list" description:"List the fuzz tests instead of running them."`
+}
+
+// fuzzTarget is a fuzz test in a Go package.
+type fuzzTarget struct {
+	Package string
+	Name    string
+}
+
+// runFuzz runs the fuzz tests of the Go packages in args, or ./... if no
+// packages were given, one after another. "go test -fuzz" runs only one fuzz
+// test at a time so this is the easiest way to fuzz everything generated
+// with --fuzz-tests.
+func runFuzz(args []string, out io.Writer) error {
+	var opts fuzzOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw fuzz"
+	parser.Usage = "[OPTIONS] [PACKAGE...]"
+
+	pkgs, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	fuzzTime := defaultFuzzTime
+	if opts.FuzzTime != "" {
+		fuzzTime, err = time.ParseDuration(opts.FuzzTime)
+		if err != nil {
+			return fmt.Errorf("invalid fuzz time %q: %v", opts.FuzzTime, err)
+		}
+	}
+	if fuzzTime <= 0 {
+		return fmt.Errorf("invalid fuzz time %v: must be positive", fuzzTime)
+	}
+
+	var match *regexp.Regexp
+	if opts.Match != "" {
+		match, err = regexp.Compile(opts.Match)
+		if err != nil {
+			return fmt.Errorf("invalid --match %q: %v", opts.Match, err)
+		}
+	}
+
+	if len(pkgs) == 0 {
+		pkgs = []string{"./..."}
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command("go", append([]string{"test", "-list", "^Fuzz"}, pkgs...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("could not list fuzz tests: %v\n%s", err, stdout.Bytes())
+	}
+
+	targets, err := parseFuzzTargets(&stdout)
+	if err != nil {
+		return err
+	}
+
+	var selected []fuzzTarget
+	for _, target := range targets {
+		if match == nil || match.MatchString(target.Name) {
+			selected = append(selected, target)
+		}
+	}
+	if len(selected) == 0 {
+		return fmt.Errorf("no fuzz tests found in %v", strings.Join(pkgs, " "))
+	}
+
+	if opts.List {
+		for _, target := range selected {
+			fmt.Fprintf(out, "%v %v\n", target.Package, target.Name)
+		}
+		return nil
+	}
+
+	for _, target := range selected {
+		fmt.Fprintf(out, "fuzzing %v %v for %v\n", target.Package, target.Name, fuzzTime)
+
+		cmd := exec.Command("go", "test",
+			"-run", "^$",
+			"-fuzz", "^"+target.Name+"$",
+			"-fuzztime", fuzzTime.String(),
+			target.Package)
+		cmd.Stdout = out
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("%v in %v failed: %v", target.Name, target.Package, err)
+		}
+	}
+	return nil
+}
+
+// parseFuzzTargets parses the output of "go test -list ^Fuzz". The names of
+// the tests in each package are followed by a line reporting the result
+// for that package.
+//
+// 	FuzzFoo
+// 	FuzzBar
+// 	ok  	example.com/foo	0.010s
+// 	?   	example.com/bar	[no test files]
+func parseFuzzTargets(r io.Reader) ([]fuzzTarget, error) {
+	var (
+		targets []fuzzTarget
+		names   []string
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 1 && strings.HasPrefix(line, "Fuzz"):
+			names = append(names, line)
+		case len(fields) >= 2 && (fields[0] == "ok" || fields[0] == "?"):
+			for _, name := range names {
+				targets = append(targets, fuzzTarget{Package: fields[1], Name: name})
+			}
+			names = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(names) > 0 {
+		return nil, fmt.Errorf("could not find the package of fuzz tests %v", strings.Join(names, ", "))
+	}
+	return targets, nil
+}
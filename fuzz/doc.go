@@ -0,0 +1,35 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package fuzz provides harnesses for fuzzing the code generated by ThriftRW
+// with Go's native fuzzing support, available since Go 1.18.
+//
+// FromWire fuzzes decoding a generated struct, union, or exception from the
+// Binary protocol. Inputs which decode successfully must survive being
+// encoded and decoded again, with both the wire.Value-based and the
+// streaming decoders, without changing.
+//
+//   func FuzzPerson(f *testing.F) {
+//     fuzz.FromWire[Person](f, seed)
+//   }
+//
+// ThriftRW generates such fuzz tests for every struct when run with
+// --fuzz-tests, and "thriftrw fuzz" runs each of them in turn.
+package fuzz
@@ -0,0 +1,168 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package fuzz
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Limits placed on inputs decoded by the harnesses. Without them, a few
+// bytes declaring a huge container would make the fuzzer run out of memory
+// rather than find bugs.
+var _limits = protocol.Options{
+	MaxContainerSize: 1 << 16,
+	MaxDepth:         64,
+	MaxStringLength:  1 << 20,
+	MaxSkipBytes:     1 << 20,
+}
+
+var (
+	_binary   = protocol.NewBinary(_limits)
+	_streamer = protocol.NewBinaryStreamer(_limits)
+)
+
+// Struct is implemented by the structs, unions, and exceptions generated by
+// ThriftRW.
+type Struct interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+	Decode(stream.Reader) error
+}
+
+// FromWire fuzzes decoding T from the Binary protocol. The given seeds,
+// Binary encoded values of T, are added to the seed corpus.
+//
+// Inputs that can't be decoded into a valid T are ignored. Those that can
+// must be encoded and decoded again to the same value, and the streaming
+// decoder must agree with FromWire about the re-encoded value.
+func FromWire[T any, P interface {
+	*T
+	Struct
+}](f *testing.F, seeds ...[]byte) {
+	f.Helper()
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		checkFromWire[T, P](t, data)
+	})
+}
+
+func checkFromWire[T any, P interface {
+	*T
+	Struct
+}](t *testing.T, data []byte) {
+	w, err := _binary.Decode(bytes.NewReader(data), wire.TStruct)
+	if err != nil {
+		return
+	}
+
+	var v T
+	if err := P(&v).FromWire(w); err != nil {
+		return
+	}
+
+	// FromWire leaves containers whose items are of the wrong type empty,
+	// which may leave a value that fails validation, so values that can't
+	// be encoded are ignored too.
+	want, err := P(&v).ToWire()
+	if err != nil {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := _binary.Encode(want, &buf); err != nil {
+		return
+	}
+	encoded := buf.Bytes()
+
+	// NaN is not equal to itself so values holding it can't be compared.
+	comparable := !hasNaN(want)
+
+	w, err = _binary.Decode(bytes.NewReader(encoded), wire.TStruct)
+	if err != nil {
+		t.Fatalf("could not decode %x: %v", encoded, err)
+	}
+
+	var got T
+	if err := P(&got).FromWire(w); err != nil {
+		t.Fatalf("FromWire failed for re-encoded value %x: %v", encoded, err)
+	}
+	if gotWire, err := P(&got).ToWire(); err != nil {
+		t.Fatalf("ToWire failed for a re-decoded value: %v", err)
+	} else if comparable && !wire.ValuesAreEqual(want, gotWire) {
+		t.Fatalf("value changed after a round trip:\n\twant: %v\n\t got: %v", want, gotWire)
+	}
+
+	var streamed T
+	if err := P(&streamed).Decode(_streamer.Reader(bytes.NewReader(encoded))); err != nil {
+		t.Fatalf("Decode failed for %x which FromWire accepted: %v", encoded, err)
+	}
+	if streamedWire, err := P(&streamed).ToWire(); err != nil {
+		t.Fatalf("ToWire failed for a streamed value: %v", err)
+	} else if comparable && !wire.ValuesAreEqual(want, streamedWire) {
+		t.Fatalf("Decode and FromWire disagree:\n\tFromWire: %v\n\t  Decode: %v", want, streamedWire)
+	}
+}
+
+// hasNaN reports whether the given value holds a NaN double anywhere.
+func hasNaN(v wire.Value) bool {
+	var found bool
+	visit := func(v wire.Value) error {
+		if hasNaN(v) {
+			found = true
+		}
+		return nil
+	}
+
+	switch v.Type() {
+	case wire.TDouble:
+		return math.IsNaN(v.GetDouble())
+	case wire.TStruct:
+		for _, f := range v.GetStruct().Fields {
+			if hasNaN(f.Value) {
+				return true
+			}
+		}
+	case wire.TList:
+		_ = v.GetList().ForEach(visit)
+	case wire.TSet:
+		_ = v.GetSet().ForEach(visit)
+	case wire.TMap:
+		_ = v.GetMap().ForEach(func(item wire.MapItem) error {
+			if hasNaN(item.Key) || hasNaN(item.Value) {
+				found = true
+			}
+			return nil
+		})
+	}
+	return found
+}
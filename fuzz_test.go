@@ -0,0 +1,80 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFuzzTargets(t *testing.T) {
+	const output = `FuzzFoo
+FuzzBar
+ok  	example.com/foo	0.010s
+?   	example.com/bar	[no test files]
+ok  	example.com/baz	0.005s
+FuzzQux
+ok  	example.com/qux	0.002s
+`
+
+	targets, err := parseFuzzTargets(strings.NewReader(output))
+	require.NoError(t, err)
+	assert.Equal(t, []fuzzTarget{
+		{Package: "example.com/foo", Name: "FuzzFoo"},
+		{Package: "example.com/foo", Name: "FuzzBar"},
+		{Package: "example.com/qux", Name: "FuzzQux"},
+	}, targets)
+
+	_, err = parseFuzzTargets(strings.NewReader("FuzzFoo\n"))
+	assert.EqualError(t, err, "could not find the package of fuzz tests FuzzFoo")
+}
+
+func TestRunFuzzErrors(t *testing.T) {
+	tests := []struct {
+		desc      string
+		args      []string
+		wantError string
+	}{
+		{
+			desc:      "negative fuzz time",
+			args:      []string{"--fuzztime", "-1s"},
+			wantError: "invalid fuzz time -1s: must be positive",
+		},
+		{
+			desc:      "invalid match",
+			args:      []string{"--match", "("},
+			wantError: `invalid --match "(": error parsing regexp`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runFuzz(tt.args, &out)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantError)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"strconv"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// benchmarkFixtureDepth is the depth of nested structs up to which optional
+// fields are set in benchmark fixtures.
+const benchmarkFixtureDepth = 3
+
+// Benchmarks generates benchmarks of ToWire, FromWire, Encode, and Decode
+// for the given struct, union, or exception. The benchmarks are intended to
+// be written to a _test.go file in the package of the struct.
+//
+// The benchmarks operate on a fixture with all fields of the struct set,
+// which is encoded with the Binary protocol at generation time. Nothing is
+// generated for structs for which no valid fixture exists.
+func Benchmarks(g Generator, spec *compile.StructSpec) error {
+	v, ok := benchmarkFixture(spec, 0)
+	if !ok {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := protocol.Binary.Encode(v, &buf); err != nil {
+		return err
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$ioutil := import "io/ioutil">
+		<$testing := import "testing">
+		<$protocol := import "go.uber.org/thriftrw/protocol">
+		<$binary := import "go.uber.org/thriftrw/protocol/binary">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$name := typeName .Spec>
+		<$fixture := printf "_%s_BenchmarkFixture" $name>
+		<$value := printf "_%s_BenchmarkValue" $name>
+
+		// <$fixture> holds the Binary encoding of <$name> with all fields set.
+		var <$fixture> = []byte(<.Fixture>)
+
+		<$b := newVar "b">
+		<$i := newVar "i">
+		// <$value> decodes <$fixture>.
+		func <$value>(<$b> *<$testing>.B) *<$name> {
+			w, err := <$protocol>.Binary.Decode(<$bytes>.NewReader(<$fixture>), <$wire>.TStruct)
+			if err != nil {
+				<$b>.Fatal(err)
+			}
+
+			var v <$name>
+			if err := v.FromWire(w); err != nil {
+				<$b>.Fatal(err)
+			}
+			return &v
+		}
+
+		func Benchmark<$name>_ToWire(<$b> *<$testing>.B) {
+			v := <$value>(<$b>)
+			<$b>.ReportAllocs()
+			<$b>.ResetTimer()
+			for <$i> := 0; <$i> <"<"> <$b>.N; <$i>++ {
+				if _, err := v.ToWire(); err != nil {
+					<$b>.Fatal(err)
+				}
+			}
+		}
+
+		func Benchmark<$name>_FromWire(<$b> *<$testing>.B) {
+			// Values decoded by the Binary protocol can be read only once
+			// so a reusable one is built with ToWire.
+			w, err := <$value>(<$b>).ToWire()
+			if err != nil {
+				<$b>.Fatal(err)
+			}
+
+			<$b>.ReportAllocs()
+			<$b>.ResetTimer()
+			for <$i> := 0; <$i> <"<"> <$b>.N; <$i>++ {
+				var v <$name>
+				if err := v.FromWire(w); err != nil {
+					<$b>.Fatal(err)
+				}
+			}
+		}
+
+		func Benchmark<$name>_Encode(<$b> *<$testing>.B) {
+			v := <$value>(<$b>)
+			<$b>.ReportAllocs()
+			<$b>.ResetTimer()
+			for <$i> := 0; <$i> <"<"> <$b>.N; <$i>++ {
+				w, err := v.ToWire()
+				if err != nil {
+					<$b>.Fatal(err)
+				}
+				if err := <$protocol>.Binary.Encode(w, <$ioutil>.Discard); err != nil {
+					<$b>.Fatal(err)
+				}
+			}
+		}
+
+		func Benchmark<$name>_Decode(<$b> *<$testing>.B) {
+			<$b>.ReportAllocs()
+			for <$i> := 0; <$i> <"<"> <$b>.N; <$i>++ {
+				var v <$name>
+				sr := <$binary>.NewStreamReader(<$bytes>.NewReader(<$fixture>))
+				if err := v.Decode(sr); err != nil {
+					<$b>.Fatal(err)
+				}
+			}
+		}
+		`,
+		struct {
+			Spec    *compile.StructSpec
+			Fixture string
+		}{Spec: spec, Fixture: strconv.Quote(buf.String())})
+}
+
+// benchmarkFixture builds a representative value of the given type. Structs
+// nested deeper than benchmarkFixtureDepth have only their required fields
+// set so that recursive types terminate.
+//
+// Returns false if no valid value of the type could be built. This is the
+// case for empty unions and structs which require themselves.
+func benchmarkFixture(spec compile.TypeSpec, depth int) (wire.Value, bool) {
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return wire.NewValueBool(true), true
+	case *compile.I8Spec:
+		return wire.NewValueI8(42), true
+	case *compile.I16Spec:
+		return wire.NewValueI16(42), true
+	case *compile.I32Spec:
+		return wire.NewValueI32(42), true
+	case *compile.I64Spec:
+		return wire.NewValueI64(42), true
+	case *compile.DoubleSpec:
+		return wire.NewValueDouble(4.2), true
+	case *compile.StringSpec:
+		return wire.NewValueString("hello world"), true
+	case *compile.BinarySpec:
+		return wire.NewValueBinary([]byte("hello world")), true
+	case *compile.EnumSpec:
+		var value int32
+		if len(s.Items) > 0 {
+			value = s.Items[0].Value
+		}
+		return wire.NewValueI32(value), true
+	case *compile.ListSpec:
+		v, ok := benchmarkFixture(s.ValueSpec, depth)
+		if !ok {
+			return wire.Value{}, false
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(v.Type(), []wire.Value{v, v})), true
+	case *compile.SetSpec:
+		v, ok := benchmarkFixture(s.ValueSpec, depth)
+		if !ok {
+			return wire.Value{}, false
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(v.Type(), []wire.Value{v})), true
+	case *compile.MapSpec:
+		k, ok := benchmarkFixture(s.KeySpec, depth)
+		if !ok {
+			return wire.Value{}, false
+		}
+		v, ok := benchmarkFixture(s.ValueSpec, depth)
+		if !ok {
+			return wire.Value{}, false
+		}
+		items := []wire.MapItem{{Key: k, Value: v}}
+		return wire.NewValueMap(wire.MapItemListFromSlice(k.Type(), v.Type(), items)), true
+	case *compile.StructSpec:
+		return benchmarkStructFixture(s, depth)
+	default:
+		return wire.Value{}, false
+	}
+}
+
+func benchmarkStructFixture(spec *compile.StructSpec, depth int) (wire.Value, bool) {
+	// Required struct fields which lead back to the same struct can't be
+	// satisfied by any value.
+	if depth > 2*benchmarkFixtureDepth {
+		return wire.Value{}, false
+	}
+
+	isUnion := spec.Type == ast.UnionType
+
+	var fields []wire.Field
+	for _, f := range spec.Fields {
+		if !f.Required && !isUnion && depth >= benchmarkFixtureDepth {
+			continue
+		}
+
+		v, ok := benchmarkFixture(f.Type, depth+1)
+		if !ok {
+			if f.Required {
+				return wire.Value{}, false
+			}
+			continue
+		}
+		fields = append(fields, wire.Field{ID: f.ID, Value: v})
+
+		// Unions must have exactly one field set.
+		if isUnion {
+			break
+		}
+	}
+
+	if isUnion && len(fields) == 0 {
+		return wire.Value{}, false
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), true
+}
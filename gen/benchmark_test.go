@@ -0,0 +1,99 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchmarkFixture(t *testing.T) {
+	node := &compile.StructSpec{Name: "Node", Type: ast.StructType}
+	node.Fields = compile.FieldGroup{
+		{ID: 1, Name: "value", Type: &compile.StringSpec{}, Required: true},
+		{ID: 2, Name: "next", Type: node},
+	}
+
+	loop := &compile.StructSpec{Name: "Loop", Type: ast.StructType}
+	loop.Fields = compile.FieldGroup{
+		{ID: 1, Name: "loop", Type: loop, Required: true},
+	}
+
+	tests := []struct {
+		desc string
+		spec *compile.StructSpec
+
+		wantOk     bool
+		wantFields []int16
+	}{
+		{
+			desc:       "recursive struct",
+			spec:       node,
+			wantOk:     true,
+			wantFields: []int16{1, 2},
+		},
+		{
+			desc: "struct requiring itself",
+			spec: loop,
+		},
+		{
+			desc: "union",
+			spec: &compile.StructSpec{
+				Name: "Union",
+				Type: ast.UnionType,
+				Fields: compile.FieldGroup{
+					{ID: 1, Name: "loop", Type: loop},
+					{ID: 2, Name: "i", Type: &compile.I32Spec{}},
+					{ID: 3, Name: "s", Type: &compile.StringSpec{}},
+				},
+			},
+			wantOk:     true,
+			wantFields: []int16{2},
+		},
+		{
+			desc: "empty union",
+			spec: &compile.StructSpec{Name: "Empty", Type: ast.UnionType},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			v, ok := benchmarkFixture(tt.spec, 0)
+			require.Equal(t, tt.wantOk, ok)
+			if !ok {
+				return
+			}
+
+			require.Equal(t, wire.TStruct, v.Type())
+			var ids []int16
+			for _, f := range v.GetStruct().Fields {
+				ids = append(ids, f.ID)
+			}
+			assert.Equal(t, tt.wantFields, ids)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tb "go.uber.org/thriftrw/gen/internal/tests/binaries"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestReaderBinariesToWire(t *testing.T) {
+	t.Run("Bytes", func(t *testing.T) {
+		contents := bytes.NewBufferString("hello")
+		w, err := (&tb.Blob{Name: "foo", Contents: contents}).ToWire()
+		require.NoError(t, err)
+
+		got := w.GetStruct().Fields[1].Value.GetBinary()
+		assert.Equal(t, []byte("hello"), got)
+		assert.Equal(t, 5, contents.Len(), "readers with a Bytes method must not be consumed")
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		contents := strings.NewReader("hello")
+		w, err := (&tb.Blob{Name: "foo", Contents: contents}).ToWire()
+		require.NoError(t, err)
+
+		got := w.GetStruct().Fields[1].Value.GetBinary()
+		assert.Equal(t, []byte("hello"), got)
+		assert.Equal(t, 0, contents.Len(), "other readers must be read to EOF")
+	})
+}
+
+func TestReaderBinariesFromWire(t *testing.T) {
+	contents := []byte("hello")
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBinary([]byte("foo"))},
+		{ID: 4, Value: wire.NewValueBinary(contents)},
+	}})
+
+	var got tb.Blob
+	require.NoError(t, got.FromWire(v))
+
+	buff, ok := got.Contents.(*bytes.Buffer)
+	require.True(t, ok, "Contents must be a *bytes.Buffer, got %T", got.Contents)
+	assert.True(t, &buff.Bytes()[0] == &contents[0], "Contents must not copy the decoded bytes")
+
+	var encoded bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(v, &encoded))
+
+	var decoded tb.Blob
+	require.NoError(t, decoded.Decode(protocol.BinaryStreamer.Reader(bytes.NewReader(encoded.Bytes()))))
+	require.NotNil(t, decoded.Contents)
+
+	b, err := ioutil.ReadAll(decoded.Contents)
+	require.NoError(t, err)
+	assert.Equal(t, contents, b)
+}
+
+func TestReaderBinariesShallowCopy(t *testing.T) {
+	contents := bytes.NewBufferString("hello")
+	x := &tb.Blob{Name: "foo", Contents: contents}
+
+	assert.True(t, x.Clone().Contents == contents, "clones must share readers")
+	assert.True(t, x.Equals(&tb.Blob{Name: "foo", Contents: contents}))
+	assert.False(t, x.Equals(&tb.Blob{Name: "foo", Contents: bytes.NewBufferString("hello")}),
+		"readers must be compared by identity")
+	assert.Equal(t, 5, contents.Len(), "readers must not be consumed")
+}
+
+func TestBinaryGoTypeErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc:    "unknown representation",
+			src:     `struct Foo { 1: optional binary (go.type = "slice") value }`,
+			wantErr: `unsupported representation for binary: (go.type = "slice") must be "string" or "reader"`,
+		},
+		{
+			desc:    "reader in list",
+			src:     `struct Foo { 1: optional list<binary (go.type = "reader")> values }`,
+			wantErr: `cannot contain binaries with (go.type = "reader")`,
+		},
+		{
+			desc:    "reader in map",
+			src:     `struct Foo { 1: optional map<string, binary (go.type = "reader")> values }`,
+			wantErr: `cannot contain binaries with (go.type = "reader")`,
+		},
+		{
+			desc:    "reader typedef",
+			src:     `typedef binary (go.type = "reader") Contents`,
+			wantErr: `typedefs cannot refer to binaries with (go.type = "reader")`,
+		},
+		{
+			desc:    "lazy reader",
+			src:     `struct Foo { 1: optional binary (go.type = "reader") value (go.lazy = "true") }`,
+			wantErr: `binary fields with (go.type = "reader") cannot be lazy`,
+		},
+		{
+			desc:    "hashable reader",
+			src:     `struct Foo { 1: optional binary (go.type = "reader") value } (go.hashable)`,
+			wantErr: `binaries with (go.type = "reader") cannot be hashed`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-binaries")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.src), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// binaryReaderGenerator generates logic to convert binaries represented as
+// io.Readers to and from their wire representation.
+type binaryReaderGenerator struct{}
+
+// ToWire generates a function to convert an io.Reader into a wire.Value.
+//
+// 	func $name(r io.Reader) (wire.Value, error) {
+// 		...
+// 	}
+//
+// And returns its name. Readers that expose their contents with a Bytes
+// method, like *bytes.Buffer, are encoded without copying and are not
+// consumed. Other readers are read until EOF.
+func (b *binaryReaderGenerator) ToWire(g Generator, spec *compile.BinarySpec) (string, error) {
+	name := toWireFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$wire := import "go.uber.org/thriftrw/wire">
+			<$r := newVar "r">
+			<$b := newVar "b">
+			func <.Name>(<$r> <import "io">.Reader) (<$wire>.Value, error) {
+				if <$b>, ok := <$r>.(interface{ Bytes() []byte }); ok {
+					return <$wire>.NewValueBinary(<$b>.Bytes()), nil
+				}
+
+				<$b>, err := <import "io/ioutil">.ReadAll(<$r>)
+				return <$wire>.NewValueBinary(<$b>), err
+			}
+		`,
+		struct{ Name string }{Name: name},
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Decoder generates a function to read an io.Reader from a stream.Reader.
+//
+// 	func $name(sr stream.Reader) (io.Reader, error) {
+// 		...
+// 	}
+//
+// And returns its name. The returned reader is backed by the decoded bytes.
+func (b *binaryReaderGenerator) Decoder(g Generator, spec *compile.BinarySpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$sr := newVar "sr">
+			<$b := newVar "b">
+			func <.Name>(<$sr> <import "go.uber.org/thriftrw/protocol/stream">.Reader) (<import "io">.Reader, error) {
+				<$b>, err := <$sr>.ReadBinary()
+				if err != nil {
+					return nil, err
+				}
+				return <import "bytes">.NewBuffer(<$b>), nil
+			}
+		`,
+		struct{ Name string }{Name: name},
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Encoder generates a function to write an io.Reader to a stream.Writer.
+//
+// 	func $name(r io.Reader, sw stream.Writer) error {
+// 		...
+// 	}
+//
+// And returns its name. Readers are consumed the same way as they are by
+// the function generated by ToWire.
+func (b *binaryReaderGenerator) Encoder(g Generator, spec *compile.BinarySpec) (string, error) {
+	name := encoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$r := newVar "r">
+			<$sw := newVar "sw">
+			<$b := newVar "b">
+			func <.Name>(<$r> <import "io">.Reader, <$sw> <import "go.uber.org/thriftrw/protocol/stream">.Writer) error {
+				if <$b>, ok := <$r>.(interface{ Bytes() []byte }); ok {
+					return <$sw>.WriteBinary(<$b>.Bytes())
+				}
+
+				<$b>, err := <import "io/ioutil">.ReadAll(<$r>)
+				if err != nil {
+					return err
+				}
+				return <$sw>.WriteBinary(<$b>)
+			}
+		`,
+		struct{ Name string }{Name: name},
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
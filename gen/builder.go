@@ -0,0 +1,162 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// BuilderLabel generates a builder with chained setters for structs. i.e.
+//
+// 	struct Order {
+// 		1: required string id
+// 		2: required i64 amount
+// 		3: optional string note
+// 	} (go.builder)
+//
+// generates an OrderBuilder which is used like so:
+//
+// 	order, err := NewOrderBuilder().WithID("1").WithAmount(100).Build()
+//
+// Setters of optional fields accept values rather than pointers. Build
+// fails with required.Errors listing the required fields which were not
+// set, so that omitting them is caught where the struct is built rather
+// than when it's sent over the wire.
+//
+// Builders are also generated for all structs and exceptions with at least
+// Options.BuilderThreshold fields unless they're annotated with
+// (go.builder = "false"). Unions never have builders.
+const BuilderLabel = "go.builder"
+
+// hasBuilder returns true if a builder should be generated for the given
+// struct.
+func hasBuilder(g Generator, spec *compile.StructSpec) (bool, error) {
+	switch v, ok := spec.Annotations[BuilderLabel]; {
+	case v == "false":
+		return false, nil
+	case ok && v != "" && v != "true":
+		return false, fmt.Errorf(
+			"invalid %v on %q: expected \"true\" or \"false\", got %q",
+			BuilderLabel, spec.Name, v)
+	case ok && spec.Type == ast.UnionType:
+		return false, fmt.Errorf("invalid %v on %q: unions cannot have builders", BuilderLabel, spec.Name)
+	case ok:
+		return true, nil
+	}
+
+	threshold := checkBuilderThreshold(g)
+	return spec.Type != ast.UnionType && threshold > 0 && len(spec.Fields) >= threshold, nil
+}
+
+// Builder generates the builder of this group. See BuilderLabel.
+func (f fieldGroupGenerator) Builder(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$b := newVar "b">
+		<$v := newVar "v">
+		<$value := newVar "value">
+		<$name := .Name>
+		<$builder := printf "%vBuilder" .Name>
+
+		// <$builder> builds <$name> values with chained setters. Build
+		// returns the value once all required fields are set.
+		type <$builder> struct {
+			v <$name>
+			<- if .BuilderChecksRequired>
+
+			set struct {
+				<- range .Fields>
+					<- if checksRequired .>
+						<goName .> bool
+					<- end>
+				<- end>
+			}
+			<- end>
+		}
+
+		// New<$builder> returns a new builder of <$name> values with no
+		// fields set.
+		func New<$builder>() *<$builder> {
+			return &<$builder>{}
+		}
+
+		<range .Fields>
+			<$fname := goName .>
+			<$m := mappedField .>
+			// With<$fname> sets <$fname> of the <$name> being built.
+			func (<$b> *<$builder>) With<$fname>(<$value> <if $m><$m.Type><else><typeReference .Type><end>) *<$builder> {
+				<- if or (inBitmap .) (lazy .)>
+					<$b>.v.Set<$fname>(<$value>)
+				<- else if and (not .Required) (or $m (isPrimitiveType .Type))>
+					<$b>.v.<$fname> = &<$value>
+				<- else>
+					<$b>.v.<$fname> = <$value>
+				<- end>
+				<- if checksRequired .>
+					<$b>.set.<$fname> = true
+				<- end>
+				return <$b>
+			}
+		<end>
+
+		// Build returns the <$name> built so far.
+		<- if .BuilderChecksRequired>
+		// It fails with required.Errors listing the required fields which
+		// were not set.
+		<- end>
+		func (<$b> *<$builder>) Build() (*<$name>, error) {
+			<- if .BuilderChecksRequired>
+				<- $missing := newVar "missing">
+				var <$missing> <import "go.uber.org/thriftrw/required">.Errors
+				<- range .Fields>
+					<- if checksRequired .>
+						if !<$b>.set.<goName .> {
+							<$missing>.Add("<$name>", "<goName .>")
+						}
+					<- end>
+				<- end>
+				if err := <$missing>.Err(); err != nil {
+					return nil, err
+				}
+			<end>
+			<$v> := <$b>.v
+			return &<$v>, nil
+		}
+		`, f,
+		TemplateFunc("checksRequired", checksRequiredField),
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
+	)
+}
+
+// BuilderChecksRequired returns true if the builder of this group checks
+// that required fields were set.
+func (f fieldGroupGenerator) BuilderChecksRequired() bool {
+	for _, field := range f.Fields {
+		if checksRequiredField(field) {
+			return true
+		}
+	}
+	return false
+}
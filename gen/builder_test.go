@@ -0,0 +1,134 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tb "go.uber.org/thriftrw/gen/internal/tests/builders"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/required"
+)
+
+func TestBuilderBuild(t *testing.T) {
+	total := &tb.Money{Amount: 100}
+	got, err := tb.NewOrderBuilder().
+		WithID("1").
+		WithTotal(total).
+		WithNote("fragile").
+		WithTags([]string{"a", "b"}).
+		WithGift(false).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, &tb.Order{
+		ID:    "1",
+		Total: total,
+		Note:  ptr.String("fragile"),
+		Tags:  []string{"a", "b"},
+		Gift:  ptr.Bool(false),
+	}, got)
+	assert.Equal(t, int32(1), got.GetQuantity(), "unset fields must use their defaults")
+
+	roundTrip(t, got, "Order")
+}
+
+func TestBuilderMissingRequiredFields(t *testing.T) {
+	b := tb.NewOrderBuilder().WithNote("fragile")
+
+	_, err := b.Build()
+	require.Error(t, err)
+	assert.Equal(t, required.Errors{
+		{Struct: "Order", Field: "ID"},
+		{Struct: "Order", Field: "Total"},
+	}, err)
+
+	_, err = b.WithTotal(&tb.Money{Amount: 1}).Build()
+	assert.Equal(t, required.Errors{{Struct: "Order", Field: "ID"}}, err)
+
+	_, err = b.WithID("1").Build()
+	assert.NoError(t, err)
+}
+
+func TestBuilderBuildCopies(t *testing.T) {
+	b := tb.NewAddressBuilder().WithCity("Paris")
+	first, err := b.Build()
+	require.NoError(t, err)
+
+	second, err := b.WithCity("Rome").Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "Paris", first.GetCity(), "values already built must not change")
+	assert.Equal(t, "Rome", second.GetCity())
+}
+
+func TestBuilderPresenceBitmap(t *testing.T) {
+	got, err := tb.NewSampleBuilder().WithName("cpu").WithTimestamp(0).Build()
+	require.NoError(t, err)
+	assert.True(t, got.IsSetTimestamp())
+	assert.False(t, got.IsSetValue())
+}
+
+func TestBuilderAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		thrift  string
+		wantErr string
+	}{
+		{
+			desc:    "invalid value",
+			thrift:  `struct Foo { 1: optional i32 x } (go.builder = "yes")`,
+			wantErr: `invalid go.builder on "Foo": expected "true" or "false", got "yes"`,
+		},
+		{
+			desc:    "union",
+			thrift:  `union Foo { 1: i32 x } (go.builder)`,
+			wantErr: `invalid go.builder on "Foo": unions cannot have builders`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-builder")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.thrift), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/version"
+)
+
+// moduleCache stores the code generated for Thrift modules on disk so that
+// it doesn't have to be regenerated if none of its inputs have changed.
+//
+// Entries are keyed by a hash of everything the generated code depends on:
+// the ThriftRW version, the code generation options, and the contents of the
+// Thrift file and all files it includes, directly or indirectly.
+type moduleCache struct {
+	Dir string
+}
+
+// Key returns the key for the code generated for the given module.
+func (c moduleCache) Key(m *compile.Module, i ThriftPackageImporter, o *Options) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "thriftrw %v\n", version.Version)
+
+	// Note that OutputDir and ThriftRoot are absent because they affect only
+	// where files are placed. The paths of the Thrift files relative to the
+	// ThriftRoot are recorded below.
+	fmt.Fprintf(h, "options %+v\n", struct {
+		PackagePrefix     string
+		NoVersionCheck    bool
+		NoTypes           bool
+		NoConstants       bool
+		NoServiceHelpers  bool
+		ServiceStubs      bool
+		HTTPHandlers      bool
+		PrometheusMetrics bool
+		NoEmbedIDL        bool
+		NoZap             bool
+		Minimal           bool
+		NamespacePackages bool
+		SQL               bool
+		SQLEnumNames      bool
+		CompactCodegen    bool
+		SliceSets         bool
+		SortedMaps        bool
+		Descriptors       bool
+		SizeMethods       bool
+		StreamEncode      bool
+		BuilderThreshold  int
+		LazyConstants     bool
+		Casing            Casing
+		Generics          bool
+		Roots             []string
+		OutputFile        string
+		OutputLayout      OutputLayout
+	}{
+		PackagePrefix:     o.PackagePrefix,
+		NoVersionCheck:    o.NoVersionCheck,
+		NoTypes:           o.NoTypes,
+		NoConstants:       o.NoConstants,
+		NoServiceHelpers:  o.NoServiceHelpers,
+		ServiceStubs:      o.ServiceStubs,
+		HTTPHandlers:      o.HTTPHandlers,
+		PrometheusMetrics: o.PrometheusMetrics,
+		NoEmbedIDL:        o.NoEmbedIDL,
+		NoZap:             o.NoZap,
+		Minimal:           o.Minimal,
+		NamespacePackages: o.NamespacePackages,
+		SQL:               o.SQL,
+		SQLEnumNames:      o.SQLEnumNames,
+		CompactCodegen:    o.CompactCodegen,
+		SliceSets:         o.SliceSets,
+		SortedMaps:        o.SortedMaps,
+		Descriptors:       o.Descriptors,
+		SizeMethods:       o.SizeMethods,
+		StreamEncode:      o.StreamEncode,
+		BuilderThreshold:  o.BuilderThreshold,
+		LazyConstants:     o.LazyConstants,
+		Casing:            o.Casing,
+		Generics:          o.Generics,
+		Roots:             o.Roots,
+		OutputFile:        o.OutputFile,
+		OutputLayout:      o.OutputLayout,
+	})
+
+	root, err := i.RelativeThriftFilePath(m.ThriftPath)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintf(h, "root %q\n", root)
+
+	var modules []*compile.Module
+	err = m.Walk(func(m *compile.Module) error {
+		modules = append(modules, m)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].ThriftPath < modules[j].ThriftPath
+	})
+	for _, m := range modules {
+		path, err := i.RelativeThriftFilePath(m.ThriftPath)
+		if err != nil {
+			return "", err
+		}
+
+		// Record the length so that the boundaries between files are
+		// unambiguous.
+		fmt.Fprintf(h, "module %q %d %q\n", path, len(m.Raw), m.BuildTags)
+		h.Write(m.Raw)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Get retrieves the code stored in the cache under the given key. Returns
+// false if the cache doesn't have an entry for this key.
+func (c moduleCache) Get(key string) (files map[string][]byte, ok bool, err error) {
+	f, err := os.Open(c.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("could not read from cache: %v", err)
+	}
+	defer f.Close()
+
+	if err := gob.NewDecoder(f).Decode(&files); err != nil {
+		return nil, false, fmt.Errorf("could not read from cache: %v", err)
+	}
+	return files, true, nil
+}
+
+// Put stores the given files in the cache under the given key.
+func (c moduleCache) Put(key string, files map[string][]byte) error {
+	var contents bytes.Buffer
+	if err := gob.NewEncoder(&contents).Encode(files); err != nil {
+		return fmt.Errorf("could not write to cache: %v", err)
+	}
+
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return fmt.Errorf("could not create cache directory %q: %v", c.Dir, err)
+	}
+
+	// Write to a temporary file first so that concurrent runs never see
+	// partially written entries.
+	f, err := ioutil.TempFile(c.Dir, key+".tmp")
+	if err != nil {
+		return fmt.Errorf("could not write to cache: %v", err)
+	}
+	defer os.Remove(f.Name()) // no-op if the rename succeeds
+
+	if err := writeAndClose(f, contents.Bytes()); err != nil {
+		return fmt.Errorf("could not write to cache: %v", err)
+	}
+
+	if err := os.Rename(f.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("could not write to cache: %v", err)
+	}
+	return nil
+}
+
+func (c moduleCache) path(key string) string {
+	return filepath.Join(c.Dir, key)
+}
+
+func writeAndClose(w io.WriteCloser, contents []byte) error {
+	_, err := w.Write(contents)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// hasTypeMapper returns true if the given TypeMapper may alter the generated
+// code.
+func hasTypeMapper(tm plugin.TypeMapper) bool {
+	switch tm := tm.(type) {
+	case nil:
+		return false
+	case plugin.MultiTypeMapper:
+		return len(tm) > 0
+	default:
+		return tm != plugin.EmptyTypeMapper
+	}
+}
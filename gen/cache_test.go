@@ -0,0 +1,215 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+)
+
+func TestGenerateCache(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	writeFile := func(name, contents string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(thriftRoot, name), []byte(contents), 0644))
+	}
+
+	writeFile("main.thrift", `
+		include "./shared.thrift"
+		include "./leaf.thrift"
+
+		struct Main {
+			1: required shared.Shared shared
+			2: required leaf.Leaf leaf
+		}
+	`)
+	writeFile("shared.thrift", `struct Shared { 1: required string name }`)
+	writeFile("leaf.thrift", `struct Leaf { 1: required i64 id }`)
+
+	outputDir := filepath.Join(thriftRoot, "out")
+	cacheDir := filepath.Join(thriftRoot, ".thriftrw-cache")
+	generate := func() {
+		module, err := compile.Compile(filepath.Join(thriftRoot, "main.thrift"))
+		require.NoError(t, err)
+		require.NoError(t, Generate(module, &Options{
+			OutputDir:     outputDir,
+			PackagePrefix: "example.com/idl",
+			ThriftRoot:    thriftRoot,
+			CacheDir:      cacheDir,
+		}))
+	}
+
+	// Tags all cache entries so that we can tell which outputs came from
+	// the cache.
+	const marker = "// from cache\n"
+	markCache := func() {
+		entries, err := ioutil.ReadDir(cacheDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 3, "expected one cache entry per module")
+
+		c := moduleCache{Dir: cacheDir}
+		for _, e := range entries {
+			files, ok, err := c.Get(e.Name())
+			require.NoError(t, err)
+			require.True(t, ok, "cache entry %q must be readable", e.Name())
+			for name, contents := range files {
+				files[name] = append([]byte(marker), contents...)
+			}
+			require.NoError(t, c.Put(e.Name(), files))
+		}
+	}
+
+	cached := func(file string) bool {
+		contents, err := ioutil.ReadFile(filepath.Join(outputDir, file))
+		require.NoError(t, err)
+		return len(contents) >= len(marker) && string(contents[:len(marker)]) == marker
+	}
+
+	generate()
+	for _, f := range []string{"main/main.go", "shared/shared.go", "leaf/leaf.go"} {
+		assert.False(t, cached(f), "%v must not come from an empty cache", f)
+	}
+
+	// Nothing changed.
+	markCache()
+	generate()
+	for _, f := range []string{"main/main.go", "shared/shared.go", "leaf/leaf.go"} {
+		assert.True(t, cached(f), "%v must come from the cache", f)
+	}
+
+	// An included file changed.
+	writeFile("shared.thrift", `struct Shared { 1: required string name; 2: optional string nickname }`)
+	generate()
+	assert.False(t, cached("main/main.go"), "main.go must be regenerated because shared.thrift changed")
+	assert.False(t, cached("shared/shared.go"), "shared.go must be regenerated because it changed")
+	assert.True(t, cached("leaf/leaf.go"), "leaf.go must come from the cache")
+}
+
+func TestGenerateCacheNoMangle(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	path := filepath.Join(thriftRoot, "store.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+		service Store {
+			void put(1: string type)
+		}
+	`), 0644))
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	generate := func(noMangle bool) error {
+		return Generate(module, &Options{
+			OutputDir:     filepath.Join(thriftRoot, "out"),
+			PackagePrefix: "example.com/idl",
+			ThriftRoot:    thriftRoot,
+			CacheDir:      filepath.Join(thriftRoot, ".thriftrw-cache"),
+			NoMangle:      noMangle,
+		})
+	}
+
+	require.NoError(t, generate(false))
+
+	// The code for store.thrift is in the cache now but it must still be
+	// rejected.
+	err = generate(true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`parameter Store.put.type would be renamed to "type2" because "type" is a Go keyword`)
+}
+
+func TestCacheKeyOptions(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	path := filepath.Join(thriftRoot, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`struct Foo {}`), 0644))
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	importer := thriftPackageImporter{
+		ImportPrefix: "example.com/idl",
+		ThriftRoot:   thriftRoot,
+	}
+
+	c := moduleCache{Dir: filepath.Join(thriftRoot, "cache")}
+	key := func(o *Options) string {
+		k, err := c.Key(module, importer, o)
+		require.NoError(t, err)
+		return k
+	}
+
+	base := key(&Options{PackagePrefix: "example.com/idl"})
+	assert.Equal(t, base, key(&Options{PackagePrefix: "example.com/idl"}),
+		"keys must be stable")
+	assert.Equal(t, base, key(&Options{PackagePrefix: "example.com/idl", OutputDir: "/elsewhere"}),
+		"OutputDir must not affect the key")
+	assert.NotEqual(t, base, key(&Options{PackagePrefix: "example.com/other"}),
+		"PackagePrefix must affect the key")
+	assert.NotEqual(t, base, key(&Options{PackagePrefix: "example.com/idl", NoZap: true}),
+		"NoZap must affect the key")
+	assert.NotEqual(t, base, key(&Options{PackagePrefix: "example.com/idl", OutputLayout: PerTypeLayout}),
+		"OutputLayout must affect the key")
+
+	tagged, err := compile.Compile(path, compile.BuildTags("internal"))
+	require.NoError(t, err)
+	taggedKey, err := c.Key(tagged, importer, &Options{PackagePrefix: "example.com/idl"})
+	require.NoError(t, err)
+	assert.NotEqual(t, base, taggedKey, "build tags must affect the key")
+}
+
+func TestHasTypeMapper(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tm := handletest.NewMockTypeMapper(mockCtrl)
+
+	tests := []struct {
+		desc string
+		give plugin.TypeMapper
+		want bool
+	}{
+		{desc: "nil", give: nil, want: false},
+		{desc: "empty", give: plugin.EmptyTypeMapper, want: false},
+		{desc: "empty multi", give: plugin.MultiTypeMapper{}, want: false},
+		{desc: "plugin", give: tm, want: true},
+		{desc: "multi", give: plugin.MultiTypeMapper{tm}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, hasTypeMapper(tt.give))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/internal/goast"
+
+// Casing controls how the Go names of types, fields, enum items, constants,
+// services, and functions are derived from their names in the Thrift file.
+// Names specified with go.name annotations are used as-is regardless of the
+// Casing.
+type Casing int
+
+const (
+	// GoCasing follows Go naming conventions. Known initialisms like ID and
+	// URL are upper cased, and words that are in all caps are Titlecased if
+	// the name has more than one word.
+	//
+	// 	user_id   => UserID
+	// 	MAX_SIZE  => MaxSize
+	GoCasing Casing = iota
+
+	// LiteralCasing capitalizes the first letter of each word and removes
+	// underscores, leaving the rest of the name as it is in the Thrift
+	// file.
+	//
+	// 	user_id   => UserId
+	// 	MAX_SIZE  => MAXSIZE
+	LiteralCasing
+
+	// ApacheCasing derives the same names as the Go generator of Apache
+	// Thrift to ease migrating code from it. Enum items are named after
+	// their enum and their name in the Thrift file separated by an
+	// underscore.
+	//
+	// 	user_id   => UserID
+	// 	MAX_SIZE  => MAX_SIZE
+	// 	Status.OK => Status_OK
+	ApacheCasing
+)
+
+// name returns the Go name of a type, field, service, or function with the
+// given name in the Thrift file.
+func (c Casing) name(s string) string {
+	switch c {
+	case LiteralCasing:
+		return goast.LiteralCase(s)
+	case ApacheCasing:
+		return goast.ApacheCase(s)
+	default:
+		return goCase(s)
+	}
+}
+
+// constantName returns the Go name of a constant with the given name in the
+// Thrift file.
+func (c Casing) constantName(s string) string {
+	switch c {
+	case LiteralCasing:
+		return goast.LiteralCase(s)
+	case ApacheCasing:
+		return goast.ApacheCase(s)
+	default:
+		return constantName(s)
+	}
+}
+
+// enumItemSuffix returns the part of the Go name of an enum item with the
+// given name in the Thrift file which follows the name of the enum.
+func (c Casing) enumItemSuffix(s string) string {
+	switch c {
+	case LiteralCasing:
+		return goast.LiteralCase(s)
+	case ApacheCasing:
+		return "_" + s
+	default:
+		return constantName(s)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCasing(t *testing.T) {
+	tests := []struct {
+		give string
+
+		goName      string
+		literalName string
+		apacheName  string
+
+		goConstant      string
+		literalConstant string
+		apacheConstant  string
+	}{
+		{
+			give:            "user_id",
+			goName:          "UserID",
+			literalName:     "UserId",
+			apacheName:      "UserID",
+			goConstant:      "UserID",
+			literalConstant: "UserId",
+			apacheConstant:  "UserID",
+		},
+		{
+			give:            "MAX_SIZE",
+			goName:          "MaxSize",
+			literalName:     "MAXSIZE",
+			apacheName:      "MAX_SIZE",
+			goConstant:      "MaxSize",
+			literalConstant: "MAXSIZE",
+			apacheConstant:  "MAX_SIZE",
+		},
+		{
+			give:            "VERSION",
+			goName:          "VERSION",
+			literalName:     "VERSION",
+			apacheName:      "VERSION",
+			goConstant:      "Version",
+			literalConstant: "VERSION",
+			apacheConstant:  "VERSION",
+		},
+		{
+			give:            "getHttpUrl",
+			goName:          "GetHttpUrl",
+			literalName:     "GetHttpUrl",
+			apacheName:      "GetHttpUrl",
+			goConstant:      "GetHttpUrl",
+			literalConstant: "GetHttpUrl",
+			apacheConstant:  "GetHttpUrl",
+		},
+		{
+			give:            "foo__bar",
+			goName:          "FooBar",
+			literalName:     "FooBar",
+			apacheName:      "Foo_Bar",
+			goConstant:      "FooBar",
+			literalConstant: "FooBar",
+			apacheConstant:  "Foo_Bar",
+		},
+		{
+			give:            "sql_query",
+			goName:          "SQLQuery",
+			literalName:     "SqlQuery",
+			apacheName:      "SqlQuery",
+			goConstant:      "SQLQuery",
+			literalConstant: "SqlQuery",
+			apacheConstant:  "SqlQuery",
+		},
+		{
+			give:            "new_item",
+			goName:          "NewItem",
+			literalName:     "NewItem",
+			apacheName:      "NewItem_",
+			goConstant:      "NewItem",
+			literalConstant: "NewItem",
+			apacheConstant:  "NewItem_",
+		},
+		{
+			give:            "get_result",
+			goName:          "GetResult",
+			literalName:     "GetResult",
+			apacheName:      "GetResult_",
+			goConstant:      "GetResult",
+			literalConstant: "GetResult",
+			apacheConstant:  "GetResult_",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			assert.Equal(t, tt.goName, GoCasing.name(tt.give), "go name")
+			assert.Equal(t, tt.literalName, LiteralCasing.name(tt.give), "literal name")
+			assert.Equal(t, tt.apacheName, ApacheCasing.name(tt.give), "apache name")
+
+			assert.Equal(t, tt.goConstant, GoCasing.constantName(tt.give), "go constant")
+			assert.Equal(t, tt.literalConstant, LiteralCasing.constantName(tt.give), "literal constant")
+			assert.Equal(t, tt.apacheConstant, ApacheCasing.constantName(tt.give), "apache constant")
+		})
+	}
+}
+
+func TestCasingEnumItemSuffix(t *testing.T) {
+	assert.Equal(t, "NotFound", GoCasing.enumItemSuffix("NOT_FOUND"))
+	assert.Equal(t, "NOTFOUND", LiteralCasing.enumItemSuffix("NOT_FOUND"))
+	assert.Equal(t, "_NOT_FOUND", ApacheCasing.enumItemSuffix("NOT_FOUND"))
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// ShallowCopyLabel allows struct fields to opt out of deep copies. Fields
+// with this annotation are copied shallowly by the generated Clone method,
+// sharing their contents with the original struct. i.e.
+//
+// 	struct Request {
+// 		1: required string id
+// 		2: required binary payload (go.shallowcopy)
+// 	}
+//
+// Clones of the above struct will share the same payload slice.
+const ShallowCopyLabel = "go.shallowcopy"
+
+// cloneGenerator is responsible for generating code that makes deep copies
+// of Thrift types.
+type cloneGenerator struct {
+	mapG  mapGenerator
+	setG  setGenerator
+	listG listGenerator
+}
+
+// Clone generates an expression of the given type which evaluates to a deep
+// copy of v.
+func (c *cloneGenerator) Clone(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if isPrimitiveType(spec) {
+		return v, nil
+	}
+
+	switch s := spec.(type) {
+	case *compile.BinarySpec:
+		if isReaderBinary(s) {
+			// Readers cannot be copied without consuming them so clones
+			// share them with the original.
+			return v, nil
+		}
+		clone, err := c.binary(g, s)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	case *compile.MapSpec:
+		clone, err := c.mapG.Clone(g, s)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	case *compile.ListSpec:
+		clone, err := c.listG.Clone(g, s)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	case *compile.SetSpec:
+		clone, err := c.setG.Clone(g, s)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	default:
+		// Custom defined type
+		return fmt.Sprintf("%s.Clone()", v), nil
+	}
+}
+
+// ClonePtr is the same as Clone except v is expected to be a reference to a
+// value of the given type.
+func (c *cloneGenerator) ClonePtr(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if !isPrimitiveType(spec) {
+		// Everything else is already a reference type.
+		return c.Clone(g, spec, v)
+	}
+
+	name := clonePtrFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$type := typeReference .Spec>
+			<$v := newVar "v">
+			func <.Name>(<$v> *<$type>) *<$type> {
+				if <$v> == nil {
+					return nil
+				}
+				<$x := newVar "x">
+				<$x> := *<$v>
+				return &<$x>
+			}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+	)
+	return fmt.Sprintf("%s(%s)", name, v), err
+}
+
+// binary generates a function to copy byte slices, and returns its name.
+//
+// Copies of non-nil empty slices are non-nil so that optional binary fields
+// that are set remain set.
+func (c *cloneGenerator) binary(g Generator, spec *compile.BinarySpec) (string, error) {
+	name := cloneFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$v := newVar "v">
+			func <.>(<$v> []byte) []byte {
+				if <$v> == nil {
+					return nil
+				}
+				return append(make([]byte, 0, len(<$v>)), <$v>...)
+			}
+		`, name)
+	return name, err
+}
+
+func shallowCopy(spec *compile.FieldSpec) bool {
+	_, ok := spec.Annotations[ShallowCopyLabel]
+	return ok
+}
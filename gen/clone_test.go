@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneNil(t *testing.T) {
+	var s *ts.Frame
+	assert.Nil(t, s.Clone())
+
+	var d *td.UUID
+	assert.Nil(t, d.Clone())
+
+	var l td.EventGroup
+	assert.Nil(t, l.Clone())
+}
+
+func TestCloneIsDeep(t *testing.T) {
+	give := &tc.PrimitiveContainers{
+		ListOfBinary:     [][]byte{[]byte("foo")},
+		ListOfInts:       []int64{1, 2},
+		SetOfStrings:     map[string]struct{}{"foo": {}},
+		MapOfIntToString: map[int32]string{1: "foo"},
+	}
+	clone := give.Clone()
+	assert.Equal(t, give, clone)
+
+	clone.ListOfBinary[0][0] = 'g'
+	clone.ListOfInts[0] = 3
+	clone.SetOfStrings["bar"] = struct{}{}
+	clone.MapOfIntToString[1] = "bar"
+
+	assert.Equal(t, &tc.PrimitiveContainers{
+		ListOfBinary:     [][]byte{[]byte("foo")},
+		ListOfInts:       []int64{1, 2},
+		SetOfStrings:     map[string]struct{}{"foo": {}},
+		MapOfIntToString: map[int32]string{1: "foo"},
+	}, give, "original must not change")
+}
+
+func TestCloneNestedStructs(t *testing.T) {
+	give := &ts.Frame{
+		TopLeft: &ts.Point{X: 1, Y: 2},
+		Size:    &ts.Size{Width: 3, Height: 4},
+	}
+	clone := give.Clone()
+	assert.Equal(t, give, clone)
+
+	clone.TopLeft.X = 5
+	assert.Equal(t, float64(1), give.TopLeft.X, "original must not change")
+}
+
+func TestCloneOptionalFields(t *testing.T) {
+	give := &ts.PrimitiveOptionalStruct{
+		StringField: ptr.String("foo"),
+		BinaryField: []byte{},
+	}
+	clone := give.Clone()
+	assert.Equal(t, give, clone)
+	assert.NotNil(t, clone.BinaryField, "empty binary fields must remain set")
+	assert.Nil(t, clone.Int32Field)
+
+	*clone.StringField = "bar"
+	assert.Equal(t, "foo", *give.StringField, "original must not change")
+}
+
+func TestCloneShallowCopy(t *testing.T) {
+	give := &ts.ShallowCopyStruct{
+		Deep:    []byte("foo"),
+		Shallow: []byte("bar"),
+		Points:  []*ts.Point{{X: 1, Y: 2}},
+	}
+	clone := give.Clone()
+	assert.Equal(t, give, clone)
+
+	clone.Deep[0] = 'g'
+	clone.Shallow[0] = 'c'
+	clone.Points[0].X = 3
+
+	assert.Equal(t, []byte("foo"), give.Deep, "deep copies must not share contents")
+	assert.Equal(t, []byte("car"), give.Shallow, "shallow copies must share contents")
+	assert.Equal(t, float64(3), give.Points[0].X, "shallow copies must share contents")
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go.uber.org/thriftrw/compile"
+)
+
+const codecPackage = "go.uber.org/thriftrw/codec"
+
+// compact returns true if the ToWire and FromWire methods of this field
+// group should delegate to a table describing its fields. This is the case
+// with CompactCodegen unless a field needs special handling: custom Go
+// types, lazy fields, presence bitmaps, and defaults of required fields and
+// of unions.
+func (f fieldGroupGenerator) compact(g Generator) (bool, error) {
+	if !checkCompactCodegen(g) || f.HasPresenceFields() {
+		return false, nil
+	}
+
+	for _, field := range f.Fields {
+		if field.Default != nil && (field.Required || f.IsUnion) {
+			return false, nil
+		}
+
+		if lazy, err := lazyField(field); err != nil || lazy {
+			return false, err
+		}
+
+		if m, err := mappedField(g, field); err != nil || m != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// compactDescriptor declares the table describing the fields of this field
+// group. It is populated by an init function because the codecs of fields
+// may refer back to the struct.
+func (f fieldGroupGenerator) compactDescriptor(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$codec := import "go.uber.org/thriftrw/codec">
+		<$v := newVar "v">
+
+		var _<.Name>_Descriptor *<$codec>.Struct
+
+		func init() {
+			_<.Name>_Descriptor = &<$codec>.Struct{
+				Name: "<.Name>",
+				<- if .IsUnion>
+					IsUnion: true,
+				<- end>
+				<- if .AllowEmptyUnion>
+					AllowEmptyUnion: true,
+				<- end>
+				Fields: []<$codec>.Field{
+					<range .Fields ->
+					{
+						ID: <.ID>,
+						Name: "<goName .>",
+						<- if .Required>
+							Required: true,
+						<- end>
+						Codec: <fieldCodec .>,
+						Ptr: func(<$v> interface{}) interface{} { return &<$v>.(*<$.Name>).<goName .> },
+					},
+					<end>
+				},
+			}
+		}
+		`, f, TemplateFunc("fieldCodec", fieldCodec))
+}
+
+// fieldCodec returns a reference to the codec.Codec used for values of the
+// given field, declaring it if necessary.
+func fieldCodec(g Generator, f *compile.FieldSpec) (string, error) {
+	// Required fields hold values and optional fields hold pointers to
+	// primitives. Other types are nillable either way.
+	ptr := !f.Required && isPrimitiveType(f.Type)
+
+	var base string
+	switch s := f.Type.(type) {
+	case *compile.BoolSpec:
+		base = "Bool"
+	case *compile.I8Spec:
+		base = "Int8"
+	case *compile.I16Spec:
+		base = "Int16"
+	case *compile.I32Spec:
+		base = "Int32"
+	case *compile.I64Spec:
+		base = "Int64"
+	case *compile.DoubleSpec:
+		base = "Double"
+	case *compile.StringSpec:
+		base = "String"
+	case *compile.BinarySpec:
+		switch s.Annotations[goTypeKey] {
+		case stringType:
+			base = "String"
+		case readerType:
+			// Readers use a generated codec.
+		default:
+			return g.Import(codecPackage) + ".Binary", nil
+		}
+	}
+	if base != "" {
+		if ptr {
+			base += "Ptr"
+		}
+		return g.Import(codecPackage) + "." + base, nil
+	}
+
+	goType, err := fieldTypeReference(g, f)
+	if err != nil {
+		return "", err
+	}
+
+	name := "_" + g.MangleType(f.Type) + "_Codec"
+	if ptr {
+		name = "_" + g.MangleType(f.Type) + "_PtrCodec"
+	}
+
+	err = g.EnsureDeclared(
+		`
+		<$codec := import "go.uber.org/thriftrw/codec">
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$p := newVar "p">
+		<$w := newVar "w">
+		<$x := printf "(*%s.(*%s))" $p .GoType>
+
+		var <.Name> = &<$codec>.Codec{
+			Type: <typeCode .Spec>,
+			IsSet: func(<$p> interface{}) bool {
+				return <if .Nillable><$x> != nil<else>true<end>
+			},
+			ToWire: func(<$p> interface{}) (<$wire>.Value, error) {
+				return <if .Ptr><toWirePtr .Spec $x><else><toWire .Spec $x><end>
+			},
+			FromWire: func(<$p> interface{}, <$w> <$wire>.Value) (err error) {
+				<if .Ptr ->
+					<fromWirePtr .Spec $x $w>
+				<- else ->
+					<$x>, err = <fromWire .Spec $w>
+				<- end>
+				return err
+			},
+		}
+		`,
+		struct {
+			Name     string
+			Spec     compile.TypeSpec
+			GoType   string
+			Ptr      bool
+			Nillable bool
+		}{
+			Name:     name,
+			Spec:     f.Type,
+			GoType:   goType,
+			Ptr:      ptr,
+			Nillable: ptr || isReferenceType(f.Type) || isStructType(f.Type),
+		})
+	return name, err
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tco "go.uber.org/thriftrw/gen/internal/tests/compact"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestCompactDefaults(t *testing.T) {
+	shape := tco.Shape{Name: "foo", Points: tco.Points{}}
+	_, err := shape.ToWire()
+	require.NoError(t, err)
+	if assert.NotNil(t, shape.Color) {
+		assert.Equal(t, tco.ColorBlue, *shape.Color, "ToWire must fill in defaults")
+	}
+
+	var got tco.Shape
+	require.NoError(t, got.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{}))},
+		{ID: 5, Value: wire.NewValueI32(0)},
+	}})))
+	if assert.NotNil(t, got.Color) {
+		assert.Equal(t, tco.ColorBlue, *got.Color, "FromWire must fill in defaults")
+	}
+}
+
+func TestCompactErrors(t *testing.T) {
+	t.Run("ToWire missing required field", func(t *testing.T) {
+		_, err := (&tco.Shape{Name: "foo"}).ToWire()
+		assert.EqualError(t, err, "field Points of Shape is required")
+	})
+
+	t.Run("FromWire missing required field", func(t *testing.T) {
+		var p tco.Point
+		err := p.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueDouble(1)},
+		}}))
+		assert.EqualError(t, err, "field Y of Point is required")
+	})
+
+	t.Run("FromWire ignores mismatched types", func(t *testing.T) {
+		var p tco.Point
+		err := p.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueDouble(1)},
+			{ID: 2, Value: wire.NewValueString("foo")},
+		}}))
+		assert.EqualError(t, err, "field Y of Point is required")
+	})
+
+	t.Run("empty union", func(t *testing.T) {
+		_, err := (&tco.Geometry{}).ToWire()
+		assert.EqualError(t, err, "Geometry should have exactly one field: got 0 fields")
+
+		var g tco.Geometry
+		err = g.FromWire(wire.NewValueStruct(wire.Struct{}))
+		assert.EqualError(t, err, "Geometry should have exactly one field: got 0 fields")
+	})
+
+	t.Run("union with multiple fields", func(t *testing.T) {
+		_, err := (&tco.Geometry{
+			Point: &tco.Point{},
+			Shape: &tco.Shape{Points: tco.Points{}},
+		}).ToWire()
+		assert.EqualError(t, err, "Geometry should have exactly one field: got 2 fields")
+	})
+
+	t.Run("nested error", func(t *testing.T) {
+		var g tco.Geometry
+		err := g.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueStruct(wire.Struct{})},
+		}}))
+		assert.EqualError(t, err, "missing required fields: "+
+			"field X of Point is required; field Y of Point is required")
+	})
+}
+
+func TestCompactFallback(t *testing.T) {
+	tests := []struct {
+		desc   string
+		field  *compile.FieldSpec
+		union  bool
+		noFlag bool
+		want   bool
+	}{
+		{
+			desc:  "optional field",
+			field: &compile.FieldSpec{Name: "foo", Type: &compile.StringSpec{}},
+			want:  true,
+		},
+		{
+			desc: "optional field with default",
+			field: &compile.FieldSpec{
+				Name:    "foo",
+				Type:    &compile.StringSpec{},
+				Default: compile.ConstantString("bar"),
+			},
+			want: true,
+		},
+		{
+			desc:   "without flag",
+			field:  &compile.FieldSpec{Name: "foo", Type: &compile.StringSpec{}},
+			noFlag: true,
+		},
+		{
+			desc: "required field with default",
+			field: &compile.FieldSpec{
+				Name:     "foo",
+				Type:     &compile.StringSpec{},
+				Required: true,
+				Default:  compile.ConstantString("bar"),
+			},
+		},
+		{
+			desc: "union field with default",
+			field: &compile.FieldSpec{
+				Name:    "foo",
+				Type:    &compile.StringSpec{},
+				Default: compile.ConstantString("bar"),
+			},
+			union: true,
+		},
+		{
+			desc: "lazy field",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.BinarySpec{},
+				Annotations: compile.Annotations{"go.lazy": "true"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f := fieldGroupGenerator{
+				Name:    "Foo",
+				Fields:  compile.FieldGroup{tt.field},
+				IsUnion: tt.union,
+			}
+			g := NewGenerator(&GeneratorOptions{
+				PackageName:    "foo",
+				CompactCodegen: !tt.noFlag,
+			})
+			got, err := f.compact(g)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
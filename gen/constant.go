@@ -22,6 +22,7 @@ package gen
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 
 	"go.uber.org/thriftrw/compile"
@@ -29,16 +30,53 @@ import (
 
 // Constant generates code for `const` expressions in Thrift files.
 func Constant(g Generator, c *compile.Constant) error {
+	if checkLazyConstants(g) && !canBeConstant(c.Type) {
+		return wrapGenerateError(c.Name, lazyConstant(g, c))
+	}
+
 	err := g.DeclareFromTemplate(
 		`<formatDoc .Doc><if canBeConstant .Type>const<else>var<end> <constantName .Name> <typeReference .Type> = <constantValue .Value .Type>`,
 		c,
 		TemplateFunc("constantValue", ConstantValue),
 		TemplateFunc("canBeConstant", canBeConstant),
-		TemplateFunc("constantName", constantName),
+		TemplateFunc("constantName", checkCasing(g).constantName),
 	)
 	return wrapGenerateError(c.Name, err)
 }
 
+// lazyConstant generates a function which builds the value of the given
+// constant the first time it's called.
+func lazyConstant(g Generator, c *compile.Constant) error {
+	return g.DeclareFromTemplate(
+		`
+		<$sync := import "sync">
+		<$name := constantName .Name>
+		<$once := printf "_%v_once" $name>
+		<$value := printf "_%v_value" $name>
+
+		var (
+			<$once> <$sync>.Once
+			<$value> <typeReference .Type>
+		)
+
+		<if .Doc><formatDoc .Doc>//
+		<end ->
+		// <$name> returns the value of the <.Name> constant. It's built the
+		// first time it's requested and shared by all callers, so it must not
+		// be modified.
+		func <$name>() <typeReference .Type> {
+			<$once>.Do(func() {
+				<$value> = <constantValue .Value .Type>
+			})
+			return <$value>
+		}
+		`,
+		c,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantName", checkCasing(g).constantName),
+	)
+}
+
 // ConstantValue generates an expression containing the given constant value of
 // the given type.
 //
@@ -64,10 +102,9 @@ func ConstantValue(g Generator, c compile.ConstantValue, t compile.TypeSpec) (st
 	case compile.EnumItemReference:
 		return enumItemReference(g, v, t)
 	case compile.ConstReference:
-		if canBeConstant(v.Target.Type) {
-			return g.LookupConstantName(v.Target)
-		}
-		return ConstantValue(g, v.Target.Value, v.Target.Type)
+		return constReference(g, v, t)
+	case compile.ServiceReference:
+		return strconv.Quote(v.Service.Name), nil
 	default:
 		panic(fmt.Sprintf("Unknown constant value %v (%T)", c, c))
 	}
@@ -183,6 +220,37 @@ func constantSet(g Generator, v compile.ConstantSet, t compile.TypeSpec) (string
 
 func constantStruct(g Generator, v *compile.ConstantStruct, t compile.TypeSpec) (string, error) {
 	fields := compile.RootTypeSpec(t).(*compile.StructSpec).Fields
+	for _, name := range sortStringKeys(v.Fields) {
+		if f, err := fields.FindByName(name); err == nil {
+			// Conversions into custom types may fail, so they cannot be
+			// used in Go constants.
+			if m, err := mappedField(g, f); err != nil {
+				return "", err
+			} else if m != nil {
+				return "", fmt.Errorf(
+					"field %q of %q cannot be set in a constant: it uses a custom type",
+					name, t.ThriftName())
+			}
+		}
+	}
+
+	bits, err := presenceBits(g, compile.RootTypeSpec(t).(*compile.StructSpec))
+	if err != nil {
+		return "", err
+	}
+	// Fields stored with a presence bitmap and lazy fields may only be set
+	// with their setters.
+	usesSetter := func(f *compile.FieldSpec) bool {
+		_, ok := bits[f]
+		lazy, _ := lazyField(f)
+		return ok || lazy
+	}
+	for name := range v.Fields {
+		if f, err := fields.FindByName(name); err == nil && usesSetter(f) {
+			return constantStructWithSetters(g, v, t, usesSetter)
+		}
+	}
+
 	return g.TextTemplate(
 		`
 		<- $fields := .Fields ->
@@ -205,9 +273,96 @@ func constantStruct(g Generator, v *compile.ConstantStruct, t compile.TypeSpec)
 	)
 }
 
+// constantStructWithSetters generates a constant struct which sets fields
+// that may only be set with their setters, so the struct is built inside a
+// function literal.
+func constantStructWithSetters(g Generator, v *compile.ConstantStruct, t compile.TypeSpec, usesSetter func(*compile.FieldSpec) bool) (string, error) {
+	type fieldValue struct {
+		Field *compile.FieldSpec
+		Value compile.ConstantValue
+	}
+
+	fields := compile.RootTypeSpec(t).(*compile.StructSpec).Fields
+	var literal, setters []fieldValue
+	for _, name := range sortStringKeys(v.Fields) {
+		f, err := fields.FindByName(name)
+		if err != nil {
+			return "", err
+		}
+		if usesSetter(f) {
+			setters = append(setters, fieldValue{Field: f, Value: v.Fields[name]})
+		} else {
+			literal = append(literal, fieldValue{Field: f, Value: v.Fields[name]})
+		}
+	}
+
+	return g.TextTemplate(
+		`
+		<- $v := newVar "v" ->
+		func() *<typeName .Spec> {
+			<$v> := &<typeName .Spec>{
+				<range .Literal>
+					<- if and (not .Field.Required) (isPrimitiveType .Field.Type) ->
+						<goName .Field>: <constantValuePtr .Value .Field.Type>,
+					<- else ->
+						<goName .Field>: <constantValue .Value .Field.Type>,
+					<- end>
+				<end>
+			}
+			<range .Setters ->
+				<$v>.Set<goName .Field>(<constantValue .Value .Field.Type>)
+			<end ->
+			return <$v>
+		}()`, struct {
+			Spec    compile.TypeSpec
+			Literal []fieldValue
+			Setters []fieldValue
+		}{Spec: t, Literal: literal, Setters: setters},
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+	)
+}
+
+func constReference(g Generator, v compile.ConstReference, t compile.TypeSpec) (string, error) {
+	if !canBeConstant(v.Target.Type) {
+		// Only primitives are declared as Go constants. Other constants are
+		// mutable so every reference gets its own copy of the value.
+		return ConstantValue(g, v.Target.Value, t)
+	}
+
+	s, err := g.LookupConstantName(v.Target)
+	if err != nil {
+		return "", err
+	}
+	if !sameConstantType(t, v.Target.Type) {
+		s, err = castConstant(g, t, s)
+	}
+	return s, err
+}
+
+// sameConstantType returns true if constants of the given types have the same
+// Go type.
+func sameConstantType(l, r compile.TypeSpec) bool {
+	if l == r {
+		return true
+	}
+
+	// Typedefs and enums are named types so they must be the same TypeSpec.
+	// Primitive types may be represented by different TypeSpecs.
+	switch l.(type) {
+	case *compile.TypedefSpec, *compile.EnumSpec:
+		return false
+	}
+	switch r.(type) {
+	case *compile.TypedefSpec, *compile.EnumSpec:
+		return false
+	}
+	return reflect.TypeOf(l) == reflect.TypeOf(r)
+}
+
 func enumItemReference(g Generator, v compile.EnumItemReference, t compile.TypeSpec) (_ string, err error) {
 	s, err := g.TextTemplate(`<enumItemName (typeName .Enum) .Item>`,
-		v, TemplateFunc("enumItemName", enumItemName))
+		v, TemplateFunc("enumItemName", checkCasing(g).enumItemName))
 	if err != nil {
 		return "", err
 	}
@@ -221,6 +376,9 @@ func enumItemReference(g Generator, v compile.EnumItemReference, t compile.TypeS
 // type $t.
 func ConstantValuePtr(g Generator, c compile.ConstantValue, t compile.TypeSpec) (string, error) {
 	var ptrFunc string
+	if !isPrimitiveType(t) {
+		return ConstantValue(g, c, t)
+	}
 
 	switch t.(type) {
 	case *compile.BoolSpec:
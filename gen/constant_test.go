@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	tk "go.uber.org/thriftrw/gen/internal/tests/constants"
+	tlc "go.uber.org/thriftrw/gen/internal/tests/lazyconsts"
 	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
 	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
@@ -270,6 +271,16 @@ func TestConstants(t *testing.T) {
 			tk.BeginningOfTime,
 			td.Timestamp(0),
 		},
+		{
+			"endOfFirstDay",
+			tk.EndOfFirstDay,
+			td.Timestamp(86400),
+		},
+		{
+			"startOfDay",
+			tk.StartOfDay,
+			int64(0),
+		},
 		{
 			"frameGroup",
 			tk.FrameGroup,
@@ -306,6 +317,27 @@ func TestConstants(t *testing.T) {
 	}
 }
 
+func TestImportedConstantDefaults(t *testing.T) {
+	var w tk.Window
+	assert.Equal(t, tok.StartOfDay, w.GetStart())
+	assert.Equal(t, td.Timestamp(tok.OneDay), w.GetFinish())
+	assert.Equal(t, tok.OneDay, w.GetLength())
+	assert.Equal(t, tk.UUID, w.GetID())
+	assert.False(t, w.GetID() == tk.UUID, "default must not share the constant")
+
+	wireVal, err := w.ToWire()
+	require.NoError(t, err)
+
+	var got tk.Window
+	require.NoError(t, got.FromWire(wireVal))
+	assert.Equal(t, &tk.Window{
+		Start:  (*td.Timestamp)(int64p(0)),
+		Finish: (*td.Timestamp)(int64p(86400)),
+		Length: int64p(86400),
+		ID:     &td.UUID{High: 1234, Low: 5678},
+	}, &got)
+}
+
 func TestConstantsMutation(t *testing.T) {
 	originalX := tok.SomePoint.X
 
@@ -326,3 +358,20 @@ func TestConstantsMutation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, g.Edges[0].StartPoint.X, originalX)
 }
+
+func TestLazyConstants(t *testing.T) {
+	origin := &tlc.Point{X: 0, Y: 0}
+	unit := &tlc.Point{X: 10, Y: 10}
+
+	assert.Equal(t, origin, tlc.Origin())
+	assert.Equal(t, &tlc.Path{
+		Name:   stringp("diagonal"),
+		Points: []*tlc.Point{origin, unit},
+	}, tlc.Diagonal())
+	assert.Equal(t, tlc.Names{"a", "b"}, tlc.DefaultNames())
+	assert.Equal(t, map[int32]struct{}{2: {}, 3: {}, 5: {}}, tlc.Primes())
+	assert.Equal(t, map[string]*tlc.Point{"origin": origin, "unit": unit}, tlc.Corners())
+
+	assert.True(t, tlc.Origin() == tlc.Origin(), "values must be built once")
+	assert.False(t, tlc.Corners()["origin"] == tlc.Origin(), "references must not share values")
+}
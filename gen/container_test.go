@@ -21,12 +21,15 @@
 package gen
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/protocol"
 	"go.uber.org/thriftrw/wire"
 
 	"github.com/stretchr/testify/assert"
@@ -1394,3 +1397,67 @@ func TestEmptyContainersRoundTrip(t *testing.T) {
 		assert.Empty(t, got.MapOfIntToString)
 	})
 }
+
+func TestContainersDecodeLength(t *testing.T) {
+	t.Run("larger than preallocated", func(t *testing.T) {
+		give := tc.PrimitiveContainers{
+			ListOfInts:       make([]int64, 0, 2000),
+			SetOfStrings:     make(map[string]struct{}, 2000),
+			MapOfIntToString: make(map[int32]string, 2000),
+		}
+		for i := 0; i < 2000; i++ {
+			give.ListOfInts = append(give.ListOfInts, int64(i))
+			give.SetOfStrings[fmt.Sprint(i)] = struct{}{}
+			give.MapOfIntToString[int32(i)] = fmt.Sprint(i)
+		}
+
+		w, err := give.ToWire()
+		require.NoError(t, err)
+
+		var buff bytes.Buffer
+		require.NoError(t, protocol.Binary.Encode(w, &buff))
+
+		var got tc.PrimitiveContainers
+		require.NoError(t, got.Decode(protocol.BinaryStreamer.Reader(bytes.NewReader(buff.Bytes()))))
+		assert.Equal(t, give, got)
+	})
+
+	// Lengths read off the wire must not be trusted for allocation.
+	tests := []struct {
+		desc string
+		give []byte
+	}{
+		{
+			desc: "list",
+			give: []byte{
+				0x0f, 0x00, 0x02, // field 2: list
+				0x0a,                   // type: i64
+				0x7f, 0xff, 0xff, 0xff, // length
+			},
+		},
+		{
+			desc: "set",
+			give: []byte{
+				0x0e, 0x00, 0x03, // field 3: set
+				0x0b,                   // type: binary
+				0x7f, 0xff, 0xff, 0xff, // length
+			},
+		},
+		{
+			desc: "map",
+			give: []byte{
+				0x0d, 0x00, 0x05, // field 5: map
+				0x08, 0x0b, // types: i32, binary
+				0x7f, 0xff, 0xff, 0xff, // length
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got tc.PrimitiveContainers
+			err := got.Decode(protocol.BinaryStreamer.Reader(bytes.NewReader(tt.give)))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// deprecatedAnnotation marks a declaration as deprecated. Its value, if
+// any, explains what to use instead.
+//
+// 	enum Status {
+// 	  ACTIVE,
+// 	  SUSPENDED (deprecated = "Use DISABLED instead."),
+// 	  DISABLED,
+// 	}
+const deprecatedAnnotation = "deprecated"
+
+// deprecatedDoc appends a "Deprecated:" paragraph to the given
+// documentation if the annotations mark its declaration as deprecated.
+func deprecatedDoc(doc string, annotations compile.Annotations) string {
+	msg, ok := annotations[deprecatedAnnotation]
+	if !ok {
+		return doc
+	}
+	if msg == "" {
+		msg = "Do not use."
+	}
+	if doc != "" {
+		doc += "\n\n"
+	}
+	return doc + "Deprecated: " + msg
+}
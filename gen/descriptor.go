@@ -0,0 +1,167 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+const dynamicPackage = "go.uber.org/thriftrw/dynamic"
+
+// reservedDescriptorIdentifiers are additionally reserved for fields of
+// structs generated with Descriptors.
+var reservedDescriptorIdentifiers = map[string]struct{}{
+	"ThriftDescriptor": {},
+}
+
+// Descriptor generates the ThriftDescriptor method of this group, which
+// describes its Thrift type for the dynamic package.
+func (f fieldGroupGenerator) Descriptor(g Generator) error {
+	for _, field := range f.Fields {
+		name, err := goName(g, field)
+		if err != nil {
+			return err
+		}
+		if _, reserved := reservedDescriptorIdentifiers[name]; reserved {
+			return fmt.Errorf("%q is a reserved ThriftRW identifier", name)
+		}
+	}
+
+	if f.ThriftName == "" {
+		f.ThriftName = f.Name
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$dynamic := import "go.uber.org/thriftrw/dynamic">
+
+		var _<.Name>_ThriftDescriptor = &<$dynamic>.StructType{
+			Name: "<.ThriftName>",
+			<- if .IsUnion>
+				IsUnion: true,
+			<- end>
+			<- if .AllowEmptyUnion>
+				AllowEmptyUnion: true,
+			<- end>
+			<- if .IsException>
+				IsException: true,
+			<- end>
+		}
+
+		<if .Fields ->
+		func init() {
+			_<.Name>_ThriftDescriptor.Fields = []<$dynamic>.Field{
+				<range .Fields ->
+				{
+					ID: <.ID>,
+					Name: "<.Name>",
+					<- if .Required>
+						Required: true,
+					<- end>
+					Type: <descriptorType .Type>,
+				},
+				<end>
+			}
+		}
+		<- end>
+
+		// ThriftDescriptor describes the Thrift type of <.Name> for the
+		// dynamic package.
+		func (*<.Name>) ThriftDescriptor() *<$dynamic>.StructType {
+			return _<.Name>_ThriftDescriptor
+		}
+		`,
+		f,
+		TemplateFunc("descriptorType", descriptorType),
+	)
+}
+
+// descriptorType returns an expression which evaluates to the
+// dynamic.Type describing the given type.
+func descriptorType(g Generator, spec compile.TypeSpec) (string, error) {
+	dynamic := g.Import(dynamicPackage)
+
+	var kind string
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		kind = "Bool"
+	case *compile.I8Spec:
+		kind = "I8"
+	case *compile.I16Spec:
+		kind = "I16"
+	case *compile.I32Spec, *compile.EnumSpec:
+		kind = "I32"
+	case *compile.I64Spec:
+		kind = "I64"
+	case *compile.DoubleSpec:
+		kind = "Double"
+	case *compile.StringSpec:
+		kind = "String"
+	case *compile.BinarySpec:
+		switch s.Annotations[goTypeKey] {
+		case stringType:
+			// The dynamic package accesses values by their Go
+			// representation.
+			kind = "String"
+		case readerType:
+			return "", fmt.Errorf("cannot describe %v: (%v = %q) is not supported "+
+				"by the dynamic package", spec.ThriftName(), goTypeKey, readerType)
+		default:
+			kind = "Binary"
+		}
+	case *compile.StructSpec:
+		name, err := typeName(g, s)
+		return fmt.Sprintf("%s.Type{Kind: %s.Struct, Struct: (*%s)(nil).ThriftDescriptor()}",
+			dynamic, dynamic, name), err
+	case *compile.ListSpec:
+		return elemDescriptorType(g, "List", nil, s.ValueSpec)
+	case *compile.SetSpec:
+		return elemDescriptorType(g, "Set", nil, s.ValueSpec)
+	case *compile.MapSpec:
+		return elemDescriptorType(g, "Map", s.KeySpec, s.ValueSpec)
+	default:
+		return "", fmt.Errorf("cannot describe %v", spec.ThriftName())
+	}
+	return fmt.Sprintf("%s.Type{Kind: %s.%s}", dynamic, dynamic, kind), nil
+}
+
+// elemDescriptorType returns an expression which evaluates to the
+// dynamic.Type describing a container of the given kind. key is nil for
+// lists and sets.
+func elemDescriptorType(g Generator, kind string, key, value compile.TypeSpec) (string, error) {
+	dynamic := g.Import(dynamicPackage)
+
+	v, err := descriptorType(g, value)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return fmt.Sprintf("%s.Type{Kind: %s.%s, Value: &%s}", dynamic, dynamic, kind, v), nil
+	}
+
+	k, err := descriptorType(g, key)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s.Type{Kind: %s.%s, Key: &%s, Value: &%s}", dynamic, dynamic, kind, k, v), nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+	td "go.uber.org/thriftrw/gen/internal/tests/descriptors"
+	"go.uber.org/thriftrw/ptr"
+)
+
+func TestDescriptors(t *testing.T) {
+	user := (*td.User)(nil).ThriftDescriptor()
+	assert.Equal(t, "User", user.Name)
+	require.Len(t, user.Fields, 6)
+
+	name := user.FieldByName("name")
+	require.NotNil(t, name)
+	assert.Equal(t, int16(1), name.ID)
+	assert.True(t, name.Required)
+	assert.Equal(t, dynamic.String, name.Type.Kind, "typedefs must be resolved")
+
+	assert.Equal(t, dynamic.I32, user.FieldByName("status").Type.Kind, "enums must be i32")
+	assert.Equal(t, "list<User>", user.FieldByName("friends").Type.String())
+	assert.True(t, user.FieldByName("friends").Type.Value.Struct == user,
+		"recursive references must point to the same descriptor")
+	assert.Equal(t, "map<string, Attribute>", user.FieldByName("attributes").Type.String())
+	assert.Equal(t, "set<string>", user.FieldByName("aliases").Type.String())
+
+	attr := (*td.Attribute)(nil).ThriftDescriptor()
+	assert.True(t, attr.IsUnion)
+	assert.Equal(t, "map<i32, list<string>>", attr.FieldByName("tags").Type.String())
+
+	assert.True(t, (*td.UserNotFound)(nil).ThriftDescriptor().IsException)
+	assert.Empty(t, (*td.Empty)(nil).ThriftDescriptor().Fields)
+
+	args := (*td.UserService_GetUser_Args)(nil).ThriftDescriptor()
+	assert.Equal(t, "getUser_args", args.Name)
+	result := (*td.UserService_GetUser_Result)(nil).ThriftDescriptor()
+	assert.Equal(t, "getUser_result", result.Name)
+	assert.True(t, result.IsUnion)
+	assert.False(t, result.AllowEmptyUnion)
+	assert.True(t, (*td.UserService_Ping_Args)(nil).ThriftDescriptor() != nil)
+}
+
+func TestDescriptorsDynamicRoundTrip(t *testing.T) {
+	give := &td.User{
+		Name:    "alice",
+		Status:  td.StatusDisabled.Ptr(),
+		ID:      ptr.Int64(42),
+		Friends: []*td.User{{Name: "bob"}},
+		Attributes: map[string]*td.Attribute{
+			"admin": {Flag: ptr.Bool(true)},
+		},
+		Aliases: td.UserNames{"al": {}},
+	}
+
+	v, err := dynamic.ValueOf(give)
+	require.NoError(t, err)
+
+	name, ok := v.Get("name")
+	require.True(t, ok)
+	assert.Equal(t, "alice", name)
+
+	status, ok := v.Get("status")
+	require.True(t, ok)
+	assert.Equal(t, int32(td.StatusDisabled), status)
+
+	require.NoError(t, v.Set("name", "carol"))
+	v.Unset("id")
+
+	var got td.User
+	require.NoError(t, v.CopyTo(&got))
+
+	want := *give
+	want.Name = "carol"
+	want.ID = nil
+	assert.True(t, want.Equals(&got), "expected %v, got %v", &want, &got)
+
+	err = v.CopyTo(&td.Attribute{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot copy User into *descriptors.Attribute")
+}
+
+func TestDescriptorsReservedName(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-descriptors")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	path := filepath.Join(thriftRoot, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(
+		`struct Foo { 1: optional i32 x (go.name = "ThriftDescriptor") }`), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+		Descriptors:   true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"ThriftDescriptor" is a reserved ThriftRW identifier`)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/idl"
+	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/lint"
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
+)
+
+// ValidationError is returned by Generate if plugins found problems with the
+// Thrift files for which code was to be generated.
+type ValidationError struct {
+	// Problems reported by the plugins.
+	Problems []lint.Problem
+}
+
+func (e *ValidationError) Error() string {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "plugins found %d problem(s) with the Thrift files:", len(e.Problems))
+	for _, p := range e.Problems {
+		fmt.Fprintf(&msg, "\n\t%v", p)
+	}
+	return msg.String()
+}
+
+// validateModules asks the given Validator to check the Thrift files for
+// which code will be generated. A *ValidationError listing the problems
+// found by it is returned if there are any.
+func validateModules(m *compile.Module, v plugin.Validator, o *Options) error {
+	req := &api.ValidateRequest{
+		ThriftFilePaths: []string{},
+		Declarations:    []*api.Declaration{},
+	}
+
+	add := func(m *compile.Module) error {
+		// The module has already been compiled, which may have allowed
+		// keywords to be used as field names.
+		prog, err := (&idl.Config{KeywordFieldNames: true}).Parse(m.Raw)
+		if err != nil {
+			return fmt.Errorf("could not parse %q: %v", m.ThriftPath, err)
+		}
+
+		req.ThriftFilePaths = append(req.ThriftFilePaths, m.ThriftPath)
+		req.Declarations = append(req.Declarations, buildDeclarations(m.ThriftPath, prog)...)
+		return nil
+	}
+
+	// Only the Thrift files for which code is generated are checked.
+	if o.NoRecurse || len(o.OutputFile) > 0 {
+		if err := add(m); err != nil {
+			return err
+		}
+	} else if err := m.Walk(add); err != nil {
+		return err
+	}
+
+	res, err := v.Validate(req)
+	if err != nil {
+		return err
+	}
+	if len(res.Diagnostics) == 0 {
+		return nil
+	}
+
+	problems := make([]lint.Problem, len(res.Diagnostics))
+	for i, d := range res.Diagnostics {
+		problems[i] = lint.Problem{
+			File:    d.ThriftFilePath,
+			Line:    int(d.Line),
+			Rule:    d.GetRule(),
+			Message: d.Message,
+		}
+	}
+	return &ValidationError{Problems: problems}
+}
+
+// buildDeclarations builds the plugin representation of the top-level
+// declarations of the given Thrift file.
+func buildDeclarations(path string, prog *ast.Program) []*api.Declaration {
+	decls := make([]*api.Declaration, 0, len(prog.Definitions))
+	for _, def := range prog.Definitions {
+		d := &api.Declaration{
+			Name:           def.Info().Name,
+			ThriftFilePath: path,
+			Line:           int32(def.Info().Line),
+		}
+
+		var (
+			anns []*ast.Annotation
+			doc  string
+		)
+		switch def := def.(type) {
+		case *ast.Constant:
+			d.Kind = api.DeclarationKindConstant
+			d.Type = ptr.String(def.Type.String())
+			doc = def.Doc
+		case *ast.Typedef:
+			d.Kind = api.DeclarationKindTypedef
+			d.Type = ptr.String(def.Type.String())
+			anns, doc = def.Annotations, def.Doc
+		case *ast.Enum:
+			d.Kind = api.DeclarationKindEnum
+			d.Members = make([]*api.Member, 0, len(def.Items))
+			for _, item := range def.Items {
+				m := buildMember(item.Name, item.Line, item.Annotations, item.Doc)
+				if item.Value != nil {
+					id := int32(*item.Value)
+					m.ID = &id
+				}
+				d.Members = append(d.Members, m)
+			}
+			anns, doc = def.Annotations, def.Doc
+		case *ast.Struct:
+			switch def.Type {
+			case ast.UnionType:
+				d.Kind = api.DeclarationKindUnion
+			case ast.ExceptionType:
+				d.Kind = api.DeclarationKindException
+			default:
+				d.Kind = api.DeclarationKindStruct
+			}
+			d.Members = make([]*api.Member, 0, len(def.Fields))
+			for _, f := range def.Fields {
+				m := buildMember(f.Name, f.Line, f.Annotations, f.Doc)
+				id := int32(f.ID)
+				m.ID = &id
+				m.Type = ptr.String(f.Type.String())
+				switch f.Requiredness {
+				case ast.Required:
+					m.IsRequired = ptr.Bool(true)
+				case ast.Optional:
+					m.IsRequired = ptr.Bool(false)
+				}
+				d.Members = append(d.Members, m)
+			}
+			anns, doc = def.Annotations, def.Doc
+		case *ast.Service:
+			d.Kind = api.DeclarationKindService
+			d.Members = make([]*api.Member, 0, len(def.Functions))
+			for _, f := range def.Functions {
+				m := buildMember(f.Name, f.Line, f.Annotations, f.Doc)
+				if f.ReturnType != nil {
+					m.Type = ptr.String(f.ReturnType.String())
+				}
+				d.Members = append(d.Members, m)
+			}
+			anns, doc = def.Annotations, def.Doc
+		default:
+			// senums are deprecated and not supported by ThriftRW.
+			continue
+		}
+
+		d.Annotations = buildAnnotations(anns)
+		if doc != "" {
+			d.Doc = ptr.String(doc)
+		}
+		decls = append(decls, d)
+	}
+	return decls
+}
+
+func buildMember(name string, line int, anns []*ast.Annotation, doc string) *api.Member {
+	m := &api.Member{
+		Name:        name,
+		Line:        int32(line),
+		Annotations: buildAnnotations(anns),
+	}
+	if doc != "" {
+		m.Doc = ptr.String(doc)
+	}
+	return m
+}
+
+func buildAnnotations(anns []*ast.Annotation) map[string]string {
+	if len(anns) == 0 {
+		return nil
+	}
+
+	m := make(map[string]string, len(anns))
+	for _, a := range anns {
+		m[a.Name] = a.Value
+	}
+	return m
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/idl"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
+)
+
+func TestBuildDeclarations(t *testing.T) {
+	prog, err := idl.Parse([]byte(`
+		const i32 Answer = 42
+
+		typedef string UUID (length = "36")
+
+		/** Colors. */
+		enum Color {
+			RED
+			GREEN = 2 (hex = "00ff00")
+		}
+
+		struct User {
+			1: required UUID id
+			2: optional list<string> emails
+			/** Name of the user. */
+			3: string name (go.redact)
+		}
+
+		union Contact { 1: string email }
+
+		exception NotFound {}
+
+		service Users {
+			User get(1: UUID id)
+			oneway void ping()
+		} (internal = "true")
+	`))
+	require.NoError(t, err)
+
+	want := []*api.Declaration{
+		{
+			Kind:           api.DeclarationKindConstant,
+			Name:           "Answer",
+			ThriftFilePath: "users.thrift",
+			Line:           2,
+			Type:           ptr.String("i32"),
+		},
+		{
+			Kind:           api.DeclarationKindTypedef,
+			Name:           "UUID",
+			ThriftFilePath: "users.thrift",
+			Line:           4,
+			Type:           ptr.String("string"),
+			Annotations:    map[string]string{"length": "36"},
+		},
+		{
+			Kind:           api.DeclarationKindEnum,
+			Name:           "Color",
+			ThriftFilePath: "users.thrift",
+			Line:           7,
+			Doc:            ptr.String("Colors."),
+			Members: []*api.Member{
+				{Name: "RED", Line: 8},
+				{Name: "GREEN", Line: 9, ID: ptr.Int32(2), Annotations: map[string]string{"hex": "00ff00"}},
+			},
+		},
+		{
+			Kind:           api.DeclarationKindStruct,
+			Name:           "User",
+			ThriftFilePath: "users.thrift",
+			Line:           12,
+			Members: []*api.Member{
+				{Name: "id", Line: 13, ID: ptr.Int32(1), IsRequired: ptr.Bool(true), Type: ptr.String("UUID")},
+				{Name: "emails", Line: 14, ID: ptr.Int32(2), IsRequired: ptr.Bool(false), Type: ptr.String("list<string>")},
+				{
+					Name:        "name",
+					Line:        16,
+					ID:          ptr.Int32(3),
+					Type:        ptr.String("string"),
+					Annotations: map[string]string{"go.redact": ""},
+					Doc:         ptr.String("Name of the user."),
+				},
+			},
+		},
+		{
+			Kind:           api.DeclarationKindUnion,
+			Name:           "Contact",
+			ThriftFilePath: "users.thrift",
+			Line:           19,
+			Members: []*api.Member{
+				{Name: "email", Line: 19, ID: ptr.Int32(1), Type: ptr.String("string")},
+			},
+		},
+		{
+			Kind:           api.DeclarationKindException,
+			Name:           "NotFound",
+			ThriftFilePath: "users.thrift",
+			Line:           21,
+			Members:        []*api.Member{},
+		},
+		{
+			Kind:           api.DeclarationKindService,
+			Name:           "Users",
+			ThriftFilePath: "users.thrift",
+			Line:           23,
+			Annotations:    map[string]string{"internal": "true"},
+			Members: []*api.Member{
+				{Name: "get", Line: 24, Type: ptr.String("User")},
+				{Name: "ping", Line: 25},
+			},
+		},
+	}
+
+	assert.Equal(t, want, buildDeclarations("users.thrift", prog))
+}
+
+func TestGenerateValidator(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-validator")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"shared.thrift": `typedef string UUID`,
+		"users.thrift": `
+			include "./shared.thrift"
+			struct user { 1: required shared.UUID id }
+		`,
+	}
+	module := compileThriftFiles(t, thriftRoot, files, "users.thrift")
+	usersPath := filepath.Join(thriftRoot, "users.thrift")
+	sharedPath := filepath.Join(thriftRoot, "shared.thrift")
+
+	tests := []struct {
+		desc      string
+		noRecurse bool
+		response  *api.ValidateResponse
+		err       error
+
+		wantPaths []string
+		wantError string
+	}{
+		{
+			desc:      "no problems",
+			response:  &api.ValidateResponse{},
+			wantPaths: []string{usersPath, sharedPath},
+		},
+		{
+			desc:      "no recurse",
+			noRecurse: true,
+			response:  &api.ValidateResponse{},
+			wantPaths: []string{usersPath},
+		},
+		{
+			desc: "problems",
+			response: &api.ValidateResponse{Diagnostics: []*api.Diagnostic{
+				{
+					ThriftFilePath: usersPath,
+					Line:           3,
+					Message:        `struct "user" must be capitalized`,
+					Rule:           ptr.String("naming"),
+				},
+				{
+					ThriftFilePath: sharedPath,
+					Message:        "missing namespace",
+					Rule:           ptr.String("namespaces"),
+				},
+			}},
+			wantPaths: []string{usersPath, sharedPath},
+			wantError: "plugins found 2 problem(s) with the Thrift files:\n" +
+				"\t" + usersPath + `:3: struct "user" must be capitalized (naming)` + "\n" +
+				"\t" + sharedPath + ":0: missing namespace (namespaces)",
+		},
+		{
+			desc:      "error",
+			err:       errors.New("great sadness"),
+			wantPaths: []string{usersPath, sharedPath},
+			wantError: "great sadness",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			outputDir, err := ioutil.TempDir("", "thriftrw-validator-out")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			var req *api.ValidateRequest
+			v := handletest.NewMockValidator(mockCtrl)
+			v.EXPECT().Validate(gomock.Any()).
+				Do(func(r *api.ValidateRequest) { req = r }).
+				Return(tt.response, tt.err)
+
+			handle := handletest.NewMockHandle(mockCtrl)
+			handle.EXPECT().Validator().Return(v)
+			if tt.wantError == "" {
+				handle.EXPECT().TypeMapper().Return(nil)
+				handle.EXPECT().ServiceGenerator().Return(nil)
+				handle.EXPECT().PostProcessor().Return(nil)
+			}
+
+			err = Generate(module, &Options{
+				OutputDir:     outputDir,
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+				NoRecurse:     tt.noRecurse,
+				Plugin:        handle,
+			})
+
+			if assert.NotNil(t, req) {
+				assert.Equal(t, tt.wantPaths, req.ThriftFilePaths)
+				assert.Len(t, req.Declarations, len(tt.wantPaths))
+			}
+
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.wantError, err.Error())
+			}
+			if tt.err == nil {
+				verr, ok := err.(*ValidationError)
+				if assert.True(t, ok, "expected a *ValidationError, got %T", err) {
+					assert.Len(t, verr.Problems, len(tt.response.Diagnostics))
+				}
+			}
+			_, err = os.Stat(filepath.Join(outputDir, "users"))
+			assert.True(t, os.IsNotExist(err), "code must not be generated")
+		})
+	}
+}
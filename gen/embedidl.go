@@ -26,9 +26,11 @@ import (
 	"sort"
 
 	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/version"
 )
 
-// embedIDL generate Go code with a full copy of the IDL embeded.
+// embedIDL generate Go code with a full copy of the IDL embeded, and
+// registers it with thriftreflect when the package is initialized.
 func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 	pkg, err := i.Package(m.ThriftPath)
 	if err != nil {
@@ -41,8 +43,8 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 
 	hash := sha1.Sum(m.Raw)
 	var includes []string
-	for _, v := range m.Includes {
-		importPath, err := i.Package(v.Module.ThriftPath)
+	for _, name := range sortStringKeys(m.Includes) {
+		importPath, err := i.Package(m.Includes[name].Module.ThriftPath)
 		if err != nil {
 			return wrapGenerateError("idl embedding", err)
 		}
@@ -56,6 +58,7 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 		Package  string
 		FilePath string
 		SHA1     string
+		Version  string
 		Includes []string
 		Raw      []byte
 	}{
@@ -63,6 +66,7 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 		Package:  pkg,
 		FilePath: packageRelPath,
 		SHA1:     hex.EncodeToString(hash[:]),
+		Version:  version.Version,
 		Includes: includes,
 		Raw:      m.Raw,
 	}
@@ -75,6 +79,7 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 			Package: "<.Package>",
 			FilePath: <printf "%q" .FilePath>,
 			SHA1: "<.SHA1>",
+			Version: "<.Version>",
 			<if .Includes ->
 				Includes: []*<$idl>.ThriftModule {<range .Includes>
 						<.>.ThriftModule, <end>
@@ -83,6 +88,10 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 			Raw: rawIDL,
 		}
 		const rawIDL = <printf "%q" .Raw>
+
+		func init() {
+			<$idl>.Register(ThriftModule)
+		}
 		`, data)
 	return wrapGenerateError("idl embedding", err)
 }
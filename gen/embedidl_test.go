@@ -32,6 +32,7 @@ import (
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
 	"go.uber.org/thriftrw/thriftreflect"
+	"go.uber.org/thriftrw/version"
 )
 
 func loadIDL(filename string) (string, string, error) {
@@ -80,3 +81,13 @@ func TestIDLEmbeddingInclude(t *testing.T) {
 		assert.Equal(t, te.ThriftModule, tm.Includes[0])
 	}
 }
+
+func TestIDLRegistry(t *testing.T) {
+	for _, tm := range []*thriftreflect.ThriftModule{te.ThriftModule, ts.ThriftModule} {
+		got, ok := thriftreflect.LookupPackage(tm.Package)
+		if assert.True(t, ok, "%v must be registered", tm.Package) {
+			assert.Equal(t, tm, got)
+		}
+		assert.Equal(t, version.Version, tm.Version)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// reservedEncodeIdentifiers are additionally reserved for fields of structs
+// generated with StreamEncode.
+var reservedEncodeIdentifiers = map[string]struct{}{
+	"Encode": {},
+}
+
+// Encode generates the Encode method of this group, which writes the struct
+// directly to a stream.Writer without building a wire.Value first.
+func (f fieldGroupGenerator) Encode(g Generator) error {
+	for _, field := range f.Fields {
+		name, err := goName(g, field)
+		if err != nil {
+			return err
+		}
+		if _, reserved := reservedEncodeIdentifiers[name]; reserved {
+			return fmt.Errorf("%q is a reserved ThriftRW identifier", name)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$v := newVar "v">
+		<$sw := newVar "sw">
+		// Encode serializes a <.Name> struct directly into bytes, without going
+		// through an intermediate representation.
+		//
+		// An error is returned if the struct or any of its fields failed to
+		// validate.
+		//
+		//   sw := protocol.BinaryStreamer.Writer(writer)
+		//   if err := <$v>.Encode(sw); err != nil {
+		//     return err
+		//   }
+		func (<$v> *<.Name>) Encode(<$sw> <$stream>.Writer) error {
+			<- if .Compact>
+				<- $w := newVar "w">
+				<$w>, err := <$v>.ToWire()
+				if err != nil {
+					return err
+				}
+				return <$stream>.WriteValue(<$sw>, <$w>)
+			<- else>
+			<- $structName := .Name>
+			<- $x := newVar "x">
+			<- if and .IsUnion (len .Fields) ->
+				<$fmt := import "fmt" ->
+				<$i := newVar "i" ->
+				<$i> := 0
+				<range .Fields>
+					<- $fname := goName . ->
+					<- if inBitmap . ->
+						if <$v>.IsSet<$fname>() {
+					<- else ->
+						if <$v>.<$fname> != nil {
+					<- end>
+							<$i>++
+						}
+				<end>
+				<if .AllowEmptyUnion>
+					if <$i> > 1 {
+						return <$fmt>.Errorf("<.Name> should have at most one field: got %v fields", <$i>)
+					}
+				<else>
+					if <$i> != 1 {
+						return <$fmt>.Errorf("<.Name> should have exactly one field: got %v fields", <$i>)
+					}
+				<end>
+
+			<end ->
+			if err := <$sw>.WriteStructBegin(); err != nil {
+				return err
+			}
+
+			<range .Fields>
+				<- $fname := goName . ->
+				<- $f := printf "%s.%s" $v (fieldName .) ->
+				<- $m := mappedField . ->
+				<- if $m ->
+					<- if .Required ->
+						{
+							<$x>, err := <$m.ToThrift>(<$f>)
+					<- else ->
+						if <$f> != nil {
+							<$x>, err := <$m.ToThrift>(*<$f>)
+					<- end>
+							if err != nil {
+								return err
+							}
+							if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <typeCode .Type>}); err != nil {
+								return err
+							}
+							if err := <encode .Type $x $sw>; err != nil {
+								return err
+							}
+							if err := <$sw>.WriteFieldEnd(); err != nil {
+								return err
+							}
+						}
+				<- else if .Required ->
+					<- if not (isPrimitiveType .Type) ->
+						if <$f> == nil {
+							return <import "errors">.New("field <$fname> of <$structName> is required")
+						}
+					<- end>
+						if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <typeCode .Type>}); err != nil {
+							return err
+						}
+						if err := <encode .Type $f $sw>; err != nil {
+							return err
+						}
+						if err := <$sw>.WriteFieldEnd(); err != nil {
+							return err
+						}
+				<- else if inBitmap . ->
+					<- if .Default ->
+						if !<$v>.IsSet<$fname>() {
+							<$v>.Set<$fname>(<constantValue .Default .Type>)
+						}
+						{
+					<- else ->
+						if <$v>.IsSet<$fname>() {
+					<- end>
+							if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <typeCode .Type>}); err != nil {
+								return err
+							}
+							if err := <encode .Type $f $sw>; err != nil {
+								return err
+							}
+							if err := <$sw>.WriteFieldEnd(); err != nil {
+								return err
+							}
+						}
+				<- else ->
+					<- if .Default ->
+						if <$f> == nil {
+							<$f> = <constantValuePtr .Default .Type>
+						}
+						{
+					<- else ->
+						if <$f> != nil {
+					<- end>
+							if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <typeCode .Type>}); err != nil {
+								return err
+							}
+							if err := <encodePtr .Type $f $sw>; err != nil {
+								return err
+							}
+							if err := <$sw>.WriteFieldEnd(); err != nil {
+								return err
+							}
+						}<if lazy .> else if <$v>.lazy<$fname> != nil {
+							<- $lazy := printf "%s.lazy%s" $v $fname>
+							if err := <$sw>.WriteFieldBegin(<$stream>.FieldHeader{ID: <.ID>, Type: <$lazy>.Type()}); err != nil {
+								return err
+							}
+							if err := <$stream>.WriteValue(<$sw>, *<$lazy>); err != nil {
+								return err
+							}
+							if err := <$sw>.WriteFieldEnd(); err != nil {
+								return err
+							}
+						}<end>
+				<- end>
+			<end>
+
+			return <$sw>.WriteStructEnd()
+			<- end>
+		}
+		`, f,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
+	)
+}
+
+// specEncode generates the Encode method of the given enum or typedef.
+func specEncode(g Generator, spec compile.TypeSpec) error {
+	var target compile.TypeSpec = &compile.I32Spec{}
+	if t, ok := spec.(*compile.TypedefSpec); ok {
+		target = t.Target
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+		<$v := newVar "v">
+		<$x := newVar "x">
+		<$sw := newVar "sw">
+
+		// Encode serializes <typeName .Spec> directly into bytes, without going
+		// through an intermediate representation.
+		func (<$v> <typeReference .Spec>) Encode(<$sw> <$stream>.Writer) error {
+			<$x> := (<typeReference .Target>)(<$v>)
+			return <encode .Target $x $sw>
+		}
+		`,
+		struct {
+			Spec   compile.TypeSpec
+			Target compile.TypeSpec
+		}{Spec: spec, Target: target},
+	)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/stream_encode"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestStreamEncode(t *testing.T) {
+	type encoder interface {
+		ToWire() (wire.Value, error)
+		Encode(stream.Writer) error
+	}
+
+	// Containers hold at most one item so that both encodings are
+	// deterministic.
+	newRecord := func() *ts.Record {
+		return &ts.Record{
+			ID:        "abc",
+			Location:  &ts.Coordinate{Lat: 1.5, Lng: -2.5},
+			CreatedAt: ts.Timestamp(42).Ptr(),
+			Labels:    ts.Labels{"foo", "bar"},
+			Path:      []*ts.Coordinate{{Lat: 1, Lng: 2}, {Lat: 3, Lng: 4}},
+			Tags:      map[string]struct{}{"x": {}},
+			Waypoints: []*ts.Coordinate{{Lat: 5, Lng: 6}},
+			Counts:    map[string]int32{"y": 1},
+			Names: []struct {
+				Key   *ts.Coordinate
+				Value string
+			}{{Key: &ts.Coordinate{Lat: 7, Lng: 8}, Value: "home"}},
+			Payload:    []byte("payload"),
+			Note:       ptr.String("note"),
+			Attachment: bytes.NewReader([]byte("attachment")),
+			Home:       &ts.Position{Lat: 9, Lng: 10},
+			Nested:     []map[ts.Label][]int16{{"z": {1, 2, 3}}},
+			Flags:      ptr.Int8(3),
+			Summary:    ts.Text("summary").Ptr(),
+		}
+	}
+
+	tests := []struct {
+		desc string
+		give func() encoder
+	}{
+		{"enum", func() encoder { return ts.StatusInactive }},
+		{"typedef", func() encoder { return ts.Label("hello") }},
+		{"typedef list", func() encoder { return ts.Labels{"foo", "bar"} }},
+		{"struct typedef", func() encoder { return &ts.Position{Lat: 1, Lng: 2} }},
+		{"minimal struct", func() encoder {
+			return &ts.Record{ID: "abc", Location: &ts.Coordinate{}}
+		}},
+		{"struct", func() encoder { return newRecord() }},
+		{"union", func() encoder { return &ts.Target{Status: ts.StatusActive.Ptr()} }},
+		{"exception", func() encoder { return &ts.RecordNotFound{ID: "abc"} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w, err := tt.give().ToWire()
+			require.NoError(t, err)
+
+			var want bytes.Buffer
+			require.NoError(t, protocol.Binary.Encode(w, &want))
+
+			var got bytes.Buffer
+			require.NoError(t, tt.give().Encode(protocol.BinaryStreamer.Writer(&got)))
+			assert.Equal(t, want.Bytes(), got.Bytes())
+		})
+	}
+}
+
+func TestStreamEncodeError(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    interface{ Encode(stream.Writer) error }
+		wantErr string
+	}{
+		{
+			desc:    "missing required field",
+			give:    &ts.Record{ID: "abc"},
+			wantErr: "field Location of Record is required",
+		},
+		{
+			desc:    "empty union",
+			give:    &ts.Target{},
+			wantErr: "Target should have exactly one field: got 0 fields",
+		},
+		{
+			desc:    "nil list item",
+			give:    &ts.Record{ID: "abc", Location: &ts.Coordinate{}, Path: []*ts.Coordinate{nil}},
+			wantErr: "invalid [0]: value is nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := tt.give.Encode(protocol.BinaryStreamer.Writer(&buf))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestStreamEncodeReservedName(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-stream-encode")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	path := filepath.Join(thriftRoot, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(
+		`struct Foo { 1: optional i32 x (go.name = "Encode") }`), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+		StreamEncode:  true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Encode" is a reserved ThriftRW identifier`)
+}
@@ -22,7 +22,6 @@ package gen
 
 import (
 	"fmt"
-	"strings"
 
 	"go.uber.org/thriftrw/compile"
 )
@@ -53,6 +52,29 @@ func (e *enumGenerator) Reader(g Generator, spec *compile.EnumSpec) (string, err
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+func (e *enumGenerator) Decoder(g Generator, spec *compile.EnumSpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$v := newVar "v">
+		<$sr := newVar "sr">
+		func <.Name>(<$sr> <$stream>.Reader) (<typeName .Spec>, error) {
+			var <$v> <typeName .Spec>
+			err := <$v>.Decode(<$sr>)
+			return <$v>, err
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.EnumSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 func enum(g Generator, spec *compile.EnumSpec) error {
 	if err := verifyUniqueEnumItemLabels(spec); err != nil {
 		return err
@@ -62,21 +84,15 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 	// TODO(abg) define an error type in the library for unrecognized enums.
 	err := g.DeclareFromTemplate(
 		`
-		<$bytes := import "bytes">
-		<$fmt := import "fmt">
-		<$json := import "encoding/json">
-		<$math := import "math">
-		<$strconv := import "strconv">
-
 		<$wire := import "go.uber.org/thriftrw/wire">
 
 		<$enumName := goName .Spec>
-		<formatDoc .Spec.Doc>type <$enumName> int32
+		<formatDoc (deprecatedDoc .Spec.Doc .Spec.Annotations)>type <$enumName> int32
 
 		<if .Spec.Items>
 			const (
 			<range .Spec.Items>
-				<- formatDoc .Doc><enumItemName $enumName .> <$enumName> = <.Value>
+				<- formatDoc (deprecatedDoc .Doc .Annotations)><enumItemName $enumName .> <$enumName> = <.Value>
 			<end>
 			)
 		<end>
@@ -92,6 +108,9 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 
 		<$v := newVar "v">
 		<$value := newVar "value">
+		<if not (checkMinimal) ->
+		<- $fmt := import "fmt" ->
+		<- $strconv := import "strconv" ->
 		// UnmarshalText tries to decode <$enumName> from a byte slice
 		// containing its name.
 		<- if .Spec.Items>
@@ -156,6 +175,7 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			return nil
 		}
 		<- end>
+		<- end>
 
 		// Ptr returns a pointer to this enum value.
 		func (<$v> <$enumName>) Ptr() *<$enumName> {
@@ -190,6 +210,33 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			return nil
 		}
 
+		<if not (checkMinimal) ->
+		<- $bytes := import "bytes" ->
+		<- $fmt := import "fmt" ->
+		<- $json := import "encoding/json" ->
+		<- $math := import "math" ->
+		<- $strconv := import "strconv" ->
+		<- $stream := import "go.uber.org/thriftrw/protocol/stream" ->
+		<$sr := newVar "sr">
+		<$i := newVar "i">
+		// Decode reads off the encoded <$enumName> directly off of the wire.
+		//
+		//   sReader := BinaryStreamer.Reader(reader)
+		//
+		//   var <$v> <$enumName>
+		//   if err := <$v>.Decode(sReader); err != nil {
+		//     return <$enumName>(0), err
+		//   }
+		//   return <$v>, nil
+		func (<$v> *<$enumName>) Decode(<$sr> <$stream>.Reader) error {
+			<$i>, err := <$sr>.ReadInt32()
+			if err != nil {
+				return err
+			}
+			*<$v> = (<$enumName>)(<$i>)
+			return nil
+		}
+
 		// String returns a readable string representation of <$enumName>.
 		func (<$v> <$enumName>) String() string {
 			<$w> := int32(<$v>)
@@ -269,6 +316,7 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 				return <$fmt>.Errorf("invalid JSON value %q (%T) to unmarshal into %q", <$t>, <$t>, "<$enumName>")
 			}
 		}
+		<- end>
 		`,
 		struct {
 			Spec        *compile.EnumSpec
@@ -277,24 +325,42 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 			Spec:        spec,
 			UniqueItems: items,
 		},
-		TemplateFunc("enumItemName", enumItemName),
+		TemplateFunc("enumItemName", checkCasing(g).enumItemName),
 		TemplateFunc("enumItemLabelName", entityLabel),
 		TemplateFunc("checkNoZap", checkNoZap),
+		TemplateFunc("checkMinimal", checkMinimal),
 	)
+	if err != nil {
+		return wrapGenerateError(spec.Name, err)
+	}
+
+	if checkSizeMethods(g) {
+		if err := specSizeInBytes(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
 
-	return wrapGenerateError(spec.Name, err)
+	if checkStreamEncode(g) && !checkMinimal(g) {
+		if err := specEncode(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	if checkSQL(g) {
+		return enumSQL(g, spec)
+	}
+	return nil
 }
 
 // enumItemName returns the Go name that should be used for an enum item with
 // the given (potentially import qualified) enumName and EnumItem.
-func enumItemName(enumName string, spec *compile.EnumItem) (string, error) {
+func (c Casing) enumItemName(enumName string, spec *compile.EnumItem) (string, error) {
 	name, err := goNameAnnotation(spec)
 	if err != nil {
 		return "", err
 	}
 	if name == "" {
-		name = pascalCase(false, /* all caps */
-			strings.Split(spec.ThriftName(), "_")...)
+		name = c.enumItemSuffix(spec.ThriftName())
 	}
 	return enumName + name, err
 }
@@ -320,7 +386,8 @@ func verifyUniqueEnumItemLabels(spec *compile.EnumSpec) error {
 // there are no value collisions between items.
 func enumUniqueItems(items []compile.EnumItem) []compile.EnumItem {
 	used := make(map[int32]struct{}, len(items))
-	filtered := items[:0] // zero-alloc filtering
+	// Filtering in place would overwrite the items of the EnumSpec.
+	filtered := make([]compile.EnumItem, 0, len(items))
 	for _, i := range items {
 		if _, isUsed := used[i.Value]; isUsed {
 			continue
@@ -21,6 +21,7 @@
 package gen
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -28,6 +29,7 @@ import (
 	"go.uber.org/thriftrw/compile"
 	tec "go.uber.org/thriftrw/gen/internal/tests/enum_conflict"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
+	"go.uber.org/thriftrw/protocol"
 	"go.uber.org/thriftrw/wire"
 
 	"github.com/stretchr/testify/assert"
@@ -164,6 +166,31 @@ func TestUnknownEnumValue(t *testing.T) {
 	}
 }
 
+func TestUnknownEnumValueRoundTrip(t *testing.T) {
+	// Values added to an enum by newer peers must survive a round trip
+	// through older code.
+	unknown := te.EnumDefault(42)
+	give := te.StructWithOptionalEnum{E: &unknown}
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueI32(42)},
+	}})
+	assertRoundTrip(t, &give, v, "StructWithOptionalEnum")
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(v, &buff))
+
+	var got te.StructWithOptionalEnum
+	require.NoError(t, got.Decode(protocol.BinaryStreamer.Reader(bytes.NewReader(buff.Bytes()))))
+	assert.Equal(t, give, got)
+
+	text, err := got.GetE().MarshalText()
+	require.NoError(t, err)
+
+	var fromText te.EnumDefault
+	require.NoError(t, fromText.UnmarshalText(text))
+	assert.Equal(t, unknown, fromText)
+}
+
 func TestOptionalEnum(t *testing.T) {
 	foo := te.EnumDefaultFoo
 
@@ -587,3 +614,21 @@ func TestEnumLabelConflict(t *testing.T) {
 		})
 	}
 }
+
+func TestEnumUniqueItemsDoesNotModifyItems(t *testing.T) {
+	items := []compile.EnumItem{
+		{Name: "A", Value: 0},
+		{Name: "B", Value: 0},
+		{Name: "C", Value: 1},
+	}
+
+	assert.Equal(t, []compile.EnumItem{
+		{Name: "A", Value: 0},
+		{Name: "C", Value: 1},
+	}, enumUniqueItems(items))
+	assert.Equal(t, []compile.EnumItem{
+		{Name: "A", Value: 0},
+		{Name: "B", Value: 0},
+		{Name: "C", Value: 1},
+	}, items, "items must not be modified")
+}
@@ -45,6 +45,10 @@ func (e *equalsGenerator) Equals(g Generator, spec compile.TypeSpec, lhs, rhs st
 
 	switch s := spec.(type) {
 	case *compile.BinarySpec:
+		if isReaderBinary(s) {
+			// Readers are compared by identity.
+			return fmt.Sprintf("(%s == %s)", lhs, rhs), nil
+		}
 		bytes := g.Import("bytes")
 		return fmt.Sprintf("%s.Equal(%s, %s)", bytes, lhs, rhs), nil
 	case *compile.MapSpec:
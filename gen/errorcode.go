@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"regexp"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// ErrorCodeLabel assigns a code from an error taxonomy to an exception, i.e.
+//
+// 	exception DoesNotExistException {
+// 		1: required string key
+// 	} (error.code = "NOT_FOUND")
+//
+// Exceptions with this annotation get an ErrorCode method which returns the
+// code, and the package gets an ErrorCodes map from the names of these
+// exceptions to their codes. Middleware may then translate errors to HTTP or
+// gRPC status codes without knowing about each service:
+//
+// 	var coded interface{ ErrorCode() string }
+// 	if errors.As(err, &coded) {
+// 		status = statusForCode[coded.ErrorCode()]
+// 	}
+//
+// Codes consist of upper case letters, digits, and underscores, and must
+// start with a letter.
+const ErrorCodeLabel = "error.code"
+
+var errorCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// exceptionErrorCode returns the code given to the given struct with
+// ErrorCodeLabel, or an empty string if it doesn't have one.
+func exceptionErrorCode(spec *compile.StructSpec) (string, error) {
+	code, ok := spec.Annotations[ErrorCodeLabel]
+	if !ok {
+		return "", nil
+	}
+	if spec.Type != ast.ExceptionType {
+		return "", fmt.Errorf(
+			"invalid %v on %q: only exceptions may have error codes", ErrorCodeLabel, spec.Name)
+	}
+	if !errorCodePattern.MatchString(code) {
+		return "", fmt.Errorf(
+			"invalid %v on %q: %q must consist of upper case letters, digits, "+
+				"and underscores, and start with a letter", ErrorCodeLabel, spec.Name, code)
+	}
+	return code, nil
+}
+
+// errorCodes generates the ErrorCodes map for the exceptions among the
+// given types which have error codes. Nothing is generated if there aren't
+// any, in which case false is returned.
+func errorCodes(g Generator, types map[string]compile.TypeSpec) (bool, error) {
+	type codedException struct {
+		Name string
+		Code string
+	}
+
+	var exceptions []codedException
+	for _, name := range sortStringKeys(types) {
+		spec, ok := types[name].(*compile.StructSpec)
+		if !ok || !spec.IsExceptionType() {
+			continue
+		}
+
+		code, err := exceptionErrorCode(spec)
+		if err != nil {
+			return false, wrapGenerateError(spec.ThriftName(), err)
+		}
+		if code != "" {
+			exceptions = append(exceptions, codedException{Name: spec.Name, Code: code})
+		}
+	}
+
+	if len(exceptions) == 0 {
+		return false, nil
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		// ErrorCodes maps the names of exceptions defined in this package to
+		// the codes given to them with the error.code annotation. The names
+		// are those returned by ErrorName.
+		var ErrorCodes = map[string]string{
+			<range . ->
+				"<.Name>": "<.Code>",
+			<end>
+		}
+		`, exceptions)
+	return true, wrapGenerateError("error codes", err)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	tf "go.uber.org/thriftrw/gen/internal/tests/services"
+	tst "go.uber.org/thriftrw/gen/internal/tests/stubs"
+)
+
+func TestExceptionErrorName(t *testing.T) {
+	assert.Equal(t, "DoesNotExistException", (&tx.DoesNotExistException{}).ErrorName())
+	assert.Equal(t, "EmptyException", (&tx.EmptyException{}).ErrorName())
+	assert.Equal(t, "InternalError", (&tf.InternalError{}).ErrorName())
+}
+
+func TestExceptionErrorCode(t *testing.T) {
+	assert.Equal(t, "NOT_FOUND", (&tx.DoesNotExistException{}).ErrorCode())
+	assert.Equal(t, "INTERNAL", (&tx.RequestFailedException{}).ErrorCode())
+
+	_, ok := interface{}(&tx.EmptyException{}).(interface{ ErrorCode() string })
+	assert.False(t, ok, "exceptions without error.code must not have an ErrorCode method")
+
+	assert.Equal(t, map[string]string{
+		"DoesNotExistException":  "NOT_FOUND",
+		"RequestFailedException": "INTERNAL",
+	}, tx.ErrorCodes)
+
+	// Middleware finds the code of wrapped errors.
+	err := fmt.Errorf("get failed: %w", &tx.DoesNotExistException{Key: "foo"})
+	var coded interface{ ErrorCode() string }
+	if assert.True(t, errors.As(err, &coded)) {
+		assert.Equal(t, "NOT_FOUND", coded.ErrorCode())
+	}
+}
+
+func TestExceptionErrorCodeInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		spec    *compile.StructSpec
+		wantErr string
+	}{
+		{
+			desc: "struct",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.StructType,
+				Annotations: compile.Annotations{"error.code": "NOT_FOUND"},
+			},
+			wantErr: `invalid error.code on "Foo": only exceptions may have error codes`,
+		},
+		{
+			desc: "lower case",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.ExceptionType,
+				Annotations: compile.Annotations{"error.code": "not_found"},
+			},
+			wantErr: `invalid error.code on "Foo": "not_found" must consist of upper case letters`,
+		},
+		{
+			desc: "empty",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.ExceptionType,
+				Annotations: compile.Annotations{"error.code": ""},
+			},
+			wantErr: `invalid error.code on "Foo": "" must consist of upper case letters`,
+		},
+		{
+			desc: "reserved field",
+			spec: &compile.StructSpec{
+				Name: "Foo",
+				Type: ast.ExceptionType,
+				Fields: compile.FieldGroup{
+					{ID: 1, Name: "ErrorCode", Type: &compile.StringSpec{}},
+				},
+				Annotations: compile.Annotations{"error.code": "NOT_FOUND"},
+			},
+			wantErr: `"ErrorCode" is a reserved ThriftRW identifier`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := structure(NewGenerator(&GeneratorOptions{PackageName: "foo"}), tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestExceptionIs(t *testing.T) {
+	var err error = &tx.DoesNotExistException{Key: "foo"}
+	assert.True(t, errors.Is(err, tx.ErrDoesNotExistException))
+	assert.False(t, errors.Is(err, tx.ErrEmptyException))
+
+	err = fmt.Errorf("get failed: %w", err)
+	assert.True(t, errors.Is(err, tx.ErrDoesNotExistException))
+
+	var dne *tx.DoesNotExistException
+	if assert.True(t, errors.As(err, &dne)) {
+		assert.Equal(t, "foo", dne.Key)
+	}
+}
+
+func TestExceptionUnwrap(t *testing.T) {
+	t.Run("no cause", func(t *testing.T) {
+		assert.Nil(t, (&tx.DoesNotExistException{}).Unwrap())
+		assert.Nil(t, (&tx.RequestFailedException{}).Unwrap())
+
+		var nilErr *tx.RequestFailedException
+		assert.Nil(t, nilErr.Unwrap())
+	})
+
+	t.Run("first cause", func(t *testing.T) {
+		err := &tx.RequestFailedException{
+			DoesNotExist: &tx.DoesNotExistException{Key: "foo"},
+			Empty:        &tx.EmptyException{},
+		}
+		assert.Equal(t, err.DoesNotExist, err.Unwrap())
+		assert.True(t, errors.Is(err, tx.ErrRequestFailedException))
+		assert.True(t, errors.Is(err, tx.ErrDoesNotExistException))
+		assert.False(t, errors.Is(err, tx.ErrEmptyException))
+	})
+
+	t.Run("other cause", func(t *testing.T) {
+		err := &tx.RequestFailedException{Empty: &tx.EmptyException{}}
+		assert.True(t, errors.Is(err, tx.ErrEmptyException))
+
+		var empty *tx.EmptyException
+		assert.True(t, errors.As(err, &empty))
+	})
+}
+
+func TestServiceErrors(t *testing.T) {
+	tests := []struct {
+		desc     string
+		registry map[string]func() error
+		want     map[string]error
+	}{
+		{
+			desc:     "no exceptions",
+			registry: tf.Cache_Errors,
+			want:     map[string]error{},
+		},
+		{
+			desc:     "included exceptions",
+			registry: tf.KeyValue_Errors,
+			want: map[string]error{
+				"DoesNotExistException": &tx.DoesNotExistException{},
+				"InternalError":         &tf.InternalError{},
+			},
+		},
+		{
+			desc:     "inherited exceptions",
+			registry: tst.Store_Errors,
+			want: map[string]error{
+				"DoesNotExistException": &tx.DoesNotExistException{},
+				"StoreError":            &tst.StoreError{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := make(map[string]error, len(tt.registry))
+			for name, newErr := range tt.registry {
+				err := newErr()
+				assert.Equal(t, name, err.(interface{ ErrorName() string }).ErrorName())
+				got[name] = err
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServiceErrorsConflict(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-service-errors")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"shared.thrift": `
+			exception NotFound {}
+		`,
+		"main.thrift": `
+			include "./shared.thrift"
+			exception NotFound {}
+			service Foo {
+				void a() throws (1: NotFound notFound)
+				void b() throws (1: shared.NotFound notFound)
+			}
+		`,
+	}
+	module := compileThriftFiles(t, thriftRoot, files, "main.thrift")
+
+	err = Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf(
+		"exceptions named %q are defined in both %q and %q", "NotFound",
+		filepath.Join(thriftRoot, "main.thrift"), filepath.Join(thriftRoot, "shared.thrift")))
+}
+
+func TestExceptionReservedIdentifiers(t *testing.T) {
+	for _, name := range []string{"Error", "ErrorName", "Unwrap", "Is"} {
+		t.Run(name, func(t *testing.T) {
+			fg := fieldGroupGenerator{
+				Namespace:   NewNamespace(),
+				Name:        "Foo",
+				IsException: true,
+				Fields: compile.FieldGroup{
+					{Name: name, Type: &compile.StringSpec{}},
+				},
+			}
+			err := fg.Generate(NewGenerator(&GeneratorOptions{PackageName: "foo"}))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), fmt.Sprintf("%q is a reserved ThriftRW identifier", name))
+		})
+	}
+}
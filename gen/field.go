@@ -39,8 +39,22 @@ const (
 var reservedIdentifiers = map[string]struct{}{
 	"ToWire":   {},
 	"FromWire": {},
+	"Decode":   {},
 	"String":   {},
 	"Equals":   {},
+	"Clone":    {},
+
+	"MarshalJSON":   {},
+	"UnmarshalJSON": {},
+}
+
+// reservedExceptionIdentifiers are additionally reserved for fields of
+// exceptions.
+var reservedExceptionIdentifiers = map[string]struct{}{
+	"Error":     {},
+	"ErrorName": {},
+	"Unwrap":    {},
+	"Is":        {},
 }
 
 // fieldGroupGenerator is responsible for generating code for FieldGroups.
@@ -50,6 +64,9 @@ type fieldGroupGenerator struct {
 	Name   string
 	Fields compile.FieldGroup
 
+	// Name of the struct in the Thrift file, if it differs from Name.
+	ThriftName string
+
 	// If this field group represents a union of values, exactly one field
 	// must be set for it to be valid.
 	IsUnion         bool
@@ -58,12 +75,35 @@ type fieldGroupGenerator struct {
 	// This field group represents a Thrift exception.
 	IsException bool
 
+	// Generate Hash and Compare methods. See HashableLabel.
+	Hashable bool
+
+	// Generate a read-only view of the encoded struct. See ViewLabel.
+	Viewable bool
+
+	// Generate a builder with chained setters. See BuilderLabel.
+	Buildable bool
+
+	// Code given to this exception, if any. See ErrorCodeLabel.
+	ErrorCode string
+
+	// ToWire and FromWire delegate to a table describing the fields. This
+	// is determined by Generate.
+	Compact bool
+
+	// Optional fields which are stored by value, mapped to their bits in
+	// the presence bitmap. See PresenceLabel.
+	PresenceBits map[*compile.FieldSpec]uint
+
 	Doc string
 }
 
 func (f fieldGroupGenerator) checkReservedIdentifier(name string) error {
 	_, match := reservedIdentifiers[name]
-	match = match || (f.IsException && name == "Error")
+	if f.IsException {
+		_, isExceptionMethod := reservedExceptionIdentifiers[name]
+		match = match || isExceptionMethod || (f.ErrorCode != "" && name == "ErrorCode")
+	}
 	if match {
 		return fmt.Errorf("%q is a reserved ThriftRW identifier", name)
 	}
@@ -75,6 +115,22 @@ func (f fieldGroupGenerator) Generate(g Generator) error {
 		return err
 	}
 
+	if err := f.verifyLazyFields(g); err != nil {
+		return err
+	}
+
+	compact, err := f.compact(g)
+	if err != nil {
+		return err
+	}
+	f.Compact = compact
+
+	if compact {
+		if err := f.compactDescriptor(g); err != nil {
+			return err
+		}
+	}
+
 	if err := f.DefineStruct(g); err != nil {
 		return err
 	}
@@ -87,12 +143,68 @@ func (f fieldGroupGenerator) Generate(g Generator) error {
 		return err
 	}
 
-	if err := f.String(g); err != nil {
-		return err
+	// With Minimal, only the types and their ToWire and FromWire methods
+	// are generated.
+	if !checkMinimal(g) {
+		if err := f.Decode(g); err != nil {
+			return err
+		}
+
+		if err := f.JSON(g); err != nil {
+			return err
+		}
+
+		if err := f.String(g); err != nil {
+			return err
+		}
+
+		if err := f.Equals(g); err != nil {
+			return err
+		}
+
+		if err := f.Clone(g); err != nil {
+			return err
+		}
+
+		if err := f.Validate(g); err != nil {
+			return err
+		}
 	}
 
-	if err := f.Equals(g); err != nil {
-		return err
+	if f.Hashable {
+		if err := f.HashAndCompare(g); err != nil {
+			return err
+		}
+	}
+
+	if f.Viewable {
+		if err := f.View(g); err != nil {
+			return err
+		}
+	}
+
+	if f.Buildable {
+		if err := f.Builder(g); err != nil {
+			return err
+		}
+	}
+
+	if checkDescriptors(g) {
+		if err := f.Descriptor(g); err != nil {
+			return err
+		}
+	}
+
+	if checkSizeMethods(g) {
+		if err := f.SizeInBytes(g); err != nil {
+			return err
+		}
+	}
+
+	if checkStreamEncode(g) && !checkMinimal(g) {
+		if err := f.Encode(g); err != nil {
+			return err
+		}
 	}
 
 	if !checkNoZap(g) {
@@ -108,14 +220,26 @@ func (f fieldGroupGenerator) DefineStruct(g Generator) error {
 	return g.DeclareFromTemplate(
 		`<formatDoc .Doc>type <.Name> struct {
 			<range .Fields>
-				<- if .Required ->
-					<formatDoc .Doc><declFieldName .> <typeReference .Type> <tag .>
-				<- else ->
-					<formatDoc .Doc><declFieldName .> <typeReferencePtr .Type> <tag .>
+				<- if lazy .>
+					<- formatDoc (deprecatedDoc .Doc .Annotations)>decoded<declFieldName .> <fieldTypeReference .>
+				<- else>
+					<- formatDoc (deprecatedDoc .Doc .Annotations)><declFieldName .> <if inBitmap .><typeReference .Type><else><fieldTypeReference .><end> <tag .>
 				<- end>
 			<end>
+			<if .HasLazyFields>
+				<range .Fields>
+					<- if lazy .>
+						lazy<goName .> *<import "go.uber.org/thriftrw/wire">.Value
+					<- end>
+				<- end>
+			<end>
+			<if .HasPresenceFields>
+				presence <.PresenceType>
+			<end>
 		}`,
 		f,
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
 		TemplateFunc("tag", generateTags),
 		TemplateFunc("declFieldName", f.declFieldName),
 	)
@@ -123,15 +247,24 @@ func (f fieldGroupGenerator) DefineStruct(g Generator) error {
 
 // generateTags parses the annotation on the thrift field and creates the resulting go tag
 func generateTags(f *compile.FieldSpec) (string, error) {
+	tags, err := fieldTags(f)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("`%s`", tags.String()), nil
+}
+
+// fieldTags builds the struct tags for the given field.
+func fieldTags(f *compile.FieldSpec) (*structtag.Tags, error) {
 	tags, err := structtag.Parse("") // no tags
 	if err != nil {
-		return "", fmt.Errorf("failed to parse tag: %v", err)
+		return nil, fmt.Errorf("failed to parse tag: %v", err)
 	}
 
 	// Default to the field name or label as the name used in the JSON
 	// representation.
 	if err := tags.Set(compileJSONTag(f, entityLabel(f))); err != nil {
-		return "", fmt.Errorf("failed to set tag: %v", err)
+		return nil, fmt.Errorf("failed to set tag: %v", err)
 	}
 
 	// Process go.tags and overwrite JSON tag if specified in Thrift
@@ -139,7 +272,7 @@ func generateTags(f *compile.FieldSpec) (string, error) {
 	if goAnnotation := f.Annotations[goTagKey]; goAnnotation != "" {
 		goTags, err := structtag.Parse(goAnnotation)
 		if err != nil {
-			return "", fmt.Errorf("failed to parse tags %q: %v", goAnnotation, err)
+			return nil, fmt.Errorf("failed to parse tags %q: %v", goAnnotation, err)
 		}
 
 		for _, t := range goTags.Tags() {
@@ -147,12 +280,24 @@ func generateTags(f *compile.FieldSpec) (string, error) {
 				t = compileJSONTag(f, t.Name, t.Options...)
 			}
 			if err := tags.Set(t); err != nil {
-				return "", fmt.Errorf("failed to set tag: %v", err)
+				return nil, fmt.Errorf("failed to set tag: %v", err)
 			}
 		}
 	}
 
-	return fmt.Sprintf("`%s`", tags.String()), nil
+	// The json.name annotation overrides the name of the JSON tag but
+	// retains its options.
+	if name := f.Annotations[jsonNameKey]; name != "" {
+		t, err := tags.Get(jsonTagKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tag: %v", err)
+		}
+		if err := tags.Set(compileJSONTag(f, name, t.Options...)); err != nil {
+			return nil, fmt.Errorf("failed to set tag: %v", err)
+		}
+	}
+
+	return tags, nil
 }
 
 func compileJSONTag(f *compile.FieldSpec, name string, opts ...string) *structtag.Tag {
@@ -188,8 +333,8 @@ func compileJSONTag(f *compile.FieldSpec, name string, opts ...string) *structta
 // It replicates goName but also register all field names in the
 // fieldGroupGenerator namespace, enforcing single field definition when
 // generating Go code. TL;DR: will fail during generation, before compilation.
-func (f *fieldGroupGenerator) declFieldName(fs *compile.FieldSpec) (string, error) {
-	name, fromAnnotation, err := goNameForNamedEntity(fs)
+func (f *fieldGroupGenerator) declFieldName(g Generator, fs *compile.FieldSpec) (string, error) {
+	name, fromAnnotation, err := checkCasing(g).goNameForNamedEntity(fs)
 	if err != nil {
 		return "", err
 	}
@@ -236,6 +381,14 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 		//     return err
 		//   }
 		func (<$v> *<.Name>) ToWire() (<$wire>.Value, error) {
+			<- if .Compact>
+				<- range .Fields><if .Default>
+					if <$v>.<goName .> == nil {
+						<$v>.<goName .> = <constantValuePtr .Default .Type>
+					}
+				<end><end>
+				return _<.Name>_Descriptor.ToWire(<$v>)
+			<- else>
     		<$fields := newVar "fields" ->
     		<- $i := newVar "i" ->
 			<- $wVal := newVar "w" ->
@@ -250,10 +403,32 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 			)
 
 			<$structName := .Name>
+			<$x := newVar "x">
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $f := printf "%s.%s" $v $fname ->
-				<- if .Required ->
+				<- $f := printf "%s.%s" $v (fieldName .) ->
+				<- $m := mappedField . ->
+				<- if $m ->
+					<- if .Required ->
+						{
+							var <$x> <typeReference .Type>
+							<$x>, err = <$m.ToThrift>(<$f>)
+					<- else ->
+						if <$f> != nil {
+							var <$x> <typeReference .Type>
+							<$x>, err = <$m.ToThrift>(*<$f>)
+					<- end>
+							if err != nil {
+								return <$wVal>, err
+							}
+							<$wVal>, err = <toWire .Type $x>
+							if err != nil {
+								return <$wVal>, err
+							}
+							<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
+							<$i>++
+						}
+				<- else if .Required ->
 					<- if not (isPrimitiveType .Type) ->
 						if <$f> == nil {
 							return <$wVal>, <import "errors">.New("field <$fname> of <$structName> is required")
@@ -265,6 +440,22 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 						}
 						<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
 						<$i>++
+				<- else if inBitmap . ->
+					<- if .Default ->
+						if !<$v>.IsSet<$fname>() {
+							<$v>.Set<$fname>(<constantValue .Default .Type>)
+						}
+						{
+					<- else ->
+						if <$v>.IsSet<$fname>() {
+					<- end>
+							<$wVal>, err = <toWire .Type $f>
+							if err != nil {
+								return <$wVal>, err
+							}
+							<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
+							<$i>++
+						}
 				<- else ->
 					<- if .Default ->
 						if <$f> == nil {
@@ -280,7 +471,10 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 							}
 							<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
 							<$i>++
-						}
+						}<if lazy .> else if <$v>.lazy<$fname> != nil {
+							<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: *<$v>.lazy<$fname>}
+							<$i>++
+						}<end>
 				<- end>
 			<end>
 
@@ -298,8 +492,14 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 			<end>
 
 			return <$wire>.NewValueStruct(<$wire>.Struct{Fields: <$fields>[:<$i>]}), nil
+			<- end>
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
+	)
 }
 
 func (f fieldGroupGenerator) FromWire(g Generator) error {
@@ -309,6 +509,7 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 
 		<$v := newVar "v">
 		<$w := newVar "w">
+		<$missing := newVar "missing">
 		// FromWire deserializes a <.Name> struct from its Thrift-level
 		// representation. The Thrift-level representation may be obtained
 		// from a ThriftRW protocol implementation.
@@ -327,7 +528,29 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 		//   }
 		//   return &<$v>, nil
 		func (<$v> *<.Name>) FromWire(<$w> <$wire>.Value) error {
-			<if len .Fields> var err error <end>
+			<- if .Compact>
+				<- if .ReportsMissingFields>
+					var <$missing> <import "go.uber.org/thriftrw/required">.Errors
+					if err := <$missing>.Merge(_<.Name>_Descriptor.FromWire(<$v>, <$w>)); err != nil {
+						return err
+					}
+				<- else>
+					if err := _<.Name>_Descriptor.FromWire(<$v>, <$w>); err != nil {
+						return err
+					}
+				<- end>
+				<range .Fields><if .Default>
+					if <$v>.<goName .> == nil {
+						<$v>.<goName .> = <constantValuePtr .Default .Type>
+					}
+				<end><end>
+				<- if .ReportsMissingFields>
+					return <$missing>.Err()
+				<- else>
+					return nil
+				<- end>
+			<- else>
+			<if .HasEagerFields> var err error <end>
 			<$f := newVar "field">
 
 			<$isSet := newNamespace>
@@ -337,24 +560,66 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 				<- end>
 			<end>
 
+			<if .ReportsMissingFields ->
+				var <$missing> <import "go.uber.org/thriftrw/required">.Errors
+			<- end>
+
+			<$x := newVar "x">
+			<$y := newVar "y">
 			for _, <$f> := range <$w>.GetStruct().Fields {
 				switch <$f>.ID {
 				<range .Fields ->
 				case <.ID>:
 					if <$f>.Value.Type() == <typeCode .Type> {
-						<- $lhs := printf "%s.%s" $v (goName .) ->
+						<- $lhs := printf "%s.%s" $v (fieldName .) ->
 						<- $value := printf "%s.Value" $f ->
-						<- if .Required ->
+						<- $m := mappedField . ->
+						<- if lazy . ->
+							var <$x> <import "go.uber.org/thriftrw/wire">.Value
+							if <$x>, err = <import "go.uber.org/thriftrw/wire">.DetachValue(<$value>); err != nil {
+								return err
+							}
+							<$v>.lazy<goName .> = &<$x>
+							<$lhs> = nil
+						<- else ->
+						<- if $m ->
+							var <$x> <typeReference .Type>
+							<- if reportsMissing .>
+							if <$x>, err = <fromWire .Type $value>; <$missing>.Merge(err) == nil {
+							<- else>
+							if <$x>, err = <fromWire .Type $value>; err == nil {
+							<- end>
+								<- if .Required>
+									<$lhs>, err = <$m.FromThrift>(<$x>)
+								<- else>
+									var <$y> <$m.Type>
+									if <$y>, err = <$m.FromThrift>(<$x>); err == nil {
+										<$lhs> = &<$y>
+									}
+								<- end>
+							}
+						<- else if .Required ->
 							<$lhs>, err = <fromWire .Type $value>
+						<- else if inBitmap . ->
+							if <$lhs>, err = <fromWire .Type $value>; err == nil {
+								<$v>.presence |= <presenceMask .>
+							}
 						<- else ->
 							<fromWirePtr .Type $lhs $value>
 						<- end>
-						if err != nil {
-							return err
-						}
+						<if reportsMissing . ->
+							if err = <$missing>.Merge(err); err != nil {
+								return err
+							}
+						<- else ->
+							if err != nil {
+								return err
+							}
+						<- end>
 						<if .Required ->
 							<$isSet.Rotate (printf "%sIsSet" .Name)> = true
 						<- end>
+						<- end>
 					}
 				<end ->
 				}
@@ -364,7 +629,157 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 			<range .Fields>
 				<$fname := goName .>
 				<$f := printf "%s.%s" $v $fname>
-				<if .Default>
+				<if and .Default (inBitmap .)>
+					if !<$v>.IsSet<$fname>() {
+						<$v>.Set<$fname>(<constantValue .Default .Type>)
+					}
+				<else if .Default>
+					if <$f> == nil {
+						<$f> = <constantValuePtr .Default .Type>
+					}
+				<else>
+					<if .Required>
+						if !<$isSet.Rotate (printf "%sIsSet" .Name)> {
+							<$missing>.Add("<$structName>", "<$fname>")
+						}
+					<end>
+				<end>
+			<end>
+
+			<if and .IsUnion (len .Fields)>
+				<$fmt := import "fmt">
+				<$count := newVar "count">
+				<$count> := 0
+				<range .Fields ->
+					if <$v>.<goName .> != nil {
+						<$count>++
+					}
+				<end>
+				<- if .AllowEmptyUnion ->
+					if <$count> > 1 {
+						return <$fmt>.Errorf( "<.Name> should have at most one field: got %v fields", <$count>)
+					}
+				<- else ->
+					if <$count> != 1 {
+						return <$fmt>.Errorf( "<.Name> should have exactly one field: got %v fields", <$count>)
+					}
+				<- end>
+			<end>
+			<- if .ReportsMissingFields>
+				return <$missing>.Err()
+			<- else>
+				return nil
+			<- end>
+			<- end>
+		}
+		`, f,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
+		TemplateFunc("presenceMask", f.presenceMask),
+		TemplateFunc("reportsMissing", reportsMissingField),
+	)
+}
+
+func (f fieldGroupGenerator) Decode(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$v := newVar "v">
+		<$sr := newVar "sr">
+		// Decode deserializes a <.Name> struct directly from its Thrift-level
+		// representation, without going through an intermediary type.
+		//
+		// An error is returned if a <.Name> struct could not be generated from
+		// the wire representation.
+		<$isSet := newNamespace>
+		func (<$v> *<.Name>) Decode(<$sr> <$stream>.Reader) error {
+			<range .Fields>
+				<- if .Required ->
+					<$isSet.NewName (printf "%sIsSet" .Name)> := false
+				<- end>
+			<end>
+
+			if err := <$sr>.ReadStructBegin(); err != nil {
+				return err
+			}
+
+			<$fh := newVar "fh">
+			<$ok := newVar "ok">
+			<$fh>, <$ok>, err := <$sr>.ReadFieldBegin()
+			if err != nil {
+				return err
+			}
+
+			<$x := newVar "x">
+			<$y := newVar "y">
+			for <$ok> {
+				switch {
+				<range .Fields ->
+				case <$fh>.ID == <.ID> && <$fh>.Type == <typeCode .Type>:
+					<- $lhs := printf "%s.%s" $v (fieldName .) ->
+					<- $m := mappedField . ->
+					<- if $m ->
+						var <$x> <typeReference .Type>
+						if <$x>, err = <decode .Type $sr>; err == nil {
+							<- if .Required>
+								<$lhs>, err = <$m.FromThrift>(<$x>)
+							<- else>
+								var <$y> <$m.Type>
+								if <$y>, err = <$m.FromThrift>(<$x>); err == nil {
+									<$lhs> = &<$y>
+								}
+							<- end>
+						}
+					<- else if .Required ->
+						<$lhs>, err = <decode .Type $sr>
+					<- else if inBitmap . ->
+						if <$lhs>, err = <decode .Type $sr>; err == nil {
+							<$v>.presence |= <presenceMask .>
+						}
+					<- else ->
+						<decodePtr .Type $lhs $sr>
+						<- if lazy .>
+							<$v>.lazy<goName .> = nil
+						<- end>
+					<- end>
+					if err != nil {
+						return err
+					}
+					<if .Required ->
+						<$isSet.Rotate (printf "%sIsSet" .Name)> = true
+					<- end>
+				<end ->
+				default:
+					if err := <$sr>.Skip(<$fh>.Type); err != nil {
+						return err
+					}
+				}
+
+				if err := <$sr>.ReadFieldEnd(); err != nil {
+					return err
+				}
+
+				if <$fh>, <$ok>, err = <$sr>.ReadFieldBegin(); err != nil {
+					return err
+				}
+			}
+
+			if err := <$sr>.ReadStructEnd(); err != nil {
+				return err
+			}
+
+			<$structName := .Name>
+			<range .Fields>
+				<$fname := goName .>
+				<$f := printf "%s.%s" $v $fname>
+				<if and .Default (inBitmap .)>
+					if !<$v>.IsSet<$fname>() {
+						<$v>.Set<$fname>(<constantValue .Default .Type>)
+					}
+				<else if .Default>
 					if <$f> == nil {
 						<$f> = <constantValuePtr .Default .Type>
 					}
@@ -396,9 +811,16 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 					}
 				<- end>
 			<end>
+
 			return nil
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		TemplateFunc("constantValue", ConstantValue),
+		TemplateFunc("constantValuePtr", ConstantValuePtr),
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
+		TemplateFunc("presenceMask", f.presenceMask),
+	)
 }
 
 func (f fieldGroupGenerator) String(g Generator) error {
@@ -414,6 +836,9 @@ func (f fieldGroupGenerator) String(g Generator) error {
 			if <$v> == nil {
 				return "<"<nil>">"
 			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+			<- end>
 
 			<$fields := newVar "fields">
 			<$i := newVar "i">
@@ -422,17 +847,31 @@ func (f fieldGroupGenerator) String(g Generator) error {
 			<$i> := 0
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $f := printf "%s.%s" $v $fname ->
+				<- $f := printf "%s.%s" $v (fieldName .) ->
 
-				<- if not .Required ->
+				<- if inBitmap . ->
+					if <$v>.IsSet<$fname>() {
+						<if redacted . ->
+							<$fields>[<$i>] = "<$fname>: <redactedValue>"
+						<- else ->
+							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
+						<- end>
+						<$i>++
+					}
+				<- else if not .Required ->
 					if <$f> != nil {
-						<if isPrimitiveType .Type ->
+						<if redacted . ->
+							<$fields>[<$i>] = "<$fname>: <redactedValue>"
+						<- else if or (mappedField .) (isPrimitiveType .Type) ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", *(<$f>))
 						<- else ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
 						<- end>
 						<$i>++
 					}
+				<- else if redacted . ->
+					<$fields>[<$i>] = "<$fname>: <redactedValue>"
+					<$i>++
 				<- else ->
 					<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
 					<$i>++
@@ -441,7 +880,11 @@ func (f fieldGroupGenerator) String(g Generator) error {
 
 			return <$fmt>.Sprintf("<.Name>{%v}", <$strings>.Join(<$fields>[:<$i>], ", "))
 		}
-		`, f)
+		`, f,
+		TemplateFunc("redacted", stringRedact),
+		TemplateFunc("redactedValue", func() string { return RedactedValue }),
+		TemplateFunc("inBitmap", f.inBitmap),
+	)
 }
 
 func (f fieldGroupGenerator) Equals(g Generator) error {
@@ -459,15 +902,41 @@ func (f fieldGroupGenerator) Equals(g Generator) error {
 			} else if <$rhs> == nil {
 				return false
 			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+				<$rhs> = <$rhs>.loaded()
+			<- end>
+			<- if .HasPresenceFields>
+				if <$v>.presence != <$rhs>.presence {
+					return false
+				}
+			<- end>
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $lhsField := printf "%s.%s" $v $fname ->
-				<- $rhsField := printf "%s.%s" $rhs $fname ->
+				<- $lhsField := printf "%s.%s" $v (fieldName .) ->
+				<- $rhsField := printf "%s.%s" $rhs (fieldName .) ->
 
-				<- if .Required ->
+				<- $m := mappedField . ->
+				<- if $m ->
+					<- if .Required ->
+						if !<mappedEquals $m $lhsField $rhsField> {
+							return false
+						}
+					<- else ->
+						<- $lhsVal := printf "*%s" $lhsField ->
+						<- $rhsVal := printf "*%s" $rhsField ->
+						if !((<$lhsField> == nil && <$rhsField> == nil) || (<$lhsField> != nil && <$rhsField> != nil && <mappedEquals $m $lhsVal $rhsVal>)) {
+							return false
+						}
+					<- end>
+				<- else if .Required ->
 					if !<equals .Type $lhsField $rhsField> {
 						return false
 					}
+				<- else if inBitmap . ->
+					if <$v>.IsSet<$fname>() && !<equals .Type $lhsField $rhsField> {
+						return false
+					}
 				<- else ->
 					if !<equalsPtr .Type $lhsField $rhsField> {
 						return false
@@ -476,7 +945,103 @@ func (f fieldGroupGenerator) Equals(g Generator) error {
 			<end>
 			return true
 		}
-		`, f)
+		`, f,
+		TemplateFunc("mappedEquals", mappedEquals),
+		TemplateFunc("inBitmap", f.inBitmap),
+	)
+}
+
+func (f fieldGroupGenerator) Clone(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$c := newVar "c">
+		// Clone returns a deep copy of this <.Name>. Fields annotated with
+		// <shallowCopyLabel> and fields with custom types are copied
+		// shallowly, sharing their contents with the original.
+		func (<$v> *<.Name>) Clone() *<.Name> {
+			if <$v> == nil {
+				return nil
+			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+			<- end>
+
+			var <$c> <.Name>
+			<range .Fields>
+				<- $fname := fieldName . ->
+				<- $field := printf "%s.%s" $v $fname ->
+				<- if or (shallowCopy .) (mappedField .) (inBitmap .) ->
+					<$c>.<$fname> = <$field>
+				<- else if .Required ->
+					<$c>.<$fname> = <clone .Type $field>
+				<- else ->
+					<$c>.<$fname> = <clonePtr .Type $field>
+				<- end>
+			<end>
+			<- if .HasPresenceFields>
+				<$c>.presence = <$v>.presence
+			<- end>
+			return &<$c>
+		}
+		`, f,
+		TemplateFunc("inBitmap", f.inBitmap),
+		TemplateFunc("shallowCopy", shallowCopy),
+		TemplateFunc("shallowCopyLabel", func() string { return ShallowCopyLabel }),
+	)
+}
+
+func (f fieldGroupGenerator) Validate(g Generator) error {
+	if !hasValidation(f.Fields) {
+		return nil
+	}
+
+	for _, field := range f.Fields {
+		if name, err := goName(g, field); err != nil {
+			return err
+		} else if name == "Validate" {
+			return fmt.Errorf("%q is a reserved ThriftRW identifier for %v", name, f.Name)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$validation := import "go.uber.org/thriftrw/validation">
+		<$v := newVar "v">
+		<$errs := newVar "errs">
+
+		// Validate returns an error if any field of this <.Name> violates
+		// the constraints declared on it with validate.* annotations. All
+		// violations, including those of nested structs, are reported
+		// together as a validation.Errors.
+		func (<$v> *<.Name>) Validate() error {
+			if <$v> == nil {
+				return nil
+			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+			<- end>
+
+			var <$errs> <$validation>.Errors
+			<range .Fields>
+				<- $field := . ->
+				<- range validateChecks $.Name . $v (inBitmap .) ->
+					if <.Violated> {
+						<$errs>.Add("<fieldLabel $field>", <printf "%q" .Message>)
+					}
+				<end ->
+				<- if validateNested . ->
+					<$errs>.Nest("<fieldLabel .>", <$v>.<fieldName .>.Validate())
+				<end ->
+			<end ->
+			return <$errs>.Err()
+		}
+		`, f,
+		TemplateFunc("validateChecks", validateChecks),
+		TemplateFunc("validateNested", validateNested),
+		TemplateFunc("fieldLabel", entityLabel),
+		TemplateFunc("inBitmap", f.inBitmap),
+	)
 }
 
 func (f fieldGroupGenerator) Zap(g Generator) error {
@@ -492,13 +1057,43 @@ func (f fieldGroupGenerator) Zap(g Generator) error {
 			if <$v> == nil {
 				return nil
 			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+			<- end>
 			<range .Fields>
 				<- if not (zapOptOut .) ->
-					<- $fval := printf "%s.%s" $v (goName .) ->
-					<- if .Required ->
+					<- $fval := printf "%s.%s" $v (fieldName .) ->
+					<- if zapRedact . ->
+						<- if .Required ->
+							<$enc>.AddString("<fieldLabel .>", "<redactedValue>")
+						<- else if inBitmap . ->
+							if <$v>.IsSet<goName .>() {
+								<$enc>.AddString("<fieldLabel .>", "<redactedValue>")
+							}
+						<- else ->
+							if <$fval> != nil {
+								<$enc>.AddString("<fieldLabel .>", "<redactedValue>")
+							}
+						<- end>
+					<- else if mappedField . ->
+						<- $multierr := import "go.uber.org/multierr" ->
+						<- if .Required ->
+							err = <$multierr>.Append(err, <$enc>.AddReflected("<fieldLabel .>", <$fval>))
+						<- else ->
+							if <$fval> != nil {
+								err = <$multierr>.Append(err, <$enc>.AddReflected("<fieldLabel .>", *<$fval>))
+							}
+						<- end>
+					<- else if .Required ->
 						<zapEncodeBegin .Type ->
 							<$enc>.Add<zapEncoder .Type>("<fieldLabel .>", <zapMarshaler .Type $fval>)
 						<- zapEncodeEnd .Type>
+					<- else if inBitmap . ->
+						if <$v>.IsSet<goName .>() {
+							<zapEncodeBegin .Type ->
+								<$enc>.Add<zapEncoder .Type>("<fieldLabel .>", <zapMarshaler .Type $fval>)
+							<- zapEncodeEnd .Type>
+						}
 					<- else ->
 						if <$fval> != nil {
 							<zapEncodeBegin .Type ->
@@ -511,7 +1106,10 @@ func (f fieldGroupGenerator) Zap(g Generator) error {
 			return err
 		}
 		`, f,
+		TemplateFunc("inBitmap", f.inBitmap),
 		TemplateFunc("zapOptOut", zapOptOut),
+		TemplateFunc("zapRedact", zapRedact),
+		TemplateFunc("redactedValue", func() string { return RedactedValue }),
 		TemplateFunc("fieldLabel", entityLabel),
 	)
 }
@@ -524,6 +1122,7 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 		`
 		<$v := newVar "v">
 		<$o := newVar "o">
+		<$value := newVar "value">
 		<$name := .Name>
 
 		<range .Fields>
@@ -533,15 +1132,44 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 			<reserveFieldOrMethod (printf "Get%v" $fname)>
 			// Get<$fname> returns the value of <$fname> if it is set or its
 			// <if .Default>default<else>zero<end> value if it is unset.
-			func (<$v> *<$name>) Get<$fname>() (<$o> <typeReference .Type>) {
-				<- if .Required ->
+			<- if lazy .>
+			//
+			// If <$fname> was deserialized lazily, its value is decoded
+			// without being kept, so Get<$fname> doesn't modify the
+			// <$name>. Values that fail to decode are treated as unset.
+			// Call Load<$fname> first to modify the value in place.
+			<- end>
+			<- $m := mappedField .>
+			func (<$v> *<$name>) Get<$fname>() (<$o> <if $m><$m.Type><else><typeReference .Type><end>) {
+				<- if lazy . ->
+				  if <$v> == nil {
+				    return
+				  }
+				  if <$v>.lazy<$fname> != nil {
+				    <- $w := newVar "w">
+				    <$w> := *<$v>.lazy<$fname>
+				    var err error
+				    <fromWirePtr .Type $o $w>
+				    if err != nil {
+				      <$o> = nil
+				    }
+				    return
+				  }
+				  return <$v>.<fieldName .>
+				<- else if .Required ->
 				  if <$v> != nil {
 				    <$o> = <$v>.<$fname>
 				  }
 				  return
+				<- else if inBitmap . ->
+				  if <$v>.IsSet<$fname>() {
+				    return <$v>.<$fname>
+				  }
+				  <if .Default><$o> = <constantValue .Default .Type><end>
+				  return
 				<- else ->
 				  if <$v> != nil && <$v>.<$fname> != nil {
-					<- if and (not .Required) (isPrimitiveType .Type) ->
+					<- if or $m (isPrimitiveType .Type) ->
 					  return *<$v>.<$fname>
 					<- else ->
 					  return <$v>.<$fname>
@@ -554,18 +1182,107 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 
 			<if shouldGenerateIsSet .>
 				<reserveFieldOrMethod (printf "IsSet%v" $fname)>
+				<- if inBitmap .>
+				// IsSet<$fname> returns true if <$fname> is set.
+				func (<$v> *<$name>) IsSet<$fname>() bool {
+					return <$v> != nil && <$v>.presence&(<presenceMask .>) != 0
+				}
+				<- else>
 				// IsSet<$fname> returns true if <$fname> is not nil.
 				func (<$v> *<$name>) IsSet<$fname>() bool {
+					<- if lazy .>
+					return <$v> != nil && (<$v>.<fieldName .> != nil || <$v>.lazy<$fname> != nil)
+					<- else>
 					return <$v> != nil && <$v>.<$fname> != nil
+					<- end>
+				}
+				<- end>
+			<end>
+
+			<if inBitmap .>
+				<reserveFieldOrMethod (printf "Set%v" $fname)>
+				// Set<$fname> sets the value of <$fname> and marks it as set.
+				func (<$v> *<$name>) Set<$fname>(<$value> <typeReference .Type>) {
+					<$v>.<$fname> = <$value>
+					<$v>.presence |= <presenceMask .>
+				}
+
+				<reserveFieldOrMethod (printf "Clear%v" $fname)>
+				// Clear<$fname> unsets <$fname>.
+				func (<$v> *<$name>) Clear<$fname>() {
+					var <$value> <typeReference .Type>
+					<$v>.<$fname> = <$value>
+					<$v>.presence &^= <presenceMask .>
+				}
+			<end>
+
+			<if lazy .>
+				<reserveFieldOrMethod (printf "Set%v" $fname)>
+				// Set<$fname> sets the value of <$fname>, replacing its value
+				// if it was deserialized lazily. Setting it to nil unsets
+				// <$fname>.
+				func (<$v> *<$name>) Set<$fname>(<$value> <typeReference .Type>) {
+					<$v>.<fieldName .> = <$value>
+					<$v>.lazy<$fname> = nil
+				}
+
+				<reserveFieldOrMethod (printf "Load%v" $fname)>
+				// Load<$fname> decodes the value of <$fname> if it was
+				// deserialized lazily and keeps it, so that it may be modified
+				// in place through Get<$fname>. An error is returned if the
+				// value fails to decode.
+				func (<$v> *<$name>) Load<$fname>() (err error) {
+					if <$v> == nil || <$v>.lazy<$fname> == nil {
+						return nil
+					}
+					<- $w := newVar "w">
+					<- $x := newVar "x">
+					<$w> := *<$v>.lazy<$fname>
+					var <$x> <typeReference .Type>
+					<fromWirePtr .Type $x $w>
+					if err != nil {
+						return err
+					}
+					<$v>.<fieldName .> = <$x>
+					<$v>.lazy<$fname> = nil
+					return nil
 				}
 			<end>
 		<end>
+
+		<if .HasLazyFields>
+			// loaded returns this <$name> with its lazily deserialized
+			// fields decoded. They're decoded into a copy of the <$name>,
+			// which is left unmodified, so this may be used by methods which
+			// only read the <$name>.
+			func (<$v> *<$name>) loaded() *<$name> {
+				if <range $i, $f := .LazyFields><if $i> && <end><$v>.lazy<goName $f> == nil<end> {
+					return <$v>
+				}
+				<- $c := newVar "c">
+				<$c> := *<$v>
+				<range .LazyFields ->
+					<$c>.<fieldName .>, <$c>.lazy<goName .> = <$v>.Get<goName .>(), nil
+				<end>
+				return &<$c>
+			}
+		<end>
 		`, f,
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
+		TemplateFunc("presenceMask", f.presenceMask),
 		TemplateFunc("constantValue", ConstantValue),
-		TemplateFunc("shouldGenerateIsSet", func(f *compile.FieldSpec) bool {
+		TemplateFunc("shouldGenerateIsSet", func(g Generator, f *compile.FieldSpec) (bool, error) {
 			// Generate IsSet functions for a field only if the field is
-			// optional or the field value itself is nillable.
-			return !f.Required || isReferenceType(f.Type) || isStructType(f.Type)
+			// optional or the field value itself is nillable. Custom types
+			// are never assumed to be nillable.
+			if f.Required {
+				m, err := mappedField(g, f)
+				if err != nil || m != nil {
+					return false, err
+				}
+			}
+			return !f.Required || isReferenceType(f.Type) || isStructType(f.Type), nil
 		}),
 		TemplateFunc("reserveFieldOrMethod", func(name string) (string, error) {
 			// we return an empty string for the sake of the templating system
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+)
+
+// FuzzTests generates a native Go fuzz test of decoding the given struct,
+// union, or exception with the harness in go.uber.org/thriftrw/fuzz. The
+// test is intended to be written to a _test.go file in the package of the
+// struct which builds only with Go 1.18 or newer.
+//
+// The Binary encoding of the same fixture used by the benchmarks seeds the
+// corpus if one exists for the struct.
+func FuzzTests(g Generator, spec *compile.StructSpec) error {
+	var seed string
+	if v, ok := benchmarkFixture(spec, 0); ok {
+		var buf bytes.Buffer
+		if err := protocol.Binary.Encode(v, &buf); err != nil {
+			return err
+		}
+		seed = strconv.Quote(buf.String())
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$testing := import "testing">
+		<$fuzz := import "go.uber.org/thriftrw/fuzz">
+
+		<$name := typeName .Spec>
+		<$f := newVar "f">
+		func Fuzz<$name>(<$f> *<$testing>.F) {
+			<$fuzz>.FromWire[<$name>](<$f><if .Seed>, []byte(<.Seed>)<end>)
+		}
+		`,
+		struct {
+			Spec *compile.StructSpec
+			Seed string
+		}{Spec: spec, Seed: seed})
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package gen
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/fuzz"
+	tco "go.uber.org/thriftrw/gen/internal/tests/compact"
+	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
+	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	tsl "go.uber.org/thriftrw/gen/internal/tests/slice_sets"
+	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
+	"go.uber.org/thriftrw/protocol"
+)
+
+// Number of values generated by quickSeeds.
+const quickSeedCount = 16
+
+// quickSeeds builds a seed corpus by encoding random values produced by the
+// generators used by TestQuickSuite. The values are generated from a fixed
+// seed so that the corpus doesn't change between runs.
+func quickSeeds(f *testing.F, generator func(require.TestingT, *rand.Rand) thriftType) [][]byte {
+	rand := rand.New(rand.NewSource(42))
+
+	seeds := make([][]byte, 0, quickSeedCount)
+	for i := 0; i < quickSeedCount; i++ {
+		w, err := generator(f, rand).ToWire()
+		if err != nil {
+			f.Fatalf("generated an invalid value: %v", err)
+		}
+
+		var buf bytes.Buffer
+		if err := protocol.Binary.Encode(w, &buf); err != nil {
+			f.Fatalf("could not encode a generated value: %v", err)
+		}
+		seeds = append(seeds, buf.Bytes())
+	}
+	return seeds
+}
+
+func FuzzContainersOfContainers(f *testing.F) {
+	seeds := quickSeeds(f, defaultValueGenerator(reflect.TypeOf(tc.ContainersOfContainers{})))
+	fuzz.FromWire[tc.ContainersOfContainers](f, seeds...)
+}
+
+func FuzzPrimitiveContainers(f *testing.F) {
+	seeds := quickSeeds(f, defaultValueGenerator(reflect.TypeOf(tc.PrimitiveContainers{})))
+	fuzz.FromWire[tc.PrimitiveContainers](f, seeds...)
+}
+
+func FuzzCompactShape(f *testing.F) {
+	seeds := quickSeeds(f, defaultValueGenerator(reflect.TypeOf(tco.Shape{})))
+	fuzz.FromWire[tco.Shape](f, seeds...)
+}
+
+func FuzzSliceSets(f *testing.F) {
+	seeds := quickSeeds(f, sliceSetValueGenerator(tsl.Sets{}))
+	fuzz.FromWire[tsl.Sets](f, seeds...)
+}
+
+func FuzzTransition(f *testing.F) {
+	seeds := quickSeeds(f, defaultValueGenerator(reflect.TypeOf(td.Transition{})))
+	fuzz.FromWire[td.Transition](f, seeds...)
+}
+
+func FuzzArbitraryValue(f *testing.F) {
+	seeds := quickSeeds(f, unionValueGenerator(tu.ArbitraryValue{}))
+	fuzz.FromWire[tu.ArbitraryValue](f, seeds...)
+}
+
+func FuzzDoesNotExistException(f *testing.F) {
+	seeds := quickSeeds(f, defaultValueGenerator(reflect.TypeOf(tx.DoesNotExistException{})))
+	fuzz.FromWire[tx.DoesNotExistException](f, seeds...)
+}
@@ -23,6 +23,7 @@ package gen
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -30,6 +31,7 @@ import (
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/plugin/api"
 
 	"go.uber.org/multierr"
 )
@@ -71,14 +73,167 @@ type Options struct {
 	// Do not generate service helpers
 	NoServiceHelpers bool
 
+	// Generate typed client and server stubs for services
+	ServiceStubs bool
+
+	// Generate fakes of the stubs for each service Foo in a footest package
+	// next to the generated package. Requires ServiceStubs.
+	ServiceTests bool
+
+	// Generate net/http handlers for services with functions annotated
+	// with http.method and http.path. Requires ServiceStubs.
+	HTTPHandlers bool
+
+	// Generate wrappers of the client and server stubs which record
+	// Prometheus metrics for every request. Requires ServiceStubs.
+	PrometheusMetrics bool
+
+	// Generate a _benchmark_test.go file next to the code generated for
+	// each Thrift file with benchmarks of ToWire, FromWire, Encode, and
+	// Decode for every struct.
+	Benchmarks bool
+
+	// Generate a _fuzz_test.go file next to the code generated for each
+	// Thrift file with a native Go fuzz test of decoding every struct. The
+	// file builds only with Go 1.18 or newer.
+	FuzzTests bool
+
+	// Derive the Go package for each Thrift file from its `namespace go`
+	// statement instead of its path relative to ThriftRoot. For
+	// "namespace go foo.bar", code is written to $OutputDir/foo/bar and
+	// imported as $PackagePrefix/foo/bar. Thrift files without a
+	// `namespace go` statement use their path.
+	//
+	// A go.package annotation on the `namespace go` statement specifies the
+	// full import path of the package. It is always honored and must be
+	// inside PackagePrefix.
+	NamespacePackages bool
+
 	// Do not embed IDLs in generated code
 	NoEmbedIDL bool
 
 	// Do not generate Zap logging code
 	NoZap bool
 
+	// Generate only types and their ToWire and FromWire methods, omitting
+	// String, Equals, JSON, Zap, streaming, and envelope helpers, for
+	// size-sensitive binaries. A go.minimal annotation on the `namespace
+	// go` statement of a Thrift file overrides this for that file:
+	//
+	// 	namespace go foo (go.minimal = "true")
+	//
+	// Code for Thrift files which are not minimal cannot include those
+	// which are. Minimal cannot be used with ServiceStubs, Benchmarks,
+	// FuzzTests, or SQL.
+	Minimal bool
+
+	// Generate database/sql Valuer and Scanner implementations for enums
+	// and typedefs of base types so that they may be stored in databases
+	// directly.
+	SQL bool
+
+	// Store enums in databases by name instead of their integer value.
+	// Requires SQL.
+	SQLEnumNames bool
+
+	// Generate smaller code for structs. Their ToWire and FromWire methods
+	// delegate to shared helpers driven by tables describing their fields,
+	// at a small runtime cost.
+	CompactCodegen bool
+
+	// Represent sets as []T instead of map[T]struct{} unless they're
+	// annotated with (go.type = "map"). Sets of values which are not
+	// primitives or enums are always represented as slices.
+	SliceSets bool
+
+	// Send the items of maps over the wire in the order of their keys so
+	// that equal maps are always encoded identically, unless they're
+	// annotated with (go.sortedMap = "false"). Maps whose keys cannot be
+	// ordered are sent in the order of their items. See SortedMapLabel.
+	SortedMaps bool
+
+	// Generate ThriftDescriptor methods which describe structs at runtime
+	// so that the dynamic package may operate on them generically. Code
+	// for included Thrift files must also be generated with this option.
+	Descriptors bool
+
+	// Generate SizeInBytes methods for structs, enums, and typedefs which
+	// report the number of bytes they occupy when encoded with the Binary
+	// protocol.
+	SizeMethods bool
+
+	// Generate Encode methods for structs, enums, and typedefs which write
+	// them directly to a stream.Writer without building their Thrift-level
+	// intermediate representation.
+	StreamEncode bool
+
+	// Generate builders with chained setters for structs and exceptions
+	// with at least this many fields. If zero, builders are generated only
+	// for structs annotated with go.builder.
+	BuilderThreshold int
+
+	// Generate functions for constants whose values are structs, lists,
+	// sets, or maps. Their values are built the first time the functions
+	// are called instead of when the program starts.
+	LazyConstants bool
+
+	// Casing controls how the Go names of types, fields, enum items,
+	// constants, services, and functions are derived from their names in
+	// the Thrift file. Defaults to GoCasing.
+	Casing Casing
+
+	// Convert lists, sets, and maps with the generic helpers in the
+	// go.uber.org/thriftrw/generic package instead of generating
+	// conversion functions for each container type. The generated code
+	// requires Go 1.18 and is constrained to it with build tags.
+	Generics bool
+
+	// Roots, if non-empty, limits code generation to the types, constants,
+	// and services reachable from the named ones. Names of entities
+	// declared in included Thrift files are qualified with the name of the
+	// include, for example "shared.UUID".
+	Roots []string
+
 	// Name of the file to be generated by ThriftRW.
 	OutputFile string
+
+	// OutputLayout controls how the code generated for each Thrift file is
+	// split into files. OutputFile may be used only with SingleFileLayout.
+	OutputLayout OutputLayout
+
+	// CacheDir, if non-empty, is a directory in which generated code is
+	// cached. Code for a Thrift file is regenerated only if it, or any of the
+	// files it includes, changed since it was cached.
+	//
+	// The cache is not used if a plugin provides a TypeMapper.
+	CacheDir string
+
+	// DeterministicCheck generates all code a second time, without using
+	// the cache, and fails if the output of the two runs differs. No files
+	// are written if the check fails.
+	DeterministicCheck bool
+
+	// NameReport, if non-nil, receives a line for every type, constant,
+	// enum item, field, service, function, and parameter declared in the
+	// Thrift files code is generated for, naming the Go identifier
+	// generated for it. See NameMapping for how names are chosen.
+	NameReport io.Writer
+
+	// NoMangle fails code generation instead of renaming a parameter whose
+	// Thrift name conflicts with a Go keyword, a predeclared identifier, or
+	// another parameter.
+	NoMangle bool
+
+	// Writer, if non-nil, receives the generated files instead of them
+	// being written to OutputDir.
+	Writer FileWriter
+}
+
+// FileWriter receives the files generated by Generate.
+type FileWriter interface {
+	// WriteFile is called with the path of each generated file relative to
+	// OutputDir, in sorted order, and its contents.
+	WriteFile(path string, contents []byte) error
 }
 
 // Generate generates code based on the given options.
@@ -95,25 +250,204 @@ func Generate(m *compile.Module, o *Options) error {
 			o.OutputDir)
 	}
 
+	if err := findImportCycles(m, o.ThriftRoot); err != nil {
+		return err
+	}
+
+	if len(o.Roots) > 0 {
+		var err error
+		m, err = pruneModule(m, o.Roots)
+		if err != nil {
+			return err
+		}
+	}
+
+	packages, err := modulePackages(m, o)
+	if err != nil {
+		return err
+	}
+
 	importer := thriftPackageImporter{
 		ImportPrefix: o.PackagePrefix,
 		ThriftRoot:   o.ThriftRoot,
+		Packages:     packages,
+	}
+
+	if o.ServiceTests && !o.ServiceStubs {
+		return fmt.Errorf("ServiceTests requires ServiceStubs")
+	}
+
+	if o.HTTPHandlers && !o.ServiceStubs {
+		return fmt.Errorf("HTTPHandlers requires ServiceStubs")
+	}
+
+	if o.PrometheusMetrics && !o.ServiceStubs {
+		return fmt.Errorf("PrometheusMetrics requires ServiceStubs")
+	}
+
+	if o.ServiceTests && len(o.OutputFile) > 0 {
+		return fmt.Errorf("ServiceTests cannot be used with OutputFile")
+	}
+
+	switch o.OutputLayout {
+	case SingleFileLayout, PerKindLayout, PerTypeLayout:
+	default:
+		return fmt.Errorf("unknown OutputLayout %d", o.OutputLayout)
+	}
+
+	switch o.Casing {
+	case GoCasing, LiteralCasing, ApacheCasing:
+	default:
+		return fmt.Errorf("unknown Casing %d", o.Casing)
+	}
+
+	if o.OutputLayout != SingleFileLayout && len(o.OutputFile) > 0 {
+		return fmt.Errorf("OutputFile cannot be used with OutputLayout")
+	}
+
+	if o.SQLEnumNames && !o.SQL {
+		return fmt.Errorf("SQLEnumNames requires SQL")
+	}
+
+	if err := validateMinimal(m, o); err != nil {
+		return err
+	}
+
+	if o.Plugin != nil {
+		if v := o.Plugin.Validator(); v != nil {
+			if err := validateModules(m, v, o); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.NameReport != nil || o.NoMangle {
+		modules := []*compile.Module{m}
+		if !o.NoRecurse && len(o.OutputFile) == 0 {
+			modules = nil
+			_ = m.Walk(func(m *compile.Module) error {
+				modules = append(modules, m)
+				return nil
+			})
+		}
+
+		if err := writeNameReport(o.NameReport, modules, o.Casing, o.NoMangle); err != nil {
+			return err
+		}
+	}
+
+	files, err := generateFiles(m, importer, o)
+	if err != nil {
+		return err
+	}
+
+	if o.DeterministicCheck {
+		again := *o
+		again.CacheDir = ""
+		againFiles, err := generateFiles(m, importer, &again)
+		if err != nil {
+			return err
+		}
+
+		if err := compareGeneratedFiles(files, againFiles); err != nil {
+			return err
+		}
+	}
+
+	if o.Plugin != nil {
+		if pp := o.Plugin.PostProcessor(); pp != nil {
+			res, err := pp.Process(&api.PostProcessRequest{
+				Files:         files,
+				PackagePrefix: o.PackagePrefix,
+			})
+			if err != nil {
+				return err
+			}
+			plugin.ApplyPostProcess(files, res)
+		}
+	}
+
+	for _, relPath := range sortStringKeys(files) {
+		if o.Writer != nil {
+			if err := o.Writer.WriteFile(relPath, files[relPath]); err != nil {
+				return fmt.Errorf("failed to write %q: %v", relPath, err)
+			}
+			continue
+		}
+
+		fullPath := filepath.Join(o.OutputDir, relPath)
+		directory := filepath.Dir(fullPath)
+
+		if err := os.MkdirAll(directory, 0755); err != nil {
+			return fmt.Errorf("could not create directory %q: %v", directory, err)
+		}
+
+		if err := ioutil.WriteFile(fullPath, files[relPath], 0644); err != nil {
+			return fmt.Errorf("failed to write %q: %v", fullPath, err)
+		}
+	}
+
+	return nil
+}
+
+// generateFiles generates the code for the given module and returns a
+// mapping of file paths relative to OutputDir to their contents.
+func generateFiles(m *compile.Module, importer thriftPackageImporter, o *Options) (map[string][]byte, error) {
+	plug := o.Plugin
+	if plug == nil {
+		plug = plugin.EmptyHandle
 	}
 
 	// Mapping of filenames relative to OutputDir to their contents.
 	files := make(map[string][]byte)
-	genBuilder := newGenerateServiceBuilder(importer)
+	typeMapper := plug.TypeMapper()
+	genBuilder := newGenerateServiceBuilder(importer, newTypeMapper(typeMapper, importer, o.SliceSets, o.Casing))
+	genBuilder.sliceSets = o.SliceSets
+	genBuilder.casing = o.Casing
 
 	generate := func(m *compile.Module) error {
-		path, contents, err := generateModule(m, importer, genBuilder, o)
+		moduleFiles, err := generateModule(m, importer, genBuilder, typeMapper, o)
 		if err != nil {
 			return generateError{Name: m.ThriftPath, Reason: err}
 		}
 
-		if err := addFile(files, path, contents); err != nil {
+		if err := mergeFiles(files, moduleFiles); err != nil {
 			return generateError{Name: m.ThriftPath, Reason: err}
 		}
 
+		if o.Benchmarks {
+			benchmarks, err := generateBenchmarks(m, importer, typeMapper, o)
+			if err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+
+			if err := mergeFiles(files, benchmarks); err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+		}
+
+		if o.FuzzTests {
+			fuzzTests, err := generateFuzzTests(m, importer, typeMapper, o)
+			if err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+
+			if err := mergeFiles(files, fuzzTests); err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+		}
+
+		if o.ServiceTests {
+			tests, err := generateServiceTests(m, importer, typeMapper, o)
+			if err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+
+			if err := mergeFiles(files, tests); err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+		}
+
 		return nil
 	}
 
@@ -125,46 +459,77 @@ func Generate(m *compile.Module, o *Options) error {
 	// be compiled into a single file.
 	if o.NoRecurse || len(o.OutputFile) > 0 {
 		if err := generate(m); err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		if err := m.Walk(generate); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	plug := o.Plugin
-	if plug == nil {
-		plug = plugin.EmptyHandle
-	}
-
 	if sgen := plug.ServiceGenerator(); sgen != nil {
 		res, err := sgen.Generate(genBuilder.Build())
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if err := mergeFiles(files, res.Files); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	for relPath, contents := range files {
-		fullPath := filepath.Join(o.OutputDir, relPath)
-		directory := filepath.Dir(fullPath)
+	return files, nil
+}
 
-		if err := os.MkdirAll(directory, 0755); err != nil {
-			return fmt.Errorf("could not create directory %q: %v", directory, err)
+// compareGeneratedFiles returns an error describing the differences between
+// the output of two runs of the code generator, if any.
+func compareGeneratedFiles(want, got map[string][]byte) error {
+	var err error
+	for _, path := range sortStringKeys(want) {
+		if _, ok := got[path]; !ok {
+			err = multierr.Append(err, fmt.Errorf("%q was generated only once", path))
 		}
+	}
 
-		if err := ioutil.WriteFile(fullPath, contents, 0644); err != nil {
-			return fmt.Errorf("failed to write %q: %v", fullPath, err)
+	for _, path := range sortStringKeys(got) {
+		wantContents, ok := want[path]
+		if !ok {
+			err = multierr.Append(err, fmt.Errorf("%q was generated only once", path))
+			continue
+		}
+
+		if line, ok := firstDifferentLine(wantContents, got[path]); ok {
+			err = multierr.Append(err, fmt.Errorf("%q differs between runs at line %d", path, line))
 		}
 	}
 
+	if err != nil {
+		return fmt.Errorf("generated code is not deterministic: %v", err)
+	}
 	return nil
 }
 
+// firstDifferentLine returns the 1-indexed number of the first line which
+// differs between a and b, and false if they are the same.
+func firstDifferentLine(a, b []byte) (int, bool) {
+	if bytes.Equal(a, b) {
+		return 0, false
+	}
+
+	aLines := bytes.Split(a, []byte("\n"))
+	bLines := bytes.Split(b, []byte("\n"))
+	for i := 0; i < len(aLines) && i < len(bLines); i++ {
+		if !bytes.Equal(aLines[i], bLines[i]) {
+			return i + 1, true
+		}
+	}
+
+	if len(aLines) < len(bLines) {
+		return len(aLines) + 1, true
+	}
+	return len(bLines) + 1, true
+}
+
 // ThriftPackageImporter determines import paths from a Thrift root.
 type ThriftPackageImporter interface {
 	// RelativePackage returns the import path for the top-level package of the
@@ -183,9 +548,17 @@ type ThriftPackageImporter interface {
 type thriftPackageImporter struct {
 	ImportPrefix string
 	ThriftRoot   string
+
+	// Packages maps paths of Thrift files to the paths of their packages
+	// relative to ImportPrefix if they don't follow the layout of the
+	// Thrift files.
+	Packages map[string]string
 }
 
 func (i thriftPackageImporter) RelativePackage(file string) (string, error) {
+	if pkg, ok := i.Packages[file]; ok {
+		return pkg, nil
+	}
 	return filepath.Rel(i.ThriftRoot, strings.TrimSuffix(file, ".thrift"))
 }
 
@@ -201,10 +574,51 @@ func (i thriftPackageImporter) Package(file string) (string, error) {
 	return filepath.Join(i.ImportPrefix, pkg), nil
 }
 
+// goPackageAnnotation specifies the import path of the package generated
+// for a Thrift file on its `namespace go` statement.
+const goPackageAnnotation = "go.package"
+
+// modulePackages returns the paths of the packages relative to PackagePrefix
+// for the given module and the modules it includes, for those whose package
+// is specified with a `namespace go` statement.
+func modulePackages(m *compile.Module, o *Options) (map[string]string, error) {
+	packages := make(map[string]string)
+	err := m.Walk(func(m *compile.Module) error {
+		ns, ok := m.Namespaces["go"]
+		if !ok {
+			return nil
+		}
+
+		if pkg, ok := ns.Annotations[goPackageAnnotation]; ok {
+			rel := strings.TrimPrefix(pkg, o.PackagePrefix+"/")
+			if rel == pkg || rel == "" {
+				return fmt.Errorf(
+					"%v %q of %q is not inside the package prefix %q",
+					goPackageAnnotation, pkg, m.ThriftPath, o.PackagePrefix)
+			}
+			packages[m.ThriftPath] = filepath.FromSlash(rel)
+			return nil
+		}
+
+		if o.NamespacePackages {
+			parts := strings.Split(ns.Name, ".")
+			for _, part := range parts {
+				if part == "" {
+					return fmt.Errorf(
+						"invalid Go namespace %q in %q", ns.Name, m.ThriftPath)
+				}
+			}
+			packages[m.ThriftPath] = filepath.Join(parts...)
+		}
+		return nil
+	})
+	return packages, err
+}
+
 func mergeFiles(dest, src map[string][]byte) error {
 	var err error
-	for path, contents := range src {
-		err = multierr.Append(err, addFile(dest, path, contents))
+	for _, path := range sortStringKeys(src) {
+		err = multierr.Append(err, addFile(dest, path, src[path]))
 	}
 	return err
 }
@@ -218,14 +632,16 @@ func addFile(dest map[string][]byte, path string, contents []byte) error {
 	return nil
 }
 
-// generateModule generates the code for the given Thrift file and returns the
-// path to the output file relative to OutputDir and the contents of the file.
+// generateModule generates the code for the given Thrift file and returns a
+// mapping of paths of the generated files relative to OutputDir to their
+// contents.
 func generateModule(
 	m *compile.Module,
 	i thriftPackageImporter,
 	builder *generateServiceBuilder,
+	typeMapper plugin.TypeMapper,
 	o *Options,
-) (outputFilepath string, contents []byte, err error) {
+) (map[string][]byte, error) {
 	// packageRelPath is the path relative to outputDir into which we'll be
 	// writing the package for this Thrift file. For $thriftRoot/foo/bar.thrift,
 	// packageRelPath is foo/bar, and packageDir is $outputDir/foo/bar. All
@@ -233,10 +649,9 @@ func generateModule(
 	// package will be importable via $importPrefix/foo/bar.
 	packageRelPath, err := i.RelativePackage(m.ThriftPath)
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
 
-	// TODO(abg): Prefer top-level package name from `namespace go` directive.
 	packageName := filepath.Base(packageRelPath)
 
 	// Output file name defaults to the package name.
@@ -244,80 +659,412 @@ func generateModule(
 	if len(o.OutputFile) > 0 {
 		outputFilename = o.OutputFile
 	}
-	outputFilepath = filepath.Join(packageRelPath, outputFilename)
 
 	// importPath is the full import path for the top-level package generated
 	// for this Thrift file.
 	importPath, err := i.Package(m.ThriftPath)
 	if err != nil {
-		return "", nil, err
+		return nil, err
+	}
+
+	// The module and its services are registered with the builder before
+	// anything else so that plugins learn about them even if the code for
+	// this module comes from the cache.
+	addModules := func(m *compile.Module) error {
+		_, err := builder.AddModule(m.ThriftPath)
+		return err
+	}
+
+	if err := m.Walk(addModules); err != nil {
+		return nil, err
+	}
+
+	for _, serviceName := range sortStringKeys(m.Services) {
+		service := m.Services[serviceName]
+
+		// generateModule gets called only for those modules for which we
+		// need to generate code. With --no-recurse, generateModule is called
+		// only on the root file specified by the user and not its included
+		// modules. Only services defined in these files are considered root
+		// services; plugins will generate code only for root services, even
+		// though they have information about the whole service tree.
+		if _, err := builder.AddRootService(service); err != nil {
+			return nil, err
+		}
+	}
+
+	// files maps names of files inside the package to their contents.
+	var files map[string][]byte
+
+	// The cache can't be used with TypeMapper plugins because the code they
+	// influence depends on more than the Thrift files.
+	//
+	// Options like NoMangle which only reject Thrift files without changing
+	// the generated code are absent from the cache key. They must be
+	// checked by Generate before the cache is consulted.
+	var (
+		cache    *moduleCache
+		cacheKey string
+	)
+	if o.CacheDir != "" && !hasTypeMapper(typeMapper) {
+		cache = &moduleCache{Dir: o.CacheDir}
+		cacheKey, err = cache.Key(m, i, o)
+		if err != nil {
+			return nil, err
+		}
+
+		var ok bool
+		files, ok, err = cache.Get(cacheKey)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return packageFiles(packageRelPath, files), nil
+		}
+	}
+
+	minimal, err := isMinimalModule(m, o)
+	if err != nil {
+		return nil, err
 	}
 
 	g := NewGenerator(&GeneratorOptions{
-		Importer:    i,
-		ImportPath:  importPath,
-		PackageName: packageName,
-		NoZap:       o.NoZap,
+		Importer:         i,
+		ImportPath:       importPath,
+		PackageName:      packageName,
+		TypeMapper:       typeMapper,
+		NoZap:            o.NoZap,
+		Minimal:          minimal,
+		SQL:              o.SQL,
+		SQLEnumNames:     o.SQLEnumNames,
+		CompactCodegen:   o.CompactCodegen,
+		SliceSets:        o.SliceSets,
+		SortedMaps:       o.SortedMaps,
+		Descriptors:      o.Descriptors,
+		SizeMethods:      o.SizeMethods,
+		StreamEncode:     o.StreamEncode,
+		BuilderThreshold: o.BuilderThreshold,
+		LazyConstants:    o.LazyConstants,
+		Casing:           o.Casing,
+		Generics:         o.Generics,
 	})
 
+	files = make(map[string][]byte)
+
+	// write writes everything generated since the last call into a file
+	// with the given name.
+	write := func(name string) error {
+		buff := new(bytes.Buffer)
+		if err := g.Write(buff, nil); err != nil {
+			return fmt.Errorf("could not write output for file %q: %v", name, err)
+		}
+		return addFile(files, name, buff.Bytes())
+	}
+
 	if len(m.Constants) > 0 {
 		for _, constantName := range sortStringKeys(m.Constants) {
 			if err := Constant(g, m.Constants[constantName]); err != nil {
-				return "", nil, err
+				return nil, err
+			}
+		}
+
+		if o.OutputLayout != SingleFileLayout {
+			if err := write("constants.go"); err != nil {
+				return nil, err
 			}
 		}
 	}
 
 	if len(m.Types) > 0 {
 		for _, typeName := range sortStringKeys(m.Types) {
-			if err := TypeDefinition(g, m.Types[typeName]); err != nil {
-				return "", nil, err
+			spec := m.Types[typeName]
+			if err := TypeDefinition(g, spec); err != nil {
+				return nil, err
+			}
+
+			if o.OutputLayout == PerTypeLayout {
+				if err := write(typeFilename(spec)); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		hasErrorCodes, err := errorCodes(g, m.Types)
+		if err != nil {
+			return nil, err
+		}
+
+		if o.OutputLayout == PerKindLayout {
+			if err := write("types.go"); err != nil {
+				return nil, err
+			}
+		}
+
+		if o.OutputLayout == PerTypeLayout && hasErrorCodes {
+			if err := write("error_codes.go"); err != nil {
+				return nil, err
 			}
 		}
 	}
 
 	if !o.NoEmbedIDL {
 		if err := embedIDL(g, i, m); err != nil {
-			return "", nil, err
+			return nil, err
 		}
-	}
 
-	addModules := func(m *compile.Module) error {
-		_, err := builder.AddModule(m.ThriftPath)
-		return err
-	}
-
-	if err := m.Walk(addModules); err != nil {
-		return "", nil, err
+		if o.OutputLayout != SingleFileLayout {
+			if err := write("idl.go"); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Services must be generated last because names of user-defined types take
 	// precedence over the names we pick for the service types.
 	if len(m.Services) > 0 {
-		for _, serviceName := range sortStringKeys(m.Services) {
-			service := m.Services[serviceName]
-
-			// generateModule gets called only for those modules for which we
-			// need to generate code. With --no-recurse, generateModule is
-			// called only on the root file specified by the user and not its
-			// included modules. Only services defined in these files are
-			// considered root services; plugins will generate code only for
-			// root services, even though they have information about the
-			// whole service tree.
-			if _, err := builder.AddRootService(service); err != nil {
-				return "", nil, err
+		// With PerTypeLayout, services are generated one at a time so that
+		// each gets its own file.
+		serviceGroups := []map[string]*compile.ServiceSpec{m.Services}
+		if o.OutputLayout == PerTypeLayout {
+			serviceGroups = nil
+			for _, serviceName := range sortStringKeys(m.Services) {
+				serviceGroups = append(serviceGroups, map[string]*compile.ServiceSpec{
+					serviceName: m.Services[serviceName],
+				})
 			}
 		}
 
-		if err = Services(g, m.Services); err != nil {
-			return "", nil, fmt.Errorf("could not generate code for services %v", err)
+		for _, services := range serviceGroups {
+			if err = Services(g, services); err != nil {
+				return nil, fmt.Errorf("could not generate code for services %v", err)
+			}
+
+			if o.ServiceStubs {
+				if err = ServiceStubs(g, services); err != nil {
+					return nil, fmt.Errorf("could not generate stubs for services %v", err)
+				}
+			}
+
+			if o.HTTPHandlers {
+				if err = ServiceHTTPHandlers(g, services); err != nil {
+					return nil, fmt.Errorf("could not generate HTTP handlers for services %v", err)
+				}
+			}
+
+			if o.PrometheusMetrics {
+				if err = ServiceMetrics(g, services); err != nil {
+					return nil, fmt.Errorf("could not generate metrics for services %v", err)
+				}
+			}
+
+			if o.OutputLayout == PerTypeLayout {
+				for serviceName := range services {
+					if err := write(layoutFilename(serviceName, "service")); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		if o.OutputLayout == PerKindLayout {
+			if err := write("services.go"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Thrift files without any code still get a file so that their package
+	// exists.
+	if o.OutputLayout == SingleFileLayout || len(files) == 0 {
+		if err := write(outputFilename); err != nil {
+			return nil, err
+		}
+	}
+
+	if cache != nil {
+		if err := cache.Put(cacheKey, files); err != nil {
+			return nil, err
 		}
 	}
 
+	return packageFiles(packageRelPath, files), nil
+}
+
+// packageFiles prefixes the names of the given files with the path of their
+// package relative to OutputDir.
+func packageFiles(packageRelPath string, files map[string][]byte) map[string][]byte {
+	out := make(map[string][]byte, len(files))
+	for name, contents := range files {
+		out[filepath.Join(packageRelPath, name)] = contents
+	}
+	return out
+}
+
+// generateServiceTests generates a package holding fakes of the stubs for
+// each service of the given Thrift file. For the service Foo of
+// $thriftRoot/foo/bar.thrift, the fakes are written to the package
+// $outputDir/foo/bar/footest.
+//
+// Returns a mapping of paths relative to OutputDir to the contents of the
+// files.
+func generateServiceTests(
+	m *compile.Module,
+	i thriftPackageImporter,
+	typeMapper plugin.TypeMapper,
+	o *Options,
+) (map[string][]byte, error) {
+	packageRelPath, err := i.RelativePackage(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath, err := i.Package(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	for _, serviceName := range sortStringKeys(m.Services) {
+		s := m.Services[serviceName]
+		packageName := strings.ToLower(s.Name) + "test"
+
+		g := NewGenerator(&GeneratorOptions{
+			Importer:    i,
+			ImportPath:  filepath.Join(importPath, packageName),
+			PackageName: packageName,
+			TypeMapper:  typeMapper,
+			NoZap:       o.NoZap,
+			Generics:    o.Generics,
+		})
+
+		if err := ServiceFakes(g, s); err != nil {
+			return nil, fmt.Errorf("could not generate fakes for service %v: %v", s.Name, err)
+		}
+
+		buff := new(bytes.Buffer)
+		if err := g.Write(buff, nil); err != nil {
+			return nil, fmt.Errorf("could not write fakes for service %v: %v", s.Name, err)
+		}
+
+		files[filepath.Join(packageRelPath, packageName, packageName+".go")] = buff.Bytes()
+	}
+	return files, nil
+}
+
+// generateBenchmarks generates benchmarks for the structs of the given
+// Thrift file. For $thriftRoot/foo/bar.thrift, the benchmarks are written to
+// $outputDir/foo/bar/bar_benchmark_test.go.
+//
+// Returns a mapping of paths relative to OutputDir to the contents of the
+// files. Nothing is returned if the file has no structs.
+func generateBenchmarks(
+	m *compile.Module,
+	i thriftPackageImporter,
+	typeMapper plugin.TypeMapper,
+	o *Options,
+) (map[string][]byte, error) {
+	packageRelPath, err := i.RelativePackage(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath, err := i.Package(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+
+	packageName := filepath.Base(packageRelPath)
+	g := NewGenerator(&GeneratorOptions{
+		Importer:    i,
+		ImportPath:  importPath,
+		PackageName: packageName,
+		TypeMapper:  typeMapper,
+		NoZap:       o.NoZap,
+		Generics:    o.Generics,
+	})
+
+	var hasStructs bool
+	for _, typeName := range sortStringKeys(m.Types) {
+		spec, ok := m.Types[typeName].(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+
+		hasStructs = true
+		if err := Benchmarks(g, spec); err != nil {
+			return nil, fmt.Errorf("could not generate benchmarks for %v: %v", spec.Name, err)
+		}
+	}
+
+	if !hasStructs {
+		return nil, nil
+	}
+
+	buff := new(bytes.Buffer)
+	if err := g.Write(buff, nil); err != nil {
+		return nil, fmt.Errorf("could not write benchmarks: %v", err)
+	}
+
+	path := filepath.Join(packageRelPath, packageName+"_benchmark_test.go")
+	return map[string][]byte{path: buff.Bytes()}, nil
+}
+
+// generateFuzzTests generates fuzz tests for the structs of the given Thrift
+// file. For $thriftRoot/foo/bar.thrift, the fuzz tests are written to
+// $outputDir/foo/bar/bar_fuzz_test.go.
+//
+// Returns a mapping of paths relative to OutputDir to the contents of the
+// files. Nothing is returned if the file has no structs.
+func generateFuzzTests(
+	m *compile.Module,
+	i thriftPackageImporter,
+	typeMapper plugin.TypeMapper,
+	o *Options,
+) (map[string][]byte, error) {
+	packageRelPath, err := i.RelativePackage(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath, err := i.Package(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+
+	packageName := filepath.Base(packageRelPath)
+	g := NewGenerator(&GeneratorOptions{
+		Importer:    i,
+		ImportPath:  importPath,
+		PackageName: packageName,
+		TypeMapper:  typeMapper,
+		NoZap:       o.NoZap,
+		// Native fuzzing requires Go 1.18 so the file always carries the
+		// build constraint of code generated with generics.
+		Generics: true,
+	})
+
+	var hasStructs bool
+	for _, typeName := range sortStringKeys(m.Types) {
+		spec, ok := m.Types[typeName].(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+
+		hasStructs = true
+		if err := FuzzTests(g, spec); err != nil {
+			return nil, fmt.Errorf("could not generate fuzz tests for %v: %v", spec.Name, err)
+		}
+	}
+
+	if !hasStructs {
+		return nil, nil
+	}
+
 	buff := new(bytes.Buffer)
 	if err := g.Write(buff, nil); err != nil {
-		return "", nil, fmt.Errorf("could not write output for file %q: %v", outputFilename, err)
+		return nil, fmt.Errorf("could not write fuzz tests: %v", err)
 	}
 
-	return outputFilepath, buff.Bytes(), nil
+	path := filepath.Join(packageRelPath, packageName+"_fuzz_test.go")
+	return map[string][]byte{path: buff.Bytes()}, nil
 }
@@ -22,12 +22,19 @@ package gen
 
 import (
 	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/goast"
 	"go.uber.org/thriftrw/internal/plugin"
 	"go.uber.org/thriftrw/internal/plugin/handletest"
 	"go.uber.org/thriftrw/plugin/api"
@@ -148,7 +155,10 @@ func TestGenerate(t *testing.T) {
 			desc: "no service generator",
 			getPlugin: func(mockCtrl *gomock.Controller) plugin.Handle {
 				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().Validator().Return(nil)
+				handle.EXPECT().TypeMapper().Return(nil)
 				handle.EXPECT().ServiceGenerator().Return(nil)
+				handle.EXPECT().PostProcessor().Return(nil)
 				return handle
 			},
 			wantFiles: []string{
@@ -187,7 +197,10 @@ func TestGenerate(t *testing.T) {
 					}, nil)
 
 				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().Validator().Return(nil)
+				handle.EXPECT().TypeMapper().Return(nil)
 				handle.EXPECT().ServiceGenerator().Return(sgen)
+				handle.EXPECT().PostProcessor().Return(nil)
 				return handle
 			},
 			wantFiles: []string{
@@ -206,6 +219,8 @@ func TestGenerate(t *testing.T) {
 					}, nil)
 
 				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().Validator().Return(nil)
+				handle.EXPECT().TypeMapper().Return(nil)
 				handle.EXPECT().ServiceGenerator().Return(sgen)
 				return handle
 			},
@@ -218,6 +233,8 @@ func TestGenerate(t *testing.T) {
 				sgen.EXPECT().Generate(gomock.Any()).Return(nil, errors.New("great sadness"))
 
 				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().Validator().Return(nil)
+				handle.EXPECT().TypeMapper().Return(nil)
 				handle.EXPECT().ServiceGenerator().Return(sgen)
 				return handle
 			},
@@ -262,6 +279,552 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+func TestGeneratePostProcessor(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	module, err := compile.Compile(testdata(t, "thrift/services.thrift"))
+	require.NoError(t, err)
+
+	sgen := handletest.NewMockServiceGenerator(mockCtrl)
+	sgen.EXPECT().Generate(gomock.Any()).
+		Return(&api.GenerateServiceResponse{
+			Files: map[string][]byte{"services/yarpc.go": []byte("package services\n")},
+		}, nil)
+
+	pp := handletest.NewMockPostProcessor(mockCtrl)
+	pp.EXPECT().Process(gomock.Any()).
+		DoAndReturn(func(req *api.PostProcessRequest) (*api.PostProcessResponse, error) {
+			assert.Equal(t, "go.uber.org/thriftrw/gen/internal/tests", req.PackagePrefix)
+			assert.Contains(t, req.Files, "services/services.go")
+			assert.Contains(t, req.Files, "services/yarpc.go",
+				"files generated by plugins must be post-processed")
+
+			return &api.PostProcessResponse{
+				Files: map[string][]byte{
+					"services/services.go": append([]byte("// +build thrift\n\n"), req.Files["services/services.go"]...),
+					"services/tags.go":     []byte("package services\n"),
+				},
+				RemovedFiles: []string{"services/yarpc.go"},
+			}, nil
+		})
+
+	handle := handletest.NewMockHandle(mockCtrl)
+	handle.EXPECT().Validator().Return(nil)
+	handle.EXPECT().TypeMapper().Return(nil)
+	handle.EXPECT().ServiceGenerator().Return(sgen)
+	handle.EXPECT().PostProcessor().Return(pp)
+
+	w := make(mapFileWriter)
+	err = Generate(module, &Options{
+		OutputDir:     "/",
+		PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		Plugin:        handle,
+		Writer:        w,
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, w, 2)
+	assert.True(t, strings.HasPrefix(string(w["services/services.go"]), "// +build thrift\n\n"))
+	assert.Equal(t, "package services\n", string(w["services/tags.go"]))
+}
+
+func TestGenerateDeterministicCheck(t *testing.T) {
+	module, err := compile.Compile(testdata(t, "thrift/services.thrift"))
+	require.NoError(t, err)
+
+	t.Run("deterministic", func(t *testing.T) {
+		outputDir, err := ioutil.TempDir("", "thriftrw-deterministic-test")
+		require.NoError(t, err)
+		defer os.RemoveAll(outputDir)
+
+		err = Generate(module, &Options{
+			OutputDir:          outputDir,
+			PackagePrefix:      "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:         testdata(t, "thrift"),
+			ServiceStubs:       true,
+			DeterministicCheck: true,
+		})
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(outputDir, "services/services.go"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("not deterministic", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		outputDir, err := ioutil.TempDir("", "thriftrw-deterministic-test")
+		require.NoError(t, err)
+		defer os.RemoveAll(outputDir)
+
+		var calls int
+		sgen := handletest.NewMockServiceGenerator(mockCtrl)
+		sgen.EXPECT().Generate(gomock.Any()).
+			DoAndReturn(func(*api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+				calls++
+				return &api.GenerateServiceResponse{
+					Files: map[string][]byte{
+						"foo.txt": []byte("hello\nworld " + strconv.Itoa(calls) + "\n"),
+					},
+				}, nil
+			}).Times(2)
+
+		handle := handletest.NewMockHandle(mockCtrl)
+		handle.EXPECT().Validator().Return(nil)
+		handle.EXPECT().TypeMapper().Return(nil).Times(2)
+		handle.EXPECT().ServiceGenerator().Return(sgen).Times(2)
+
+		err = Generate(module, &Options{
+			OutputDir:          outputDir,
+			PackagePrefix:      "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:         testdata(t, "thrift"),
+			Plugin:             handle,
+			DeterministicCheck: true,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "generated code is not deterministic")
+		assert.Contains(t, err.Error(), `"foo.txt" differs between runs at line 2`)
+
+		_, err = os.Stat(filepath.Join(outputDir, "services/services.go"))
+		assert.True(t, os.IsNotExist(err), "no files must be written")
+	})
+}
+
+type mapFileWriter map[string][]byte
+
+func (w mapFileWriter) WriteFile(path string, contents []byte) error {
+	if strings.HasSuffix(path, "/fail.go") {
+		return errors.New("great sadness")
+	}
+	w[path] = contents
+	return nil
+}
+
+func TestGenerateWriter(t *testing.T) {
+	module, err := compile.Compile(testdata(t, "thrift/services.thrift"))
+	require.NoError(t, err)
+
+	outputDir, err := ioutil.TempDir("", "thriftrw-writer-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	files := make(mapFileWriter)
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+		ThriftRoot:    testdata(t, "thrift"),
+		Writer:        files,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, files, "services/services.go")
+	assert.Contains(t, files, "exceptions/exceptions.go", "included files must be written too")
+
+	entries, err := ioutil.ReadDir(outputDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "nothing must be written to the output directory")
+
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+		ThriftRoot:    testdata(t, "thrift"),
+		OutputFile:    "fail.go",
+		NoRecurse:     true,
+		Writer:        files,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `failed to write "services/fail.go": great sadness`)
+}
+
+func TestGenerateOutputLayout(t *testing.T) {
+	module, err := compile.Compile(testdata(t, "thrift/layout.thrift"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc       string
+		layout     OutputLayout
+		outputFile string
+
+		wantFiles []string
+		wantError string
+	}{
+		{
+			desc:      "single",
+			layout:    SingleFileLayout,
+			wantFiles: []string{"layout/layout.go"},
+		},
+		{
+			desc:   "per-kind",
+			layout: PerKindLayout,
+			wantFiles: []string{
+				"layout/constants.go",
+				"layout/idl.go",
+				"layout/services.go",
+				"layout/types.go",
+			},
+		},
+		{
+			desc:   "per-type",
+			layout: PerTypeLayout,
+			wantFiles: []string{
+				"layout/archive_struct.go",
+				"layout/bundle_struct.go",
+				"layout/canvas_service.go",
+				"layout/color_enum.go",
+				"layout/constants.go",
+				"layout/idl.go",
+				"layout/name_typedef.go",
+				"layout/point_struct.go",
+				"layout/registry_service.go",
+				"layout/shape_union.go",
+				"layout/shapenotfound_exception.go",
+				"layout/tag_struct.go",
+			},
+		},
+		{
+			desc:       "output file",
+			layout:     PerKindLayout,
+			outputFile: "layout.go",
+			wantError:  "OutputFile cannot be used with OutputLayout",
+		},
+		{
+			desc:      "unknown",
+			layout:    OutputLayout(42),
+			wantError: "unknown OutputLayout 42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			files := make(mapFileWriter)
+			err := Generate(module, &Options{
+				OutputDir:     "/does/not/exist",
+				PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+				ThriftRoot:    testdata(t, "thrift"),
+				OutputFile:    tt.outputFile,
+				OutputLayout:  tt.layout,
+				Writer:        files,
+			})
+			if tt.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFiles, sortStringKeys(files))
+			assertNoUnusedImports(t, files)
+		})
+	}
+}
+
+// assertNoUnusedImports type checks the given generated files and fails the
+// test if any of them has imports that it doesn't use. Imported packages are
+// replaced with empty packages so errors other than unused imports are
+// ignored.
+func assertNoUnusedImports(t *testing.T, files map[string][]byte) {
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, name := range sortStringKeys(files) {
+		f, err := parser.ParseFile(fset, name, files[name], 0)
+		require.NoError(t, err, "could not parse %q", name)
+		parsed = append(parsed, f)
+	}
+
+	cfg := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			pkg := types.NewPackage(path, goast.DeterminePackageName(path))
+			pkg.MarkComplete()
+			return pkg, nil
+		}),
+		Error: func(err error) {
+			if strings.Contains(err.Error(), "imported and not used") {
+				t.Error(err)
+			}
+		},
+	}
+	cfg.Check(parsed[0].Name.Name, fset, parsed, nil)
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+func TestLayoutFilename(t *testing.T) {
+	tests := []struct {
+		name, kind string
+		want       string
+	}{
+		{"KeyValue", "service", "keyvalue_service.go"},
+		{"Foo_test", "struct", "foo_test_struct.go"},
+		{"Arch_amd64", "enum", "arch_amd64_enum.go"},
+		{"__Internal", "typedef", "internal_typedef.go"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, layoutFilename(tt.name, tt.kind), "%v %v", tt.kind, tt.name)
+	}
+}
+
+func TestCompareGeneratedFiles(t *testing.T) {
+	tests := []struct {
+		desc      string
+		want, got map[string][]byte
+		wantError []string
+	}{
+		{
+			desc: "same",
+			want: map[string][]byte{"a.go": []byte("a\nb\n")},
+			got:  map[string][]byte{"a.go": []byte("a\nb\n")},
+		},
+		{
+			desc:      "different line",
+			want:      map[string][]byte{"a.go": []byte("a\nb\nc\n")},
+			got:       map[string][]byte{"a.go": []byte("a\nc\nc\n")},
+			wantError: []string{`"a.go" differs between runs at line 2`},
+		},
+		{
+			desc:      "truncated",
+			want:      map[string][]byte{"a.go": []byte("a\nb")},
+			got:       map[string][]byte{"a.go": []byte("a\nb\nc")},
+			wantError: []string{`"a.go" differs between runs at line 3`},
+		},
+		{
+			desc: "missing files",
+			want: map[string][]byte{"a.go": nil, "b.go": nil},
+			got:  map[string][]byte{"b.go": nil, "c.go": nil},
+			wantError: []string{
+				`"a.go" was generated only once`,
+				`"c.go" was generated only once`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := compareGeneratedFiles(tt.want, tt.got)
+			if len(tt.wantError) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, msg := range tt.wantError {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestGenerateIncludeAs(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-include-as")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"main.thrift": `
+			include "./foo/shared.thrift" as fooshared
+			include "./bar/shared.thrift" as barshared
+
+			struct Pair {
+				1: required fooshared.Item foo
+				2: required barshared.Item bar
+			}
+		`,
+		"foo/shared.thrift": `struct Item { 1: required string name }`,
+		"bar/shared.thrift": `struct Item { 1: required i64 id }`,
+	}
+	module := compileThriftFiles(t, thriftRoot, files, "main.thrift")
+
+	outputDir := filepath.Join(thriftRoot, "out")
+	require.NoError(t, Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+	}))
+
+	f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(outputDir, "main", "main.go"), nil, parser.ImportsOnly)
+	require.NoError(t, err)
+
+	imports := make(map[string]string) // path -> name
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		require.NoError(t, err)
+		if imp.Name != nil {
+			imports[path] = imp.Name.Name
+		} else {
+			imports[path] = filepath.Base(path)
+		}
+	}
+
+	require.Contains(t, imports, "example.com/idl/foo/shared")
+	require.Contains(t, imports, "example.com/idl/bar/shared")
+	assert.NotEqual(t,
+		imports["example.com/idl/foo/shared"],
+		imports["example.com/idl/bar/shared"],
+		"packages with the same name must be imported under different names")
+
+	for _, f := range []string{"foo/shared/shared.go", "bar/shared/shared.go"} {
+		_, err := os.Stat(filepath.Join(outputDir, f))
+		assert.NoError(t, err, "expected %v to be generated", f)
+	}
+}
+
+func TestGenerateNamespacePackages(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-namespace-packages")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"main.thrift": `
+			namespace go services.main
+			include "./types/shared.thrift"
+			include "./types/other.thrift"
+
+			struct Request {
+				1: required shared.Item item
+				2: required other.Item other
+			}
+		`,
+		"types/shared.thrift": `
+			namespace go common.types (go.package = "example.com/idl/custom/sharedtypes")
+			struct Item { 1: required string name }
+		`,
+		"types/other.thrift": `struct Item { 1: required i64 id }`,
+	}
+	module := compileThriftFiles(t, thriftRoot, files, "main.thrift")
+
+	tests := []struct {
+		desc              string
+		namespacePackages bool
+		wantFiles         []string
+		wantImports       []string
+	}{
+		{
+			desc: "file layout",
+			wantFiles: []string{
+				"main/main.go",
+				"custom/sharedtypes/sharedtypes.go",
+				"types/other/other.go",
+			},
+			wantImports: []string{
+				"example.com/idl/custom/sharedtypes",
+				"example.com/idl/types/other",
+			},
+		},
+		{
+			desc:              "namespace layout",
+			namespacePackages: true,
+			wantFiles: []string{
+				"services/main/main.go",
+				"custom/sharedtypes/sharedtypes.go",
+				"types/other/other.go",
+			},
+			wantImports: []string{
+				"example.com/idl/custom/sharedtypes",
+				"example.com/idl/types/other",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			outputDir, err := ioutil.TempDir("", "thriftrw-namespace-packages-out")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			require.NoError(t, Generate(module, &Options{
+				OutputDir:         outputDir,
+				PackagePrefix:     "example.com/idl",
+				ThriftRoot:        thriftRoot,
+				NamespacePackages: tt.namespacePackages,
+			}))
+
+			for _, f := range tt.wantFiles {
+				_, err := os.Stat(filepath.Join(outputDir, f))
+				assert.NoError(t, err, "expected %v to be generated", f)
+			}
+
+			f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(outputDir, tt.wantFiles[0]), nil, parser.ImportsOnly)
+			require.NoError(t, err)
+
+			var imports []string
+			for _, imp := range f.Imports {
+				path, err := strconv.Unquote(imp.Path.Value)
+				require.NoError(t, err)
+				imports = append(imports, path)
+			}
+			for _, want := range tt.wantImports {
+				assert.Contains(t, imports, want)
+			}
+		})
+	}
+}
+
+func TestGenerateGoPackageOutsidePrefix(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-go-package")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	path := filepath.Join(thriftRoot, "main.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+		namespace go main (go.package = "example.org/main")
+	`), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`go.package "example.org/main" of "`+path+`" is not inside the package prefix "example.com/idl"`)
+}
+
+func TestGenerateImportCycle(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-import-cycle")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"a.thrift": `
+			include "./b.thrift"
+			struct A { 1: optional b.B b }
+		`,
+		"b.thrift": `
+			include "./c.thrift"
+			struct B { 1: optional c.C c }
+		`,
+		"c.thrift": `
+			include "./a.thrift"
+			struct C { 1: optional a.A a }
+		`,
+	}
+	module := compileThriftFiles(t, thriftRoot, files, "b.thrift")
+
+	err = Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+	})
+	require.Error(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"found an import cycle between the packages for:",
+		"    b.thrift",
+		" -> c.thrift",
+		" -> a.thrift",
+		" -> b.thrift",
+		"types which refer to each other must be defined in the same file",
+	}, "\n"), err.Error())
+}
+
 func TestGenerateModule(t *testing.T) {
 	t.Run("module data should be added to the GenerateServiceBuilder even if the Thrift module contains no service data", func(t *testing.T) {
 		thriftRoot := testdata(t, "thrift")
@@ -271,7 +834,7 @@ func TestGenerateModule(t *testing.T) {
 			ThriftRoot:   thriftRoot,
 		}
 
-		genBuilder := newGenerateServiceBuilder(importer)
+		genBuilder := newGenerateServiceBuilder(importer, nil)
 
 		module, err := compile.Compile("internal/tests/thrift/structs.thrift")
 		require.NoError(t, err)
@@ -283,7 +846,7 @@ func TestGenerateModule(t *testing.T) {
 			ThriftRoot:    thriftRoot,
 		}
 
-		_, _, err = generateModule(module, importer, genBuilder, opt)
+		_, err = generateModule(module, importer, genBuilder, nil, opt)
 		require.NoError(t, err)
 
 		gen := genBuilder.Build()
@@ -35,11 +35,16 @@ import (
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/internal/curry"
+	"go.uber.org/thriftrw/internal/plugin"
 	"go.uber.org/thriftrw/version"
 )
 
 var generatedByHeader = fmt.Sprintf("// Code generated by thriftrw v%s. DO NOT EDIT.\n// @generated\n\n", version.Version)
 
+// genericsBuildConstraint restricts code generated with generics to the
+// versions of Go which support them.
+const genericsBuildConstraint = "//go:build go1.18\n// +build go1.18\n\n"
+
 // Generator allows generating declarations and other templated text for a
 // single Go package which may be spread across multiple files.
 //
@@ -77,6 +82,11 @@ type Generator interface {
 	// necessary.
 	LookupConstantName(*compile.Constant) (string, error)
 
+	// LookupServiceName returns the fully qualified form of the given name
+	// declared in the package generated for the given Thrift service. It
+	// imports the corresponding Go package if necessary.
+	LookupServiceName(s *compile.ServiceSpec, name string) (string, error)
+
 	// Import ensures that the given package has been imported in the generated
 	// code. Returns the name that should be used to reference the imported
 	// module.
@@ -128,10 +138,25 @@ type generator struct {
 
 	w              WireGenerator
 	e              equalsGenerator
+	c              cloneGenerator
 	z              zapGenerator
 	noZap          bool
+	minimal        bool
+	sql            bool
+	sqlEnumNames   bool
+	compact        bool
+	sliceSets      bool
+	sortedMaps     bool
+	descriptors    bool
+	sizeMethods    bool
+	streamEncode   bool
+	builders       int
+	lazyConstants  bool
+	casing         Casing
+	generics       bool
 	decls          []ast.Decl
 	thriftImporter ThriftPackageImporter
+	typeMapper     *typeMapper
 	mangler        *mangler
 
 	counter int
@@ -146,22 +171,95 @@ type GeneratorOptions struct {
 	ImportPath  string
 	PackageName string
 
+	// TypeMapper, if non-nil, provides custom Go types for fields.
+	TypeMapper plugin.TypeMapper
+
 	NoZap bool
+
+	// Minimal generates only types and their ToWire and FromWire methods,
+	// omitting String, Equals, JSON, zap, streaming, and envelope helpers.
+	// It implies NoZap.
+	Minimal bool
+
+	// SQL generates database/sql Valuer and Scanner implementations for
+	// enums and typedefs of base types. Enums are stored by name instead of
+	// their integer value if SQLEnumNames is set.
+	SQL          bool
+	SQLEnumNames bool
+
+	// CompactCodegen generates ToWire and FromWire methods of structs which
+	// delegate to tables describing their fields.
+	CompactCodegen bool
+
+	// SliceSets represents sets as slices instead of maps unless they're
+	// annotated with (go.type = "map").
+	SliceSets bool
+
+	// SortedMaps sends the items of maps over the wire in the order of
+	// their keys unless they're annotated with (go.sortedMap = "false").
+	SortedMaps bool
+
+	// Descriptors generates ThriftDescriptor methods which describe structs
+	// for the dynamic package.
+	Descriptors bool
+
+	// SizeMethods generates SizeInBytes methods which report the encoded
+	// size of structs, enums, and typedefs.
+	SizeMethods bool
+
+	// StreamEncode generates Encode methods which write structs, enums,
+	// and typedefs directly to a stream.Writer.
+	StreamEncode bool
+
+	// BuilderThreshold generates builders for structs with at least this
+	// many fields. Builders are generated only for structs annotated with
+	// go.builder if this is zero.
+	BuilderThreshold int
+
+	// LazyConstants generates functions which build the values of
+	// constants that cannot be Go constants the first time they're called
+	// instead of package-level variables.
+	LazyConstants bool
+
+	// Casing controls how Go names are derived from the names of entities
+	// in Thrift files.
+	Casing Casing
+
+	// Generics converts lists, sets, and maps with the helpers in the
+	// generic package, which requires Go 1.18.
+	Generics bool
 }
 
 // NewGenerator sets up a new generator for Go code.
 func NewGenerator(o *GeneratorOptions) Generator {
 	// TODO(abg): Determine package name from `namespace go` directive.
 	namespace := NewNamespace()
+	mangler := newMangler()
+	mangler.sliceSets = o.SliceSets
+	mangler.sortedMaps = o.SortedMaps
 	return &generator{
 		PackageName:    o.PackageName,
 		ImportPath:     o.ImportPath,
 		Namespace:      namespace,
 		importer:       newImporter(namespace.Child()),
-		mangler:        newMangler(),
+		mangler:        mangler,
 		thriftImporter: o.Importer,
+		typeMapper:     newTypeMapper(o.TypeMapper, o.Importer, o.SliceSets, o.Casing),
 		fset:           token.NewFileSet(),
-		noZap:          o.NoZap,
+		noZap:          o.NoZap || o.Minimal,
+		minimal:        o.Minimal,
+		sql:            o.SQL,
+		sqlEnumNames:   o.SQLEnumNames,
+		compact:        o.CompactCodegen,
+		sliceSets:      o.SliceSets,
+		sortedMaps:     o.SortedMaps,
+		descriptors:    o.Descriptors,
+		sizeMethods:    o.SizeMethods,
+		streamEncode:   o.StreamEncode,
+		builders:       o.BuilderThreshold,
+		lazyConstants:  o.LazyConstants,
+		casing:         o.Casing,
+		generics:       o.Generics,
 	}
 }
 
@@ -173,6 +271,114 @@ func checkNoZap(g Generator) bool {
 	return false
 }
 
+// checkMinimal returns whether the Minimal flag is passed.
+func checkMinimal(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.minimal
+	}
+	return false
+}
+
+// checkSQL returns whether the SQL flag is passed.
+func checkSQL(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.sql
+	}
+	return false
+}
+
+// checkCompactCodegen returns whether the CompactCodegen flag is passed.
+func checkCompactCodegen(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.compact
+	}
+	return false
+}
+
+// checkSliceSets returns whether the SliceSets flag is passed.
+func checkSliceSets(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.sliceSets
+	}
+	return false
+}
+
+// checkSortedMaps returns whether the SortedMaps flag is passed.
+func checkSortedMaps(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.sortedMaps
+	}
+	return false
+}
+
+// checkDescriptors returns whether the Descriptors flag is passed.
+func checkDescriptors(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.descriptors
+	}
+	return false
+}
+
+// checkSizeMethods returns whether the SizeMethods flag is passed.
+func checkSizeMethods(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.sizeMethods
+	}
+	return false
+}
+
+// checkStreamEncode returns whether the StreamEncode flag is passed.
+func checkStreamEncode(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.streamEncode
+	}
+	return false
+}
+
+// checkBuilderThreshold returns the value of the BuilderThreshold option.
+func checkBuilderThreshold(g Generator) int {
+	if gen, ok := g.(*generator); ok {
+		return gen.builders
+	}
+	return 0
+}
+
+// checkLazyConstants returns whether the LazyConstants flag is passed.
+func checkLazyConstants(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.lazyConstants
+	}
+	return false
+}
+
+// checkCasing returns the value of the Casing option.
+func checkCasing(g Generator) Casing {
+	if gen, ok := g.(*generator); ok {
+		return gen.casing
+	}
+	return GoCasing
+}
+
+// checkGenerics returns whether the Generics flag is passed.
+func checkGenerics(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.generics
+	}
+	return false
+}
+
+// checkSQLEnumNames returns whether the SQLEnumNames flag is passed.
+func checkSQLEnumNames(g Generator) bool {
+	if gen, ok := g.(*generator); ok {
+		return gen.sqlEnumNames
+	}
+	return false
+}
+
+func (g *generator) setUsesMap(spec *compile.SetSpec) bool {
+	return setUsesMap(spec, g.sliceSets)
+}
+
 func (g *generator) MangleType(t compile.TypeSpec) string {
 	return g.mangler.MangleType(t)
 }
@@ -188,7 +394,7 @@ func (g *generator) LookupTypeName(t compile.TypeSpec) (string, error) {
 		return "", err
 	}
 
-	name, err := goName(t)
+	name, err := g.casing.goName(t)
 	if err != nil {
 		return "", err
 	}
@@ -205,7 +411,20 @@ func (g *generator) LookupConstantName(c *compile.Constant) (string, error) {
 		return "", err
 	}
 
-	name := constantName(c.Name)
+	name := g.casing.constantName(c.Name)
+	if importPath != g.ImportPath {
+		pkg := g.Import(importPath)
+		name = pkg + "." + name
+	}
+	return name, nil
+}
+
+func (g *generator) LookupServiceName(s *compile.ServiceSpec, name string) (string, error) {
+	importPath, err := g.thriftImporter.Package(s.File)
+	if err != nil {
+		return "", err
+	}
+
 	if importPath != g.ImportPath {
 		pkg := g.Import(importPath)
 		name = pkg + "." + name
@@ -216,31 +435,42 @@ func (g *generator) LookupConstantName(c *compile.Constant) (string, error) {
 // TextTemplate renders the given template with the given template context.
 func (g *generator) TextTemplate(s string, data interface{}, opts ...TemplateOption) (string, error) {
 	templateFuncs := template.FuncMap{
-		"formatDoc":        formatDoc,
-		"goCase":           goCase,
-		"goName":           goName,
-		"import":           g.Import,
-		"isHashable":       isHashable,
-		"setUsesMap":       setUsesMap,
-		"isPrimitiveType":  isPrimitiveType,
-		"isStructType":     isStructType,
-		"newNamespace":     g.Namespace.Child,
-		"newVar":           g.Namespace.Child().NewName,
-		"typeName":         curryGenerator(typeName, g),
-		"typeReference":    curryGenerator(typeReference, g),
-		"typeReferencePtr": curryGenerator(typeReferencePtr, g),
-		"fromWire":         curryGenerator(g.w.FromWire, g),
-		"fromWirePtr":      curryGenerator(g.w.FromWirePtr, g),
-		"toWire":           curryGenerator(g.w.ToWire, g),
-		"toWirePtr":        curryGenerator(g.w.ToWirePtr, g),
-		"typeCode":         curryGenerator(TypeCode, g),
-		"equals":           curryGenerator(g.e.Equals, g),
-		"equalsPtr":        curryGenerator(g.e.EqualsPtr, g),
-		"zapEncodeBegin":   curryGenerator(g.z.zapEncodeBegin, g),
-		"zapEncodeEnd":     g.z.zapEncodeEnd,
-		"zapEncoder":       curryGenerator(g.z.zapEncoder, g),
-		"zapMarshaler":     curryGenerator(g.z.zapMarshaler, g),
-		"zapMarshalerPtr":  curryGenerator(g.z.zapMarshalerPtr, g),
+		"formatDoc":          formatDoc,
+		"deprecatedDoc":      deprecatedDoc,
+		"goCase":             g.casing.name,
+		"goName":             g.casing.goName,
+		"fieldName":          curryGenerator(fieldName, g),
+		"import":             g.Import,
+		"isHashable":         isHashable,
+		"setUsesMap":         g.setUsesMap,
+		"isPrimitiveType":    isPrimitiveType,
+		"isStructType":       isStructType,
+		"newNamespace":       g.Namespace.Child,
+		"newParamNamespace":  newParamNamespace,
+		"newVar":             g.Namespace.Child().NewName,
+		"typeName":           curryGenerator(typeName, g),
+		"typeReference":      curryGenerator(typeReference, g),
+		"typeReferencePtr":   curryGenerator(typeReferencePtr, g),
+		"fieldTypeReference": curryGenerator(fieldTypeReference, g),
+		"mappedField":        curryGenerator(mappedField, g),
+		"fromWire":           curryGenerator(g.w.FromWire, g),
+		"fromWirePtr":        curryGenerator(g.w.FromWirePtr, g),
+		"toWire":             curryGenerator(g.w.ToWire, g),
+		"toWirePtr":          curryGenerator(g.w.ToWirePtr, g),
+		"decode":             curryGenerator(g.w.Decode, g),
+		"decodePtr":          curryGenerator(g.w.DecodePtr, g),
+		"encode":             curryGenerator(g.w.Encode, g),
+		"encodePtr":          curryGenerator(g.w.EncodePtr, g),
+		"typeCode":           curryGenerator(TypeCode, g),
+		"equals":             curryGenerator(g.e.Equals, g),
+		"equalsPtr":          curryGenerator(g.e.EqualsPtr, g),
+		"clone":              curryGenerator(g.c.Clone, g),
+		"clonePtr":           curryGenerator(g.c.ClonePtr, g),
+		"zapEncodeBegin":     curryGenerator(g.z.zapEncodeBegin, g),
+		"zapEncodeEnd":       g.z.zapEncodeEnd,
+		"zapEncoder":         curryGenerator(g.z.zapEncoder, g),
+		"zapMarshaler":       curryGenerator(g.z.zapMarshaler, g),
+		"zapMarshalerPtr":    curryGenerator(g.z.zapMarshalerPtr, g),
 	}
 
 	tmpl := template.New("thriftrw").Delims("<", ">").Funcs(templateFuncs)
@@ -305,6 +535,10 @@ func (g *generator) recordGenDeclNames(d *ast.GenDecl) (conflict bool, err error
 	case token.VAR:
 		for _, spec := range d.Specs {
 			for _, name := range spec.(*ast.ValueSpec).Names {
+				// Blank variables may be declared any number of times.
+				if name.Name == "_" {
+					continue
+				}
 				if err := g.Reserve(name.Name); err != nil {
 					return true, fmt.Errorf(
 						"could not declare var %q: %v", name.Name, err,
@@ -342,12 +576,16 @@ func (g *generator) recordGenDeclNames(d *ast.GenDecl) (conflict bool, err error
 //
 // goCase(str): Accepts a string and returns it in CamelCase form and the
 // first character upper-cased. The string may be ALLCAPS, snake_case, or
-// already camelCase.
+// already camelCase. Initialisms and underscores are handled as per the
+// Casing option.
 //
 // goName(ItemSpec): Accepts any Thrift items offering a Name and Annotations.
 // It returns the annotated name if available (after some sanity check) or
 // returns the Thrift name trough goCase.
 //
+// fieldName(FieldSpec): Returns the name of the Go struct field holding the
+// value of the given field. This is its goName unless the field is lazy.
+//
 // import(str): Accepts a string and returns the name that should be used in
 // the template to refer to that imported module. This helps avoid naming
 // conflicts with imports.
@@ -375,6 +613,20 @@ func (g *generator) recordGenDeclNames(d *ast.GenDecl) (conflict bool, err error
 // contains the wire representation of the item "v" which is a reference to a
 // value of type TypeSpec.
 //
+// decode(TypeSpec, r): Returns an expression of type (T, error) where T is
+// the type represented by TypeSpec, read directly from the stream.Reader r.
+//
+// decodePtr(TypeSpec, lhs, r): Assigns a value of the given type read from
+// the stream.Reader r to lhs, which is a pointer to that type. A variable err
+// of type error must be in scope.
+//
+// encode(TypeSpec, v, w): Returns an expression of type error which writes
+// the item "v" of the type represented by TypeSpec directly to the
+// stream.Writer w.
+//
+// encodePtr(TypeSpec, v, w): Same as encode but v is a reference to a
+// value of that type.
+//
 // typeCode(TypeSpec): Gets the wire.Type for the given TypeSpec, importing
 // the wire module if necessary.
 //
@@ -392,6 +644,15 @@ func (g *generator) recordGenDeclNames(d *ast.GenDecl) (conflict bool, err error
 //
 // 	<typeReferencePtr $someType>
 //
+// fieldTypeReference(FieldSpec): Returns a reference to the Go type used for
+// the given field. This takes into account whether the field is optional and
+// whether a plugin provided a custom type for it.
+//
+// 	<fieldTypeReference $field>
+//
+// mappedField(FieldSpec): Returns the custom Go type provided by a plugin for
+// the given field, or nil if the field uses the default type.
+//
 // equals(TypeSpec, lhs, rhs): Returns an expression of type bool that
 // compares lhs and rhs of given TypeSpec for equality.
 //
@@ -402,6 +663,16 @@ func (g *generator) recordGenDeclNames(d *ast.GenDecl) (conflict bool, err error
 //
 //  <equalsPtr $someType $lhs $rhs>
 //
+// clone(TypeSpec, v): Returns an expression of the given type that evaluates
+// to a deep copy of v.
+//
+//  <clone $someType $v>
+//
+// clonePtr(TypeSpec, v): Returns an expression that evaluates to a deep copy
+// of v, a reference to a value of the given type.
+//
+//  <clonePtr $someType $v>
+//
 // formatDoc(string): Formats a docblock. Generates a trailing newline so use
 // this NEXT to the thing being documented.
 //
@@ -469,6 +740,12 @@ func (g *generator) Write(w io.Writer, _ *token.FileSet) error {
 		return err
 	}
 
+	if g.generics {
+		if _, err := io.WriteString(w, genericsBuildConstraint); err != nil {
+			return err
+		}
+	}
+
 	if _, err := fmt.Fprintf(w, "package %s\n\n", g.PackageName); err != nil {
 		return err
 	}
@@ -478,6 +755,7 @@ func (g *generator) Write(w io.Writer, _ *token.FileSet) error {
 		Tabwidth: 8,
 	}
 
+	g.removeUnused(g.decls)
 	if importDecl := g.importDecl(); importDecl != nil {
 		if err := cfg.Fprint(w, g.fset, importDecl); err != nil {
 			return err
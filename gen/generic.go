@@ -0,0 +1,287 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+const genericPackage = "go.uber.org/thriftrw/generic"
+
+// genericGenerator generates code which converts lists, sets, and maps to
+// and from their Thrift-level representation with the helpers in the
+// generic package. It's used instead of the listGenerator, setGenerator,
+// and mapGenerator if generics are enabled.
+//
+// Rather than declaring a ValueList, a reader, and a decoder for each
+// container type, only a function returning a generic.Codec is declared for
+// each type of item held in containers.
+type genericGenerator struct{}
+
+func genericCodecFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_GenericCodec", g.MangleType(spec))
+}
+
+// Codec generates a function which returns a generic.Codec for the given
+// type.
+//
+// 	func $name() generic.Codec[$type] {
+// 		...
+// 	}
+//
+// And returns an expression calling it. A function is used instead of a
+// variable so that codecs of recursive types don't form initialization
+// cycles.
+func (genericGenerator) Codec(g Generator, spec compile.TypeSpec) (string, error) {
+	name := genericCodecFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$generic := import "go.uber.org/thriftrw/generic">
+			<$wire := import "go.uber.org/thriftrw/wire">
+			<$type := typeReference .Spec>
+
+			<$v := newVar "v">
+			<$w := newVar "w">
+			<$sr := newVar "sr">
+			func <.Name>() <$generic>.Codec[<$type>] {
+				return <$generic>.Codec[<$type>]{
+					Type: <typeCode .Spec>,
+					ToWire: func(<$v> <$type>) (<$wire>.Value, error) {
+						return <toWire .Spec $v>
+					},
+					FromWire: func(<$w> <$wire>.Value) (<$type>, error) {
+						return <fromWire .Spec $w>
+					},
+					<- if not (checkMinimal)>
+					<- $stream := import "go.uber.org/thriftrw/protocol/stream">
+					Decode: func(<$sr> <$stream>.Reader) (<$type>, error) {
+						return <decode .Spec $sr>
+					},
+					<- end>
+					<- if not (isPrimitiveType .Spec)>
+					IsNil: func(<$v> <$type>) bool {
+						return <$v> == nil
+					},
+					<- end>
+				}
+			}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+		TemplateFunc("checkMinimal", checkMinimal),
+	)
+
+	return name + "()", wrapGenerateError(spec.ThriftName(), err)
+}
+
+// ToWire generates an expression of type (wire.Value, error) which converts
+// the container $v of the given type.
+func (c genericGenerator) ToWire(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	wire := g.Import("go.uber.org/thriftrw/wire")
+	generic := g.Import(genericPackage)
+
+	switch s := spec.(type) {
+	case *compile.ListSpec:
+		codec, err := c.Codec(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.NewValueList(%s.ListValueList(%s, %s)), error(nil)", wire, generic, codec, v), nil
+
+	case *compile.SetSpec:
+		codec, err := c.Codec(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		if setUsesMap(s, checkSliceSets(g)) {
+			return fmt.Sprintf("%s.NewValueSet(%s.SetValueList(%s, %s)), error(nil)", wire, generic, codec, v), nil
+		}
+
+		compare, err := c.compare(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.NewValueSet(%s.SliceSetValueList(%s, %s, %s)), error(nil)", wire, generic, codec, v, compare), nil
+
+	case *compile.MapSpec:
+		kcodec, vcodec, err := c.mapCodecs(g, s)
+		if err != nil {
+			return "", err
+		}
+		sorted, err := sortedMap(g, s)
+		if err != nil {
+			return "", err
+		}
+		if sorted {
+			compare, err := c.compareFunc(g, s.KeySpec)
+			if err != nil {
+				return "", err
+			}
+			if isHashable(s.KeySpec) {
+				return fmt.Sprintf("%s.NewValueMap(%s.SortedMapItemList(%s, %s, %s, %s)), error(nil)", wire, generic, kcodec, vcodec, v, compare), nil
+			}
+			return fmt.Sprintf("%s.NewValueMap(%s.SortedSliceMapItemList(%s, %s, %s, %s)), error(nil)", wire, generic, kcodec, vcodec, v, compare), nil
+		}
+		if isHashable(s.KeySpec) {
+			return fmt.Sprintf("%s.NewValueMap(%s.MapItemList(%s, %s, %s)), error(nil)", wire, generic, kcodec, vcodec, v), nil
+		}
+		return fmt.Sprintf("%s.NewValueMap(%s.SliceMapItemList(%s, %s, %s)), error(nil)", wire, generic, kcodec, vcodec, v), nil
+
+	default:
+		panic(fmt.Sprintf("not a container type: (%T) %v", spec, spec))
+	}
+}
+
+// FromWire generates an expression of type ($spec, error) which reads the
+// container of the given type from the wire.Value $value.
+func (c genericGenerator) FromWire(g Generator, spec compile.TypeSpec, value string) (string, error) {
+	generic := g.Import(genericPackage)
+
+	switch s := spec.(type) {
+	case *compile.ListSpec:
+		codec, err := c.Codec(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.ReadList(%s, %s.GetList())", generic, codec, value), nil
+
+	case *compile.SetSpec:
+		codec, err := c.Codec(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		if setUsesMap(s, checkSliceSets(g)) {
+			return fmt.Sprintf("%s.ReadSet(%s, %s.GetSet())", generic, codec, value), nil
+		}
+
+		unique, err := c.unique(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.ReadSliceSet(%s, %s.GetSet(), %s)", generic, codec, value, unique), nil
+
+	case *compile.MapSpec:
+		kcodec, vcodec, err := c.mapCodecs(g, s)
+		if err != nil {
+			return "", err
+		}
+		if isHashable(s.KeySpec) {
+			return fmt.Sprintf("%s.ReadMap(%s, %s, %s.GetMap())", generic, kcodec, vcodec, value), nil
+		}
+		return fmt.Sprintf("%s.ReadSliceMap(%s, %s, %s.GetMap())", generic, kcodec, vcodec, value), nil
+
+	default:
+		panic(fmt.Sprintf("not a container type: (%T) %v", spec, spec))
+	}
+}
+
+// Decode generates an expression of type ($spec, error) which reads the
+// container of the given type directly from the stream.Reader $reader.
+func (c genericGenerator) Decode(g Generator, spec compile.TypeSpec, reader string) (string, error) {
+	generic := g.Import(genericPackage)
+
+	switch s := spec.(type) {
+	case *compile.ListSpec:
+		codec, err := c.Codec(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.DecodeList(%s, %s)", generic, codec, reader), nil
+
+	case *compile.SetSpec:
+		codec, err := c.Codec(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		if setUsesMap(s, checkSliceSets(g)) {
+			return fmt.Sprintf("%s.DecodeSet(%s, %s)", generic, codec, reader), nil
+		}
+
+		unique, err := c.unique(g, s.ValueSpec)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.DecodeSliceSet(%s, %s, %s)", generic, codec, reader, unique), nil
+
+	case *compile.MapSpec:
+		kcodec, vcodec, err := c.mapCodecs(g, s)
+		if err != nil {
+			return "", err
+		}
+		if isHashable(s.KeySpec) {
+			return fmt.Sprintf("%s.DecodeMap(%s, %s, %s)", generic, kcodec, vcodec, reader), nil
+		}
+		return fmt.Sprintf("%s.DecodeSliceMap(%s, %s, %s)", generic, kcodec, vcodec, reader), nil
+
+	default:
+		panic(fmt.Sprintf("not a container type: (%T) %v", spec, spec))
+	}
+}
+
+func (c genericGenerator) mapCodecs(g Generator, spec *compile.MapSpec) (kcodec, vcodec string, err error) {
+	kcodec, err = c.Codec(g, spec.KeySpec)
+	if err != nil {
+		return "", "", err
+	}
+	vcodec, err = c.Codec(g, spec.ValueSpec)
+	return kcodec, vcodec, err
+}
+
+// compare generates the function used to order the items of sets of the
+// given type represented as slices when they're sent over the wire.
+//
+// Items of sets of hashable structs are sent in the order defined by
+// Compare so that equal sets are encoded identically. Items of other sets
+// are sent in the order in which they appear.
+func (c genericGenerator) compare(g Generator, spec compile.TypeSpec) (string, error) {
+	if hashableStructSpec(spec) == nil {
+		return "nil", nil
+	}
+	return c.compareFunc(g, spec)
+}
+
+// compareFunc generates a function which compares values of the given
+// type with compareValues.
+func (genericGenerator) compareFunc(g Generator, spec compile.TypeSpec) (string, error) {
+	return g.TextTemplate(
+		`<$lhs := newVar "lhs"><$rhs := newVar "rhs">`+
+			`func(<$lhs>, <$rhs> <typeReference .Spec>) int { return <compareValues .Spec $lhs $rhs> }`,
+		struct{ Spec compile.TypeSpec }{Spec: spec},
+		TemplateFunc("compareValues", compareValues),
+	)
+}
+
+// unique generates the function used to drop duplicates from sets of the
+// given type represented as slices when they're read.
+//
+// Only duplicates of hashable values are dropped.
+func (genericGenerator) unique(g Generator, spec compile.TypeSpec) (string, error) {
+	if !isHashable(spec) {
+		return "nil", nil
+	}
+
+	return g.TextTemplate(
+		`<import "go.uber.org/thriftrw/generic">.Unique[<typeReference .>]`, spec)
+}
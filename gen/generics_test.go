@@ -0,0 +1,190 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package gen
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tg "go.uber.org/thriftrw/gen/internal/tests/generics"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// supportsGenerics is true if the tests run with a version of Go which can
+// build code generated with generics.
+const supportsGenerics = true
+
+func TestGenericContainersToWire(t *testing.T) {
+	give := tg.Containers{
+		Ints:          []int32{1, 2},
+		Int64SliceSet: []int64{3},
+		PointSet:      []*tg.Point{{X: 1, Y: 2}, {X: 2, Y: 1}},
+		Counts:        map[string]int32{"foo": 4},
+		Labels: []struct {
+			Key   *tg.Point
+			Value string
+		}{{Key: &tg.Point{X: 1, Y: 1}, Value: "origin"}},
+	}
+
+	point := func(x, y int32) wire.Value {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueI32(x)},
+			{ID: 2, Value: wire.NewValueI32(y)},
+		}})
+	}
+
+	want := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+			wire.NewValueI32(1),
+			wire.NewValueI32(2),
+		}))},
+		{ID: 8, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI64, []wire.Value{
+			wire.NewValueI64(3),
+		}))},
+		// Items of sets of hashable structs are sent in the order defined
+		// by Compare.
+		{ID: 9, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+			point(1, 2),
+			point(2, 1),
+		}))},
+		{ID: 11, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI32, []wire.MapItem{
+			{Key: wire.NewValueString("foo"), Value: wire.NewValueI32(4)},
+		}))},
+		{ID: 13, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TStruct, wire.TBinary, []wire.MapItem{
+			{Key: point(1, 1), Value: wire.NewValueString("origin")},
+		}))},
+	}})
+	assertRoundTrip(t, &give, want, "Containers")
+
+	give.PointSet = []*tg.Point{give.PointSet[1], give.PointSet[0]}
+	got, err := give.ToWire()
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(want, got), "order of set items must not matter")
+}
+
+func TestGenericContainersDecode(t *testing.T) {
+	names := tg.Names{"foo", "bar"}
+	give := tg.Containers{
+		Ints:          []int32{1, 2, 3},
+		Blobs:         [][]byte{{1}, {2, 3}},
+		Nested:        [][]string{{"a"}, {}, {"b", "c"}},
+		Points:        []*tg.Point{{X: 1, Y: 2}},
+		Names:         names,
+		StringSet:     map[string]struct{}{"foo": {}, "bar": {}},
+		ColorSet:      map[tg.Color]struct{}{tg.ColorRed: {}},
+		Int64SliceSet: []int64{1, 2},
+		PointSet:      []*tg.Point{{X: 1, Y: 2}, {X: 2, Y: 1}},
+		BlobSet:       [][]byte{{1}},
+		Counts:        map[string]int32{"foo": 1},
+		NamesByColor:  map[tg.Color][]tg.Name{tg.ColorBlue: {"sky"}},
+		BlobIndex: []struct {
+			Key   []byte
+			Value map[int32]struct{}
+		}{{Key: []byte("foo"), Value: map[int32]struct{}{1: {}}}},
+		Tree: &tg.Node{
+			Value:    "root",
+			Children: []*tg.Node{{Value: "leaf"}},
+		},
+	}
+
+	w, err := give.ToWire()
+	require.NoError(t, err)
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(w, &buff))
+
+	var got tg.Containers
+	require.NoError(t, got.Decode(protocol.BinaryStreamer.Reader(bytes.NewReader(buff.Bytes()))))
+	assert.Equal(t, give, got)
+}
+
+func TestGenericSliceSetDropsDuplicates(t *testing.T) {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 8, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI64, []wire.Value{
+			wire.NewValueI64(2),
+			wire.NewValueI64(1),
+			wire.NewValueI64(2),
+		}))},
+	}})
+
+	var got tg.Containers
+	require.NoError(t, got.FromWire(v))
+	assert.Equal(t, []int64{2, 1}, got.Int64SliceSet)
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(v, &buff))
+
+	var decoded tg.Containers
+	require.NoError(t, decoded.Decode(protocol.BinaryStreamer.Reader(bytes.NewReader(buff.Bytes()))))
+	assert.Equal(t, []int64{2, 1}, decoded.Int64SliceSet)
+}
+
+func TestGenericContainersNilItems(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    tg.Containers
+		wantErr string
+	}{
+		{
+			desc:    "list",
+			give:    tg.Containers{Points: []*tg.Point{{}, nil}},
+			wantErr: "invalid [1]: value is nil",
+		},
+		{
+			desc:    "set",
+			give:    tg.Containers{BlobSet: [][]byte{nil}},
+			wantErr: "invalid set item: value is nil",
+		},
+		{
+			desc: "map key",
+			give: tg.Containers{Labels: []struct {
+				Key   *tg.Point
+				Value string
+			}{{Key: nil, Value: "foo"}}},
+			wantErr: "invalid map key: value is nil",
+		},
+		{
+			desc:    "map value",
+			give:    tg.Containers{NamesByColor: map[tg.Color][]tg.Name{tg.ColorRed: nil}},
+			wantErr: "invalid [RED]: value is nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w, err := tt.give.ToWire()
+			if err == nil {
+				// Containers are converted lazily.
+				var buff bytes.Buffer
+				err = protocol.Binary.Encode(w, &buff)
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
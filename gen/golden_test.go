@@ -42,6 +42,109 @@ var noZapFiles = map[string]struct{}{
 	"nozap": {},
 }
 
+// Set of files that are passed a --service-tests flag in code generation
+var serviceStubFiles = map[string]struct{}{
+	"stubs":        {},
+	"stubs_health": {},
+}
+
+// Set of files that are passed a --http-handlers flag in code generation
+var httpHandlerFiles = map[string]struct{}{
+	"http_handlers": {},
+}
+
+// Set of files that are passed a --prometheus-metrics flag in code
+// generation
+var prometheusMetricsFiles = map[string]struct{}{
+	"instrumented": {},
+}
+
+// Set of files that are passed a --benchmarks flag in code generation
+var benchmarkFiles = map[string]struct{}{
+	"containers": {},
+}
+
+// Set of files that are passed a --fuzz-tests flag in code generation
+var fuzzTestFiles = map[string]struct{}{
+	"structs": {},
+}
+
+// Set of files that are passed a --compact-codegen flag in code generation
+var compactFiles = map[string]struct{}{
+	"compact": {},
+}
+
+// Set of files that are passed a --set-type=slice flag in code generation
+var sliceSetFiles = map[string]struct{}{
+	"slice_sets": {},
+}
+
+// Set of files that are passed --sorted-maps and --stream-encode flags in
+// code generation
+var sortedMapFiles = map[string]struct{}{
+	"sorted_maps": {},
+}
+
+// Set of files that are passed a --descriptors flag in code generation
+var descriptorFiles = map[string]struct{}{
+	"descriptors": {},
+}
+
+// Set of files that are passed a --size-methods flag in code generation
+var sizeMethodFiles = map[string]struct{}{
+	"sizes": {},
+}
+
+// Set of files that are passed a --stream-encode flag in code generation
+var streamEncodeFiles = map[string]struct{}{
+	"stream_encode": {},
+}
+
+// Set of files that are passed a --builder-threshold=4 flag in code
+// generation
+var builderFiles = map[string]struct{}{
+	"builders": {},
+}
+
+// Set of files that are passed a --lazy-constants flag in code generation
+var lazyConstantFiles = map[string]struct{}{
+	"lazyconsts": {},
+}
+
+// Set of files that are passed a --casing=apache flag in code generation
+var apacheCasingFiles = map[string]struct{}{
+	"apache_casing": {},
+}
+
+// Set of files that are passed a --min-go-version=1.18 flag in code
+// generation. These are skipped if the tests run with an older version of
+// Go.
+var genericsFiles = map[string]struct{}{
+	"generics": {},
+}
+
+// Set of files that are passed a --output-layout=per-type flag in code
+// generation
+var perTypeLayoutFiles = map[string]struct{}{
+	"layout": {},
+}
+
+// Set of files that are passed a --output-layout=per-kind flag in code
+// generation
+var perKindLayoutFiles = map[string]struct{}{
+	"layout_kind": {},
+}
+
+// Set of files that are passed a --sql flag in code generation
+var sqlFiles = map[string]struct{}{
+	"sqlvalues": {},
+}
+
+// Set of files that are passed a --sql-enum-names flag in code generation
+var sqlEnumNameFiles = map[string]struct{}{
+	"sqlnames": {},
+}
+
 func TestCodeIsUpToDate(t *testing.T) {
 	// This test just verifies that the generated code in internal/tests/ is up to
 	// date. If this test failed, run 'make' in the internal/tests/ directory and
@@ -62,6 +165,11 @@ func TestCodeIsUpToDate(t *testing.T) {
 		currentPackageDir := filepath.Join("internal/tests", pkgRelPath)
 		newPackageDir := filepath.Join(outputDir, pkgRelPath)
 
+		_, generics := genericsFiles[pkgRelPath]
+		if generics && !supportsGenerics {
+			continue
+		}
+
 		currentHash, err := dirhash(currentPackageDir)
 		require.NoError(t, err, "could not hash %q", currentPackageDir)
 
@@ -69,12 +177,60 @@ func TestCodeIsUpToDate(t *testing.T) {
 		require.NoError(t, err, "failed to compile %q", thriftFile)
 
 		_, nozap := noZapFiles[pkgRelPath]
+		_, stubs := serviceStubFiles[pkgRelPath]
+		_, httpHandlers := httpHandlerFiles[pkgRelPath]
+		_, prometheusMetrics := prometheusMetricsFiles[pkgRelPath]
+		_, benchmarks := benchmarkFiles[pkgRelPath]
+		_, fuzzTests := fuzzTestFiles[pkgRelPath]
+		_, sql := sqlFiles[pkgRelPath]
+		_, sqlEnumNames := sqlEnumNameFiles[pkgRelPath]
+		_, compact := compactFiles[pkgRelPath]
+		_, sliceSets := sliceSetFiles[pkgRelPath]
+		_, sortedMaps := sortedMapFiles[pkgRelPath]
+		_, descriptors := descriptorFiles[pkgRelPath]
+		_, sizeMethods := sizeMethodFiles[pkgRelPath]
+		_, streamEncode := streamEncodeFiles[pkgRelPath]
+		_, lazyConstants := lazyConstantFiles[pkgRelPath]
+		var casing Casing
+		if _, ok := apacheCasingFiles[pkgRelPath]; ok {
+			casing = ApacheCasing
+		}
+		var builderThreshold int
+		if _, ok := builderFiles[pkgRelPath]; ok {
+			builderThreshold = 4
+		}
+		layout := SingleFileLayout
+		if _, ok := perTypeLayoutFiles[pkgRelPath]; ok {
+			layout = PerTypeLayout
+		}
+		if _, ok := perKindLayoutFiles[pkgRelPath]; ok {
+			layout = PerKindLayout
+		}
 		err = Generate(module, &Options{
-			OutputDir:     outputDir,
-			PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
-			ThriftRoot:    thriftRoot,
-			NoRecurse:     true,
-			NoZap:         nozap,
+			OutputDir:         outputDir,
+			PackagePrefix:     "go.uber.org/thriftrw/gen/internal/tests",
+			ThriftRoot:        thriftRoot,
+			NoRecurse:         true,
+			NoZap:             nozap,
+			ServiceStubs:      stubs || httpHandlers || prometheusMetrics,
+			ServiceTests:      stubs,
+			HTTPHandlers:      httpHandlers,
+			PrometheusMetrics: prometheusMetrics,
+			Benchmarks:        benchmarks,
+			FuzzTests:         fuzzTests,
+			SQL:               sql || sqlEnumNames,
+			SQLEnumNames:      sqlEnumNames,
+			CompactCodegen:    compact,
+			SliceSets:         sliceSets,
+			SortedMaps:        sortedMaps,
+			Descriptors:       descriptors,
+			SizeMethods:       sizeMethods,
+			StreamEncode:      streamEncode || sortedMaps,
+			BuilderThreshold:  builderThreshold,
+			LazyConstants:     lazyConstants,
+			Casing:            casing,
+			Generics:          generics,
+			OutputLayout:      layout,
 		})
 		require.NoError(t, err, "failed to generate code for %q", thriftFile)
 
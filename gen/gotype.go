@@ -20,6 +20,8 @@
 
 package gen
 
+import "go.uber.org/thriftrw/compile"
+
 const (
 	// goTypeKey is a Thrift annotation that allows overriding the type of
 	// a typedef target type or a struct field type. By default, thrift set type
@@ -29,7 +31,44 @@ const (
 	//
 	//     (go.type = "slice")
 	//
-	// Currently, only thrift set to go slice type overriding is supported.
-	goTypeKey = "go.type"
-	sliceType = "slice"
+	// Sets annotated with (go.type = "map") are generated as maps even if
+	// slices are the default because of the SliceSets option.
+	//
+	// Binary types may be annotated to use a different representation than
+	// []byte.
+	//
+	//     (go.type = "string")
+	//
+	// Binaries annotated with (go.type = "string") are represented as Go
+	// strings. They behave exactly like Thrift strings in the generated code
+	// but retain their binary type in the IDL.
+	//
+	//     (go.type = "reader")
+	//
+	// Binaries annotated with (go.type = "reader") are represented as
+	// io.Reader. Decoded values are backed directly by the decoded bytes
+	// without copying them. This representation is supported only for
+	// struct, union, and exception field types.
+	//
+	// Currently, only overriding the representation of thrift sets and
+	// binaries is supported.
+	goTypeKey  = "go.type"
+	sliceType  = "slice"
+	mapType    = "map"
+	stringType = "string"
+	readerType = "reader"
 )
+
+// isStringBinary returns true if the given type is a binary or a typedef of
+// a binary that is represented as a Go string.
+func isStringBinary(spec compile.TypeSpec) bool {
+	s, ok := compile.RootTypeSpec(spec).(*compile.BinarySpec)
+	return ok && s.Annotations[goTypeKey] == stringType
+}
+
+// isReaderBinary returns true if the given type is a binary that is
+// represented as an io.Reader.
+func isReaderBinary(spec compile.TypeSpec) bool {
+	s, ok := compile.RootTypeSpec(spec).(*compile.BinarySpec)
+	return ok && s.Annotations[goTypeKey] == readerType
+}
@@ -0,0 +1,369 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// HashableLabel generates Hash and Compare methods for structs so that
+// they may be used as keys in user data structures and kept in sorted
+// containers. i.e.
+//
+// 	struct Point {
+// 		1: required i32 x
+// 		2: required i32 y
+// 		3: optional string label
+// 	} (go.hashable)
+//
+// Hash returns a 64-bit hash of the struct which is the same for all values
+// which are Equal, and which doesn't change between processes. Compare
+// orders values of the struct totally: fields are compared in the order of
+// their IDs, and unset optional fields are ordered before set ones.
+//
+// All fields of such structs must be primitives, binary, enums, other
+// structs with this annotation, or typedefs of these. Sets of these structs
+// are sent over the wire in the order defined by Compare.
+const HashableLabel = "go.hashable"
+
+const hashingPackage = "go.uber.org/thriftrw/hashing"
+
+// reservedHashableIdentifiers are additionally reserved for fields of
+// structs annotated with HashableLabel.
+var reservedHashableIdentifiers = map[string]struct{}{
+	"Hash":    {},
+	"Compare": {},
+}
+
+// isHashableStruct returns true if the given struct is annotated with
+// HashableLabel.
+func isHashableStruct(spec *compile.StructSpec) (bool, error) {
+	switch v, ok := spec.Annotations[HashableLabel]; {
+	case !ok || v == "false":
+		return false, nil
+	case v != "" && v != "true":
+		return false, fmt.Errorf(
+			"invalid %v on %q: expected \"true\" or \"false\", got %q",
+			HashableLabel, spec.Name, v)
+	}
+	return true, nil
+}
+
+// hashableStructSpec returns the struct which the given type refers to if
+// it's annotated with HashableLabel, or nil otherwise.
+func hashableStructSpec(spec compile.TypeSpec) *compile.StructSpec {
+	s, ok := compile.RootTypeSpec(spec).(*compile.StructSpec)
+	if !ok {
+		return nil
+	}
+	if hashable, err := isHashableStruct(s); err != nil || !hashable {
+		return nil
+	}
+	return s
+}
+
+// verifyHashable verifies that all fields of this group may be hashed and
+// compared.
+func (f fieldGroupGenerator) verifyHashable(g Generator) error {
+	for _, field := range f.Fields {
+		name, err := goName(g, field)
+		if err != nil {
+			return err
+		}
+		if _, reserved := reservedHashableIdentifiers[name]; reserved {
+			return fmt.Errorf("%q is a reserved ThriftRW identifier", name)
+		}
+
+		if m, err := mappedField(g, field); err != nil {
+			return err
+		} else if m != nil {
+			return fmt.Errorf(
+				"field %q of %q cannot be hashed: it uses a custom type", field.Name, f.Name)
+		}
+
+		if isReaderBinary(field.Type) {
+			return fmt.Errorf(
+				"field %q of %q cannot be hashed: binaries with (%v = %q) cannot be hashed",
+				field.Name, f.Name, goTypeKey, readerType)
+		}
+
+		switch root := compile.RootTypeSpec(field.Type).(type) {
+		case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+			*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec,
+			*compile.BinarySpec, *compile.EnumSpec:
+			// ok
+		case *compile.StructSpec:
+			if hashableStructSpec(root) == nil {
+				return fmt.Errorf(
+					"field %q of %q cannot be hashed: %v is not annotated with %v",
+					field.Name, f.Name, root.ThriftName(), HashableLabel)
+			}
+		default:
+			return fmt.Errorf(
+				"field %q of %q cannot be hashed: fields of type %v cannot be hashed",
+				field.Name, f.Name, root.ThriftName())
+		}
+	}
+	return nil
+}
+
+// fieldsByID returns the fields of this group in the order of their IDs.
+func (f fieldGroupGenerator) fieldsByID() []*compile.FieldSpec {
+	fields := make([]*compile.FieldSpec, len(f.Fields))
+	copy(fields, f.Fields)
+	sort.Slice(fields, func(i, j int) bool { return fields[i].ID < fields[j].ID })
+	return fields
+}
+
+// HashAndCompare generates the Hash and Compare methods of this group.
+func (f fieldGroupGenerator) HashAndCompare(g Generator) error {
+	if err := f.verifyHashable(g); err != nil {
+		return err
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$hashing := import "go.uber.org/thriftrw/hashing">
+		<$v := newVar "v">
+		<$rhs := newVar "rhs">
+		<$h := newVar "h">
+		<$c := newVar "c">
+
+		// Hash returns a 64-bit hash of this <.Name>. Values which are
+		// Equal have the same hash, and the hash of a value does not change
+		// between processes.
+		func (<$v> *<.Name>) Hash() uint64 {
+			if <$v> == nil {
+				return 0
+			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+			<- end>
+
+			<$h> := <$hashing>.New()
+			<range .FieldsByID ->
+				<hashField . $h $v>
+			<end>
+			return <$h>.Sum64()
+		}
+
+		// Compare returns 0 if this <.Name> is equal to the provided
+		// <.Name>, a negative number if it's ordered before it, and a
+		// positive number if it's ordered after it.
+		//
+		// Fields are compared in the order of their IDs. Unset optional
+		// fields are ordered before set ones, and nil is ordered before all
+		// other values.
+		func (<$v> *<.Name>) Compare(<$rhs> *<.Name>) int {
+			if <$v> == nil || <$rhs> == nil {
+				return <$hashing>.CompareBool(<$v> != nil, <$rhs> != nil)
+			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+				<$rhs> = <$rhs>.loaded()
+			<- end>
+			<range .FieldsByID ->
+				<compareField . $c $v $rhs>
+			<end>
+			return 0
+		}
+		`,
+		struct {
+			fieldGroupGenerator
+
+			FieldsByID []*compile.FieldSpec
+		}{fieldGroupGenerator: f, FieldsByID: f.fieldsByID()},
+		TemplateFunc("hashField", f.hashField),
+		TemplateFunc("compareField", f.compareField),
+	)
+}
+
+// fieldAccess returns an expression which evaluates to true if the given
+// field of the struct v is set, or an empty string for required fields,
+// along with an expression for the value of the field if it's set.
+func (f fieldGroupGenerator) fieldAccess(g Generator, field *compile.FieldSpec, v string) (isSet, value string, err error) {
+	name, err := goName(g, field)
+	if err != nil {
+		return "", "", err
+	}
+	storage, err := fieldName(g, field)
+	if err != nil {
+		return "", "", err
+	}
+
+	value = fmt.Sprintf("%s.%s", v, storage)
+	switch {
+	case field.Required:
+		// always set
+	case f.inBitmap(field):
+		isSet = fmt.Sprintf("%s.IsSet%s()", v, name)
+	case isPrimitiveType(field.Type):
+		isSet = fmt.Sprintf("%s != nil", value)
+		value = "*" + value
+	default:
+		isSet = fmt.Sprintf("%s != nil", value)
+	}
+	return isSet, value, nil
+}
+
+// hashField generates the statements which write the given field of the
+// struct v to the Hasher h if it's set.
+func (f fieldGroupGenerator) hashField(g Generator, field *compile.FieldSpec, h, v string) (string, error) {
+	isSet, value, err := f.fieldAccess(g, field, v)
+	if err != nil {
+		return "", err
+	}
+
+	write, err := hashValue(g, field.Type, h, value)
+	if err != nil {
+		return "", err
+	}
+
+	stmts := fmt.Sprintf("%s.Field(%d)\n%s", h, field.ID, write)
+	if isSet == "" {
+		return stmts, nil
+	}
+	return fmt.Sprintf("if %s {\n%s\n}", isSet, stmts), nil
+}
+
+// compareField generates the statements which return the result of
+// comparing the given field of the structs lhs and rhs if they differ.
+func (f fieldGroupGenerator) compareField(g Generator, field *compile.FieldSpec, c, lhs, rhs string) (string, error) {
+	lhsSet, lhsValue, err := f.fieldAccess(g, field, lhs)
+	if err != nil {
+		return "", err
+	}
+	rhsSet, rhsValue, err := f.fieldAccess(g, field, rhs)
+	if err != nil {
+		return "", err
+	}
+
+	cmp, err := compareValues(g, field.Type, lhsValue, rhsValue)
+	if err != nil {
+		return "", err
+	}
+
+	stmt := fmt.Sprintf("if %s := %s; %s != 0 {\nreturn %s\n}", c, cmp, c, c)
+	if lhsSet == "" || (hashableStructSpec(field.Type) != nil && !f.inBitmap(field)) {
+		// Compare orders nil structs before others by itself.
+		return stmt, nil
+	}
+
+	hashing := g.Import(hashingPackage)
+	return fmt.Sprintf(
+		"if %s := %s.CompareBool(%s, %s); %s != 0 {\nreturn %s\n}\nif %s {\n%s\n}",
+		c, hashing, lhsSet, rhsSet, c, c, lhsSet, stmt), nil
+}
+
+// hashValue generates a statement which writes the value v of the given
+// type to the Hasher h.
+func hashValue(g Generator, spec compile.TypeSpec, h, v string) (string, error) {
+	switch root := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return fmt.Sprintf("%s.Bool(%s)", h, convertValue(spec, "bool", v)), nil
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec, *compile.EnumSpec:
+		return fmt.Sprintf("%s.Int64(%s)", h, convertInt64(spec, v)), nil
+	case *compile.DoubleSpec:
+		return fmt.Sprintf("%s.Double(%s)", h, convertValue(spec, "float64", v)), nil
+	case *compile.StringSpec:
+		return fmt.Sprintf("%s.String(%s)", h, convertValue(spec, "string", v)), nil
+	case *compile.BinarySpec:
+		if isStringBinary(root) {
+			// Strings and byte slices with the same contents have the same
+			// hash.
+			return fmt.Sprintf("%s.String(%s)", h, convertValue(spec, "string", v)), nil
+		}
+		return fmt.Sprintf("%s.Binary(%s)", h, convertValue(spec, "[]byte", v)), nil
+	case *compile.StructSpec:
+		s, err := convertStruct(g, spec, v)
+		return fmt.Sprintf("%s.Uint64(%s.Hash())", h, s), err
+	default:
+		return "", fmt.Errorf("values of type %v cannot be hashed", root.ThriftName())
+	}
+}
+
+// compareValues generates an expression which compares the values lhs and
+// rhs of the given type.
+func compareValues(g Generator, spec compile.TypeSpec, lhs, rhs string) (string, error) {
+	hashing := g.Import(hashingPackage)
+	switch root := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return fmt.Sprintf("%s.CompareBool(%s, %s)", hashing,
+			convertValue(spec, "bool", lhs), convertValue(spec, "bool", rhs)), nil
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec, *compile.EnumSpec:
+		return fmt.Sprintf("%s.CompareInt64(%s, %s)", hashing,
+			convertInt64(spec, lhs), convertInt64(spec, rhs)), nil
+	case *compile.DoubleSpec:
+		return fmt.Sprintf("%s.CompareDouble(%s, %s)", hashing,
+			convertValue(spec, "float64", lhs), convertValue(spec, "float64", rhs)), nil
+	case *compile.StringSpec:
+		return fmt.Sprintf("%s.CompareString(%s, %s)", hashing,
+			convertValue(spec, "string", lhs), convertValue(spec, "string", rhs)), nil
+	case *compile.BinarySpec:
+		if isStringBinary(root) {
+			return fmt.Sprintf("%s.CompareString(%s, %s)", hashing,
+				convertValue(spec, "string", lhs), convertValue(spec, "string", rhs)), nil
+		}
+		return fmt.Sprintf("%s.CompareBinary(%s, %s)", hashing,
+			convertValue(spec, "[]byte", lhs), convertValue(spec, "[]byte", rhs)), nil
+	case *compile.StructSpec:
+		l, err := convertStruct(g, spec, lhs)
+		if err != nil {
+			return "", err
+		}
+		r, err := convertStruct(g, spec, rhs)
+		return fmt.Sprintf("%s.Compare(%s)", l, r), err
+	default:
+		return "", fmt.Errorf("values of type %v cannot be compared", root.ThriftName())
+	}
+}
+
+// convertValue converts v to the given Go type if the given Thrift type is
+// a typedef.
+func convertValue(spec compile.TypeSpec, goType, v string) string {
+	if _, isTypedef := spec.(*compile.TypedefSpec); isTypedef {
+		return fmt.Sprintf("%s(%s)", goType, v)
+	}
+	return v
+}
+
+// convertInt64 converts v of the given integer or enum type to int64.
+func convertInt64(spec compile.TypeSpec, v string) string {
+	if _, isI64 := spec.(*compile.I64Spec); isI64 {
+		return v
+	}
+	return fmt.Sprintf("int64(%s)", v)
+}
+
+// convertStruct converts v to a pointer to the struct the given type
+// refers to if it's a typedef, so that its Hash and Compare methods may be
+// called.
+func convertStruct(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if _, isTypedef := spec.(*compile.TypedefSpec); !isTypedef {
+		return v, nil
+	}
+
+	ref, err := typeReference(g, compile.RootTypeSpec(spec))
+	return fmt.Sprintf("(%s)(%s)", ref, v), err
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	th "go.uber.org/thriftrw/gen/internal/tests/hashable"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestHashableEqualValues(t *testing.T) {
+	newLabel := func() *th.Label {
+		return &th.Label{
+			Priority: ptr.Int16(3),
+			Name:     "foo",
+			Weight:   ptr.Int64(42),
+			Score:    ptr.Float64(0.5),
+			Data:     []byte("bar"),
+			Color:    th.ColorGreen.Ptr(),
+			Hidden:   ptr.Bool(true),
+			Point:    &th.Point{X: 1, Y: 2},
+			Location: &th.Location{X: 3, Y: 4},
+			Origin:   &th.Point{},
+		}
+	}
+
+	x, y := newLabel(), newLabel()
+	require.True(t, x.Equals(y))
+	assert.Equal(t, x.Hash(), y.Hash(), "equal values must have the same hash")
+	assert.Equal(t, 0, x.Compare(y))
+
+	y.Location.Y = 5
+	assert.NotEqual(t, x.Hash(), y.Hash())
+	assert.True(t, x.Compare(y) < 0)
+	assert.True(t, y.Compare(x) > 0)
+
+	var nilLabel *th.Label
+	assert.Equal(t, uint64(0), nilLabel.Hash())
+	assert.Equal(t, 0, nilLabel.Compare(nil))
+	assert.True(t, nilLabel.Compare(x) < 0, "nil must be ordered first")
+	assert.True(t, x.Compare(nil) > 0, "nil must be ordered first")
+}
+
+func TestHashableHashIsStable(t *testing.T) {
+	// Hashes must not change between releases. If this test fails, the
+	// hashing scheme was changed in an incompatible way.
+	assert.Equal(t, uint64(0xcbf29ce484222325), (&th.Counters{}).Hash())
+
+	a := &th.Point{X: 1, Y: 2}
+	b := &th.Point{X: 2, Y: 1}
+	assert.NotEqual(t, a.Hash(), b.Hash(), "hash must depend on field order")
+	assert.Equal(t, a.Hash(), (&th.Point{X: 1, Y: 2}).Hash())
+}
+
+func TestHashableCompare(t *testing.T) {
+	origin := &th.Point{}
+	tests := []struct {
+		desc string
+		lhs  *th.Label
+		rhs  *th.Label
+	}{
+		{
+			desc: "unset before set",
+			lhs:  &th.Label{Name: "a", Origin: origin},
+			rhs:  &th.Label{Name: "a", Weight: ptr.Int64(-10), Origin: origin},
+		},
+		{
+			desc: "fields compared in order of IDs",
+			lhs:  &th.Label{Name: "a", Priority: ptr.Int16(9), Origin: origin},
+			rhs:  &th.Label{Name: "b", Priority: ptr.Int16(1), Origin: origin},
+		},
+		{
+			desc: "nil struct before others",
+			lhs:  &th.Label{Name: "a", Origin: origin},
+			rhs:  &th.Label{Name: "a", Point: &th.Point{}, Origin: origin},
+		},
+		{
+			desc: "typedef of struct",
+			lhs:  &th.Label{Name: "a", Location: &th.Location{X: 1}, Origin: origin},
+			rhs:  &th.Label{Name: "a", Location: &th.Location{X: 2}, Origin: origin},
+		},
+		{
+			desc: "binary",
+			lhs:  &th.Label{Name: "a", Data: []byte{1, 2}, Origin: origin},
+			rhs:  &th.Label{Name: "a", Data: []byte{1, 3}, Origin: origin},
+		},
+		{
+			desc: "NaN before numbers",
+			lhs:  &th.Label{Name: "a", Score: ptr.Float64(math.NaN()), Origin: origin},
+			rhs:  &th.Label{Name: "a", Score: ptr.Float64(math.Inf(-1)), Origin: origin},
+		},
+		{
+			desc: "false before true",
+			lhs:  &th.Label{Name: "a", Hidden: ptr.Bool(false), Origin: origin},
+			rhs:  &th.Label{Name: "a", Hidden: ptr.Bool(true), Origin: origin},
+		},
+		{
+			desc: "enum",
+			lhs:  &th.Label{Name: "a", Color: th.ColorRed.Ptr(), Origin: origin},
+			rhs:  &th.Label{Name: "a", Color: th.ColorBlue.Ptr(), Origin: origin},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, tt.lhs.Compare(tt.rhs) < 0, "expected lhs < rhs")
+			assert.True(t, tt.rhs.Compare(tt.lhs) > 0, "expected rhs > lhs")
+			assert.Equal(t, 0, tt.lhs.Compare(tt.lhs))
+			assert.NotEqual(t, tt.lhs.Hash(), tt.rhs.Hash())
+		})
+	}
+}
+
+func TestHashablePresenceBitmap(t *testing.T) {
+	var x, y th.Counters
+	assert.Equal(t, x.Hash(), y.Hash())
+
+	y.SetHits(0)
+	assert.NotEqual(t, x.Hash(), y.Hash(), "set zero value must differ from unset field")
+	assert.True(t, x.Compare(&y) < 0)
+
+	x.SetHits(0)
+	assert.Equal(t, x.Hash(), y.Hash())
+	assert.Equal(t, 0, x.Compare(&y))
+
+	// Assigning the field directly doesn't mark it as set.
+	x.Owner = "foo"
+	assert.Equal(t, x.Hash(), y.Hash())
+	assert.Equal(t, 0, x.Compare(&y))
+}
+
+func TestHashableUnion(t *testing.T) {
+	point := &th.Shape{Point: &th.Point{X: 1}}
+	name := &th.Shape{Name: ptr.String("circle")}
+
+	assert.NotEqual(t, point.Hash(), name.Hash())
+	assert.True(t, name.Compare(point) < 0, "unset point must be ordered first")
+	assert.Equal(t, 0, point.Compare(&th.Shape{Point: &th.Point{X: 1}}))
+}
+
+func TestHashableSetsAreDeterministic(t *testing.T) {
+	a := &th.Point{X: 1, Y: 1}
+	b := &th.Point{X: 1, Y: 2}
+	c := &th.Point{X: 2, Y: 0}
+
+	x := &th.Drawing{
+		Points:    []*th.Point{c, a, b},
+		Locations: []*th.Location{(*th.Location)(b), (*th.Location)(a)},
+		Shapes:    []*th.Shape{{Point: a}, {Name: ptr.String("x")}},
+	}
+	y := &th.Drawing{
+		Points:    []*th.Point{b, c, a},
+		Locations: []*th.Location{(*th.Location)(a), (*th.Location)(b)},
+		Shapes:    []*th.Shape{{Name: ptr.String("x")}, {Point: a}},
+	}
+
+	xw, err := x.ToWire()
+	require.NoError(t, err)
+	yw, err := y.ToWire()
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(xw, yw), "equal sets must be encoded identically")
+
+	var xb, yb bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(xw, &xb))
+	require.NoError(t, protocol.Binary.Encode(yw, &yb))
+	assert.Equal(t, xb.Bytes(), yb.Bytes())
+
+	var got th.Drawing
+	require.NoError(t, got.FromWire(xw))
+	assert.Equal(t, []*th.Point{a, b, c}, got.Points, "items must be sorted")
+	assert.Equal(t, []*th.Point{c, a, b}, x.Points, "original value must not be modified")
+	assert.True(t, x.Equals(&got))
+}
+
+func TestHashableErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		thrift  string
+		wantErr string
+	}{
+		{
+			desc:    "invalid value",
+			thrift:  `struct Foo { 1: optional i32 x } (go.hashable = "yes")`,
+			wantErr: `invalid go.hashable on "Foo": expected "true" or "false", got "yes"`,
+		},
+		{
+			desc: "container field",
+			thrift: `struct Foo {
+				1: optional list<i32> x
+			} (go.hashable)`,
+			wantErr: `field "x" of "Foo" cannot be hashed: fields of type list<i32> cannot be hashed`,
+		},
+		{
+			desc: "struct field",
+			thrift: `
+				struct Bar { 1: optional i32 x }
+				struct Foo { 1: optional Bar bar } (go.hashable)
+			`,
+			wantErr: `field "bar" of "Foo" cannot be hashed: Bar is not annotated with go.hashable`,
+		},
+		{
+			desc: "custom type",
+			thrift: `struct Foo {
+				1: optional i64 x (go.unsigned)
+			} (go.hashable)`,
+			wantErr: `field "x" of "Foo" cannot be hashed: it uses a custom type`,
+		},
+		{
+			desc:    "reserved name",
+			thrift:  `struct Foo { 1: optional i32 hash (go.name = "Hash") } (go.hashable)`,
+			wantErr: `"Hash" is a reserved ThriftRW identifier`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-hashable")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.thrift), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
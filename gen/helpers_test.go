@@ -27,8 +27,13 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"testing"
 
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/idltest"
 	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/require"
 )
 
 // This file contains helpers for the different test cases in this module.
@@ -39,6 +44,15 @@ func singleFieldStruct(id int16, value wire.Value) wire.Value {
 	}})
 }
 
+// compileThriftFiles writes the given Thrift files to thriftRoot and
+// compiles the one at root, relative to thriftRoot.
+func compileThriftFiles(t *testing.T, thriftRoot string, files map[string]string, root string) *compile.Module {
+	idltest.WriteFiles(t, thriftRoot, files)
+	m, err := compile.Compile(filepath.Join(thriftRoot, root))
+	require.NoError(t, err)
+	return m
+}
+
 func boolp(x bool) *bool         { return &x }
 func bytep(x int8) *int8         { return &x }
 func int16p(x int16) *int16      { return &x }
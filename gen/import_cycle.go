@@ -0,0 +1,178 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// findImportCycles returns an error if the Go packages generated for the
+// given module and the modules it includes would import each other.
+//
+// Thrift files may include each other and their types may refer to each
+// other, but Go does not allow import cycles, so types which are mutually
+// recursive across Thrift files cannot be generated. They must be defined
+// in the same file instead.
+func findImportCycles(m *compile.Module, thriftRoot string) error {
+	modules := make(map[string]*compile.Module)
+	_ = m.Walk(func(m *compile.Module) error {
+		modules[m.ThriftPath] = m
+		return nil
+	})
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+
+	var visit func(string) error
+	visit = func(file string) error {
+		switch state[file] {
+		case visiting:
+			for i, f := range path {
+				if f == file {
+					path = path[i:]
+					break
+				}
+			}
+			return importCycleError{Files: append(path, file), ThriftRoot: thriftRoot}
+		case visited:
+			return nil
+		}
+
+		state[file] = visiting
+		path = append(path, file)
+		if mod, ok := modules[file]; ok {
+			for _, ref := range moduleReferences(mod) {
+				if err := visit(ref); err != nil {
+					return err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[file] = visited
+		return nil
+	}
+
+	return visit(m.ThriftPath)
+}
+
+// moduleReferences returns the sorted list of Thrift files, other than the
+// module's own, whose types, constants, or services are referenced by the
+// definitions in the given module.
+func moduleReferences(m *compile.Module) []string {
+	refs := make(map[string]struct{})
+	seen := make(map[compile.TypeSpec]struct{})
+
+	var visit func(compile.TypeSpec) error
+	visit = func(t compile.TypeSpec) error {
+		if t == nil {
+			return nil
+		}
+
+		if file := t.ThriftFile(); file != "" && file != m.ThriftPath {
+			refs[file] = struct{}{}
+			return nil
+		}
+
+		switch t.(type) {
+		case *compile.StructSpec, *compile.TypedefSpec:
+			if _, ok := seen[t]; ok {
+				return nil
+			}
+			seen[t] = struct{}{}
+		}
+		return t.ForEachTypeReference(visit)
+	}
+
+	visitFields := func(fields compile.FieldGroup) {
+		for _, f := range fields {
+			visit(f.Type)
+		}
+	}
+
+	for _, t := range m.Types {
+		visit(t)
+	}
+
+	for _, c := range m.Constants {
+		visit(c.Type)
+	}
+
+	for _, s := range m.Services {
+		if s.Parent != nil && s.Parent.File != m.ThriftPath {
+			refs[s.Parent.File] = struct{}{}
+		}
+
+		for _, f := range s.Functions {
+			visitFields(compile.FieldGroup(f.ArgsSpec))
+			if f.ResultSpec != nil {
+				visit(f.ResultSpec.ReturnType)
+				visitFields(f.ResultSpec.Exceptions)
+			}
+		}
+	}
+
+	files := make([]string, 0, len(refs))
+	for file := range refs {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// importCycleError is returned if the Go packages generated for a set of
+// Thrift files would import each other.
+type importCycleError struct {
+	Files      []string
+	ThriftRoot string
+}
+
+func (e importCycleError) Error() string {
+	// Outputs:
+	//
+	// 	found an import cycle between the packages for:
+	// 	    a.thrift
+	// 	 -> b.thrift
+	// 	 -> a.thrift
+	// 	types which refer to each other must be defined in the same file
+
+	lines := make([]string, 0, len(e.Files)+2)
+	lines = append(lines, "found an import cycle between the packages for:")
+	for i, file := range e.Files {
+		if rel, err := filepath.Rel(e.ThriftRoot, file); err == nil {
+			file = rel
+		}
+		if i == 0 {
+			lines = append(lines, "    "+file)
+		} else {
+			lines = append(lines, " -> "+file)
+		}
+	}
+	lines = append(lines, "types which refer to each other must be defined in the same file")
+	return strings.Join(lines, "\n")
+}
@@ -24,6 +24,7 @@ import (
 	"go/ast"
 	"go/token"
 	"path/filepath"
+	"strconv"
 
 	"go.uber.org/thriftrw/internal/goast"
 )
@@ -82,6 +83,44 @@ func (i importer) Import(path string) string {
 	return name
 }
 
+// removeUnused drops imports which aren't referenced by any of the given
+// declarations.
+//
+// Helpers shared between types are declared only once per package with
+// EnsureDeclared, but rendering them records their imports even when an
+// earlier file already declared them. When the generated code is split across
+// multiple files, this leaves imports behind in files that don't use them.
+func (i importer) removeUnused(decls []ast.Decl) {
+	used := make(map[string]struct{})
+	for _, decl := range decls {
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					used[x.Name] = struct{}{}
+				}
+			}
+			return true
+		})
+	}
+
+	for key, imp := range i.imports {
+		var name string
+		if imp.Name != nil {
+			name = imp.Name.Name
+		} else if path, err := strconv.Unquote(imp.Path.Value); err == nil {
+			name = goast.DeterminePackageName(path)
+		} else {
+			continue
+		}
+		if name == "_" || name == "." {
+			continue
+		}
+		if _, ok := used[name]; !ok {
+			delete(i.imports, key)
+		}
+	}
+}
+
 // importDecl builds an import declation from the given list of imports.
 func (i importer) importDecl() ast.Decl {
 	imports := i.imports
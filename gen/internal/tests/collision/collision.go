@@ -9,6 +9,8 @@ import (
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -137,6 +139,147 @@ func (v *AccessorConflict) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *AccessorConflict) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.GetName2 = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsSetName2 = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a AccessorConflict struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *AccessorConflict) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Name == nil) {
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	if !(v.GetName2 == nil) {
+		b, err := json.Marshal(v.GetName2)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"get_name":`)
+		buff.Write(b)
+	}
+	if !(v.IsSetName2 == nil) {
+		b, err := json.Marshal(v.IsSetName2)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"is_set_name":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a AccessorConflict struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *AccessorConflict) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["get_name"]; ok {
+		if err := json.Unmarshal(r, &v.GetName2); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["is_set_name"]; ok {
+		if err := json.Unmarshal(r, &v.IsSetName2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a AccessorConflict
 // struct.
 func (v *AccessorConflict) String() string {
@@ -205,6 +348,40 @@ func (v *AccessorConflict) Equals(rhs *AccessorConflict) bool {
 	return true
 }
 
+func _String_ClonePtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Bool_ClonePtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this AccessorConflict. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *AccessorConflict) Clone() *AccessorConflict {
+	if v == nil {
+		return nil
+	}
+
+	var c AccessorConflict
+	c.Name = _String_ClonePtr(v.Name)
+	c.GetName2 = _String_ClonePtr(v.GetName2)
+	c.IsSetName2 = _Bool_ClonePtr(v.IsSetName2)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of AccessorConflict.
 func (v *AccessorConflict) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -364,6 +541,123 @@ func (v *AccessorNoConflict) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *AccessorNoConflict) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Getname = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.GetName = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a AccessorNoConflict struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *AccessorNoConflict) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Getname == nil) {
+		b, err := json.Marshal(v.Getname)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"getname":`)
+		buff.Write(b)
+	}
+	if !(v.GetName == nil) {
+		b, err := json.Marshal(v.GetName)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"get_name":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a AccessorNoConflict struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *AccessorNoConflict) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["getname"]; ok {
+		if err := json.Unmarshal(r, &v.Getname); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["get_name"]; ok {
+		if err := json.Unmarshal(r, &v.GetName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a AccessorNoConflict
 // struct.
 func (v *AccessorNoConflict) String() string {
@@ -405,6 +699,21 @@ func (v *AccessorNoConflict) Equals(rhs *AccessorNoConflict) bool {
 	return true
 }
 
+// Clone returns a deep copy of this AccessorNoConflict. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *AccessorNoConflict) Clone() *AccessorNoConflict {
+	if v == nil {
+		return nil
+	}
+
+	var c AccessorNoConflict
+	c.Getname = _String_ClonePtr(v.Getname)
+	c.GetName = _String_ClonePtr(v.GetName)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of AccessorNoConflict.
 func (v *AccessorNoConflict) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -480,6 +789,13 @@ func (v *LittlePotatoe) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes LittlePotatoe directly off the wire.
+func (v *LittlePotatoe) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt64()
+	*v = (LittlePotatoe)(x)
+	return err
+}
+
 // Equals returns true if this LittlePotatoe is equal to the provided
 // LittlePotatoe.
 func (lhs LittlePotatoe) Equals(rhs LittlePotatoe) bool {
@@ -614,6 +930,24 @@ func (v *MyEnum) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded MyEnum directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v MyEnum
+//   if err := v.Decode(sReader); err != nil {
+//     return MyEnum(0), err
+//   }
+//   return v, nil
+func (v *MyEnum) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (MyEnum)(i)
+	return nil
+}
+
 // String returns a readable string representation of MyEnum.
 func (v MyEnum) String() string {
 	w := int32(v)
@@ -864,6 +1198,7 @@ func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
 	}
 
 	o := make(map[string]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetString(), error(nil)
 		if err != nil {
@@ -957,101 +1292,409 @@ func (v *PrimitiveContainers) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PrimitiveContainers
-// struct.
-func (v *PrimitiveContainers) String() string {
-	if v == nil {
-		return "<nil>"
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	var fields [3]string
-	i := 0
-	if v.A != nil {
-		fields[i] = fmt.Sprintf("A: %v", v.A)
-		i++
-	}
-	if v.B != nil {
-		fields[i] = fmt.Sprintf("B: %v", v.B)
-		i++
-	}
-	if v.C != nil {
-		fields[i] = fmt.Sprintf("C: %v", v.C)
-		i++
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
 	}
 
-	return fmt.Sprintf("PrimitiveContainers{%v}", strings.Join(fields[:i], ", "))
-}
+	n := lh.Length
+	if n > 1024 {
 
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
+	o := make([]string, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return true
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for x := range rhs {
-		if _, ok := lhs[x]; !ok {
-			return false
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadSetEnd()
 	}
 
-	return true
-}
+	n := sh.Length
+	if n > 1024 {
 
-func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !(lv == rv) {
-			return false
+	o := make(map[string]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
 		}
-	}
-	return true
-}
 
-// Equals returns true if all the fields of this PrimitiveContainers match the
-// provided PrimitiveContainers.
-//
-// This function performs a deep comparison.
-func (v *PrimitiveContainers) Equals(rhs *PrimitiveContainers) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !((v.A == nil && rhs.A == nil) || (v.A != nil && rhs.A != nil && _List_String_Equals(v.A, rhs.A))) {
-		return false
+		o[v] = struct{}{}
 	}
-	if !((v.B == nil && rhs.B == nil) || (v.B != nil && rhs.B != nil && _Set_String_mapType_Equals(v.B, rhs.B))) {
-		return false
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
 	}
-	if !((v.C == nil && rhs.C == nil) || (v.C != nil && rhs.C != nil && _Map_String_String_Equals(v.C, rhs.C))) {
-		return false
+	return o, err
+}
+
+func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	return true
-}
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
 
-type _List_String_Zapper []string
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]string, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *PrimitiveContainers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.A, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TSet:
+			v.B, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.C, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a PrimitiveContainers struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PrimitiveContainers) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.A) == 0) {
+		b, err := json.Marshal(v.A)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"ListOrSetOrMap":`)
+		buff.Write(b)
+	}
+	if !(len(v.B) == 0) {
+		b, err := json.Marshal(v.B)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"List_Or_SetOrMap":`)
+		buff.Write(b)
+	}
+	if !(len(v.C) == 0) {
+		b, err := json.Marshal(v.C)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"ListOrSet_Or_Map":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PrimitiveContainers struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PrimitiveContainers) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["ListOrSetOrMap"]; ok {
+		if err := json.Unmarshal(r, &v.A); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["List_Or_SetOrMap"]; ok {
+		if err := json.Unmarshal(r, &v.B); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["ListOrSet_Or_Map"]; ok {
+		if err := json.Unmarshal(r, &v.C); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PrimitiveContainers
+// struct.
+func (v *PrimitiveContainers) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.A != nil {
+		fields[i] = fmt.Sprintf("A: %v", v.A)
+		i++
+	}
+	if v.B != nil {
+		fields[i] = fmt.Sprintf("B: %v", v.B)
+		i++
+	}
+	if v.C != nil {
+		fields[i] = fmt.Sprintf("C: %v", v.C)
+		i++
+	}
+
+	return fmt.Sprintf("PrimitiveContainers{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this PrimitiveContainers match the
+// provided PrimitiveContainers.
+//
+// This function performs a deep comparison.
+func (v *PrimitiveContainers) Equals(rhs *PrimitiveContainers) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.A == nil && rhs.A == nil) || (v.A != nil && rhs.A != nil && _List_String_Equals(v.A, rhs.A))) {
+		return false
+	}
+	if !((v.B == nil && rhs.B == nil) || (v.B != nil && rhs.B != nil && _Set_String_mapType_Equals(v.B, rhs.B))) {
+		return false
+	}
+	if !((v.C == nil && rhs.C == nil) || (v.C != nil && rhs.C != nil && _Map_String_String_Equals(v.C, rhs.C))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Clone(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_String_mapType_Clone(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_String_String_Clone(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this PrimitiveContainers. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PrimitiveContainers) Clone() *PrimitiveContainers {
+	if v == nil {
+		return nil
+	}
+
+	var c PrimitiveContainers
+	c.A = _List_String_Clone(v.A)
+	c.B = _Set_String_mapType_Clone(v.B)
+	c.C = _Map_String_String_Clone(v.C)
+
+	return &c
+}
+
+type _List_String_Zapper []string
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
 // fast logging of _List_String_Zapper.
@@ -1215,6 +1858,8 @@ func (v *StructCollision) FromWire(w wire.Value) error {
 	collisionFieldIsSet := false
 	collision_fieldIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -1236,6 +1881,63 @@ func (v *StructCollision) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !collisionFieldIsSet {
+		missing.Add("StructCollision", "CollisionField")
+	}
+
+	if !collision_fieldIsSet {
+		missing.Add("StructCollision", "CollisionField2")
+	}
+
+	return missing.Err()
+}
+
+func (v *StructCollision) Decode(sr stream.Reader) error {
+	collisionFieldIsSet := false
+	collision_fieldIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			v.CollisionField, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			collisionFieldIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.CollisionField2, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			collision_fieldIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !collisionFieldIsSet {
 		return errors.New("field CollisionField of StructCollision is required")
 	}
@@ -1247,6 +1949,72 @@ func (v *StructCollision) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a StructCollision struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *StructCollision) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.CollisionField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collisionField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.CollisionField2)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collision_field":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a StructCollision struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *StructCollision) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["collisionField"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["collision_field"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a StructCollision
 // struct.
 func (v *StructCollision) String() string {
@@ -1284,6 +2052,21 @@ func (v *StructCollision) Equals(rhs *StructCollision) bool {
 	return true
 }
 
+// Clone returns a deep copy of this StructCollision. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *StructCollision) Clone() *StructCollision {
+	if v == nil {
+		return nil
+	}
+
+	var c StructCollision
+	c.CollisionField = v.CollisionField
+	c.CollisionField2 = v.CollisionField2
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of StructCollision.
 func (v *StructCollision) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1424,6 +2207,134 @@ func (v *UnionCollision) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *UnionCollision) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.CollisionField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.CollisionField2 = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.CollisionField != nil {
+		count++
+	}
+	if v.CollisionField2 != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("UnionCollision should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a UnionCollision struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *UnionCollision) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.CollisionField == nil) {
+		b, err := json.Marshal(v.CollisionField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collisionField":`)
+		buff.Write(b)
+	}
+	if !(v.CollisionField2 == nil) {
+		b, err := json.Marshal(v.CollisionField2)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collision_field":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a UnionCollision struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *UnionCollision) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["collisionField"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["collision_field"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a UnionCollision
 // struct.
 func (v *UnionCollision) String() string {
@@ -1465,6 +2376,21 @@ func (v *UnionCollision) Equals(rhs *UnionCollision) bool {
 	return true
 }
 
+// Clone returns a deep copy of this UnionCollision. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *UnionCollision) Clone() *UnionCollision {
+	if v == nil {
+		return nil
+	}
+
+	var c UnionCollision
+	c.CollisionField = _Bool_ClonePtr(v.CollisionField)
+	c.CollisionField2 = _String_ClonePtr(v.CollisionField2)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of UnionCollision.
 func (v *UnionCollision) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1510,6 +2436,87 @@ func (v *UnionCollision) IsSetCollisionField2() bool {
 	return v != nil && v.CollisionField2 != nil
 }
 
+// UnionCollisionKind identifies the field of a UnionCollision that is set.
+type UnionCollisionKind int
+
+const (
+	// UnionCollisionKindUnset indicates that no field of a UnionCollision is set.
+	UnionCollisionKindUnset UnionCollisionKind = iota
+
+	// UnionCollisionKindCollisionField indicates that CollisionField is set.
+	UnionCollisionKindCollisionField
+
+	// UnionCollisionKindCollisionField2 indicates that CollisionField2 is set.
+	UnionCollisionKindCollisionField2
+)
+
+// String returns the Thrift name of the field identified by this
+// UnionCollisionKind.
+func (k UnionCollisionKind) String() string {
+	switch k {
+	case UnionCollisionKindUnset:
+		return "unset"
+	case UnionCollisionKindCollisionField:
+		return "collisionField"
+	case UnionCollisionKindCollisionField2:
+		return "collision_field"
+	default:
+		return fmt.Sprintf("UnionCollisionKind(%d)", int(k))
+	}
+}
+
+// Which returns the kind of the field of this UnionCollision that is set,
+// or UnionCollisionKindUnset if none of its fields is set.
+func (v *UnionCollision) Which() UnionCollisionKind {
+	if v == nil {
+		return UnionCollisionKindUnset
+	}
+
+	if v.CollisionField != nil {
+		return UnionCollisionKindCollisionField
+	}
+
+	if v.CollisionField2 != nil {
+		return UnionCollisionKindCollisionField2
+	}
+	return UnionCollisionKindUnset
+}
+
+// GetCollisionFieldOk returns the value of CollisionField and true if it is
+// set, or its zero value and false if it is unset.
+func (v *UnionCollision) GetCollisionFieldOk() (o bool, ok bool) {
+	if v == nil || v.CollisionField == nil {
+		return
+	}
+	return *v.CollisionField, true
+}
+
+// GetCollisionField2Ok returns the value of CollisionField2 and true if it is
+// set, or its zero value and false if it is unset.
+func (v *UnionCollision) GetCollisionField2Ok() (o string, ok bool) {
+	if v == nil || v.CollisionField2 == nil {
+		return
+	}
+	return *v.CollisionField2, true
+}
+
+// Match calls the function provided for the field of this UnionCollision
+// that is set with its value, and returns its result. An error is
+// returned if none of its fields is set.
+func (v *UnionCollision) Match(
+	onCollisionField func(bool) error,
+	onCollisionField2 func(string) error,
+) error {
+	switch v.Which() {
+	case UnionCollisionKindCollisionField:
+		return onCollisionField(*v.CollisionField)
+	case UnionCollisionKindCollisionField2:
+		return onCollisionField2(*v.CollisionField2)
+	default:
+		return errors.New("UnionCollision should have exactly one field: got 0 fields")
+	}
+}
+
 type WithDefault struct {
 	Pouet *StructCollision2 `json:"pouet,omitempty"`
 }
@@ -1581,17 +2588,73 @@ func _StructCollision_Read(w wire.Value) (*StructCollision2, error) {
 func (v *WithDefault) FromWire(w wire.Value) error {
 	var err error
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TStruct {
-				v.Pouet, err = _StructCollision_Read(field.Value)
-				if err != nil {
-					return err
-				}
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Pouet, err = _StructCollision_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Pouet == nil {
+		v.Pouet = &StructCollision2{
+			CollisionField:  false,
+			CollisionField2: "false indeed",
+		}
+	}
+
+	return missing.Err()
+}
+
+func _StructCollision_Decode(sr stream.Reader) (*StructCollision2, error) {
+	var v StructCollision2
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *WithDefault) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Pouet, err = _StructCollision_Decode(sr)
+			if err != nil {
+				return err
+			}
 
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
 			}
 		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
 	if v.Pouet == nil {
@@ -1604,6 +2667,56 @@ func (v *WithDefault) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a WithDefault struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *WithDefault) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Pouet == nil) {
+		b, err := json.Marshal(v.Pouet)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"pouet":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a WithDefault struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *WithDefault) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["pouet"]; ok {
+		if err := json.Unmarshal(r, &v.Pouet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a WithDefault
 // struct.
 func (v *WithDefault) String() string {
@@ -1638,6 +2751,20 @@ func (v *WithDefault) Equals(rhs *WithDefault) bool {
 	return true
 }
 
+// Clone returns a deep copy of this WithDefault. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *WithDefault) Clone() *WithDefault {
+	if v == nil {
+		return nil
+	}
+
+	var c WithDefault
+	c.Pouet = v.Pouet.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of WithDefault.
 func (v *WithDefault) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1698,6 +2825,13 @@ func (v *LittlePotatoe2) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes LittlePotatoe2 directly off the wire.
+func (v *LittlePotatoe2) Decode(sr stream.Reader) error {
+	x, err := sr.ReadDouble()
+	*v = (LittlePotatoe2)(x)
+	return err
+}
+
 // Equals returns true if this LittlePotatoe2 is equal to the provided
 // LittlePotatoe2.
 func (lhs LittlePotatoe2) Equals(rhs LittlePotatoe2) bool {
@@ -1814,6 +2948,24 @@ func (v *MyEnum2) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded MyEnum2 directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v MyEnum2
+//   if err := v.Decode(sReader); err != nil {
+//     return MyEnum2(0), err
+//   }
+//   return v, nil
+func (v *MyEnum2) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (MyEnum2)(i)
+	return nil
+}
+
 // String returns a readable string representation of MyEnum2.
 func (v MyEnum2) String() string {
 	w := int32(v)
@@ -1956,6 +3108,8 @@ func (v *StructCollision2) FromWire(w wire.Value) error {
 	collisionFieldIsSet := false
 	collision_fieldIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -1977,6 +3131,63 @@ func (v *StructCollision2) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !collisionFieldIsSet {
+		missing.Add("StructCollision2", "CollisionField")
+	}
+
+	if !collision_fieldIsSet {
+		missing.Add("StructCollision2", "CollisionField2")
+	}
+
+	return missing.Err()
+}
+
+func (v *StructCollision2) Decode(sr stream.Reader) error {
+	collisionFieldIsSet := false
+	collision_fieldIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			v.CollisionField, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			collisionFieldIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.CollisionField2, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			collision_fieldIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !collisionFieldIsSet {
 		return errors.New("field CollisionField of StructCollision2 is required")
 	}
@@ -1988,6 +3199,72 @@ func (v *StructCollision2) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a StructCollision2 struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *StructCollision2) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.CollisionField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collisionField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.CollisionField2)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collision_field":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a StructCollision2 struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *StructCollision2) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["collisionField"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["collision_field"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a StructCollision2
 // struct.
 func (v *StructCollision2) String() string {
@@ -2025,6 +3302,21 @@ func (v *StructCollision2) Equals(rhs *StructCollision2) bool {
 	return true
 }
 
+// Clone returns a deep copy of this StructCollision2. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *StructCollision2) Clone() *StructCollision2 {
+	if v == nil {
+		return nil
+	}
+
+	var c StructCollision2
+	c.CollisionField = v.CollisionField
+	c.CollisionField2 = v.CollisionField2
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of StructCollision2.
 func (v *StructCollision2) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2165,6 +3457,134 @@ func (v *UnionCollision2) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *UnionCollision2) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.CollisionField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.CollisionField2 = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.CollisionField != nil {
+		count++
+	}
+	if v.CollisionField2 != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("UnionCollision2 should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a UnionCollision2 struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *UnionCollision2) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.CollisionField == nil) {
+		b, err := json.Marshal(v.CollisionField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collisionField":`)
+		buff.Write(b)
+	}
+	if !(v.CollisionField2 == nil) {
+		b, err := json.Marshal(v.CollisionField2)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"collision_field":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a UnionCollision2 struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *UnionCollision2) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["collisionField"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["collision_field"]; ok {
+		if err := json.Unmarshal(r, &v.CollisionField2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a UnionCollision2
 // struct.
 func (v *UnionCollision2) String() string {
@@ -2206,6 +3626,21 @@ func (v *UnionCollision2) Equals(rhs *UnionCollision2) bool {
 	return true
 }
 
+// Clone returns a deep copy of this UnionCollision2. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *UnionCollision2) Clone() *UnionCollision2 {
+	if v == nil {
+		return nil
+	}
+
+	var c UnionCollision2
+	c.CollisionField = _Bool_ClonePtr(v.CollisionField)
+	c.CollisionField2 = _String_ClonePtr(v.CollisionField2)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of UnionCollision2.
 func (v *UnionCollision2) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2251,13 +3686,99 @@ func (v *UnionCollision2) IsSetCollisionField2() bool {
 	return v != nil && v.CollisionField2 != nil
 }
 
+// UnionCollision2Kind identifies the field of a UnionCollision2 that is set.
+type UnionCollision2Kind int
+
+const (
+	// UnionCollision2KindUnset indicates that no field of a UnionCollision2 is set.
+	UnionCollision2KindUnset UnionCollision2Kind = iota
+
+	// UnionCollision2KindCollisionField indicates that CollisionField is set.
+	UnionCollision2KindCollisionField
+
+	// UnionCollision2KindCollisionField2 indicates that CollisionField2 is set.
+	UnionCollision2KindCollisionField2
+)
+
+// String returns the Thrift name of the field identified by this
+// UnionCollision2Kind.
+func (k UnionCollision2Kind) String() string {
+	switch k {
+	case UnionCollision2KindUnset:
+		return "unset"
+	case UnionCollision2KindCollisionField:
+		return "collisionField"
+	case UnionCollision2KindCollisionField2:
+		return "collision_field"
+	default:
+		return fmt.Sprintf("UnionCollision2Kind(%d)", int(k))
+	}
+}
+
+// Which returns the kind of the field of this UnionCollision2 that is set,
+// or UnionCollision2KindUnset if none of its fields is set.
+func (v *UnionCollision2) Which() UnionCollision2Kind {
+	if v == nil {
+		return UnionCollision2KindUnset
+	}
+
+	if v.CollisionField != nil {
+		return UnionCollision2KindCollisionField
+	}
+
+	if v.CollisionField2 != nil {
+		return UnionCollision2KindCollisionField2
+	}
+	return UnionCollision2KindUnset
+}
+
+// GetCollisionFieldOk returns the value of CollisionField and true if it is
+// set, or its zero value and false if it is unset.
+func (v *UnionCollision2) GetCollisionFieldOk() (o bool, ok bool) {
+	if v == nil || v.CollisionField == nil {
+		return
+	}
+	return *v.CollisionField, true
+}
+
+// GetCollisionField2Ok returns the value of CollisionField2 and true if it is
+// set, or its zero value and false if it is unset.
+func (v *UnionCollision2) GetCollisionField2Ok() (o string, ok bool) {
+	if v == nil || v.CollisionField2 == nil {
+		return
+	}
+	return *v.CollisionField2, true
+}
+
+// Match calls the function provided for the field of this UnionCollision2
+// that is set with its value, and returns its result. An error is
+// returned if none of its fields is set.
+func (v *UnionCollision2) Match(
+	onCollisionField func(bool) error,
+	onCollisionField2 func(string) error,
+) error {
+	switch v.Which() {
+	case UnionCollision2KindCollisionField:
+		return onCollisionField(*v.CollisionField)
+	case UnionCollision2KindCollisionField2:
+		return onCollisionField2(*v.CollisionField2)
+	default:
+		return errors.New("UnionCollision2 should have exactly one field: got 0 fields")
+	}
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "collision",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/collision",
 	FilePath: "collision.thrift",
 	SHA1:     "b7ffef8f5aede3fbc4440cadb2f225e474797f2d",
+	Version:  "1.21.0-dev",
 	Raw:      rawIDL,
 }
 
 const rawIDL = "\nstruct StructCollision {\n\t1: required bool collisionField\n\t2: required string collision_field (go.name = \"CollisionField2\")\n}\n\nstruct struct_collision {\n\t1: required bool collisionField\n\t2: required string collision_field (go.name = \"CollisionField2\")\n} (go.name=\"StructCollision2\")\n\nstruct PrimitiveContainers {\n    1: optional list<string> ListOrSetOrMap (go.name = \"A\")\n    3: optional set<string>  List_Or_SetOrMap (go.name = \"B\")\n    5: optional map<string, string> ListOrSet_Or_Map (go.name = \"C\")\n}\n\nenum MyEnum {\n    X = 123,\n    Y = 456,\n    Z = 789,\n    FooBar,\n    foo_bar (go.name=\"FooBar2\"),\n}\n\nenum my_enum {\n    X = 12,\n    Y = 34,\n    Z = 56,\n} (go.name=\"MyEnum2\")\n\ntypedef i64 LittlePotatoe\ntypedef double little_potatoe (go.name=\"LittlePotatoe2\")\n\nconst struct_collision struct_constant = {\n\t\"collisionField\": false,\n\t\"collision_field\": \"false indeed\",\n}\n\nunion UnionCollision {\n\t1: bool collisionField\n\t2: string collision_field (go.name = \"CollisionField2\")\n}\n\nunion union_collision {\n\t1: bool collisionField\n\t2: string collision_field (go.name = \"CollisionField2\")\n} (go.name=\"UnionCollision2\")\n\nstruct WithDefault {\n\t1: required struct_collision pouet = struct_constant\n}\n\nstruct AccessorNoConflict {\n    1: optional string getname\n    2: optional string get_name\n}\n\nstruct AccessorConflict {\n    1: optional string name\n    2: optional string get_name (go.name = \"GetName2\")\n    3: optional bool is_set_name (go.name = \"IsSetName2\")\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
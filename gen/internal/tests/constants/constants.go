@@ -4,6 +4,10 @@
 package constants
 
 import (
+	bytes "bytes"
+	json "encoding/json"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
 	containers "go.uber.org/thriftrw/gen/internal/tests/containers"
 	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
 	exceptions "go.uber.org/thriftrw/gen/internal/tests/exceptions"
@@ -11,8 +15,13 @@ import (
 	structs "go.uber.org/thriftrw/gen/internal/tests/structs"
 	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	unions "go.uber.org/thriftrw/gen/internal/tests/unions"
+	stream "go.uber.org/thriftrw/protocol/stream"
 	ptr "go.uber.org/thriftrw/ptr"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
 )
 
 const Home enums.RecordType = enums.RecordTypeHomeAddress
@@ -199,6 +208,8 @@ var ContainersOfContainers *containers.ContainersOfContainers = &containers.Cont
 
 var EmptyException *exceptions.EmptyException = &exceptions.EmptyException{}
 
+const EndOfFirstDay typedefs.Timestamp = typedefs.Timestamp(other_constants.OneDay)
+
 var EnumContainers *containers.EnumContainers = &containers.EnumContainers{
 	ListOfEnums: []enums.EnumDefault{
 		enums.EnumDefaultBar,
@@ -315,6 +326,8 @@ var PrimitiveContainers *containers.PrimitiveContainers = &containers.PrimitiveC
 	},
 }
 
+const StartOfDay int64 = int64(other_constants.StartOfDay)
+
 func _EnumDefault_ptr(v enums.EnumDefault) *enums.EnumDefault {
 	return &v
 }
@@ -328,12 +341,609 @@ var UUID *typedefs.UUID = &typedefs.UUID{
 	Low:  5678,
 }
 
+type Window struct {
+	Start  *typedefs.Timestamp `json:"start,omitempty"`
+	Finish *typedefs.Timestamp `json:"finish,omitempty"`
+	Length *int64              `json:"length,omitempty"`
+	ID     *typedefs.UUID      `json:"id,omitempty"`
+}
+
+func _Timestamp_ptr(v typedefs.Timestamp) *typedefs.Timestamp {
+	return &v
+}
+
+// ToWire translates a Window struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Window) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Start == nil {
+		v.Start = _Timestamp_ptr(other_constants.StartOfDay)
+	}
+	{
+		w, err = v.Start.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Finish == nil {
+		v.Finish = _Timestamp_ptr(typedefs.Timestamp(other_constants.OneDay))
+	}
+	{
+		w, err = v.Finish.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Length == nil {
+		v.Length = ptr.Int64(other_constants.OneDay)
+	}
+	{
+		w, err = wire.NewValueI64(*(v.Length)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ID == nil {
+		v.ID = &typedefs.UUID{
+			High: 1234,
+			Low:  5678,
+		}
+	}
+	{
+		w, err = v.ID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Timestamp_Read(w wire.Value) (typedefs.Timestamp, error) {
+	var x typedefs.Timestamp
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _UUID_Read(w wire.Value) (*typedefs.UUID, error) {
+	var x typedefs.UUID
+	err := x.FromWire(w)
+	return &x, err
+}
+
+// FromWire deserializes a Window struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Window struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Window
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Window) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				var x typedefs.Timestamp
+				x, err = _Timestamp_Read(field.Value)
+				v.Start = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x typedefs.Timestamp
+				x, err = _Timestamp_Read(field.Value)
+				v.Finish = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Length = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ID, err = _UUID_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.Start == nil {
+		v.Start = _Timestamp_ptr(other_constants.StartOfDay)
+	}
+
+	if v.Finish == nil {
+		v.Finish = _Timestamp_ptr(typedefs.Timestamp(other_constants.OneDay))
+	}
+
+	if v.Length == nil {
+		v.Length = ptr.Int64(other_constants.OneDay)
+	}
+
+	if v.ID == nil {
+		v.ID = &typedefs.UUID{
+			High: 1234,
+			Low:  5678,
+		}
+	}
+
+	return missing.Err()
+}
+
+func _Timestamp_Decode(sr stream.Reader) (typedefs.Timestamp, error) {
+	var x typedefs.Timestamp
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _UUID_Decode(sr stream.Reader) (*typedefs.UUID, error) {
+	var x typedefs.UUID
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func (v *Window) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			var x typedefs.Timestamp
+			x, err = _Timestamp_Decode(sr)
+			v.Start = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x typedefs.Timestamp
+			x, err = _Timestamp_Decode(sr)
+			v.Finish = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Length = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ID, err = _UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.Start == nil {
+		v.Start = _Timestamp_ptr(other_constants.StartOfDay)
+	}
+
+	if v.Finish == nil {
+		v.Finish = _Timestamp_ptr(typedefs.Timestamp(other_constants.OneDay))
+	}
+
+	if v.Length == nil {
+		v.Length = ptr.Int64(other_constants.OneDay)
+	}
+
+	if v.ID == nil {
+		v.ID = &typedefs.UUID{
+			High: 1234,
+			Low:  5678,
+		}
+	}
+
+	return nil
+}
+
+func _I64_UnmarshalJSON(text []byte) (*int64, error) {
+	// json.Number accepts both JSON numbers and JSON strings holding
+	// numbers, and retains all digits of the value.
+	var n json.Number
+	if err := json.Unmarshal(text, &n); err != nil {
+		return nil, err
+	}
+	if n == "" {
+		return nil, nil
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// MarshalJSON serializes a Window struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Window) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Start == nil) {
+		b, err := json.Marshal(v.Start)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"start":`)
+		buff.Write(b)
+	}
+	if !(v.Finish == nil) {
+		b, err := json.Marshal(v.Finish)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"finish":`)
+		buff.Write(b)
+	}
+	if !(v.Length == nil) {
+		b, err := json.Marshal(v.Length)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"length":`)
+		buff.Write(b)
+	}
+	if !(v.ID == nil) {
+		b, err := json.Marshal(v.ID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"id":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Window struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Window) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["start"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.Start = (*typedefs.Timestamp)(x)
+	}
+	if r, ok := raw["finish"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.Finish = (*typedefs.Timestamp)(x)
+	}
+	if r, ok := raw["length"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.Length = (*int64)(x)
+	}
+	if r, ok := raw["id"]; ok {
+		if err := json.Unmarshal(r, &v.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Window
+// struct.
+func (v *Window) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Start != nil {
+		fields[i] = fmt.Sprintf("Start: %v", *(v.Start))
+		i++
+	}
+	if v.Finish != nil {
+		fields[i] = fmt.Sprintf("Finish: %v", *(v.Finish))
+		i++
+	}
+	if v.Length != nil {
+		fields[i] = fmt.Sprintf("Length: %v", *(v.Length))
+		i++
+	}
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", v.ID)
+		i++
+	}
+
+	return fmt.Sprintf("Window{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Timestamp_EqualsPtr(lhs, rhs *typedefs.Timestamp) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Window match the
+// provided Window.
+//
+// This function performs a deep comparison.
+func (v *Window) Equals(rhs *Window) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.Start, rhs.Start) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.Finish, rhs.Finish) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Length, rhs.Length) {
+		return false
+	}
+	if !((v.ID == nil && rhs.ID == nil) || (v.ID != nil && rhs.ID != nil && v.ID.Equals(rhs.ID))) {
+		return false
+	}
+
+	return true
+}
+
+func _Timestamp_ClonePtr(v *typedefs.Timestamp) *typedefs.Timestamp {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_ClonePtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Window. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Window) Clone() *Window {
+	if v == nil {
+		return nil
+	}
+
+	var c Window
+	c.Start = _Timestamp_ClonePtr(v.Start)
+	c.Finish = _Timestamp_ClonePtr(v.Finish)
+	c.Length = _I64_ClonePtr(v.Length)
+	c.ID = v.ID.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Window.
+func (v *Window) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Start != nil {
+		enc.AddInt64("start", (int64)(*v.Start))
+	}
+	if v.Finish != nil {
+		enc.AddInt64("finish", (int64)(*v.Finish))
+	}
+	if v.Length != nil {
+		enc.AddInt64("length", *v.Length)
+	}
+	if v.ID != nil {
+		err = multierr.Append(err, enc.AddObject("id", (*typedefs.I128)(v.ID)))
+	}
+	return err
+}
+
+// GetStart returns the value of Start if it is set or its
+// default value if it is unset.
+func (v *Window) GetStart() (o typedefs.Timestamp) {
+	if v != nil && v.Start != nil {
+		return *v.Start
+	}
+	o = other_constants.StartOfDay
+	return
+}
+
+// IsSetStart returns true if Start is not nil.
+func (v *Window) IsSetStart() bool {
+	return v != nil && v.Start != nil
+}
+
+// GetFinish returns the value of Finish if it is set or its
+// default value if it is unset.
+func (v *Window) GetFinish() (o typedefs.Timestamp) {
+	if v != nil && v.Finish != nil {
+		return *v.Finish
+	}
+	o = typedefs.Timestamp(other_constants.OneDay)
+	return
+}
+
+// IsSetFinish returns true if Finish is not nil.
+func (v *Window) IsSetFinish() bool {
+	return v != nil && v.Finish != nil
+}
+
+// GetLength returns the value of Length if it is set or its
+// default value if it is unset.
+func (v *Window) GetLength() (o int64) {
+	if v != nil && v.Length != nil {
+		return *v.Length
+	}
+	o = other_constants.OneDay
+	return
+}
+
+// IsSetLength returns true if Length is not nil.
+func (v *Window) IsSetLength() bool {
+	return v != nil && v.Length != nil
+}
+
+// GetID returns the value of ID if it is set or its
+// default value if it is unset.
+func (v *Window) GetID() (o *typedefs.UUID) {
+	if v != nil && v.ID != nil {
+		return v.ID
+	}
+	o = &typedefs.UUID{
+		High: 1234,
+		Low:  5678,
+	}
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Window) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "constants",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/constants",
 	FilePath: "constants.thrift",
-	SHA1:     "c174d3c52157a0b78e14ad7677b2128174517eb6",
+	SHA1:     "37be18e60b4994ab2426b086550602fea0f904c2",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		containers.ThriftModule,
 		enums.ThriftModule,
@@ -346,4 +956,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./other_constants.thrift\"\ninclude \"./containers.thrift\"\ninclude \"./enums.thrift\"\ninclude \"./exceptions.thrift\"\ninclude \"./structs.thrift\"\ninclude \"./unions.thrift\"\ninclude \"./typedefs.thrift\"\n\nconst containers.PrimitiveContainers primitiveContainers = {\n    \"listOfInts\": other_constants.listOfInts, // imported constant\n    \"setOfStrings\": [\"foo\", \"bar\"],\n    \"setOfBytes\": other_constants.listOfInts, // imported constant with type casting\n    \"mapOfIntToString\": {\n        1: \"1\",\n        2: \"2\",\n        3: \"3\",\n    },\n    \"mapOfStringToBool\": {\n        \"1\": 0,\n        \"2\": 1,\n        \"3\": 1,\n    }\n}\n\nconst containers.EnumContainers enumContainers = {\n    \"listOfEnums\": [1, enums.EnumDefault.Foo],\n    \"setOfEnums\": [123, enums.EnumWithValues.Y],\n    \"mapOfEnums\": {\n        0: 1,\n        enums.EnumWithDuplicateValues.Q: 2,\n    },\n}\n\nconst containers.ContainersOfContainers containersOfContainers = {\n    \"listOfLists\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfSets\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfMaps\": [{1: 2, 3: 4, 5: 6}, {7: 8, 9: 10, 11: 12}],\n    \"setOfSets\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfLists\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfMaps\": [\n        {\"1\": \"2\", \"3\": \"4\", \"5\": \"6\"},\n        {\"7\": \"8\", \"9\": \"10\", \"11\": \"12\"},\n    ],\n    \"mapOfMapToInt\": {\n        {\"1\": 1, \"2\": 2, \"3\": 3}: 100,\n        {\"4\": 4, \"5\": 5, \"6\": 6}: 200,\n    },\n    \"mapOfListToSet\": {\n        // more type casting\n        other_constants.listOfInts: other_constants.listOfInts,\n        [4, 5, 6]: [4, 5, 6],\n    },\n    \"mapOfSetToListOfDouble\": {\n        [1, 2, 3]: [1.2, 3.4],\n        [4, 5, 6]: [5.6, 7.8],\n    },\n}\n\nconst enums.StructWithOptionalEnum structWithOptionalEnum = {\n    \"e\": enums.EnumDefault.Baz\n}\n\nconst exceptions.EmptyException emptyException = {}\n\nconst structs.Graph graph = {\n    \"edges\": [\n        {\"startPoint\": other_constants.some_point, \"endPoint\": {\"x\": 3, \"y\": 4}},\n        {\"startPoint\": {\"x\": 5, \"y\": 6}, \"endPoint\": {\"x\": 7, \"y\": 8}},\n    ]\n}\n\nconst structs.Node lastNode = {\"value\": 3}\nconst structs.Node node = {\n    \"value\": 1,\n    \"tail\": {\"value\": 2, \"tail\": lastNode},\n}\n\nconst unions.ArbitraryValue arbitraryValue = {\n    \"listValue\": [\n        {\"boolValue\": 1},\n        {\"int64Value\": 2},\n        {\"stringValue\": \"hello\"},\n        {\"mapValue\": {\"foo\": {\"stringValue\": \"bar\"}}},\n    ],\n}\n// TODO: union validation for constants?\n\nconst typedefs.i128 i128 = uuid\nconst typedefs.UUID uuid = {\"high\": 1234, \"low\": 5678}\n\n/** Timestamp at which time began. */\nconst typedefs.Timestamp beginningOfTime = 0\n\n/**\n * An example frame group.\n *\n * Contains two frames.\n */\nconst typedefs.FrameGroup frameGroup = [\n    {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    {\n        \"topLeft\": {\"x\": 3, \"y\": 4},\n        \"size\": {\"width\": 300, \"height\": 400},\n    },\n]\n\nconst typedefs.MyEnum myEnum = enums.EnumWithValues.Y\n\nconst enums.RecordType NAME = enums.RecordType.NAME\nconst enums.RecordType HOME = enums.RecordType.HOME_ADDRESS\nconst enums.RecordType WORK_ADDRESS = enums.RecordType.WORK_ADDRESS\n\nconst enums.lowerCaseEnum lower = enums.lowerCaseEnum.items\n"
+const rawIDL = "include \"./other_constants.thrift\"\ninclude \"./containers.thrift\"\ninclude \"./enums.thrift\"\ninclude \"./exceptions.thrift\"\ninclude \"./structs.thrift\"\ninclude \"./unions.thrift\"\ninclude \"./typedefs.thrift\"\n\nconst containers.PrimitiveContainers primitiveContainers = {\n    \"listOfInts\": other_constants.listOfInts, // imported constant\n    \"setOfStrings\": [\"foo\", \"bar\"],\n    \"setOfBytes\": other_constants.listOfInts, // imported constant with type casting\n    \"mapOfIntToString\": {\n        1: \"1\",\n        2: \"2\",\n        3: \"3\",\n    },\n    \"mapOfStringToBool\": {\n        \"1\": 0,\n        \"2\": 1,\n        \"3\": 1,\n    }\n}\n\nconst containers.EnumContainers enumContainers = {\n    \"listOfEnums\": [1, enums.EnumDefault.Foo],\n    \"setOfEnums\": [123, enums.EnumWithValues.Y],\n    \"mapOfEnums\": {\n        0: 1,\n        enums.EnumWithDuplicateValues.Q: 2,\n    },\n}\n\nconst containers.ContainersOfContainers containersOfContainers = {\n    \"listOfLists\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfSets\": [[1, 2, 3], [4, 5, 6]],\n    \"listOfMaps\": [{1: 2, 3: 4, 5: 6}, {7: 8, 9: 10, 11: 12}],\n    \"setOfSets\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfLists\": [[\"1\", \"2\", \"3\"], [\"4\", \"5\", \"6\"]],\n    \"setOfMaps\": [\n        {\"1\": \"2\", \"3\": \"4\", \"5\": \"6\"},\n        {\"7\": \"8\", \"9\": \"10\", \"11\": \"12\"},\n    ],\n    \"mapOfMapToInt\": {\n        {\"1\": 1, \"2\": 2, \"3\": 3}: 100,\n        {\"4\": 4, \"5\": 5, \"6\": 6}: 200,\n    },\n    \"mapOfListToSet\": {\n        // more type casting\n        other_constants.listOfInts: other_constants.listOfInts,\n        [4, 5, 6]: [4, 5, 6],\n    },\n    \"mapOfSetToListOfDouble\": {\n        [1, 2, 3]: [1.2, 3.4],\n        [4, 5, 6]: [5.6, 7.8],\n    },\n}\n\nconst enums.StructWithOptionalEnum structWithOptionalEnum = {\n    \"e\": enums.EnumDefault.Baz\n}\n\nconst exceptions.EmptyException emptyException = {}\n\nconst structs.Graph graph = {\n    \"edges\": [\n        {\"startPoint\": other_constants.some_point, \"endPoint\": {\"x\": 3, \"y\": 4}},\n        {\"startPoint\": {\"x\": 5, \"y\": 6}, \"endPoint\": {\"x\": 7, \"y\": 8}},\n    ]\n}\n\nconst structs.Node lastNode = {\"value\": 3}\nconst structs.Node node = {\n    \"value\": 1,\n    \"tail\": {\"value\": 2, \"tail\": lastNode},\n}\n\nconst unions.ArbitraryValue arbitraryValue = {\n    \"listValue\": [\n        {\"boolValue\": 1},\n        {\"int64Value\": 2},\n        {\"stringValue\": \"hello\"},\n        {\"mapValue\": {\"foo\": {\"stringValue\": \"bar\"}}},\n    ],\n}\n// TODO: union validation for constants?\n\nconst typedefs.i128 i128 = uuid\nconst typedefs.UUID uuid = {\"high\": 1234, \"low\": 5678}\n\n/** Timestamp at which time began. */\nconst typedefs.Timestamp beginningOfTime = 0\n\nconst typedefs.Timestamp endOfFirstDay = other_constants.oneDay // imported constant cast to a typedef\nconst i64 startOfDay = other_constants.startOfDay // imported typedef'd constant\n\nstruct Window {\n    1: optional typedefs.Timestamp start = other_constants.startOfDay\n    2: optional typedefs.Timestamp finish = other_constants.oneDay\n    3: optional i64 length = other_constants.oneDay\n    4: optional typedefs.UUID id = uuid\n}\n\n/**\n * An example frame group.\n *\n * Contains two frames.\n */\nconst typedefs.FrameGroup frameGroup = [\n    {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    {\n        \"topLeft\": {\"x\": 3, \"y\": 4},\n        \"size\": {\"width\": 300, \"height\": 400},\n    },\n]\n\nconst typedefs.MyEnum myEnum = enums.EnumWithValues.Y\n\nconst enums.RecordType NAME = enums.RecordType.NAME\nconst enums.RecordType HOME = enums.RecordType.HOME_ADDRESS\nconst enums.RecordType WORK_ADDRESS = enums.RecordType.WORK_ADDRESS\n\nconst enums.lowerCaseEnum lower = enums.lowerCaseEnum.items\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -6,6 +6,7 @@ package containers
 import (
 	bytes "bytes"
 	base64 "encoding/base64"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
@@ -13,6 +14,8 @@ import (
 	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
 	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	uuid_conflict "go.uber.org/thriftrw/gen/internal/tests/uuid_conflict"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -751,6 +754,7 @@ func _Set_I32_mapType_Read(s wire.ValueList) (map[int32]struct{}, error) {
 	}
 
 	o := make(map[int32]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetI32(), error(nil)
 		if err != nil {
@@ -834,6 +838,7 @@ func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
 	}
 
 	o := make(map[string]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetString(), error(nil)
 		if err != nil {
@@ -853,6 +858,7 @@ func _Set_Set_String_mapType_sliceType_Read(s wire.ValueList) ([]map[string]stru
 	}
 
 	o := make([]map[string]struct{}, 0, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := _Set_String_mapType_Read(x.GetSet())
 		if err != nil {
@@ -890,6 +896,7 @@ func _Set_List_String_sliceType_Read(s wire.ValueList) ([][]string, error) {
 	}
 
 	o := make([][]string, 0, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := _List_String_Read(x.GetList())
 		if err != nil {
@@ -937,6 +944,7 @@ func _Set_Map_String_String_sliceType_Read(s wire.ValueList) ([]map[string]strin
 	}
 
 	o := make([]map[string]string, 0, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := _Map_String_String_Read(x.GetMap())
 		if err != nil {
@@ -1021,6 +1029,7 @@ func _Set_I64_mapType_Read(s wire.ValueList) (map[int64]struct{}, error) {
 	}
 
 	o := make(map[int64]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetI64(), error(nil)
 		if err != nil {
@@ -1226,283 +1235,1291 @@ func (v *ContainersOfContainers) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ContainersOfContainers
-// struct.
-func (v *ContainersOfContainers) String() string {
-	if v == nil {
-		return "<nil>"
+func _List_I32_Decode(sr stream.Reader) ([]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	var fields [9]string
-	i := 0
-	if v.ListOfLists != nil {
-		fields[i] = fmt.Sprintf("ListOfLists: %v", v.ListOfLists)
-		i++
-	}
-	if v.ListOfSets != nil {
-		fields[i] = fmt.Sprintf("ListOfSets: %v", v.ListOfSets)
-		i++
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
 	}
-	if v.ListOfMaps != nil {
-		fields[i] = fmt.Sprintf("ListOfMaps: %v", v.ListOfMaps)
-		i++
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
 	}
-	if v.SetOfSets != nil {
-		fields[i] = fmt.Sprintf("SetOfSets: %v", v.SetOfSets)
-		i++
+
+	o := make([]int32, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
 	}
-	if v.SetOfLists != nil {
-		fields[i] = fmt.Sprintf("SetOfLists: %v", v.SetOfLists)
-		i++
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
 	}
-	if v.SetOfMaps != nil {
-		fields[i] = fmt.Sprintf("SetOfMaps: %v", v.SetOfMaps)
-		i++
+	return o, err
+}
+
+func _List_List_I32_Decode(sr stream.Reader) ([][]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
-	if v.MapOfMapToInt != nil {
-		fields[i] = fmt.Sprintf("MapOfMapToInt: %v", v.MapOfMapToInt)
-		i++
+
+	if lh.Type != wire.TList {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
 	}
-	if v.MapOfListToSet != nil {
-		fields[i] = fmt.Sprintf("MapOfListToSet: %v", v.MapOfListToSet)
-		i++
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
 	}
-	if v.MapOfSetToListOfDouble != nil {
-		fields[i] = fmt.Sprintf("MapOfSetToListOfDouble: %v", v.MapOfSetToListOfDouble)
-		i++
+
+	o := make([][]int32, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _List_I32_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
 	}
 
-	return fmt.Sprintf("ContainersOfContainers{%v}", strings.Join(fields[:i], ", "))
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _List_I32_Equals(lhs, rhs []int32) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _Set_I32_mapType_Decode(sr stream.Reader) (map[int32]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadSetEnd()
 	}
 
-	return true
-}
+	n := sh.Length
+	if n > 1024 {
 
-func _List_List_I32_Equals(lhs, rhs [][]int32) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !_List_I32_Equals(lv, rv) {
-			return false
+	o := make(map[int32]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
 		}
+
+		o[v] = struct{}{}
 	}
 
-	return true
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Set_I32_mapType_Equals(lhs, rhs map[int32]struct{}) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_Set_I32_mapType_Decode(sr stream.Reader) ([]map[int32]struct{}, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for x := range rhs {
-		if _, ok := lhs[x]; !ok {
-			return false
+	if lh.Type != wire.TSet {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadListEnd()
 	}
 
-	return true
-}
+	n := lh.Length
+	if n > 1024 {
 
-func _List_Set_I32_mapType_Equals(lhs, rhs []map[int32]struct{}) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !_Set_I32_mapType_Equals(lv, rv) {
-			return false
+	o := make([]map[int32]struct{}, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Set_I32_mapType_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return true
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Map_I32_I32_Equals(lhs, rhs map[int32]int32) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _Map_I32_I32_Decode(sr stream.Reader) (map[int32]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
 		}
-		if !(lv == rv) {
-			return false
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[int32]int32, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
 		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
 	}
-	return true
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _List_Map_I32_I32_Equals(lhs, rhs []map[int32]int32) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_Map_I32_I32_Decode(sr stream.Reader) ([]map[int32]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !_Map_I32_I32_Equals(lv, rv) {
-			return false
+	if lh.Type != wire.TMap {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadListEnd()
 	}
 
-	return true
-}
+	n := lh.Length
+	if n > 1024 {
 
-func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for x := range rhs {
-		if _, ok := lhs[x]; !ok {
-			return false
+	o := make([]map[int32]int32, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Map_I32_I32_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return true
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Set_Set_String_mapType_sliceType_Equals(lhs, rhs []map[string]struct{}) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for _, x := range lhs {
-		ok := false
-		for _, y := range rhs {
-			if _Set_String_mapType_Equals(x, y) {
-				ok = true
-				break
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
 			}
 		}
-		if !ok {
-			return false
-		}
+		return nil, sr.ReadSetEnd()
 	}
 
-	return true
-}
+	n := sh.Length
+	if n > 1024 {
 
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
+	o := make(map[string]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
 		}
+
+		o[v] = struct{}{}
 	}
 
-	return true
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Set_List_String_sliceType_Equals(lhs, rhs [][]string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _Set_Set_String_mapType_sliceType_Decode(sr stream.Reader) ([]map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for _, x := range lhs {
-		ok := false
-		for _, y := range rhs {
-			if _List_String_Equals(x, y) {
-				ok = true
-				break
+	if sh.Type != wire.TSet {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
 			}
 		}
-		if !ok {
-			return false
-		}
+		return nil, sr.ReadSetEnd()
 	}
 
-	return true
-}
+	n := sh.Length
+	if n > 1024 {
 
-func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !(lv == rv) {
-			return false
+	o := make([]map[string]struct{}, 0, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Set_String_mapType_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+
+		o = append(o, v)
 	}
-	return true
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Set_Map_String_String_sliceType_Equals(lhs, rhs []map[string]string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for _, x := range lhs {
-		ok := false
-		for _, y := range rhs {
-			if _Map_String_String_Equals(x, y) {
-				ok = true
-				break
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
 			}
 		}
-		if !ok {
-			return false
-		}
+		return nil, sr.ReadListEnd()
 	}
 
-	return true
-}
+	n := lh.Length
+	if n > 1024 {
 
-func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !(lv == rv) {
-			return false
+	o := make([]string, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
-	return true
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Map_Map_String_I32_I64_Equals(lhs, rhs []struct {
-	Key   map[string]int32
-	Value int64
-}) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _Set_List_String_sliceType_Decode(sr stream.Reader) ([][]string, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for _, i := range lhs {
-		lk := i.Key
-		lv := i.Value
+	if sh.Type != wire.TList {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([][]string, 0, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _List_String_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]string, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_Map_String_String_sliceType_Decode(sr stream.Reader) ([]map[string]string, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TMap {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]map[string]string, 0, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Map_String_String_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I32_Decode(sr stream.Reader) (map[string]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]int32, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Map_String_I32_I64_Decode(sr stream.Reader) ([]struct {
+	Key   map[string]int32
+	Value int64
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TMap || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]struct {
+		Key   map[string]int32
+		Value int64
+	}, 0, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Map_String_I32_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   map[string]int32
+			Value int64
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_I64_mapType_Decode(sr stream.Reader) (map[int64]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI64 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[int64]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_List_I32_Set_I64_mapType_Decode(sr stream.Reader) ([]struct {
+	Key   []int32
+	Value map[int64]struct{}
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TList || mh.ValueType != wire.TSet {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]struct {
+		Key   []int32
+		Value map[int64]struct{}
+	}, 0, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _List_I32_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Set_I64_mapType_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   []int32
+			Value map[int64]struct{}
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_Double_Decode(sr stream.Reader) ([]float64, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TDouble {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]float64, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_Set_I32_mapType_List_Double_Decode(sr stream.Reader) ([]struct {
+	Key   map[int32]struct{}
+	Value []float64
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TSet || mh.ValueType != wire.TList {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]struct {
+		Key   map[int32]struct{}
+		Value []float64
+	}, 0, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Set_I32_mapType_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _List_Double_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   map[int32]struct{}
+			Value []float64
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *ContainersOfContainers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.ListOfLists, err = _List_List_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.ListOfSets, err = _List_Set_I32_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.ListOfMaps, err = _List_Map_I32_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TSet:
+			v.SetOfSets, err = _Set_Set_String_mapType_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TSet:
+			v.SetOfLists, err = _Set_List_String_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.SetOfMaps, err = _Set_Map_String_String_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.MapOfMapToInt, err = _Map_Map_String_I32_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TMap:
+			v.MapOfListToSet, err = _Map_List_I32_Set_I64_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TMap:
+			v.MapOfSetToListOfDouble, err = _Map_Set_I32_mapType_List_Double_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ContainersOfContainers struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ContainersOfContainers) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.ListOfLists) == 0) {
+		b, err := json.Marshal(v.ListOfLists)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfLists":`)
+		buff.Write(b)
+	}
+	if !(len(v.ListOfSets) == 0) {
+		b, err := json.Marshal(v.ListOfSets)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfSets":`)
+		buff.Write(b)
+	}
+	if !(len(v.ListOfMaps) == 0) {
+		b, err := json.Marshal(v.ListOfMaps)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfMaps":`)
+		buff.Write(b)
+	}
+	if !(len(v.SetOfSets) == 0) {
+		b, err := json.Marshal(v.SetOfSets)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfSets":`)
+		buff.Write(b)
+	}
+	if !(len(v.SetOfLists) == 0) {
+		b, err := json.Marshal(v.SetOfLists)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfLists":`)
+		buff.Write(b)
+	}
+	if !(len(v.SetOfMaps) == 0) {
+		b, err := json.Marshal(v.SetOfMaps)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfMaps":`)
+		buff.Write(b)
+	}
+	if !(len(v.MapOfMapToInt) == 0) {
+		b, err := json.Marshal(v.MapOfMapToInt)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfMapToInt":`)
+		buff.Write(b)
+	}
+	if !(len(v.MapOfListToSet) == 0) {
+		b, err := json.Marshal(v.MapOfListToSet)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfListToSet":`)
+		buff.Write(b)
+	}
+	if !(len(v.MapOfSetToListOfDouble) == 0) {
+		b, err := json.Marshal(v.MapOfSetToListOfDouble)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfSetToListOfDouble":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ContainersOfContainers struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ContainersOfContainers) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["listOfLists"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfLists); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["listOfSets"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfSets); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["listOfMaps"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfMaps); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfSets"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfSets); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfLists"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfLists); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfMaps"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfMaps); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfMapToInt"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfMapToInt); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfListToSet"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfListToSet); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfSetToListOfDouble"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfSetToListOfDouble); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ContainersOfContainers
+// struct.
+func (v *ContainersOfContainers) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	if v.ListOfLists != nil {
+		fields[i] = fmt.Sprintf("ListOfLists: %v", v.ListOfLists)
+		i++
+	}
+	if v.ListOfSets != nil {
+		fields[i] = fmt.Sprintf("ListOfSets: %v", v.ListOfSets)
+		i++
+	}
+	if v.ListOfMaps != nil {
+		fields[i] = fmt.Sprintf("ListOfMaps: %v", v.ListOfMaps)
+		i++
+	}
+	if v.SetOfSets != nil {
+		fields[i] = fmt.Sprintf("SetOfSets: %v", v.SetOfSets)
+		i++
+	}
+	if v.SetOfLists != nil {
+		fields[i] = fmt.Sprintf("SetOfLists: %v", v.SetOfLists)
+		i++
+	}
+	if v.SetOfMaps != nil {
+		fields[i] = fmt.Sprintf("SetOfMaps: %v", v.SetOfMaps)
+		i++
+	}
+	if v.MapOfMapToInt != nil {
+		fields[i] = fmt.Sprintf("MapOfMapToInt: %v", v.MapOfMapToInt)
+		i++
+	}
+	if v.MapOfListToSet != nil {
+		fields[i] = fmt.Sprintf("MapOfListToSet: %v", v.MapOfListToSet)
+		i++
+	}
+	if v.MapOfSetToListOfDouble != nil {
+		fields[i] = fmt.Sprintf("MapOfSetToListOfDouble: %v", v.MapOfSetToListOfDouble)
+		i++
+	}
+
+	return fmt.Sprintf("ContainersOfContainers{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_List_I32_Equals(lhs, rhs [][]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_List_I32_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_I32_mapType_Equals(lhs, rhs map[int32]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Set_I32_mapType_Equals(lhs, rhs []map[int32]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_Set_I32_mapType_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_I32_I32_Equals(lhs, rhs map[int32]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _List_Map_I32_I32_Equals(lhs, rhs []map[int32]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_Map_I32_I32_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_Set_String_mapType_sliceType_Equals(lhs, rhs []map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if _Set_String_mapType_Equals(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_List_String_sliceType_Equals(lhs, rhs [][]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if _List_String_Equals(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_Map_String_String_sliceType_Equals(lhs, rhs []map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if _Map_String_String_Equals(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Map_String_I32_I64_Equals(lhs, rhs []struct {
+	Key   map[string]int32
+	Value int64
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
 		ok := false
 		for _, j := range rhs {
 			rk := j.Key
@@ -1569,96 +2586,373 @@ func _Map_List_I32_Set_I64_mapType_Equals(lhs, rhs []struct {
 			return false
 		}
 	}
-	return true
+	return true
+}
+
+func _List_Double_Equals(lhs, rhs []float64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_Set_I32_mapType_List_Double_Equals(lhs, rhs []struct {
+	Key   map[int32]struct{}
+	Value []float64
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !_Set_I32_mapType_Equals(lk, rk) {
+				continue
+			}
+
+			if !_List_Double_Equals(lv, rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this ContainersOfContainers match the
+// provided ContainersOfContainers.
+//
+// This function performs a deep comparison.
+func (v *ContainersOfContainers) Equals(rhs *ContainersOfContainers) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ListOfLists == nil && rhs.ListOfLists == nil) || (v.ListOfLists != nil && rhs.ListOfLists != nil && _List_List_I32_Equals(v.ListOfLists, rhs.ListOfLists))) {
+		return false
+	}
+	if !((v.ListOfSets == nil && rhs.ListOfSets == nil) || (v.ListOfSets != nil && rhs.ListOfSets != nil && _List_Set_I32_mapType_Equals(v.ListOfSets, rhs.ListOfSets))) {
+		return false
+	}
+	if !((v.ListOfMaps == nil && rhs.ListOfMaps == nil) || (v.ListOfMaps != nil && rhs.ListOfMaps != nil && _List_Map_I32_I32_Equals(v.ListOfMaps, rhs.ListOfMaps))) {
+		return false
+	}
+	if !((v.SetOfSets == nil && rhs.SetOfSets == nil) || (v.SetOfSets != nil && rhs.SetOfSets != nil && _Set_Set_String_mapType_sliceType_Equals(v.SetOfSets, rhs.SetOfSets))) {
+		return false
+	}
+	if !((v.SetOfLists == nil && rhs.SetOfLists == nil) || (v.SetOfLists != nil && rhs.SetOfLists != nil && _Set_List_String_sliceType_Equals(v.SetOfLists, rhs.SetOfLists))) {
+		return false
+	}
+	if !((v.SetOfMaps == nil && rhs.SetOfMaps == nil) || (v.SetOfMaps != nil && rhs.SetOfMaps != nil && _Set_Map_String_String_sliceType_Equals(v.SetOfMaps, rhs.SetOfMaps))) {
+		return false
+	}
+	if !((v.MapOfMapToInt == nil && rhs.MapOfMapToInt == nil) || (v.MapOfMapToInt != nil && rhs.MapOfMapToInt != nil && _Map_Map_String_I32_I64_Equals(v.MapOfMapToInt, rhs.MapOfMapToInt))) {
+		return false
+	}
+	if !((v.MapOfListToSet == nil && rhs.MapOfListToSet == nil) || (v.MapOfListToSet != nil && rhs.MapOfListToSet != nil && _Map_List_I32_Set_I64_mapType_Equals(v.MapOfListToSet, rhs.MapOfListToSet))) {
+		return false
+	}
+	if !((v.MapOfSetToListOfDouble == nil && rhs.MapOfSetToListOfDouble == nil) || (v.MapOfSetToListOfDouble != nil && rhs.MapOfSetToListOfDouble != nil && _Map_Set_I32_mapType_List_Double_Equals(v.MapOfSetToListOfDouble, rhs.MapOfSetToListOfDouble))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_I32_Clone(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_List_I32_Clone(v [][]int32) [][]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]int32, len(v))
+	for i, x := range v {
+		o[i] = _List_I32_Clone(x)
+	}
+	return o
+}
+
+func _Set_I32_mapType_Clone(v map[int32]struct{}) map[int32]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _List_Set_I32_mapType_Clone(v []map[int32]struct{}) []map[int32]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[int32]struct{}, len(v))
+	for i, x := range v {
+		o[i] = _Set_I32_mapType_Clone(x)
+	}
+	return o
+}
+
+func _Map_I32_I32_Clone(v map[int32]int32) map[int32]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]int32, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _List_Map_I32_I32_Clone(v []map[int32]int32) []map[int32]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[int32]int32, len(v))
+	for i, x := range v {
+		o[i] = _Map_I32_I32_Clone(x)
+	}
+	return o
+}
+
+func _Set_String_mapType_Clone(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Set_Set_String_mapType_sliceType_Clone(v []map[string]struct{}) []map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[string]struct{}, len(v))
+	for i, x := range v {
+		o[i] = _Set_String_mapType_Clone(x)
+	}
+
+	return o
+}
+
+func _List_String_Clone(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_List_String_sliceType_Clone(v [][]string) [][]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]string, len(v))
+	for i, x := range v {
+		o[i] = _List_String_Clone(x)
+	}
+
+	return o
+}
+
+func _Map_String_String_Clone(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Set_Map_String_String_sliceType_Clone(v []map[string]string) []map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]map[string]string, len(v))
+	for i, x := range v {
+		o[i] = _Map_String_String_Clone(x)
+	}
+
+	return o
+}
+
+func _Map_String_I32_Clone(v map[string]int32) map[string]int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_Map_String_I32_I64_Clone(v []struct {
+	Key   map[string]int32
+	Value int64
+}) []struct {
+	Key   map[string]int32
+	Value int64
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   map[string]int32
+		Value int64
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _Map_String_I32_Clone(x.Key)
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Set_I64_mapType_Clone(v map[int64]struct{}) map[int64]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_List_I32_Set_I64_mapType_Clone(v []struct {
+	Key   []int32
+	Value map[int64]struct{}
+}) []struct {
+	Key   []int32
+	Value map[int64]struct{}
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []int32
+		Value map[int64]struct{}
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _List_I32_Clone(x.Key)
+		o[i].Value = _Set_I64_mapType_Clone(x.Value)
+	}
+	return o
 }
 
-func _List_Double_Equals(lhs, rhs []float64) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_Double_Clone(v []float64) []float64 {
+	if v == nil {
+		return nil
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
+	o := make([]float64, len(v))
+	for i, x := range v {
+		o[i] = x
 	}
-
-	return true
+	return o
 }
 
-func _Map_Set_I32_mapType_List_Double_Equals(lhs, rhs []struct {
+func _Map_Set_I32_mapType_List_Double_Clone(v []struct {
 	Key   map[int32]struct{}
 	Value []float64
-}) bool {
-	if len(lhs) != len(rhs) {
-		return false
+}) []struct {
+	Key   map[int32]struct{}
+	Value []float64
+} {
+	if v == nil {
+		return nil
 	}
 
-	for _, i := range lhs {
-		lk := i.Key
-		lv := i.Value
-		ok := false
-		for _, j := range rhs {
-			rk := j.Key
-			rv := j.Value
-			if !_Set_I32_mapType_Equals(lk, rk) {
-				continue
-			}
-
-			if !_List_Double_Equals(lv, rv) {
-				return false
-			}
-			ok = true
-			break
-		}
-
-		if !ok {
-			return false
-		}
+	o := make([]struct {
+		Key   map[int32]struct{}
+		Value []float64
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _Set_I32_mapType_Clone(x.Key)
+		o[i].Value = _List_Double_Clone(x.Value)
 	}
-	return true
+	return o
 }
 
-// Equals returns true if all the fields of this ContainersOfContainers match the
-// provided ContainersOfContainers.
-//
-// This function performs a deep comparison.
-func (v *ContainersOfContainers) Equals(rhs *ContainersOfContainers) bool {
+// Clone returns a deep copy of this ContainersOfContainers. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ContainersOfContainers) Clone() *ContainersOfContainers {
 	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !((v.ListOfLists == nil && rhs.ListOfLists == nil) || (v.ListOfLists != nil && rhs.ListOfLists != nil && _List_List_I32_Equals(v.ListOfLists, rhs.ListOfLists))) {
-		return false
-	}
-	if !((v.ListOfSets == nil && rhs.ListOfSets == nil) || (v.ListOfSets != nil && rhs.ListOfSets != nil && _List_Set_I32_mapType_Equals(v.ListOfSets, rhs.ListOfSets))) {
-		return false
-	}
-	if !((v.ListOfMaps == nil && rhs.ListOfMaps == nil) || (v.ListOfMaps != nil && rhs.ListOfMaps != nil && _List_Map_I32_I32_Equals(v.ListOfMaps, rhs.ListOfMaps))) {
-		return false
-	}
-	if !((v.SetOfSets == nil && rhs.SetOfSets == nil) || (v.SetOfSets != nil && rhs.SetOfSets != nil && _Set_Set_String_mapType_sliceType_Equals(v.SetOfSets, rhs.SetOfSets))) {
-		return false
-	}
-	if !((v.SetOfLists == nil && rhs.SetOfLists == nil) || (v.SetOfLists != nil && rhs.SetOfLists != nil && _Set_List_String_sliceType_Equals(v.SetOfLists, rhs.SetOfLists))) {
-		return false
-	}
-	if !((v.SetOfMaps == nil && rhs.SetOfMaps == nil) || (v.SetOfMaps != nil && rhs.SetOfMaps != nil && _Set_Map_String_String_sliceType_Equals(v.SetOfMaps, rhs.SetOfMaps))) {
-		return false
-	}
-	if !((v.MapOfMapToInt == nil && rhs.MapOfMapToInt == nil) || (v.MapOfMapToInt != nil && rhs.MapOfMapToInt != nil && _Map_Map_String_I32_I64_Equals(v.MapOfMapToInt, rhs.MapOfMapToInt))) {
-		return false
-	}
-	if !((v.MapOfListToSet == nil && rhs.MapOfListToSet == nil) || (v.MapOfListToSet != nil && rhs.MapOfListToSet != nil && _Map_List_I32_Set_I64_mapType_Equals(v.MapOfListToSet, rhs.MapOfListToSet))) {
-		return false
-	}
-	if !((v.MapOfSetToListOfDouble == nil && rhs.MapOfSetToListOfDouble == nil) || (v.MapOfSetToListOfDouble != nil && rhs.MapOfSetToListOfDouble != nil && _Map_Set_I32_mapType_List_Double_Equals(v.MapOfSetToListOfDouble, rhs.MapOfSetToListOfDouble))) {
-		return false
+		return nil
 	}
 
-	return true
+	var c ContainersOfContainers
+	c.ListOfLists = _List_List_I32_Clone(v.ListOfLists)
+	c.ListOfSets = _List_Set_I32_mapType_Clone(v.ListOfSets)
+	c.ListOfMaps = _List_Map_I32_I32_Clone(v.ListOfMaps)
+	c.SetOfSets = _Set_Set_String_mapType_sliceType_Clone(v.SetOfSets)
+	c.SetOfLists = _Set_List_String_sliceType_Clone(v.SetOfLists)
+	c.SetOfMaps = _Set_Map_String_String_sliceType_Clone(v.SetOfMaps)
+	c.MapOfMapToInt = _Map_Map_String_I32_I64_Clone(v.MapOfMapToInt)
+	c.MapOfListToSet = _Map_List_I32_Set_I64_mapType_Clone(v.MapOfListToSet)
+	c.MapOfSetToListOfDouble = _Map_Set_I32_mapType_List_Double_Clone(v.MapOfSetToListOfDouble)
+
+	return &c
 }
 
 type _List_I32_Zapper []int32
@@ -2286,6 +3580,7 @@ func _Set_EnumWithValues_mapType_Read(s wire.ValueList) (map[enums.EnumWithValue
 	}
 
 	o := make(map[enums.EnumWithValues]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := _EnumWithValues_Read(x)
 		if err != nil {
@@ -2385,6 +3680,278 @@ func (v *EnumContainers) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _EnumDefault_Decode(sr stream.Reader) (enums.EnumDefault, error) {
+	var v enums.EnumDefault
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_EnumDefault_Decode(sr stream.Reader) ([]enums.EnumDefault, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]enums.EnumDefault, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _EnumDefault_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _EnumWithValues_Decode(sr stream.Reader) (enums.EnumWithValues, error) {
+	var v enums.EnumWithValues
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Set_EnumWithValues_mapType_Decode(sr stream.Reader) (map[enums.EnumWithValues]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[enums.EnumWithValues]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _EnumWithValues_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _EnumWithDuplicateValues_Decode(sr stream.Reader) (enums.EnumWithDuplicateValues, error) {
+	var v enums.EnumWithDuplicateValues
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _Map_EnumWithDuplicateValues_I32_Decode(sr stream.Reader) (map[enums.EnumWithDuplicateValues]int32, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[enums.EnumWithDuplicateValues]int32, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _EnumWithDuplicateValues_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *EnumContainers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.ListOfEnums, err = _List_EnumDefault_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.SetOfEnums, err = _Set_EnumWithValues_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.MapOfEnums, err = _Map_EnumWithDuplicateValues_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a EnumContainers struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *EnumContainers) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.ListOfEnums) == 0) {
+		b, err := json.Marshal(v.ListOfEnums)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfEnums":`)
+		buff.Write(b)
+	}
+	if !(len(v.SetOfEnums) == 0) {
+		b, err := json.Marshal(v.SetOfEnums)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfEnums":`)
+		buff.Write(b)
+	}
+	if !(len(v.MapOfEnums) == 0) {
+		b, err := json.Marshal(v.MapOfEnums)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfEnums":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a EnumContainers struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *EnumContainers) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["listOfEnums"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfEnums); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfEnums"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfEnums); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfEnums"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfEnums); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a EnumContainers
 // struct.
 func (v *EnumContainers) String() string {
@@ -2456,27 +4023,81 @@ func _Map_EnumWithDuplicateValues_I32_Equals(lhs, rhs map[enums.EnumWithDuplicat
 	return true
 }
 
-// Equals returns true if all the fields of this EnumContainers match the
-// provided EnumContainers.
-//
-// This function performs a deep comparison.
-func (v *EnumContainers) Equals(rhs *EnumContainers) bool {
+// Equals returns true if all the fields of this EnumContainers match the
+// provided EnumContainers.
+//
+// This function performs a deep comparison.
+func (v *EnumContainers) Equals(rhs *EnumContainers) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ListOfEnums == nil && rhs.ListOfEnums == nil) || (v.ListOfEnums != nil && rhs.ListOfEnums != nil && _List_EnumDefault_Equals(v.ListOfEnums, rhs.ListOfEnums))) {
+		return false
+	}
+	if !((v.SetOfEnums == nil && rhs.SetOfEnums == nil) || (v.SetOfEnums != nil && rhs.SetOfEnums != nil && _Set_EnumWithValues_mapType_Equals(v.SetOfEnums, rhs.SetOfEnums))) {
+		return false
+	}
+	if !((v.MapOfEnums == nil && rhs.MapOfEnums == nil) || (v.MapOfEnums != nil && rhs.MapOfEnums != nil && _Map_EnumWithDuplicateValues_I32_Equals(v.MapOfEnums, rhs.MapOfEnums))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_EnumDefault_Clone(v []enums.EnumDefault) []enums.EnumDefault {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]enums.EnumDefault, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_EnumWithValues_mapType_Clone(v map[enums.EnumWithValues]struct{}) map[enums.EnumWithValues]struct{} {
 	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+		return nil
 	}
-	if !((v.ListOfEnums == nil && rhs.ListOfEnums == nil) || (v.ListOfEnums != nil && rhs.ListOfEnums != nil && _List_EnumDefault_Equals(v.ListOfEnums, rhs.ListOfEnums))) {
-		return false
+
+	o := make(map[enums.EnumWithValues]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
 	}
-	if !((v.SetOfEnums == nil && rhs.SetOfEnums == nil) || (v.SetOfEnums != nil && rhs.SetOfEnums != nil && _Set_EnumWithValues_mapType_Equals(v.SetOfEnums, rhs.SetOfEnums))) {
-		return false
+
+	return o
+}
+
+func _Map_EnumWithDuplicateValues_I32_Clone(v map[enums.EnumWithDuplicateValues]int32) map[enums.EnumWithDuplicateValues]int32 {
+	if v == nil {
+		return nil
 	}
-	if !((v.MapOfEnums == nil && rhs.MapOfEnums == nil) || (v.MapOfEnums != nil && rhs.MapOfEnums != nil && _Map_EnumWithDuplicateValues_I32_Equals(v.MapOfEnums, rhs.MapOfEnums))) {
-		return false
+
+	o := make(map[enums.EnumWithDuplicateValues]int32, len(v))
+
+	for k, x := range v {
+		o[k] = x
 	}
+	return o
+}
 
-	return true
+// Clone returns a deep copy of this EnumContainers. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *EnumContainers) Clone() *EnumContainers {
+	if v == nil {
+		return nil
+	}
+
+	var c EnumContainers
+	c.ListOfEnums = _List_EnumDefault_Clone(v.ListOfEnums)
+	c.SetOfEnums = _Set_EnumWithValues_mapType_Clone(v.SetOfEnums)
+	c.MapOfEnums = _Map_EnumWithDuplicateValues_I32_Clone(v.MapOfEnums)
+
+	return &c
 }
 
 type _List_EnumDefault_Zapper []enums.EnumDefault
@@ -2761,6 +4382,8 @@ func (v *ListOfConflictingEnums) FromWire(w wire.Value) error {
 	recordsIsSet := false
 	otherRecordsIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -2782,6 +4405,147 @@ func (v *ListOfConflictingEnums) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !recordsIsSet {
+		missing.Add("ListOfConflictingEnums", "Records")
+	}
+
+	if !otherRecordsIsSet {
+		missing.Add("ListOfConflictingEnums", "OtherRecords")
+	}
+
+	return missing.Err()
+}
+
+func _RecordType_Decode(sr stream.Reader) (enum_conflict.RecordType, error) {
+	var v enum_conflict.RecordType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_RecordType_Decode(sr stream.Reader) ([]enum_conflict.RecordType, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]enum_conflict.RecordType, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _RecordType_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _RecordType_1_Decode(sr stream.Reader) (enums.RecordType, error) {
+	var v enums.RecordType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_RecordType_1_Decode(sr stream.Reader) ([]enums.RecordType, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]enums.RecordType, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _RecordType_1_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *ListOfConflictingEnums) Decode(sr stream.Reader) error {
+	recordsIsSet := false
+	otherRecordsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Records, err = _List_RecordType_Decode(sr)
+			if err != nil {
+				return err
+			}
+			recordsIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.OtherRecords, err = _List_RecordType_1_Decode(sr)
+			if err != nil {
+				return err
+			}
+			otherRecordsIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !recordsIsSet {
 		return errors.New("field Records of ListOfConflictingEnums is required")
 	}
@@ -2793,6 +4557,72 @@ func (v *ListOfConflictingEnums) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListOfConflictingEnums struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ListOfConflictingEnums) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Records)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"records":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.OtherRecords)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"otherRecords":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ListOfConflictingEnums struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ListOfConflictingEnums) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["records"]; ok {
+		if err := json.Unmarshal(r, &v.Records); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["otherRecords"]; ok {
+		if err := json.Unmarshal(r, &v.OtherRecords); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a ListOfConflictingEnums
 // struct.
 func (v *ListOfConflictingEnums) String() string {
@@ -2860,6 +4690,45 @@ func (v *ListOfConflictingEnums) Equals(rhs *ListOfConflictingEnums) bool {
 	return true
 }
 
+func _List_RecordType_Clone(v []enum_conflict.RecordType) []enum_conflict.RecordType {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]enum_conflict.RecordType, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_RecordType_1_Clone(v []enums.RecordType) []enums.RecordType {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]enums.RecordType, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this ListOfConflictingEnums. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ListOfConflictingEnums) Clone() *ListOfConflictingEnums {
+	if v == nil {
+		return nil
+	}
+
+	var c ListOfConflictingEnums
+	c.Records = _List_RecordType_Clone(v.Records)
+	c.OtherRecords = _List_RecordType_1_Clone(v.OtherRecords)
+
+	return &c
+}
+
 type _List_RecordType_Zapper []enum_conflict.RecordType
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -3038,15 +4907,19 @@ func _List_UUID_Read(l wire.ValueList) ([]*typedefs.UUID, error) {
 	}
 
 	o := make([]*typedefs.UUID, 0, l.Size())
+	var missing required.Errors
 	err := l.ForEach(func(x wire.Value) error {
 		i, err := _UUID_Read(x)
-		if err != nil {
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 		o = append(o, i)
 		return nil
 	})
 	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
 	return o, err
 }
 
@@ -3065,57 +4938,200 @@ func _List_UUID_1_Read(l wire.ValueList) ([]uuid_conflict.UUID, error) {
 	err := l.ForEach(func(x wire.Value) error {
 		i, err := _UUID_1_Read(x)
 		if err != nil {
-			return err
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListOfConflictingUUIDs struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListOfConflictingUUIDs struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListOfConflictingUUIDs
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListOfConflictingUUIDs) FromWire(w wire.Value) error {
+	var err error
+
+	uuidsIsSet := false
+	otherUUIDsIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Uuids, err = _List_UUID_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				uuidsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.OtherUUIDs, err = _List_UUID_1_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				otherUUIDsIsSet = true
+			}
+		}
+	}
+
+	if !uuidsIsSet {
+		missing.Add("ListOfConflictingUUIDs", "Uuids")
+	}
+
+	if !otherUUIDsIsSet {
+		missing.Add("ListOfConflictingUUIDs", "OtherUUIDs")
+	}
+
+	return missing.Err()
+}
+
+func _UUID_Decode(sr stream.Reader) (*typedefs.UUID, error) {
+	var x typedefs.UUID
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _List_UUID_Decode(sr stream.Reader) ([]*typedefs.UUID, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*typedefs.UUID, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _UUID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _UUID_1_Decode(sr stream.Reader) (uuid_conflict.UUID, error) {
+	var x uuid_conflict.UUID
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _List_UUID_1_Decode(sr stream.Reader) ([]uuid_conflict.UUID, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]uuid_conflict.UUID, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _UUID_1_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
 	return o, err
 }
 
-// FromWire deserializes a ListOfConflictingUUIDs struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ListOfConflictingUUIDs struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ListOfConflictingUUIDs
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ListOfConflictingUUIDs) FromWire(w wire.Value) error {
-	var err error
-
+func (v *ListOfConflictingUUIDs) Decode(sr stream.Reader) error {
 	uuidsIsSet := false
 	otherUUIDsIsSet := false
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TList {
-				v.Uuids, err = _List_UUID_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-				uuidsIsSet = true
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Uuids, err = _List_UUID_Decode(sr)
+			if err != nil {
+				return err
 			}
-		case 2:
-			if field.Value.Type() == wire.TList {
-				v.OtherUUIDs, err = _List_UUID_1_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-				otherUUIDsIsSet = true
+			uuidsIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.OtherUUIDs, err = _List_UUID_1_Decode(sr)
+			if err != nil {
+				return err
+			}
+			otherUUIDsIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
 			}
 		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
 	if !uuidsIsSet {
@@ -3129,6 +5145,72 @@ func (v *ListOfConflictingUUIDs) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListOfConflictingUUIDs struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ListOfConflictingUUIDs) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Uuids)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"uuids":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.OtherUUIDs)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"otherUUIDs":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ListOfConflictingUUIDs struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ListOfConflictingUUIDs) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["uuids"]; ok {
+		if err := json.Unmarshal(r, &v.Uuids); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["otherUUIDs"]; ok {
+		if err := json.Unmarshal(r, &v.OtherUUIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a ListOfConflictingUUIDs
 // struct.
 func (v *ListOfConflictingUUIDs) String() string {
@@ -3196,6 +5278,45 @@ func (v *ListOfConflictingUUIDs) Equals(rhs *ListOfConflictingUUIDs) bool {
 	return true
 }
 
+func _List_UUID_Clone(v []*typedefs.UUID) []*typedefs.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*typedefs.UUID, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _List_UUID_1_Clone(v []uuid_conflict.UUID) []uuid_conflict.UUID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]uuid_conflict.UUID, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this ListOfConflictingUUIDs. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ListOfConflictingUUIDs) Clone() *ListOfConflictingUUIDs {
+	if v == nil {
+		return nil
+	}
+
+	var c ListOfConflictingUUIDs
+	c.Uuids = _List_UUID_Clone(v.Uuids)
+	c.OtherUUIDs = _List_UUID_1_Clone(v.OtherUUIDs)
+
+	return &c
+}
+
 type _List_UUID_Zapper []*typedefs.UUID
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -3431,67 +5552,281 @@ func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
 		return nil, nil
 	}
 
-	if m.ValueType() != wire.TBinary {
-		return nil, nil
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a MapOfBinaryAndString struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MapOfBinaryAndString struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MapOfBinaryAndString
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MapOfBinaryAndString) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.BinaryToString, err = _Map_Binary_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.StringToBinary, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Map_Binary_String_Decode(sr stream.Reader) ([]struct {
+	Key   []byte
+	Value string
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, 0, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   []byte
+			Value string
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string][]byte, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *MapOfBinaryAndString) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.BinaryToString, err = _Map_Binary_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TMap:
+			v.StringToBinary, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a MapOfBinaryAndString struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *MapOfBinaryAndString) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
 	}
 
-	o := make(map[string][]byte, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.BinaryToString) == 0) {
+		b, err := json.Marshal(v.BinaryToString)
 		if err != nil {
-			return err
+			return nil, err
 		}
-
-		v, err := x.Value.GetBinary(), error(nil)
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"binaryToString":`)
+		buff.Write(b)
+	}
+	if !(len(v.StringToBinary) == 0) {
+		b, err := json.Marshal(v.StringToBinary)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"stringToBinary":`)
+		buff.Write(b)
+	}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-// FromWire deserializes a MapOfBinaryAndString struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a MapOfBinaryAndString struct
-// from the provided intermediate representation.
+// UnmarshalJSON deserializes a MapOfBinaryAndString struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
 //
-//   var v MapOfBinaryAndString
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *MapOfBinaryAndString) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TMap {
-				v.BinaryToString, err = _Map_Binary_String_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 2:
-			if field.Value.Type() == wire.TMap {
-				v.StringToBinary, err = _Map_String_Binary_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
+// This implements json.Unmarshaler.
+func (v *MapOfBinaryAndString) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["binaryToString"]; ok {
+		if err := json.Unmarshal(r, &v.BinaryToString); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["stringToBinary"]; ok {
+		if err := json.Unmarshal(r, &v.StringToBinary); err != nil {
+			return err
 		}
 	}
 
@@ -3589,6 +5924,63 @@ func (v *MapOfBinaryAndString) Equals(rhs *MapOfBinaryAndString) bool {
 	return true
 }
 
+func _Binary_Clone(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(v)), v...)
+}
+
+func _Map_Binary_String_Clone(v []struct {
+	Key   []byte
+	Value string
+}) []struct {
+	Key   []byte
+	Value string
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = _Binary_Clone(x.Key)
+		o[i].Value = x.Value
+	}
+	return o
+}
+
+func _Map_String_Binary_Clone(v map[string][]byte) map[string][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]byte, len(v))
+
+	for k, x := range v {
+		o[k] = _Binary_Clone(x)
+	}
+	return o
+}
+
+// Clone returns a deep copy of this MapOfBinaryAndString. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *MapOfBinaryAndString) Clone() *MapOfBinaryAndString {
+	if v == nil {
+		return nil
+	}
+
+	var c MapOfBinaryAndString
+	c.BinaryToString = _Map_Binary_String_Clone(v.BinaryToString)
+	c.StringToBinary = _Map_String_Binary_Clone(v.StringToBinary)
+
+	return &c
+}
+
 type _Map_Binary_String_Item_Zapper struct {
 	Key   []byte
 	Value string
@@ -3954,6 +6346,7 @@ func _Set_Byte_mapType_Read(s wire.ValueList) (map[int8]struct{}, error) {
 	}
 
 	o := make(map[int8]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetI8(), error(nil)
 		if err != nil {
@@ -4053,47 +6446,449 @@ func (v *PrimitiveContainers) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 2:
-			if field.Value.Type() == wire.TList {
-				v.ListOfInts, err = _List_I64_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.ListOfInts, err = _List_I64_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TSet {
+				v.SetOfStrings, err = _Set_String_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TSet {
+				v.SetOfBytes, err = _Set_Byte_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.MapOfIntToString, err = _Map_I32_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.MapOfStringToBool, err = _Map_String_Bool_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_Binary_Decode(sr stream.Reader) ([][]byte, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([][]byte, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_I64_Decode(sr stream.Reader) ([]int64, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI64 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]int64, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_Byte_mapType_Decode(sr stream.Reader) (map[int8]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI8 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[int8]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadInt8()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_I32_String_Decode(sr stream.Reader) (map[int32]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[int32]string, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_Bool_Decode(sr stream.Reader) (map[string]bool, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBool {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]bool, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadBool()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *PrimitiveContainers) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.ListOfBinary, err = _List_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
 
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.ListOfInts, err = _List_I64_Decode(sr)
+			if err != nil {
+				return err
 			}
-		case 3:
-			if field.Value.Type() == wire.TSet {
-				v.SetOfStrings, err = _Set_String_mapType_Read(field.Value.GetSet())
-				if err != nil {
-					return err
-				}
 
+		case fh.ID == 3 && fh.Type == wire.TSet:
+			v.SetOfStrings, err = _Set_String_mapType_Decode(sr)
+			if err != nil {
+				return err
 			}
-		case 4:
-			if field.Value.Type() == wire.TSet {
-				v.SetOfBytes, err = _Set_Byte_mapType_Read(field.Value.GetSet())
-				if err != nil {
-					return err
-				}
 
+		case fh.ID == 4 && fh.Type == wire.TSet:
+			v.SetOfBytes, err = _Set_Byte_mapType_Decode(sr)
+			if err != nil {
+				return err
 			}
-		case 5:
-			if field.Value.Type() == wire.TMap {
-				v.MapOfIntToString, err = _Map_I32_String_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
 
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.MapOfIntToString, err = _Map_I32_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TMap:
+			v.MapOfStringToBool, err = _Map_String_Bool_Decode(sr)
+			if err != nil {
+				return err
 			}
-		case 6:
-			if field.Value.Type() == wire.TMap {
-				v.MapOfStringToBool, err = _Map_String_Bool_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
 
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
 			}
 		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a PrimitiveContainers struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PrimitiveContainers) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.ListOfBinary) == 0) {
+		b, err := json.Marshal(v.ListOfBinary)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfBinary":`)
+		buff.Write(b)
+	}
+	if !(len(v.ListOfInts) == 0) {
+		b, err := json.Marshal(v.ListOfInts)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfInts":`)
+		buff.Write(b)
+	}
+	if !(len(v.SetOfStrings) == 0) {
+		b, err := json.Marshal(v.SetOfStrings)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfStrings":`)
+		buff.Write(b)
+	}
+	if !(len(v.SetOfBytes) == 0) {
+		b, err := json.Marshal(v.SetOfBytes)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfBytes":`)
+		buff.Write(b)
+	}
+	if !(len(v.MapOfIntToString) == 0) {
+		b, err := json.Marshal(v.MapOfIntToString)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfIntToString":`)
+		buff.Write(b)
+	}
+	if !(len(v.MapOfStringToBool) == 0) {
+		b, err := json.Marshal(v.MapOfStringToBool)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfStringToBool":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PrimitiveContainers struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PrimitiveContainers) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["listOfBinary"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfBinary); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["listOfInts"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfInts); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfStrings"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfStrings); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfBytes"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfBytes); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfIntToString"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfIntToString); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfStringToBool"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfStringToBool); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -4246,6 +7041,88 @@ func (v *PrimitiveContainers) Equals(rhs *PrimitiveContainers) bool {
 	return true
 }
 
+func _List_Binary_Clone(v [][]byte) [][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]byte, len(v))
+	for i, x := range v {
+		o[i] = _Binary_Clone(x)
+	}
+	return o
+}
+
+func _List_I64_Clone(v []int64) []int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int64, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_Byte_mapType_Clone(v map[int8]struct{}) map[int8]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int8]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_I32_String_Clone(v map[int32]string) map[int32]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]string, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+func _Map_String_Bool_Clone(v map[string]bool) map[string]bool {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]bool, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this PrimitiveContainers. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PrimitiveContainers) Clone() *PrimitiveContainers {
+	if v == nil {
+		return nil
+	}
+
+	var c PrimitiveContainers
+	c.ListOfBinary = _List_Binary_Clone(v.ListOfBinary)
+	c.ListOfInts = _List_I64_Clone(v.ListOfInts)
+	c.SetOfStrings = _Set_String_mapType_Clone(v.SetOfStrings)
+	c.SetOfBytes = _Set_Byte_mapType_Clone(v.SetOfBytes)
+	c.MapOfIntToString = _Map_I32_String_Clone(v.MapOfIntToString)
+	c.MapOfStringToBool = _Map_String_Bool_Clone(v.MapOfStringToBool)
+
+	return &c
+}
+
 type _List_Binary_Zapper [][]byte
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -4578,6 +7455,8 @@ func (v *PrimitiveContainersRequired) FromWire(w wire.Value) error {
 	setOfIntsIsSet := false
 	mapOfIntsToDoublesIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -4607,6 +7486,120 @@ func (v *PrimitiveContainersRequired) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !listOfStringsIsSet {
+		missing.Add("PrimitiveContainersRequired", "ListOfStrings")
+	}
+
+	if !setOfIntsIsSet {
+		missing.Add("PrimitiveContainersRequired", "SetOfInts")
+	}
+
+	if !mapOfIntsToDoublesIsSet {
+		missing.Add("PrimitiveContainersRequired", "MapOfIntsToDoubles")
+	}
+
+	return missing.Err()
+}
+
+func _Map_I64_Double_Decode(sr stream.Reader) (map[int64]float64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI64 || mh.ValueType != wire.TDouble {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[int64]float64, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *PrimitiveContainersRequired) Decode(sr stream.Reader) error {
+	listOfStringsIsSet := false
+	setOfIntsIsSet := false
+	mapOfIntsToDoublesIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.ListOfStrings, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			listOfStringsIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.SetOfInts, err = _Set_I32_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+			setOfIntsIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.MapOfIntsToDoubles, err = _Map_I64_Double_Decode(sr)
+			if err != nil {
+				return err
+			}
+			mapOfIntsToDoublesIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !listOfStringsIsSet {
 		return errors.New("field ListOfStrings of PrimitiveContainersRequired is required")
 	}
@@ -4622,6 +7615,88 @@ func (v *PrimitiveContainersRequired) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a PrimitiveContainersRequired struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PrimitiveContainersRequired) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.ListOfStrings)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfStrings":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.SetOfInts)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfInts":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.MapOfIntsToDoubles)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfIntsToDoubles":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PrimitiveContainersRequired struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PrimitiveContainersRequired) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["listOfStrings"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfStrings); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfInts"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfInts); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfIntsToDoubles"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfIntsToDoubles); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a PrimitiveContainersRequired
 // struct.
 func (v *PrimitiveContainersRequired) String() string {
@@ -4681,6 +7756,35 @@ func (v *PrimitiveContainersRequired) Equals(rhs *PrimitiveContainersRequired) b
 	return true
 }
 
+func _Map_I64_Double_Clone(v map[int64]float64) map[int64]float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]float64, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this PrimitiveContainersRequired. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PrimitiveContainersRequired) Clone() *PrimitiveContainersRequired {
+	if v == nil {
+		return nil
+	}
+
+	var c PrimitiveContainersRequired
+	c.ListOfStrings = _List_String_Clone(v.ListOfStrings)
+	c.SetOfInts = _Set_I32_mapType_Clone(v.SetOfInts)
+	c.MapOfIntsToDoubles = _Map_I64_Double_Clone(v.MapOfIntsToDoubles)
+
+	return &c
+}
+
 type _Map_I64_Double_Item_Zapper struct {
 	Key   int64
 	Value float64
@@ -4765,6 +7869,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/containers",
 	FilePath: "containers.thrift",
 	SHA1:     "bb2b06a31ccbbcfce43163a9b0d50f109e21a24b",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		enum_conflict.ThriftModule,
 		enums.ThriftModule,
@@ -4775,3 +7880,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./enums.thrift\"\ninclude \"./enum_conflict.thrift\"\ninclude \"./typedefs.thrift\"\ninclude \"./uuid_conflict.thrift\"\n\nstruct PrimitiveContainers {\n    1: optional list<binary> listOfBinary\n    2: optional list<i64> listOfInts\n    3: optional set<string> setOfStrings\n    4: optional set<byte> setOfBytes\n    5: optional map<i32, string> mapOfIntToString\n    6: optional map<string, bool> mapOfStringToBool\n}\n\nstruct PrimitiveContainersRequired {\n    1: required list<string> listOfStrings\n    2: required set<i32> setOfInts\n    3: required map<i64, double> mapOfIntsToDoubles\n}\n\nstruct EnumContainers {\n    1: optional list<enums.EnumDefault> listOfEnums\n    2: optional set<enums.EnumWithValues> setOfEnums\n    3: optional map<enums.EnumWithDuplicateValues, i32> mapOfEnums\n}\n\nstruct ContainersOfContainers {\n    1: optional list<list<i32>> listOfLists;\n    2: optional list<set<i32>> listOfSets;\n    3: optional list<map<i32, i32>> listOfMaps;\n\n    4: optional set<set<string>> setOfSets;\n    5: optional set<list<string>> setOfLists;\n    6: optional set<map<string, string>> setOfMaps;\n\n    7: optional map<map<string, i32>, i64> mapOfMapToInt;\n    8: optional map<list<i32>, set<i64>> mapOfListToSet;\n    9: optional map<set<i32>, list<double>> mapOfSetToListOfDouble;\n}\n\nstruct MapOfBinaryAndString {\n    1: optional map<binary, string> binaryToString;\n    2: optional map<string, binary> stringToBinary;\n}\n\nstruct ListOfConflictingEnums {\n    1: required list<enum_conflict.RecordType> records\n    2: required list<enums.RecordType> otherRecords\n}\n\nstruct ListOfConflictingUUIDs {\n    1: required list<typedefs.UUID> uuids\n    2: required list<uuid_conflict.UUID> otherUUIDs\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
+	stream "go.uber.org/thriftrw/protocol/stream"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -122,6 +123,24 @@ func (v *RecordType) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded RecordType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v RecordType
+//   if err := v.Decode(sReader); err != nil {
+//     return RecordType(0), err
+//   }
+//   return v, nil
+func (v *RecordType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (RecordType)(i)
+	return nil
+}
+
 // String returns a readable string representation of RecordType.
 func (v RecordType) String() string {
 	w := int32(v)
@@ -322,6 +341,143 @@ func (v *Records) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _RecordType_Decode(sr stream.Reader) (RecordType, error) {
+	var v RecordType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _RecordType_1_Decode(sr stream.Reader) (enums.RecordType, error) {
+	var v enums.RecordType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func (v *Records) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x RecordType
+			x, err = _RecordType_Decode(sr)
+			v.RecordType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x enums.RecordType
+			x, err = _RecordType_1_Decode(sr)
+			v.OtherRecordType = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.RecordType == nil {
+		v.RecordType = _RecordType_ptr(DefaultRecordType)
+	}
+
+	if v.OtherRecordType == nil {
+		v.OtherRecordType = _RecordType_1_ptr(DefaultOtherRecordType)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Records struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Records) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.RecordType == nil) {
+		b, err := json.Marshal(v.RecordType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"recordType":`)
+		buff.Write(b)
+	}
+	if !(v.OtherRecordType == nil) {
+		b, err := json.Marshal(v.OtherRecordType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"otherRecordType":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Records struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Records) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["recordType"]; ok {
+		if err := json.Unmarshal(r, &v.RecordType); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["otherRecordType"]; ok {
+		if err := json.Unmarshal(r, &v.OtherRecordType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Records
 // struct.
 func (v *Records) String() string {
@@ -383,6 +539,39 @@ func (v *Records) Equals(rhs *Records) bool {
 	return true
 }
 
+func _RecordType_ClonePtr(v *RecordType) *RecordType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _RecordType_1_ClonePtr(v *enums.RecordType) *enums.RecordType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Records. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Records) Clone() *Records {
+	if v == nil {
+		return nil
+	}
+
+	var c Records
+	c.RecordType = _RecordType_ClonePtr(v.RecordType)
+	c.OtherRecordType = _RecordType_1_ClonePtr(v.OtherRecordType)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Records.
 func (v *Records) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -434,6 +623,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/enum_conflict",
 	FilePath: "enum_conflict.thrift",
 	SHA1:     "75e0e6472e2f0c74412512d61531cf1a0da7429c",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		enums.ThriftModule,
 	},
@@ -441,3 +631,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./enums.thrift\"\n\nenum RecordType {\n    Name, Email\n}\n\nconst RecordType defaultRecordType = RecordType.Name\n\nconst enums.RecordType defaultOtherRecordType = enums.RecordType.NAME\n\nstruct Records {\n    1: optional RecordType recordType = defaultRecordType\n    2: optional enums.RecordType otherRecordType = defaultOtherRecordType\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
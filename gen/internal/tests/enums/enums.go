@@ -8,6 +8,7 @@ import (
 	json "encoding/json"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -16,6 +17,226 @@ import (
 	strings "strings"
 )
 
+// Statuses of an account.
+type AccountStatus int32
+
+const (
+	AccountStatusActive AccountStatus = 0
+	// The account may not be used until it is restored.
+	//
+	// Deprecated: Use DISABLED instead.
+	AccountStatusSuspended AccountStatus = 1
+	// Deprecated: Do not use.
+	AccountStatusClosed   AccountStatus = 2
+	AccountStatusDisabled AccountStatus = 3
+)
+
+// AccountStatus_Values returns all recognized values of AccountStatus.
+func AccountStatus_Values() []AccountStatus {
+	return []AccountStatus{
+		AccountStatusActive,
+		AccountStatusSuspended,
+		AccountStatusClosed,
+		AccountStatusDisabled,
+	}
+}
+
+// UnmarshalText tries to decode AccountStatus from a byte slice
+// containing its name.
+//
+//   var v AccountStatus
+//   err := v.UnmarshalText([]byte("ACTIVE"))
+func (v *AccountStatus) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "ACTIVE":
+		*v = AccountStatusActive
+		return nil
+	case "SUSPENDED":
+		*v = AccountStatusSuspended
+		return nil
+	case "CLOSED":
+		*v = AccountStatusClosed
+		return nil
+	case "DISABLED":
+		*v = AccountStatusDisabled
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "AccountStatus", err)
+		}
+		*v = AccountStatus(val)
+		return nil
+	}
+}
+
+// MarshalText encodes AccountStatus to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v AccountStatus) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("ACTIVE"), nil
+	case 1:
+		return []byte("SUSPENDED"), nil
+	case 2:
+		return []byte("CLOSED"), nil
+	case 3:
+		return []byte("DISABLED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AccountStatus.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v AccountStatus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ACTIVE")
+	case 1:
+		enc.AddString("name", "SUSPENDED")
+	case 2:
+		enc.AddString("name", "CLOSED")
+	case 3:
+		enc.AddString("name", "DISABLED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v AccountStatus) Ptr() *AccountStatus {
+	return &v
+}
+
+// ToWire translates AccountStatus into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v AccountStatus) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes AccountStatus from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return AccountStatus(0), err
+//   }
+//
+//   var v AccountStatus
+//   if err := v.FromWire(x); err != nil {
+//     return AccountStatus(0), err
+//   }
+//   return v, nil
+func (v *AccountStatus) FromWire(w wire.Value) error {
+	*v = (AccountStatus)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded AccountStatus directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v AccountStatus
+//   if err := v.Decode(sReader); err != nil {
+//     return AccountStatus(0), err
+//   }
+//   return v, nil
+func (v *AccountStatus) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (AccountStatus)(i)
+	return nil
+}
+
+// String returns a readable string representation of AccountStatus.
+func (v AccountStatus) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ACTIVE"
+	case 1:
+		return "SUSPENDED"
+	case 2:
+		return "CLOSED"
+	case 3:
+		return "DISABLED"
+	}
+	return fmt.Sprintf("AccountStatus(%d)", w)
+}
+
+// Equals returns true if this AccountStatus value matches the provided
+// value.
+func (v AccountStatus) Equals(rhs AccountStatus) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes AccountStatus into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v AccountStatus) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ACTIVE\""), nil
+	case 1:
+		return ([]byte)("\"SUSPENDED\""), nil
+	case 2:
+		return ([]byte)("\"CLOSED\""), nil
+	case 3:
+		return ([]byte)("\"DISABLED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode AccountStatus from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *AccountStatus) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "AccountStatus")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "AccountStatus")
+		}
+		*v = (AccountStatus)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "AccountStatus")
+	}
+}
+
 type EmptyEnum int32
 
 // EmptyEnum_Values returns all recognized values of EmptyEnum.
@@ -88,6 +309,24 @@ func (v *EmptyEnum) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded EmptyEnum directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EmptyEnum
+//   if err := v.Decode(sReader); err != nil {
+//     return EmptyEnum(0), err
+//   }
+//   return v, nil
+func (v *EmptyEnum) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (EmptyEnum)(i)
+	return nil
+}
+
 // String returns a readable string representation of EmptyEnum.
 func (v EmptyEnum) String() string {
 	w := int32(v)
@@ -256,6 +495,24 @@ func (v *EnumDefault) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded EnumDefault directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EnumDefault
+//   if err := v.Decode(sReader); err != nil {
+//     return EnumDefault(0), err
+//   }
+//   return v, nil
+func (v *EnumDefault) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (EnumDefault)(i)
+	return nil
+}
+
 // String returns a readable string representation of EnumDefault.
 func (v EnumDefault) String() string {
 	w := int32(v)
@@ -494,6 +751,24 @@ func (v *EnumWithDuplicateName) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded EnumWithDuplicateName directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EnumWithDuplicateName
+//   if err := v.Decode(sReader); err != nil {
+//     return EnumWithDuplicateName(0), err
+//   }
+//   return v, nil
+func (v *EnumWithDuplicateName) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (EnumWithDuplicateName)(i)
+	return nil
+}
+
 // String returns a readable string representation of EnumWithDuplicateName.
 func (v EnumWithDuplicateName) String() string {
 	w := int32(v)
@@ -698,6 +973,24 @@ func (v *EnumWithDuplicateValues) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded EnumWithDuplicateValues directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EnumWithDuplicateValues
+//   if err := v.Decode(sReader); err != nil {
+//     return EnumWithDuplicateValues(0), err
+//   }
+//   return v, nil
+func (v *EnumWithDuplicateValues) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (EnumWithDuplicateValues)(i)
+	return nil
+}
+
 // String returns a readable string representation of EnumWithDuplicateValues.
 func (v EnumWithDuplicateValues) String() string {
 	w := int32(v)
@@ -905,6 +1198,24 @@ func (v *EnumWithLabel) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded EnumWithLabel directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EnumWithLabel
+//   if err := v.Decode(sReader); err != nil {
+//     return EnumWithLabel(0), err
+//   }
+//   return v, nil
+func (v *EnumWithLabel) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (EnumWithLabel)(i)
+	return nil
+}
+
 // String returns a readable string representation of EnumWithLabel.
 func (v EnumWithLabel) String() string {
 	w := int32(v)
@@ -1101,6 +1412,24 @@ func (v *EnumWithValues) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded EnumWithValues directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EnumWithValues
+//   if err := v.Decode(sReader); err != nil {
+//     return EnumWithValues(0), err
+//   }
+//   return v, nil
+func (v *EnumWithValues) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (EnumWithValues)(i)
+	return nil
+}
+
 // String returns a readable string representation of EnumWithValues.
 func (v EnumWithValues) String() string {
 	w := int32(v)
@@ -1293,6 +1622,24 @@ func (v *RecordType) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded RecordType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v RecordType
+//   if err := v.Decode(sReader); err != nil {
+//     return RecordType(0), err
+//   }
+//   return v, nil
+func (v *RecordType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (RecordType)(i)
+	return nil
+}
+
 // String returns a readable string representation of RecordType.
 func (v RecordType) String() string {
 	w := int32(v)
@@ -1468,6 +1815,24 @@ func (v *RecordTypeValues) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded RecordTypeValues directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v RecordTypeValues
+//   if err := v.Decode(sReader); err != nil {
+//     return RecordTypeValues(0), err
+//   }
+//   return v, nil
+func (v *RecordTypeValues) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (RecordTypeValues)(i)
+	return nil
+}
+
 // String returns a readable string representation of RecordTypeValues.
 func (v RecordTypeValues) String() string {
 	w := int32(v)
@@ -1621,6 +1986,105 @@ func (v *StructWithOptionalEnum) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _EnumDefault_Decode(sr stream.Reader) (EnumDefault, error) {
+	var v EnumDefault
+	err := v.Decode(sr)
+	return v, err
+}
+
+func (v *StructWithOptionalEnum) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x EnumDefault
+			x, err = _EnumDefault_Decode(sr)
+			v.E = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a StructWithOptionalEnum struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *StructWithOptionalEnum) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.E == nil) {
+		b, err := json.Marshal(v.E)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"e":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a StructWithOptionalEnum struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *StructWithOptionalEnum) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["e"]; ok {
+		if err := json.Unmarshal(r, &v.E); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a StructWithOptionalEnum
 // struct.
 func (v *StructWithOptionalEnum) String() string {
@@ -1665,6 +2129,29 @@ func (v *StructWithOptionalEnum) Equals(rhs *StructWithOptionalEnum) bool {
 	return true
 }
 
+func _EnumDefault_ClonePtr(v *EnumDefault) *EnumDefault {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this StructWithOptionalEnum. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *StructWithOptionalEnum) Clone() *StructWithOptionalEnum {
+	if v == nil {
+		return nil
+	}
+
+	var c StructWithOptionalEnum
+	c.E = _EnumDefault_ClonePtr(v.E)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of StructWithOptionalEnum.
 func (v *StructWithOptionalEnum) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1802,6 +2289,24 @@ func (v *LowerCaseEnum) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded LowerCaseEnum directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v LowerCaseEnum
+//   if err := v.Decode(sReader); err != nil {
+//     return LowerCaseEnum(0), err
+//   }
+//   return v, nil
+func (v *LowerCaseEnum) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (LowerCaseEnum)(i)
+	return nil
+}
+
 // String returns a readable string representation of LowerCaseEnum.
 func (v LowerCaseEnum) String() string {
 	w := int32(v)
@@ -1881,8 +2386,13 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "enums",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/enums",
 	FilePath: "enums.thrift",
-	SHA1:     "16921e977d77e214a9bac398d279f60889b055de",
+	SHA1:     "0697aebc2c117c825031322ede44c072bdd6c6d6",
+	Version:  "1.21.0-dev",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "enum EmptyEnum {}\n\nenum EnumDefault {\n    Foo, Bar, Baz\n}\n\nenum EnumWithValues {\n    X = 123,\n    Y = 456,\n    Z = 789,\n}\n\nenum EnumWithDuplicateValues {\n    P, // 0\n    Q = -1,\n    R, // 0\n}\n\n// enum with item names conflicting with those of another enum\nenum EnumWithDuplicateName {\n    A, B, C, P, Q, R, X, Y, Z\n}\n\n// Enum treated as optional inside a struct\nstruct StructWithOptionalEnum {\n    1: optional EnumDefault e\n}\n\n/**\n * Kinds of records stored in the database.\n */\nenum RecordType {\n  /** Name of the user. */\n  NAME,\n\n  /**\n   * Home address of the user.\n   *\n   * This record is always present.\n   */\n  HOME_ADDRESS,\n\n  /**\n   * Home address of the user.\n   *\n   * This record may not be present.\n   */\n  WORK_ADDRESS\n}\n\nenum lowerCaseEnum {\n    containing, lower_case, items\n}\n\n// EnumWithLabel use label name in serialization/deserialization\nenum EnumWithLabel {\n    USERNAME (go.label = \"surname\"),\n    PASSWORD (go.label = \"hashed_password\"),\n    SALT (go.label = \"\"),\n    SUGAR (go.label),\n    relay (go.label = \"RELAY\")\n    NAIVE4_N1 (go.label = \"function\")\n\n}\n\n// collision with RecordType_Values() function.\nenum RecordType_Values { FOO, BAR }\n"
+const rawIDL = "enum EmptyEnum {}\n\nenum EnumDefault {\n    Foo, Bar, Baz\n}\n\nenum EnumWithValues {\n    X = 123,\n    Y = 456,\n    Z = 789,\n}\n\nenum EnumWithDuplicateValues {\n    P, // 0\n    Q = -1,\n    R, // 0\n}\n\n// enum with item names conflicting with those of another enum\nenum EnumWithDuplicateName {\n    A, B, C, P, Q, R, X, Y, Z\n}\n\n// Enum treated as optional inside a struct\nstruct StructWithOptionalEnum {\n    1: optional EnumDefault e\n}\n\n/**\n * Kinds of records stored in the database.\n */\nenum RecordType {\n  /** Name of the user. */\n  NAME,\n\n  /**\n   * Home address of the user.\n   *\n   * This record is always present.\n   */\n  HOME_ADDRESS,\n\n  /**\n   * Home address of the user.\n   *\n   * This record may not be present.\n   */\n  WORK_ADDRESS\n}\n\nenum lowerCaseEnum {\n    containing, lower_case, items\n}\n\n// EnumWithLabel use label name in serialization/deserialization\nenum EnumWithLabel {\n    USERNAME (go.label = \"surname\"),\n    PASSWORD (go.label = \"hashed_password\"),\n    SALT (go.label = \"\"),\n    SUGAR (go.label),\n    relay (go.label = \"RELAY\")\n    NAIVE4_N1 (go.label = \"function\")\n\n}\n\n// collision with RecordType_Values() function.\nenum RecordType_Values { FOO, BAR }\n\n/** Statuses of an account. */\nenum AccountStatus {\n    ACTIVE,\n    /** The account may not be used until it is restored. */\n    SUSPENDED (deprecated = \"Use DISABLED instead.\"),\n    CLOSED (deprecated),\n    DISABLED,\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -4,8 +4,13 @@
 package exceptions
 
 import (
+	bytes "bytes"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -82,6 +87,8 @@ func (v *DoesNotExistException) FromWire(w wire.Value) error {
 
 	keyIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -105,6 +112,60 @@ func (v *DoesNotExistException) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !keyIsSet {
+		missing.Add("DoesNotExistException", "Key")
+	}
+
+	return missing.Err()
+}
+
+func (v *DoesNotExistException) Decode(sr stream.Reader) error {
+	keyIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Error2 = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !keyIsSet {
 		return errors.New("field Key of DoesNotExistException is required")
 	}
@@ -112,6 +173,72 @@ func (v *DoesNotExistException) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a DoesNotExistException struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *DoesNotExistException) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Key)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"key":`)
+		buff.Write(b)
+	}
+	if !(v.Error2 == nil) {
+		b, err := json.Marshal(v.Error2)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"Error":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a DoesNotExistException struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *DoesNotExistException) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["key"]; ok {
+		if err := json.Unmarshal(r, &v.Key); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["Error"]; ok {
+		if err := json.Unmarshal(r, &v.Error2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a DoesNotExistException
 // struct.
 func (v *DoesNotExistException) String() string {
@@ -161,6 +288,30 @@ func (v *DoesNotExistException) Equals(rhs *DoesNotExistException) bool {
 	return true
 }
 
+func _String_ClonePtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this DoesNotExistException. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *DoesNotExistException) Clone() *DoesNotExistException {
+	if v == nil {
+		return nil
+	}
+
+	var c DoesNotExistException
+	c.Key = v.Key
+	c.Error2 = _String_ClonePtr(v.Error2)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DoesNotExistException.
 func (v *DoesNotExistException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -198,10 +349,40 @@ func (v *DoesNotExistException) IsSetError2() bool {
 	return v != nil && v.Error2 != nil
 }
 
+// ErrDoesNotExistException matches all DoesNotExistException errors with errors.Is.
+//
+//   if errors.Is(err, ErrDoesNotExistException) {
+//     ...
+//   }
+var ErrDoesNotExistException = errors.New("DoesNotExistException")
+
 func (v *DoesNotExistException) Error() string {
 	return v.String()
 }
 
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*DoesNotExistException) ErrorName() string {
+	return "DoesNotExistException"
+}
+
+// ErrorCode is the code given to this exception with the error.code
+// annotation in the Thrift file.
+func (*DoesNotExistException) ErrorCode() string {
+	return "NOT_FOUND"
+}
+
+// Unwrap returns the first field of this DoesNotExistException which holds an
+// exception and is set, or nil if there isn't one.
+func (v *DoesNotExistException) Unwrap() error {
+	return nil
+}
+
+// Is reports whether the given target is ErrDoesNotExistException.
+func (*DoesNotExistException) Is(target error) bool {
+	return target == ErrDoesNotExistException
+}
+
 type EmptyException struct {
 }
 
@@ -256,6 +437,70 @@ func (v *EmptyException) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *EmptyException) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a EmptyException struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *EmptyException) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a EmptyException struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *EmptyException) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a EmptyException
 // struct.
 func (v *EmptyException) String() string {
@@ -283,6 +528,19 @@ func (v *EmptyException) Equals(rhs *EmptyException) bool {
 	return true
 }
 
+// Clone returns a deep copy of this EmptyException. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *EmptyException) Clone() *EmptyException {
+	if v == nil {
+		return nil
+	}
+
+	var c EmptyException
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of EmptyException.
 func (v *EmptyException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -292,17 +550,502 @@ func (v *EmptyException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	return err
 }
 
+// ErrEmptyException matches all EmptyException errors with errors.Is.
+//
+//   if errors.Is(err, ErrEmptyException) {
+//     ...
+//   }
+var ErrEmptyException = errors.New("EmptyException")
+
 func (v *EmptyException) Error() string {
 	return v.String()
 }
 
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*EmptyException) ErrorName() string {
+	return "EmptyException"
+}
+
+// Unwrap returns the first field of this EmptyException which holds an
+// exception and is set, or nil if there isn't one.
+func (v *EmptyException) Unwrap() error {
+	return nil
+}
+
+// Is reports whether the given target is ErrEmptyException.
+func (*EmptyException) Is(target error) bool {
+	return target == ErrEmptyException
+}
+
+// Raised when a request failed because of another exception.
+type RequestFailedException struct {
+	Message      *string                `json:"message,omitempty"`
+	DoesNotExist *DoesNotExistException `json:"doesNotExist,omitempty"`
+	Empty        *EmptyException        `json:"empty,omitempty"`
+}
+
+// ToWire translates a RequestFailedException struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RequestFailedException) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.DoesNotExist != nil {
+		w, err = v.DoesNotExist.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Empty != nil {
+		w, err = v.Empty.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DoesNotExistException_Read(w wire.Value) (*DoesNotExistException, error) {
+	var v DoesNotExistException
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _EmptyException_Read(w wire.Value) (*EmptyException, error) {
+	var v EmptyException
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RequestFailedException struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RequestFailedException struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RequestFailedException
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RequestFailedException) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.DoesNotExist, err = _DoesNotExistException_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Empty, err = _EmptyException_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return missing.Err()
+}
+
+func _DoesNotExistException_Decode(sr stream.Reader) (*DoesNotExistException, error) {
+	var v DoesNotExistException
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _EmptyException_Decode(sr stream.Reader) (*EmptyException, error) {
+	var v EmptyException
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *RequestFailedException) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.DoesNotExist, err = _DoesNotExistException_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Empty, err = _EmptyException_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a RequestFailedException struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *RequestFailedException) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Message == nil) {
+		b, err := json.Marshal(v.Message)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"message":`)
+		buff.Write(b)
+	}
+	if !(v.DoesNotExist == nil) {
+		b, err := json.Marshal(v.DoesNotExist)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doesNotExist":`)
+		buff.Write(b)
+	}
+	if !(v.Empty == nil) {
+		b, err := json.Marshal(v.Empty)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"empty":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a RequestFailedException struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *RequestFailedException) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["message"]; ok {
+		if err := json.Unmarshal(r, &v.Message); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["doesNotExist"]; ok {
+		if err := json.Unmarshal(r, &v.DoesNotExist); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["empty"]; ok {
+		if err := json.Unmarshal(r, &v.Empty); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RequestFailedException
+// struct.
+func (v *RequestFailedException) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+	if v.DoesNotExist != nil {
+		fields[i] = fmt.Sprintf("DoesNotExist: %v", v.DoesNotExist)
+		i++
+	}
+	if v.Empty != nil {
+		fields[i] = fmt.Sprintf("Empty: %v", v.Empty)
+		i++
+	}
+
+	return fmt.Sprintf("RequestFailedException{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RequestFailedException match the
+// provided RequestFailedException.
+//
+// This function performs a deep comparison.
+func (v *RequestFailedException) Equals(rhs *RequestFailedException) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+	if !((v.DoesNotExist == nil && rhs.DoesNotExist == nil) || (v.DoesNotExist != nil && rhs.DoesNotExist != nil && v.DoesNotExist.Equals(rhs.DoesNotExist))) {
+		return false
+	}
+	if !((v.Empty == nil && rhs.Empty == nil) || (v.Empty != nil && rhs.Empty != nil && v.Empty.Equals(rhs.Empty))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this RequestFailedException. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *RequestFailedException) Clone() *RequestFailedException {
+	if v == nil {
+		return nil
+	}
+
+	var c RequestFailedException
+	c.Message = _String_ClonePtr(v.Message)
+	c.DoesNotExist = v.DoesNotExist.Clone()
+	c.Empty = v.Empty.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RequestFailedException.
+func (v *RequestFailedException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	if v.DoesNotExist != nil {
+		err = multierr.Append(err, enc.AddObject("doesNotExist", v.DoesNotExist))
+	}
+	if v.Empty != nil {
+		err = multierr.Append(err, enc.AddObject("empty", v.Empty))
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *RequestFailedException) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *RequestFailedException) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// GetDoesNotExist returns the value of DoesNotExist if it is set or its
+// zero value if it is unset.
+func (v *RequestFailedException) GetDoesNotExist() (o *DoesNotExistException) {
+	if v != nil && v.DoesNotExist != nil {
+		return v.DoesNotExist
+	}
+
+	return
+}
+
+// IsSetDoesNotExist returns true if DoesNotExist is not nil.
+func (v *RequestFailedException) IsSetDoesNotExist() bool {
+	return v != nil && v.DoesNotExist != nil
+}
+
+// GetEmpty returns the value of Empty if it is set or its
+// zero value if it is unset.
+func (v *RequestFailedException) GetEmpty() (o *EmptyException) {
+	if v != nil && v.Empty != nil {
+		return v.Empty
+	}
+
+	return
+}
+
+// IsSetEmpty returns true if Empty is not nil.
+func (v *RequestFailedException) IsSetEmpty() bool {
+	return v != nil && v.Empty != nil
+}
+
+// ErrRequestFailedException matches all RequestFailedException errors with errors.Is.
+//
+//   if errors.Is(err, ErrRequestFailedException) {
+//     ...
+//   }
+var ErrRequestFailedException = errors.New("RequestFailedException")
+
+func (v *RequestFailedException) Error() string {
+	return v.String()
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*RequestFailedException) ErrorName() string {
+	return "RequestFailedException"
+}
+
+// ErrorCode is the code given to this exception with the error.code
+// annotation in the Thrift file.
+func (*RequestFailedException) ErrorCode() string {
+	return "INTERNAL"
+}
+
+// Unwrap returns the first field of this RequestFailedException which holds an
+// exception and is set, or nil if there isn't one.
+func (v *RequestFailedException) Unwrap() error {
+	if v == nil {
+		return nil
+	}
+
+	if v.DoesNotExist != nil {
+		return v.DoesNotExist
+	}
+
+	if v.Empty != nil {
+		return v.Empty
+	}
+
+	return nil
+}
+
+// Is reports whether the given target is ErrRequestFailedException.
+func (*RequestFailedException) Is(target error) bool {
+	return target == ErrRequestFailedException
+}
+
+// ErrorCodes maps the names of exceptions defined in this package to
+// the codes given to them with the error.code annotation. The names
+// are those returned by ErrorName.
+var ErrorCodes = map[string]string{
+	"DoesNotExistException":  "NOT_FOUND",
+	"RequestFailedException": "INTERNAL",
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "exceptions",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/exceptions",
 	FilePath: "exceptions.thrift",
-	SHA1:     "743daa9bfc5a3d69637e7c67dd6f35a7d10e79a3",
+	SHA1:     "fa95ae060f194b7d3bfe8f19d1e1fd8419ff66ff",
+	Version:  "1.21.0-dev",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "exception EmptyException {}\n\n/**\n * Raised when something doesn't exist.\n */\nexception DoesNotExistException {\n    /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n}\n"
+const rawIDL = "exception EmptyException {}\n\n/**\n * Raised when something doesn't exist.\n */\nexception DoesNotExistException {\n    /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n} (error.code = \"NOT_FOUND\")\n\n/**\n * Raised when a request failed because of another exception.\n */\nexception RequestFailedException {\n    1: optional string message\n    2: optional DoesNotExistException doesNotExist\n    3: optional EmptyException empty\n} (error.code = \"INTERNAL\")\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -8,6 +8,8 @@ import (
 	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	math "math"
@@ -108,6 +110,24 @@ func (v *EnumDefault) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded EnumDefault directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v EnumDefault
+//   if err := v.Decode(sReader); err != nil {
+//     return EnumDefault(0), err
+//   }
+//   return v, nil
+func (v *EnumDefault) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (EnumDefault)(i)
+	return nil
+}
+
 // String returns a readable string representation of EnumDefault.
 func (v EnumDefault) String() string {
 	w := int32(v)
@@ -418,6 +438,7 @@ func _Set_I32_mapType_Read(s wire.ValueList) (map[int32]struct{}, error) {
 	}
 
 	o := make(map[int32]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetI32(), error(nil)
 		if err != nil {
@@ -491,6 +512,8 @@ func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
 	setOfIntsIsSet := false
 	mapOfIntsToDoublesIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -584,6 +607,281 @@ func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !boolFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "BoolField")
+	}
+
+	if !byteFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "ByteField")
+	}
+
+	if !int16FieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "Int16Field")
+	}
+
+	if !int32FieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "Int32Field")
+	}
+
+	if !int64FieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "Int64Field")
+	}
+
+	if !doubleFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "DoubleField")
+	}
+
+	if !stringFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "StringField")
+	}
+
+	if !binaryFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "BinaryField")
+	}
+
+	if !listOfStringsIsSet {
+		missing.Add("PrimitiveRequiredStruct", "ListOfStrings")
+	}
+
+	if !setOfIntsIsSet {
+		missing.Add("PrimitiveRequiredStruct", "SetOfInts")
+	}
+
+	if !mapOfIntsToDoublesIsSet {
+		missing.Add("PrimitiveRequiredStruct", "MapOfIntsToDoubles")
+	}
+
+	return missing.Err()
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]string, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_I32_mapType_Decode(sr stream.Reader) (map[int32]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[int32]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_I64_Double_Decode(sr stream.Reader) (map[int64]float64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI64 || mh.ValueType != wire.TDouble {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[int64]float64, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *PrimitiveRequiredStruct) Decode(sr stream.Reader) error {
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+	int16FieldIsSet := false
+	int32FieldIsSet := false
+	int64FieldIsSet := false
+	doubleFieldIsSet := false
+	stringFieldIsSet := false
+	binaryFieldIsSet := false
+	listOfStringsIsSet := false
+	setOfIntsIsSet := false
+	mapOfIntsToDoublesIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			v.BoolField, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			boolFieldIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI8:
+			v.ByteField, err = sr.ReadInt8()
+			if err != nil {
+				return err
+			}
+			byteFieldIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI16:
+			v.Int16Field, err = sr.ReadInt16()
+			if err != nil {
+				return err
+			}
+			int16FieldIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			v.Int32Field, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			int32FieldIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TI64:
+			v.Int64Field, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			int64FieldIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TDouble:
+			v.DoubleField, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			doubleFieldIsSet = true
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			v.StringField, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			stringFieldIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.BinaryField, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+			binaryFieldIsSet = true
+		case fh.ID == 9 && fh.Type == wire.TList:
+			v.ListOfStrings, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			listOfStringsIsSet = true
+		case fh.ID == 10 && fh.Type == wire.TSet:
+			v.SetOfInts, err = _Set_I32_mapType_Decode(sr)
+			if err != nil {
+				return err
+			}
+			setOfIntsIsSet = true
+		case fh.ID == 11 && fh.Type == wire.TMap:
+			v.MapOfIntsToDoubles, err = _Map_I64_Double_Decode(sr)
+			if err != nil {
+				return err
+			}
+			mapOfIntsToDoublesIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !boolFieldIsSet {
 		return errors.New("field BoolField of PrimitiveRequiredStruct is required")
 	}
@@ -631,6 +929,238 @@ func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _I64_UnmarshalJSON(text []byte) (*int64, error) {
+	// json.Number accepts both JSON numbers and JSON strings holding
+	// numbers, and retains all digits of the value.
+	var n json.Number
+	if err := json.Unmarshal(text, &n); err != nil {
+		return nil, err
+	}
+	if n == "" {
+		return nil, nil
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// MarshalJSON serializes a PrimitiveRequiredStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PrimitiveRequiredStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.BoolField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"boolField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ByteField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"byteField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Int16Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int16Field":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Int32Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int32Field":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Int64Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int64Field":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.DoubleField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doubleField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.StringField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"stringField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.BinaryField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"binaryField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ListOfStrings)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listOfStrings":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.SetOfInts)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"setOfInts":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.MapOfIntsToDoubles)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapOfIntsToDoubles":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PrimitiveRequiredStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PrimitiveRequiredStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["boolField"]; ok {
+		if err := json.Unmarshal(r, &v.BoolField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["byteField"]; ok {
+		if err := json.Unmarshal(r, &v.ByteField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int16Field"]; ok {
+		if err := json.Unmarshal(r, &v.Int16Field); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int32Field"]; ok {
+		if err := json.Unmarshal(r, &v.Int32Field); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int64Field"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		if x != nil {
+			v.Int64Field = (int64)(*x)
+		}
+	}
+	if r, ok := raw["doubleField"]; ok {
+		if err := json.Unmarshal(r, &v.DoubleField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["stringField"]; ok {
+		if err := json.Unmarshal(r, &v.StringField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["binaryField"]; ok {
+		if err := json.Unmarshal(r, &v.BinaryField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["listOfStrings"]; ok {
+		if err := json.Unmarshal(r, &v.ListOfStrings); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["setOfInts"]; ok {
+		if err := json.Unmarshal(r, &v.SetOfInts); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapOfIntsToDoubles"]; ok {
+		if err := json.Unmarshal(r, &v.MapOfIntsToDoubles); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a PrimitiveRequiredStruct
 // struct.
 func (v *PrimitiveRequiredStruct) String() string {
@@ -759,6 +1289,75 @@ func (v *PrimitiveRequiredStruct) Equals(rhs *PrimitiveRequiredStruct) bool {
 	return true
 }
 
+func _Binary_Clone(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(v)), v...)
+}
+
+func _List_String_Clone(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_I32_mapType_Clone(v map[int32]struct{}) map[int32]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int32]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_I64_Double_Clone(v map[int64]float64) map[int64]float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[int64]float64, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this PrimitiveRequiredStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PrimitiveRequiredStruct) Clone() *PrimitiveRequiredStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c PrimitiveRequiredStruct
+	c.BoolField = v.BoolField
+	c.ByteField = v.ByteField
+	c.Int16Field = v.Int16Field
+	c.Int32Field = v.Int32Field
+	c.Int64Field = v.Int64Field
+	c.DoubleField = v.DoubleField
+	c.StringField = v.StringField
+	c.BinaryField = _Binary_Clone(v.BinaryField)
+	c.ListOfStrings = _List_String_Clone(v.ListOfStrings)
+	c.SetOfInts = _Set_I32_mapType_Clone(v.SetOfInts)
+	c.MapOfIntsToDoubles = _Map_I64_Double_Clone(v.MapOfIntsToDoubles)
+
+	return &c
+}
+
 // GetBoolField returns the value of BoolField if it is set or its
 // zero value if it is unset.
 func (v *PrimitiveRequiredStruct) GetBoolField() (o bool) {
@@ -901,12 +1500,33 @@ func (v *Primitives) FromWire(w wire.Value) error {
 	return (*PrimitiveRequiredStruct)(v).FromWire(w)
 }
 
+// Decode deserializes Primitives directly off the wire.
+func (v *Primitives) Decode(sr stream.Reader) error {
+	return (*PrimitiveRequiredStruct)(v).Decode(sr)
+}
+
+// MarshalJSON serializes Primitives into JSON.
+func (v *Primitives) MarshalJSON() ([]byte, error) {
+	return (*PrimitiveRequiredStruct)(v).MarshalJSON()
+}
+
+// UnmarshalJSON deserializes Primitives from JSON.
+func (v *Primitives) UnmarshalJSON(text []byte) error {
+	return (*PrimitiveRequiredStruct)(v).UnmarshalJSON(text)
+}
+
 // Equals returns true if this Primitives is equal to the provided
 // Primitives.
 func (lhs *Primitives) Equals(rhs *Primitives) bool {
 	return (*PrimitiveRequiredStruct)(lhs).Equals((*PrimitiveRequiredStruct)(rhs))
 }
 
+// Clone returns a deep copy of this Primitives.
+func (v *Primitives) Clone() *Primitives {
+	x := (*PrimitiveRequiredStruct)(v)
+	return (*Primitives)(x.Clone())
+}
+
 type StringList []string
 
 // ToWire translates StringList into a Thrift-level intermediate
@@ -932,12 +1552,25 @@ func (v *StringList) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes StringList directly off the wire.
+func (v *StringList) Decode(sr stream.Reader) error {
+	x, err := _List_String_Decode(sr)
+	*v = (StringList)(x)
+	return err
+}
+
 // Equals returns true if this StringList is equal to the provided
 // StringList.
 func (lhs StringList) Equals(rhs StringList) bool {
 	return _List_String_Equals(([]string)(lhs), ([]string)(rhs))
 }
 
+// Clone returns a deep copy of this StringList.
+func (v StringList) Clone() StringList {
+	x := ([]string)(v)
+	return (StringList)(_List_String_Clone(x))
+}
+
 type _Map_String_String_MapItemList map[string]string
 
 func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
@@ -1001,6 +1634,52 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 	return o, err
 }
 
+func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]string, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
 	if len(lhs) != len(rhs) {
 		return false
@@ -1018,6 +1697,19 @@ func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
 	return true
 }
 
+func _Map_String_String_Clone(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
 type StringMap map[string]string
 
 // ToWire translates StringMap into a Thrift-level intermediate
@@ -1043,19 +1735,37 @@ func (v *StringMap) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes StringMap directly off the wire.
+func (v *StringMap) Decode(sr stream.Reader) error {
+	x, err := _Map_String_String_Decode(sr)
+	*v = (StringMap)(x)
+	return err
+}
+
 // Equals returns true if this StringMap is equal to the provided
 // StringMap.
 func (lhs StringMap) Equals(rhs StringMap) bool {
 	return _Map_String_String_Equals((map[string]string)(lhs), (map[string]string)(rhs))
 }
 
+// Clone returns a deep copy of this StringMap.
+func (v StringMap) Clone() StringMap {
+	x := (map[string]string)(v)
+	return (StringMap)(_Map_String_String_Clone(x))
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "nozap",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/nozap",
 	FilePath: "nozap.thrift",
 	SHA1:     "05f7228060eeb97fbd181a7a2660a6483799ac78",
+	Version:  "1.21.0-dev",
 	Raw:      rawIDL,
 }
 
 const rawIDL = "enum EnumDefault {\n    Foo, Bar, Baz\n}\n\nstruct PrimitiveRequiredStruct {\n    1: required bool boolField\n    2: required byte byteField\n    3: required i16 int16Field\n    4: required i32 int32Field\n    5: required i64 int64Field\n    6: required double doubleField\n    7: required string stringField\n    8: required binary binaryField\n    9: required list<string> listOfStrings\n    10: required set<i32> setOfInts\n    11: required map<i64, double> mapOfIntsToDoubles\n}\n\ntypedef map<string, string> StringMap\ntypedef PrimitiveRequiredStruct Primitives\ntypedef list<string> StringList\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -5,6 +5,7 @@ package other_constants
 
 import (
 	structs "go.uber.org/thriftrw/gen/internal/tests/structs"
+	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 )
 
@@ -14,21 +15,31 @@ var ListOfInts []int32 = []int32{
 	3,
 }
 
+const OneDay int64 = 86400
+
 var SomePoint *structs.Point = &structs.Point{
 	X: 1,
 	Y: 2,
 }
 
+const StartOfDay typedefs.Timestamp = typedefs.Timestamp(0)
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "other_constants",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/other_constants",
 	FilePath: "other_constants.thrift",
-	SHA1:     "578e8e5aafda10921bb99b58be9a3714c78e31fc",
+	SHA1:     "cac1fb418d18affe87eda2d17b098152b01350c1",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		structs.ThriftModule,
+		typedefs.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./structs.thrift\"\n\nconst list<i32> listOfInts = [1, 2, 3]\n\nconst structs.Point some_point = {\"x\": 1, \"y\": 2.0}\n"
+const rawIDL = "include \"./structs.thrift\"\ninclude \"./typedefs.thrift\"\n\nconst list<i32> listOfInts = [1, 2, 3]\n\nconst structs.Point some_point = {\"x\": 1, \"y\": 2.0}\n\nconst i64 oneDay = 86400\n\nconst typedefs.Timestamp startOfDay = 0\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -6,17 +6,26 @@ package services
 import (
 	bytes "bytes"
 	base64 "encoding/base64"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	exceptions "go.uber.org/thriftrw/gen/internal/tests/exceptions"
 	unions "go.uber.org/thriftrw/gen/internal/tests/unions"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
 	strings "strings"
 )
 
+// Names of the services to which requests are routed.
+var RoutedServices []Key = []Key{
+	"KeyValue",
+	"Cache",
+}
+
 type ConflictingNamesSetValueArgs struct {
 	Key   string `json:"key,required"`
 	Value []byte `json:"value,required"`
@@ -87,6 +96,8 @@ func (v *ConflictingNamesSetValueArgs) FromWire(w wire.Value) error {
 	keyIsSet := false
 	valueIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -108,6 +119,63 @@ func (v *ConflictingNamesSetValueArgs) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !keyIsSet {
+		missing.Add("ConflictingNamesSetValueArgs", "Key")
+	}
+
+	if !valueIsSet {
+		missing.Add("ConflictingNamesSetValueArgs", "Value")
+	}
+
+	return missing.Err()
+}
+
+func (v *ConflictingNamesSetValueArgs) Decode(sr stream.Reader) error {
+	keyIsSet := false
+	valueIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+			valueIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !keyIsSet {
 		return errors.New("field Key of ConflictingNamesSetValueArgs is required")
 	}
@@ -119,6 +187,72 @@ func (v *ConflictingNamesSetValueArgs) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a ConflictingNamesSetValueArgs struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ConflictingNamesSetValueArgs) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Key)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"key":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"value":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ConflictingNamesSetValueArgs struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ConflictingNamesSetValueArgs) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["key"]; ok {
+		if err := json.Unmarshal(r, &v.Key); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["value"]; ok {
+		if err := json.Unmarshal(r, &v.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a ConflictingNamesSetValueArgs
 // struct.
 func (v *ConflictingNamesSetValueArgs) String() string {
@@ -156,6 +290,28 @@ func (v *ConflictingNamesSetValueArgs) Equals(rhs *ConflictingNamesSetValueArgs)
 	return true
 }
 
+func _Binary_Clone(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(v)), v...)
+}
+
+// Clone returns a deep copy of this ConflictingNamesSetValueArgs. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ConflictingNamesSetValueArgs) Clone() *ConflictingNamesSetValueArgs {
+	if v == nil {
+		return nil
+	}
+
+	var c ConflictingNamesSetValueArgs
+	c.Key = v.Key
+	c.Value = _Binary_Clone(v.Value)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ConflictingNamesSetValueArgs.
 func (v *ConflictingNamesSetValueArgs) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -267,6 +423,99 @@ func (v *InternalError) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *InternalError) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a InternalError struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *InternalError) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Message == nil) {
+		b, err := json.Marshal(v.Message)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"message":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a InternalError struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *InternalError) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["message"]; ok {
+		if err := json.Unmarshal(r, &v.Message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a InternalError
 // struct.
 func (v *InternalError) String() string {
@@ -311,6 +560,29 @@ func (v *InternalError) Equals(rhs *InternalError) bool {
 	return true
 }
 
+func _String_ClonePtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this InternalError. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *InternalError) Clone() *InternalError {
+	if v == nil {
+		return nil
+	}
+
+	var c InternalError
+	c.Message = _String_ClonePtr(v.Message)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of InternalError.
 func (v *InternalError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -338,10 +610,34 @@ func (v *InternalError) IsSetMessage() bool {
 	return v != nil && v.Message != nil
 }
 
+// ErrInternalError matches all InternalError errors with errors.Is.
+//
+//   if errors.Is(err, ErrInternalError) {
+//     ...
+//   }
+var ErrInternalError = errors.New("InternalError")
+
 func (v *InternalError) Error() string {
 	return v.String()
 }
 
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*InternalError) ErrorName() string {
+	return "InternalError"
+}
+
+// Unwrap returns the first field of this InternalError which holds an
+// exception and is set, or nil if there isn't one.
+func (v *InternalError) Unwrap() error {
+	return nil
+}
+
+// Is reports whether the given target is ErrInternalError.
+func (*InternalError) Is(target error) bool {
+	return target == ErrInternalError
+}
+
 type Key string
 
 // KeyPtr returns a pointer to a Key
@@ -372,6 +668,13 @@ func (v *Key) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes Key directly off the wire.
+func (v *Key) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Key)(x)
+	return err
+}
+
 // Equals returns true if this Key is equal to the provided
 // Key.
 func (lhs Key) Equals(rhs Key) bool {
@@ -383,7 +686,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "services",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/services",
 	FilePath: "services.thrift",
-	SHA1:     "22d2183c133fb327925d7288a6191b17e8f28175",
+	SHA1:     "39fd95f57b2ed91c91177728d476db278484b38a",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		exceptions.ThriftModule,
 		unions.ThriftModule,
@@ -391,7 +695,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./unions.thrift\"\ninclude \"./exceptions.thrift\"\n\ntypedef string Key\n\nexception InternalError {\n    1: optional string message\n}\n\nservice KeyValue {\n    // void and no exceptions\n    void setValue(1: Key key, 2: unions.ArbitraryValue value)\n\n    void setValueV2(\n        /** Key to change. */\n        1: required Key key,\n        /**\n         * New value for the key.\n         *\n         * If the key already has an existing value, it will be overwritten.\n         */\n        2: required unions.ArbitraryValue value,\n    )\n\n    // Return with exceptions\n    unions.ArbitraryValue getValue(1: Key key)\n        throws (1: exceptions.DoesNotExistException doesNotExist)\n\n    // void with exceptions\n    void deleteValue(1: Key key)\n        throws (\n            /**\n             * Raised if a value with the given key doesn't exist.\n             */\n            1: exceptions.DoesNotExistException doesNotExist,\n            2: InternalError internalError\n        )\n\n    list<unions.ArbitraryValue> getManyValues(\n        1: list<Key> range  // < reserved keyword as an argument\n    ) throws (\n        1: exceptions.DoesNotExistException doesNotExist,\n    )\n\n    i64 size()  // < primitve return value\n}\n\nservice Cache {\n    oneway void clear()\n    oneway void clearAfter(1: i64 durationMS)\n}\n\nstruct ConflictingNames_SetValue_Args {\n    1: required string key\n    2: required binary value\n}\n\nservice ConflictingNames {\n    void setValue(1: ConflictingNames_SetValue_Args request)\n}\n\nservice non_standard_service_name {\n    void non_standard_function_name()\n}\n"
+const rawIDL = "include \"./unions.thrift\"\ninclude \"./exceptions.thrift\"\n\ntypedef string Key\n\nexception InternalError {\n    1: optional string message\n}\n\nservice KeyValue {\n    // void and no exceptions\n    void setValue(1: Key key, 2: unions.ArbitraryValue value)\n\n    void setValueV2(\n        /** Key to change. */\n        1: required Key key,\n        /**\n         * New value for the key.\n         *\n         * If the key already has an existing value, it will be overwritten.\n         */\n        2: required unions.ArbitraryValue value,\n    )\n\n    // Return with exceptions\n    unions.ArbitraryValue getValue(1: Key key)\n        throws (1: exceptions.DoesNotExistException doesNotExist)\n\n    // void with exceptions\n    void deleteValue(1: Key key)\n        throws (\n            /**\n             * Raised if a value with the given key doesn't exist.\n             */\n            1: exceptions.DoesNotExistException doesNotExist,\n            2: InternalError internalError\n        )\n\n    list<unions.ArbitraryValue> getManyValues(\n        1: list<Key> range  // < reserved keyword as an argument\n    ) throws (\n        1: exceptions.DoesNotExistException doesNotExist,\n    )\n\n    i64 size()  // < primitve return value\n}\n\nservice Cache {\n    oneway void clear()\n    oneway void clearAfter(1: i64 durationMS)\n}\n\nstruct ConflictingNames_SetValue_Args {\n    1: required string key\n    2: required binary value\n}\n\nservice ConflictingNames {\n    void setValue(1: ConflictingNames_SetValue_Args request)\n}\n\nservice non_standard_service_name {\n    void non_standard_function_name()\n}\n\n/** Names of the services to which requests are routed. */\nconst list<Key> ROUTED_SERVICES = [KeyValue, Cache]\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
 
 // Cache_Clear_Args represents the arguments for the Cache.clear function.
 //
@@ -450,6 +758,70 @@ func (v *Cache_Clear_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *Cache_Clear_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Cache_Clear_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Cache_Clear_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a Cache_Clear_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Cache_Clear_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Cache_Clear_Args
 // struct.
 func (v *Cache_Clear_Args) String() string {
@@ -477,6 +849,19 @@ func (v *Cache_Clear_Args) Equals(rhs *Cache_Clear_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Cache_Clear_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Cache_Clear_Args) Clone() *Cache_Clear_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c Cache_Clear_Args
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Cache_Clear_Args.
 func (v *Cache_Clear_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -597,6 +982,119 @@ func (v *Cache_ClearAfter_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *Cache_ClearAfter_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DurationMS = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func _I64_UnmarshalJSON(text []byte) (*int64, error) {
+	// json.Number accepts both JSON numbers and JSON strings holding
+	// numbers, and retains all digits of the value.
+	var n json.Number
+	if err := json.Unmarshal(text, &n); err != nil {
+		return nil, err
+	}
+	if n == "" {
+		return nil, nil
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// MarshalJSON serializes a Cache_ClearAfter_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Cache_ClearAfter_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.DurationMS == nil) {
+		b, err := json.Marshal(v.DurationMS)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"durationMS":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Cache_ClearAfter_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Cache_ClearAfter_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["durationMS"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.DurationMS = (*int64)(x)
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Cache_ClearAfter_Args
 // struct.
 func (v *Cache_ClearAfter_Args) String() string {
@@ -641,6 +1139,29 @@ func (v *Cache_ClearAfter_Args) Equals(rhs *Cache_ClearAfter_Args) bool {
 	return true
 }
 
+func _I64_ClonePtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Cache_ClearAfter_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Cache_ClearAfter_Args) Clone() *Cache_ClearAfter_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c Cache_ClearAfter_Args
+	c.DurationMS = _I64_ClonePtr(v.DurationMS)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Cache_ClearAfter_Args.
 func (v *Cache_ClearAfter_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -705,6 +1226,16 @@ func init() {
 
 }
 
+// Cache_Errors maps the names of exceptions thrown by functions
+// of the Cache service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := Cache_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var Cache_Errors = map[string]func() error{}
+
 // ConflictingNames_SetValue_Args represents the arguments for the ConflictingNames.setValue function.
 //
 // The arguments for setValue are sent and received over the wire as this struct.
@@ -773,12 +1304,14 @@ func _ConflictingNamesSetValueArgs_Read(w wire.Value) (*ConflictingNamesSetValue
 func (v *ConflictingNames_SetValue_Args) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.Request, err = _ConflictingNamesSetValueArgs_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -786,6 +1319,103 @@ func (v *ConflictingNames_SetValue_Args) FromWire(w wire.Value) error {
 		}
 	}
 
+	return missing.Err()
+}
+
+func _ConflictingNamesSetValueArgs_Decode(sr stream.Reader) (*ConflictingNamesSetValueArgs, error) {
+	var v ConflictingNamesSetValueArgs
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *ConflictingNames_SetValue_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _ConflictingNamesSetValueArgs_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ConflictingNames_SetValue_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ConflictingNames_SetValue_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Request == nil) {
+		b, err := json.Marshal(v.Request)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"request":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ConflictingNames_SetValue_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ConflictingNames_SetValue_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["request"]; ok {
+		if err := json.Unmarshal(r, &v.Request); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -823,6 +1453,20 @@ func (v *ConflictingNames_SetValue_Args) Equals(rhs *ConflictingNames_SetValue_A
 	return true
 }
 
+// Clone returns a deep copy of this ConflictingNames_SetValue_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ConflictingNames_SetValue_Args) Clone() *ConflictingNames_SetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c ConflictingNames_SetValue_Args
+	c.Request = v.Request.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ConflictingNames_SetValue_Args.
 func (v *ConflictingNames_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -997,6 +1641,70 @@ func (v *ConflictingNames_SetValue_Result) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *ConflictingNames_SetValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ConflictingNames_SetValue_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ConflictingNames_SetValue_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a ConflictingNames_SetValue_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ConflictingNames_SetValue_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a ConflictingNames_SetValue_Result
 // struct.
 func (v *ConflictingNames_SetValue_Result) String() string {
@@ -1024,6 +1732,19 @@ func (v *ConflictingNames_SetValue_Result) Equals(rhs *ConflictingNames_SetValue
 	return true
 }
 
+// Clone returns a deep copy of this ConflictingNames_SetValue_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ConflictingNames_SetValue_Result) Clone() *ConflictingNames_SetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c ConflictingNames_SetValue_Result
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ConflictingNames_SetValue_Result.
 func (v *ConflictingNames_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1048,6 +1769,16 @@ func (v *ConflictingNames_SetValue_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
+// ConflictingNames_Errors maps the names of exceptions thrown by functions
+// of the ConflictingNames service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := ConflictingNames_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var ConflictingNames_Errors = map[string]func() error{}
+
 // KeyValue_DeleteValue_Args represents the arguments for the KeyValue.deleteValue function.
 //
 // The arguments for deleteValue are sent and received over the wire as this struct.
@@ -1134,6 +1865,105 @@ func (v *KeyValue_DeleteValue_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _Key_Decode(sr stream.Reader) (Key, error) {
+	var x Key
+	err := x.Decode(sr)
+	return x, err
+}
+
+func (v *KeyValue_DeleteValue_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x Key
+			x, err = _Key_Decode(sr)
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_DeleteValue_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_DeleteValue_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Key == nil) {
+		b, err := json.Marshal(v.Key)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"key":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_DeleteValue_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_DeleteValue_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["key"]; ok {
+		if err := json.Unmarshal(r, &v.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a KeyValue_DeleteValue_Args
 // struct.
 func (v *KeyValue_DeleteValue_Args) String() string {
@@ -1178,6 +2008,29 @@ func (v *KeyValue_DeleteValue_Args) Equals(rhs *KeyValue_DeleteValue_Args) bool
 	return true
 }
 
+func _Key_ClonePtr(v *Key) *Key {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this KeyValue_DeleteValue_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_DeleteValue_Args) Clone() *KeyValue_DeleteValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_DeleteValue_Args
+	c.Key = _Key_ClonePtr(v.Key)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_DeleteValue_Args.
 func (v *KeyValue_DeleteValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1408,12 +2261,14 @@ func _InternalError_Read(w wire.Value) (*InternalError, error) {
 func (v *KeyValue_DeleteValue_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.DoesNotExist, err = _DoesNotExistException_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -1429,15 +2284,151 @@ func (v *KeyValue_DeleteValue_Result) FromWire(w wire.Value) error {
 		}
 	}
 
-	count := 0
-	if v.DoesNotExist != nil {
-		count++
+	count := 0
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("KeyValue_DeleteValue_Result should have at most one field: got %v fields", count)
+	}
+
+	return missing.Err()
+}
+
+func _DoesNotExistException_Decode(sr stream.Reader) (*exceptions.DoesNotExistException, error) {
+	var v exceptions.DoesNotExistException
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _InternalError_Decode(sr stream.Reader) (*InternalError, error) {
+	var v InternalError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *KeyValue_DeleteValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.DoesNotExist, err = _DoesNotExistException_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalError, err = _InternalError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if v.InternalError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("KeyValue_DeleteValue_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_DeleteValue_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_DeleteValue_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.DoesNotExist == nil) {
+		b, err := json.Marshal(v.DoesNotExist)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doesNotExist":`)
+		buff.Write(b)
+	}
+	if !(v.InternalError == nil) {
+		b, err := json.Marshal(v.InternalError)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"internalError":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_DeleteValue_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_DeleteValue_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
 	}
-	if v.InternalError != nil {
-		count++
+	if r, ok := raw["doesNotExist"]; ok {
+		if err := json.Unmarshal(r, &v.DoesNotExist); err != nil {
+			return err
+		}
 	}
-	if count > 1 {
-		return fmt.Errorf("KeyValue_DeleteValue_Result should have at most one field: got %v fields", count)
+	if r, ok := raw["internalError"]; ok {
+		if err := json.Unmarshal(r, &v.InternalError); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1484,6 +2475,21 @@ func (v *KeyValue_DeleteValue_Result) Equals(rhs *KeyValue_DeleteValue_Result) b
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_DeleteValue_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_DeleteValue_Result) Clone() *KeyValue_DeleteValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_DeleteValue_Result
+	c.DoesNotExist = v.DoesNotExist.Clone()
+	c.InternalError = v.InternalError.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_DeleteValue_Result.
 func (v *KeyValue_DeleteValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1666,6 +2672,133 @@ func (v *KeyValue_GetManyValues_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _List_Key_Decode(sr stream.Reader) ([]Key, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]Key, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Key_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *KeyValue_GetManyValues_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Range, err = _List_Key_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_GetManyValues_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_GetManyValues_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.Range) == 0) {
+		b, err := json.Marshal(v.Range)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"range":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_GetManyValues_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_GetManyValues_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["range"]; ok {
+		if err := json.Unmarshal(r, &v.Range); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a KeyValue_GetManyValues_Args
 // struct.
 func (v *KeyValue_GetManyValues_Args) String() string {
@@ -1715,6 +2848,32 @@ func (v *KeyValue_GetManyValues_Args) Equals(rhs *KeyValue_GetManyValues_Args) b
 	return true
 }
 
+func _List_Key_Clone(v []Key) []Key {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]Key, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this KeyValue_GetManyValues_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_GetManyValues_Args) Clone() *KeyValue_GetManyValues_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_GetManyValues_Args
+	c.Range = _List_Key_Clone(v.Range)
+
+	return &c
+}
+
 type _List_Key_Zapper []Key
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -1994,6 +3153,8 @@ func _List_ArbitraryValue_Read(l wire.ValueList) ([]*unions.ArbitraryValue, erro
 func (v *KeyValue_GetManyValues_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
@@ -2007,7 +3168,7 @@ func (v *KeyValue_GetManyValues_Result) FromWire(w wire.Value) error {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.DoesNotExist, err = _DoesNotExistException_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -2026,6 +3187,172 @@ func (v *KeyValue_GetManyValues_Result) FromWire(w wire.Value) error {
 		return fmt.Errorf("KeyValue_GetManyValues_Result should have exactly one field: got %v fields", count)
 	}
 
+	return missing.Err()
+}
+
+func _ArbitraryValue_Decode(sr stream.Reader) (*unions.ArbitraryValue, error) {
+	var v unions.ArbitraryValue
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ArbitraryValue_Decode(sr stream.Reader) ([]*unions.ArbitraryValue, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*unions.ArbitraryValue, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ArbitraryValue_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *KeyValue_GetManyValues_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TList:
+			v.Success, err = _List_ArbitraryValue_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.DoesNotExist, err = _DoesNotExistException_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetManyValues_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_GetManyValues_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_GetManyValues_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.Success) == 0) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+	if !(v.DoesNotExist == nil) {
+		b, err := json.Marshal(v.DoesNotExist)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doesNotExist":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_GetManyValues_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_GetManyValues_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["doesNotExist"]; ok {
+		if err := json.Unmarshal(r, &v.DoesNotExist); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -2085,6 +3412,33 @@ func (v *KeyValue_GetManyValues_Result) Equals(rhs *KeyValue_GetManyValues_Resul
 	return true
 }
 
+func _List_ArbitraryValue_Clone(v []*unions.ArbitraryValue) []*unions.ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*unions.ArbitraryValue, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this KeyValue_GetManyValues_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_GetManyValues_Result) Clone() *KeyValue_GetManyValues_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_GetManyValues_Result
+	c.Success = _List_ArbitraryValue_Clone(v.Success)
+	c.DoesNotExist = v.DoesNotExist.Clone()
+
+	return &c
+}
+
 type _List_ArbitraryValue_Zapper []*unions.ArbitraryValue
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -2218,18 +3572,111 @@ func (v *KeyValue_GetValue_Args) ToWire() (wire.Value, error) {
 func (v *KeyValue_GetValue_Args) FromWire(w wire.Value) error {
 	var err error
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				var x Key
-				x, err = _Key_Read(field.Value)
-				v.Key = &x
-				if err != nil {
-					return err
-				}
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x Key
+				x, err = _Key_Read(field.Value)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *KeyValue_GetValue_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x Key
+			x, err = _Key_Decode(sr)
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_GetValue_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_GetValue_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Key == nil) {
+		b, err := json.Marshal(v.Key)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"key":`)
+		buff.Write(b)
+	}
 
-			}
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_GetValue_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_GetValue_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["key"]; ok {
+		if err := json.Unmarshal(r, &v.Key); err != nil {
+			return err
 		}
 	}
 
@@ -2270,6 +3717,20 @@ func (v *KeyValue_GetValue_Args) Equals(rhs *KeyValue_GetValue_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_GetValue_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_GetValue_Args) Clone() *KeyValue_GetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_GetValue_Args
+	c.Key = _Key_ClonePtr(v.Key)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_GetValue_Args.
 func (v *KeyValue_GetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2485,6 +3946,8 @@ func (v *KeyValue_GetValue_Result) ToWire() (wire.Value, error) {
 func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
@@ -2498,7 +3961,7 @@ func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.DoesNotExist, err = _DoesNotExistException_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -2517,6 +3980,130 @@ func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
 		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
 	}
 
+	return missing.Err()
+}
+
+func (v *KeyValue_GetValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _ArbitraryValue_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.DoesNotExist, err = _DoesNotExistException_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.DoesNotExist != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_GetValue_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_GetValue_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+	if !(v.DoesNotExist == nil) {
+		b, err := json.Marshal(v.DoesNotExist)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doesNotExist":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_GetValue_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_GetValue_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["doesNotExist"]; ok {
+		if err := json.Unmarshal(r, &v.DoesNotExist); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -2561,6 +4148,21 @@ func (v *KeyValue_GetValue_Result) Equals(rhs *KeyValue_GetValue_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_GetValue_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_GetValue_Result) Clone() *KeyValue_GetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_GetValue_Result
+	c.Success = v.Success.Clone()
+	c.DoesNotExist = v.DoesNotExist.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_GetValue_Result.
 func (v *KeyValue_GetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2718,6 +4320,121 @@ func (v *KeyValue_SetValue_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *KeyValue_SetValue_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x Key
+			x, err = _Key_Decode(sr)
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Value, err = _ArbitraryValue_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_SetValue_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_SetValue_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Key == nil) {
+		b, err := json.Marshal(v.Key)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"key":`)
+		buff.Write(b)
+	}
+	if !(v.Value == nil) {
+		b, err := json.Marshal(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"value":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_SetValue_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_SetValue_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["key"]; ok {
+		if err := json.Unmarshal(r, &v.Key); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["value"]; ok {
+		if err := json.Unmarshal(r, &v.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a KeyValue_SetValue_Args
 // struct.
 func (v *KeyValue_SetValue_Args) String() string {
@@ -2759,6 +4476,21 @@ func (v *KeyValue_SetValue_Args) Equals(rhs *KeyValue_SetValue_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValue_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_SetValue_Args) Clone() *KeyValue_SetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_SetValue_Args
+	c.Key = _Key_ClonePtr(v.Key)
+	c.Value = v.Value.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValue_Args.
 func (v *KeyValue_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2954,6 +4686,70 @@ func (v *KeyValue_SetValue_Result) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *KeyValue_SetValue_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_SetValue_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_SetValue_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_SetValue_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_SetValue_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a KeyValue_SetValue_Result
 // struct.
 func (v *KeyValue_SetValue_Result) String() string {
@@ -2981,6 +4777,19 @@ func (v *KeyValue_SetValue_Result) Equals(rhs *KeyValue_SetValue_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValue_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_SetValue_Result) Clone() *KeyValue_SetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_SetValue_Result
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValue_Result.
 func (v *KeyValue_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3082,6 +4891,8 @@ func (v *KeyValue_SetValueV2_Args) FromWire(w wire.Value) error {
 	keyIsSet := false
 	valueIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -3103,12 +4914,135 @@ func (v *KeyValue_SetValueV2_Args) FromWire(w wire.Value) error {
 		}
 	}
 
-	if !keyIsSet {
-		return errors.New("field Key of KeyValue_SetValueV2_Args is required")
-	}
+	if !keyIsSet {
+		missing.Add("KeyValue_SetValueV2_Args", "Key")
+	}
+
+	if !valueIsSet {
+		missing.Add("KeyValue_SetValueV2_Args", "Value")
+	}
+
+	return missing.Err()
+}
+
+func (v *KeyValue_SetValueV2_Args) Decode(sr stream.Reader) error {
+	keyIsSet := false
+	valueIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Key, err = _Key_Decode(sr)
+			if err != nil {
+				return err
+			}
+			keyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Value, err = _ArbitraryValue_Decode(sr)
+			if err != nil {
+				return err
+			}
+			valueIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !keyIsSet {
+		return errors.New("field Key of KeyValue_SetValueV2_Args is required")
+	}
+
+	if !valueIsSet {
+		return errors.New("field Value of KeyValue_SetValueV2_Args is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_SetValueV2_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_SetValueV2_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Key)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"key":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"value":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
 
-	if !valueIsSet {
-		return errors.New("field Value of KeyValue_SetValueV2_Args is required")
+// UnmarshalJSON deserializes a KeyValue_SetValueV2_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_SetValueV2_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["key"]; ok {
+		if err := json.Unmarshal(r, &v.Key); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["value"]; ok {
+		if err := json.Unmarshal(r, &v.Value); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -3151,6 +5085,21 @@ func (v *KeyValue_SetValueV2_Args) Equals(rhs *KeyValue_SetValueV2_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValueV2_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_SetValueV2_Args) Clone() *KeyValue_SetValueV2_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_SetValueV2_Args
+	c.Key = v.Key
+	c.Value = v.Value.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValueV2_Args.
 func (v *KeyValue_SetValueV2_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3335,6 +5284,70 @@ func (v *KeyValue_SetValueV2_Result) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *KeyValue_SetValueV2_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_SetValueV2_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_SetValueV2_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_SetValueV2_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_SetValueV2_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a KeyValue_SetValueV2_Result
 // struct.
 func (v *KeyValue_SetValueV2_Result) String() string {
@@ -3362,6 +5375,19 @@ func (v *KeyValue_SetValueV2_Result) Equals(rhs *KeyValue_SetValueV2_Result) boo
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValueV2_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_SetValueV2_Result) Clone() *KeyValue_SetValueV2_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_SetValueV2_Result
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_SetValueV2_Result.
 func (v *KeyValue_SetValueV2_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3443,6 +5469,70 @@ func (v *KeyValue_Size_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *KeyValue_Size_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_Size_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_Size_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_Size_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_Size_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a KeyValue_Size_Args
 // struct.
 func (v *KeyValue_Size_Args) String() string {
@@ -3470,6 +5560,19 @@ func (v *KeyValue_Size_Args) Equals(rhs *KeyValue_Size_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_Size_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_Size_Args) Clone() *KeyValue_Size_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_Size_Args
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_Size_Args.
 func (v *KeyValue_Size_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3664,6 +5767,109 @@ func (v *KeyValue_Size_Result) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *KeyValue_Size_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Success = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("KeyValue_Size_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a KeyValue_Size_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *KeyValue_Size_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a KeyValue_Size_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *KeyValue_Size_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["success"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.Success = (*int64)(x)
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a KeyValue_Size_Result
 // struct.
 func (v *KeyValue_Size_Result) String() string {
@@ -3698,6 +5904,20 @@ func (v *KeyValue_Size_Result) Equals(rhs *KeyValue_Size_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_Size_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *KeyValue_Size_Result) Clone() *KeyValue_Size_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c KeyValue_Size_Result
+	c.Success = _I64_ClonePtr(v.Success)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of KeyValue_Size_Result.
 func (v *KeyValue_Size_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3740,6 +5960,19 @@ func (v *KeyValue_Size_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
+// KeyValue_Errors maps the names of exceptions thrown by functions
+// of the KeyValue service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := KeyValue_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var KeyValue_Errors = map[string]func() error{
+	"DoesNotExistException": func() error { return new(exceptions.DoesNotExistException) },
+	"InternalError":         func() error { return new(InternalError) },
+}
+
 // NonStandardServiceName_NonStandardFunctionName_Args represents the arguments for the non_standard_service_name.non_standard_function_name function.
 //
 // The arguments for non_standard_function_name are sent and received over the wire as this struct.
@@ -3797,6 +6030,70 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Args) FromWire(w wire.Va
 	return nil
 }
 
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a NonStandardServiceName_NonStandardFunctionName_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a NonStandardServiceName_NonStandardFunctionName_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a NonStandardServiceName_NonStandardFunctionName_Args
 // struct.
 func (v *NonStandardServiceName_NonStandardFunctionName_Args) String() string {
@@ -3824,6 +6121,19 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Args) Equals(rhs *NonSta
 	return true
 }
 
+// Clone returns a deep copy of this NonStandardServiceName_NonStandardFunctionName_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) Clone() *NonStandardServiceName_NonStandardFunctionName_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c NonStandardServiceName_NonStandardFunctionName_Args
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of NonStandardServiceName_NonStandardFunctionName_Args.
 func (v *NonStandardServiceName_NonStandardFunctionName_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3974,6 +6284,70 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Result) FromWire(w wire.
 	return nil
 }
 
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a NonStandardServiceName_NonStandardFunctionName_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a NonStandardServiceName_NonStandardFunctionName_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a NonStandardServiceName_NonStandardFunctionName_Result
 // struct.
 func (v *NonStandardServiceName_NonStandardFunctionName_Result) String() string {
@@ -4001,6 +6375,19 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Result) Equals(rhs *NonS
 	return true
 }
 
+// Clone returns a deep copy of this NonStandardServiceName_NonStandardFunctionName_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) Clone() *NonStandardServiceName_NonStandardFunctionName_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c NonStandardServiceName_NonStandardFunctionName_Result
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of NonStandardServiceName_NonStandardFunctionName_Result.
 func (v *NonStandardServiceName_NonStandardFunctionName_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4024,3 +6411,13 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Result) MethodName() str
 func (v *NonStandardServiceName_NonStandardFunctionName_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// non_standard_service_name_Errors maps the names of exceptions thrown by functions
+// of the non_standard_service_name service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := non_standard_service_name_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var non_standard_service_name_Errors = map[string]func() error{}
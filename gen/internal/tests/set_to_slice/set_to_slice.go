@@ -4,9 +4,13 @@
 package set_to_slice
 
 import (
+	bytes "bytes"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -32,6 +36,12 @@ func _MyStringList_Read(w wire.Value) (MyStringList, error) {
 	return x, err
 }
 
+func _MyStringList_Decode(sr stream.Reader) (MyStringList, error) {
+	var x MyStringList
+	err := x.Decode(sr)
+	return x, err
+}
+
 type _Set_String_sliceType_Zapper []string
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -68,12 +78,25 @@ func (v *AnotherStringList) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes AnotherStringList directly off the wire.
+func (v *AnotherStringList) Decode(sr stream.Reader) error {
+	x, err := _MyStringList_Decode(sr)
+	*v = (AnotherStringList)(x)
+	return err
+}
+
 // Equals returns true if this AnotherStringList is equal to the provided
 // AnotherStringList.
 func (lhs AnotherStringList) Equals(rhs AnotherStringList) bool {
 	return (MyStringList)(lhs).Equals((MyStringList)(rhs))
 }
 
+// Clone returns a deep copy of this AnotherStringList.
+func (v AnotherStringList) Clone() AnotherStringList {
+	x := (MyStringList)(v)
+	return (AnotherStringList)(x.Clone())
+}
+
 func (v AnotherStringList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_sliceType_Zapper)(([]string)(v))).MarshalLogArray(enc)
 }
@@ -320,13 +343,18 @@ func _Set_I32_sliceType_Read(s wire.ValueList) ([]int32, error) {
 	}
 
 	o := make([]int32, 0, s.Size())
+	seen := make(map[int32]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetI32(), error(nil)
 		if err != nil {
 			return err
 		}
 
-		o = append(o, i)
+		if _, dup := seen[i]; !dup {
+			seen[i] = struct{}{}
+			o = append(o, i)
+		}
 		return nil
 	})
 	s.Close()
@@ -339,13 +367,18 @@ func _Set_String_sliceType_Read(s wire.ValueList) ([]string, error) {
 	}
 
 	o := make([]string, 0, s.Size())
+	seen := make(map[string]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetString(), error(nil)
 		if err != nil {
 			return err
 		}
 
-		o = append(o, i)
+		if _, dup := seen[i]; !dup {
+			seen[i] = struct{}{}
+			o = append(o, i)
+		}
 		return nil
 	})
 	s.Close()
@@ -370,9 +403,11 @@ func _Set_Foo_sliceType_Read(s wire.ValueList) ([]*Foo, error) {
 	}
 
 	o := make([]*Foo, 0, s.Size())
+
+	var missing required.Errors
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := _Foo_Read(x)
-		if err != nil {
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 
@@ -380,6 +415,9 @@ func _Set_Foo_sliceType_Read(s wire.ValueList) ([]*Foo, error) {
 		return nil
 	})
 	s.Close()
+	if err == nil {
+		err = missing.Err()
+	}
 	return o, err
 }
 
@@ -395,6 +433,7 @@ func _Set_Set_String_sliceType_sliceType_Read(s wire.ValueList) ([][]string, err
 	}
 
 	o := make([][]string, 0, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := _Set_String_sliceType_Read(x.GetSet())
 		if err != nil {
@@ -445,6 +484,8 @@ func (v *Bar) FromWire(w wire.Value) error {
 	requiredStringListListFieldIsSet := false
 	requiredTypedefStringListListFieldIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -482,7 +523,7 @@ func (v *Bar) FromWire(w wire.Value) error {
 		case 5:
 			if field.Value.Type() == wire.TSet {
 				v.RequiredFooListField, err = _Set_Foo_sliceType_Read(field.Value.GetSet())
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				requiredFooListFieldIsSet = true
@@ -490,7 +531,7 @@ func (v *Bar) FromWire(w wire.Value) error {
 		case 6:
 			if field.Value.Type() == wire.TSet {
 				v.OptionalFooListField, err = _Set_Foo_sliceType_Read(field.Value.GetSet())
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -498,7 +539,7 @@ func (v *Bar) FromWire(w wire.Value) error {
 		case 7:
 			if field.Value.Type() == wire.TSet {
 				v.RequiredTypedefFooListField, err = _FooList_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				requiredTypedefFooListFieldIsSet = true
@@ -506,7 +547,7 @@ func (v *Bar) FromWire(w wire.Value) error {
 		case 8:
 			if field.Value.Type() == wire.TSet {
 				v.OptionalTypedefFooListField, err = _FooList_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -530,6 +571,315 @@ func (v *Bar) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !requiredInt32ListFieldIsSet {
+		missing.Add("Bar", "RequiredInt32ListField")
+	}
+
+	if !requiredTypedefStringListFieldIsSet {
+		missing.Add("Bar", "RequiredTypedefStringListField")
+	}
+
+	if !requiredFooListFieldIsSet {
+		missing.Add("Bar", "RequiredFooListField")
+	}
+
+	if !requiredTypedefFooListFieldIsSet {
+		missing.Add("Bar", "RequiredTypedefFooListField")
+	}
+
+	if !requiredStringListListFieldIsSet {
+		missing.Add("Bar", "RequiredStringListListField")
+	}
+
+	if !requiredTypedefStringListListFieldIsSet {
+		missing.Add("Bar", "RequiredTypedefStringListListField")
+	}
+
+	return missing.Err()
+}
+
+func _Set_I32_sliceType_Decode(sr stream.Reader) ([]int32, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TI32 {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]int32, 0, n)
+	seen := make(map[int32]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, dup := seen[v]; !dup {
+			seen[v] = struct{}{}
+			o = append(o, v)
+		}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Set_String_sliceType_Decode(sr stream.Reader) ([]string, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]string, 0, n)
+	seen := make(map[string]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, dup := seen[v]; !dup {
+			seen[v] = struct{}{}
+			o = append(o, v)
+		}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _StringList_Decode(sr stream.Reader) (StringList, error) {
+	var x StringList
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Foo_Decode(sr stream.Reader) (*Foo, error) {
+	var v Foo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Set_Foo_sliceType_Decode(sr stream.Reader) ([]*Foo, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TStruct {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Foo, 0, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Foo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _FooList_Decode(sr stream.Reader) (FooList, error) {
+	var x FooList
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Set_Set_String_sliceType_sliceType_Decode(sr stream.Reader) ([][]string, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TSet {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([][]string, 0, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Set_String_sliceType_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _StringListList_Decode(sr stream.Reader) (StringListList, error) {
+	var x StringListList
+	err := x.Decode(sr)
+	return x, err
+}
+
+func (v *Bar) Decode(sr stream.Reader) error {
+	requiredInt32ListFieldIsSet := false
+
+	requiredTypedefStringListFieldIsSet := false
+
+	requiredFooListFieldIsSet := false
+
+	requiredTypedefFooListFieldIsSet := false
+
+	requiredStringListListFieldIsSet := false
+	requiredTypedefStringListListFieldIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TSet:
+			v.RequiredInt32ListField, err = _Set_I32_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredInt32ListFieldIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TSet:
+			v.OptionalStringListField, err = _Set_String_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TSet:
+			v.RequiredTypedefStringListField, err = _StringList_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredTypedefStringListFieldIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TSet:
+			v.OptionalTypedefStringListField, err = _StringList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TSet:
+			v.RequiredFooListField, err = _Set_Foo_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredFooListFieldIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TSet:
+			v.OptionalFooListField, err = _Set_Foo_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TSet:
+			v.RequiredTypedefFooListField, err = _FooList_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredTypedefFooListFieldIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TSet:
+			v.OptionalTypedefFooListField, err = _FooList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TSet:
+			v.RequiredStringListListField, err = _Set_Set_String_sliceType_sliceType_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredStringListListFieldIsSet = true
+		case fh.ID == 10 && fh.Type == wire.TSet:
+			v.RequiredTypedefStringListListField, err = _StringListList_Decode(sr)
+			if err != nil {
+				return err
+			}
+			requiredTypedefStringListListFieldIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !requiredInt32ListFieldIsSet {
 		return errors.New("field RequiredInt32ListField of Bar is required")
 	}
@@ -557,6 +907,200 @@ func (v *Bar) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a Bar struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Bar) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.RequiredInt32ListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredInt32ListField":`)
+		buff.Write(b)
+	}
+	if !(len(v.OptionalStringListField) == 0) {
+		b, err := json.Marshal(v.OptionalStringListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalStringListField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.RequiredTypedefStringListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredTypedefStringListField":`)
+		buff.Write(b)
+	}
+	if !(len(v.OptionalTypedefStringListField) == 0) {
+		b, err := json.Marshal(v.OptionalTypedefStringListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalTypedefStringListField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.RequiredFooListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredFooListField":`)
+		buff.Write(b)
+	}
+	if !(len(v.OptionalFooListField) == 0) {
+		b, err := json.Marshal(v.OptionalFooListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalFooListField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.RequiredTypedefFooListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredTypedefFooListField":`)
+		buff.Write(b)
+	}
+	if !(len(v.OptionalTypedefFooListField) == 0) {
+		b, err := json.Marshal(v.OptionalTypedefFooListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalTypedefFooListField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.RequiredStringListListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredStringListListField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.RequiredTypedefStringListListField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredTypedefStringListListField":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Bar struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Bar) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["requiredInt32ListField"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredInt32ListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalStringListField"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalStringListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredTypedefStringListField"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredTypedefStringListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalTypedefStringListField"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalTypedefStringListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredFooListField"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredFooListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalFooListField"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalFooListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredTypedefFooListField"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredTypedefFooListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalTypedefFooListField"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalTypedefFooListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredStringListListField"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredStringListListField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredTypedefStringListListField"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredTypedefStringListListField); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Bar
 // struct.
 func (v *Bar) String() string {
@@ -726,6 +1270,81 @@ func (v *Bar) Equals(rhs *Bar) bool {
 	return true
 }
 
+func _Set_I32_sliceType_Clone(v []int32) []int32 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]int32, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+
+	return o
+}
+
+func _Set_String_sliceType_Clone(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+
+	return o
+}
+
+func _Set_Foo_sliceType_Clone(v []*Foo) []*Foo {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Foo, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+
+	return o
+}
+
+func _Set_Set_String_sliceType_sliceType_Clone(v [][]string) [][]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]string, len(v))
+	for i, x := range v {
+		o[i] = _Set_String_sliceType_Clone(x)
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this Bar. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Bar) Clone() *Bar {
+	if v == nil {
+		return nil
+	}
+
+	var c Bar
+	c.RequiredInt32ListField = _Set_I32_sliceType_Clone(v.RequiredInt32ListField)
+	c.OptionalStringListField = _Set_String_sliceType_Clone(v.OptionalStringListField)
+	c.RequiredTypedefStringListField = v.RequiredTypedefStringListField.Clone()
+	c.OptionalTypedefStringListField = v.OptionalTypedefStringListField.Clone()
+	c.RequiredFooListField = _Set_Foo_sliceType_Clone(v.RequiredFooListField)
+	c.OptionalFooListField = _Set_Foo_sliceType_Clone(v.OptionalFooListField)
+	c.RequiredTypedefFooListField = v.RequiredTypedefFooListField.Clone()
+	c.OptionalTypedefFooListField = v.OptionalTypedefFooListField.Clone()
+	c.RequiredStringListListField = _Set_Set_String_sliceType_sliceType_Clone(v.RequiredStringListListField)
+	c.RequiredTypedefStringListListField = v.RequiredTypedefStringListListField.Clone()
+
+	return &c
+}
+
 type _Set_I32_sliceType_Zapper []int32
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -989,6 +1608,8 @@ func (v *Foo) FromWire(w wire.Value) error {
 
 	stringFieldIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -1002,6 +1623,52 @@ func (v *Foo) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !stringFieldIsSet {
+		missing.Add("Foo", "StringField")
+	}
+
+	return missing.Err()
+}
+
+func (v *Foo) Decode(sr stream.Reader) error {
+	stringFieldIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.StringField, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			stringFieldIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !stringFieldIsSet {
 		return errors.New("field StringField of Foo is required")
 	}
@@ -1009,6 +1676,56 @@ func (v *Foo) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a Foo struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Foo) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.StringField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"stringField":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Foo struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Foo) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["stringField"]; ok {
+		if err := json.Unmarshal(r, &v.StringField); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Foo
 // struct.
 func (v *Foo) String() string {
@@ -1041,6 +1758,20 @@ func (v *Foo) Equals(rhs *Foo) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Foo. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Foo) Clone() *Foo {
+	if v == nil {
+		return nil
+	}
+
+	var c Foo
+	c.StringField = v.StringField
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Foo.
 func (v *Foo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1085,12 +1816,25 @@ func (v *FooList) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes FooList directly off the wire.
+func (v *FooList) Decode(sr stream.Reader) error {
+	x, err := _Set_Foo_sliceType_Decode(sr)
+	*v = (FooList)(x)
+	return err
+}
+
 // Equals returns true if this FooList is equal to the provided
 // FooList.
 func (lhs FooList) Equals(rhs FooList) bool {
 	return _Set_Foo_sliceType_Equals(([]*Foo)(lhs), ([]*Foo)(rhs))
 }
 
+// Clone returns a deep copy of this FooList.
+func (v FooList) Clone() FooList {
+	x := ([]*Foo)(v)
+	return (FooList)(_Set_Foo_sliceType_Clone(x))
+}
+
 func (v FooList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Foo_sliceType_Zapper)(([]*Foo)(v))).MarshalLogArray(enc)
 }
@@ -1120,12 +1864,25 @@ func (v *MyStringList) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes MyStringList directly off the wire.
+func (v *MyStringList) Decode(sr stream.Reader) error {
+	x, err := _StringList_Decode(sr)
+	*v = (MyStringList)(x)
+	return err
+}
+
 // Equals returns true if this MyStringList is equal to the provided
 // MyStringList.
 func (lhs MyStringList) Equals(rhs MyStringList) bool {
 	return (StringList)(lhs).Equals((StringList)(rhs))
 }
 
+// Clone returns a deep copy of this MyStringList.
+func (v MyStringList) Clone() MyStringList {
+	x := (StringList)(v)
+	return (MyStringList)(x.Clone())
+}
+
 func (v MyStringList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_sliceType_Zapper)(([]string)(v))).MarshalLogArray(enc)
 }
@@ -1155,12 +1912,25 @@ func (v *StringList) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes StringList directly off the wire.
+func (v *StringList) Decode(sr stream.Reader) error {
+	x, err := _Set_String_sliceType_Decode(sr)
+	*v = (StringList)(x)
+	return err
+}
+
 // Equals returns true if this StringList is equal to the provided
 // StringList.
 func (lhs StringList) Equals(rhs StringList) bool {
 	return _Set_String_sliceType_Equals(([]string)(lhs), ([]string)(rhs))
 }
 
+// Clone returns a deep copy of this StringList.
+func (v StringList) Clone() StringList {
+	x := ([]string)(v)
+	return (StringList)(_Set_String_sliceType_Clone(x))
+}
+
 func (v StringList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_sliceType_Zapper)(([]string)(v))).MarshalLogArray(enc)
 }
@@ -1190,12 +1960,25 @@ func (v *StringListList) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes StringListList directly off the wire.
+func (v *StringListList) Decode(sr stream.Reader) error {
+	x, err := _Set_Set_String_sliceType_sliceType_Decode(sr)
+	*v = (StringListList)(x)
+	return err
+}
+
 // Equals returns true if this StringListList is equal to the provided
 // StringListList.
 func (lhs StringListList) Equals(rhs StringListList) bool {
 	return _Set_Set_String_sliceType_sliceType_Equals(([][]string)(lhs), ([][]string)(rhs))
 }
 
+// Clone returns a deep copy of this StringListList.
+func (v StringListList) Clone() StringListList {
+	x := ([][]string)(v)
+	return (StringListList)(_Set_Set_String_sliceType_sliceType_Clone(x))
+}
+
 func (v StringListList) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Set_String_sliceType_sliceType_Zapper)(([][]string)(v))).MarshalLogArray(enc)
 }
@@ -1232,6 +2015,7 @@ func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
 	}
 
 	o := make(map[string]struct{}, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetString(), error(nil)
 		if err != nil {
@@ -1245,6 +2029,43 @@ func _Set_String_mapType_Read(s wire.ValueList) (map[string]struct{}, error) {
 	return o, err
 }
 
+func _Set_String_mapType_Decode(sr stream.Reader) (map[string]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]struct{}, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
 	if len(lhs) != len(rhs) {
 		return false
@@ -1259,6 +2080,19 @@ func _Set_String_mapType_Equals(lhs, rhs map[string]struct{}) bool {
 	return true
 }
 
+func _Set_String_mapType_Clone(v map[string]struct{}) map[string]struct{} {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(v))
+	for x := range v {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
 type _Set_String_mapType_Zapper map[string]struct{}
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -1295,12 +2129,25 @@ func (v *StringSet) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes StringSet directly off the wire.
+func (v *StringSet) Decode(sr stream.Reader) error {
+	x, err := _Set_String_mapType_Decode(sr)
+	*v = (StringSet)(x)
+	return err
+}
+
 // Equals returns true if this StringSet is equal to the provided
 // StringSet.
 func (lhs StringSet) Equals(rhs StringSet) bool {
 	return _Set_String_mapType_Equals((map[string]struct{})(lhs), (map[string]struct{})(rhs))
 }
 
+// Clone returns a deep copy of this StringSet.
+func (v StringSet) Clone() StringSet {
+	x := (map[string]struct{})(v)
+	return (StringSet)(_Set_String_mapType_Clone(x))
+}
+
 func (v StringSet) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_String_mapType_Zapper)((map[string]struct{})(v))).MarshalLogArray(enc)
 }
@@ -1311,7 +2158,12 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/set_to_slice",
 	FilePath: "set_to_slice.thrift",
 	SHA1:     "34a394ad873ba45745fa9002fe3625d8162d35e9",
+	Version:  "1.21.0-dev",
 	Raw:      rawIDL,
 }
 
 const rawIDL = "typedef set<string> StringSet\ntypedef set<string> (go.type = \"slice\") StringList\ntypedef set<Foo> (go.type = \"slice\") FooList\ntypedef StringList MyStringList\ntypedef MyStringList AnotherStringList\n\ntypedef set<set<string> (go.type = \"slice\")> (go.type = \"slice\") StringListList\n\nstruct Foo {\n    1: required string stringField\n}\n\nstruct Bar {\n    1: required set<i32> (go.type = \"slice\") requiredInt32ListField\n    2: optional set<string> (go.type = \"slice\") optionalStringListField\n    3: required StringList requiredTypedefStringListField\n    4: optional StringList optionalTypedefStringListField\n    5: required set<Foo> (go.type = \"slice\") requiredFooListField\n    6: optional set<Foo> (go.type = \"slice\") optionalFooListField\n    7: required FooList requiredTypedefFooListField\n    8: optional FooList optionalTypedefFooListField\n    9: required set<set<string> (go.type = \"slice\")> (go.type = \"slice\") requiredStringListListField\n    10: required StringListList requiredTypedefStringListListField\n}\n\nconst set<string> (go.type = \"slice\") ConstStringList = [\"hello\"]\nconst set<set<string>(go.type = \"slice\")> (go.type = \"slice\") ConstListStringList = [[\"hello\"], [\"world\"]]\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -6,22 +6,28 @@ package structs
 import (
 	bytes "bytes"
 	base64 "encoding/base64"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
+	stream "go.uber.org/thriftrw/protocol/stream"
 	ptr "go.uber.org/thriftrw/ptr"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	validation "go.uber.org/thriftrw/validation"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
+	regexp "regexp"
 	strings "strings"
 )
 
-type ContactInfo struct {
-	EmailAddress string `json:"emailAddress,required"`
+type Branch struct {
+	Tree    *Tree   `json:"tree,required"`
+	Sibling *Branch `json:"sibling,omitempty"`
 }
 
-// ToWire translates a ContactInfo struct into a Thrift-level intermediate
+// ToWire translates a Branch struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -36,29 +42,52 @@ type ContactInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ContactInfo) ToWire() (wire.Value, error) {
+func (v *Branch) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.EmailAddress), error(nil)
+	if v.Tree == nil {
+		return w, errors.New("field Tree of Branch is required")
+	}
+	w, err = v.Tree.ToWire()
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
+	if v.Sibling != nil {
+		w, err = v.Sibling.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ContactInfo struct from its Thrift-level
+func _Tree_Read(w wire.Value) (*Tree, error) {
+	var v Tree
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Branch_Read(w wire.Value) (*Branch, error) {
+	var v Branch
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Branch struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ContactInfo struct
+// An error is returned if we were unable to build a Branch struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -66,155 +95,277 @@ func (v *ContactInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ContactInfo
+//   var v Branch
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ContactInfo) FromWire(w wire.Value) error {
+func (v *Branch) FromWire(w wire.Value) error {
 	var err error
 
-	emailAddressIsSet := false
+	treeIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.EmailAddress, err = field.Value.GetString(), error(nil)
-				if err != nil {
+			if field.Value.Type() == wire.TStruct {
+				v.Tree, err = _Tree_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
-				emailAddressIsSet = true
+				treeIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Sibling, err = _Branch_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
-	if !emailAddressIsSet {
-		return errors.New("field EmailAddress of ContactInfo is required")
+	if !treeIsSet {
+		missing.Add("Branch", "Tree")
+	}
+
+	return missing.Err()
+}
+
+func _Tree_Decode(sr stream.Reader) (*Tree, error) {
+	var v Tree
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Branch_Decode(sr stream.Reader) (*Branch, error) {
+	var v Branch
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Branch) Decode(sr stream.Reader) error {
+	treeIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Tree, err = _Tree_Decode(sr)
+			if err != nil {
+				return err
+			}
+			treeIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Sibling, err = _Branch_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !treeIsSet {
+		return errors.New("field Tree of Branch is required")
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a ContactInfo
+// MarshalJSON serializes a Branch struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Branch) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Tree)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"tree":`)
+		buff.Write(b)
+	}
+	if !(v.Sibling == nil) {
+		b, err := json.Marshal(v.Sibling)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"sibling":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Branch struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Branch) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["tree"]; ok {
+		if err := json.Unmarshal(r, &v.Tree); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["sibling"]; ok {
+		if err := json.Unmarshal(r, &v.Sibling); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Branch
 // struct.
-func (v *ContactInfo) String() string {
+func (v *Branch) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("EmailAddress: %v", v.EmailAddress)
+	fields[i] = fmt.Sprintf("Tree: %v", v.Tree)
 	i++
+	if v.Sibling != nil {
+		fields[i] = fmt.Sprintf("Sibling: %v", v.Sibling)
+		i++
+	}
 
-	return fmt.Sprintf("ContactInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Branch{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ContactInfo match the
-// provided ContactInfo.
+// Equals returns true if all the fields of this Branch match the
+// provided Branch.
 //
 // This function performs a deep comparison.
-func (v *ContactInfo) Equals(rhs *ContactInfo) bool {
+func (v *Branch) Equals(rhs *Branch) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.EmailAddress == rhs.EmailAddress) {
+	if !v.Tree.Equals(rhs.Tree) {
+		return false
+	}
+	if !((v.Sibling == nil && rhs.Sibling == nil) || (v.Sibling != nil && rhs.Sibling != nil && v.Sibling.Equals(rhs.Sibling))) {
 		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this Branch. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Branch) Clone() *Branch {
+	if v == nil {
+		return nil
+	}
+
+	var c Branch
+	c.Tree = v.Tree.Clone()
+	c.Sibling = v.Sibling.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ContactInfo.
-func (v *ContactInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Branch.
+func (v *Branch) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("emailAddress", v.EmailAddress)
+	err = multierr.Append(err, enc.AddObject("tree", v.Tree))
+	if v.Sibling != nil {
+		err = multierr.Append(err, enc.AddObject("sibling", v.Sibling))
+	}
 	return err
 }
 
-// GetEmailAddress returns the value of EmailAddress if it is set or its
+// GetTree returns the value of Tree if it is set or its
 // zero value if it is unset.
-func (v *ContactInfo) GetEmailAddress() (o string) {
+func (v *Branch) GetTree() (o *Tree) {
 	if v != nil {
-		o = v.EmailAddress
+		o = v.Tree
 	}
 	return
 }
 
-type DefaultsStruct struct {
-	RequiredPrimitive *int32             `json:"requiredPrimitive,omitempty"`
-	OptionalPrimitive *int32             `json:"optionalPrimitive,omitempty"`
-	RequiredEnum      *enums.EnumDefault `json:"requiredEnum,omitempty"`
-	OptionalEnum      *enums.EnumDefault `json:"optionalEnum,omitempty"`
-	RequiredList      []string           `json:"requiredList,omitempty"`
-	OptionalList      []float64          `json:"optionalList,omitempty"`
-	RequiredStruct    *Frame             `json:"requiredStruct,omitempty"`
-	OptionalStruct    *Edge              `json:"optionalStruct,omitempty"`
-}
-
-func _EnumDefault_ptr(v enums.EnumDefault) *enums.EnumDefault {
-	return &v
+// IsSetTree returns true if Tree is not nil.
+func (v *Branch) IsSetTree() bool {
+	return v != nil && v.Tree != nil
 }
 
-type _List_String_ValueList []string
-
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetSibling returns the value of Sibling if it is set or its
+// zero value if it is unset.
+func (v *Branch) GetSibling() (o *Branch) {
+	if v != nil && v.Sibling != nil {
+		return v.Sibling
 	}
-	return nil
-}
-
-func (v _List_String_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
-}
-
-func (_List_String_ValueList) Close() {}
-
-type _List_Double_ValueList []float64
 
-func (v _List_Double_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueDouble(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return
 }
 
-func (v _List_Double_ValueList) Size() int {
-	return len(v)
+// IsSetSibling returns true if Sibling is not nil.
+func (v *Branch) IsSetSibling() bool {
+	return v != nil && v.Sibling != nil
 }
 
-func (_List_Double_ValueList) ValueType() wire.Type {
-	return wire.TDouble
+type ContactInfo struct {
+	EmailAddress string `json:"emailAddress,required"`
 }
 
-func (_List_Double_ValueList) Close() {}
-
-// ToWire translates a DefaultsStruct struct into a Thrift-level intermediate
+// ToWire translates a ContactInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -229,34 +380,339 @@ func (_List_Double_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DefaultsStruct) ToWire() (wire.Value, error) {
+func (v *ContactInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.RequiredPrimitive == nil {
-		v.RequiredPrimitive = ptr.Int32(100)
+	w, err = wire.NewValueString(v.EmailAddress), error(nil)
+	if err != nil {
+		return w, err
 	}
-	{
-		w, err = wire.NewValueI32(*(v.RequiredPrimitive)), error(nil)
-		if err != nil {
-			return w, err
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ContactInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ContactInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ContactInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ContactInfo) FromWire(w wire.Value) error {
+	var err error
+
+	emailAddressIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.EmailAddress, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				emailAddressIsSet = true
+			}
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
 	}
-	if v.OptionalPrimitive == nil {
-		v.OptionalPrimitive = ptr.Int32(200)
+
+	if !emailAddressIsSet {
+		missing.Add("ContactInfo", "EmailAddress")
 	}
-	{
-		w, err = wire.NewValueI32(*(v.OptionalPrimitive)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 2, Value: w}
+
+	return missing.Err()
+}
+
+func (v *ContactInfo) Decode(sr stream.Reader) error {
+	emailAddressIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.EmailAddress, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			emailAddressIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !emailAddressIsSet {
+		return errors.New("field EmailAddress of ContactInfo is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ContactInfo struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ContactInfo) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.EmailAddress)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"emailAddress":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ContactInfo struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ContactInfo) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["emailAddress"]; ok {
+		if err := json.Unmarshal(r, &v.EmailAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ContactInfo
+// struct.
+func (v *ContactInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("EmailAddress: %v", v.EmailAddress)
+	i++
+
+	return fmt.Sprintf("ContactInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ContactInfo match the
+// provided ContactInfo.
+//
+// This function performs a deep comparison.
+func (v *ContactInfo) Equals(rhs *ContactInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.EmailAddress == rhs.EmailAddress) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this ContactInfo. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ContactInfo) Clone() *ContactInfo {
+	if v == nil {
+		return nil
+	}
+
+	var c ContactInfo
+	c.EmailAddress = v.EmailAddress
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ContactInfo.
+func (v *ContactInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("emailAddress", v.EmailAddress)
+	return err
+}
+
+// GetEmailAddress returns the value of EmailAddress if it is set or its
+// zero value if it is unset.
+func (v *ContactInfo) GetEmailAddress() (o string) {
+	if v != nil {
+		o = v.EmailAddress
+	}
+	return
+}
+
+type DefaultsStruct struct {
+	RequiredPrimitive *int32             `json:"requiredPrimitive,omitempty"`
+	OptionalPrimitive *int32             `json:"optionalPrimitive,omitempty"`
+	RequiredEnum      *enums.EnumDefault `json:"requiredEnum,omitempty"`
+	OptionalEnum      *enums.EnumDefault `json:"optionalEnum,omitempty"`
+	RequiredList      []string           `json:"requiredList,omitempty"`
+	OptionalList      []float64          `json:"optionalList,omitempty"`
+	RequiredStruct    *Frame             `json:"requiredStruct,omitempty"`
+	OptionalStruct    *Edge              `json:"optionalStruct,omitempty"`
+}
+
+func _EnumDefault_ptr(v enums.EnumDefault) *enums.EnumDefault {
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _List_Double_ValueList []float64
+
+func (v _List_Double_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueDouble(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Double_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Double_ValueList) ValueType() wire.Type {
+	return wire.TDouble
+}
+
+func (_List_Double_ValueList) Close() {}
+
+// ToWire translates a DefaultsStruct struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DefaultsStruct) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.RequiredPrimitive == nil {
+		v.RequiredPrimitive = ptr.Int32(100)
+	}
+	{
+		w, err = wire.NewValueI32(*(v.RequiredPrimitive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.OptionalPrimitive == nil {
+		v.OptionalPrimitive = ptr.Int32(200)
+	}
+	{
+		w, err = wire.NewValueI32(*(v.OptionalPrimitive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.RequiredEnum == nil {
@@ -428,6 +884,8 @@ func _Edge_Read(w wire.Value) (*Edge, error) {
 func (v *DefaultsStruct) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -489,7 +947,7 @@ func (v *DefaultsStruct) FromWire(w wire.Value) error {
 		case 7:
 			if field.Value.Type() == wire.TStruct {
 				v.RequiredStruct, err = _Frame_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -497,7 +955,7 @@ func (v *DefaultsStruct) FromWire(w wire.Value) error {
 		case 8:
 			if field.Value.Type() == wire.TStruct {
 				v.OptionalStruct, err = _Edge_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -562,33 +1020,433 @@ func (v *DefaultsStruct) FromWire(w wire.Value) error {
 		}
 	}
 
-	return nil
+	return missing.Err()
 }
 
-// String returns a readable string representation of a DefaultsStruct
-// struct.
-func (v *DefaultsStruct) String() string {
-	if v == nil {
-		return "<nil>"
+func _EnumDefault_Decode(sr stream.Reader) (enums.EnumDefault, error) {
+	var v enums.EnumDefault
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	var fields [8]string
-	i := 0
-	if v.RequiredPrimitive != nil {
-		fields[i] = fmt.Sprintf("RequiredPrimitive: %v", *(v.RequiredPrimitive))
-		i++
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
 	}
-	if v.OptionalPrimitive != nil {
-		fields[i] = fmt.Sprintf("OptionalPrimitive: %v", *(v.OptionalPrimitive))
-		i++
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
 	}
-	if v.RequiredEnum != nil {
-		fields[i] = fmt.Sprintf("RequiredEnum: %v", *(v.RequiredEnum))
-		i++
+
+	o := make([]string, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
 	}
-	if v.OptionalEnum != nil {
-		fields[i] = fmt.Sprintf("OptionalEnum: %v", *(v.OptionalEnum))
-		i++
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_Double_Decode(sr stream.Reader) ([]float64, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TDouble {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]float64, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Frame_Decode(sr stream.Reader) (*Frame, error) {
+	var v Frame
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Edge_Decode(sr stream.Reader) (*Edge, error) {
+	var v Edge
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *DefaultsStruct) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RequiredPrimitive = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.OptionalPrimitive = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x enums.EnumDefault
+			x, err = _EnumDefault_Decode(sr)
+			v.RequiredEnum = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x enums.EnumDefault
+			x, err = _EnumDefault_Decode(sr)
+			v.OptionalEnum = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.RequiredList, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TList:
+			v.OptionalList, err = _List_Double_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.RequiredStruct, err = _Frame_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TStruct:
+			v.OptionalStruct, err = _Edge_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.RequiredPrimitive == nil {
+		v.RequiredPrimitive = ptr.Int32(100)
+	}
+
+	if v.OptionalPrimitive == nil {
+		v.OptionalPrimitive = ptr.Int32(200)
+	}
+
+	if v.RequiredEnum == nil {
+		v.RequiredEnum = _EnumDefault_ptr(enums.EnumDefaultBar)
+	}
+
+	if v.OptionalEnum == nil {
+		v.OptionalEnum = _EnumDefault_ptr(enums.EnumDefaultBaz)
+	}
+
+	if v.RequiredList == nil {
+		v.RequiredList = []string{
+			"hello",
+			"world",
+		}
+	}
+
+	if v.OptionalList == nil {
+		v.OptionalList = []float64{
+			1,
+			2,
+			3,
+		}
+	}
+
+	if v.RequiredStruct == nil {
+		v.RequiredStruct = &Frame{
+			Size: &Size{
+				Height: 200,
+				Width:  100,
+			},
+			TopLeft: &Point{
+				X: 1,
+				Y: 2,
+			},
+		}
+	}
+
+	if v.OptionalStruct == nil {
+		v.OptionalStruct = &Edge{
+			EndPoint: &Point{
+				X: 3,
+				Y: 4,
+			},
+			StartPoint: &Point{
+				X: 1,
+				Y: 2,
+			},
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a DefaultsStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *DefaultsStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.RequiredPrimitive == nil) {
+		b, err := json.Marshal(v.RequiredPrimitive)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredPrimitive":`)
+		buff.Write(b)
+	}
+	if !(v.OptionalPrimitive == nil) {
+		b, err := json.Marshal(v.OptionalPrimitive)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalPrimitive":`)
+		buff.Write(b)
+	}
+	if !(v.RequiredEnum == nil) {
+		b, err := json.Marshal(v.RequiredEnum)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredEnum":`)
+		buff.Write(b)
+	}
+	if !(v.OptionalEnum == nil) {
+		b, err := json.Marshal(v.OptionalEnum)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalEnum":`)
+		buff.Write(b)
+	}
+	if !(len(v.RequiredList) == 0) {
+		b, err := json.Marshal(v.RequiredList)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredList":`)
+		buff.Write(b)
+	}
+	if !(len(v.OptionalList) == 0) {
+		b, err := json.Marshal(v.OptionalList)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalList":`)
+		buff.Write(b)
+	}
+	if !(v.RequiredStruct == nil) {
+		b, err := json.Marshal(v.RequiredStruct)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"requiredStruct":`)
+		buff.Write(b)
+	}
+	if !(v.OptionalStruct == nil) {
+		b, err := json.Marshal(v.OptionalStruct)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"optionalStruct":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a DefaultsStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *DefaultsStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["requiredPrimitive"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredPrimitive); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalPrimitive"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalPrimitive); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredEnum"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredEnum); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalEnum"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalEnum); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredList"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredList); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalList"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalList); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["requiredStruct"]; ok {
+		if err := json.Unmarshal(r, &v.RequiredStruct); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["optionalStruct"]; ok {
+		if err := json.Unmarshal(r, &v.OptionalStruct); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DefaultsStruct
+// struct.
+func (v *DefaultsStruct) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.RequiredPrimitive != nil {
+		fields[i] = fmt.Sprintf("RequiredPrimitive: %v", *(v.RequiredPrimitive))
+		i++
+	}
+	if v.OptionalPrimitive != nil {
+		fields[i] = fmt.Sprintf("OptionalPrimitive: %v", *(v.OptionalPrimitive))
+		i++
+	}
+	if v.RequiredEnum != nil {
+		fields[i] = fmt.Sprintf("RequiredEnum: %v", *(v.RequiredEnum))
+		i++
+	}
+	if v.OptionalEnum != nil {
+		fields[i] = fmt.Sprintf("OptionalEnum: %v", *(v.OptionalEnum))
+		i++
 	}
 	if v.RequiredList != nil {
 		fields[i] = fmt.Sprintf("RequiredList: %v", v.RequiredList)
@@ -698,6 +1556,69 @@ func (v *DefaultsStruct) Equals(rhs *DefaultsStruct) bool {
 	return true
 }
 
+func _I32_ClonePtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _EnumDefault_ClonePtr(v *enums.EnumDefault) *enums.EnumDefault {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_String_Clone(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_Double_Clone(v []float64) []float64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]float64, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this DefaultsStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *DefaultsStruct) Clone() *DefaultsStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c DefaultsStruct
+	c.RequiredPrimitive = _I32_ClonePtr(v.RequiredPrimitive)
+	c.OptionalPrimitive = _I32_ClonePtr(v.OptionalPrimitive)
+	c.RequiredEnum = _EnumDefault_ClonePtr(v.RequiredEnum)
+	c.OptionalEnum = _EnumDefault_ClonePtr(v.OptionalEnum)
+	c.RequiredList = _List_String_Clone(v.RequiredList)
+	c.OptionalList = _List_Double_Clone(v.OptionalList)
+	c.RequiredStruct = v.RequiredStruct.Clone()
+	c.OptionalStruct = v.OptionalStruct.Clone()
+
+	return &c
+}
+
 type _List_String_Zapper []string
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -977,12 +1898,14 @@ func (v *Edge) FromWire(w wire.Value) error {
 	startPointIsSet := false
 	endPointIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.StartPoint, err = _Point_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				startPointIsSet = true
@@ -990,7 +1913,7 @@ func (v *Edge) FromWire(w wire.Value) error {
 		case 2:
 			if field.Value.Type() == wire.TStruct {
 				v.EndPoint, err = _Point_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				endPointIsSet = true
@@ -998,6 +1921,69 @@ func (v *Edge) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !startPointIsSet {
+		missing.Add("Edge", "StartPoint")
+	}
+
+	if !endPointIsSet {
+		missing.Add("Edge", "EndPoint")
+	}
+
+	return missing.Err()
+}
+
+func _Point_Decode(sr stream.Reader) (*Point, error) {
+	var v Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Edge) Decode(sr stream.Reader) error {
+	startPointIsSet := false
+	endPointIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.StartPoint, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+			startPointIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.EndPoint, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+			endPointIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !startPointIsSet {
 		return errors.New("field StartPoint of Edge is required")
 	}
@@ -1009,6 +1995,72 @@ func (v *Edge) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a Edge struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Edge) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.StartPoint)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"startPoint":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.EndPoint)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"endPoint":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Edge struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Edge) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["startPoint"]; ok {
+		if err := json.Unmarshal(r, &v.StartPoint); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["endPoint"]; ok {
+		if err := json.Unmarshal(r, &v.EndPoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Edge
 // struct.
 func (v *Edge) String() string {
@@ -1046,6 +2098,21 @@ func (v *Edge) Equals(rhs *Edge) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Edge. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Edge) Clone() *Edge {
+	if v == nil {
+		return nil
+	}
+
+	var c Edge
+	c.StartPoint = v.StartPoint.Clone()
+	c.EndPoint = v.EndPoint.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Edge.
 func (v *Edge) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1139,6 +2206,70 @@ func (v *EmptyStruct) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *EmptyStruct) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a EmptyStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *EmptyStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a EmptyStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *EmptyStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a EmptyStruct
 // struct.
 func (v *EmptyStruct) String() string {
@@ -1166,6 +2297,19 @@ func (v *EmptyStruct) Equals(rhs *EmptyStruct) bool {
 	return true
 }
 
+// Clone returns a deep copy of this EmptyStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *EmptyStruct) Clone() *EmptyStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c EmptyStruct
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of EmptyStruct.
 func (v *EmptyStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1175,6 +2319,179 @@ func (v *EmptyStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	return err
 }
 
+type _List_Tree_ValueList []*Tree
+
+func (v _List_Tree_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Tree_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Tree_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Tree_ValueList) Close() {}
+
+func _List_Tree_Read(l wire.ValueList) ([]*Tree, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Tree, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Tree_Read(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+func _List_Tree_Decode(sr stream.Reader) ([]*Tree, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Tree, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Tree_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_Tree_Equals(lhs, rhs []*Tree) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Tree_Clone(v []*Tree) []*Tree {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Tree, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+type _List_Tree_Zapper []*Tree
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Tree_Zapper.
+func (l _List_Tree_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type Forest []*Tree
+
+// ToWire translates Forest into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Forest) ToWire() (wire.Value, error) {
+	x := ([]*Tree)(v)
+	return wire.NewValueList(_List_Tree_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Forest.
+func (v Forest) String() string {
+	x := ([]*Tree)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Forest from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Forest) FromWire(w wire.Value) error {
+	x, err := _List_Tree_Read(w.GetList())
+	*v = (Forest)(x)
+	return err
+}
+
+// Decode deserializes Forest directly off the wire.
+func (v *Forest) Decode(sr stream.Reader) error {
+	x, err := _List_Tree_Decode(sr)
+	*v = (Forest)(x)
+	return err
+}
+
+// Equals returns true if this Forest is equal to the provided
+// Forest.
+func (lhs Forest) Equals(rhs Forest) bool {
+	return _List_Tree_Equals(([]*Tree)(lhs), ([]*Tree)(rhs))
+}
+
+// Clone returns a deep copy of this Forest.
+func (v Forest) Clone() Forest {
+	x := ([]*Tree)(v)
+	return (Forest)(_List_Tree_Clone(x))
+}
+
+func (v Forest) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_Tree_Zapper)(([]*Tree)(v))).MarshalLogArray(enc)
+}
+
 type Frame struct {
 	TopLeft *Point `json:"topLeft,required"`
 	Size    *Size  `json:"size,required"`
@@ -1254,12 +2571,14 @@ func (v *Frame) FromWire(w wire.Value) error {
 	topLeftIsSet := false
 	sizeIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.TopLeft, err = _Point_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				topLeftIsSet = true
@@ -1267,7 +2586,7 @@ func (v *Frame) FromWire(w wire.Value) error {
 		case 2:
 			if field.Value.Type() == wire.TStruct {
 				v.Size, err = _Size_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				sizeIsSet = true
@@ -1276,42 +2595,171 @@ func (v *Frame) FromWire(w wire.Value) error {
 	}
 
 	if !topLeftIsSet {
-		return errors.New("field TopLeft of Frame is required")
+		missing.Add("Frame", "TopLeft")
 	}
 
 	if !sizeIsSet {
-		return errors.New("field Size of Frame is required")
+		missing.Add("Frame", "Size")
 	}
 
-	return nil
+	return missing.Err()
 }
 
-// String returns a readable string representation of a Frame
-// struct.
-func (v *Frame) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+func _Size_Decode(sr stream.Reader) (*Size, error) {
+	var v Size
+	err := v.Decode(sr)
+	return &v, err
+}
 
-	var fields [2]string
-	i := 0
-	fields[i] = fmt.Sprintf("TopLeft: %v", v.TopLeft)
-	i++
-	fields[i] = fmt.Sprintf("Size: %v", v.Size)
-	i++
+func (v *Frame) Decode(sr stream.Reader) error {
+	topLeftIsSet := false
+	sizeIsSet := false
 
-	return fmt.Sprintf("Frame{%v}", strings.Join(fields[:i], ", "))
-}
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
 
-// Equals returns true if all the fields of this Frame match the
-// provided Frame.
-//
-// This function performs a deep comparison.
-func (v *Frame) Equals(rhs *Frame) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.TopLeft, err = _Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+			topLeftIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Size, err = _Size_Decode(sr)
+			if err != nil {
+				return err
+			}
+			sizeIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !topLeftIsSet {
+		return errors.New("field TopLeft of Frame is required")
+	}
+
+	if !sizeIsSet {
+		return errors.New("field Size of Frame is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Frame struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Frame) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.TopLeft)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"topLeft":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Size)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"size":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Frame struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Frame) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["topLeft"]; ok {
+		if err := json.Unmarshal(r, &v.TopLeft); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["size"]; ok {
+		if err := json.Unmarshal(r, &v.Size); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Frame
+// struct.
+func (v *Frame) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("TopLeft: %v", v.TopLeft)
+	i++
+	fields[i] = fmt.Sprintf("Size: %v", v.Size)
+	i++
+
+	return fmt.Sprintf("Frame{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Frame match the
+// provided Frame.
+//
+// This function performs a deep comparison.
+func (v *Frame) Equals(rhs *Frame) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
 	if !v.TopLeft.Equals(rhs.TopLeft) {
 		return false
@@ -1323,6 +2771,21 @@ func (v *Frame) Equals(rhs *Frame) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Frame. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Frame) Clone() *Frame {
+	if v == nil {
+		return nil
+	}
+
+	var c Frame
+	c.TopLeft = v.TopLeft.Clone()
+	c.Size = v.Size.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Frame.
 func (v *Frame) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1468,6 +2931,8 @@ func (v *GoTags) FromWire(w wire.Value) error {
 
 	FooBarWithRequiredIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -1525,6 +2990,103 @@ func (v *GoTags) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !FooIsSet {
+		missing.Add("GoTags", "Foo")
+	}
+
+	if !FooBarIsSet {
+		missing.Add("GoTags", "FooBar")
+	}
+
+	if !FooBarWithSpaceIsSet {
+		missing.Add("GoTags", "FooBarWithSpace")
+	}
+
+	if !FooBarWithRequiredIsSet {
+		missing.Add("GoTags", "FooBarWithRequired")
+	}
+
+	return missing.Err()
+}
+
+func (v *GoTags) Decode(sr stream.Reader) error {
+	FooIsSet := false
+
+	FooBarIsSet := false
+	FooBarWithSpaceIsSet := false
+
+	FooBarWithRequiredIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Foo, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			FooIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Bar = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.FooBar, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			FooBarIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			v.FooBarWithSpace, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			FooBarWithSpaceIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FooBarWithOmitEmpty = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TBinary:
+			v.FooBarWithRequired, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			FooBarWithRequiredIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !FooIsSet {
 		return errors.New("field Foo of GoTags is required")
 	}
@@ -1544,6 +3106,120 @@ func (v *GoTags) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a GoTags struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *GoTags) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Bar == nil) {
+		b, err := json.Marshal(v.Bar)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"Bar":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.FooBar)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"foobar":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.FooBarWithSpace)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"foobarWithSpace":`)
+		buff.Write(b)
+	}
+	if !(v.FooBarWithOmitEmpty == nil) {
+		b, err := json.Marshal(v.FooBarWithOmitEmpty)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"foobarWithOmitEmpty":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.FooBarWithRequired)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"foobarWithRequired":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a GoTags struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *GoTags) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["Bar"]; ok {
+		if err := json.Unmarshal(r, &v.Bar); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["foobar"]; ok {
+		if err := json.Unmarshal(r, &v.FooBar); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["foobarWithSpace"]; ok {
+		if err := json.Unmarshal(r, &v.FooBarWithSpace); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["foobarWithOmitEmpty"]; ok {
+		if err := json.Unmarshal(r, &v.FooBarWithOmitEmpty); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["foobarWithRequired"]; ok {
+		if err := json.Unmarshal(r, &v.FooBarWithRequired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a GoTags
 // struct.
 func (v *GoTags) String() string {
@@ -1615,6 +3291,34 @@ func (v *GoTags) Equals(rhs *GoTags) bool {
 	return true
 }
 
+func _String_ClonePtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this GoTags. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *GoTags) Clone() *GoTags {
+	if v == nil {
+		return nil
+	}
+
+	var c GoTags
+	c.Foo = v.Foo
+	c.Bar = _String_ClonePtr(v.Bar)
+	c.FooBar = v.FooBar
+	c.FooBarWithSpace = v.FooBarWithSpace
+	c.FooBarWithOmitEmpty = _String_ClonePtr(v.FooBarWithOmitEmpty)
+	c.FooBarWithRequired = v.FooBarWithRequired
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of GoTags.
 func (v *GoTags) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1779,15 +3483,19 @@ func _List_Edge_Read(l wire.ValueList) ([]*Edge, error) {
 	}
 
 	o := make([]*Edge, 0, l.Size())
+	var missing required.Errors
 	err := l.ForEach(func(x wire.Value) error {
 		i, err := _Edge_Read(x)
-		if err != nil {
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 		o = append(o, i)
 		return nil
 	})
 	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
 	return o, err
 }
 
@@ -1813,12 +3521,14 @@ func (v *Graph) FromWire(w wire.Value) error {
 
 	edgesIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TList {
 				v.Edges, err = _List_Edge_Read(field.Value.GetList())
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				edgesIsSet = true
@@ -1827,60 +3537,218 @@ func (v *Graph) FromWire(w wire.Value) error {
 	}
 
 	if !edgesIsSet {
-		return errors.New("field Edges of Graph is required")
+		missing.Add("Graph", "Edges")
 	}
 
-	return nil
+	return missing.Err()
 }
 
-// String returns a readable string representation of a Graph
-// struct.
-func (v *Graph) String() string {
-	if v == nil {
-		return "<nil>"
+func _List_Edge_Decode(sr stream.Reader) ([]*Edge, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	var fields [1]string
-	i := 0
-	fields[i] = fmt.Sprintf("Edges: %v", v.Edges)
-	i++
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
 
-	return fmt.Sprintf("Graph{%v}", strings.Join(fields[:i], ", "))
-}
+	n := lh.Length
+	if n > 1024 {
 
-func _List_Edge_Equals(lhs, rhs []*Edge) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
+	o := make([]*Edge, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Edge_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return true
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Equals returns true if all the fields of this Graph match the
-// provided Graph.
-//
-// This function performs a deep comparison.
-func (v *Graph) Equals(rhs *Graph) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_List_Edge_Equals(v.Edges, rhs.Edges) {
-		return false
+func (v *Graph) Decode(sr stream.Reader) error {
+	edgesIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	return true
-}
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
 
-type _List_Edge_Zapper []*Edge
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Edges, err = _List_Edge_Decode(sr)
+			if err != nil {
+				return err
+			}
+			edgesIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !edgesIsSet {
+		return errors.New("field Edges of Graph is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Graph struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Graph) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Edges)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"edges":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Graph struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Graph) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["edges"]; ok {
+		if err := json.Unmarshal(r, &v.Edges); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Graph
+// struct.
+func (v *Graph) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Edges: %v", v.Edges)
+	i++
+
+	return fmt.Sprintf("Graph{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Edge_Equals(lhs, rhs []*Edge) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Graph match the
+// provided Graph.
+//
+// This function performs a deep comparison.
+func (v *Graph) Equals(rhs *Graph) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_List_Edge_Equals(v.Edges, rhs.Edges) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Edge_Clone(v []*Edge) []*Edge {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Edge, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this Graph. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Graph) Clone() *Graph {
+	if v == nil {
+		return nil
+	}
+
+	var c Graph
+	c.Edges = _List_Edge_Clone(v.Edges)
+
+	return &c
+}
+
+type _List_Edge_Zapper []*Edge
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
 // fast logging of _List_Edge_Zapper.
@@ -1915,47 +3783,14 @@ func (v *Graph) IsSetEdges() bool {
 	return v != nil && v.Edges != nil
 }
 
-type List Node
-
-// ToWire translates List into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-func (v *List) ToWire() (wire.Value, error) {
-	x := (*Node)(v)
-	return x.ToWire()
-}
-
-// String returns a readable string representation of List.
-func (v *List) String() string {
-	x := (*Node)(v)
-	return fmt.Sprint(x)
-}
-
-// FromWire deserializes List from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-func (v *List) FromWire(w wire.Value) error {
-	return (*Node)(v).FromWire(w)
-}
-
-// Equals returns true if this List is equal to the provided
-// List.
-func (lhs *List) Equals(rhs *List) bool {
-	return (*Node)(lhs).Equals((*Node)(rhs))
-}
-
-func (v *List) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	return ((*Node)(v)).MarshalLogObject(enc)
-}
-
-// Node is linked list of values.
-// All values are 32-bit integers.
-type Node struct {
-	Value int32 `json:"value,required"`
-	Tail  *List `json:"tail,omitempty"`
+type JSONNames struct {
+	UserName  string  `json:"user_name,required"`
+	UserID    *int64  `json:"user_id,omitempty"`
+	Nickname  *string `json:"nick_name,omitempty"`
+	CreatedAt int64   `json:"createdAt,required"`
 }
 
-// ToWire translates a Node struct into a Thrift-level intermediate
+// ToWire translates a JSONNames struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1970,43 +3805,52 @@ type Node struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Node) ToWire() (wire.Value, error) {
+func (v *JSONNames) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueI32(v.Value), error(nil)
+	w, err = wire.NewValueString(v.UserName), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
-	if v.Tail != nil {
-		w, err = v.Tail.ToWire()
+	if v.UserID != nil {
+		w, err = wire.NewValueI64(*(v.UserID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.Nickname != nil {
+		w, err = wire.NewValueString(*(v.Nickname)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
+	w, err = wire.NewValueI64(v.CreatedAt), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
 
-func _List_Read(w wire.Value) (*List, error) {
-	var x List
-	err := x.FromWire(w)
-	return &x, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Node struct from its Thrift-level
+// FromWire deserializes a JSONNames struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Node struct
+// An error is returned if we were unable to build a JSONNames struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2014,126 +3858,482 @@ func _List_Read(w wire.Value) (*List, error) {
 //     return nil, err
 //   }
 //
-//   var v Node
+//   var v JSONNames
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Node) FromWire(w wire.Value) error {
+func (v *JSONNames) FromWire(w wire.Value) error {
 	var err error
 
-	valueIsSet := false
+	userNameIsSet := false
+
+	createdAtIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TI32 {
-				v.Value, err = field.Value.GetI32(), error(nil)
+			if field.Value.Type() == wire.TBinary {
+				v.UserName, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				valueIsSet = true
+				userNameIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TStruct {
-				v.Tail, err = _List_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.UserID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Nickname = &x
 				if err != nil {
 					return err
 				}
 
 			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				v.CreatedAt, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return err
+				}
+				createdAtIsSet = true
+			}
 		}
 	}
 
-	if !valueIsSet {
-		return errors.New("field Value of Node is required")
+	if !userNameIsSet {
+		missing.Add("JSONNames", "UserName")
 	}
 
-	return nil
+	if !createdAtIsSet {
+		missing.Add("JSONNames", "CreatedAt")
+	}
+
+	return missing.Err()
 }
 
-// String returns a readable string representation of a Node
-// struct.
-func (v *Node) String() string {
-	if v == nil {
-		return "<nil>"
+func (v *JSONNames) Decode(sr stream.Reader) error {
+	userNameIsSet := false
+
+	createdAtIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	var fields [2]string
-	i := 0
-	fields[i] = fmt.Sprintf("Value: %v", v.Value)
-	i++
-	if v.Tail != nil {
-		fields[i] = fmt.Sprintf("Tail: %v", v.Tail)
-		i++
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return fmt.Sprintf("Node{%v}", strings.Join(fields[:i], ", "))
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.UserName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			userNameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.UserID = &x
+			if err != nil {
+				return err
+			}
 
-// Equals returns true if all the fields of this Node match the
-// provided Node.
-//
-// This function performs a deep comparison.
-func (v *Node) Equals(rhs *Node) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Nickname = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TI64:
+			v.CreatedAt, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			createdAtIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	if !(v.Value == rhs.Value) {
-		return false
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
-	if !((v.Tail == nil && rhs.Tail == nil) || (v.Tail != nil && rhs.Tail != nil && v.Tail.Equals(rhs.Tail))) {
-		return false
+
+	if !userNameIsSet {
+		return errors.New("field UserName of JSONNames is required")
 	}
 
-	return true
+	if !createdAtIsSet {
+		return errors.New("field CreatedAt of JSONNames is required")
+	}
+
+	return nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Node.
-func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+func _I64_UnmarshalJSON(text []byte) (*int64, error) {
+	// json.Number accepts both JSON numbers and JSON strings holding
+	// numbers, and retains all digits of the value.
+	var n json.Number
+	if err := json.Unmarshal(text, &n); err != nil {
+		return nil, err
 	}
-	enc.AddInt32("value", v.Value)
-	if v.Tail != nil {
-		err = multierr.Append(err, enc.AddObject("tail", (*Node)(v.Tail)))
+	if n == "" {
+		return nil, nil
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// MarshalJSON serializes a JSONNames struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *JSONNames) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.UserName)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"user_name":`)
+		buff.Write(b)
+	}
+	if !(v.UserID == nil) {
+		b, err := json.Marshal(v.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"user_id":`)
+		buff.Write(b)
+	}
+	if !(v.Nickname == nil) {
+		b, err := json.Marshal(v.Nickname)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"nick_name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"createdAt":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a JSONNames struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *JSONNames) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["user_name"]; ok {
+		if err := json.Unmarshal(r, &v.UserName); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["user_id"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.UserID = (*int64)(x)
+	}
+	if r, ok := raw["nick_name"]; ok {
+		if err := json.Unmarshal(r, &v.Nickname); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["createdAt"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		if x != nil {
+			v.CreatedAt = (int64)(*x)
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a JSONNames
+// struct.
+func (v *JSONNames) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("UserName: %v", v.UserName)
+	i++
+	if v.UserID != nil {
+		fields[i] = fmt.Sprintf("UserID: %v", *(v.UserID))
+		i++
+	}
+	if v.Nickname != nil {
+		fields[i] = fmt.Sprintf("Nickname: %v", *(v.Nickname))
+		i++
+	}
+	fields[i] = fmt.Sprintf("CreatedAt: %v", v.CreatedAt)
+	i++
+
+	return fmt.Sprintf("JSONNames{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this JSONNames match the
+// provided JSONNames.
+//
+// This function performs a deep comparison.
+func (v *JSONNames) Equals(rhs *JSONNames) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.UserName == rhs.UserName) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.UserID, rhs.UserID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Nickname, rhs.Nickname) {
+		return false
+	}
+	if !(v.CreatedAt == rhs.CreatedAt) {
+		return false
 	}
+
+	return true
+}
+
+func _I64_ClonePtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this JSONNames. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *JSONNames) Clone() *JSONNames {
+	if v == nil {
+		return nil
+	}
+
+	var c JSONNames
+	c.UserName = v.UserName
+	c.UserID = _I64_ClonePtr(v.UserID)
+	c.Nickname = _String_ClonePtr(v.Nickname)
+	c.CreatedAt = v.CreatedAt
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of JSONNames.
+func (v *JSONNames) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("userName", v.UserName)
+	if v.UserID != nil {
+		enc.AddInt64("id", *v.UserID)
+	}
+	if v.Nickname != nil {
+		enc.AddString("nickname", *v.Nickname)
+	}
+	enc.AddInt64("createdAt", v.CreatedAt)
 	return err
 }
 
-// GetValue returns the value of Value if it is set or its
+// GetUserName returns the value of UserName if it is set or its
 // zero value if it is unset.
-func (v *Node) GetValue() (o int32) {
+func (v *JSONNames) GetUserName() (o string) {
 	if v != nil {
-		o = v.Value
+		o = v.UserName
 	}
 	return
 }
 
-// GetTail returns the value of Tail if it is set or its
+// GetUserID returns the value of UserID if it is set or its
 // zero value if it is unset.
-func (v *Node) GetTail() (o *List) {
-	if v != nil && v.Tail != nil {
-		return v.Tail
+func (v *JSONNames) GetUserID() (o int64) {
+	if v != nil && v.UserID != nil {
+		return *v.UserID
 	}
 
 	return
 }
 
-// IsSetTail returns true if Tail is not nil.
-func (v *Node) IsSetTail() bool {
-	return v != nil && v.Tail != nil
+// IsSetUserID returns true if UserID is not nil.
+func (v *JSONNames) IsSetUserID() bool {
+	return v != nil && v.UserID != nil
 }
 
-type Omit struct {
-	Serialized string `json:"serialized,required"`
-	Hidden     string `json:"-"`
+// GetNickname returns the value of Nickname if it is set or its
+// zero value if it is unset.
+func (v *JSONNames) GetNickname() (o string) {
+	if v != nil && v.Nickname != nil {
+		return *v.Nickname
+	}
+
+	return
 }
 
-// ToWire translates a Omit struct into a Thrift-level intermediate
+// IsSetNickname returns true if Nickname is not nil.
+func (v *JSONNames) IsSetNickname() bool {
+	return v != nil && v.Nickname != nil
+}
+
+// GetCreatedAt returns the value of CreatedAt if it is set or its
+// zero value if it is unset.
+func (v *JSONNames) GetCreatedAt() (o int64) {
+	if v != nil {
+		o = v.CreatedAt
+	}
+	return
+}
+
+type List Node
+
+// ToWire translates List into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *List) ToWire() (wire.Value, error) {
+	x := (*Node)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of List.
+func (v *List) String() string {
+	x := (*Node)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes List from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *List) FromWire(w wire.Value) error {
+	return (*Node)(v).FromWire(w)
+}
+
+// Decode deserializes List directly off the wire.
+func (v *List) Decode(sr stream.Reader) error {
+	return (*Node)(v).Decode(sr)
+}
+
+// MarshalJSON serializes List into JSON.
+func (v *List) MarshalJSON() ([]byte, error) {
+	return (*Node)(v).MarshalJSON()
+}
+
+// UnmarshalJSON deserializes List from JSON.
+func (v *List) UnmarshalJSON(text []byte) error {
+	return (*Node)(v).UnmarshalJSON(text)
+}
+
+// Equals returns true if this List is equal to the provided
+// List.
+func (lhs *List) Equals(rhs *List) bool {
+	return (*Node)(lhs).Equals((*Node)(rhs))
+}
+
+// Clone returns a deep copy of this List.
+func (v *List) Clone() *List {
+	x := (*Node)(v)
+	return (*List)(x.Clone())
+}
+
+func (v *List) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((*Node)(v)).MarshalLogObject(enc)
+}
+
+// Node is linked list of values.
+// All values are 32-bit integers.
+type Node struct {
+	Value int32 `json:"value,required"`
+	Tail  *List `json:"tail,omitempty"`
+}
+
+// ToWire translates a Node struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2148,7 +4348,7 @@ type Omit struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Omit) ToWire() (wire.Value, error) {
+func (v *Node) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -2156,28 +4356,35 @@ func (v *Omit) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Serialized), error(nil)
+	w, err = wire.NewValueI32(v.Value), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
-
-	w, err = wire.NewValueString(v.Hidden), error(nil)
-	if err != nil {
-		return w, err
+	if v.Tail != nil {
+		w, err = v.Tail.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Omit struct from its Thrift-level
+func _List_Read(w wire.Value) (*List, error) {
+	var x List
+	err := x.FromWire(w)
+	return &x, err
+}
+
+// FromWire deserializes a Node struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Omit struct
+// An error is returned if we were unable to build a Node struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2185,260 +4392,267 @@ func (v *Omit) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Omit
+//   var v Node
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Omit) FromWire(w wire.Value) error {
+func (v *Node) FromWire(w wire.Value) error {
 	var err error
 
-	serializedIsSet := false
-	hiddenIsSet := false
+	valueIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Serialized, err = field.Value.GetString(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				v.Value, err = field.Value.GetI32(), error(nil)
 				if err != nil {
 					return err
 				}
-				serializedIsSet = true
+				valueIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TBinary {
-				v.Hidden, err = field.Value.GetString(), error(nil)
-				if err != nil {
+			if field.Value.Type() == wire.TStruct {
+				v.Tail, err = _List_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
-				hiddenIsSet = true
+
 			}
 		}
 	}
 
-	if !serializedIsSet {
-		return errors.New("field Serialized of Omit is required")
+	if !valueIsSet {
+		missing.Add("Node", "Value")
 	}
 
-	if !hiddenIsSet {
-		return errors.New("field Hidden of Omit is required")
-	}
+	return missing.Err()
+}
 
-	return nil
+func _List_Decode(sr stream.Reader) (*List, error) {
+	var x List
+	err := x.Decode(sr)
+	return &x, err
 }
 
-// String returns a readable string representation of a Omit
-// struct.
-func (v *Omit) String() string {
-	if v == nil {
-		return "<nil>"
+func (v *Node) Decode(sr stream.Reader) error {
+	valueIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	var fields [2]string
-	i := 0
-	fields[i] = fmt.Sprintf("Serialized: %v", v.Serialized)
-	i++
-	fields[i] = fmt.Sprintf("Hidden: %v", v.Hidden)
-	i++
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
 
-	return fmt.Sprintf("Omit{%v}", strings.Join(fields[:i], ", "))
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.Value, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			valueIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Tail, err = _List_Decode(sr)
+			if err != nil {
+				return err
+			}
 
-// Equals returns true if all the fields of this Omit match the
-// provided Omit.
-//
-// This function performs a deep comparison.
-func (v *Omit) Equals(rhs *Omit) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !(v.Serialized == rhs.Serialized) {
-		return false
-	}
-	if !(v.Hidden == rhs.Hidden) {
-		return false
-	}
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-	return true
-}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Omit.
-func (v *Omit) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	enc.AddString("serialized", v.Serialized)
-	enc.AddString("hidden", v.Hidden)
-	return err
-}
 
-// GetSerialized returns the value of Serialized if it is set or its
-// zero value if it is unset.
-func (v *Omit) GetSerialized() (o string) {
-	if v != nil {
-		o = v.Serialized
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
-	return
-}
 
-// GetHidden returns the value of Hidden if it is set or its
-// zero value if it is unset.
-func (v *Omit) GetHidden() (o string) {
-	if v != nil {
-		o = v.Hidden
+	if !valueIsSet {
+		return errors.New("field Value of Node is required")
 	}
-	return
-}
 
-type PersonalInfo struct {
-	Age *int32 `json:"age,omitempty"`
+	return nil
 }
 
-// ToWire translates a PersonalInfo struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// MarshalJSON serializes a Node struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PersonalInfo) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// This implements json.Marshaler.
+func (v *Node) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
 
-	if v.Age != nil {
-		w, err = wire.NewValueI32(*(v.Age)), error(nil)
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Value)
 		if err != nil {
-			return w, err
+			return nil, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"value":`)
+		buff.Write(b)
+	}
+	if !(v.Tail == nil) {
+		b, err := json.Marshal(v.Tail)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"tail":`)
+		buff.Write(b)
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-// FromWire deserializes a PersonalInfo struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a PersonalInfo struct
-// from the provided intermediate representation.
+// UnmarshalJSON deserializes a Node struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
 //
-//   var v PersonalInfo
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *PersonalInfo) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Age = &x
-				if err != nil {
-					return err
-				}
-
-			}
+// This implements json.Unmarshaler.
+func (v *Node) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["value"]; ok {
+		if err := json.Unmarshal(r, &v.Value); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["tail"]; ok {
+		if err := json.Unmarshal(r, &v.Tail); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a PersonalInfo
+// String returns a readable string representation of a Node
 // struct.
-func (v *PersonalInfo) String() string {
+func (v *Node) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	if v.Age != nil {
-		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
+	fields[i] = fmt.Sprintf("Value: %v", v.Value)
+	i++
+	if v.Tail != nil {
+		fields[i] = fmt.Sprintf("Tail: %v", v.Tail)
 		i++
 	}
 
-	return fmt.Sprintf("PersonalInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Node{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PersonalInfo match the
-// provided PersonalInfo.
+// Equals returns true if all the fields of this Node match the
+// provided Node.
 //
 // This function performs a deep comparison.
-func (v *PersonalInfo) Equals(rhs *PersonalInfo) bool {
+func (v *Node) Equals(rhs *Node) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.Age, rhs.Age) {
+	if !(v.Value == rhs.Value) {
+		return false
+	}
+	if !((v.Tail == nil && rhs.Tail == nil) || (v.Tail != nil && rhs.Tail != nil && v.Tail.Equals(rhs.Tail))) {
 		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this Node. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Node) Clone() *Node {
+	if v == nil {
+		return nil
+	}
+
+	var c Node
+	c.Value = v.Value
+	c.Tail = v.Tail.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PersonalInfo.
-func (v *PersonalInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Node.
+func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Age != nil {
-		enc.AddInt32("age", *v.Age)
+	enc.AddInt32("value", v.Value)
+	if v.Tail != nil {
+		err = multierr.Append(err, enc.AddObject("tail", (*Node)(v.Tail)))
 	}
 	return err
 }
 
-// GetAge returns the value of Age if it is set or its
+// GetValue returns the value of Value if it is set or its
 // zero value if it is unset.
-func (v *PersonalInfo) GetAge() (o int32) {
-	if v != nil && v.Age != nil {
-		return *v.Age
+func (v *Node) GetValue() (o int32) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
+// GetTail returns the value of Tail if it is set or its
+// zero value if it is unset.
+func (v *Node) GetTail() (o *List) {
+	if v != nil && v.Tail != nil {
+		return v.Tail
 	}
 
 	return
 }
 
-// IsSetAge returns true if Age is not nil.
-func (v *PersonalInfo) IsSetAge() bool {
-	return v != nil && v.Age != nil
+// IsSetTail returns true if Tail is not nil.
+func (v *Node) IsSetTail() bool {
+	return v != nil && v.Tail != nil
 }
 
-// A point in 2D space.
-type Point struct {
-	X float64 `json:"x,required"`
-	Y float64 `json:"y,required"`
+type Omit struct {
+	Serialized string `json:"serialized,required"`
+	Hidden     string `json:"-"`
 }
 
-// ToWire translates a Point struct into a Thrift-level intermediate
+// ToWire translates a Omit struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2453,7 +4667,7 @@ type Point struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Point) ToWire() (wire.Value, error) {
+func (v *Omit) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -2461,14 +4675,14 @@ func (v *Point) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueDouble(v.X), error(nil)
+	w, err = wire.NewValueString(v.Serialized), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueDouble(v.Y), error(nil)
+	w, err = wire.NewValueString(v.Hidden), error(nil)
 	if err != nil {
 		return w, err
 	}
@@ -2478,11 +4692,11 @@ func (v *Point) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Point struct from its Thrift-level
+// FromWire deserializes a Omit struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Point struct
+// An error is returned if we were unable to build a Omit struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2490,130 +4704,244 @@ func (v *Point) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Point
+//   var v Omit
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Point) FromWire(w wire.Value) error {
+func (v *Omit) FromWire(w wire.Value) error {
 	var err error
 
-	xIsSet := false
-	yIsSet := false
+	serializedIsSet := false
+	hiddenIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TDouble {
-				v.X, err = field.Value.GetDouble(), error(nil)
+			if field.Value.Type() == wire.TBinary {
+				v.Serialized, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				xIsSet = true
+				serializedIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TDouble {
-				v.Y, err = field.Value.GetDouble(), error(nil)
+			if field.Value.Type() == wire.TBinary {
+				v.Hidden, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				yIsSet = true
+				hiddenIsSet = true
 			}
 		}
 	}
 
-	if !xIsSet {
-		return errors.New("field X of Point is required")
+	if !serializedIsSet {
+		missing.Add("Omit", "Serialized")
 	}
 
-	if !yIsSet {
-		return errors.New("field Y of Point is required")
+	if !hiddenIsSet {
+		missing.Add("Omit", "Hidden")
 	}
 
-	return nil
+	return missing.Err()
 }
 
-// String returns a readable string representation of a Point
-// struct.
-func (v *Point) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
+func (v *Omit) Decode(sr stream.Reader) error {
+	serializedIsSet := false
+	hiddenIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Serialized, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			serializedIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Hidden, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			hiddenIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !serializedIsSet {
+		return errors.New("field Serialized of Omit is required")
+	}
+
+	if !hiddenIsSet {
+		return errors.New("field Hidden of Omit is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Omit struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Omit) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Serialized)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"serialized":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Omit struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Omit) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["serialized"]; ok {
+		if err := json.Unmarshal(r, &v.Serialized); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Omit
+// struct.
+func (v *Omit) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
 	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("X: %v", v.X)
+	fields[i] = fmt.Sprintf("Serialized: %v", v.Serialized)
 	i++
-	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	fields[i] = fmt.Sprintf("Hidden: %v", v.Hidden)
 	i++
 
-	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Omit{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Point match the
-// provided Point.
+// Equals returns true if all the fields of this Omit match the
+// provided Omit.
 //
 // This function performs a deep comparison.
-func (v *Point) Equals(rhs *Point) bool {
+func (v *Omit) Equals(rhs *Omit) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.X == rhs.X) {
+	if !(v.Serialized == rhs.Serialized) {
 		return false
 	}
-	if !(v.Y == rhs.Y) {
+	if !(v.Hidden == rhs.Hidden) {
 		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this Omit. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Omit) Clone() *Omit {
+	if v == nil {
+		return nil
+	}
+
+	var c Omit
+	c.Serialized = v.Serialized
+	c.Hidden = v.Hidden
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Point.
-func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Omit.
+func (v *Omit) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddFloat64("x", v.X)
-	enc.AddFloat64("y", v.Y)
+	enc.AddString("serialized", v.Serialized)
+	enc.AddString("hidden", v.Hidden)
 	return err
 }
 
-// GetX returns the value of X if it is set or its
+// GetSerialized returns the value of Serialized if it is set or its
 // zero value if it is unset.
-func (v *Point) GetX() (o float64) {
+func (v *Omit) GetSerialized() (o string) {
 	if v != nil {
-		o = v.X
+		o = v.Serialized
 	}
 	return
 }
 
-// GetY returns the value of Y if it is set or its
+// GetHidden returns the value of Hidden if it is set or its
 // zero value if it is unset.
-func (v *Point) GetY() (o float64) {
+func (v *Omit) GetHidden() (o string) {
 	if v != nil {
-		o = v.Y
+		o = v.Hidden
 	}
 	return
 }
 
-// A struct that contains primitive fields exclusively.
-//
-// All fields are optional.
-type PrimitiveOptionalStruct struct {
-	BoolField   *bool    `json:"boolField,omitempty"`
-	ByteField   *int8    `json:"byteField,omitempty"`
-	Int16Field  *int16   `json:"int16Field,omitempty"`
-	Int32Field  *int32   `json:"int32Field,omitempty"`
-	Int64Field  *int64   `json:"int64Field,omitempty"`
-	DoubleField *float64 `json:"doubleField,omitempty"`
-	StringField *string  `json:"stringField,omitempty"`
-	BinaryField []byte   `json:"binaryField,omitempty"`
+type PersonalInfo struct {
+	Age *int32 `json:"age,omitempty"`
 }
 
-// ToWire translates a PrimitiveOptionalStruct struct into a Thrift-level intermediate
+// ToWire translates a PersonalInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2628,87 +4956,31 @@ type PrimitiveOptionalStruct struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PrimitiveOptionalStruct) ToWire() (wire.Value, error) {
+func (v *PersonalInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.BoolField != nil {
-		w, err = wire.NewValueBool(*(v.BoolField)), error(nil)
+	if v.Age != nil {
+		w, err = wire.NewValueI32(*(v.Age)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.ByteField != nil {
-		w, err = wire.NewValueI8(*(v.ByteField)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 2, Value: w}
-		i++
-	}
-	if v.Int16Field != nil {
-		w, err = wire.NewValueI16(*(v.Int16Field)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.Int32Field != nil {
-		w, err = wire.NewValueI32(*(v.Int32Field)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.Int64Field != nil {
-		w, err = wire.NewValueI64(*(v.Int64Field)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.DoubleField != nil {
-		w, err = wire.NewValueDouble(*(v.DoubleField)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 6, Value: w}
-		i++
-	}
-	if v.StringField != nil {
-		w, err = wire.NewValueString(*(v.StringField)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 7, Value: w}
-		i++
-	}
-	if v.BinaryField != nil {
-		w, err = wire.NewValueBinary(v.BinaryField), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 8, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PrimitiveOptionalStruct struct from its Thrift-level
+// FromWire deserializes a PersonalInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PrimitiveOptionalStruct struct
+// An error is returned if we were unable to build a PersonalInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2716,401 +4988,522 @@ func (v *PrimitiveOptionalStruct) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PrimitiveOptionalStruct
+//   var v PersonalInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PrimitiveOptionalStruct) FromWire(w wire.Value) error {
+func (v *PersonalInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.BoolField = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 2:
-			if field.Value.Type() == wire.TI8 {
-				var x int8
-				x, err = field.Value.GetI8(), error(nil)
-				v.ByteField = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TI16 {
-				var x int16
-				x, err = field.Value.GetI16(), error(nil)
-				v.Int16Field = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.Int32Field = &x
+				v.Age = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 5:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Int64Field = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
+
+	return nil
+}
+
+func (v *PersonalInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
 
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Age = &x
+			if err != nil {
+				return err
 			}
-		case 6:
-			if field.Value.Type() == wire.TDouble {
-				var x float64
-				x, err = field.Value.GetDouble(), error(nil)
-				v.DoubleField = &x
-				if err != nil {
-					return err
-				}
 
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
 			}
-		case 7:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.StringField = &x
-				if err != nil {
-					return err
-				}
+		}
 
-			}
-		case 8:
-			if field.Value.Type() == wire.TBinary {
-				v.BinaryField, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-			}
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
 		}
 	}
 
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a PrimitiveOptionalStruct
-// struct.
-func (v *PrimitiveOptionalStruct) String() string {
+// MarshalJSON serializes a PersonalInfo struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PersonalInfo) MarshalJSON() ([]byte, error) {
 	if v == nil {
-		return "<nil>"
+		return []byte("null"), nil
 	}
 
-	var fields [8]string
-	i := 0
-	if v.BoolField != nil {
-		fields[i] = fmt.Sprintf("BoolField: %v", *(v.BoolField))
-		i++
-	}
-	if v.ByteField != nil {
-		fields[i] = fmt.Sprintf("ByteField: %v", *(v.ByteField))
-		i++
-	}
-	if v.Int16Field != nil {
-		fields[i] = fmt.Sprintf("Int16Field: %v", *(v.Int16Field))
-		i++
-	}
-	if v.Int32Field != nil {
-		fields[i] = fmt.Sprintf("Int32Field: %v", *(v.Int32Field))
-		i++
-	}
-	if v.Int64Field != nil {
-		fields[i] = fmt.Sprintf("Int64Field: %v", *(v.Int64Field))
-		i++
-	}
-	if v.DoubleField != nil {
-		fields[i] = fmt.Sprintf("DoubleField: %v", *(v.DoubleField))
-		i++
-	}
-	if v.StringField != nil {
-		fields[i] = fmt.Sprintf("StringField: %v", *(v.StringField))
-		i++
-	}
-	if v.BinaryField != nil {
-		fields[i] = fmt.Sprintf("BinaryField: %v", v.BinaryField)
-		i++
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Age == nil) {
+		b, err := json.Marshal(v.Age)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"age":`)
+		buff.Write(b)
 	}
 
-	return fmt.Sprintf("PrimitiveOptionalStruct{%v}", strings.Join(fields[:i], ", "))
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// UnmarshalJSON deserializes a PersonalInfo struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PersonalInfo) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
 	}
-	return lhs == nil && rhs == nil
-}
-
-func _Byte_EqualsPtr(lhs, rhs *int8) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	if r, ok := raw["age"]; ok {
+		if err := json.Unmarshal(r, &v.Age); err != nil {
+			return err
+		}
 	}
-	return lhs == nil && rhs == nil
-}
-
-func _I16_EqualsPtr(lhs, rhs *int16) bool {
-	if lhs != nil && rhs != nil {
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return nil
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// String returns a readable string representation of a PersonalInfo
+// struct.
+func (v *PersonalInfo) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return lhs == nil && rhs == nil
-}
-
-func _Double_EqualsPtr(lhs, rhs *float64) bool {
-	if lhs != nil && rhs != nil {
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	var fields [1]string
+	i := 0
+	if v.Age != nil {
+		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
+		i++
 	}
-	return lhs == nil && rhs == nil
+
+	return fmt.Sprintf("PersonalInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PrimitiveOptionalStruct match the
-// provided PrimitiveOptionalStruct.
+// Equals returns true if all the fields of this PersonalInfo match the
+// provided PersonalInfo.
 //
 // This function performs a deep comparison.
-func (v *PrimitiveOptionalStruct) Equals(rhs *PrimitiveOptionalStruct) bool {
+func (v *PersonalInfo) Equals(rhs *PersonalInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.BoolField, rhs.BoolField) {
-		return false
-	}
-	if !_Byte_EqualsPtr(v.ByteField, rhs.ByteField) {
-		return false
-	}
-	if !_I16_EqualsPtr(v.Int16Field, rhs.Int16Field) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Int32Field, rhs.Int32Field) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Int64Field, rhs.Int64Field) {
-		return false
-	}
-	if !_Double_EqualsPtr(v.DoubleField, rhs.DoubleField) {
-		return false
-	}
-	if !_String_EqualsPtr(v.StringField, rhs.StringField) {
-		return false
-	}
-	if !((v.BinaryField == nil && rhs.BinaryField == nil) || (v.BinaryField != nil && rhs.BinaryField != nil && bytes.Equal(v.BinaryField, rhs.BinaryField))) {
+	if !_I32_EqualsPtr(v.Age, rhs.Age) {
 		return false
 	}
 
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PrimitiveOptionalStruct.
-func (v *PrimitiveOptionalStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// Clone returns a deep copy of this PersonalInfo. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PersonalInfo) Clone() *PersonalInfo {
 	if v == nil {
 		return nil
 	}
-	if v.BoolField != nil {
-		enc.AddBool("boolField", *v.BoolField)
-	}
-	if v.ByteField != nil {
-		enc.AddInt8("byteField", *v.ByteField)
-	}
-	if v.Int16Field != nil {
-		enc.AddInt16("int16Field", *v.Int16Field)
-	}
-	if v.Int32Field != nil {
-		enc.AddInt32("int32Field", *v.Int32Field)
-	}
-	if v.Int64Field != nil {
-		enc.AddInt64("int64Field", *v.Int64Field)
-	}
-	if v.DoubleField != nil {
-		enc.AddFloat64("doubleField", *v.DoubleField)
-	}
-	if v.StringField != nil {
-		enc.AddString("stringField", *v.StringField)
+
+	var c PersonalInfo
+	c.Age = _I32_ClonePtr(v.Age)
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PersonalInfo.
+func (v *PersonalInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	if v.BinaryField != nil {
-		enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
+	if v.Age != nil {
+		enc.AddInt32("age", *v.Age)
 	}
 	return err
 }
 
-// GetBoolField returns the value of BoolField if it is set or its
+// GetAge returns the value of Age if it is set or its
 // zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetBoolField() (o bool) {
-	if v != nil && v.BoolField != nil {
-		return *v.BoolField
+func (v *PersonalInfo) GetAge() (o int32) {
+	if v != nil && v.Age != nil {
+		return *v.Age
 	}
 
 	return
 }
 
-// IsSetBoolField returns true if BoolField is not nil.
-func (v *PrimitiveOptionalStruct) IsSetBoolField() bool {
-	return v != nil && v.BoolField != nil
+// IsSetAge returns true if Age is not nil.
+func (v *PersonalInfo) IsSetAge() bool {
+	return v != nil && v.Age != nil
 }
 
-// GetByteField returns the value of ByteField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetByteField() (o int8) {
-	if v != nil && v.ByteField != nil {
-		return *v.ByteField
-	}
-
-	return
+// A point in 2D space.
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
 }
 
-// IsSetByteField returns true if ByteField is not nil.
-func (v *PrimitiveOptionalStruct) IsSetByteField() bool {
-	return v != nil && v.ByteField != nil
-}
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// GetInt16Field returns the value of Int16Field if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetInt16Field() (o int16) {
-	if v != nil && v.Int16Field != nil {
-		return *v.Int16Field
+	w, err = wire.NewValueDouble(v.X), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
-	return
-}
+	w, err = wire.NewValueDouble(v.Y), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
 
-// IsSetInt16Field returns true if Int16Field is not nil.
-func (v *PrimitiveOptionalStruct) IsSetInt16Field() bool {
-	return v != nil && v.Int16Field != nil
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// GetInt32Field returns the value of Int32Field if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetInt32Field() (o int32) {
-	if v != nil && v.Int32Field != nil {
-		return *v.Int32Field
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var err error
+
+	xIsSet := false
+	yIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				yIsSet = true
+			}
+		}
 	}
 
-	return
+	if !xIsSet {
+		missing.Add("Point", "X")
+	}
+
+	if !yIsSet {
+		missing.Add("Point", "Y")
+	}
+
+	return missing.Err()
 }
 
-// IsSetInt32Field returns true if Int32Field is not nil.
-func (v *PrimitiveOptionalStruct) IsSetInt32Field() bool {
-	return v != nil && v.Int32Field != nil
+func (v *Point) Decode(sr stream.Reader) error {
+	xIsSet := false
+	yIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.X, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			xIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Y, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			yIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
 }
 
-// GetInt64Field returns the value of Int64Field if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetInt64Field() (o int64) {
-	if v != nil && v.Int64Field != nil {
-		return *v.Int64Field
+// MarshalJSON serializes a Point struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Point) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
 	}
 
-	return
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.X)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"x":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Y)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"y":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-// IsSetInt64Field returns true if Int64Field is not nil.
-func (v *PrimitiveOptionalStruct) IsSetInt64Field() bool {
-	return v != nil && v.Int64Field != nil
+// UnmarshalJSON deserializes a Point struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Point) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["x"]; ok {
+		if err := json.Unmarshal(r, &v.X); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["y"]; ok {
+		if err := json.Unmarshal(r, &v.Y); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// GetDoubleField returns the value of DoubleField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetDoubleField() (o float64) {
-	if v != nil && v.DoubleField != nil {
-		return *v.DoubleField
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
 }
 
-// IsSetDoubleField returns true if DoubleField is not nil.
-func (v *PrimitiveOptionalStruct) IsSetDoubleField() bool {
-	return v != nil && v.DoubleField != nil
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
 }
 
-// GetStringField returns the value of StringField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetStringField() (o string) {
-	if v != nil && v.StringField != nil {
-		return *v.StringField
+// Clone returns a deep copy of this Point. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Point) Clone() *Point {
+	if v == nil {
+		return nil
 	}
 
-	return
+	var c Point
+	c.X = v.X
+	c.Y = v.Y
+
+	return &c
 }
 
-// IsSetStringField returns true if StringField is not nil.
-func (v *PrimitiveOptionalStruct) IsSetStringField() bool {
-	return v != nil && v.StringField != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return err
 }
 
-// GetBinaryField returns the value of BinaryField if it is set or its
+// GetX returns the value of X if it is set or its
 // zero value if it is unset.
-func (v *PrimitiveOptionalStruct) GetBinaryField() (o []byte) {
-	if v != nil && v.BinaryField != nil {
-		return v.BinaryField
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
 	}
-
 	return
 }
 
-// IsSetBinaryField returns true if BinaryField is not nil.
-func (v *PrimitiveOptionalStruct) IsSetBinaryField() bool {
-	return v != nil && v.BinaryField != nil
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
 }
 
 // A struct that contains primitive fields exclusively.
 //
-// All fields are required.
-type PrimitiveRequiredStruct struct {
-	BoolField   bool    `json:"boolField,required"`
-	ByteField   int8    `json:"byteField,required"`
-	Int16Field  int16   `json:"int16Field,required"`
-	Int32Field  int32   `json:"int32Field,required"`
-	Int64Field  int64   `json:"int64Field,required"`
-	DoubleField float64 `json:"doubleField,required"`
-	StringField string  `json:"stringField,required"`
-	BinaryField []byte  `json:"binaryField,required"`
+// All fields are optional.
+type PrimitiveOptionalStruct struct {
+	BoolField   *bool    `json:"boolField,omitempty"`
+	ByteField   *int8    `json:"byteField,omitempty"`
+	Int16Field  *int16   `json:"int16Field,omitempty"`
+	Int32Field  *int32   `json:"int32Field,omitempty"`
+	Int64Field  *int64   `json:"int64Field,omitempty"`
+	DoubleField *float64 `json:"doubleField,omitempty"`
+	StringField *string  `json:"stringField,omitempty"`
+	BinaryField []byte   `json:"binaryField,omitempty"`
 }
 
-// ToWire translates a PrimitiveRequiredStruct struct into a Thrift-level intermediate
+// ToWire translates a PrimitiveOptionalStruct struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3125,7 +5518,7 @@ type PrimitiveRequiredStruct struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PrimitiveRequiredStruct) ToWire() (wire.Value, error) {
+func (v *PrimitiveOptionalStruct) ToWire() (wire.Value, error) {
 	var (
 		fields [8]wire.Field
 		i      int = 0
@@ -3133,72 +5526,79 @@ func (v *PrimitiveRequiredStruct) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueBool(v.BoolField), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	w, err = wire.NewValueI8(v.ByteField), error(nil)
-	if err != nil {
-		return w, err
+	if v.BoolField != nil {
+		w, err = wire.NewValueBool(*(v.BoolField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
-
-	w, err = wire.NewValueI16(v.Int16Field), error(nil)
-	if err != nil {
-		return w, err
+	if v.ByteField != nil {
+		w, err = wire.NewValueI8(*(v.ByteField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
-
-	w, err = wire.NewValueI32(v.Int32Field), error(nil)
-	if err != nil {
-		return w, err
+	if v.Int16Field != nil {
+		w, err = wire.NewValueI16(*(v.Int16Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 4, Value: w}
-	i++
-
-	w, err = wire.NewValueI64(v.Int64Field), error(nil)
-	if err != nil {
-		return w, err
+	if v.Int32Field != nil {
+		w, err = wire.NewValueI32(*(v.Int32Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 5, Value: w}
-	i++
-
-	w, err = wire.NewValueDouble(v.DoubleField), error(nil)
-	if err != nil {
-		return w, err
+	if v.Int64Field != nil {
+		w, err = wire.NewValueI64(*(v.Int64Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 6, Value: w}
-	i++
-
-	w, err = wire.NewValueString(v.StringField), error(nil)
-	if err != nil {
-		return w, err
+	if v.DoubleField != nil {
+		w, err = wire.NewValueDouble(*(v.DoubleField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 7, Value: w}
-	i++
-	if v.BinaryField == nil {
-		return w, errors.New("field BinaryField of PrimitiveRequiredStruct is required")
+	if v.StringField != nil {
+		w, err = wire.NewValueString(*(v.StringField)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
 	}
-	w, err = wire.NewValueBinary(v.BinaryField), error(nil)
-	if err != nil {
-		return w, err
+	if v.BinaryField != nil {
+		w, err = wire.NewValueBinary(v.BinaryField), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 8, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PrimitiveRequiredStruct struct from its Thrift-level
+// FromWire deserializes a PrimitiveOptionalStruct struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PrimitiveRequiredStruct struct
+// An error is returned if we were unable to build a PrimitiveOptionalStruct struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3206,80 +5606,85 @@ func (v *PrimitiveRequiredStruct) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PrimitiveRequiredStruct
+//   var v PrimitiveOptionalStruct
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
+func (v *PrimitiveOptionalStruct) FromWire(w wire.Value) error {
 	var err error
 
-	boolFieldIsSet := false
-	byteFieldIsSet := false
-	int16FieldIsSet := false
-	int32FieldIsSet := false
-	int64FieldIsSet := false
-	doubleFieldIsSet := false
-	stringFieldIsSet := false
-	binaryFieldIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				v.BoolField, err = field.Value.GetBool(), error(nil)
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.BoolField = &x
 				if err != nil {
 					return err
 				}
-				boolFieldIsSet = true
+
 			}
 		case 2:
 			if field.Value.Type() == wire.TI8 {
-				v.ByteField, err = field.Value.GetI8(), error(nil)
+				var x int8
+				x, err = field.Value.GetI8(), error(nil)
+				v.ByteField = &x
 				if err != nil {
 					return err
 				}
-				byteFieldIsSet = true
+
 			}
 		case 3:
 			if field.Value.Type() == wire.TI16 {
-				v.Int16Field, err = field.Value.GetI16(), error(nil)
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.Int16Field = &x
 				if err != nil {
 					return err
 				}
-				int16FieldIsSet = true
+
 			}
 		case 4:
 			if field.Value.Type() == wire.TI32 {
-				v.Int32Field, err = field.Value.GetI32(), error(nil)
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Int32Field = &x
 				if err != nil {
 					return err
 				}
-				int32FieldIsSet = true
+
 			}
 		case 5:
 			if field.Value.Type() == wire.TI64 {
-				v.Int64Field, err = field.Value.GetI64(), error(nil)
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Int64Field = &x
 				if err != nil {
 					return err
 				}
-				int64FieldIsSet = true
+
 			}
 		case 6:
 			if field.Value.Type() == wire.TDouble {
-				v.DoubleField, err = field.Value.GetDouble(), error(nil)
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DoubleField = &x
 				if err != nil {
 					return err
 				}
-				doubleFieldIsSet = true
+
 			}
 		case 7:
 			if field.Value.Type() == wire.TBinary {
-				v.StringField, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StringField = &x
 				if err != nil {
 					return err
 				}
-				stringFieldIsSet = true
+
 			}
 		case 8:
 			if field.Value.Type() == wire.TBinary {
@@ -3287,213 +5692,4715 @@ func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
 				if err != nil {
 					return err
 				}
-				binaryFieldIsSet = true
+
 			}
 		}
 	}
 
-	if !boolFieldIsSet {
-		return errors.New("field BoolField of PrimitiveRequiredStruct is required")
-	}
+	return nil
+}
 
-	if !byteFieldIsSet {
-		return errors.New("field ByteField of PrimitiveRequiredStruct is required")
-	}
+func (v *PrimitiveOptionalStruct) Decode(sr stream.Reader) error {
 
-	if !int16FieldIsSet {
-		return errors.New("field Int16Field of PrimitiveRequiredStruct is required")
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	if !int32FieldIsSet {
-		return errors.New("field Int32Field of PrimitiveRequiredStruct is required")
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	if !int64FieldIsSet {
-		return errors.New("field Int64Field of PrimitiveRequiredStruct is required")
-	}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.BoolField = &x
+			if err != nil {
+				return err
+			}
 
-	if !doubleFieldIsSet {
-		return errors.New("field DoubleField of PrimitiveRequiredStruct is required")
-	}
+		case fh.ID == 2 && fh.Type == wire.TI8:
+			var x int8
+			x, err = sr.ReadInt8()
+			v.ByteField = &x
+			if err != nil {
+				return err
+			}
 
-	if !stringFieldIsSet {
-		return errors.New("field StringField of PrimitiveRequiredStruct is required")
-	}
+		case fh.ID == 3 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.Int16Field = &x
+			if err != nil {
+				return err
+			}
 
-	if !binaryFieldIsSet {
-		return errors.New("field BinaryField of PrimitiveRequiredStruct is required")
-	}
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Int32Field = &x
+			if err != nil {
+				return err
+			}
 
-	return nil
-}
+		case fh.ID == 5 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Int64Field = &x
+			if err != nil {
+				return err
+			}
 
-// String returns a readable string representation of a PrimitiveRequiredStruct
-// struct.
-func (v *PrimitiveRequiredStruct) String() string {
-	if v == nil {
-		return "<nil>"
+		case fh.ID == 6 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.DoubleField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StringField = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.BinaryField, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	var fields [8]string
-	i := 0
-	fields[i] = fmt.Sprintf("BoolField: %v", v.BoolField)
-	i++
-	fields[i] = fmt.Sprintf("ByteField: %v", v.ByteField)
-	i++
-	fields[i] = fmt.Sprintf("Int16Field: %v", v.Int16Field)
-	i++
-	fields[i] = fmt.Sprintf("Int32Field: %v", v.Int32Field)
-	i++
-	fields[i] = fmt.Sprintf("Int64Field: %v", v.Int64Field)
-	i++
-	fields[i] = fmt.Sprintf("DoubleField: %v", v.DoubleField)
-	i++
-	fields[i] = fmt.Sprintf("StringField: %v", v.StringField)
-	i++
-	fields[i] = fmt.Sprintf("BinaryField: %v", v.BinaryField)
-	i++
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
-	return fmt.Sprintf("PrimitiveRequiredStruct{%v}", strings.Join(fields[:i], ", "))
+	return nil
 }
 
-// Equals returns true if all the fields of this PrimitiveRequiredStruct match the
-// provided PrimitiveRequiredStruct.
+// MarshalJSON serializes a PrimitiveOptionalStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
 //
-// This function performs a deep comparison.
-func (v *PrimitiveRequiredStruct) Equals(rhs *PrimitiveRequiredStruct) bool {
+// This implements json.Marshaler.
+func (v *PrimitiveOptionalStruct) MarshalJSON() ([]byte, error) {
 	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+		return []byte("null"), nil
 	}
-	if !(v.BoolField == rhs.BoolField) {
-		return false
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.BoolField == nil) {
+		b, err := json.Marshal(v.BoolField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"boolField":`)
+		buff.Write(b)
 	}
-	if !(v.ByteField == rhs.ByteField) {
-		return false
+	if !(v.ByteField == nil) {
+		b, err := json.Marshal(v.ByteField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"byteField":`)
+		buff.Write(b)
 	}
-	if !(v.Int16Field == rhs.Int16Field) {
-		return false
+	if !(v.Int16Field == nil) {
+		b, err := json.Marshal(v.Int16Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int16Field":`)
+		buff.Write(b)
 	}
-	if !(v.Int32Field == rhs.Int32Field) {
-		return false
+	if !(v.Int32Field == nil) {
+		b, err := json.Marshal(v.Int32Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int32Field":`)
+		buff.Write(b)
 	}
-	if !(v.Int64Field == rhs.Int64Field) {
-		return false
+	if !(v.Int64Field == nil) {
+		b, err := json.Marshal(v.Int64Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int64Field":`)
+		buff.Write(b)
 	}
-	if !(v.DoubleField == rhs.DoubleField) {
-		return false
+	if !(v.DoubleField == nil) {
+		b, err := json.Marshal(v.DoubleField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doubleField":`)
+		buff.Write(b)
 	}
-	if !(v.StringField == rhs.StringField) {
-		return false
+	if !(v.StringField == nil) {
+		b, err := json.Marshal(v.StringField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"stringField":`)
+		buff.Write(b)
 	}
-	if !bytes.Equal(v.BinaryField, rhs.BinaryField) {
-		return false
+	if !(len(v.BinaryField) == 0) {
+		b, err := json.Marshal(v.BinaryField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"binaryField":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PrimitiveOptionalStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PrimitiveOptionalStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["boolField"]; ok {
+		if err := json.Unmarshal(r, &v.BoolField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["byteField"]; ok {
+		if err := json.Unmarshal(r, &v.ByteField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int16Field"]; ok {
+		if err := json.Unmarshal(r, &v.Int16Field); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int32Field"]; ok {
+		if err := json.Unmarshal(r, &v.Int32Field); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int64Field"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.Int64Field = (*int64)(x)
+	}
+	if r, ok := raw["doubleField"]; ok {
+		if err := json.Unmarshal(r, &v.DoubleField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["stringField"]; ok {
+		if err := json.Unmarshal(r, &v.StringField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["binaryField"]; ok {
+		if err := json.Unmarshal(r, &v.BinaryField); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PrimitiveOptionalStruct
+// struct.
+func (v *PrimitiveOptionalStruct) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.BoolField != nil {
+		fields[i] = fmt.Sprintf("BoolField: %v", *(v.BoolField))
+		i++
+	}
+	if v.ByteField != nil {
+		fields[i] = fmt.Sprintf("ByteField: %v", *(v.ByteField))
+		i++
+	}
+	if v.Int16Field != nil {
+		fields[i] = fmt.Sprintf("Int16Field: %v", *(v.Int16Field))
+		i++
+	}
+	if v.Int32Field != nil {
+		fields[i] = fmt.Sprintf("Int32Field: %v", *(v.Int32Field))
+		i++
+	}
+	if v.Int64Field != nil {
+		fields[i] = fmt.Sprintf("Int64Field: %v", *(v.Int64Field))
+		i++
+	}
+	if v.DoubleField != nil {
+		fields[i] = fmt.Sprintf("DoubleField: %v", *(v.DoubleField))
+		i++
+	}
+	if v.StringField != nil {
+		fields[i] = fmt.Sprintf("StringField: %v", *(v.StringField))
+		i++
+	}
+	if v.BinaryField != nil {
+		fields[i] = fmt.Sprintf("BinaryField: %v", v.BinaryField)
+		i++
+	}
+
+	return fmt.Sprintf("PrimitiveOptionalStruct{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Byte_EqualsPtr(lhs, rhs *int8) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this PrimitiveOptionalStruct match the
+// provided PrimitiveOptionalStruct.
+//
+// This function performs a deep comparison.
+func (v *PrimitiveOptionalStruct) Equals(rhs *PrimitiveOptionalStruct) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.BoolField, rhs.BoolField) {
+		return false
+	}
+	if !_Byte_EqualsPtr(v.ByteField, rhs.ByteField) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.Int16Field, rhs.Int16Field) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Int32Field, rhs.Int32Field) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Int64Field, rhs.Int64Field) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DoubleField, rhs.DoubleField) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StringField, rhs.StringField) {
+		return false
+	}
+	if !((v.BinaryField == nil && rhs.BinaryField == nil) || (v.BinaryField != nil && rhs.BinaryField != nil && bytes.Equal(v.BinaryField, rhs.BinaryField))) {
+		return false
+	}
+
+	return true
+}
+
+func _Bool_ClonePtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Byte_ClonePtr(v *int8) *int8 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I16_ClonePtr(v *int16) *int16 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Double_ClonePtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _Binary_Clone(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(v)), v...)
+}
+
+// Clone returns a deep copy of this PrimitiveOptionalStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PrimitiveOptionalStruct) Clone() *PrimitiveOptionalStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c PrimitiveOptionalStruct
+	c.BoolField = _Bool_ClonePtr(v.BoolField)
+	c.ByteField = _Byte_ClonePtr(v.ByteField)
+	c.Int16Field = _I16_ClonePtr(v.Int16Field)
+	c.Int32Field = _I32_ClonePtr(v.Int32Field)
+	c.Int64Field = _I64_ClonePtr(v.Int64Field)
+	c.DoubleField = _Double_ClonePtr(v.DoubleField)
+	c.StringField = _String_ClonePtr(v.StringField)
+	c.BinaryField = _Binary_Clone(v.BinaryField)
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BoolField != nil {
+		enc.AddBool("boolField", *v.BoolField)
+	}
+	if v.ByteField != nil {
+		enc.AddInt8("byteField", *v.ByteField)
+	}
+	if v.Int16Field != nil {
+		enc.AddInt16("int16Field", *v.Int16Field)
+	}
+	if v.Int32Field != nil {
+		enc.AddInt32("int32Field", *v.Int32Field)
+	}
+	if v.Int64Field != nil {
+		enc.AddInt64("int64Field", *v.Int64Field)
+	}
+	if v.DoubleField != nil {
+		enc.AddFloat64("doubleField", *v.DoubleField)
+	}
+	if v.StringField != nil {
+		enc.AddString("stringField", *v.StringField)
+	}
+	if v.BinaryField != nil {
+		enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
+	}
+	return err
+}
+
+// GetBoolField returns the value of BoolField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetBoolField() (o bool) {
+	if v != nil && v.BoolField != nil {
+		return *v.BoolField
+	}
+
+	return
+}
+
+// IsSetBoolField returns true if BoolField is not nil.
+func (v *PrimitiveOptionalStruct) IsSetBoolField() bool {
+	return v != nil && v.BoolField != nil
+}
+
+// GetByteField returns the value of ByteField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetByteField() (o int8) {
+	if v != nil && v.ByteField != nil {
+		return *v.ByteField
+	}
+
+	return
+}
+
+// IsSetByteField returns true if ByteField is not nil.
+func (v *PrimitiveOptionalStruct) IsSetByteField() bool {
+	return v != nil && v.ByteField != nil
+}
+
+// GetInt16Field returns the value of Int16Field if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetInt16Field() (o int16) {
+	if v != nil && v.Int16Field != nil {
+		return *v.Int16Field
+	}
+
+	return
+}
+
+// IsSetInt16Field returns true if Int16Field is not nil.
+func (v *PrimitiveOptionalStruct) IsSetInt16Field() bool {
+	return v != nil && v.Int16Field != nil
+}
+
+// GetInt32Field returns the value of Int32Field if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetInt32Field() (o int32) {
+	if v != nil && v.Int32Field != nil {
+		return *v.Int32Field
+	}
+
+	return
+}
+
+// IsSetInt32Field returns true if Int32Field is not nil.
+func (v *PrimitiveOptionalStruct) IsSetInt32Field() bool {
+	return v != nil && v.Int32Field != nil
+}
+
+// GetInt64Field returns the value of Int64Field if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetInt64Field() (o int64) {
+	if v != nil && v.Int64Field != nil {
+		return *v.Int64Field
+	}
+
+	return
+}
+
+// IsSetInt64Field returns true if Int64Field is not nil.
+func (v *PrimitiveOptionalStruct) IsSetInt64Field() bool {
+	return v != nil && v.Int64Field != nil
+}
+
+// GetDoubleField returns the value of DoubleField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetDoubleField() (o float64) {
+	if v != nil && v.DoubleField != nil {
+		return *v.DoubleField
+	}
+
+	return
+}
+
+// IsSetDoubleField returns true if DoubleField is not nil.
+func (v *PrimitiveOptionalStruct) IsSetDoubleField() bool {
+	return v != nil && v.DoubleField != nil
+}
+
+// GetStringField returns the value of StringField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetStringField() (o string) {
+	if v != nil && v.StringField != nil {
+		return *v.StringField
+	}
+
+	return
+}
+
+// IsSetStringField returns true if StringField is not nil.
+func (v *PrimitiveOptionalStruct) IsSetStringField() bool {
+	return v != nil && v.StringField != nil
+}
+
+// GetBinaryField returns the value of BinaryField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveOptionalStruct) GetBinaryField() (o []byte) {
+	if v != nil && v.BinaryField != nil {
+		return v.BinaryField
+	}
+
+	return
+}
+
+// IsSetBinaryField returns true if BinaryField is not nil.
+func (v *PrimitiveOptionalStruct) IsSetBinaryField() bool {
+	return v != nil && v.BinaryField != nil
+}
+
+// A struct that contains primitive fields exclusively.
+//
+// All fields are required.
+type PrimitiveRequiredStruct struct {
+	BoolField   bool    `json:"boolField,required"`
+	ByteField   int8    `json:"byteField,required"`
+	Int16Field  int16   `json:"int16Field,required"`
+	Int32Field  int32   `json:"int32Field,required"`
+	Int64Field  int64   `json:"int64Field,required"`
+	DoubleField float64 `json:"doubleField,required"`
+	StringField string  `json:"stringField,required"`
+	BinaryField []byte  `json:"binaryField,required"`
+}
+
+// ToWire translates a PrimitiveRequiredStruct struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PrimitiveRequiredStruct) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueBool(v.BoolField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI8(v.ByteField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = wire.NewValueI16(v.Int16Field), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.Int32Field), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+
+	w, err = wire.NewValueI64(v.Int64Field), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.DoubleField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 6, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.StringField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+	if v.BinaryField == nil {
+		return w, errors.New("field BinaryField of PrimitiveRequiredStruct is required")
+	}
+	w, err = wire.NewValueBinary(v.BinaryField), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 8, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PrimitiveRequiredStruct struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PrimitiveRequiredStruct struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PrimitiveRequiredStruct
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
+	var err error
+
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+	int16FieldIsSet := false
+	int32FieldIsSet := false
+	int64FieldIsSet := false
+	doubleFieldIsSet := false
+	stringFieldIsSet := false
+	binaryFieldIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				v.BoolField, err = field.Value.GetBool(), error(nil)
+				if err != nil {
+					return err
+				}
+				boolFieldIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI8 {
+				v.ByteField, err = field.Value.GetI8(), error(nil)
+				if err != nil {
+					return err
+				}
+				byteFieldIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI16 {
+				v.Int16Field, err = field.Value.GetI16(), error(nil)
+				if err != nil {
+					return err
+				}
+				int16FieldIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				v.Int32Field, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				int32FieldIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				v.Int64Field, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return err
+				}
+				int64FieldIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TDouble {
+				v.DoubleField, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				doubleFieldIsSet = true
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				v.StringField, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				stringFieldIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.BinaryField, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+				binaryFieldIsSet = true
+			}
+		}
+	}
+
+	if !boolFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "BoolField")
+	}
+
+	if !byteFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "ByteField")
+	}
+
+	if !int16FieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "Int16Field")
+	}
+
+	if !int32FieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "Int32Field")
+	}
+
+	if !int64FieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "Int64Field")
+	}
+
+	if !doubleFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "DoubleField")
+	}
+
+	if !stringFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "StringField")
+	}
+
+	if !binaryFieldIsSet {
+		missing.Add("PrimitiveRequiredStruct", "BinaryField")
+	}
+
+	return missing.Err()
+}
+
+func (v *PrimitiveRequiredStruct) Decode(sr stream.Reader) error {
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+	int16FieldIsSet := false
+	int32FieldIsSet := false
+	int64FieldIsSet := false
+	doubleFieldIsSet := false
+	stringFieldIsSet := false
+	binaryFieldIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			v.BoolField, err = sr.ReadBool()
+			if err != nil {
+				return err
+			}
+			boolFieldIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI8:
+			v.ByteField, err = sr.ReadInt8()
+			if err != nil {
+				return err
+			}
+			byteFieldIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI16:
+			v.Int16Field, err = sr.ReadInt16()
+			if err != nil {
+				return err
+			}
+			int16FieldIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			v.Int32Field, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			int32FieldIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TI64:
+			v.Int64Field, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			int64FieldIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TDouble:
+			v.DoubleField, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			doubleFieldIsSet = true
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			v.StringField, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			stringFieldIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			v.BinaryField, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+			binaryFieldIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !boolFieldIsSet {
+		return errors.New("field BoolField of PrimitiveRequiredStruct is required")
+	}
+
+	if !byteFieldIsSet {
+		return errors.New("field ByteField of PrimitiveRequiredStruct is required")
+	}
+
+	if !int16FieldIsSet {
+		return errors.New("field Int16Field of PrimitiveRequiredStruct is required")
+	}
+
+	if !int32FieldIsSet {
+		return errors.New("field Int32Field of PrimitiveRequiredStruct is required")
+	}
+
+	if !int64FieldIsSet {
+		return errors.New("field Int64Field of PrimitiveRequiredStruct is required")
+	}
+
+	if !doubleFieldIsSet {
+		return errors.New("field DoubleField of PrimitiveRequiredStruct is required")
+	}
+
+	if !stringFieldIsSet {
+		return errors.New("field StringField of PrimitiveRequiredStruct is required")
+	}
+
+	if !binaryFieldIsSet {
+		return errors.New("field BinaryField of PrimitiveRequiredStruct is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a PrimitiveRequiredStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PrimitiveRequiredStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.BoolField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"boolField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ByteField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"byteField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Int16Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int16Field":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Int32Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int32Field":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Int64Field)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int64Field":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.DoubleField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doubleField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.StringField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"stringField":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.BinaryField)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"binaryField":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PrimitiveRequiredStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PrimitiveRequiredStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["boolField"]; ok {
+		if err := json.Unmarshal(r, &v.BoolField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["byteField"]; ok {
+		if err := json.Unmarshal(r, &v.ByteField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int16Field"]; ok {
+		if err := json.Unmarshal(r, &v.Int16Field); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int32Field"]; ok {
+		if err := json.Unmarshal(r, &v.Int32Field); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int64Field"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		if x != nil {
+			v.Int64Field = (int64)(*x)
+		}
+	}
+	if r, ok := raw["doubleField"]; ok {
+		if err := json.Unmarshal(r, &v.DoubleField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["stringField"]; ok {
+		if err := json.Unmarshal(r, &v.StringField); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["binaryField"]; ok {
+		if err := json.Unmarshal(r, &v.BinaryField); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PrimitiveRequiredStruct
+// struct.
+func (v *PrimitiveRequiredStruct) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	fields[i] = fmt.Sprintf("BoolField: %v", v.BoolField)
+	i++
+	fields[i] = fmt.Sprintf("ByteField: %v", v.ByteField)
+	i++
+	fields[i] = fmt.Sprintf("Int16Field: %v", v.Int16Field)
+	i++
+	fields[i] = fmt.Sprintf("Int32Field: %v", v.Int32Field)
+	i++
+	fields[i] = fmt.Sprintf("Int64Field: %v", v.Int64Field)
+	i++
+	fields[i] = fmt.Sprintf("DoubleField: %v", v.DoubleField)
+	i++
+	fields[i] = fmt.Sprintf("StringField: %v", v.StringField)
+	i++
+	fields[i] = fmt.Sprintf("BinaryField: %v", v.BinaryField)
+	i++
+
+	return fmt.Sprintf("PrimitiveRequiredStruct{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PrimitiveRequiredStruct match the
+// provided PrimitiveRequiredStruct.
+//
+// This function performs a deep comparison.
+func (v *PrimitiveRequiredStruct) Equals(rhs *PrimitiveRequiredStruct) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.BoolField == rhs.BoolField) {
+		return false
+	}
+	if !(v.ByteField == rhs.ByteField) {
+		return false
+	}
+	if !(v.Int16Field == rhs.Int16Field) {
+		return false
+	}
+	if !(v.Int32Field == rhs.Int32Field) {
+		return false
+	}
+	if !(v.Int64Field == rhs.Int64Field) {
+		return false
+	}
+	if !(v.DoubleField == rhs.DoubleField) {
+		return false
+	}
+	if !(v.StringField == rhs.StringField) {
+		return false
+	}
+	if !bytes.Equal(v.BinaryField, rhs.BinaryField) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this PrimitiveRequiredStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PrimitiveRequiredStruct) Clone() *PrimitiveRequiredStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c PrimitiveRequiredStruct
+	c.BoolField = v.BoolField
+	c.ByteField = v.ByteField
+	c.Int16Field = v.Int16Field
+	c.Int32Field = v.Int32Field
+	c.Int64Field = v.Int64Field
+	c.DoubleField = v.DoubleField
+	c.StringField = v.StringField
+	c.BinaryField = _Binary_Clone(v.BinaryField)
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddBool("boolField", v.BoolField)
+	enc.AddInt8("byteField", v.ByteField)
+	enc.AddInt16("int16Field", v.Int16Field)
+	enc.AddInt32("int32Field", v.Int32Field)
+	enc.AddInt64("int64Field", v.Int64Field)
+	enc.AddFloat64("doubleField", v.DoubleField)
+	enc.AddString("stringField", v.StringField)
+	enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
+	return err
+}
+
+// GetBoolField returns the value of BoolField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetBoolField() (o bool) {
+	if v != nil {
+		o = v.BoolField
+	}
+	return
+}
+
+// GetByteField returns the value of ByteField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetByteField() (o int8) {
+	if v != nil {
+		o = v.ByteField
+	}
+	return
+}
+
+// GetInt16Field returns the value of Int16Field if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetInt16Field() (o int16) {
+	if v != nil {
+		o = v.Int16Field
+	}
+	return
+}
+
+// GetInt32Field returns the value of Int32Field if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetInt32Field() (o int32) {
+	if v != nil {
+		o = v.Int32Field
+	}
+	return
+}
+
+// GetInt64Field returns the value of Int64Field if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetInt64Field() (o int64) {
+	if v != nil {
+		o = v.Int64Field
+	}
+	return
+}
+
+// GetDoubleField returns the value of DoubleField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetDoubleField() (o float64) {
+	if v != nil {
+		o = v.DoubleField
+	}
+	return
+}
+
+// GetStringField returns the value of StringField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetStringField() (o string) {
+	if v != nil {
+		o = v.StringField
+	}
+	return
+}
+
+// GetBinaryField returns the value of BinaryField if it is set or its
+// zero value if it is unset.
+func (v *PrimitiveRequiredStruct) GetBinaryField() (o []byte) {
+	if v != nil {
+		o = v.BinaryField
+	}
+	return
+}
+
+// IsSetBinaryField returns true if BinaryField is not nil.
+func (v *PrimitiveRequiredStruct) IsSetBinaryField() bool {
+	return v != nil && v.BinaryField != nil
+}
+
+type Rename struct {
+	Default   string `json:"default,required"`
+	CamelCase string `json:"snake_case,required"`
+}
+
+// ToWire translates a Rename struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Rename) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Default), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.CamelCase), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Rename struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Rename struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Rename
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Rename) FromWire(w wire.Value) error {
+	var err error
+
+	DefaultIsSet := false
+	camelCaseIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Default, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				DefaultIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.CamelCase, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				camelCaseIsSet = true
+			}
+		}
+	}
+
+	if !DefaultIsSet {
+		missing.Add("Rename", "Default")
+	}
+
+	if !camelCaseIsSet {
+		missing.Add("Rename", "CamelCase")
+	}
+
+	return missing.Err()
+}
+
+func (v *Rename) Decode(sr stream.Reader) error {
+	DefaultIsSet := false
+	camelCaseIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Default, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			DefaultIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.CamelCase, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			camelCaseIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !DefaultIsSet {
+		return errors.New("field Default of Rename is required")
+	}
+
+	if !camelCaseIsSet {
+		return errors.New("field CamelCase of Rename is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Rename struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Rename) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Default)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"default":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.CamelCase)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"snake_case":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Rename struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Rename) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["default"]; ok {
+		if err := json.Unmarshal(r, &v.Default); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["snake_case"]; ok {
+		if err := json.Unmarshal(r, &v.CamelCase); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Rename
+// struct.
+func (v *Rename) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Default: %v", v.Default)
+	i++
+	fields[i] = fmt.Sprintf("CamelCase: %v", v.CamelCase)
+	i++
+
+	return fmt.Sprintf("Rename{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Rename match the
+// provided Rename.
+//
+// This function performs a deep comparison.
+func (v *Rename) Equals(rhs *Rename) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Default == rhs.Default) {
+		return false
+	}
+	if !(v.CamelCase == rhs.CamelCase) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Rename. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Rename) Clone() *Rename {
+	if v == nil {
+		return nil
+	}
+
+	var c Rename
+	c.Default = v.Default
+	c.CamelCase = v.CamelCase
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Rename.
+func (v *Rename) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("Default", v.Default)
+	enc.AddString("camelCase", v.CamelCase)
+	return err
+}
+
+// GetDefault returns the value of Default if it is set or its
+// zero value if it is unset.
+func (v *Rename) GetDefault() (o string) {
+	if v != nil {
+		o = v.Default
+	}
+	return
+}
+
+// GetCamelCase returns the value of CamelCase if it is set or its
+// zero value if it is unset.
+func (v *Rename) GetCamelCase() (o string) {
+	if v != nil {
+		o = v.CamelCase
+	}
+	return
+}
+
+type ShallowCopyStruct struct {
+	Deep    []byte   `json:"deep,required"`
+	Shallow []byte   `json:"shallow,required"`
+	Points  []*Point `json:"points,omitempty"`
+}
+
+type _List_Point_ValueList []*Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+// ToWire translates a ShallowCopyStruct struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ShallowCopyStruct) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Deep == nil {
+		return w, errors.New("field Deep of ShallowCopyStruct is required")
+	}
+	w, err = wire.NewValueBinary(v.Deep), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Shallow == nil {
+		return w, errors.New("field Shallow of ShallowCopyStruct is required")
+	}
+	w, err = wire.NewValueBinary(v.Shallow), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_Point_ValueList(v.Points)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a ShallowCopyStruct struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ShallowCopyStruct struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ShallowCopyStruct
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ShallowCopyStruct) FromWire(w wire.Value) error {
+	var err error
+
+	deepIsSet := false
+	shallowIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Deep, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+				deepIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Shallow, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+				shallowIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_Point_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !deepIsSet {
+		missing.Add("ShallowCopyStruct", "Deep")
+	}
+
+	if !shallowIsSet {
+		missing.Add("ShallowCopyStruct", "Shallow")
+	}
+
+	return missing.Err()
+}
+
+func _List_Point_Decode(sr stream.Reader) ([]*Point, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Point, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Point_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *ShallowCopyStruct) Decode(sr stream.Reader) error {
+	deepIsSet := false
+	shallowIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Deep, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+			deepIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Shallow, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+			shallowIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Points, err = _List_Point_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !deepIsSet {
+		return errors.New("field Deep of ShallowCopyStruct is required")
+	}
+
+	if !shallowIsSet {
+		return errors.New("field Shallow of ShallowCopyStruct is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ShallowCopyStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ShallowCopyStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Deep)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"deep":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Shallow)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"shallow":`)
+		buff.Write(b)
+	}
+	if !(len(v.Points) == 0) {
+		b, err := json.Marshal(v.Points)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"points":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ShallowCopyStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ShallowCopyStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["deep"]; ok {
+		if err := json.Unmarshal(r, &v.Deep); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["shallow"]; ok {
+		if err := json.Unmarshal(r, &v.Shallow); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["points"]; ok {
+		if err := json.Unmarshal(r, &v.Points); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ShallowCopyStruct
+// struct.
+func (v *ShallowCopyStruct) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Deep: %v", v.Deep)
+	i++
+	fields[i] = fmt.Sprintf("Shallow: %v", v.Shallow)
+	i++
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+
+	return fmt.Sprintf("ShallowCopyStruct{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ShallowCopyStruct match the
+// provided ShallowCopyStruct.
+//
+// This function performs a deep comparison.
+func (v *ShallowCopyStruct) Equals(rhs *ShallowCopyStruct) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !bytes.Equal(v.Deep, rhs.Deep) {
+		return false
+	}
+	if !bytes.Equal(v.Shallow, rhs.Shallow) {
+		return false
+	}
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this ShallowCopyStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ShallowCopyStruct) Clone() *ShallowCopyStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c ShallowCopyStruct
+	c.Deep = _Binary_Clone(v.Deep)
+	c.Shallow = v.Shallow
+	c.Points = v.Points
+
+	return &c
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ShallowCopyStruct.
+func (v *ShallowCopyStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("deep", base64.StdEncoding.EncodeToString(v.Deep))
+	enc.AddString("shallow", base64.StdEncoding.EncodeToString(v.Shallow))
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_Point_Zapper)(v.Points)))
+	}
+	return err
+}
+
+// GetDeep returns the value of Deep if it is set or its
+// zero value if it is unset.
+func (v *ShallowCopyStruct) GetDeep() (o []byte) {
+	if v != nil {
+		o = v.Deep
+	}
+	return
+}
+
+// IsSetDeep returns true if Deep is not nil.
+func (v *ShallowCopyStruct) IsSetDeep() bool {
+	return v != nil && v.Deep != nil
+}
+
+// GetShallow returns the value of Shallow if it is set or its
+// zero value if it is unset.
+func (v *ShallowCopyStruct) GetShallow() (o []byte) {
+	if v != nil {
+		o = v.Shallow
+	}
+	return
+}
+
+// IsSetShallow returns true if Shallow is not nil.
+func (v *ShallowCopyStruct) IsSetShallow() bool {
+	return v != nil && v.Shallow != nil
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+func (v *ShallowCopyStruct) GetPoints() (o []*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+func (v *ShallowCopyStruct) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// Size of something.
+type Size struct {
+	// Width in pixels.
+	Width float64 `json:"width,required"`
+	// Height in pixels.
+	Height float64 `json:"height,required"`
+}
+
+// ToWire translates a Size struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Size) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.Width), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueDouble(v.Height), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Size struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Size struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Size
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Size) FromWire(w wire.Value) error {
+	var err error
+
+	widthIsSet := false
+	heightIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.Width, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				widthIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Height, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				heightIsSet = true
+			}
+		}
+	}
+
+	if !widthIsSet {
+		missing.Add("Size", "Width")
+	}
+
+	if !heightIsSet {
+		missing.Add("Size", "Height")
+	}
+
+	return missing.Err()
+}
+
+func (v *Size) Decode(sr stream.Reader) error {
+	widthIsSet := false
+	heightIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TDouble:
+			v.Width, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			widthIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TDouble:
+			v.Height, err = sr.ReadDouble()
+			if err != nil {
+				return err
+			}
+			heightIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !widthIsSet {
+		return errors.New("field Width of Size is required")
+	}
+
+	if !heightIsSet {
+		return errors.New("field Height of Size is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Size struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Size) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Width)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"width":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Height)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"height":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Size struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Size) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["width"]; ok {
+		if err := json.Unmarshal(r, &v.Width); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["height"]; ok {
+		if err := json.Unmarshal(r, &v.Height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Size
+// struct.
+func (v *Size) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Width: %v", v.Width)
+	i++
+	fields[i] = fmt.Sprintf("Height: %v", v.Height)
+	i++
+
+	return fmt.Sprintf("Size{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Size match the
+// provided Size.
+//
+// This function performs a deep comparison.
+func (v *Size) Equals(rhs *Size) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Width == rhs.Width) {
+		return false
+	}
+	if !(v.Height == rhs.Height) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Size. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Size) Clone() *Size {
+	if v == nil {
+		return nil
+	}
+
+	var c Size
+	c.Width = v.Width
+	c.Height = v.Height
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Size.
+func (v *Size) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("width", v.Width)
+	enc.AddFloat64("height", v.Height)
+	return err
+}
+
+// GetWidth returns the value of Width if it is set or its
+// zero value if it is unset.
+func (v *Size) GetWidth() (o float64) {
+	if v != nil {
+		o = v.Width
+	}
+	return
+}
+
+// GetHeight returns the value of Height if it is set or its
+// zero value if it is unset.
+func (v *Size) GetHeight() (o float64) {
+	if v != nil {
+		o = v.Height
+	}
+	return
+}
+
+type Slug string
+
+// SlugPtr returns a pointer to a Slug
+func (v Slug) Ptr() *Slug {
+	return &v
+}
+
+// ToWire translates Slug into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Slug) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of Slug.
+func (v Slug) String() string {
+	x := (string)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Slug from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Slug) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (Slug)(x)
+	return err
+}
+
+// Decode deserializes Slug directly off the wire.
+func (v *Slug) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (Slug)(x)
+	return err
+}
+
+// Equals returns true if this Slug is equal to the provided
+// Slug.
+func (lhs Slug) Equals(rhs Slug) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+type StructLabels struct {
+	IsRequired *bool   `json:"required,omitempty"`
+	Foo        *string `json:"not_bar,omitempty"`
+	Qux        *string `json:"qux,omitempty"`
+	Quux       *string `json:"QUUX,omitempty"`
+}
+
+// ToWire translates a StructLabels struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StructLabels) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.IsRequired != nil {
+		w, err = wire.NewValueBool(*(v.IsRequired)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Foo != nil {
+		w, err = wire.NewValueString(*(v.Foo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Qux != nil {
+		w, err = wire.NewValueString(*(v.Qux)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Quux != nil {
+		w, err = wire.NewValueString(*(v.Quux)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a StructLabels struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StructLabels struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StructLabels
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StructLabels) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsRequired = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Foo = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Qux = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Quux = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *StructLabels) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsRequired = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Foo = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Qux = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Quux = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a StructLabels struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *StructLabels) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.IsRequired == nil) {
+		b, err := json.Marshal(v.IsRequired)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"required":`)
+		buff.Write(b)
+	}
+	if !(v.Foo == nil) {
+		b, err := json.Marshal(v.Foo)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"not_bar":`)
+		buff.Write(b)
+	}
+	if !(v.Qux == nil) {
+		b, err := json.Marshal(v.Qux)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"qux":`)
+		buff.Write(b)
+	}
+	if !(v.Quux == nil) {
+		b, err := json.Marshal(v.Quux)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"QUUX":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a StructLabels struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *StructLabels) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["required"]; ok {
+		if err := json.Unmarshal(r, &v.IsRequired); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["not_bar"]; ok {
+		if err := json.Unmarshal(r, &v.Foo); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["qux"]; ok {
+		if err := json.Unmarshal(r, &v.Qux); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["QUUX"]; ok {
+		if err := json.Unmarshal(r, &v.Quux); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StructLabels
+// struct.
+func (v *StructLabels) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.IsRequired != nil {
+		fields[i] = fmt.Sprintf("IsRequired: %v", *(v.IsRequired))
+		i++
+	}
+	if v.Foo != nil {
+		fields[i] = fmt.Sprintf("Foo: %v", *(v.Foo))
+		i++
+	}
+	if v.Qux != nil {
+		fields[i] = fmt.Sprintf("Qux: %v", *(v.Qux))
+		i++
+	}
+	if v.Quux != nil {
+		fields[i] = fmt.Sprintf("Quux: %v", *(v.Quux))
+		i++
+	}
+
+	return fmt.Sprintf("StructLabels{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this StructLabels match the
+// provided StructLabels.
+//
+// This function performs a deep comparison.
+func (v *StructLabels) Equals(rhs *StructLabels) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsRequired, rhs.IsRequired) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Foo, rhs.Foo) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Qux, rhs.Qux) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Quux, rhs.Quux) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this StructLabels. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *StructLabels) Clone() *StructLabels {
+	if v == nil {
+		return nil
+	}
+
+	var c StructLabels
+	c.IsRequired = _Bool_ClonePtr(v.IsRequired)
+	c.Foo = _String_ClonePtr(v.Foo)
+	c.Qux = _String_ClonePtr(v.Qux)
+	c.Quux = _String_ClonePtr(v.Quux)
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StructLabels.
+func (v *StructLabels) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.IsRequired != nil {
+		enc.AddBool("required", *v.IsRequired)
+	}
+	if v.Foo != nil {
+		enc.AddString("bar", *v.Foo)
+	}
+	if v.Qux != nil {
+		enc.AddString("qux", *v.Qux)
+	}
+	if v.Quux != nil {
+		enc.AddString("QUUX", *v.Quux)
+	}
+	return err
+}
+
+// GetIsRequired returns the value of IsRequired if it is set or its
+// zero value if it is unset.
+func (v *StructLabels) GetIsRequired() (o bool) {
+	if v != nil && v.IsRequired != nil {
+		return *v.IsRequired
+	}
+
+	return
+}
+
+// IsSetIsRequired returns true if IsRequired is not nil.
+func (v *StructLabels) IsSetIsRequired() bool {
+	return v != nil && v.IsRequired != nil
+}
+
+// GetFoo returns the value of Foo if it is set or its
+// zero value if it is unset.
+func (v *StructLabels) GetFoo() (o string) {
+	if v != nil && v.Foo != nil {
+		return *v.Foo
+	}
+
+	return
+}
+
+// IsSetFoo returns true if Foo is not nil.
+func (v *StructLabels) IsSetFoo() bool {
+	return v != nil && v.Foo != nil
+}
+
+// GetQux returns the value of Qux if it is set or its
+// zero value if it is unset.
+func (v *StructLabels) GetQux() (o string) {
+	if v != nil && v.Qux != nil {
+		return *v.Qux
+	}
+
+	return
+}
+
+// IsSetQux returns true if Qux is not nil.
+func (v *StructLabels) IsSetQux() bool {
+	return v != nil && v.Qux != nil
+}
+
+// GetQuux returns the value of Quux if it is set or its
+// zero value if it is unset.
+func (v *StructLabels) GetQuux() (o string) {
+	if v != nil && v.Quux != nil {
+		return *v.Quux
+	}
+
+	return
+}
+
+// IsSetQuux returns true if Quux is not nil.
+func (v *StructLabels) IsSetQuux() bool {
+	return v != nil && v.Quux != nil
+}
+
+// Tree refers back to itself through a chain of typedefs and through
+// Branch.
+type Tree struct {
+	Name     string           `json:"name,required"`
+	Children Trees            `json:"children,omitempty"`
+	ByName   map[string]*Tree `json:"byName,omitempty"`
+	Branch   *Branch          `json:"branch,omitempty"`
+}
+
+type _Map_String_Tree_MapItemList map[string]*Tree
+
+func (m _Map_String_Tree_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Tree_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Tree_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Tree_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Tree_MapItemList) Close() {}
+
+// ToWire translates a Tree struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Tree) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Children != nil {
+		w, err = v.Children.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ByName != nil {
+		w, err = wire.NewValueMap(_Map_String_Tree_MapItemList(v.ByName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Branch != nil {
+		w, err = v.Branch.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Trees_Read(w wire.Value) (Trees, error) {
+	var x Trees
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Map_String_Tree_Read(m wire.MapItemList) (map[string]*Tree, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*Tree, m.Size())
+	var missing required.Errors
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Tree_Read(x.Value)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a Tree struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Tree struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Tree
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Tree) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Children, err = _Trees_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.ByName, err = _Map_String_Tree_Read(field.Value.GetMap())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Branch, err = _Branch_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("Tree", "Name")
+	}
+
+	return missing.Err()
+}
+
+func _Trees_Decode(sr stream.Reader) (Trees, error) {
+	var x Trees
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _Map_String_Tree_Decode(sr stream.Reader) (map[string]*Tree, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]*Tree, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Tree_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *Tree) Decode(sr stream.Reader) error {
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Children, err = _Trees_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.ByName, err = _Map_String_Tree_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.Branch, err = _Branch_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Tree is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Tree struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Tree) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	if !(len(v.Children) == 0) {
+		b, err := json.Marshal(v.Children)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"children":`)
+		buff.Write(b)
+	}
+	if !(len(v.ByName) == 0) {
+		b, err := json.Marshal(v.ByName)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"byName":`)
+		buff.Write(b)
+	}
+	if !(v.Branch == nil) {
+		b, err := json.Marshal(v.Branch)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"branch":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Tree struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Tree) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["children"]; ok {
+		if err := json.Unmarshal(r, &v.Children); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["byName"]; ok {
+		if err := json.Unmarshal(r, &v.ByName); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["branch"]; ok {
+		if err := json.Unmarshal(r, &v.Branch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Tree
+// struct.
+func (v *Tree) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Children != nil {
+		fields[i] = fmt.Sprintf("Children: %v", v.Children)
+		i++
+	}
+	if v.ByName != nil {
+		fields[i] = fmt.Sprintf("ByName: %v", v.ByName)
+		i++
+	}
+	if v.Branch != nil {
+		fields[i] = fmt.Sprintf("Branch: %v", v.Branch)
+		i++
+	}
+
+	return fmt.Sprintf("Tree{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_Tree_Equals(lhs, rhs map[string]*Tree) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Tree match the
+// provided Tree.
+//
+// This function performs a deep comparison.
+func (v *Tree) Equals(rhs *Tree) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Children == nil && rhs.Children == nil) || (v.Children != nil && rhs.Children != nil && v.Children.Equals(rhs.Children))) {
+		return false
+	}
+	if !((v.ByName == nil && rhs.ByName == nil) || (v.ByName != nil && rhs.ByName != nil && _Map_String_Tree_Equals(v.ByName, rhs.ByName))) {
+		return false
+	}
+	if !((v.Branch == nil && rhs.Branch == nil) || (v.Branch != nil && rhs.Branch != nil && v.Branch.Equals(rhs.Branch))) {
+		return false
+	}
+
+	return true
+}
+
+func _Map_String_Tree_Clone(v map[string]*Tree) map[string]*Tree {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*Tree, len(v))
+
+	for k, x := range v {
+		o[k] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this Tree. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Tree) Clone() *Tree {
+	if v == nil {
+		return nil
+	}
+
+	var c Tree
+	c.Name = v.Name
+	c.Children = v.Children.Clone()
+	c.ByName = _Map_String_Tree_Clone(v.ByName)
+	c.Branch = v.Branch.Clone()
+
+	return &c
+}
+
+type _Map_String_Tree_Zapper map[string]*Tree
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Tree_Zapper.
+func (m _Map_String_Tree_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Tree.
+func (v *Tree) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Children != nil {
+		err = multierr.Append(err, enc.AddArray("children", (_List_Tree_Zapper)(([]*Tree)(v.Children))))
+	}
+	if v.ByName != nil {
+		err = multierr.Append(err, enc.AddObject("byName", (_Map_String_Tree_Zapper)(v.ByName)))
+	}
+	if v.Branch != nil {
+		err = multierr.Append(err, enc.AddObject("branch", v.Branch))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Tree) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetChildren returns the value of Children if it is set or its
+// zero value if it is unset.
+func (v *Tree) GetChildren() (o Trees) {
+	if v != nil && v.Children != nil {
+		return v.Children
+	}
+
+	return
+}
+
+// IsSetChildren returns true if Children is not nil.
+func (v *Tree) IsSetChildren() bool {
+	return v != nil && v.Children != nil
+}
+
+// GetByName returns the value of ByName if it is set or its
+// zero value if it is unset.
+func (v *Tree) GetByName() (o map[string]*Tree) {
+	if v != nil && v.ByName != nil {
+		return v.ByName
+	}
+
+	return
+}
+
+// IsSetByName returns true if ByName is not nil.
+func (v *Tree) IsSetByName() bool {
+	return v != nil && v.ByName != nil
+}
+
+// GetBranch returns the value of Branch if it is set or its
+// zero value if it is unset.
+func (v *Tree) GetBranch() (o *Branch) {
+	if v != nil && v.Branch != nil {
+		return v.Branch
+	}
+
+	return
+}
+
+// IsSetBranch returns true if Branch is not nil.
+func (v *Tree) IsSetBranch() bool {
+	return v != nil && v.Branch != nil
+}
+
+func _Forest_Read(w wire.Value) (Forest, error) {
+	var x Forest
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Forest_Decode(sr stream.Reader) (Forest, error) {
+	var x Forest
+	err := x.Decode(sr)
+	return x, err
+}
+
+type Trees Forest
+
+// ToWire translates Trees into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Trees) ToWire() (wire.Value, error) {
+	x := (Forest)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Trees.
+func (v Trees) String() string {
+	x := (Forest)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Trees from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Trees) FromWire(w wire.Value) error {
+	x, err := _Forest_Read(w)
+	*v = (Trees)(x)
+	return err
+}
+
+// Decode deserializes Trees directly off the wire.
+func (v *Trees) Decode(sr stream.Reader) error {
+	x, err := _Forest_Decode(sr)
+	*v = (Trees)(x)
+	return err
+}
+
+// Equals returns true if this Trees is equal to the provided
+// Trees.
+func (lhs Trees) Equals(rhs Trees) bool {
+	return (Forest)(lhs).Equals((Forest)(rhs))
+}
+
+// Clone returns a deep copy of this Trees.
+func (v Trees) Clone() Trees {
+	x := (Forest)(v)
+	return (Trees)(x.Clone())
+}
+
+func (v Trees) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_Tree_Zapper)(([]*Tree)(v))).MarshalLogArray(enc)
+}
+
+func _I16_FromUnsigned(v uint16) (int16, error) {
+	return int16(v), nil
+}
+
+func _I16_ToUnsigned(v int16) (uint16, error) {
+	return uint16(v), nil
+}
+
+func _I32_FromUnsigned(v uint32) (int32, error) {
+	return int32(v), nil
+}
+
+func _I32_ToUnsigned(v int32) (uint32, error) {
+	return uint32(v), nil
+}
+
+func _I64_FromUnsigned(v uint64) (int64, error) {
+	return int64(v), nil
+}
+
+func _I64_ToUnsigned(v int64) (uint64, error) {
+	return uint64(v), nil
+}
+
+type UnsignedStruct struct {
+	Tiny   int8    `json:"tiny,required"`
+	Small  uint16  `json:"small,required"`
+	Medium uint32  `json:"medium,required"`
+	Large  *uint64 `json:"large,omitempty"`
+}
+
+// ToWire translates a UnsignedStruct struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UnsignedStruct) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI8(v.Tiny), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	{
+		var x int16
+		x, err = _I16_FromUnsigned(v.Small)
+		if err != nil {
+			return w, err
+		}
+		w, err = wire.NewValueI16(x), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	{
+		var x int32
+		x, err = _I32_FromUnsigned(v.Medium)
+		if err != nil {
+			return w, err
+		}
+		w, err = wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Large != nil {
+		var x int64
+		x, err = _I64_FromUnsigned(*v.Large)
+		if err != nil {
+			return w, err
+		}
+		w, err = wire.NewValueI64(x), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UnsignedStruct struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UnsignedStruct struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UnsignedStruct
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UnsignedStruct) FromWire(w wire.Value) error {
+	var err error
+
+	tinyIsSet := false
+	smallIsSet := false
+	mediumIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI8 {
+				v.Tiny, err = field.Value.GetI8(), error(nil)
+				if err != nil {
+					return err
+				}
+				tinyIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				if x, err = field.Value.GetI16(), error(nil); err == nil {
+					v.Small, err = _I16_ToUnsigned(x)
+				}
+				if err != nil {
+					return err
+				}
+				smallIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				if x, err = field.Value.GetI32(), error(nil); err == nil {
+					v.Medium, err = _I32_ToUnsigned(x)
+				}
+				if err != nil {
+					return err
+				}
+				mediumIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				if x, err = field.Value.GetI64(), error(nil); err == nil {
+					var y uint64
+					if y, err = _I64_ToUnsigned(x); err == nil {
+						v.Large = &y
+					}
+				}
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !tinyIsSet {
+		missing.Add("UnsignedStruct", "Tiny")
+	}
+
+	if !smallIsSet {
+		missing.Add("UnsignedStruct", "Small")
+	}
+
+	if !mediumIsSet {
+		missing.Add("UnsignedStruct", "Medium")
+	}
+
+	return missing.Err()
+}
+
+func (v *UnsignedStruct) Decode(sr stream.Reader) error {
+	tinyIsSet := false
+	smallIsSet := false
+	mediumIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI8:
+			v.Tiny, err = sr.ReadInt8()
+			if err != nil {
+				return err
+			}
+			tinyIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI16:
+			var x int16
+			if x, err = sr.ReadInt16(); err == nil {
+				v.Small, err = _I16_ToUnsigned(x)
+			}
+			if err != nil {
+				return err
+			}
+			smallIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x int32
+			if x, err = sr.ReadInt32(); err == nil {
+				v.Medium, err = _I32_ToUnsigned(x)
+			}
+			if err != nil {
+				return err
+			}
+			mediumIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI64:
+			var x int64
+			if x, err = sr.ReadInt64(); err == nil {
+				var y uint64
+				if y, err = _I64_ToUnsigned(x); err == nil {
+					v.Large = &y
+				}
+			}
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !tinyIsSet {
+		return errors.New("field Tiny of UnsignedStruct is required")
+	}
+
+	if !smallIsSet {
+		return errors.New("field Small of UnsignedStruct is required")
+	}
+
+	if !mediumIsSet {
+		return errors.New("field Medium of UnsignedStruct is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a UnsignedStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *UnsignedStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Tiny)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"tiny":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Small)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"small":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Medium)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"medium":`)
+		buff.Write(b)
+	}
+	if !(v.Large == nil) {
+		b, err := json.Marshal(v.Large)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"large":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a UnsignedStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *UnsignedStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["tiny"]; ok {
+		if err := json.Unmarshal(r, &v.Tiny); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["small"]; ok {
+		if err := json.Unmarshal(r, &v.Small); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["medium"]; ok {
+		if err := json.Unmarshal(r, &v.Medium); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["large"]; ok {
+		if err := json.Unmarshal(r, &v.Large); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UnsignedStruct
+// struct.
+func (v *UnsignedStruct) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Tiny: %v", v.Tiny)
+	i++
+	fields[i] = fmt.Sprintf("Small: %v", v.Small)
+	i++
+	fields[i] = fmt.Sprintf("Medium: %v", v.Medium)
+	i++
+	if v.Large != nil {
+		fields[i] = fmt.Sprintf("Large: %v", *(v.Large))
+		i++
+	}
+
+	return fmt.Sprintf("UnsignedStruct{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UnsignedStruct match the
+// provided UnsignedStruct.
+//
+// This function performs a deep comparison.
+func (v *UnsignedStruct) Equals(rhs *UnsignedStruct) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Tiny == rhs.Tiny) {
+		return false
+	}
+	if !(v.Small == rhs.Small) {
+		return false
+	}
+	if !(v.Medium == rhs.Medium) {
+		return false
+	}
+	if !((v.Large == nil && rhs.Large == nil) || (v.Large != nil && rhs.Large != nil && (*v.Large == *rhs.Large))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this UnsignedStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *UnsignedStruct) Clone() *UnsignedStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c UnsignedStruct
+	c.Tiny = v.Tiny
+	c.Small = v.Small
+	c.Medium = v.Medium
+	c.Large = v.Large
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UnsignedStruct.
+func (v *UnsignedStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddInt8("tiny", v.Tiny)
+	err = multierr.Append(err, enc.AddReflected("small", v.Small))
+	err = multierr.Append(err, enc.AddReflected("medium", v.Medium))
+	if v.Large != nil {
+		err = multierr.Append(err, enc.AddReflected("large", *v.Large))
+	}
+	return err
+}
+
+// GetTiny returns the value of Tiny if it is set or its
+// zero value if it is unset.
+func (v *UnsignedStruct) GetTiny() (o int8) {
+	if v != nil {
+		o = v.Tiny
+	}
+	return
+}
+
+// GetSmall returns the value of Small if it is set or its
+// zero value if it is unset.
+func (v *UnsignedStruct) GetSmall() (o uint16) {
+	if v != nil {
+		o = v.Small
+	}
+	return
+}
+
+// GetMedium returns the value of Medium if it is set or its
+// zero value if it is unset.
+func (v *UnsignedStruct) GetMedium() (o uint32) {
+	if v != nil {
+		o = v.Medium
+	}
+	return
+}
+
+// GetLarge returns the value of Large if it is set or its
+// zero value if it is unset.
+func (v *UnsignedStruct) GetLarge() (o uint64) {
+	if v != nil && v.Large != nil {
+		return *v.Large
+	}
+
+	return
+}
+
+// IsSetLarge returns true if Large is not nil.
+func (v *UnsignedStruct) IsSetLarge() bool {
+	return v != nil && v.Large != nil
+}
+
+type User struct {
+	Name     string        `json:"name,required"`
+	Contact  *ContactInfo  `json:"contact,omitempty"`
+	Personal *PersonalInfo `json:"personal,omitempty"`
+}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Contact != nil {
+		w, err = v.Contact.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Personal != nil {
+		w, err = v.Personal.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ContactInfo_Read(w wire.Value) (*ContactInfo, error) {
+	var v ContactInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _PersonalInfo_Read(w wire.Value) (*PersonalInfo, error) {
+	var v PersonalInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Contact, err = _ContactInfo_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Personal, err = _PersonalInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("User", "Name")
+	}
+
+	return missing.Err()
+}
+
+func _ContactInfo_Decode(sr stream.Reader) (*ContactInfo, error) {
+	var v ContactInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _PersonalInfo_Decode(sr stream.Reader) (*PersonalInfo, error) {
+	var v PersonalInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *User) Decode(sr stream.Reader) error {
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Contact, err = _ContactInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.Personal, err = _PersonalInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of User is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a User struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *User) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	if !(v.Contact == nil) {
+		b, err := json.Marshal(v.Contact)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"contact":`)
+		buff.Write(b)
+	}
+	if !(v.Personal == nil) {
+		b, err := json.Marshal(v.Personal)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"personal":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a User struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *User) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["contact"]; ok {
+		if err := json.Unmarshal(r, &v.Contact); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["personal"]; ok {
+		if err := json.Unmarshal(r, &v.Personal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Contact != nil {
+		fields[i] = fmt.Sprintf("Contact: %v", v.Contact)
+		i++
+	}
+	if v.Personal != nil {
+		fields[i] = fmt.Sprintf("Personal: %v", v.Personal)
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Contact == nil && rhs.Contact == nil) || (v.Contact != nil && rhs.Contact != nil && v.Contact.Equals(rhs.Contact))) {
+		return false
+	}
+	if !((v.Personal == nil && rhs.Personal == nil) || (v.Personal != nil && rhs.Personal != nil && v.Personal.Equals(rhs.Personal))) {
+		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this User. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *User) Clone() *User {
+	if v == nil {
+		return nil
+	}
+
+	var c User
+	c.Name = v.Name
+	c.Contact = v.Contact.Clone()
+	c.Personal = v.Personal.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PrimitiveRequiredStruct.
-func (v *PrimitiveRequiredStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.Contact != nil {
+		err = multierr.Append(err, enc.AddObject("contact", v.Contact))
+	}
+	if v.Personal != nil {
+		err = multierr.Append(err, enc.AddObject("personal", v.Personal))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *User) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetContact returns the value of Contact if it is set or its
+// zero value if it is unset.
+func (v *User) GetContact() (o *ContactInfo) {
+	if v != nil && v.Contact != nil {
+		return v.Contact
+	}
+
+	return
+}
+
+// IsSetContact returns true if Contact is not nil.
+func (v *User) IsSetContact() bool {
+	return v != nil && v.Contact != nil
+}
+
+// GetPersonal returns the value of Personal if it is set or its
+// zero value if it is unset.
+func (v *User) GetPersonal() (o *PersonalInfo) {
+	if v != nil && v.Personal != nil {
+		return v.Personal
+	}
+
+	return
+}
+
+// IsSetPersonal returns true if Personal is not nil.
+func (v *User) IsSetPersonal() bool {
+	return v != nil && v.Personal != nil
+}
+
+type _Map_String_User_MapItemList map[string]*User
+
+func (m _Map_String_User_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_User_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_User_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_User_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_User_MapItemList) Close() {}
+
+func _User_Read(w wire.Value) (*User, error) {
+	var v User
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_User_Read(m wire.MapItemList) (map[string]*User, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*User, m.Size())
+	var missing required.Errors
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _User_Read(x.Value)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+func _User_Decode(sr stream.Reader) (*User, error) {
+	var v User
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_User_Decode(sr stream.Reader) (map[string]*User, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]*User, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _User_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_User_Equals(lhs, rhs map[string]*User) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_User_Clone(v map[string]*User) map[string]*User {
 	if v == nil {
 		return nil
 	}
-	enc.AddBool("boolField", v.BoolField)
-	enc.AddInt8("byteField", v.ByteField)
-	enc.AddInt16("int16Field", v.Int16Field)
-	enc.AddInt32("int32Field", v.Int32Field)
-	enc.AddInt64("int64Field", v.Int64Field)
-	enc.AddFloat64("doubleField", v.DoubleField)
-	enc.AddString("stringField", v.StringField)
-	enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
-	return err
-}
 
-// GetBoolField returns the value of BoolField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetBoolField() (o bool) {
-	if v != nil {
-		o = v.BoolField
+	o := make(map[string]*User, len(v))
+
+	for k, x := range v {
+		o[k] = x.Clone()
 	}
-	return
+	return o
 }
 
-// GetByteField returns the value of ByteField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetByteField() (o int8) {
-	if v != nil {
-		o = v.ByteField
+type _Map_String_User_Zapper map[string]*User
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_User_Zapper.
+func (m _Map_String_User_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
 	}
-	return
+	return err
 }
 
-// GetInt16Field returns the value of Int16Field if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetInt16Field() (o int16) {
-	if v != nil {
-		o = v.Int16Field
-	}
-	return
+type UserMap map[string]*User
+
+// ToWire translates UserMap into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v UserMap) ToWire() (wire.Value, error) {
+	x := (map[string]*User)(v)
+	return wire.NewValueMap(_Map_String_User_MapItemList(x)), error(nil)
 }
 
-// GetInt32Field returns the value of Int32Field if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetInt32Field() (o int32) {
-	if v != nil {
-		o = v.Int32Field
-	}
-	return
+// String returns a readable string representation of UserMap.
+func (v UserMap) String() string {
+	x := (map[string]*User)(v)
+	return fmt.Sprint(x)
 }
 
-// GetInt64Field returns the value of Int64Field if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetInt64Field() (o int64) {
-	if v != nil {
-		o = v.Int64Field
-	}
-	return
+// FromWire deserializes UserMap from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *UserMap) FromWire(w wire.Value) error {
+	x, err := _Map_String_User_Read(w.GetMap())
+	*v = (UserMap)(x)
+	return err
 }
 
-// GetDoubleField returns the value of DoubleField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetDoubleField() (o float64) {
-	if v != nil {
-		o = v.DoubleField
-	}
-	return
+// Decode deserializes UserMap directly off the wire.
+func (v *UserMap) Decode(sr stream.Reader) error {
+	x, err := _Map_String_User_Decode(sr)
+	*v = (UserMap)(x)
+	return err
 }
 
-// GetStringField returns the value of StringField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetStringField() (o string) {
-	if v != nil {
-		o = v.StringField
-	}
-	return
+// Equals returns true if this UserMap is equal to the provided
+// UserMap.
+func (lhs UserMap) Equals(rhs UserMap) bool {
+	return _Map_String_User_Equals((map[string]*User)(lhs), (map[string]*User)(rhs))
 }
 
-// GetBinaryField returns the value of BinaryField if it is set or its
-// zero value if it is unset.
-func (v *PrimitiveRequiredStruct) GetBinaryField() (o []byte) {
-	if v != nil {
-		o = v.BinaryField
-	}
-	return
+// Clone returns a deep copy of this UserMap.
+func (v UserMap) Clone() UserMap {
+	x := (map[string]*User)(v)
+	return (UserMap)(_Map_String_User_Clone(x))
 }
 
-// IsSetBinaryField returns true if BinaryField is not nil.
-func (v *PrimitiveRequiredStruct) IsSetBinaryField() bool {
-	return v != nil && v.BinaryField != nil
+func (v UserMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return ((_Map_String_User_Zapper)((map[string]*User)(v))).MarshalLogObject(enc)
 }
 
-type Rename struct {
-	Default   string `json:"default,required"`
-	CamelCase string `json:"snake_case,required"`
+type ValidatedAddress struct {
+	Street string `json:"street,required"`
+	Unit   *int32 `json:"unit,omitempty"`
 }
 
-// ToWire translates a Rename struct into a Thrift-level intermediate
+// ToWire translates a ValidatedAddress struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3508,7 +10415,7 @@ type Rename struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Rename) ToWire() (wire.Value, error) {
+func (v *ValidatedAddress) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -3516,28 +10423,29 @@ func (v *Rename) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Default), error(nil)
+	w, err = wire.NewValueString(v.Street), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
-
-	w, err = wire.NewValueString(v.CamelCase), error(nil)
-	if err != nil {
-		return w, err
+	if v.Unit != nil {
+		w, err = wire.NewValueI32(*(v.Unit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Rename struct from its Thrift-level
+// FromWire deserializes a ValidatedAddress struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Rename struct
+// An error is returned if we were unable to build a ValidatedAddress struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3545,124 +10453,293 @@ func (v *Rename) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Rename
+//   var v ValidatedAddress
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Rename) FromWire(w wire.Value) error {
+func (v *ValidatedAddress) FromWire(w wire.Value) error {
 	var err error
 
-	DefaultIsSet := false
-	camelCaseIsSet := false
+	streetIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Default, err = field.Value.GetString(), error(nil)
+				v.Street, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				DefaultIsSet = true
+				streetIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TBinary {
-				v.CamelCase, err = field.Value.GetString(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Unit = &x
 				if err != nil {
 					return err
 				}
-				camelCaseIsSet = true
+
 			}
 		}
 	}
 
-	if !DefaultIsSet {
-		return errors.New("field Default of Rename is required")
+	if !streetIsSet {
+		missing.Add("ValidatedAddress", "Street")
 	}
 
-	if !camelCaseIsSet {
-		return errors.New("field CamelCase of Rename is required")
+	return missing.Err()
+}
+
+func (v *ValidatedAddress) Decode(sr stream.Reader) error {
+	streetIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Street, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			streetIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Unit = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of ValidatedAddress is required")
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a Rename
+// MarshalJSON serializes a ValidatedAddress struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ValidatedAddress) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Street)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"street":`)
+		buff.Write(b)
+	}
+	if !(v.Unit == nil) {
+		b, err := json.Marshal(v.Unit)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"unit":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ValidatedAddress struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ValidatedAddress) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["street"]; ok {
+		if err := json.Unmarshal(r, &v.Street); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["unit"]; ok {
+		if err := json.Unmarshal(r, &v.Unit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ValidatedAddress
 // struct.
-func (v *Rename) String() string {
+func (v *ValidatedAddress) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("Default: %v", v.Default)
-	i++
-	fields[i] = fmt.Sprintf("CamelCase: %v", v.CamelCase)
+	fields[i] = fmt.Sprintf("Street: %v", v.Street)
 	i++
+	if v.Unit != nil {
+		fields[i] = fmt.Sprintf("Unit: %v", *(v.Unit))
+		i++
+	}
 
-	return fmt.Sprintf("Rename{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ValidatedAddress{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Rename match the
-// provided Rename.
+// Equals returns true if all the fields of this ValidatedAddress match the
+// provided ValidatedAddress.
 //
 // This function performs a deep comparison.
-func (v *Rename) Equals(rhs *Rename) bool {
+func (v *ValidatedAddress) Equals(rhs *ValidatedAddress) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Default == rhs.Default) {
+	if !(v.Street == rhs.Street) {
 		return false
 	}
-	if !(v.CamelCase == rhs.CamelCase) {
+	if !_I32_EqualsPtr(v.Unit, rhs.Unit) {
 		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this ValidatedAddress. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ValidatedAddress) Clone() *ValidatedAddress {
+	if v == nil {
+		return nil
+	}
+
+	var c ValidatedAddress
+	c.Street = v.Street
+	c.Unit = _I32_ClonePtr(v.Unit)
+
+	return &c
+}
+
+// Validate returns an error if any field of this ValidatedAddress violates
+// the constraints declared on it with validate.* annotations. All
+// violations, including those of nested structs, are reported
+// together as a validation.Errors.
+func (v *ValidatedAddress) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	var errs validation.Errors
+	if len(v.Street) < 1 {
+		errs.Add("street", "length must be at least 1")
+	}
+	if v.Unit != nil && *v.Unit < 1 {
+		errs.Add("unit", "must be at least 1")
+	}
+	if v.Unit != nil && *v.Unit > 9999 {
+		errs.Add("unit", "must be at most 9999")
+	}
+	return errs.Err()
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Rename.
-func (v *Rename) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ValidatedAddress.
+func (v *ValidatedAddress) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("Default", v.Default)
-	enc.AddString("camelCase", v.CamelCase)
+	enc.AddString("street", v.Street)
+	if v.Unit != nil {
+		enc.AddInt32("unit", *v.Unit)
+	}
 	return err
 }
 
-// GetDefault returns the value of Default if it is set or its
+// GetStreet returns the value of Street if it is set or its
 // zero value if it is unset.
-func (v *Rename) GetDefault() (o string) {
+func (v *ValidatedAddress) GetStreet() (o string) {
 	if v != nil {
-		o = v.Default
+		o = v.Street
 	}
 	return
 }
 
-// GetCamelCase returns the value of CamelCase if it is set or its
+// GetUnit returns the value of Unit if it is set or its
 // zero value if it is unset.
-func (v *Rename) GetCamelCase() (o string) {
-	if v != nil {
-		o = v.CamelCase
+func (v *ValidatedAddress) GetUnit() (o int32) {
+	if v != nil && v.Unit != nil {
+		return *v.Unit
 	}
+
 	return
 }
 
-// Size of something.
-type Size struct {
-	// Width in pixels.
-	Width float64 `json:"width,required"`
-	// Height in pixels.
-	Height float64 `json:"height,required"`
+// IsSetUnit returns true if Unit is not nil.
+func (v *ValidatedAddress) IsSetUnit() bool {
+	return v != nil && v.Unit != nil
 }
 
-// ToWire translates a Size struct into a Thrift-level intermediate
+type ValidatedStruct struct {
+	Name            string            `json:"name,required"`
+	Age             *int16            `json:"age,omitempty"`
+	Score           *float64          `json:"score,omitempty"`
+	Email           *string           `json:"email,omitempty"`
+	Slug            *Slug             `json:"slug,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Address         *ValidatedAddress `json:"address,required"`
+	PreviousAddress *ValidatedAddress `json:"previousAddress,omitempty"`
+}
+
+// ToWire translates a ValidatedStruct struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3677,36 +10754,98 @@ type Size struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Size) ToWire() (wire.Value, error) {
+func (v *ValidatedStruct) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueDouble(v.Width), error(nil)
+	w, err = wire.NewValueString(v.Name), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	w, err = wire.NewValueDouble(v.Height), error(nil)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Age != nil {
+		w, err = wire.NewValueI16(*(v.Age)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Score != nil {
+		w, err = wire.NewValueDouble(*(v.Score)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Email != nil {
+		w, err = wire.NewValueString(*(v.Email)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Slug != nil {
+		w, err = v.Slug.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Address == nil {
+		return w, errors.New("field Address of ValidatedStruct is required")
+	}
+	w, err = v.Address.ToWire()
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
+	fields[i] = wire.Field{ID: 7, Value: w}
 	i++
+	if v.PreviousAddress != nil {
+		w, err = v.PreviousAddress.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Size struct from its Thrift-level
+func _Slug_Read(w wire.Value) (Slug, error) {
+	var x Slug
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _ValidatedAddress_Read(w wire.Value) (*ValidatedAddress, error) {
+	var v ValidatedAddress
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ValidatedStruct struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Size struct
+// An error is returned if we were unable to build a ValidatedStruct struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3714,392 +10853,694 @@ func (v *Size) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Size
+//   var v ValidatedStruct
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Size) FromWire(w wire.Value) error {
+func (v *ValidatedStruct) FromWire(w wire.Value) error {
 	var err error
 
-	widthIsSet := false
-	heightIsSet := false
+	nameIsSet := false
+
+	addressIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TDouble {
-				v.Width, err = field.Value.GetDouble(), error(nil)
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				widthIsSet = true
+				nameIsSet = true
 			}
 		case 2:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.Age = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
 			if field.Value.Type() == wire.TDouble {
-				v.Height, err = field.Value.GetDouble(), error(nil)
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Score = &x
 				if err != nil {
 					return err
 				}
-				heightIsSet = true
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Email = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				var x Slug
+				x, err = _Slug_Read(field.Value)
+				v.Slug = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.Address, err = _ValidatedAddress_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				addressIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.PreviousAddress, err = _ValidatedAddress_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
-	if !widthIsSet {
-		return errors.New("field Width of Size is required")
+	if !nameIsSet {
+		missing.Add("ValidatedStruct", "Name")
 	}
 
-	if !heightIsSet {
-		return errors.New("field Height of Size is required")
+	if !addressIsSet {
+		missing.Add("ValidatedStruct", "Address")
 	}
 
-	return nil
+	return missing.Err()
 }
 
-// String returns a readable string representation of a Size
-// struct.
-func (v *Size) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [2]string
-	i := 0
-	fields[i] = fmt.Sprintf("Width: %v", v.Width)
-	i++
-	fields[i] = fmt.Sprintf("Height: %v", v.Height)
-	i++
+func _Slug_Decode(sr stream.Reader) (Slug, error) {
+	var x Slug
+	err := x.Decode(sr)
+	return x, err
+}
 
-	return fmt.Sprintf("Size{%v}", strings.Join(fields[:i], ", "))
+func _ValidatedAddress_Decode(sr stream.Reader) (*ValidatedAddress, error) {
+	var v ValidatedAddress
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// Equals returns true if all the fields of this Size match the
-// provided Size.
-//
-// This function performs a deep comparison.
-func (v *Size) Equals(rhs *Size) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !(v.Width == rhs.Width) {
-		return false
+func (v *ValidatedStruct) Decode(sr stream.Reader) error {
+	nameIsSet := false
+
+	addressIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	if !(v.Height == rhs.Height) {
-		return false
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return true
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.Age = &x
+			if err != nil {
+				return err
+			}
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Size.
-func (v *Size) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+		case fh.ID == 3 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.Score = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Email = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			var x Slug
+			x, err = _Slug_Decode(sr)
+			v.Slug = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TList:
+			v.Tags, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TStruct:
+			v.Address, err = _ValidatedAddress_Decode(sr)
+			if err != nil {
+				return err
+			}
+			addressIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TStruct:
+			v.PreviousAddress, err = _ValidatedAddress_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	enc.AddFloat64("width", v.Width)
-	enc.AddFloat64("height", v.Height)
-	return err
-}
 
-// GetWidth returns the value of Width if it is set or its
-// zero value if it is unset.
-func (v *Size) GetWidth() (o float64) {
-	if v != nil {
-		o = v.Width
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
-	return
-}
 
-// GetHeight returns the value of Height if it is set or its
-// zero value if it is unset.
-func (v *Size) GetHeight() (o float64) {
-	if v != nil {
-		o = v.Height
+	if !nameIsSet {
+		return errors.New("field Name of ValidatedStruct is required")
 	}
-	return
-}
 
-type StructLabels struct {
-	IsRequired *bool   `json:"required,omitempty"`
-	Foo        *string `json:"not_bar,omitempty"`
-	Qux        *string `json:"qux,omitempty"`
-	Quux       *string `json:"QUUX,omitempty"`
+	if !addressIsSet {
+		return errors.New("field Address of ValidatedStruct is required")
+	}
+
+	return nil
 }
 
-// ToWire translates a StructLabels struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// MarshalJSON serializes a ValidatedStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *StructLabels) ToWire() (wire.Value, error) {
-	var (
-		fields [4]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// This implements json.Marshaler.
+func (v *ValidatedStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
 
-	if v.IsRequired != nil {
-		w, err = wire.NewValueBool(*(v.IsRequired)), error(nil)
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
 		if err != nil {
-			return w, err
+			return nil, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
 	}
-	if v.Foo != nil {
-		w, err = wire.NewValueString(*(v.Foo)), error(nil)
+	if !(v.Age == nil) {
+		b, err := json.Marshal(v.Age)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"age":`)
+		buff.Write(b)
+	}
+	if !(v.Score == nil) {
+		b, err := json.Marshal(v.Score)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"score":`)
+		buff.Write(b)
+	}
+	if !(v.Email == nil) {
+		b, err := json.Marshal(v.Email)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"email":`)
+		buff.Write(b)
+	}
+	if !(v.Slug == nil) {
+		b, err := json.Marshal(v.Slug)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"slug":`)
+		buff.Write(b)
+	}
+	if !(len(v.Tags) == 0) {
+		b, err := json.Marshal(v.Tags)
 		if err != nil {
-			return w, err
+			return nil, err
 		}
-		fields[i] = wire.Field{ID: 2, Value: w}
-		i++
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"tags":`)
+		buff.Write(b)
 	}
-	if v.Qux != nil {
-		w, err = wire.NewValueString(*(v.Qux)), error(nil)
+	{
+		b, err := json.Marshal(v.Address)
 		if err != nil {
-			return w, err
+			return nil, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"address":`)
+		buff.Write(b)
 	}
-	if v.Quux != nil {
-		w, err = wire.NewValueString(*(v.Quux)), error(nil)
+	if !(v.PreviousAddress == nil) {
+		b, err := json.Marshal(v.PreviousAddress)
 		if err != nil {
-			return w, err
+			return nil, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"previousAddress":`)
+		buff.Write(b)
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-// FromWire deserializes a StructLabels struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a StructLabels struct
-// from the provided intermediate representation.
+// UnmarshalJSON deserializes a ValidatedStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
 //
-//   var v StructLabels
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *StructLabels) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsRequired = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 2:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Foo = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Qux = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Quux = &x
-				if err != nil {
-					return err
-				}
-
-			}
+// This implements json.Unmarshaler.
+func (v *ValidatedStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["age"]; ok {
+		if err := json.Unmarshal(r, &v.Age); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["score"]; ok {
+		if err := json.Unmarshal(r, &v.Score); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["email"]; ok {
+		if err := json.Unmarshal(r, &v.Email); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["slug"]; ok {
+		if err := json.Unmarshal(r, &v.Slug); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["tags"]; ok {
+		if err := json.Unmarshal(r, &v.Tags); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["address"]; ok {
+		if err := json.Unmarshal(r, &v.Address); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["previousAddress"]; ok {
+		if err := json.Unmarshal(r, &v.PreviousAddress); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a StructLabels
+// String returns a readable string representation of a ValidatedStruct
 // struct.
-func (v *StructLabels) String() string {
+func (v *ValidatedStruct) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [8]string
 	i := 0
-	if v.IsRequired != nil {
-		fields[i] = fmt.Sprintf("IsRequired: %v", *(v.IsRequired))
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Age != nil {
+		fields[i] = fmt.Sprintf("Age: %v", *(v.Age))
 		i++
 	}
-	if v.Foo != nil {
-		fields[i] = fmt.Sprintf("Foo: %v", *(v.Foo))
+	if v.Score != nil {
+		fields[i] = fmt.Sprintf("Score: %v", *(v.Score))
 		i++
 	}
-	if v.Qux != nil {
-		fields[i] = fmt.Sprintf("Qux: %v", *(v.Qux))
+	if v.Email != nil {
+		fields[i] = fmt.Sprintf("Email: %v", *(v.Email))
 		i++
 	}
-	if v.Quux != nil {
-		fields[i] = fmt.Sprintf("Quux: %v", *(v.Quux))
+	if v.Slug != nil {
+		fields[i] = fmt.Sprintf("Slug: %v", *(v.Slug))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	fields[i] = fmt.Sprintf("Address: %v", v.Address)
+	i++
+	if v.PreviousAddress != nil {
+		fields[i] = fmt.Sprintf("PreviousAddress: %v", v.PreviousAddress)
 		i++
 	}
 
-	return fmt.Sprintf("StructLabels{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ValidatedStruct{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this StructLabels match the
-// provided StructLabels.
+func _Slug_EqualsPtr(lhs, rhs *Slug) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ValidatedStruct match the
+// provided ValidatedStruct.
 //
 // This function performs a deep comparison.
-func (v *StructLabels) Equals(rhs *StructLabels) bool {
+func (v *ValidatedStruct) Equals(rhs *ValidatedStruct) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.IsRequired, rhs.IsRequired) {
+	if !(v.Name == rhs.Name) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Foo, rhs.Foo) {
+	if !_I16_EqualsPtr(v.Age, rhs.Age) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Qux, rhs.Qux) {
+	if !_Double_EqualsPtr(v.Score, rhs.Score) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Quux, rhs.Quux) {
+	if !_String_EqualsPtr(v.Email, rhs.Email) {
+		return false
+	}
+	if !_Slug_EqualsPtr(v.Slug, rhs.Slug) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !v.Address.Equals(rhs.Address) {
+		return false
+	}
+	if !((v.PreviousAddress == nil && rhs.PreviousAddress == nil) || (v.PreviousAddress != nil && rhs.PreviousAddress != nil && v.PreviousAddress.Equals(rhs.PreviousAddress))) {
 		return false
 	}
 
 	return true
 }
 
+func _Slug_ClonePtr(v *Slug) *Slug {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this ValidatedStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ValidatedStruct) Clone() *ValidatedStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c ValidatedStruct
+	c.Name = v.Name
+	c.Age = _I16_ClonePtr(v.Age)
+	c.Score = _Double_ClonePtr(v.Score)
+	c.Email = _String_ClonePtr(v.Email)
+	c.Slug = _Slug_ClonePtr(v.Slug)
+	c.Tags = _List_String_Clone(v.Tags)
+	c.Address = v.Address.Clone()
+	c.PreviousAddress = v.PreviousAddress.Clone()
+
+	return &c
+}
+
+var _ValidatedStruct_Email_Pattern = regexp.MustCompile("^[^@]+@[^@]+$")
+
+var _ValidatedStruct_Slug_Pattern = regexp.MustCompile("^[a-z-]+$")
+
+// Validate returns an error if any field of this ValidatedStruct violates
+// the constraints declared on it with validate.* annotations. All
+// violations, including those of nested structs, are reported
+// together as a validation.Errors.
+func (v *ValidatedStruct) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	var errs validation.Errors
+	if len(v.Name) < 1 {
+		errs.Add("name", "length must be at least 1")
+	}
+	if len(v.Name) > 8 {
+		errs.Add("name", "length must be at most 8")
+	}
+	if v.Age != nil && *v.Age < 0 {
+		errs.Add("age", "must be at least 0")
+	}
+	if v.Age != nil && *v.Age > 150 {
+		errs.Add("age", "must be at most 150")
+	}
+	if v.Score != nil && *v.Score < 0.5 {
+		errs.Add("score", "must be at least 0.5")
+	}
+	if v.Email != nil && !_ValidatedStruct_Email_Pattern.MatchString(*v.Email) {
+		errs.Add("email", "must match pattern \"^[^@]+@[^@]+$\"")
+	}
+	if v.Slug != nil && !_ValidatedStruct_Slug_Pattern.MatchString(string(*v.Slug)) {
+		errs.Add("slug", "must match pattern \"^[a-z-]+$\"")
+	}
+	if v.Tags != nil && len(v.Tags) > 2 {
+		errs.Add("tags", "length must be at most 2")
+	}
+	errs.Nest("address", v.Address.Validate())
+	errs.Nest("previousAddress", v.PreviousAddress.Validate())
+	return errs.Err()
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of StructLabels.
-func (v *StructLabels) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ValidatedStruct.
+func (v *ValidatedStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.IsRequired != nil {
-		enc.AddBool("required", *v.IsRequired)
+	enc.AddString("name", v.Name)
+	if v.Age != nil {
+		enc.AddInt16("age", *v.Age)
 	}
-	if v.Foo != nil {
-		enc.AddString("bar", *v.Foo)
+	if v.Score != nil {
+		enc.AddFloat64("score", *v.Score)
 	}
-	if v.Qux != nil {
-		enc.AddString("qux", *v.Qux)
+	if v.Email != nil {
+		enc.AddString("email", *v.Email)
 	}
-	if v.Quux != nil {
-		enc.AddString("QUUX", *v.Quux)
+	if v.Slug != nil {
+		enc.AddString("slug", (string)(*v.Slug))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	err = multierr.Append(err, enc.AddObject("address", v.Address))
+	if v.PreviousAddress != nil {
+		err = multierr.Append(err, enc.AddObject("previousAddress", v.PreviousAddress))
 	}
 	return err
 }
 
-// GetIsRequired returns the value of IsRequired if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *StructLabels) GetIsRequired() (o bool) {
-	if v != nil && v.IsRequired != nil {
-		return *v.IsRequired
+func (v *ValidatedStruct) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetAge returns the value of Age if it is set or its
+// zero value if it is unset.
+func (v *ValidatedStruct) GetAge() (o int16) {
+	if v != nil && v.Age != nil {
+		return *v.Age
 	}
 
 	return
 }
 
-// IsSetIsRequired returns true if IsRequired is not nil.
-func (v *StructLabels) IsSetIsRequired() bool {
-	return v != nil && v.IsRequired != nil
+// IsSetAge returns true if Age is not nil.
+func (v *ValidatedStruct) IsSetAge() bool {
+	return v != nil && v.Age != nil
 }
 
-// GetFoo returns the value of Foo if it is set or its
+// GetScore returns the value of Score if it is set or its
 // zero value if it is unset.
-func (v *StructLabels) GetFoo() (o string) {
-	if v != nil && v.Foo != nil {
-		return *v.Foo
+func (v *ValidatedStruct) GetScore() (o float64) {
+	if v != nil && v.Score != nil {
+		return *v.Score
 	}
 
 	return
 }
 
-// IsSetFoo returns true if Foo is not nil.
-func (v *StructLabels) IsSetFoo() bool {
-	return v != nil && v.Foo != nil
+// IsSetScore returns true if Score is not nil.
+func (v *ValidatedStruct) IsSetScore() bool {
+	return v != nil && v.Score != nil
 }
 
-// GetQux returns the value of Qux if it is set or its
+// GetEmail returns the value of Email if it is set or its
 // zero value if it is unset.
-func (v *StructLabels) GetQux() (o string) {
-	if v != nil && v.Qux != nil {
-		return *v.Qux
+func (v *ValidatedStruct) GetEmail() (o string) {
+	if v != nil && v.Email != nil {
+		return *v.Email
 	}
 
 	return
 }
 
-// IsSetQux returns true if Qux is not nil.
-func (v *StructLabels) IsSetQux() bool {
-	return v != nil && v.Qux != nil
+// IsSetEmail returns true if Email is not nil.
+func (v *ValidatedStruct) IsSetEmail() bool {
+	return v != nil && v.Email != nil
 }
 
-// GetQuux returns the value of Quux if it is set or its
+// GetSlug returns the value of Slug if it is set or its
 // zero value if it is unset.
-func (v *StructLabels) GetQuux() (o string) {
-	if v != nil && v.Quux != nil {
-		return *v.Quux
+func (v *ValidatedStruct) GetSlug() (o Slug) {
+	if v != nil && v.Slug != nil {
+		return *v.Slug
 	}
 
 	return
 }
 
-// IsSetQuux returns true if Quux is not nil.
-func (v *StructLabels) IsSetQuux() bool {
-	return v != nil && v.Quux != nil
+// IsSetSlug returns true if Slug is not nil.
+func (v *ValidatedStruct) IsSetSlug() bool {
+	return v != nil && v.Slug != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *ValidatedStruct) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *ValidatedStruct) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *ValidatedStruct) GetAddress() (o *ValidatedAddress) {
+	if v != nil {
+		o = v.Address
+	}
+	return
+}
+
+// IsSetAddress returns true if Address is not nil.
+func (v *ValidatedStruct) IsSetAddress() bool {
+	return v != nil && v.Address != nil
+}
+
+// GetPreviousAddress returns the value of PreviousAddress if it is set or its
+// zero value if it is unset.
+func (v *ValidatedStruct) GetPreviousAddress() (o *ValidatedAddress) {
+	if v != nil && v.PreviousAddress != nil {
+		return v.PreviousAddress
+	}
+
+	return
+}
+
+// IsSetPreviousAddress returns true if PreviousAddress is not nil.
+func (v *ValidatedStruct) IsSetPreviousAddress() bool {
+	return v != nil && v.PreviousAddress != nil
 }
 
-type User struct {
-	Name     string        `json:"name,required"`
-	Contact  *ContactInfo  `json:"contact,omitempty"`
-	Personal *PersonalInfo `json:"personal,omitempty"`
+type ZapOptOutStruct struct {
+	Name   string `json:"name,required"`
+	Optout string `json:"optout,required"`
 }
 
-// ToWire translates a User struct into a Thrift-level intermediate
+// ToWire translates a ZapOptOutStruct struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4114,9 +11555,9 @@ type User struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *User) ToWire() (wire.Value, error) {
+func (v *ZapOptOutStruct) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4128,43 +11569,22 @@ func (v *User) ToWire() (wire.Value, error) {
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
-	if v.Contact != nil {
-		w, err = v.Contact.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 2, Value: w}
-		i++
-	}
-	if v.Personal != nil {
-		w, err = v.Personal.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
+
+	w, err = wire.NewValueString(v.Optout), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ContactInfo_Read(w wire.Value) (*ContactInfo, error) {
-	var v ContactInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _PersonalInfo_Read(w wire.Value) (*PersonalInfo, error) {
-	var v PersonalInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a User struct from its Thrift-level
+// FromWire deserializes a ZapOptOutStruct struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a User struct
+// An error is returned if we were unable to build a ZapOptOutStruct struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4172,15 +11592,18 @@ func _PersonalInfo_Read(w wire.Value) (*PersonalInfo, error) {
 //     return nil, err
 //   }
 //
-//   var v User
+//   var v ZapOptOutStruct
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *User) FromWire(w wire.Value) error {
+func (v *ZapOptOutStruct) FromWire(w wire.Value) error {
 	var err error
 
 	nameIsSet := false
+	optoutIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -4193,273 +11616,239 @@ func (v *User) FromWire(w wire.Value) error {
 				nameIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TStruct {
-				v.Contact, err = _ContactInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.Personal, err = _PersonalInfo_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				v.Optout, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				optoutIsSet = true
 			}
 		}
 	}
 
 	if !nameIsSet {
-		return errors.New("field Name of User is required")
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a User
-// struct.
-func (v *User) String() string {
-	if v == nil {
-		return "<nil>"
+		missing.Add("ZapOptOutStruct", "Name")
 	}
 
-	var fields [3]string
-	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	if v.Contact != nil {
-		fields[i] = fmt.Sprintf("Contact: %v", v.Contact)
-		i++
-	}
-	if v.Personal != nil {
-		fields[i] = fmt.Sprintf("Personal: %v", v.Personal)
-		i++
+	if !optoutIsSet {
+		missing.Add("ZapOptOutStruct", "Optout")
 	}
 
-	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+	return missing.Err()
 }
 
-// Equals returns true if all the fields of this User match the
-// provided User.
-//
-// This function performs a deep comparison.
-func (v *User) Equals(rhs *User) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !(v.Name == rhs.Name) {
-		return false
-	}
-	if !((v.Contact == nil && rhs.Contact == nil) || (v.Contact != nil && rhs.Contact != nil && v.Contact.Equals(rhs.Contact))) {
-		return false
+func (v *ZapOptOutStruct) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	optoutIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	if !((v.Personal == nil && rhs.Personal == nil) || (v.Personal != nil && rhs.Personal != nil && v.Personal.Equals(rhs.Personal))) {
-		return false
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return true
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Optout, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			optoutIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of User.
-func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	enc.AddString("name", v.Name)
-	if v.Contact != nil {
-		err = multierr.Append(err, enc.AddObject("contact", v.Contact))
-	}
-	if v.Personal != nil {
-		err = multierr.Append(err, enc.AddObject("personal", v.Personal))
-	}
-	return err
-}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *User) GetName() (o string) {
-	if v != nil {
-		o = v.Name
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	return
-}
 
-// GetContact returns the value of Contact if it is set or its
-// zero value if it is unset.
-func (v *User) GetContact() (o *ContactInfo) {
-	if v != nil && v.Contact != nil {
-		return v.Contact
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
-	return
-}
-
-// IsSetContact returns true if Contact is not nil.
-func (v *User) IsSetContact() bool {
-	return v != nil && v.Contact != nil
-}
-
-// GetPersonal returns the value of Personal if it is set or its
-// zero value if it is unset.
-func (v *User) GetPersonal() (o *PersonalInfo) {
-	if v != nil && v.Personal != nil {
-		return v.Personal
+	if !nameIsSet {
+		return errors.New("field Name of ZapOptOutStruct is required")
 	}
 
-	return
-}
+	if !optoutIsSet {
+		return errors.New("field Optout of ZapOptOutStruct is required")
+	}
 
-// IsSetPersonal returns true if Personal is not nil.
-func (v *User) IsSetPersonal() bool {
-	return v != nil && v.Personal != nil
+	return nil
 }
 
-type _Map_String_User_MapItemList map[string]*User
+// MarshalJSON serializes a ZapOptOutStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ZapOptOutStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
 
-func (m _Map_String_User_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
 		if err != nil {
-			return err
+			return nil, err
 		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Optout)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
 		}
+		buff.WriteString(`"optout":`)
+		buff.Write(b)
 	}
-	return nil
-}
-
-func (m _Map_String_User_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_User_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
 
-func (_Map_String_User_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
-}
-
-func (_Map_String_User_MapItemList) Close() {}
-
-func _User_Read(w wire.Value) (*User, error) {
-	var v User
-	err := v.FromWire(w)
-	return &v, err
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-func _Map_String_User_Read(m wire.MapItemList) (map[string]*User, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
+// UnmarshalJSON deserializes a ZapOptOutStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ZapOptOutStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
 	}
-
-	o := make(map[string]*User, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
 			return err
 		}
-
-		v, err := _User_Read(x.Value)
-		if err != nil {
+	}
+	if r, ok := raw["optout"]; ok {
+		if err := json.Unmarshal(r, &v.Optout); err != nil {
 			return err
 		}
+	}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
+	return nil
+}
+
+// String returns a readable string representation of a ZapOptOutStruct
+// struct.
+func (v *ZapOptOutStruct) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = "Optout: <redacted>"
+	i++
+
+	return fmt.Sprintf("ZapOptOutStruct{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Map_String_User_Equals(lhs, rhs map[string]*User) bool {
-	if len(lhs) != len(rhs) {
+// Equals returns true if all the fields of this ZapOptOutStruct match the
+// provided ZapOptOutStruct.
+//
+// This function performs a deep comparison.
+func (v *ZapOptOutStruct) Equals(rhs *ZapOptOutStruct) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
 		return false
 	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
+	if !(v.Name == rhs.Name) {
+		return false
 	}
+	if !(v.Optout == rhs.Optout) {
+		return false
+	}
+
 	return true
 }
 
-type _Map_String_User_Zapper map[string]*User
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_User_Zapper.
-func (m _Map_String_User_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
+// Clone returns a deep copy of this ZapOptOutStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ZapOptOutStruct) Clone() *ZapOptOutStruct {
+	if v == nil {
+		return nil
 	}
-	return err
-}
 
-type UserMap map[string]*User
+	var c ZapOptOutStruct
+	c.Name = v.Name
+	c.Optout = v.Optout
 
-// ToWire translates UserMap into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-func (v UserMap) ToWire() (wire.Value, error) {
-	x := (map[string]*User)(v)
-	return wire.NewValueMap(_Map_String_User_MapItemList(x)), error(nil)
+	return &c
 }
 
-// String returns a readable string representation of UserMap.
-func (v UserMap) String() string {
-	x := (map[string]*User)(v)
-	return fmt.Sprint(x)
-}
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ZapOptOutStruct.
+func (v *ZapOptOutStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
 
-// FromWire deserializes UserMap from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-func (v *UserMap) FromWire(w wire.Value) error {
-	x, err := _Map_String_User_Read(w.GetMap())
-	*v = (UserMap)(x)
 	return err
 }
 
-// Equals returns true if this UserMap is equal to the provided
-// UserMap.
-func (lhs UserMap) Equals(rhs UserMap) bool {
-	return _Map_String_User_Equals((map[string]*User)(lhs), (map[string]*User)(rhs))
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *ZapOptOutStruct) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
 }
 
-func (v UserMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	return ((_Map_String_User_Zapper)((map[string]*User)(v))).MarshalLogObject(enc)
+// GetOptout returns the value of Optout if it is set or its
+// zero value if it is unset.
+func (v *ZapOptOutStruct) GetOptout() (o string) {
+	if v != nil {
+		o = v.Optout
+	}
+	return
 }
 
-type ZapOptOutStruct struct {
-	Name   string `json:"name,required"`
-	Optout string `json:"optout,required"`
+type ZapRedactStruct struct {
+	Name     string   `json:"name,required"`
+	Password string   `json:"password,required"`
+	Token    []byte   `json:"token,omitempty"`
+	Secrets  []string `json:"secrets,omitempty"`
 }
 
-// ToWire translates a ZapOptOutStruct struct into a Thrift-level intermediate
+// ToWire translates a ZapRedactStruct struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4474,9 +11863,9 @@ type ZapOptOutStruct struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ZapOptOutStruct) ToWire() (wire.Value, error) {
+func (v *ZapRedactStruct) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4489,21 +11878,37 @@ func (v *ZapOptOutStruct) ToWire() (wire.Value, error) {
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.Optout), error(nil)
+	w, err = wire.NewValueString(v.Password), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
+	if v.Token != nil {
+		w, err = wire.NewValueBinary(v.Token), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Secrets != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Secrets)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ZapOptOutStruct struct from its Thrift-level
+// FromWire deserializes a ZapRedactStruct struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ZapOptOutStruct struct
+// An error is returned if we were unable to build a ZapRedactStruct struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4511,16 +11916,18 @@ func (v *ZapOptOutStruct) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ZapOptOutStruct
+//   var v ZapRedactStruct
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ZapOptOutStruct) FromWire(w wire.Value) error {
+func (v *ZapRedactStruct) FromWire(w wire.Value) error {
 	var err error
 
 	nameIsSet := false
-	optoutIsSet := false
+	passwordIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -4534,48 +11941,239 @@ func (v *ZapOptOutStruct) FromWire(w wire.Value) error {
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.Optout, err = field.Value.GetString(), error(nil)
+				v.Password, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				optoutIsSet = true
+				passwordIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.Token, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Secrets, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
 	if !nameIsSet {
-		return errors.New("field Name of ZapOptOutStruct is required")
+		missing.Add("ZapRedactStruct", "Name")
 	}
 
-	if !optoutIsSet {
-		return errors.New("field Optout of ZapOptOutStruct is required")
+	if !passwordIsSet {
+		missing.Add("ZapRedactStruct", "Password")
+	}
+
+	return missing.Err()
+}
+
+func (v *ZapRedactStruct) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	passwordIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Password, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			passwordIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.Token, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TList:
+			v.Secrets, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of ZapRedactStruct is required")
+	}
+
+	if !passwordIsSet {
+		return errors.New("field Password of ZapRedactStruct is required")
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a ZapOptOutStruct
+// MarshalJSON serializes a ZapRedactStruct struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ZapRedactStruct) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Password)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"password":`)
+		buff.Write(b)
+	}
+	if !(len(v.Token) == 0) {
+		b, err := json.Marshal(v.Token)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"token":`)
+		buff.Write(b)
+	}
+	if !(len(v.Secrets) == 0) {
+		b, err := json.Marshal(v.Secrets)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"secrets":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ZapRedactStruct struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ZapRedactStruct) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["password"]; ok {
+		if err := json.Unmarshal(r, &v.Password); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["token"]; ok {
+		if err := json.Unmarshal(r, &v.Token); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["secrets"]; ok {
+		if err := json.Unmarshal(r, &v.Secrets); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ZapRedactStruct
 // struct.
-func (v *ZapOptOutStruct) String() string {
+func (v *ZapRedactStruct) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
-	fields[i] = fmt.Sprintf("Optout: %v", v.Optout)
+	fields[i] = "Password: <redacted>"
 	i++
+	if v.Token != nil {
+		fields[i] = "Token: <redacted>"
+		i++
+	}
+	if v.Secrets != nil {
+		fields[i] = "Secrets: <redacted>"
+		i++
+	}
 
-	return fmt.Sprintf("ZapOptOutStruct{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ZapRedactStruct{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ZapOptOutStruct match the
-// provided ZapOptOutStruct.
+// Equals returns true if all the fields of this ZapRedactStruct match the
+// provided ZapRedactStruct.
 //
 // This function performs a deep comparison.
-func (v *ZapOptOutStruct) Equals(rhs *ZapOptOutStruct) bool {
+func (v *ZapRedactStruct) Equals(rhs *ZapRedactStruct) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4584,52 +12182,116 @@ func (v *ZapOptOutStruct) Equals(rhs *ZapOptOutStruct) bool {
 	if !(v.Name == rhs.Name) {
 		return false
 	}
-	if !(v.Optout == rhs.Optout) {
+	if !(v.Password == rhs.Password) {
+		return false
+	}
+	if !((v.Token == nil && rhs.Token == nil) || (v.Token != nil && rhs.Token != nil && bytes.Equal(v.Token, rhs.Token))) {
+		return false
+	}
+	if !((v.Secrets == nil && rhs.Secrets == nil) || (v.Secrets != nil && rhs.Secrets != nil && _List_String_Equals(v.Secrets, rhs.Secrets))) {
 		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this ZapRedactStruct. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ZapRedactStruct) Clone() *ZapRedactStruct {
+	if v == nil {
+		return nil
+	}
+
+	var c ZapRedactStruct
+	c.Name = v.Name
+	c.Password = v.Password
+	c.Token = _Binary_Clone(v.Token)
+	c.Secrets = _List_String_Clone(v.Secrets)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ZapOptOutStruct.
-func (v *ZapOptOutStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ZapRedactStruct.
+func (v *ZapRedactStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	enc.AddString("name", v.Name)
-
+	enc.AddString("password", "<redacted>")
+	if v.Token != nil {
+		enc.AddString("token", "<redacted>")
+	}
+	if v.Secrets != nil {
+		enc.AddString("secrets", "<redacted>")
+	}
 	return err
 }
 
 // GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *ZapOptOutStruct) GetName() (o string) {
+func (v *ZapRedactStruct) GetName() (o string) {
 	if v != nil {
 		o = v.Name
 	}
 	return
 }
 
-// GetOptout returns the value of Optout if it is set or its
+// GetPassword returns the value of Password if it is set or its
 // zero value if it is unset.
-func (v *ZapOptOutStruct) GetOptout() (o string) {
+func (v *ZapRedactStruct) GetPassword() (o string) {
 	if v != nil {
-		o = v.Optout
+		o = v.Password
+	}
+	return
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+func (v *ZapRedactStruct) GetToken() (o []byte) {
+	if v != nil && v.Token != nil {
+		return v.Token
+	}
+
+	return
+}
+
+// IsSetToken returns true if Token is not nil.
+func (v *ZapRedactStruct) IsSetToken() bool {
+	return v != nil && v.Token != nil
+}
+
+// GetSecrets returns the value of Secrets if it is set or its
+// zero value if it is unset.
+func (v *ZapRedactStruct) GetSecrets() (o []string) {
+	if v != nil && v.Secrets != nil {
+		return v.Secrets
 	}
+
 	return
 }
 
+// IsSetSecrets returns true if Secrets is not nil.
+func (v *ZapRedactStruct) IsSetSecrets() bool {
+	return v != nil && v.Secrets != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "structs",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/structs",
 	FilePath: "structs.thrift",
-	SHA1:     "dab5c0bea66d50915769e8c6c2f125dc93b16738",
+	SHA1:     "5a48697d38ff826824d0944920080b27ce9827de",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		enums.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./enums.thrift\"\n\nstruct EmptyStruct {}\n\n//////////////////////////////////////////////////////////////////////////////\n// Structs with primitives\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are required.\n */\nstruct PrimitiveRequiredStruct {\n    1: required bool boolField\n    2: required byte byteField\n    3: required i16 int16Field\n    4: required i32 int32Field\n    5: required i64 int64Field\n    6: required double doubleField\n    7: required string stringField\n    8: required binary binaryField\n}\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are optional.\n */\nstruct PrimitiveOptionalStruct {\n    1: optional bool boolField\n    2: optional byte byteField\n    3: optional i16 int16Field\n    4: optional i32 int32Field\n    5: optional i64 int64Field\n    6: optional double doubleField\n    7: optional string stringField\n    8: optional binary binaryField\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Required)\n\n/**\n * A point in 2D space.\n */\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\n/**\n * Size of something.\n */\nstruct Size {\n    /**\n     * Width in pixels.\n     */\n    1: required double width\n    /** Height in pixels. */\n    2: required double height\n}\n\nstruct Frame {\n    1: required Point topLeft\n    2: required Size size\n}\n\nstruct Edge {\n    1: required Point startPoint\n    2: required Point endPoint\n}\n\n/**\n * A graph is comprised of zero or more edges.\n */\nstruct Graph {\n    /**\n     * List of edges in the graph.\n     *\n     * May be empty.\n     */\n    1: required list<Edge> edges\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Optional)\n\nstruct ContactInfo {\n    1: required string emailAddress\n}\n\nstruct PersonalInfo {\n    1: optional i32 age\n}\n\nstruct User {\n    1: required string name\n    2: optional ContactInfo contact\n    3: optional PersonalInfo personal\n}\n\ntypedef map<string, User> UserMap\n\n//////////////////////////////////////////////////////////////////////////////\n// self-referential struct\n\ntypedef Node List\n\n/**\n * Node is linked list of values.\n * All values are 32-bit integers.\n */\nstruct Node {\n    1: required i32 value\n    2: optional List tail\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// JSON tagged structs\n\nstruct Rename {\n    1: required string Default (go.tag = 'json:\"default\"')\n    2: required string camelCase (go.tag = 'json:\"snake_case\"')\n}\n\nstruct Omit {\n    1: required string serialized\n    2: required string hidden (go.tag = 'json:\"-\"')\n}\n\nstruct GoTags {\n        1: required string Foo (go.tag = 'json:\"-\" foo:\"bar\"')\n        2: optional string Bar (go.tag = 'bar:\"foo\"')\n        3: required string FooBar (go.tag = 'json:\"foobar,option1,option2\" bar:\"foo,option1\" foo:\"foobar\"')\n        4: required string FooBarWithSpace (go.tag = 'json:\"foobarWithSpace\" foo:\"foo bar foobar barfoo\"')\n        5: optional string FooBarWithOmitEmpty (go.tag = 'json:\"foobarWithOmitEmpty,omitempty\"')\n        6: required string FooBarWithRequired (go.tag = 'json:\"foobarWithRequired,required\"')\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Default values\n\nstruct DefaultsStruct {\n    1: required i32 requiredPrimitive = 100\n    2: optional i32 optionalPrimitive = 200\n\n    3: required enums.EnumDefault requiredEnum = enums.EnumDefault.Bar\n    4: optional enums.EnumDefault optionalEnum = 2\n\n    5: required list<string> requiredList = [\"hello\", \"world\"]\n    6: optional list<double> optionalList = [1, 2.0, 3]\n\n    7: required Frame requiredStruct = {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    8: optional Edge optionalStruct = {\n        \"startPoint\": {\"x\": 1, \"y\": 2},\n        \"endPoint\":   {\"x\": 3, \"y\": 4},\n    }\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Opt-out of Zap\n\nstruct ZapOptOutStruct {\n    1: required string name\n    2: required string optout (go.nolog)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Field jabels\n\nstruct StructLabels {\n    // reserved keyword as label\n    1: optional bool isRequired (go.label = \"required\")\n\n    // go.tag's JSON tag takes precedence over go.label\n    2: optional string foo (go.label = \"bar\", go.tag = 'json:\"not_bar\"')\n\n    // Empty label\n    3: optional string qux (go.label = \"\")\n\n    // All-caps label\n    4: optional string quux (go.label = \"QUUX\")\n}\n"
+const rawIDL = "include \"./enums.thrift\"\n\nstruct EmptyStruct {}\n\n//////////////////////////////////////////////////////////////////////////////\n// Structs with primitives\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are required.\n */\nstruct PrimitiveRequiredStruct {\n    1: required bool boolField\n    2: required byte byteField\n    3: required i16 int16Field\n    4: required i32 int32Field\n    5: required i64 int64Field\n    6: required double doubleField\n    7: required string stringField\n    8: required binary binaryField\n}\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are optional.\n */\nstruct PrimitiveOptionalStruct {\n    1: optional bool boolField\n    2: optional byte byteField\n    3: optional i16 int16Field\n    4: optional i32 int32Field\n    5: optional i64 int64Field\n    6: optional double doubleField\n    7: optional string stringField\n    8: optional binary binaryField\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Required)\n\n/**\n * A point in 2D space.\n */\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\n/**\n * Size of something.\n */\nstruct Size {\n    /**\n     * Width in pixels.\n     */\n    1: required double width\n    /** Height in pixels. */\n    2: required double height\n}\n\nstruct Frame {\n    1: required Point topLeft\n    2: required Size size\n}\n\nstruct Edge {\n    1: required Point startPoint\n    2: required Point endPoint\n}\n\n/**\n * A graph is comprised of zero or more edges.\n */\nstruct Graph {\n    /**\n     * List of edges in the graph.\n     *\n     * May be empty.\n     */\n    1: required list<Edge> edges\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Optional)\n\nstruct ContactInfo {\n    1: required string emailAddress\n}\n\nstruct PersonalInfo {\n    1: optional i32 age\n}\n\nstruct User {\n    1: required string name\n    2: optional ContactInfo contact\n    3: optional PersonalInfo personal\n}\n\ntypedef map<string, User> UserMap\n\n//////////////////////////////////////////////////////////////////////////////\n// self-referential struct\n\ntypedef Node List\n\n/**\n * Node is linked list of values.\n * All values are 32-bit integers.\n */\nstruct Node {\n    1: required i32 value\n    2: optional List tail\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// mutually recursive structs\n\ntypedef list<Tree> Forest\ntypedef Forest Trees\n\n/**\n * Tree refers back to itself through a chain of typedefs and through\n * Branch.\n */\nstruct Tree {\n    1: required string name\n    2: optional Trees children\n    3: optional map<string, Tree> byName\n    4: optional Branch branch\n}\n\nstruct Branch {\n    1: required Tree tree\n    2: optional Branch sibling\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// JSON tagged structs\n\nstruct Rename {\n    1: required string Default (go.tag = 'json:\"default\"')\n    2: required string camelCase (go.tag = 'json:\"snake_case\"')\n}\n\nstruct Omit {\n    1: required string serialized\n    2: required string hidden (go.tag = 'json:\"-\"')\n}\n\nstruct GoTags {\n        1: required string Foo (go.tag = 'json:\"-\" foo:\"bar\"')\n        2: optional string Bar (go.tag = 'bar:\"foo\"')\n        3: required string FooBar (go.tag = 'json:\"foobar,option1,option2\" bar:\"foo,option1\" foo:\"foobar\"')\n        4: required string FooBarWithSpace (go.tag = 'json:\"foobarWithSpace\" foo:\"foo bar foobar barfoo\"')\n        5: optional string FooBarWithOmitEmpty (go.tag = 'json:\"foobarWithOmitEmpty,omitempty\"')\n        6: required string FooBarWithRequired (go.tag = 'json:\"foobarWithRequired,required\"')\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Default values\n\nstruct DefaultsStruct {\n    1: required i32 requiredPrimitive = 100\n    2: optional i32 optionalPrimitive = 200\n\n    3: required enums.EnumDefault requiredEnum = enums.EnumDefault.Bar\n    4: optional enums.EnumDefault optionalEnum = 2\n\n    5: required list<string> requiredList = [\"hello\", \"world\"]\n    6: optional list<double> optionalList = [1, 2.0, 3]\n\n    7: required Frame requiredStruct = {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    8: optional Edge optionalStruct = {\n        \"startPoint\": {\"x\": 1, \"y\": 2},\n        \"endPoint\":   {\"x\": 3, \"y\": 4},\n    }\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Opt-out of Zap\n\nstruct ZapOptOutStruct {\n    1: required string name\n    2: required string optout (go.nolog)\n}\n\nstruct ZapRedactStruct {\n    1: required string name\n    2: required string password (go.redact)\n    3: optional binary token (go.redact)\n    4: optional list<string> secrets (go.redact)\n}\n\nstruct ShallowCopyStruct {\n    1: required binary deep\n    2: required binary shallow (go.shallowcopy)\n    3: optional list<Point> points (go.shallowcopy)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Field jabels\n\nstruct StructLabels {\n    // reserved keyword as label\n    1: optional bool isRequired (go.label = \"required\")\n\n    // go.tag's JSON tag takes precedence over go.label\n    2: optional string foo (go.label = \"bar\", go.tag = 'json:\"not_bar\"')\n\n    // Empty label\n    3: optional string qux (go.label = \"\")\n\n    // All-caps label\n    4: optional string quux (go.label = \"QUUX\")\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// JSON names\n\nstruct JSONNames {\n    // json.name overrides the Thrift name\n    1: required string userName (json.name = \"user_name\")\n\n    // json.name takes precedence over go.label\n    2: optional i64 userID (go.label = \"id\", json.name = \"user_id\")\n\n    // json.name takes precedence over go.tag's JSON tag name but retains\n    // its options\n    3: optional string nickname (go.tag = 'json:\"nick,omitempty\"', json.name = \"nick_name\")\n\n    4: required i64 createdAt\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Validation\n\ntypedef string Slug\n\nstruct ValidatedAddress {\n    1: required string street (validate.minLen = \"1\")\n    2: optional i32 unit (validate.min = \"1\", validate.max = \"9999\")\n}\n\nstruct ValidatedStruct {\n    1: required string name (validate.minLen = \"1\", validate.maxLen = \"8\")\n    2: optional i16 age (validate.min = \"0\", validate.max = \"150\")\n    3: optional double score (validate.min = \"0.5\")\n    4: optional string email (validate.pattern = \"^[^@]+@[^@]+$\")\n    5: optional Slug slug (validate.pattern = \"^[a-z-]+$\")\n    6: optional list<string> tags (validate.maxLen = \"2\")\n    7: required ValidatedAddress address\n    8: optional ValidatedAddress previousAddress\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Unsigned integers\n\nstruct UnsignedStruct {\n    1: required i8 tiny\n    2: required i16 small (go.unsigned = \"true\")\n    3: required i32 medium (go.unsigned = \"true\")\n    4: optional i64 large (go.unsigned = \"true\")\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
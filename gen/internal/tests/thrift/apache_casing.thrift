@@ -0,0 +1,27 @@
+typedef string user_id
+
+enum http_status {
+    OK = 200
+    NOT_FOUND = 404
+    SERVER_ERROR = 500 (go.name = "InternalError")
+}
+
+struct web_request {
+    1: required user_id user_id
+    2: optional string request_url
+    3: optional http_status status
+    4: optional i32 MAX_retries
+    5: optional string json_body (go.name = "Body")
+}
+
+exception request_error {
+    1: optional string message
+}
+
+const i32 MAX_RETRIES = 3
+
+const web_request default_request = {"user_id": "anonymous", "status": http_status.OK}
+
+service web_service {
+    web_request get_request(1: user_id user_id) throws (1: request_error error)
+}
@@ -0,0 +1,25 @@
+typedef binary (go.type = "string") Text
+
+struct Blob {
+    1: required binary (go.type = "string") name
+    2: optional binary (go.type = "string") label
+    3: optional Text summary
+    4: optional binary (go.type = "reader") contents
+    5: optional binary raw
+}
+
+struct Bundle {
+    1: required list<binary (go.type = "string")> names
+    2: optional set<Text> tags
+    3: optional map<binary (go.type = "string"), binary> attachments
+}
+
+union Payload {
+    1: binary (go.type = "string") text
+    2: binary (go.type = "reader") stream
+}
+
+service BlobStore {
+    binary (go.type = "reader") download(1: required binary (go.type = "string") name)
+    void upload(1: required Blob blob)
+}
@@ -0,0 +1,54 @@
+enum Currency {
+    USD,
+    EUR,
+}
+
+typedef string OrderID
+
+struct Money {
+    1: required i64 amount
+    2: optional Currency currency = Currency.USD
+}
+
+/** Order has enough fields that a builder is generated for it. */
+struct Order {
+    1: required OrderID id
+    2: required Money total
+    3: required i32 quantity = 1
+    4: optional string note
+    5: optional list<string> tags
+    6: optional map<string, string> metadata
+    7: optional bool gift
+}
+
+struct Address {
+    1: optional string street
+    2: optional string city
+} (go.builder)
+
+struct Sample {
+    1: required string name
+    2: optional i64 timestamp
+    3: optional double value
+} (go.builder, go.presence = "bitmap")
+
+exception OrderFailed {
+    1: required OrderID id
+    2: optional string reason
+    3: optional i32 code
+    4: optional bool retryable
+}
+
+struct Large {
+    1: optional string a
+    2: optional string b
+    3: optional string c
+    4: optional string d
+} (go.builder = "false")
+
+union Payment {
+    1: string card
+    2: string account
+    3: string voucher
+    4: string cash
+}
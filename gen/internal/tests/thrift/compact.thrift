@@ -0,0 +1,65 @@
+include "./enums.thrift"
+
+typedef string UUID
+typedef list<Point> Points
+
+enum Color {
+    RED,
+    GREEN,
+    BLUE
+}
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+/**
+ * Primitives has a field of every primitive type.
+ */
+struct Primitives {
+    1: required bool boolField
+    2: required byte byteField
+    3: required i16 int16Field
+    4: required i32 int32Field
+    5: required i64 int64Field
+    6: required double doubleField
+    7: required string stringField
+    8: required binary binaryField
+    9: optional bool optBoolField
+    10: optional byte optByteField
+    11: optional i16 optInt16Field
+    12: optional i32 optInt32Field
+    13: optional i64 optInt64Field
+    14: optional double optDoubleField
+    15: optional string optStringField
+    16: optional binary optBinaryField
+}
+
+struct Shape {
+    1: required string name
+    2: required Points points
+    3: optional UUID id
+    4: optional Color color = Color.BLUE
+    5: required Color fill
+    6: optional enums.EnumDefault external
+    7: optional map<string, Point> labels
+    8: optional set<i32> tags
+    9: optional Shape parent
+}
+
+union Geometry {
+    1: Point point
+    2: Shape shape
+    3: list<Geometry> collection
+}
+
+exception ShapeError {
+    1: optional string message
+    2: optional Shape shape
+}
+
+service Drawing {
+    Shape draw(1: Geometry geometry) throws (1: ShapeError err)
+    void clear()
+}
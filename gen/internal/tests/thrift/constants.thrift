@@ -91,6 +91,16 @@ const typedefs.UUID uuid = {"high": 1234, "low": 5678}
 /** Timestamp at which time began. */
 const typedefs.Timestamp beginningOfTime = 0
 
+const typedefs.Timestamp endOfFirstDay = other_constants.oneDay // imported constant cast to a typedef
+const i64 startOfDay = other_constants.startOfDay // imported typedef'd constant
+
+struct Window {
+    1: optional typedefs.Timestamp start = other_constants.startOfDay
+    2: optional typedefs.Timestamp finish = other_constants.oneDay
+    3: optional i64 length = other_constants.oneDay
+    4: optional typedefs.UUID id = uuid
+}
+
 /**
  * An example frame group.
  *
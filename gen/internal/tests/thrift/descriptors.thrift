@@ -0,0 +1,39 @@
+enum Status {
+    ACTIVE,
+    DISABLED,
+}
+
+typedef string UserName
+typedef set<UserName> UserNames
+
+struct User {
+    1: required UserName name
+    2: optional Status status
+    3: optional i64 id
+    4: optional list<User> friends
+    5: optional map<string, Attribute> attributes
+    6: optional UserNames aliases
+}
+
+union Attribute {
+    1: bool flag
+    2: byte level
+    3: i16 rank
+    4: i32 count
+    5: double score
+    6: string text
+    7: binary data
+    8: map<i32, list<string>> tags
+}
+
+exception UserNotFound {
+    1: required string message
+}
+
+struct Empty {}
+
+service UserService {
+    User getUser(1: UserName name) throws (1: UserNotFound notFound)
+
+    oneway void ping()
+}
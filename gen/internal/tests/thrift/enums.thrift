@@ -65,3 +65,12 @@ enum EnumWithLabel {
 
 // collision with RecordType_Values() function.
 enum RecordType_Values { FOO, BAR }
+
+/** Statuses of an account. */
+enum AccountStatus {
+    ACTIVE,
+    /** The account may not be used until it is restored. */
+    SUSPENDED (deprecated = "Use DISABLED instead."),
+    CLOSED (deprecated),
+    DISABLED,
+}
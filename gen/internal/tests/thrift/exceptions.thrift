@@ -7,4 +7,13 @@ exception DoesNotExistException {
     /** Key that was missing. */
     1: required string key
     2: optional string Error (go.name="Error2")
-}
+} (error.code = "NOT_FOUND")
+
+/**
+ * Raised when a request failed because of another exception.
+ */
+exception RequestFailedException {
+    1: optional string message
+    2: optional DoesNotExistException doesNotExist
+    3: optional EmptyException empty
+} (error.code = "INTERNAL")
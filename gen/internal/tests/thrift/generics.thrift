@@ -0,0 +1,42 @@
+// Code for this file is generated with --min-go-version=1.18.
+
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+typedef string Name
+typedef list<Name> Names
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+} (go.hashable)
+
+struct Node {
+    1: required string value
+    2: optional list<Node> children
+}
+
+struct Containers {
+    1: optional list<i32> ints
+    2: optional list<binary> blobs
+    3: optional list<list<string>> nested
+    4: optional list<Point> points
+    5: optional Names names
+    6: optional set<string> stringSet
+    7: optional set<Color> colorSet
+    8: optional set<i64> (go.type = "slice") int64SliceSet
+    9: optional set<Point> pointSet
+    10: optional set<binary> blobSet
+    11: optional map<string, i32> counts
+    12: optional map<Color, list<Name>> namesByColor
+    13: optional map<Point, string> labels
+    14: optional map<binary, set<i32>> blobIndex
+    15: optional Node tree
+}
+
+service Registry {
+    list<Point> lookup(1: set<Name> names, 2: map<Name, Point> hints)
+}
@@ -0,0 +1,43 @@
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+typedef string Name
+typedef Point Location
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+} (go.hashable)
+
+struct Label {
+    10: optional i16 priority
+    1: required Name name
+    2: optional i64 weight
+    3: optional double score
+    4: optional binary data
+    5: optional Color color
+    6: optional bool hidden
+    7: optional Point point
+    8: optional Location location
+    9: required Point origin
+} (go.hashable)
+
+struct Counters {
+    1: optional i32 hits
+    2: optional string owner
+    3: optional i8 level
+} (go.hashable, go.presence = "bitmap")
+
+union Shape {
+    1: Point point
+    2: string name
+} (go.hashable)
+
+struct Drawing {
+    1: required set<Point> points
+    2: optional set<Location> locations
+    3: optional set<Shape> shapes
+}
@@ -0,0 +1,43 @@
+enum Role {
+    USER
+    ADMIN
+}
+
+struct User {
+    1: required string id
+    2: optional string name
+    3: optional Role role
+}
+
+exception NotFound {
+    1: optional string id
+}
+
+exception Forbidden {
+    1: optional string reason
+}
+
+service Users {
+    User getUser(1: required string id, 2: optional bool verbose)
+        throws (1: NotFound notFound (http.status = "404"))
+        (http.method = "GET", http.path = "/users/{id}", http.query = "verbose")
+
+    // Matched before getUser because its path is more specific.
+    User getSelf() (http.method = "GET", http.path = "/users/self")
+
+    list<User> listUsers(1: optional Role role, 2: optional list<string> ids, 3: optional i32 limit)
+        (http.method = "GET", http.path = "/users", http.query = "role, ids, limit")
+
+    void putUser(1: required string id, 2: required User user)
+        throws (1: Forbidden forbidden (http.status = "403"), 2: NotFound notFound)
+        (http.method = "PUT", http.path = "/users/{id}", http.body = "user")
+
+    User renameUser(1: required string id, 2: optional string name, 3: optional Role role)
+        (http.method = "PATCH", http.path = "/users/{id}", http.body = "*")
+
+    oneway void touchUser(1: required string id)
+        (http.method = "POST", http.path = "/users/{id}/touch")
+
+    // Not served over HTTP.
+    i32 countUsers()
+}
@@ -0,0 +1,27 @@
+exception NotFound {
+    1: optional string key
+}
+
+exception Unavailable {
+    1: optional string reason
+}
+
+service Health {
+    bool healthy()
+}
+
+service Cache extends Health {
+    binary get(1: required string key)
+        throws (1: NotFound notFound, 2: Unavailable unavailable)
+
+    // Arguments that conflict with names used in the generated code.
+    void put(1: string start, 2: binary impl, 3: optional i64 ctx)
+
+    oneway void evict(1: string key)
+
+    stream<string> keys(1: optional string prefix)
+}
+
+service TieredCache extends Cache {
+    void promote(1: string key) throws (1: NotFound notFound)
+}
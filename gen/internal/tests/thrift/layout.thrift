@@ -0,0 +1,51 @@
+const i32 defaultPageSize = 100
+
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+typedef string Name
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+union Shape {
+    1: Point point
+    2: list<Point> polygon
+}
+
+// Archive and Bundle share helpers for list<binary> and set<Tag>. These are
+// declared in only one of the files of the package.
+struct Tag {
+    1: required string name
+} (go.hashable)
+
+struct Archive {
+    1: optional list<binary> blobs
+    2: optional set<Tag> tags
+}
+
+struct Bundle {
+    1: optional list<binary> blobs
+    2: optional set<Tag> tags
+}
+
+exception ShapeNotFound {
+    1: optional string message
+}
+
+service Canvas {
+    void draw(1: Shape shape)
+
+    Color colorAt(1: Point point) throws (1: ShapeNotFound notFound)
+}
+
+service Registry {
+    list<Name> names(1: i32 limit = defaultPageSize)
+
+    list<binary> fetch(1: list<binary> ids)
+}
@@ -0,0 +1,17 @@
+// Helpers for list<binary> and set<Tag> are shared between the types and
+// services files of the package.
+
+struct Tag {
+    1: required string name
+} (go.hashable)
+
+struct Archive {
+    1: optional list<binary> blobs
+    2: optional set<Tag> tags
+}
+
+service Store {
+    list<binary> fetch(1: list<binary> ids)
+
+    void tag(1: set<Tag> tags)
+}
@@ -0,0 +1,21 @@
+struct Payload {
+    1: required string name
+    2: optional list<string> tags
+    3: optional map<string, i64> counts
+}
+
+typedef Payload PayloadAlias
+
+// Envelope routes payloads without inspecting them.
+struct Envelope {
+    1: required string route
+    2: optional Payload payload (go.lazy = "true")
+    3: optional binary attachment (go.lazy = "true")
+    4: optional PayloadAlias aliased (go.lazy = "true")
+    5: optional Payload eager
+}
+
+exception RoutingError {
+    1: optional string message
+    2: optional Payload payload (go.lazy = "true")
+}
@@ -0,0 +1,26 @@
+typedef list<string> Names
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+}
+
+struct Path {
+    1: optional string name
+    2: optional list<Point> points
+}
+
+const i32 scale = 10
+
+/** Origin of the coordinate system. */
+const Point origin = {"x": 0, "y": 0}
+
+const Point unit = {"x": scale, "y": scale}
+
+const Path diagonal = {"name": "diagonal", "points": [origin, unit]}
+
+const Names defaultNames = ["a", "b"]
+
+const set<i32> primes = [2, 3, 5]
+
+const map<string, Point> corners = {"origin": origin, "unit": unit}
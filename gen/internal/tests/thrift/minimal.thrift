@@ -0,0 +1,43 @@
+// Code for this file is generated as if --minimal was passed: only types and
+// their ToWire and FromWire methods are generated.
+namespace go minimal (go.minimal = "true")
+
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+typedef string Name
+typedef list<Name> Names
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Shape {
+    1: required Name name
+    2: optional Color color
+    3: optional list<Point> points
+    4: optional map<string, i64> tags
+    5: optional set<Color> colors
+}
+
+union Fill {
+    1: Color solid
+    2: list<Color> gradient
+}
+
+exception ShapeNotFound {
+    1: required Name name
+    2: optional string message
+}
+
+const Point Origin = {"x": 0, "y": 0}
+
+service Canvas {
+    Shape getShape(1: Name name) throws (1: ShapeNotFound notFound)
+    void fill(1: Name name, 2: Fill fill)
+    oneway void clear()
+}
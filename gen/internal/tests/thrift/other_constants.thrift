@@ -1,5 +1,10 @@
 include "./structs.thrift"
+include "./typedefs.thrift"
 
 const list<i32> listOfInts = [1, 2, 3]
 
 const structs.Point some_point = {"x": 1, "y": 2.0}
+
+const i64 oneDay = 86400
+
+const typedefs.Timestamp startOfDay = 0
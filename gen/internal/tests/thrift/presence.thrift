@@ -0,0 +1,48 @@
+include "./enums.thrift"
+
+typedef string Host
+
+enum Unit {
+    SECONDS,
+    BYTES
+}
+
+/**
+ * Sample keeps track of its optional fields with a presence bitmap.
+ */
+struct Sample {
+    1: required string metric
+    2: optional i64 timestamp
+    3: optional double value
+    4: optional bool final
+    5: optional byte priority
+    6: optional i16 shard
+    7: optional i32 count (validate.min = "0")
+    8: optional string token (go.redact)
+    9: optional Host host (validate.pattern = "^[a-z.]+$")
+    10: optional Unit unit = Unit.SECONDS
+    11: optional enums.EnumDefault external
+    12: optional i64 id (go.tag = 'json:"id,string"')
+    // Fields which are not stored by value.
+    13: optional binary payload
+    14: optional list<string> tags
+    15: optional Sample parent
+    16: optional i64 size (go.unsigned = "true")
+} (go.presence = "bitmap")
+
+struct Series {
+    1: optional Sample first = {"metric": "mem", "timestamp": 10}
+    2: optional list<Sample> samples
+}
+
+exception SampleError {
+    1: optional i32 code
+    2: optional string message
+} (go.presence = "bitmap")
+
+const Sample DefaultSample = {
+    "metric": "cpu",
+    "count": 3,
+    "unit": Unit.BYTES,
+    "tags": ["a"],
+}
@@ -62,3 +62,6 @@ service ConflictingNames {
 service non_standard_service_name {
     void non_standard_function_name()
 }
+
+/** Names of the services to which requests are routed. */
+const list<Key> ROUTED_SERVICES = [KeyValue, Cache]
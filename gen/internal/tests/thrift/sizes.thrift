@@ -0,0 +1,35 @@
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+typedef string Name
+typedef list<Name> Names
+typedef Shape Outline
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Shape {
+    1: required Name name
+    2: optional Color color
+    3: optional list<Point> points
+    4: optional map<string, i64> tags
+    5: optional set<i32> layers
+    6: optional binary data
+    7: optional bool visible
+    8: optional byte depth
+    9: optional i16 order
+}
+
+union Fill {
+    1: Color solid
+    2: list<Color> gradient
+}
+
+exception ShapeNotFound {
+    1: required string message
+}
@@ -0,0 +1,28 @@
+// Code for this file is generated with --set-type=slice.
+
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+typedef set<string> StringSet
+typedef set<string> (go.type = "map") StringMapSet
+
+struct Foo {
+    1: required string stringField
+}
+
+struct Sets {
+    1: required set<i32> int32Set
+    2: optional set<string> stringSet
+    3: optional StringSet typedefStringSet
+    4: optional set<Color> colorSet
+    5: optional set<string> (go.type = "map") stringMapSet
+    6: optional StringMapSet typedefStringMapSet
+    7: optional set<Foo> fooSet
+    8: optional set<set<i64>> int64SetSet
+}
+
+const set<string> ConstStringSet = ["hello", "world"]
+const StringMapSet ConstStringMapSet = ["hello", "world"]
@@ -0,0 +1,31 @@
+enum Color {
+    RED,
+    GREEN,
+    BLUE,
+}
+
+typedef string Name
+typedef map<Name, i64> (go.sortedMap) Scores
+
+struct Point {
+    1: required i32 x
+    2: required i32 y
+} (go.hashable)
+
+struct Tile {
+    1: required string kind
+}
+
+struct Inventory {
+    1: required map<string, i64> counts
+    2: optional map<Color, string> colors
+    3: optional map<Name, map<i32, double>> nested
+    4: optional map<binary, string> blobs
+    5: optional map<Point, string> labels
+    6: optional Scores scores
+    // Keys of these maps cannot be ordered, so they are sent in the order
+    // of their items.
+    7: optional map<Tile, i32> tiles
+    8: optional map<list<string>, i32> paths
+    9: optional map<string, bool> (go.sortedMap = "false") flags
+}
@@ -0,0 +1,5 @@
+enum Status {
+    Pending,
+    Active = 5,
+    Inactive
+}
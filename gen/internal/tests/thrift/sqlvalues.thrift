@@ -0,0 +1,27 @@
+enum Status {
+    Pending,
+    Active = 5,
+    Inactive
+}
+
+typedef bool Flag
+typedef byte Tiny
+typedef i16 Small
+typedef i32 Count
+typedef i64 Timestamp
+typedef double Ratio
+typedef string Name
+typedef binary Blob
+
+// Typedefs of typedefs of base types are stored like the base type.
+typedef Count Total
+
+// Values of the following typedefs can't be stored directly.
+typedef list<string> Names
+typedef Status State
+
+struct Record {
+    1: required Status status
+    2: optional Timestamp createdAt
+    3: optional Blob data
+}
@@ -0,0 +1,56 @@
+enum Status {
+    ACTIVE,
+    INACTIVE = 3,
+}
+
+typedef string Label
+typedef i64 Timestamp
+typedef list<Label> Labels
+typedef Coordinate Position
+typedef binary (go.type = "string") Text
+
+struct Coordinate {
+    1: required double lat
+    2: required double lng
+}
+
+struct Record {
+    1: required string id
+    2: required Coordinate location
+    3: optional Status status = Status.ACTIVE
+    4: optional Timestamp createdAt
+    5: optional Labels labels
+    6: optional list<Coordinate> path
+    7: optional set<string> tags
+    8: optional set<Coordinate> waypoints
+    9: optional map<string, i32> counts
+    10: optional map<Coordinate, string> names
+    11: optional binary payload
+    12: optional binary (go.type = "string") note
+    13: optional binary (go.type = "reader") attachment
+    14: optional Position home
+    15: optional list<map<Label, list<i16>>> nested
+    16: optional bool enabled = true
+    17: optional byte flags
+    18: optional Text summary
+}
+
+union Target {
+    1: Coordinate point
+    2: string name
+    3: Status status
+}
+
+union Selection {
+    1: list<Target> targets
+}
+
+exception RecordNotFound {
+    1: required string id
+    2: optional string message
+}
+
+service RecordStore {
+    Record get(1: required string id) throws (1: RecordNotFound notFound)
+    void put(1: required Record record)
+}
@@ -115,6 +115,28 @@ struct Node {
     2: optional List tail
 }
 
+//////////////////////////////////////////////////////////////////////////////
+// mutually recursive structs
+
+typedef list<Tree> Forest
+typedef Forest Trees
+
+/**
+ * Tree refers back to itself through a chain of typedefs and through
+ * Branch.
+ */
+struct Tree {
+    1: required string name
+    2: optional Trees children
+    3: optional map<string, Tree> byName
+    4: optional Branch branch
+}
+
+struct Branch {
+    1: required Tree tree
+    2: optional Branch sibling
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // JSON tagged structs
 
@@ -168,6 +190,19 @@ struct ZapOptOutStruct {
     2: required string optout (go.nolog)
 }
 
+struct ZapRedactStruct {
+    1: required string name
+    2: required string password (go.redact)
+    3: optional binary token (go.redact)
+    4: optional list<string> secrets (go.redact)
+}
+
+struct ShallowCopyStruct {
+    1: required binary deep
+    2: required binary shallow (go.shallowcopy)
+    3: optional list<Point> points (go.shallowcopy)
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // Field jabels
 
@@ -184,3 +219,51 @@ struct StructLabels {
     // All-caps label
     4: optional string quux (go.label = "QUUX")
 }
+
+//////////////////////////////////////////////////////////////////////////////
+// JSON names
+
+struct JSONNames {
+    // json.name overrides the Thrift name
+    1: required string userName (json.name = "user_name")
+
+    // json.name takes precedence over go.label
+    2: optional i64 userID (go.label = "id", json.name = "user_id")
+
+    // json.name takes precedence over go.tag's JSON tag name but retains
+    // its options
+    3: optional string nickname (go.tag = 'json:"nick,omitempty"', json.name = "nick_name")
+
+    4: required i64 createdAt
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Validation
+
+typedef string Slug
+
+struct ValidatedAddress {
+    1: required string street (validate.minLen = "1")
+    2: optional i32 unit (validate.min = "1", validate.max = "9999")
+}
+
+struct ValidatedStruct {
+    1: required string name (validate.minLen = "1", validate.maxLen = "8")
+    2: optional i16 age (validate.min = "0", validate.max = "150")
+    3: optional double score (validate.min = "0.5")
+    4: optional string email (validate.pattern = "^[^@]+@[^@]+$")
+    5: optional Slug slug (validate.pattern = "^[a-z-]+$")
+    6: optional list<string> tags (validate.maxLen = "2")
+    7: required ValidatedAddress address
+    8: optional ValidatedAddress previousAddress
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Unsigned integers
+
+struct UnsignedStruct {
+    1: required i8 tiny
+    2: required i16 small (go.unsigned = "true")
+    3: required i32 medium (go.unsigned = "true")
+    4: optional i64 large (go.unsigned = "true")
+}
@@ -0,0 +1,57 @@
+include "./exceptions.thrift"
+include "./stubs_health.thrift"
+
+typedef string Key
+
+struct Item {
+    1: required Key key
+    2: optional binary value
+    3: optional i64 expiresAt (deprecated = "Items no longer expire.")
+}
+
+/** Metadata about an item. */
+struct ItemInfo {
+    1: optional i64 size
+} (deprecated = "Use Item instead.")
+
+typedef Key LegacyKey (deprecated)
+
+exception StoreError {
+    1: optional string message
+}
+
+service ReadOnlyStore {
+    bool healthy() (deprecated = "Use Admin instead.")
+
+    Item get(1: required Key key)
+        throws (1: exceptions.DoesNotExistException doesNotExist)
+
+    stream<Item> scan(1: optional Key prefix)
+}
+
+/**
+ * Store is a key-value store.
+ *
+ * Items are identified by their keys.
+ */
+service Store extends ReadOnlyStore {
+    // Arguments that conflict with names used in the generated code.
+    void put(1: Key ctx, 2: Item result, 3: optional i64 body)
+        throws (1: StoreError storeError)
+
+    list<Item> getMany(1: list<Key> range)
+
+    // Arguments named after Go keywords, predeclared identifiers, and the
+    // names those are renamed to.
+    void tag(1: Key type, 2: i64 len, 3: optional string type2)
+
+    /** Removes the item with the given key, if any. */
+    oneway void forget(1: Key key) (deprecated)
+
+    stream<i64> watch(1: Key key) throws (1: StoreError storeError)
+}
+
+/** Admin administers a store. Its health checks are inherited. */
+service Admin extends stubs_health.Health {
+    void drain(1: optional bool force)
+}
@@ -0,0 +1,10 @@
+// Code for this file is generated with --service-tests. Services in
+// stubs.thrift inherit from the services defined here.
+
+/** Health reports whether a server is able to handle requests. */
+service Health {
+    bool healthy()
+
+    /** Returns the names of the checks which failed, if any. */
+    list<string> failedChecks(1: optional i32 limit)
+}
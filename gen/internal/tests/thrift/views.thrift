@@ -0,0 +1,35 @@
+enum Format {
+    TEXT,
+    HTML,
+}
+
+typedef binary Blob
+typedef Author Writer
+
+struct Author {
+    1: required string name
+    2: optional string email
+} (go.view)
+
+struct Attachment {
+    1: required string filename
+    2: optional Blob data
+}
+
+struct Document {
+    1: required string title
+    2: optional binary body
+    3: optional Writer author
+    4: optional Format format = Format.TEXT
+    5: optional list<string> tags
+    6: optional map<string, i64> counters
+    7: optional Attachment attachment
+    8: required Author reviewer
+    9: optional i32 version
+    10: optional Blob signature
+} (go.view)
+
+union Content {
+    1: string text
+    2: Document document
+} (go.view)
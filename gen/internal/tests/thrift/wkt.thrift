@@ -0,0 +1,17 @@
+struct Timestamp {
+    1: required i64 seconds
+    2: required i32 nanos
+} (go.wkt = "timestamp")
+
+struct Duration {
+    1: optional i64 seconds
+    2: optional i32 nanos
+} (go.wkt = "duration")
+
+struct Event {
+    1: required string name
+    2: required Timestamp createdAt
+    3: optional Timestamp updatedAt
+    4: optional Duration ttl
+    5: optional list<Timestamp> history
+}
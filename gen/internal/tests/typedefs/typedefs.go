@@ -6,11 +6,14 @@ package typedefs
 import (
 	bytes "bytes"
 	base64 "encoding/base64"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
 	structs "go.uber.org/thriftrw/gen/internal/tests/structs"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -52,6 +55,7 @@ func _Set_Binary_sliceType_Read(s wire.ValueList) ([][]byte, error) {
 	}
 
 	o := make([][]byte, 0, s.Size())
+
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := x.GetBinary(), error(nil)
 		if err != nil {
@@ -65,6 +69,43 @@ func _Set_Binary_sliceType_Read(s wire.ValueList) ([][]byte, error) {
 	return o, err
 }
 
+func _Set_Binary_sliceType_Decode(sr stream.Reader) ([][]byte, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TBinary {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([][]byte, 0, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 func _Set_Binary_sliceType_Equals(lhs, rhs [][]byte) bool {
 	if len(lhs) != len(rhs) {
 		return false
@@ -86,6 +127,26 @@ func _Set_Binary_sliceType_Equals(lhs, rhs [][]byte) bool {
 	return true
 }
 
+func _Binary_Clone(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(v)), v...)
+}
+
+func _Set_Binary_sliceType_Clone(v [][]byte) [][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make([][]byte, len(v))
+	for i, x := range v {
+		o[i] = _Binary_Clone(x)
+	}
+
+	return o
+}
+
 type _Set_Binary_sliceType_Zapper [][]byte
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -122,12 +183,25 @@ func (v *BinarySet) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes BinarySet directly off the wire.
+func (v *BinarySet) Decode(sr stream.Reader) error {
+	x, err := _Set_Binary_sliceType_Decode(sr)
+	*v = (BinarySet)(x)
+	return err
+}
+
 // Equals returns true if this BinarySet is equal to the provided
 // BinarySet.
 func (lhs BinarySet) Equals(rhs BinarySet) bool {
 	return _Set_Binary_sliceType_Equals(([][]byte)(lhs), ([][]byte)(rhs))
 }
 
+// Clone returns a deep copy of this BinarySet.
+func (v BinarySet) Clone() BinarySet {
+	x := ([][]byte)(v)
+	return (BinarySet)(_Set_Binary_sliceType_Clone(x))
+}
+
 func (v BinarySet) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Binary_sliceType_Zapper)(([][]byte)(v))).MarshalLogArray(enc)
 }
@@ -226,6 +300,109 @@ func (v *DefaultPrimitiveTypedef) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _State_Decode(sr stream.Reader) (State, error) {
+	var x State
+	err := x.Decode(sr)
+	return x, err
+}
+
+func (v *DefaultPrimitiveTypedef) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x State
+			x, err = _State_Decode(sr)
+			v.State = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.State == nil {
+		v.State = _State_ptr("hello")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a DefaultPrimitiveTypedef struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *DefaultPrimitiveTypedef) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.State == nil) {
+		b, err := json.Marshal(v.State)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"state":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a DefaultPrimitiveTypedef struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *DefaultPrimitiveTypedef) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["state"]; ok {
+		if err := json.Unmarshal(r, &v.State); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a DefaultPrimitiveTypedef
 // struct.
 func (v *DefaultPrimitiveTypedef) String() string {
@@ -270,6 +447,29 @@ func (v *DefaultPrimitiveTypedef) Equals(rhs *DefaultPrimitiveTypedef) bool {
 	return true
 }
 
+func _State_ClonePtr(v *State) *State {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this DefaultPrimitiveTypedef. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *DefaultPrimitiveTypedef) Clone() *DefaultPrimitiveTypedef {
+	if v == nil {
+		return nil
+	}
+
+	var c DefaultPrimitiveTypedef
+	c.State = _State_ClonePtr(v.State)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DefaultPrimitiveTypedef.
 func (v *DefaultPrimitiveTypedef) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -365,14 +565,15 @@ func _Map_Edge_Edge_Read(m wire.MapItemList) ([]struct {
 		Key   *structs.Edge
 		Value *structs.Edge
 	}, 0, m.Size())
+	var missing required.Errors
 	err := m.ForEach(func(x wire.MapItem) error {
 		k, err := _Edge_Read(x.Key)
-		if err != nil {
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 
 		v, err := _Edge_Read(x.Value)
-		if err != nil {
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 
@@ -383,6 +584,70 @@ func _Map_Edge_Edge_Read(m wire.MapItemList) ([]struct {
 		return nil
 	})
 	m.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+func _Edge_Decode(sr stream.Reader) (*structs.Edge, error) {
+	var v structs.Edge
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_Edge_Edge_Decode(sr stream.Reader) ([]struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	}, 0, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Edge_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Edge_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, struct {
+			Key   *structs.Edge
+			Value *structs.Edge
+		}{k, v})
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
 	return o, err
 }
 
@@ -419,6 +684,28 @@ func _Map_Edge_Edge_Equals(lhs, rhs []struct {
 	return true
 }
 
+func _Map_Edge_Edge_Clone(v []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}) []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Clone()
+		o[i].Value = x.Value.Clone()
+	}
+	return o
+}
+
 type _Map_Edge_Edge_Item_Zapper struct {
 	Key   *structs.Edge
 	Value *structs.Edge
@@ -482,6 +769,13 @@ func (v *EdgeMap) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes EdgeMap directly off the wire.
+func (v *EdgeMap) Decode(sr stream.Reader) error {
+	x, err := _Map_Edge_Edge_Decode(sr)
+	*v = (EdgeMap)(x)
+	return err
+}
+
 // Equals returns true if this EdgeMap is equal to the provided
 // EdgeMap.
 func (lhs EdgeMap) Equals(rhs EdgeMap) bool {
@@ -494,6 +788,15 @@ func (lhs EdgeMap) Equals(rhs EdgeMap) bool {
 	})(rhs))
 }
 
+// Clone returns a deep copy of this EdgeMap.
+func (v EdgeMap) Clone() EdgeMap {
+	x := ([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	})(v)
+	return (EdgeMap)(_Map_Edge_Edge_Clone(x))
+}
+
 func (v EdgeMap) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Map_Edge_Edge_Zapper)(([]struct {
 		Key   *structs.Edge
@@ -584,12 +887,14 @@ func (v *Event) FromWire(w wire.Value) error {
 
 	uuidIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.UUID, err = _UUID_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				uuidIsSet = true
@@ -607,6 +912,72 @@ func (v *Event) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !uuidIsSet {
+		missing.Add("Event", "UUID")
+	}
+
+	return missing.Err()
+}
+
+func _UUID_Decode(sr stream.Reader) (*UUID, error) {
+	var x UUID
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func _Timestamp_Decode(sr stream.Reader) (Timestamp, error) {
+	var x Timestamp
+	err := x.Decode(sr)
+	return x, err
+}
+
+func (v *Event) Decode(sr stream.Reader) error {
+	uuidIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.UUID, err = _UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			uuidIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x Timestamp
+			x, err = _Timestamp_Decode(sr)
+			v.Time = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !uuidIsSet {
 		return errors.New("field UUID of Event is required")
 	}
@@ -614,6 +985,92 @@ func (v *Event) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _I64_UnmarshalJSON(text []byte) (*int64, error) {
+	// json.Number accepts both JSON numbers and JSON strings holding
+	// numbers, and retains all digits of the value.
+	var n json.Number
+	if err := json.Unmarshal(text, &n); err != nil {
+		return nil, err
+	}
+	if n == "" {
+		return nil, nil
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// MarshalJSON serializes a Event struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Event) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"uuid":`)
+		buff.Write(b)
+	}
+	if !(v.Time == nil) {
+		b, err := json.Marshal(v.Time)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"time":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Event struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Event) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["uuid"]; ok {
+		if err := json.Unmarshal(r, &v.UUID); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["time"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.Time = (*Timestamp)(x)
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Event
 // struct.
 func (v *Event) String() string {
@@ -663,6 +1120,30 @@ func (v *Event) Equals(rhs *Event) bool {
 	return true
 }
 
+func _Timestamp_ClonePtr(v *Timestamp) *Timestamp {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Event. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Event) Clone() *Event {
+	if v == nil {
+		return nil
+	}
+
+	var c Event
+	c.UUID = v.UUID.Clone()
+	c.Time = _Timestamp_ClonePtr(v.Time)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Event.
 func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -746,15 +1227,61 @@ func _List_Event_Read(l wire.ValueList) ([]*Event, error) {
 	}
 
 	o := make([]*Event, 0, l.Size())
+	var missing required.Errors
 	err := l.ForEach(func(x wire.Value) error {
 		i, err := _Event_Read(x)
-		if err != nil {
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 		o = append(o, i)
 		return nil
 	})
 	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+func _Event_Decode(sr stream.Reader) (*Event, error) {
+	var v Event
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Event_Decode(sr stream.Reader) ([]*Event, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Event, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Event_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
 	return o, err
 }
 
@@ -773,6 +1300,18 @@ func _List_Event_Equals(lhs, rhs []*Event) bool {
 	return true
 }
 
+func _List_Event_Clone(v []*Event) []*Event {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Event, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
 type _List_Event_Zapper []*Event
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -809,12 +1348,25 @@ func (v *EventGroup) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes EventGroup directly off the wire.
+func (v *EventGroup) Decode(sr stream.Reader) error {
+	x, err := _List_Event_Decode(sr)
+	*v = (EventGroup)(x)
+	return err
+}
+
 // Equals returns true if this EventGroup is equal to the provided
 // EventGroup.
 func (lhs EventGroup) Equals(rhs EventGroup) bool {
 	return _List_Event_Equals(([]*Event)(lhs), ([]*Event)(rhs))
 }
 
+// Clone returns a deep copy of this EventGroup.
+func (v EventGroup) Clone() EventGroup {
+	x := ([]*Event)(v)
+	return (EventGroup)(_List_Event_Clone(x))
+}
+
 func (v EventGroup) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_List_Event_Zapper)(([]*Event)(v))).MarshalLogArray(enc)
 }
@@ -860,9 +1412,11 @@ func _Set_Frame_sliceType_Read(s wire.ValueList) ([]*structs.Frame, error) {
 	}
 
 	o := make([]*structs.Frame, 0, s.Size())
+
+	var missing required.Errors
 	err := s.ForEach(func(x wire.Value) error {
 		i, err := _Frame_Read(x)
-		if err != nil {
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 
@@ -870,6 +1424,52 @@ func _Set_Frame_sliceType_Read(s wire.ValueList) ([]*structs.Frame, error) {
 		return nil
 	})
 	s.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+func _Frame_Decode(sr stream.Reader) (*structs.Frame, error) {
+	var v structs.Frame
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Set_Frame_sliceType_Decode(sr stream.Reader) ([]*structs.Frame, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != wire.TStruct {
+		for i := 0; i < sh.Length; i++ {
+			if err := sr.Skip(sh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	n := sh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*structs.Frame, 0, n)
+	for i := 0; i < sh.Length; i++ {
+		v, err := _Frame_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
 	return o, err
 }
 
@@ -894,6 +1494,19 @@ func _Set_Frame_sliceType_Equals(lhs, rhs []*structs.Frame) bool {
 	return true
 }
 
+func _Set_Frame_sliceType_Clone(v []*structs.Frame) []*structs.Frame {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*structs.Frame, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+
+	return o
+}
+
 type _Set_Frame_sliceType_Zapper []*structs.Frame
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -930,12 +1543,25 @@ func (v *FrameGroup) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes FrameGroup directly off the wire.
+func (v *FrameGroup) Decode(sr stream.Reader) error {
+	x, err := _Set_Frame_sliceType_Decode(sr)
+	*v = (FrameGroup)(x)
+	return err
+}
+
 // Equals returns true if this FrameGroup is equal to the provided
 // FrameGroup.
 func (lhs FrameGroup) Equals(rhs FrameGroup) bool {
 	return _Set_Frame_sliceType_Equals(([]*structs.Frame)(lhs), ([]*structs.Frame)(rhs))
 }
 
+// Clone returns a deep copy of this FrameGroup.
+func (v FrameGroup) Clone() FrameGroup {
+	x := ([]*structs.Frame)(v)
+	return (FrameGroup)(_Set_Frame_sliceType_Clone(x))
+}
+
 func (v FrameGroup) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Set_Frame_sliceType_Zapper)(([]*structs.Frame)(v))).MarshalLogArray(enc)
 }
@@ -946,6 +1572,12 @@ func _EnumWithValues_Read(w wire.Value) (enums.EnumWithValues, error) {
 	return v, err
 }
 
+func _EnumWithValues_Decode(sr stream.Reader) (enums.EnumWithValues, error) {
+	var v enums.EnumWithValues
+	err := v.Decode(sr)
+	return v, err
+}
+
 type MyEnum enums.EnumWithValues
 
 // MyEnumPtr returns a pointer to a MyEnum
@@ -976,6 +1608,13 @@ func (v *MyEnum) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes MyEnum directly off the wire.
+func (v *MyEnum) Decode(sr stream.Reader) error {
+	x, err := _EnumWithValues_Decode(sr)
+	*v = (MyEnum)(x)
+	return err
+}
+
 // Equals returns true if this MyEnum is equal to the provided
 // MyEnum.
 func (lhs MyEnum) Equals(rhs MyEnum) bool {
@@ -1011,12 +1650,25 @@ func (v *PDF) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes PDF directly off the wire.
+func (v *PDF) Decode(sr stream.Reader) error {
+	x, err := sr.ReadBinary()
+	*v = (PDF)(x)
+	return err
+}
+
 // Equals returns true if this PDF is equal to the provided
 // PDF.
 func (lhs PDF) Equals(rhs PDF) bool {
 	return bytes.Equal(([]byte)(lhs), ([]byte)(rhs))
 }
 
+// Clone returns a deep copy of this PDF.
+func (v PDF) Clone() PDF {
+	x := ([]byte)(v)
+	return (PDF)(_Binary_Clone(x))
+}
+
 type _Map_Point_Point_MapItemList []struct {
 	Key   *structs.Point
 	Value *structs.Point
@@ -1077,32 +1729,97 @@ func _Map_Point_Point_Read(m wire.MapItemList) ([]struct {
 		return nil, nil
 	}
 
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	}, 0, m.Size())
+	var missing required.Errors
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Point_Read(x.Key)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		v, err := _Point_Read(x.Value)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *structs.Point
+			Value *structs.Point
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+func _Point_Decode(sr stream.Reader) (*structs.Point, error) {
+	var v structs.Point
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_Point_Point_Decode(sr stream.Reader) ([]struct {
+	Key   *structs.Point
+	Value *structs.Point
+}, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TStruct || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
 	}
 
 	o := make([]struct {
 		Key   *structs.Point
 		Value *structs.Point
-	}, 0, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := _Point_Read(x.Key)
+	}, 0, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _Point_Decode(sr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		v, err := _Point_Read(x.Value)
+		v, err := _Point_Decode(sr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		o = append(o, struct {
 			Key   *structs.Point
 			Value *structs.Point
 		}{k, v})
-		return nil
-	})
-	m.Close()
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
 	return o, err
 }
 
@@ -1139,6 +1856,28 @@ func _Map_Point_Point_Equals(lhs, rhs []struct {
 	return true
 }
 
+func _Map_Point_Point_Clone(v []struct {
+	Key   *structs.Point
+	Value *structs.Point
+}) []struct {
+	Key   *structs.Point
+	Value *structs.Point
+} {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	}, len(v))
+	for i, x := range v {
+		o[i].Key = x.Key.Clone()
+		o[i].Value = x.Value.Clone()
+	}
+	return o
+}
+
 type _Map_Point_Point_Item_Zapper struct {
 	Key   *structs.Point
 	Value *structs.Point
@@ -1202,6 +1941,13 @@ func (v *PointMap) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes PointMap directly off the wire.
+func (v *PointMap) Decode(sr stream.Reader) error {
+	x, err := _Map_Point_Point_Decode(sr)
+	*v = (PointMap)(x)
+	return err
+}
+
 // Equals returns true if this PointMap is equal to the provided
 // PointMap.
 func (lhs PointMap) Equals(rhs PointMap) bool {
@@ -1214,6 +1960,15 @@ func (lhs PointMap) Equals(rhs PointMap) bool {
 	})(rhs))
 }
 
+// Clone returns a deep copy of this PointMap.
+func (v PointMap) Clone() PointMap {
+	x := ([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	})(v)
+	return (PointMap)(_Map_Point_Point_Clone(x))
+}
+
 func (v PointMap) MarshalLogArray(enc zapcore.ArrayEncoder) error {
 	return ((_Map_Point_Point_Zapper)(([]struct {
 		Key   *structs.Point
@@ -1251,6 +2006,13 @@ func (v *State) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes State directly off the wire.
+func (v *State) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (State)(x)
+	return err
+}
+
 // Equals returns true if this State is equal to the provided
 // State.
 func (lhs State) Equals(rhs State) bool {
@@ -1320,6 +2082,52 @@ func _Map_State_I64_Read(m wire.MapItemList) (map[State]int64, error) {
 	return o, err
 }
 
+func _Map_State_I64_Decode(sr stream.Reader) (map[State]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[State]int64, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _State_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 func _Map_State_I64_Equals(lhs, rhs map[State]int64) bool {
 	if len(lhs) != len(rhs) {
 		return false
@@ -1337,6 +2145,19 @@ func _Map_State_I64_Equals(lhs, rhs map[State]int64) bool {
 	return true
 }
 
+func _Map_State_I64_Clone(v map[State]int64) map[State]int64 {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[State]int64, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
 type _Map_State_I64_Zapper map[State]int64
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
@@ -1373,12 +2194,25 @@ func (v *StateMap) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes StateMap directly off the wire.
+func (v *StateMap) Decode(sr stream.Reader) error {
+	x, err := _Map_State_I64_Decode(sr)
+	*v = (StateMap)(x)
+	return err
+}
+
 // Equals returns true if this StateMap is equal to the provided
 // StateMap.
 func (lhs StateMap) Equals(rhs StateMap) bool {
 	return _Map_State_I64_Equals((map[State]int64)(lhs), (map[State]int64)(rhs))
 }
 
+// Clone returns a deep copy of this StateMap.
+func (v StateMap) Clone() StateMap {
+	x := (map[State]int64)(v)
+	return (StateMap)(_Map_State_I64_Clone(x))
+}
+
 func (v StateMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((_Map_State_I64_Zapper)((map[State]int64)(v))).MarshalLogObject(enc)
 }
@@ -1416,6 +2250,13 @@ func (v *Timestamp) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes Timestamp directly off the wire.
+func (v *Timestamp) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt64()
+	*v = (Timestamp)(x)
+	return err
+}
+
 // Equals returns true if this Timestamp is equal to the provided
 // Timestamp.
 func (lhs Timestamp) Equals(rhs Timestamp) bool {
@@ -1505,6 +2346,8 @@ func (v *Transition) FromWire(w wire.Value) error {
 	fromStateIsSet := false
 	toStateIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -1526,7 +2369,7 @@ func (v *Transition) FromWire(w wire.Value) error {
 		case 3:
 			if field.Value.Type() == wire.TList {
 				v.Events, err = _EventGroup_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -1534,6 +2377,75 @@ func (v *Transition) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !fromStateIsSet {
+		missing.Add("Transition", "FromState")
+	}
+
+	if !toStateIsSet {
+		missing.Add("Transition", "ToState")
+	}
+
+	return missing.Err()
+}
+
+func _EventGroup_Decode(sr stream.Reader) (EventGroup, error) {
+	var x EventGroup
+	err := x.Decode(sr)
+	return x, err
+}
+
+func (v *Transition) Decode(sr stream.Reader) error {
+	fromStateIsSet := false
+	toStateIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.FromState, err = _State_Decode(sr)
+			if err != nil {
+				return err
+			}
+			fromStateIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ToState, err = _State_Decode(sr)
+			if err != nil {
+				return err
+			}
+			toStateIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Events, err = _EventGroup_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !fromStateIsSet {
 		return errors.New("field FromState of Transition is required")
 	}
@@ -1545,6 +2457,88 @@ func (v *Transition) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a Transition struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Transition) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.FromState)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"fromState":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ToState)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"toState":`)
+		buff.Write(b)
+	}
+	if !(len(v.Events) == 0) {
+		b, err := json.Marshal(v.Events)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"events":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Transition struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Transition) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["fromState"]; ok {
+		if err := json.Unmarshal(r, &v.FromState); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["toState"]; ok {
+		if err := json.Unmarshal(r, &v.ToState); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["events"]; ok {
+		if err := json.Unmarshal(r, &v.Events); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Transition
 // struct.
 func (v *Transition) String() string {
@@ -1589,6 +2583,22 @@ func (v *Transition) Equals(rhs *Transition) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Transition. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Transition) Clone() *Transition {
+	if v == nil {
+		return nil
+	}
+
+	var c Transition
+	c.FromState = v.FromState
+	c.ToState = v.ToState
+	c.Events = v.Events.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Transition.
 func (v *Transition) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1659,12 +2669,33 @@ func (v *UUID) FromWire(w wire.Value) error {
 	return (*I128)(v).FromWire(w)
 }
 
+// Decode deserializes UUID directly off the wire.
+func (v *UUID) Decode(sr stream.Reader) error {
+	return (*I128)(v).Decode(sr)
+}
+
+// MarshalJSON serializes UUID into JSON.
+func (v *UUID) MarshalJSON() ([]byte, error) {
+	return (*I128)(v).MarshalJSON()
+}
+
+// UnmarshalJSON deserializes UUID from JSON.
+func (v *UUID) UnmarshalJSON(text []byte) error {
+	return (*I128)(v).UnmarshalJSON(text)
+}
+
 // Equals returns true if this UUID is equal to the provided
 // UUID.
 func (lhs *UUID) Equals(rhs *UUID) bool {
 	return (*I128)(lhs).Equals((*I128)(rhs))
 }
 
+// Clone returns a deep copy of this UUID.
+func (v *UUID) Clone() *UUID {
+	x := (*I128)(v)
+	return (*UUID)(x.Clone())
+}
+
 func (v *UUID) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return ((*I128)(v)).MarshalLogObject(enc)
 }
@@ -1737,6 +2768,8 @@ func (v *I128) FromWire(w wire.Value) error {
 	highIsSet := false
 	lowIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -1758,6 +2791,63 @@ func (v *I128) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !highIsSet {
+		missing.Add("I128", "High")
+	}
+
+	if !lowIsSet {
+		missing.Add("I128", "Low")
+	}
+
+	return missing.Err()
+}
+
+func (v *I128) Decode(sr stream.Reader) error {
+	highIsSet := false
+	lowIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI64:
+			v.High, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			highIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			v.Low, err = sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			lowIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !highIsSet {
 		return errors.New("field High of I128 is required")
 	}
@@ -1769,6 +2859,80 @@ func (v *I128) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a I128 struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *I128) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.High)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"high":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Low)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"low":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a I128 struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *I128) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["high"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		if x != nil {
+			v.High = (int64)(*x)
+		}
+	}
+	if r, ok := raw["low"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		if x != nil {
+			v.Low = (int64)(*x)
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a I128
 // struct.
 func (v *I128) String() string {
@@ -1806,6 +2970,21 @@ func (v *I128) Equals(rhs *I128) bool {
 	return true
 }
 
+// Clone returns a deep copy of this I128. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *I128) Clone() *I128 {
+	if v == nil {
+		return nil
+	}
+
+	var c I128
+	c.High = v.High
+	c.Low = v.Low
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of I128.
 func (v *I128) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1841,6 +3020,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/typedefs",
 	FilePath: "typedefs.thrift",
 	SHA1:     "c44a85a79e17ab1c29e271bdef1909abe37fbb97",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		enums.ThriftModule,
 		structs.ThriftModule,
@@ -1849,3 +3029,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./structs.thrift\"\ninclude \"./enums.thrift\"\n\n/**\n * Number of seconds since epoch.\n *\n * Deprecated: Use ISOTime instead.\n */\ntypedef i64 Timestamp  // alias of primitive\ntypedef string State\n\ntypedef i128 UUID  // alias of struct\n\ntypedef list<Event> EventGroup  // alias fo collection\n\nstruct i128 {\n    1: required i64 high\n    2: required i64 low\n}\n\nstruct Event {\n    1: required UUID uuid  // required typedef\n    2: optional Timestamp time  // optional typedef\n}\n\nstruct DefaultPrimitiveTypedef {\n    1: optional State state = \"hello\"\n}\n\nstruct Transition {\n    1: required State fromState\n    2: required State toState\n    3: optional EventGroup events\n}\n\ntypedef binary PDF  // alias of []byte\n\ntypedef set<structs.Frame> FrameGroup\n\ntypedef map<structs.Point, structs.Point> PointMap\n\ntypedef set<binary> BinarySet\n\ntypedef map<structs.Edge, structs.Edge> EdgeMap\n\ntypedef map<State, i64> StateMap\n\ntypedef enums.EnumWithValues MyEnum\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
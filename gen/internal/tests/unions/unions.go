@@ -4,10 +4,14 @@
 package unions
 
 import (
+	bytes "bytes"
 	base64 "encoding/base64"
+	json "encoding/json"
+	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
+	stream "go.uber.org/thriftrw/protocol/stream"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -315,6 +319,319 @@ func (v *ArbitraryValue) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _ArbitraryValue_Decode(sr stream.Reader) (*ArbitraryValue, error) {
+	var v ArbitraryValue
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ArbitraryValue_Decode(sr stream.Reader) ([]*ArbitraryValue, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*ArbitraryValue, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ArbitraryValue_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_ArbitraryValue_Decode(sr stream.Reader) (map[string]*ArbitraryValue, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]*ArbitraryValue, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _ArbitraryValue_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *ArbitraryValue) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.BoolValue = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Int64Value = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StringValue = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TList:
+			v.ListValue, err = _List_ArbitraryValue_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.MapValue, err = _Map_String_ArbitraryValue_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.BoolValue != nil {
+		count++
+	}
+	if v.Int64Value != nil {
+		count++
+	}
+	if v.StringValue != nil {
+		count++
+	}
+	if v.ListValue != nil {
+		count++
+	}
+	if v.MapValue != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("ArbitraryValue should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+func _I64_UnmarshalJSON(text []byte) (*int64, error) {
+	// json.Number accepts both JSON numbers and JSON strings holding
+	// numbers, and retains all digits of the value.
+	var n json.Number
+	if err := json.Unmarshal(text, &n); err != nil {
+		return nil, err
+	}
+	if n == "" {
+		return nil, nil
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// MarshalJSON serializes a ArbitraryValue struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ArbitraryValue) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.BoolValue == nil) {
+		b, err := json.Marshal(v.BoolValue)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"boolValue":`)
+		buff.Write(b)
+	}
+	if !(v.Int64Value == nil) {
+		b, err := json.Marshal(v.Int64Value)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"int64Value":`)
+		buff.Write(b)
+	}
+	if !(v.StringValue == nil) {
+		b, err := json.Marshal(v.StringValue)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"stringValue":`)
+		buff.Write(b)
+	}
+	if !(len(v.ListValue) == 0) {
+		b, err := json.Marshal(v.ListValue)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"listValue":`)
+		buff.Write(b)
+	}
+	if !(len(v.MapValue) == 0) {
+		b, err := json.Marshal(v.MapValue)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapValue":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ArbitraryValue struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ArbitraryValue) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["boolValue"]; ok {
+		if err := json.Unmarshal(r, &v.BoolValue); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["int64Value"]; ok {
+		x, err := _I64_UnmarshalJSON(r)
+		if err != nil {
+			return err
+		}
+		v.Int64Value = (*int64)(x)
+	}
+	if r, ok := raw["stringValue"]; ok {
+		if err := json.Unmarshal(r, &v.StringValue); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["listValue"]; ok {
+		if err := json.Unmarshal(r, &v.ListValue); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapValue"]; ok {
+		if err := json.Unmarshal(r, &v.MapValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a ArbitraryValue
 // struct.
 func (v *ArbitraryValue) String() string {
@@ -439,6 +756,76 @@ func (v *ArbitraryValue) Equals(rhs *ArbitraryValue) bool {
 	return true
 }
 
+func _Bool_ClonePtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _I64_ClonePtr(v *int64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _String_ClonePtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_ArbitraryValue_Clone(v []*ArbitraryValue) []*ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*ArbitraryValue, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _Map_String_ArbitraryValue_Clone(v map[string]*ArbitraryValue) map[string]*ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]*ArbitraryValue, len(v))
+
+	for k, x := range v {
+		o[k] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this ArbitraryValue. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ArbitraryValue) Clone() *ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	var c ArbitraryValue
+	c.BoolValue = _Bool_ClonePtr(v.BoolValue)
+	c.Int64Value = _I64_ClonePtr(v.Int64Value)
+	c.StringValue = _String_ClonePtr(v.StringValue)
+	c.ListValue = _List_ArbitraryValue_Clone(v.ListValue)
+	c.MapValue = _Map_String_ArbitraryValue_Clone(v.MapValue)
+
+	return &c
+}
+
 type _List_ArbitraryValue_Zapper []*ArbitraryValue
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
@@ -560,6 +947,150 @@ func (v *ArbitraryValue) IsSetMapValue() bool {
 	return v != nil && v.MapValue != nil
 }
 
+// ArbitraryValueKind identifies the field of a ArbitraryValue that is set.
+type ArbitraryValueKind int
+
+const (
+	// ArbitraryValueKindUnset indicates that no field of a ArbitraryValue is set.
+	ArbitraryValueKindUnset ArbitraryValueKind = iota
+
+	// ArbitraryValueKindBoolValue indicates that BoolValue is set.
+	ArbitraryValueKindBoolValue
+
+	// ArbitraryValueKindInt64Value indicates that Int64Value is set.
+	ArbitraryValueKindInt64Value
+
+	// ArbitraryValueKindStringValue indicates that StringValue is set.
+	ArbitraryValueKindStringValue
+
+	// ArbitraryValueKindListValue indicates that ListValue is set.
+	ArbitraryValueKindListValue
+
+	// ArbitraryValueKindMapValue indicates that MapValue is set.
+	ArbitraryValueKindMapValue
+)
+
+// String returns the Thrift name of the field identified by this
+// ArbitraryValueKind.
+func (k ArbitraryValueKind) String() string {
+	switch k {
+	case ArbitraryValueKindUnset:
+		return "unset"
+	case ArbitraryValueKindBoolValue:
+		return "boolValue"
+	case ArbitraryValueKindInt64Value:
+		return "int64Value"
+	case ArbitraryValueKindStringValue:
+		return "stringValue"
+	case ArbitraryValueKindListValue:
+		return "listValue"
+	case ArbitraryValueKindMapValue:
+		return "mapValue"
+	default:
+		return fmt.Sprintf("ArbitraryValueKind(%d)", int(k))
+	}
+}
+
+// Which returns the kind of the field of this ArbitraryValue that is set,
+// or ArbitraryValueKindUnset if none of its fields is set.
+func (v *ArbitraryValue) Which() ArbitraryValueKind {
+	if v == nil {
+		return ArbitraryValueKindUnset
+	}
+
+	if v.BoolValue != nil {
+		return ArbitraryValueKindBoolValue
+	}
+
+	if v.Int64Value != nil {
+		return ArbitraryValueKindInt64Value
+	}
+
+	if v.StringValue != nil {
+		return ArbitraryValueKindStringValue
+	}
+
+	if v.ListValue != nil {
+		return ArbitraryValueKindListValue
+	}
+
+	if v.MapValue != nil {
+		return ArbitraryValueKindMapValue
+	}
+	return ArbitraryValueKindUnset
+}
+
+// GetBoolValueOk returns the value of BoolValue and true if it is
+// set, or its zero value and false if it is unset.
+func (v *ArbitraryValue) GetBoolValueOk() (o bool, ok bool) {
+	if v == nil || v.BoolValue == nil {
+		return
+	}
+	return *v.BoolValue, true
+}
+
+// GetInt64ValueOk returns the value of Int64Value and true if it is
+// set, or its zero value and false if it is unset.
+func (v *ArbitraryValue) GetInt64ValueOk() (o int64, ok bool) {
+	if v == nil || v.Int64Value == nil {
+		return
+	}
+	return *v.Int64Value, true
+}
+
+// GetStringValueOk returns the value of StringValue and true if it is
+// set, or its zero value and false if it is unset.
+func (v *ArbitraryValue) GetStringValueOk() (o string, ok bool) {
+	if v == nil || v.StringValue == nil {
+		return
+	}
+	return *v.StringValue, true
+}
+
+// GetListValueOk returns the value of ListValue and true if it is
+// set, or its zero value and false if it is unset.
+func (v *ArbitraryValue) GetListValueOk() (o []*ArbitraryValue, ok bool) {
+	if v == nil || v.ListValue == nil {
+		return
+	}
+	return v.ListValue, true
+}
+
+// GetMapValueOk returns the value of MapValue and true if it is
+// set, or its zero value and false if it is unset.
+func (v *ArbitraryValue) GetMapValueOk() (o map[string]*ArbitraryValue, ok bool) {
+	if v == nil || v.MapValue == nil {
+		return
+	}
+	return v.MapValue, true
+}
+
+// Match calls the function provided for the field of this ArbitraryValue
+// that is set with its value, and returns its result. An error is
+// returned if none of its fields is set.
+func (v *ArbitraryValue) Match(
+	onBoolValue func(bool) error,
+	onInt64Value func(int64) error,
+	onStringValue func(string) error,
+	onListValue func([]*ArbitraryValue) error,
+	onMapValue func(map[string]*ArbitraryValue) error,
+) error {
+	switch v.Which() {
+	case ArbitraryValueKindBoolValue:
+		return onBoolValue(*v.BoolValue)
+	case ArbitraryValueKindInt64Value:
+		return onInt64Value(*v.Int64Value)
+	case ArbitraryValueKindStringValue:
+		return onStringValue(*v.StringValue)
+	case ArbitraryValueKindListValue:
+		return onListValue(v.ListValue)
+	case ArbitraryValueKindMapValue:
+		return onMapValue(v.MapValue)
+	default:
+		return errors.New("ArbitraryValue should have exactly one field: got 0 fields")
+	}
+}
+
 type Document struct {
 	Pdf       typedefs.PDF `json:"pdf,omitempty"`
 	PlainText *string      `json:"plainText,omitempty"`
@@ -675,6 +1206,138 @@ func (v *Document) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _PDF_Decode(sr stream.Reader) (typedefs.PDF, error) {
+	var x typedefs.PDF
+	err := x.Decode(sr)
+	return x, err
+}
+
+func (v *Document) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Pdf, err = _PDF_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.PlainText = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Pdf != nil {
+		count++
+	}
+	if v.PlainText != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Document should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Document struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Document) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.Pdf) == 0) {
+		b, err := json.Marshal(v.Pdf)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"pdf":`)
+		buff.Write(b)
+	}
+	if !(v.PlainText == nil) {
+		b, err := json.Marshal(v.PlainText)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"plainText":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Document struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Document) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["pdf"]; ok {
+		if err := json.Unmarshal(r, &v.Pdf); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["plainText"]; ok {
+		if err := json.Unmarshal(r, &v.PlainText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Document
 // struct.
 func (v *Document) String() string {
@@ -716,6 +1379,21 @@ func (v *Document) Equals(rhs *Document) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Document. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Document) Clone() *Document {
+	if v == nil {
+		return nil
+	}
+
+	var c Document
+	c.Pdf = v.Pdf.Clone()
+	c.PlainText = _String_ClonePtr(v.PlainText)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Document.
 func (v *Document) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -761,6 +1439,87 @@ func (v *Document) IsSetPlainText() bool {
 	return v != nil && v.PlainText != nil
 }
 
+// DocumentKind identifies the field of a Document that is set.
+type DocumentKind int
+
+const (
+	// DocumentKindUnset indicates that no field of a Document is set.
+	DocumentKindUnset DocumentKind = iota
+
+	// DocumentKindPdf indicates that Pdf is set.
+	DocumentKindPdf
+
+	// DocumentKindPlainText indicates that PlainText is set.
+	DocumentKindPlainText
+)
+
+// String returns the Thrift name of the field identified by this
+// DocumentKind.
+func (k DocumentKind) String() string {
+	switch k {
+	case DocumentKindUnset:
+		return "unset"
+	case DocumentKindPdf:
+		return "pdf"
+	case DocumentKindPlainText:
+		return "plainText"
+	default:
+		return fmt.Sprintf("DocumentKind(%d)", int(k))
+	}
+}
+
+// Which returns the kind of the field of this Document that is set,
+// or DocumentKindUnset if none of its fields is set.
+func (v *Document) Which() DocumentKind {
+	if v == nil {
+		return DocumentKindUnset
+	}
+
+	if v.Pdf != nil {
+		return DocumentKindPdf
+	}
+
+	if v.PlainText != nil {
+		return DocumentKindPlainText
+	}
+	return DocumentKindUnset
+}
+
+// GetPdfOk returns the value of Pdf and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Document) GetPdfOk() (o typedefs.PDF, ok bool) {
+	if v == nil || v.Pdf == nil {
+		return
+	}
+	return v.Pdf, true
+}
+
+// GetPlainTextOk returns the value of PlainText and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Document) GetPlainTextOk() (o string, ok bool) {
+	if v == nil || v.PlainText == nil {
+		return
+	}
+	return *v.PlainText, true
+}
+
+// Match calls the function provided for the field of this Document
+// that is set with its value, and returns its result. An error is
+// returned if none of its fields is set.
+func (v *Document) Match(
+	onPdf func(typedefs.PDF) error,
+	onPlainText func(string) error,
+) error {
+	switch v.Which() {
+	case DocumentKindPdf:
+		return onPdf(v.Pdf)
+	case DocumentKindPlainText:
+		return onPlainText(*v.PlainText)
+	default:
+		return errors.New("Document should have exactly one field: got 0 fields")
+	}
+}
+
 type EmptyUnion struct {
 }
 
@@ -815,6 +1574,70 @@ func (v *EmptyUnion) FromWire(w wire.Value) error {
 	return nil
 }
 
+func (v *EmptyUnion) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a EmptyUnion struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *EmptyUnion) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a EmptyUnion struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *EmptyUnion) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a EmptyUnion
 // struct.
 func (v *EmptyUnion) String() string {
@@ -842,6 +1665,19 @@ func (v *EmptyUnion) Equals(rhs *EmptyUnion) bool {
 	return true
 }
 
+// Clone returns a deep copy of this EmptyUnion. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *EmptyUnion) Clone() *EmptyUnion {
+	if v == nil {
+		return nil
+	}
+
+	var c EmptyUnion
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of EmptyUnion.
 func (v *EmptyUnion) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -857,6 +1693,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/unions",
 	FilePath: "unions.thrift",
 	SHA1:     "ae222d8ff3a8efe55b3d2ebb0c70b4565255623c",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		typedefs.ThriftModule,
 	},
@@ -864,3 +1701,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./typedefs.thrift\"\n\nunion EmptyUnion {}\n\nunion Document {\n    1: typedefs.PDF pdf\n    2: string plainText\n}\n\n/**\n * ArbitraryValue allows constructing complex values without a schema.\n *\n * A value is one of,\n *\n * * Boolean\n * * Integer\n * * String\n * * A list of other values\n * * A dictionary of other values\n */\nunion ArbitraryValue {\n    1: bool boolValue\n    2: i64 int64Value\n    3: string stringValue\n    4: list<ArbitraryValue> listValue\n    5: map<string, ArbitraryValue> mapValue\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -4,10 +4,14 @@
 package uuid_conflict
 
 import (
+	bytes "bytes"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
 	typedefs "go.uber.org/thriftrw/gen/internal/tests/typedefs"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -44,6 +48,13 @@ func (v *UUID) FromWire(w wire.Value) error {
 	return err
 }
 
+// Decode deserializes UUID directly off the wire.
+func (v *UUID) Decode(sr stream.Reader) error {
+	x, err := sr.ReadString()
+	*v = (UUID)(x)
+	return err
+}
+
 // Equals returns true if this UUID is equal to the provided
 // UUID.
 func (lhs UUID) Equals(rhs UUID) bool {
@@ -132,6 +143,8 @@ func (v *UUIDConflict) FromWire(w wire.Value) error {
 	localUUIDIsSet := false
 	importedUUIDIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -145,7 +158,7 @@ func (v *UUIDConflict) FromWire(w wire.Value) error {
 		case 2:
 			if field.Value.Type() == wire.TStruct {
 				v.ImportedUUID, err = _UUID_1_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				importedUUIDIsSet = true
@@ -153,6 +166,75 @@ func (v *UUIDConflict) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !localUUIDIsSet {
+		missing.Add("UUIDConflict", "LocalUUID")
+	}
+
+	if !importedUUIDIsSet {
+		missing.Add("UUIDConflict", "ImportedUUID")
+	}
+
+	return missing.Err()
+}
+
+func _UUID_Decode(sr stream.Reader) (UUID, error) {
+	var x UUID
+	err := x.Decode(sr)
+	return x, err
+}
+
+func _UUID_1_Decode(sr stream.Reader) (*typedefs.UUID, error) {
+	var x typedefs.UUID
+	err := x.Decode(sr)
+	return &x, err
+}
+
+func (v *UUIDConflict) Decode(sr stream.Reader) error {
+	localUUIDIsSet := false
+	importedUUIDIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.LocalUUID, err = _UUID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			localUUIDIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.ImportedUUID, err = _UUID_1_Decode(sr)
+			if err != nil {
+				return err
+			}
+			importedUUIDIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !localUUIDIsSet {
 		return errors.New("field LocalUUID of UUIDConflict is required")
 	}
@@ -164,6 +246,72 @@ func (v *UUIDConflict) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a UUIDConflict struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *UUIDConflict) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.LocalUUID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"localUUID":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ImportedUUID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"importedUUID":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a UUIDConflict struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *UUIDConflict) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["localUUID"]; ok {
+		if err := json.Unmarshal(r, &v.LocalUUID); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["importedUUID"]; ok {
+		if err := json.Unmarshal(r, &v.ImportedUUID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a UUIDConflict
 // struct.
 func (v *UUIDConflict) String() string {
@@ -201,6 +349,21 @@ func (v *UUIDConflict) Equals(rhs *UUIDConflict) bool {
 	return true
 }
 
+// Clone returns a deep copy of this UUIDConflict. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *UUIDConflict) Clone() *UUIDConflict {
+	if v == nil {
+		return nil
+	}
+
+	var c UUIDConflict
+	c.LocalUUID = v.LocalUUID
+	c.ImportedUUID = v.ImportedUUID.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of UUIDConflict.
 func (v *UUIDConflict) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -241,6 +404,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/uuid_conflict",
 	FilePath: "uuid_conflict.thrift",
 	SHA1:     "c7ab8450f4c3a548cde8938fe7e150cf1b8f9493",
+	Version:  "1.21.0-dev",
 	Includes: []*thriftreflect.ThriftModule{
 		typedefs.ThriftModule,
 	},
@@ -248,3 +412,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./typedefs.thrift\"\n\ntypedef string UUID\n\nstruct UUIDConflict {\n    1: required UUID localUUID\n    2: required typedefs.UUID importedUUID\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
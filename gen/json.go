@@ -0,0 +1,355 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/fatih/structtag"
+)
+
+// jsonNameKey is a Thrift annotation that allows overriding the key used for
+// a struct field in its JSON representation.
+//
+//   struct User {
+//     1: required string name (json.name = "full_name")
+//   }
+//
+// This takes precedence over the go.label annotation and the name in a
+// json go.tag.
+const jsonNameKey = "json.name"
+
+// jsonTag returns the JSON struct tag generated for the given field.
+func jsonTag(f *compile.FieldSpec) (*structtag.Tag, error) {
+	tags, err := fieldTags(f)
+	if err != nil {
+		return nil, err
+	}
+	return tags.Get(jsonTagKey)
+}
+
+// jsonFields returns the fields of a field group that are present in the
+// JSON representation. Fields tagged with `json:"-"` are excluded, as are
+// binary fields represented as io.Readers because they cannot be encoded
+// without consuming them.
+func jsonFields(fs compile.FieldGroup) (compile.FieldGroup, error) {
+	var fields compile.FieldGroup
+	for _, f := range fs {
+		t, err := jsonTag(f)
+		if err != nil {
+			return nil, err
+		}
+		if t.Name != "-" && !isReaderBinary(f.Type) {
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+// jsonKey returns the JSON object key for the given field. If the JSON tag
+// does not specify a name, the name of the Go field is used, matching the
+// behavior of encoding/json.
+func jsonKey(g Generator, f *compile.FieldSpec) (string, error) {
+	t, err := jsonTag(f)
+	if err != nil {
+		return "", err
+	}
+	if t.Name != "" {
+		return t.Name, nil
+	}
+	return goName(g, f)
+}
+
+// jsonKeyLiteral returns a Go string literal holding the JSON-encoded key
+// for the given field, followed by a colon.
+func jsonKeyLiteral(g Generator, f *compile.FieldSpec) (string, error) {
+	key, err := jsonKey(g, f)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	lit := string(b) + ":"
+	if strings.ContainsRune(lit, '`') {
+		return strconv.Quote(lit), nil
+	}
+	return "`" + lit + "`", nil
+}
+
+// jsonOmitEmpty returns true if the given field should be left out of the
+// JSON representation when its value is empty.
+//
+// Required fields with custom types are never omitted because their
+// emptiness cannot be determined.
+func jsonOmitEmpty(g Generator, f *compile.FieldSpec) (bool, error) {
+	t, err := jsonTag(f)
+	if err != nil {
+		return false, err
+	}
+	if f.Required {
+		if m, err := mappedField(g, f); err != nil || m != nil {
+			return false, err
+		}
+	}
+	return t.HasOption("omitempty"), nil
+}
+
+// jsonQuoted returns true if the value of the given field should be
+// encoded inside a JSON string, as with the ",string" option of
+// encoding/json. This does not apply to fields with custom types.
+func jsonQuoted(g Generator, f *compile.FieldSpec) (bool, error) {
+	t, err := jsonTag(f)
+	if err != nil {
+		return false, err
+	}
+	if m, err := mappedField(g, f); err != nil || m != nil {
+		return false, err
+	}
+	return t.HasOption("string") && isPrimitiveType(f.Type), nil
+}
+
+// jsonI64Field returns true if the given field holds an i64 that may be
+// read from either a JSON number or a JSON string.
+func jsonI64Field(g Generator, f *compile.FieldSpec) (bool, error) {
+	if m, err := mappedField(g, f); err != nil || m != nil {
+		return false, err
+	}
+	return isI64Type(f.Type), nil
+}
+
+// jsonIsEmpty returns a Go expression that evaluates to true if the given
+// field value is empty as defined by encoding/json for "omitempty".
+func jsonIsEmpty(g Generator, f *compile.FieldSpec, v string) (string, error) {
+	m, err := mappedField(g, f)
+	if err != nil {
+		return "", err
+	}
+	if m != nil || isStructType(f.Type) || (!f.Required && !isReferenceType(f.Type)) {
+		return fmt.Sprintf("%s == nil", v), nil
+	}
+
+	switch compile.RootTypeSpec(f.Type).(type) {
+	case *compile.BinarySpec, *compile.MapSpec, *compile.ListSpec, *compile.SetSpec:
+		return fmt.Sprintf("len(%s) == 0", v), nil
+	case *compile.BoolSpec:
+		return fmt.Sprintf("!%s", v), nil
+	case *compile.StringSpec:
+		return fmt.Sprintf("%s == \"\"", v), nil
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec,
+		*compile.DoubleSpec, *compile.EnumSpec:
+		return fmt.Sprintf("%s == 0", v), nil
+	default:
+		return "", fmt.Errorf("cannot check %q for emptiness", f.Type.ThriftName())
+	}
+}
+
+// isI64Type returns true if the given type is an i64 or a typedef of one.
+func isI64Type(spec compile.TypeSpec) bool {
+	_, ok := compile.RootTypeSpec(spec).(*compile.I64Spec)
+	return ok
+}
+
+// jsonI64Reader returns the name of a function that reads an i64 from either
+// a JSON number or a JSON string. The function returns nil if the JSON value
+// is null.
+func jsonI64Reader(g Generator) (string, error) {
+	name := "_I64_UnmarshalJSON"
+	err := g.EnsureDeclared(
+		`
+		<$json := import "encoding/json">
+
+		<$text := newVar "text">
+		<$n := newVar "n">
+		<$i := newVar "i">
+		func <.Name>(<$text> []byte) (*int64, error) {
+			// json.Number accepts both JSON numbers and JSON strings holding
+			// numbers, and retains all digits of the value.
+			var <$n> <$json>.Number
+			if err := <$json>.Unmarshal(<$text>, &<$n>); err != nil {
+				return nil, err
+			}
+			if <$n> == "" {
+				return nil, nil
+			}
+
+			<$i>, err := <$n>.Int64()
+			if err != nil {
+				return nil, err
+			}
+			return &<$i>, nil
+		}
+		`,
+		struct{ Name string }{Name: name},
+	)
+	return name, err
+}
+
+func (f fieldGroupGenerator) JSON(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$json := import "encoding/json">
+
+		<$v := newVar "v">
+		<$fields := jsonFields .Fields>
+		// MarshalJSON serializes a <.Name> struct into JSON. Fields are keyed
+		// by their Thrift names or by their json.name annotations, and
+		// optional fields that are not set are omitted.
+		//
+		// This implements json.Marshaler.
+		func (<$v> *<.Name>) MarshalJSON() ([]byte, error) {
+			if <$v> == nil {
+				return []byte("null"), nil
+			}
+			<- if .HasLazyFields>
+				<$v> = <$v>.loaded()
+			<- end>
+
+			<- if $fields>
+				<$buff := newVar "buff">
+				<$b := newVar "b">
+
+				var <$buff> <$bytes>.Buffer
+				<$buff>.WriteByte('{')
+				<range $fields>
+					<- $f := printf "%s.%s" $v (fieldName .) ->
+					<- if inBitmap . ->
+						if <$v>.IsSet<goName .>() {
+					<- else if jsonOmitEmpty . ->
+						if !(<jsonIsEmpty . $f>) {
+					<- else ->
+						{
+					<- end>
+						<$b>, err := <$json>.Marshal(<$f>)
+						if err != nil {
+							return nil, err
+						}
+						<- if jsonQuoted .>
+							if <$b>, err = <$json>.Marshal(string(<$b>)); err != nil {
+								return nil, err
+							}
+						<- end>
+						if <$buff>.Len() > 1 {
+							<$buff>.WriteByte(',')
+						}
+						<$buff>.WriteString(<jsonKeyLiteral .>)
+						<$buff>.Write(<$b>)
+					}
+				<end>
+				<$buff>.WriteByte('}')
+				return <$buff>.Bytes(), nil
+			<- else>
+				return []byte("{}"), nil
+			<- end>
+		}
+
+		<$text := newVar "text">
+		<$raw := newVar "raw">
+		<$r := newVar "r">
+		<$s := newVar "s">
+		<$x := newVar "x">
+		<$y := newVar "y">
+		// UnmarshalJSON deserializes a <.Name> struct from JSON. Fields are
+		// looked up by the same keys used by MarshalJSON.
+		//
+		// Values of i64 fields may be provided as JSON numbers or as JSON
+		// strings holding numbers. The latter allows clients that represent
+		// all numbers as doubles to send them without a loss of precision.
+		//
+		// This implements json.Unmarshaler.
+		func (<$v> *<.Name>) UnmarshalJSON(<$text> []byte) error {
+			var <$raw> map[string]<$json>.RawMessage
+			if err := <$json>.Unmarshal(<$text>, &<$raw>); err != nil {
+				return err
+			}
+			<range $fields>
+				<- $f := printf "%s.%s" $v (fieldName .) ->
+				if <$r>, ok := <$raw>[<printf "%q" (jsonKey .)>]; ok {
+					<- if jsonQuoted .>
+						var <$s> string
+						if err := <$json>.Unmarshal(<$r>, &<$s>); err != nil {
+							return err
+						}
+						<$r> = <$json>.RawMessage(<$s>)
+					<- end>
+					<- if jsonI64Field .>
+						<$x>, err := <jsonI64Reader>(<$r>)
+						if err != nil {
+							return err
+						}
+						<- if .Required>
+							if <$x> != nil {
+								<$f> = (<typeReference .Type>)(*<$x>)
+							}
+						<- else if inBitmap .>
+							if <$x> != nil {
+								<$v>.Set<goName .>((<typeReference .Type>)(*<$x>))
+							} else {
+								<$v>.Clear<goName .>()
+							}
+						<- else>
+							<$f> = (<typeReferencePtr .Type>)(<$x>)
+						<- end>
+					<- else if inBitmap .>
+						var <$y> <typeReferencePtr .Type>
+						if err := <$json>.Unmarshal(<$r>, &<$y>); err != nil {
+							return err
+						}
+						if <$y> != nil {
+							<$v>.Set<goName .>(*<$y>)
+						} else {
+							<$v>.Clear<goName .>()
+						}
+					<- else if lazy .>
+						var <$y> <typeReference .Type>
+						if err := <$json>.Unmarshal(<$r>, &<$y>); err != nil {
+							return err
+						}
+						<$v>.Set<goName .>(<$y>)
+					<- else>
+						if err := <$json>.Unmarshal(<$r>, &<$f>); err != nil {
+							return err
+						}
+					<- end>
+				}
+			<end>
+			return nil
+		}
+		`, f,
+		TemplateFunc("jsonFields", jsonFields),
+		TemplateFunc("jsonKey", jsonKey),
+		TemplateFunc("jsonKeyLiteral", jsonKeyLiteral),
+		TemplateFunc("jsonOmitEmpty", jsonOmitEmpty),
+		TemplateFunc("jsonQuoted", jsonQuoted),
+		TemplateFunc("jsonIsEmpty", jsonIsEmpty),
+		TemplateFunc("jsonI64Field", jsonI64Field),
+		TemplateFunc("jsonI64Reader", jsonI64Reader),
+		TemplateFunc("lazy", lazyField),
+		TemplateFunc("inBitmap", f.inBitmap),
+	)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// OutputLayout controls how the code generated for a Thrift file is split
+// into files inside its package.
+type OutputLayout int
+
+const (
+	// SingleFileLayout generates all code for a Thrift file into a single
+	// file named after its package, or OutputFile if specified.
+	SingleFileLayout OutputLayout = iota
+
+	// PerKindLayout generates constants, types, and services into
+	// constants.go, types.go, and services.go, respectively. The embedded
+	// IDL is generated into idl.go.
+	PerKindLayout
+
+	// PerTypeLayout generates each type and service into its own file named
+	// after it and its kind. For example, the code for the struct Point and
+	// the service KeyValue is generated into point_struct.go and
+	// keyvalue_service.go. Constants and the embedded IDL are generated into
+	// constants.go and idl.go.
+	PerTypeLayout
+)
+
+// typeFilename returns the name of the file into which code for the given
+// type is generated with PerTypeLayout.
+func typeFilename(spec compile.TypeSpec) string {
+	kind := "typedef"
+	switch s := spec.(type) {
+	case *compile.EnumSpec:
+		kind = "enum"
+	case *compile.StructSpec:
+		switch s.Type {
+		case ast.UnionType:
+			kind = "union"
+		case ast.ExceptionType:
+			kind = "exception"
+		default:
+			kind = "struct"
+		}
+	}
+	return layoutFilename(spec.ThriftName(), kind)
+}
+
+// layoutFilename returns the name of the file for the entity with the given
+// name and kind.
+//
+// The kind always comes last so that the file name never ends with _test or
+// a GOOS or GOARCH, which would change when the Go tool builds it.
+func layoutFilename(name, kind string) string {
+	// The Go tool ignores files whose names begin with "_".
+	name = strings.TrimLeft(name, "_")
+	return strings.ToLower(name) + "_" + kind + ".go"
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// LazyLabel allows optional struct and binary fields to be deserialized
+// lazily. i.e.
+//
+// 	struct Envelope {
+// 		1: required string route
+// 		2: optional Payload payload (go.lazy = "true")
+// 	}
+//
+// FromWire reads the Thrift-level representation of payload and keeps it
+// around without decoding it, and ToWire sends it back over the wire as-is
+// if it was never decoded. This allows services that route large payloads
+// without inspecting them to skip building them.
+//
+// Lazy fields are not exported. They're read with GetPayload and changed
+// with SetPayload; setting them to nil unsets them. GetPayload decodes the
+// value every time it's called without keeping it, so it doesn't modify the
+// struct and values that fail to decode are treated as unset. LoadPayload
+// decodes the value and keeps it, and reports values that fail to decode.
+// Other methods of the struct like String and Equals decode lazy fields as
+// needed without modifying it either, so they may be called concurrently.
+//
+// Decode reads lazy fields eagerly. Arguments of functions cannot be lazy.
+const LazyLabel = "go.lazy"
+
+// lazyField returns true if the given field is annotated with LazyLabel.
+func lazyField(f *compile.FieldSpec) (bool, error) {
+	switch v, ok := f.Annotations[LazyLabel]; {
+	case !ok || v == "false":
+		return false, nil
+	case v != "" && v != "true":
+		return false, fmt.Errorf(
+			"invalid %v on field %q: expected \"true\" or \"false\", got %q",
+			LazyLabel, f.Name, v)
+	}
+	return true, nil
+}
+
+// fieldName returns the name of the Go struct field which holds the value of
+// the given field. Decoded values of lazy fields are held in unexported
+// fields so that they're only changed with setters which drop their
+// Thrift-level representation.
+func fieldName(g Generator, f *compile.FieldSpec) (string, error) {
+	name, err := goName(g, f)
+	if err != nil {
+		return "", err
+	}
+	if lazy, _ := lazyField(f); lazy {
+		return "decoded" + name, nil
+	}
+	return name, nil
+}
+
+// verifyNoLazyArgs verifies that none of the arguments of the given
+// function are annotated with LazyLabel. Plugins access arguments by their
+// exported fields.
+func verifyNoLazyArgs(f *compile.FunctionSpec) error {
+	for _, arg := range f.ArgsSpec {
+		if lazy, err := lazyField(arg); err != nil {
+			return err
+		} else if lazy {
+			return fmt.Errorf(
+				"invalid %v on argument %q: arguments of functions cannot be lazy",
+				LazyLabel, arg.Name)
+		}
+	}
+	return nil
+}
+
+// verifyLazyFields verifies that the fields of this group annotated with
+// LazyLabel may be deserialized lazily.
+func (f fieldGroupGenerator) verifyLazyFields(g Generator) error {
+	for _, field := range f.Fields {
+		lazy, err := lazyField(field)
+		if err != nil {
+			return err
+		} else if !lazy {
+			continue
+		}
+
+		switch root := compile.RootTypeSpec(field.Type).(type) {
+		case *compile.StructSpec:
+		case *compile.BinarySpec:
+			if t, ok := root.Annotations[goTypeKey]; ok {
+				return fmt.Errorf(
+					"invalid %v on field %q: binary fields with (%v = %q) cannot be lazy",
+					LazyLabel, field.Name, goTypeKey, t)
+			}
+		default:
+			return fmt.Errorf(
+				"invalid %v on field %q: only struct and binary fields may be lazy",
+				LazyLabel, field.Name)
+		}
+
+		switch {
+		case f.IsUnion:
+			return fmt.Errorf(
+				"invalid %v on field %q: fields of unions cannot be lazy",
+				LazyLabel, field.Name)
+		case field.Required:
+			return fmt.Errorf(
+				"invalid %v on field %q: only optional fields may be lazy",
+				LazyLabel, field.Name)
+		case field.Default != nil:
+			return fmt.Errorf(
+				"field %q cannot have a default value: it is lazy", field.Name)
+		}
+
+		if m, err := mappedField(g, field); err != nil {
+			return err
+		} else if m != nil {
+			return fmt.Errorf(
+				"invalid %v on field %q: fields with custom Go types cannot be lazy",
+				LazyLabel, field.Name)
+		}
+	}
+	return nil
+}
+
+// HasLazyFields returns true if any field of this group is annotated with
+// LazyLabel.
+func (f fieldGroupGenerator) HasLazyFields() bool {
+	return len(f.LazyFields()) > 0
+}
+
+// LazyFields returns the fields of this group annotated with LazyLabel.
+func (f fieldGroupGenerator) LazyFields() []*compile.FieldSpec {
+	var fields []*compile.FieldSpec
+	for _, field := range f.Fields {
+		if lazy, _ := lazyField(field); lazy {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// HasEagerFields returns true if any field of this group is not annotated
+// with LazyLabel.
+func (f fieldGroupGenerator) HasEagerFields() bool {
+	for _, field := range f.Fields {
+		if lazy, _ := lazyField(field); !lazy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tl "go.uber.org/thriftrw/gen/internal/tests/lazy"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeEnvelope serializes the given Envelope and reads it back with
+// FromWire.
+func decodeEnvelope(t *testing.T, give *tl.Envelope) (*tl.Envelope, wire.Value) {
+	w, err := give.ToWire()
+	require.NoError(t, err, "failed to serialize %v", give)
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(w, &buff))
+
+	raw, err := protocol.Binary.Decode(bytes.NewReader(buff.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+
+	var got tl.Envelope
+	require.NoError(t, got.FromWire(raw))
+	return &got, w
+}
+
+func TestLazyFieldsPassThrough(t *testing.T) {
+	payload := &tl.Payload{
+		Name:   "foo",
+		Tags:   []string{"a", "b"},
+		Counts: map[string]int64{"x": 1},
+	}
+	give := &tl.Envelope{Route: "bar"}
+	give.SetPayload(payload)
+	give.SetAttachment([]byte("baz"))
+
+	got, want := decodeEnvelope(t, give)
+	assert.True(t, got.IsSetPayload())
+	assert.True(t, got.IsSetAttachment())
+	assert.False(t, got.IsSetAliased())
+
+	w, err := got.ToWire()
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(want, w), "ToWire must pass lazy fields through")
+
+	assert.Equal(t, payload, got.GetPayload())
+	assert.Equal(t, []byte("baz"), got.GetAttachment())
+
+	require.NoError(t, got.LoadPayload())
+	assert.Equal(t, payload, got.GetPayload())
+
+	w, err = got.ToWire()
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(want, w))
+}
+
+func TestLazyFieldsDecodedOnDemand(t *testing.T) {
+	give := &tl.Envelope{Route: "bar"}
+	give.SetPayload(&tl.Payload{Name: "foo", Tags: []string{"a"}})
+
+	t.Run("Equals", func(t *testing.T) {
+		got, _ := decodeEnvelope(t, give)
+		assert.True(t, got.Equals(give))
+		assert.True(t, give.Equals(got))
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		got, _ := decodeEnvelope(t, give)
+		assert.Equal(t, give, got.Clone())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		got, _ := decodeEnvelope(t, give)
+		assert.Equal(t, give.String(), got.String())
+	})
+
+	t.Run("MarshalJSON", func(t *testing.T) {
+		want, err := give.MarshalJSON()
+		require.NoError(t, err)
+
+		got, _ := decodeEnvelope(t, give)
+		b, err := got.MarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(want), string(b))
+	})
+
+	t.Run("UnmarshalJSON", func(t *testing.T) {
+		got, _ := decodeEnvelope(t, give)
+		require.NoError(t, got.UnmarshalJSON([]byte(`{"route": "bar", "payload": {"name": "qux"}}`)))
+		assert.Equal(t, "qux", got.GetPayload().Name)
+	})
+
+	t.Run("set value wins", func(t *testing.T) {
+		got, _ := decodeEnvelope(t, give)
+		got.SetPayload(&tl.Payload{Name: "qux"})
+		require.NoError(t, got.LoadPayload())
+		assert.Equal(t, "qux", got.GetPayload().Name)
+
+		w, err := got.ToWire()
+		require.NoError(t, err)
+		var decoded tl.Envelope
+		require.NoError(t, decoded.FromWire(w))
+		assert.Equal(t, "qux", decoded.GetPayload().Name)
+	})
+}
+
+func TestLazyFieldSetNil(t *testing.T) {
+	give := &tl.Envelope{Route: "bar"}
+	give.SetPayload(&tl.Payload{Name: "foo"})
+	give.SetAttachment([]byte("baz"))
+
+	got, _ := decodeEnvelope(t, give)
+	got.SetPayload(nil)
+	got.SetAttachment(nil)
+	assert.False(t, got.IsSetPayload())
+	assert.False(t, got.IsSetAttachment())
+	assert.Nil(t, got.GetPayload())
+
+	w, err := got.ToWire()
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(
+		wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("bar")},
+		}}), w), "unset lazy fields must not be written")
+	assert.True(t, got.Equals(&tl.Envelope{Route: "bar"}))
+}
+
+func TestLazyFieldGetDoesNotModify(t *testing.T) {
+	give := &tl.Envelope{Route: "bar"}
+	give.SetPayload(&tl.Payload{Name: "foo"})
+
+	got, _ := decodeEnvelope(t, give)
+	got.GetPayload().Name = "qux"
+	assert.Equal(t, "foo", got.GetPayload().Name,
+		"values decoded by GetPayload must not be kept")
+
+	require.NoError(t, got.LoadPayload())
+	got.GetPayload().Name = "qux"
+	assert.Equal(t, "qux", got.GetPayload().Name,
+		"values decoded by LoadPayload must be kept")
+}
+
+func TestLazyFieldsConcurrentReads(t *testing.T) {
+	give := &tl.Envelope{Route: "bar"}
+	give.SetPayload(&tl.Payload{Name: "foo", Tags: []string{"a"}})
+	give.SetAttachment([]byte("baz"))
+
+	lhs, _ := decodeEnvelope(t, give)
+	rhs, _ := decodeEnvelope(t, give)
+
+	// Run with -race to verify that reads don't modify the Envelopes.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, give.String(), lhs.String())
+			assert.True(t, lhs.Equals(rhs))
+			assert.True(t, rhs.Equals(lhs))
+			assert.Equal(t, give, lhs.Clone())
+			assert.True(t, lhs.IsSetPayload())
+			assert.Equal(t, "foo", lhs.GetPayload().Name)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLazyFieldDecodeError(t *testing.T) {
+	var got tl.Envelope
+	require.NoError(t, got.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("bar")},
+		// Payload is missing its required name.
+		{ID: 2, Value: wire.NewValueStruct(wire.Struct{})},
+	}})))
+
+	assert.Nil(t, got.GetPayload())
+	assert.Error(t, got.LoadPayload())
+	assert.True(t, got.IsSetPayload())
+}
+
+func TestLazyFieldInvalid(t *testing.T) {
+	lazy := compile.Annotations{"go.lazy": "true"}
+	tests := []struct {
+		desc    string
+		field   *compile.FieldSpec
+		union   bool
+		wantErr string
+	}{
+		{
+			desc: "string",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.StringSpec{},
+				Annotations: lazy,
+			},
+			wantErr: `invalid go.lazy on field "foo": only struct and binary fields may be lazy`,
+		},
+		{
+			desc: "invalid value",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.BinarySpec{},
+				Annotations: compile.Annotations{"go.lazy": "yes"},
+			},
+			wantErr: `invalid go.lazy on field "foo": expected "true" or "false", got "yes"`,
+		},
+		{
+			desc: "required",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.BinarySpec{},
+				Required:    true,
+				Annotations: lazy,
+			},
+			wantErr: `invalid go.lazy on field "foo": only optional fields may be lazy`,
+		},
+		{
+			desc: "union",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.BinarySpec{},
+				Annotations: lazy,
+			},
+			union:   true,
+			wantErr: `invalid go.lazy on field "foo": fields of unions cannot be lazy`,
+		},
+		{
+			desc: "default value",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.BinarySpec{},
+				Annotations: lazy,
+				Default:     compile.ConstantString("bar"),
+			},
+			wantErr: `field "foo" cannot have a default value: it is lazy`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f := fieldGroupGenerator{
+				Name:    "Foo",
+				Fields:  compile.FieldGroup{tt.field},
+				IsUnion: tt.union,
+			}
+			err := f.verifyLazyFields(NewGenerator(&GeneratorOptions{PackageName: "foo"}))
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
+
+func TestLazyArgsInvalid(t *testing.T) {
+	err := verifyNoLazyArgs(&compile.FunctionSpec{
+		Name: "route",
+		ArgsSpec: compile.ArgsSpec{
+			{ID: 1, Name: "route", Type: &compile.StringSpec{}},
+			{
+				ID:          2,
+				Name:        "payload",
+				Type:        &compile.BinarySpec{},
+				Annotations: compile.Annotations{"go.lazy": "true"},
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t,
+		`invalid go.lazy on argument "payload": arguments of functions cannot be lazy`,
+		err.Error())
+}
@@ -113,21 +113,36 @@ func (l *listGenerator) Reader(g Generator, spec *compile.ListSpec) (string, err
 			<$i := newVar "i">
 			<$o := newVar "o">
 			<$x := newVar "x">
+			<$missing := newVar "missing">
 			func <.Name>(<$l> <$wire>.ValueList) (<$listType>, error) {
 				if <$l>.ValueType() != <typeCode .Spec.ValueSpec> {
 					return nil, nil
 				}
 
 				<$o> := make(<$listType>, 0, <$l>.Size())
+				<- if reportsMissing .Spec.ValueSpec>
+					var <$missing> <import "go.uber.org/thriftrw/required">.Errors
+				<- end>
 				err := <$l>.ForEach(func(<$x> <$wire>.Value) error {
 					<$i>, err := <fromWire .Spec.ValueSpec $x>
-					if err != nil {
-						return err
-					}
+					<- if reportsMissing .Spec.ValueSpec>
+						if err = <$missing>.Merge(err); err != nil {
+							return err
+						}
+					<- else>
+						if err != nil {
+							return err
+						}
+					<- end>
 					<$o> = append(<$o>, <$i>)
 					return nil
 				})
 				<$l>.Close()
+				<- if reportsMissing .Spec.ValueSpec>
+					if err == nil {
+						err = <$missing>.Err()
+					}
+				<- end>
 				return <$o>, err
 			}
 		`,
@@ -135,6 +150,126 @@ func (l *listGenerator) Reader(g Generator, spec *compile.ListSpec) (string, err
 			Name string
 			Spec *compile.ListSpec
 		}{Name: name, Spec: spec},
+		TemplateFunc("reportsMissing", reportsMissingFields),
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Decoder generates a function to read a list of the given type directly
+// from a stream.Reader.
+//
+// 	func $name(sr stream.Reader) ($listType, error) {
+// 		...
+// 	}
+//
+// And returns its name.
+func (l *listGenerator) Decoder(g Generator, spec *compile.ListSpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+			<$listType := typeReference .Spec>
+
+			<$sr := newVar "sr">
+			<$lh := newVar "lh">
+			<$i := newVar "i">
+			<$o := newVar "o">
+			<$n := newVar "n">
+			<$v := newVar "v">
+			func <.Name>(<$sr> <$stream>.Reader) (<$listType>, error) {
+				<$lh>, err := <$sr>.ReadListBegin()
+				if err != nil {
+					return nil, err
+				}
+
+				if <$lh>.Type != <typeCode .Spec.ValueSpec> {
+					for <$i> := 0; <$i> <"<"> <$lh>.Length; <$i>++ {
+						if err := <$sr>.Skip(<$lh>.Type); err != nil {
+							return nil, err
+						}
+					}
+					return nil, <$sr>.ReadListEnd()
+				}
+
+				<$n> := <$lh>.Length
+				if <$n> > 1024 {
+					// Don't trust the length read off the wire for the initial
+					// allocation. The collection grows as items are read.
+					<$n> = 1024
+				}
+
+				<$o> := make(<$listType>, 0, <$n>)
+				for <$i> := 0; <$i> <"<"> <$lh>.Length; <$i>++ {
+					<$v>, err := <decode .Spec.ValueSpec $sr>
+					if err != nil {
+						return nil, err
+					}
+					<$o> = append(<$o>, <$v>)
+				}
+
+				if err = <$sr>.ReadListEnd(); err != nil {
+					return nil, err
+				}
+				return <$o>, err
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Encoder generates a function to write a list of the given type directly
+// to a stream.Writer.
+//
+// 	func $name(v $listType, sw stream.Writer) error {
+// 		...
+// 	}
+//
+// And returns its name.
+func (l *listGenerator) Encoder(g Generator, spec *compile.ListSpec) (string, error) {
+	name := encoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+			<$v := newVar "v">
+			<$sw := newVar "sw">
+			<$lh := newVar "lh">
+			<$i := newVar "i">
+			<$x := newVar "x">
+			func <.Name>(<$v> <typeReference .Spec>, <$sw> <$stream>.Writer) error {
+				<$lh> := <$stream>.ListHeader{
+					Type:   <typeCode .Spec.ValueSpec>,
+					Length: len(<$v>),
+				}
+				if err := <$sw>.WriteListBegin(<$lh>); err != nil {
+					return err
+				}
+
+				<if isPrimitiveType .Spec.ValueSpec ->
+				for _, <$x> := range <$v> {
+				<- else ->
+				for <$i>, <$x> := range <$v> {
+					if <$x> == nil {
+						return <import "fmt">.Errorf("invalid [%v]: value is nil", <$i>)
+					}
+				<- end>
+					if err := <encode .Spec.ValueSpec $x $sw>; err != nil {
+						return err
+					}
+				}
+				return <$sw>.WriteListEnd()
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
 	)
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
@@ -182,6 +317,44 @@ func (l *listGenerator) Equals(g Generator, spec *compile.ListSpec) (string, err
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+// Clone generates a function to make deep copies of lists of the given type
+//
+// 	func $name(v $listType) $listType {
+// 		...
+// 	}
+//
+// And returns its name.
+func (l *listGenerator) Clone(g Generator, spec *compile.ListSpec) (string, error) {
+	name := cloneFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$listType := typeReference .Spec>
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <$listType>) <$listType> {
+				if <$v> == nil {
+					return nil
+				}
+
+				<$o := newVar "o">
+				<$i := newVar "i">
+				<$x := newVar "x">
+				<$o> := make(<$listType>, len(<$v>))
+				for <$i>, <$x> := range <$v> {
+					<$o>[<$i>] = <clone .Spec.ValueSpec $x>
+				}
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 // Slices are logged as JSON arrays.
 func (l *listGenerator) zapMarshaler(
 	g Generator,
@@ -32,6 +32,12 @@ type mangler struct {
 
 	// all names for custom types that have been taken so far
 	taken map[string]struct{}
+
+	// whether sets are slices unless annotated otherwise
+	sliceSets bool
+
+	// whether maps are sorted unless annotated otherwise
+	sortedMaps bool
 }
 
 func newMangler() *mangler {
@@ -44,18 +50,31 @@ func newMangler() *mangler {
 func (m *mangler) MangleType(spec compile.TypeSpec) string {
 	switch s := spec.(type) {
 	case *compile.MapSpec:
+		mapType := "Map"
+		if sorted, _ := isSortedMap(s, m.sortedMaps); sorted {
+			mapType = "SortedMap"
+		}
+
 		return fmt.Sprintf(
-			"Map_%s_%s", m.MangleType(s.KeySpec), m.MangleType(s.ValueSpec),
+			"%s_%s_%s", mapType, m.MangleType(s.KeySpec), m.MangleType(s.ValueSpec),
 		)
 	case *compile.ListSpec:
 		return fmt.Sprintf("List_%s", m.MangleType(s.ValueSpec))
 	case *compile.SetSpec:
 		setType := "slice"
-		if setUsesMap(s) {
+		if setUsesMap(s, m.sliceSets) {
 			setType = "map"
 		}
 
 		return fmt.Sprintf("Set_%s_%vType", m.MangleType(s.ValueSpec), setType)
+	case *compile.BinarySpec:
+		// Binaries represented as strings share their helpers with strings.
+		switch s.Annotations[goTypeKey] {
+		case stringType:
+			return "String"
+		case readerType:
+			return "BinaryReader"
+		}
 	}
 
 	// Native primitive types have unique names
@@ -47,8 +47,15 @@ type mapGenerator struct{}
 // And $mapItemListName is returned. This may be used where a MapItemList of the
 // given type is expected.
 func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, error) {
+	sorted, err := sortedMap(g, spec)
+	if err != nil {
+		return "", err
+	}
+
+	// Items of sorted maps are sent in the order of their keys so that
+	// equal maps are encoded identically. See SortedMapLabel.
 	name := mapItemListName(g, spec)
-	err := g.EnsureDeclared(
+	err = g.EnsureDeclared(
 		`
 			<$wire := import "go.uber.org/thriftrw/wire">
 			type <.Name> <typeReference .Spec>
@@ -61,9 +68,33 @@ func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, err
 			<$kw := newVar "kw">
 			<$vw := newVar "vw">
 			func (<$m> <.Name>) ForEach(<$f> func(<$wire>.MapItem) error) error {
-				<- if isHashable .Spec.KeySpec ->
+				<- if and .Sorted (isHashable .Spec.KeySpec) ->
+					<- $keys := newVar "keys" ->
+					<- $j := newVar "j" ->
+					<$keys> := make([]<typeReference .Spec.KeySpec>, 0, len(<$m>))
+					for <$k> := range <$m> {
+						<$keys> = append(<$keys>, <$k>)
+					}
+					<import "sort">.Slice(<$keys>, func(<$i>, <$j> int) bool {
+						return <compareValues .Spec.KeySpec (printf "%v[%v]" $keys $i) (printf "%v[%v]" $keys $j)> <"<"> 0
+					})
+
+					for _, <$k> := range <$keys> {
+						<$v> := <$m>[<$k>]
+				<else if isHashable .Spec.KeySpec ->
 					for <$k>, <$v> := range <$m> {
 				<else ->
+					<- if .Sorted ->
+						<- $items := newVar "items" ->
+						<- $j := newVar "j" ->
+						<$items> := make(<typeReference .Spec>, len(<$m>))
+						copy(<$items>, <$m>)
+						<import "sort">.Slice(<$items>, func(<$i>, <$j> int) bool {
+							return <compareValues .Spec.KeySpec (printf "%v[%v].Key" $items $i) (printf "%v[%v].Key" $items $j)> <"<"> 0
+						})
+						<$m> = <$items>
+
+					<end ->
 					for _, <$i> := range <$m> {
 						<$k> := <$i>.Key
 						<$v> := <$i>.Value
@@ -111,9 +142,11 @@ func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, err
 			func (<.Name>) Close() {}
 		`,
 		struct {
-			Name string
-			Spec *compile.MapSpec
-		}{Name: name, Spec: spec},
+			Name   string
+			Spec   *compile.MapSpec
+			Sorted bool
+		}{Name: name, Spec: spec, Sorted: sorted},
+		TemplateFunc("compareValues", compareValues),
 	)
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
@@ -131,6 +164,7 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 			<$x := newVar "x">
 			<$k := newVar "k">
 			<$v := newVar "v">
+			<$missing := newVar "missing">
 			func <.Name>(<$m> <$wire>.MapItemList) (<$mapType>, error) {
 				if <$m>.KeyType() != <typeCode .Spec.KeySpec> {
 					return nil, nil
@@ -145,16 +179,31 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 				<else>
 					<$o> := make(<$mapType>, 0, <$m>.Size())
 				<end ->
+				<if reportsMissing .Spec ->
+					var <$missing> <import "go.uber.org/thriftrw/required">.Errors
+				<end ->
 				err := <$m>.ForEach(func(<$x> <$wire>.MapItem) error {
 					<$k>, err := <fromWire .Spec.KeySpec (printf "%s.Key" $x)>
-					if err != nil {
-						return err
-					}
+					<- if reportsMissing .Spec.KeySpec>
+						if err = <$missing>.Merge(err); err != nil {
+							return err
+						}
+					<- else>
+						if err != nil {
+							return err
+						}
+					<- end>
 
 					<$v>, err := <fromWire .Spec.ValueSpec (printf "%s.Value" $x)>
-					if err != nil {
-						return err
-					}
+					<- if reportsMissing .Spec.ValueSpec>
+						if err = <$missing>.Merge(err); err != nil {
+							return err
+						}
+					<- else>
+						if err != nil {
+							return err
+						}
+					<- end>
 
 					<if isHashable .Spec.KeySpec>
 						<$o>[<$k>] = <$v>
@@ -167,6 +216,11 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 					return nil
 				})
 				<$m>.Close()
+				<- if reportsMissing .Spec>
+					if err == nil {
+						err = <$missing>.Err()
+					}
+				<- end>
 				return <$o>, err
 			}
 		`,
@@ -174,6 +228,195 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 			Name string
 			Spec *compile.MapSpec
 		}{Name: name, Spec: spec},
+		TemplateFunc("reportsMissing", reportsMissingFields),
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Decoder generates a function to read a map of the given type directly
+// from a stream.Reader.
+//
+// 	func $name(sr stream.Reader) ($mapType, error) {
+// 		...
+// 	}
+//
+// And returns its name.
+func (m *mapGenerator) Decoder(g Generator, spec *compile.MapSpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+			<$mapType := typeReference .Spec>
+
+			<$sr := newVar "sr">
+			<$mh := newVar "mh">
+			<$i := newVar "i">
+			<$o := newVar "o">
+			<$n := newVar "n">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			func <.Name>(<$sr> <$stream>.Reader) (<$mapType>, error) {
+				<$mh>, err := <$sr>.ReadMapBegin()
+				if err != nil {
+					return nil, err
+				}
+
+				if <$mh>.KeyType != <typeCode .Spec.KeySpec> || <$mh>.ValueType != <typeCode .Spec.ValueSpec> {
+					for <$i> := 0; <$i> <"<"> <$mh>.Length; <$i>++ {
+						if err := <$sr>.Skip(<$mh>.KeyType); err != nil {
+							return nil, err
+						}
+
+						if err := <$sr>.Skip(<$mh>.ValueType); err != nil {
+							return nil, err
+						}
+					}
+					return nil, <$sr>.ReadMapEnd()
+				}
+
+				<$n> := <$mh>.Length
+				if <$n> > 1024 {
+					// Don't trust the length read off the wire for the initial
+					// allocation. The collection grows as items are read.
+					<$n> = 1024
+				}
+
+				<if isHashable .Spec.KeySpec>
+					<$o> := make(<$mapType>, <$n>)
+				<else>
+					<$o> := make(<$mapType>, 0, <$n>)
+				<end ->
+				for <$i> := 0; <$i> <"<"> <$mh>.Length; <$i>++ {
+					<$k>, err := <decode .Spec.KeySpec $sr>
+					if err != nil {
+						return nil, err
+					}
+
+					<$v>, err := <decode .Spec.ValueSpec $sr>
+					if err != nil {
+						return nil, err
+					}
+
+					<if isHashable .Spec.KeySpec>
+						<$o>[<$k>] = <$v>
+					<else>
+						<$o> = append(<$o>, struct {
+							Key <typeReference .Spec.KeySpec>
+							Value <typeReference .Spec.ValueSpec>
+						}{<$k>, <$v>})
+					<end ->
+				}
+
+				if err = <$sr>.ReadMapEnd(); err != nil {
+					return nil, err
+				}
+				return <$o>, err
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Encoder generates a function to write a map of the given type directly
+// to a stream.Writer.
+//
+// 	func $name(m $mapType, sw stream.Writer) error {
+// 		...
+// 	}
+//
+// And returns its name.
+func (m *mapGenerator) Encoder(g Generator, spec *compile.MapSpec) (string, error) {
+	sorted, err := sortedMap(g, spec)
+	if err != nil {
+		return "", err
+	}
+
+	name := encoderFuncName(g, spec)
+	err = g.EnsureDeclared(
+		`
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+			<$m := newVar "m">
+			<$sw := newVar "sw">
+			<$mh := newVar "mh">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			<$i := newVar "i">
+			func <.Name>(<$m> <typeReference .Spec>, <$sw> <$stream>.Writer) error {
+				<$mh> := <$stream>.MapHeader{
+					KeyType:   <typeCode .Spec.KeySpec>,
+					ValueType: <typeCode .Spec.ValueSpec>,
+					Length:    len(<$m>),
+				}
+				if err := <$sw>.WriteMapBegin(<$mh>); err != nil {
+					return err
+				}
+
+				<if and .Sorted (isHashable .Spec.KeySpec) ->
+					<- $keys := newVar "keys" ->
+					<- $j := newVar "j" ->
+					<$keys> := make([]<typeReference .Spec.KeySpec>, 0, len(<$m>))
+					for <$k> := range <$m> {
+						<$keys> = append(<$keys>, <$k>)
+					}
+					<import "sort">.Slice(<$keys>, func(<$i>, <$j> int) bool {
+						return <compareValues .Spec.KeySpec (printf "%v[%v]" $keys $i) (printf "%v[%v]" $keys $j)> <"<"> 0
+					})
+
+					for _, <$k> := range <$keys> {
+						<$v> := <$m>[<$k>]
+				<else if isHashable .Spec.KeySpec ->
+					for <$k>, <$v> := range <$m> {
+				<else ->
+					<- if .Sorted ->
+						<- $items := newVar "items" ->
+						<- $j := newVar "j" ->
+						<$items> := make(<typeReference .Spec>, len(<$m>))
+						copy(<$items>, <$m>)
+						<import "sort">.Slice(<$items>, func(<$i>, <$j> int) bool {
+							return <compareValues .Spec.KeySpec (printf "%v[%v].Key" $items $i) (printf "%v[%v].Key" $items $j)> <"<"> 0
+						})
+						<$m> = <$items>
+
+					<end ->
+					for _, <$i> := range <$m> {
+						<$k> := <$i>.Key
+						<$v> := <$i>.Value
+				<end>
+						<- if not (isPrimitiveType .Spec.KeySpec) ->
+							if <$k> == nil {
+								return <import "fmt">.Errorf("invalid map key: value is nil")
+							}
+						<end ->
+						<- if not (isPrimitiveType .Spec.ValueSpec) ->
+							if <$v> == nil {
+								return <import "fmt">.Errorf("invalid [%v]: value is nil", <$k>)
+							}
+						<end ->
+
+						if err := <encode .Spec.KeySpec $k $sw>; err != nil {
+							return err
+						}
+
+						if err := <encode .Spec.ValueSpec $v $sw>; err != nil {
+							return err
+						}
+					}
+				return <$sw>.WriteMapEnd()
+			}
+		`,
+		struct {
+			Name   string
+			Spec   *compile.MapSpec
+			Sorted bool
+		}{Name: name, Spec: spec, Sorted: sorted},
+		TemplateFunc("compareValues", compareValues),
 	)
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
@@ -282,6 +525,56 @@ func (m *mapGenerator) equalsUnhashable(g Generator, spec *compile.MapSpec) (str
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+// Clone generates a function to make deep copies of maps of the given type
+//
+// 	func $name(v $mapType) $mapType {
+// 		...
+// 	}
+//
+// And returns its name.
+func (m *mapGenerator) Clone(g Generator, spec *compile.MapSpec) (string, error) {
+	name := cloneFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$mapType := typeReference .Spec>
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <$mapType>) <$mapType> {
+				if <$v> == nil {
+					return nil
+				}
+
+				<$o := newVar "o">
+				<$i := newVar "i">
+				<$k := newVar "k">
+				<$x := newVar "x">
+				<$o> := make(<$mapType>, len(<$v>))
+				<if isHashable .Spec.KeySpec ->
+					// Hashable keys are primitives so they may be copied
+					// as-is.
+					for <$k>, <$x> := range <$v> {
+						<$o>[<$k>] = <clone .Spec.ValueSpec $x>
+					}
+				<- else ->
+					for <$i>, <$x> := range <$v> {
+						<- $key := printf "%s.Key" $x ->
+						<- $value := printf "%s.Value" $x>
+						<$o>[<$i>].Key = <clone .Spec.KeySpec $key>
+						<$o>[<$i>].Value = <clone .Spec.ValueSpec $value>
+					}
+				<- end>
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 // Maps are logged as objects if the key is a string or a typedef of a
 // string. If the key is not a string, maps are logged as arrays of
 // objects with a key and value.
@@ -298,12 +591,10 @@ func (m *mapGenerator) zapMarshaler(
 	fieldValue string,
 ) (string, error) {
 	name := zapperName(g, root)
-	switch compile.RootTypeSpec(root.KeySpec).(type) {
-	case *compile.StringSpec:
+	if isStringKey(root.KeySpec) {
 		return m.zapStringKeyMarshaler(g, name, root, fieldValue)
-	default:
-		return m.zapNonstringKeyMarshaler(g, name, root, fieldValue)
 	}
+	return m.zapNonstringKeyMarshaler(g, name, root, fieldValue)
 }
 
 func (m *mapGenerator) zapStringKeyMarshaler(
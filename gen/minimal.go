@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// goMinimalAnnotation, when set to "true" or "false" on the `namespace go`
+// statement of a Thrift file, overrides whether the code for it is
+// generated with the Minimal option.
+//
+// 	namespace go foo (go.minimal = "true")
+const goMinimalAnnotation = "go.minimal"
+
+// isMinimalModule returns whether the code for the given module is
+// generated with the Minimal option.
+func isMinimalModule(m *compile.Module, o *Options) (bool, error) {
+	ns, ok := m.Namespaces["go"]
+	if !ok {
+		return o.Minimal, nil
+	}
+
+	v, ok := ns.Annotations[goMinimalAnnotation]
+	if !ok {
+		return o.Minimal, nil
+	}
+
+	switch v {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, fmt.Errorf(
+			`%v of %q must be "true" or "false", not %q`,
+			goMinimalAnnotation, m.ThriftPath, v)
+	}
+}
+
+// validateMinimal verifies that the modules generated with the Minimal
+// option are not used with options which rely on the code it omits, and
+// that they are included only by other minimal modules.
+func validateMinimal(root *compile.Module, o *Options) error {
+	return root.Walk(func(m *compile.Module) error {
+		minimal, err := isMinimalModule(m, o)
+		if err != nil {
+			return err
+		}
+
+		// With NoRecurse, the code for included modules is generated
+		// separately, possibly with other options.
+		if minimal && (m == root || !o.NoRecurse) {
+			var conflict string
+			switch {
+			case o.ServiceStubs:
+				conflict = "ServiceStubs"
+			case o.Benchmarks:
+				conflict = "Benchmarks"
+			case o.FuzzTests:
+				conflict = "FuzzTests"
+			case o.SQL:
+				conflict = "SQL"
+			}
+			if conflict != "" {
+				return fmt.Errorf(
+					"%v cannot be used with Minimal: %q is generated with Minimal",
+					conflict, m.ThriftPath)
+			}
+		}
+
+		if minimal {
+			return nil
+		}
+
+		for _, name := range sortStringKeys(m.Includes) {
+			inc := m.Includes[name].Module
+			incMinimal, err := isMinimalModule(inc, o)
+			if err != nil {
+				return err
+			}
+			if incMinimal {
+				return fmt.Errorf(
+					"code for %q must be generated with Minimal because it includes %q",
+					m.ThriftPath, inc.ThriftPath)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMinimal(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-minimal")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"main.thrift": `
+			include "./shared.thrift"
+			struct Request { 1: required shared.Item item }
+			service Store { void put(1: Request request) }
+		`,
+		"shared.thrift": `
+			namespace go shared (go.minimal = "false")
+			enum Kind { A, B }
+			struct Item { 1: required Kind kind }
+		`,
+	}
+	module := compileThriftFiles(t, thriftRoot, files, "main.thrift")
+
+	out := make(mapFileWriter)
+	require.NoError(t, Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+		Minimal:       true,
+		Writer:        out,
+	}))
+
+	main := string(out["main/main.go"])
+	assert.Contains(t, main, "func (v *Request) ToWire() (wire.Value, error)")
+	assert.Contains(t, main, "func (v *Request) FromWire(w wire.Value) error")
+	assert.Contains(t, main, "type Store_Put_Args struct")
+	for _, omitted := range []string{
+		"func (v *Request) String() string",
+		"func (v *Request) Equals(",
+		"func (v *Request) Decode(",
+		"MarshalLogObject",
+		"func (v *Store_Put_Args) MethodName() string",
+		"Store_Put_Helper",
+	} {
+		assert.NotContains(t, main, omitted)
+	}
+
+	shared := string(out["shared/shared.go"])
+	assert.Contains(t, shared, "func (v *Item) String() string",
+		"go.minimal must override Minimal")
+	assert.Contains(t, shared, "func (v Kind) MarshalJSON() ([]byte, error)",
+		"go.minimal must override Minimal")
+}
+
+func TestGenerateMinimalErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		files   map[string]string
+		options Options
+		wantErr string
+	}{
+		{
+			desc: "invalid annotation",
+			files: map[string]string{
+				"main.thrift": `namespace go main (go.minimal = "yes")`,
+			},
+			wantErr: `go.minimal of "main.thrift" must be "true" or "false", not "yes"`,
+		},
+		{
+			desc: "service stubs",
+			files: map[string]string{
+				"main.thrift": `struct Item {}`,
+			},
+			options: Options{Minimal: true, ServiceStubs: true},
+			wantErr: `ServiceStubs cannot be used with Minimal: "main.thrift" is generated with Minimal`,
+		},
+		{
+			desc: "sql with annotation",
+			files: map[string]string{
+				"main.thrift": `
+					namespace go main (go.minimal = "true")
+					typedef string Name
+				`,
+			},
+			options: Options{SQL: true},
+			wantErr: `SQL cannot be used with Minimal: "main.thrift" is generated with Minimal`,
+		},
+		{
+			desc: "included by a module which is not minimal",
+			files: map[string]string{
+				"main.thrift": `
+					include "./shared.thrift"
+					struct Request { 1: required shared.Item item }
+				`,
+				"shared.thrift": `
+					namespace go shared (go.minimal = "true")
+					struct Item {}
+				`,
+			},
+			wantErr: `code for "main.thrift" must be generated with Minimal because it includes "shared.thrift"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-minimal")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			module := compileThriftFiles(t, thriftRoot, tt.files, "main.thrift")
+
+			o := tt.options
+			o.OutputDir = filepath.Join(thriftRoot, "out")
+			o.PackagePrefix = "example.com/idl"
+			o.ThriftRoot = thriftRoot
+			o.Writer = make(mapFileWriter)
+			err = Generate(module, &o)
+			require.Error(t, err)
+			assert.Contains(t, strings.Replace(err.Error(), thriftRoot+"/", "", -1), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/goast"
+)
+
+// NameMapping records the Go identifier generated for a named entity in a
+// Thrift file.
+//
+// Most entities get the Go-style spelling of their Thrift name, or the name
+// given by their go.name annotation, and fail code generation if that
+// conflicts with another name. Only the parameters of service methods are
+// renamed: a parameter keeps its Thrift name unless that is a Go keyword, a
+// predeclared Go identifier like string or len, or the name of an earlier
+// parameter of the same function, in which case the smallest number
+// starting at 2 which makes it unique is appended to it. So, the arguments
+// "type", "string", and "type2" of a function become the parameters
+// "type2", "string2", and "type22".
+type NameMapping struct {
+	// Path to the Thrift file which declares the entity.
+	ThriftFile string
+
+	// Kind of entity: type, constant, enum item, field, service, function,
+	// or parameter.
+	Kind string
+
+	// Name of the entity qualified with the names of the entities that
+	// contain it, for example "KeyValue.setValue.key" for the argument key
+	// of the setValue function of the KeyValue service.
+	ThriftName string
+
+	// Go identifier generated for the entity.
+	GoName string
+
+	// Reason explains why GoName was changed to avoid a conflict. It's
+	// empty if the name wasn't changed.
+	Reason string
+}
+
+// Mangled returns true if the Go name was changed to avoid a conflict.
+func (n NameMapping) Mangled() bool {
+	return n.Reason != ""
+}
+
+func (n NameMapping) String() string {
+	s := fmt.Sprintf("%v: %v %v -> %v", filepath.Base(n.ThriftFile), n.Kind, n.ThriftName, n.GoName)
+	if n.Mangled() {
+		s += fmt.Sprintf(" (renamed: %v)", n.Reason)
+	}
+	return s
+}
+
+// GoNames lists the Go identifiers generated for the entities declared in
+// the given Thrift module, excluding the modules it includes, when its code
+// is generated with the given Casing.
+//
+// Types, constants, and services are listed in alphabetical order, followed
+// by their fields, enum items, functions, and parameters in the order in
+// which they are declared.
+func GoNames(m *compile.Module, casing Casing) ([]NameMapping, error) {
+	var names []NameMapping
+	add := func(kind, thriftName, goName, reason string) {
+		names = append(names, NameMapping{
+			ThriftFile: m.ThriftPath,
+			Kind:       kind,
+			ThriftName: thriftName,
+			GoName:     goName,
+			Reason:     reason,
+		})
+	}
+
+	for _, typeName := range sortStringKeys(m.Types) {
+		spec := m.Types[typeName]
+		name, err := casing.goName(spec)
+		if err != nil {
+			return nil, wrapGenerateError(typeName, err)
+		}
+		add("type", typeName, name, "")
+
+		switch s := spec.(type) {
+		case *compile.EnumSpec:
+			for i := range s.Items {
+				item := &s.Items[i]
+				itemName, err := casing.enumItemName(name, item)
+				if err != nil {
+					return nil, wrapGenerateError(typeName, err)
+				}
+				add("enum item", typeName+"."+item.Name, itemName, "")
+			}
+		case *compile.StructSpec:
+			for _, f := range s.Fields {
+				fieldName, err := casing.goName(f)
+				if err != nil {
+					return nil, wrapGenerateError(typeName, err)
+				}
+				add("field", typeName+"."+f.Name, fieldName, "")
+			}
+		}
+	}
+
+	for _, constName := range sortStringKeys(m.Constants) {
+		add("constant", constName, casing.constantName(constName), "")
+	}
+
+	for _, serviceName := range sortStringKeys(m.Services) {
+		s := m.Services[serviceName]
+		add("service", serviceName, casing.name(serviceName), "")
+		for _, funcName := range sortStringKeys(s.Functions) {
+			f := s.Functions[funcName]
+			qualifiedName := serviceName + "." + funcName
+			add("function", qualifiedName, casing.name(funcName), "")
+
+			for i, paramName := range goParamNames(f) {
+				arg := f.ArgsSpec[i]
+				add("parameter", qualifiedName+"."+arg.Name, paramName,
+					paramRenameReason(arg.Name, paramName))
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// writeNameReport writes the Go names of the entities in the given modules
+// to w, one per line, and fails if noMangle is set and any of them had to be
+// renamed.
+func writeNameReport(w io.Writer, modules []*compile.Module, casing Casing, noMangle bool) error {
+	for _, m := range modules {
+		names, err := GoNames(m, casing)
+		if err != nil {
+			return generateError{Name: m.ThriftPath, Reason: err}
+		}
+
+		for _, n := range names {
+			if w != nil {
+				if _, err := fmt.Fprintln(w, n); err != nil {
+					return err
+				}
+			}
+
+			if noMangle && n.Mangled() {
+				return generateError{
+					Name: m.ThriftPath,
+					Reason: fmt.Errorf(
+						"%v %v would be renamed to %q because %v",
+						n.Kind, n.ThriftName, n.GoName, n.Reason),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// newParamNamespace builds the namespace for the Go parameters of a service
+// function. It doesn't inherit the names declared by the package so that
+// the parameter names depend only on the function.
+func newParamNamespace() Namespace {
+	return &namespace{
+		taken:    make(map[string]struct{}),
+		gave:     make(map[string][]string),
+		reserved: goast.IsPredeclared,
+	}
+}
+
+// goParamNames returns the Go names of the parameters for the arguments of
+// the given function, in order.
+func goParamNames(f *compile.FunctionSpec) []string {
+	ns := newParamNamespace()
+	names := make([]string, len(f.ArgsSpec))
+	for i, arg := range f.ArgsSpec {
+		names[i] = ns.NewName(arg.Name)
+	}
+	return names
+}
+
+// paramRenameReason explains why the argument with the given Thrift name
+// was given a different Go name, or returns an empty string if it wasn't.
+func paramRenameReason(thriftName, goName string) string {
+	switch {
+	case thriftName == goName:
+		return ""
+	case goast.IsPredeclared(thriftName):
+		return fmt.Sprintf("%q is a predeclared Go identifier", thriftName)
+	case goast.IsReservedKeyword(thriftName):
+		return fmt.Sprintf("%q is a Go keyword", thriftName)
+	default:
+		return fmt.Sprintf("%q is used by another parameter", thriftName)
+	}
+}
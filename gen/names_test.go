@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileNamesThrift(t *testing.T, thriftRoot string) *compile.Module {
+	files := map[string]string{
+		"shared.thrift": `
+			struct Shared { 1: optional string value }
+		`,
+		"main.thrift": `
+			include "./shared.thrift"
+
+			const i32 max_items = 10
+
+			enum item_kind { FOO_BAR, baz (go.name = "Qux") }
+
+			struct item_info {
+				1: optional string user_id
+				2: optional item_kind kind (go.name = "Type")
+			}
+
+			service item_store {
+				void put_item(1: string type, 2: i64 len, 3: string type2, 4: string key)
+			}
+		`,
+	}
+	return compileThriftFiles(t, thriftRoot, files, "main.thrift")
+}
+
+func TestGoNames(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-go-names")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	module := compileNamesThrift(t, thriftRoot)
+	names, err := GoNames(module, GoCasing)
+	require.NoError(t, err)
+
+	var lines []string
+	for _, n := range names {
+		assert.Equal(t, module.ThriftPath, n.ThriftFile)
+		lines = append(lines, n.String())
+	}
+	assert.Equal(t, []string{
+		"main.thrift: type item_info -> ItemInfo",
+		"main.thrift: field item_info.user_id -> UserID",
+		"main.thrift: field item_info.kind -> Type",
+		"main.thrift: type item_kind -> ItemKind",
+		"main.thrift: enum item item_kind.FOO_BAR -> ItemKindFooBar",
+		"main.thrift: enum item item_kind.baz -> ItemKindQux",
+		"main.thrift: constant max_items -> MaxItems",
+		"main.thrift: service item_store -> ItemStore",
+		"main.thrift: function item_store.put_item -> PutItem",
+		`main.thrift: parameter item_store.put_item.type -> type2 (renamed: "type" is a Go keyword)`,
+		`main.thrift: parameter item_store.put_item.len -> len2 (renamed: "len" is a predeclared Go identifier)`,
+		`main.thrift: parameter item_store.put_item.type2 -> type22 (renamed: "type2" is used by another parameter)`,
+		"main.thrift: parameter item_store.put_item.key -> key",
+	}, lines)
+}
+
+func TestGenerateNameReport(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-name-report")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	module := compileNamesThrift(t, thriftRoot)
+
+	t.Run("report", func(t *testing.T) {
+		var report bytes.Buffer
+		err := Generate(module, &Options{
+			OutputDir:     filepath.Join(thriftRoot, "out"),
+			PackagePrefix: "example.com/idl",
+			ThriftRoot:    thriftRoot,
+			NameReport:    &report,
+		})
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(report.String()), "\n")
+		assert.Contains(t, lines, "shared.thrift: field Shared.value -> Value",
+			"names in included files must be reported")
+		assert.Contains(t, lines, `main.thrift: parameter item_store.put_item.type -> type2 (renamed: "type" is a Go keyword)`)
+	})
+
+	t.Run("no recurse", func(t *testing.T) {
+		var report bytes.Buffer
+		err := Generate(module, &Options{
+			OutputDir:     filepath.Join(thriftRoot, "out"),
+			PackagePrefix: "example.com/idl",
+			ThriftRoot:    thriftRoot,
+			NoRecurse:     true,
+			NameReport:    &report,
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, report.String(), "shared.thrift")
+	})
+
+	t.Run("no mangle", func(t *testing.T) {
+		err := Generate(module, &Options{
+			OutputDir:     filepath.Join(thriftRoot, "out"),
+			PackagePrefix: "example.com/idl",
+			ThriftRoot:    thriftRoot,
+			NoMangle:      true,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			`parameter item_store.put_item.type would be renamed to "type2" because "type" is a Go keyword`)
+	})
+}
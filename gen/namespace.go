@@ -68,6 +68,10 @@ type namespace struct {
 	// gave is a mapping from the name requested by the user to the names we
 	// returned
 	gave map[string][]string
+
+	// reserved, if non-nil, reports names other than Go keywords that may
+	// not be used in the root namespace and its children.
+	reserved func(string) bool
 }
 
 // NewNamespace creates a new namespace.
@@ -85,6 +89,9 @@ func (n *namespace) isTaken(name string) bool {
 	if n.parent != nil {
 		return n.parent.isTaken(name)
 	}
+	if n.reserved != nil && n.reserved(name) {
+		return true
+	}
 	return goast.IsReservedKeyword(name)
 }
 
@@ -33,7 +33,8 @@ type serviceName string
 type generateServiceBuilder struct {
 	api.GenerateServiceRequest
 
-	importer thriftPackageImporter
+	importer   thriftPackageImporter
+	typeMapper *typeMapper
 
 	nextModuleID  api.ModuleID
 	nextServiceID api.ServiceID
@@ -46,9 +47,15 @@ type generateServiceBuilder struct {
 
 	// To ensure there are no duplicates
 	rootServices map[api.ServiceID]struct{}
+
+	// Whether sets are slices unless annotated otherwise
+	sliceSets bool
+
+	// How Go names are derived from Thrift names
+	casing Casing
 }
 
-func newGenerateServiceBuilder(i thriftPackageImporter) *generateServiceBuilder {
+func newGenerateServiceBuilder(i thriftPackageImporter, tm *typeMapper) *generateServiceBuilder {
 	return &generateServiceBuilder{
 		GenerateServiceRequest: api.GenerateServiceRequest{
 			RootServices:  make([]api.ServiceID, 0, 10),
@@ -58,6 +65,7 @@ func newGenerateServiceBuilder(i thriftPackageImporter) *generateServiceBuilder
 			PackagePrefix: i.ImportPrefix,
 		},
 		importer:      i,
+		typeMapper:    tm,
 		nextModuleID:  1,
 		nextServiceID: 1,
 		moduleIDs:     make(map[string]api.ModuleID),
@@ -150,14 +158,19 @@ func (g *generateServiceBuilder) addService(spec *compile.ServiceSpec) (api.Serv
 		functions = append(functions, function)
 	}
 
-	g.Services[serviceID] = &api.Service{
+	service := &api.Service{
 		ThriftName:  spec.Name,
-		Name:        goCase(spec.Name),
+		Name:        g.casing.name(spec.Name),
 		ParentID:    parentID,
 		Functions:   functions,
 		ModuleID:    moduleID,
 		Annotations: spec.Annotations,
 	}
+	if spec.Doc != "" {
+		service.Doc = ptr.String(spec.Doc)
+	}
+
+	g.Services[serviceID] = service
 	return serviceID, nil
 }
 
@@ -168,7 +181,7 @@ func (g *generateServiceBuilder) buildFunction(spec *compile.FunctionSpec) (*api
 	}
 
 	function := &api.Function{
-		Name:        goCase(spec.Name),
+		Name:        g.casing.name(spec.Name),
 		ThriftName:  spec.Name,
 		Arguments:   args,
 		Annotations: spec.Annotations,
@@ -176,6 +189,12 @@ func (g *generateServiceBuilder) buildFunction(spec *compile.FunctionSpec) (*api
 	if spec.OneWay {
 		function.OneWay = ptr.Bool(spec.OneWay)
 	}
+	if spec.Streaming {
+		function.Streaming = ptr.Bool(spec.Streaming)
+	}
+	if spec.Doc != "" {
+		function.Doc = ptr.String(spec.Doc)
+	}
 
 	if spec.ResultSpec != nil {
 		var err error
@@ -205,19 +224,38 @@ func (g *generateServiceBuilder) buildFieldGroup(fs compile.FieldGroup) ([]*api.
 			return nil, err
 		}
 
-		name, err := goName(f)
+		// Arguments claimed by a TypeMapper use the custom Go type.
+		mapping, err := g.typeMapper.MapField(f)
+		if err != nil {
+			return nil, err
+		}
+		if mapping != nil {
+			t = mapping.Type
+			if !f.Required {
+				t = &api.Type{PointerType: t}
+			}
+		}
+
+		name, err := g.casing.goName(f)
 		if err != nil {
 			return nil, err
 		}
 		args = append(args, &api.Argument{
-			Name: name,
-			Type: t,
+			Name:        name,
+			Type:        t,
+			Annotations: f.Annotations,
 		})
 	}
 	return args, nil
 }
 
 func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool) (*api.Type, error) {
+	return buildType(g.importer, spec, required, g.sliceSets, g.casing)
+}
+
+// buildType builds a reference to the Go type used for the given TypeSpec.
+// Sets are slices unless they use maps as per setUsesMap.
+func buildType(importer ThriftPackageImporter, spec compile.TypeSpec, required, sliceSets bool, casing Casing) (*api.Type, error) {
 	simpleType := func(t api.SimpleType) *api.SimpleType { return &t }
 
 	// try primitives first since they have to be wrapped inside a pointer if
@@ -238,12 +276,20 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeFloat64)}
 	case *compile.StringSpec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeString)}
+	case *compile.BinarySpec:
+		if isStringBinary(s) {
+			t = &api.Type{SimpleType: simpleType(api.SimpleTypeString)}
+		}
 	case *compile.EnumSpec:
-		importPath, err := g.importer.Package(s.ThriftFile())
+		importPath, err := importer.Package(s.ThriftFile())
+		if err != nil {
+			return nil, err
+		}
+		name, err := casing.goName(s)
 		if err != nil {
 			return nil, err
 		}
-		name, err := goName(s)
+		items, err := buildEnumItems(casing, name, s)
 		if err != nil {
 			return nil, err
 		}
@@ -252,6 +298,7 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 				Name:        name,
 				ImportPath:  importPath,
 				Annotations: s.Annotations,
+				EnumItems:   items,
 			},
 		}
 	}
@@ -265,15 +312,18 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 
 	switch s := spec.(type) {
 	case *compile.BinarySpec:
+		if isReaderBinary(s) {
+			return &api.Type{ReferenceType: &api.TypeReference{Name: "Reader", ImportPath: "io"}}, nil
+		}
 		return &api.Type{SliceType: &api.Type{SimpleType: simpleType(api.SimpleTypeByte)}}, nil
 
 	case *compile.MapSpec:
-		k, err := g.buildType(s.KeySpec, true)
+		k, err := buildType(importer, s.KeySpec, true, sliceSets, casing)
 		if err != nil {
 			return nil, err
 		}
 
-		v, err := g.buildType(s.ValueSpec, true)
+		v, err := buildType(importer, s.ValueSpec, true, sliceSets, casing)
 		if err != nil {
 			return nil, err
 		}
@@ -285,7 +335,7 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		return &api.Type{MapType: &api.TypePair{Left: k, Right: v}}, nil
 
 	case *compile.ListSpec:
-		v, err := g.buildType(s.ValueSpec, true)
+		v, err := buildType(importer, s.ValueSpec, true, sliceSets, casing)
 		if err != nil {
 			return nil, err
 		}
@@ -293,12 +343,12 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		return &api.Type{SliceType: v}, nil
 
 	case *compile.SetSpec:
-		v, err := g.buildType(s.ValueSpec, true)
+		v, err := buildType(importer, s.ValueSpec, true, sliceSets, casing)
 		if err != nil {
 			return nil, err
 		}
 
-		if !isHashable(s.ValueSpec) {
+		if !setUsesMap(s, sliceSets) {
 			return &api.Type{SliceType: v}, nil
 		}
 
@@ -308,12 +358,12 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		}}, nil
 
 	case *compile.StructSpec:
-		importPath, err := g.importer.Package(s.ThriftFile())
+		importPath, err := importer.Package(s.ThriftFile())
 		if err != nil {
 			return nil, err
 		}
 
-		name, err := goName(s)
+		name, err := casing.goName(s)
 		if err != nil {
 			return nil, err
 		}
@@ -329,12 +379,12 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		}, nil
 
 	case *compile.TypedefSpec:
-		importPath, err := g.importer.Package(s.ThriftFile())
+		importPath, err := importer.Package(s.ThriftFile())
 		if err != nil {
 			return nil, err
 		}
 
-		name, err := goName(s)
+		name, err := casing.goName(s)
 		if err != nil {
 			return nil, err
 		}
@@ -357,3 +407,27 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		panic(fmt.Sprintf("Unknown type (%T) %v", spec, spec))
 	}
 }
+
+// buildEnumItems builds the plugin representation of the items of the
+// given enum, whose Go type has the given name.
+func buildEnumItems(casing Casing, enumName string, spec *compile.EnumSpec) ([]*api.EnumItem, error) {
+	if len(spec.Items) == 0 {
+		return nil, nil
+	}
+
+	items := make([]*api.EnumItem, len(spec.Items))
+	for i := range spec.Items {
+		item := &spec.Items[i]
+		name, err := casing.enumItemName(enumName, item)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = &api.EnumItem{
+			Name:        name,
+			ThriftName:  item.Name,
+			Value:       item.Value,
+			Annotations: item.Annotations,
+		}
+	}
+	return items, nil
+}
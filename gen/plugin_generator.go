@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+// NewPluginGenerator builds the Generator service that plugins may call
+// while generating code for the given module and the modules it includes.
+//
+// The PackagePrefix and ThriftRoot options determine the import paths of the
+// types it reports.
+func NewPluginGenerator(m *compile.Module, o *Options) api.Generator {
+	// Invalid go.package annotations are reported by Generate.
+	packages, _ := modulePackages(m, o)
+	g := pluginGenerator{
+		importer: thriftPackageImporter{
+			ImportPrefix: o.PackagePrefix,
+			ThriftRoot:   o.ThriftRoot,
+			Packages:     packages,
+		},
+		modules:   make(map[string]*compile.Module),
+		sliceSets: o.SliceSets,
+		casing:    o.Casing,
+	}
+	m.Walk(func(m *compile.Module) error {
+		g.modules[m.ThriftPath] = m
+		return nil
+	})
+	return g
+}
+
+// pluginGenerator implements the Generator service for plugins.
+type pluginGenerator struct {
+	importer thriftPackageImporter
+
+	// Modules indexed by the paths to their Thrift files.
+	modules map[string]*compile.Module
+
+	// Whether sets are slices unless annotated otherwise
+	sliceSets bool
+
+	// How Go names are derived from Thrift names
+	casing Casing
+}
+
+func (g pluginGenerator) ResolveType(req *api.ResolveTypeRequest) (*api.ResolveTypeResponse, error) {
+	m, ok := g.modules[req.ThriftFilePath]
+	if !ok {
+		return nil, fmt.Errorf("unknown Thrift file %q", req.ThriftFilePath)
+	}
+
+	spec, err := m.LookupType(req.Name)
+	if err != nil {
+		parts := strings.SplitN(req.Name, ".", 2)
+		inc, ok := m.Includes[parts[0]]
+		if len(parts) < 2 || !ok {
+			return nil, fmt.Errorf("unknown type %q in %q", req.Name, req.ThriftFilePath)
+		}
+
+		spec, err = inc.Module.LookupType(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("unknown type %q in %q", req.Name, req.ThriftFilePath)
+		}
+	}
+
+	t, err := buildType(g.importer, spec, true, g.sliceSets, g.casing)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ResolveTypeResponse{Type: t}, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginGeneratorResolveType(t *testing.T) {
+	thriftRoot := testdata(t, "thrift")
+	typedefs := testdata(t, "thrift", "typedefs.thrift")
+
+	module, err := compile.Compile(typedefs)
+	require.NoError(t, err)
+
+	g := NewPluginGenerator(module, &Options{
+		PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+		ThriftRoot:    thriftRoot,
+	})
+
+	tests := []struct {
+		desc    string
+		file    string
+		name    string
+		want    *api.Type
+		wantErr string
+	}{
+		{
+			desc: "local typedef",
+			file: typedefs,
+			name: "UUID",
+			want: &api.Type{PointerType: &api.Type{ReferenceType: &api.TypeReference{
+				Name:       "UUID",
+				ImportPath: "go.uber.org/thriftrw/gen/internal/tests/typedefs",
+			}}},
+		},
+		{
+			desc: "included struct",
+			file: typedefs,
+			name: "structs.Point",
+			want: &api.Type{PointerType: &api.Type{ReferenceType: &api.TypeReference{
+				Name:       "Point",
+				ImportPath: "go.uber.org/thriftrw/gen/internal/tests/structs",
+			}}},
+		},
+		{
+			desc: "type in an included file",
+			file: testdata(t, "thrift", "structs.thrift"),
+			name: "Frame",
+			want: &api.Type{PointerType: &api.Type{ReferenceType: &api.TypeReference{
+				Name:       "Frame",
+				ImportPath: "go.uber.org/thriftrw/gen/internal/tests/structs",
+			}}},
+		},
+		{
+			desc:    "unknown file",
+			file:    testdata(t, "thrift", "nope.thrift"),
+			name:    "Point",
+			wantErr: `unknown Thrift file "` + testdata(t, "thrift", "nope.thrift") + `"`,
+		},
+		{
+			desc:    "unknown type",
+			file:    typedefs,
+			name:    "Nope",
+			wantErr: `unknown type "Nope"`,
+		},
+		{
+			desc:    "unknown included type",
+			file:    typedefs,
+			name:    "structs.Nope",
+			wantErr: `unknown type "structs.Nope"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			res, err := g.ResolveType(&api.ResolveTypeRequest{
+				ThriftFilePath: tt.file,
+				Name:           tt.name,
+			})
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res.Type)
+		})
+	}
+}
@@ -154,6 +154,35 @@ func TestAddRootService(t *testing.T) {
 				ThriftRoot:    _testThriftRoot,
 			},
 		},
+		{
+			desc: "service with docs",
+			spec: &compile.ServiceSpec{
+				Name: "EmptyService",
+				File: "idl/empty.thrift",
+				Doc:  "EmptyService does nothing.",
+			},
+			want: &api.GenerateServiceRequest{
+				RootServices: []api.ServiceID{1},
+				Services: map[api.ServiceID]*api.Service{
+					1: {
+						Name:       "EmptyService",
+						ThriftName: "EmptyService",
+						Functions:  []*api.Function{}, // must be non-nil
+						ModuleID:   1,
+						Doc:        ptr.String("EmptyService does nothing."),
+					},
+				},
+				Modules: map[api.ModuleID]*api.Module{
+					1: {
+						ImportPath:     "go.uber.org/thriftrw/gen/internal/tests/empty",
+						Directory:      "empty",
+						ThriftFilePath: "idl/empty.thrift",
+					},
+				},
+				PackagePrefix: _testPackagePrefix,
+				ThriftRoot:    _testThriftRoot,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -168,7 +197,7 @@ func TestAddRootService(t *testing.T) {
 			ThriftRoot:   "idl",
 		}
 
-		g := newGenerateServiceBuilder(importer)
+		g := newGenerateServiceBuilder(importer, nil)
 
 		if spec.Parent != nil {
 			g.AddModule(spec.Parent.ThriftFile())
@@ -303,6 +332,37 @@ func TestBuildFunction(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "streaming",
+			spec: &compile.FunctionSpec{
+				Name:      "subscribe",
+				Streaming: true,
+				ResultSpec: &compile.ResultSpec{
+					ReturnType: &compile.BinarySpec{},
+				},
+			},
+			want: &api.Function{
+				Name:       "Subscribe",
+				ThriftName: "subscribe",
+				Arguments:  []*api.Argument{},
+				ReturnType: &api.Type{SliceType: &api.Type{SimpleType: simpleType(api.SimpleTypeByte)}},
+				Streaming:  ptr.Bool(true),
+			},
+		},
+		{
+			desc: "docs",
+			spec: &compile.FunctionSpec{
+				Name:       "foo",
+				ResultSpec: &compile.ResultSpec{},
+				Doc:        "foo does nothing.",
+			},
+			want: &api.Function{
+				Name:       "Foo",
+				ThriftName: "foo",
+				Arguments:  []*api.Argument{},
+				Doc:        ptr.String("foo does nothing."),
+			},
+		},
 		{
 			desc: "annotations",
 			spec: &compile.FunctionSpec{
@@ -322,6 +382,58 @@ func TestBuildFunction(t *testing.T) {
 					"private": "",
 				},
 			},
+		},		{
+			desc: "argument annotations",
+			spec: &compile.FunctionSpec{
+				Name: "setValue",
+				ArgsSpec: compile.ArgsSpec{
+					{
+						ID:          1,
+						Name:        "key",
+						Type:        &compile.StringSpec{},
+						Annotations: compile.Annotations{"length": "16"},
+					},
+				},
+				ResultSpec: &compile.ResultSpec{
+					Exceptions: compile.FieldGroup{
+						{
+							ID:   1,
+							Name: "error",
+							Type: &compile.StructSpec{
+								Name: "InternalError",
+								File: "idl/keyvalue.thrift",
+								Type: ast.ExceptionType,
+							},
+							Annotations: compile.Annotations{"retryable": ""},
+						},
+					},
+				},
+			},
+			want: &api.Function{
+				Name:       "SetValue",
+				ThriftName: "setValue",
+				Arguments: []*api.Argument{
+					{
+						Name:        "Key",
+						Type:        &api.Type{PointerType: &api.Type{SimpleType: simpleType(api.SimpleTypeString)}},
+						Annotations: map[string]string{"length": "16"},
+					},
+				},
+				Exceptions: []*api.Argument{
+					{
+						Name: "Error",
+						Type: &api.Type{
+							PointerType: &api.Type{
+								ReferenceType: &api.TypeReference{
+									Name:       "InternalError",
+									ImportPath: "go.uber.org/thriftrw/gen/internal/tests/keyvalue",
+								},
+							},
+						},
+						Annotations: map[string]string{"retryable": ""},
+					},
+				},
+			},
 		},
 	}
 
@@ -337,7 +449,7 @@ func TestBuildFunction(t *testing.T) {
 			ThriftRoot:   "idl",
 		}
 
-		g := newGenerateServiceBuilder(importer)
+		g := newGenerateServiceBuilder(importer, nil)
 		got, err := g.buildFunction(spec)
 		if assert.NoError(t, err, tt.desc) {
 			assert.Equal(t, tt.want, got, tt.desc)
@@ -546,6 +658,10 @@ func TestBuildType(t *testing.T) {
 				ReferenceType: &api.TypeReference{
 					Name:       "Foo",
 					ImportPath: "go.uber.org/thriftrw/gen/internal/tests/bar",
+					EnumItems: []*api.EnumItem{
+						{Name: "FooA", ThriftName: "A", Value: 0},
+						{Name: "FooB", ThriftName: "B", Value: 2},
+					},
 				},
 			},
 		},
@@ -565,6 +681,10 @@ func TestBuildType(t *testing.T) {
 					ReferenceType: &api.TypeReference{
 						Name:       "Foo",
 						ImportPath: "go.uber.org/thriftrw/gen/internal/tests/bar",
+						EnumItems: []*api.EnumItem{
+							{Name: "FooA", ThriftName: "A", Value: 0},
+							{Name: "FooB", ThriftName: "B", Value: 2},
+						},
 					},
 				},
 			},
@@ -576,7 +696,16 @@ func TestBuildType(t *testing.T) {
 				File: "idl/bar.thrift",
 				Items: []compile.EnumItem{
 					{Name: "A", Value: 0},
-					{Name: "B", Value: 2},
+					{
+						Name:        "B",
+						Value:       2,
+						Annotations: compile.Annotations{"deprecated": "Use A instead."},
+					},
+					{
+						Name:        "C",
+						Value:       3,
+						Annotations: compile.Annotations{"go.name": "Sea"},
+					},
 				},
 				Annotations: compile.Annotations{
 					"foo": "bar",
@@ -592,6 +721,21 @@ func TestBuildType(t *testing.T) {
 						"foo": "bar",
 						"baz": "",
 					},
+					EnumItems: []*api.EnumItem{
+						{Name: "FooA", ThriftName: "A", Value: 0},
+						{
+							Name:        "FooB",
+							ThriftName:  "B",
+							Value:       2,
+							Annotations: map[string]string{"deprecated": "Use A instead."},
+						},
+						{
+							Name:        "FooSea",
+							ThriftName:  "C",
+							Value:       3,
+							Annotations: map[string]string{"go.name": "Sea"},
+						},
+					},
 				},
 			},
 		},
@@ -764,7 +908,7 @@ func TestBuildType(t *testing.T) {
 			ThriftRoot:   "idl",
 		}
 
-		g := newGenerateServiceBuilder(importer)
+		g := newGenerateServiceBuilder(importer, nil)
 		got, err := g.buildType(spec, tt.required)
 		if assert.NoError(t, err, tt.desc) {
 			assert.Equal(t, tt.want, got, tt.desc)
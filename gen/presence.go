@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// PresenceLabel controls how structs keep track of which of their optional
+// fields are set. i.e.
+//
+// 	struct Sample {
+// 		1: required string name
+// 		2: optional i64 timestamp
+// 		3: optional double value
+// 	} (go.presence = "bitmap")
+//
+// By default, optional fields are pointers which are nil if the field is
+// unset. With "bitmap", optional fields of primitive types and enums are
+// stored by value instead, and whether they are set is recorded in a
+// bitmap. This avoids allocating a value for every such field that is set.
+//
+// Because a field's value says nothing about whether it is set, these
+// fields must be changed with their SetTimestamp and ClearTimestamp
+// methods, and read with GetTimestamp and IsSetTimestamp. Assigning to the
+// field directly does not mark it as set.
+const PresenceLabel = "go.presence"
+
+// maxPresenceFields is the number of fields that fit into the largest
+// presence bitmap.
+const maxPresenceFields = 64
+
+// presenceBits returns the bits of the presence bitmap of the given struct
+// for its optional fields which are stored by value, or nil if the struct
+// doesn't use PresenceLabel.
+func presenceBits(g Generator, spec *compile.StructSpec) (map[*compile.FieldSpec]uint, error) {
+	switch v := spec.Annotations[PresenceLabel]; v {
+	case "", "pointer":
+		return nil, nil
+	case "bitmap":
+		// ok
+	default:
+		return nil, fmt.Errorf(
+			"invalid %v on %q: expected \"bitmap\" or \"pointer\", got %q",
+			PresenceLabel, spec.Name, v)
+	}
+
+	if spec.Type == ast.UnionType {
+		return nil, fmt.Errorf("invalid %v on %q: unions cannot use presence bitmaps", PresenceLabel, spec.Name)
+	}
+
+	bits := make(map[*compile.FieldSpec]uint)
+	for _, f := range spec.Fields {
+		if f.Required || !isPrimitiveType(f.Type) {
+			continue
+		}
+
+		// Fields with custom Go types are always pointers.
+		if m, err := mappedField(g, f); err != nil {
+			return nil, err
+		} else if m != nil {
+			continue
+		}
+
+		if len(bits) == maxPresenceFields {
+			return nil, fmt.Errorf(
+				"invalid %v on %q: at most %d optional fields may use a presence bitmap",
+				PresenceLabel, spec.Name, maxPresenceFields)
+		}
+		bits[f] = uint(len(bits))
+	}
+	return bits, nil
+}
+
+// HasPresenceFields returns true if any fields of this group are stored by
+// value with a presence bitmap.
+func (f fieldGroupGenerator) HasPresenceFields() bool {
+	return len(f.PresenceBits) > 0
+}
+
+// PresenceType returns the smallest unsigned integer type which fits the
+// presence bitmap of this group.
+func (f fieldGroupGenerator) PresenceType() string {
+	switch n := len(f.PresenceBits); {
+	case n <= 8:
+		return "uint8"
+	case n <= 16:
+		return "uint16"
+	case n <= 32:
+		return "uint32"
+	default:
+		return "uint64"
+	}
+}
+
+// inBitmap returns true if the presence of the given field is recorded in
+// the presence bitmap.
+func (f fieldGroupGenerator) inBitmap(field *compile.FieldSpec) bool {
+	_, ok := f.PresenceBits[field]
+	return ok
+}
+
+// presenceMask returns an expression for the bit of the presence bitmap
+// which records whether the given field is set.
+func (f fieldGroupGenerator) presenceMask(field *compile.FieldSpec) string {
+	return fmt.Sprintf("1 << %d", f.PresenceBits[field])
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tp "go.uber.org/thriftrw/gen/internal/tests/presence"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestPresenceAccessors(t *testing.T) {
+	var v tp.Sample
+	assert.False(t, v.IsSetCount())
+	assert.Equal(t, int32(0), v.GetCount())
+	assert.Equal(t, tp.UnitSeconds, v.GetUnit(), "unset field must use its default")
+
+	v.SetCount(0)
+	assert.True(t, v.IsSetCount(), "zero value must be set")
+	assert.Equal(t, int32(0), v.GetCount())
+
+	v.SetCount(5)
+	assert.Equal(t, int32(5), v.GetCount())
+	assert.Equal(t, int32(5), v.Count)
+
+	v.ClearCount()
+	assert.False(t, v.IsSetCount())
+	assert.Equal(t, int32(0), v.Count)
+
+	// Assigning the field directly doesn't mark it as set.
+	v.Count = 7
+	assert.False(t, v.IsSetCount())
+	assert.Equal(t, int32(0), v.GetCount())
+
+	var nilSample *tp.Sample
+	assert.False(t, nilSample.IsSetCount())
+	assert.Equal(t, int32(0), nilSample.GetCount())
+}
+
+func TestPresenceEqualsAndClone(t *testing.T) {
+	x := &tp.Sample{Metric: "cpu"}
+	y := &tp.Sample{Metric: "cpu"}
+	assert.True(t, x.Equals(y))
+
+	x.SetCount(0)
+	assert.False(t, x.Equals(y), "set zero value must differ from unset field")
+
+	y.SetCount(0)
+	assert.True(t, x.Equals(y))
+
+	x.SetHost("example.com")
+	c := x.Clone()
+	assert.Equal(t, x, c)
+	assert.True(t, c.IsSetHost())
+
+	c.ClearHost()
+	assert.True(t, x.IsSetHost(), "clone must not share the presence bitmap")
+}
+
+func TestPresenceJSON(t *testing.T) {
+	x := &tp.Sample{Metric: "cpu"}
+	x.SetCount(0)
+	x.SetTimestamp(5)
+	x.SetID(12)
+
+	b, err := json.Marshal(x)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metric": "cpu", "timestamp": 5, "count": 0, "id": "12"}`, string(b))
+
+	var got tp.Sample
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, x, &got)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"count": null, "timestamp": null}`), &got))
+	assert.False(t, got.IsSetCount())
+	assert.False(t, got.IsSetTimestamp())
+	assert.True(t, got.IsSetID())
+	assert.Equal(t, "cpu", got.Metric)
+}
+
+func TestPresenceString(t *testing.T) {
+	x := &tp.Sample{Metric: "cpu"}
+	x.SetCount(0)
+	x.SetToken("secret")
+	assert.Equal(t, "Sample{Metric: cpu, Count: 0, Token: <redacted>}", x.String())
+}
+
+func TestPresenceValidate(t *testing.T) {
+	x := &tp.Sample{Metric: "cpu"}
+	x.Count = -1
+	assert.NoError(t, x.Validate(), "unset fields must not be validated")
+
+	x.SetCount(-1)
+	x.SetHost("EXAMPLE")
+	err := x.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "count")
+	assert.Contains(t, err.Error(), "host")
+}
+
+func TestPresenceConstants(t *testing.T) {
+	assert.Equal(t, "cpu", tp.DefaultSample.Metric)
+	assert.Equal(t, []string{"a"}, tp.DefaultSample.Tags)
+	assert.True(t, tp.DefaultSample.IsSetCount())
+	assert.Equal(t, int32(3), tp.DefaultSample.GetCount())
+	assert.Equal(t, tp.UnitBytes, tp.DefaultSample.GetUnit())
+	assert.False(t, tp.DefaultSample.IsSetTimestamp())
+
+	var s tp.Series
+	require.NoError(t, s.FromWire(wire.NewValueStruct(wire.Struct{})))
+	if assert.NotNil(t, s.First) {
+		assert.Equal(t, "mem", s.First.Metric)
+		assert.Equal(t, int64(10), s.First.GetTimestamp())
+		assert.True(t, s.First.IsSetTimestamp())
+	}
+}
+
+func TestPresenceErrors(t *testing.T) {
+	var many []string
+	for i := 1; i <= maxPresenceFields+1; i++ {
+		many = append(many, fmt.Sprintf("%d: optional i32 f%d", i, i))
+	}
+
+	tests := []struct {
+		desc    string
+		thrift  string
+		wantErr string
+	}{
+		{
+			desc:    "invalid value",
+			thrift:  `struct Foo { 1: optional i32 x } (go.presence = "bits")`,
+			wantErr: `invalid go.presence on "Foo": expected "bitmap" or "pointer", got "bits"`,
+		},
+		{
+			desc:    "union",
+			thrift:  `union Foo { 1: i32 x } (go.presence = "bitmap")`,
+			wantErr: `invalid go.presence on "Foo": unions cannot use presence bitmaps`,
+		},
+		{
+			desc:    "too many fields",
+			thrift:  `struct Foo {` + strings.Join(many, "\n") + `} (go.presence = "bitmap")`,
+			wantErr: `invalid go.presence on "Foo": at most 64 optional fields may use a presence bitmap`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-presence")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.thrift), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
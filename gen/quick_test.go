@@ -21,6 +21,7 @@
 package gen
 
 import (
+	"bytes"
 	"encoding"
 	"encoding/json"
 	"fmt"
@@ -33,6 +34,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tl "go.uber.org/thriftrw/gen/internal/tests/collision"
+	tco "go.uber.org/thriftrw/gen/internal/tests/compact"
 	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
 	tle "go.uber.org/thriftrw/gen/internal/tests/enum_conflict"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
@@ -40,11 +42,13 @@ import (
 	tz "go.uber.org/thriftrw/gen/internal/tests/nozap"
 	tf "go.uber.org/thriftrw/gen/internal/tests/services"
 	tss "go.uber.org/thriftrw/gen/internal/tests/set_to_slice"
+	tsl "go.uber.org/thriftrw/gen/internal/tests/slice_sets"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
 	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
 	tul "go.uber.org/thriftrw/gen/internal/tests/uuid_conflict"
 	envex "go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/zap/zapcore"
 )
@@ -70,8 +74,8 @@ func thriftTypeIsValid(v thriftType) bool {
 	return true
 }
 
-func defaultValueGenerator(typ reflect.Type) func(*testing.T, *rand.Rand) thriftType {
-	return func(t *testing.T, rand *rand.Rand) thriftType {
+func defaultValueGenerator(typ reflect.Type) func(require.TestingT, *rand.Rand) thriftType {
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
 		for {
 			// We will keep trying to generate a value until a valid one
 			// is found.
@@ -92,9 +96,9 @@ func defaultValueGenerator(typ reflect.Type) func(*testing.T, *rand.Rand) thrift
 
 // Version fo defaultValueGenerator that sets only one of the fields of a
 // union.
-func unionValueGenerator(sample interface{}) func(*testing.T, *rand.Rand) thriftType {
+func unionValueGenerator(sample interface{}) func(require.TestingT, *rand.Rand) thriftType {
 	typ := reflect.TypeOf(sample)
-	return func(t *testing.T, rand *rand.Rand) thriftType {
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
 		for {
 			// We will keep trying to generate a value until a valid one
 			// is found.
@@ -121,12 +125,63 @@ func unionValueGenerator(sample interface{}) func(*testing.T, *rand.Rand) thrift
 	}
 }
 
+// Version of defaultValueGenerator for types with sets represented as
+// slices. Duplicates are dropped from sets of hashable values when they're
+// decoded, so they're dropped from the generated values too.
+func sliceSetValueGenerator(sample interface{}) func(require.TestingT, *rand.Rand) thriftType {
+	gen := defaultValueGenerator(reflect.TypeOf(sample))
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
+		v := gen(t, rand)
+		dedupSlices(reflect.ValueOf(v))
+		return v
+	}
+}
+
+// dedupSlices drops duplicate items from all slices of comparable values
+// reachable from the given value.
+func dedupSlices(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			dedupSlices(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			dedupSlices(v.Field(i))
+		}
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return
+		}
+
+		switch v.Type().Elem().Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Struct:
+			for i := 0; i < v.Len(); i++ {
+				dedupSlices(v.Index(i))
+			}
+			return
+		}
+
+		seen := make(map[interface{}]struct{}, v.Len())
+		items := reflect.MakeSlice(v.Type(), 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			x := v.Index(i)
+			if _, dup := seen[x.Interface()]; dup {
+				continue
+			}
+			seen[x.Interface()] = struct{}{}
+			items = reflect.Append(items, x)
+		}
+		v.Set(items)
+	}
+}
+
 // enumValueGenerator builds a generator for random enum values given the
 // `*_Values` function for that enum.
-func enumValueGenerator(valuesFunc interface{}) func(*testing.T, *rand.Rand) thriftType {
+func enumValueGenerator(valuesFunc interface{}) func(require.TestingT, *rand.Rand) thriftType {
 	vfunc := reflect.ValueOf(valuesFunc)
 	typ := vfunc.Type().Out(0).Elem() // Foo_Values() []Foo -> Foo
-	return func(t *testing.T, rand *rand.Rand) thriftType {
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
 		knownValues := vfunc.Call(nil)[0]
 
 		var giveV reflect.Value
@@ -145,10 +200,10 @@ func enumValueGenerator(valuesFunc interface{}) func(*testing.T, *rand.Rand) thr
 	}
 }
 
-func keyValueSetValueArgsGenerator() func(*testing.T, *rand.Rand) thriftType {
+func keyValueSetValueArgsGenerator() func(require.TestingT, *rand.Rand) thriftType {
 	keyGenerator := defaultValueGenerator(reflect.TypeOf(tf.Key("")))
 	valueGenerator := unionValueGenerator(tu.ArbitraryValue{})
-	return func(t *testing.T, rand *rand.Rand) thriftType {
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
 		return &tf.KeyValue_SetValue_Args{
 			Key:   keyGenerator(t, rand).(*tf.Key),
 			Value: valueGenerator(t, rand).(*tu.ArbitraryValue),
@@ -156,10 +211,10 @@ func keyValueSetValueArgsGenerator() func(*testing.T, *rand.Rand) thriftType {
 	}
 }
 
-func keyValueSetValueV2ArgsGenerator() func(*testing.T, *rand.Rand) thriftType {
+func keyValueSetValueV2ArgsGenerator() func(require.TestingT, *rand.Rand) thriftType {
 	keyGenerator := defaultValueGenerator(reflect.TypeOf(tf.Key("")))
 	valueGenerator := unionValueGenerator(tu.ArbitraryValue{})
-	return func(t *testing.T, rand *rand.Rand) thriftType {
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
 		return &tf.KeyValue_SetValueV2_Args{
 			Key:   *keyGenerator(t, rand).(*tf.Key),
 			Value: valueGenerator(t, rand).(*tu.ArbitraryValue),
@@ -167,10 +222,10 @@ func keyValueSetValueV2ArgsGenerator() func(*testing.T, *rand.Rand) thriftType {
 	}
 }
 
-func keyValueGetValueResultGenerator() func(*testing.T, *rand.Rand) thriftType {
+func keyValueGetValueResultGenerator() func(require.TestingT, *rand.Rand) thriftType {
 	successGenerator := unionValueGenerator(tu.ArbitraryValue{})
 	doesNotExistGenerator := defaultValueGenerator(reflect.TypeOf(tx.DoesNotExistException{}))
-	return func(t *testing.T, rand *rand.Rand) thriftType {
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
 		var result tf.KeyValue_GetValue_Result
 		if rand.Int()%2 == 0 {
 			result.Success = successGenerator(t, rand).(*tu.ArbitraryValue)
@@ -181,9 +236,9 @@ func keyValueGetValueResultGenerator() func(*testing.T, *rand.Rand) thriftType {
 	}
 }
 
-func keyValueGetManyValuesResultGenerator() func(*testing.T, *rand.Rand) thriftType {
+func keyValueGetManyValuesResultGenerator() func(require.TestingT, *rand.Rand) thriftType {
 	arbitraryValueGenerator := unionValueGenerator(tu.ArbitraryValue{})
-	successGenerator := func(t *testing.T, rand *rand.Rand) []*tu.ArbitraryValue {
+	successGenerator := func(t require.TestingT, rand *rand.Rand) []*tu.ArbitraryValue {
 		values := make([]*tu.ArbitraryValue, rand.Intn(10))
 		for i := range values {
 			values[i] = arbitraryValueGenerator(t, rand).(*tu.ArbitraryValue)
@@ -191,7 +246,7 @@ func keyValueGetManyValuesResultGenerator() func(*testing.T, *rand.Rand) thriftT
 		return values
 	}
 	doesNotExistGenerator := defaultValueGenerator(reflect.TypeOf(tx.DoesNotExistException{}))
-	return func(t *testing.T, rand *rand.Rand) thriftType {
+	return func(t require.TestingT, rand *rand.Rand) thriftType {
 		var result tf.KeyValue_GetManyValues_Result
 		if rand.Int()%2 == 0 {
 			result.Success = successGenerator(t, rand)
@@ -241,7 +296,7 @@ func TestQuickSuite(t *testing.T) {
 
 		// Specifies how we generate valid values of this type. Defaults to
 		// defaultValueGenerator(Type) if unspecified.
-		Generator func(*testing.T, *rand.Rand) thriftType
+		Generator func(require.TestingT, *rand.Rand) thriftType
 
 		// If set, logging for this type will not be tested. This is needed
 		// for typedefs of primitives which can't implement ArrayMarshaler or
@@ -333,6 +388,19 @@ func TestQuickSuite(t *testing.T) {
 			Kind:      thriftStruct,
 		},
 		{Sample: tl.WithDefault{}, Kind: thriftStruct},
+		{Sample: tco.Drawing_Clear_Args{}, Kind: thriftStruct},
+		{Sample: tco.Drawing_Clear_Result{}, Kind: thriftStruct},
+		{Sample: tco.Drawing_Draw_Args{}, Kind: thriftStruct},
+		{Sample: tco.Drawing_Draw_Result{}, Kind: thriftStruct},
+		{
+			Sample:    tco.Geometry{},
+			Generator: unionValueGenerator(tco.Geometry{}),
+			Kind:      thriftStruct,
+		},
+		{Sample: tco.Point{}, Kind: thriftStruct},
+		{Sample: tco.Primitives{}, Kind: thriftStruct},
+		{Sample: tco.Shape{}, Kind: thriftStruct},
+		{Sample: tco.ShapeError{}, Kind: thriftStruct},
 		{Sample: tle.Records{}, Kind: thriftStruct},
 		{Sample: ts.ContactInfo{}, Kind: thriftStruct},
 		{Sample: ts.DefaultsStruct{}, Kind: thriftStruct},
@@ -341,6 +409,7 @@ func TestQuickSuite(t *testing.T) {
 		{Sample: ts.Frame{}, Kind: thriftStruct},
 		{Sample: ts.GoTags{}, Kind: thriftStruct},
 		{Sample: ts.Graph{}, Kind: thriftStruct},
+		{Sample: ts.JSONNames{}, Kind: thriftStruct},
 		{Sample: ts.Node{}, Kind: thriftStruct},
 		{Sample: ts.Omit{}, Kind: thriftStruct},
 		{Sample: ts.Point{}, Kind: thriftStruct},
@@ -350,8 +419,13 @@ func TestQuickSuite(t *testing.T) {
 		{Sample: ts.Rename{}, Kind: thriftStruct},
 		{Sample: ts.Size{}, Kind: thriftStruct},
 		{Sample: ts.StructLabels{}, Kind: thriftStruct},
+		{Sample: ts.UnsignedStruct{}, Kind: thriftStruct},
 		{Sample: ts.User{}, Kind: thriftStruct},
 		{Sample: ts.ZapOptOutStruct{}, Kind: thriftStruct},
+		{Sample: ts.ZapRedactStruct{}, Kind: thriftStruct},
+		{Sample: ts.ShallowCopyStruct{}, Kind: thriftStruct},
+		{Sample: ts.ValidatedAddress{}, Kind: thriftStruct},
+		{Sample: ts.ValidatedStruct{}, Kind: thriftStruct},
 		{
 			Sample:    tu.ArbitraryValue{},
 			Generator: unionValueGenerator(tu.ArbitraryValue{}),
@@ -370,16 +444,24 @@ func TestQuickSuite(t *testing.T) {
 		{Sample: tul.UUIDConflict{}, Kind: thriftStruct},
 		{Sample: tx.DoesNotExistException{}, Kind: thriftStruct},
 		{Sample: tx.EmptyException{}, Kind: thriftStruct},
+		{Sample: tx.RequestFailedException{}, Kind: thriftStruct},
 		{
 			Sample: tz.PrimitiveRequiredStruct{},
 			NoLog:  true,
 			Kind:   thriftStruct,
 		},
 		{
-			Sample: tss.Bar{},
-			NoLog:  true,
-			Kind:   thriftStruct,
+			Sample:    tss.Bar{},
+			Generator: sliceSetValueGenerator(tss.Bar{}),
+			NoLog:     true,
+			Kind:      thriftStruct,
+		},
+		{
+			Sample:    tsl.Sets{},
+			Generator: sliceSetValueGenerator(tsl.Sets{}),
+			Kind:      thriftStruct,
 		},
+		{Sample: tsl.Foo{}, Kind: thriftStruct},
 
 		// typedefs
 		{Sample: td.BinarySet{}, Kind: thriftTypedef},
@@ -392,17 +474,45 @@ func TestQuickSuite(t *testing.T) {
 		{Sample: td.StateMap{}, Kind: thriftTypedef},
 		{Sample: td.Timestamp(0), NoLog: true, Kind: thriftTypedef},
 		{Sample: td.UUID{}, Kind: thriftTypedef},
+		{Sample: tco.Points{}, Kind: thriftTypedef},
+		{Sample: tco.UUID(""), NoLog: true, Kind: thriftTypedef},
 		{Sample: tl.LittlePotatoe(0), NoLog: true, Kind: thriftTypedef},
 		{Sample: tl.LittlePotatoe2(0.0), NoLog: true, Kind: thriftTypedef},
 		{Sample: tul.UUID(""), NoLog: true, Kind: thriftTypedef},
 		{Sample: tz.StringMap{}, NoLog: true, Kind: thriftTypedef},
 		{Sample: tz.Primitives{}, NoLog: true, Kind: thriftTypedef},
 		{Sample: tz.StringList{}, NoLog: true, Kind: thriftTypedef},
-		{Sample: tss.StringList{}, Kind: thriftTypedef},
-		{Sample: tss.FooList{}, Kind: thriftTypedef},
-		{Sample: tss.MyStringList{}, Kind: thriftTypedef},
-		{Sample: tss.AnotherStringList{}, Kind: thriftTypedef},
-		{Sample: tss.StringListList{}, Kind: thriftTypedef},
+		{
+			Sample:    tss.StringList{},
+			Generator: sliceSetValueGenerator(tss.StringList{}),
+			Kind:      thriftTypedef,
+		},
+		{
+			Sample:    tss.FooList{},
+			Generator: sliceSetValueGenerator(tss.FooList{}),
+			Kind:      thriftTypedef,
+		},
+		{
+			Sample:    tss.MyStringList{},
+			Generator: sliceSetValueGenerator(tss.MyStringList{}),
+			Kind:      thriftTypedef,
+		},
+		{
+			Sample:    tss.AnotherStringList{},
+			Generator: sliceSetValueGenerator(tss.AnotherStringList{}),
+			Kind:      thriftTypedef,
+		},
+		{
+			Sample:    tss.StringListList{},
+			Generator: sliceSetValueGenerator(tss.StringListList{}),
+			Kind:      thriftTypedef,
+		},
+		{
+			Sample:    tsl.StringSet{},
+			Generator: sliceSetValueGenerator(tsl.StringSet{}),
+			Kind:      thriftTypedef,
+		},
+		{Sample: tsl.StringMapSet{}, Kind: thriftTypedef},
 
 		// enums
 		{
@@ -410,6 +520,16 @@ func TestQuickSuite(t *testing.T) {
 			Generator: enumValueGenerator(envex.ExceptionType_Values),
 			Kind:      thriftEnum,
 		},
+		{
+			Sample:    tco.Color(0),
+			Generator: enumValueGenerator(tco.Color_Values),
+			Kind:      thriftEnum,
+		},
+		{
+			Sample:    tsl.Color(0),
+			Generator: enumValueGenerator(tsl.Color_Values),
+			Kind:      thriftEnum,
+		},
 		{
 			Sample:    te.EmptyEnum(0),
 			Generator: enumValueGenerator(te.EmptyEnum_Values),
@@ -505,6 +625,12 @@ func TestQuickSuite(t *testing.T) {
 				}
 			})
 
+			t.Run("Streaming", func(t *testing.T) {
+				for _, give := range values {
+					suite.testStreamingRoundTrip(t, give)
+				}
+			})
+
 			t.Run("String", func(t *testing.T) {
 				for _, give := range values {
 					suite.testString(t, give)
@@ -556,6 +682,12 @@ func TestQuickSuite(t *testing.T) {
 					suite.testIsSetAccessorsOnNil(t)
 				})
 
+				t.Run("Clone", func(t *testing.T) {
+					for _, give := range values {
+						suite.testClone(t, give)
+					}
+				})
+
 			case thriftTypedef:
 				if isThriftPrimitive(typ) {
 					t.Run("Ptr", func(t *testing.T) {
@@ -563,6 +695,12 @@ func TestQuickSuite(t *testing.T) {
 							suite.testTypedefPrimitivePtr(t, give)
 						}
 					})
+				} else {
+					t.Run("Clone", func(t *testing.T) {
+						for _, give := range values {
+							suite.testClone(t, give)
+						}
+					})
 				}
 			}
 
@@ -657,6 +795,28 @@ func (q *quickSuite) testThriftRoundTrip(t *testing.T, give thriftType) {
 	assert.Equal(t, got, give)
 }
 
+// Tests that decoding the provided value directly from the byte stream
+// produces the same result as decoding it through wire.Value.
+func (q *quickSuite) testStreamingRoundTrip(t *testing.T, give thriftType) {
+	w, err := give.ToWire()
+	require.NoError(t, err, "failed to Thrift encode %v", give)
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(w, &buff), "failed to serialize %v", give)
+
+	decoded, err := protocol.Binary.Decode(bytes.NewReader(buff.Bytes()), w.Type())
+	require.NoError(t, err, "failed to deserialize %v", give)
+
+	want := q.newEmptyPtr().Interface().(thriftType)
+	require.NoError(t, want.FromWire(decoded), "failed to Thrift decode from %v", decoded)
+
+	got := q.newEmptyPtr().Interface().(thriftType)
+	sr := protocol.BinaryStreamer.Reader(bytes.NewReader(buff.Bytes()))
+	require.NoError(t, got.Decode(sr), "failed to stream decode from %v", w)
+
+	assert.Equal(t, want, got)
+}
+
 // Tests that String() works on any valid value of this type.
 func (q *quickSuite) testString(t *testing.T, give thriftType) {
 	assert.NotPanics(t, func() {
@@ -762,6 +922,23 @@ func (q *quickSuite) testEquals(t *testing.T, giveVal thriftType) {
 		"%v should be equal to itself", giveVal)
 }
 
+// Tests that v.Clone() always returns a value matching v.
+func (q *quickSuite) testClone(t *testing.T, giveVal thriftType) {
+	give := reflect.ValueOf(giveVal)
+
+	clone := give.MethodByName("Clone")
+	require.True(t, clone.IsValid(), "Type does not implement Clone()")
+
+	got := clone.Call(nil)[0]
+	if got.Type() != give.Type() {
+		// Clone was defined on the value-form but we were passing the
+		// object around by pointer.
+		give = give.Elem()
+	}
+
+	assert.Equal(t, give.Interface(), got.Interface(), "clone of %v does not match", giveVal)
+}
+
 // Tests that Equals methods work with nil values and receivers.
 func (q *quickSuite) testEqualsNil(t *testing.T) {
 	t.Run("both nil", func(t *testing.T) {
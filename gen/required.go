@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// checksRequiredField returns whether FromWire reports the given field if
+// it's missing. Required fields with default values are never missing.
+func checksRequiredField(f *compile.FieldSpec) bool {
+	return f.Required && f.Default == nil
+}
+
+// reportsMissingFields returns whether decoding a value of the given type
+// with FromWire may report required fields missing from it, or from values
+// nested inside it, as required.Errors.
+func reportsMissingFields(spec compile.TypeSpec) bool {
+	return reportsMissingFieldsIn(spec, make(map[compile.TypeSpec]struct{}))
+}
+
+func reportsMissingFieldsIn(spec compile.TypeSpec, seen map[compile.TypeSpec]struct{}) bool {
+	if _, ok := seen[spec]; ok {
+		return false
+	}
+	seen[spec] = struct{}{}
+
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		return reportsMissingFieldsIn(s.Target, seen)
+	case *compile.ListSpec:
+		return reportsMissingFieldsIn(s.ValueSpec, seen)
+	case *compile.SetSpec:
+		return reportsMissingFieldsIn(s.ValueSpec, seen)
+	case *compile.MapSpec:
+		return reportsMissingFieldsIn(s.KeySpec, seen) ||
+			reportsMissingFieldsIn(s.ValueSpec, seen)
+	case *compile.StructSpec:
+		for _, f := range s.Fields {
+			if checksRequiredField(f) || nestedMissingFields(f, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nestedMissingFields returns whether decoding the value of the given field
+// may report missing required fields. Lazy fields are decoded only when
+// they're accessed.
+func nestedMissingFields(f *compile.FieldSpec, seen map[compile.TypeSpec]struct{}) bool {
+	if lazy, _ := lazyField(f); lazy {
+		return false
+	}
+	return reportsMissingFieldsIn(f.Type, seen)
+}
+
+// reportsMissingField returns whether decoding the value of the given field
+// with FromWire may report missing required fields.
+func reportsMissingField(f *compile.FieldSpec) bool {
+	return nestedMissingFields(f, make(map[compile.TypeSpec]struct{}))
+}
+
+// ReportsMissingFields returns whether the FromWire method of this group
+// may report missing required fields.
+func (f fieldGroupGenerator) ReportsMissingFields() bool {
+	for _, field := range f.Fields {
+		if checksRequiredField(field) || reportsMissingField(field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tco "go.uber.org/thriftrw/gen/internal/tests/compact"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/required"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestFromWireMissingRequiredFields(t *testing.T) {
+	point := func(fields ...wire.Field) wire.Value {
+		return wire.NewValueStruct(wire.Struct{Fields: fields})
+	}
+	x := wire.Field{ID: 1, Value: wire.NewValueDouble(1)}
+	y := wire.Field{ID: 2, Value: wire.NewValueDouble(2)}
+
+	// The first edge is missing startPoint.x and the second is missing
+	// endPoint entirely.
+	give := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: point(y)},
+				{ID: 2, Value: point(x, y)},
+			}}),
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: point(x, y)},
+			}}),
+		}))},
+	}})
+	want := &ts.Graph{Edges: []*ts.Edge{
+		{StartPoint: &ts.Point{Y: 2}, EndPoint: &ts.Point{X: 1, Y: 2}},
+		{StartPoint: &ts.Point{X: 1, Y: 2}},
+	}}
+	wantMissing := required.Errors{
+		{Struct: "Point", Field: "X"},
+		{Struct: "Edge", Field: "EndPoint"},
+	}
+
+	t.Run("FromWire", func(t *testing.T) {
+		var got ts.Graph
+		err := got.FromWire(give)
+		assert.Equal(t, wantMissing, err)
+		assert.Equal(t, want, &got, "must decode all other fields")
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		var got ts.Graph
+		err := required.FromWire(&got, give, required.Strict)
+		assert.Equal(t, wantMissing[0], err)
+		assert.EqualError(t, err, "field X of Point is required")
+	})
+
+	t.Run("lenient", func(t *testing.T) {
+		var got ts.Graph
+		require.NoError(t, required.FromWire(&got, give, required.Lenient))
+		assert.Equal(t, want, &got)
+	})
+
+	t.Run("collect", func(t *testing.T) {
+		var got ts.Graph
+		err := required.FromWire(&got, give, required.Collect)
+		assert.Equal(t, wantMissing, err)
+		assert.EqualError(t, err, "missing required fields: "+
+			"field X of Point is required; field EndPoint of Edge is required")
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		var got tco.Geometry
+		err := required.FromWire(&got, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 3, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+				wire.NewValueStruct(wire.Struct{Fields: []wire.Field{{ID: 1, Value: point(x)}}}),
+				wire.NewValueStruct(wire.Struct{}),
+			}))},
+		}}), required.Lenient)
+		assert.EqualError(t, err, "Geometry should have exactly one field: got 0 fields")
+	})
+
+	t.Run("compact", func(t *testing.T) {
+		var got tco.Geometry
+		err := required.FromWire(&got, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 3, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+				wire.NewValueStruct(wire.Struct{Fields: []wire.Field{{ID: 1, Value: point(x)}}}),
+				wire.NewValueStruct(wire.Struct{Fields: []wire.Field{{ID: 1, Value: point(y)}}}),
+			}))},
+		}}), required.Collect)
+		assert.Equal(t, required.Errors{
+			{Struct: "Point", Field: "Y"},
+			{Struct: "Point", Field: "X"},
+		}, err)
+		require.Len(t, got.Collection, 2)
+		assert.Equal(t, &tco.Point{X: 1}, got.Collection[0].Point)
+		assert.Equal(t, &tco.Point{Y: 2}, got.Collection[1].Point)
+	})
+}
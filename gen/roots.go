@@ -0,0 +1,211 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// pruneModule returns a copy of the given module and the modules it includes
+// which declares only the types, constants, and services reachable from the
+// given roots.
+//
+// Roots are the names of types, constants, or services declared in the given
+// module. Those declared in included modules are qualified with the name of
+// the include.
+//
+// 	--roots KeyValue,shared.UUID
+func pruneModule(m *compile.Module, roots []string) (*compile.Module, error) {
+	r := reachability{
+		types:     make(map[compile.TypeSpec]struct{}),
+		constants: make(map[*compile.Constant]struct{}),
+		services:  make(map[*compile.ServiceSpec]struct{}),
+	}
+	for _, root := range roots {
+		if err := r.visitRoot(m, root); err != nil {
+			return nil, err
+		}
+	}
+	return r.prune(m, make(map[string]*compile.Module)), nil
+}
+
+// reachability tracks the entities reachable from the roots passed to
+// pruneModule.
+type reachability struct {
+	types     map[compile.TypeSpec]struct{}
+	constants map[*compile.Constant]struct{}
+	services  map[*compile.ServiceSpec]struct{}
+}
+
+// visitRoot marks the entity with the given, possibly include qualified,
+// name in the given module and everything it refers to as reachable.
+func (r *reachability) visitRoot(m *compile.Module, name string) error {
+	if t, ok := m.Types[name]; ok {
+		r.visitType(t)
+		return nil
+	}
+	if c, ok := m.Constants[name]; ok {
+		r.visitConstant(c)
+		return nil
+	}
+	if s, ok := m.Services[name]; ok {
+		r.visitService(s)
+		return nil
+	}
+
+	if parts := strings.SplitN(name, ".", 2); len(parts) == 2 {
+		if inc, ok := m.Includes[parts[0]]; ok {
+			return r.visitRoot(inc.Module, parts[1])
+		}
+	}
+	return fmt.Errorf("unknown root %q: %q does not declare a type, constant, or service with that name", name, m.ThriftPath)
+}
+
+func (r *reachability) visitType(spec compile.TypeSpec) {
+	if _, ok := r.types[spec]; ok {
+		return
+	}
+	r.types[spec] = struct{}{}
+
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		r.visitType(s.Target)
+	case *compile.StructSpec:
+		r.visitFields(s.Fields)
+	case *compile.MapSpec:
+		r.visitType(s.KeySpec)
+		r.visitType(s.ValueSpec)
+	case *compile.ListSpec:
+		r.visitType(s.ValueSpec)
+	case *compile.SetSpec:
+		r.visitType(s.ValueSpec)
+	}
+}
+
+func (r *reachability) visitFields(fields compile.FieldGroup) {
+	for _, f := range fields {
+		r.visitType(f.Type)
+		if f.Default != nil {
+			r.visitValue(f.Default)
+		}
+	}
+}
+
+func (r *reachability) visitConstant(c *compile.Constant) {
+	if _, ok := r.constants[c]; ok {
+		return
+	}
+	r.constants[c] = struct{}{}
+	r.visitType(c.Type)
+	r.visitValue(c.Value)
+}
+
+func (r *reachability) visitValue(v compile.ConstantValue) {
+	switch v := v.(type) {
+	case *compile.ConstantStruct:
+		for _, fv := range v.Fields {
+			r.visitValue(fv)
+		}
+	case compile.ConstantMap:
+		for _, pair := range v {
+			r.visitValue(pair.Key)
+			r.visitValue(pair.Value)
+		}
+	case compile.ConstantSet:
+		for _, iv := range v {
+			r.visitValue(iv)
+		}
+	case compile.ConstantList:
+		for _, iv := range v {
+			r.visitValue(iv)
+		}
+	case compile.ConstReference:
+		r.visitConstant(v.Target)
+	case compile.EnumItemReference:
+		r.visitType(v.Enum)
+	}
+}
+
+func (r *reachability) visitService(s *compile.ServiceSpec) {
+	if _, ok := r.services[s]; ok {
+		return
+	}
+	r.services[s] = struct{}{}
+
+	if s.Parent != nil {
+		r.visitService(s.Parent)
+	}
+	for _, f := range s.Functions {
+		r.visitFields(compile.FieldGroup(f.ArgsSpec))
+		if f.ResultSpec == nil {
+			continue
+		}
+		if f.ResultSpec.ReturnType != nil {
+			r.visitType(f.ResultSpec.ReturnType)
+		}
+		r.visitFields(f.ResultSpec.Exceptions)
+	}
+}
+
+// prune returns a copy of the given module and its includes without the
+// entities that aren't reachable. Copies are indexed by the paths to their
+// Thrift files.
+func (r *reachability) prune(m *compile.Module, copies map[string]*compile.Module) *compile.Module {
+	if c, ok := copies[m.ThriftPath]; ok {
+		return c
+	}
+
+	c := *m
+	copies[m.ThriftPath] = &c
+
+	c.Types = make(map[string]compile.TypeSpec)
+	for name, t := range m.Types {
+		if _, ok := r.types[t]; ok {
+			c.Types[name] = t
+		}
+	}
+
+	c.Constants = make(map[string]*compile.Constant)
+	for name, k := range m.Constants {
+		if _, ok := r.constants[k]; ok {
+			c.Constants[name] = k
+		}
+	}
+
+	c.Services = make(map[string]*compile.ServiceSpec)
+	for name, s := range m.Services {
+		if _, ok := r.services[s]; ok {
+			c.Services[name] = s
+		}
+	}
+
+	c.Includes = make(map[string]*compile.IncludedModule, len(m.Includes))
+	for name, inc := range m.Includes {
+		c.Includes[name] = &compile.IncludedModule{
+			Name:   inc.Name,
+			Module: r.prune(inc.Module, copies),
+		}
+	}
+	return &c
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileRootsThrift(t *testing.T, thriftRoot string) *compile.Module {
+	files := map[string]string{
+		"shared.thrift": `
+			typedef string UUID
+
+			struct Unused { 1: optional string value }
+		`,
+		"main.thrift": `
+			include "./shared.thrift"
+
+			const i32 defaultLimit = 10
+
+			const i32 unusedLimit = 20
+
+			enum Kind { FOO, BAR }
+
+			struct Item {
+				1: required shared.UUID id
+				2: optional Kind kind = Kind.FOO
+				3: optional i32 limit = defaultLimit
+			}
+
+			struct Unrelated { 1: optional string value }
+
+			exception NotFound {}
+
+			service Base {
+				void ping()
+			}
+
+			service Store extends Base {
+				list<Item> listItems(1: i32 limit) throws (1: NotFound notFound)
+			}
+
+			service Other {
+				Unrelated get()
+			}
+		`,
+	}
+	return compileThriftFiles(t, thriftRoot, files, "main.thrift")
+}
+
+func TestPruneModule(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-roots-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	module := compileRootsThrift(t, thriftRoot)
+
+	tests := []struct {
+		desc  string
+		roots []string
+
+		wantTypes          []string
+		wantConstants      []string
+		wantServices       []string
+		wantSharedTypes    []string
+		wantErrorSubstring string
+	}{
+		{
+			desc:            "service",
+			roots:           []string{"Store"},
+			wantTypes:       []string{"Item", "Kind", "NotFound"},
+			wantConstants:   []string{"defaultLimit"},
+			wantServices:    []string{"Base", "Store"},
+			wantSharedTypes: []string{"UUID"},
+		},
+		{
+			desc:         "struct and service",
+			roots:        []string{"Unrelated", "Base"},
+			wantTypes:    []string{"Unrelated"},
+			wantServices: []string{"Base"},
+		},
+		{
+			desc:          "constant",
+			roots:         []string{"unusedLimit"},
+			wantConstants: []string{"unusedLimit"},
+		},
+		{
+			desc:            "included type",
+			roots:           []string{"shared.Unused"},
+			wantSharedTypes: []string{"Unused"},
+		},
+		{
+			desc:               "unknown",
+			roots:              []string{"Store", "Missing"},
+			wantErrorSubstring: `unknown root "Missing"`,
+		},
+		{
+			desc:               "unknown in include",
+			roots:              []string{"shared.Missing"},
+			wantErrorSubstring: `unknown root "Missing"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			pruned, err := pruneModule(module, tt.roots)
+			if tt.wantErrorSubstring != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrorSubstring)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantTypes, nilIfEmpty(sortStringKeys(pruned.Types)), "types")
+			assert.Equal(t, tt.wantConstants, nilIfEmpty(sortStringKeys(pruned.Constants)), "constants")
+			assert.Equal(t, tt.wantServices, nilIfEmpty(sortStringKeys(pruned.Services)), "services")
+
+			shared := pruned.Includes["shared"].Module
+			assert.Equal(t, tt.wantSharedTypes, nilIfEmpty(sortStringKeys(shared.Types)), "shared types")
+		})
+	}
+
+	// The original module is left untouched.
+	assert.Len(t, module.Types, 4)
+	assert.Len(t, module.Services, 3)
+}
+
+func nilIfEmpty(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
+func TestGenerateWithRoots(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-roots-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	module := compileRootsThrift(t, thriftRoot)
+
+	w := make(mapFileWriter)
+	require.NoError(t, Generate(module, &Options{
+		OutputDir:     thriftRoot,
+		PackagePrefix: "example.com/roots",
+		ThriftRoot:    thriftRoot,
+		Roots:         []string{"Store"},
+		Writer:        w,
+	}))
+
+	main := string(w["main/main.go"])
+	assert.Contains(t, main, "type Item struct")
+	assert.Contains(t, main, "type Store_ListItems_Args struct")
+	assert.NotContains(t, main, "type Unrelated struct")
+	assert.NotContains(t, main, "type Other_Get_Args struct")
+
+	shared := string(w["shared/shared.go"])
+	assert.Contains(t, shared, "type UUID string")
+	assert.NotContains(t, shared, "type Unused struct")
+}
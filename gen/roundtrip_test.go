@@ -33,9 +33,15 @@ import (
 )
 
 // assertRoundTrip checks if x.ToWire() results in the given Value and whether
-// x.FromWire() with the given value results in the original x.
+// x.FromWire() and x.Decode() with the given value result in the original x.
 func assertRoundTrip(t *testing.T, x thriftType, v wire.Value, msg string, args ...interface{}) bool {
 	message := fmt.Sprintf(msg, args...)
+
+	var encoded bytes.Buffer
+	if !assert.NoError(t, protocol.Binary.Encode(v, &encoded), "%v: failed to serialize", message) {
+		return false
+	}
+
 	if w, err := x.ToWire(); assert.NoError(t, err, "failed to serialize: %v", x) {
 		if !assert.True(
 			t, wire.ValuesAreEqual(v, w), "%v: %v.ToWire() != %v", message, x, v) {
@@ -69,8 +75,16 @@ func assertRoundTrip(t *testing.T, x thriftType, v wire.Value, msg string, args
 	}
 
 	gotX := reflect.New(xType).Interface().(thriftType)
-	if assert.NoError(t, gotX.FromWire(v), "FromWire: %v", message) {
-		return assert.Equal(t, x, gotX, "FromWire: %v", message)
+	if !assert.NoError(t, gotX.FromWire(v), "FromWire: %v", message) ||
+		!assert.Equal(t, x, gotX, "FromWire: %v", message) {
+		return false
+	}
+
+	// Decode must produce the same value as FromWire.
+	gotX = reflect.New(xType).Interface().(thriftType)
+	sr := protocol.BinaryStreamer.Reader(bytes.NewReader(encoded.Bytes()))
+	if assert.NoError(t, gotX.Decode(sr), "Decode: %v", message) {
+		return assert.Equal(t, x, gotX, "Decode: %v", message)
 	}
 	return false
 }
@@ -71,33 +71,102 @@ func Services(g Generator, services map[string]*compile.ServiceSpec) error {
 					s.Name, functionName, err)
 			}
 		}
+
+		if err := serviceErrors(g, s); err != nil {
+			return wrapGenerateError(s.Name, err)
+		}
 	}
 
 	return nil
 }
 
+// serviceErrors generates a map from the names of the exceptions which may
+// be thrown by functions of the given service, including inherited
+// functions, to constructors for them.
+func serviceErrors(g Generator, s *compile.ServiceSpec) error {
+	var exceptions []*compile.StructSpec
+	seen := make(map[string]*compile.StructSpec)
+	for svc := s; svc != nil; svc = svc.Parent {
+		for _, functionName := range sortStringKeys(svc.Functions) {
+			f := svc.Functions[functionName]
+			if f.ResultSpec == nil {
+				continue
+			}
+
+			for _, e := range f.ResultSpec.Exceptions {
+				spec := e.Type.(*compile.StructSpec)
+				if other, ok := seen[spec.Name]; ok {
+					if other != spec {
+						return fmt.Errorf(
+							"exceptions named %q are defined in both %q and %q",
+							spec.Name, other.ThriftFile(), spec.ThriftFile())
+					}
+					continue
+				}
+				seen[spec.Name] = spec
+				exceptions = append(exceptions, spec)
+			}
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		// <.Service.Name>_Errors maps the names of exceptions thrown by functions
+		// of the <.Service.Name> service to functions which build empty values of
+		// them. The names are those returned by ErrorName.
+		//
+		//   if newErr, ok := <.Service.Name>_Errors[name]; ok {
+		//     err := newErr()
+		//     ...
+		//   }
+		var <.Service.Name>_Errors = map[string]func() error{
+			<range .Exceptions ->
+				"<.Name>": func() error { return new(<typeName .>) },
+			<end>
+		}
+		`,
+		struct {
+			Service    *compile.ServiceSpec
+			Exceptions []*compile.StructSpec
+		}{Service: s, Exceptions: exceptions},
+	)
+}
+
 // ServiceFunction generates code for the given function of the given service.
 func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) error {
-	argsName := functionNamePrefix(s, f) + "Args"
-	argsGen := fieldGroupGenerator{
-		Namespace: NewNamespace(),
-		Name:      argsName,
-		Fields:    compile.FieldGroup(f.ArgsSpec),
-		Doc: fmt.Sprintf(
-			"%v represents the arguments for the %v.%v function.\n\n"+
-				"The arguments for %v are sent and received over the wire as this struct.",
-			argsName, s.Name, f.Name, f.Name,
-		),
+	argsName := functionNamePrefix(g, s, f) + "Args"
+	argsDoc := fmt.Sprintf("%v represents the arguments for the %v.%v function.", argsName, s.Name, f.Name)
+	if f.Doc != "" {
+		argsDoc += "\n\n" + f.Doc
 	}
-	if err := argsGen.Generate(g); err != nil {
+	argsDoc += fmt.Sprintf("\n\nThe arguments for %v are sent and received over the wire as this struct.", f.Name)
+
+	if err := verifyNoLazyArgs(f); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
 	}
-	if err := functionArgsEnveloper(g, s, f); err != nil {
+
+	argsGen := fieldGroupGenerator{
+		Namespace:  NewNamespace(),
+		Name:       argsName,
+		ThriftName: f.Name + "_args",
+		Fields:     compile.FieldGroup(f.ArgsSpec),
+		Doc:        argsDoc,
+	}
+	if err := argsGen.Generate(g); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
 	}
 
-	if err := functionHelper(g, s, f); err != nil {
-		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+	// With Minimal, the arguments and results are generated without the
+	// envelope and helper functions.
+	minimal := checkMinimal(g)
+	if !minimal {
+		if err := functionArgsEnveloper(g, s, f); err != nil {
+			return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+		}
+
+		if err := functionHelper(g, s, f); err != nil {
+			return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+		}
 	}
 
 	if f.ResultSpec == nil {
@@ -115,7 +184,7 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 	}
 	resultFields = append(resultFields, f.ResultSpec.Exceptions...)
 
-	resultName := functionNamePrefix(s, f) + "Result"
+	resultName := functionNamePrefix(g, s, f) + "Result"
 	resultDoc := fmt.Sprintf(
 		"%v represents the result of a %v.%v function call.\n\n"+
 			"The result of a %v execution is sent and received over the wire as this struct.",
@@ -128,6 +197,7 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 	resultGen := fieldGroupGenerator{
 		Namespace:       NewNamespace(),
 		Name:            resultName,
+		ThriftName:      f.Name + "_result",
 		Fields:          resultFields,
 		IsUnion:         true,
 		AllowEmptyUnion: f.ResultSpec.ReturnType == nil,
@@ -136,8 +206,10 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 	if err := resultGen.Generate(g); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
 	}
-	if err := functionResponseEnveloper(g, s, f); err != nil {
-		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+	if !minimal {
+		if err := functionResponseEnveloper(g, s, f); err != nil {
+			return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+		}
 	}
 
 	// TODO(abg): If we receive unknown exceptions over the wire, we need to
@@ -149,14 +221,10 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 func functionParams(g Generator, f *compile.FunctionSpec) (string, error) {
 	return g.TextTemplate(
 		`
-		<- $params := newNamespace ->
+		<- $params := newParamNamespace ->
 		<- range .ArgsSpec>
-			<- if .Required>
-				<$params.NewName .Name> <typeReference .Type>,
-			<- else>
-				<$params.NewName .Name> <typeReferencePtr .Type>,
-			<- end ->
-		<end>
+			<$params.NewName .Name> <fieldTypeReference .>,
+		<- end>
 		`, f)
 }
 
@@ -287,15 +355,11 @@ func functionNewArgs(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 		`
 		<- $f := .Function ->
 		<- $prefix := namePrefix .Service $f ->
-		<- $params := newNamespace ->
+		<- $params := newParamNamespace ->
 		func(
 			<- range $f.ArgsSpec>
-				<- if .Required>
-					<$params.NewName .Name> <typeReference .Type>,
-				<- else>
-					<$params.NewName .Name> <typeReferencePtr .Type>,
-				<- end ->
-			<end>
+				<$params.NewName .Name> <fieldTypeReference .>,
+			<- end>
 		) *<$prefix>Args {
 			return &<$prefix>Args{
 			<range $f.ArgsSpec>
@@ -477,6 +541,7 @@ func functionResponseEnveloper(g Generator, s *compile.ServiceSpec, f *compile.F
 
 }
 
-func functionNamePrefix(s *compile.ServiceSpec, f *compile.FunctionSpec) string {
-	return fmt.Sprintf("%s_%s_", goCase(s.Name), goCase(f.Name))
+func functionNamePrefix(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) string {
+	c := checkCasing(g)
+	return fmt.Sprintf("%s_%s_", c.name(s.Name), c.name(f.Name))
 }
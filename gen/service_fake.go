@@ -0,0 +1,270 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// ServiceFakes generates fake implementations of the client and server
+// stubs of the given service for use in tests.
+//
+// For a service Foo, this generates a Client implementing FooClient and a
+// Server implementing FooServer, built with NewClient and NewServer. Calls
+// made to the fakes are recorded and may be inspected with Calls. Each call
+// is answered by the next function passed to the Expect method of the
+// function called, e.g. ExpectBar for Bar. Unexpected calls fail the test
+// and Finish fails the test if any expected calls were not made.
+//
+// The fakes are intended to be generated into their own package, separate
+// from the package holding the stubs generated by ServiceStubs.
+func ServiceFakes(g Generator, s *compile.ServiceSpec) error {
+	functions, err := fakeFunctions(g, s)
+	if err != nil {
+		return err
+	}
+
+	if err := g.DeclareFromTemplate(
+		`
+		<$fmt := import "fmt">
+		<$sync := import "sync">
+
+		// TestingT is the subset of testing.T used by the fakes in this
+		// package.
+		type TestingT interface {
+			Errorf(format string, args ...interface{})
+		}
+
+		// Call is a call made to a fake.
+		type Call struct {
+			// Method is the name of the Thrift function that was called.
+			Method string
+
+			// Args holds the arguments of the call as a pointer to the Args
+			// struct generated for the function.
+			Args interface{}
+		}
+
+		// fake records calls and holds expectations for the Client and Server
+		// fakes.
+		type fake struct {
+			t TestingT
+
+			mu       <$sync>.Mutex
+			calls    []Call
+			expected map[string][]interface{}
+		}
+
+		func newFake(t TestingT) *fake {
+			return &fake{t: t, expected: make(map[string][]interface{})}
+		}
+
+		func (f *fake) expect(method string, h interface{}) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			f.expected[method] = append(f.expected[method], h)
+		}
+
+		// call records a call to the given method and returns the function
+		// which answers it.
+		func (f *fake) call(method string, args interface{}) (interface{}, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			f.calls = append(f.calls, Call{Method: method, Args: args})
+			hs := f.expected[method]
+			if len(hs) == 0 {
+				err := <$fmt>.Errorf("unexpected call to <.Name>.%v", method)
+				f.t.Errorf("%v", err)
+				return nil, err
+			}
+
+			f.expected[method] = hs[1:]
+			return hs[0], nil
+		}
+
+		// Calls returns the calls made so far, in the order in which they
+		// were made.
+		func (f *fake) Calls() []Call {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			return append([]Call(nil), f.calls...)
+		}
+
+		// Finish fails the test if any of the expected calls were not made.
+		func (f *fake) Finish() {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			methods := make([]string, 0, len(f.expected))
+			for method := range f.expected {
+				methods = append(methods, method)
+			}
+			<import "sort">.Strings(methods)
+
+			for _, method := range methods {
+				if n := len(f.expected[method]); n > 0 {
+					f.t.Errorf("missing %d expected call(s) to <.Name>.%v", n, method)
+				}
+			}
+		}
+		`, s); err != nil {
+		return err
+	}
+
+	for _, server := range []bool{false, true} {
+		if err := serviceFake(g, s, functions, server); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fakeFunction is a function of a service, or one of its parents, for which
+// a fake method is generated.
+type fakeFunction struct {
+	// Service which declares the function.
+	Service  *compile.ServiceSpec
+	Function *compile.FunctionSpec
+}
+
+// fakeFunctions returns the functions of the given service and all its
+// parents, verifying that the methods generated for them don't conflict.
+func fakeFunctions(g Generator, s *compile.ServiceSpec) ([]fakeFunction, error) {
+	casing := checkCasing(g)
+	var functions []fakeFunction
+	names := make(map[string]struct{})
+	for ; s != nil; s = s.Parent {
+		for _, functionName := range sortStringKeys(s.Functions) {
+			f := s.Functions[functionName]
+			functions = append(functions, fakeFunction{Service: s, Function: f})
+			names[casing.name(f.Name)] = struct{}{}
+		}
+	}
+
+	for _, f := range functions {
+		name := casing.name(f.Function.Name)
+		if name == "Calls" || name == "Finish" {
+			return nil, fmt.Errorf(
+				"cannot generate fakes for %v: %q is a reserved ThriftRW identifier",
+				f.Service.Name, name)
+		}
+		if _, ok := names["Expect"+name]; ok {
+			return nil, fmt.Errorf(
+				"cannot generate fakes for %v: Expect%v for function %q conflicts with function %q",
+				f.Service.Name, name, f.Function.Name, "Expect"+name)
+		}
+	}
+	return functions, nil
+}
+
+// serviceFake generates the Client or Server fake of the given service.
+func serviceFake(g Generator, s *compile.ServiceSpec, functions []fakeFunction, server bool) error {
+	fake := "Client"
+	if server {
+		fake = "Server"
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$Fake := .Fake>
+		<$Interface := printf "%s%s" (goCase .Service.Name) .Fake>
+		<$server := .Server>
+
+		// <$Fake> is a fake <lookupService .Service $Interface> for use in tests.
+		//
+		// Calls to a function are answered by the functions passed to its
+		// Expect method, in the order in which they were passed. Calls which
+		// were not expected fail the test and return an error.
+		type <$Fake> struct{ *fake }
+
+		var _ <lookupService .Service $Interface> = (*<$Fake>)(nil)
+
+		// New<$Fake> builds a new fake <$Fake> which reports failures to t.
+		func New<$Fake>(t TestingT) *<$Fake> {
+			return &<$Fake>{fake: newFake(t)}
+		}
+
+		<range .Functions>
+			<$f := .Function>
+			<$prefix := namePrefix .Service $f>
+			<$ns := newParamNamespace>
+			<range $f.ArgsSpec><$arg := $ns.NewName .Name><end>
+			<$locals := $ns.Child>
+			<$c := $locals.NewName "c">
+			<$ctx := $locals.NewName "ctx">
+			<$serverStream := and $server $f.Streaming>
+			<$funcType := printf "func(%s) %s" (stubParams .Service $f $server) (stubResults .Service $f $server)>
+
+			// <goCase $f.Name> records a call to <$f.Name> and answers it with the next
+			// function passed to Expect<goCase $f.Name>.
+			func (<$c> *<$Fake>) <goCase $f.Name>(<$ctx> <import "context">.Context
+				<- range $f.ArgsSpec>, <$ns.Rotate .Name> <fieldTypeReference .><end>
+				<- $stream := $locals.NewName "stream" ->
+				<- if $serverStream>, <$stream> <lookupService .Service (printf "%sServerStream" $prefix)><end>) (
+				<- $success := $locals.NewName "success" ->
+				<- $err := $locals.NewName "err" ->
+				<- if and $f.Streaming (not $server) ->
+					<$stream> <lookupService .Service (printf "%sClientStream" $prefix)>,
+				<- else if not (or $f.OneWay $f.Streaming) ->
+					<- with $f.ResultSpec.ReturnType ->
+						<$success> <typeReference .>,
+					<- end>
+				<- end>
+				<- $err> error) {
+				<- $h := $locals.NewName "h">
+				var <$h> interface{}
+				<$h>, <$err> = <$c>.call("<$f.MethodName>", <lookupService .Service (printf "%sHelper" $prefix)>.Args(
+					<- range $i, $arg := $f.ArgsSpec>
+						<- if $i>, <end><$ns.Rotate $arg.Name>
+					<- end>))
+				if <$err> != nil {
+					return
+				}
+
+				return <$h>.(<$funcType>)(<$ctx>
+					<- range $f.ArgsSpec>, <$ns.Rotate .Name><end>
+					<- if $serverStream>, <$stream><end>)
+			}
+
+			// Expect<goCase $f.Name> expects a call to <goCase $f.Name> which will be answered by
+			// calling h.
+			func (<$c> *<$Fake>) Expect<goCase $f.Name>(h <$funcType>) {
+				<$c>.expect("<$f.MethodName>", h)
+			}
+		<end>
+		`,
+		struct {
+			Service   *compile.ServiceSpec
+			Functions []fakeFunction
+			Server    bool
+			Fake      string
+		}{Service: s, Functions: functions, Server: server, Fake: fake},
+		TemplateFunc("lookupService", Generator.LookupServiceName),
+		TemplateFunc("namePrefix", functionNamePrefix),
+		TemplateFunc("stubParams", stubParams),
+		TemplateFunc("stubResults", stubResults),
+	)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/stubs"
+	"go.uber.org/thriftrw/gen/internal/tests/stubs/storetest"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/rpc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingT is a storetest.TestingT which records failures.
+type recordingT struct{ errors []string }
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestServiceFakesClient(t *testing.T) {
+	var rt recordingT
+	client := storetest.NewClient(&rt)
+	ctx := context.Background()
+
+	foo := &ts.Item{Key: "foo"}
+	client.ExpectGet(func(ctx context.Context, key ts.Key) (*ts.Item, error) {
+		assert.Equal(t, ts.Key("foo"), key)
+		return foo, nil
+	})
+	client.ExpectGet(func(ctx context.Context, key ts.Key) (*ts.Item, error) {
+		return nil, errors.New("great sadness")
+	})
+	client.ExpectForget(func(ctx context.Context, key *ts.Key) error {
+		return nil
+	})
+	client.ExpectPut(func(ctx context.Context, key *ts.Key, item *ts.Item, body *int64) error {
+		return nil
+	})
+
+	item, err := client.Get(ctx, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, foo, item)
+
+	_, err = client.Get(ctx, "bar")
+	assert.EqualError(t, err, "great sadness")
+
+	require.NoError(t, client.Forget(ctx, (*ts.Key)(ptr.String("baz"))))
+	assert.Empty(t, rt.errors)
+
+	_, err = client.Healthy(ctx)
+	assert.EqualError(t, err, "unexpected call to Store.healthy")
+	assert.Equal(t, []string{"unexpected call to Store.healthy"}, rt.errors)
+
+	assert.Equal(t, []storetest.Call{
+		{Method: "get", Args: &ts.ReadOnlyStore_Get_Args{Key: "foo"}},
+		{Method: "get", Args: &ts.ReadOnlyStore_Get_Args{Key: "bar"}},
+		{Method: "forget", Args: &ts.Store_Forget_Args{Key: (*ts.Key)(ptr.String("baz"))}},
+		{Method: "healthy", Args: &ts.ReadOnlyStore_Healthy_Args{}},
+	}, client.Calls())
+
+	rt.errors = nil
+	client.Finish()
+	assert.Equal(t, []string{"missing 1 expected call(s) to Store.put"}, rt.errors)
+}
+
+func TestServiceFakesServer(t *testing.T) {
+	var rt recordingT
+	fake := storetest.NewServer(&rt)
+	server := rpc.NewServer(protocol.Binary, ts.NewStoreHandler(fake))
+	client := ts.NewStoreClient(rpc.NewClient(protocol.Binary, serverTransport(server)))
+	ctx := context.Background()
+
+	fake.ExpectHealthy(func(ctx context.Context) (bool, error) {
+		return true, nil
+	})
+	fake.ExpectScan(func(ctx context.Context, prefix *ts.Key, stream ts.ReadOnlyStore_Scan_ServerStream) error {
+		return stream.Send(&ts.Item{Key: ts.Key(*prefix)})
+	})
+
+	healthy, err := client.Healthy(ctx)
+	require.NoError(t, err)
+	assert.True(t, healthy)
+
+	stream, err := client.Scan(ctx, (*ts.Key)(ptr.String("foo")))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	item, err := stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, &ts.Item{Key: "foo"}, item)
+
+	_, err = stream.Next()
+	assert.Equal(t, io.EOF, err)
+
+	fake.Finish()
+	assert.Empty(t, rt.errors)
+	assert.Len(t, fake.Calls(), 2)
+}
+
+func TestServiceTestsRequiresStubs(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-service-tests")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	module, err := compile.Compile("internal/tests/thrift/stubs.thrift")
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		ServiceTests:  true,
+	})
+	assert.EqualError(t, err, "ServiceTests requires ServiceStubs")
+}
+
+func TestServiceFakesConflicts(t *testing.T) {
+	tests := []struct {
+		desc      string
+		functions []string
+		wantError string
+	}{
+		{
+			desc:      "reserved name",
+			functions: []string{"calls"},
+			wantError: `cannot generate fakes for Foo: "Calls" is a reserved ThriftRW identifier`,
+		},
+		{
+			desc:      "expect method",
+			functions: []string{"bar", "expectBar"},
+			wantError: `cannot generate fakes for Foo: ExpectBar for function "bar" conflicts with function "ExpectBar"`,
+		},
+		{
+			desc:      "no conflict",
+			functions: []string{"bar", "expect"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			s := &compile.ServiceSpec{
+				Name:      "Foo",
+				Functions: make(map[string]*compile.FunctionSpec),
+			}
+			for _, name := range tt.functions {
+				s.Functions[name] = &compile.FunctionSpec{Name: name}
+			}
+
+			_, err := fakeFunctions(nil, s)
+			if tt.wantError != "" {
+				assert.EqualError(t, err, tt.wantError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/rest"
+)
+
+// Annotations on service functions which expose them over HTTP with the
+// handlers generated by ServiceHTTPHandlers.
+//
+// 	User getUser(1: required string id, 2: optional bool verbose)
+// 		throws (1: NotFound notFound (http.status = "404"))
+// 		(http.method = "GET", http.path = "/users/{id}", http.query = "verbose")
+const (
+	httpMethodAnnotation = "http.method"
+	httpPathAnnotation   = "http.path"
+	httpQueryAnnotation  = "http.query"
+	httpBodyAnnotation   = "http.body"
+	httpStatusAnnotation = "http.status"
+)
+
+// httpMethods are the HTTP methods allowed in http.method annotations,
+// mapped to whether requests with them may have a body.
+var httpMethods = map[string]bool{
+	"GET":    false,
+	"DELETE": false,
+	"POST":   true,
+	"PUT":    true,
+	"PATCH":  true,
+}
+
+// httpRoute is a function exposed over HTTP.
+type httpRoute struct {
+	Function *compile.FunctionSpec
+	Method   string
+	Path     string
+
+	// Arguments decoded from the path and the query string.
+	PathArgs  compile.FieldGroup
+	QueryArgs compile.FieldGroup
+
+	// Argument decoded from the body. If BodyArgs is true, the body holds
+	// all arguments that aren't in PathArgs or QueryArgs instead.
+	BodyArg  *compile.FieldSpec
+	BodyArgs bool
+
+	Exceptions []httpException
+}
+
+// httpException is an exception thrown by a function exposed over HTTP,
+// and the status with which it's answered.
+type httpException struct {
+	Field  *compile.FieldSpec
+	Status int
+}
+
+// ServiceHTTPHandlers generates net/http handlers for all the given
+// services and stores the code in the generator to be written.
+//
+// For each service Foo with functions annotated with http.method and
+// http.path, this generates NewFooHTTPHandler which serves a FooServer over
+// HTTP with the rest package. Functions inherited by Foo are not served by
+// its handler. The handlers use the stubs generated by ServiceStubs.
+func ServiceHTTPHandlers(g Generator, services map[string]*compile.ServiceSpec) error {
+	for _, serviceName := range sortStringKeys(services) {
+		s := services[serviceName]
+		routes, err := httpRoutes(s)
+		if err != nil {
+			return err
+		}
+		if len(routes) == 0 {
+			continue
+		}
+		if err := serviceHTTPHandler(g, s, routes); err != nil {
+			return fmt.Errorf("could not generate HTTP handler for %s: %v", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// httpRoutes returns the routes of the functions of the given service
+// annotated with http.method and http.path, ordered so that routes with
+// literal path segments come before those with parameters in their place.
+func httpRoutes(s *compile.ServiceSpec) ([]*httpRoute, error) {
+	var routes []*httpRoute
+	for _, functionName := range sortStringKeys(s.Functions) {
+		f := s.Functions[functionName]
+		r, err := httpRouteFor(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP annotations on %s.%s: %v", s.Name, f.Name, err)
+		}
+		if r != nil {
+			routes = append(routes, r)
+		}
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return httpPathLess(routes[i].Path, routes[j].Path)
+	})
+
+	seen := make(map[string]string, len(routes))
+	for _, r := range routes {
+		key := r.Method + " " + strings.Join(httpPathPattern(r.Path), "/")
+		if other, ok := seen[key]; ok {
+			return nil, fmt.Errorf(
+				"%s.%s and %s.%s are both served by %v %v",
+				s.Name, other, s.Name, r.Function.Name, r.Method, r.Path)
+		}
+		seen[key] = r.Function.Name
+	}
+	return routes, nil
+}
+
+// httpRouteFor returns the route of the given function, or nil if it isn't
+// exposed over HTTP.
+func httpRouteFor(f *compile.FunctionSpec) (*httpRoute, error) {
+	method, hasMethod := f.Annotations[httpMethodAnnotation]
+	path, hasPath := f.Annotations[httpPathAnnotation]
+	switch {
+	case !hasMethod && !hasPath:
+		for _, a := range []string{httpQueryAnnotation, httpBodyAnnotation} {
+			if _, ok := f.Annotations[a]; ok {
+				return nil, fmt.Errorf("%v requires %v and %v", a, httpMethodAnnotation, httpPathAnnotation)
+			}
+		}
+		return nil, nil
+	case !hasMethod:
+		return nil, fmt.Errorf("%v requires %v", httpPathAnnotation, httpMethodAnnotation)
+	case !hasPath:
+		return nil, fmt.Errorf("%v requires %v", httpMethodAnnotation, httpPathAnnotation)
+	case f.Streaming:
+		return nil, fmt.Errorf("streaming functions cannot be served over HTTP")
+	}
+
+	method = strings.ToUpper(method)
+	acceptsBody, ok := httpMethods[method]
+	if !ok {
+		return nil, fmt.Errorf("unsupported %v %q", httpMethodAnnotation, method)
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("%v %q must start with '/'", httpPathAnnotation, path)
+	}
+
+	r := &httpRoute{Function: f, Method: method, Path: path}
+	args := make(map[string]*compile.FieldSpec, len(f.ArgsSpec))
+	for _, arg := range f.ArgsSpec {
+		args[arg.Name] = arg
+	}
+
+	bound := make(map[string]string) // argument name -> where it's read from
+	bind := func(name, source string, textOnly bool) (*compile.FieldSpec, error) {
+		arg, ok := args[name]
+		if !ok {
+			return nil, fmt.Errorf("%v refers to unknown argument %q", source, name)
+		}
+		if other, ok := bound[name]; ok {
+			return nil, fmt.Errorf("argument %q is read from both %v and %v", name, other, source)
+		}
+		if textOnly && !isHTTPTextType(arg.Type, source == httpQueryAnnotation) {
+			return nil, fmt.Errorf(
+				"argument %q of type %v cannot be read from %v", name, arg.Type.ThriftName(), source)
+		}
+		bound[name] = source
+		return arg, nil
+	}
+
+	for _, name := range rest.PathParams(path) {
+		arg, err := bind(name, httpPathAnnotation, true)
+		if err != nil {
+			return nil, err
+		}
+		r.PathArgs = append(r.PathArgs, arg)
+	}
+
+	if query, ok := f.Annotations[httpQueryAnnotation]; ok {
+		for _, name := range strings.Split(query, ",") {
+			arg, err := bind(strings.TrimSpace(name), httpQueryAnnotation, true)
+			if err != nil {
+				return nil, err
+			}
+			r.QueryArgs = append(r.QueryArgs, arg)
+		}
+	}
+
+	if body, ok := f.Annotations[httpBodyAnnotation]; ok {
+		if !acceptsBody {
+			return nil, fmt.Errorf("%v requests cannot have a body", method)
+		}
+		if body == "*" {
+			r.BodyArgs = true
+		} else {
+			arg, err := bind(body, httpBodyAnnotation, false)
+			if err != nil {
+				return nil, err
+			}
+			r.BodyArg = arg
+		}
+	}
+
+	if !r.BodyArgs {
+		for _, arg := range f.ArgsSpec {
+			if _, ok := bound[arg.Name]; !ok {
+				return nil, fmt.Errorf(
+					"argument %q is not read from %v, %v, or %v",
+					arg.Name, httpPathAnnotation, httpQueryAnnotation, httpBodyAnnotation)
+			}
+		}
+	}
+
+	if f.ResultSpec != nil {
+		for _, exc := range f.ResultSpec.Exceptions {
+			status := 400
+			if s, ok := exc.Annotations[httpStatusAnnotation]; ok {
+				var err error
+				status, err = strconv.Atoi(s)
+				if err != nil || status < 100 || status > 599 {
+					return nil, fmt.Errorf(
+						"invalid %v %q on exception %q", httpStatusAnnotation, s, exc.Name)
+				}
+			}
+			r.Exceptions = append(r.Exceptions, httpException{Field: exc, Status: status})
+		}
+	}
+
+	return r, nil
+}
+
+// isHTTPTextType returns true if values of the given type can be read from
+// text in a path or, if list is true, in a query string, where lists are
+// represented by repeated parameters.
+func isHTTPTextType(spec compile.TypeSpec, list bool) bool {
+	spec = compile.RootTypeSpec(spec)
+	if l, ok := spec.(*compile.ListSpec); ok && list {
+		return isHTTPTextType(l.ValueSpec, false)
+	}
+	if _, ok := spec.(*compile.BinarySpec); ok {
+		return true
+	}
+	return isPrimitiveType(spec)
+}
+
+// httpPathPattern returns the segments of the given path with the names of
+// parameters removed, so that paths which match the same requests have the
+// same pattern.
+func httpPathPattern(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			segments[i] = "{}"
+		}
+	}
+	return segments
+}
+
+// httpPathLess returns true if the route with the path a must be matched
+// before the route with the path b because it's more specific.
+func httpPathLess(a, b string) bool {
+	as, bs := httpPathPattern(a), httpPathPattern(b)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if (as[i] == "{}") != (bs[i] == "{}") {
+			return bs[i] == "{}"
+		}
+	}
+	return false
+}
+
+func serviceHTTPHandler(g Generator, s *compile.ServiceSpec, routes []*httpRoute) error {
+	return g.DeclareFromTemplate(
+		`
+		<$rest := import "go.uber.org/thriftrw/rest">
+		<$context := import "context">
+
+		<$service := .Service>
+		<$name := goCase $service.Name>
+
+		// New<$name>HTTPHandler builds an http.Handler which serves the
+		// functions of the <$service.Name> service annotated with http.method
+		// and http.path with the given <$name>Server.
+		//
+		// See go.uber.org/thriftrw/rest for how requests and responses are
+		// encoded.
+		func New<$name>HTTPHandler(impl <$name>Server) <import "net/http">.Handler {
+			return <$rest>.NewHandler(
+			<- range .Routes>
+				<- $prefix := namePrefix $service .Function>
+				<$rest>.Route{
+					Method: "<.Method>",
+					Path: <printf "%q" .Path>,
+					Handle: func(ctx <$context>.Context, r *<$rest>.Request) (interface{}, error) {
+						<- if .Function.ArgsSpec>
+							var args <$prefix>Args
+						<- if .BodyArgs>
+							if err := r.Body(&args); err != nil {
+								return nil, err
+							}
+						<- else if .BodyArg>
+							if err := r.Body(&args.<goName .BodyArg>); err != nil {
+								return nil, err
+							}
+						<- end>
+						<- range .PathArgs>
+							if err := r.Path(<printf "%q" .Name>, &args.<goName .>); err != nil {
+								return nil, err
+							}
+						<- end>
+						<- range .QueryArgs>
+							if err := r.Query(<printf "%q" .Name>, &args.<goName .>); err != nil {
+								return nil, err
+							}
+						<- end>
+
+						<end>
+						<- if .Function.OneWay ->
+							return nil, impl.<goCase .Function.Name>(ctx,
+								<- range .Function.ArgsSpec> args.<goName .>,<end>)
+						<- else ->
+							result, err := <$prefix>Helper.WrapResponse(
+								impl.<goCase .Function.Name>(ctx,
+									<- range .Function.ArgsSpec> args.<goName .>,<end>),
+							)
+							if err != nil {
+								return nil, err
+							}
+							<- range .Exceptions>
+								if result.<goName .Field> != nil {
+									return nil, &<$rest>.Error{Status: <.Status>, Body: result}
+								}
+							<- end>
+							<if .Function.ResultSpec.ReturnType ->
+								return result.Success, nil
+							<- else ->
+								return nil, nil
+							<- end>
+						<- end>
+					},
+				},
+			<- end>
+			)
+		}
+		`,
+		struct {
+			Service *compile.ServiceSpec
+			Routes  []*httpRoute
+		}{Service: s, Routes: routes},
+		TemplateFunc("namePrefix", functionNamePrefix),
+	)
+}
@@ -0,0 +1,328 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	th "go.uber.org/thriftrw/gen/internal/tests/http_handlers"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUsers is an in-memory implementation of the Users service.
+type fakeUsers struct {
+	users   map[string]*th.User
+	touched []string
+}
+
+var _ th.UsersServer = (*fakeUsers)(nil)
+
+func (u *fakeUsers) GetUser(ctx context.Context, id string, verbose *bool) (*th.User, error) {
+	user, ok := u.users[id]
+	if !ok {
+		return nil, &th.NotFound{ID: ptr.String(id)}
+	}
+	if verbose == nil || !*verbose {
+		return &th.User{ID: user.ID}, nil
+	}
+	return user, nil
+}
+
+func (u *fakeUsers) GetSelf(ctx context.Context) (*th.User, error) {
+	return &th.User{ID: "self"}, nil
+}
+
+func (u *fakeUsers) ListUsers(ctx context.Context, role *th.Role, ids []string, limit *int32) ([]*th.User, error) {
+	users := make([]*th.User, 0, len(ids))
+	for _, id := range ids {
+		user, ok := u.users[id]
+		if !ok || (role != nil && user.GetRole() != *role) {
+			continue
+		}
+		if limit != nil && int32(len(users)) >= *limit {
+			break
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (u *fakeUsers) PutUser(ctx context.Context, id string, user *th.User) error {
+	if id == "root" {
+		return &th.Forbidden{Reason: ptr.String("root is read-only")}
+	}
+	user.ID = id
+	u.users[id] = user
+	return nil
+}
+
+func (u *fakeUsers) RenameUser(ctx context.Context, id string, name *string, role *th.Role) (*th.User, error) {
+	user, ok := u.users[id]
+	if !ok {
+		return nil, errors.New("great sadness")
+	}
+	user.Name = name
+	if role != nil {
+		user.Role = role
+	}
+	return user, nil
+}
+
+func (u *fakeUsers) TouchUser(ctx context.Context, id string) error {
+	u.touched = append(u.touched, id)
+	return nil
+}
+
+func (u *fakeUsers) CountUsers(ctx context.Context) (int32, error) {
+	return int32(len(u.users)), nil
+}
+
+func TestServiceHTTPHandler(t *testing.T) {
+	users := &fakeUsers{users: map[string]*th.User{
+		"alice": {ID: "alice", Name: ptr.String("Alice"), Role: th.RoleAdmin.Ptr()},
+		"bob":   {ID: "bob", Name: ptr.String("Bob"), Role: th.RoleUser.Ptr()},
+	}}
+	h := th.NewUsersHTTPHandler(users)
+
+	tests := []struct {
+		desc       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			desc:       "path",
+			method:     "GET",
+			target:     "/users/alice",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"alice"}`,
+		},
+		{
+			desc:       "path and query",
+			method:     "GET",
+			target:     "/users/alice?verbose=true",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"alice","name":"Alice","role":"ADMIN"}`,
+		},
+		{
+			desc:       "literal path before parameter",
+			method:     "GET",
+			target:     "/users/self",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"self"}`,
+		},
+		{
+			desc:       "exception with status",
+			method:     "GET",
+			target:     "/users/carol",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"notFound":{"id":"carol"}}`,
+		},
+		{
+			desc:       "repeated query parameters and enums",
+			method:     "GET",
+			target:     "/users?ids=alice&ids=bob&role=USER",
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"id":"bob","name":"Bob","role":"USER"}]`,
+		},
+		{
+			desc:       "invalid query parameter",
+			method:     "GET",
+			target:     "/users?limit=ten",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `invalid query parameter "limit": strconv.ParseInt: parsing "ten": invalid syntax`,
+		},
+		{
+			desc:       "body argument",
+			method:     "PUT",
+			target:     "/users/carol",
+			body:       `{"id": "", "name": "Carol"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			desc:       "exception with custom status",
+			method:     "PUT",
+			target:     "/users/root",
+			body:       `{"id": ""}`,
+			wantStatus: http.StatusForbidden,
+			wantBody:   `{"forbidden":{"reason":"root is read-only"}}`,
+		},
+		{
+			desc:       "whole body",
+			method:     "PATCH",
+			target:     "/users/bob",
+			body:       `{"name": "Robert", "role": "ADMIN"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"bob","name":"Robert","role":"ADMIN"}`,
+		},
+		{
+			desc:       "undeclared error",
+			method:     "PATCH",
+			target:     "/users/dave",
+			body:       `{"name": "Dave"}`,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "great sadness",
+		},
+		{
+			desc:       "oneway",
+			method:     "POST",
+			target:     "/users/alice/touch",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantBody, strings.TrimSpace(w.Body.String()))
+		})
+	}
+
+	assert.Equal(t, "Carol", users.users["carol"].GetName())
+	assert.Equal(t, []string{"alice"}, users.touched)
+}
+
+func TestServiceHTTPHandlerErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		thrift  string
+		wantErr string
+	}{
+		{
+			desc:    "missing path",
+			thrift:  `service Foo { void bar() (http.method = "GET") }`,
+			wantErr: "invalid HTTP annotations on Foo.bar: http.method requires http.path",
+		},
+		{
+			desc:    "query without route",
+			thrift:  `service Foo { void bar(1: string x) (http.query = "x") }`,
+			wantErr: "invalid HTTP annotations on Foo.bar: http.query requires http.method and http.path",
+		},
+		{
+			desc:    "unsupported method",
+			thrift:  `service Foo { void bar() (http.method = "TRACE", http.path = "/bar") }`,
+			wantErr: `invalid HTTP annotations on Foo.bar: unsupported http.method "TRACE"`,
+		},
+		{
+			desc:    "relative path",
+			thrift:  `service Foo { void bar() (http.method = "GET", http.path = "bar") }`,
+			wantErr: `invalid HTTP annotations on Foo.bar: http.path "bar" must start with '/'`,
+		},
+		{
+			desc:    "unknown path parameter",
+			thrift:  `service Foo { void bar() (http.method = "GET", http.path = "/bar/{id}") }`,
+			wantErr: `invalid HTTP annotations on Foo.bar: http.path refers to unknown argument "id"`,
+		},
+		{
+			desc: "argument read twice",
+			thrift: `service Foo {
+				void bar(1: string id) (http.method = "GET", http.path = "/bar/{id}", http.query = "id")
+			}`,
+			wantErr: `invalid HTTP annotations on Foo.bar: argument "id" is read from both http.path and http.query`,
+		},
+		{
+			desc: "struct in path",
+			thrift: `struct Id { 1: optional string value }
+			service Foo {
+				void bar(1: Id id) (http.method = "GET", http.path = "/bar/{id}")
+			}`,
+			wantErr: `invalid HTTP annotations on Foo.bar: argument "id" of type Id cannot be read from http.path`,
+		},
+		{
+			desc: "unbound argument",
+			thrift: `service Foo {
+				void bar(1: string id) (http.method = "POST", http.path = "/bar")
+			}`,
+			wantErr: `invalid HTTP annotations on Foo.bar: argument "id" is not read from http.path, http.query, or http.body`,
+		},
+		{
+			desc: "body with GET",
+			thrift: `service Foo {
+				void bar(1: string id) (http.method = "GET", http.path = "/bar", http.body = "*")
+			}`,
+			wantErr: "invalid HTTP annotations on Foo.bar: GET requests cannot have a body",
+		},
+		{
+			desc: "invalid status",
+			thrift: `exception Oops {}
+			service Foo {
+				void bar() throws (1: Oops oops (http.status = "teapot"))
+					(http.method = "POST", http.path = "/bar")
+			}`,
+			wantErr: `invalid HTTP annotations on Foo.bar: invalid http.status "teapot" on exception "oops"`,
+		},
+		{
+			desc: "conflicting routes",
+			thrift: `service Foo {
+				void bar(1: string id) (http.method = "GET", http.path = "/x/{id}")
+				void baz(1: string name) (http.method = "GET", http.path = "/x/{name}")
+			}`,
+			wantErr: "Foo.bar and Foo.baz are both served by GET /x/{name}",
+		},
+		{
+			desc:    "streaming",
+			thrift:  `service Foo { stream<i32> bar() (http.method = "GET", http.path = "/bar") }`,
+			wantErr: "invalid HTTP annotations on Foo.bar: streaming functions cannot be served over HTTP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-http")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.thrift), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+				ServiceStubs:  true,
+				HTTPHandlers:  true,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// ServiceMetrics generates wrappers of the client and server stubs of all
+// the given services which record Prometheus metrics for each request.
+//
+// For each service Foo, this generates InstrumentFooServer and
+// InstrumentFooClient, which wrap a FooServer or a FooClient so that every
+// call to a function of Foo or its parents is recorded with
+// go.uber.org/thriftrw/metrics.
+//
+// The wrappers use the stubs generated by ServiceStubs.
+func ServiceMetrics(g Generator, services map[string]*compile.ServiceSpec) error {
+	for _, serviceName := range sortStringKeys(services) {
+		s := services[serviceName]
+		for _, server := range []bool{false, true} {
+			if err := serviceMetrics(g, s, server); err != nil {
+				return fmt.Errorf("could not generate metrics for %s: %v", s.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// serviceMetrics generates the instrumented Client or Server of the given
+// service.
+func serviceMetrics(g Generator, s *compile.ServiceSpec, server bool) error {
+	side := "Client"
+	if server {
+		side = "Server"
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$metrics := import "go.uber.org/thriftrw/metrics">
+		<$prometheus := import "github.com/prometheus/client_golang/prometheus">
+
+		<$name := goCase .Service.Name>
+		<$Interface := printf "%s%s" $name .Side>
+		<$Instrument := printf "Instrument%s" $Interface>
+		<$instrumented := printf "_%s_instrumented%s" $name .Side>
+		<$service := .Service>
+		<$server := .Server>
+
+		// <$Instrument> wraps <if $server>impl<else>c<end> to record Prometheus metrics for
+		// every request it <if $server>handles<else>sends<end>. The metrics are registered with reg,
+		// or with prometheus.DefaultRegisterer if reg is nil.
+		<- if $server>
+		//
+		//   handler := New<$name>Handler(<$Instrument>(impl, prometheus.DefaultRegisterer))
+		<- end>
+		func <$Instrument>(<if $server>impl<else>c<end> <$Interface>, reg <$prometheus>.Registerer) <$Interface> {
+			return <$instrumented>{
+				<- with .Service.Parent>
+					<goCase .Name><$.Side>: <lookupService . (printf "Instrument%s%s" (goCase .Name) $.Side)>(<if $server>impl<else>c<end>, reg),
+				<end>
+				next: <if $server>impl<else>c<end>,
+				m:    <$metrics>.New(reg),
+			}
+		}
+
+		type <$instrumented> struct {
+			<- with .Service.Parent>
+				<lookupService . (printf "%s%s" (goCase .Name) $.Side)>
+			<end>
+			next <$Interface>
+			m    *<$metrics>.Metrics
+		}
+
+		<range .Service.Functions>
+			<$prefix := namePrefix $service .>
+			<$ns := newParamNamespace>
+			<range .ArgsSpec><$arg := $ns.NewName .Name><end>
+			<$locals := $ns.Child>
+			<$i := $locals.NewName "i">
+			<$ctx := $locals.NewName "ctx">
+			<$serverStream := and $server .Streaming>
+			func (<$i> <$instrumented>) <goCase .Name>(<$ctx> <import "context">.Context
+				<- range .ArgsSpec>, <$ns.Rotate .Name> <fieldTypeReference .><end>
+				<- $stream := $locals.NewName "stream" ->
+				<- if $serverStream>, <$stream> <$prefix>ServerStream<end>) (
+				<- $success := $locals.NewName "success" ->
+				<- $err := $locals.NewName "err" ->
+				<- if and .Streaming (not $server) ->
+					<$stream> <$prefix>ClientStream,
+				<- else if not (or .OneWay .Streaming) ->
+					<- with .ResultSpec.ReturnType ->
+						<$success> <typeReference .>,
+					<- end>
+				<- end>
+				<- $err> error) {
+				<- $start := $locals.NewName "start">
+				<$start> := <import "time">.Now()
+				defer func() {
+					<$i>.m.Observe(<$metrics>.<$.Side>, "<$service.Name>", "<.MethodName>", <$start>, <$err>)
+				}()
+
+				return <$i>.next.<goCase .Name>(<$ctx>
+					<- range .ArgsSpec>, <$ns.Rotate .Name><end>
+					<- if $serverStream>, <$stream><end>)
+			}
+		<end>
+		`,
+		struct {
+			Service *compile.ServiceSpec
+			Server  bool
+			Side    string
+		}{Service: s, Server: server, Side: side},
+		TemplateFunc("lookupService", Generator.LookupServiceName),
+		TemplateFunc("namePrefix", functionNamePrefix),
+	)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	ti "go.uber.org/thriftrw/gen/internal/tests/instrumented"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/rpc"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCache is an in-memory implementation of the TieredCache service.
+type fakeCache struct {
+	items map[string][]byte
+}
+
+var _ ti.TieredCacheServer = (*fakeCache)(nil)
+
+func (c *fakeCache) Healthy(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+func (c *fakeCache) Get(ctx context.Context, key string) ([]byte, error) {
+	v, ok := c.items[key]
+	if !ok {
+		return nil, &ti.NotFound{Key: &key}
+	}
+	return v, nil
+}
+
+func (c *fakeCache) Put(ctx context.Context, key *string, value []byte, _ *int64) error {
+	c.items[*key] = value
+	return nil
+}
+
+func (c *fakeCache) Evict(ctx context.Context, key *string) error {
+	delete(c.items, *key)
+	return nil
+}
+
+func (c *fakeCache) Keys(ctx context.Context, prefix *string, stream ti.Cache_Keys_ServerStream) error {
+	for key := range c.items {
+		if err := stream.Send(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *fakeCache) Promote(ctx context.Context, key *string) error {
+	return &ti.Unavailable{Reason: ptr.String("no tiers")}
+}
+
+func TestServiceMetrics(t *testing.T) {
+	serverReg := prometheus.NewRegistry()
+	clientReg := prometheus.NewRegistry()
+
+	impl := ti.InstrumentTieredCacheServer(&fakeCache{items: make(map[string][]byte)}, serverReg)
+	server := rpc.NewServer(protocol.Binary, ti.NewTieredCacheHandler(impl))
+	client := ti.InstrumentTieredCacheClient(
+		ti.NewTieredCacheClient(rpc.NewClient(protocol.Binary, serverTransport(server))),
+		clientReg,
+	)
+	ctx := context.Background()
+
+	healthy, err := client.Healthy(ctx)
+	require.NoError(t, err)
+	assert.True(t, healthy)
+
+	_, err = client.Get(ctx, "foo")
+	assert.Equal(t, &ti.NotFound{Key: ptr.String("foo")}, err)
+
+	require.NoError(t, client.Put(ctx, ptr.String("foo"), []byte("bar"), nil))
+	require.NoError(t, client.Evict(ctx, ptr.String("foo")))
+
+	stream, err := client.Keys(ctx, nil)
+	require.NoError(t, err)
+	_, err = stream.Next()
+	assert.Equal(t, io.EOF, err)
+	require.NoError(t, stream.Close())
+
+	// Promote is declared with NotFound only, so Unavailable reaches the
+	// client as a TApplicationException.
+	require.Error(t, client.Promote(ctx, ptr.String("foo")))
+
+	for _, tt := range []struct {
+		side string
+		reg  prometheus.Gatherer
+		want string
+	}{
+		{
+			side: "client",
+			reg:  clientReg,
+			want: `
+				# HELP thriftrw_request_errors_total Number of Thrift requests which failed, by exception.
+				# TYPE thriftrw_request_errors_total counter
+				thriftrw_request_errors_total{exception="NotFound",method="get",service="Cache",side="client"} 1
+				thriftrw_request_errors_total{exception="TApplicationException",method="promote",service="TieredCache",side="client"} 1
+				# HELP thriftrw_requests_total Number of Thrift requests.
+				# TYPE thriftrw_requests_total counter
+				thriftrw_requests_total{method="evict",service="Cache",side="client"} 1
+				thriftrw_requests_total{method="get",service="Cache",side="client"} 1
+				thriftrw_requests_total{method="healthy",service="Health",side="client"} 1
+				thriftrw_requests_total{method="keys",service="Cache",side="client"} 1
+				thriftrw_requests_total{method="promote",service="TieredCache",side="client"} 1
+				thriftrw_requests_total{method="put",service="Cache",side="client"} 1
+			`,
+		},
+		{
+			side: "server",
+			reg:  serverReg,
+			want: `
+				# HELP thriftrw_request_errors_total Number of Thrift requests which failed, by exception.
+				# TYPE thriftrw_request_errors_total counter
+				thriftrw_request_errors_total{exception="NotFound",method="get",service="Cache",side="server"} 1
+				thriftrw_request_errors_total{exception="Unavailable",method="promote",service="TieredCache",side="server"} 1
+				# HELP thriftrw_requests_total Number of Thrift requests.
+				# TYPE thriftrw_requests_total counter
+				thriftrw_requests_total{method="evict",service="Cache",side="server"} 1
+				thriftrw_requests_total{method="get",service="Cache",side="server"} 1
+				thriftrw_requests_total{method="healthy",service="Health",side="server"} 1
+				thriftrw_requests_total{method="keys",service="Cache",side="server"} 1
+				thriftrw_requests_total{method="promote",service="TieredCache",side="server"} 1
+				thriftrw_requests_total{method="put",service="Cache",side="server"} 1
+			`,
+		},
+	} {
+		t.Run(tt.side, func(t *testing.T) {
+			err := testutil.GatherAndCompare(tt.reg, strings.NewReader(tt.want),
+				"thriftrw_requests_total", "thriftrw_request_errors_total")
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,572 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// ServiceStubs generates typed client and server stubs for all the given
+// services and stores the code in the generator to be written.
+//
+// For each service Foo, this generates a FooClient interface and its
+// implementation, built over an rpc.Client with NewFooClient, and a
+// FooServer interface which may be served as an rpc.Handler with
+// NewFooHandler. All methods accept a context.Context as their first
+// argument. Both constructors accept middleware from the envelope/dispatch
+// package which runs around each request to a unary or oneway function.
+//
+// Client stubs for streaming functions return a Foo_Bar_ClientStream from
+// which values may be read. Server implementations of streaming functions
+// receive a Foo_Bar_ServerStream to which they send values.
+//
+// The stubs use the helpers generated by Services.
+func ServiceStubs(g Generator, services map[string]*compile.ServiceSpec) error {
+	for _, serviceName := range sortStringKeys(services) {
+		s := services[serviceName]
+		for _, functionName := range sortStringKeys(s.Functions) {
+			f := s.Functions[functionName]
+			if !f.Streaming {
+				continue
+			}
+			if err := serviceStream(g, s, f); err != nil {
+				return fmt.Errorf(
+					"could not generate streams for %s.%s: %v", s.Name, f.Name, err)
+			}
+		}
+		if err := serviceClient(g, s); err != nil {
+			return fmt.Errorf("could not generate client for %s: %v", s.Name, err)
+		}
+		if err := serviceServer(g, s); err != nil {
+			return fmt.Errorf("could not generate server for %s: %v", s.Name, err)
+		}
+	}
+	return nil
+}
+
+func serviceClient(g Generator, s *compile.ServiceSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$rpc := import "go.uber.org/thriftrw/rpc">
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$dispatch := import "go.uber.org/thriftrw/envelope/dispatch">
+
+		<$name := goCase .Name>
+		<$Client := printf "%sClient" $name>
+		<$client := printf "_%s_client" $name>
+		<$service := .>
+
+		// <$Client> is a client for the <.Name> service.
+		<with deprecatedDoc .Doc .Annotations>//
+		<formatDoc .><end ->
+		type <$Client> interface {
+			<- with .Parent>
+				<lookupService . (printf "%sClient" (goCase .Name))>
+			<end>
+			<range .Functions>
+				<formatDoc (deprecatedDoc .Doc .Annotations)><goCase .Name>(<stubParams $service . false>) <stubResults $service . false>
+			<end>
+		}
+
+		// New<$Client> builds a new <$Client> which sends requests through
+		// the given rpc.Client. Requests pass through the given middleware
+		// before they are sent.
+		func New<$Client>(c <$rpc>.Client, outbound ...<$dispatch>.UnaryOutbound) <$Client> {
+			return <$client>{
+				<- with .Parent>
+					<goCase .Name>Client: <lookupService . (printf "New%sClient" (goCase .Name))>(c, outbound...),
+				<end>
+				c: <$dispatch>.NewClient("<.Name>", c, outbound...),
+			}
+		}
+
+		type <$client> struct {
+			<- with .Parent>
+				<lookupService . (printf "%sClient" (goCase .Name))>
+			<end>
+			c <$rpc>.Client
+		}
+
+		<range .Functions>
+			<$prefix := namePrefix $service .>
+			<$ns := newParamNamespace>
+			<range .ArgsSpec><$arg := $ns.NewName .Name><end>
+			<$locals := $ns.Child>
+			<$c := $locals.NewName "c">
+			<$ctx := $locals.NewName "ctx">
+			func (<$c> <$client>) <goCase .Name>(<$ctx> <import "context">.Context
+				<- range .ArgsSpec>, <$ns.Rotate .Name> <fieldTypeReference .><end>) (
+				<- $success := $locals.NewName "success" ->
+				<- $err := $locals.NewName "err" ->
+				<- $stream := $locals.NewName "stream" ->
+				<- if .Streaming ->
+					<$stream> <$prefix>ClientStream,
+				<- else if not .OneWay ->
+					<- with .ResultSpec.ReturnType ->
+						<$success> <typeReference .>,
+					<- end>
+				<- end>
+				<- $err> error) {
+				<$args := $locals.NewName "args">
+				<$body := $locals.NewName "body">
+				<$args> := <$prefix>Helper.Args(
+					<- range $i, $arg := .ArgsSpec>
+						<- if $i>, <end><$ns.Rotate $arg.Name>
+					<- end>)
+
+				var <$body> <$wire>.Value
+				<$body>, <$err> = <$args>.ToWire()
+				if <$err> != nil {
+					return
+				}
+
+				<if .OneWay>
+					<$err> = <$c>.c.CallOneway(<$ctx>, "<.MethodName>", <$body>)
+					return
+				<else if .Streaming>
+					<$s := $locals.NewName "s">
+					var <$s> <$rpc>.Stream
+					<$s>, <$err> = <$c>.c.CallStream(<$ctx>, "<.MethodName>", <$body>)
+					if <$err> != nil {
+						return
+					}
+
+					<$stream> = _<$prefix>clientStream{s: <$s>}
+					return
+				<else>
+					<$body>, <$err> = <$c>.c.Call(<$ctx>, "<.MethodName>", <$body>)
+					if <$err> != nil {
+						return
+					}
+
+					<$result := $locals.NewName "result">
+					var <$result> <$prefix>Result
+					if <$err> = <$result>.FromWire(<$body>); <$err> != nil {
+						return
+					}
+
+					<if .ResultSpec.ReturnType><$success>, <end><$err> = <$prefix>Helper.UnwrapResponse(&<$result>)
+					return
+				<end>
+			}
+		<end>
+		`, s,
+		TemplateFunc("lookupService", Generator.LookupServiceName),
+		TemplateFunc("namePrefix", functionNamePrefix),
+		TemplateFunc("stubParams", stubParams),
+		TemplateFunc("stubResults", stubResults),
+	)
+}
+
+func serviceServer(g Generator, s *compile.ServiceSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$rpc := import "go.uber.org/thriftrw/rpc">
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$dispatch := import "go.uber.org/thriftrw/envelope/dispatch">
+
+		<$name := goCase .Name>
+		<$Server := printf "%sServer" $name>
+		<$handler := printf "_%s_handler" $name>
+		<$service := .>
+
+		// <$Server> is implemented by servers of the <.Name> service.
+		//
+		// Use New<$name>Handler to serve an implementation of <$Server>.
+		<with deprecatedDoc .Doc .Annotations>//
+		<formatDoc .><end ->
+		type <$Server> interface {
+			<- with .Parent>
+				<lookupService . (printf "%sServer" (goCase .Name))>
+			<end>
+			<range .Functions>
+				<formatDoc (deprecatedDoc .Doc .Annotations)><goCase .Name>(<stubParams $service . true>) <stubResults $service . true>
+			<end>
+		}
+
+		// New<$name>Handler builds an rpc.Handler which dispatches requests
+		// for the <.Name> service to the given <$Server>. Requests pass
+		// through the given middleware before they reach the <$Server>.
+		func New<$name>Handler(impl <$Server>, inbound ...<$dispatch>.UnaryInbound) <$rpc>.Handler {
+			return <$handler>{
+				impl:    impl,
+				inbound: inbound,
+				<- with .Parent>
+					parent: <lookupService . (printf "New%sHandler" (goCase .Name))>(impl, inbound...),
+				<end>
+			}
+		}
+
+		type <$handler> struct {
+			impl    <$Server>
+			inbound []<$dispatch>.UnaryInbound
+			<- if .Parent>
+				parent <$rpc>.Handler
+			<end>
+		}
+
+		<$h := newVar "h">
+		// Handle receives and handles a request for the <.Name> service.
+		func (<$h> <$handler>) Handle(ctx <import "context">.Context, method string, body <$wire>.Value) (<$wire>.Value, error) {
+			<- $calls := unaryMethods . false ->
+			<- $oneways := unaryMethods . true ->
+			<- if or $calls $oneways>
+				if len(<$h>.inbound) == 0 {
+					return <$h>.handle(ctx, method, body)
+				}
+
+				req := &<$dispatch>.Request{
+					Service: "<.Name>",
+					Method:  method,
+					Type:    <$wire>.Call,
+					Body:    body,
+				}
+				switch method {
+				<- if $calls>
+				case <$calls>:
+				<- end>
+				<- if $oneways>
+				case <$oneways>:
+					req.Type = <$wire>.OneWay
+				<- end>
+				default:
+					return <$h>.handle(ctx, method, body)
+				}
+
+				handler := <$dispatch>.ApplyInbound(
+					<$dispatch>.UnaryHandlerFunc(func(ctx <import "context">.Context, req *<$dispatch>.Request) (<$wire>.Value, error) {
+						return <$h>.handle(ctx, req.Method, req.Body)
+					}),
+					<$h>.inbound...,
+				)
+				return handler.Handle(ctx, req)
+			<- else>
+				return <$h>.handle(ctx, method, body)
+			<- end>
+		}
+
+		func (<$h> <$handler>) handle(ctx <import "context">.Context, method string, body <$wire>.Value) (<$wire>.Value, error) {
+			switch method {
+			<range .Functions>
+				<if not .Streaming>
+				<$prefix := namePrefix $service .>
+				case "<.MethodName>":
+					var args <$prefix>Args
+					if err := args.FromWire(body); err != nil {
+						return <$wire>.Value{}, <$rpc>.ErrInvalidRequest{Method: method, Err: err}
+					}
+
+					<if .OneWay>
+						return <$wire>.Value{}, <$h>.impl.<goCase .Name>(ctx,
+							<- range .ArgsSpec> args.<goName .>,<end>)
+					<else>
+						result, err := <$prefix>Helper.WrapResponse(
+							<$h>.impl.<goCase .Name>(ctx,
+								<- range .ArgsSpec> args.<goName .>,<end>),
+						)
+						if err != nil {
+							return <$wire>.Value{}, err
+						}
+
+						return result.ToWire()
+					<end>
+				<end>
+			<end>
+			default:
+				<if .Parent>
+					return <$h>.parent.Handle(ctx, method, body)
+				<else>
+					return <$wire>.Value{}, <$rpc>.ErrUnknownMethod(method)
+				<end>
+			}
+		}
+
+		// Methods returns the names of the methods of the <.Name> service,
+		// including those it inherits.
+		func (<$h> <$handler>) Methods() []string {
+			<- if .Parent>
+				methods := []string{<range .Functions>"<.MethodName>", <end>}
+				if parent, ok := <$h>.parent.(<$rpc>.MethodLister); ok {
+					methods = append(methods, parent.Methods()...)
+				}
+				return methods
+			<- else>
+				return []string{<range .Functions>"<.MethodName>", <end>}
+			<- end>
+		}
+
+		<if hasDeprecated .>
+		// DeprecatedMethods returns the deprecated methods of the <.Name>
+		// service, including those it inherits, mapped to their deprecation
+		// messages.
+		func (<$h> <$handler>) DeprecatedMethods() map[string]string {
+			methods := map[string]string{
+				<- range .Functions>
+					<- if isDeprecated .>
+						"<.MethodName>": <printf "%q" (index .Annotations "deprecated")>,
+					<- end>
+				<- end>
+			}
+			<- if .Parent>
+				if parent, ok := <$h>.parent.(<$rpc>.DeprecatedMethodLister); ok {
+					for method, msg := range parent.DeprecatedMethods() {
+						methods[method] = msg
+					}
+				}
+			<- end>
+			return methods
+		}
+		<end>
+
+		<if hasStreaming .>
+		// HandleStream receives and handles a request to a streaming function
+		// of the <.Name> service.
+		func (<$h> <$handler>) HandleStream(ctx <import "context">.Context, method string, body <$wire>.Value, send func(<$wire>.Value) error) error {
+			switch method {
+			<range .Functions>
+				<if .Streaming>
+				<$prefix := namePrefix $service .>
+				case "<.MethodName>":
+					var args <$prefix>Args
+					if err := args.FromWire(body); err != nil {
+						return <$rpc>.ErrInvalidRequest{Method: method, Err: err}
+					}
+
+					stream := _<$prefix>serverStream{send: send}
+					if err := <$h>.impl.<goCase .Name>(ctx,
+						<- range .ArgsSpec> args.<goName .>,<end> stream); err != nil {
+						// Exceptions thrown by the function are sent to the
+						// client as the final value of the stream.
+						var success <typeReference .ResultSpec.ReturnType>
+						result, err := <$prefix>Helper.WrapResponse(success, err)
+						if err != nil {
+							return err
+						}
+
+						v, err := result.ToWire()
+						if err != nil {
+							return err
+						}
+						return send(v)
+					}
+					return nil
+				<end>
+			<end>
+			default:
+				<if .Parent>
+					if parent, ok := <$h>.parent.(<$rpc>.StreamHandler); ok {
+						return parent.HandleStream(ctx, method, body, send)
+					}
+				<end>
+				return <$rpc>.ErrUnknownMethod(method)
+			}
+		}
+		<end>
+		`, s,
+		TemplateFunc("hasStreaming", hasStreaming),
+		TemplateFunc("unaryMethods", unaryMethods),
+		TemplateFunc("hasDeprecated", hasDeprecated),
+		TemplateFunc("isDeprecated", isDeprecated),
+		TemplateFunc("lookupService", Generator.LookupServiceName),
+		TemplateFunc("namePrefix", functionNamePrefix),
+		TemplateFunc("stubParams", stubParams),
+		TemplateFunc("stubResults", stubResults),
+	)
+}
+
+func serviceStream(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$rpc := import "go.uber.org/thriftrw/rpc">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$prefix := namePrefix .Service .Function>
+		<$method := printf "%s.%s" .Service.Name .Function.Name>
+		<$ReturnType := typeReference .Function.ResultSpec.ReturnType>
+
+		// <$prefix>ClientStream receives the values streamed by the server in
+		// response to a call to <$method>.
+		type <$prefix>ClientStream interface {
+			// Next returns the next value in the stream. It returns io.EOF
+			// after the last value, or the exception thrown by the server,
+			// if any.
+			Next() (<$ReturnType>, error)
+
+			// Close stops receiving values from the stream.
+			Close() error
+		}
+
+		type _<$prefix>clientStream struct{ s <$rpc>.Stream }
+
+		func (s _<$prefix>clientStream) Next() (success <$ReturnType>, err error) {
+			var body <$wire>.Value
+			body, err = s.s.Receive()
+			if err != nil {
+				return
+			}
+
+			var result <$prefix>Result
+			if err = result.FromWire(body); err != nil {
+				return
+			}
+
+			return <$prefix>Helper.UnwrapResponse(&result)
+		}
+
+		func (s _<$prefix>clientStream) Close() error {
+			return s.s.Close()
+		}
+
+		// <$prefix>ServerStream sends values to the client in response to a
+		// call to <$method>.
+		type <$prefix>ServerStream interface {
+			// Send sends the given value to the client.
+			Send(<$ReturnType>) error
+		}
+
+		type _<$prefix>serverStream struct {
+			send func(<$wire>.Value) error
+		}
+
+		func (s _<$prefix>serverStream) Send(success <$ReturnType>) error {
+			result, err := <$prefix>Helper.WrapResponse(success, nil)
+			if err != nil {
+				return err
+			}
+
+			body, err := result.ToWire()
+			if err != nil {
+				return err
+			}
+			return s.send(body)
+		}
+		`,
+		struct {
+			Service  *compile.ServiceSpec
+			Function *compile.FunctionSpec
+		}{Service: s, Function: f},
+		TemplateFunc("namePrefix", functionNamePrefix),
+	)
+}
+
+// hasStreaming returns true if the given service or any of its parents has
+// a streaming function.
+func hasStreaming(s *compile.ServiceSpec) bool {
+	for ; s != nil; s = s.Parent {
+		for _, f := range s.Functions {
+			if f.Streaming {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unaryMethods returns the quoted method names of the non-streaming
+// functions declared by the given service, separated by commas. Only oneway
+// functions are included if oneway is true, and only other functions
+// otherwise.
+func unaryMethods(s *compile.ServiceSpec, oneway bool) string {
+	var names []string
+	for _, name := range sortStringKeys(s.Functions) {
+		f := s.Functions[name]
+		if !f.Streaming && f.OneWay == oneway {
+			names = append(names, strconv.Quote(f.MethodName()))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// hasDeprecated returns true if the given service or any of its parents has
+// a deprecated function.
+func hasDeprecated(s *compile.ServiceSpec) bool {
+	for ; s != nil; s = s.Parent {
+		for _, f := range s.Functions {
+			if isDeprecated(f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isDeprecated returns true if the given function is annotated with
+// (deprecated).
+func isDeprecated(f *compile.FunctionSpec) bool {
+	_, ok := f.Annotations[deprecatedAnnotation]
+	return ok
+}
+
+// stubParams returns the parameter list for the stub method of the given
+// function, starting with the context.Context. Names of the function's
+// arguments take precedence over the names of the context and, for
+// streaming functions on the server side, the stream.
+func stubParams(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec, server bool) (string, error) {
+	return g.TextTemplate(
+		`
+		<- $params := newParamNamespace ->
+		<- range .Function.ArgsSpec><$arg := $params.NewName .Name><end ->
+		<- $locals := $params.Child ->
+		<$locals.NewName "ctx"> <import "context">.Context
+		<- range .Function.ArgsSpec>, <$params.Rotate .Name> <fieldTypeReference .><end ->
+		<- if and .Server .Function.Streaming ->
+			, <$locals.NewName "stream"> <lookupService .Service (printf "%sServerStream" (namePrefix .Service .Function))>
+		<- end ->
+		`,
+		struct {
+			Service  *compile.ServiceSpec
+			Function *compile.FunctionSpec
+			Server   bool
+		}{Service: s, Function: f, Server: server},
+		TemplateFunc("lookupService", Generator.LookupServiceName),
+		TemplateFunc("namePrefix", functionNamePrefix),
+	)
+}
+
+// stubResults returns the result list for the stub method of the given
+// function. Streaming functions return a stream on the client side and only
+// an error on the server side.
+func stubResults(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec, server bool) (string, error) {
+	if f.Streaming {
+		if server {
+			return "error", nil
+		}
+		stream, err := g.LookupServiceName(s, functionNamePrefix(g, s, f)+"ClientStream")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%v, error)", stream), nil
+	}
+
+	if f.OneWay || f.ResultSpec.ReturnType == nil {
+		return "error", nil
+	}
+
+	t, err := typeReference(g, f.ResultSpec.ReturnType)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%v, error)", t), nil
+}
@@ -0,0 +1,397 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/envelope/dispatch"
+	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	ts "go.uber.org/thriftrw/gen/internal/tests/stubs"
+	"go.uber.org/thriftrw/gen/internal/tests/stubs/admintest"
+	th "go.uber.org/thriftrw/gen/internal/tests/stubs_health"
+	envex "go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore is an in-memory implementation of the Store service.
+type fakeStore struct {
+	items     map[ts.Key]*ts.Item
+	forgotten []ts.Key
+	tags      []string
+}
+
+var _ ts.StoreServer = (*fakeStore)(nil)
+
+func (s *fakeStore) Healthy(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+func (s *fakeStore) Get(ctx context.Context, key ts.Key) (*ts.Item, error) {
+	item, ok := s.items[key]
+	if !ok {
+		return nil, &tx.DoesNotExistException{Key: string(key)}
+	}
+	return item, nil
+}
+
+func (s *fakeStore) GetMany(ctx context.Context, keys []ts.Key) ([]*ts.Item, error) {
+	items := make([]*ts.Item, 0, len(keys))
+	for _, key := range keys {
+		if item, ok := s.items[key]; ok {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (s *fakeStore) Put(ctx context.Context, key *ts.Key, item *ts.Item, _ *int64) error {
+	if key == nil {
+		return &ts.StoreError{Message: ptr.String("key is required")}
+	}
+	if item == nil {
+		return errors.New("item is required")
+	}
+	s.items[*key] = item
+	return nil
+}
+
+func (s *fakeStore) Forget(ctx context.Context, key *ts.Key) error {
+	s.forgotten = append(s.forgotten, *key)
+	return nil
+}
+
+func (s *fakeStore) Tag(ctx context.Context, key *ts.Key, n *int64, tag *string) error {
+	s.tags = append(s.tags, fmt.Sprintf("%v:%v:%v", *key, *n, *tag))
+	return nil
+}
+
+func (s *fakeStore) Scan(ctx context.Context, prefix *ts.Key, stream ts.ReadOnlyStore_Scan_ServerStream) error {
+	keys := make([]string, 0, len(s.items))
+	for key := range s.items {
+		if prefix == nil || strings.HasPrefix(string(key), string(*prefix)) {
+			keys = append(keys, string(key))
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := stream.Send(s.items[ts.Key(key)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) Watch(ctx context.Context, key *ts.Key, stream ts.Store_Watch_ServerStream) error {
+	if key == nil {
+		return &ts.StoreError{Message: ptr.String("key is required")}
+	}
+	for i := int64(1); i <= 3; i++ {
+		if err := stream.Send(i); err != nil {
+			return err
+		}
+	}
+	return &ts.StoreError{Message: ptr.String("watch ended")}
+}
+
+type serverTransport rpc.Server
+
+func (t serverTransport) Send(ctx context.Context, req []byte) ([]byte, error) {
+	return rpc.Server(t).Handle(ctx, req)
+}
+
+func (t serverTransport) SendStream(ctx context.Context, req []byte) (rpc.MessageStream, error) {
+	var msgs [][]byte
+	err := rpc.Server(t).HandleStream(ctx, req, func(msg []byte) error {
+		msgs = append(msgs, msg)
+		return nil
+	})
+	return &sliceStream{msgs: msgs}, err
+}
+
+type sliceStream struct{ msgs [][]byte }
+
+func (s *sliceStream) Receive() ([]byte, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *sliceStream) Close() error { return nil }
+
+func TestServiceStubs(t *testing.T) {
+	store := &fakeStore{items: make(map[ts.Key]*ts.Item)}
+	server := rpc.NewServer(protocol.Binary, ts.NewStoreHandler(store))
+	client := ts.NewStoreClient(rpc.NewClient(protocol.Binary, serverTransport(server)))
+	ctx := context.Background()
+
+	t.Run("inherited function", func(t *testing.T) {
+		healthy, err := client.Healthy(ctx)
+		require.NoError(t, err)
+		assert.True(t, healthy)
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		_, err := client.Get(ctx, "foo")
+		assert.Equal(t, &tx.DoesNotExistException{Key: "foo"}, err)
+
+		err = client.Put(ctx, nil, &ts.Item{Key: "foo"}, nil)
+		assert.Equal(t, &ts.StoreError{Message: ptr.String("key is required")}, err)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		err := client.Put(ctx, (*ts.Key)(ptr.String("foo")), nil, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item is required")
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		foo := &ts.Item{Key: "foo", Value: []byte("bar")}
+		require.NoError(t, client.Put(ctx, (*ts.Key)(ptr.String("foo")), foo, ptr.Int64(42)))
+
+		item, err := client.Get(ctx, "foo")
+		require.NoError(t, err)
+		assert.Equal(t, foo, item)
+
+		items, err := client.GetMany(ctx, []ts.Key{"foo", "bar"})
+		require.NoError(t, err)
+		assert.Equal(t, []*ts.Item{foo}, items)
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		require.NoError(t, client.Forget(ctx, (*ts.Key)(ptr.String("foo"))))
+		assert.Equal(t, []ts.Key{"foo"}, store.forgotten)
+	})
+
+	t.Run("renamed parameters", func(t *testing.T) {
+		require.NoError(t, client.Tag(ctx, (*ts.Key)(ptr.String("foo")), ptr.Int64(1), ptr.String("bar")))
+		assert.Equal(t, []string{"foo:1:bar"}, store.tags)
+	})
+
+	t.Run("inherited stream", func(t *testing.T) {
+		foo := &ts.Item{Key: "foo"}
+		food := &ts.Item{Key: "food"}
+		store.items["foo"] = foo
+		store.items["food"] = food
+		store.items["bar"] = &ts.Item{Key: "bar"}
+
+		stream, err := client.Scan(ctx, (*ts.Key)(ptr.String("foo")))
+		require.NoError(t, err)
+		defer stream.Close()
+
+		var items []*ts.Item
+		for {
+			item, err := stream.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			items = append(items, item)
+		}
+		assert.Equal(t, []*ts.Item{foo, food}, items)
+	})
+
+	t.Run("stream exception", func(t *testing.T) {
+		stream, err := client.Watch(ctx, (*ts.Key)(ptr.String("foo")))
+		require.NoError(t, err)
+		defer stream.Close()
+
+		var values []int64
+		for {
+			v, err := stream.Next()
+			if err != nil {
+				assert.Equal(t, &ts.StoreError{Message: ptr.String("watch ended")}, err)
+				break
+			}
+			values = append(values, v)
+		}
+		assert.Equal(t, []int64{1, 2, 3}, values)
+	})
+}
+
+func TestServiceStubsUnknownMethod(t *testing.T) {
+	// A ReadOnlyStore server does not know about the functions added by its
+	// child service.
+	server := rpc.NewServer(protocol.Binary, ts.NewReadOnlyStoreHandler(&fakeStore{}))
+	client := ts.NewStoreClient(rpc.NewClient(protocol.Binary, serverTransport(server)))
+
+	err := client.Forget(context.Background(), (*ts.Key)(ptr.String("foo")))
+	assert.EqualError(t, err, `unknown method "forget"`)
+
+	_, err = client.GetMany(context.Background(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown method "getMany"`)
+
+	stream, err := client.Watch(context.Background(), (*ts.Key)(ptr.String("foo")))
+	require.NoError(t, err)
+	_, err = stream.Next()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown method "watch"`)
+}
+
+func TestServiceStubsInvalidRequest(t *testing.T) {
+	server := rpc.NewServer(protocol.Binary, ts.NewStoreHandler(&fakeStore{}))
+	client := rpc.NewClient(protocol.Binary, serverTransport(server))
+
+	// The key argument of get is required.
+	_, err := client.Call(context.Background(), "get", wire.NewValueStruct(wire.Struct{}))
+	require.Error(t, err)
+
+	exc, ok := err.(*envex.TApplicationException)
+	require.True(t, ok, "expected TApplicationException, got %T", err)
+	assert.Equal(t, envex.ExceptionTypeProtocolError, exc.GetType())
+	assert.Contains(t, exc.GetMessage(), `invalid request to "get"`)
+}
+
+func TestServiceStubsIncludedParent(t *testing.T) {
+	// Admin inherits the functions of Health, which is defined in another
+	// Thrift file and generated into another package.
+	var rt recordingT
+	fake := admintest.NewServer(&rt)
+	server := rpc.NewServer(protocol.Binary, ts.NewAdminHandler(fake))
+	client := ts.NewAdminClient(rpc.NewClient(protocol.Binary, serverTransport(server)))
+	ctx := context.Background()
+
+	var health th.HealthClient = client
+	fake.ExpectFailedChecks(func(ctx context.Context, limit *int32) ([]string, error) {
+		return []string{"disk"}, nil
+	})
+	checks, err := health.FailedChecks(ctx, ptr.Int32(1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"disk"}, checks)
+
+	fake.ExpectDrain(func(ctx context.Context, force *bool) error {
+		return nil
+	})
+	require.NoError(t, client.Drain(ctx, ptr.Bool(true)))
+
+	fake.Finish()
+	assert.Empty(t, rt.errors)
+	assert.Len(t, fake.Calls(), 2)
+
+	// A Health server does not know about the functions added by Admin.
+	server = rpc.NewServer(protocol.Binary, th.NewHealthHandler(fake))
+	client = ts.NewAdminClient(rpc.NewClient(protocol.Binary, serverTransport(server)))
+	err = client.Drain(ctx, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown method "drain"`)
+}
+
+func TestServiceStubsMiddleware(t *testing.T) {
+	var inbound, outbound []string
+	record := func(calls *[]string, req *dispatch.Request) {
+		*calls = append(*calls, fmt.Sprintf("%v.%v %v", req.Service, req.Method, req.Type))
+	}
+
+	server := rpc.NewServer(protocol.Binary, ts.NewStoreHandler(
+		&fakeStore{items: make(map[ts.Key]*ts.Item)},
+		dispatch.UnaryInboundFunc(func(ctx context.Context, req *dispatch.Request, h dispatch.UnaryHandler) (wire.Value, error) {
+			record(&inbound, req)
+			if req.Method == "tag" {
+				return wire.Value{}, errors.New("tags are disabled")
+			}
+			return h.Handle(ctx, req)
+		}),
+	))
+	client := ts.NewStoreClient(
+		rpc.NewClient(protocol.Binary, serverTransport(server)),
+		dispatch.UnaryOutboundFunc(func(ctx context.Context, req *dispatch.Request, c dispatch.UnaryCaller) (wire.Value, error) {
+			record(&outbound, req)
+			return c.Call(ctx, req)
+		}),
+	)
+	ctx := context.Background()
+
+	_, err := client.Healthy(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Put(ctx, (*ts.Key)(ptr.String("foo")), &ts.Item{Key: "foo"}, nil))
+	require.NoError(t, client.Forget(ctx, (*ts.Key)(ptr.String("foo"))))
+
+	err = client.Tag(ctx, (*ts.Key)(ptr.String("foo")), ptr.Int64(1), ptr.String("bar"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tags are disabled")
+
+	// Streaming functions do not pass through middleware.
+	stream, err := client.Scan(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, stream.Close())
+
+	want := []string{
+		"ReadOnlyStore.healthy Call",
+		"Store.put Call",
+		"Store.forget OneWay",
+		"Store.tag Call",
+	}
+	assert.Equal(t, want, inbound, "inbound requests")
+	assert.Equal(t, want, outbound, "outbound requests")
+}
+
+func TestServiceStubsRecover(t *testing.T) {
+	server := rpc.NewServer(protocol.Binary, ts.NewStoreHandler(nil, dispatch.Recover))
+	client := ts.NewStoreClient(rpc.NewClient(protocol.Binary, serverTransport(server)))
+
+	// The nil StoreServer panics when it is called.
+	_, err := client.Healthy(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "panic while handling ReadOnlyStore.healthy")
+}
+
+func TestServiceStubsMethods(t *testing.T) {
+	h, ok := ts.NewStoreHandler(&fakeStore{}).(rpc.MethodLister)
+	require.True(t, ok, "handler must implement rpc.MethodLister")
+	assert.ElementsMatch(t, []string{
+		"forget", "get", "getMany", "healthy", "put", "scan", "tag", "watch",
+	}, h.Methods())
+
+	h, ok = ts.NewAdminHandler(admintest.NewServer(&recordingT{})).(rpc.MethodLister)
+	require.True(t, ok, "handler must implement rpc.MethodLister")
+	assert.Contains(t, h.Methods(), "drain")
+	assert.Contains(t, h.Methods(), "failedChecks", "must include inherited methods")
+}
+
+func TestServiceStubsDeprecatedMethods(t *testing.T) {
+	h, ok := ts.NewStoreHandler(&fakeStore{}).(rpc.DeprecatedMethodLister)
+	require.True(t, ok, "handler must implement rpc.DeprecatedMethodLister")
+	assert.Equal(t, map[string]string{
+		"forget":  "",
+		"healthy": "Use Admin instead.",
+	}, h.DeprecatedMethods())
+
+	_, ok = ts.NewAdminHandler(admintest.NewServer(&recordingT{})).(rpc.DeprecatedMethodLister)
+	assert.False(t, ok, "services without deprecated functions must not implement rpc.DeprecatedMethodLister")
+}
@@ -32,6 +32,7 @@ import (
 	tv "go.uber.org/thriftrw/gen/internal/tests/services"
 	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
 	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/stream"
 	"go.uber.org/thriftrw/ptr"
 	"go.uber.org/thriftrw/wire"
 
@@ -45,6 +46,7 @@ type serviceType interface {
 	envelope.Enveloper
 
 	FromWire(wire.Value) error
+	Decode(stream.Reader) error
 }
 
 func TestServiceArgsAndResult(t *testing.T) {
@@ -674,3 +676,7 @@ func TestArgsAndResultValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestConstantServiceReferences(t *testing.T) {
+	assert.Equal(t, []tv.Key{"KeyValue", "Cache"}, tv.RoutedServices)
+}
@@ -44,6 +44,9 @@ type setGenerator struct{}
 // given type is expected.
 func (s *setGenerator) ValueList(g Generator, spec *compile.SetSpec) (string, error) {
 	name := valueListName(g, spec)
+
+	// Items of sets of hashable structs are sent in the order defined by
+	// Compare so that equal sets are encoded identically.
 	err := g.EnsureDeclared(
 		`
 			<$wire := import "go.uber.org/thriftrw/wire">
@@ -54,6 +57,18 @@ func (s *setGenerator) ValueList(g Generator, spec *compile.SetSpec) (string, er
 			<$f := newVar "f">
 			<$w := newVar "w">
 			func (<$v> <.Name>) ForEach(<$f> func(<$wire>.Value) error) error {
+				<- if hashableStruct .Spec.ValueSpec ->
+					<- $items := newVar "items" ->
+					<- $i := newVar "i" ->
+					<- $j := newVar "j" ->
+					<$items> := make(<typeReference .Spec>, len(<$v>))
+					copy(<$items>, <$v>)
+					<import "sort">.Slice(<$items>, func(<$i>, <$j> int) bool {
+						return <compareValues .Spec.ValueSpec (printf "%v[%v]" $items $i) (printf "%v[%v]" $items $j)> <"<"> 0
+					})
+					<$v> = <$items>
+
+				<end ->
 				<- if setUsesMap .Spec ->
 					for <$x> := range <$v> {
 				<- else ->
@@ -91,6 +106,10 @@ func (s *setGenerator) ValueList(g Generator, spec *compile.SetSpec) (string, er
 			Name string
 			Spec *compile.SetSpec
 		}{Name: name, Spec: spec},
+		TemplateFunc("hashableStruct", func(spec compile.TypeSpec) bool {
+			return hashableStructSpec(spec) != nil
+		}),
+		TemplateFunc("compareValues", compareValues),
 	)
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
@@ -107,6 +126,9 @@ func (s *setGenerator) Reader(g Generator, spec *compile.SetSpec) (string, error
 			<$i := newVar "i">
 			<$o := newVar "o">
 			<$x := newVar "x">
+			<$seen := newVar "seen">
+			<$dup := newVar "dup">
+			<$missing := newVar "missing">
 			func <.Name>(<$s> <$wire>.ValueList) (<$setType>, error) {
 				if <$s>.ValueType() != <typeCode .Spec.ValueSpec> {
 					return nil, nil
@@ -116,20 +138,44 @@ func (s *setGenerator) Reader(g Generator, spec *compile.SetSpec) (string, error
 					<$o> := make(<$setType>, <$s>.Size())
 				<else>
 					<$o> := make(<$setType>, 0, <$s>.Size())
+					<- if isHashable .Spec.ValueSpec>
+						<$seen> := make(map[<typeReference .Spec.ValueSpec>]struct{}, <$s>.Size())
+					<- end>
 				<end ->
+				<- if reportsMissing .Spec.ValueSpec>
+					var <$missing> <import "go.uber.org/thriftrw/required">.Errors
+				<- end>
 				err := <$s>.ForEach(func(<$x> <$wire>.Value) error {
 					<$i>, err := <fromWire .Spec.ValueSpec $x>
-					if err != nil {
-						return err
-					}
+					<- if reportsMissing .Spec.ValueSpec>
+						if err = <$missing>.Merge(err); err != nil {
+							return err
+						}
+					<- else>
+						if err != nil {
+							return err
+						}
+					<- end>
 					<if setUsesMap .Spec>
 						<$o>[<$i>] = struct{}{}
+					<else if isHashable .Spec.ValueSpec>
+						// Drop duplicates, keeping values in the order in
+						// which they were first seen.
+						if _, <$dup> := <$seen>[<$i>]; !<$dup> {
+							<$seen>[<$i>] = struct{}{}
+							<$o> = append(<$o>, <$i>)
+						}
 					<else>
 						<$o> = append(<$o>, <$i>)
 					<end ->
 					return nil
 				})
 				<$s>.Close()
+				<- if reportsMissing .Spec.ValueSpec>
+					if err == nil {
+						err = <$missing>.Err()
+					}
+				<- end>
 				return <$o>, err
 			}
 		`,
@@ -137,6 +183,165 @@ func (s *setGenerator) Reader(g Generator, spec *compile.SetSpec) (string, error
 			Name string
 			Spec *compile.SetSpec
 		}{Name: name, Spec: spec},
+		TemplateFunc("reportsMissing", reportsMissingFields),
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Decoder generates a function to read a set of the given type directly
+// from a stream.Reader.
+//
+// func $name(sr stream.Reader) ($setType, error) {
+//      ...
+// }
+//
+// And returns its name.
+func (s *setGenerator) Decoder(g Generator, spec *compile.SetSpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+			<$setType := typeReference .Spec>
+
+			<$sr := newVar "sr">
+			<$sh := newVar "sh">
+			<$i := newVar "i">
+			<$o := newVar "o">
+			<$n := newVar "n">
+			<$v := newVar "v">
+			<$seen := newVar "seen">
+			<$dup := newVar "dup">
+			func <.Name>(<$sr> <$stream>.Reader) (<$setType>, error) {
+				<$sh>, err := <$sr>.ReadSetBegin()
+				if err != nil {
+					return nil, err
+				}
+
+				if <$sh>.Type != <typeCode .Spec.ValueSpec> {
+					for <$i> := 0; <$i> <"<"> <$sh>.Length; <$i>++ {
+						if err := <$sr>.Skip(<$sh>.Type); err != nil {
+							return nil, err
+						}
+					}
+					return nil, <$sr>.ReadSetEnd()
+				}
+
+				<$n> := <$sh>.Length
+				if <$n> > 1024 {
+					// Don't trust the length read off the wire for the initial
+					// allocation. The collection grows as items are read.
+					<$n> = 1024
+				}
+
+				<if setUsesMap .Spec>
+					<$o> := make(<$setType>, <$n>)
+				<else>
+					<$o> := make(<$setType>, 0, <$n>)
+					<- if isHashable .Spec.ValueSpec>
+						<$seen> := make(map[<typeReference .Spec.ValueSpec>]struct{}, <$n>)
+					<- end>
+				<end ->
+				for <$i> := 0; <$i> <"<"> <$sh>.Length; <$i>++ {
+					<$v>, err := <decode .Spec.ValueSpec $sr>
+					if err != nil {
+						return nil, err
+					}
+					<if setUsesMap .Spec>
+						<$o>[<$v>] = struct{}{}
+					<else if isHashable .Spec.ValueSpec>
+						// Drop duplicates, keeping values in the order in
+						// which they were first seen.
+						if _, <$dup> := <$seen>[<$v>]; !<$dup> {
+							<$seen>[<$v>] = struct{}{}
+							<$o> = append(<$o>, <$v>)
+						}
+					<else>
+						<$o> = append(<$o>, <$v>)
+					<end ->
+				}
+
+				if err = <$sr>.ReadSetEnd(); err != nil {
+					return nil, err
+				}
+				return <$o>, err
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// Encoder generates a function to write a set of the given type directly
+// to a stream.Writer.
+//
+// 	func $name(v $setType, sw stream.Writer) error {
+// 		...
+// 	}
+//
+// And returns its name. Items are written in the same order as the
+// ValueList of the set.
+func (s *setGenerator) Encoder(g Generator, spec *compile.SetSpec) (string, error) {
+	name := encoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+			<$v := newVar "v">
+			<$sw := newVar "sw">
+			<$sh := newVar "sh">
+			<$x := newVar "x">
+			func <.Name>(<$v> <typeReference .Spec>, <$sw> <$stream>.Writer) error {
+				<$sh> := <$stream>.SetHeader{
+					Type:   <typeCode .Spec.ValueSpec>,
+					Length: len(<$v>),
+				}
+				if err := <$sw>.WriteSetBegin(<$sh>); err != nil {
+					return err
+				}
+
+				<if hashableStruct .Spec.ValueSpec ->
+					<- $items := newVar "items" ->
+					<- $i := newVar "i" ->
+					<- $j := newVar "j" ->
+					<$items> := make(<typeReference .Spec>, len(<$v>))
+					copy(<$items>, <$v>)
+					<import "sort">.Slice(<$items>, func(<$i>, <$j> int) bool {
+						return <compareValues .Spec.ValueSpec (printf "%v[%v]" $items $i) (printf "%v[%v]" $items $j)> <"<"> 0
+					})
+					<$v> = <$items>
+
+				<end ->
+				<- if setUsesMap .Spec ->
+					for <$x> := range <$v> {
+				<- else ->
+					for _, <$x> := range <$v> {
+				<- end ->
+						<if not (isPrimitiveType .Spec.ValueSpec)>
+							if <$x> == nil {
+								return <import "fmt">.Errorf("invalid set item: value is nil")
+							}
+						<end ->
+
+						if err := <encode .Spec.ValueSpec $x $sw>; err != nil {
+							return err
+						}
+					}
+				return <$sw>.WriteSetEnd()
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+		TemplateFunc("hashableStruct", func(spec compile.TypeSpec) bool {
+			return hashableStructSpec(spec) != nil
+		}),
+		TemplateFunc("compareValues", compareValues),
 	)
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
@@ -202,6 +407,53 @@ func (s *setGenerator) Equals(g Generator, spec *compile.SetSpec) (string, error
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+// Clone generates a function to make deep copies of sets of the given type
+//
+// func $name(v $setType) $setType {
+//      ...
+// }
+//
+// And returns its name.
+func (s *setGenerator) Clone(g Generator, spec *compile.SetSpec) (string, error) {
+	name := cloneFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$setType := typeReference .Spec>
+
+			<$v := newVar "v">
+			func <.Name>(<$v> <$setType>) <$setType> {
+				if <$v> == nil {
+					return nil
+				}
+
+				<$o := newVar "o">
+				<$i := newVar "i">
+				<$x := newVar "x">
+				<if setUsesMap .Spec>
+					// Hashable values are primitives so they may be copied
+					// as-is.
+					<$o> := make(<$setType>, len(<$v>))
+					for <$x> := range <$v> {
+						<$o>[<$x>] = struct{}{}
+					}
+				<else>
+					<$o> := make(<$setType>, len(<$v>))
+					for <$i>, <$x> := range <$v> {
+						<$o>[<$i>] = <clone .Spec.ValueSpec $x>
+					}
+				<end>
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 func (s *setGenerator) zapMarshaler(
 	g Generator,
 	root *compile.SetSpec,
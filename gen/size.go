@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// reservedSizeIdentifiers are additionally reserved for fields of structs
+// generated with SizeMethods.
+var reservedSizeIdentifiers = map[string]struct{}{
+	"SizeInBytes": {},
+}
+
+// SizeInBytes generates the SizeInBytes method of this group, which
+// reports the encoded size of the struct.
+func (f fieldGroupGenerator) SizeInBytes(g Generator) error {
+	for _, field := range f.Fields {
+		name, err := goName(g, field)
+		if err != nil {
+			return err
+		}
+		if _, reserved := reservedSizeIdentifiers[name]; reserved {
+			return fmt.Errorf("%q is a reserved ThriftRW identifier", name)
+		}
+	}
+
+	return sizeInBytes(g, f.Name, "*"+f.Name)
+}
+
+// specSizeInBytes generates the SizeInBytes method of the given enum or
+// typedef.
+func specSizeInBytes(g Generator, spec compile.TypeSpec) error {
+	name, err := typeName(g, spec)
+	if err != nil {
+		return err
+	}
+	receiver, err := typeReference(g, spec)
+	if err != nil {
+		return err
+	}
+	return sizeInBytes(g, name, receiver)
+}
+
+// sizeInBytes generates a SizeInBytes method for the given type, which must
+// have a ToWire method. receiver is the receiver type of the method.
+func sizeInBytes(g Generator, name, receiver string) error {
+	return g.DeclareFromTemplate(
+		`
+		<$protocol := import "go.uber.org/thriftrw/protocol">
+		<$v := newVar "v">
+		<$w := newVar "w">
+
+		// SizeInBytes returns the number of bytes this <.Name> occupies when
+		// encoded with the Thrift Binary protocol.
+		//
+		// The size is computed from the Thrift-level intermediate
+		// representation of <.Name> without encoding it.
+		func (<$v> <.Receiver>) SizeInBytes() (int, error) {
+			<$w>, err := <$v>.ToWire()
+			if err != nil {
+				return 0, err
+			}
+			return <$protocol>.EncodedSize(<$w>)
+		}
+		`,
+		struct {
+			Name     string
+			Receiver string
+		}{Name: name, Receiver: receiver},
+	)
+}
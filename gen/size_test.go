@@ -0,0 +1,111 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/sizes"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestSizeInBytes(t *testing.T) {
+	type sizer interface {
+		ToWire() (wire.Value, error)
+		SizeInBytes() (int, error)
+	}
+
+	shape := ts.Shape{
+		Name:    "square",
+		Color:   ts.ColorBlue.Ptr(),
+		Points:  []*ts.Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}},
+		Tags:    map[string]int64{"sides": 4, "corners": 4},
+		Layers:  map[int32]struct{}{1: {}, 2: {}},
+		Data:    []byte("hello"),
+		Visible: ptr.Bool(true),
+		Depth:   ptr.Int8(1),
+		Order:   ptr.Int16(2),
+	}
+
+	tests := []struct {
+		desc string
+		give sizer
+	}{
+		{"enum", ts.ColorGreen},
+		{"typedef", ts.Name("hello")},
+		{"typedef list", ts.Names{"foo", "bar"}},
+		{"empty struct", &ts.Shape{}},
+		{"struct", &shape},
+		{"struct typedef", (*ts.Outline)(&shape)},
+		{"union", &ts.Fill{Gradient: []ts.Color{ts.ColorRed, ts.ColorBlue}}},
+		{"exception", &ts.ShapeNotFound{Message: "not found"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w, err := tt.give.ToWire()
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, protocol.Binary.Encode(w, &buf))
+
+			size, err := tt.give.SizeInBytes()
+			require.NoError(t, err)
+			assert.Equal(t, buf.Len(), size)
+		})
+	}
+}
+
+func TestSizeInBytesError(t *testing.T) {
+	_, err := (&ts.Fill{}).SizeInBytes()
+	assert.Error(t, err, "empty unions cannot be encoded")
+}
+
+func TestSizeMethodsReservedName(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-sizes")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	path := filepath.Join(thriftRoot, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(
+		`struct Foo { 1: optional i32 x (go.name = "SizeInBytes") }`), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+		SizeMethods:   true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"SizeInBytes" is a reserved ThriftRW identifier`)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tsl "go.uber.org/thriftrw/gen/internal/tests/slice_sets"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestSliceSetsDropDuplicates(t *testing.T) {
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+			wire.NewValueI32(2),
+			wire.NewValueI32(1),
+			wire.NewValueI32(2),
+			wire.NewValueI32(3),
+			wire.NewValueI32(1),
+		}))},
+		{ID: 2, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("a"),
+			wire.NewValueString("a"),
+		}))},
+		{ID: 7, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+			singleFieldStruct(1, wire.NewValueString("foo")),
+			singleFieldStruct(1, wire.NewValueString("foo")),
+		}))},
+	}})
+	want := &tsl.Sets{
+		Int32Set:  []int32{2, 1, 3},
+		StringSet: []string{"a"},
+		// Structs are not hashable so their duplicates are kept.
+		FooSet: []*tsl.Foo{{StringField: "foo"}, {StringField: "foo"}},
+	}
+
+	var got tsl.Sets
+	require.NoError(t, got.FromWire(v))
+	assert.Equal(t, want, &got, "FromWire")
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(v, &buff))
+
+	var decoded tsl.Sets
+	require.NoError(t, decoded.Decode(protocol.BinaryStreamer.Reader(bytes.NewReader(buff.Bytes()))))
+	assert.Equal(t, want, &decoded, "Decode")
+}
+
+func TestSliceSetsConstants(t *testing.T) {
+	assert.Equal(t, []string{"hello", "world"}, tsl.ConstStringSet)
+	assert.Equal(t, tsl.StringMapSet{"hello": {}, "world": {}}, tsl.ConstStringMapSet)
+}
+
+func TestSetUsesMap(t *testing.T) {
+	tests := []struct {
+		desc      string
+		spec      *compile.SetSpec
+		sliceSets bool
+		want      bool
+	}{
+		{
+			desc: "default",
+			spec: &compile.SetSpec{ValueSpec: &compile.StringSpec{}},
+			want: true,
+		},
+		{
+			desc:      "default slice",
+			spec:      &compile.SetSpec{ValueSpec: &compile.StringSpec{}},
+			sliceSets: true,
+			want:      false,
+		},
+		{
+			desc: "slice annotation",
+			spec: &compile.SetSpec{
+				ValueSpec:   &compile.StringSpec{},
+				Annotations: compile.Annotations{"go.type": "slice"},
+			},
+			want: false,
+		},
+		{
+			desc: "map annotation",
+			spec: &compile.SetSpec{
+				ValueSpec:   &compile.StringSpec{},
+				Annotations: compile.Annotations{"go.type": "map"},
+			},
+			sliceSets: true,
+			want:      true,
+		},
+		{
+			desc: "unhashable",
+			spec: &compile.SetSpec{ValueSpec: &compile.BinarySpec{}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, setUsesMap(tt.spec, tt.sliceSets))
+
+			typ, err := buildType(nil, tt.spec, true, tt.sliceSets, GoCasing)
+			require.NoError(t, err)
+			if tt.want {
+				assert.NotNil(t, typ.MapType, "expected a map: %v", typ)
+			} else {
+				assert.NotNil(t, typ.SliceType, "expected a slice: %v", typ)
+			}
+		})
+	}
+}
+
+func TestSetMapAnnotationUnhashable(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-slice-sets")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	path := filepath.Join(thriftRoot, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(
+		`struct Foo { 1: optional set<binary> (go.type = "map") values }`,
+	), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     filepath.Join(thriftRoot, "out"),
+		PackagePrefix: "example.com/idl",
+		ThriftRoot:    thriftRoot,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `set<binary> cannot be represented as a map`)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// SortedMapLabel sends the items of maps over the wire in the order of
+// their keys. i.e.
+//
+// 	struct Manifest {
+// 		1: required map<string, binary> (go.sortedMap) files
+// 	}
+//
+// Items of Go maps are otherwise sent in the random order in which Go
+// iterates over them, so equal values may be encoded differently each
+// time. With this annotation, equal maps are always encoded identically,
+// which keeps hashes of payloads and golden files stable.
+//
+// The keys of such maps must be primitives, binary, enums, structs
+// annotated with HashableLabel, or typedefs of these. Structs are ordered
+// by their Compare method.
+//
+// The SortedMaps option sorts all maps whose keys may be ordered.
+// Individual maps may opt out of it with (go.sortedMap = "false").
+const SortedMapLabel = "go.sortedMap"
+
+// isSortedMap returns true if the items of the given map are sent in the
+// order of their keys. sortedMaps is the value of the SortedMaps option.
+func isSortedMap(spec *compile.MapSpec, sortedMaps bool) (bool, error) {
+	switch v, ok := spec.Annotations[SortedMapLabel]; {
+	case !ok:
+		return sortedMaps && isOrderedKey(spec.KeySpec), nil
+	case v == "false":
+		return false, nil
+	case v != "" && v != "true":
+		return false, fmt.Errorf(
+			"invalid %v on %v: expected \"true\" or \"false\", got %q",
+			SortedMapLabel, spec.ThriftName(), v)
+	case !isOrderedKey(spec.KeySpec):
+		return false, fmt.Errorf(
+			"invalid %v on %v: keys of type %v cannot be ordered",
+			SortedMapLabel, spec.ThriftName(), spec.KeySpec.ThriftName())
+	}
+	return true, nil
+}
+
+// sortedMap returns true if the items of the given map are sent in the
+// order of their keys by code generated with g.
+func sortedMap(g Generator, spec *compile.MapSpec) (bool, error) {
+	return isSortedMap(spec, checkSortedMaps(g))
+}
+
+// isOrderedKey returns true if compareValues can order values of the given
+// type.
+func isOrderedKey(spec compile.TypeSpec) bool {
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.EnumSpec:
+		return true
+	case *compile.BinarySpec:
+		return !isReaderBinary(spec)
+	case *compile.StructSpec:
+		return hashableStructSpec(spec) != nil
+	default:
+		return false
+	}
+}
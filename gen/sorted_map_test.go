@@ -0,0 +1,230 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/sorted_maps"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestSortedMaps(t *testing.T) {
+	newInventory := func() *ts.Inventory {
+		inv := &ts.Inventory{
+			Counts: make(map[string]int64),
+			Colors: map[ts.Color]string{
+				ts.ColorBlue:  "sky",
+				ts.ColorRed:   "apple",
+				ts.ColorGreen: "grass",
+			},
+			Nested: make(map[ts.Name]map[int32]float64),
+			Blobs: []struct {
+				Key   []byte
+				Value string
+			}{
+				{Key: []byte("b"), Value: "2"},
+				{Key: []byte{}, Value: "0"},
+				{Key: []byte("a"), Value: "1"},
+			},
+			Labels: []struct {
+				Key   *ts.Point
+				Value string
+			}{
+				{Key: &ts.Point{X: 1, Y: 2}, Value: "b"},
+				{Key: &ts.Point{X: 0, Y: 5}, Value: "a"},
+				{Key: &ts.Point{X: 1, Y: 1}, Value: "c"},
+			},
+			Scores: ts.Scores{"zed": 1, "amy": 2, "kim": 3},
+			Tiles: []struct {
+				Key   *ts.Tile
+				Value int32
+			}{
+				{Key: &ts.Tile{Kind: "water"}, Value: 1},
+				{Key: &ts.Tile{Kind: "grass"}, Value: 2},
+			},
+		}
+		for i := 0; i < 20; i++ {
+			inv.Counts[fmt.Sprintf("item%02d", 19-i)] = int64(i)
+			inv.Nested[ts.Name(fmt.Sprint(i))] = map[int32]float64{int32(-i): 1, int32(i): 2}
+		}
+		return inv
+	}
+
+	// keys returns the keys of the given map field of the given struct as
+	// sent over the wire.
+	keys := func(t *testing.T, w wire.Value, id int16) []wire.Value {
+		for _, f := range w.GetStruct().Fields {
+			if f.ID != id {
+				continue
+			}
+
+			var keys []wire.Value
+			require.NoError(t, f.Value.GetMap().ForEach(func(i wire.MapItem) error {
+				keys = append(keys, i.Key)
+				return nil
+			}))
+			return keys
+		}
+		t.Fatalf("field %v not found", id)
+		return nil
+	}
+
+	w, err := newInventory().ToWire()
+	require.NoError(t, err)
+
+	t.Run("primitive keys", func(t *testing.T) {
+		counts := keys(t, w, 1)
+		require.Len(t, counts, 20)
+		for i, k := range counts {
+			assert.Equal(t, fmt.Sprintf("item%02d", i), k.GetString())
+		}
+	})
+
+	t.Run("enum keys", func(t *testing.T) {
+		assert.Equal(t, []wire.Value{
+			wire.NewValueI32(int32(ts.ColorRed)),
+			wire.NewValueI32(int32(ts.ColorGreen)),
+			wire.NewValueI32(int32(ts.ColorBlue)),
+		}, keys(t, w, 2))
+	})
+
+	t.Run("binary keys", func(t *testing.T) {
+		assert.Equal(t, []wire.Value{
+			wire.NewValueBinary([]byte{}),
+			wire.NewValueBinary([]byte("a")),
+			wire.NewValueBinary([]byte("b")),
+		}, keys(t, w, 4))
+	})
+
+	t.Run("struct keys", func(t *testing.T) {
+		var got []*ts.Point
+		for _, k := range keys(t, w, 5) {
+			var p ts.Point
+			require.NoError(t, p.FromWire(k))
+			got = append(got, &p)
+		}
+		assert.Equal(t, []*ts.Point{{X: 0, Y: 5}, {X: 1, Y: 1}, {X: 1, Y: 2}}, got)
+	})
+
+	t.Run("typedef", func(t *testing.T) {
+		assert.Equal(t, []wire.Value{
+			wire.NewValueString("amy"),
+			wire.NewValueString("kim"),
+			wire.NewValueString("zed"),
+		}, keys(t, w, 6))
+	})
+
+	t.Run("unordered keys", func(t *testing.T) {
+		water, err := (&ts.Tile{Kind: "water"}).ToWire()
+		require.NoError(t, err)
+		grass, err := (&ts.Tile{Kind: "grass"}).ToWire()
+		require.NoError(t, err)
+		assert.Equal(t, []wire.Value{water, grass}, keys(t, w, 7))
+	})
+
+	t.Run("input is not modified", func(t *testing.T) {
+		inv := newInventory()
+		_, err := inv.ToWire()
+		require.NoError(t, err)
+		assert.Equal(t, newInventory(), inv)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		var want bytes.Buffer
+		require.NoError(t, protocol.Binary.Encode(w, &want))
+
+		for i := 0; i < 10; i++ {
+			inv := newInventory()
+
+			v, err := inv.ToWire()
+			require.NoError(t, err)
+			var got bytes.Buffer
+			require.NoError(t, protocol.Binary.Encode(v, &got))
+			assert.Equal(t, want.Bytes(), got.Bytes(), "ToWire")
+
+			got.Reset()
+			require.NoError(t, inv.Encode(protocol.BinaryStreamer.Writer(&got)))
+			assert.Equal(t, want.Bytes(), got.Bytes(), "Encode")
+		}
+	})
+}
+
+func TestSortedMapsInvalid(t *testing.T) {
+	tests := []struct {
+		desc       string
+		give       string
+		sortedMaps bool
+		wantErr    string
+	}{
+		{
+			desc:    "invalid value",
+			give:    `struct Foo { 1: optional map<string, i32> (go.sortedMap = "yes") x }`,
+			wantErr: `invalid go.sortedMap on map<string, i32>: expected "true" or "false", got "yes"`,
+		},
+		{
+			desc:    "unordered keys",
+			give:    `struct Foo { 1: optional map<list<i32>, i32> (go.sortedMap) x }`,
+			wantErr: `invalid go.sortedMap on map<list<i32>, i32>: keys of type list<i32> cannot be ordered`,
+		},
+		{
+			desc: "struct keys",
+			give: `
+				struct Key { 1: required string name }
+				struct Foo { 1: optional map<Key, i32> (go.sortedMap) x }
+			`,
+			sortedMaps: true,
+			wantErr:    `invalid go.sortedMap on map<Key, i32>: keys of type Key cannot be ordered`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-sorted-maps")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.give), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+				SortedMaps:    tt.sortedMaps,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// enumSQL generates database/sql Valuer and Scanner implementations for the
+// given enum.
+//
+// Enums are stored as their integer values, or as their names if SQLEnumNames
+// was set. Both forms are accepted when reading them.
+func enumSQL(g Generator, spec *compile.EnumSpec) error {
+	err := g.DeclareFromTemplate(
+		`
+		<$driver := import "database/sql/driver">
+		<$fmt := import "fmt">
+		<$math := import "math">
+
+		<$enumName := goName .>
+		<$v := newVar "v">
+		<if checkSQLEnumNames>
+		// Value returns the name of <$enumName> to store it in a database. The
+		// integer value is returned if the enum value is not recognized.
+		//
+		// This implements driver.Valuer.
+		func (<$v> <$enumName>) Value() (<$driver>.Value, error) {
+			<- $text := newVar "text">
+			<$text>, err := <$v>.MarshalText()
+			return string(<$text>), err
+		}
+		<else>
+		// Value returns the integer value of <$enumName> to store it in a
+		// database.
+		//
+		// This implements driver.Valuer.
+		func (<$v> <$enumName>) Value() (<$driver>.Value, error) {
+			return int64(<$v>), nil
+		}
+		<end>
+
+		<$src := newVar "src">
+		// Scan reads <$enumName> from a value stored in a database. Both,
+		// integer values and names are accepted.
+		//
+		// This implements sql.Scanner.
+		func (<$v> *<$enumName>) Scan(<$src> interface{}) error {
+			<- $x := newVar "x">
+			switch <$x> := <$src>.(type) {
+			case int64:
+				if <$x> <"<"> <$math>.MinInt32 || <$x> <">"> <$math>.MaxInt32 {
+					return <$fmt>.Errorf("enum value %d out of range for %q", <$x>, "<$enumName>")
+				}
+				*<$v> = (<$enumName>)(<$x>)
+				return nil
+			case []byte:
+				return <$v>.UnmarshalText(<$x>)
+			case string:
+				return <$v>.UnmarshalText([]byte(<$x>))
+			default:
+				return <$fmt>.Errorf("cannot scan %T into %q", <$src>, "<$enumName>")
+			}
+		}
+		`,
+		spec,
+		TemplateFunc("checkSQLEnumNames", checkSQLEnumNames),
+	)
+	return wrapGenerateError(spec.Name, err)
+}
+
+// typedefSQL generates database/sql Valuer and Scanner implementations for
+// the given typedef if it refers to a base type. Nothing is generated for
+// other typedefs.
+func typedefSQL(g Generator, spec *compile.TypedefSpec) error {
+	var data struct {
+		Spec *compile.TypedefSpec
+
+		// Go type in which the value is stored in the database.
+		ValueType string
+
+		// sql.Null* type with which values are scanned, if any.
+		NullType  string
+		NullField string
+
+		// Bounds of integers narrower than 64 bits.
+		Min, Max string
+	}
+	data.Spec = spec
+
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		data.ValueType, data.NullType, data.NullField = "bool", "NullBool", "Bool"
+	case *compile.I8Spec:
+		data.ValueType, data.NullType, data.NullField = "int64", "NullInt64", "Int64"
+		data.Min, data.Max = "MinInt8", "MaxInt8"
+	case *compile.I16Spec:
+		data.ValueType, data.NullType, data.NullField = "int64", "NullInt64", "Int64"
+		data.Min, data.Max = "MinInt16", "MaxInt16"
+	case *compile.I32Spec:
+		data.ValueType, data.NullType, data.NullField = "int64", "NullInt64", "Int64"
+		data.Min, data.Max = "MinInt32", "MaxInt32"
+	case *compile.I64Spec:
+		data.ValueType, data.NullType, data.NullField = "int64", "NullInt64", "Int64"
+	case *compile.DoubleSpec:
+		data.ValueType, data.NullType, data.NullField = "float64", "NullFloat64", "Float64"
+	case *compile.StringSpec:
+		data.ValueType, data.NullType, data.NullField = "string", "NullString", "String"
+	case *compile.BinarySpec:
+		if isStringBinary(spec) {
+			data.ValueType, data.NullType, data.NullField = "string", "NullString", "String"
+		} else {
+			data.ValueType = "[]byte"
+		}
+	default:
+		return nil
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$driver := import "database/sql/driver">
+		<$fmt := import "fmt">
+
+		<$name := typeName .Spec>
+		<$v := newVar "v">
+		// Value returns the value of <$name> to store it in a database.
+		//
+		// This implements driver.Valuer.
+		func (<$v> <$name>) Value() (<$driver>.Value, error) {
+			return (<.ValueType>)(<$v>), nil
+		}
+
+		<$src := newVar "src">
+		<$x := newVar "x">
+		// Scan reads <$name> from a value stored in a database.
+		//
+		// This implements sql.Scanner.
+		func (<$v> *<$name>) Scan(<$src> interface{}) error {
+			<if .NullType ->
+				var <$x> <import "database/sql">.<.NullType>
+				if err := <$x>.Scan(<$src>); err != nil {
+					return err
+				}
+				if !<$x>.Valid {
+					return <$fmt>.Errorf("cannot scan NULL into %q", "<$name>")
+				}
+				<if .Min ->
+					<$math := import "math">
+					if <$x>.<.NullField> <"<"> <$math>.<.Min> || <$x>.<.NullField> <">"> <$math>.<.Max> {
+						return <$fmt>.Errorf("value %d out of range for %q", <$x>.<.NullField>, "<$name>")
+					}
+				<end ->
+				*<$v> = (<$name>)(<$x>.<.NullField>)
+				return nil
+			<- else ->
+				switch <$x> := <$src>.(type) {
+				case []byte:
+					*<$v> = (<$name>)(append([]byte(nil), <$x>...))
+					return nil
+				case string:
+					*<$v> = (<$name>)(<$x>)
+					return nil
+				default:
+					return <$fmt>.Errorf("cannot scan %T into %q", <$src>, "<$name>")
+				}
+			<- end>
+		}
+		`,
+		data,
+	)
+	return wrapGenerateError(spec.Name, err)
+}
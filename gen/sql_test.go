@@ -0,0 +1,175 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tsn "go.uber.org/thriftrw/gen/internal/tests/sqlnames"
+	tsv "go.uber.org/thriftrw/gen/internal/tests/sqlvalues"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumSQL(t *testing.T) {
+	tests := []struct {
+		desc string
+
+		value     driver.Valuer
+		wantValue driver.Value
+	}{
+		{desc: "integer", value: tsv.StatusActive, wantValue: int64(5)},
+		{desc: "unknown integer", value: tsv.Status(42), wantValue: int64(42)},
+		{desc: "name", value: tsn.StatusActive, wantValue: "Active"},
+		{desc: "unknown name", value: tsn.Status(42), wantValue: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			v, err := tt.value.Value()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantValue, v)
+		})
+	}
+}
+
+func TestEnumSQLScan(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     interface{}
+		want    tsn.Status
+		wantErr string
+	}{
+		{desc: "integer", src: int64(5), want: tsn.StatusActive},
+		{desc: "name", src: "Inactive", want: tsn.StatusInactive},
+		{desc: "name bytes", src: []byte("Pending"), want: tsn.StatusPending},
+		{desc: "integer string", src: "42", want: tsn.Status(42)},
+		{
+			desc:    "overflow",
+			src:     int64(1 << 40),
+			wantErr: `enum value 1099511627776 out of range for "Status"`,
+		},
+		{
+			desc:    "unknown name",
+			src:     "Deleted",
+			wantErr: `unknown enum value "Deleted" for "Status"`,
+		},
+		{
+			desc:    "NULL",
+			src:     nil,
+			wantErr: `cannot scan <nil> into "Status"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got tsn.Status
+			err := got.Scan(tt.src)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTypedefSQL(t *testing.T) {
+	tests := []struct {
+		desc      string
+		value     driver.Valuer
+		wantValue driver.Value
+		scanner   sql.Scanner
+	}{
+		{desc: "bool", value: tsv.Flag(true), wantValue: true, scanner: new(tsv.Flag)},
+		{desc: "byte", value: tsv.Tiny(-8), wantValue: int64(-8), scanner: new(tsv.Tiny)},
+		{desc: "i16", value: tsv.Small(300), wantValue: int64(300), scanner: new(tsv.Small)},
+		{desc: "i32", value: tsv.Count(42), wantValue: int64(42), scanner: new(tsv.Count)},
+		{desc: "i64", value: tsv.Timestamp(1 << 40), wantValue: int64(1 << 40), scanner: new(tsv.Timestamp)},
+		{desc: "double", value: tsv.Ratio(0.5), wantValue: 0.5, scanner: new(tsv.Ratio)},
+		{desc: "string", value: tsv.Name("foo"), wantValue: "foo", scanner: new(tsv.Name)},
+		{desc: "binary", value: tsv.Blob("foo"), wantValue: []byte("foo"), scanner: new(tsv.Blob)},
+		{desc: "typedef of typedef", value: tsv.Total(42), wantValue: int64(42), scanner: new(tsv.Total)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			v, err := tt.value.Value()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantValue, v)
+
+			require.NoError(t, tt.scanner.Scan(v))
+			assert.Equal(t, tt.value, reflect.ValueOf(tt.scanner).Elem().Interface())
+		})
+	}
+
+	// Values of other typedefs can't be stored directly.
+	for _, v := range []interface{}{tsv.Names(nil), tsv.State(0)} {
+		_, ok := v.(driver.Valuer)
+		assert.False(t, ok, "%T must not implement driver.Valuer", v)
+	}
+}
+
+func TestTypedefSQLScan(t *testing.T) {
+	var tiny tsv.Tiny
+	assert.EqualError(t, tiny.Scan(int64(300)), `value 300 out of range for "Tiny"`)
+
+	var count tsv.Count
+	require.NoError(t, count.Scan("42"))
+	assert.Equal(t, tsv.Count(42), count)
+
+	var name tsv.Name
+	assert.EqualError(t, name.Scan(nil), `cannot scan NULL into "Name"`)
+
+	var blob tsv.Blob
+	src := []byte("foo")
+	require.NoError(t, blob.Scan(src))
+	src[0] = 'g'
+	assert.Equal(t, tsv.Blob("foo"), blob, "scanned bytes must be copied")
+	assert.EqualError(t, blob.Scan(int64(1)), `cannot scan int64 into "Blob"`)
+}
+
+func TestSQLEnumNamesRequiresSQL(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-sql")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	module, err := compile.Compile("internal/tests/thrift/sqlnames.thrift")
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/internal/tests",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		SQLEnumNames:  true,
+	})
+	assert.EqualError(t, err, "SQLEnumNames requires SQL")
+}
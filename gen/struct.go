@@ -51,34 +51,87 @@ func (s *structGenerator) Reader(g Generator, spec *compile.StructSpec) (string,
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+func (s *structGenerator) Decoder(g Generator, spec *compile.StructSpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$v := newVar "v">
+		<$sr := newVar "sr">
+		func <.Name>(<$sr> <$stream>.Reader) (<typeReference .Spec>, error) {
+			var <$v> <typeName .Spec>
+			err := <$v>.Decode(<$sr>)
+			return &<$v>, err
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.StructSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 func structure(g Generator, spec *compile.StructSpec) error {
-	name, err := goName(spec)
+	name, err := goName(g, spec)
 	if err != nil {
 		return err
 	}
 
+	bits, err := presenceBits(g, spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	hashable, err := isHashableStruct(spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	viewable, err := isViewStruct(spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	buildable, err := hasBuilder(g, spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	errorCode, err := exceptionErrorCode(spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	fg := fieldGroupGenerator{
-		Namespace:   NewNamespace(),
-		Name:        name,
-		Doc:         spec.Doc,
-		Fields:      spec.Fields,
-		IsUnion:     spec.Type == ast.UnionType,
-		IsException: spec.Type == ast.ExceptionType,
+		Namespace:    NewNamespace(),
+		Name:         name,
+		ThriftName:   spec.Name,
+		Doc:          deprecatedDoc(spec.Doc, spec.Annotations),
+		Fields:       spec.Fields,
+		IsUnion:      spec.Type == ast.UnionType,
+		IsException:  spec.Type == ast.ExceptionType,
+		Hashable:     hashable,
+		Viewable:     viewable,
+		Buildable:    buildable,
+		ErrorCode:    errorCode,
+		PresenceBits: bits,
 	}
 
 	if err := fg.Generate(g); err != nil {
 		return wrapGenerateError(spec.ThriftName(), err)
 	}
 
+	if spec.Type == ast.UnionType && !checkMinimal(g) {
+		if err := unionVariants(g, fg); err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+	}
+
 	if spec.Type == ast.ExceptionType {
-		err := g.DeclareFromTemplate(
-			`
-			<$v := newVar "v">
-			func (<$v> *<typeName .>) Error() string {
-				return <$v>.String()
-			}
-			`, spec)
-		if err != nil {
+		if err := exception(g, spec, errorCode); err != nil {
 			return wrapGenerateError(spec.ThriftName(), err)
 		}
 	}
@@ -89,3 +142,93 @@ func structure(g Generator, spec *compile.StructSpec) error {
 	// TODO(abg): For exceptions, handle the case where a field is named
 	// Error.
 }
+
+// exception generates the methods which make exceptions idiomatic Go
+// errors, along with a sentinel which matches all errors of the exception
+// type with errors.Is. If errorCode is non-empty, the exception also gets an
+// ErrorCode method. See ErrorCodeLabel.
+func exception(g Generator, spec *compile.StructSpec, errorCode string) error {
+	var causes []*compile.FieldSpec
+	for _, f := range spec.Fields {
+		if s, ok := f.Type.(*compile.StructSpec); ok && s.IsExceptionType() {
+			causes = append(causes, f)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$errors := import "errors">
+		<$v := newVar "v">
+		<$target := newVar "target">
+		<$name := typeName .Spec>
+
+		// Err<$name> matches all <$name> errors with errors.Is.
+		//
+		//   if errors.Is(err, Err<$name>) {
+		//     ...
+		//   }
+		var Err<$name> = <$errors>.New("<.Spec.Name>")
+
+		<if checkMinimal ->
+			// Error returns the name of this exception. Code generated with
+			// --minimal has no String method to describe its fields.
+			func (*<$name>) Error() string {
+				return "<.Spec.Name>"
+			}
+		<- else ->
+			func (<$v> *<$name>) Error() string {
+				return <$v>.String()
+			}
+		<- end>
+
+		// ErrorName is the name of this type as defined in the Thrift
+		// file.
+		func (*<$name>) ErrorName() string {
+			return "<.Spec.Name>"
+		}
+
+		<if .ErrorCode ->
+			// ErrorCode is the code given to this exception with the error.code
+			// annotation in the Thrift file.
+			func (*<$name>) ErrorCode() string {
+				return "<.ErrorCode>"
+			}
+		<- end>
+
+		// Unwrap returns the first field of this <$name> which holds an
+		// exception and is set, or nil if there isn't one.
+		func (<$v> *<$name>) Unwrap() error {
+			<- if .Causes>
+				if <$v> == nil {
+					return nil
+				}
+				<range .Causes>
+					<- if lazy .>
+						<- $c := newVar "c">
+						if <$c> := <$v>.Get<goName .>(); <$c> != nil {
+							return <$c>
+						}
+					<- else>
+						if <$v>.<goName .> != nil {
+							return <$v>.<goName .>
+						}
+					<- end>
+				<end>
+			<end>
+			return nil
+		}
+
+		// Is reports whether the given target is Err<$name>.
+		func (*<$name>) Is(<$target> error) bool {
+			return <$target> == Err<$name>
+		}
+		`,
+		struct {
+			Spec      *compile.StructSpec
+			Causes    []*compile.FieldSpec
+			ErrorCode string
+		}{Spec: spec, Causes: causes, ErrorCode: errorCode},
+		TemplateFunc("checkMinimal", checkMinimal),
+		TemplateFunc("lazy", lazyField),
+	)
+}
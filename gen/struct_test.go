@@ -23,15 +23,20 @@ package gen
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tb "go.uber.org/thriftrw/gen/internal/tests/binaries"
+	tco "go.uber.org/thriftrw/gen/internal/tests/compact"
 	tc "go.uber.org/thriftrw/gen/internal/tests/containers"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
 	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	tp "go.uber.org/thriftrw/gen/internal/tests/presence"
 	tss "go.uber.org/thriftrw/gen/internal/tests/set_to_slice"
+	tsl "go.uber.org/thriftrw/gen/internal/tests/slice_sets"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
 	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
 	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
@@ -41,6 +46,30 @@ import (
 )
 
 func TestStructRoundTripAndString(t *testing.T) {
+	fullSample := &tp.Sample{Metric: "cpu"}
+	fullSample.SetTimestamp(42)
+	fullSample.SetValue(1.5)
+	fullSample.SetFinal(true)
+	fullSample.SetPriority(-1)
+	fullSample.SetShard(7)
+	fullSample.SetCount(3)
+	fullSample.SetToken("secret")
+	fullSample.SetHost("example.com")
+	fullSample.SetUnit(tp.UnitBytes)
+	fullSample.SetID(100)
+
+	zeroSample := &tp.Sample{Metric: "cpu"}
+	zeroSample.SetTimestamp(0)
+	zeroSample.SetFinal(false)
+	zeroSample.SetUnit(tp.UnitSeconds)
+
+	blue := tco.ColorBlue
+	id := tco.UUID("abc")
+	external := te.EnumDefaultBaz
+
+	label := "label"
+	summary := tb.Text("summary")
+
 	tests := []struct {
 		desc string
 		x    thriftType
@@ -248,6 +277,38 @@ func TestStructRoundTripAndString(t *testing.T) {
 			}}),
 			"Node{Value: 1, Tail: Node{Value: 2}}",
 		},
+		{
+			"Tree: mutually recursive structs",
+			&ts.Tree{
+				Name:     "root",
+				Children: ts.Trees{{Name: "child"}},
+				Branch: &ts.Branch{
+					Tree:    &ts.Tree{Name: "leaf"},
+					Sibling: &ts.Branch{Tree: &ts.Tree{Name: "other"}},
+				},
+			},
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("root")},
+				{ID: 2, Value: wire.NewValueList(
+					wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+						wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+							{ID: 1, Value: wire.NewValueString("child")},
+						}}),
+					}),
+				)},
+				{ID: 4, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueString("leaf")},
+					}})},
+					{ID: 2, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+							{ID: 1, Value: wire.NewValueString("other")},
+						}})},
+					}})},
+				}})},
+			}}),
+			"Tree{Name: root, Children: [Tree{Name: child}], Branch: Branch{Tree: Tree{Name: leaf}, Sibling: Branch{Tree: Tree{Name: other}}}}",
+		},
 		{
 			"Document: PDF",
 			&tu.Document{Pdf: []byte{1, 2, 3}},
@@ -466,10 +527,249 @@ func TestStructRoundTripAndString(t *testing.T) {
 			}),
 			"Bar{RequiredInt32ListField: [1 2], OptionalStringListField: [a b], RequiredTypedefStringListField: [a b], OptionalTypedefStringListField: [c d], RequiredFooListField: [Foo{StringField: a}], RequiredTypedefFooListField: [Foo{StringField: b}], RequiredStringListListField: [[x y]], RequiredTypedefStringListListField: [[x] [y]]}",
 		},
+		{
+			desc: "StringBinariesBlob",
+			x: &tb.Blob{
+				Name:    "foo",
+				Label:   &label,
+				Summary: &summary,
+				Raw:     []byte("raw"),
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueBinary([]byte("foo"))},
+				{ID: 2, Value: wire.NewValueBinary([]byte("label"))},
+				{ID: 3, Value: wire.NewValueBinary([]byte("summary"))},
+				{ID: 5, Value: wire.NewValueBinary([]byte("raw"))},
+			}}),
+		},
+		{
+			desc: "StringBinariesBundle",
+			x: &tb.Bundle{
+				Names:       []string{"a", "b"},
+				Tags:        map[tb.Text]struct{}{"c": {}},
+				Attachments: map[string][]byte{"d": []byte("e")},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+					wire.NewValueBinary([]byte("a")),
+					wire.NewValueBinary([]byte("b")),
+				}))},
+				{ID: 2, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+					wire.NewValueBinary([]byte("c")),
+				}))},
+				{ID: 3, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TBinary, []wire.MapItem{
+					{Key: wire.NewValueBinary([]byte("d")), Value: wire.NewValueBinary([]byte("e"))},
+				}))},
+			}}),
+		},
+		{
+			desc: "StringBinariesPayload",
+			x:    &tb.Payload{Text: &label},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueBinary([]byte("label"))},
+			}}),
+		},
+		{
+			"CompactPoint",
+			&tco.Point{X: 1, Y: 2},
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueDouble(1)},
+				{ID: 2, Value: wire.NewValueDouble(2)},
+			}}),
+			"",
+		},
+		{
+			"CompactPrimitives",
+			&tco.Primitives{
+				BoolField:      true,
+				ByteField:      1,
+				Int16Field:     2,
+				Int32Field:     3,
+				Int64Field:     4,
+				DoubleField:    5,
+				StringField:    "foo",
+				BinaryField:    []byte("bar"),
+				OptBoolField:   boolp(false),
+				OptInt64Field:  int64p(6),
+				OptStringField: stringp(""),
+				OptBinaryField: []byte("baz"),
+			},
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueBool(true)},
+				{ID: 2, Value: wire.NewValueI8(1)},
+				{ID: 3, Value: wire.NewValueI16(2)},
+				{ID: 4, Value: wire.NewValueI32(3)},
+				{ID: 5, Value: wire.NewValueI64(4)},
+				{ID: 6, Value: wire.NewValueDouble(5)},
+				{ID: 7, Value: wire.NewValueString("foo")},
+				{ID: 8, Value: wire.NewValueBinary([]byte("bar"))},
+				{ID: 9, Value: wire.NewValueBool(false)},
+				{ID: 13, Value: wire.NewValueI64(6)},
+				{ID: 15, Value: wire.NewValueString("")},
+				{ID: 16, Value: wire.NewValueBinary([]byte("baz"))},
+			}}),
+			"",
+		},
+		{
+			"CompactShape",
+			&tco.Shape{
+				Name:     "square",
+				Points:   tco.Points{{X: 0, Y: 0}, {X: 1, Y: 1}},
+				ID:       &id,
+				Color:    &blue,
+				Fill:     tco.ColorGreen,
+				External: &external,
+				Labels:   map[string]*tco.Point{"origin": {}},
+				Tags:     map[int32]struct{}{42: {}},
+				Parent: &tco.Shape{
+					Name:   "parent",
+					Points: tco.Points{},
+					Color:  &blue,
+				},
+			},
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("square")},
+				{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueDouble(0)},
+						{ID: 2, Value: wire.NewValueDouble(0)},
+					}}),
+					wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueDouble(1)},
+						{ID: 2, Value: wire.NewValueDouble(1)},
+					}}),
+				}))},
+				{ID: 3, Value: wire.NewValueString("abc")},
+				{ID: 4, Value: wire.NewValueI32(2)},
+				{ID: 5, Value: wire.NewValueI32(1)},
+				{ID: 6, Value: wire.NewValueI32(2)},
+				{ID: 7, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TStruct, []wire.MapItem{
+					{
+						Key: wire.NewValueString("origin"),
+						Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+							{ID: 1, Value: wire.NewValueDouble(0)},
+							{ID: 2, Value: wire.NewValueDouble(0)},
+						}}),
+					},
+				}))},
+				{ID: 8, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+					wire.NewValueI32(42),
+				}))},
+				{ID: 9, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueString("parent")},
+					{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{}))},
+					{ID: 4, Value: wire.NewValueI32(2)},
+					{ID: 5, Value: wire.NewValueI32(0)},
+				}})},
+			}}),
+			"",
+		},
+		{
+			"CompactGeometry",
+			&tco.Geometry{Collection: []*tco.Geometry{
+				{Point: &tco.Point{X: 1, Y: 2}},
+			}},
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 3, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+							{ID: 1, Value: wire.NewValueDouble(1)},
+							{ID: 2, Value: wire.NewValueDouble(2)},
+						}})},
+					}}),
+				}))},
+			}}),
+			"",
+		},
+		{
+			"CompactShapeError",
+			&tco.ShapeError{Message: stringp("foo")},
+			wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+			}}),
+			"",
+		},
+		{
+			desc: "PresenceRequiredOnly",
+			x: func() *tp.Sample {
+				v := &tp.Sample{Metric: "cpu"}
+				v.SetUnit(tp.UnitSeconds)
+				return v
+			}(),
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("cpu")},
+				{ID: 10, Value: wire.NewValueI32(0)},
+			}}),
+		},
+		{
+			desc: "PresenceAllSet",
+			x:    fullSample,
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("cpu")},
+				{ID: 2, Value: wire.NewValueI64(42)},
+				{ID: 3, Value: wire.NewValueDouble(1.5)},
+				{ID: 4, Value: wire.NewValueBool(true)},
+				{ID: 5, Value: wire.NewValueI8(-1)},
+				{ID: 6, Value: wire.NewValueI16(7)},
+				{ID: 7, Value: wire.NewValueI32(3)},
+				{ID: 8, Value: wire.NewValueString("secret")},
+				{ID: 9, Value: wire.NewValueString("example.com")},
+				{ID: 10, Value: wire.NewValueI32(1)},
+				{ID: 12, Value: wire.NewValueI64(100)},
+			}}),
+		},
+		{
+			desc: "PresenceZeroValues",
+			x:    zeroSample,
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("cpu")},
+				{ID: 2, Value: wire.NewValueI64(0)},
+				{ID: 4, Value: wire.NewValueBool(false)},
+				{ID: 10, Value: wire.NewValueI32(0)},
+			}}),
+		},
+		{
+			desc: "SliceSetsRequiredOnly",
+			x:    &tsl.Sets{Int32Set: []int32{}},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI32, []wire.Value{}))},
+			}}),
+		},
+		{
+			desc: "SliceSets",
+			x: &tsl.Sets{
+				Int32Set:         []int32{3, 1, 2},
+				TypedefStringSet: tsl.StringSet{"b", "a"},
+				ColorSet:         []tsl.Color{tsl.ColorBlue, tsl.ColorRed},
+				StringMapSet:     map[string]struct{}{"a": {}},
+				FooSet:           []*tsl.Foo{{StringField: "foo"}},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+					wire.NewValueI32(3),
+					wire.NewValueI32(1),
+					wire.NewValueI32(2),
+				}))},
+				{ID: 3, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+					wire.NewValueString("b"),
+					wire.NewValueString("a"),
+				}))},
+				{ID: 4, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+					wire.NewValueI32(2),
+					wire.NewValueI32(0),
+				}))},
+				{ID: 5, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+					wire.NewValueString("a"),
+				}))},
+				{ID: 7, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					singleFieldStruct(1, wire.NewValueString("foo")),
+				}))},
+			}}),
+		},
 	}
 
 	for _, tt := range tests {
-		assertRoundTrip(t, tt.x, tt.v, tt.desc)
+		assertRoundTrip(t, tt.x, tt.v, "%s", tt.desc)
 		if tt.s != "" {
 			assert.Equal(t, tt.s, tt.x.String(), "ToString: %v", tt.desc)
 		} else {
@@ -640,6 +940,35 @@ func TestStructStringWithMissingRequiredFields(t *testing.T) {
 	}
 }
 
+func TestStructStringRedacted(t *testing.T) {
+	tests := []struct {
+		i fmt.Stringer
+		o string
+	}{
+		{
+			&ts.ZapOptOutStruct{Name: "foo", Optout: "bar"},
+			"ZapOptOutStruct{Name: foo, Optout: <redacted>}",
+		},
+		{
+			&ts.ZapRedactStruct{Name: "foo", Password: "hunter2"},
+			"ZapRedactStruct{Name: foo, Password: <redacted>}",
+		},
+		{
+			&ts.ZapRedactStruct{
+				Name:     "foo",
+				Password: "hunter2",
+				Token:    []byte("token"),
+				Secrets:  []string{"a", "b"},
+			},
+			"ZapRedactStruct{Name: foo, Password: <redacted>, Token: <redacted>, Secrets: <redacted>}",
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.o, tt.i.String())
+	}
+}
+
 func TestBasicException(t *testing.T) {
 	tests := []struct {
 		s tx.DoesNotExistException
@@ -1990,3 +2319,94 @@ func TestStructLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestStructJSONNames(t *testing.T) {
+	tests := []struct {
+		desc string
+		give ts.JSONNames
+		json string
+	}{
+		{
+			desc: "required only",
+			give: ts.JSONNames{UserName: "foo", CreatedAt: 1},
+			json: `{"user_name":"foo","createdAt":1}`,
+		},
+		{
+			desc: "all fields",
+			give: ts.JSONNames{
+				UserName:  "foo",
+				UserID:    ptr.Int64(42),
+				Nickname:  ptr.String("bar"),
+				CreatedAt: 1,
+			},
+			json: `{"user_name":"foo","user_id":42,"nick_name":"bar","createdAt":1}`,
+		},
+		{
+			desc: "large i64",
+			give: ts.JSONNames{UserID: ptr.Int64(math.MaxInt64), CreatedAt: math.MinInt64},
+			json: `{"user_name":"","user_id":9223372036854775807,"createdAt":-9223372036854775808}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			b, err := json.Marshal(&tt.give)
+			require.NoError(t, err)
+			assert.Equal(t, tt.json, string(b))
+
+			var got ts.JSONNames
+			require.NoError(t, json.Unmarshal(b, &got))
+			assert.Equal(t, tt.give, got)
+		})
+	}
+}
+
+func TestStructJSONStringInt64(t *testing.T) {
+	tests := []struct {
+		desc    string
+		json    string
+		want    ts.JSONNames
+		wantErr string
+	}{
+		{
+			desc: "quoted i64",
+			json: `{"user_id":"9007199254740993","createdAt":"-9007199254740993"}`,
+			want: ts.JSONNames{UserID: ptr.Int64(9007199254740993), CreatedAt: -9007199254740993},
+		},
+		{
+			desc: "null i64",
+			json: `{"user_id":null,"createdAt":null}`,
+			want: ts.JSONNames{},
+		},
+		{
+			desc:    "fractional i64",
+			json:    `{"createdAt":1.5}`,
+			wantErr: "invalid syntax",
+		},
+		{
+			desc:    "non-numeric string",
+			json:    `{"user_id":"foo"}`,
+			wantErr: "foo",
+		},
+		{
+			desc:    "wrong type",
+			json:    `{"createdAt":true}`,
+			wantErr: "cannot unmarshal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got ts.JSONNames
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,561 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// DefaultTestDataContainerSize is the number of items in the containers,
+// strings, and binaries of the max fixtures if
+// TestDataOptions.MaxContainerSize is unset.
+const DefaultTestDataContainerSize = 100
+
+// TestDataOptions controls how the package of fixtures is generated by
+// GenerateTestData.
+type TestDataOptions struct {
+	// PackagePrefix, ThriftRoot, and NamespacePackages determine the import
+	// paths of the packages generated for the Thrift files as they do for
+	// Generate.
+	PackagePrefix     string
+	ThriftRoot        string
+	NamespacePackages bool
+
+	// Name of the generated package. Defaults to the name of the package
+	// generated for the Thrift file followed by "testdata".
+	PackageName string
+
+	// Number of items in the containers, strings, and binaries of the max
+	// fixtures. Defaults to DefaultTestDataContainerSize.
+	MaxContainerSize int
+}
+
+// GenerateTestData generates a Go package with fixtures for every struct,
+// union, and exception declared in the given Thrift file, for use in the
+// tests of code which handles them. Returns the contents of the only file
+// of the package.
+//
+// For a struct Foo, FooFixtures returns valid values keyed by the name of
+// the fixture, and FooInvalidFixtures returns the Binary encodings of values
+// which FromWire rejects. See TestData for the fixtures generated.
+func GenerateTestData(m *compile.Module, o *TestDataOptions) ([]byte, error) {
+	if !filepath.IsAbs(o.ThriftRoot) {
+		return nil, fmt.Errorf(
+			"ThriftRoot must be an absolute path: %q is not absolute",
+			o.ThriftRoot)
+	}
+
+	size := o.MaxContainerSize
+	if size == 0 {
+		size = DefaultTestDataContainerSize
+	}
+	if size < 0 {
+		return nil, fmt.Errorf("MaxContainerSize must not be negative: got %d", size)
+	}
+
+	packages, err := modulePackages(m, &Options{
+		PackagePrefix:     o.PackagePrefix,
+		ThriftRoot:        o.ThriftRoot,
+		NamespacePackages: o.NamespacePackages,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	importer := thriftPackageImporter{
+		ImportPrefix: o.PackagePrefix,
+		ThriftRoot:   o.ThriftRoot,
+		Packages:     packages,
+	}
+
+	importPath, err := importer.Package(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+
+	packageName := o.PackageName
+	if packageName == "" {
+		packageRelPath, err := importer.RelativePackage(m.ThriftPath)
+		if err != nil {
+			return nil, err
+		}
+		packageName = filepath.Base(packageRelPath) + "testdata"
+	}
+
+	g := NewGenerator(&GeneratorOptions{
+		Importer:    importer,
+		ImportPath:  filepath.Join(importPath, packageName),
+		PackageName: packageName,
+	})
+
+	var hasFixtures bool
+	for _, typeName := range sortStringKeys(m.Types) {
+		spec, ok := m.Types[typeName].(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+
+		if !hasFixtures {
+			if err := testDataDecoder(g); err != nil {
+				return nil, err
+			}
+			hasFixtures = true
+		}
+
+		if err := TestData(g, spec, size); err != nil {
+			return nil, generateError{Name: m.ThriftPath, Reason: err}
+		}
+	}
+
+	var buff bytes.Buffer
+	if err := g.Write(&buff, nil); err != nil {
+		return nil, fmt.Errorf("could not write fixtures for %q: %v", m.ThriftPath, err)
+	}
+	return buff.Bytes(), nil
+}
+
+// testDataFixture is a named value of a struct.
+type testDataFixture struct {
+	Name        string
+	Description string
+	Value       string // quoted Binary encoding of the value
+}
+
+// testDataDecoder declares the function used by the fixtures to decode
+// their Binary encodings.
+func testDataDecoder(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$protocol := import "go.uber.org/thriftrw/protocol">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$b := newVar "b">
+		<$v := newVar "v">
+		// decodeFixture decodes the Binary encoding of a fixture into v. The
+		// fixtures are valid so any failure to decode them is a bug.
+		func decodeFixture(<$b> []byte, <$v> interface{ FromWire(<$wire>.Value) error }) {
+			w, err := <$protocol>.Binary.Decode(<$bytes>.NewReader(<$b>), <$wire>.TStruct)
+			if err != nil {
+				panic(err)
+			}
+			if err := <$v>.FromWire(w); err != nil {
+				panic(err)
+			}
+		}
+		`, nil)
+}
+
+// TestData generates functions returning fixtures of the given struct,
+// union, or exception into a package other than that of the struct.
+//
+// The valid fixtures of a struct or exception are:
+//
+// 	required: only the required fields are set
+// 	full: all fields are set, including those of nested structs
+// 	zero: all fields are set to their zero values
+// 	min: all fields are set to their smallest values
+// 	max: all fields are set to their largest values, and their strings,
+// 	binaries, and containers have size items
+//
+// Nested structs have only their required fields set in the zero, min, and
+// max fixtures. Unions have a valid fixture named after each of their
+// fields with only that field set.
+//
+// The invalid fixtures are the full fixture without each required field,
+// named "missing" followed by the name of the field. For unions, they are
+// "empty" with no fields set and "two fields" with two of them set.
+//
+// Fixtures which can't be built, for example because a struct requires
+// itself, are omitted. Fixtures don't respect validate annotations.
+func TestData(g Generator, spec *compile.StructSpec, size int) error {
+	valid, invalid, err := testDataFixtures(spec, size)
+	if err != nil {
+		return err
+	}
+
+	name, err := goName(g, spec)
+	if err != nil {
+		return err
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$fixtures := newVar "fixtures">
+		<$name := newVar "name">
+		<$b := newVar "b">
+		<$v := newVar "v">
+
+		<$type := typeName .Spec>
+		// <.Name>Fixtures returns valid values of <$type> keyed by the name of
+		// the fixture:
+		//
+		<range .Valid ->
+		// 	<.Name>: <.Description>
+		<end ->
+		func <.Name>Fixtures() map[string]*<$type> {
+			<$fixtures> := make(map[string]*<$type>, <len .Valid>)
+			for <$name>, <$b> := range map[string][]byte{
+				<range .Valid ->
+					<printf "%q" .Name>: []byte(<.Value>),
+				<end>
+			} {
+				var <$v> <$type>
+				decodeFixture(<$b>, &<$v>)
+				<$fixtures>[<$name>] = &<$v>
+			}
+			return <$fixtures>
+		}
+
+		// <.Name>InvalidFixtures returns the Binary encodings of values of
+		// <$type> which fail to decode, keyed by the name of the fixture.
+		<if .Invalid ->
+		//
+		<range .Invalid ->
+		// 	<.Name>: <.Description>
+		<end ->
+		<end ->
+		func <.Name>InvalidFixtures() map[string][]byte {
+			return map[string][]byte{
+				<range .Invalid ->
+					<printf "%q" .Name>: []byte(<.Value>),
+				<end>
+			}
+		}
+		`,
+		struct {
+			Name    string
+			Spec    *compile.StructSpec
+			Valid   []testDataFixture
+			Invalid []testDataFixture
+		}{Name: name, Spec: spec, Valid: valid, Invalid: invalid})
+}
+
+// testDataFixtures builds the valid and invalid fixtures of the given
+// struct in the order in which they're documented by TestData.
+func testDataFixtures(spec *compile.StructSpec, size int) (valid, invalid []testDataFixture, err error) {
+	add := func(fixtures *[]testDataFixture, name, desc string, v wire.Value) {
+		if err != nil {
+			return
+		}
+
+		var buf bytes.Buffer
+		if err = protocol.Binary.Encode(v, &buf); err != nil {
+			return
+		}
+		*fixtures = append(*fixtures, testDataFixture{
+			Name:        name,
+			Description: desc,
+			Value:       strconv.Quote(buf.String()),
+		})
+	}
+
+	if spec.Type == ast.UnionType {
+		var set []wire.Field
+		for _, f := range spec.Fields {
+			v, ok := benchmarkFixture(f.Type, 1)
+			if !ok {
+				continue
+			}
+			field := wire.Field{ID: f.ID, Value: v}
+			set = append(set, field)
+			add(&valid, f.Name, fmt.Sprintf("only %v is set", f.Name),
+				wire.NewValueStruct(wire.Struct{Fields: []wire.Field{field}}))
+		}
+
+		add(&invalid, "empty", "no fields are set", wire.NewValueStruct(wire.Struct{}))
+		if len(set) > 1 {
+			add(&invalid, "two fields", "two fields are set",
+				wire.NewValueStruct(wire.Struct{Fields: set[:2]}))
+		}
+		return valid, invalid, err
+	}
+
+	kinds := []struct {
+		Name        string
+		Description string
+		Kind        testDataKind
+	}{
+		{"required", "only the required fields are set", requiredTestData},
+		{"full", "all fields are set", fullTestData},
+		{"zero", "all fields are set to their zero values", zeroTestData},
+		{"min", "all fields are set to their smallest values", minTestData},
+		{"max", fmt.Sprintf("all fields are set to their largest values and sizes (%d items)", size), maxTestData},
+	}
+	for _, k := range kinds {
+		if v, ok := testDataStruct(spec, k.Kind, size, 0); ok {
+			add(&valid, k.Name, k.Description, v)
+		}
+	}
+
+	full, ok := benchmarkFixture(spec, 0)
+	if !ok {
+		return valid, invalid, err
+	}
+	for _, f := range spec.Fields {
+		if !f.Required {
+			continue
+		}
+
+		var fields []wire.Field
+		for _, field := range full.GetStruct().Fields {
+			if field.ID != f.ID {
+				fields = append(fields, field)
+			}
+		}
+		add(&invalid, "missing "+f.Name, fmt.Sprintf("%v is not set", f.Name),
+			wire.NewValueStruct(wire.Struct{Fields: fields}))
+	}
+	return valid, invalid, err
+}
+
+// testDataKind specifies the values used for a fixture.
+type testDataKind int
+
+const (
+	requiredTestData testDataKind = iota
+	fullTestData
+	zeroTestData
+	minTestData
+	maxTestData
+)
+
+// testDataStruct builds a fixture of the given kind for a struct, union, or
+// exception. Nested structs have only their required fields set, or exactly
+// one field if they're unions.
+func testDataStruct(spec *compile.StructSpec, kind testDataKind, size, depth int) (wire.Value, bool) {
+	if kind == fullTestData {
+		return benchmarkFixture(spec, depth)
+	}
+
+	// Required struct fields which lead back to the same struct can't be
+	// satisfied by any value.
+	if depth > 2*benchmarkFixtureDepth {
+		return wire.Value{}, false
+	}
+
+	isUnion := spec.Type == ast.UnionType
+
+	var fields []wire.Field
+	for _, f := range spec.Fields {
+		if !f.Required && !isUnion && (depth > 0 || kind == requiredTestData) {
+			continue
+		}
+
+		v, ok := testDataValue(f.Type, kind, size, depth+1)
+		if !ok {
+			if f.Required {
+				return wire.Value{}, false
+			}
+			continue
+		}
+		fields = append(fields, wire.Field{ID: f.ID, Value: v})
+
+		// Unions must have exactly one field set.
+		if isUnion {
+			break
+		}
+	}
+
+	if isUnion && len(fields) == 0 {
+		return wire.Value{}, false
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), true
+}
+
+// testDataValue builds a value of the given type for a field of a fixture
+// of the given kind.
+//
+// The items of the containers in max fixtures are the same as those of
+// full fixtures. Sets and maps may have fewer than size items if their
+// items can't have that many distinct values.
+func testDataValue(spec compile.TypeSpec, kind testDataKind, size, depth int) (wire.Value, bool) {
+	if kind == requiredTestData || kind == fullTestData {
+		if s, ok := compile.RootTypeSpec(spec).(*compile.StructSpec); ok {
+			return testDataStruct(s, kind, size, depth)
+		}
+		return benchmarkFixture(spec, depth)
+	}
+
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return wire.NewValueBool(kind == maxTestData), true
+	case *compile.I8Spec:
+		return wire.NewValueI8(int8(testDataInt(kind, math.MinInt8, math.MaxInt8))), true
+	case *compile.I16Spec:
+		return wire.NewValueI16(int16(testDataInt(kind, math.MinInt16, math.MaxInt16))), true
+	case *compile.I32Spec:
+		return wire.NewValueI32(int32(testDataInt(kind, math.MinInt32, math.MaxInt32))), true
+	case *compile.I64Spec:
+		return wire.NewValueI64(testDataInt(kind, math.MinInt64, math.MaxInt64)), true
+	case *compile.DoubleSpec:
+		return wire.NewValueDouble(float64(testDataInt(kind, -1, 1)) * math.MaxFloat64), true
+	case *compile.StringSpec:
+		return wire.NewValueString(testDataString(kind, size)), true
+	case *compile.BinarySpec:
+		return wire.NewValueBinary([]byte(testDataString(kind, size))), true
+	case *compile.EnumSpec:
+		var values []int
+		for _, item := range s.Items {
+			values = append(values, int(item.Value))
+		}
+		sort.Ints(values)
+
+		var value int32
+		if len(values) > 0 {
+			switch kind {
+			case minTestData:
+				value = int32(values[0])
+			case maxTestData:
+				value = int32(values[len(values)-1])
+			}
+		}
+		return wire.NewValueI32(value), true
+	case *compile.ListSpec:
+		var items []wire.Value
+		if kind == maxTestData {
+			v, ok := benchmarkFixture(s.ValueSpec, depth)
+			if !ok {
+				return wire.Value{}, false
+			}
+			for i := 0; i < size; i++ {
+				items = append(items, v)
+			}
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), true
+	case *compile.SetSpec:
+		var items []wire.Value
+		if kind == maxTestData {
+			items = testDataDistinct(s.ValueSpec, size, depth)
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), true
+	case *compile.MapSpec:
+		var items []wire.MapItem
+		if kind == maxTestData {
+			keys := testDataDistinct(s.KeySpec, size, depth)
+			if len(keys) > 0 {
+				v, ok := benchmarkFixture(s.ValueSpec, depth)
+				if !ok {
+					return wire.Value{}, false
+				}
+				for _, k := range keys {
+					items = append(items, wire.MapItem{Key: k, Value: v})
+				}
+			}
+		}
+		return wire.NewValueMap(wire.MapItemListFromSlice(s.KeySpec.TypeCode(), s.ValueSpec.TypeCode(), items)), true
+	case *compile.StructSpec:
+		if kind == maxTestData {
+			return benchmarkFixture(s, depth)
+		}
+		return testDataStruct(s, kind, size, depth)
+	default:
+		return wire.Value{}, false
+	}
+}
+
+// testDataInt returns the integer used for the given kind of fixture.
+func testDataInt(kind testDataKind, min, max int64) int64 {
+	switch kind {
+	case minTestData:
+		return min
+	case maxTestData:
+		return max
+	default:
+		return 0
+	}
+}
+
+// testDataString returns the string used for the given kind of fixture.
+func testDataString(kind testDataKind, size int) string {
+	if kind == maxTestData {
+		return strings.Repeat("x", size)
+	}
+	return ""
+}
+
+// testDataDistinct returns up to n distinct values of the given type. Only
+// a single value is returned for types other than primitives and enums.
+func testDataDistinct(spec compile.TypeSpec, n, depth int) []wire.Value {
+	var values []wire.Value
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		for i := 0; i < n && i < 2; i++ {
+			values = append(values, wire.NewValueBool(i == 1))
+		}
+	case *compile.I8Spec:
+		for i := 0; i < n && i <= math.MaxInt8; i++ {
+			values = append(values, wire.NewValueI8(int8(i)))
+		}
+	case *compile.I16Spec:
+		for i := 0; i < n && i <= math.MaxInt16; i++ {
+			values = append(values, wire.NewValueI16(int16(i)))
+		}
+	case *compile.I32Spec:
+		for i := 0; i < n; i++ {
+			values = append(values, wire.NewValueI32(int32(i)))
+		}
+	case *compile.I64Spec:
+		for i := 0; i < n; i++ {
+			values = append(values, wire.NewValueI64(int64(i)))
+		}
+	case *compile.DoubleSpec:
+		for i := 0; i < n; i++ {
+			values = append(values, wire.NewValueDouble(float64(i)))
+		}
+	case *compile.StringSpec:
+		for i := 0; i < n; i++ {
+			values = append(values, wire.NewValueString(strconv.Itoa(i)))
+		}
+	case *compile.BinarySpec:
+		for i := 0; i < n; i++ {
+			values = append(values, wire.NewValueBinary([]byte(strconv.Itoa(i))))
+		}
+	case *compile.EnumSpec:
+		// Items of an enum may share values.
+		seen := make(map[int32]struct{})
+		for _, item := range s.Items {
+			if _, ok := seen[item.Value]; ok || len(values) == n {
+				continue
+			}
+			seen[item.Value] = struct{}{}
+			values = append(values, wire.NewValueI32(item.Value))
+		}
+	default:
+		if n > 0 {
+			if v, ok := benchmarkFixture(spec, depth); ok {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structstestdata"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestDataIsUpToDate(t *testing.T) {
+	// If this test fails, run 'make structstestdata' in the
+	// internal/tests/ directory and commit the changes.
+
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	module, err := compile.Compile(filepath.Join(thriftRoot, "structs.thrift"))
+	require.NoError(t, err)
+
+	got, err := GenerateTestData(module, &TestDataOptions{
+		PackagePrefix:    "go.uber.org/thriftrw/gen/internal/tests",
+		ThriftRoot:       thriftRoot,
+		MaxContainerSize: 3,
+	})
+	require.NoError(t, err)
+
+	want, err := ioutil.ReadFile("internal/tests/structstestdata/structstestdata.go")
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(want, got),
+		"Fixtures for structs.thrift are out of date. "+
+			"Please run 'make structstestdata' in gen/internal/tests.")
+}
+
+func TestTestDataFixtures(t *testing.T) {
+	tests := []struct {
+		fixtures interface{} // func() map[string]*T
+		invalid  func() map[string][]byte
+	}{
+		{ts.BranchFixtures, ts.BranchInvalidFixtures},
+		{ts.ContactInfoFixtures, ts.ContactInfoInvalidFixtures},
+		{ts.DefaultsStructFixtures, ts.DefaultsStructInvalidFixtures},
+		{ts.EdgeFixtures, ts.EdgeInvalidFixtures},
+		{ts.EmptyStructFixtures, ts.EmptyStructInvalidFixtures},
+		{ts.FrameFixtures, ts.FrameInvalidFixtures},
+		{ts.GoTagsFixtures, ts.GoTagsInvalidFixtures},
+		{ts.GraphFixtures, ts.GraphInvalidFixtures},
+		{ts.JSONNamesFixtures, ts.JSONNamesInvalidFixtures},
+		{ts.NodeFixtures, ts.NodeInvalidFixtures},
+		{ts.OmitFixtures, ts.OmitInvalidFixtures},
+		{ts.PersonalInfoFixtures, ts.PersonalInfoInvalidFixtures},
+		{ts.PointFixtures, ts.PointInvalidFixtures},
+		{ts.PrimitiveOptionalStructFixtures, ts.PrimitiveOptionalStructInvalidFixtures},
+		{ts.PrimitiveRequiredStructFixtures, ts.PrimitiveRequiredStructInvalidFixtures},
+		{ts.RenameFixtures, ts.RenameInvalidFixtures},
+		{ts.ShallowCopyStructFixtures, ts.ShallowCopyStructInvalidFixtures},
+		{ts.SizeFixtures, ts.SizeInvalidFixtures},
+		{ts.StructLabelsFixtures, ts.StructLabelsInvalidFixtures},
+		{ts.TreeFixtures, ts.TreeInvalidFixtures},
+		{ts.UnsignedStructFixtures, ts.UnsignedStructInvalidFixtures},
+		{ts.UserFixtures, ts.UserInvalidFixtures},
+		{ts.ValidatedAddressFixtures, ts.ValidatedAddressInvalidFixtures},
+		{ts.ValidatedStructFixtures, ts.ValidatedStructInvalidFixtures},
+		{ts.ZapOptOutStructFixtures, ts.ZapOptOutStructInvalidFixtures},
+		{ts.ZapRedactStructFixtures, ts.ZapRedactStructInvalidFixtures},
+	}
+
+	for _, tt := range tests {
+		// The fixtures panic if they fail to decode.
+		fixtures := reflect.ValueOf(tt.fixtures).Call(nil)[0]
+		typ := fixtures.Type().Elem().Elem()
+		t.Run(typ.Name(), func(t *testing.T) {
+			assert.NotEmpty(t, fixtures.Len(), "must have valid fixtures")
+			for name, b := range tt.invalid() {
+				assertInvalidFixture(t, reflect.New(typ).Interface().(thriftType), name, b)
+			}
+		})
+	}
+}
+
+func TestTestDataFixturesUnionsAndExceptions(t *testing.T) {
+	tests := []struct {
+		file    string
+		name    string
+		sample  thriftType
+		valid   []string
+		invalid []string
+	}{
+		{
+			file:    "unions.thrift",
+			name:    "ArbitraryValue",
+			sample:  &tu.ArbitraryValue{},
+			valid:   []string{"boolValue", "int64Value", "listValue", "mapValue", "stringValue"},
+			invalid: []string{"empty", "two fields"},
+		},
+		{
+			file:    "unions.thrift",
+			name:    "EmptyUnion",
+			sample:  &tu.EmptyUnion{},
+			invalid: []string{"empty"},
+		},
+		{
+			file:    "exceptions.thrift",
+			name:    "DoesNotExistException",
+			sample:  &tx.DoesNotExistException{},
+			valid:   []string{"full", "max", "min", "required", "zero"},
+			invalid: []string{"missing key"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := compile.Compile(filepath.Join("internal/tests/thrift", tt.file))
+			require.NoError(t, err)
+
+			valid, invalid, err := testDataFixtures(module.Types[tt.name].(*compile.StructSpec), 3)
+			require.NoError(t, err)
+
+			var validNames []string
+			for _, f := range valid {
+				validNames = append(validNames, f.Name)
+
+				v := reflect.New(reflect.TypeOf(tt.sample).Elem()).Interface().(thriftType)
+				assert.NoError(t, v.FromWire(decodeTestDataFixture(t, f)), "fixture %q must be valid", f.Name)
+			}
+			sort.Strings(validNames)
+			assert.Equal(t, tt.valid, validNames)
+
+			var invalidNames []string
+			for _, f := range invalid {
+				invalidNames = append(invalidNames, f.Name)
+			}
+			assert.Equal(t, tt.invalid, invalidNames)
+		})
+	}
+}
+
+func TestTestDataMaxFixture(t *testing.T) {
+	module, err := compile.Compile("internal/tests/thrift/containers.thrift")
+	require.NoError(t, err)
+
+	valid, _, err := testDataFixtures(module.Types["EnumContainers"].(*compile.StructSpec), 5)
+	require.NoError(t, err)
+
+	for _, f := range valid {
+		if f.Name != "max" {
+			continue
+		}
+
+		w := decodeTestDataFixture(t, f)
+
+		// Sets and maps of enums have as many items as the enum has
+		// distinct values.
+		for _, field := range w.GetStruct().Fields {
+			switch field.Value.Type() {
+			case wire.TList:
+				assert.Equal(t, 5, field.Value.GetList().Size(), "field %d", field.ID)
+			case wire.TSet:
+				assert.Equal(t, 3, field.Value.GetSet().Size(), "field %d", field.ID)
+			case wire.TMap:
+				assert.Equal(t, 2, field.Value.GetMap().Size(), "field %d", field.ID)
+			}
+		}
+		return
+	}
+	t.Fatal("max fixture not found")
+}
+
+func TestGenerateTestDataErrors(t *testing.T) {
+	module, err := compile.Compile("internal/tests/thrift/structs.thrift")
+	require.NoError(t, err)
+
+	_, err = GenerateTestData(module, &TestDataOptions{ThriftRoot: "internal/tests/thrift"})
+	assert.EqualError(t, err, `ThriftRoot must be an absolute path: "internal/tests/thrift" is not absolute`)
+
+	thriftRoot, err := filepath.Abs("internal/tests/thrift")
+	require.NoError(t, err)
+
+	_, err = GenerateTestData(module, &TestDataOptions{ThriftRoot: thriftRoot, MaxContainerSize: -1})
+	assert.EqualError(t, err, "MaxContainerSize must not be negative: got -1")
+}
+
+func decodeTestDataFixture(t *testing.T, f testDataFixture) wire.Value {
+	b, err := strconv.Unquote(f.Value)
+	require.NoError(t, err)
+
+	w, err := protocol.Binary.Decode(bytes.NewReader([]byte(b)), wire.TStruct)
+	require.NoError(t, err)
+	return w
+}
+
+func assertInvalidFixture(t *testing.T, v thriftType, name string, b []byte) {
+	w, err := protocol.Binary.Decode(bytes.NewReader(b), wire.TStruct)
+	require.NoError(t, err, "fixture %q must be a valid struct", name)
+	assert.Error(t, v.FromWire(w), "fixture %q must fail to decode", name)
+}
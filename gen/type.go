@@ -49,11 +49,26 @@ func isHashable(t compile.TypeSpec) bool {
 	return isPrimitiveType(t)
 }
 
-// setUsesMap returns true if the given set type is not annotated with
-// (go.type = "slice") and the value of the set is considered hashable
-// by thriftrw.
-func setUsesMap(spec *compile.SetSpec) bool {
-	return (spec.Annotations[goTypeKey] != sliceType) && isHashable(spec.ValueSpec)
+// setUsesMap returns true if the given set type is represented as a Go map
+// instead of a slice.
+//
+// Sets annotated with (go.type = "slice") or (go.type = "map") use that
+// representation. Other sets are slices if sliceSets is true and maps
+// otherwise. Sets of values which are not considered hashable by thriftrw
+// are always slices.
+func setUsesMap(spec *compile.SetSpec, sliceSets bool) bool {
+	if !isHashable(spec.ValueSpec) {
+		return false
+	}
+
+	switch spec.Annotations[goTypeKey] {
+	case sliceType:
+		return false
+	case mapType:
+		return true
+	default:
+		return !sliceSets
+	}
 }
 
 // isPrimitiveType returns true if the given type is a primitive type.
@@ -70,6 +85,10 @@ func isPrimitiveType(spec compile.TypeSpec) bool {
 		return true
 	}
 
+	if isStringBinary(spec) {
+		return true
+	}
+
 	_, isEnum := spec.(*compile.EnumSpec)
 	return isEnum
 }
@@ -80,7 +99,7 @@ func isPrimitiveType(spec compile.TypeSpec) bool {
 func isReferenceType(spec compile.TypeSpec) bool {
 	spec = compile.RootTypeSpec(spec)
 	if _, ok := spec.(*compile.BinarySpec); ok {
-		return true
+		return !isStringBinary(spec)
 	}
 
 	switch spec.(type) {
@@ -146,8 +165,22 @@ func typeName(g Generator, spec compile.TypeSpec) (string, error) {
 	case *compile.StringSpec:
 		return "string", nil
 	case *compile.BinarySpec:
-		return "[]byte", nil
+		switch t := s.Annotations[goTypeKey]; t {
+		case "":
+			return "[]byte", nil
+		case stringType:
+			return "string", nil
+		case readerType:
+			return g.Import("io") + ".Reader", nil
+		default:
+			return "", fmt.Errorf(
+				"unsupported representation for %v: (%v = %q) must be %q or %q",
+				s.ThriftName(), goTypeKey, t, stringType, readerType)
+		}
 	case *compile.MapSpec:
+		if isReaderBinary(s.KeySpec) || isReaderBinary(s.ValueSpec) {
+			return "", errReaderInContainer(s)
+		}
 		k, err := typeReference(g, s.KeySpec)
 		if err != nil {
 			return "", err
@@ -162,18 +195,28 @@ func typeName(g Generator, spec compile.TypeSpec) (string, error) {
 		}
 		return fmt.Sprintf("map[%s]%s", k, v), nil
 	case *compile.ListSpec:
+		if isReaderBinary(s.ValueSpec) {
+			return "", errReaderInContainer(s)
+		}
 		v, err := typeReference(g, s.ValueSpec)
 		if err != nil {
 			return "", err
 		}
 		return "[]" + v, nil
 	case *compile.SetSpec:
+		if isReaderBinary(s.ValueSpec) {
+			return "", errReaderInContainer(s)
+		}
 		v, err := typeReference(g, s.ValueSpec)
 		if err != nil {
 			return "", err
 		}
-		// not annotated to be slice and hashable value type
-		if setUsesMap(s) {
+		if s.Annotations[goTypeKey] == mapType && !isHashable(s.ValueSpec) {
+			return "", fmt.Errorf(
+				"%v cannot be represented as a map: (%v = %q) requires values "+
+					"of primitive or enum types", s.ThriftName(), goTypeKey, mapType)
+		}
+		if setUsesMap(s, checkSliceSets(g)) {
 			return fmt.Sprintf("map[%s]struct{}", v), nil
 		}
 		return fmt.Sprintf("[]%s", v), nil
@@ -184,6 +227,15 @@ func typeName(g Generator, spec compile.TypeSpec) (string, error) {
 	}
 }
 
+// errReaderInContainer builds the error returned when a binary represented
+// as an io.Reader is used inside the given container type.
+func errReaderInContainer(spec compile.TypeSpec) error {
+	return fmt.Errorf(
+		"%v cannot contain binaries with (%v = %q): "+
+			"readers are supported only as field types",
+		spec.ThriftName(), goTypeKey, readerType)
+}
+
 func equalsFuncName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_Equals", g.MangleType(spec))
 }
@@ -192,10 +244,30 @@ func equalsPtrFuncName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_EqualsPtr", g.MangleType(spec))
 }
 
+func cloneFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Clone", g.MangleType(spec))
+}
+
+func clonePtrFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_ClonePtr", g.MangleType(spec))
+}
+
 func readerFuncName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_Read", g.MangleType(spec))
 }
 
+func decoderFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Decode", g.MangleType(spec))
+}
+
+func encoderFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Encode", g.MangleType(spec))
+}
+
+func toWireFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_ToWire", g.MangleType(spec))
+}
+
 func valueListName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_ValueList", g.MangleType(spec))
 }
@@ -0,0 +1,261 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+// typeMapper looks up the custom Go types provided by plugins for fields.
+//
+// A nil typeMapper maps no fields.
+type typeMapper struct {
+	plugin   plugin.TypeMapper
+	importer ThriftPackageImporter
+
+	// Mappings already retrieved from the plugin. A nil entry indicates that
+	// the field was not claimed.
+	mappings map[*compile.FieldSpec]*api.TypeMapping
+
+	// Whether sets are slices unless annotated otherwise
+	sliceSets bool
+
+	// How Go names are derived from Thrift names
+	casing Casing
+}
+
+// newTypeMapper builds a typeMapper backed by the given plugin. Returns nil
+// if tm is nil.
+func newTypeMapper(tm plugin.TypeMapper, i ThriftPackageImporter, sliceSets bool, casing Casing) *typeMapper {
+	if tm == nil {
+		return nil
+	}
+	return &typeMapper{
+		plugin:    tm,
+		importer:  i,
+		mappings:  make(map[*compile.FieldSpec]*api.TypeMapping),
+		sliceSets: sliceSets,
+		casing:    casing,
+	}
+}
+
+// MapField returns the custom Go type for the given field, or nil if the
+// field was not claimed by a plugin.
+//
+// Plugins are consulted only for fields that have annotations.
+func (m *typeMapper) MapField(f *compile.FieldSpec) (*api.TypeMapping, error) {
+	if m == nil || len(f.Annotations) == 0 {
+		return nil, nil
+	}
+
+	if mapping, ok := m.mappings[f]; ok {
+		return mapping, nil
+	}
+
+	t, err := buildType(m.importer, f.Type, true, m.sliceSets, m.casing)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := m.plugin.MapType(&api.MapTypeRequest{
+		Type:        t,
+		Annotations: f.Annotations,
+		FieldName:   f.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Mapping != nil && f.Default != nil {
+		return nil, fmt.Errorf(
+			"field %q cannot have a default value: it uses a custom type", f.Name)
+	}
+
+	m.mappings[f] = res.Mapping
+	return res.Mapping, nil
+}
+
+// fieldMapping is a custom Go type for a field ready to be used in generated
+// code.
+type fieldMapping struct {
+	// Reference to the custom type.
+	Type string
+
+	// Functions converting the custom type to and from the type ThriftRW
+	// would otherwise use for the field.
+	ToThrift   string
+	FromThrift string
+
+	// Function comparing two values of the custom type. If empty, values
+	// should be compared with ==.
+	Equals string
+}
+
+// mappedField returns the custom Go type for the given field, or nil if the
+// field was not claimed by a plugin.
+//
+// Fields annotated with UnsignedLabel are mapped to unsigned integers, and
+// fields of structs annotated with WellKnownTypeLabel to the Go types
+// representing them, without consulting plugins.
+func mappedField(g Generator, f *compile.FieldSpec) (*fieldMapping, error) {
+	if m, err := unsignedField(g, f); err != nil || m != nil {
+		return m, err
+	}
+
+	if m, err := wellKnownTypeField(g, f); err != nil || m != nil {
+		return m, err
+	}
+
+	gen, ok := g.(*generator)
+	if !ok {
+		return nil, nil
+	}
+
+	m, err := gen.typeMapper.MapField(f)
+	if err != nil || m == nil {
+		return nil, err
+	}
+
+	typ, err := apiTypeReference(gen, m.Type)
+	if err != nil {
+		return nil, fmt.Errorf("invalid type for field %q: %v", f.Name, err)
+	}
+
+	fm := fieldMapping{
+		Type:       typ,
+		ToThrift:   apiFunctionReference(gen, m.ToThrift),
+		FromThrift: apiFunctionReference(gen, m.FromThrift),
+	}
+	if m.EqualsFunc != nil {
+		fm.Equals = apiFunctionReference(gen, m.EqualsFunc)
+	}
+	return &fm, nil
+}
+
+// fieldTypeReference returns a reference to the Go type used for the given
+// field. This is a pointer to the field's type if the field is optional and
+// its type is not a reference type.
+func fieldTypeReference(g Generator, f *compile.FieldSpec) (string, error) {
+	m, err := mappedField(g, f)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case m != nil && f.Required:
+		return m.Type, nil
+	case m != nil:
+		return "*" + m.Type, nil
+	case f.Required:
+		return typeReference(g, f.Type)
+	default:
+		return typeReferencePtr(g, f.Type)
+	}
+}
+
+// apiTypeReference returns a reference to the Go type described by the
+// given plugin API type, importing packages as necessary.
+func apiTypeReference(g *generator, t *api.Type) (string, error) {
+	switch {
+	case t == nil:
+		return "", fmt.Errorf("type is not set")
+
+	case t.SimpleType != nil:
+		switch *t.SimpleType {
+		case api.SimpleTypeBool:
+			return "bool", nil
+		case api.SimpleTypeByte:
+			return "byte", nil
+		case api.SimpleTypeInt8:
+			return "int8", nil
+		case api.SimpleTypeInt16:
+			return "int16", nil
+		case api.SimpleTypeInt32:
+			return "int32", nil
+		case api.SimpleTypeInt64:
+			return "int64", nil
+		case api.SimpleTypeFloat64:
+			return "float64", nil
+		case api.SimpleTypeString:
+			return "string", nil
+		case api.SimpleTypeStructEmpty:
+			return "struct{}", nil
+		default:
+			return "", fmt.Errorf("unknown simple type %v", *t.SimpleType)
+		}
+
+	case t.SliceType != nil:
+		v, err := apiTypeReference(g, t.SliceType)
+		return "[]" + v, err
+
+	case t.KeyValueSliceType != nil:
+		k, err := apiTypeReference(g, t.KeyValueSliceType.Left)
+		if err != nil {
+			return "", err
+		}
+		v, err := apiTypeReference(g, t.KeyValueSliceType.Right)
+		return fmt.Sprintf("[]struct{Key %v; Value %v}", k, v), err
+
+	case t.MapType != nil:
+		k, err := apiTypeReference(g, t.MapType.Left)
+		if err != nil {
+			return "", err
+		}
+		v, err := apiTypeReference(g, t.MapType.Right)
+		return fmt.Sprintf("map[%v]%v", k, v), err
+
+	case t.ReferenceType != nil:
+		return qualifiedName(g, t.ReferenceType.ImportPath, t.ReferenceType.Name), nil
+
+	case t.PointerType != nil:
+		v, err := apiTypeReference(g, t.PointerType)
+		return "*" + v, err
+
+	default:
+		return "", fmt.Errorf("type is empty")
+	}
+}
+
+// apiFunctionReference returns a reference to the given function, importing
+// its package if necessary.
+func apiFunctionReference(g *generator, f *api.FunctionReference) string {
+	return qualifiedName(g, f.ImportPath, f.Name)
+}
+
+func qualifiedName(g *generator, importPath, name string) string {
+	if importPath == "" || importPath == g.ImportPath {
+		return name
+	}
+	return g.Import(importPath) + "." + name
+}
+
+// mappedEquals returns an expression comparing lhs and rhs, two values of the
+// given custom type.
+func mappedEquals(m *fieldMapping, lhs, rhs string) string {
+	if m.Equals == "" {
+		return fmt.Sprintf("(%v == %v)", lhs, rhs)
+	}
+	return fmt.Sprintf("%v(%v, %v)", m.Equals, lhs, rhs)
+}
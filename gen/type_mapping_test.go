@@ -0,0 +1,180 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timeMapper returns a MockTypeMapper which maps all fields annotated with
+// (time.unix) to time.Time.
+func timeMapper(mockCtrl *gomock.Controller) *handletest.MockTypeMapper {
+	tm := handletest.NewMockTypeMapper(mockCtrl)
+	tm.EXPECT().MapType(gomock.Any()).
+		DoAndReturn(func(req *api.MapTypeRequest) (*api.MapTypeResponse, error) {
+			if _, ok := req.Annotations["time.unix"]; !ok {
+				return &api.MapTypeResponse{}, nil
+			}
+			return &api.MapTypeResponse{
+				Mapping: &api.TypeMapping{
+					Type: &api.Type{
+						ReferenceType: &api.TypeReference{Name: "Time", ImportPath: "time"},
+					},
+					ToThrift:   &api.FunctionReference{Name: "ToUnix", ImportPath: "example.com/timeutil"},
+					FromThrift: &api.FunctionReference{Name: "FromUnix", ImportPath: "example.com/timeutil"},
+					EqualsFunc: &api.FunctionReference{Name: "Equal", ImportPath: "example.com/timeutil"},
+				},
+			}, nil
+		}).AnyTimes()
+	return tm
+}
+
+// generateWithTypeMapper generates code for the given Thrift file contents
+// with the given TypeMapper and returns the generated Go code.
+func generateWithTypeMapper(t *testing.T, mockCtrl *gomock.Controller, contents string, tm *handletest.MockTypeMapper) (string, error) {
+	dir, err := ioutil.TempDir("", "thriftrw-type-mapping")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	thriftRoot := filepath.Join(dir, "thrift")
+	outputDir := filepath.Join(dir, "out")
+	require.NoError(t, os.MkdirAll(thriftRoot, 0755))
+
+	path := filepath.Join(thriftRoot, "events.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	handle := handletest.NewMockHandle(mockCtrl)
+	handle.EXPECT().Validator().Return(nil)
+	handle.EXPECT().TypeMapper().Return(tm)
+	handle.EXPECT().ServiceGenerator().Return(nil).AnyTimes()
+	handle.EXPECT().PostProcessor().Return(nil).AnyTimes()
+
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "example.com/events",
+		ThriftRoot:    thriftRoot,
+		Plugin:        handle,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	code, err := ioutil.ReadFile(filepath.Join(outputDir, "events", "events.go"))
+	require.NoError(t, err)
+	return string(code), nil
+}
+
+func TestGenerateTypeMapping(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	code, err := generateWithTypeMapper(t, mockCtrl, `
+		struct Event {
+			1: required i64 createdAt (time.unix)
+			2: optional i64 deletedAt (time.unix)
+			3: optional i64 version (unrelated)
+		}
+
+		service Events {
+			void record(1: i64 at (time.unix))
+		}
+	`, timeMapper(mockCtrl))
+	require.NoError(t, err)
+
+	// Collapse whitespace so that the assertions don't depend on alignment.
+	code = strings.Join(strings.Fields(code), " ")
+
+	for _, want := range []string{
+		`CreatedAt time.Time`,
+		`DeletedAt *time.Time`,
+		`Version *int64`,
+		`x, err = timeutil.ToUnix(v.CreatedAt)`,
+		`x, err = timeutil.ToUnix(*v.DeletedAt)`,
+		`v.CreatedAt, err = timeutil.FromUnix(x)`,
+		`v.DeletedAt = &y`,
+		`if !timeutil.Equal(v.CreatedAt, rhs.CreatedAt) {`,
+		`func (v *Event) GetCreatedAt() (o time.Time)`,
+		`func (v *Event) GetDeletedAt() (o time.Time)`,
+		`func (v *Event) IsSetDeletedAt() bool`,
+		`enc.AddReflected("createdAt", v.CreatedAt)`,
+		`at *time.Time, ) *Events_Record_Args`,
+	} {
+		assert.Contains(t, code, want)
+	}
+
+	assert.NotContains(t, code, "IsSetCreatedAt",
+		"required fields with custom types must not have IsSet methods")
+}
+
+func TestGenerateTypeMappingErrors(t *testing.T) {
+	tests := []struct {
+		desc      string
+		thrift    string
+		wantError string
+	}{
+		{
+			desc: "default value",
+			thrift: `
+				struct Event {
+					1: optional i64 createdAt = 0 (time.unix)
+				}
+			`,
+			wantError: `field "createdAt" cannot have a default value: it uses a custom type`,
+		},
+		{
+			desc: "constant",
+			thrift: `
+				struct Event {
+					1: optional i64 createdAt (time.unix)
+				}
+
+				const Event epoch = {"createdAt": 0}
+			`,
+			wantError: `field "createdAt" of "Event" cannot be set in a constant: it uses a custom type`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			_, err := generateWithTypeMapper(t, mockCtrl, tt.thrift, timeMapper(mockCtrl))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantError)
+		})
+	}
+}
@@ -20,7 +20,11 @@
 
 package gen
 
-import "go.uber.org/thriftrw/compile"
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
 
 // typedefGenerator generates code to serialize and deserialize typedefs.
 type typedefGenerator struct{}
@@ -52,15 +56,47 @@ func (t *typedefGenerator) Reader(g Generator, spec *compile.TypedefSpec) (strin
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
 
+func (t *typedefGenerator) Decoder(g Generator, spec *compile.TypedefSpec) (string, error) {
+	name := decoderFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+		<$stream := import "go.uber.org/thriftrw/protocol/stream">
+
+		<$x := newVar "x">
+		<$sr := newVar "sr">
+		func <.Name>(<$sr> <$stream>.Reader) (<typeReference .Spec>, error) {
+			var <$x> <typeName .Spec>
+			err := <$x>.Decode(<$sr>)
+			<if isStructType .Spec.Target ->
+				return &<$x>, err
+			<- else ->
+				return <$x>, err
+			<- end>
+		}
+		`,
+		struct {
+			Name string
+			Spec *compile.TypedefSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
 // typedef generates code for the given typedef.
 func typedef(g Generator, spec *compile.TypedefSpec) error {
+	if isReaderBinary(spec.Target) {
+		// Methods cannot be declared on interface types.
+		return wrapGenerateError(spec.Name, fmt.Errorf(
+			"typedefs cannot refer to binaries with (%v = %q)", goTypeKey, readerType))
+	}
+
 	err := g.DeclareFromTemplate(
 		`
-		<$fmt := import "fmt">
 		<$wire := import "go.uber.org/thriftrw/wire">
 		<$typedefType := typeReference .>
 
-		<formatDoc .Doc>type <typeName .> <typeName .Target>
+		<formatDoc (deprecatedDoc .Doc .Annotations)>type <typeName .> <typeName .Target>
 
 		<$v := newVar "v">
 		<$x := newVar "x">
@@ -80,11 +116,14 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 			return <toWire .Target $x>
 		}
 
+		<if not (checkMinimal) ->
+		<- $fmt := import "fmt" ->
 		// String returns a readable string representation of <typeName .>.
 		func (<$v> <$typedefType>) String() string {
 			<$x> := (<typeReference .Target>)(<$v>)
 			return <$fmt>.Sprint(<$x>)
 		}
+		<- end>
 
 		<$w := newVar "w">
 		// FromWire deserializes <typeName .> from its Thrift-level
@@ -100,6 +139,33 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 			<- end>
 		}
 
+		<if not (checkMinimal) ->
+		<- $stream := import "go.uber.org/thriftrw/protocol/stream" ->
+		<$sr := newVar "sr">
+		// Decode deserializes <typeName .> directly off the wire.
+		func (<$v> *<typeName .>) Decode(<$sr> <$stream>.Reader) error {
+			<if isStructType . ->
+				return (<typeReference .Target>)(<$v>).Decode(<$sr>)
+			<- else ->
+				<$x>, err := <decode .Target $sr>
+				*<$v> = (<$typedefType>)(<$x>)
+				return err
+			<- end>
+		}
+
+		<if isStructType . ->
+			// MarshalJSON serializes <typeName .> into JSON.
+			func (<$v> *<typeName .>) MarshalJSON() ([]byte, error) {
+				return (<typeReference .Target>)(<$v>).MarshalJSON()
+			}
+
+			<$text := newVar "text">
+			// UnmarshalJSON deserializes <typeName .> from JSON.
+			func (<$v> *<typeName .>) UnmarshalJSON(<$text> []byte) error {
+				return (<typeReference .Target>)(<$v>).UnmarshalJSON(<$text>)
+			}
+		<- end>
+
 		<$lhs := newVar "lhs">
 		<$rhs := newVar "rhs">
 		// Equals returns true if this <typeName .> is equal to the provided
@@ -110,6 +176,15 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 			return <equals .Target $lhsCast $rhsCast>
 		}
 
+		<if not (isPrimitiveType .) ->
+			// Clone returns a deep copy of this <typeName .>.
+			func (<$v> <$typedefType>) Clone() <$typedefType> {
+				<$x> := (<typeReference .Target>)(<$v>)
+				return (<$typedefType>)(<clone .Target $x>)
+			}
+		<- end>
+		<- end>
+
 		<if not (checkNoZap) ->
 		</* We want the behavior of the underlying type for typedefs: in the case that
 				they are objects or arrays, we need to cast to the underlying object or array;
@@ -133,6 +208,26 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 		`,
 		spec,
 		TemplateFunc("checkNoZap", checkNoZap),
+		TemplateFunc("checkMinimal", checkMinimal),
 	)
-	return wrapGenerateError(spec.Name, err)
+	if err != nil {
+		return wrapGenerateError(spec.Name, err)
+	}
+
+	if checkSizeMethods(g) {
+		if err := specSizeInBytes(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	if checkStreamEncode(g) && !checkMinimal(g) {
+		if err := specEncode(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	if checkSQL(g) {
+		return typedefSQL(g, spec)
+	}
+	return nil
 }
@@ -0,0 +1,174 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "fmt"
+
+// unionVariants generates an API to inspect which field of a union is set:
+//
+// 	union Document {
+// 		1: binary pdf
+// 		2: string plainText
+// 	}
+//
+// Generates a DocumentKind enum with the values DocumentKindUnset,
+// DocumentKindPdf, and DocumentKindPlainText; a Which method returning the
+// kind of the field that is set; GetPdfOk and GetPlainTextOk methods
+// returning the value of a field and whether it is set; and a Match method
+// which accepts a function for every field and calls the one for the field
+// that is set.
+//
+// Because Match requires a function for every field, adding a field to the
+// union breaks callers at compile time until they handle it.
+func unionVariants(g Generator, f fieldGroupGenerator) error {
+	if len(f.Fields) == 0 {
+		return nil
+	}
+
+	if err := checkUnionVariantNames(g, f); err != nil {
+		return err
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$fmt := import "fmt">
+		<$name := .Name>
+		<$kind := printf "%sKind" .Name>
+
+		// <$kind> identifies the field of a <$name> that is set.
+		type <$kind> int
+
+		const (
+			// <$kind>Unset indicates that no field of a <$name> is set.
+			<$kind>Unset <$kind> = iota
+			<range .Fields>
+				// <$kind><goName .> indicates that <goName .> is set.
+				<$kind><goName .>
+			<end>
+		)
+
+		<$k := newVar "k">
+		// String returns the Thrift name of the field identified by this
+		// <$kind>.
+		func (<$k> <$kind>) String() string {
+			switch <$k> {
+			case <$kind>Unset:
+				return "unset"
+			<range .Fields ->
+			case <$kind><goName .>:
+				return "<.Name>"
+			<end ->
+			default:
+				return <$fmt>.Sprintf("<$kind>(%d)", int(<$k>))
+			}
+		}
+
+		<$v := newVar "v">
+		// Which returns the kind of the field of this <$name> that is set,
+		// or <$kind>Unset if none of its fields is set.
+		func (<$v> *<$name>) Which() <$kind> {
+			if <$v> == nil {
+				return <$kind>Unset
+			}
+			<range .Fields>
+				if <$v>.<goName .> != nil {
+					return <$kind><goName .>
+				}
+			<end ->
+			return <$kind>Unset
+		}
+
+		<$o := newVar "o">
+		<range .Fields>
+			<- $fname := goName . ->
+			<- $m := mappedField . ->
+			// Get<$fname>Ok returns the value of <$fname> and true if it is
+			// set, or its zero value and false if it is unset.
+			func (<$v> *<$name>) Get<$fname>Ok() (<$o> <if $m><$m.Type><else><typeReference .Type><end>, ok bool) {
+				if <$v> == nil || <$v>.<$fname> == nil {
+					return
+				}
+				<- if or $m (isPrimitiveType .Type)>
+					return *<$v>.<$fname>, true
+				<- else>
+					return <$v>.<$fname>, true
+				<- end>
+			}
+		<end>
+
+		// Match calls the function provided for the field of this <$name>
+		// that is set with its value, and returns its result. An error is
+		// returned if none of its fields is set.
+		func (<$v> *<$name>) Match(
+			<range .Fields ->
+				<- $m := mappedField . ->
+				on<goName .> func(<if $m><$m.Type><else><typeReference .Type><end>) error,
+			<end ->
+		) error {
+			switch <$v>.Which() {
+			<range .Fields ->
+			<- $fname := goName . ->
+			case <$kind><$fname>:
+				<- if or (mappedField .) (isPrimitiveType .Type)>
+					return on<$fname>(*<$v>.<$fname>)
+				<- else>
+					return on<$fname>(<$v>.<$fname>)
+				<- end>
+			<end ->
+			default:
+				return <import "errors">.New("<$name> should have exactly one field: got 0 fields")
+			}
+		}
+		`, f)
+}
+
+// checkUnionVariantNames verifies that the methods generated by
+// unionVariants don't conflict with the fields of the union or their
+// accessors.
+func checkUnionVariantNames(g Generator, f fieldGroupGenerator) error {
+	names := NewNamespace()
+	for _, field := range f.Fields {
+		fname, err := goName(g, field)
+		if err != nil {
+			return err
+		}
+		for _, name := range []string{fname, "Get" + fname, "IsSet" + fname} {
+			// Conflicts between the fields and their accessors are
+			// reported by Accessors.
+			_ = names.Reserve(name)
+		}
+	}
+
+	for _, name := range []string{"Which", "Match"} {
+		if err := names.Reserve(name); err != nil {
+			return fmt.Errorf("%q is a reserved ThriftRW identifier for union %v", name, f.Name)
+		}
+	}
+
+	for _, field := range f.Fields {
+		fname, _ := goName(g, field)
+		if err := names.Reserve("Get" + fname + "Ok"); err != nil {
+			return fmt.Errorf(
+				"cannot generate Get%vOk for union %v: %v", fname, f.Name, err)
+		}
+	}
+	return nil
+}
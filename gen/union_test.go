@@ -0,0 +1,141 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	td "go.uber.org/thriftrw/gen/internal/tests/typedefs"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unions"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnionWhich(t *testing.T) {
+	tests := []struct {
+		desc string
+		give *tu.Document
+		want tu.DocumentKind
+	}{
+		{desc: "nil", want: tu.DocumentKindUnset},
+		{desc: "empty", give: &tu.Document{}, want: tu.DocumentKindUnset},
+		{
+			desc: "pdf",
+			give: &tu.Document{Pdf: td.PDF("foo")},
+			want: tu.DocumentKindPdf,
+		},
+		{
+			desc: "plain text",
+			give: &tu.Document{PlainText: ptr.String("foo")},
+			want: tu.DocumentKindPlainText,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.give.Which())
+		})
+	}
+}
+
+func TestUnionKindString(t *testing.T) {
+	assert.Equal(t, "unset", tu.DocumentKindUnset.String())
+	assert.Equal(t, "pdf", tu.DocumentKindPdf.String())
+	assert.Equal(t, "plainText", tu.DocumentKindPlainText.String())
+	assert.Equal(t, "DocumentKind(42)", tu.DocumentKind(42).String())
+}
+
+func TestUnionGetOk(t *testing.T) {
+	var nilValue *tu.ArbitraryValue
+	_, ok := nilValue.GetBoolValueOk()
+	assert.False(t, ok)
+
+	v := &tu.ArbitraryValue{BoolValue: ptr.Bool(false)}
+	b, ok := v.GetBoolValueOk()
+	assert.True(t, ok)
+	assert.False(t, b)
+
+	s, ok := v.GetStringValueOk()
+	assert.False(t, ok)
+	assert.Empty(t, s)
+
+	v = &tu.ArbitraryValue{ListValue: []*tu.ArbitraryValue{}}
+	l, ok := v.GetListValueOk()
+	assert.True(t, ok)
+	assert.Equal(t, []*tu.ArbitraryValue{}, l)
+}
+
+func TestUnionMatch(t *testing.T) {
+	var got []string
+	match := func(v *tu.Document) error {
+		return v.Match(
+			func(pdf td.PDF) error {
+				got = append(got, "pdf:"+string(pdf))
+				return nil
+			},
+			func(text string) error {
+				got = append(got, "text:"+text)
+				return errors.New("great sadness")
+			},
+		)
+	}
+
+	require.NoError(t, match(&tu.Document{Pdf: td.PDF("foo")}))
+	assert.EqualError(t, match(&tu.Document{PlainText: ptr.String("bar")}), "great sadness")
+	assert.Equal(t, []string{"pdf:foo", "text:bar"}, got)
+
+	err := match(&tu.Document{})
+	assert.EqualError(t, err, "Document should have exactly one field: got 0 fields")
+}
+
+func TestUnionVariantNameConflict(t *testing.T) {
+	tests := []struct {
+		desc    string
+		fields  compile.FieldGroup
+		wantErr string
+	}{
+		{
+			desc:    "Which",
+			fields:  compile.FieldGroup{{Name: "which", Type: &compile.BoolSpec{}}},
+			wantErr: `"Which" is a reserved ThriftRW identifier for union Foo`,
+		},
+		{
+			desc: "GetOk",
+			fields: compile.FieldGroup{
+				{Name: "bar", Type: &compile.BoolSpec{}},
+				{Name: "getBarOk", Type: &compile.BoolSpec{}},
+			},
+			wantErr: `cannot generate GetBarOk for union Foo`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := checkUnionVariantNames(nil, fieldGroupGenerator{Name: "Foo", Fields: tt.fields})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// UnsignedLabel allows i16, i32, and i64 fields to use the unsigned Go
+// types uint16, uint32, and uint64. i.e.
+//
+// 	struct Object {
+// 		1: required i64 size (go.unsigned = "true")
+// 	}
+//
+// The value is sent over the wire as the signed Thrift type with the same
+// bits, so values above the maximum of the signed type wrap around to
+// negative numbers for other Thrift implementations.
+const UnsignedLabel = "go.unsigned"
+
+// unsignedField returns the mapping of the given field to an unsigned Go
+// type, or nil if the field is not annotated with UnsignedLabel.
+func unsignedField(g Generator, f *compile.FieldSpec) (*fieldMapping, error) {
+	switch v, ok := f.Annotations[UnsignedLabel]; {
+	case !ok || v == "false":
+		return nil, nil
+	case v != "" && v != "true":
+		return nil, fmt.Errorf(
+			"invalid %v on field %q: expected \"true\" or \"false\", got %q",
+			UnsignedLabel, f.Name, v)
+	}
+
+	var unsigned string
+	switch compile.RootTypeSpec(f.Type).(type) {
+	case *compile.I16Spec:
+		unsigned = "uint16"
+	case *compile.I32Spec:
+		unsigned = "uint32"
+	case *compile.I64Spec:
+		unsigned = "uint64"
+	default:
+		return nil, fmt.Errorf(
+			"invalid %v on field %q: only i16, i32, and i64 fields may be unsigned",
+			UnsignedLabel, f.Name)
+	}
+
+	if f.Default != nil {
+		return nil, fmt.Errorf(
+			"field %q cannot have a default value: it is unsigned", f.Name)
+	}
+
+	name := g.MangleType(f.Type)
+	data := struct {
+		Spec       compile.TypeSpec
+		Unsigned   string
+		ToThrift   string
+		FromThrift string
+	}{
+		Spec:       f.Type,
+		Unsigned:   unsigned,
+		ToThrift:   fmt.Sprintf("_%s_FromUnsigned", name),
+		FromThrift: fmt.Sprintf("_%s_ToUnsigned", name),
+	}
+
+	// Conversions between integers of the same size keep their bits so
+	// values wrap around instead of failing.
+	err := g.EnsureDeclared(
+		`
+		<$v := newVar "v">
+		func <.ToThrift>(<$v> <.Unsigned>) (<typeReference .Spec>, error) {
+			return <typeReference .Spec>(<$v>), nil
+		}
+
+		func <.FromThrift>(<$v> <typeReference .Spec>) (<.Unsigned>, error) {
+			return <.Unsigned>(<$v>), nil
+		}
+		`, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldMapping{
+		Type:       unsigned,
+		ToThrift:   data.ToThrift,
+		FromThrift: data.FromThrift,
+	}, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"math"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsignedFieldsWrapAround(t *testing.T) {
+	large := uint64(math.MaxUint64)
+	give := &ts.UnsignedStruct{
+		Tiny:   -1,
+		Small:  math.MaxUint16,
+		Medium: math.MaxInt32 + 1,
+		Large:  &large,
+	}
+	want := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueI8(-1)},
+		{ID: 2, Value: wire.NewValueI16(-1)},
+		{ID: 3, Value: wire.NewValueI32(math.MinInt32)},
+		{ID: 4, Value: wire.NewValueI64(-1)},
+	}})
+
+	assertRoundTrip(t, give, want, "UnsignedStruct")
+}
+
+func TestUnsignedFieldInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		field   *compile.FieldSpec
+		wantErr string
+	}{
+		{
+			desc: "string",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{"go.unsigned": "true"},
+			},
+			wantErr: `invalid go.unsigned on field "foo": only i16, i32, and i64 fields may be unsigned`,
+		},
+		{
+			desc: "invalid value",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.I32Spec{},
+				Annotations: compile.Annotations{"go.unsigned": "yes"},
+			},
+			wantErr: `invalid go.unsigned on field "foo": expected "true" or "false", got "yes"`,
+		},
+		{
+			desc: "default value",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.I32Spec{},
+				Annotations: compile.Annotations{"go.unsigned": "true"},
+				Default:     compile.ConstantInt(1),
+			},
+			wantErr: `field "foo" cannot have a default value: it is unsigned`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := unsignedField(NewGenerator(&GeneratorOptions{PackageName: "foo"}), tt.field)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
+
+func TestUnsignedFieldDisabled(t *testing.T) {
+	m, err := unsignedField(nil /* generator */, &compile.FieldSpec{
+		Name:        "foo",
+		Type:        &compile.I32Spec{},
+		Annotations: compile.Annotations{"go.unsigned": "false"},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, m)
+}
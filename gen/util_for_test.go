@@ -23,6 +23,7 @@ package gen
 import (
 	"fmt"
 
+	"go.uber.org/thriftrw/protocol/stream"
 	"go.uber.org/thriftrw/wire"
 )
 
@@ -36,4 +37,5 @@ type thriftType interface {
 
 	ToWire() (wire.Value, error)
 	FromWire(wire.Value) error
+	Decode(stream.Reader) error
 }
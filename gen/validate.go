@@ -0,0 +1,251 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Annotations declaring constraints on the values of struct fields. Structs
+// with constrained fields, or with fields whose struct types have
+// constrained fields, get a Validate method which reports all violations.
+// i.e.
+//
+// 	struct User {
+// 		1: required string name (validate.minLen = "1", validate.maxLen = "255")
+// 		2: optional i32 age (validate.min = "0", validate.max = "150")
+// 		3: optional string email (validate.pattern = "^[^@]+@[^@]+$")
+// 	}
+//
+// Constraints on optional fields apply only if the field is set.
+const (
+	// ValidateMinLabel and ValidateMaxLabel bound the values of numeric
+	// fields, inclusively.
+	ValidateMinLabel = "validate.min"
+	ValidateMaxLabel = "validate.max"
+
+	// ValidateMinLenLabel and ValidateMaxLenLabel bound the lengths of
+	// strings, binary, and containers, inclusively.
+	ValidateMinLenLabel = "validate.minLen"
+	ValidateMaxLenLabel = "validate.maxLen"
+
+	// ValidatePatternLabel is a regular expression which values of string
+	// fields must match.
+	ValidatePatternLabel = "validate.pattern"
+)
+
+// validateCheck is a single constraint of a field.
+type validateCheck struct {
+	// Go expression which evaluates to true if the constraint is violated.
+	Violated string
+
+	// Description of the constraint reported when it's violated.
+	Message string
+}
+
+// validateChecks builds the checks for the constraints declared on the
+// given field. v is a reference to the struct containing the field, and
+// inBitmap is true if the field is stored by value with a presence bitmap.
+func validateChecks(g Generator, structName string, f *compile.FieldSpec, v string, inBitmap bool) ([]validateCheck, error) {
+	fname, err := goName(g, f)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := fieldName(g, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if m, err := mappedField(g, f); err != nil {
+		return nil, err
+	} else if m != nil {
+		for _, k := range sortStringKeys(f.Annotations) {
+			if strings.HasPrefix(k, "validate.") {
+				return nil, fmt.Errorf(
+					"invalid %v on field %q: fields with custom types cannot be validated", k, f.Name)
+			}
+		}
+		return nil, nil
+	}
+
+	value := fmt.Sprintf("%s.%s", v, storage)
+	if !f.Required && isPrimitiveType(f.Type) && !inBitmap {
+		value = "*" + value
+	}
+
+	var checks []validateCheck
+	add := func(violated, message string) {
+		switch {
+		case inBitmap:
+			violated = fmt.Sprintf("%s.IsSet%s() && %s", v, fname, violated)
+		case !f.Required:
+			violated = fmt.Sprintf("%s.%s != nil && %s", v, storage, violated)
+		}
+		checks = append(checks, validateCheck{Violated: violated, Message: message})
+	}
+
+	root := compile.RootTypeSpec(f.Type)
+	for _, label := range []string{ValidateMinLabel, ValidateMaxLabel} {
+		bound, ok := f.Annotations[label]
+		if !ok {
+			continue
+		}
+		if err := checkNumericBound(root, bound); err != nil {
+			return nil, fmt.Errorf("invalid %v on field %q: %v", label, f.Name, err)
+		}
+		if label == ValidateMinLabel {
+			add(fmt.Sprintf("%s < %s", value, bound), "must be at least "+bound)
+		} else {
+			add(fmt.Sprintf("%s > %s", value, bound), "must be at most "+bound)
+		}
+	}
+
+	for _, label := range []string{ValidateMinLenLabel, ValidateMaxLenLabel} {
+		bound, ok := f.Annotations[label]
+		if !ok {
+			continue
+		}
+		switch root.(type) {
+		case *compile.StringSpec, *compile.BinarySpec,
+			*compile.ListSpec, *compile.SetSpec, *compile.MapSpec:
+			if isReaderBinary(root) {
+				return nil, fmt.Errorf(
+					"invalid %v on field %q: the length of readers is unknown",
+					label, f.Name)
+			}
+		default:
+			return nil, fmt.Errorf(
+				"invalid %v on field %q: fields of type %v do not have a length",
+				label, f.Name, root.ThriftName())
+		}
+		if n, err := strconv.ParseUint(bound, 10, 31); err != nil {
+			return nil, fmt.Errorf("invalid %v on field %q: %q is not a valid length", label, f.Name, bound)
+		} else if label == ValidateMinLenLabel {
+			add(fmt.Sprintf("len(%s) < %d", value, n), fmt.Sprintf("length must be at least %d", n))
+		} else {
+			add(fmt.Sprintf("len(%s) > %d", value, n), fmt.Sprintf("length must be at most %d", n))
+		}
+	}
+
+	if pattern, ok := f.Annotations[ValidatePatternLabel]; ok {
+		if _, isString := root.(*compile.StringSpec); !isString && !isStringBinary(root) {
+			return nil, fmt.Errorf(
+				"invalid %v on field %q: only string fields may have patterns",
+				ValidatePatternLabel, f.Name)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid %v on field %q: %v", ValidatePatternLabel, f.Name, err)
+		}
+
+		name := fmt.Sprintf("_%v_%v_Pattern", structName, fname)
+		err := g.EnsureDeclared(
+			`
+			<$regexp := import "regexp">
+			var <.Name> = <$regexp>.MustCompile(<printf "%q" .Pattern>)
+			`,
+			struct {
+				Name    string
+				Pattern string
+			}{Name: name, Pattern: pattern},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, isTypedef := f.Type.(*compile.TypedefSpec); isTypedef {
+			value = fmt.Sprintf("string(%s)", value)
+		}
+		add(fmt.Sprintf("!%s.MatchString(%s)", name, value), fmt.Sprintf("must match pattern %q", pattern))
+	}
+
+	return checks, nil
+}
+
+// checkNumericBound verifies that bound is a valid bound for values of the
+// given type.
+func checkNumericBound(spec compile.TypeSpec, bound string) error {
+	var bits int
+	switch spec.(type) {
+	case *compile.I8Spec:
+		bits = 8
+	case *compile.I16Spec:
+		bits = 16
+	case *compile.I32Spec:
+		bits = 32
+	case *compile.I64Spec:
+		bits = 64
+	case *compile.DoubleSpec:
+		if _, err := strconv.ParseFloat(bound, 64); err != nil {
+			return fmt.Errorf("%q is not a valid double", bound)
+		}
+		return nil
+	default:
+		return fmt.Errorf("fields of type %v are not numeric", spec.ThriftName())
+	}
+
+	if _, err := strconv.ParseInt(bound, 10, bits); err != nil {
+		return fmt.Errorf("%q is not a valid %v", bound, spec.ThriftName())
+	}
+	return nil
+}
+
+// hasValidation returns true if a Validate method is generated for the
+// given field group.
+func hasValidation(fields compile.FieldGroup) bool {
+	return hasValidationVisit(fields, make(map[*compile.StructSpec]struct{}))
+}
+
+func hasValidationVisit(fields compile.FieldGroup, seen map[*compile.StructSpec]struct{}) bool {
+	for _, f := range fields {
+		for k := range f.Annotations {
+			if strings.HasPrefix(k, "validate.") {
+				return true
+			}
+		}
+	}
+
+	for _, f := range fields {
+		s, ok := f.Type.(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		if hasValidationVisit(s.Fields, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNested returns true if the value of the given field should be
+// validated with its own Validate method.
+func validateNested(f *compile.FieldSpec) bool {
+	s, ok := f.Type.(*compile.StructSpec)
+	return ok && hasValidation(s.Fields)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/validation"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	valid := func() *ts.ValidatedStruct {
+		return &ts.ValidatedStruct{
+			Name:    "foo",
+			Address: &ts.ValidatedAddress{Street: "Market St"},
+		}
+	}
+
+	tests := []struct {
+		desc string
+		give func(*ts.ValidatedStruct)
+		want validation.Errors
+	}{
+		{desc: "valid", give: func(*ts.ValidatedStruct) {}},
+		{
+			desc: "valid optional fields",
+			give: func(v *ts.ValidatedStruct) {
+				v.Age = ptr.Int16(150)
+				v.Score = ptr.Float64(0.5)
+				v.Email = ptr.String("foo@example.com")
+				slug := ts.Slug("foo-bar")
+				v.Slug = &slug
+				v.Tags = []string{"a", "b"}
+				v.PreviousAddress = &ts.ValidatedAddress{Street: "Main St", Unit: ptr.Int32(1)}
+			},
+		},
+		{
+			desc: "too short",
+			give: func(v *ts.ValidatedStruct) { v.Name = "" },
+			want: validation.Errors{{Field: "name", Message: "length must be at least 1"}},
+		},
+		{
+			desc: "too long",
+			give: func(v *ts.ValidatedStruct) {
+				v.Name = "foobarbaz"
+				v.Tags = []string{"a", "b", "c"}
+			},
+			want: validation.Errors{
+				{Field: "name", Message: "length must be at most 8"},
+				{Field: "tags", Message: "length must be at most 2"},
+			},
+		},
+		{
+			desc: "out of range",
+			give: func(v *ts.ValidatedStruct) {
+				v.Age = ptr.Int16(-1)
+				v.Score = ptr.Float64(0.25)
+			},
+			want: validation.Errors{
+				{Field: "age", Message: "must be at least 0"},
+				{Field: "score", Message: "must be at least 0.5"},
+			},
+		},
+		{
+			desc: "pattern mismatch",
+			give: func(v *ts.ValidatedStruct) {
+				v.Email = ptr.String("foo")
+				slug := ts.Slug("Foo Bar")
+				v.Slug = &slug
+			},
+			want: validation.Errors{
+				{Field: "email", Message: `must match pattern "^[^@]+@[^@]+$"`},
+				{Field: "slug", Message: `must match pattern "^[a-z-]+$"`},
+			},
+		},
+		{
+			desc: "nested",
+			give: func(v *ts.ValidatedStruct) {
+				v.Address.Street = ""
+				v.PreviousAddress = &ts.ValidatedAddress{Street: "Main St", Unit: ptr.Int32(10000)}
+			},
+			want: validation.Errors{
+				{Field: "address.street", Message: "length must be at least 1"},
+				{Field: "previousAddress.unit", Message: "must be at most 9999"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			v := valid()
+			tt.give(v)
+
+			err := v.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.want, err)
+		})
+	}
+}
+
+func TestValidateNil(t *testing.T) {
+	var v *ts.ValidatedStruct
+	assert.NoError(t, v.Validate())
+}
+
+func TestValidateInvalidAnnotations(t *testing.T) {
+	tests := []struct {
+		desc    string
+		field   *compile.FieldSpec
+		wantErr string
+	}{
+		{
+			desc: "min on string",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{"validate.min": "1"},
+			},
+			wantErr: `invalid validate.min on field "foo": fields of type string are not numeric`,
+		},
+		{
+			desc: "max out of range",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.I8Spec{},
+				Annotations: compile.Annotations{"validate.max": "1000"},
+			},
+			wantErr: `invalid validate.max on field "foo": "1000" is not a valid byte`,
+		},
+		{
+			desc: "minLen on i32",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.I32Spec{},
+				Annotations: compile.Annotations{"validate.minLen": "1"},
+			},
+			wantErr: `invalid validate.minLen on field "foo": fields of type i32 do not have a length`,
+		},
+		{
+			desc: "negative maxLen",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{"validate.maxLen": "-1"},
+			},
+			wantErr: `invalid validate.maxLen on field "foo": "-1" is not a valid length`,
+		},
+		{
+			desc: "pattern on binary",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.BinarySpec{},
+				Annotations: compile.Annotations{"validate.pattern": "a"},
+			},
+			wantErr: `invalid validate.pattern on field "foo": only string fields may have patterns`,
+		},
+		{
+			desc: "invalid pattern",
+			field: &compile.FieldSpec{
+				Name:        "foo",
+				Type:        &compile.StringSpec{},
+				Annotations: compile.Annotations{"validate.pattern": "("},
+			},
+			wantErr: `invalid validate.pattern on field "foo": error parsing regexp`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			tt.field.Required = true
+			fg := fieldGroupGenerator{
+				Namespace: NewNamespace(),
+				Name:      "Foo",
+				Fields:    compile.FieldGroup{tt.field},
+			}
+			err := fg.Validate(NewGenerator(&GeneratorOptions{PackageName: "foo"}))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
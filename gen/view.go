@@ -0,0 +1,205 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// ViewLabel generates a read-only view of structs encoded with the Thrift
+// Binary protocol. i.e.
+//
+// 	struct Document {
+// 		1: required string title
+// 		2: optional binary body
+// 		3: optional Author author
+// 	} (go.view)
+//
+// generates a DocumentView type which is built from the encoded bytes with
+// NewDocumentView. Its methods, named after the fields, find the requested
+// field by its ID and decode only that field, so that consumers which read
+// a few fields of large structs don't need to decode them entirely. Like
+// FromWire, they use the last field with the requested ID, so the field
+// headers of the whole struct are scanned every time. Binary
+// values reference the encoded bytes without copying them, and fields
+// holding structs which are also annotated with go.view are returned as
+// views. Decode decodes the whole struct.
+const ViewLabel = "go.view"
+
+const binaryProtocolPackage = "go.uber.org/thriftrw/protocol/binary"
+
+// isViewStruct returns true if the given struct is annotated with
+// ViewLabel.
+func isViewStruct(spec *compile.StructSpec) (bool, error) {
+	switch v, ok := spec.Annotations[ViewLabel]; {
+	case !ok || v == "false":
+		return false, nil
+	case v != "" && v != "true":
+		return false, fmt.Errorf(
+			"invalid %v on %q: expected \"true\" or \"false\", got %q",
+			ViewLabel, spec.Name, v)
+	}
+	return true, nil
+}
+
+// viewStructSpec returns the struct which the given type refers to if it's
+// annotated with ViewLabel, or nil otherwise.
+func viewStructSpec(spec compile.TypeSpec) *compile.StructSpec {
+	s, ok := compile.RootTypeSpec(spec).(*compile.StructSpec)
+	if !ok {
+		return nil
+	}
+	if view, err := isViewStruct(s); err != nil || !view {
+		return nil
+	}
+	return s
+}
+
+// viewTypeName returns the name of the view of the struct the given type
+// refers to if the struct is annotated with ViewLabel, or an empty string
+// otherwise.
+func viewTypeName(g Generator, spec compile.TypeSpec) (string, error) {
+	s := viewStructSpec(spec)
+	if s == nil {
+		return "", nil
+	}
+	name, err := typeName(g, s)
+	return name + "View", err
+}
+
+// View generates the view of this group. See ViewLabel.
+func (f fieldGroupGenerator) View(g Generator) error {
+	for _, field := range f.Fields {
+		if m, err := mappedField(g, field); err != nil {
+			return err
+		} else if m != nil {
+			return fmt.Errorf(
+				"field %q of %q cannot be viewed: it uses a custom type", field.Name, f.Name)
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$binary := import "go.uber.org/thriftrw/protocol/binary">
+		<$v := newVar "v">
+		<$o := newVar "o">
+		<$w := newVar "w">
+		<$b := newVar "b">
+		<$s := newVar "s">
+		<$ok := newVar "ok">
+		<$x := newVar "x">
+		<$name := .Name>
+		<$view := printf "%vView" .Name>
+
+		// <$view> provides read-only access to <$name> values encoded with
+		// the Thrift Binary protocol. Fields are decoded from the encoded
+		// bytes when they are accessed.
+		type <$view> <$binary>.StructView
+
+		// New<$view> builds a view of the <$name> encoded in the given bytes.
+		// The bytes must not be modified while the view, or binary values
+		// read from it, are in use.
+		func New<$view>(<$b> []byte) <$view> {
+			return <$view>(<$binary>.NewStructView(<$b>))
+		}
+
+		<range .Fields>
+			<$fname := goName .>
+			<$fview := viewTypeName .Type>
+
+			<if $fview ->
+			// <$fname> returns a view of <.Name>.
+			<- if .Required> It fails if <.Name> is missing.<else> The view is empty if <.Name> is unset.<end>
+			func (<$v> <$view>) <$fname>() (<$fview>, error) {
+				<- if .Required>
+					<$s>, <$ok>, err := <$binary>.StructView(<$v>).FieldStruct(<.ID>)
+					if err == nil && !<$ok> {
+						err = <import "errors">.New("field <$fname> of <$name> is required")
+					}
+				<- else>
+					<$s>, _, err := <$binary>.StructView(<$v>).FieldStruct(<.ID>)
+				<- end>
+				return <$fview>(<$s>), err
+			}
+			<- else ->
+			// <$fname> decodes the value of <.Name>.
+			<- if .Default> It returns the default value if it is unset.
+			<- else if .Required> It fails if <.Name> is missing.
+			<- else> It returns the zero value if it is unset.<end>
+			func (<$v> <$view>) <$fname>() (<$o> <typeReference .Type>, err error) {
+				<- if isBinary .Type>
+					<- $t := typeReference .Type>
+					<$b>, <if or .Default .Required><$ok><else>_<end>, err := <$binary>.StructView(<$v>).FieldBinary(<.ID>)
+					<if eq $t "[]byte"><$o> = <$b><else><$o> = <$t>(<$b>)<end>
+				<- else>
+					<$w>, <$ok>, err := <$binary>.StructView(<$v>).Field(<.ID>, <typeCode .Type>)
+					if <$ok> {
+						<$o>, err = <fromWire .Type $w>
+					}
+				<- end>
+				<- if .Default>
+					if err == nil && !<$ok> {
+						<$o> = <constantValue .Default .Type>
+					}
+				<- else if .Required>
+					if err == nil && !<$ok> {
+						err = <import "errors">.New("field <$fname> of <$name> is required")
+					}
+				<- end>
+				return <$o>, err
+			}
+			<- end>
+
+			<if not .Required>
+			// IsSet<$fname> returns true if <.Name> is present in the encoded
+			// bytes.
+			func (<$v> <$view>) IsSet<$fname>() bool {
+				return <$binary>.StructView(<$v>).HasField(<.ID>, <typeCode .Type>)
+			}
+			<end>
+		<end>
+
+		// Decode decodes the whole <$name> from the encoded bytes.
+		func (<$v> <$view>) Decode() (*<$name>, error) {
+			<$w>, err := <$binary>.StructView(<$v>).Wire()
+			if err != nil {
+				return nil, err
+			}
+
+			var <$x> <$name>
+			err = <$x>.FromWire(<$w>)
+			return &<$x>, err
+		}
+		`, f,
+		TemplateFunc("viewTypeName", viewTypeName),
+		TemplateFunc("isBinary", isBinaryType),
+		TemplateFunc("constantValue", ConstantValue),
+	)
+}
+
+// isBinaryType returns true if the given type is binary or a typedef of
+// binary that is represented as a byte slice or a string.
+func isBinaryType(spec compile.TypeSpec) bool {
+	_, ok := compile.RootTypeSpec(spec).(*compile.BinarySpec)
+	return ok && !isReaderBinary(spec)
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tv "go.uber.org/thriftrw/gen/internal/tests/views"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+func encodeBinary(t *testing.T, v interface {
+	ToWire() (wire.Value, error)
+}) []byte {
+	w, err := v.ToWire()
+	require.NoError(t, err)
+	return encodeWire(t, w)
+}
+
+func encodeWire(t *testing.T, w wire.Value) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(w, &buf))
+	return buf.Bytes()
+}
+
+func TestViewAccessors(t *testing.T) {
+	doc := &tv.Document{
+		Title:      "hello",
+		Body:       []byte("world"),
+		Author:     &tv.Writer{Name: "alice", Email: ptr.String("alice@example.com")},
+		Tags:       []string{"a", "b"},
+		Counters:   map[string]int64{"views": 42},
+		Attachment: &tv.Attachment{Filename: "x.txt", Data: tv.Blob("x")},
+		Reviewer:   &tv.Author{Name: "bob"},
+		Version:    ptr.Int32(3),
+		Signature:  tv.Blob("sig"),
+	}
+	b := encodeBinary(t, doc)
+	v := tv.NewDocumentView(b)
+
+	title, err := v.Title()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", title)
+
+	body, err := v.Body()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("world"), body)
+
+	author, err := v.Author()
+	require.NoError(t, err)
+	name, err := author.Name()
+	require.NoError(t, err)
+	assert.Equal(t, "alice", name)
+	email, err := author.Email()
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", email)
+
+	format, err := v.Format()
+	require.NoError(t, err)
+	assert.Equal(t, tv.FormatText, format)
+
+	tags, err := v.Tags()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tags)
+
+	counters, err := v.Counters()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"views": 42}, counters)
+
+	attachment, err := v.Attachment()
+	require.NoError(t, err)
+	assert.True(t, doc.Attachment.Equals(attachment))
+
+	reviewer, err := v.Reviewer()
+	require.NoError(t, err)
+	assert.False(t, reviewer.IsSetEmail())
+
+	version, err := v.Version()
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), version)
+
+	sig, err := v.Signature()
+	require.NoError(t, err)
+	assert.Equal(t, tv.Blob("sig"), sig)
+
+	decoded, err := v.Decode()
+	require.NoError(t, err)
+	assert.True(t, doc.Equals(decoded), "expected %v, got %v", doc, decoded)
+}
+
+func TestViewBinaryIsNotCopied(t *testing.T) {
+	b := encodeBinary(t, &tv.Document{
+		Title:    "hello",
+		Body:     []byte("world"),
+		Reviewer: &tv.Author{Name: "bob"},
+	})
+
+	body, err := tv.NewDocumentView(b).Body()
+	require.NoError(t, err)
+	require.Equal(t, []byte("world"), body)
+
+	i := bytes.Index(b, []byte("world"))
+	require.True(t, i >= 0)
+	b[i] = 'W'
+	assert.Equal(t, []byte("World"), body, "body must reference the encoded bytes")
+	assert.Len(t, body, cap(body), "appending to body must not overwrite the encoded bytes")
+}
+
+func TestViewUnsetFields(t *testing.T) {
+	v := tv.NewDocumentView(encodeBinary(t, &tv.Document{
+		Title:    "hello",
+		Reviewer: &tv.Author{Name: "bob"},
+	}))
+
+	assert.False(t, v.IsSetBody())
+	body, err := v.Body()
+	require.NoError(t, err)
+	assert.Nil(t, body)
+
+	assert.False(t, v.IsSetAuthor())
+	author, err := v.Author()
+	require.NoError(t, err)
+	assert.False(t, author.IsSetEmail(), "views of unset fields must be empty")
+	_, err = author.Name()
+	assert.EqualError(t, err, "field Name of Author is required")
+
+	version, err := v.Version()
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), version)
+
+	// ToWire always writes fields with defaults so they can only be missing
+	// if the struct was encoded by something else.
+	v = tv.NewDocumentView(encodeWire(t, wire.NewValueStruct(wire.Struct{})))
+	assert.False(t, v.IsSetFormat())
+	format, err := v.Format()
+	require.NoError(t, err)
+	assert.Equal(t, tv.FormatText, format, "default must be used")
+}
+
+func TestViewErrors(t *testing.T) {
+	t.Run("missing required field", func(t *testing.T) {
+		v := tv.NewDocumentView(encodeWire(t, wire.NewValueStruct(wire.Struct{})))
+
+		_, err := v.Title()
+		assert.EqualError(t, err, "field Title of Document is required")
+
+		_, err = v.Reviewer()
+		assert.EqualError(t, err, "field Reviewer of Document is required")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		b := encodeBinary(t, &tv.Document{
+			Title:    "hello",
+			Body:     []byte("world"),
+			Reviewer: &tv.Author{Name: "bob"},
+			Version:  ptr.Int32(1),
+		})
+		v := tv.NewDocumentView(b[:len(b)-8])
+
+		// The whole struct is scanned for every field because later fields
+		// with the same ID take precedence.
+		_, err := v.Title()
+		assert.Error(t, err)
+
+		_, err = v.Version()
+		assert.Error(t, err)
+		assert.False(t, v.IsSetVersion())
+
+		_, err = v.Decode()
+		assert.Error(t, err)
+	})
+
+	t.Run("duplicate fields", func(t *testing.T) {
+		w := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("first")},
+			{ID: 9, Value: wire.NewValueI32(1)},
+			{ID: 8, Value: singleFieldStruct(1, wire.NewValueString("bob"))},
+			{ID: 1, Value: wire.NewValueString("last")},
+			{ID: 9, Value: wire.NewValueString("not an i32")},
+		}})
+		v := tv.NewDocumentView(encodeWire(t, w))
+
+		var want tv.Document
+		require.NoError(t, want.FromWire(w))
+
+		title, err := v.Title()
+		require.NoError(t, err)
+		assert.Equal(t, want.Title, title, "the last field must win")
+		assert.Equal(t, "last", title)
+
+		version, err := v.Version()
+		require.NoError(t, err)
+		assert.Equal(t, want.GetVersion(), version, "fields of other types must be ignored")
+		assert.Equal(t, int32(1), version)
+
+		decoded, err := v.Decode()
+		require.NoError(t, err)
+		assert.True(t, want.Equals(decoded), "expected %v, got %v", &want, decoded)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		v := tv.NewContentView(encodeBinary(t, &tv.Content{Text: ptr.String("hi")}))
+		assert.True(t, v.IsSetText())
+		assert.False(t, v.IsSetDocument())
+
+		c, err := v.Decode()
+		require.NoError(t, err)
+		assert.Equal(t, "hi", c.GetText())
+	})
+}
+
+func TestViewAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		thrift  string
+		wantErr string
+	}{
+		{
+			desc:    "invalid value",
+			thrift:  `struct Foo { 1: optional i32 x } (go.view = "yes")`,
+			wantErr: `invalid go.view on "Foo": expected "true" or "false", got "yes"`,
+		},
+		{
+			desc: "custom type",
+			thrift: `struct Foo {
+				1: optional i64 x (go.unsigned)
+			} (go.view)`,
+			wantErr: `field "x" of "Foo" cannot be viewed: it uses a custom type`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-view")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.thrift), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
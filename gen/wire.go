@@ -29,9 +29,11 @@ import (
 // WireGenerator is responsible for generating code that knows how to convert
 // between Thrift types and their Value representations.
 type WireGenerator struct {
-	mapG  mapGenerator
-	setG  setGenerator
-	listG listGenerator
+	mapG     mapGenerator
+	setG     setGenerator
+	listG    listGenerator
+	genericG genericGenerator
+	binaryG  binaryReaderGenerator
 
 	enumG    enumGenerator
 	structG  structGenerator
@@ -58,8 +60,21 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 	case *compile.StringSpec:
 		return fmt.Sprintf("%s.NewValueString(%s), error(nil)", wire, varName), nil
 	case *compile.BinarySpec:
+		switch s.Annotations[goTypeKey] {
+		case stringType:
+			return fmt.Sprintf("%s.NewValueString(%s), error(nil)", wire, varName), nil
+		case readerType:
+			toWire, err := w.binaryG.ToWire(g, s)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s(%s)", toWire, varName), nil
+		}
 		return fmt.Sprintf("%s.NewValueBinary(%s), error(nil)", wire, varName), nil
 	case *compile.MapSpec:
+		if checkGenerics(g) {
+			return w.genericG.ToWire(g, s, varName)
+		}
 		mapItemList, err := w.mapG.ItemList(g, s)
 		if err != nil {
 			return "", err
@@ -75,6 +90,9 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 			}{Wire: wire, Name: varName, Spec: s, MapItemList: mapItemList},
 		)
 	case *compile.ListSpec:
+		if checkGenerics(g) {
+			return w.genericG.ToWire(g, s, varName)
+		}
 		valueList, err := w.listG.ValueList(g, s)
 		if err != nil {
 			return "", err
@@ -90,6 +108,9 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 			}{Wire: wire, Name: varName, Spec: s, ValueList: valueList},
 		)
 	case *compile.SetSpec:
+		if checkGenerics(g) {
+			return w.genericG.ToWire(g, s, varName)
+		}
 		valueList, err := w.setG.ValueList(g, s)
 		if err != nil {
 			return "", err
@@ -113,10 +134,15 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 // ToWirePtr is the same as ToWire expect `varName` is expected to be a
 // reference to a value of the given type.
 func (w *WireGenerator) ToWirePtr(g Generator, spec compile.TypeSpec, varName string) (string, error) {
-	switch spec.(type) {
+	switch s := spec.(type) {
 	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
 		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec:
 		return w.ToWire(g, spec, fmt.Sprintf("*(%s)", varName))
+	case *compile.BinarySpec:
+		if isStringBinary(s) {
+			return w.ToWire(g, spec, fmt.Sprintf("*(%s)", varName))
+		}
+		return w.ToWire(g, spec, varName)
 	default:
 		// Everything else is either a reference type or has a ToWire method
 		// on it that does automatic dereferencing.
@@ -143,20 +169,35 @@ func (w *WireGenerator) FromWire(g Generator, spec compile.TypeSpec, value strin
 	case *compile.StringSpec:
 		return fmt.Sprintf("%s.GetString(), error(nil)", value), nil
 	case *compile.BinarySpec:
+		switch s.Annotations[goTypeKey] {
+		case stringType:
+			return fmt.Sprintf("%s.GetString(), error(nil)", value), nil
+		case readerType:
+			return fmt.Sprintf("%s.NewBuffer(%s.GetBinary()), error(nil)", g.Import("bytes"), value), nil
+		}
 		return fmt.Sprintf("%s.GetBinary(), error(nil)", value), nil
 	case *compile.MapSpec:
+		if checkGenerics(g) {
+			return w.genericG.FromWire(g, s, value)
+		}
 		reader, err := w.mapG.Reader(g, s)
 		if err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("%s(%s.GetMap())", reader, value), nil
 	case *compile.ListSpec:
+		if checkGenerics(g) {
+			return w.genericG.FromWire(g, s, value)
+		}
 		reader, err := w.listG.Reader(g, s)
 		if err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("%s(%s.GetList())", reader, value), nil
 	case *compile.SetSpec:
+		if checkGenerics(g) {
+			return w.genericG.FromWire(g, s, value)
+		}
 		reader, err := w.setG.Reader(g, s)
 		if err != nil {
 			return "", err
@@ -214,6 +255,189 @@ func (w *WireGenerator) FromWirePtr(g Generator, spec compile.TypeSpec, lhs stri
 	)
 }
 
+// Decode generates an expression of type ($spec, error) which reads a value
+// of type $spec directly from the stream.Reader $reader.
+func (w *WireGenerator) Decode(g Generator, spec compile.TypeSpec, reader string) (string, error) {
+	switch s := spec.(type) {
+	case *compile.BoolSpec:
+		return fmt.Sprintf("%s.ReadBool()", reader), nil
+	case *compile.I8Spec:
+		return fmt.Sprintf("%s.ReadInt8()", reader), nil
+	case *compile.I16Spec:
+		return fmt.Sprintf("%s.ReadInt16()", reader), nil
+	case *compile.I32Spec:
+		return fmt.Sprintf("%s.ReadInt32()", reader), nil
+	case *compile.I64Spec:
+		return fmt.Sprintf("%s.ReadInt64()", reader), nil
+	case *compile.DoubleSpec:
+		return fmt.Sprintf("%s.ReadDouble()", reader), nil
+	case *compile.StringSpec:
+		return fmt.Sprintf("%s.ReadString()", reader), nil
+	case *compile.BinarySpec:
+		switch s.Annotations[goTypeKey] {
+		case stringType:
+			return fmt.Sprintf("%s.ReadString()", reader), nil
+		case readerType:
+			decoder, err := w.binaryG.Decoder(g, s)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s(%s)", decoder, reader), nil
+		}
+		return fmt.Sprintf("%s.ReadBinary()", reader), nil
+	case *compile.MapSpec:
+		if checkGenerics(g) {
+			return w.genericG.Decode(g, s, reader)
+		}
+		decoder, err := w.mapG.Decoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
+	case *compile.ListSpec:
+		if checkGenerics(g) {
+			return w.genericG.Decode(g, s, reader)
+		}
+		decoder, err := w.listG.Decoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
+	case *compile.SetSpec:
+		if checkGenerics(g) {
+			return w.genericG.Decode(g, s, reader)
+		}
+		decoder, err := w.setG.Decoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
+	case *compile.TypedefSpec:
+		decoder, err := w.typedefG.Decoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
+	case *compile.EnumSpec:
+		decoder, err := w.enumG.Decoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
+	case *compile.StructSpec:
+		decoder, err := w.structG.Decoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", decoder, reader), nil
+	default:
+		panic(fmt.Sprintf("Unknown TypeSpec (%T) %v", spec, spec))
+	}
+}
+
+// DecodePtr generates a string assigning a value of the given type read from
+// the stream.Reader $reader to the given lhs, which is a pointer to a value
+// of the given type.
+//
+// A variable err of type error MUST be in scope and will be assigned the
+// parse error, if any.
+func (w *WireGenerator) DecodePtr(g Generator, spec compile.TypeSpec, lhs string, reader string) (string, error) {
+	if !isPrimitiveType(spec) {
+		// Everything else can be assigned to directly.
+		out, err := w.Decode(g, spec, reader)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s, err = %s", lhs, out), err
+	}
+	return g.TextTemplate(
+		`
+			<- $x := newVar "x" ->
+			var <$x> <typeReference .Spec>
+			<$x>, err = <decode .Spec .Reader>
+			<.LHS> = &<$x ->
+			`,
+		struct {
+			Spec   compile.TypeSpec
+			LHS    string
+			Reader string
+		}{Spec: spec, LHS: lhs, Reader: reader},
+	)
+}
+
+// Encode generates an expression of type error which writes the variable
+// $varName of type $spec directly to the stream.Writer $writer.
+func (w *WireGenerator) Encode(g Generator, spec compile.TypeSpec, varName string, writer string) (string, error) {
+	switch s := spec.(type) {
+	case *compile.BoolSpec:
+		return fmt.Sprintf("%s.WriteBool(%s)", writer, varName), nil
+	case *compile.I8Spec:
+		return fmt.Sprintf("%s.WriteInt8(%s)", writer, varName), nil
+	case *compile.I16Spec:
+		return fmt.Sprintf("%s.WriteInt16(%s)", writer, varName), nil
+	case *compile.I32Spec:
+		return fmt.Sprintf("%s.WriteInt32(%s)", writer, varName), nil
+	case *compile.I64Spec:
+		return fmt.Sprintf("%s.WriteInt64(%s)", writer, varName), nil
+	case *compile.DoubleSpec:
+		return fmt.Sprintf("%s.WriteDouble(%s)", writer, varName), nil
+	case *compile.StringSpec:
+		return fmt.Sprintf("%s.WriteString(%s)", writer, varName), nil
+	case *compile.BinarySpec:
+		switch s.Annotations[goTypeKey] {
+		case stringType:
+			return fmt.Sprintf("%s.WriteString(%s)", writer, varName), nil
+		case readerType:
+			encoder, err := w.binaryG.Encoder(g, s)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s(%s, %s)", encoder, varName, writer), nil
+		}
+		return fmt.Sprintf("%s.WriteBinary(%s)", writer, varName), nil
+	case *compile.MapSpec:
+		encoder, err := w.mapG.Encoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s, %s)", encoder, varName, writer), nil
+	case *compile.ListSpec:
+		encoder, err := w.listG.Encoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s, %s)", encoder, varName, writer), nil
+	case *compile.SetSpec:
+		encoder, err := w.setG.Encoder(g, s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s, %s)", encoder, varName, writer), nil
+	default:
+		// Custom defined type
+		return fmt.Sprintf("%s.Encode(%s)", varName, writer), nil
+	}
+}
+
+// EncodePtr is the same as Encode except `varName` is expected to be a
+// reference to a value of the given type.
+func (w *WireGenerator) EncodePtr(g Generator, spec compile.TypeSpec, varName string, writer string) (string, error) {
+	switch s := spec.(type) {
+	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec:
+		return w.Encode(g, spec, fmt.Sprintf("*(%s)", varName), writer)
+	case *compile.BinarySpec:
+		if isStringBinary(s) {
+			return w.Encode(g, spec, fmt.Sprintf("*(%s)", varName), writer)
+		}
+		return w.Encode(g, spec, varName, writer)
+	default:
+		// Everything else is either a reference type or has an Encode
+		// method on it that does automatic dereferencing.
+		return w.Encode(g, spec, varName, writer)
+	}
+}
+
 // TypeCode gets an expression of type 'wire.Type' that represents the
 // over-the-wire type code for the given TypeSpec.
 func TypeCode(g Generator, spec compile.TypeSpec) string {
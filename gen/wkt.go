@@ -0,0 +1,219 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// WellKnownTypeLabel maps structs with the same fields as the Protocol
+// Buffers well-known types google.protobuf.Timestamp and
+// google.protobuf.Duration to the Go types representing them. i.e.
+//
+// 	struct Timestamp {
+// 		1: required i64 seconds
+// 		2: required i32 nanos
+// 	} (go.wkt = "timestamp")
+//
+// 	struct Event {
+// 		1: required Timestamp createdAt
+// 	}
+//
+// Fields of type Timestamp, like Event.CreatedAt, use time.Time. Structs
+// annotated with (go.wkt = "duration") are used as time.Duration. The
+// structs are sent over the wire unchanged, and the structs themselves are
+// generated as usual, so Thrift and Protocol Buffers models may describe
+// the same values.
+//
+// Only fields which refer to these structs directly are mapped. Typedefs
+// of them and containers holding them use the generated structs.
+const WellKnownTypeLabel = "go.wkt"
+
+// wellKnownTypes maps the supported values of WellKnownTypeLabel to the Go
+// types they are represented as and the name used for their conversion
+// functions.
+var wellKnownTypes = map[string]struct{ Type, Name string }{
+	"timestamp": {Type: "time.Time", Name: "Time"},
+	"duration":  {Type: "time.Duration", Name: "Duration"},
+}
+
+// wellKnownTypeField returns the mapping of the given field to the Go type
+// representing the well-known type of its struct, or nil if its type is
+// not a struct annotated with WellKnownTypeLabel.
+func wellKnownTypeField(g Generator, f *compile.FieldSpec) (*fieldMapping, error) {
+	spec, ok := f.Type.(*compile.StructSpec)
+	if !ok {
+		return nil, nil
+	}
+
+	wkt, ok := spec.Annotations[WellKnownTypeLabel]
+	if !ok {
+		return nil, nil
+	}
+
+	t, ok := wellKnownTypes[wkt]
+	if !ok {
+		return nil, fmt.Errorf(
+			"invalid %v on %q: expected \"timestamp\" or \"duration\", got %q",
+			WellKnownTypeLabel, spec.Name, wkt)
+	}
+
+	if err := verifyWellKnownType(g, spec); err != nil {
+		return nil, err
+	}
+
+	if f.Default != nil {
+		return nil, fmt.Errorf(
+			"field %q cannot have a default value: it uses a custom type", f.Name)
+	}
+
+	name := g.MangleType(spec)
+	data := struct {
+		Spec       *compile.StructSpec
+		WKT        string
+		ToThrift   string
+		FromThrift string
+		Equals     string
+	}{
+		Spec:       spec,
+		WKT:        wkt,
+		ToThrift:   fmt.Sprintf("_%s_From%s", name, t.Name),
+		FromThrift: fmt.Sprintf("_%s_To%s", name, t.Name),
+	}
+	if wkt == "timestamp" {
+		// Times in different locations may refer to the same instant.
+		data.Equals = fmt.Sprintf("_%s_Equals%s", name, t.Name)
+	}
+
+	err := g.EnsureDeclared(
+		`
+		<$fmt := import "fmt">
+		<$time := import "time">
+		<$seconds := newVar "seconds">
+		<$nanos := newVar "nanos">
+		<$v := newVar "v">
+		<$lhs := newVar "lhs">
+		<$rhs := newVar "rhs">
+
+		<if eq .WKT "timestamp">
+			func <.ToThrift>(<$v> <$time>.Time) (<typeReference .Spec>, error) {
+				<$seconds> := <$v>.Unix()
+				<$nanos> := int32(<$v>.Nanosecond())
+				return &<typeName .Spec>{
+					<- range .Spec.Fields>
+						<goName .>: <if not .Required>&<end><if eq .Name "seconds"><$seconds><else><$nanos><end>,
+					<- end>
+				}, nil
+			}
+
+			func <.FromThrift>(<$v> <typeReference .Spec>) (<$time>.Time, error) {
+				<$nanos> := <$v>.GetNanos()
+				if 0 > <$nanos> || <$nanos> >= 1e9 {
+					return <$time>.Time{}, <$fmt>.Errorf("invalid <.Spec.Name>: nanos %d out of range", <$nanos>)
+				}
+				return <$time>.Unix(<$v>.GetSeconds(), int64(<$nanos>)).UTC(), nil
+			}
+
+			func <.Equals>(<$lhs>, <$rhs> <$time>.Time) bool {
+				return <$lhs>.Equal(<$rhs>)
+			}
+		<else>
+			func <.ToThrift>(<$v> <$time>.Duration) (<typeReference .Spec>, error) {
+				<$seconds> := int64(<$v> / <$time>.Second)
+				<$nanos> := int32(<$v> % <$time>.Second)
+				return &<typeName .Spec>{
+					<- range .Spec.Fields>
+						<goName .>: <if not .Required>&<end><if eq .Name "seconds"><$seconds><else><$nanos><end>,
+					<- end>
+				}, nil
+			}
+
+			func <.FromThrift>(<$v> <typeReference .Spec>) (<$time>.Duration, error) {
+				<$seconds>, <$nanos> := <$v>.GetSeconds(), <$v>.GetNanos()
+				if -1e9 >= <$nanos> || <$nanos> >= 1e9 {
+					return 0, <$fmt>.Errorf("invalid <.Spec.Name>: nanos %d out of range", <$nanos>)
+				}
+				<- $math := import "math">
+				<$maxSeconds := newVar "maxSeconds" ->
+				<$maxSeconds> := int64(<$math>.MaxInt64 / <$time>.Second)
+				if <$seconds> > <$maxSeconds> || -<$maxSeconds> > <$seconds> {
+					return 0, <$fmt>.Errorf("invalid <.Spec.Name>: %d seconds cannot be represented as a time.Duration", <$seconds>)
+				}
+				return <$time>.Duration(<$seconds>)*<$time>.Second + <$time>.Duration(<$nanos>), nil
+			}
+		<end>
+		`, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldMapping{
+		Type:       g.Import("time") + "." + t.Name,
+		ToThrift:   data.ToThrift,
+		FromThrift: data.FromThrift,
+		Equals:     data.Equals,
+	}, nil
+}
+
+// verifyWellKnownType verifies that the given struct has exactly the
+// fields of the well-known type it's annotated with: an i64 named seconds
+// and an i32 named nanos, stored as plain fields.
+func verifyWellKnownType(g Generator, spec *compile.StructSpec) error {
+	if spec.Type != ast.StructType {
+		return fmt.Errorf("invalid %v on %q: only structs may be well-known types",
+			WellKnownTypeLabel, spec.Name)
+	}
+
+	if len(spec.Annotations[PresenceLabel]) > 0 && spec.Annotations[PresenceLabel] != "pointer" {
+		return fmt.Errorf("invalid %v on %q: well-known types cannot use presence bitmaps",
+			WellKnownTypeLabel, spec.Name)
+	}
+
+	want := map[string]compile.TypeSpec{
+		"seconds": &compile.I64Spec{},
+		"nanos":   &compile.I32Spec{},
+	}
+	for _, f := range spec.Fields {
+		t, ok := want[f.Name]
+		if !ok || compile.RootTypeSpec(f.Type).ThriftName() != t.ThriftName() {
+			return fmt.Errorf(
+				"invalid %v on %q: expected only the fields \"i64 seconds\" and \"i32 nanos\", found %q",
+				WellKnownTypeLabel, spec.Name, f.Name)
+		}
+		if m, err := mappedField(g, f); err != nil {
+			return err
+		} else if m != nil {
+			return fmt.Errorf("invalid %v on %q: field %q uses a custom type",
+				WellKnownTypeLabel, spec.Name, f.Name)
+		}
+		delete(want, f.Name)
+	}
+
+	if len(want) > 0 {
+		return fmt.Errorf(
+			"invalid %v on %q: expected only the fields \"i64 seconds\" and \"i32 nanos\"",
+			WellKnownTypeLabel, spec.Name)
+	}
+	return nil
+}
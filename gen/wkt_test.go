@@ -0,0 +1,200 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tw "go.uber.org/thriftrw/gen/internal/tests/wkt"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestWellKnownTypesRoundTrip(t *testing.T) {
+	created := time.Unix(1500000000, 5).UTC()
+	updated := time.Unix(-1, 999999999).UTC()
+	ttl := -(90*time.Second + 250*time.Millisecond)
+
+	timestamp := func(seconds int64, nanos int32) wire.Value {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueI64(seconds)},
+			{ID: 2, Value: wire.NewValueI32(nanos)},
+		}})
+	}
+
+	tests := []struct {
+		desc string
+		x    *tw.Event
+		v    wire.Value
+	}{
+		{
+			desc: "required only",
+			x:    &tw.Event{Name: "a", CreatedAt: created},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("a")},
+				{ID: 2, Value: timestamp(1500000000, 5)},
+			}}),
+		},
+		{
+			desc: "all set",
+			x: &tw.Event{
+				Name:      "a",
+				CreatedAt: created,
+				UpdatedAt: &updated,
+				TTL:       &ttl,
+				History:   []*tw.Timestamp{{Seconds: 1, Nanos: 2}},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("a")},
+				{ID: 2, Value: timestamp(1500000000, 5)},
+				{ID: 3, Value: timestamp(-1, 999999999)},
+				{ID: 4, Value: timestamp(-90, -250000000)},
+				{ID: 5, Value: wire.NewValueList(
+					wire.ValueListFromSlice(wire.TStruct, []wire.Value{timestamp(1, 2)}),
+				)},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertRoundTrip(t, tt.x, tt.v, "Event")
+		})
+	}
+}
+
+func TestWellKnownTypesEquals(t *testing.T) {
+	at := time.Unix(1500000000, 0)
+	utc := &tw.Event{Name: "a", CreatedAt: at.UTC()}
+	local := &tw.Event{Name: "a", CreatedAt: at.In(time.FixedZone("X", 3600))}
+	assert.True(t, utc.Equals(local), "times of the same instant must be equal")
+
+	later := &tw.Event{Name: "a", CreatedAt: at.Add(time.Nanosecond)}
+	assert.False(t, utc.Equals(later))
+}
+
+func TestWellKnownTypesFromWireErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		field   wire.Field
+		wantErr string
+	}{
+		{
+			desc: "negative timestamp nanos",
+			field: wire.Field{ID: 2, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueI64(0)},
+				{ID: 2, Value: wire.NewValueI32(-1)},
+			}})},
+			wantErr: "invalid Timestamp: nanos -1 out of range",
+		},
+		{
+			desc: "duration out of range",
+			field: wire.Field{ID: 4, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueI64(1 << 40)},
+			}})},
+			wantErr: "invalid Duration: 1099511627776 seconds cannot be represented as a time.Duration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var e tw.Event
+			err := e.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("a")},
+				tt.field,
+			}}))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestWellKnownTypeAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		thrift  string
+		wantErr string
+	}{
+		{
+			desc: "unknown type",
+			thrift: `
+				struct Foo { 1: required i64 seconds, 2: required i32 nanos } (go.wkt = "date")
+				struct Bar { 1: optional Foo foo }
+			`,
+			wantErr: `invalid go.wkt on "Foo": expected "timestamp" or "duration", got "date"`,
+		},
+		{
+			desc: "wrong field type",
+			thrift: `
+				struct Foo { 1: required i32 seconds, 2: required i32 nanos } (go.wkt = "timestamp")
+				struct Bar { 1: optional Foo foo }
+			`,
+			wantErr: `invalid go.wkt on "Foo": expected only the fields "i64 seconds" and "i32 nanos", found "seconds"`,
+		},
+		{
+			desc: "missing field",
+			thrift: `
+				struct Foo { 1: required i64 seconds } (go.wkt = "duration")
+				struct Bar { 1: optional Foo foo }
+			`,
+			wantErr: `invalid go.wkt on "Foo": expected only the fields "i64 seconds" and "i32 nanos"`,
+		},
+		{
+			desc: "presence bitmap",
+			thrift: `
+				struct Foo {
+					1: optional i64 seconds
+					2: optional i32 nanos
+				} (go.wkt = "duration", go.presence = "bitmap")
+				struct Bar { 1: optional Foo foo }
+			`,
+			wantErr: `invalid go.wkt on "Foo": well-known types cannot use presence bitmaps`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-wkt")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.thrift), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     filepath.Join(thriftRoot, "out"),
+				PackagePrefix: "example.com/idl",
+				ThriftRoot:    thriftRoot,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -35,9 +35,28 @@ import (
 // 		2: required string optout (go.nolog)
 // 	}
 //
-// The above struct will be logged without the optout string.
+// The above struct will be logged without the optout string, and its String
+// method will print RedactedValue in place of the optout string.
 const NoZapLabel = "go.nolog"
 
+// RedactLabel allows struct fields to be logged without their values.
+// Fields with this annotation are logged with the placeholder
+// RedactedValue in place of their actual value. i.e.
+//
+// 	struct Credentials {
+// 		1: required string username
+// 		2: required string password (go.redact)
+// 	}
+//
+// The above struct will be logged with password set to "<redacted>", and
+// the same placeholder is used by its String method. Optional fields that
+// are not set are omitted as usual.
+const RedactLabel = "go.redact"
+
+// RedactedValue is logged in place of the values of fields annotated with
+// RedactLabel.
+const RedactedValue = "<redacted>"
+
 type zapGenerator struct {
 	mapG  mapGenerator
 	setG  setGenerator
@@ -70,12 +89,10 @@ func (z *zapGenerator) zapEncoder(g Generator, spec compile.TypeSpec) string {
 
 	// Containers
 	case *compile.MapSpec:
-		switch compile.RootTypeSpec(t.KeySpec).(type) {
-		case *compile.StringSpec:
+		if isStringKey(t.KeySpec) {
 			return "Object"
-		default:
-			return "Array"
 		}
+		return "Array"
 	case *compile.SetSpec, *compile.ListSpec:
 		return "Array"
 
@@ -86,6 +103,13 @@ func (z *zapGenerator) zapEncoder(g Generator, spec compile.TypeSpec) string {
 	panic(root)
 }
 
+// isStringKey returns true if maps with the given key type are logged as
+// objects keyed by their string keys.
+func isStringKey(spec compile.TypeSpec) bool {
+	_, ok := compile.RootTypeSpec(spec).(*compile.StringSpec)
+	return ok || isStringBinary(spec)
+}
+
 // zapMarshaler takes a TypeSpec, evaluates whether there are underlying elements
 // that require more Zap implementation to log everything, and returns a string
 // that properly casts the fieldValue, if needed, for logging.
@@ -165,7 +189,22 @@ func (z *zapGenerator) zapEncodeEnd(spec compile.TypeSpec) string {
 	return ""
 }
 
+// zapOptOut returns true if the given field should not be logged. Binary
+// fields represented as io.Readers are never logged because they cannot be
+// read without consuming them.
 func zapOptOut(spec *compile.FieldSpec) bool {
 	_, ok := spec.Annotations[NoZapLabel]
+	return ok || isReaderBinary(spec.Type)
+}
+
+func zapRedact(spec *compile.FieldSpec) bool {
+	_, ok := spec.Annotations[RedactLabel]
 	return ok
 }
+
+// stringRedact returns true if the value of the given field should be
+// replaced with RedactedValue in the String method of its struct.
+func stringRedact(spec *compile.FieldSpec) bool {
+	_, ok := spec.Annotations[NoZapLabel]
+	return ok || zapRedact(spec)
+}
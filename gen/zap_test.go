@@ -541,6 +541,46 @@ func TestOptOutOfZap(t *testing.T) {
 	assert.Equal(t, expected, mapEncoder.Fields)
 }
 
+func TestRedactZap(t *testing.T) {
+	// These types are created to ease building map[string]interface{}
+	type o = map[string]interface{}
+
+	tests := []struct {
+		desc string
+		give ts.ZapRedactStruct
+		want o
+	}{
+		{
+			desc: "optional fields unset",
+			give: ts.ZapRedactStruct{Name: "foo", Password: "hunter2"},
+			want: o{"name": "foo", "password": "<redacted>"},
+		},
+		{
+			desc: "optional fields set",
+			give: ts.ZapRedactStruct{
+				Name:     "foo",
+				Password: "hunter2",
+				Token:    []byte("token"),
+				Secrets:  []string{"bar"},
+			},
+			want: o{
+				"name":     "foo",
+				"password": "<redacted>",
+				"token":    "<redacted>",
+				"secrets":  "<redacted>",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mapEncoder := zapcore.NewMapObjectEncoder()
+			require.NoError(t, tt.give.MarshalLogObject(mapEncoder))
+			assert.Equal(t, tt.want, mapEncoder.Fields)
+		})
+	}
+}
+
 func TestTypedefsZapLogging(t *testing.T) {
 	// These types are created to ease building map[string]interface{}
 	type o = map[string]interface{}
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Codec converts values of type T to and from their Thrift-level
+// representation.
+type Codec[T any] struct {
+	// Type is the type of the Thrift-level representation.
+	Type wire.Type
+
+	// ToWire converts the given value.
+	ToWire func(T) (wire.Value, error)
+
+	// FromWire reads a value from the given Thrift-level representation.
+	FromWire func(wire.Value) (T, error)
+
+	// Decode reads a value directly from the given stream.Reader.
+	Decode func(stream.Reader) (T, error)
+
+	// IsNil reports whether the given value is nil. Containers may not
+	// hold nil values. This is nil for types which can't be nil.
+	IsNil func(T) bool
+}
+
+func (c Codec[T]) isNil(v T) bool {
+	return c.IsNil != nil && c.IsNil(v)
+}
+
+// skip skips n values of type t from the stream.
+func skip(sr stream.Reader, t wire.Type, n int) error {
+	for i := 0; i < n; i++ {
+		if err := sr.Skip(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initialCapacity limits the amount of memory we pre-allocate for a
+// collection based on a length read off the wire. We don't want bad requests
+// to lock the system up.
+func initialCapacity(n int) int {
+	const maxPrealloc = 1024
+	if n > maxPrealloc {
+		return maxPrealloc
+	}
+	return n
+}
@@ -0,0 +1,335 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+var i32Codec = Codec[int32]{
+	Type:     wire.TI32,
+	ToWire:   func(v int32) (wire.Value, error) { return wire.NewValueI32(v), nil },
+	FromWire: func(w wire.Value) (int32, error) { return w.GetI32(), nil },
+	Decode:   func(sr stream.Reader) (int32, error) { return sr.ReadInt32() },
+}
+
+var stringCodec = Codec[string]{
+	Type:     wire.TBinary,
+	ToWire:   func(v string) (wire.Value, error) { return wire.NewValueString(v), nil },
+	FromWire: func(w wire.Value) (string, error) { return w.GetString(), nil },
+	Decode:   func(sr stream.Reader) (string, error) { return sr.ReadString() },
+}
+
+var binaryCodec = Codec[[]byte]{
+	Type:     wire.TBinary,
+	ToWire:   func(v []byte) (wire.Value, error) { return wire.NewValueBinary(v), nil },
+	FromWire: func(w wire.Value) ([]byte, error) { return w.GetBinary(), nil },
+	Decode:   func(sr stream.Reader) ([]byte, error) { return sr.ReadBinary() },
+	IsNil:    func(v []byte) bool { return v == nil },
+}
+
+// roundTrip encodes the given value and returns the value decoded from the
+// result along with a stream.Reader positioned at its start.
+func roundTrip(t *testing.T, v wire.Value) (wire.Value, stream.Reader) {
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(v, &buff), "failed to encode")
+
+	got, err := protocol.Binary.Decode(bytes.NewReader(buff.Bytes()), v.Type())
+	require.NoError(t, err, "failed to decode")
+	return got, protocol.BinaryStreamer.Reader(bytes.NewReader(buff.Bytes()))
+}
+
+func TestList(t *testing.T) {
+	give := []string{"foo", "bar", "foo"}
+	w, sr := roundTrip(t, wire.NewValueList(ListValueList(stringCodec, give)))
+
+	got, err := ReadList(stringCodec, w.GetList())
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+
+	got, err = DecodeList(stringCodec, sr)
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+}
+
+func TestListTypeMismatch(t *testing.T) {
+	w, sr := roundTrip(t, wire.NewValueList(ListValueList(i32Codec, []int32{1, 2})))
+
+	got, err := ReadList(stringCodec, w.GetList())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = DecodeList(stringCodec, sr)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestListNilItem(t *testing.T) {
+	l := ListValueList(binaryCodec, [][]byte{{1}, nil})
+	err := l.ForEach(func(wire.Value) error { return nil })
+	assert.EqualError(t, err, "invalid [1]: value is nil")
+}
+
+func TestSet(t *testing.T) {
+	give := map[int32]struct{}{1: {}, 2: {}, 3: {}}
+	w, sr := roundTrip(t, wire.NewValueSet(SetValueList(i32Codec, give)))
+
+	got, err := ReadSet(i32Codec, w.GetSet())
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+
+	got, err = DecodeSet(i32Codec, sr)
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+}
+
+func TestSliceSet(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    []string
+		compare func(string, string) int
+		unique  func([]string) []string
+		want    []string
+	}{
+		{
+			desc: "unordered",
+			give: []string{"b", "a", "b"},
+			want: []string{"b", "a", "b"},
+		},
+		{
+			desc:    "ordered",
+			give:    []string{"b", "c", "a"},
+			compare: strings.Compare,
+			want:    []string{"a", "b", "c"},
+		},
+		{
+			desc:   "unique",
+			give:   []string{"b", "a", "b", "c", "a"},
+			unique: Unique[string],
+			want:   []string{"b", "a", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			give := append([]string(nil), tt.give...)
+			w, sr := roundTrip(t, wire.NewValueSet(SliceSetValueList(stringCodec, give, tt.compare)))
+			assert.Equal(t, tt.give, give, "input must not be modified")
+
+			got, err := ReadSliceSet(stringCodec, w.GetSet(), tt.unique)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			got, err = DecodeSliceSet(stringCodec, sr, tt.unique)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetNilItem(t *testing.T) {
+	s := SliceSetValueList(binaryCodec, [][]byte{nil}, nil)
+	err := s.ForEach(func(wire.Value) error { return nil })
+	assert.EqualError(t, err, "invalid set item: value is nil")
+}
+
+func TestMap(t *testing.T) {
+	give := map[string]int32{"foo": 1, "bar": 2}
+	w, sr := roundTrip(t, wire.NewValueMap(MapItemList(stringCodec, i32Codec, give)))
+
+	got, err := ReadMap(stringCodec, i32Codec, w.GetMap())
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+
+	got, err = DecodeMap(stringCodec, i32Codec, sr)
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+}
+
+func TestMapTypeMismatch(t *testing.T) {
+	w, sr := roundTrip(t, wire.NewValueMap(MapItemList(stringCodec, i32Codec, map[string]int32{"foo": 1})))
+
+	got, err := ReadMap(stringCodec, stringCodec, w.GetMap())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = DecodeMap(stringCodec, stringCodec, sr)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestSliceMap(t *testing.T) {
+	give := []struct {
+		Key   []byte
+		Value string
+	}{
+		{Key: []byte("foo"), Value: "bar"},
+		{Key: []byte("baz"), Value: "qux"},
+	}
+	w, sr := roundTrip(t, wire.NewValueMap(SliceMapItemList(binaryCodec, stringCodec, give)))
+
+	got, err := ReadSliceMap(binaryCodec, stringCodec, w.GetMap())
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+
+	got, err = DecodeSliceMap(binaryCodec, stringCodec, sr)
+	require.NoError(t, err)
+	assert.Equal(t, give, got)
+}
+
+func TestSortedMap(t *testing.T) {
+	type item struct {
+		Key   string
+		Value int32
+	}
+
+	want := []item{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}}
+	tests := []struct {
+		desc string
+		give wire.MapItemList
+	}{
+		{
+			desc: "map",
+			give: SortedMapItemList(stringCodec, i32Codec,
+				map[string]int32{"c": 3, "a": 1, "d": 4, "b": 2}, strings.Compare),
+		},
+		{
+			desc: "slice",
+			give: SortedSliceMapItemList(stringCodec, i32Codec, []struct {
+				Key   string
+				Value int32
+			}{{"c", 3}, {"a", 1}, {"d", 4}, {"b", 2}}, strings.Compare),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w, _ := roundTrip(t, wire.NewValueMap(tt.give))
+
+			var got []item
+			require.NoError(t, w.GetMap().ForEach(func(i wire.MapItem) error {
+				got = append(got, item{i.Key.GetString(), i.Value.GetI32()})
+				return nil
+			}))
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestMapNilItems(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    wire.MapItemList
+		wantErr string
+	}{
+		{
+			desc: "key",
+			give: SliceMapItemList(binaryCodec, stringCodec, []struct {
+				Key   []byte
+				Value string
+			}{{Key: nil, Value: "foo"}}),
+			wantErr: "invalid map key: value is nil",
+		},
+		{
+			desc:    "value",
+			give:    MapItemList(stringCodec, binaryCodec, map[string][]byte{"foo": nil}),
+			wantErr: "invalid [foo]: value is nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.give.ForEach(func(wire.MapItem) error { return nil })
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDecodeLength(t *testing.T) {
+	// Headers claiming more items than the stream holds.
+	list := []byte{0x08, 0x7f, 0xff, 0xff, 0xff}
+	mapH := []byte{0x0b, 0x08, 0x7f, 0xff, 0xff, 0xff}
+
+	tests := []struct {
+		desc   string
+		give   []byte
+		decode func(stream.Reader) error
+	}{
+		{
+			desc: "list",
+			give: list,
+			decode: func(sr stream.Reader) error {
+				_, err := DecodeList(i32Codec, sr)
+				return err
+			},
+		},
+		{
+			desc: "set",
+			give: list,
+			decode: func(sr stream.Reader) error {
+				_, err := DecodeSet(i32Codec, sr)
+				return err
+			},
+		},
+		{
+			desc: "slice set",
+			give: list,
+			decode: func(sr stream.Reader) error {
+				_, err := DecodeSliceSet(i32Codec, sr, nil)
+				return err
+			},
+		},
+		{
+			desc: "map",
+			give: mapH,
+			decode: func(sr stream.Reader) error {
+				_, err := DecodeMap(stringCodec, i32Codec, sr)
+				return err
+			},
+		},
+		{
+			desc: "slice map",
+			give: mapH,
+			decode: func(sr stream.Reader) error {
+				_, err := DecodeSliceMap(stringCodec, i32Codec, sr)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.decode(protocol.BinaryStreamer.Reader(bytes.NewReader(tt.give)))
+			assert.Error(t, err)
+		})
+	}
+}
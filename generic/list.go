@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/required"
+	"go.uber.org/thriftrw/wire"
+)
+
+type listValueList[T any] struct {
+	c     Codec[T]
+	items []T
+}
+
+// ListValueList returns a ValueList which converts the items of the given
+// list with the given Codec.
+func ListValueList[T any](c Codec[T], items []T) wire.ValueList {
+	return listValueList[T]{c: c, items: items}
+}
+
+func (l listValueList[T]) ForEach(f func(wire.Value) error) error {
+	for i, x := range l.items {
+		if l.c.isNil(x) {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+
+		w, err := l.c.ToWire(x)
+		if err != nil {
+			return err
+		}
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l listValueList[T]) Size() int {
+	return len(l.items)
+}
+
+func (l listValueList[T]) ValueType() wire.Type {
+	return l.c.Type
+}
+
+func (listValueList[T]) Close() {}
+
+// ReadList reads a list from the given ValueList, converting its items with
+// the given Codec.
+//
+// A nil list is returned if the items of the ValueList aren't of the type
+// expected by the Codec. Items missing required fields are kept and
+// reported together as required.Errors.
+func ReadList[T any](c Codec[T], l wire.ValueList) ([]T, error) {
+	if l.ValueType() != c.Type {
+		return nil, nil
+	}
+
+	o := make([]T, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := c.FromWire(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// DecodeList reads a list directly from the given stream.Reader, decoding
+// its items with the given Codec.
+//
+// The list is skipped and a nil list is returned if its items aren't of the
+// type expected by the Codec.
+func DecodeList[T any](c Codec[T], sr stream.Reader) ([]T, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != c.Type {
+		if err := skip(sr, lh.Type, lh.Length); err != nil {
+			return nil, err
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]T, 0, initialCapacity(lh.Length))
+	for i := 0; i < lh.Length; i++ {
+		v, err := c.Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
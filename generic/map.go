@@ -0,0 +1,354 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/required"
+	"go.uber.org/thriftrw/wire"
+)
+
+var errNilMapKey = errors.New("invalid map key: value is nil")
+
+// mapItemToWire converts a single item of a map.
+func mapItemToWire[K, V any](kc Codec[K], vc Codec[V], k K, v V) (wire.MapItem, error) {
+	if kc.isNil(k) {
+		return wire.MapItem{}, errNilMapKey
+	}
+	if vc.isNil(v) {
+		return wire.MapItem{}, fmt.Errorf("invalid [%v]: value is nil", k)
+	}
+
+	kw, err := kc.ToWire(k)
+	if err != nil {
+		return wire.MapItem{}, err
+	}
+
+	vw, err := vc.ToWire(v)
+	if err != nil {
+		return wire.MapItem{}, err
+	}
+	return wire.MapItem{Key: kw, Value: vw}, nil
+}
+
+// readMap reads the items of the given MapItemList, calling add with each
+// of them. Items missing required fields are added and reported together
+// as required.Errors.
+func readMap[K, V any](kc Codec[K], vc Codec[V], m wire.MapItemList, add func(K, V)) error {
+	var missing required.Errors
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := kc.FromWire(x.Key)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		v, err := vc.FromWire(x.Value)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		add(k, v)
+		return nil
+	})
+	m.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return err
+}
+
+// decodeMapBegin reads a map header from the given stream.Reader and
+// returns the number of items that follow it. The map is skipped and false
+// is returned if its items aren't of the given types.
+func decodeMapBegin(sr stream.Reader, kt, vt wire.Type) (int, bool, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return 0, false, err
+	}
+
+	if mh.KeyType != kt || mh.ValueType != vt {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return 0, false, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return 0, false, err
+			}
+		}
+		return 0, false, sr.ReadMapEnd()
+	}
+
+	return mh.Length, true, nil
+}
+
+// decodeMapItems decodes n items of a map from the given stream.Reader,
+// calling add with each of them, and then reads the end of the map.
+func decodeMapItems[K, V any](kc Codec[K], vc Codec[V], sr stream.Reader, n int, add func(K, V)) error {
+	for i := 0; i < n; i++ {
+		k, err := kc.Decode(sr)
+		if err != nil {
+			return err
+		}
+
+		v, err := vc.Decode(sr)
+		if err != nil {
+			return err
+		}
+
+		add(k, v)
+	}
+	return sr.ReadMapEnd()
+}
+
+type mapItemList[K comparable, V any] struct {
+	kc    Codec[K]
+	vc    Codec[V]
+	items map[K]V
+}
+
+// MapItemList returns a MapItemList which converts the items of the given
+// map with the given Codecs.
+func MapItemList[K comparable, V any](kc Codec[K], vc Codec[V], items map[K]V) wire.MapItemList {
+	return mapItemList[K, V]{kc: kc, vc: vc, items: items}
+}
+
+func (m mapItemList[K, V]) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m.items {
+		item, err := mapItemToWire(m.kc, m.vc, k, v)
+		if err != nil {
+			return err
+		}
+		if err := f(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m mapItemList[K, V]) Size() int {
+	return len(m.items)
+}
+
+func (m mapItemList[K, V]) KeyType() wire.Type {
+	return m.kc.Type
+}
+
+func (m mapItemList[K, V]) ValueType() wire.Type {
+	return m.vc.Type
+}
+
+func (mapItemList[K, V]) Close() {}
+
+// ReadMap reads a map from the given MapItemList, converting its keys and
+// values with the given Codecs.
+//
+// A nil map is returned if the items of the MapItemList aren't of the types
+// expected by the Codecs.
+func ReadMap[K comparable, V any](kc Codec[K], vc Codec[V], m wire.MapItemList) (map[K]V, error) {
+	if m.KeyType() != kc.Type || m.ValueType() != vc.Type {
+		return nil, nil
+	}
+
+	o := make(map[K]V, m.Size())
+	err := readMap(kc, vc, m, func(k K, v V) {
+		o[k] = v
+	})
+	return o, err
+}
+
+// DecodeMap reads a map directly from the given stream.Reader, decoding its
+// keys and values with the given Codecs.
+//
+// The map is skipped and a nil map is returned if its items aren't of the
+// types expected by the Codecs.
+func DecodeMap[K comparable, V any](kc Codec[K], vc Codec[V], sr stream.Reader) (map[K]V, error) {
+	n, ok, err := decodeMapBegin(sr, kc.Type, vc.Type)
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	o := make(map[K]V, initialCapacity(n))
+	err = decodeMapItems(kc, vc, sr, n, func(k K, v V) {
+		o[k] = v
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+type sliceMapItemList[K, V any] struct {
+	kc    Codec[K]
+	vc    Codec[V]
+	items []struct {
+		Key   K
+		Value V
+	}
+}
+
+// SliceMapItemList returns a MapItemList which converts the items of the
+// given map, represented as a slice of key-value pairs, with the given
+// Codecs. Maps whose keys can't be used as keys of Go maps are represented
+// this way.
+func SliceMapItemList[K, V any](kc Codec[K], vc Codec[V], items []struct {
+	Key   K
+	Value V
+}) wire.MapItemList {
+	return sliceMapItemList[K, V]{kc: kc, vc: vc, items: items}
+}
+
+func (m sliceMapItemList[K, V]) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m.items {
+		item, err := mapItemToWire(m.kc, m.vc, i.Key, i.Value)
+		if err != nil {
+			return err
+		}
+		if err := f(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m sliceMapItemList[K, V]) Size() int {
+	return len(m.items)
+}
+
+func (m sliceMapItemList[K, V]) KeyType() wire.Type {
+	return m.kc.Type
+}
+
+func (m sliceMapItemList[K, V]) ValueType() wire.Type {
+	return m.vc.Type
+}
+
+func (sliceMapItemList[K, V]) Close() {}
+
+// SortedMapItemList is like MapItemList, but the items of the given map are
+// sent in the order of their keys defined by compare so that equal maps are
+// encoded identically. compare returns a negative number, zero, or a
+// positive number if its first argument is less than, equal to, or greater
+// than its second argument.
+func SortedMapItemList[K comparable, V any](kc Codec[K], vc Codec[V], items map[K]V, compare func(K, K) int) wire.MapItemList {
+	sorted := make([]struct {
+		Key   K
+		Value V
+	}, 0, len(items))
+	for k, v := range items {
+		sorted = append(sorted, struct {
+			Key   K
+			Value V
+		}{k, v})
+	}
+	sortMapItems(sorted, compare)
+	return sliceMapItemList[K, V]{kc: kc, vc: vc, items: sorted}
+}
+
+// SortedSliceMapItemList is like SliceMapItemList, but the items of the
+// given map are sent in the order of their keys defined by compare.
+func SortedSliceMapItemList[K, V any](kc Codec[K], vc Codec[V], items []struct {
+	Key   K
+	Value V
+}, compare func(K, K) int) wire.MapItemList {
+	sorted := make([]struct {
+		Key   K
+		Value V
+	}, len(items))
+	copy(sorted, items)
+	sortMapItems(sorted, compare)
+	return sliceMapItemList[K, V]{kc: kc, vc: vc, items: sorted}
+}
+
+// sortMapItems sorts the given items in the order of their keys.
+func sortMapItems[K, V any](items []struct {
+	Key   K
+	Value V
+}, compare func(K, K) int) {
+	sort.Slice(items, func(i, j int) bool {
+		return compare(items[i].Key, items[j].Key) < 0
+	})
+}
+
+// ReadSliceMap reads a map represented as a slice of key-value pairs from
+// the given MapItemList, converting its keys and values with the given
+// Codecs.
+//
+// A nil map is returned if the items of the MapItemList aren't of the types
+// expected by the Codecs.
+func ReadSliceMap[K, V any](kc Codec[K], vc Codec[V], m wire.MapItemList) ([]struct {
+	Key   K
+	Value V
+}, error) {
+	if m.KeyType() != kc.Type || m.ValueType() != vc.Type {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   K
+		Value V
+	}, 0, m.Size())
+	err := readMap(kc, vc, m, func(k K, v V) {
+		o = append(o, struct {
+			Key   K
+			Value V
+		}{k, v})
+	})
+	return o, err
+}
+
+// DecodeSliceMap reads a map represented as a slice of key-value pairs
+// directly from the given stream.Reader, decoding its keys and values with
+// the given Codecs.
+//
+// The map is skipped and a nil map is returned if its items aren't of the
+// types expected by the Codecs.
+func DecodeSliceMap[K, V any](kc Codec[K], vc Codec[V], sr stream.Reader) ([]struct {
+	Key   K
+	Value V
+}, error) {
+	n, ok, err := decodeMapBegin(sr, kc.Type, vc.Type)
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	o := make([]struct {
+		Key   K
+		Value V
+	}, 0, initialCapacity(n))
+	err = decodeMapItems(kc, vc, sr, n, func(k K, v V) {
+		o = append(o, struct {
+			Key   K
+			Value V
+		}{k, v})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package generic
+
+import (
+	"errors"
+	"sort"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/required"
+	"go.uber.org/thriftrw/wire"
+)
+
+var errNilSetItem = errors.New("invalid set item: value is nil")
+
+type setValueList[T comparable] struct {
+	c     Codec[T]
+	items map[T]struct{}
+}
+
+// SetValueList returns a ValueList which converts the items of the given
+// set with the given Codec.
+func SetValueList[T comparable](c Codec[T], items map[T]struct{}) wire.ValueList {
+	return setValueList[T]{c: c, items: items}
+}
+
+func (s setValueList[T]) ForEach(f func(wire.Value) error) error {
+	for x := range s.items {
+		if s.c.isNil(x) {
+			return errNilSetItem
+		}
+
+		w, err := s.c.ToWire(x)
+		if err != nil {
+			return err
+		}
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s setValueList[T]) Size() int {
+	return len(s.items)
+}
+
+func (s setValueList[T]) ValueType() wire.Type {
+	return s.c.Type
+}
+
+func (setValueList[T]) Close() {}
+
+// ReadSet reads a set from the given ValueList, converting its items with
+// the given Codec.
+//
+// A nil set is returned if the items of the ValueList aren't of the type
+// expected by the Codec.
+func ReadSet[T comparable](c Codec[T], s wire.ValueList) (map[T]struct{}, error) {
+	if s.ValueType() != c.Type {
+		return nil, nil
+	}
+
+	o := make(map[T]struct{}, s.Size())
+	var missing required.Errors
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := c.FromWire(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// DecodeSet reads a set directly from the given stream.Reader, decoding its
+// items with the given Codec.
+//
+// The set is skipped and a nil set is returned if its items aren't of the
+// type expected by the Codec.
+func DecodeSet[T comparable](c Codec[T], sr stream.Reader) (map[T]struct{}, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != c.Type {
+		if err := skip(sr, sh.Type, sh.Length); err != nil {
+			return nil, err
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make(map[T]struct{}, initialCapacity(sh.Length))
+	for i := 0; i < sh.Length; i++ {
+		v, err := c.Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o[v] = struct{}{}
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+type sliceSetValueList[T any] struct {
+	c     Codec[T]
+	items []T
+}
+
+// SliceSetValueList returns a ValueList which converts the items of the
+// given set, represented as a slice, with the given Codec.
+//
+// If compare is non-nil, the items are sent in the order defined by it so
+// that equal sets are encoded identically. compare returns a negative
+// number, zero, or a positive number if its first argument is less than,
+// equal to, or greater than its second argument.
+func SliceSetValueList[T any](c Codec[T], items []T, compare func(T, T) int) wire.ValueList {
+	if compare != nil {
+		sorted := make([]T, len(items))
+		copy(sorted, items)
+		sort.Slice(sorted, func(i, j int) bool {
+			return compare(sorted[i], sorted[j]) < 0
+		})
+		items = sorted
+	}
+	return sliceSetValueList[T]{c: c, items: items}
+}
+
+func (s sliceSetValueList[T]) ForEach(f func(wire.Value) error) error {
+	for _, x := range s.items {
+		if s.c.isNil(x) {
+			return errNilSetItem
+		}
+
+		w, err := s.c.ToWire(x)
+		if err != nil {
+			return err
+		}
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s sliceSetValueList[T]) Size() int {
+	return len(s.items)
+}
+
+func (s sliceSetValueList[T]) ValueType() wire.Type {
+	return s.c.Type
+}
+
+func (sliceSetValueList[T]) Close() {}
+
+// ReadSliceSet reads a set represented as a slice from the given ValueList,
+// converting its items with the given Codec.
+//
+// If unique is non-nil, the items read are passed through it to drop
+// duplicates. See Unique.
+//
+// A nil set is returned if the items of the ValueList aren't of the type
+// expected by the Codec.
+func ReadSliceSet[T any](c Codec[T], s wire.ValueList, unique func([]T) []T) ([]T, error) {
+	o, err := ReadList(c, s)
+	if _, missing := err.(required.Errors); (err == nil || missing) && o != nil && unique != nil {
+		o = unique(o)
+	}
+	return o, err
+}
+
+// DecodeSliceSet reads a set represented as a slice directly from the given
+// stream.Reader, decoding its items with the given Codec.
+//
+// If unique is non-nil, the items read are passed through it to drop
+// duplicates. See Unique.
+//
+// The set is skipped and a nil set is returned if its items aren't of the
+// type expected by the Codec.
+func DecodeSliceSet[T any](c Codec[T], sr stream.Reader, unique func([]T) []T) ([]T, error) {
+	sh, err := sr.ReadSetBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Type != c.Type {
+		if err := skip(sr, sh.Type, sh.Length); err != nil {
+			return nil, err
+		}
+		return nil, sr.ReadSetEnd()
+	}
+
+	o := make([]T, 0, initialCapacity(sh.Length))
+	for i := 0; i < sh.Length; i++ {
+		v, err := c.Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadSetEnd(); err != nil {
+		return nil, err
+	}
+	if unique != nil {
+		o = unique(o)
+	}
+	return o, err
+}
+
+// Unique drops duplicates from the given slice in place, keeping values in
+// the order in which they were first seen.
+func Unique[T comparable](items []T) []T {
+	seen := make(map[T]struct{}, len(items))
+	o := items[:0]
+	for _, x := range items {
+		if _, dup := seen[x]; !dup {
+			seen[x] = struct{}{}
+			o = append(o, x)
+		}
+	}
+	return o
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"go.uber.org/thriftrw/codegen"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/graph"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type graphOptions struct {
+	Format        string `long:"format" value-name:"FORMAT" description:"Format in which the graph is written: dot or json. Defaults to dot."`
+	ThriftRoot    string `long:"thrift-root" value-name:"DIR" description:"Directory whose descendants contain all Thrift files. Defaults to the deepest common ancestor directory of the Thrift files."`
+	PackagePrefix string `long:"pkg-prefix" value-name:"PREFIX" description:"Prefix for import paths of generated packages."`
+}
+
+// runGraph writes the include graph of the Thrift file in args to out.
+func runGraph(args []string, out io.Writer) error {
+	var opts graphOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw graph"
+	parser.Usage = "[OPTIONS] FILE"
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) != 1 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	module, err := compile.Compile(files[0])
+	if err != nil {
+		return err
+	}
+
+	thriftRoot := opts.ThriftRoot
+	if thriftRoot == "" {
+		thriftRoot, err = codegen.FindCommonAncestor(module)
+	} else {
+		thriftRoot, err = filepath.Abs(thriftRoot)
+	}
+	if err != nil {
+		return fmt.Errorf("could not determine the Thrift root: %v", err)
+	}
+
+	g, err := graph.Build(module, &graph.Options{
+		ThriftRoot:    thriftRoot,
+		PackagePrefix: opts.PackagePrefix,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case "", "dot":
+		return graph.WriteDOT(out, g)
+	case "json":
+		b, err := json.MarshalIndent(g, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(append(b, '\n'))
+		return err
+	default:
+		return fmt.Errorf("unknown format %q: expected dot or json", opts.Format)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteDOT writes the given graph to w in the DOT language.
+//
+// Each module is labeled with its path and the Go package generated for it.
+// Unused includes are drawn with dashed edges, and includes which are part
+// of a cycle are drawn in red.
+func WriteDOT(w io.Writer, g *Graph) error {
+	var buf bytes.Buffer
+	buf.WriteString("digraph thrift {\n")
+	buf.WriteString("  node [shape=box];\n")
+
+	for _, m := range g.Modules {
+		fmt.Fprintf(&buf, "  %v [label=%v];\n",
+			strconv.Quote(m.Path), strconv.Quote(m.Path+"\n"+m.Package))
+	}
+
+	for _, m := range g.Modules {
+		for _, inc := range m.Includes {
+			var attrs []string
+			if inc.Unused {
+				attrs = append(attrs, "style=dashed")
+			}
+			if inc.Cyclic {
+				attrs = append(attrs, "color=red")
+			}
+
+			fmt.Fprintf(&buf, "  %v -> %v", strconv.Quote(m.Path), strconv.Quote(inc.Path))
+			if len(attrs) > 0 {
+				fmt.Fprintf(&buf, " [%v]", strings.Join(attrs, ", "))
+			}
+			buf.WriteString(";\n")
+		}
+	}
+
+	buf.WriteString("}\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
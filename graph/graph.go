@@ -0,0 +1,304 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/idl"
+)
+
+// Options controls how a Graph is built.
+type Options struct {
+	// ThriftRoot is the directory containing all Thrift files in the graph.
+	// Paths in the graph are relative to this directory, which must be
+	// absolute.
+	ThriftRoot string
+
+	// PackagePrefix is the import path prefix of the generated Go packages,
+	// as passed to the code generator.
+	PackagePrefix string
+}
+
+// Graph is the include graph of a Thrift file.
+type Graph struct {
+	// Path to the Thrift file from which the graph was built.
+	Root string `json:"root"`
+
+	// Modules reachable from Root, including itself, ordered by path.
+	Modules []*Module `json:"modules"`
+
+	// Groups of modules which include each other, directly or
+	// transitively. Paths in each group are sorted.
+	Cycles [][]string `json:"cycles,omitempty"`
+}
+
+// Module is a single Thrift file in a Graph.
+type Module struct {
+	// Path to the Thrift file relative to the ThriftRoot.
+	Path string `json:"path"`
+
+	// Import path of the Go package generated for this file.
+	Package string `json:"package"`
+
+	// Files included by this file, ordered by name.
+	Includes []*Include `json:"includes,omitempty"`
+}
+
+// Include is an include statement of a Thrift file.
+type Include struct {
+	// Name under which the included file is referenced.
+	Name string `json:"name"`
+
+	// Path to the included file relative to the ThriftRoot.
+	Path string `json:"path"`
+
+	// Unused is true if nothing in the including file refers to this
+	// include.
+	Unused bool `json:"unused,omitempty"`
+
+	// Cyclic is true if the included file includes the including file,
+	// directly or transitively.
+	Cyclic bool `json:"cyclic,omitempty"`
+}
+
+// Build builds the include graph of the given module.
+func Build(m *compile.Module, o *Options) (*Graph, error) {
+	if !filepath.IsAbs(o.ThriftRoot) {
+		return nil, fmt.Errorf(
+			"ThriftRoot must be an absolute path: %q is not absolute", o.ThriftRoot)
+	}
+
+	relPath := func(m *compile.Module) (string, error) {
+		path, err := filepath.Rel(o.ThriftRoot, m.ThriftPath)
+		if err != nil || strings.HasPrefix(path, "..") {
+			return "", fmt.Errorf(
+				"%q is not contained in the %q directory tree", m.ThriftPath, o.ThriftRoot)
+		}
+		return filepath.ToSlash(path), nil
+	}
+
+	root, err := relPath(m)
+	if err != nil {
+		return nil, err
+	}
+	g := &Graph{Root: root}
+
+	err = m.Walk(func(m *compile.Module) error {
+		path, err := relPath(m)
+		if err != nil {
+			return err
+		}
+
+		used, err := referencedIncludes(m)
+		if err != nil {
+			return err
+		}
+
+		mod := &Module{
+			Path:    path,
+			Package: strings.TrimSuffix(pathJoin(o.PackagePrefix, path), ".thrift"),
+		}
+		for _, name := range sortedIncludeNames(m) {
+			incPath, err := relPath(m.Includes[name].Module)
+			if err != nil {
+				return err
+			}
+
+			_, isUsed := used[name]
+			mod.Includes = append(mod.Includes, &Include{
+				Name:   name,
+				Path:   incPath,
+				Unused: !isUsed,
+			})
+		}
+
+		g.Modules = append(g.Modules, mod)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(g.Modules, func(i, j int) bool {
+		return g.Modules[i].Path < g.Modules[j].Path
+	})
+	g.findCycles()
+	return g, nil
+}
+
+// Module returns the module with the given path, or nil if the graph
+// doesn't have it.
+func (g *Graph) Module(path string) *Module {
+	i := sort.Search(len(g.Modules), func(i int) bool {
+		return g.Modules[i].Path >= path
+	})
+	if i < len(g.Modules) && g.Modules[i].Path == path {
+		return g.Modules[i]
+	}
+	return nil
+}
+
+// findCycles populates the Cycles of this graph and marks the includes
+// which are part of them. Cycles are the strongly connected components of
+// the graph with more than one module, or with a module including itself.
+func (g *Graph) findCycles() {
+	var (
+		index   = make(map[string]int)
+		lowlink = make(map[string]int)
+		onStack = make(map[string]bool)
+		stack   []string
+		next    int
+		group   = make(map[string]int)
+	)
+
+	var visit func(path string)
+	visit = func(path string) {
+		index[path] = next
+		lowlink[path] = next
+		next++
+		stack = append(stack, path)
+		onStack[path] = true
+
+		for _, inc := range g.Module(path).Includes {
+			if _, visited := index[inc.Path]; !visited {
+				visit(inc.Path)
+				if lowlink[inc.Path] < lowlink[path] {
+					lowlink[path] = lowlink[inc.Path]
+				}
+			} else if onStack[inc.Path] && index[inc.Path] < lowlink[path] {
+				lowlink[path] = index[inc.Path]
+			}
+		}
+
+		if lowlink[path] != index[path] {
+			return
+		}
+
+		var members []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			members = append(members, top)
+			if top == path {
+				break
+			}
+		}
+
+		if len(members) == 1 && !includes(g.Module(path), path) {
+			return
+		}
+
+		sort.Strings(members)
+		for _, member := range members {
+			group[member] = len(g.Cycles)
+		}
+		g.Cycles = append(g.Cycles, members)
+	}
+
+	for _, m := range g.Modules {
+		if _, visited := index[m.Path]; !visited {
+			visit(m.Path)
+		}
+	}
+
+	sort.Slice(g.Cycles, func(i, j int) bool {
+		return g.Cycles[i][0] < g.Cycles[j][0]
+	})
+
+	for _, m := range g.Modules {
+		mGroup, ok := group[m.Path]
+		if !ok {
+			continue
+		}
+		for _, inc := range m.Includes {
+			if incGroup, ok := group[inc.Path]; ok && incGroup == mGroup {
+				inc.Cyclic = true
+			}
+		}
+	}
+}
+
+func includes(m *Module, path string) bool {
+	for _, inc := range m.Includes {
+		if inc.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// referencedIncludes returns the names of the includes of the given module
+// which are referred to by its types, constants, or services.
+func referencedIncludes(m *compile.Module) (map[string]struct{}, error) {
+	// The module has already been compiled, which may have allowed
+	// keywords to be used as field names.
+	prog, err := (&idl.Config{KeywordFieldNames: true}).Parse(m.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q: %v", m.ThriftPath, err)
+	}
+
+	used := make(map[string]struct{})
+	use := func(name string) {
+		if i := strings.IndexByte(name, '.'); i > 0 {
+			if _, ok := m.Includes[name[:i]]; ok {
+				used[name[:i]] = struct{}{}
+			}
+		}
+	}
+
+	ast.Walk(ast.VisitorFunc(func(w ast.Walker, n ast.Node) {
+		switch n := n.(type) {
+		case ast.TypeReference:
+			use(n.Name)
+		case ast.ConstantReference:
+			use(n.Name)
+		case *ast.Service:
+			if n.Parent != nil {
+				use(n.Parent.Name)
+			}
+		}
+	}), prog)
+	return used, nil
+}
+
+func sortedIncludeNames(m *compile.Module) []string {
+	names := make([]string, 0, len(m.Includes))
+	for name := range m.Includes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pathJoin joins the given import path prefix and slash-separated path.
+func pathJoin(prefix, path string) string {
+	if prefix == "" {
+		return path
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + path
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	dir := idltest.TempDir(t, map[string]string{
+		"api.thrift": `
+			include "common/types.thrift"
+			include "common/unused.thrift"
+			include "events.thrift"
+
+			struct Request {}
+
+			service Users extends events.Publisher {
+				types.User get(1: string id)
+			}
+		`,
+		"events.thrift": `
+			include "api.thrift"
+			include "./events.thrift" as me
+
+			typedef api.Request Event
+			service Publisher {}
+		`,
+		"common/types.thrift": `
+			struct User {}
+		`,
+		"common/unused.thrift": `
+			enum Role { User }
+			const Role DEFAULT = Role.User
+		`,
+	})
+	defer os.RemoveAll(dir)
+
+	module, err := compile.Compile(filepath.Join(dir, "api.thrift"))
+	require.NoError(t, err)
+
+	g, err := Build(module, &Options{ThriftRoot: dir, PackagePrefix: "example.com/idl/"})
+	require.NoError(t, err)
+
+	assert.Equal(t, &Graph{
+		Root: "api.thrift",
+		Modules: []*Module{
+			{
+				Path:    "api.thrift",
+				Package: "example.com/idl/api",
+				Includes: []*Include{
+					{Name: "events", Path: "events.thrift", Cyclic: true},
+					{Name: "types", Path: "common/types.thrift"},
+					{Name: "unused", Path: "common/unused.thrift", Unused: true},
+				},
+			},
+			{Path: "common/types.thrift", Package: "example.com/idl/common/types"},
+			{Path: "common/unused.thrift", Package: "example.com/idl/common/unused"},
+			{
+				Path:    "events.thrift",
+				Package: "example.com/idl/events",
+				Includes: []*Include{
+					{Name: "api", Path: "api.thrift", Cyclic: true},
+					{Name: "me", Path: "events.thrift", Unused: true, Cyclic: true},
+				},
+			},
+		},
+		Cycles: [][]string{{"api.thrift", "events.thrift"}},
+	}, g)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDOT(&buf, g))
+	assert.Equal(t, `digraph thrift {
+  node [shape=box];
+  "api.thrift" [label="api.thrift\nexample.com/idl/api"];
+  "common/types.thrift" [label="common/types.thrift\nexample.com/idl/common/types"];
+  "common/unused.thrift" [label="common/unused.thrift\nexample.com/idl/common/unused"];
+  "events.thrift" [label="events.thrift\nexample.com/idl/events"];
+  "api.thrift" -> "events.thrift" [color=red];
+  "api.thrift" -> "common/types.thrift";
+  "api.thrift" -> "common/unused.thrift" [style=dashed];
+  "events.thrift" -> "api.thrift" [color=red];
+  "events.thrift" -> "events.thrift" [style=dashed, color=red];
+}
+`, buf.String())
+}
+
+func TestBuildSelfInclude(t *testing.T) {
+	dir := idltest.TempDir(t, map[string]string{
+		"a.thrift": `
+			include "./a.thrift" as me
+			typedef string UUID
+			typedef me.UUID ID
+		`,
+	})
+	defer os.RemoveAll(dir)
+
+	module, err := compile.Compile(filepath.Join(dir, "a.thrift"))
+	require.NoError(t, err)
+
+	g, err := Build(module, &Options{ThriftRoot: dir})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{{"a.thrift"}}, g.Cycles)
+	assert.Equal(t, "a", g.Module("a.thrift").Package)
+	assert.Equal(t, []*Include{
+		{Name: "me", Path: "a.thrift", Cyclic: true},
+	}, g.Module("a.thrift").Includes)
+}
+
+func TestBuildErrors(t *testing.T) {
+	dir := idltest.TempDir(t, map[string]string{"a.thrift": ""})
+	defer os.RemoveAll(dir)
+
+	module, err := compile.Compile(filepath.Join(dir, "a.thrift"))
+	require.NoError(t, err)
+
+	_, err = Build(module, &Options{ThriftRoot: "idl"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `ThriftRoot must be an absolute path: "idl" is not absolute`)
+	}
+
+	_, err = Build(module, &Options{ThriftRoot: filepath.Join(dir, "foo")})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "is not contained in the")
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package hashing holds the helpers used by the Hash and Compare methods
+// generated for structs annotated with go.hashable.
+//
+// Hashes are computed with 64-bit FNV-1a over a fixed encoding of the set
+// fields of a struct, so they do not change between processes, platforms,
+// or releases of ThriftRW.
+//
+// The contents of this package are meant to be used by generated code only.
+package hashing
+
+import (
+	"bytes"
+	"math"
+	"strings"
+)
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// Hasher computes the hash of a struct from its fields. Hashers must be
+// built with New.
+type Hasher struct {
+	sum uint64
+}
+
+// New builds a new Hasher.
+func New() Hasher {
+	return Hasher{sum: offset64}
+}
+
+// Sum64 returns the hash of everything written to this Hasher so far.
+func (h *Hasher) Sum64() uint64 {
+	return h.sum
+}
+
+func (h *Hasher) writeByte(b byte) {
+	h.sum ^= uint64(b)
+	h.sum *= prime64
+}
+
+func (h *Hasher) writeUint64(x uint64) {
+	for i := uint(0); i < 64; i += 8 {
+		h.writeByte(byte(x >> i))
+	}
+}
+
+// Field records that the field with the given ID is set. It must be called
+// before the value of each set field is written.
+func (h *Hasher) Field(id int16) {
+	h.writeByte(byte(id))
+	h.writeByte(byte(uint16(id) >> 8))
+}
+
+// Bool writes a bool value.
+func (h *Hasher) Bool(b bool) {
+	if b {
+		h.writeByte(1)
+	} else {
+		h.writeByte(0)
+	}
+}
+
+// Int64 writes an integer value. Integers of all sizes and enums are
+// written with this.
+func (h *Hasher) Int64(x int64) {
+	h.writeUint64(uint64(x))
+}
+
+// Uint64 writes an unsigned integer value, such as the hash of a nested
+// struct.
+func (h *Hasher) Uint64(x uint64) {
+	h.writeUint64(x)
+}
+
+// Double writes a floating point value. Positive and negative zero, which
+// are equal, have the same hash.
+func (h *Hasher) Double(f float64) {
+	if f == 0 {
+		f = 0 // drop the sign of negative zero
+	}
+	h.writeUint64(math.Float64bits(f))
+}
+
+// String writes a string value.
+func (h *Hasher) String(s string) {
+	h.writeUint64(uint64(len(s)))
+	for i := 0; i < len(s); i++ {
+		h.writeByte(s[i])
+	}
+}
+
+// Binary writes a binary value. Nil and empty slices have the same hash.
+func (h *Hasher) Binary(b []byte) {
+	h.writeUint64(uint64(len(b)))
+	for _, c := range b {
+		h.writeByte(c)
+	}
+}
+
+// CompareBool compares two bools, ordering false before true. It returns 0
+// if a == b, -1 if a is ordered before b, and +1 otherwise.
+//
+// This is also used to order unset optional fields before set ones.
+func CompareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// CompareInt64 compares two integers. It returns 0 if a == b, -1 if a < b,
+// and +1 otherwise.
+func CompareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CompareDouble compares two floating point values. NaN is ordered before
+// all other values. It returns 0 if a == b, -1 if a < b, and +1 otherwise.
+func CompareDouble(a, b float64) int {
+	aNaN, bNaN := math.IsNaN(a), math.IsNaN(b)
+	switch {
+	case aNaN || bNaN:
+		return CompareBool(!aNaN, !bNaN)
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CompareString compares two strings lexicographically. It returns 0 if
+// a == b, -1 if a < b, and +1 otherwise.
+func CompareString(a, b string) int {
+	return strings.Compare(a, b)
+}
+
+// CompareBinary compares two binary values lexicographically. Nil and empty
+// slices are equal. It returns 0 if a == b, -1 if a < b, and +1 otherwise.
+func CompareBinary(a, b []byte) int {
+	return bytes.Compare(a, b)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package hashing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasher(t *testing.T) {
+	sum := func(f func(*Hasher)) uint64 {
+		h := New()
+		f(&h)
+		return h.Sum64()
+	}
+
+	// FNV-1a of no input is the offset basis.
+	assert.Equal(t, uint64(14695981039346656037), sum(func(*Hasher) {}))
+
+	tests := []struct {
+		desc       string
+		give, want func(*Hasher)
+		same       bool
+	}{
+		{
+			desc: "negative zero",
+			give: func(h *Hasher) { h.Double(math.Copysign(0, -1)) },
+			want: func(h *Hasher) { h.Double(0) },
+			same: true,
+		},
+		{
+			desc: "nil binary",
+			give: func(h *Hasher) { h.Binary(nil) },
+			want: func(h *Hasher) { h.Binary([]byte{}) },
+			same: true,
+		},
+		{
+			desc: "string and binary",
+			give: func(h *Hasher) { h.String("foo") },
+			want: func(h *Hasher) { h.Binary([]byte("foo")) },
+			same: true,
+		},
+		{
+			desc: "string boundaries",
+			give: func(h *Hasher) { h.String("ab"); h.String("c") },
+			want: func(h *Hasher) { h.String("a"); h.String("bc") },
+		},
+		{
+			desc: "field IDs",
+			give: func(h *Hasher) { h.Field(1); h.Int64(42) },
+			want: func(h *Hasher) { h.Field(2); h.Int64(42) },
+		},
+		{
+			desc: "bools",
+			give: func(h *Hasher) { h.Bool(true) },
+			want: func(h *Hasher) { h.Bool(false) },
+		},
+		{
+			desc: "ints",
+			give: func(h *Hasher) { h.Int64(-1) },
+			want: func(h *Hasher) { h.Uint64(math.MaxUint64) },
+			same: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if tt.same {
+				assert.Equal(t, sum(tt.want), sum(tt.give))
+			} else {
+				assert.NotEqual(t, sum(tt.want), sum(tt.give))
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, 0, CompareBool(true, true))
+	assert.Equal(t, -1, CompareBool(false, true))
+	assert.Equal(t, 1, CompareBool(true, false))
+
+	assert.Equal(t, 0, CompareInt64(1, 1))
+	assert.Equal(t, -1, CompareInt64(-5, 1))
+	assert.Equal(t, 1, CompareInt64(5, 1))
+
+	nan := math.NaN()
+	assert.Equal(t, 0, CompareDouble(0, math.Copysign(0, -1)))
+	assert.Equal(t, -1, CompareDouble(1.5, 2))
+	assert.Equal(t, 1, CompareDouble(2, 1.5))
+	assert.Equal(t, 0, CompareDouble(nan, nan))
+	assert.Equal(t, -1, CompareDouble(nan, math.Inf(-1)))
+	assert.Equal(t, 1, CompareDouble(math.Inf(-1), nan))
+
+	assert.Equal(t, -1, CompareString("a", "ab"))
+	assert.Equal(t, 1, CompareString("b", "ab"))
+
+	assert.Equal(t, 0, CompareBinary(nil, []byte{}))
+	assert.Equal(t, -1, CompareBinary([]byte{1}, []byte{2}))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package internal
+
+import (
+	"bytes"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// Comments returns the comments in the given Thrift document, including
+// docstrings, in the order in which they appear.
+//
+// The lexer discards comments so this scans the document separately. String
+// literals are skipped so that comment markers inside them are not mistaken
+// for comments.
+func Comments(s []byte) []*ast.Comment {
+	var comments []*ast.Comment
+	line, start := 1, 0 // start is the offset of the current line
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '\n':
+			line++
+			i++
+			start = i
+
+		case c == '"' || c == '\'':
+			i = skipLiteral(s, i)
+
+		case c == '`':
+			// Raw string literals may span multiple lines.
+			end := bytes.IndexByte(s[i+1:], '`')
+			if end < 0 {
+				end = len(s)
+			} else {
+				end += i + 2
+			}
+			line, start = advance(s, i, end, line, start)
+			i = end
+
+		case c == '#' || bytes.HasPrefix(s[i:], []byte("//")):
+			end := bytes.IndexByte(s[i:], '\n')
+			if end < 0 {
+				end = len(s)
+			} else {
+				end += i
+			}
+			comments = append(comments, &ast.Comment{
+				Text:   string(s[i:end]),
+				Line:   line,
+				Column: i - start + 1,
+			})
+			i = end
+
+		case bytes.HasPrefix(s[i:], []byte("/*")):
+			// Skip past the "/*" so that "/*/" does not end the comment.
+			end := bytes.Index(s[i+2:], []byte("*/"))
+			if end < 0 {
+				end = len(s)
+			} else {
+				end += i + 4
+			}
+			comments = append(comments, &ast.Comment{
+				Text:   string(s[i:end]),
+				Line:   line,
+				Column: i - start + 1,
+			})
+			line, start = advance(s, i, end, line, start)
+			i = end
+
+		default:
+			i++
+		}
+	}
+	return comments
+}
+
+// advance returns the line number and the offset of the start of the line
+// at s[end], given those at s[i].
+func advance(s []byte, i, end, line, start int) (int, int) {
+	for ; i < end; i++ {
+		if s[i] == '\n' {
+			line++
+			start = i + 1
+		}
+	}
+	return line, start
+}
+
+// skipLiteral returns the index just past the end of the string literal
+// starting at s[i].
+func skipLiteral(s []byte, i int) int {
+	quote := s[i]
+	for i++; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		case '\n':
+			// Literals may not span multiple lines. Leave the newline for
+			// the caller to count.
+			return i
+		}
+	}
+	return i
+}
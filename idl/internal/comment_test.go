@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package internal
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComments(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want []*ast.Comment
+	}{
+		{desc: "empty"},
+		{
+			desc: "line comments",
+			give: "# foo\n" +
+				"struct Foo {} // bar\n" +
+				"\n" +
+				"//baz",
+			want: []*ast.Comment{
+				{Text: "# foo", Line: 1, Column: 1},
+				{Text: "// bar", Line: 2, Column: 15},
+				{Text: "//baz", Line: 4, Column: 1},
+			},
+		},
+		{
+			desc: "block comments",
+			give: "/* foo\n" +
+				" * bar */ struct Foo {\n" +
+				"  /*/ baz */\n" +
+				"  /**\n" +
+				"   * qux\n" +
+				"   */\n" +
+				"}\n" +
+				"/**/ /***/",
+			want: []*ast.Comment{
+				{Text: "/* foo\n * bar */", Line: 1, Column: 1},
+				{Text: "/*/ baz */", Line: 3, Column: 3},
+				{Text: "/**\n   * qux\n   */", Line: 4, Column: 3},
+				{Text: "/**/", Line: 8, Column: 1},
+				{Text: "/***/", Line: 8, Column: 6},
+			},
+		},
+		{
+			desc: "literals",
+			give: `const string a = "// not a comment" // comment` + "\n" +
+				`const string b = '#\'/*' # comment` + "\n" +
+				`const string c = "\"" /* comment */`,
+			want: []*ast.Comment{
+				{Text: "// comment", Line: 1, Column: 37},
+				{Text: "# comment", Line: 2, Column: 26},
+				{Text: "/* comment */", Line: 3, Column: 23},
+			},
+		},
+		{
+			desc: "raw literals",
+			give: "const string a = `# not\n" +
+				"// a comment` // comment\n" +
+				"const string b = `/*` # comment",
+			want: []*ast.Comment{
+				{Text: "// comment", Line: 2, Column: 15},
+				{Text: "# comment", Line: 3, Column: 23},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, Comments([]byte(tt.give)))
+		})
+	}
+}
@@ -23,29 +23,51 @@ package internal
 import (
 	"bytes"
 	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/internal/source"
 )
 
-// parseError is an error type to keep track of any parse errors and the
+// ParseError is an error type to keep track of any parse errors and the
 // positions they occur at.
-type parseError struct {
-	Errors map[int][]string
+type ParseError struct {
+	Errors []Message
+
+	src []byte
+}
+
+// Message is a single message of a ParseError.
+type Message struct {
+	Pos ast.Position
+	Msg string
+}
+
+func newParseError(src []byte) ParseError {
+	return ParseError{src: src}
 }
 
-func newParseError() parseError {
-	return parseError{Errors: make(map[int][]string)}
+func (pe *ParseError) add(pos ast.Position, msg string) {
+	pe.Errors = append(pe.Errors, Message{Pos: pos, Msg: msg})
 }
 
-func (pe parseError) add(line int, msg string) {
-	pe.Errors[line] = append(pe.Errors[line], msg)
+// Sorted returns the messages of this error ordered by their positions.
+func (pe ParseError) Sorted() []Message {
+	errs := make([]Message, len(pe.Errors))
+	copy(errs, pe.Errors)
+	sort.SliceStable(errs, func(i, j int) bool {
+		l, r := errs[i].Pos, errs[j].Pos
+		return l.Line < r.Line || (l.Line == r.Line && l.Column < r.Column)
+	})
+	return errs
 }
 
-func (pe parseError) Error() string {
+func (pe ParseError) Error() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("parse error\n")
-	for line, msgs := range pe.Errors {
-		for _, msg := range msgs {
-			buffer.WriteString(fmt.Sprintf("  line %d: %s\n", line, msg))
-		}
+	for _, e := range pe.Sorted() {
+		buffer.WriteString(fmt.Sprintf("  line %v: %s\n", e.Pos, e.Msg))
+		buffer.WriteString(source.Snippet(pe.src, e.Pos, "    "))
 	}
 	return buffer.String()
 }
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package internal
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// ParseInteger parses the text of an integer literal. Literals may have a
+// sign, and hexadecimal, octal, and binary literals are prefixed with 0x, 0o,
+// and 0b respectively.
+//
+// If the value does not fit into an int64, it is returned as a big.Int
+// instead.
+//
+// 	ParseInteger("0x2a")               == 42, nil, nil
+// 	ParseInteger("-0x2a")              == -42, nil, nil
+// 	ParseInteger("0xffffffffffffffff") == 0, 18446744073709551615, nil
+func ParseInteger(s string) (int64, *big.Int, error) {
+	var sign string
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		sign, s = s[:1], s[1:]
+	}
+
+	base, digits := 10, s
+	if len(s) > 2 && s[0] == '0' {
+		switch s[1] {
+		case 'x':
+			base = 16
+		case 'o':
+			base = 8
+		case 'b':
+			base = 2
+		}
+		if base != 10 {
+			digits = s[2:]
+		}
+	}
+	digits = sign + digits
+
+	i, err := strconv.ParseInt(digits, base, 64)
+	if err == nil {
+		return i, nil, nil
+	}
+	if numErr, ok := err.(*strconv.NumError); !ok || numErr.Err != strconv.ErrRange {
+		return 0, nil, err
+	}
+
+	bigint, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid integer %q", sign+s)
+	}
+	return 0, bigint, nil
+}
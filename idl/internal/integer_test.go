@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package internal
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInteger(t *testing.T) {
+	bigint := func(s string) *big.Int {
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			t.Fatalf("invalid integer %q", s)
+		}
+		return i
+	}
+
+	tests := []struct {
+		give    string
+		want    int64
+		wantBig *big.Int
+		wantErr string
+	}{
+		{give: "0", want: 0},
+		{give: "42", want: 42},
+		{give: "+42", want: 42},
+		{give: "-42", want: -42},
+		{give: "0042", want: 42},
+		{give: "0x2a", want: 42},
+		{give: "0x7fffffffffffffff", want: 9223372036854775807},
+		{give: "0o52", want: 42},
+		{give: "0b101010", want: 42},
+		{give: "-0x1F", want: -31},
+		{give: "+0x1F", want: 31},
+		{give: "-0o17", want: -15},
+		{give: "-0x8000000000000000", want: -9223372036854775808},
+		{give: "-9223372036854775808", want: -9223372036854775808},
+		{
+			give:    "0xffffffffffffffff",
+			wantBig: bigint("18446744073709551615"),
+		},
+		{
+			give:    "9223372036854775808",
+			wantBig: bigint("9223372036854775808"),
+		},
+		{
+			give:    "-9223372036854775809",
+			wantBig: bigint("-9223372036854775809"),
+		},
+		{
+			give:    "0b1" + strings.Repeat("0", 100),
+			wantBig: new(big.Int).Lsh(big.NewInt(1), 100),
+		},
+		{
+			give:    "-0xffffffffffffffff",
+			wantBig: bigint("-18446744073709551615"),
+		},
+		{give: "0x", wantErr: "invalid syntax"},
+		{give: "-0x", wantErr: "invalid syntax"},
+		{give: "0b102", wantErr: "invalid syntax"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			got, gotBig, err := ParseInteger(tt.give)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantBig, gotBig)
+			}
+		})
+	}
+}
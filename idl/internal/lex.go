@@ -26,8 +26,10 @@
 package internal
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"go.uber.org/thriftrw/ast"
 )
@@ -40,16 +42,21 @@ const thrift_en_main int = 19
 
 
 type lexer struct {
-	line    int
-	program *ast.Program
+	line int
+	// Offset in data at which the current line starts.
+	lineStart int
+	program   *ast.Program
 
 	docstringStart      int
 	lastDocstring       string
 	linesSinceDocstring int
 
-	err         parseError
+	err         ParseError
 	parseFailed bool
 
+	// Whether Thrift keywords may be used as field names.
+	keywordFieldNames bool
+
 	// Ragel:
 	p, pe, cs, ts, te, act int
 	data                   []byte
@@ -58,7 +65,7 @@ type lexer struct {
 func newLexer(data []byte) *lexer {
 	lex := &lexer{
 		line:        1,
-		err:         newParseError(),
+		err:         newParseError(data),
 		parseFailed: false,
 		data:        data,
 		p:           0,
@@ -898,6 +905,22 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto st_case_403
 		case 404:
 			goto st_case_404
+		case 405:
+			goto st_case_405
+		case 406:
+			goto st_case_406
+		case 407:
+			goto st_case_407
+		case 408:
+			goto st_case_408
+		case 409:
+			goto st_case_409
+		case 410:
+			goto st_case_410
+		case 411:
+			goto st_case_411
+		case 412:
+			goto st_case_412
 		}
 		goto st_out
 	tr2:
@@ -964,14 +987,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 		(lex.p) = (lex.te) - 1
 		{
 			str := string(lex.data[lex.ts:lex.te])
-			base := 10
-			if len(str) > 2 && str[0:2] == "0x" {
-				// Hex constant
-				base = 16
-			}
-
-			if i64, err := strconv.ParseInt(str, base, 64); err != nil {
+			if i64, bigint, err := ParseInteger(str); err != nil {
 				lex.Error(err.Error())
+			} else if bigint != nil {
+				// Integers that don't fit into an int64 are kept as-is
+				// so that nothing is lost.
+				out.bigint = bigint
+				tok = BIGINTCONSTANT
 			} else {
 				out.i64 = i64
 				tok = INTCONSTANT
@@ -1279,7 +1301,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 			{
 				(lex.p) = (lex.te) - 1
 
-				lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+				if reservedKeyword == "as" {
+					// as is reserved in other languages but the IDL uses it
+					// for the include-as syntax.
+					tok = AS
+				} else {
+					lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+				}
 				{
 					(lex.p)++
 					lex.cs = 19
@@ -1290,8 +1318,28 @@ func (lex *lexer) Lex(out *yySymType) int {
 			{
 				(lex.p) = (lex.te) - 1
 
-				out.str = string(lex.data[lex.ts:lex.te])
-				tok = IDENTIFIER
+				str := string(lex.data[lex.ts:lex.te])
+				switch str {
+				case "senum":
+					// senum and slist are deprecated but accepted so that
+					// older documents still parse.
+					tok = SENUM
+				case "slist":
+					tok = SLIST
+				case "xsd_all":
+					// The XSD attributes of early versions of Apache Thrift
+					// are accepted and recorded as annotations.
+					tok = XSD_ALL
+				case "xsd_optional":
+					tok = XSD_OPTIONAL
+				case "xsd_nillable":
+					tok = XSD_NILLABLE
+				case "xsd_attrs":
+					tok = XSD_ATTRS
+				default:
+					out.str = str
+					tok = IDENTIFIER
+				}
 				{
 					(lex.p)++
 					lex.cs = 19
@@ -1308,6 +1356,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr30:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		lex.te = (lex.p) + 1
@@ -1334,14 +1383,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 		(lex.p)--
 		{
 			str := string(lex.data[lex.ts:lex.te])
-			base := 10
-			if len(str) > 2 && str[0:2] == "0x" {
-				// Hex constant
-				base = 16
-			}
-
-			if i64, err := strconv.ParseInt(str, base, 64); err != nil {
+			if i64, bigint, err := ParseInteger(str); err != nil {
 				lex.Error(err.Error())
+			} else if bigint != nil {
+				// Integers that don't fit into an int64 are kept as-is
+				// so that nothing is lost.
+				out.bigint = bigint
+				tok = BIGINTCONSTANT
 			} else {
 				out.i64 = i64
 				tok = INTCONSTANT
@@ -1380,8 +1428,28 @@ func (lex *lexer) Lex(out *yySymType) int {
 		lex.te = (lex.p)
 		(lex.p)--
 		{
-			out.str = string(lex.data[lex.ts:lex.te])
-			tok = IDENTIFIER
+			str := string(lex.data[lex.ts:lex.te])
+			switch str {
+			case "senum":
+				// senum and slist are deprecated but accepted so that
+				// older documents still parse.
+				tok = SENUM
+			case "slist":
+				tok = SLIST
+			case "xsd_all":
+				// The XSD attributes of early versions of Apache Thrift
+				// are accepted and recorded as annotations.
+				tok = XSD_ALL
+			case "xsd_optional":
+				tok = XSD_OPTIONAL
+			case "xsd_nillable":
+				tok = XSD_NILLABLE
+			case "xsd_attrs":
+				tok = XSD_ATTRS
+			default:
+				out.str = str
+				tok = IDENTIFIER
+			}
 			{
 				(lex.p)++
 				lex.cs = 19
@@ -1393,7 +1461,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 		lex.te = (lex.p)
 		(lex.p)--
 		{
-			lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+			if reservedKeyword == "as" {
+				// as is reserved in other languages but the IDL uses it
+				// for the include-as syntax.
+				tok = AS
+			} else {
+				lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+			}
 			{
 				(lex.p)++
 				lex.cs = 19
@@ -1771,6 +1845,8 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto st1
 		case 35:
 			goto st20
+		case 38:
+			goto tr31
 		case 39:
 			goto st3
 		case 43:
@@ -1831,6 +1907,8 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto tr56
 		case 120:
 			goto tr57
+		case 96:
+			goto st409
 		case 121:
 			goto tr58
 		case 123:
@@ -1919,7 +1997,10 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof5
 		}
 	st_case_5:
-		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+		if lex.data[(lex.p)] == 48 {
+			goto tr34
+		}
+		if 49 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
 			goto st21
 		}
 		goto st0
@@ -1928,13 +2009,55 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof21
 		}
 	st_case_21:
-		if lex.data[(lex.p)] == 46 {
+		switch lex.data[(lex.p)] {
+		case 46:
 			goto tr61
+		case 69:
+			goto tr482
+		case 101:
+			goto tr482
 		}
 		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
 			goto st21
 		}
 		goto tr60
+	tr482:
+		lex.te = (lex.p)
+
+		goto st410
+	st410:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof410
+		}
+	st_case_410:
+		switch lex.data[(lex.p)] {
+		case 43:
+			goto st411
+		case 45:
+			goto st411
+		}
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto st412
+		}
+		goto tr25
+	st411:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof411
+		}
+	st_case_411:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto st412
+		}
+		goto tr25
+	st412:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof412
+		}
+	st_case_412:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto st412
+		}
+		goto tr62
 	tr61:
 		lex.te = (lex.p) + 1
 
@@ -2014,6 +2137,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr13:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st10
@@ -2063,6 +2187,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr18:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st13
@@ -2138,6 +2263,14 @@ func (lex *lexer) Lex(out *yySymType) int {
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto tr61
+		case 69:
+			goto tr482
+		case 101:
+			goto tr482
+		case 98:
+			goto st405
+		case 111:
+			goto st406
 		case 120:
 			goto st17
 		}
@@ -2181,6 +2314,74 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto st26
 		}
 		goto tr60
+	st405:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof405
+		}
+	st_case_405:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 49 {
+			goto st407
+		}
+		goto tr25
+	st407:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof407
+		}
+	st_case_407:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 49 {
+			goto st407
+		}
+		goto tr60
+	st406:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof406
+		}
+	st_case_406:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 55 {
+			goto st408
+		}
+		goto tr25
+	st408:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof408
+		}
+	st_case_408:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 55 {
+			goto st408
+		}
+		goto tr60
+	st409:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof409
+		}
+	st_case_409:
+		if lex.data[(lex.p)] == 96 {
+			goto tr481
+		}
+		goto st409
+	tr481:
+		lex.te = (lex.p) + 1
+		{
+			str := string(lex.data[lex.ts+1 : lex.te-1])
+
+			// Newlines inside the literal aren't matched by the newline
+			// machine so they must be counted here.
+			lines := strings.Count(str, "\n")
+			lex.line += lines
+			lex.linesSinceDocstring += lines
+			if lines > 0 {
+				lex.lineStart = lex.ts + 2 + strings.LastIndex(str, "\n")
+			}
+
+			out.str = str
+			tok = LITERAL
+			{
+				(lex.p)++
+				lex.cs = 19
+				goto _out
+			}
+		}
+		goto st19
 	tr28:
 		lex.te = (lex.p) + 1
 
@@ -2396,6 +2597,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr74:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st33
@@ -4284,6 +4486,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr135:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st93
@@ -4405,6 +4608,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr140:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st97
@@ -4619,6 +4823,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr147:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st104
@@ -5029,6 +5234,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr162:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st117
@@ -5650,6 +5856,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr182:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st136
@@ -6562,6 +6769,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr211:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st164
@@ -6851,6 +7059,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr222:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st173
@@ -7034,6 +7243,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr229:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st179
@@ -7227,6 +7437,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr240:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st185
@@ -8015,6 +8226,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr266:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st210
@@ -8105,6 +8317,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr270:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st213
@@ -8195,6 +8408,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr274:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st216
@@ -8254,6 +8468,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr277:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st218
@@ -8702,6 +8917,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr294:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st232
@@ -9352,6 +9568,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr313:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st253
@@ -9475,6 +9692,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr319:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st257
@@ -9852,6 +10070,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr333:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st269
@@ -10165,6 +10384,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr343:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st279
@@ -10410,6 +10630,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr352:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st287
@@ -11388,6 +11609,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr385:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st318
@@ -11742,6 +11964,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr400:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st329
@@ -11801,6 +12024,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr403:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st331
@@ -12050,6 +12274,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr412:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st339
@@ -12171,6 +12396,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr417:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st343
@@ -12681,6 +12907,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr434:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st359
@@ -12961,6 +13188,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr444:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st368
@@ -13175,6 +13403,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr452:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st375
@@ -13399,6 +13628,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr463:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st382
@@ -13805,6 +14035,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 	tr475:
 
 		lex.line++
+		lex.lineStart = lex.p + 1
 		lex.linesSinceDocstring++
 
 		goto st395
@@ -14183,6 +14414,30 @@ func (lex *lexer) Lex(out *yySymType) int {
 	_test_eof26:
 		lex.cs = 26
 		goto _test_eof
+	_test_eof405:
+		lex.cs = 405
+		goto _test_eof
+	_test_eof406:
+		lex.cs = 406
+		goto _test_eof
+	_test_eof407:
+		lex.cs = 407
+		goto _test_eof
+	_test_eof408:
+		lex.cs = 408
+		goto _test_eof
+	_test_eof409:
+		lex.cs = 409
+		goto _test_eof
+	_test_eof410:
+		lex.cs = 410
+		goto _test_eof
+	_test_eof411:
+		lex.cs = 411
+		goto _test_eof
+	_test_eof412:
+		lex.cs = 412
+		goto _test_eof
 	_test_eof27:
 		lex.cs = 27
 		goto _test_eof
@@ -16108,6 +16363,20 @@ func (lex *lexer) Lex(out *yySymType) int {
 				goto tr66
 			case 404:
 				goto tr66
+			case 405:
+				goto tr25
+			case 406:
+				goto tr25
+			case 407:
+				goto tr60
+			case 408:
+				goto tr60
+			case 410:
+				goto tr25
+			case 411:
+				goto tr25
+			case 412:
+				goto tr62
 			}
 		}
 
@@ -16117,6 +16386,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 	}
 
 
+	if tok == 0 {
+		// Nothing was matched so errors reported from here on should point
+		// at the current position rather than at the last token.
+		lex.ts = lex.p
+	}
+	out.pos = lex.pos()
+
 	if lex.cs == thrift_error {
 		lex.Error(fmt.Sprintf("unknown token at index %d", lex.p))
 	}
@@ -16124,8 +16400,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 }
 
 func (lex *lexer) Error(e string) {
+	lex.errorAt(lex.pos(), e)
+}
+
+// errorAt reports an error at the given position.
+func (lex *lexer) errorAt(pos ast.Position, e string) {
 	lex.parseFailed = true
-	lex.err.add(lex.line, e)
+	lex.err.add(pos, e)
 }
 
 func (lex *lexer) LastDocstring() string {
@@ -16140,3 +16421,15 @@ func (lex *lexer) LastDocstring() string {
 	lex.linesSinceDocstring = 0
 	return s
 }
+
+// pos returns the position at which the most recently matched token starts.
+func (lex *lexer) pos() ast.Position {
+	line, start := lex.line, lex.lineStart
+	for lex.ts < start {
+		// Keywords consume trailing whitespace so the token may start on
+		// an earlier line than the one the lexer is on.
+		start = bytes.LastIndexByte(lex.data[:start-1], '\n') + 1
+		line--
+	}
+	return ast.Position{Line: line, Column: lex.ts - start + 1}
+}
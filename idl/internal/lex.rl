@@ -3,6 +3,7 @@
 package internal
 
 import (
+    "bytes"
     "fmt"
     "io"
     "strconv"
@@ -23,15 +24,20 @@ variable pe lex.pe;
 
 type lexer struct {
     line int
+    // Offset in data at which the current line starts.
+    lineStart int
     program *ast.Program
 
     docstringStart int
     lastDocstring string
     linesSinceDocstring int
 
-    err parseError
+    err ParseError
     parseFailed bool
 
+    // Whether Thrift keywords may be used as field names.
+    keywordFieldNames bool
+
     // Ragel:
     p, pe, cs, ts, te, act int
     data []byte
@@ -41,7 +47,7 @@ type lexer struct {
 func newLexer(data []byte) *lexer {
     lex := &lexer{
         line: 1,
-        err: newParseError(),
+        err: newParseError(data),
         parseFailed: false,
         data: data,
         p: 0,
@@ -74,6 +80,7 @@ func (lex *lexer) Lex(out *yySymType) int {
         # number tracking.
         newline = '\n' >{
             lex.line++
+            lex.lineStart = lex.p + 1
             lex.linesSinceDocstring++
         };
 
@@ -84,7 +91,7 @@ func (lex *lexer) Lex(out *yySymType) int {
         multiline_comment = '/*' (newline | any)* :>> '*/';
 
         # Symbols are sent to the parser as-is.
-        symbol = [\*=<>\(\)\{\},;:\[\]];
+        symbol = [\*=<>\(\)\{\},;:\[\]&];
 
         # String literals.
         literal
@@ -92,12 +99,21 @@ func (lex *lexer) Lex(out *yySymType) int {
             | ("'" ([^'\n\\] | '\\' any)* "'")
             ;
 
+        # Raw string literals may span multiple lines and don't support
+        # escape sequences.
+        raw_literal = '`' [^`]* '`';
+
         identifier = [a-zA-Z_] ([a-zA-Z0-9_] | '.' [a-zA-Z0-9_])*;
 
-        integer = ('+' | '-')? digit+;
-        hex_integer = '0x' xdigit+;
+        sign = ('+' | '-')?;
+
+        integer = sign digit+;
+        hex_integer = sign '0x' xdigit+;
+        octal_integer = sign '0o' [0-7]+;
+        binary_integer = sign '0b' [01]+;
 
-        double = integer '.' digit* ([Ee] integer)?;
+        exponent = [Ee] integer;
+        double = integer (('.' digit* exponent?) | exponent);
 
         # The following keywords are reserved in different languages and are
         # disallowed as identifiers in the IDL.
@@ -269,16 +285,15 @@ func (lex *lexer) Lex(out *yySymType) int {
             line_comment;
             multiline_comment;
 
-            (integer | hex_integer) => {
+            (integer | hex_integer | octal_integer | binary_integer) => {
                 str := string(lex.data[lex.ts:lex.te])
-                base := 10
-                if len(str) > 2 && str[0:2] == "0x" {
-                    // Hex constant
-                    base = 16
-                }
-
-                if i64, err := strconv.ParseInt(str, base, 64); err != nil {
+                if i64, bigint, err := ParseInteger(str); err != nil {
                     lex.Error(err.Error())
+                } else if bigint != nil {
+                    // Integers that don't fit into an int64 are kept as-is
+                    // so that nothing is lost.
+                    out.bigint = bigint
+                    tok = BIGINTCONSTANT
                 } else {
                     out.i64 = i64
                     tok = INTCONSTANT
@@ -318,14 +333,57 @@ func (lex *lexer) Lex(out *yySymType) int {
                 fbreak;
             };
 
+            raw_literal => {
+                str := string(lex.data[lex.ts+1:lex.te-1])
+
+                // Newlines inside the literal aren't matched by the newline
+                // machine so they must be counted here.
+                lines := strings.Count(str, "\n")
+                lex.line += lines
+                lex.linesSinceDocstring += lines
+                if lines > 0 {
+                    lex.lineStart = lex.ts + 2 + strings.LastIndex(str, "\n")
+                }
+
+                out.str = str
+                tok = LITERAL
+                fbreak;
+            };
+
             reservedKeyword __ => {
-                lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+                if reservedKeyword == "as" {
+                    // as is reserved in other languages but the IDL uses it
+                    // for the include-as syntax.
+                    tok = AS
+                } else {
+                    lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+                }
                 fbreak;
             };
 
             identifier => {
-                out.str = string(lex.data[lex.ts:lex.te])
-                tok = IDENTIFIER
+                str := string(lex.data[lex.ts:lex.te])
+                switch str {
+                case "senum":
+                    // senum and slist are deprecated but accepted so that
+                    // older documents still parse.
+                    tok = SENUM
+                case "slist":
+                    tok = SLIST
+                case "xsd_all":
+                    // The XSD attributes of early versions of Apache Thrift
+                    // are accepted and recorded as annotations.
+                    tok = XSD_ALL
+                case "xsd_optional":
+                    tok = XSD_OPTIONAL
+                case "xsd_nillable":
+                    tok = XSD_NILLABLE
+                case "xsd_attrs":
+                    tok = XSD_ATTRS
+                default:
+                    out.str = str
+                    tok = IDENTIFIER
+                }
                 fbreak;
             };
         *|;
@@ -334,6 +392,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 
     }%%
 
+    if tok == 0 {
+        // Nothing was matched so errors reported from here on should point
+        // at the current position rather than at the last token.
+        lex.ts = lex.p
+    }
+    out.pos = lex.pos()
+
     if lex.cs == thrift_error {
         lex.Error(fmt.Sprintf("unknown token at index %d", lex.p))
     }
@@ -341,8 +406,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 }
 
 func (lex *lexer) Error(e string) {
+    lex.errorAt(lex.pos(), e)
+}
+
+// errorAt reports an error at the given position.
+func (lex *lexer) errorAt(pos ast.Position, e string) {
     lex.parseFailed = true
-    lex.err.add(lex.line, e)
+    lex.err.add(pos, e)
 }
 
 func (lex *lexer) LastDocstring() string {
@@ -357,3 +427,15 @@ func (lex *lexer) LastDocstring() string {
     lex.linesSinceDocstring = 0
     return s
 }
+
+// pos returns the position at which the most recently matched token starts.
+func (lex *lexer) pos() ast.Position {
+    line, start := lex.line, lex.lineStart
+    for lex.ts < start {
+        // Keywords consume trailing whitespace so the token may start on
+        // an earlier line than the one the lexer is on.
+        start = bytes.LastIndexByte(lex.data[:start-1], '\n') + 1
+        line--
+    }
+    return ast.Position{Line: line, Column: lex.ts - start + 1}
+}
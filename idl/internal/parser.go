@@ -26,9 +26,17 @@ func init() {
 	yyErrorVerbose = true
 }
 
+// Options configures the parser.
+type Options struct {
+	// KeywordFieldNames allows Thrift keywords to be used as the names of
+	// fields.
+	KeywordFieldNames bool
+}
+
 // Parse parses the given Thrift document.
-func Parse(s []byte) (*ast.Program, error) {
+func Parse(s []byte, opts Options) (*ast.Program, error) {
 	lex := newLexer(s)
+	lex.keywordFieldNames = opts.KeywordFieldNames
 	e := yyParse(lex)
 	if e == 0 && !lex.parseFailed {
 		return lex.program, nil
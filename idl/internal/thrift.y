@@ -1,13 +1,19 @@
 %{
 package internal
 
-import "go.uber.org/thriftrw/ast"
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+)
 %}
 
 %union {
-    // Used to record line numbers when the line number at the start point is
-    // required.
-    line int
+    // Used to record positions when the position at the start point is
+    // required. The lexer records the position of every token here.
+    pos ast.Position
 
     docstring string
 
@@ -19,6 +25,7 @@ import "go.uber.org/thriftrw/ast"
     bul bool
     str string
     i64 int64
+    bigint *big.Int
     dub float64
 
     fieldType ast.Type
@@ -46,25 +53,35 @@ import "go.uber.org/thriftrw/ast"
     constantValue ast.ConstantValue
     constantValues []ast.ConstantValue
     constantMapItems []ast.ConstantMapItem
+
+    senumValues []string
 }
 
 %token <str> IDENTIFIER
 %token <str> LITERAL
 %token <i64> INTCONSTANT
+%token <bigint> BIGINTCONSTANT
 %token <dub> DUBCONSTANT
 
 // Reserved keywords
-%token NAMESPACE INCLUDE
+%token NAMESPACE INCLUDE AS
 %token VOID BOOL BYTE I8 I16 I32 I64 DOUBLE STRING BINARY MAP LIST SET
 %token ONEWAY TYPEDEF STRUCT UNION EXCEPTION EXTENDS THROWS SERVICE ENUM CONST
 %token REQUIRED OPTIONAL TRUE FALSE
 
-%type <line> lineno
+// Deprecated keywords
+%token SENUM SLIST
+
+// XSD attributes of early versions of Apache Thrift
+%token XSD_ALL XSD_OPTIONAL XSD_NILLABLE XSD_ATTRS
+
+%type <pos> lineno
 %type <docstring> docstring
 %type <prog> program
 %type <fieldType> type
 %type <baseTypeID> base_type_name
 %type <fieldRequired> field_required
+%type <str> field_name keyword annotation_name
 %type <structType> struct_type
 
 %type <field> field
@@ -79,6 +96,8 @@ import "go.uber.org/thriftrw/ast"
 %type <enumItem> enum_item
 %type <enumItems> enum_items
 
+%type <senumValues> senum_values
+
 %type <definition> definition
 %type <definitions> definitions
 
@@ -87,6 +106,7 @@ import "go.uber.org/thriftrw/ast"
 %type <constantMapItems> const_map_items
 
 %type <typeAnnotations> type_annotation_list type_annotations
+%type <typeAnnotations> xsd_all xsd_field_attributes xsd_optional xsd_nillable xsd_attrs
 
 %%
 
@@ -113,7 +133,8 @@ header
         {
             $$ = &ast.Include{
                 Path: $3,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
             }
         }
     | lineno INCLUDE IDENTIFIER LITERAL
@@ -121,23 +142,37 @@ header
             $$ = &ast.Include{
                 Name: $3,
                 Path: $4,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
+            }
+        }
+    | lineno INCLUDE LITERAL AS IDENTIFIER
+        {
+            $$ = &ast.Include{
+                Name: $5,
+                Path: $3,
+                Line: $1.Line,
+                Column: $1.Column,
             }
         }
-    | lineno NAMESPACE '*' IDENTIFIER
+    | lineno NAMESPACE '*' IDENTIFIER type_annotations
         {
             $$ = &ast.Namespace{
                 Scope: "*",
                 Name: $4,
-                Line: $1,
+                Annotations: $5,
+                Line: $1.Line,
+                Column: $1.Column,
             }
         }
-    | lineno NAMESPACE IDENTIFIER IDENTIFIER
+    | lineno NAMESPACE IDENTIFIER IDENTIFIER type_annotations
         {
             $$ = &ast.Namespace{
                 Scope: $3,
                 Name: $4,
-                Line: $1,
+                Annotations: $5,
+                Line: $1.Line,
+                Column: $1.Column,
             }
         }
     ;
@@ -160,7 +195,8 @@ definition
                 Name: $5,
                 Type: $4,
                 Value: $7,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
                 Doc: ParseDocstring($2),
             }
         }
@@ -171,7 +207,8 @@ definition
                 Name: $5,
                 Type: $4,
                 Annotations: $6,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
                 Doc: ParseDocstring($2),
             }
         }
@@ -181,18 +218,33 @@ definition
                 Name: $4,
                 Items: $6,
                 Annotations: $8,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
+                EndLine: $<pos>7.Line,
                 Doc: ParseDocstring($2),
             }
         }
-    | lineno docstring struct_type IDENTIFIER '{' fields '}' type_annotations
+    | lineno docstring SENUM IDENTIFIER '{' senum_values '}' type_annotations
+        {
+            $$ = &ast.Senum{
+                Name: $4,
+                Values: $6,
+                Annotations: $8,
+                Line: $1.Line,
+                Column: $1.Column,
+                Doc: ParseDocstring($2),
+            }
+        }
+    | lineno docstring struct_type IDENTIFIER xsd_all '{' fields '}' type_annotations
         {
             $$ = &ast.Struct{
                 Name: $4,
                 Type: $3,
-                Fields: $6,
-                Annotations: $8,
-                Line: $1,
+                Fields: $7,
+                Annotations: append($5, $9...),
+                Line: $1.Line,
+                Column: $1.Column,
+                EndLine: $<pos>8.Line,
                 Doc: ParseDocstring($2),
             }
         }
@@ -203,16 +255,19 @@ definition
                 Name: $4,
                 Functions: $6,
                 Annotations: $8,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
+                EndLine: $<pos>7.Line,
                 Doc: ParseDocstring($2),
             }
         }
-    | lineno docstring SERVICE IDENTIFIER EXTENDS lineno IDENTIFIER '{' functions '}'
-      type_annotations
+    | lineno docstring SERVICE IDENTIFIER EXTENDS lineno IDENTIFIER '{' functions
+      '}' type_annotations
         {
             parent := &ast.ServiceReference{
                 Name: $7,
-                Line: $6,
+                Line: $6.Line,
+                Column: $6.Column,
             }
 
             $$ = &ast.Service{
@@ -220,7 +275,9 @@ definition
                 Functions: $9,
                 Parent: parent,
                 Annotations: $11,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
+                EndLine: $<pos>10.Line,
                 Doc: ParseDocstring($2),
             }
         }
@@ -243,7 +300,8 @@ enum_item
             $$ = &ast.EnumItem{
                 Name: $3,
                 Annotations: $4,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
                 Doc: ParseDocstring($2),
             }
         }
@@ -254,47 +312,135 @@ enum_item
                 Name: $3,
                 Value: &value,
                 Annotations: $6,
-                Line: $1,
+                Line: $1.Line,
+                Column: $1.Column,
                 Doc: ParseDocstring($2),
             }
         }
     ;
 
+senum_values
+    : /* nothing */ { $$ = nil }
+    | senum_values LITERAL optional_sep { $$ = append($1, $2) }
+    ;
+
 fields
     : /* nothing */ { $$ = nil }
-    | fields field optional_sep { $$ = append($1, $2) }
+    | fields field optional_sep
+        {
+            if $2.ImplicitID {
+                $2.ID = -1
+                for _, f := range $1 {
+                    if f.ImplicitID {
+                        $2.ID--
+                    }
+                }
+            }
+            $$ = append($1, $2)
+        }
     ;
 
 
 field
-    : lineno docstring INTCONSTANT ':' field_required type IDENTIFIER type_annotations
+    : lineno docstring field_id field_required type field_reference field_name
+      xsd_field_attributes type_annotations
         {
             $$ = &ast.Field{
-                ID: int($3),
+                ID: int($<i64>3),
+                ImplicitID: $<bul>3,
                 Name: $7,
-                Type: $6,
-                Requiredness: $5,
-                Annotations: $8,
-                Line: $1,
+                Type: $5,
+                Reference: $<bul>6,
+                Requiredness: $4,
+                Annotations: append($8, $9...),
+                Line: $1.Line,
+                Column: $1.Column,
                 Doc: ParseDocstring($2),
             }
         }
-    | lineno docstring INTCONSTANT ':' field_required type IDENTIFIER '=' const_value
-      type_annotations
+    | lineno docstring field_id field_required type field_reference field_name
+      '=' const_value xsd_field_attributes type_annotations
         {
             $$ = &ast.Field{
-                ID: int($3),
+                ID: int($<i64>3),
+                ImplicitID: $<bul>3,
                 Name: $7,
-                Type: $6,
-                Requiredness: $5,
+                Type: $5,
+                Reference: $<bul>6,
+                Requiredness: $4,
                 Default: $9,
-                Annotations: $10,
-                Line: $1,
+                Annotations: append($10, $11...),
+                Line: $1.Line,
+                Column: $1.Column,
                 Doc: ParseDocstring($2),
             }
         }
     ;
 
+field_id
+    : INTCONSTANT ':' { $<i64>$ = $1; $<bul>$ = false }
+    /* IDs of fields without one are filled in by the fields rule. */
+    | /* nothing */   { $<i64>$ = 0;  $<bul>$ = true }
+    ;
+
+field_reference
+    : '&'           { $<bul>$ = true }
+    | /* nothing */ { $<bul>$ = false }
+    ;
+
+field_name
+    : IDENTIFIER { $$ = $1 }
+    /* Documents produced by other systems may use keywords as field names.
+     * These are accepted only if the lexer was asked to allow them. */
+    | keyword
+        {
+            $$ = $1
+            if lex := yylex.(*lexer); !lex.keywordFieldNames {
+                lex.errorAt($<pos>1, fmt.Sprintf(
+                    "%q is a keyword and cannot be used as a field name", $1))
+            }
+        }
+    ;
+
+keyword
+    : NAMESPACE { $$ = "namespace" }
+    | INCLUDE   { $$ = "include" }
+    | AS        { $$ = "as" }
+    | VOID      { $$ = "void" }
+    | BOOL      { $$ = "bool" }
+    | BYTE      { $$ = "byte" }
+    | I8        { $$ = "i8" }
+    | I16       { $$ = "i16" }
+    | I32       { $$ = "i32" }
+    | I64       { $$ = "i64" }
+    | DOUBLE    { $$ = "double" }
+    | STRING    { $$ = "string" }
+    | BINARY    { $$ = "binary" }
+    | MAP       { $$ = "map" }
+    | LIST      { $$ = "list" }
+    | SET       { $$ = "set" }
+    | ONEWAY    { $$ = "oneway" }
+    | TYPEDEF   { $$ = "typedef" }
+    | STRUCT    { $$ = "struct" }
+    | UNION     { $$ = "union" }
+    | EXCEPTION { $$ = "exception" }
+    | EXTENDS   { $$ = "extends" }
+    | THROWS    { $$ = "throws" }
+    | SERVICE   { $$ = "service" }
+    | ENUM      { $$ = "enum" }
+    | CONST     { $$ = "const" }
+    | REQUIRED  { $$ = "required" }
+    | OPTIONAL  { $$ = "optional" }
+    | TRUE      { $$ = "true" }
+    | FALSE     { $$ = "false" }
+    | SENUM     { $$ = "senum" }
+    | SLIST     { $$ = "slist" }
+    | XSD_ALL      { $$ = "xsd_all" }
+    | XSD_OPTIONAL { $$ = "xsd_optional" }
+    | XSD_NILLABLE { $$ = "xsd_nillable" }
+    | XSD_ATTRS    { $$ = "xsd_attrs" }
+    ;
+
 field_required
     : REQUIRED { $$ =    ast.Required }
     | OPTIONAL { $$ =    ast.Optional }
@@ -316,8 +462,10 @@ function
                 ReturnType: $<fieldType>3,
                 Exceptions: $<fields>9,
                 OneWay: $<bul>2,
+                Streaming: $<bul>3,
                 Annotations: $10,
-                Line: $4,
+                Line: $4.Line,
+                Column: $4.Column,
                 Doc: ParseDocstring($1),
             }
         }
@@ -329,8 +477,19 @@ oneway
     ;
 
 function_type
-    : VOID { $<fieldType>$ = nil }
-    | type { $<fieldType>$ = $1  }
+    : VOID { $<fieldType>$ = nil; $<bul>$ = false }
+    | type { $<fieldType>$ = $1;  $<bul>$ = false }
+    | lineno IDENTIFIER '<' type '>'
+        {
+            // stream is not a reserved keyword so that existing Thrift files
+            // which use it as a name continue to compile.
+            if $2 != "stream" {
+                yylex.(*lexer).errorAt($1, fmt.Sprintf(
+                    "unknown return type %s<...>: only stream<...> is supported", $2))
+            }
+            $<fieldType>$ = $4
+            $<bul>$ = true
+        }
     ;
 
 throws
@@ -344,17 +503,17 @@ throws
 
 type
     : lineno base_type_name type_annotations
-        { $$ = ast.BaseType{ID: $2, Annotations: $3, Line: $1} }
+        { $$ = ast.BaseType{ID: $2, Annotations: $3, Line: $1.Line, Column: $1.Column} }
 
     /* container types */
     | lineno MAP '<' type ',' type '>' type_annotations
-        { $$ = ast.MapType{KeyType: $4, ValueType: $6, Annotations: $8, Line: $1} }
+        { $$ = ast.MapType{KeyType: $4, ValueType: $6, Annotations: $8, Line: $1.Line, Column: $1.Column} }
     | lineno LIST '<' type '>' type_annotations
-        { $$ = ast.ListType{ValueType: $4, Annotations: $6, Line: $1} }
+        { $$ = ast.ListType{ValueType: $4, Annotations: $6, Line: $1.Line, Column: $1.Column} }
     | lineno SET '<' type '>' type_annotations
-        { $$ = ast.SetType{ValueType: $4, Annotations: $6, Line: $1} }
+        { $$ = ast.SetType{ValueType: $4, Annotations: $6, Line: $1.Line, Column: $1.Column} }
     | lineno IDENTIFIER
-        { $$ = ast.TypeReference{Name: $2, Line: $1} }
+        { $$ = ast.TypeReference{Name: $2, Line: $1.Line, Column: $1.Column} }
     ;
 
 base_type_name
@@ -367,6 +526,7 @@ base_type_name
     | DOUBLE  { $$ = ast.DoubleTypeID }
     | STRING  { $$ = ast.StringTypeID }
     | BINARY  { $$ = ast.BinaryTypeID }
+    | SLIST   { $$ =  ast.SlistTypeID }
     ;
 
 /***************************************************************************
@@ -375,15 +535,16 @@ base_type_name
 
 const_value
     : INTCONSTANT { $$ = ast.ConstantInteger($1) }
+    | BIGINTCONSTANT { $$ = ast.ConstantBigInteger{Value: $1} }
     | DUBCONSTANT { $$ = ast.ConstantDouble($1) }
     | TRUE        { $$ = ast.ConstantBoolean(true) }
     | FALSE       { $$ = ast.ConstantBoolean(false) }
     | LITERAL     { $$ = ast.ConstantString($1) }
     | lineno IDENTIFIER
-        { $$ = ast.ConstantReference{Name: $2, Line: $1} }
+        { $$ = ast.ConstantReference{Name: $2, Line: $1.Line, Column: $1.Column} }
 
-    | lineno '[' const_list_items ']' { $$ = ast.ConstantList{Items: $3, Line: $1} }
-    | lineno '{' const_map_items  '}' { $$ =  ast.ConstantMap{Items: $3, Line: $1} }
+    | lineno '[' const_list_items ']' { $$ = ast.ConstantList{Items: $3, Line: $1.Line, Column: $1.Column} }
+    | lineno '{' const_map_items  '}' { $$ =  ast.ConstantMap{Items: $3, Line: $1.Line, Column: $1.Column} }
     ;
 
 const_list_items
@@ -395,7 +556,7 @@ const_list_items
 const_map_items
     : /* nothing */ { $$ = nil }
     | const_map_items lineno const_value ':' const_value optional_sep
-        { $$ = append($1, ast.ConstantMapItem{Key: $3, Value: $5, Line: $2}) }
+        { $$ = append($1, ast.ConstantMapItem{Key: $3, Value: $5, Line: $2.Line, Column: $2.Column}) }
     ;
 
 /***************************************************************************
@@ -409,27 +570,96 @@ type_annotations
 
 type_annotation_list
     : /* nothing */ { $$ = nil }
-    | type_annotation_list lineno IDENTIFIER '=' LITERAL optional_sep
-        { $$ = append($1, &ast.Annotation{Name: $3, Value: $5, Line: $2}) }
-    | type_annotation_list lineno IDENTIFIER optional_sep
-        { $$ = append($1, &ast.Annotation{Name: $3, Line: $2}) }
+    | type_annotation_list lineno annotation_name '=' LITERAL optional_sep
+        { $$ = append($1, &ast.Annotation{Name: $3, Value: $5, Line: $2.Line, Column: $2.Column}) }
+    | type_annotation_list lineno annotation_name optional_sep
+        { $$ = append($1, &ast.Annotation{Name: $3, Line: $2.Line, Column: $2.Column}) }
+    ;
+
+/* The XSD attributes are recorded as annotations of the same name, so they
+ * may also be written as such. */
+annotation_name
+    : IDENTIFIER   { $$ = $1 }
+    | XSD_ALL      { $$ = "xsd_all" }
+    | XSD_OPTIONAL { $$ = "xsd_optional" }
+    | XSD_NILLABLE { $$ = "xsd_nillable" }
+    | XSD_ATTRS    { $$ = "xsd_attrs" }
+    ;
+
+/***************************************************************************
+ XSD attributes
+
+ Early versions of Apache Thrift accepted attributes describing how types
+ map to XML schemas. These are accepted so that old documents still parse,
+ and are recorded as annotations with the same names.
+ ***************************************************************************/
+
+xsd_all
+    : /* nothing */ { $$ = nil }
+    | lineno XSD_ALL
+        { $$ = []*ast.Annotation{{Name: "xsd_all", Line: $1.Line, Column: $1.Column}} }
+    ;
+
+xsd_field_attributes
+    : xsd_optional xsd_nillable xsd_attrs
+        { $$ = append(append($1, $2...), $3...) }
+    ;
+
+xsd_optional
+    : /* nothing */ { $$ = nil }
+    | lineno XSD_OPTIONAL
+        { $$ = []*ast.Annotation{{Name: "xsd_optional", Line: $1.Line, Column: $1.Column}} }
+    ;
+
+xsd_nillable
+    : /* nothing */ { $$ = nil }
+    | lineno XSD_NILLABLE
+        { $$ = []*ast.Annotation{{Name: "xsd_nillable", Line: $1.Line, Column: $1.Column}} }
+    ;
+
+/* The value of the xsd_attrs annotation lists the names of the attributes,
+ * separated by commas. */
+xsd_attrs
+    : /* nothing */ { $$ = nil }
+    | lineno XSD_ATTRS '{' fields '}'
+        {
+            names := make([]string, len($4))
+            for i, f := range $4 {
+                names[i] = f.Name
+            }
+            $$ = []*ast.Annotation{{
+                Name: "xsd_attrs",
+                Value: strings.Join(names, ","),
+                Line: $1.Line,
+                Column: $1.Column,
+            }}
+        }
     ;
 
 /***************************************************************************
  Other
  ***************************************************************************/
 
-/* Grammar rules that need to record a line number at a specific token should
+/* Grammar rules that need to record a position at a specific token should
    include this somewhere. For example,
 
     foo : bar lineno baz { x := $2 }
 
-  $2 in the above example contains the line number right after 'bar' but before
-  'baz'. This way, if 'baz' spans mulitple lines, we still get the line number
+  $2 in the above example contains the position right after 'bar' but before
+  'baz'. This way, if 'baz' spans mulitple lines, we still get the position
   for where the rule started rather than where it ends.
  */
 lineno
-    : /* nothing */ { $$ = yylex.(*lexer).line }
+    : /* nothing */
+        {
+            // The parser may reduce this rule before it has read the token
+            // that follows. Read it now so that we get the position of that
+            // token rather than the one before it.
+            if yyrcvr.char < 0 {
+                yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+            }
+            $$ = yyrcvr.lval.pos
+        }
     ;
 
 docstring
@@ -30,14 +30,20 @@ import __yyfmt__ "fmt"
 
 //line thrift.y:2
 
-import "go.uber.org/thriftrw/ast"
+import (
+	"fmt"
+	"math/big"
+	"strings"
 
-//line thrift.y:7
+	"go.uber.org/thriftrw/ast"
+)
+
+//line thrift.y:13
 type yySymType struct {
 	yys int
-	// Used to record line numbers when the line number at the start point is
-	// required.
-	line int
+	// Used to record positions when the position at the start point is
+	// required. The lexer records the position of every token here.
+	pos ast.Position
 
 	docstring string
 
@@ -46,10 +52,11 @@ type yySymType struct {
 
 	// Other intermediate variables:
 
-	bul bool
-	str string
-	i64 int64
-	dub float64
+	bul    bool
+	str    string
+	i64    int64
+	bigint *big.Int
+	dub    float64
 
 	fieldType     ast.Type
 	structType    ast.StructureType
@@ -76,41 +83,51 @@ type yySymType struct {
 	constantValue    ast.ConstantValue
 	constantValues   []ast.ConstantValue
 	constantMapItems []ast.ConstantMapItem
+
+	senumValues []string
 }
 
 const IDENTIFIER = 57346
 const LITERAL = 57347
 const INTCONSTANT = 57348
-const DUBCONSTANT = 57349
-const NAMESPACE = 57350
-const INCLUDE = 57351
-const VOID = 57352
-const BOOL = 57353
-const BYTE = 57354
-const I8 = 57355
-const I16 = 57356
-const I32 = 57357
-const I64 = 57358
-const DOUBLE = 57359
-const STRING = 57360
-const BINARY = 57361
-const MAP = 57362
-const LIST = 57363
-const SET = 57364
-const ONEWAY = 57365
-const TYPEDEF = 57366
-const STRUCT = 57367
-const UNION = 57368
-const EXCEPTION = 57369
-const EXTENDS = 57370
-const THROWS = 57371
-const SERVICE = 57372
-const ENUM = 57373
-const CONST = 57374
-const REQUIRED = 57375
-const OPTIONAL = 57376
-const TRUE = 57377
-const FALSE = 57378
+const BIGINTCONSTANT = 57349
+const DUBCONSTANT = 57350
+const NAMESPACE = 57351
+const INCLUDE = 57352
+const AS = 57353
+const VOID = 57354
+const BOOL = 57355
+const BYTE = 57356
+const I8 = 57357
+const I16 = 57358
+const I32 = 57359
+const I64 = 57360
+const DOUBLE = 57361
+const STRING = 57362
+const BINARY = 57363
+const MAP = 57364
+const LIST = 57365
+const SET = 57366
+const ONEWAY = 57367
+const TYPEDEF = 57368
+const STRUCT = 57369
+const UNION = 57370
+const EXCEPTION = 57371
+const EXTENDS = 57372
+const THROWS = 57373
+const SERVICE = 57374
+const ENUM = 57375
+const CONST = 57376
+const REQUIRED = 57377
+const OPTIONAL = 57378
+const TRUE = 57379
+const FALSE = 57380
+const SENUM = 57381
+const SLIST = 57382
+const XSD_ALL = 57383
+const XSD_OPTIONAL = 57384
+const XSD_NILLABLE = 57385
+const XSD_ATTRS = 57386
 
 var yyToknames = [...]string{
 	"$end",
@@ -119,9 +136,11 @@ var yyToknames = [...]string{
 	"IDENTIFIER",
 	"LITERAL",
 	"INTCONSTANT",
+	"BIGINTCONSTANT",
 	"DUBCONSTANT",
 	"NAMESPACE",
 	"INCLUDE",
+	"AS",
 	"VOID",
 	"BOOL",
 	"BYTE",
@@ -149,20 +168,28 @@ var yyToknames = [...]string{
 	"OPTIONAL",
 	"TRUE",
 	"FALSE",
+	"SENUM",
+	"SLIST",
+	"XSD_ALL",
+	"XSD_OPTIONAL",
+	"XSD_NILLABLE",
+	"XSD_ATTRS",
 	"'*'",
 	"'='",
 	"'{'",
 	"'}'",
 	"':'",
+	"'&'",
 	"'('",
 	"')'",
 	"'<'",
-	"','",
 	"'>'",
+	"','",
 	"'['",
 	"']'",
 	"';'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
@@ -170,154 +197,225 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
 	-1, 2,
-	8, 70,
-	9, 70,
-	-2, 8,
+	9, 133,
+	10, 133,
+	-2, 9,
 	-1, 3,
 	1, 1,
-	-2, 70,
+	-2, 133,
+	-1, 36,
+	41, 133,
+	-2, 124,
+	-1, 178,
+	42, 133,
+	-2, 127,
+	-1, 220,
+	43, 133,
+	-2, 129,
+	-1, 225,
+	42, 133,
+	-2, 127,
+	-1, 226,
+	44, 133,
+	-2, 131,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 187
-
-var yyAct = [...]int{
-
-	30, 10, 66, 5, 7, 63, 64, 56, 122, 85,
-	29, 71, 67, 68, 11, 11, 87, 13, 12, 12,
-	124, 94, 93, 92, 60, 59, 58, 159, 151, 150,
-	31, 126, 90, 57, 157, 57, 57, 144, 140, 127,
-	129, 69, 70, 120, 83, 71, 67, 68, 80, 77,
-	54, 89, 105, 52, 118, 65, 72, 51, 61, 88,
-	17, 53, 55, 79, 82, 136, 137, 154, 104, 74,
-	75, 76, 115, 134, 91, 69, 70, 9, 8, 113,
-	96, 15, 14, 99, 132, 95, 102, 26, 98, 97,
-	146, 101, 100, 16, 138, 112, 108, 86, 50, 35,
-	34, 110, 111, 109, 33, 32, 28, 72, 121, 119,
-	123, 27, 117, 153, 116, 128, 114, 103, 73, 107,
-	125, 130, 72, 106, 131, 3, 6, 62, 78, 84,
-	2, 4, 133, 81, 141, 21, 135, 139, 36, 1,
-	0, 72, 142, 145, 0, 0, 143, 148, 82, 0,
-	147, 72, 0, 152, 149, 0, 0, 0, 0, 82,
-	155, 156, 0, 158, 19, 23, 24, 25, 40, 0,
-	22, 20, 18, 0, 0, 41, 42, 43, 44, 45,
-	46, 47, 48, 49, 37, 38, 39,
+const yyLast = 336
+
+var yyAct = [...]uint8{
+	32, 99, 1, 5, 7, 43, 160, 178, 31, 13,
+	180, 101, 22, 119, 96, 4, 2, 98, 76, 92,
+	72, 73, 6, 3, 80, 128, 129, 65, 33, 61,
+	218, 220, 226, 232, 150, 173, 123, 62, 137, 39,
+	222, 9, 8, 10, 11, 15, 14, 12, 17, 19,
+	24, 25, 26, 27, 28, 23, 20, 18, 29, 30,
+	34, 35, 21, 36, 37, 77, 79, 87, 38, 40,
+	41, 42, 58, 93, 59, 60, 64, 88, 89, 90,
+	66, 75, 68, 67, 69, 74, 70, 78, 100, 16,
+	47, 91, 95, 63, 110, 115, 97, 120, 71, 48,
+	49, 50, 51, 52, 53, 54, 55, 56, 44, 45,
+	46, 118, 111, 124, 133, 112, 125, 102, 107, 130,
+	138, 142, 136, 145, 140, 147, 57, 151, 149, 87,
+	146, 113, 139, 40, 116, 94, 114, 121, 152, 117,
+	154, 159, 122, 163, 141, 127, 165, 87, 164, 167,
+	126, 131, 132, 144, 103, 104, 105, 106, 134, 11,
+	170, 109, 12, 135, 86, 81, 82, 83, 87, 169,
+	108, 157, 174, 148, 171, 217, 120, 161, 162, 221,
+	86, 81, 82, 83, 176, 175, 155, 158, 156, 219,
+	228, 223, 172, 234, 166, 237, 84, 85, 230, 168,
+	239, 238, 241, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 84, 85, 0, 0, 177, 0, 0, 0,
+	87, 227, 0, 0, 0, 0, 221, 233, 0, 0,
+	0, 0, 143, 0, 0, 0, 120, 0, 0, 0,
+	0, 120, 0, 0, 225, 235, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 0, 231, 0, 224, 0,
+	0, 0, 229, 153, 0, 0, 0, 0, 0, 0,
+	0, 236, 48, 49, 50, 51, 52, 53, 54, 55,
+	56, 44, 45, 46, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 179, 0, 0, 0, 57,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216,
 }
-var yyPact = [...]int{
-
-	-1000, -1000, -1000, -1000, -1000, 69, -31, -1000, 77, 56,
-	-1000, -1000, -1000, 140, -1000, 82, 107, 102, -1000, -1000,
-	101, 100, 96, -1000, -1000, -1000, -1000, -1000, -1000, 95,
-	164, 94, 18, 14, 22, 24, -6, -18, -19, -20,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-6, -1000, -1000, -1000, -1000, 40, -1000, -1000, -1000, -1000,
-	-1000, -1000, 9, 8, 4, 93, -1000, -1000, -1000, -1000,
-	-1000, -1000, 12, -11, -22, -24, -25, -6, -31, -1000,
-	-6, -31, -1000, -6, -31, 45, 13, -1000, -1000, -1000,
-	-1000, 92, -1000, -6, -6, -1000, -1000, 91, -1000, -1000,
-	73, -1000, -1000, 62, -1000, -1000, 6, 3, -30, -26,
-	-1000, -1000, -7, -2, -1000, -1000, -1000, 0, -1000, -31,
-	-1000, 40, 79, -1000, -6, -1000, 67, 32, 90, -6,
-	-1000, -3, -31, -1000, -6, -1000, -1000, -1000, -5, -1000,
-	40, -1000, -1000, 86, -1000, -31, -9, -15, -1000, -1000,
-	40, 38, -6, -6, -8, -1000, -1000, -1000, -16, -1000,
+
+var yyPact = [...]int16{
+	-32768, -32768, -32768, -32768, -32768, 32, -11, -32768, 41, 44,
+	-32768, -32768, -32768, 23, 42, 49, 54, 55, -32768, -32768,
+	56, 57, 59, 60, -32768, -32768, -32768, 64, -32768, 18,
+	18, 67, 86, 68, 27, 28, -32768, 46, -32768, -32768,
+	-32768, -32768, 34, 18, 29, 31, 33, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 18, -32768,
+	-32768, 38, 40, -32768, -32768, 35, 159, -32768, -32768, -32768,
+	-32768, -32768, 43, 87, -32768, -32768, 48, 84, -32768, 113,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 114, 39, 58,
+	61, 18, -11, -32768, 18, -11, 63, 18, -11, 88,
+	69, 104, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, 18, 18, -32768, -32768, 110, -32768, -32768, 18, -11,
+	-32768, -32768, -32768, 108, -32768, -32768, 116, -32768, 175, 75,
+	71, -32768, -32768, 82, -32768, -32768, 121, -32768, -32768, -32768,
+	259, 92, -11, -32768, -11, -32768, 159, 18, -32768, 135,
+	142, 94, 144, 93, 18, -32768, -32768, 100, -32768, 18,
+	-32768, -32768, -32768, -32768, 109, -32768, -32768, 159, -32768, 122,
+	-32768, 130, -11, 291, -32768, 123, -32768, -32768, 143, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 160, 18, 159,
+	-32768, 148, 18, 147, -32768, -32768, -32768, 150, -32768, -32768,
+	-32768, 18, -32768, 151, -32768, 149, -32768, 153, -32768, -32768,
+	154, -32768,
 }
-var yyPgo = [...]int{
 
-	0, 0, 9, 139, 10, 138, 136, 135, 133, 5,
-	131, 130, 129, 6, 128, 127, 126, 125, 2, 123,
-	119, 118, 7, 1, 117, 116, 113,
+var yyPgo = [...]uint8{
+	0, 0, 1, 2, 8, 5, 6, 7, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 39, 29, 30, 31,
+	32, 33, 43, 34, 35, 36, 38, 40,
 }
-var yyR1 = [...]int{
-
-	0, 3, 11, 11, 10, 10, 10, 10, 17, 17,
-	16, 16, 16, 16, 16, 16, 7, 7, 7, 15,
-	15, 14, 14, 9, 9, 8, 8, 6, 6, 6,
-	13, 13, 12, 24, 24, 25, 25, 26, 26, 4,
-	4, 4, 4, 4, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 18, 18, 18, 18, 18, 18, 18,
-	18, 19, 19, 20, 20, 22, 22, 21, 21, 21,
-	1, 2, 23, 23, 23,
+
+var yyR1 = [...]int8{
+	0, 3, 14, 14, 13, 13, 13, 13, 13, 21,
+	21, 20, 20, 20, 20, 20, 20, 20, 10, 10,
+	10, 18, 18, 17, 17, 19, 19, 12, 12, 11,
+	11, 33, 33, 34, 34, 7, 7, 8, 8, 8,
+	8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+	8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+	8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+	8, 8, 8, 6, 6, 6, 16, 16, 15, 35,
+	35, 36, 36, 36, 37, 37, 4, 4, 4, 4,
+	4, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	23, 23, 24, 24, 26, 26, 25, 25, 25, 9,
+	9, 9, 9, 9, 27, 27, 28, 29, 29, 30,
+	30, 31, 31, 1, 2, 32, 32, 32,
 }
-var yyR2 = [...]int{
-
-	0, 2, 0, 2, 3, 4, 4, 4, 0, 3,
-	7, 6, 8, 8, 8, 11, 1, 1, 1, 0,
-	3, 4, 6, 0, 3, 8, 10, 1, 1, 0,
-	0, 3, 10, 1, 0, 1, 1, 0, 4, 3,
-	8, 6, 6, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 4,
-	4, 0, 3, 0, 6, 0, 3, 0, 6, 4,
-	0, 0, 1, 1, 0,
+
+var yyR2 = [...]int8{
+	0, 2, 0, 2, 3, 4, 5, 5, 5, 0,
+	3, 7, 6, 8, 8, 9, 8, 11, 1, 1,
+	1, 0, 3, 4, 6, 0, 3, 0, 3, 9,
+	11, 2, 0, 1, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 0, 3, 10, 1,
+	0, 1, 1, 5, 0, 4, 3, 8, 6, 6,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 4, 4,
+	0, 3, 0, 6, 0, 3, 0, 6, 4, 1,
+	1, 1, 1, 1, 0, 2, 3, 0, 2, 0,
+	2, 0, 5, 0, 0, 1, 1, 0,
 }
-var yyChk = [...]int{
-
-	-1000, -3, -11, -17, -10, -1, -16, -1, 9, 8,
-	-23, 45, 49, -2, 5, 4, 37, 4, 32, 24,
-	31, -7, 30, 25, 26, 27, 5, 4, 4, -4,
-	-1, -4, 4, 4, 4, 4, -5, 20, 21, 22,
-	4, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	4, 39, 39, 39, 28, 38, -22, 42, 44, 44,
-	44, -22, -15, -9, -13, -1, -18, 6, 7, 35,
-	36, 5, -1, -21, -4, -4, -4, 40, -14, -1,
-	40, -8, -1, 40, -12, -2, 4, 4, 47, 39,
-	43, -1, 45, 46, 46, -22, -23, -2, -22, -23,
-	-2, -22, -23, -24, 23, 39, -19, -20, 4, -4,
-	-22, -22, 4, 6, -25, 10, -4, -13, 48, -18,
-	40, -1, 38, -23, 46, -22, 38, 41, -1, 40,
-	-23, -18, 5, -22, 6, -6, 33, 34, 4, -22,
-	41, -23, -22, -4, 42, -18, 4, -9, -23, -22,
-	38, 43, -18, -26, 29, -22, -22, 42, -9, 43,
+
+var yyChk = [...]int16{
+	-32768, -3, -14, -21, -13, -1, -20, -1, 10, 9,
+	-32, 55, 58, -2, 5, 4, 45, 4, 34, 26,
+	33, 39, -10, 32, 27, 28, 29, 11, 5, 4,
+	4, -4, -1, -4, 4, 4, 4, 4, 4, -26,
+	51, -26, 4, -5, 22, 23, 24, 4, 13, 14,
+	15, 16, 17, 18, 19, 20, 21, 40, 4, 47,
+	47, -27, -1, 47, 30, -25, 46, -26, 53, 53,
+	53, -26, -18, -19, 47, 41, -16, -1, 52, -1,
+	-22, 6, 7, 8, 37, 38, 5, -1, -4, -4,
+	-4, 48, -17, -1, 48, 5, -12, 48, -15, -2,
+	4, -9, 4, 41, 42, 43, 44, 4, 56, 47,
+	55, 54, 54, -26, -32, -2, -26, -32, 48, -11,
+	-1, -26, -32, -35, 25, 47, 46, -32, -23, -24,
+	-4, -26, -26, 4, -26, -32, -2, -36, 12, -4,
+	-1, -16, 5, 57, -22, 48, -1, 54, -26, 46,
+	-33, 6, -1, 4, 48, -32, -32, -22, -26, 6,
+	-6, 35, 36, 49, 4, 53, -26, 49, -26, -4,
+	51, -4, -22, -34, 50, -12, 54, -32, -7, 4,
+	-8, 9, 10, 11, 12, 13, 14, 15, 16, 17,
+	18, 19, 20, 21, 22, 23, 24, 25, 26, 27,
+	28, 29, 30, 31, 32, 33, 34, 35, 36, 37,
+	38, 39, 40, 41, 42, 43, 44, 52, -28, 46,
+	-29, -1, -37, 31, -26, -22, -30, -1, 42, -26,
+	51, -28, -31, -1, 43, -12, -26, 44, 52, 47,
+	-12, 48,
 }
-var yyDef = [...]int{
-
-	2, -2, -2, -2, 3, 0, 74, 71, 0, 0,
-	9, 72, 73, 0, 4, 0, 0, 0, 70, 70,
-	0, 0, 0, 16, 17, 18, 5, 6, 7, 0,
-	0, 0, 0, 0, 0, 0, 65, 0, 0, 0,
-	43, 44, 45, 46, 47, 48, 49, 50, 51, 52,
-	65, 19, 23, 30, 70, 70, 39, 67, 70, 70,
-	70, 11, 70, 70, 71, 0, 10, 53, 54, 55,
-	56, 57, 0, 70, 0, 0, 0, 65, 74, 71,
-	65, 74, 71, 65, 74, 34, 0, 58, 61, 63,
-	66, 0, 70, 65, 65, 12, 20, 0, 13, 24,
-	0, 14, 31, 70, 33, 30, 70, 70, 74, 0,
-	41, 42, 65, 0, 70, 35, 36, 71, 59, 74,
-	60, 70, 0, 69, 65, 21, 0, 29, 0, 65,
-	62, 0, 74, 40, 65, 70, 27, 28, 0, 15,
-	70, 68, 22, 0, 23, 74, 65, 70, 64, 25,
-	70, 37, 65, 65, 0, 26, 32, 23, 70, 38,
+
+var yyDef = [...]int16{
+	2, -2, -2, -2, 3, 0, 137, 134, 0, 0,
+	10, 135, 136, 0, 4, 0, 0, 0, 133, 133,
+	0, 0, 0, 0, 18, 19, 20, 0, 5, 114,
+	114, 0, 0, 0, 0, 0, -2, 0, 6, 7,
+	116, 8, 0, 114, 0, 0, 0, 90, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 114, 21,
+	25, 0, 0, 76, 133, 133, 133, 86, 133, 133,
+	133, 12, 133, 0, 27, 125, 134, 0, 115, 0,
+	11, 101, 102, 103, 104, 105, 106, 0, 0, 0,
+	0, 114, 137, 134, 114, 137, 133, 114, 137, 80,
+	0, 137, 119, 120, 121, 122, 123, 107, 110, 112,
+	133, 114, 114, 13, 22, 0, 14, 26, 114, 137,
+	134, 16, 77, 133, 79, 76, 0, 118, 133, 133,
+	0, 88, 89, 114, 15, 28, 32, 133, 81, 82,
+	0, 134, 137, 108, 137, 109, 133, 114, 23, 0,
+	75, 0, 0, 90, 114, 117, 111, 0, 87, 114,
+	133, 73, 74, 31, 0, 133, 17, 133, 24, 34,
+	27, 0, 137, 0, 33, 133, 83, 113, -2, 35,
+	36, 37, 38, 39, 40, 41, 42, 43, 44, 45,
+	46, 47, 48, 49, 50, 51, 52, 53, 54, 55,
+	56, 57, 58, 59, 60, 61, 62, 63, 64, 65,
+	66, 67, 68, 69, 70, 71, 72, 84, 114, 133,
+	-2, 0, 114, 0, 29, -2, -2, 0, 128, 78,
+	27, 114, 126, 0, 130, 133, 30, 0, 85, 27,
+	133, 132,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	42, 43, 37, 3, 45, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 41, 49,
-	44, 38, 46, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 50, 3,
+	51, 52, 45, 3, 55, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 49, 58,
+	53, 46, 54, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 47, 3, 48, 3, 3, 3, 3, 3, 3,
+	3, 56, 3, 57, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 39, 3, 40,
+	3, 3, 3, 47, 3, 48,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
-	32, 33, 34, 35, 36,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -360,7 +458,7 @@ func yyNewParser() yyParser {
 	return &yyParserImpl{}
 }
 
-const yyFlag = -1000
+const yyFlag = -32768
 
 func yyTokname(c int) string {
 	if c >= 1 && c-1 < len(yyToknames) {
@@ -399,9 +497,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -411,13 +509,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -448,30 +546,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -526,7 +624,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -537,8 +635,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -551,7 +649,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -560,18 +658,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -593,10 +691,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -632,7 +730,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -643,16 +741,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -660,7 +758,7 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line thrift.y:95
+//line thrift.y:115
 		{
 			yyVAL.prog = &ast.Program{Headers: yyDollar[1].headers, Definitions: yyDollar[2].definitions}
 			yylex.(*lexer).program = yyVAL.prog
@@ -668,135 +766,174 @@ yydefault:
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:107
+//line thrift.y:127
 		{
 			yyVAL.headers = nil
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line thrift.y:108
+//line thrift.y:128
 		{
 			yyVAL.headers = append(yyDollar[1].headers, yyDollar[2].header)
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:113
+//line thrift.y:133
 		{
 			yyVAL.header = &ast.Include{
-				Path: yyDollar[3].str,
-				Line: yyDollar[1].line,
+				Path:   yyDollar[3].str,
+				Line:   yyDollar[1].pos.Line,
+				Column: yyDollar[1].pos.Column,
 			}
 		}
 	case 5:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:120
+//line thrift.y:141
 		{
 			yyVAL.header = &ast.Include{
-				Name: yyDollar[3].str,
-				Path: yyDollar[4].str,
-				Line: yyDollar[1].line,
+				Name:   yyDollar[3].str,
+				Path:   yyDollar[4].str,
+				Line:   yyDollar[1].pos.Line,
+				Column: yyDollar[1].pos.Column,
 			}
 		}
 	case 6:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:128
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line thrift.y:150
 		{
-			yyVAL.header = &ast.Namespace{
-				Scope: "*",
-				Name:  yyDollar[4].str,
-				Line:  yyDollar[1].line,
+			yyVAL.header = &ast.Include{
+				Name:   yyDollar[5].str,
+				Path:   yyDollar[3].str,
+				Line:   yyDollar[1].pos.Line,
+				Column: yyDollar[1].pos.Column,
 			}
 		}
 	case 7:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:136
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line thrift.y:159
 		{
 			yyVAL.header = &ast.Namespace{
-				Scope: yyDollar[3].str,
-				Name:  yyDollar[4].str,
-				Line:  yyDollar[1].line,
+				Scope:       "*",
+				Name:        yyDollar[4].str,
+				Annotations: yyDollar[5].typeAnnotations,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
 			}
 		}
 	case 8:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line thrift.y:169
+		{
+			yyVAL.header = &ast.Namespace{
+				Scope:       yyDollar[3].str,
+				Name:        yyDollar[4].str,
+				Annotations: yyDollar[5].typeAnnotations,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
+			}
+		}
+	case 9:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:150
+//line thrift.y:185
 		{
 			yyVAL.definitions = nil
 		}
-	case 9:
+	case 10:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:151
+//line thrift.y:186
 		{
 			yyVAL.definitions = append(yyDollar[1].definitions, yyDollar[2].definition)
 		}
-	case 10:
+	case 11:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line thrift.y:158
+//line thrift.y:193
 		{
 			yyVAL.definition = &ast.Constant{
-				Name:  yyDollar[5].str,
-				Type:  yyDollar[4].fieldType,
-				Value: yyDollar[7].constantValue,
-				Line:  yyDollar[1].line,
-				Doc:   ParseDocstring(yyDollar[2].docstring),
+				Name:   yyDollar[5].str,
+				Type:   yyDollar[4].fieldType,
+				Value:  yyDollar[7].constantValue,
+				Line:   yyDollar[1].pos.Line,
+				Column: yyDollar[1].pos.Column,
+				Doc:    ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 11:
+	case 12:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line thrift.y:169
+//line thrift.y:205
 		{
 			yyVAL.definition = &ast.Typedef{
 				Name:        yyDollar[5].str,
 				Type:        yyDollar[4].fieldType,
 				Annotations: yyDollar[6].typeAnnotations,
-				Line:        yyDollar[1].line,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 12:
+	case 13:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line thrift.y:179
+//line thrift.y:216
 		{
 			yyVAL.definition = &ast.Enum{
 				Name:        yyDollar[4].str,
 				Items:       yyDollar[6].enumItems,
 				Annotations: yyDollar[8].typeAnnotations,
-				Line:        yyDollar[1].line,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
+				EndLine:     yyDollar[7].pos.Line,
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 13:
+	case 14:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line thrift.y:189
+//line thrift.y:228
+		{
+			yyVAL.definition = &ast.Senum{
+				Name:        yyDollar[4].str,
+				Values:      yyDollar[6].senumValues,
+				Annotations: yyDollar[8].typeAnnotations,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
+				Doc:         ParseDocstring(yyDollar[2].docstring),
+			}
+		}
+	case 15:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line thrift.y:239
 		{
 			yyVAL.definition = &ast.Struct{
 				Name:        yyDollar[4].str,
 				Type:        yyDollar[3].structType,
-				Fields:      yyDollar[6].fields,
-				Annotations: yyDollar[8].typeAnnotations,
-				Line:        yyDollar[1].line,
+				Fields:      yyDollar[7].fields,
+				Annotations: append(yyDollar[5].typeAnnotations, yyDollar[9].typeAnnotations...),
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
+				EndLine:     yyDollar[8].pos.Line,
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 14:
+	case 16:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line thrift.y:201
+//line thrift.y:253
 		{
 			yyVAL.definition = &ast.Service{
 				Name:        yyDollar[4].str,
 				Functions:   yyDollar[6].functions,
 				Annotations: yyDollar[8].typeAnnotations,
-				Line:        yyDollar[1].line,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
+				EndLine:     yyDollar[7].pos.Line,
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 15:
+	case 17:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line thrift.y:212
+//line thrift.y:266
 		{
 			parent := &ast.ServiceReference{
-				Name: yyDollar[7].str,
-				Line: yyDollar[6].line,
+				Name:   yyDollar[7].str,
+				Line:   yyDollar[6].pos.Line,
+				Column: yyDollar[6].pos.Column,
 			}
 
 			yyVAL.definition = &ast.Service{
@@ -804,138 +941,426 @@ yydefault:
 				Functions:   yyDollar[9].functions,
 				Parent:      parent,
 				Annotations: yyDollar[11].typeAnnotations,
-				Line:        yyDollar[1].line,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
+				EndLine:     yyDollar[10].pos.Line,
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 16:
+	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:230
+//line thrift.y:287
 		{
 			yyVAL.structType = ast.StructType
 		}
-	case 17:
+	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:231
+//line thrift.y:288
 		{
 			yyVAL.structType = ast.UnionType
 		}
-	case 18:
+	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:232
+//line thrift.y:289
 		{
 			yyVAL.structType = ast.ExceptionType
 		}
-	case 19:
+	case 21:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:236
+//line thrift.y:293
 		{
 			yyVAL.enumItems = nil
 		}
-	case 20:
+	case 22:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:237
+//line thrift.y:294
 		{
 			yyVAL.enumItems = append(yyDollar[1].enumItems, yyDollar[2].enumItem)
 		}
-	case 21:
+	case 23:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:242
+//line thrift.y:299
 		{
 			yyVAL.enumItem = &ast.EnumItem{
 				Name:        yyDollar[3].str,
 				Annotations: yyDollar[4].typeAnnotations,
-				Line:        yyDollar[1].line,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 22:
+	case 24:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line thrift.y:251
+//line thrift.y:309
 		{
 			value := int(yyDollar[5].i64)
 			yyVAL.enumItem = &ast.EnumItem{
 				Name:        yyDollar[3].str,
 				Value:       &value,
 				Annotations: yyDollar[6].typeAnnotations,
-				Line:        yyDollar[1].line,
+				Line:        yyDollar[1].pos.Line,
+				Column:      yyDollar[1].pos.Column,
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 23:
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:264
+//line thrift.y:323
+		{
+			yyVAL.senumValues = nil
+		}
+	case 26:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line thrift.y:324
+		{
+			yyVAL.senumValues = append(yyDollar[1].senumValues, yyDollar[2].str)
+		}
+	case 27:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line thrift.y:328
 		{
 			yyVAL.fields = nil
 		}
-	case 24:
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:265
+//line thrift.y:330
 		{
+			if yyDollar[2].field.ImplicitID {
+				yyDollar[2].field.ID = -1
+				for _, f := range yyDollar[1].fields {
+					if f.ImplicitID {
+						yyDollar[2].field.ID--
+					}
+				}
+			}
 			yyVAL.fields = append(yyDollar[1].fields, yyDollar[2].field)
 		}
-	case 25:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line thrift.y:271
+	case 29:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line thrift.y:347
 		{
 			yyVAL.field = &ast.Field{
 				ID:           int(yyDollar[3].i64),
+				ImplicitID:   yyDollar[3].bul,
 				Name:         yyDollar[7].str,
-				Type:         yyDollar[6].fieldType,
-				Requiredness: yyDollar[5].fieldRequired,
-				Annotations:  yyDollar[8].typeAnnotations,
-				Line:         yyDollar[1].line,
+				Type:         yyDollar[5].fieldType,
+				Reference:    yyDollar[6].bul,
+				Requiredness: yyDollar[4].fieldRequired,
+				Annotations:  append(yyDollar[8].typeAnnotations, yyDollar[9].typeAnnotations...),
+				Line:         yyDollar[1].pos.Line,
+				Column:       yyDollar[1].pos.Column,
 				Doc:          ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 26:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line thrift.y:284
+	case 30:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line thrift.y:363
 		{
 			yyVAL.field = &ast.Field{
 				ID:           int(yyDollar[3].i64),
+				ImplicitID:   yyDollar[3].bul,
 				Name:         yyDollar[7].str,
-				Type:         yyDollar[6].fieldType,
-				Requiredness: yyDollar[5].fieldRequired,
+				Type:         yyDollar[5].fieldType,
+				Reference:    yyDollar[6].bul,
+				Requiredness: yyDollar[4].fieldRequired,
 				Default:      yyDollar[9].constantValue,
-				Annotations:  yyDollar[10].typeAnnotations,
-				Line:         yyDollar[1].line,
+				Annotations:  append(yyDollar[10].typeAnnotations, yyDollar[11].typeAnnotations...),
+				Line:         yyDollar[1].pos.Line,
+				Column:       yyDollar[1].pos.Column,
 				Doc:          ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 27:
+	case 31:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:381
+		{
+			yyVAL.i64 = yyDollar[1].i64
+			yyVAL.bul = false
+		}
+	case 32:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line thrift.y:383
+		{
+			yyVAL.i64 = 0
+			yyVAL.bul = true
+		}
+	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:299
+//line thrift.y:387
+		{
+			yyVAL.bul = true
+		}
+	case 34:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line thrift.y:388
+		{
+			yyVAL.bul = false
+		}
+	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:392
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:396
+		{
+			yyVAL.str = yyDollar[1].str
+			if lex := yylex.(*lexer); !lex.keywordFieldNames {
+				lex.errorAt(yyDollar[1].pos, fmt.Sprintf(
+					"%q is a keyword and cannot be used as a field name", yyDollar[1].str))
+			}
+		}
+	case 37:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:406
+		{
+			yyVAL.str = "namespace"
+		}
+	case 38:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:407
+		{
+			yyVAL.str = "include"
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:408
+		{
+			yyVAL.str = "as"
+		}
+	case 40:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:409
+		{
+			yyVAL.str = "void"
+		}
+	case 41:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:410
+		{
+			yyVAL.str = "bool"
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:411
+		{
+			yyVAL.str = "byte"
+		}
+	case 43:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:412
+		{
+			yyVAL.str = "i8"
+		}
+	case 44:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:413
+		{
+			yyVAL.str = "i16"
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:414
+		{
+			yyVAL.str = "i32"
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:415
+		{
+			yyVAL.str = "i64"
+		}
+	case 47:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:416
+		{
+			yyVAL.str = "double"
+		}
+	case 48:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:417
+		{
+			yyVAL.str = "string"
+		}
+	case 49:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:418
+		{
+			yyVAL.str = "binary"
+		}
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:419
+		{
+			yyVAL.str = "map"
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:420
+		{
+			yyVAL.str = "list"
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:421
+		{
+			yyVAL.str = "set"
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:422
+		{
+			yyVAL.str = "oneway"
+		}
+	case 54:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:423
+		{
+			yyVAL.str = "typedef"
+		}
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:424
+		{
+			yyVAL.str = "struct"
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:425
+		{
+			yyVAL.str = "union"
+		}
+	case 57:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:426
+		{
+			yyVAL.str = "exception"
+		}
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:427
+		{
+			yyVAL.str = "extends"
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:428
+		{
+			yyVAL.str = "throws"
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:429
+		{
+			yyVAL.str = "service"
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:430
+		{
+			yyVAL.str = "enum"
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:431
+		{
+			yyVAL.str = "const"
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:432
+		{
+			yyVAL.str = "required"
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:433
+		{
+			yyVAL.str = "optional"
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:434
+		{
+			yyVAL.str = "true"
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:435
+		{
+			yyVAL.str = "false"
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:436
+		{
+			yyVAL.str = "senum"
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:437
+		{
+			yyVAL.str = "slist"
+		}
+	case 69:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:438
+		{
+			yyVAL.str = "xsd_all"
+		}
+	case 70:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:439
+		{
+			yyVAL.str = "xsd_optional"
+		}
+	case 71:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:440
+		{
+			yyVAL.str = "xsd_nillable"
+		}
+	case 72:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:441
+		{
+			yyVAL.str = "xsd_attrs"
+		}
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:445
 		{
 			yyVAL.fieldRequired = ast.Required
 		}
-	case 28:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:300
+//line thrift.y:446
 		{
 			yyVAL.fieldRequired = ast.Optional
 		}
-	case 29:
+	case 75:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:301
+//line thrift.y:447
 		{
 			yyVAL.fieldRequired = ast.Unspecified
 		}
-	case 30:
+	case 76:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:305
+//line thrift.y:451
 		{
 			yyVAL.functions = nil
 		}
-	case 31:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:306
+//line thrift.y:452
 		{
 			yyVAL.functions = append(yyDollar[1].functions, yyDollar[2].function)
 		}
-	case 32:
+	case 78:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line thrift.y:312
+//line thrift.y:458
 		{
 			yyVAL.function = &ast.Function{
 				Name:        yyDollar[5].str,
@@ -943,242 +1368,370 @@ yydefault:
 				ReturnType:  yyDollar[3].fieldType,
 				Exceptions:  yyDollar[9].fields,
 				OneWay:      yyDollar[2].bul,
+				Streaming:   yyDollar[3].bul,
 				Annotations: yyDollar[10].typeAnnotations,
-				Line:        yyDollar[4].line,
+				Line:        yyDollar[4].pos.Line,
+				Column:      yyDollar[4].pos.Column,
 				Doc:         ParseDocstring(yyDollar[1].docstring),
 			}
 		}
-	case 33:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:327
+//line thrift.y:475
 		{
 			yyVAL.bul = true
 		}
-	case 34:
+	case 80:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:328
+//line thrift.y:476
 		{
 			yyVAL.bul = false
 		}
-	case 35:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:332
+//line thrift.y:480
 		{
 			yyVAL.fieldType = nil
+			yyVAL.bul = false
 		}
-	case 36:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:333
+//line thrift.y:481
 		{
 			yyVAL.fieldType = yyDollar[1].fieldType
+			yyVAL.bul = false
 		}
-	case 37:
+	case 83:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line thrift.y:483
+		{
+			// stream is not a reserved keyword so that existing Thrift files
+			// which use it as a name continue to compile.
+			if yyDollar[2].str != "stream" {
+				yylex.(*lexer).errorAt(yyDollar[1].pos, fmt.Sprintf(
+					"unknown return type %s<...>: only stream<...> is supported", yyDollar[2].str))
+			}
+			yyVAL.fieldType = yyDollar[4].fieldType
+			yyVAL.bul = true
+		}
+	case 84:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:337
+//line thrift.y:496
 		{
 			yyVAL.fields = nil
 		}
-	case 38:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:338
+//line thrift.y:497
 		{
 			yyVAL.fields = yyDollar[3].fields
 		}
-	case 39:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:347
+//line thrift.y:506
 		{
-			yyVAL.fieldType = ast.BaseType{ID: yyDollar[2].baseTypeID, Annotations: yyDollar[3].typeAnnotations, Line: yyDollar[1].line}
+			yyVAL.fieldType = ast.BaseType{ID: yyDollar[2].baseTypeID, Annotations: yyDollar[3].typeAnnotations, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 40:
+	case 87:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line thrift.y:351
+//line thrift.y:510
 		{
-			yyVAL.fieldType = ast.MapType{KeyType: yyDollar[4].fieldType, ValueType: yyDollar[6].fieldType, Annotations: yyDollar[8].typeAnnotations, Line: yyDollar[1].line}
+			yyVAL.fieldType = ast.MapType{KeyType: yyDollar[4].fieldType, ValueType: yyDollar[6].fieldType, Annotations: yyDollar[8].typeAnnotations, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 41:
+	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line thrift.y:353
+//line thrift.y:512
 		{
-			yyVAL.fieldType = ast.ListType{ValueType: yyDollar[4].fieldType, Annotations: yyDollar[6].typeAnnotations, Line: yyDollar[1].line}
+			yyVAL.fieldType = ast.ListType{ValueType: yyDollar[4].fieldType, Annotations: yyDollar[6].typeAnnotations, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 42:
+	case 89:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line thrift.y:355
+//line thrift.y:514
 		{
-			yyVAL.fieldType = ast.SetType{ValueType: yyDollar[4].fieldType, Annotations: yyDollar[6].typeAnnotations, Line: yyDollar[1].line}
+			yyVAL.fieldType = ast.SetType{ValueType: yyDollar[4].fieldType, Annotations: yyDollar[6].typeAnnotations, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 43:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line thrift.y:357
+//line thrift.y:516
 		{
-			yyVAL.fieldType = ast.TypeReference{Name: yyDollar[2].str, Line: yyDollar[1].line}
+			yyVAL.fieldType = ast.TypeReference{Name: yyDollar[2].str, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 44:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:361
+//line thrift.y:520
 		{
 			yyVAL.baseTypeID = ast.BoolTypeID
 		}
-	case 45:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:362
+//line thrift.y:521
 		{
 			yyVAL.baseTypeID = ast.I8TypeID
 		}
-	case 46:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:363
+//line thrift.y:522
 		{
 			yyVAL.baseTypeID = ast.I8TypeID
 		}
-	case 47:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:364
+//line thrift.y:523
 		{
 			yyVAL.baseTypeID = ast.I16TypeID
 		}
-	case 48:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:365
+//line thrift.y:524
 		{
 			yyVAL.baseTypeID = ast.I32TypeID
 		}
-	case 49:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:366
+//line thrift.y:525
 		{
 			yyVAL.baseTypeID = ast.I64TypeID
 		}
-	case 50:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:367
+//line thrift.y:526
 		{
 			yyVAL.baseTypeID = ast.DoubleTypeID
 		}
-	case 51:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:368
+//line thrift.y:527
 		{
 			yyVAL.baseTypeID = ast.StringTypeID
 		}
-	case 52:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:369
+//line thrift.y:528
 		{
 			yyVAL.baseTypeID = ast.BinaryTypeID
 		}
-	case 53:
+	case 100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:529
+		{
+			yyVAL.baseTypeID = ast.SlistTypeID
+		}
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:377
+//line thrift.y:537
 		{
 			yyVAL.constantValue = ast.ConstantInteger(yyDollar[1].i64)
 		}
-	case 54:
+	case 102:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:538
+		{
+			yyVAL.constantValue = ast.ConstantBigInteger{Value: yyDollar[1].bigint}
+		}
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:378
+//line thrift.y:539
 		{
 			yyVAL.constantValue = ast.ConstantDouble(yyDollar[1].dub)
 		}
-	case 55:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:379
+//line thrift.y:540
 		{
 			yyVAL.constantValue = ast.ConstantBoolean(true)
 		}
-	case 56:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:380
+//line thrift.y:541
 		{
 			yyVAL.constantValue = ast.ConstantBoolean(false)
 		}
-	case 57:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line thrift.y:381
+//line thrift.y:542
 		{
 			yyVAL.constantValue = ast.ConstantString(yyDollar[1].str)
 		}
-	case 58:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line thrift.y:383
+//line thrift.y:544
 		{
-			yyVAL.constantValue = ast.ConstantReference{Name: yyDollar[2].str, Line: yyDollar[1].line}
+			yyVAL.constantValue = ast.ConstantReference{Name: yyDollar[2].str, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 59:
+	case 108:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:385
+//line thrift.y:546
 		{
-			yyVAL.constantValue = ast.ConstantList{Items: yyDollar[3].constantValues, Line: yyDollar[1].line}
+			yyVAL.constantValue = ast.ConstantList{Items: yyDollar[3].constantValues, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 60:
+	case 109:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:386
+//line thrift.y:547
 		{
-			yyVAL.constantValue = ast.ConstantMap{Items: yyDollar[3].constantMapItems, Line: yyDollar[1].line}
+			yyVAL.constantValue = ast.ConstantMap{Items: yyDollar[3].constantMapItems, Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}
 		}
-	case 61:
+	case 110:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:390
+//line thrift.y:551
 		{
 			yyVAL.constantValues = nil
 		}
-	case 62:
+	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:392
+//line thrift.y:553
 		{
 			yyVAL.constantValues = append(yyDollar[1].constantValues, yyDollar[2].constantValue)
 		}
-	case 63:
+	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:396
+//line thrift.y:557
 		{
 			yyVAL.constantMapItems = nil
 		}
-	case 64:
+	case 113:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line thrift.y:398
+//line thrift.y:559
 		{
-			yyVAL.constantMapItems = append(yyDollar[1].constantMapItems, ast.ConstantMapItem{Key: yyDollar[3].constantValue, Value: yyDollar[5].constantValue, Line: yyDollar[2].line})
+			yyVAL.constantMapItems = append(yyDollar[1].constantMapItems, ast.ConstantMapItem{Key: yyDollar[3].constantValue, Value: yyDollar[5].constantValue, Line: yyDollar[2].pos.Line, Column: yyDollar[2].pos.Column})
 		}
-	case 65:
+	case 114:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:406
+//line thrift.y:567
 		{
 			yyVAL.typeAnnotations = nil
 		}
-	case 66:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line thrift.y:407
+//line thrift.y:568
 		{
 			yyVAL.typeAnnotations = yyDollar[2].typeAnnotations
 		}
-	case 67:
+	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:411
+//line thrift.y:572
 		{
 			yyVAL.typeAnnotations = nil
 		}
-	case 68:
+	case 117:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line thrift.y:413
+//line thrift.y:574
 		{
-			yyVAL.typeAnnotations = append(yyDollar[1].typeAnnotations, &ast.Annotation{Name: yyDollar[3].str, Value: yyDollar[5].str, Line: yyDollar[2].line})
+			yyVAL.typeAnnotations = append(yyDollar[1].typeAnnotations, &ast.Annotation{Name: yyDollar[3].str, Value: yyDollar[5].str, Line: yyDollar[2].pos.Line, Column: yyDollar[2].pos.Column})
 		}
-	case 69:
+	case 118:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line thrift.y:415
+//line thrift.y:576
 		{
-			yyVAL.typeAnnotations = append(yyDollar[1].typeAnnotations, &ast.Annotation{Name: yyDollar[3].str, Line: yyDollar[2].line})
+			yyVAL.typeAnnotations = append(yyDollar[1].typeAnnotations, &ast.Annotation{Name: yyDollar[3].str, Line: yyDollar[2].pos.Line, Column: yyDollar[2].pos.Column})
 		}
-	case 70:
+	case 119:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:582
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:583
+		{
+			yyVAL.str = "xsd_all"
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:584
+		{
+			yyVAL.str = "xsd_optional"
+		}
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:585
+		{
+			yyVAL.str = "xsd_nillable"
+		}
+	case 123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line thrift.y:586
+		{
+			yyVAL.str = "xsd_attrs"
+		}
+	case 124:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:432
+//line thrift.y:598
 		{
-			yyVAL.line = yylex.(*lexer).line
+			yyVAL.typeAnnotations = nil
 		}
-	case 71:
+	case 125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:600
+		{
+			yyVAL.typeAnnotations = []*ast.Annotation{{Name: "xsd_all", Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}}
+		}
+	case 126:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line thrift.y:605
+		{
+			yyVAL.typeAnnotations = append(append(yyDollar[1].typeAnnotations, yyDollar[2].typeAnnotations...), yyDollar[3].typeAnnotations...)
+		}
+	case 127:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line thrift.y:436
+//line thrift.y:609
+		{
+			yyVAL.typeAnnotations = nil
+		}
+	case 128:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:611
+		{
+			yyVAL.typeAnnotations = []*ast.Annotation{{Name: "xsd_optional", Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}}
+		}
+	case 129:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line thrift.y:615
+		{
+			yyVAL.typeAnnotations = nil
+		}
+	case 130:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:617
+		{
+			yyVAL.typeAnnotations = []*ast.Annotation{{Name: "xsd_nillable", Line: yyDollar[1].pos.Line, Column: yyDollar[1].pos.Column}}
+		}
+	case 131:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line thrift.y:623
+		{
+			yyVAL.typeAnnotations = nil
+		}
+	case 132:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line thrift.y:625
+		{
+			names := make([]string, len(yyDollar[4].fields))
+			for i, f := range yyDollar[4].fields {
+				names[i] = f.Name
+			}
+			yyVAL.typeAnnotations = []*ast.Annotation{{
+				Name:   "xsd_attrs",
+				Value:  strings.Join(names, ","),
+				Line:   yyDollar[1].pos.Line,
+				Column: yyDollar[1].pos.Column,
+			}}
+		}
+	case 133:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line thrift.y:654
+		{
+			// The parser may reduce this rule before it has read the token
+			// that follows. Read it now so that we get the position of that
+			// token rather than the one before it.
+			if yyrcvr.char < 0 {
+				yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+			}
+			yyVAL.pos = yyrcvr.lval.pos
+		}
+	case 134:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line thrift.y:666
 		{
 			yyVAL.docstring = yylex.(*lexer).LastDocstring()
 		}
@@ -24,7 +24,70 @@ package idl
 import "go.uber.org/thriftrw/ast"
 import "go.uber.org/thriftrw/idl/internal"
 
+// Config configures the Thrift parser.
+type Config struct {
+	// Comments specifies whether the comments in the document should be
+	// recorded in the Comments field of the parsed Program.
+	Comments bool
+
+	// KeywordFieldNames allows Thrift keywords like required and optional
+	// to be used as the names of struct fields and function parameters.
+	// Documents which do so are rejected by default.
+	KeywordFieldNames bool
+}
+
 // Parse parses a Thrift document.
 func Parse(s []byte) (*ast.Program, error) {
-	return internal.Parse(s)
+	return (&Config{}).Parse(s)
+}
+
+// Parse parses a Thrift document with this configuration.
+//
+// A *ParseError is returned if the document is not valid Thrift.
+func (c *Config) Parse(s []byte) (*ast.Program, error) {
+	prog, err := internal.Parse(s, internal.Options{
+		KeywordFieldNames: c.KeywordFieldNames,
+	})
+	if pe, ok := err.(internal.ParseError); ok {
+		return nil, newParseError(pe)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if c.Comments {
+		prog.Comments = internal.Comments(s)
+	}
+	return prog, nil
+}
+
+// ParseError is returned by Parse if the Thrift document could not be
+// parsed. Its message lists all problems found along with the offending
+// lines.
+type ParseError struct {
+	// Errors found in the document, ordered by their positions.
+	Errors []Error
+
+	msg string
+}
+
+// Error is a single problem found while parsing a Thrift document.
+type Error struct {
+	// Position in the document at which the problem was found.
+	Pos ast.Position
+
+	// Message describing the problem.
+	Message string
+}
+
+func newParseError(pe internal.ParseError) *ParseError {
+	msgs := pe.Sorted()
+	errs := make([]Error, len(msgs))
+	for i, m := range msgs {
+		errs[i] = Error{Pos: m.Pos, Message: m.Msg}
+	}
+	return &ParseError{Errors: errs, msg: pe.Error()}
+}
+
+func (e *ParseError) Error() string {
+	return e.msg
 }
@@ -21,6 +21,9 @@
 package idl
 
 import (
+	"fmt"
+	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -28,6 +31,7 @@ import (
 
 	"github.com/kr/pretty"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type parseCase struct {
@@ -64,6 +68,27 @@ func TestParseComments(t *testing.T) {
 	assert.NoError(t, err, "Failed to parse:\n%s", s)
 }
 
+func TestParseRecordComments(t *testing.T) {
+	s := "// foo\n/** bar */\nstruct Foo {} # baz\n"
+
+	program, err := (&Config{Comments: true}).Parse([]byte(s))
+	if assert.NoError(t, err, "Failed to parse:\n%s", s) {
+		assert.Equal(t, []*Comment{
+			{Text: "// foo", Line: 1, Column: 1},
+			{Text: "/** bar */", Line: 2, Column: 1},
+			{Text: "# baz", Line: 3, Column: 15},
+		}, program.Comments)
+	}
+
+	program, err = Parse([]byte(s))
+	if assert.NoError(t, err, "Failed to parse:\n%s", s) {
+		assert.Nil(t, program.Comments)
+	}
+
+	_, err = (&Config{Comments: true}).Parse([]byte("struct {"))
+	assert.Error(t, err)
+}
+
 func TestParseOrphanDocstring(t *testing.T) {
 	tests := []parseCase{
 		{
@@ -76,10 +101,11 @@ func TestParseOrphanDocstring(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Constant{
-					Name:  "bar",
-					Type:  BaseType{ID: StringTypeID, Line: 6},
-					Value: ConstantString(`b`),
-					Line:  6,
+					Name:   "bar",
+					Type:   BaseType{ID: StringTypeID, Line: 6, Column: 11},
+					Value:  ConstantString(`b`),
+					Line:   6,
+					Column: 5,
 				},
 			}},
 		},
@@ -95,58 +121,110 @@ func TestParseErrors(t *testing.T) {
 	}{
 		{
 			give:       "namespace foo \x00",
-			wantErrors: []string{"line 1: unknown token at index 14"},
+			wantErrors: []string{"line 1:15: unknown token at index 14"},
 		},
 		{
 			give:       `const string 42 = "foo"`,
-			wantErrors: []string{"line 1:", "unexpected INTCONSTANT, expecting IDENTIFIER"},
+			wantErrors: []string{"line 1:14:", "unexpected INTCONSTANT, expecting IDENTIFIER"},
 		},
 		{
 			give:       `typedef foo bar baz`,
-			wantErrors: []string{"line 1:", "unexpected IDENTIFIER"},
+			wantErrors: []string{"line 1:17:", "unexpected IDENTIFIER"},
 		},
 		{
 			give:       `typedef foo`,
-			wantErrors: []string{"line 1:", "unexpected $end"},
+			wantErrors: []string{"line 1:12:", "unexpected $end"},
 		},
 		{
 			give:       `enum Foo {`,
-			wantErrors: []string{"line 1:", "unexpected $end"},
+			wantErrors: []string{"line 1:11:", "unexpected $end"},
+		},
+		{
+			give:       "const string foo = `bar",
+			wantErrors: []string{"line 1:24:", "unexpected $end"},
+		},
+		{
+			give:       `const i64 foo = 0b102`,
+			wantErrors: []string{"line 1:21:", "unexpected INTCONSTANT"},
+		},
+		{
+			give:       `struct Foo { 0x10000000000000000: string bar }`,
+			wantErrors: []string{"line 1:14:", "unexpected BIGINTCONSTANT"},
+		},
+		{
+			give:       `senum Foo { 42 }`,
+			wantErrors: []string{"line 1:13:", "unexpected INTCONSTANT"},
+		},
+		{
+			give:       `struct senum {}`,
+			wantErrors: []string{"line 1:8:", "unexpected SENUM, expecting IDENTIFIER"},
 		},
 		{
 			give:       `enum { }`,
-			wantErrors: []string{"line 1:", "unexpected '{'"},
+			wantErrors: []string{"line 1:6:", "unexpected '{'"},
 		},
 		{
 			give: `
 				enum Foo {}
 				include "bar.thrift"
 			`,
-			wantErrors: []string{"line 3:", "unexpected INCLUDE"},
+			wantErrors: []string{"line 3:5:", "unexpected INCLUDE"},
+		},
+		{
+			give:       `include "bar.thrift" named bar`,
+			wantErrors: []string{"line 1:22:", "unexpected IDENTIFIER"},
+		},
+		{
+			give:       `include "bar.thrift" as`,
+			wantErrors: []string{"line 1:24:", "unexpected $end"},
+		},
+		{
+			give:       `struct as {}`,
+			wantErrors: []string{"line 1:8:", "unexpected AS"},
 		},
 		{
 			give:       `service Foo extends {}`,
-			wantErrors: []string{"line 1:", "unexpected '{'"},
+			wantErrors: []string{"line 1:21:", "unexpected '{'"},
 		},
 		{
 			give:       `service Foo Bar {}`,
-			wantErrors: []string{"line 1:", "unexpected IDENTIFIER"},
+			wantErrors: []string{"line 1:13:", "unexpected IDENTIFIER"},
 		},
 		{
 			give:       `service Foo { void foo() () (foo = "bar") }`,
-			wantErrors: []string{"line 1:", "unexpected '('"},
+			wantErrors: []string{"line 1:29:", "unexpected '('"},
 		},
 		{
 			give:       `service Foo { void foo() throws }`,
-			wantErrors: []string{"line 1:", "unexpected '}'"},
+			wantErrors: []string{"line 1:33:", "unexpected '}'"},
+		},
+		{
+			give:       `service Foo { future<i32> foo() }`,
+			wantErrors: []string{"line 1:15:", "unknown return type future<...>: only stream<...> is supported"},
+		},
+		{
+			give:       `service Foo { stream<void> foo() }`,
+			wantErrors: []string{"line 1:22:", "unexpected VOID"},
 		},
 		{
 			give:       `typedef string (foo =) UUID`,
-			wantErrors: []string{"line 1:", "unexpected ')'"},
+			wantErrors: []string{"line 1:22:", "unexpected ')'"},
 		},
 		{
 			give:       `union Operation { 1: Insert insert; 2: Delete delete }`,
-			wantErrors: []string{"line 1:", `"delete" is a reserved keyword`},
+			wantErrors: []string{"line 1:47:", `"delete" is a reserved keyword`},
+		},
+		{
+			give:       `struct Foo { 1: optional string required }`,
+			wantErrors: []string{"line 1:33:", `"required" is a keyword and cannot be used as a field name`},
+		},
+		{
+			give:       `struct Foo { 1: string xsd_optional }`,
+			wantErrors: []string{"line 1:24:", `"xsd_optional" is a keyword and cannot be used as a field name`},
+		},
+		{
+			give:       `struct Foo { 1: string bar xsd_nillable xsd_optional }`,
+			wantErrors: []string{"line 1:41:", "unexpected XSD_OPTIONAL"},
 		},
 	}
 
@@ -160,16 +238,54 @@ func TestParseErrors(t *testing.T) {
 	}
 }
 
+func TestParseErrorSnippet(t *testing.T) {
+	_, err := Parse([]byte(
+		"struct Foo {\n" +
+			"\t1: required string foo\n" +
+			"\t2: optional i32 delete\n" +
+			"}\n",
+	))
+	if assert.Error(t, err) {
+		assert.Equal(t, "parse error\n"+
+			"  line 3:18: \"delete\" is a reserved keyword\n"+
+			"    3 | \t2: optional i32 delete\n"+
+			"      | \t                ^\n"+
+			"  line 4:1: syntax error: unexpected $end\n"+
+			"    4 | }\n"+
+			"      | ^\n",
+			err.Error())
+	}
+}
+
+func TestParseErrorPositions(t *testing.T) {
+	_, err := Parse([]byte(
+		"struct Foo {\n" +
+			"\t1: required string foo\n" +
+			"\t2: optional i32 delete\n" +
+			"}\n",
+	))
+
+	pe, ok := err.(*ParseError)
+	if assert.True(t, ok, "expected a *ParseError, got %T", err) {
+		assert.Equal(t, []Error{
+			{Pos: Position{Line: 3, Column: 18}, Message: `"delete" is a reserved keyword`},
+			{Pos: Position{Line: 4, Column: 1}, Message: "syntax error: unexpected $end"},
+		}, pe.Errors)
+	}
+}
+
 func TestParseHeaders(t *testing.T) {
 	tests := []parseCase{
 		{
 			`
 				include "foo.thrift"
 				include t "bar.thrift"
+				include "./common/bar.thrift" as common
 			`,
 			&Program{Headers: []Header{
-				&Include{Path: "foo.thrift", Line: 2},
-				&Include{Path: "bar.thrift", Name: "t", Line: 3},
+				&Include{Path: "foo.thrift", Line: 2, Column: 5},
+				&Include{Path: "bar.thrift", Name: "t", Line: 3, Column: 5},
+				&Include{Path: "./common/bar.thrift", Name: "common", Line: 4, Column: 5},
 			}},
 		},
 		{
@@ -178,10 +294,30 @@ func TestParseHeaders(t *testing.T) {
 				namespace * foo
 			`,
 			&Program{Headers: []Header{
-				&Namespace{Scope: "py", Name: "bar", Line: 2},
-				&Namespace{Scope: "*", Name: "foo", Line: 3},
+				&Namespace{Scope: "py", Name: "bar", Line: 2, Column: 5},
+				&Namespace{Scope: "*", Name: "foo", Line: 3, Column: 5},
 			}},
 		},
+		{
+			`
+				namespace go foo.bar (go.package = "example.com/foo/bar")
+				namespace * foo ()
+			`,
+			&Program{
+				Headers: []Header{
+					&Namespace{
+						Scope: "go",
+						Name:  "foo.bar",
+						Annotations: []*Annotation{
+							{Name: "go.package", Value: "example.com/foo/bar", Line: 2, Column: 27},
+						},
+						Line:   2,
+						Column: 5,
+					},
+					&Namespace{Scope: "*", Name: "foo", Line: 3, Column: 5},
+				},
+			},
+		},
 		{
 			`
 				// defines shared types
@@ -198,10 +334,10 @@ func TestParseHeaders(t *testing.T) {
 			`,
 			&Program{
 				Headers: []Header{
-					&Include{Path: "shared.thrift", Line: 3},
-					&Namespace{Scope: "go", Name: "foo_service", Line: 4},
-					&Include{Path: "errors.thrift", Line: 9},
-					&Namespace{Scope: "py", Name: "services.foo", Line: 12},
+					&Include{Path: "shared.thrift", Line: 3, Column: 5},
+					&Namespace{Scope: "go", Name: "foo_service", Line: 4, Column: 5},
+					&Include{Path: "errors.thrift", Line: 9, Column: 5},
+					&Namespace{Scope: "py", Name: "services.foo", Line: 12, Column: 5},
 				},
 			},
 		},
@@ -225,37 +361,43 @@ func TestParseConstants(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Constant{
-					Name:  "foo",
-					Type:  BaseType{ID: I32TypeID, Line: 2},
-					Value: ConstantInteger(42),
-					Line:  2,
+					Name:   "foo",
+					Type:   BaseType{ID: I32TypeID, Line: 2, Column: 11},
+					Value:  ConstantInteger(42),
+					Line:   2,
+					Column: 5,
 				},
 				&Constant{
 					Name: "bar",
-					Type: BaseType{ID: I64TypeID, Line: 3},
+					Type: BaseType{ID: I64TypeID, Line: 3, Column: 11},
 					Value: ConstantReference{
-						Name: "shared.baz",
-						Line: 3,
+						Name:   "shared.baz",
+						Line:   3,
+						Column: 21,
 					},
-					Line: 3,
+					Line:   3,
+					Column: 5,
 				},
 				&Constant{
-					Name:  "baz",
-					Type:  BaseType{ID: StringTypeID, Line: 5},
-					Value: ConstantString("hello world"),
-					Line:  5,
+					Name:   "baz",
+					Type:   BaseType{ID: StringTypeID, Line: 5, Column: 11},
+					Value:  ConstantString("hello world"),
+					Line:   5,
+					Column: 5,
 				},
 				&Constant{
-					Name:  "qux",
-					Type:  BaseType{ID: DoubleTypeID, Line: 7},
-					Value: ConstantDouble(3.141592),
-					Line:  7,
+					Name:   "qux",
+					Type:   BaseType{ID: DoubleTypeID, Line: 7, Column: 11},
+					Value:  ConstantDouble(3.141592),
+					Line:   7,
+					Column: 5,
 				},
 				&Constant{
-					Name:  "def_",
-					Type:  BaseType{ID: DoubleTypeID, Line: 10},
-					Value: ConstantDouble(1.23),
-					Line:  10,
+					Name:   "def_",
+					Type:   BaseType{ID: DoubleTypeID, Line: 10, Column: 11},
+					Value:  ConstantDouble(1.23),
+					Line:   10,
+					Column: 5,
 				},
 			}},
 		},
@@ -266,20 +408,23 @@ func TestParseConstants(t *testing.T) {
 				&Constant{
 					Name: "baz",
 					Type: BaseType{
-						ID:   BoolTypeID,
-						Line: 1,
+						ID:     BoolTypeID,
+						Line:   1,
+						Column: 7,
 						Annotations: []*Annotation{
-							{Name: "foo", Value: "a\nb", Line: 1},
+							{Name: "foo", Value: "a\nb", Line: 1, Column: 13},
 						},
 					},
-					Value: ConstantBoolean(true),
-					Line:  1,
+					Value:  ConstantBoolean(true),
+					Line:   1,
+					Column: 1,
 				},
 				&Constant{
-					Name:  "include_something",
-					Type:  BaseType{ID: BoolTypeID, Line: 2},
-					Value: ConstantBoolean(false),
-					Line:  2,
+					Name:   "include_something",
+					Type:   BaseType{ID: BoolTypeID, Line: 2, Column: 11},
+					Value:  ConstantBoolean(false),
+					Line:   2,
+					Column: 5,
 				},
 			}},
 		},
@@ -303,43 +448,51 @@ func TestParseConstants(t *testing.T) {
 					Name: "stuff",
 					Type: MapType{
 						KeyType: BaseType{
-							ID:   StringTypeID,
-							Line: 2,
+							ID:     StringTypeID,
+							Line:   2,
+							Column: 15,
 							Annotations: []*Annotation{
-								{Name: "foo", Value: "", Line: 2},
+								{Name: "foo", Value: "", Line: 2, Column: 23},
 							},
 						},
-						ValueType: BaseType{ID: I32TypeID, Line: 2},
+						ValueType: BaseType{ID: I32TypeID, Line: 2, Column: 29},
 						Line:      2,
+						Column:    11,
 						Annotations: []*Annotation{
-							{Name: "baz", Value: "qux", Line: 2},
+							{Name: "baz", Value: "qux", Line: 2, Column: 35},
 						},
 					},
 					Value: ConstantMap{
 						Items: []ConstantMapItem{
 							{
-								Key:   ConstantString("a"),
-								Value: ConstantInteger(1),
-								Line:  3,
+								Key:    ConstantString("a"),
+								Value:  ConstantInteger(1),
+								Line:   3,
+								Column: 6,
 							},
 							{
-								Key:   ConstantString("b"),
-								Value: ConstantInteger(2),
-								Line:  4,
+								Key:    ConstantString("b"),
+								Value:  ConstantInteger(2),
+								Line:   4,
+								Column: 6,
 							},
 						},
-						Line: 2,
+						Line:   2,
+						Column: 56,
 					},
-					Line: 2,
+					Line:   2,
+					Column: 5,
 				},
 				&Constant{
 					Name: "list_of_lists",
 					Type: ListType{
 						ValueType: ListType{
-							ValueType: BaseType{ID: I32TypeID, Line: 6},
+							ValueType: BaseType{ID: I32TypeID, Line: 6, Column: 21},
 							Line:      6,
+							Column:    16,
 						},
-						Line: 6,
+						Line:   6,
+						Column: 11,
 					},
 					Value: ConstantList{
 						Items: []ConstantValue{
@@ -349,7 +502,8 @@ func TestParseConstants(t *testing.T) {
 									ConstantInteger(2),
 									ConstantInteger(3),
 								},
-								Line: 7,
+								Line:   7,
+								Column: 6,
 							},
 							ConstantList{
 								Items: []ConstantValue{
@@ -357,32 +511,39 @@ func TestParseConstants(t *testing.T) {
 									ConstantInteger(5),
 									ConstantInteger(6),
 								},
-								Line: 8,
+								Line:   8,
+								Column: 6,
 							},
 						},
-						Line: 6,
+						Line:   6,
+						Column: 43,
 					},
-					Line: 6,
+					Line:   6,
+					Column: 5,
 				},
 				&Constant{
 					Name: "const_struct",
-					Type: TypeReference{Name: "Item", Line: 10},
+					Type: TypeReference{Name: "Item", Line: 10, Column: 11},
 					Value: ConstantMap{
 						Items: []ConstantMapItem{
 							{
-								Key:   ConstantString("key"),
-								Value: ConstantString("foo"),
-								Line:  11,
+								Key:    ConstantString("key"),
+								Value:  ConstantString("foo"),
+								Line:   11,
+								Column: 6,
 							},
 							{
-								Key:   ConstantString("value"),
-								Value: ConstantInteger(42),
-								Line:  12,
+								Key:    ConstantString("value"),
+								Value:  ConstantInteger(42),
+								Line:   12,
+								Column: 6,
 							},
 						},
-						Line: 10,
+						Line:   10,
+						Column: 31,
 					},
-					Line: 10,
+					Line:   10,
+					Column: 5,
 				},
 			}},
 		},
@@ -393,16 +554,134 @@ func TestParseConstants(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Constant{
-					Name:  "foo",
-					Type:  BaseType{ID: StringTypeID, Line: 2},
-					Value: ConstantString(`a "b" c`),
-					Line:  2,
+					Name:   "foo",
+					Type:   BaseType{ID: StringTypeID, Line: 2, Column: 11},
+					Value:  ConstantString(`a "b" c`),
+					Line:   2,
+					Column: 5,
 				},
 				&Constant{
-					Name:  "bar",
-					Type:  BaseType{ID: StringTypeID, Line: 3},
-					Value: ConstantString(`a 'b' c`),
-					Line:  3,
+					Name:   "bar",
+					Type:   BaseType{ID: StringTypeID, Line: 3, Column: 11},
+					Value:  ConstantString(`a 'b' c`),
+					Line:   3,
+					Column: 5,
+				},
+			}},
+		},
+		{
+			`
+				const i64 hex = 0x2a
+				const i64 oct = 0o52
+				const i64 bin = 0b101010
+				const i64 zero = 0
+				const i64 u64 = 0xffffffffffffffff
+				const i64 neg = -9223372036854775809
+			`,
+			&Program{Definitions: []Definition{
+				&Constant{
+					Name:   "hex",
+					Type:   BaseType{ID: I64TypeID, Line: 2, Column: 11},
+					Value:  ConstantInteger(42),
+					Line:   2,
+					Column: 5,
+				},
+				&Constant{
+					Name:   "oct",
+					Type:   BaseType{ID: I64TypeID, Line: 3, Column: 11},
+					Value:  ConstantInteger(42),
+					Line:   3,
+					Column: 5,
+				},
+				&Constant{
+					Name:   "bin",
+					Type:   BaseType{ID: I64TypeID, Line: 4, Column: 11},
+					Value:  ConstantInteger(42),
+					Line:   4,
+					Column: 5,
+				},
+				&Constant{
+					Name:   "zero",
+					Type:   BaseType{ID: I64TypeID, Line: 5, Column: 11},
+					Value:  ConstantInteger(0),
+					Line:   5,
+					Column: 5,
+				},
+				&Constant{
+					Name:   "u64",
+					Type:   BaseType{ID: I64TypeID, Line: 6, Column: 11},
+					Value:  ConstantBigInteger{Value: new(big.Int).SetUint64(math.MaxUint64)},
+					Line:   6,
+					Column: 5,
+				},
+				&Constant{
+					Name: "neg",
+					Type: BaseType{ID: I64TypeID, Line: 7, Column: 11},
+					Value: ConstantBigInteger{
+						Value: new(big.Int).Sub(big.NewInt(math.MinInt64), big.NewInt(1)),
+					},
+					Line:   7,
+					Column: 5,
+				},
+			}},
+		},
+		{
+			`
+				const i32 nhex = -0x1F
+				const double e = 1e10
+				const double ne = -1E-3
+			`,
+			&Program{Definitions: []Definition{
+				&Constant{
+					Name:   "nhex",
+					Type:   BaseType{ID: I32TypeID, Line: 2, Column: 11},
+					Value:  ConstantInteger(-31),
+					Line:   2,
+					Column: 5,
+				},
+				&Constant{
+					Name:   "e",
+					Type:   BaseType{ID: DoubleTypeID, Line: 3, Column: 11},
+					Value:  ConstantDouble(1e10),
+					Line:   3,
+					Column: 5,
+				},
+				&Constant{
+					Name:   "ne",
+					Type:   BaseType{ID: DoubleTypeID, Line: 4, Column: 11},
+					Value:  ConstantDouble(-1e-3),
+					Line:   4,
+					Column: 5,
+				},
+			}},
+		},
+		{
+			"\n" +
+				"const string foo = `a \"b\" 'c' \\n`\n" +
+				"const string bar = `line 1\n" +
+				"line 2`\n" +
+				"const string baz = ``\n",
+			&Program{Definitions: []Definition{
+				&Constant{
+					Name:   "foo",
+					Type:   BaseType{ID: StringTypeID, Line: 2, Column: 7},
+					Value:  ConstantString(`a "b" 'c' \n`),
+					Line:   2,
+					Column: 1,
+				},
+				&Constant{
+					Name:   "bar",
+					Type:   BaseType{ID: StringTypeID, Line: 3, Column: 7},
+					Value:  ConstantString("line 1\nline 2"),
+					Line:   3,
+					Column: 1,
+				},
+				&Constant{
+					Name:   "baz",
+					Type:   BaseType{ID: StringTypeID, Line: 5, Column: 7},
+					Value:  ConstantString(""),
+					Line:   5,
+					Column: 1,
 				},
 			}},
 		},
@@ -415,11 +694,12 @@ func TestParseConstants(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Constant{
-					Name:  "foo",
-					Type:  BaseType{ID: StringTypeID, Line: 5},
-					Value: ConstantString(`a`),
-					Doc:   "foo does stuff",
-					Line:  5,
+					Name:   "foo",
+					Type:   BaseType{ID: StringTypeID, Line: 5, Column: 11},
+					Value:  ConstantString(`a`),
+					Doc:    "foo does stuff",
+					Line:   5,
+					Column: 5,
 				},
 			}},
 		},
@@ -446,46 +726,54 @@ func TestParseTypedef(t *testing.T) {
 			&Program{Definitions: []Definition{
 				&Typedef{
 					Name: "UUID",
-					Type: BaseType{ID: StringTypeID, Line: 2},
+					Type: BaseType{ID: StringTypeID, Line: 2, Column: 13},
 					Annotations: []*Annotation{
 						{
-							Name:  "length",
-							Value: "32",
-							Line:  2,
+							Name:   "length",
+							Value:  "32",
+							Line:   2,
+							Column: 26,
 						},
 					},
-					Line: 2,
+					Line:   2,
+					Column: 5,
 				},
 				&Typedef{
 					Name: "Date",
 					Type: BaseType{
-						ID:   I64TypeID,
-						Line: 4,
+						ID:     I64TypeID,
+						Line:   4,
+						Column: 13,
 						Annotations: []*Annotation{
 							{
-								Name:  "js.type",
-								Value: "Date",
-								Line:  4,
+								Name:   "js.type",
+								Value:  "Date",
+								Line:   4,
+								Column: 18,
 							},
 						},
 					},
-					Line: 4,
+					Line:   4,
+					Column: 5,
 				},
 				&Typedef{
-					Name: "foo",
-					Type: BaseType{ID: I8TypeID, Line: 6},
-					Line: 6,
+					Name:   "foo",
+					Type:   BaseType{ID: I8TypeID, Line: 6, Column: 13},
+					Line:   6,
+					Column: 5,
 				},
 				&Typedef{
-					Name: "bar",
-					Type: BaseType{ID: I8TypeID, Line: 7},
-					Line: 7,
+					Name:   "bar",
+					Type:   BaseType{ID: I8TypeID, Line: 7, Column: 13},
+					Line:   7,
+					Column: 5,
 				},
 				&Typedef{
-					Name: "ISODate",
-					Type: BaseType{ID: StringTypeID, Line: 12},
-					Doc:  "ISODate specifies the date in ISO8601 format.",
-					Line: 12,
+					Name:   "ISODate",
+					Type:   BaseType{ID: StringTypeID, Line: 12, Column: 13},
+					Doc:    "ISODate specifies the date in ISO8601 format.",
+					Line:   12,
+					Column: 5,
 				},
 			}},
 		},
@@ -504,7 +792,7 @@ func TestParseEnum(t *testing.T) {
 				{
 				}
 			`,
-			&Program{Definitions: []Definition{&Enum{Name: "EmptyEnum", Line: 2}}},
+			&Program{Definitions: []Definition{&Enum{Name: "EmptyEnum", Line: 2, Column: 5, EndLine: 4}}},
 		},
 		{
 			`
@@ -523,23 +811,27 @@ func TestParseEnum(t *testing.T) {
 							Name: "foo",
 							Annotations: []*Annotation{
 								{
-									Name:  "x",
-									Value: "",
-									Line:  3,
+									Name:   "x",
+									Value:  "",
+									Line:   3,
+									Column: 11,
 								},
 							},
-							Line: 3,
+							Line:   3,
+							Column: 6,
 						},
-						{Name: "bar", Line: 3},
-						{Name: "baz", Value: &aValue, Line: 4},
-						{Name: "qux", Line: 5},
-						{Name: "quux", Line: 6},
+						{Name: "bar", Line: 3, Column: 15},
+						{Name: "baz", Value: &aValue, Line: 4, Column: 9},
+						{Name: "qux", Line: 5, Column: 6},
+						{Name: "quux", Line: 6, Column: 6},
 					},
 					Annotations: []*Annotation{
-						{Name: "_", Value: "__", Line: 7},
-						{Name: "foo", Value: "bar", Line: 7},
+						{Name: "_", Value: "__", Line: 7, Column: 8},
+						{Name: "foo", Value: "bar", Line: 7, Column: 18},
 					},
-					Line: 2,
+					Line:    2,
+					Column:  5,
+					EndLine: 7,
 				},
 			}},
 		},
@@ -565,30 +857,36 @@ func TestParseEnum(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Enum{
-					Name: "UserRole",
-					Line: 5,
-					Doc:  "UserRole specifies the different roles a user can have.",
+					Name:    "UserRole",
+					Line:    5,
+					Column:  5,
+					EndLine: 18,
+					Doc:     "UserRole specifies the different roles a user can have.",
 					Items: []*EnumItem{
 						{
-							Name: "User",
-							Line: 7,
-							Doc:  "A regular user.",
+							Name:   "User",
+							Line:   7,
+							Column: 6,
+							Doc:    "A regular user.",
 						},
 						{
-							Name: "Moderator",
-							Line: 11,
-							Doc:  "A user with moderation privileges.",
+							Name:   "Moderator",
+							Line:   11,
+							Column: 6,
+							Doc:    "A user with moderation privileges.",
 						},
 						{
-							Name: "Admin",
-							Line: 15,
-							Doc:  "A user with administration privileges.",
+							Name:   "Admin",
+							Line:   15,
+							Column: 6,
+							Doc:    "A user with administration privileges.",
 						},
 						{
-							Name:  "Banned",
-							Line:  17,
-							Value: ptrInt(-1),
-							Doc:   "This user was banned.",
+							Name:   "Banned",
+							Line:   17,
+							Column: 6,
+							Value:  ptrInt(-1),
+							Doc:    "This user was banned.",
 						},
 					},
 				},
@@ -599,6 +897,94 @@ func TestParseEnum(t *testing.T) {
 	assertParseCases(t, tests)
 }
 
+func TestParseDeprecatedTypes(t *testing.T) {
+	tests := []parseCase{
+		{
+			`
+				/** Primary colors. */
+				senum Color {
+					"red", 'green';
+					"blue"
+				} (go.name = "Colour")
+
+				senum Empty {}
+
+				typedef slist Names
+			`,
+			&Program{Definitions: []Definition{
+				&Senum{
+					Name:   "Color",
+					Values: []string{"red", "green", "blue"},
+					Annotations: []*Annotation{
+						{Name: "go.name", Value: "Colour", Line: 6, Column: 8},
+					},
+					Line:   3,
+					Column: 5,
+					Doc:    "Primary colors.",
+				},
+				&Senum{Name: "Empty", Line: 8, Column: 5},
+				&Typedef{
+					Name:   "Names",
+					Type:   BaseType{ID: SlistTypeID, Line: 10, Column: 13},
+					Line:   10,
+					Column: 5,
+				},
+			}},
+		},
+	}
+
+	assertParseCases(t, tests)
+}
+
+func TestParseXSDAttributes(t *testing.T) {
+	s := `
+		struct Person xsd_all {
+			1: string name xsd_optional
+			2: optional i32 age = 0 xsd_optional xsd_nillable (foo = "bar")
+			3: Address address xsd_attrs {
+				1: string street
+				2: string city
+			}
+			4: string nickname (xsd_optional)
+		}
+
+		service People {
+			void add(1: Person person xsd_nillable)
+		}
+	`
+
+	program, err := Parse([]byte(s))
+	require.NoError(t, err, "Failed to parse:\n%s", s)
+	require.Len(t, program.Definitions, 2)
+
+	person := program.Definitions[0].(*Struct)
+	assert.Equal(t, []*Annotation{
+		{Name: "xsd_all", Line: 2, Column: 17},
+	}, person.Annotations)
+	require.Len(t, person.Fields, 4)
+	assert.Equal(t, []*Annotation{
+		{Name: "xsd_optional", Line: 3, Column: 19},
+	}, person.Fields[0].Annotations)
+	assert.Equal(t, ConstantInteger(0), person.Fields[1].Default)
+	assert.Equal(t, []*Annotation{
+		{Name: "xsd_optional", Line: 4, Column: 28},
+		{Name: "xsd_nillable", Line: 4, Column: 41},
+		{Name: "foo", Value: "bar", Line: 4, Column: 55},
+	}, person.Fields[1].Annotations)
+	assert.Equal(t, []*Annotation{
+		{Name: "xsd_attrs", Value: "street,city", Line: 5, Column: 23},
+	}, person.Fields[2].Annotations)
+	assert.Equal(t, []*Annotation{
+		{Name: "xsd_optional", Line: 9, Column: 24},
+	}, person.Fields[3].Annotations)
+
+	add := program.Definitions[1].(*Service).Functions[0]
+	require.Len(t, add.Parameters, 1)
+	assert.Equal(t, []*Annotation{
+		{Name: "xsd_nillable", Line: 13, Column: 30},
+	}, add.Parameters[0].Annotations)
+}
+
 func TestParseStruct(t *testing.T) {
 	tests := []parseCase{
 		{
@@ -608,9 +994,9 @@ func TestParseStruct(t *testing.T) {
 				exception EmptyExc {}
 			`,
 			&Program{Definitions: []Definition{
-				&Struct{Name: "EmptyStruct", Type: StructType, Line: 2},
-				&Struct{Name: "EmptyUnion", Type: UnionType, Line: 3},
-				&Struct{Name: "EmptyExc", Type: ExceptionType, Line: 4},
+				&Struct{Name: "EmptyStruct", Type: StructType, Line: 2, Column: 5, EndLine: 2},
+				&Struct{Name: "EmptyUnion", Type: UnionType, Line: 3, Column: 5, EndLine: 3},
+				&Struct{Name: "EmptyExc", Type: ExceptionType, Line: 4, Column: 5, EndLine: 4},
 			}},
 		},
 		{
@@ -637,26 +1023,31 @@ func TestParseStruct(t *testing.T) {
 						{
 							ID:           1,
 							Name:         "high",
-							Type:         BaseType{ID: I64TypeID, Line: 3},
+							Type:         BaseType{ID: I64TypeID, Line: 3, Column: 18},
 							Requiredness: Required,
 							Line:         3,
+							Column:       6,
 						},
 						{
 							ID:           2,
 							Name:         "low",
-							Type:         BaseType{ID: I64TypeID, Line: 4},
+							Type:         BaseType{ID: I64TypeID, Line: 4, Column: 18},
 							Requiredness: Required,
 							Line:         4,
+							Column:       6,
 						},
 					},
 					Annotations: []*Annotation{
 						{
-							Name:  "serializer",
-							Value: "Int128Serializer",
-							Line:  5,
+							Name:   "serializer",
+							Value:  "Int128Serializer",
+							Line:   5,
+							Column: 8,
 						},
 					},
-					Line: 2,
+					Line:    2,
+					Column:  5,
+					EndLine: 5,
 				},
 				&Struct{
 					Name: "Contents",
@@ -667,35 +1058,42 @@ func TestParseStruct(t *testing.T) {
 							Name:         "plainText",
 							Requiredness: Unspecified,
 							Type: BaseType{
-								ID:   StringTypeID,
-								Line: 8,
+								ID:     StringTypeID,
+								Line:   8,
+								Column: 9,
 								Annotations: []*Annotation{
 									{
-										Name:  "format",
-										Value: "markdown",
-										Line:  8,
+										Name:   "format",
+										Value:  "markdown",
+										Line:   8,
+										Column: 17,
 									},
 								},
 							},
-							Line: 8,
+							Line:   8,
+							Column: 6,
 						},
 						{
 							ID:   2,
 							Name: "pdf",
-							Type: BaseType{ID: BinaryTypeID, Line: 9},
+							Type: BaseType{ID: BinaryTypeID, Line: 9, Column: 9},
 							// Requiredness intentionally skipped because
 							// zero-value for it is Unspecified.
 							Annotations: []*Annotation{
 								{
-									Name:  "name",
-									Value: "pdfFile",
-									Line:  9,
+									Name:   "name",
+									Value:  "pdfFile",
+									Line:   9,
+									Column: 21,
 								},
 							},
-							Line: 9,
+							Line:   9,
+							Column: 6,
 						},
 					},
-					Line: 7,
+					Line:    7,
+					Column:  5,
+					EndLine: 10,
 				},
 				&Struct{
 					Name: "GreatSadness",
@@ -704,12 +1102,15 @@ func TestParseStruct(t *testing.T) {
 						{
 							ID:           1,
 							Name:         "message",
-							Type:         BaseType{ID: StringTypeID, Line: 13},
+							Type:         BaseType{ID: StringTypeID, Line: 13, Column: 18},
 							Requiredness: Optional,
 							Line:         13,
+							Column:       6,
 						},
 					},
-					Line: 12,
+					Line:    12,
+					Column:  5,
+					EndLine: 14,
 				},
 			}},
 		},
@@ -753,55 +1154,65 @@ func TestParseStruct(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Struct{
-					Name: "Comment",
-					Type: StructType,
-					Line: 5,
-					Doc:  "Comment is a comment posted on a document.",
+					Name:    "Comment",
+					Type:    StructType,
+					Line:    5,
+					Column:  5,
+					EndLine: 12,
+					Doc:     "Comment is a comment posted on a document.",
 					Fields: []*Field{
 						{
 							ID:           1,
 							Name:         "author",
 							Requiredness: Required,
-							Type:         TypeReference{Name: "User", Line: 9},
+							Type:         TypeReference{Name: "User", Line: 9, Column: 18},
 							Line:         9,
+							Column:       6,
 							Doc:          "User who posted this comment.",
 						},
 						{
 							ID:           2,
 							Name:         "body",
 							Requiredness: Required,
-							Type:         TypeReference{Name: "CommentBody", Line: 11},
+							Type:         TypeReference{Name: "CommentBody", Line: 11, Column: 18},
 							Line:         11,
+							Column:       6,
 							Doc:          "Contents of the comment.",
 						},
 					},
 				},
 				&Struct{
-					Name: "CommentBody",
-					Type: UnionType,
-					Line: 17,
-					Doc:  "CommentBody holds the contents of a comment.",
+					Name:    "CommentBody",
+					Type:    UnionType,
+					Line:    17,
+					Column:  5,
+					EndLine: 22,
+					Doc:     "CommentBody holds the contents of a comment.",
 					Fields: []*Field{
 						{
-							ID:   1,
-							Name: "plain",
-							Type: BaseType{ID: StringTypeID, Line: 19},
-							Line: 19,
-							Doc:  "Plain text comment.",
+							ID:     1,
+							Name:   "plain",
+							Type:   BaseType{ID: StringTypeID, Line: 19, Column: 9},
+							Line:   19,
+							Column: 6,
+							Doc:    "Plain text comment.",
 						},
 						{
-							ID:   2,
-							Name: "image",
-							Type: BaseType{ID: BinaryTypeID, Line: 21},
-							Line: 21,
-							Doc:  "An image was posted as a comment.",
+							ID:     2,
+							Name:   "image",
+							Type:   BaseType{ID: BinaryTypeID, Line: 21, Column: 9},
+							Line:   21,
+							Column: 6,
+							Doc:    "An image was posted as a comment.",
 						},
 					},
 				},
 				&Struct{
-					Name: "UnauthorizedError",
-					Type: ExceptionType,
-					Line: 28,
+					Name:    "UnauthorizedError",
+					Type:    ExceptionType,
+					Line:    28,
+					Column:  5,
+					EndLine: 36,
 					Doc: "Raised when a user performs an action they're not\n" +
 						"authorized to do.",
 					Fields: []*Field{
@@ -809,15 +1220,17 @@ func TestParseStruct(t *testing.T) {
 							ID:           1,
 							Name:         "user",
 							Requiredness: Optional,
-							Type:         TypeReference{Name: "User", Line: 30},
+							Type:         TypeReference{Name: "User", Line: 30, Column: 18},
 							Line:         30,
+							Column:       6,
 						},
 						{
 							ID:           2,
 							Name:         "message",
 							Requiredness: Optional,
-							Type:         BaseType{ID: StringTypeID, Line: 35},
+							Type:         BaseType{ID: StringTypeID, Line: 35, Column: 18},
 							Line:         35,
+							Column:       6,
 							Doc:          "Error message.",
 						},
 					},
@@ -835,16 +1248,19 @@ func TestParseStruct(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Struct{
-					Name: "Foo",
-					Line: 2,
-					Type: StructType,
+					Name:    "Foo",
+					Line:    2,
+					Column:  5,
+					EndLine: 7,
+					Type:    StructType,
 					Fields: []*Field{
 						{
 							ID:           1,
 							Name:         "x",
 							Requiredness: Optional,
-							Type:         BaseType{ID: StringTypeID, Line: 6},
+							Type:         BaseType{ID: StringTypeID, Line: 6, Column: 18},
 							Line:         6,
+							Column:       6,
 							Doc:          "foo",
 							Default:      ConstantString("bar"),
 						},
@@ -852,11 +1268,100 @@ func TestParseStruct(t *testing.T) {
 				},
 			}},
 		},
+		{
+			`
+				struct Tuple {
+					optional i32 a
+					2: i32 b
+					Tuple & c;
+				}
+			`,
+			&Program{Definitions: []Definition{
+				&Struct{
+					Name: "Tuple",
+					Type: StructType,
+					Fields: []*Field{
+						{
+							ID:           -1,
+							ImplicitID:   true,
+							Name:         "a",
+							Type:         BaseType{ID: I32TypeID, Line: 3, Column: 15},
+							Requiredness: Optional,
+							Line:         3,
+							Column:       6,
+						},
+						{
+							ID:     2,
+							Name:   "b",
+							Type:   BaseType{ID: I32TypeID, Line: 4, Column: 9},
+							Line:   4,
+							Column: 6,
+						},
+						{
+							ID:         -2,
+							ImplicitID: true,
+							Name:       "c",
+							Type:       TypeReference{Name: "Tuple", Line: 5, Column: 6},
+							Reference:  true,
+							Line:       5,
+							Column:     6,
+						},
+					},
+					Line:    2,
+					Column:  5,
+					EndLine: 6,
+				},
+			}},
+		},
 	}
 
 	assertParseCases(t, tests)
 }
 
+func TestParseKeywordFieldNames(t *testing.T) {
+	s := `
+		struct Foo {
+			1: required string optional = "x"
+			2: optional bool required (foo = "bar")
+			3: optional i32 i32
+		}
+
+		service Bar {
+			void baz(1: string struct) throws (1: Error exception)
+		}
+	`
+
+	program, err := (&Config{KeywordFieldNames: true}).Parse([]byte(s))
+	require.NoError(t, err, "Failed to parse:\n%s", s)
+	require.Len(t, program.Definitions, 2)
+
+	foo := program.Definitions[0].(*Struct)
+	if assert.Len(t, foo.Fields, 3) {
+		assert.Equal(t, "optional", foo.Fields[0].Name)
+		assert.Equal(t, Required, foo.Fields[0].Requiredness)
+		assert.Equal(t, ConstantString("x"), foo.Fields[0].Default)
+
+		assert.Equal(t, "required", foo.Fields[1].Name)
+		assert.Equal(t, Optional, foo.Fields[1].Requiredness)
+		assert.Len(t, foo.Fields[1].Annotations, 1)
+
+		assert.Equal(t, "i32", foo.Fields[2].Name)
+	}
+
+	baz := program.Definitions[1].(*Service).Functions[0]
+	if assert.Len(t, baz.Parameters, 1) && assert.Len(t, baz.Exceptions, 1) {
+		assert.Equal(t, "struct", baz.Parameters[0].Name)
+		assert.Equal(t, "exception", baz.Exceptions[0].Name)
+	}
+
+	_, err = Parse([]byte(s))
+	if assert.Error(t, err) {
+		for _, name := range []string{"optional", "required", "i32", "struct", "exception"} {
+			assert.Contains(t, err.Error(), fmt.Sprintf("%q is a keyword", name))
+		}
+	}
+}
+
 func TestParseServices(t *testing.T) {
 	tests := []parseCase{
 		{
@@ -866,15 +1371,18 @@ func TestParseServices(t *testing.T) {
 				service AnotherEmptyService extends EmptyService {}
 			`,
 			&Program{Definitions: []Definition{
-				&Service{Name: "EmptyService", Line: 2},
+				&Service{Name: "EmptyService", Line: 2, Column: 5, EndLine: 2},
 				&Service{
 					Name: "AnotherEmptyService",
 					Parent: &ServiceReference{
-						Name: "EmptyService",
-						Line: 4,
+						Name:   "EmptyService",
+						Line:   4,
+						Column: 41,
 					},
-					Line: 4,
-					Doc:  "AnotherEmptyService does not do anything.",
+					Line:    4,
+					Column:  5,
+					EndLine: 4,
+					Doc:     "AnotherEmptyService does not do anything.",
 				},
 			}},
 		},
@@ -902,58 +1410,132 @@ func TestParseServices(t *testing.T) {
 							Name:   "empty",
 							OneWay: true,
 							Line:   4,
+							Column: 7,
 						},
 						{
 							Name:       "something",
-							ReturnType: BaseType{ID: I32TypeID, Line: 7},
+							ReturnType: BaseType{ID: I32TypeID, Line: 7, Column: 6},
 							Exceptions: []*Field{
 								{
 									ID:   1,
 									Name: "sadness",
 									Type: TypeReference{
-										Name: "GreatSadness",
-										Line: 8,
+										Name:   "GreatSadness",
+										Line:   8,
+										Column: 19,
 									},
-									Line: 8,
+									Line:   8,
+									Column: 16,
 								},
 							},
 							OneWay: false,
 							Line:   7,
+							Column: 10,
 						},
 						{
 							Name: "somethingElse",
 							Parameters: []*Field{
 								{
-									ID:   1,
-									Name: "a",
-									Type: TypeReference{Name: "A", Line: 11},
-									Line: 11,
+									ID:     1,
+									Name:   "a",
+									Type:   TypeReference{Name: "A", Line: 11, Column: 10},
+									Line:   11,
+									Column: 7,
 								},
 								{
-									ID:   2,
-									Name: "b",
-									Type: TypeReference{Name: "B", Line: 12},
-									Line: 12,
+									ID:     2,
+									Name:   "b",
+									Type:   TypeReference{Name: "B", Line: 12, Column: 10},
+									Line:   12,
+									Column: 7,
 								},
 							},
 							Annotations: []*Annotation{
 								{
-									Name:  "py.name",
-									Value: "something_else",
-									Line:  13,
+									Name:   "py.name",
+									Value:  "something_else",
+									Line:   13,
+									Column: 9,
 								},
 							},
-							Line: 10,
+							Line:   10,
+							Column: 11,
 						},
 					},
 					Annotations: []*Annotation{
 						{
-							Name:  "ttl.milliseconds",
-							Value: "200",
-							Line:  14,
+							Name:   "ttl.milliseconds",
+							Value:  "200",
+							Line:   14,
+							Column: 8,
 						},
 					},
-					Line: 2,
+					Line:    2,
+					Column:  5,
+					EndLine: 14,
+				},
+			}},
+		},
+		{
+			`
+				typedef string stream
+
+				service Events {
+					stream<Event> subscribe(1: stream topic)
+						throws (1: GreatSadness sadness)
+
+					stream stream()
+				}
+			`,
+			&Program{Definitions: []Definition{
+				&Typedef{
+					Name:   "stream",
+					Type:   BaseType{ID: StringTypeID, Line: 2, Column: 13},
+					Line:   2,
+					Column: 5,
+				},
+				&Service{
+					Name: "Events",
+					Functions: []*Function{
+						{
+							Name:       "subscribe",
+							ReturnType: TypeReference{Name: "Event", Line: 5, Column: 13},
+							Parameters: []*Field{
+								{
+									ID:     1,
+									Name:   "topic",
+									Type:   TypeReference{Name: "stream", Line: 5, Column: 33},
+									Line:   5,
+									Column: 30,
+								},
+							},
+							Exceptions: []*Field{
+								{
+									ID:   1,
+									Name: "sadness",
+									Type: TypeReference{
+										Name:   "GreatSadness",
+										Line:   6,
+										Column: 18,
+									},
+									Line:   6,
+									Column: 15,
+								},
+							},
+							Streaming: true,
+							Line:      5,
+							Column:    20,
+						},
+						{
+							Name:       "stream",
+							ReturnType: TypeReference{Name: "stream", Line: 8, Column: 6},
+							Line:       8,
+							Column:     13,
+						},
+					},
+					Line:    4,
+					Column:  5,
+					EndLine: 9,
 				},
 			}},
 		},
@@ -986,46 +1568,53 @@ func TestParseServices(t *testing.T) {
 			`,
 			&Program{Definitions: []Definition{
 				&Service{
-					Name: "KeyValue",
-					Line: 5,
-					Doc:  "KeyValue is a key-value store.",
+					Name:    "KeyValue",
+					Line:    5,
+					Column:  5,
+					EndLine: 25,
+					Doc:     "KeyValue is a key-value store.",
 					Functions: []*Function{
 						{
 							Name:       "getValue",
 							Line:       9,
+							Column:     13,
 							Doc:        "Retrieves the value associated with the given key.",
-							ReturnType: BaseType{ID: StringTypeID, Line: 9},
+							ReturnType: BaseType{ID: StringTypeID, Line: 9, Column: 6},
 							Parameters: []*Field{
 								{
-									ID:   1,
-									Name: "key",
-									Type: BaseType{ID: StringTypeID, Line: 11},
-									Line: 11,
-									Doc:  "Name of the value.",
+									ID:     1,
+									Name:   "key",
+									Type:   BaseType{ID: StringTypeID, Line: 11, Column: 10},
+									Line:   11,
+									Column: 7,
+									Doc:    "Name of the value.",
 								},
 								{
-									ID:   2,
-									Name: "timeout",
-									Type: TypeReference{Name: "Duration", Line: 16},
-									Line: 16,
+									ID:     2,
+									Name:   "timeout",
+									Type:   TypeReference{Name: "Duration", Line: 16, Column: 10},
+									Line:   16,
+									Column: 7,
 									Doc: "Amount of time to wait while retrieving the value\n" +
 										"before giving up.",
 								},
 							},
 							Exceptions: []*Field{
 								{
-									ID:   1,
-									Name: "notFound",
-									Type: TypeReference{Name: "NotFoundException", Line: 19},
-									Line: 19,
-									Doc:  "A matching value was not found.",
+									ID:     1,
+									Name:   "notFound",
+									Type:   TypeReference{Name: "NotFoundException", Line: 19, Column: 10},
+									Line:   19,
+									Column: 7,
+									Doc:    "A matching value was not found.",
 								},
 								{
-									ID:   2,
-									Name: "timedOut",
-									Type: TypeReference{Name: "TimedOutException", Line: 23},
-									Line: 23,
-									Doc:  "The request timed out.",
+									ID:     2,
+									Name:   "timedOut",
+									Type:   TypeReference{Name: "TimedOutException", Line: 23, Column: 10},
+									Line:   23,
+									Column: 7,
+									Doc:    "The request timed out.",
 								},
 							},
 						},
@@ -0,0 +1,206 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package conformance checks how much of the Thrift files from Apache
+// Thrift's own test suite ThriftRW is able to handle.
+//
+// The files are kept in the testdata directory. Each of them is parsed,
+// compiled, and used to generate code, and the outcome is recorded in
+// REPORT.md. Run 'go generate' in this directory to update the report after
+// changing the parser, compiler, or code generator.
+//
+// The tests of this package also check the Binary, Compact, and JSON
+// protocols against hand-written payloads in testdata/payloads.
+package conformance
+
+//go:generate go run report.go
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+	"go.uber.org/thriftrw/idl"
+)
+
+// Stage is a step of processing a Thrift file.
+type Stage int
+
+// Stages which a Thrift file goes through, in order.
+const (
+	Parse Stage = iota + 1
+	Compile
+	Generate
+)
+
+func (s Stage) String() string {
+	switch s {
+	case Parse:
+		return "parse"
+	case Compile:
+		return "compile"
+	case Generate:
+		return "generate"
+	default:
+		return fmt.Sprintf("Stage(%d)", int(s))
+	}
+}
+
+// Result is the outcome of checking a single Thrift file.
+type Result struct {
+	// Name of the file inside the corpus directory.
+	File string
+
+	// Passed is the last stage the file made it through, or zero if it
+	// couldn't be parsed.
+	Passed Stage
+
+	// Error reported by the stage that failed, if any, with the path of
+	// the corpus directory and source snippets stripped.
+	Error string
+}
+
+// OK returns true if the file made it through all stages.
+func (r *Result) OK() bool {
+	return r.Passed == Generate
+}
+
+// Check runs all Thrift files in the given directory through the parser,
+// compiler, and code generator, and returns the results sorted by file name.
+//
+// Files are compiled without strict validation because Apache Thrift
+// doesn't require fields to be marked required or optional.
+func Check(dir string) ([]*Result, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.thrift"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	results := make([]*Result, 0, len(files))
+	for _, path := range files {
+		r := &Result{File: filepath.Base(path)}
+		if err := check(dir, path, r); err != nil {
+			r.Error = cleanError(dir, err)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func check(dir, path string, r *Result) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := idl.Parse(contents); err != nil {
+		return err
+	}
+	r.Passed = Parse
+
+	module, err := compile.Compile(path, compile.NonStrict())
+	if err != nil {
+		return err
+	}
+	r.Passed = Compile
+
+	outputDir, err := ioutil.TempDir("", "thriftrw-conformance")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(outputDir)
+
+	err = gen.Generate(module, &gen.Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "example.com/conformance",
+		ThriftRoot:    dir,
+	})
+	if err != nil {
+		return err
+	}
+	r.Passed = Generate
+	return nil
+}
+
+// _snippetLine matches the lines of source snippets included in errors.
+var _snippetLine = regexp.MustCompile(`^\s*\d*\s*\|`)
+
+// cleanError formats the given error as a single line without any paths
+// specific to this machine.
+func cleanError(dir string, err error) string {
+	var parts []string
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || _snippetLine.MatchString(line) {
+			continue
+		}
+		parts = append(parts, strings.Replace(line, dir+string(filepath.Separator), "", -1))
+	}
+	return strings.Join(parts, " ")
+}
+
+// WriteReport writes a Markdown report of the given results to w.
+func WriteReport(w io.Writer, results []*Result) error {
+	var passed int
+	for _, r := range results {
+		if r.OK() {
+			passed++
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Apache Thrift conformance\n\n")
+	b.WriteString("<!-- Code generated by go generate. DO NOT EDIT. -->\n\n")
+	fmt.Fprintf(&b, "%d of %d files from Apache Thrift's test suite pass all stages.\n\n",
+		passed, len(results))
+
+	b.WriteString("| File | Parse | Compile | Generate | Error |\n")
+	b.WriteString("|------|-------|---------|----------|-------|\n")
+	for _, r := range results {
+		fmt.Fprintf(&b, "| %v |", r.File)
+		for _, s := range []Stage{Parse, Compile, Generate} {
+			switch {
+			case r.Passed >= s:
+				b.WriteString(" yes |")
+			case r.Passed == s-1:
+				b.WriteString(" **no** |")
+			default:
+				b.WriteString(" - |")
+			}
+		}
+		fmt.Fprintf(&b, " %v |\n", strings.Replace(r.Error, "|", `\|`, -1))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package conformance
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportIsUpToDate(t *testing.T) {
+	results, err := Check("testdata")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteReport(&buf, results))
+
+	want, err := ioutil.ReadFile("REPORT.md")
+	require.NoError(t, err)
+	assert.Equal(t, string(want), buf.String(),
+		"REPORT.md is out of date. Please run 'go generate' in internal/conformance.")
+}
+
+func TestCorpusParses(t *testing.T) {
+	results, err := Check("testdata")
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+
+	for _, r := range results {
+		assert.True(t, r.Passed >= Parse, "%v must parse: %v", r.File, r.Error)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteReport(&buf, []*Result{
+		{File: "a.thrift", Passed: Generate},
+		{File: "b.thrift", Passed: Parse, Error: "a | b"},
+		{File: "c.thrift", Error: "parse error"},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "1 of 3 files")
+	assert.Contains(t, buf.String(), "| a.thrift | yes | yes | yes |  |\n")
+	assert.Contains(t, buf.String(), "| b.thrift | yes | **no** | - | a \\| b |\n")
+	assert.Contains(t, buf.String(), "| c.thrift | **no** | - | - | parse error |\n")
+}
+
+func TestCleanError(t *testing.T) {
+	err := errors.New("could not compile file \"/corpus/foo.thrift\":\n" +
+		"  line 3:5: bad thing\n" +
+		"    3 | struct Foo {\n" +
+		"      |     ^\n")
+	assert.Equal(t, `could not compile file "foo.thrift": line 3:5: bad thing`,
+		cleanError("/corpus", err))
+}
@@ -81,19 +81,25 @@ func (c client) Send(name string, reqValue wire.Value) (wire.Value, error) {
 		return wire.Value{}, err
 	}
 
-	switch resEnvelope.Type {
+	return responseValue(resEnvelope)
+}
+
+// responseValue returns the body of the given response envelope, or an error
+// if the envelope holds an exception.
+func responseValue(e wire.Envelope) (wire.Value, error) {
+	switch e.Type {
 	case wire.Exception:
 		var exc exception.TApplicationException
-		if err := exc.FromWire(resEnvelope.Value); err != nil {
+		if err := exc.FromWire(e.Value); err != nil {
 			return wire.Value{}, err
 		}
 		return wire.Value{}, &exc
 
 	case wire.Reply:
-		return resEnvelope.Value, nil
+		return e.Value, nil
 
 	default:
-		return wire.Value{}, errUnknownEnvelopeType(resEnvelope.Type)
+		return wire.Value{}, errUnknownEnvelopeType(e.Type)
 	}
 }
 
@@ -26,8 +26,10 @@ package exception
 import (
 	bytes "bytes"
 	json "encoding/json"
+	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -218,6 +220,24 @@ func (v *ExceptionType) FromWire(w wire.Value) error {
 	return nil
 }
 
+// Decode reads off the encoded ExceptionType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v ExceptionType
+//   if err := v.Decode(sReader); err != nil {
+//     return ExceptionType(0), err
+//   }
+//   return v, nil
+func (v *ExceptionType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (ExceptionType)(i)
+	return nil
+}
+
 // String returns a readable string representation of ExceptionType.
 func (v ExceptionType) String() string {
 	w := int32(v)
@@ -426,6 +446,129 @@ func (v *TApplicationException) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _ExceptionType_Decode(sr stream.Reader) (ExceptionType, error) {
+	var v ExceptionType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func (v *TApplicationException) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			var x ExceptionType
+			x, err = _ExceptionType_Decode(sr)
+			v.Type = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a TApplicationException struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *TApplicationException) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Message == nil) {
+		b, err := json.Marshal(v.Message)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"message":`)
+		buff.Write(b)
+	}
+	if !(v.Type == nil) {
+		b, err := json.Marshal(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"type":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a TApplicationException struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *TApplicationException) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["message"]; ok {
+		if err := json.Unmarshal(r, &v.Message); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["type"]; ok {
+		if err := json.Unmarshal(r, &v.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a TApplicationException
 // struct.
 func (v *TApplicationException) String() string {
@@ -487,6 +630,39 @@ func (v *TApplicationException) Equals(rhs *TApplicationException) bool {
 	return true
 }
 
+func _String_ClonePtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _ExceptionType_ClonePtr(v *ExceptionType) *ExceptionType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this TApplicationException. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *TApplicationException) Clone() *TApplicationException {
+	if v == nil {
+		return nil
+	}
+
+	var c TApplicationException
+	c.Message = _String_ClonePtr(v.Message)
+	c.Type = _ExceptionType_ClonePtr(v.Type)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of TApplicationException.
 func (v *TApplicationException) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -532,17 +708,46 @@ func (v *TApplicationException) IsSetType() bool {
 	return v != nil && v.Type != nil
 }
 
+// ErrTApplicationException matches all TApplicationException errors with errors.Is.
+//
+//   if errors.Is(err, ErrTApplicationException) {
+//     ...
+//   }
+var ErrTApplicationException = errors.New("TApplicationException")
+
 func (v *TApplicationException) Error() string {
 	return v.String()
 }
 
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*TApplicationException) ErrorName() string {
+	return "TApplicationException"
+}
+
+// Unwrap returns the first field of this TApplicationException which holds an
+// exception and is set, or nil if there isn't one.
+func (v *TApplicationException) Unwrap() error {
+	return nil
+}
+
+// Is reports whether the given target is ErrTApplicationException.
+func (*TApplicationException) Is(target error) bool {
+	return target == ErrTApplicationException
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "exception",
 	Package:  "go.uber.org/thriftrw/internal/envelope/exception",
 	FilePath: "exception.thrift",
 	SHA1:     "88105bcd404d4aee06542af9452f7cf76647ae98",
+	Version:  "1.21.0-dev",
 	Raw:      rawIDL,
 }
 
 const rawIDL = "enum ExceptionType {\n  UNKNOWN = 0\n  UNKNOWN_METHOD = 1\n  INVALID_MESSAGE_TYPE = 2\n  WRONG_METHOD_NAME = 3\n  BAD_SEQUENCE_ID = 4\n  MISSING_RESULT = 5\n  INTERNAL_ERROR = 6\n  PROTOCOL_ERROR = 7\n  INVALID_TRANSFORM = 8\n  INVALID_PROTOCOL = 9\n  UNSUPPORTED_CLIENT_TYPE = 10\n}\n\nexception TApplicationException {\n  1: optional string message\n  2: optional ExceptionType type\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"go.uber.org/atomic"
+	"go.uber.org/multierr"
+)
+
+// errPeerClosed is returned for requests that were sent or in flight after a
+// Peer stopped.
+var errPeerClosed = errors.New("peer has been stopped")
+
+// Peer is one end of a bidirectional connection over which both ends may send
+// requests to each other.
+//
+// Requests and responses are framed envelopes matched by their sequence IDs,
+// so any number of requests may be in flight in either direction at the same
+// time. Incoming requests are dispatched to the Handler concurrently. This
+// allows a Handler to send requests of its own to the other end and wait for
+// their responses while it is handling a request.
+//
+// Peer implements Client and is thread-safe.
+type Peer struct {
+	p protocol.Protocol
+	h Handler
+	r *frame.Reader
+	w *frame.Writer
+
+	running  *atomic.Bool
+	handlers sync.WaitGroup
+
+	mu        sync.Mutex
+	stopped   bool
+	lastSeqID int32
+	pending   map[int32]chan<- wire.Envelope
+	err       error // first error encountered while responding to a request
+}
+
+var _ Client = (*Peer)(nil)
+
+// NewPeer builds a new Peer which reads frames from the given Reader and
+// writes them to the given Writer, encoding envelopes using the given
+// protocol. Requests received from the other end are handled by h.
+//
+// The Peer does not read any frames until Serve is called.
+func NewPeer(p protocol.Protocol, r io.Reader, w io.Writer, h Handler) *Peer {
+	return &Peer{
+		p:       p,
+		h:       h,
+		r:       frame.NewReader(r),
+		w:       frame.NewWriter(w),
+		running: atomic.NewBool(false),
+		pending: make(map[int32]chan<- wire.Envelope),
+	}
+}
+
+// Send sends a request to the other end and waits for its response.
+func (p *Peer) Send(name string, body wire.Value) (wire.Value, error) {
+	res := make(chan wire.Envelope, 1)
+
+	p.mu.Lock()
+	if p.stopped {
+		p.mu.Unlock()
+		return wire.Value{}, errPeerClosed
+	}
+	p.lastSeqID++
+	seqID := p.lastSeqID
+	p.pending[seqID] = res
+	p.mu.Unlock()
+
+	err := p.write(wire.Envelope{
+		Name:  name,
+		Type:  wire.Call,
+		SeqID: seqID,
+		Value: body,
+	})
+	if err != nil {
+		p.mu.Lock()
+		delete(p.pending, seqID)
+		p.mu.Unlock()
+		return wire.Value{}, err
+	}
+
+	e, ok := <-res
+	if !ok {
+		return wire.Value{}, errPeerClosed
+	}
+	return responseValue(e)
+}
+
+func (p *Peer) write(e wire.Envelope) error {
+	var buff bytes.Buffer
+	if err := p.p.EncodeEnveloped(e, &buff); err != nil {
+		return err
+	}
+	return p.w.Write(buff.Bytes())
+}
+
+// Serve reads frames from the other end, dispatching requests to the Handler
+// and responses to the requests that are waiting for them.
+//
+// This blocks until the Peer is stopped using Stop or there is an IO error.
+// Requests that have not received responses by then fail, and Serve waits
+// for requests that are still being handled before returning.
+func (p *Peer) Serve() (err error) {
+	if p.running.Swap(true) {
+		return fmt.Errorf("peer is already running")
+	}
+
+	defer func() {
+		p.running.Store(false)
+
+		// Fail outstanding requests first so that handlers waiting on them
+		// can finish.
+		p.mu.Lock()
+		p.stopped = true
+		for seqID, res := range p.pending {
+			close(res)
+			delete(p.pending, seqID)
+		}
+		p.mu.Unlock()
+
+		p.handlers.Wait()
+
+		p.mu.Lock()
+		err = multierr.Append(err, p.err)
+		p.mu.Unlock()
+
+		err = multierr.Append(err, p.r.Close())
+		err = multierr.Append(err, p.w.Close())
+	}()
+
+	for p.running.Load() {
+		data, err := p.r.Read()
+		if err != nil {
+			// If the error occurred because the peer was stopped, ignore it.
+			if !p.running.Load() {
+				break
+			}
+			return err
+		}
+
+		e, err := p.p.DecodeEnveloped(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+
+		switch e.Type {
+		case wire.Call, wire.OneWay:
+			p.handlers.Add(1)
+			go p.handle(e)
+
+		case wire.Reply, wire.Exception:
+			p.mu.Lock()
+			res, ok := p.pending[e.SeqID]
+			delete(p.pending, e.SeqID)
+			p.mu.Unlock()
+
+			if !ok {
+				return fmt.Errorf("received response for unknown request %d", e.SeqID)
+			}
+			res <- e
+
+		default:
+			return fmt.Errorf("unknown envelope type: %v", e.Type)
+		}
+	}
+
+	return nil
+}
+
+// handle handles a single request, writing its response unless the request
+// was a oneway request.
+func (p *Peer) handle(request wire.Envelope) {
+	defer p.handlers.Done()
+
+	response, err := handleEnvelope(p.h, request)
+	if err == nil && request.Type != wire.OneWay {
+		err = p.write(response)
+	}
+	if err == nil {
+		return
+	}
+
+	p.mu.Lock()
+	p.err = multierr.Append(p.err, err)
+	p.mu.Unlock()
+	p.Stop()
+}
+
+// Stop tells the Peer that it's okay to stop Serve.
+//
+// Requests that are being handled may still send their responses after Stop
+// is called. This is a no-op if the Peer wasn't already running.
+func (p *Peer) Stop() error {
+	// As with frame.Server, only the reader is closed here so that a handler
+	// which called Stop (goodbye()) can still send back its response. The
+	// writer is closed when Serve returns.
+	if p.running.Swap(false) {
+		return p.r.Close()
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectedPeers builds two Peers connected to each other and starts serving
+// them. The returned function stops both peers and waits for them to finish.
+func connectedPeers(t *testing.T, left, right Handler) (*Peer, *Peer, func()) {
+	leftReader, rightWriter := io.Pipe()
+	rightReader, leftWriter := io.Pipe()
+
+	l := NewPeer(protocol.Binary, leftReader, leftWriter, left)
+	r := NewPeer(protocol.Binary, rightReader, rightWriter, right)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, l.Serve(), "left peer failed")
+	}()
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, r.Serve(), "right peer failed")
+	}()
+
+	return l, r, func() {
+		assert.NoError(t, l.Stop())
+		assert.NoError(t, r.Stop())
+		wg.Wait()
+	}
+}
+
+// str builds an envelope body holding the given string.
+func str(s string) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBinary([]byte(s))},
+	}})
+}
+
+// getStr returns the string held in an envelope body built with str.
+func getStr(v wire.Value) string {
+	fields := v.GetStruct().Fields
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0].Value.GetString()
+}
+
+func echoHandler(prefix string) handlerFunc {
+	return func(name string, body wire.Value) (wire.Value, error) {
+		return str(prefix + name + ":" + getStr(body)), nil
+	}
+}
+
+func TestPeerSendBothWays(t *testing.T) {
+	left, right, stop := connectedPeers(t, echoHandler("left:"), echoHandler("right:"))
+	defer stop()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			res, err := left.Send("foo", str(fmt.Sprint(i)))
+			if assert.NoError(t, err) {
+				assert.Equal(t, fmt.Sprintf("right:foo:%d", i), getStr(res))
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			res, err := right.Send("bar", str(fmt.Sprint(i)))
+			if assert.NoError(t, err) {
+				assert.Equal(t, fmt.Sprintf("left:bar:%d", i), getStr(res))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestPeerNestedRequest(t *testing.T) {
+	// The right peer calls back into the left peer while it is handling a
+	// request from it.
+	var right *Peer
+	rightHandler := handlerFunc(func(name string, body wire.Value) (wire.Value, error) {
+		res, err := right.Send("lookup", body)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return str("found " + getStr(res)), nil
+	})
+
+	left, r, stop := connectedPeers(t, echoHandler(""), rightHandler)
+	defer stop()
+	right = r
+
+	res, err := left.Send("generate", str("x"))
+	require.NoError(t, err)
+	assert.Equal(t, "found lookup:x", getStr(res))
+}
+
+func TestPeerHandlerError(t *testing.T) {
+	failing := handlerFunc(func(name string, body wire.Value) (wire.Value, error) {
+		return wire.Value{}, ErrUnknownMethod(name)
+	})
+
+	left, _, stop := connectedPeers(t, echoHandler(""), failing)
+	defer stop()
+
+	_, err := left.Send("foo", str("x"))
+	require.Error(t, err)
+
+	exc, ok := err.(*exception.TApplicationException)
+	require.True(t, ok, "expected a TApplicationException, got %T", err)
+	assert.Equal(t, exception.ExceptionTypeUnknownMethod, *exc.Type)
+}
+
+func TestPeerStopFailsPendingRequests(t *testing.T) {
+	leftReader, rightWriter := io.Pipe()
+	rightReader, leftWriter := io.Pipe()
+
+	received := make(chan struct{})
+	block := make(chan struct{})
+	left := NewPeer(protocol.Binary, leftReader, leftWriter, echoHandler(""))
+	right := NewPeer(protocol.Binary, rightReader, rightWriter, handlerFunc(
+		func(name string, body wire.Value) (wire.Value, error) {
+			close(received)
+			<-block
+			return body, nil
+		}))
+
+	leftDone := make(chan error, 1)
+	rightDone := make(chan error, 1)
+	go func() { leftDone <- left.Serve() }()
+	go func() { rightDone <- right.Serve() }()
+
+	sent := make(chan error, 1)
+	go func() {
+		_, err := left.Send("foo", str("x"))
+		sent <- err
+	}()
+
+	<-received
+	require.NoError(t, left.Stop())
+	assert.Equal(t, errPeerClosed, <-sent)
+	assert.NoError(t, <-leftDone)
+
+	_, err := left.Send("foo", str("x"))
+	assert.Equal(t, errPeerClosed, err, "requests after stopping must fail")
+
+	// The other end loses its connection and fails to respond.
+	close(block)
+	assert.Error(t, <-rightDone)
+}
+
+func TestPeerServeTwice(t *testing.T) {
+	left, _, stop := connectedPeers(t, echoHandler(""), echoHandler(""))
+	defer stop()
+
+	// Make sure the first Serve has started.
+	_, err := left.Send("foo", str("x"))
+	require.NoError(t, err)
+
+	assert.Error(t, left.Serve())
+}
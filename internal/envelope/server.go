@@ -59,19 +59,45 @@ func NewServer(p protocol.Protocol, h Handler) Server {
 }
 
 // Handle handles the given binary payload.
+//
+// No response is produced for oneway requests. Errors returned by the
+// Handler for them are returned as-is.
 func (s Server) Handle(data []byte) ([]byte, error) {
 	request, err := s.p.DecodeEnveloped(bytes.NewReader(data))
 	if err != nil {
 		return nil, err
 	}
 
+	if request.Type == wire.OneWay {
+		_, err := s.h.Handle(request.Name, request.Value)
+		return nil, err
+	}
+
+	response, err := handleEnvelope(s.h, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var buff bytes.Buffer
+	if err := s.p.EncodeEnveloped(response, &buff); err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// handleEnvelope calls the handler with the given request and builds the
+// response envelope. Errors returned by the handler are sent back as
+// TApplicationExceptions.
+func handleEnvelope(h Handler, request wire.Envelope) (wire.Envelope, error) {
 	response := wire.Envelope{
 		Name:  request.Name,
 		SeqID: request.SeqID,
 		Type:  wire.Reply,
 	}
 
-	response.Value, err = s.h.Handle(request.Name, request.Value)
+	var err error
+	response.Value, err = h.Handle(request.Name, request.Value)
 	if err != nil {
 		response.Type = wire.Exception
 		switch err.(type) {
@@ -82,16 +108,11 @@ func (s Server) Handle(data []byte) ([]byte, error) {
 		}
 
 		if err != nil {
-			return nil, err
+			return wire.Envelope{}, err
 		}
 	}
 
-	var buff bytes.Buffer
-	if err := s.p.EncodeEnveloped(response, &buff); err != nil {
-		return nil, err
-	}
-
-	return buff.Bytes(), nil
+	return response, nil
 }
 
 // Helper to build TApplicationException wire.Values
@@ -99,6 +99,32 @@ func TestServer(t *testing.T) {
 				}}),
 			},
 		},
+		{
+			desc: "oneway",
+			giveEnvelope: wire.Envelope{
+				Name:  "notify",
+				Type:  wire.OneWay,
+				SeqID: 1,
+				Value: wire.NewValueStruct(wire.Struct{}),
+			},
+			handler: func(name string, body wire.Value) (wire.Value, error) {
+				assert.Equal(t, "notify", name)
+				return wire.Value{}, nil
+			},
+		},
+		{
+			desc: "oneway error",
+			giveEnvelope: wire.Envelope{
+				Name:  "notify",
+				Type:  wire.OneWay,
+				SeqID: 1,
+				Value: wire.NewValueStruct(wire.Struct{}),
+			},
+			handler: func(string, wire.Value) (wire.Value, error) {
+				return wire.Value{}, errors.New("great sadness")
+			},
+			wantError: errors.New("great sadness"),
+		},
 	}
 
 	for _, tt := range tests {
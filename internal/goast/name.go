@@ -0,0 +1,208 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package goast
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isAllCaps checks if a string contains all capital letters only. Non-letters
+// are not considered.
+func isAllCaps(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) && !unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// PascalCase combines the given words using PascalCase.
+//
+// If allowAllCaps is true, when an all-caps word that is not a known
+// abbreviation is encountered, it is left unchanged. Otherwise, it is
+// Titlecased.
+func PascalCase(allowAllCaps bool, words ...string) string {
+	for i, chunk := range words {
+		if len(chunk) == 0 {
+			// foo__bar
+			continue
+		}
+
+		// known initalism
+		init := strings.ToUpper(chunk)
+		if _, ok := commonInitialisms[init]; ok {
+			words[i] = init
+			continue
+		}
+
+		// Was SCREAMING_SNAKE_CASE and not a known initialism so Titlecase it.
+		if isAllCaps(chunk) && !allowAllCaps {
+			// A single ALLCAPS word does not count as SCREAMING_SNAKE_CASE.
+			// There must be at least one underscore.
+			words[i] = strings.Title(strings.ToLower(chunk))
+			continue
+		}
+
+		// Just another word, but could already be camelCased somehow, so just
+		// change the first letter.
+		head, headIndex := utf8.DecodeRuneInString(chunk)
+		words[i] = string(unicode.ToUpper(head)) + string(chunk[headIndex:])
+	}
+
+	return strings.Join(words, "")
+}
+
+// GoCase converts strings into PascalCase.
+func GoCase(s string) string {
+	if len(s) == 0 {
+		panic(fmt.Sprintf("%q is not a valid identifier", s))
+	}
+
+	words := strings.Split(s, "_")
+	return PascalCase(len(words) == 1 /* all caps */, words...)
+	// goCase allows all caps only if the string is a single all caps word.
+	// That is, "FOO" is allowed but "FOO_BAR" is changed to "FooBar".
+}
+
+// LiteralCase converts snake_case strings into PascalCase by capitalizing
+// the first letter of each word. Unlike GoCase, the rest of each word is left
+// unchanged so known initialisms and all caps words are not altered.
+//
+// 	user_id  => UserId
+// 	FOO_BAR  => FOOBAR
+func LiteralCase(s string) string {
+	if len(s) == 0 {
+		panic(fmt.Sprintf("%q is not a valid identifier", s))
+	}
+
+	words := strings.Split(s, "_")
+	for i, word := range words {
+		if len(word) == 0 {
+			continue
+		}
+		head, headIndex := utf8.DecodeRuneInString(word)
+		words[i] = string(unicode.ToUpper(head)) + word[headIndex:]
+	}
+	return strings.Join(words, "")
+}
+
+// ApacheCase converts strings into the names used by the Go generator of
+// Apache Thrift.
+//
+// The first letter is capitalized, and underscores followed by a lower case
+// letter are removed with the letter capitalized. Other underscores are
+// kept. Words which are known initialisms are upper cased. Names starting
+// with "New" or ending with "Args" or "Result" are suffixed with an
+// underscore so that they don't conflict with the constructors and argument
+// and result types generated by Apache Thrift.
+//
+// 	user_id   => UserID
+// 	FOO_BAR   => FOO_BAR
+// 	new_item  => NewItem_
+func ApacheCase(s string) string {
+	if len(s) == 0 {
+		panic(fmt.Sprintf("%q is not a valid identifier", s))
+	}
+
+	name := []rune(s)
+	name[0] = unicode.ToUpper(name[0])
+	name = apacheInitialism(name, 0)
+	for i := 1; i < len(name)-1; i++ {
+		if name[i] != '_' {
+			continue
+		}
+		if unicode.IsLower(name[i+1]) {
+			name = append(name[:i], append([]rune{unicode.ToUpper(name[i+1])}, name[i+2:]...)...)
+		}
+		name = apacheInitialism(name, i)
+	}
+
+	s = string(name)
+	suffix := ""
+	if strings.HasPrefix(s, "New") {
+		suffix += "_"
+	}
+	if strings.HasSuffix(s, "Args") || strings.HasSuffix(s, "Result") {
+		suffix += "_"
+	}
+	return s + suffix
+}
+
+// apacheInitialism upper cases the word starting at index i of the given
+// name if it's a known initialism. Words are separated by underscores.
+func apacheInitialism(name []rune, i int) []rune {
+	end := i
+	for end < len(name) && name[end] != '_' {
+		end++
+	}
+
+	word := strings.ToUpper(string(name[i:end]))
+	// Apache Thrift's list of initialisms predates the addition of SQL to
+	// golint's.
+	if commonInitialisms[word] && word != "SQL" {
+		copy(name[i:end], []rune(word))
+	}
+	return name
+}
+
+// This set is taken from https://github.com/golang/lint/blob/master/lint.go#L692
+var commonInitialisms = map[string]bool{
+	"API":   true,
+	"ASCII": true,
+	"CPU":   true,
+	"CSS":   true,
+	"DNS":   true,
+	"EOF":   true,
+	"GUID":  true,
+	"HTML":  true,
+	"HTTP":  true,
+	"HTTPS": true,
+	"ID":    true,
+	"IP":    true,
+	"JSON":  true,
+	"LHS":   true,
+	"QPS":   true,
+	"RAM":   true,
+	"RHS":   true,
+	"RPC":   true,
+	"SLA":   true,
+	"SMTP":  true,
+	"SQL":   true,
+	"SSH":   true,
+	"TCP":   true,
+	"TLS":   true,
+	"TTL":   true,
+	"UDP":   true,
+	"UI":    true,
+	"UID":   true,
+	"UUID":  true,
+	"URI":   true,
+	"URL":   true,
+	"UTF8":  true,
+	"VM":    true,
+	"XML":   true,
+	"XSRF":  true,
+	"XSS":   true,
+}
@@ -41,3 +41,28 @@ func IsReservedKeyword(n string) bool {
 	_, ok := _reservedNames[n]
 	return ok
 }
+
+var _predeclaredNames = make(map[string]struct{})
+
+func init() {
+	// from https://golang.org/ref/spec#Predeclared_identifiers
+	predeclaredNames := []string{
+		"bool", "byte", "complex64", "complex128", "error", "float32",
+		"float64", "int", "int8", "int16", "int32", "int64", "rune", "string",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "true",
+		"false", "iota", "nil", "append", "cap", "close", "complex", "copy",
+		"delete", "imag", "len", "make", "new", "panic", "print", "println",
+		"real", "recover",
+	}
+
+	for _, n := range predeclaredNames {
+		_predeclaredNames[n] = struct{}{}
+	}
+}
+
+// IsPredeclared returns true if the given word is a predeclared Go
+// identifier, like a builtin type or function.
+func IsPredeclared(n string) bool {
+	_, ok := _predeclaredNames[n]
+	return ok
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package idltest provides helpers for tests which operate on Thrift files.
+package idltest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/require"
+)
+
+// WriteFiles writes the given Thrift files to dir. Files are keyed by their
+// paths relative to dir and missing directories are created.
+func WriteFiles(t testing.TB, dir string, files map[string]string) {
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+}
+
+// TempDir writes the given Thrift files to a new temporary directory and
+// returns its path. The caller must remove the directory.
+func TempDir(t testing.TB, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "thriftrw-idltest")
+	require.NoError(t, err)
+	WriteFiles(t, dir, files)
+	return dir
+}
+
+// Compile writes the given Thrift files to a temporary directory and
+// compiles the one at root, relative to that directory.
+func Compile(t testing.TB, files map[string]string, root string, opts ...compile.Option) *compile.Module {
+	dir := TempDir(t, files)
+	defer os.RemoveAll(dir)
+
+	m, err := compile.Compile(filepath.Join(dir, root), opts...)
+	require.NoError(t, err)
+	return m
+}
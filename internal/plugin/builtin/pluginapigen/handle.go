@@ -50,6 +50,18 @@ func (handle) ServiceGenerator() intplugin.ServiceGenerator {
 	return sgen{}
 }
 
+func (handle) TypeMapper() intplugin.TypeMapper {
+	return nil
+}
+
+func (handle) Validator() intplugin.Validator {
+	return nil
+}
+
+func (handle) PostProcessor() intplugin.PostProcessor {
+	return nil
+}
+
 type sgen struct{}
 
 func (sgen) Handle() intplugin.Handle {
@@ -39,6 +39,18 @@ func (emptyHandle) ServiceGenerator() ServiceGenerator {
 	return EmptyServiceGenerator
 }
 
+func (emptyHandle) TypeMapper() TypeMapper {
+	return EmptyTypeMapper
+}
+
+func (emptyHandle) Validator() Validator {
+	return EmptyValidator
+}
+
+func (emptyHandle) PostProcessor() PostProcessor {
+	return EmptyPostProcessor
+}
+
 // EmptyServiceGenerator is a no-op service generator that does not generate
 // any new files.
 var EmptyServiceGenerator ServiceGenerator = emptyServiceGenerator{}
@@ -52,3 +64,43 @@ func (emptyServiceGenerator) Handle() Handle {
 func (emptyServiceGenerator) Generate(Request *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
 	return &api.GenerateServiceResponse{Files: make(map[string][]byte)}, nil
 }
+
+// EmptyTypeMapper is a no-op type mapper that does not claim any fields.
+var EmptyTypeMapper TypeMapper = emptyTypeMapper{}
+
+type emptyTypeMapper struct{}
+
+func (emptyTypeMapper) Handle() Handle {
+	return EmptyHandle
+}
+
+func (emptyTypeMapper) MapType(*api.MapTypeRequest) (*api.MapTypeResponse, error) {
+	return &api.MapTypeResponse{}, nil
+}
+
+// EmptyValidator is a no-op validator that does not find any problems.
+var EmptyValidator Validator = emptyValidator{}
+
+type emptyValidator struct{}
+
+func (emptyValidator) Handle() Handle {
+	return EmptyHandle
+}
+
+func (emptyValidator) Validate(*api.ValidateRequest) (*api.ValidateResponse, error) {
+	return &api.ValidateResponse{}, nil
+}
+
+// EmptyPostProcessor is a no-op post-processor that leaves all files
+// unchanged.
+var EmptyPostProcessor PostProcessor = emptyPostProcessor{}
+
+type emptyPostProcessor struct{}
+
+func (emptyPostProcessor) Handle() Handle {
+	return EmptyHandle
+}
+
+func (emptyPostProcessor) Process(*api.PostProcessRequest) (*api.PostProcessResponse, error) {
+	return &api.PostProcessResponse{}, nil
+}
@@ -44,11 +44,11 @@ func (e errNameMismatch) Error() string {
 }
 
 type errAPIVersionMismatch struct {
-	Want, Got int32
+	Min, Max, Got int32
 }
 
 func (e errAPIVersionMismatch) Error() string {
-	return fmt.Sprintf("plugin API version mismatch: expected %v but got %v", e.Want, e.Got)
+	return fmt.Sprintf("plugin API version mismatch: expected %v through %v but got %v", e.Min, e.Max, e.Got)
 }
 
 type errVersionMismatch struct {
@@ -27,7 +27,9 @@ import (
 	"sync"
 
 	"go.uber.org/thriftrw/internal/concurrent"
+	"go.uber.org/thriftrw/internal/multiplex"
 	"go.uber.org/thriftrw/internal/process"
+	"go.uber.org/thriftrw/plugin/api"
 
 	"github.com/anmitsu/go-shlex"
 	"go.uber.org/multierr"
@@ -53,14 +55,22 @@ type Flag struct {
 
 // Handle gets a Handle to this plugin specification.
 //
+// Requests made by the plugin to the Generator service are handled by g. If g
+// is nil, such requests fail.
+//
 // The returned handle MUST be closed by the caller if error was nil.
-func (f *Flag) Handle() (Handle, error) {
-	transport, err := process.NewClient(f.Command)
+func (f *Flag) Handle(g api.Generator) (Handle, error) {
+	handler := multiplex.NewHandler()
+	if g != nil {
+		handler.Put("Generator", api.NewGeneratorHandler(g))
+	}
+
+	transport, err := process.NewPeer(f.Command, _proto, handler)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open plugin %q: %v", f.Name, err)
 	}
 
-	handle, err := NewTransportHandle(f.Name, transport)
+	handle, err := NewClientHandle(f.Name, transport)
 	if err != nil {
 		return nil, multierr.Combine(
 			fmt.Errorf("failed to open plugin %q: %v", f.Name, err),
@@ -100,17 +110,18 @@ func (f *Flag) UnmarshalFlag(value string) error {
 type Flags []Flag
 
 // Handle gets a MultiHandle to all the plugins in this list or nil if the
-// list is empty.
+// list is empty. Requests made by the plugins to the Generator service are
+// handled by g.
 //
 // The returned handle MUST be closed by the caller if error was nil.
-func (fs Flags) Handle() (MultiHandle, error) {
+func (fs Flags) Handle(g api.Generator) (MultiHandle, error) {
 	var (
 		lock  sync.Mutex
 		multi MultiHandle
 	)
 
 	err := concurrent.Range(fs, func(_ int, f Flag) error {
-		h, err := f.Handle()
+		h, err := f.Handle(g)
 		if err != nil {
 			return err
 		}
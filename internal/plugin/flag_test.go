@@ -150,7 +150,7 @@ func TestFlagHandle(t *testing.T) {
 			Name:    tt.name,
 			Command: exec.Command(tt.path, tt.args...),
 		}
-		h, err := f.Handle()
+		h, err := f.Handle(nil)
 
 		if len(tt.wantErrors) > 0 {
 			if !assert.Error(t, err, "%v: expected error", tt.desc) {
@@ -245,7 +245,7 @@ func TestFlagsHandle(t *testing.T) {
 			})
 		}
 
-		h, err := flags.Handle()
+		h, err := flags.Handle(nil)
 
 		if len(tt.wantErrors) > 0 {
 			if !assert.Error(t, err, "%v: expected error", tt.desc) {
@@ -17,7 +17,7 @@ set -x
 #   go install go.uber.org/thriftrw/vendor/github.com/golang/mock/mockgen
 
 PACKAGE=go.uber.org/thriftrw/internal/plugin
-INTERFACES=Handle,ServiceGenerator
+INTERFACES=Handle,ServiceGenerator,TypeMapper,Validator,PostProcessor
 DESTINATION=handletest/mock.go
 PACKAGENAME=handletest
 
@@ -39,6 +39,27 @@ type Handle interface {
 	// Note that the ServiceGenerator is valid only as long as Close is not
 	// called on the Handle.
 	ServiceGenerator() ServiceGenerator
+
+	// TypeMapper returns a TypeMapper for this plugin or nil if this plugin
+	// does not implement that feature.
+	//
+	// Note that the TypeMapper is valid only as long as Close is not called
+	// on the Handle.
+	TypeMapper() TypeMapper
+
+	// Validator returns a Validator for this plugin or nil if this plugin
+	// does not implement that feature.
+	//
+	// Note that the Validator is valid only as long as Close is not called
+	// on the Handle.
+	Validator() Validator
+
+	// PostProcessor returns a PostProcessor for this plugin or nil if this
+	// plugin does not implement that feature.
+	//
+	// Note that the PostProcessor is valid only as long as Close is not
+	// called on the Handle.
+	PostProcessor() PostProcessor
 }
 
 // ServiceGenerator generates files for Thrift services.
@@ -48,3 +69,27 @@ type ServiceGenerator interface {
 	// Handle returns the Handle that owns this ServiceGenerator.
 	Handle() Handle
 }
+
+// TypeMapper maps annotated fields to custom Go types.
+type TypeMapper interface {
+	api.TypeMapper
+
+	// Handle returns the Handle that owns this TypeMapper.
+	Handle() Handle
+}
+
+// Validator checks Thrift files for problems.
+type Validator interface {
+	api.Validator
+
+	// Handle returns the Handle that owns this Validator.
+	Handle() Handle
+}
+
+// PostProcessor modifies generated files before they are written.
+type PostProcessor interface {
+	api.PostProcessor
+
+	// Handle returns the Handle that owns this PostProcessor.
+	Handle() Handle
+}
@@ -19,7 +19,7 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
-// Source: go.uber.org/thriftrw/internal/plugin (interfaces: Handle,ServiceGenerator)
+// Source: go.uber.org/thriftrw/internal/plugin (interfaces: Handle,ServiceGenerator,TypeMapper,Validator,PostProcessor)
 
 // Package handletest is a generated GoMock package.
 package handletest
@@ -82,6 +82,20 @@ func (mr *MockHandleMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockHandle)(nil).Name))
 }
 
+// PostProcessor mocks base method
+func (m *MockHandle) PostProcessor() plugin.PostProcessor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostProcessor")
+	ret0, _ := ret[0].(plugin.PostProcessor)
+	return ret0
+}
+
+// PostProcessor indicates an expected call of PostProcessor
+func (mr *MockHandleMockRecorder) PostProcessor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostProcessor", reflect.TypeOf((*MockHandle)(nil).PostProcessor))
+}
+
 // ServiceGenerator mocks base method
 func (m *MockHandle) ServiceGenerator() plugin.ServiceGenerator {
 	m.ctrl.T.Helper()
@@ -96,6 +110,34 @@ func (mr *MockHandleMockRecorder) ServiceGenerator() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceGenerator", reflect.TypeOf((*MockHandle)(nil).ServiceGenerator))
 }
 
+// TypeMapper mocks base method
+func (m *MockHandle) TypeMapper() plugin.TypeMapper {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeMapper")
+	ret0, _ := ret[0].(plugin.TypeMapper)
+	return ret0
+}
+
+// TypeMapper indicates an expected call of TypeMapper
+func (mr *MockHandleMockRecorder) TypeMapper() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeMapper", reflect.TypeOf((*MockHandle)(nil).TypeMapper))
+}
+
+// Validator mocks base method
+func (m *MockHandle) Validator() plugin.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator")
+	ret0, _ := ret[0].(plugin.Validator)
+	return ret0
+}
+
+// Validator indicates an expected call of Validator
+func (mr *MockHandleMockRecorder) Validator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockHandle)(nil).Validator))
+}
+
 // MockServiceGenerator is a mock of ServiceGenerator interface
 type MockServiceGenerator struct {
 	ctrl     *gomock.Controller
@@ -147,3 +189,159 @@ func (mr *MockServiceGeneratorMockRecorder) Handle() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockServiceGenerator)(nil).Handle))
 }
+
+// MockTypeMapper is a mock of TypeMapper interface
+type MockTypeMapper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypeMapperMockRecorder
+}
+
+// MockTypeMapperMockRecorder is the mock recorder for MockTypeMapper
+type MockTypeMapperMockRecorder struct {
+	mock *MockTypeMapper
+}
+
+// NewMockTypeMapper creates a new mock instance
+func NewMockTypeMapper(ctrl *gomock.Controller) *MockTypeMapper {
+	mock := &MockTypeMapper{ctrl: ctrl}
+	mock.recorder = &MockTypeMapperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTypeMapper) EXPECT() *MockTypeMapperMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method
+func (m *MockTypeMapper) Handle() plugin.Handle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(plugin.Handle)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle
+func (mr *MockTypeMapperMockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockTypeMapper)(nil).Handle))
+}
+
+// MapType mocks base method
+func (m *MockTypeMapper) MapType(arg0 *api.MapTypeRequest) (*api.MapTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MapType", arg0)
+	ret0, _ := ret[0].(*api.MapTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MapType indicates an expected call of MapType
+func (mr *MockTypeMapperMockRecorder) MapType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapType", reflect.TypeOf((*MockTypeMapper)(nil).MapType), arg0)
+}
+
+// MockValidator is a mock of Validator interface
+type MockValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorMockRecorder
+}
+
+// MockValidatorMockRecorder is the mock recorder for MockValidator
+type MockValidatorMockRecorder struct {
+	mock *MockValidator
+}
+
+// NewMockValidator creates a new mock instance
+func NewMockValidator(ctrl *gomock.Controller) *MockValidator {
+	mock := &MockValidator{ctrl: ctrl}
+	mock.recorder = &MockValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method
+func (m *MockValidator) Handle() plugin.Handle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(plugin.Handle)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle
+func (mr *MockValidatorMockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockValidator)(nil).Handle))
+}
+
+// Validate mocks base method
+func (m *MockValidator) Validate(arg0 *api.ValidateRequest) (*api.ValidateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", arg0)
+	ret0, _ := ret[0].(*api.ValidateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate
+func (mr *MockValidatorMockRecorder) Validate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockValidator)(nil).Validate), arg0)
+}
+
+// MockPostProcessor is a mock of PostProcessor interface
+type MockPostProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostProcessorMockRecorder
+}
+
+// MockPostProcessorMockRecorder is the mock recorder for MockPostProcessor
+type MockPostProcessorMockRecorder struct {
+	mock *MockPostProcessor
+}
+
+// NewMockPostProcessor creates a new mock instance
+func NewMockPostProcessor(ctrl *gomock.Controller) *MockPostProcessor {
+	mock := &MockPostProcessor{ctrl: ctrl}
+	mock.recorder = &MockPostProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPostProcessor) EXPECT() *MockPostProcessorMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method
+func (m *MockPostProcessor) Handle() plugin.Handle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(plugin.Handle)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle
+func (mr *MockPostProcessorMockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockPostProcessor)(nil).Handle))
+}
+
+// Process mocks base method
+func (m *MockPostProcessor) Process(arg0 *api.PostProcessRequest) (*api.PostProcessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", arg0)
+	ret0, _ := ret[0].(*api.PostProcessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process
+func (mr *MockPostProcessorMockRecorder) Process(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockPostProcessor)(nil).Process), arg0)
+}
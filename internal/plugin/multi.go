@@ -21,7 +21,9 @@
 package plugin
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -110,3 +112,187 @@ func (msg MultiServiceGenerator) Generate(req *api.GenerateServiceRequest) (*api
 
 	return &api.GenerateServiceResponse{Files: files}, err
 }
+
+// TypeMapper returns a TypeMapper which calls into the TypeMappers of all
+// plugins associated with this MultiHandle.
+func (mh MultiHandle) TypeMapper() TypeMapper {
+	mtm := make(MultiTypeMapper, 0, len(mh))
+	for _, h := range mh {
+		if tm := h.TypeMapper(); tm != nil {
+			mtm = append(mtm, tm)
+		}
+	}
+	return mtm
+}
+
+// MultiTypeMapper wraps a collection of TypeMappers into a single
+// TypeMapper.
+type MultiTypeMapper []TypeMapper
+
+// Handle returns a reference to the Handle that owns this TypeMapper.
+func (mtm MultiTypeMapper) Handle() Handle {
+	mh := make(MultiHandle, len(mtm))
+	for i, tm := range mtm {
+		mh[i] = tm.Handle()
+	}
+	return mh
+}
+
+// MapType calls all the type mappers associated with this plugin and
+// returns the mapping provided by them, if any.
+//
+// A field may be claimed by at most one plugin. If more than one plugin
+// provides a mapping for the same field, a failure is returned.
+func (mtm MultiTypeMapper) MapType(req *api.MapTypeRequest) (*api.MapTypeResponse, error) {
+	var (
+		lock      sync.Mutex
+		mapping   *api.TypeMapping
+		claimedBy string
+	)
+
+	err := concurrent.Range(mtm, func(_ int, tm TypeMapper) error {
+		res, err := tm.MapType(req)
+		if err != nil {
+			return err
+		}
+		if res.Mapping == nil {
+			return nil
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		pluginName := tm.Handle().Name()
+		if mapping != nil {
+			return fmt.Errorf("plugin conflict: cannot map field %q for plugin %q: "+
+				"plugin %q already mapped that field", req.FieldName, pluginName, claimedBy)
+		}
+
+		mapping = res.Mapping
+		claimedBy = pluginName
+		return nil
+	})
+
+	return &api.MapTypeResponse{Mapping: mapping}, err
+}
+
+// Validator returns a Validator which calls into the Validators of all
+// plugins associated with this MultiHandle.
+func (mh MultiHandle) Validator() Validator {
+	mv := make(MultiValidator, 0, len(mh))
+	for _, h := range mh {
+		if v := h.Validator(); v != nil {
+			mv = append(mv, v)
+		}
+	}
+	return mv
+}
+
+// MultiValidator wraps a collection of Validators into a single Validator.
+type MultiValidator []Validator
+
+// Handle returns a reference to the Handle that owns this Validator.
+func (mv MultiValidator) Handle() Handle {
+	mh := make(MultiHandle, len(mv))
+	for i, v := range mv {
+		mh[i] = v.Handle()
+	}
+	return mh
+}
+
+// Validate calls all the validators associated with this plugin and returns
+// the problems found by all of them, in the order of the plugins.
+func (mv MultiValidator) Validate(req *api.ValidateRequest) (*api.ValidateResponse, error) {
+	results := make([][]*api.Diagnostic, len(mv))
+	err := concurrent.Range(mv, func(i int, v Validator) error {
+		res, err := v.Validate(req)
+		if err != nil {
+			return err
+		}
+
+		// Each plugin writes to its own index so no locking is needed.
+		results[i] = res.Diagnostics
+		return nil
+	})
+
+	var diagnostics []*api.Diagnostic
+	for _, ds := range results {
+		diagnostics = append(diagnostics, ds...)
+	}
+	return &api.ValidateResponse{Diagnostics: diagnostics}, err
+}
+
+// PostProcessor returns a PostProcessor which calls into the PostProcessors
+// of all plugins associated with this MultiHandle.
+func (mh MultiHandle) PostProcessor() PostProcessor {
+	mpp := make(MultiPostProcessor, 0, len(mh))
+	for _, h := range mh {
+		if pp := h.PostProcessor(); pp != nil {
+			mpp = append(mpp, pp)
+		}
+	}
+	return mpp
+}
+
+// MultiPostProcessor wraps a collection of PostProcessors into a single
+// PostProcessor.
+type MultiPostProcessor []PostProcessor
+
+// Handle returns a reference to the Handle that owns this PostProcessor.
+func (mpp MultiPostProcessor) Handle() Handle {
+	mh := make(MultiHandle, len(mpp))
+	for i, pp := range mpp {
+		mh[i] = pp.Handle()
+	}
+	return mh
+}
+
+// Process calls the post-processors associated with this plugin one after
+// another, passing the files produced by each to the next, and returns
+// their combined changes to the files in the request.
+func (mpp MultiPostProcessor) Process(req *api.PostProcessRequest) (*api.PostProcessResponse, error) {
+	files := make(map[string][]byte, len(req.Files))
+	for path, contents := range req.Files {
+		files[path] = contents
+	}
+
+	for _, pp := range mpp {
+		res, err := pp.Process(&api.PostProcessRequest{
+			Files:         files,
+			PackagePrefix: req.PackagePrefix,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ApplyPostProcess(files, res)
+	}
+
+	res := &api.PostProcessResponse{}
+	for path, contents := range files {
+		if old, ok := req.Files[path]; ok && bytes.Equal(old, contents) {
+			continue
+		}
+		if res.Files == nil {
+			res.Files = make(map[string][]byte)
+		}
+		res.Files[path] = contents
+	}
+	for path := range req.Files {
+		if _, ok := files[path]; !ok {
+			res.RemovedFiles = append(res.RemovedFiles, path)
+		}
+	}
+	sort.Strings(res.RemovedFiles)
+	return res, nil
+}
+
+// ApplyPostProcess applies the changes requested by a PostProcessor to the
+// given map of file paths to contents.
+func ApplyPostProcess(files map[string][]byte, res *api.PostProcessResponse) {
+	for _, path := range res.RemovedFiles {
+		delete(files, path)
+	}
+	for path, contents := range res.Files {
+		files[path] = contents
+	}
+}
@@ -263,3 +263,306 @@ func TestMultiServiceGeneratorGenerateNil(t *testing.T) {
 	_, err := msg.Generate(&api.GenerateServiceRequest{})
 	assert.NoError(t, err)
 }
+
+func TestMultiHandleTypeMapper(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var mh MultiHandle
+	for i := 0; i < 10; i++ {
+		handle := handletest.NewMockHandle(mockCtrl)
+		mh = append(mh, handle)
+
+		// only odd handles have a TypeMapper
+		if i%2 == 0 {
+			handle.EXPECT().TypeMapper().Return(nil)
+			continue
+		}
+
+		handle.EXPECT().TypeMapper().Return(handletest.NewMockTypeMapper(mockCtrl))
+	}
+
+	assert.Len(t, mh.TypeMapper(), 5)
+}
+
+func TestMultiTypeMapperMapType(t *testing.T) {
+	mapping := &api.TypeMapping{
+		Type:       &api.Type{ReferenceType: &api.TypeReference{Name: "Time", ImportPath: "time"}},
+		ToThrift:   &api.FunctionReference{Name: "ToUnix", ImportPath: "example.com/timeutil"},
+		FromThrift: &api.FunctionReference{Name: "FromUnix", ImportPath: "example.com/timeutil"},
+	}
+
+	type response struct {
+		success *api.MapTypeResponse
+		failure error
+	}
+
+	tests := []struct {
+		desc      string
+		responses []response
+
+		wantResponse *api.MapTypeResponse
+		wantErrors   []string
+	}{
+		{
+			desc: "no mappings",
+			responses: []response{
+				{success: &api.MapTypeResponse{}},
+				{success: &api.MapTypeResponse{}},
+			},
+			wantResponse: &api.MapTypeResponse{},
+		},
+		{
+			desc: "single mapping",
+			responses: []response{
+				{success: &api.MapTypeResponse{}},
+				{success: &api.MapTypeResponse{Mapping: mapping}},
+				{success: &api.MapTypeResponse{}},
+			},
+			wantResponse: &api.MapTypeResponse{Mapping: mapping},
+		},
+		{
+			desc: "error",
+			responses: []response{
+				{success: &api.MapTypeResponse{Mapping: mapping}},
+				{failure: errors.New("great sadness")},
+			},
+			wantErrors: []string{"great sadness"},
+		},
+		{
+			desc: "conflict",
+			responses: []response{
+				{success: &api.MapTypeResponse{Mapping: mapping}},
+				{success: &api.MapTypeResponse{Mapping: mapping}},
+			},
+			wantErrors: []string{
+				`plugin conflict: cannot map field "createdAt" for plugin`,
+				`already mapped that field`,
+			},
+		},
+	}
+
+	req := &api.MapTypeRequest{
+		Type:        &api.Type{SimpleType: api.SimpleTypeInt64.Ptr()},
+		Annotations: map[string]string{"go.type": "time"},
+		FieldName:   "createdAt",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var mtm MultiTypeMapper
+			for i, res := range tt.responses {
+				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().Name().Return(fmt.Sprintf("plugin-%d", i)).AnyTimes()
+
+				tm := handletest.NewMockTypeMapper(mockCtrl)
+				tm.EXPECT().MapType(req).Return(res.success, res.failure)
+				tm.EXPECT().Handle().Return(handle).AnyTimes()
+				mtm = append(mtm, tm)
+			}
+
+			res, err := mtm.MapType(req)
+			if len(tt.wantErrors) > 0 {
+				require.Error(t, err)
+				for _, msg := range tt.wantErrors {
+					assert.Contains(t, err.Error(), msg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponse, res)
+		})
+	}
+}
+
+func TestMultiHandleValidator(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var mh MultiHandle
+	for i := 0; i < 10; i++ {
+		handle := handletest.NewMockHandle(mockCtrl)
+		mh = append(mh, handle)
+
+		// only odd handles have a Validator
+		if i%2 == 0 {
+			handle.EXPECT().Validator().Return(nil)
+			continue
+		}
+
+		handle.EXPECT().Validator().Return(handletest.NewMockValidator(mockCtrl))
+	}
+
+	assert.Len(t, mh.Validator(), 5)
+}
+
+func TestMultiValidatorValidate(t *testing.T) {
+	problem := func(msg string) *api.Diagnostic {
+		return &api.Diagnostic{ThriftFilePath: "foo.thrift", Line: 1, Message: msg}
+	}
+
+	type response struct {
+		success *api.ValidateResponse
+		failure error
+	}
+
+	tests := []struct {
+		desc      string
+		responses []response
+
+		wantResponse *api.ValidateResponse
+		wantErrors   []string
+	}{
+		{
+			desc: "no problems",
+			responses: []response{
+				{success: &api.ValidateResponse{}},
+				{success: &api.ValidateResponse{}},
+			},
+			wantResponse: &api.ValidateResponse{},
+		},
+		{
+			desc: "problems",
+			responses: []response{
+				{success: &api.ValidateResponse{Diagnostics: []*api.Diagnostic{problem("a"), problem("b")}}},
+				{success: &api.ValidateResponse{}},
+				{success: &api.ValidateResponse{Diagnostics: []*api.Diagnostic{problem("c")}}},
+			},
+			wantResponse: &api.ValidateResponse{
+				Diagnostics: []*api.Diagnostic{problem("a"), problem("b"), problem("c")},
+			},
+		},
+		{
+			desc: "error",
+			responses: []response{
+				{success: &api.ValidateResponse{Diagnostics: []*api.Diagnostic{problem("a")}}},
+				{failure: errors.New("great sadness")},
+			},
+			wantErrors: []string{"great sadness"},
+		},
+	}
+
+	req := &api.ValidateRequest{
+		ThriftFilePaths: []string{"foo.thrift"},
+		Declarations:    []*api.Declaration{},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var mv MultiValidator
+			for _, res := range tt.responses {
+				v := handletest.NewMockValidator(mockCtrl)
+				v.EXPECT().Validate(req).Return(res.success, res.failure)
+				mv = append(mv, v)
+			}
+
+			res, err := mv.Validate(req)
+			if len(tt.wantErrors) > 0 {
+				require.Error(t, err)
+				for _, msg := range tt.wantErrors {
+					assert.Contains(t, err.Error(), msg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponse, res)
+		})
+	}
+}
+
+func TestMultiHandlePostProcessor(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var mh MultiHandle
+	for i := 0; i < 10; i++ {
+		handle := handletest.NewMockHandle(mockCtrl)
+		mh = append(mh, handle)
+
+		// only odd handles have a PostProcessor
+		if i%2 == 0 {
+			handle.EXPECT().PostProcessor().Return(nil)
+			continue
+		}
+
+		handle.EXPECT().PostProcessor().Return(handletest.NewMockPostProcessor(mockCtrl))
+	}
+
+	assert.Len(t, mh.PostProcessor(), 5)
+}
+
+func TestMultiPostProcessorProcess(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	req := &api.PostProcessRequest{
+		Files: map[string][]byte{
+			"foo/foo.go": []byte("package foo"),
+			"bar/bar.go": []byte("package bar"),
+			"baz/baz.go": []byte("package baz"),
+		},
+		PackagePrefix: "example.com/idl",
+	}
+
+	// The first plugin adds a build tag to foo.go and drops bar.go.
+	first := handletest.NewMockPostProcessor(mockCtrl)
+	first.EXPECT().Process(req).Return(&api.PostProcessResponse{
+		Files: map[string][]byte{
+			"foo/foo.go": []byte("// +build thrift\n\npackage foo"),
+		},
+		RemovedFiles: []string{"bar/bar.go"},
+	}, nil)
+
+	// The second plugin sees the output of the first and appends to foo.go.
+	second := handletest.NewMockPostProcessor(mockCtrl)
+	second.EXPECT().Process(&api.PostProcessRequest{
+		Files: map[string][]byte{
+			"foo/foo.go": []byte("// +build thrift\n\npackage foo"),
+			"baz/baz.go": []byte("package baz"),
+		},
+		PackagePrefix: "example.com/idl",
+	}).Return(&api.PostProcessResponse{
+		Files: map[string][]byte{
+			"foo/foo.go":   []byte("// +build thrift\n\npackage foo\n\nvar x int"),
+			"foo/extra.go": []byte("package foo"),
+			"baz/baz.go":   []byte("package baz"),
+		},
+	}, nil)
+
+	res, err := MultiPostProcessor{first, second}.Process(req)
+	require.NoError(t, err)
+	assert.Equal(t, &api.PostProcessResponse{
+		Files: map[string][]byte{
+			"foo/foo.go":   []byte("// +build thrift\n\npackage foo\n\nvar x int"),
+			"foo/extra.go": []byte("package foo"),
+		},
+		RemovedFiles: []string{"bar/bar.go"},
+	}, res)
+
+	// The request must not be modified.
+	assert.Len(t, req.Files, 3)
+}
+
+func TestMultiPostProcessorProcessError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	req := &api.PostProcessRequest{Files: map[string][]byte{"foo.go": []byte("package foo")}}
+
+	first := handletest.NewMockPostProcessor(mockCtrl)
+	first.EXPECT().Process(req).Return(nil, errors.New("great sadness"))
+
+	// The second plugin isn't called.
+	second := handletest.NewMockPostProcessor(mockCtrl)
+
+	_, err := MultiPostProcessor{first, second}.Process(req)
+	assert.EqualError(t, err, "great sadness")
+}
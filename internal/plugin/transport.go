@@ -29,6 +29,7 @@ import (
 	"go.uber.org/thriftrw/internal/multiplex"
 	"go.uber.org/thriftrw/plugin/api"
 	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
 
 	"go.uber.org/atomic"
 	"go.uber.org/multierr"
@@ -36,11 +37,18 @@ import (
 
 var _proto = protocol.Binary
 
-// transportHandle is a Handle to a plugin which is behind an envelope.Transport.
+// _minAPIVersion is the oldest version of the plugin API still accepted
+// from plugins. Changes to the API since then only added optional fields,
+// features, and services, which older plugins don't know about and never
+// use.
+const _minAPIVersion int32 = 4
+
+// transportHandle is a Handle to a plugin which is behind an envelope.Client.
 type transportHandle struct {
 	name string
 
-	Transport envelope.Transport
+	Transport envelope.Client
+	Closer    io.Closer // may be nil
 	Client    api.Plugin
 	Running   *atomic.Bool
 	Features  map[api.Feature]struct{}
@@ -50,10 +58,22 @@ type transportHandle struct {
 //
 // If the transport is an io.Closer, it will be closed when the handle is closed.
 func NewTransportHandle(name string, t envelope.Transport) (Handle, error) {
-	client := api.NewPluginClient(multiplex.NewClient(
-		"Plugin",
-		envelope.NewClient(_proto, t),
-	))
+	closer, _ := t.(io.Closer)
+	return newTransportHandle(name, envelope.NewClient(_proto, t), closer)
+}
+
+// NewClientHandle builds a new Handle which sends enveloped requests to the
+// plugin using the given client. Use an envelope.Peer to allow the plugin to
+// make requests back to ThriftRW.
+//
+// If the client is an io.Closer, it will be closed when the handle is closed.
+func NewClientHandle(name string, c envelope.Client) (Handle, error) {
+	closer, _ := c.(io.Closer)
+	return newTransportHandle(name, c, closer)
+}
+
+func newTransportHandle(name string, c envelope.Client, closer io.Closer) (Handle, error) {
+	client := api.NewPluginClient(multiplex.NewClient("Plugin", c))
 
 	handshake, err := client.Handshake(&api.HandshakeRequest{})
 	if err != nil {
@@ -67,10 +87,14 @@ func NewTransportHandle(name string, t envelope.Transport) (Handle, error) {
 		}
 	}
 
-	if handshake.APIVersion != api.APIVersion {
+	if handshake.APIVersion < _minAPIVersion || handshake.APIVersion > api.APIVersion {
 		return nil, errHandshakeFailed{
-			Name:   name,
-			Reason: errAPIVersionMismatch{Want: api.APIVersion, Got: handshake.APIVersion},
+			Name: name,
+			Reason: errAPIVersionMismatch{
+				Min: _minAPIVersion,
+				Max: api.APIVersion,
+				Got: handshake.APIVersion,
+			},
 		}
 	}
 
@@ -81,7 +105,8 @@ func NewTransportHandle(name string, t envelope.Transport) (Handle, error) {
 
 	return &transportHandle{
 		name:      name,
-		Transport: t,
+		Transport: c,
+		Closer:    closer,
 		Client:    client,
 		Running:   atomic.NewBool(true),
 		Features:  features,
@@ -98,8 +123,8 @@ func (h *transportHandle) Close() error {
 	}
 
 	err := h.Client.Goodbye()
-	if closer, ok := h.Transport.(io.Closer); ok {
-		err = multierr.Append(err, closer.Close())
+	if h.Closer != nil {
+		err = multierr.Append(err, h.Closer.Close())
 	}
 	return err
 }
@@ -118,7 +143,7 @@ func (h *transportHandle) ServiceGenerator() ServiceGenerator {
 		Running: h.Running,
 		ServiceGenerator: api.NewServiceGeneratorClient(multiplex.NewClient(
 			"ServiceGenerator",
-			envelope.NewClient(_proto, h.Transport),
+			h.Transport,
 		)),
 	}
 }
@@ -158,3 +183,180 @@ func (sg *serviceGenerator) Generate(req *api.GenerateServiceRequest) (*api.Gene
 
 	return res, nil
 }
+
+func (h *transportHandle) TypeMapper() TypeMapper {
+	if !h.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", h.name))
+	}
+
+	if _, hasFeature := h.Features[api.FeatureTypeMapper]; !hasFeature {
+		return nil
+	}
+
+	return &typeMapper{
+		handle:  h,
+		Running: h.Running,
+		TypeMapper: api.NewTypeMapperClient(multiplex.NewClient(
+			"TypeMapper",
+			h.Transport,
+		)),
+	}
+}
+
+// typeMapper is a TypeMapper that validates the output of a TypeMapper.
+//
+// It also panics if a request is made to it after it has been closed.
+type typeMapper struct {
+	handle *transportHandle
+
+	TypeMapper api.TypeMapper
+	Running    *atomic.Bool
+}
+
+func (tm *typeMapper) Handle() Handle {
+	return tm.handle
+}
+
+func (tm *typeMapper) MapType(req *api.MapTypeRequest) (*api.MapTypeResponse, error) {
+	name := tm.handle.name
+	if !tm.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", name))
+	}
+
+	res, err := tm.TypeMapper.MapType(req)
+	if err != nil {
+		return res, fmt.Errorf("plugin %q failed to map field %q: %v", name, req.FieldName, err)
+	}
+
+	if m := res.Mapping; m != nil {
+		funcs := []*api.FunctionReference{m.ToThrift, m.FromThrift}
+		if m.EqualsFunc != nil {
+			funcs = append(funcs, m.EqualsFunc)
+		}
+		for _, f := range funcs {
+			if f == nil || f.Name == "" {
+				return res, fmt.Errorf(
+					"plugin %q returned an invalid mapping for field %q: "+
+						"function references must have names", name, req.FieldName)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (h *transportHandle) Validator() Validator {
+	if !h.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", h.name))
+	}
+
+	if _, hasFeature := h.Features[api.FeatureValidator]; !hasFeature {
+		return nil
+	}
+
+	return &validator{
+		handle:  h,
+		Running: h.Running,
+		Validator: api.NewValidatorClient(multiplex.NewClient(
+			"Validator",
+			h.Transport,
+		)),
+	}
+}
+
+// validator is a Validator that validates the output of a Validator.
+//
+// It also panics if a request is made to it after it has been closed.
+type validator struct {
+	handle *transportHandle
+
+	Validator api.Validator
+	Running   *atomic.Bool
+}
+
+func (v *validator) Handle() Handle {
+	return v.handle
+}
+
+func (v *validator) Validate(req *api.ValidateRequest) (*api.ValidateResponse, error) {
+	name := v.handle.name
+	if !v.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", name))
+	}
+
+	res, err := v.Validator.Validate(req)
+	if err != nil {
+		return res, fmt.Errorf("plugin %q failed to validate Thrift files: %v", name, err)
+	}
+
+	for _, d := range res.Diagnostics {
+		if d == nil || d.ThriftFilePath == "" {
+			return res, fmt.Errorf(
+				"plugin %q returned an invalid diagnostic: "+
+					"diagnostics must have a Thrift file path", name)
+		}
+
+		// Problems that don't name a rule are attributed to the plugin.
+		if d.Rule == nil || *d.Rule == "" {
+			d.Rule = ptr.String(name)
+		}
+	}
+
+	return res, nil
+}
+
+func (h *transportHandle) PostProcessor() PostProcessor {
+	if !h.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", h.name))
+	}
+
+	if _, hasFeature := h.Features[api.FeaturePostProcessor]; !hasFeature {
+		return nil
+	}
+
+	return &postProcessor{
+		handle:  h,
+		Running: h.Running,
+		PostProcessor: api.NewPostProcessorClient(multiplex.NewClient(
+			"PostProcessor",
+			h.Transport,
+		)),
+	}
+}
+
+// postProcessor is a PostProcessor that validates the output of a
+// PostProcessor.
+//
+// It also panics if a request is made to it after it has been closed.
+type postProcessor struct {
+	handle *transportHandle
+
+	PostProcessor api.PostProcessor
+	Running       *atomic.Bool
+}
+
+func (pp *postProcessor) Handle() Handle {
+	return pp.handle
+}
+
+func (pp *postProcessor) Process(req *api.PostProcessRequest) (*api.PostProcessResponse, error) {
+	name := pp.handle.name
+	if !pp.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", name))
+	}
+
+	res, err := pp.PostProcessor.Process(req)
+	if err != nil {
+		return res, fmt.Errorf("plugin %q failed to post-process generated files: %v", name, err)
+	}
+
+	for path := range res.Files {
+		if strings.Contains(path, "..") {
+			return res, fmt.Errorf(
+				"plugin %q is attempting to write to a parent directory: "+
+					`path %q contains ".."`, name, path)
+		}
+	}
+
+	return res, nil
+}
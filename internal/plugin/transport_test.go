@@ -28,6 +28,9 @@ type fakePluginServer struct {
 	ClientTransport  envelope.Transport
 	Plugin           *plugintest.MockPlugin
 	ServiceGenerator *plugintest.MockServiceGenerator
+	TypeMapper       *plugintest.MockTypeMapper
+	Validator        *plugintest.MockValidator
+	PostProcessor    *plugintest.MockPostProcessor
 }
 
 func newFakePluginServer(mockCtrl *gomock.Controller) *fakePluginServer {
@@ -39,10 +42,16 @@ func newFakePluginServer(mockCtrl *gomock.Controller) *fakePluginServer {
 
 	mockPlugin := plugintest.NewMockPlugin(mockCtrl)
 	mockServiceGenerator := plugintest.NewMockServiceGenerator(mockCtrl)
+	mockTypeMapper := plugintest.NewMockTypeMapper(mockCtrl)
+	mockValidator := plugintest.NewMockValidator(mockCtrl)
+	mockPostProcessor := plugintest.NewMockPostProcessor(mockCtrl)
 
 	handler := multiplex.NewHandler()
 	handler.Put("Plugin", api.NewPluginHandler(mockPlugin))
 	handler.Put("ServiceGenerator", api.NewServiceGeneratorHandler(mockServiceGenerator))
+	handler.Put("TypeMapper", api.NewTypeMapperHandler(mockTypeMapper))
+	handler.Put("Validator", api.NewValidatorHandler(mockValidator))
+	handler.Put("PostProcessor", api.NewPostProcessorHandler(mockPostProcessor))
 
 	done := make(chan error)
 	go func() {
@@ -59,6 +68,9 @@ func newFakePluginServer(mockCtrl *gomock.Controller) *fakePluginServer {
 		ClientTransport:  client,
 		Plugin:           mockPlugin,
 		ServiceGenerator: mockServiceGenerator,
+		TypeMapper:       mockTypeMapper,
+		Validator:        mockValidator,
+		PostProcessor:    mockPostProcessor,
 	}
 }
 
@@ -142,7 +154,19 @@ func TestTransportHandleHandshakeError(t *testing.T) {
 				Features:       []api.Feature{},
 			},
 			wantError: `handshake with plugin "foo" failed: ` +
-				fmt.Sprintf("plugin API version mismatch: expected %d but got 42", api.APIVersion),
+				fmt.Sprintf("plugin API version mismatch: expected 4 through %d but got 42", api.APIVersion),
+		},
+		{
+			desc: "version too old",
+			name: "foo",
+			response: &api.HandshakeResponse{
+				Name:           "foo",
+				APIVersion:     3,
+				LibraryVersion: ptr.String(version.Version),
+				Features:       []api.Feature{},
+			},
+			wantError: `handshake with plugin "foo" failed: ` +
+				fmt.Sprintf("plugin API version mismatch: expected 4 through %d but got 3", api.APIVersion),
 		},
 	}
 
@@ -163,6 +187,32 @@ func TestTransportHandleHandshakeError(t *testing.T) {
 	}
 }
 
+func TestTransportHandleOlderAPIVersion(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	// Plugins built against version 4 of the API don't know about the
+	// Generator service or the features added since.
+	server.Plugin.EXPECT().Handshake(&api.HandshakeRequest{}).
+		Return(&api.HandshakeResponse{
+			Name:           "foo",
+			APIVersion:     4,
+			LibraryVersion: ptr.String(version.Version),
+			Features:       []api.Feature{api.FeatureServiceGenerator},
+		}, nil)
+
+	handle, err := NewTransportHandle("foo", server.ClientTransport)
+	require.NoError(t, err)
+	assert.NotNil(t, handle.ServiceGenerator())
+	assert.Nil(t, handle.TypeMapper())
+
+	server.ExpectGoodbye()
+	assert.NoError(t, handle.Close())
+}
+
 func TestTransportHandleServiceGenerator(t *testing.T) {
 	tests := []struct {
 		desc                string
@@ -384,3 +434,341 @@ func TestServiceGeneratorGenerate(t *testing.T) {
 		}()
 	}
 }
+
+func TestTransportHandleTypeMapper(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeatureServiceGenerator})
+	assert.Nil(t, handle.TypeMapper())
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+
+	assert.Panics(t, func() {
+		handle.TypeMapper()
+	})
+}
+
+func TestTypeMapperClosed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeatureTypeMapper})
+	tm := handle.TypeMapper()
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+
+	assert.Panics(t, func() {
+		tm.MapType(&api.MapTypeRequest{})
+	})
+}
+
+func TestTypeMapperMapType(t *testing.T) {
+	timeType := &api.Type{ReferenceType: &api.TypeReference{Name: "Time", ImportPath: "time"}}
+
+	tests := []struct {
+		desc        string
+		mapResponse *api.MapTypeResponse
+		mapError    error
+
+		wantError string
+	}{
+		{
+			desc:        "not mapped",
+			mapResponse: &api.MapTypeResponse{},
+		},
+		{
+			desc: "mapped",
+			mapResponse: &api.MapTypeResponse{Mapping: &api.TypeMapping{
+				Type:       timeType,
+				ToThrift:   &api.FunctionReference{Name: "ToUnix", ImportPath: "example.com/timeutil"},
+				FromThrift: &api.FunctionReference{Name: "FromUnix", ImportPath: "example.com/timeutil"},
+				EqualsFunc: &api.FunctionReference{Name: "Equal", ImportPath: "example.com/timeutil"},
+			}},
+		},
+		{
+			desc: "unnamed function",
+			mapResponse: &api.MapTypeResponse{Mapping: &api.TypeMapping{
+				Type:       timeType,
+				ToThrift:   &api.FunctionReference{Name: "ToUnix"},
+				FromThrift: &api.FunctionReference{Name: "FromUnix"},
+				EqualsFunc: &api.FunctionReference{ImportPath: "example.com/timeutil"},
+			}},
+			wantError: `plugin "foo" returned an invalid mapping for field "createdAt": ` +
+				`function references must have names`,
+		},
+		{
+			desc:     "call error",
+			mapError: errors.New("great sadness"),
+			wantError: `plugin "foo" failed to map field "createdAt": ` +
+				"TApplicationException{Message: great sadness, Type: INTERNAL_ERROR}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			server := newFakePluginServer(mockCtrl)
+			defer server.Close()
+
+			handle := server.Handshake(t, "foo", []api.Feature{api.FeatureTypeMapper})
+			defer func() {
+				server.ExpectGoodbye()
+				require.NoError(t, handle.Close())
+			}()
+
+			req := &api.MapTypeRequest{
+				Type:        &api.Type{SimpleType: api.SimpleTypeInt64.Ptr()},
+				Annotations: map[string]string{"go.type": "time"},
+				FieldName:   "createdAt",
+			}
+			server.TypeMapper.EXPECT().MapType(req).Return(tt.mapResponse, tt.mapError)
+
+			res, err := handle.TypeMapper().MapType(req)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantError, err.Error())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.mapResponse, res)
+		})
+	}
+}
+
+func TestTransportHandleValidator(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeatureServiceGenerator})
+	assert.Nil(t, handle.Validator())
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+
+	assert.Panics(t, func() {
+		handle.Validator()
+	})
+}
+
+func TestValidatorClosed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeatureValidator})
+	v := handle.Validator()
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+
+	assert.Panics(t, func() {
+		v.Validate(&api.ValidateRequest{})
+	})
+}
+
+func TestValidatorValidate(t *testing.T) {
+	tests := []struct {
+		desc             string
+		validateResponse *api.ValidateResponse
+		validateError    error
+
+		wantResponse *api.ValidateResponse
+		wantError    string
+	}{
+		{
+			desc:             "no problems",
+			validateResponse: &api.ValidateResponse{},
+			wantResponse:     &api.ValidateResponse{},
+		},
+		{
+			desc: "problems",
+			validateResponse: &api.ValidateResponse{Diagnostics: []*api.Diagnostic{
+				{ThriftFilePath: "foo.thrift", Line: 3, Message: "bad name", Rule: ptr.String("naming")},
+				{ThriftFilePath: "foo.thrift", Message: "missing namespace"},
+			}},
+			wantResponse: &api.ValidateResponse{Diagnostics: []*api.Diagnostic{
+				{ThriftFilePath: "foo.thrift", Line: 3, Message: "bad name", Rule: ptr.String("naming")},
+				{ThriftFilePath: "foo.thrift", Message: "missing namespace", Rule: ptr.String("foo")},
+			}},
+		},
+		{
+			desc: "no file",
+			validateResponse: &api.ValidateResponse{Diagnostics: []*api.Diagnostic{
+				{Line: 3, Message: "bad name"},
+			}},
+			wantError: `plugin "foo" returned an invalid diagnostic: ` +
+				"diagnostics must have a Thrift file path",
+		},
+		{
+			desc:          "call error",
+			validateError: errors.New("great sadness"),
+			wantError: `plugin "foo" failed to validate Thrift files: ` +
+				"TApplicationException{Message: great sadness, Type: INTERNAL_ERROR}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			server := newFakePluginServer(mockCtrl)
+			defer server.Close()
+
+			handle := server.Handshake(t, "foo", []api.Feature{api.FeatureValidator})
+			defer func() {
+				server.ExpectGoodbye()
+				require.NoError(t, handle.Close())
+			}()
+
+			req := &api.ValidateRequest{
+				ThriftFilePaths: []string{"foo.thrift"},
+				Declarations:    []*api.Declaration{},
+			}
+			server.Validator.EXPECT().Validate(req).Return(tt.validateResponse, tt.validateError)
+
+			res, err := handle.Validator().Validate(req)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantError, err.Error())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponse, res)
+		})
+	}
+}
+
+func TestTransportHandlePostProcessor(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeatureServiceGenerator})
+	assert.Nil(t, handle.PostProcessor())
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+
+	assert.Panics(t, func() {
+		handle.PostProcessor()
+	})
+}
+
+func TestPostProcessorClosed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	server := newFakePluginServer(mockCtrl)
+	defer server.Close()
+
+	handle := server.Handshake(t, "foo", []api.Feature{api.FeaturePostProcessor})
+	pp := handle.PostProcessor()
+
+	server.ExpectGoodbye()
+	require.NoError(t, handle.Close())
+
+	assert.Panics(t, func() {
+		pp.Process(&api.PostProcessRequest{})
+	})
+}
+
+func TestPostProcessorProcess(t *testing.T) {
+	tests := []struct {
+		desc            string
+		processResponse *api.PostProcessResponse
+		processError    error
+
+		wantResponse *api.PostProcessResponse
+		wantError    string
+	}{
+		{
+			desc:            "no changes",
+			processResponse: &api.PostProcessResponse{},
+			wantResponse:    &api.PostProcessResponse{},
+		},
+		{
+			desc: "changes",
+			processResponse: &api.PostProcessResponse{
+				Files:        map[string][]byte{"foo/foo.go": []byte("// +build thrift\n\npackage foo")},
+				RemovedFiles: []string{"foo/bar.go"},
+			},
+			wantResponse: &api.PostProcessResponse{
+				Files:        map[string][]byte{"foo/foo.go": []byte("// +build thrift\n\npackage foo")},
+				RemovedFiles: []string{"foo/bar.go"},
+			},
+		},
+		{
+			desc: "parent directory",
+			processResponse: &api.PostProcessResponse{
+				Files: map[string][]byte{"../foo.go": []byte("package foo")},
+			},
+			wantError: `plugin "foo" is attempting to write to a parent directory: ` +
+				`path "../foo.go" contains ".."`,
+		},
+		{
+			desc:         "call error",
+			processError: errors.New("great sadness"),
+			wantError: `plugin "foo" failed to post-process generated files: ` +
+				"TApplicationException{Message: great sadness, Type: INTERNAL_ERROR}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			server := newFakePluginServer(mockCtrl)
+			defer server.Close()
+
+			handle := server.Handshake(t, "foo", []api.Feature{api.FeaturePostProcessor})
+			defer func() {
+				server.ExpectGoodbye()
+				require.NoError(t, handle.Close())
+			}()
+
+			req := &api.PostProcessRequest{
+				Files: map[string][]byte{
+					"foo/foo.go": []byte("package foo"),
+					"foo/bar.go": []byte("package foo"),
+				},
+				PackagePrefix: "example.com/idl",
+			}
+			server.PostProcessor.EXPECT().Process(req).Return(tt.processResponse, tt.processError)
+
+			res, err := handle.PostProcessor().Process(req)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantError, err.Error())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponse, res)
+		})
+	}
+}
@@ -46,18 +46,9 @@ type Client struct {
 //
 // The Cmd MUST NOT have Stdout or Stdin set.
 func NewClient(cmd *exec.Cmd) (*Client, error) {
-	stdout, err := cmd.StdoutPipe()
+	stdin, stdout, err := start(cmd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create stdout pipe to %q: %v", cmd.Path, err)
-	}
-
-	stdin, err := cmd.StdinPipe()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create stdin pipe to %q: %v", cmd.Path, err)
-	}
-
-	if err := cmd.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start %q: %v", cmd.Path, err)
+		return nil, err
 	}
 
 	return &Client{
@@ -69,6 +60,26 @@ func NewClient(cmd *exec.Cmd) (*Client, error) {
 	}, nil
 }
 
+// start starts up the given external process and returns pipes to its stdin
+// and stdout.
+func start(cmd *exec.Cmd) (stdin io.WriteCloser, stdout io.ReadCloser, err error) {
+	stdout, err = cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create stdout pipe to %q: %v", cmd.Path, err)
+	}
+
+	stdin, err = cmd.StdinPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create stdin pipe to %q: %v", cmd.Path, err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, nil, fmt.Errorf("failed to start %q: %v", cmd.Path, err)
+	}
+
+	return stdin, stdout, nil
+}
+
 // Send sends the given frame to the external process and returns the response.
 //
 // Panics if Close was already called.
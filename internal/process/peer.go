@@ -0,0 +1,97 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package process
+
+import (
+	"fmt"
+	"os/exec"
+
+	"go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"go.uber.org/atomic"
+	"go.uber.org/multierr"
+)
+
+// Peer exchanges enveloped requests and responses with an external process in
+// both directions.
+type Peer struct {
+	running *atomic.Bool
+	cmd     *exec.Cmd
+	peer    *envelope.Peer
+	done    chan error // receives the result of Serve
+}
+
+var _ envelope.Client = (*Peer)(nil)
+
+// NewPeer starts up the given external process and communicates with it over
+// stdin and stdout using framed envelopes, encoded with the given protocol.
+// Requests made by the process are handled by h.
+//
+// The Cmd MUST NOT have Stdout or Stdin set.
+func NewPeer(cmd *exec.Cmd, p protocol.Protocol, h envelope.Handler) (*Peer, error) {
+	stdin, stdout, err := start(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	peer := &Peer{
+		running: atomic.NewBool(true),
+		cmd:     cmd,
+		peer:    envelope.NewPeer(p, stdout, stdin, h),
+		done:    make(chan error, 1),
+	}
+	go func() {
+		peer.done <- peer.peer.Serve()
+	}()
+	return peer, nil
+}
+
+// Send sends a request to the external process and returns its response.
+//
+// Panics if Close was already called.
+func (p *Peer) Send(name string, body wire.Value) (wire.Value, error) {
+	if !p.running.Load() {
+		panic(fmt.Sprintf("process.Peer for %q has been closed", p.cmd.Path))
+	}
+
+	return p.peer.Send(name, body)
+}
+
+// Close detaches from the external process and waits for it to exit.
+func (p *Peer) Close() error {
+	if !p.running.Swap(false) {
+		return nil // already stopped
+	}
+
+	var errors []error
+	if err := p.peer.Stop(); err != nil {
+		errors = append(errors, fmt.Errorf("failed to detach stdout from %q: %v", p.cmd.Path, err))
+	}
+	if err := <-p.done; err != nil {
+		errors = append(errors, fmt.Errorf("lost connection to %q: %v", p.cmd.Path, err))
+	}
+	if err := p.cmd.Wait(); err != nil {
+		errors = append(errors, fmt.Errorf("%q failed with: %v", p.cmd.Path, err))
+	}
+	return multierr.Combine(errors...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package process
+
+import (
+	"os/exec"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type handlerFunc func(string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(name string, body wire.Value) (wire.Value, error) {
+	return f(name, body)
+}
+
+func TestPeerEcho(t *testing.T) {
+	// cat echoes our own request back to us. We handle it and our response
+	// is echoed back as the response to the original request.
+	var handled []string
+	peer, err := NewPeer(exec.Command("cat"), protocol.Binary, handlerFunc(
+		func(name string, body wire.Value) (wire.Value, error) {
+			handled = append(handled, name)
+			return body, nil
+		}))
+	require.NoError(t, err)
+
+	give := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueI32(42)},
+	}})
+	got, err := peer.Send("hello", give)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(give, got), "response mismatch")
+	assert.Equal(t, []string{"hello"}, handled)
+
+	require.NoError(t, peer.Close())
+	require.NoError(t, peer.Close(), "closing twice must not fail")
+
+	assert.Panics(t, func() {
+		peer.Send("hello", give)
+	})
+}
+
+func TestPeerStartError(t *testing.T) {
+	_, err := NewPeer(exec.Command("this_command_does_not_exist"), protocol.Binary, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `failed to start "this_command_does_not_exist":`)
+	}
+}
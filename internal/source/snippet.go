@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package source renders excerpts of Thrift documents for error messages.
+package source
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// Snippet renders the line of src at the given position followed by a caret
+// pointing at the column.
+//
+// 	  12 | struct Foo {
+// 	     |        ^
+//
+// Every line of the output is prefixed with indent and terminated by a
+// newline. An empty string is returned if the position is not inside src.
+func Snippet(src []byte, pos ast.Position, indent string) string {
+	if pos.Line < 1 {
+		return ""
+	}
+
+	lines := bytes.Split(src, []byte{'\n'})
+	if pos.Line > len(lines) {
+		return ""
+	}
+	line := bytes.TrimRight(lines[pos.Line-1], "\r")
+
+	// Tabs are copied from the source line so that the caret lines up
+	// regardless of the reader's tab width.
+	col := pos.Column - 1
+	if col < 0 {
+		col = 0
+	} else if col > len(line) {
+		col = len(line)
+	}
+	var caret strings.Builder
+	for _, c := range line[:col] {
+		if c == '\t' {
+			caret.WriteByte('\t')
+		} else {
+			caret.WriteByte(' ')
+		}
+	}
+	caret.WriteByte('^')
+
+	lineNo := fmt.Sprint(pos.Line)
+	var buff strings.Builder
+	fmt.Fprintf(&buff, "%v%v | %s\n", indent, lineNo, line)
+	fmt.Fprintf(&buff, "%v%v | %v\n", indent, strings.Repeat(" ", len(lineNo)), caret.String())
+	return buff.String()
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestSnippet(t *testing.T) {
+	src := []byte("namespace go foo\n\nstruct Foo {\n\t1: required strin bar\r\n}")
+
+	tests := []struct {
+		desc   string
+		pos    ast.Position
+		indent string
+		want   string
+	}{
+		{
+			desc: "first line",
+			pos:  ast.Position{Line: 1, Column: 14},
+			want: "1 | namespace go foo\n" +
+				"  |              ^\n",
+		},
+		{
+			desc:   "tabs and carriage returns",
+			pos:    ast.Position{Line: 4, Column: 14},
+			indent: "  ",
+			want: "  4 | \t1: required strin bar\n" +
+				"    | \t            ^\n",
+		},
+		{
+			desc: "column past the end of the line",
+			pos:  ast.Position{Line: 5, Column: 10},
+			want: "5 | }\n" +
+				"  |  ^\n",
+		},
+		{
+			desc: "no column",
+			pos:  ast.Position{Line: 3},
+			want: "3 | struct Foo {\n" +
+				"  | ^\n",
+		},
+		{desc: "no line", pos: ast.Position{}},
+		{desc: "line out of range", pos: ast.Position{Line: 6, Column: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, Snippet(src, tt.pos, tt.indent))
+		})
+	}
+}
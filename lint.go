@@ -0,0 +1,135 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"go.uber.org/thriftrw/idl"
+	"go.uber.org/thriftrw/lint"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type lintOptions struct {
+	Disable     []string `long:"disable" value-name:"RULE" description:"Disable the given lint rule. This option may be provided multiple times."`
+	Diagnostics string   `long:"diagnostics" value-name:"FORMAT" description:"Format in which problems are reported: text or json. With json, the problems and any parse errors are written as a JSON object with a list of diagnostics. Defaults to text."`
+}
+
+// runLint runs the linter over the Thrift files in args and writes the
+// problems found to out. An error is returned if any problems were found.
+func runLint(args []string, out io.Writer) error {
+	var opts lintOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw lint"
+	parser.Usage = "[OPTIONS] FILE..."
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	jsonDiagnostics, err := parseDiagnosticsFormat(opts.Diagnostics)
+	if err != nil {
+		return err
+	}
+
+	linter := lint.New()
+	for _, name := range opts.Disable {
+		if err := linter.Disable(name); err != nil {
+			return err
+		}
+	}
+
+	if jsonDiagnostics {
+		return lintDiagnostics(linter, files, out)
+	}
+
+	var count int
+	for _, file := range files {
+		problems, err := linter.LintFile(file)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range problems {
+			fmt.Fprintln(out, p)
+		}
+		count += len(problems)
+	}
+
+	if count > 0 {
+		return fmt.Errorf("found %d lint problem(s)", count)
+	}
+	return nil
+}
+
+// lintDiagnostics lints the given files and writes the problems found, and
+// any errors encountered parsing the files, to out as JSON.
+func lintDiagnostics(linter *lint.Linter, files []string, out io.Writer) error {
+	var (
+		ds       []diagnostic
+		problems int
+	)
+	for _, file := range files {
+		contents, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		prog, err := idl.Parse(contents)
+		if perr, ok := err.(*idl.ParseError); ok {
+			ds = append(ds, compileDiagnostics(file, perr)...)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		ps := linter.Lint(file, prog)
+		ds = append(ds, problemDiagnostics(severityWarning, ps)...)
+		problems += len(ps)
+	}
+
+	if err := writeDiagnostics(out, ds); err != nil {
+		return err
+	}
+	if len(ds) > problems {
+		return fmt.Errorf("could not parse %d file(s)", len(ds)-problems)
+	}
+	if problems > 0 {
+		return fmt.Errorf("found %d lint problem(s)", problems)
+	}
+	return nil
+}
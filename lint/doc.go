@@ -0,0 +1,43 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package lint checks Thrift files for problems that are legal Thrift but
+// are likely to cause trouble, such as names that collide with Go keywords
+// or fields that will be difficult to evolve.
+//
+// A Linter runs a set of Rules over the AST of a Thrift file. New comes with
+// the rules provided by ThriftRW; organizations may Register their own.
+//
+//   linter := lint.New()
+//   if err := linter.Register(myRule); err != nil {
+//     return err
+//   }
+//
+//   problems, err := linter.LintFile("service.thrift")
+//
+// Rules may be implemented with NewRule.
+//
+//   var noUnions = lint.NewRule("no-unions",
+//     func(w ast.Walker, n ast.Node, r lint.Reporter) {
+//       if s, ok := n.(*ast.Struct); ok && s.Type == ast.UnionType {
+//         r.Report(n, "union %q is not allowed", s.Name)
+//       }
+//     })
+package lint
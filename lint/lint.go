@@ -0,0 +1,220 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+)
+
+// Rule is a single check run by a Linter.
+type Rule interface {
+	// Name uniquely identifies this rule. Names are used in reported
+	// problems and to disable rules.
+	Name() string
+
+	// Check is called with every node in the AST of the Thrift file being
+	// linted. Problems found with the node should be reported to the given
+	// Reporter.
+	Check(w ast.Walker, n ast.Node, r Reporter)
+}
+
+// Reporter records problems found by a Rule.
+type Reporter interface {
+	// Report records a problem with the given node.
+	Report(n ast.Node, format string, args ...interface{})
+}
+
+// ReportFix records a problem with the given node along with a suggested
+// fix for it, for example "mark the field optional". The fix is dropped if
+// the Reporter was not provided by a Linter.
+func ReportFix(r Reporter, n ast.Node, fix string, format string, args ...interface{}) {
+	if fr, ok := r.(fixReporter); ok {
+		fr.reportFix(n, fix, format, args...)
+		return
+	}
+	r.Report(n, format, args...)
+}
+
+// fixReporter is implemented by Reporters which record suggested fixes.
+type fixReporter interface {
+	reportFix(n ast.Node, fix string, format string, args ...interface{})
+}
+
+// NewRule builds a Rule with the given name which checks nodes with the
+// given function.
+func NewRule(name string, check func(ast.Walker, ast.Node, Reporter)) Rule {
+	return funcRule{name: name, check: check}
+}
+
+type funcRule struct {
+	name  string
+	check func(ast.Walker, ast.Node, Reporter)
+}
+
+func (r funcRule) Name() string { return r.name }
+
+func (r funcRule) Check(w ast.Walker, n ast.Node, rep Reporter) {
+	r.check(w, n, rep)
+}
+
+// Problem is a problem found in a Thrift file.
+type Problem struct {
+	// Path to the Thrift file.
+	File string
+
+	// Line on which the problem was found, or 0 if unknown.
+	Line int
+
+	// Column at which the problem was found, or 0 if unknown.
+	Column int
+
+	// Name of the Rule which found this problem.
+	Rule string
+
+	Message string
+
+	// Suggested fix for the problem, if any.
+	Fix string
+}
+
+func (p Problem) String() string {
+	pos := ast.Position{Line: p.Line, Column: p.Column}
+	return fmt.Sprintf("%v:%v: %v (%v)", p.File, pos, p.Message, p.Rule)
+}
+
+// Linter checks Thrift files for problems.
+type Linter struct {
+	rules []Rule
+}
+
+// New builds a Linter with the default rules provided by ThriftRW.
+func New() *Linter {
+	l := &Linter{}
+	for _, r := range DefaultRules() {
+		if err := l.Register(r); err != nil {
+			panic(err)
+		}
+	}
+	return l
+}
+
+// Register adds a rule to this Linter. An error is returned if a rule with
+// the same name has already been registered.
+func (l *Linter) Register(r Rule) error {
+	name := r.Name()
+	if name == "" {
+		return fmt.Errorf("cannot register rule %v: rules must have a name", r)
+	}
+	if l.find(name) >= 0 {
+		return fmt.Errorf("cannot register rule %q: a rule with that name already exists", name)
+	}
+	l.rules = append(l.rules, r)
+	return nil
+}
+
+// Disable removes the rule with the given name from this Linter. An error is
+// returned if no such rule is registered.
+func (l *Linter) Disable(name string) error {
+	i := l.find(name)
+	if i < 0 {
+		return fmt.Errorf("unknown lint rule %q", name)
+	}
+	l.rules = append(l.rules[:i], l.rules[i+1:]...)
+	return nil
+}
+
+func (l *Linter) find(name string) int {
+	for i, r := range l.rules {
+		if r.Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Lint runs all rules over the given program and returns the problems they
+// found, ordered by position. path is used only to populate the File field
+// of the returned problems.
+func (l *Linter) Lint(path string, p *ast.Program) []Problem {
+	var problems []Problem
+	ast.Walk(ast.VisitorFunc(func(w ast.Walker, n ast.Node) {
+		for _, rule := range l.rules {
+			rule.Check(w, n, reporter{
+				File:     path,
+				Rule:     rule.Name(),
+				Problems: &problems,
+			})
+		}
+	}), p)
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Line != problems[j].Line {
+			return problems[i].Line < problems[j].Line
+		}
+		return problems[i].Column < problems[j].Column
+	})
+	return problems
+}
+
+// LintFile parses the Thrift file at the given path and runs all rules over
+// it.
+//
+// Included files are not linted.
+func (l *Linter) LintFile(path string) ([]Problem, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	prog, err := idl.Parse(contents)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q: %v", path, err)
+	}
+
+	return l.Lint(path, prog), nil
+}
+
+type reporter struct {
+	File     string
+	Rule     string
+	Problems *[]Problem
+}
+
+func (r reporter) Report(n ast.Node, format string, args ...interface{}) {
+	r.reportFix(n, "", format, args...)
+}
+
+func (r reporter) reportFix(n ast.Node, fix string, format string, args ...interface{}) {
+	pos := ast.Pos(n)
+	*r.Problems = append(*r.Problems, Problem{
+		File:    r.File,
+		Line:    pos.Line,
+		Column:  pos.Column,
+		Rule:    r.Rule,
+		Message: fmt.Sprintf(format, args...),
+		Fix:     fix,
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var noUnions = NewRule("no-unions", func(w ast.Walker, n ast.Node, r Reporter) {
+	if s, ok := n.(*ast.Struct); ok && s.Type == ast.UnionType {
+		r.Report(n, "union %q is not allowed", s.Name)
+	}
+})
+
+func writeThrift(t *testing.T, contents string) (path string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "thriftrw-lint")
+	require.NoError(t, err)
+
+	path = filepath.Join(dir, "test.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestLinterCustomRule(t *testing.T) {
+	path, cleanup := writeThrift(t, `
+		union Foo {
+			1: i32 a
+		}
+
+		struct Bar {
+			0: optional i32 b
+		}
+	`)
+	defer cleanup()
+
+	linter := New()
+	require.NoError(t, linter.Register(noUnions))
+
+	problems, err := linter.LintFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []Problem{
+		{File: path, Line: 2, Column: 3, Rule: "no-unions", Message: `union "Foo" is not allowed`},
+		{File: path, Line: 7, Column: 4, Rule: "field-id", Message: `field "b" has ID 0: field IDs must be positive`},
+	}, problems)
+
+	assert.Equal(t,
+		path+`:2:3: union "Foo" is not allowed (no-unions)`,
+		problems[0].String())
+}
+
+func TestLinterRegisterErrors(t *testing.T) {
+	linter := New()
+
+	err := linter.Register(NewRule("field-id", func(ast.Walker, ast.Node, Reporter) {}))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `a rule with that name already exists`)
+	}
+
+	err = linter.Register(NewRule("", func(ast.Walker, ast.Node, Reporter) {}))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `rules must have a name`)
+	}
+}
+
+func TestLinterDisable(t *testing.T) {
+	path, cleanup := writeThrift(t, `
+		struct Foo {
+			0: required i32 a
+		}
+	`)
+	defer cleanup()
+
+	linter := New()
+	require.NoError(t, linter.Disable("required-without-default"))
+
+	problems, err := linter.LintFile(path)
+	require.NoError(t, err)
+	if assert.Len(t, problems, 1) {
+		assert.Equal(t, "field-id", problems[0].Rule)
+	}
+
+	err = linter.Disable("required-without-default")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `unknown lint rule "required-without-default"`)
+	}
+}
+
+func TestLintFileErrors(t *testing.T) {
+	_, err := New().LintFile("does/not/exist.thrift")
+	assert.Error(t, err)
+
+	path, cleanup := writeThrift(t, "struct {")
+	defer cleanup()
+
+	_, err = New().LintFile(path)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "could not parse")
+	}
+}
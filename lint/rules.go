@@ -0,0 +1,262 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/internal/goast"
+)
+
+// DefaultRules returns the rules provided by ThriftRW.
+//
+//   field-id:
+//     Field IDs must be positive. Fields without explicit IDs are assigned
+//     negative IDs by other Thrift implementations.
+//   required-without-default:
+//     Required struct fields should have default values. Required fields
+//     without defaults cannot be added to an existing struct without
+//     breaking older producers of that struct.
+//   go-keyword:
+//     Function parameters should not be named after Go keywords, predeclared
+//     Go identifiers like string or len, or the Go name another parameter
+//     gets. Generated code renames such parameters by appending a number.
+//   enum-gap:
+//     Enum values should be contiguous. Gaps are often left behind by
+//     removed items whose values should not be reused.
+//   deprecated-type:
+//     The senum and slist types are deprecated. They are accepted only so
+//     that older Thrift files still parse, and are treated as strings.
+//   deprecated-usage:
+//     Declarations annotated with (deprecated) should not be used. Types,
+//     enum items, and services of the same file referenced outside other
+//     deprecated declarations are reported.
+func DefaultRules() []Rule {
+	return []Rule{
+		NewRule("field-id", checkFieldID),
+		NewRule("required-without-default", checkRequiredWithoutDefault),
+		NewRule("go-keyword", checkGoKeyword),
+		NewRule("enum-gap", checkEnumGap),
+		NewRule("deprecated-type", checkDeprecatedType),
+		NewRule("deprecated-usage", checkDeprecatedUsage),
+	}
+}
+
+func checkFieldID(w ast.Walker, n ast.Node, r Reporter) {
+	if f, ok := n.(*ast.Field); ok && f.ID <= 0 {
+		r.Report(f, "field %q has ID %d: field IDs must be positive", f.Name, f.ID)
+	}
+}
+
+func checkRequiredWithoutDefault(w ast.Walker, n ast.Node, r Reporter) {
+	f, ok := n.(*ast.Field)
+	if !ok || f.Requiredness != ast.Required || f.Default != nil {
+		return
+	}
+
+	// Function parameters, exceptions, and unions are not subject to this
+	// rule.
+	s, ok := w.Parent().(*ast.Struct)
+	if !ok || s.Type != ast.StructType {
+		return
+	}
+
+	ReportFix(r, f, "mark the field optional or give it a default value",
+		"required field %q of %q does not have a default value: "+
+		"adding it to an existing struct breaks compatibility", f.Name, s.Name)
+}
+
+func checkGoKeyword(w ast.Walker, n ast.Node, r Reporter) {
+	fn, ok := n.(*ast.Function)
+	if !ok {
+		return
+	}
+
+	// This mirrors how the generator names parameters: a name is taken if
+	// it's a Go keyword, a predeclared identifier, or the name of an earlier
+	// parameter, in which case the smallest number starting at 2 which makes
+	// it unique is appended to it.
+	isTaken := func(name string, taken map[string]struct{}) bool {
+		_, ok := taken[name]
+		return ok || goast.IsReservedKeyword(name) || goast.IsPredeclared(name)
+	}
+
+	taken := make(map[string]struct{}, len(fn.Parameters))
+	for _, p := range fn.Parameters {
+		name := p.Name
+		for i := 2; isTaken(name, taken); i++ {
+			name = fmt.Sprintf("%s%d", p.Name, i)
+		}
+		taken[name] = struct{}{}
+		if name == p.Name {
+			continue
+		}
+
+		var reason string
+		switch {
+		case goast.IsReservedKeyword(p.Name):
+			reason = "is a Go keyword"
+		case goast.IsPredeclared(p.Name):
+			reason = "is a predeclared Go identifier"
+		default:
+			reason = "is used by another parameter"
+		}
+		ReportFix(r, p, fmt.Sprintf("rename the parameter to %q", name),
+			"parameter %q of function %q %v: it will be named %q in Go",
+			p.Name, fn.Name, reason, name)
+	}
+}
+
+func checkEnumGap(w ast.Walker, n ast.Node, r Reporter) {
+	e, ok := n.(*ast.Enum)
+	if !ok {
+		return
+	}
+
+	// Items without explicit values take the value of the previous item
+	// plus one, starting at zero.
+	next := 0
+	for i, item := range e.Items {
+		value := next
+		if item.Value != nil {
+			value = *item.Value
+		}
+		switch {
+		case i == 0 || value <= next:
+			// no gap
+		case value == next+1:
+			r.Report(item, "enum %q skips value %d before %q", e.Name, next, item.Name)
+		default:
+			r.Report(item, "enum %q skips values %d through %d before %q",
+				e.Name, next, value-1, item.Name)
+		}
+		next = value + 1
+	}
+}
+
+func checkDeprecatedType(w ast.Walker, n ast.Node, r Reporter) {
+	switch n := n.(type) {
+	case *ast.Senum:
+		ReportFix(r, n, fmt.Sprintf("replace the senum with typedef string %v", n.Name),
+			"senum %q is deprecated: use a typedef of string instead", n.Name)
+	case ast.BaseType:
+		if n.ID == ast.SlistTypeID {
+			ReportFix(r, n, "replace slist with string", "slist is deprecated: use string instead")
+		}
+	}
+}
+
+// deprecatedAnnotation marks a declaration as deprecated. Its value, if
+// any, explains what to use instead.
+const deprecatedAnnotation = "deprecated"
+
+func checkDeprecatedUsage(w ast.Walker, n ast.Node, r Reporter) {
+	var kind, name string
+	switch n := n.(type) {
+	case ast.TypeReference:
+		kind, name = "type", n.Name
+	case ast.ConstantReference:
+		kind, name = "enum item", n.Name
+	case *ast.Service:
+		if n.Parent == nil {
+			return
+		}
+		kind, name = "service", n.Parent.Name
+	default:
+		return
+	}
+
+	ancestors := w.Ancestors()
+	for _, a := range ancestors {
+		if _, ok := deprecation(a); ok {
+			// Deprecated declarations may use each other.
+			return
+		}
+	}
+	if len(ancestors) == 0 {
+		return
+	}
+	prog, ok := ancestors[len(ancestors)-1].(*ast.Program)
+	if !ok {
+		return
+	}
+
+	msg, ok := deprecatedDeclarations(prog)[name]
+	if !ok {
+		return
+	}
+	if msg == "" {
+		r.Report(n, "%v %q is deprecated", kind, name)
+	} else {
+		r.Report(n, "%v %q is deprecated: %v", kind, name, msg)
+	}
+}
+
+// deprecatedDeclarations returns the deprecation messages of the deprecated
+// types, enum items, and services declared in the given program, indexed by
+// the names used to refer to them.
+func deprecatedDeclarations(prog *ast.Program) map[string]string {
+	decls := make(map[string]string)
+	for _, def := range prog.Definitions {
+		if msg, ok := deprecation(def); ok {
+			decls[def.Info().Name] = msg
+		}
+
+		if e, ok := def.(*ast.Enum); ok {
+			for _, item := range e.Items {
+				if msg, ok := deprecation(item); ok {
+					decls[e.Name+"."+item.Name] = msg
+				}
+			}
+		}
+	}
+	return decls
+}
+
+// deprecation returns the deprecation message of the given node if it is
+// annotated as deprecated.
+func deprecation(n ast.Node) (msg string, ok bool) {
+	var anns []*ast.Annotation
+	switch n := n.(type) {
+	case *ast.Typedef:
+		anns = n.Annotations
+	case *ast.Enum:
+		anns = n.Annotations
+	case *ast.EnumItem:
+		anns = n.Annotations
+	case *ast.Struct:
+		anns = n.Annotations
+	case *ast.Service:
+		anns = n.Annotations
+	case *ast.Function:
+		anns = n.Annotations
+	case *ast.Field:
+		anns = n.Annotations
+	}
+
+	for _, a := range anns {
+		if a.Name == deprecatedAnnotation {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
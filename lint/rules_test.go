@@ -0,0 +1,221 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/idl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRules(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want []Problem
+	}{
+		{
+			desc: "no problems",
+			give: `
+				struct Foo {
+					1: required string a = ""
+					2: optional i32 b
+				}
+
+				enum Bar { A, B, C = 2, D }
+
+				service Baz {
+					void qux(1: string type_)
+				}
+			`,
+		},
+		{
+			desc: "Go keywords",
+			give: `
+				struct Foo {
+					1: optional string type
+				}
+
+				service Svc {
+					void f(1: string type, 2: string len, 3: string type2, 4: string value)
+				}
+			`,
+			want: []Problem{
+				{
+					Line: 7, Column: 13, Rule: "go-keyword",
+					Message: `parameter "type" of function "f" is a Go keyword: it will be named "type2" in Go`,
+					Fix:     `rename the parameter to "type2"`,
+				},
+				{
+					Line: 7, Column: 29, Rule: "go-keyword",
+					Message: `parameter "len" of function "f" is a predeclared Go identifier: it will be named "len2" in Go`,
+					Fix:     `rename the parameter to "len2"`,
+				},
+				{
+					Line: 7, Column: 44, Rule: "go-keyword",
+					Message: `parameter "type2" of function "f" is used by another parameter: it will be named "type22" in Go`,
+					Fix:     `rename the parameter to "type22"`,
+				},
+			},
+		},
+		{
+			desc: "field IDs",
+			give: `
+				struct Foo {
+					0: optional string a
+					-1: optional string b
+					1: optional string c
+				}
+			`,
+			want: []Problem{
+				{Line: 3, Column: 6, Rule: "field-id", Message: `field "a" has ID 0: field IDs must be positive`},
+				{Line: 4, Column: 6, Rule: "field-id", Message: `field "b" has ID -1: field IDs must be positive`},
+			},
+		},
+		{
+			desc: "required without default",
+			give: `
+				struct Foo {
+					1: required string a
+					2: required string b = "b"
+				}
+
+				exception Err {
+					1: required string message
+				}
+
+				union Choice {
+					1: required string a
+					2: optional string b
+				}
+
+				service Svc {
+					void f(1: required string key) throws (1: required Err err)
+				}
+			`,
+			want: []Problem{
+				{
+					Line:   3,
+					Column: 6,
+					Rule:   "required-without-default",
+					Message: `required field "a" of "Foo" does not have a default value: ` +
+						"adding it to an existing struct breaks compatibility",
+					Fix: "mark the field optional or give it a default value",
+				},
+			},
+		},
+		{
+			desc: "enum gaps",
+			give: `
+				enum Foo {
+					A = 1,
+					B,
+					C = 4,
+					D = 10,
+					E = 2,
+					F,
+				}
+			`,
+			want: []Problem{
+				{Line: 5, Column: 6, Rule: "enum-gap", Message: `enum "Foo" skips value 3 before "C"`},
+				{Line: 6, Column: 6, Rule: "enum-gap", Message: `enum "Foo" skips values 5 through 9 before "D"`},
+			},
+		},
+		{
+			desc: "deprecated types",
+			give: `
+				senum Color { "red", "green" }
+
+				struct Foo {
+					1: optional slist names
+					2: optional list<slist> more
+				}
+			`,
+			want: []Problem{
+				{
+					Line: 2, Column: 5, Rule: "deprecated-type",
+					Message: `senum "Color" is deprecated: use a typedef of string instead`,
+					Fix:     "replace the senum with typedef string Color",
+				},
+				{
+					Line: 5, Column: 18, Rule: "deprecated-type",
+					Message: "slist is deprecated: use string instead",
+					Fix:     "replace slist with string",
+				},
+				{
+					Line: 6, Column: 23, Rule: "deprecated-type",
+					Message: "slist is deprecated: use string instead",
+					Fix:     "replace slist with string",
+				},
+			},
+		},
+		{
+			desc: "deprecated usage",
+			give: `
+				struct Old {} (deprecated = "use New")
+				struct New {}
+
+				enum Status {
+					ACTIVE,
+					SUSPENDED (deprecated),
+				}
+
+				typedef Old Older (deprecated)
+
+				struct Foo {
+					1: optional Old old
+					2: optional list<New> latest
+					3: optional Status status = Status.SUSPENDED
+					4: optional Older older (deprecated)
+				}
+
+				service Base {} (deprecated = "use Svc2")
+
+				service Svc extends Base {
+					Old get(1: Status status = Status.ACTIVE)
+				}
+			`,
+			want: []Problem{
+				{Line: 13, Column: 18, Rule: "deprecated-usage", Message: `type "Old" is deprecated: use New`},
+				{Line: 15, Column: 34, Rule: "deprecated-usage", Message: `enum item "Status.SUSPENDED" is deprecated`},
+				{Line: 21, Column: 5, Rule: "deprecated-usage", Message: `service "Base" is deprecated: use Svc2`},
+				{Line: 22, Column: 6, Rule: "deprecated-usage", Message: `type "Old" is deprecated: use New`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			prog, err := idl.Parse([]byte(tt.give))
+			require.NoError(t, err)
+
+			for i := range tt.want {
+				tt.want[i].File = "test.thrift"
+			}
+
+			got := New().Lint("test.thrift", prog)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
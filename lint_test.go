@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	good := filepath.Join(dir, "good.thrift")
+	require.NoError(t, ioutil.WriteFile(good, []byte(`
+		struct Foo {
+			1: optional string a
+		}
+	`), 0644))
+
+	bad := filepath.Join(dir, "bad.thrift")
+	require.NoError(t, ioutil.WriteFile(bad, []byte(`
+		struct Foo {
+			0: required string a
+		}
+	`), 0644))
+
+	tests := []struct {
+		desc      string
+		args      []string
+		wantOut   string
+		wantError string
+	}{
+		{
+			desc: "no problems",
+			args: []string{good},
+		},
+		{
+			desc: "problems",
+			args: []string{good, bad},
+			wantOut: bad + `:3:4: field "a" has ID 0: field IDs must be positive (field-id)` + "\n" +
+				bad + `:3:4: required field "a" of "Foo" does not have a default value: ` +
+				"adding it to an existing struct breaks compatibility (required-without-default)\n",
+			wantError: "found 2 lint problem(s)",
+		},
+		{
+			desc:      "disabled rule",
+			args:      []string{"--disable", "required-without-default", bad},
+			wantOut:   bad + `:3:4: field "a" has ID 0: field IDs must be positive (field-id)` + "\n",
+			wantError: "found 1 lint problem(s)",
+		},
+		{
+			desc:      "unknown rule",
+			args:      []string{"--disable", "foo", bad},
+			wantError: `unknown lint rule "foo"`,
+		},
+		{
+			desc:      "no files",
+			wantError: "thriftrw lint [OPTIONS] FILE...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runLint(tt.args, &out)
+			assert.Equal(t, tt.wantOut, out.String())
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantError)
+			}
+		})
+	}
+}
+
+func TestRunLintDiagnostics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bad := filepath.Join(dir, "bad.thrift")
+	require.NoError(t, ioutil.WriteFile(bad, []byte(`
+		struct Foo {
+			1: required string a
+		}
+	`), 0644))
+
+	broken := filepath.Join(dir, "broken.thrift")
+	require.NoError(t, ioutil.WriteFile(broken, []byte(`
+		struct Foo {
+			1: string a
+	`), 0644))
+
+	var out bytes.Buffer
+	err = runLint([]string{"--diagnostics=json", bad, broken}, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not parse 1 file(s)")
+
+	var got struct {
+		Diagnostics []diagnostic `json:"diagnostics"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got), "invalid JSON: %s", out.String())
+	require.Len(t, got.Diagnostics, 2)
+
+	assert.Equal(t, diagnostic{
+		File:     bad,
+		Range:    diagnosticRange{Start: diagnosticPosition{Line: 3, Column: 4}},
+		Severity: severityWarning,
+		Code:     "required-without-default",
+		Message: `required field "a" of "Foo" does not have a default value: ` +
+			"adding it to an existing struct breaks compatibility",
+		Fix: "mark the field optional or give it a default value",
+	}, got.Diagnostics[0])
+
+	assert.Equal(t, broken, got.Diagnostics[1].File)
+	assert.Equal(t, severityError, got.Diagnostics[1].Severity)
+	assert.Equal(t, "parse", got.Diagnostics[1].Code)
+	assert.Equal(t, 4, got.Diagnostics[1].Range.Start.Line)
+}
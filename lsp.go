@@ -0,0 +1,71 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"errors"
+	"io"
+
+	"go.uber.org/thriftrw/lint"
+	"go.uber.org/thriftrw/lsp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type lspOptions struct {
+	IncludeDirs []string `long:"include-dir" short:"I" value-name:"DIR" description:"Directory in which included Thrift files are searched for if they're not found relative to the file including them. This option may be provided multiple times."`
+	Disable     []string `long:"disable" value-name:"RULE" description:"Disable the given lint rule. This option may be provided multiple times."`
+}
+
+// runLSP runs a Language Server Protocol server for Thrift files which
+// reads requests from in and writes responses to out.
+func runLSP(args []string, in io.Reader, out io.Writer) error {
+	var opts lspOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw lsp"
+	parser.Usage = "[OPTIONS]"
+
+	rest, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(rest) > 0 {
+		return errors.New("thriftrw lsp does not accept arguments: " +
+			"it communicates with the editor over stdin and stdout")
+	}
+
+	linter := lint.New()
+	for _, name := range opts.Disable {
+		if err := linter.Disable(name); err != nil {
+			return err
+		}
+	}
+
+	return lsp.NewServer(lsp.Options{
+		IncludeDirs: opts.IncludeDirs,
+		Linter:      linter,
+	}).Serve(in, out)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package lsp implements a Language Server Protocol server for Thrift
+// files, for use by editors.
+//
+// The server publishes parse errors, compile errors, and lint problems for
+// open documents as diagnostics. It supports go-to-definition, hover, and
+// rename for the types, constants, services, and enum items defined in
+// Thrift files, following includes across files.
+//
+//   server := lsp.NewServer(lsp.Options{IncludeDirs: dirs})
+//   if err := server.Serve(os.Stdin, os.Stdout); err != nil {
+//     log.Fatal(err)
+//   }
+//
+// Documents are synchronized in full: editors send the complete text of a
+// document every time it changes.
+package lsp
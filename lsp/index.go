@@ -0,0 +1,228 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lsp
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+)
+
+// symbol identifies an entity defined in a Thrift file.
+type symbol struct {
+	// Absolute path to the Thrift file that defines the entity.
+	Path string
+
+	// Name of the entity. Enum items are named "Enum.ITEM". This is empty
+	// if the symbol refers to the Thrift file itself, as in an include.
+	Name string
+}
+
+// occurrence is a span of a Thrift file that defines or refers to a symbol.
+type occurrence struct {
+	Start, End int // byte offsets
+	Target     symbol
+	Definition bool
+}
+
+// index records the symbols defined and referenced in a Thrift file.
+type index struct {
+	Path    string
+	Content *content
+	Program *ast.Program
+
+	// Definitions in this file keyed by name. Enum items are keyed by
+	// "Enum.ITEM".
+	Definitions map[string]ast.Node
+
+	// Absolute paths of included files keyed by the name under which they
+	// are included.
+	Includes map[string]string
+
+	Occurrences []occurrence
+}
+
+// newIndex parses the given Thrift file and indexes it. resolve is used to
+// find the absolute paths of included files; includes that cannot be
+// resolved are ignored.
+func newIndex(path string, text []byte, resolve func(dir, rel string) (string, bool)) (*index, error) {
+	prog, err := idl.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &index{
+		Path:        path,
+		Content:     newContent(text),
+		Program:     prog,
+		Definitions: make(map[string]ast.Node),
+		Includes:    make(map[string]string),
+	}
+
+	for _, h := range prog.Headers {
+		inc, ok := h.(*ast.Include)
+		if !ok {
+			continue
+		}
+		incPath, ok := resolve(filepath.Dir(path), inc.Path)
+		if !ok {
+			continue
+		}
+
+		name := inc.Name
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(inc.Path), filepath.Ext(inc.Path))
+		}
+		idx.Includes[name] = incPath
+
+		// Point at the quoted path.
+		start := idx.Content.ASTOffset(ast.Pos(inc))
+		if i := bytes.IndexAny(idx.Content.Text[start:], "\"'"); i >= 0 {
+			start += i
+			if j := bytes.IndexByte(idx.Content.Text[start+1:], idx.Content.Text[start]); j >= 0 {
+				idx.Occurrences = append(idx.Occurrences, occurrence{
+					Start:  start,
+					End:    start + j + 2,
+					Target: symbol{Path: incPath},
+				})
+			}
+		}
+	}
+
+	// References are recorded before definitions so that the names of
+	// definitions can be told apart from references on the same line.
+	var defs []ast.Node
+	ast.Walk(ast.VisitorFunc(func(w ast.Walker, n ast.Node) {
+		switch n := n.(type) {
+		case ast.TypeReference:
+			idx.reference(n.Name, ast.Pos(n))
+		case ast.ConstantReference:
+			idx.reference(n.Name, ast.Pos(n))
+		case *ast.Service:
+			if n.Parent != nil {
+				idx.reference(n.Parent.Name, ast.Position{Line: n.Parent.Line, Column: n.Parent.Column})
+			}
+			defs = append(defs, n)
+		case *ast.Struct, *ast.Enum, *ast.Typedef, *ast.Constant, *ast.Senum:
+			defs = append(defs, n)
+		case *ast.EnumItem:
+			if enum, ok := w.Parent().(*ast.Enum); ok {
+				idx.define(enum.Name+"."+n.Name, n.Name, n)
+			}
+		}
+	}), prog)
+
+	for _, n := range defs {
+		name := n.(ast.Definition).Info().Name
+		idx.define(name, name, n)
+	}
+
+	return idx, nil
+}
+
+// reference records a reference to a possibly qualified name at the given
+// position. Each part of the name is recorded as a separate occurrence:
+// "shared.Status.ENABLED" refers to the included file, the enum, and the
+// enum item.
+func (idx *index) reference(name string, pos ast.Position) {
+	start := idx.Content.ASTOffset(pos)
+	if !bytes.HasPrefix(idx.Content.Text[start:], []byte(name)) {
+		return
+	}
+
+	parts := strings.Split(name, ".")
+	path := idx.Path
+	if incPath, ok := idx.Includes[parts[0]]; ok && len(parts) > 1 {
+		path = incPath
+		idx.Occurrences = append(idx.Occurrences, occurrence{
+			Start:  start,
+			End:    start + len(parts[0]),
+			Target: symbol{Path: path},
+		})
+		start += len(parts[0]) + 1
+		parts = parts[1:]
+	}
+
+	for i, part := range parts {
+		idx.Occurrences = append(idx.Occurrences, occurrence{
+			Start:  start,
+			End:    start + len(part),
+			Target: symbol{Path: path, Name: strings.Join(parts[:i+1], ".")},
+		})
+		start += len(part) + 1
+	}
+}
+
+// define records the definition of the given symbol. The identifier ident
+// is the first occurrence of that word at or after the position of the
+// node which is not a reference.
+func (idx *index) define(name, ident string, n ast.Node) {
+	idx.Definitions[name] = n
+
+	text := idx.Content.Text
+	for off := idx.Content.ASTOffset(ast.Pos(n)); off < len(text); {
+		i := bytes.Index(text[off:], []byte(ident))
+		if i < 0 {
+			return
+		}
+		start, end := off+i, off+i+len(ident)
+		off = end
+
+		if (start > 0 && isIdentByte(text[start-1])) || (end < len(text) && isIdentByte(text[end])) {
+			continue // part of a longer word
+		}
+		if idx.At(start) != nil {
+			continue // a reference
+		}
+
+		idx.Occurrences = append(idx.Occurrences, occurrence{
+			Start:      start,
+			End:        end,
+			Target:     symbol{Path: idx.Path, Name: name},
+			Definition: true,
+		})
+		return
+	}
+}
+
+// At returns the occurrence at the given offset or nil if there isn't one.
+func (idx *index) At(off int) *occurrence {
+	for i, o := range idx.Occurrences {
+		if o.Start <= off && off <= o.End {
+			return &idx.Occurrences[i]
+		}
+	}
+	return nil
+}
+
+// Definition returns the occurrence which defines the symbol with the
+// given name or nil if it isn't defined in this file.
+func (idx *index) Definition(name string) *occurrence {
+	for i, o := range idx.Occurrences {
+		if o.Definition && o.Target.Name == name {
+			return &idx.Occurrences[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,616 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/idl"
+	"go.uber.org/thriftrw/lint"
+	"go.uber.org/thriftrw/version"
+)
+
+// Options controls the behavior of a Server.
+type Options struct {
+	// Directories in which included Thrift files are searched for if
+	// they're not found relative to the file including them.
+	IncludeDirs []string
+
+	// Linter used to find problems in open documents. Defaults to
+	// lint.New().
+	Linter *lint.Linter
+}
+
+// Server is a Language Server Protocol server for Thrift files.
+type Server struct {
+	includeDirs []string
+	linter      *lint.Linter
+
+	// Directory of the workspace opened by the editor, if any. Thrift files
+	// in it are updated when symbols are renamed.
+	root string
+
+	// Contents of open documents keyed by absolute path.
+	docs documentFS
+
+	shutdown bool
+}
+
+// NewServer builds a new Server.
+func NewServer(opts Options) *Server {
+	linter := opts.Linter
+	if linter == nil {
+		linter = lint.New()
+	}
+	return &Server{
+		includeDirs: opts.IncludeDirs,
+		linter:      linter,
+		docs:        make(documentFS),
+	}
+}
+
+// Serve reads requests from r and writes responses to w until the client
+// sends the exit notification or closes r.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	c := newConn(r, w)
+	for {
+		msg, err := c.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if rerr, ok := err.(*rpcError); ok {
+			if err := c.Reply(nil, nil, rerr); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("received exit notification before shutdown")
+			}
+			return nil
+		}
+
+		result, err := s.handle(c, msg)
+		if len(msg.ID) == 0 {
+			// Notifications don't get responses. Errors handling them
+			// can't be reported to the client.
+			continue
+		}
+		if err := c.Reply(msg.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(c *conn, msg *message) (interface{}, error) {
+	switch msg.Method {
+	case "initialize":
+		var params initializeParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		if params.RootURI != "" {
+			s.root, _ = uriToPath(params.RootURI)
+		}
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:   1,
+				DefinitionProvider: true,
+				HoverProvider:      true,
+				RenameProvider:     true,
+			},
+			ServerInfo: serverInfo{Name: "thriftrw", Version: version.Version},
+		}, nil
+
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params didOpenTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		path, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		s.docs[path] = []byte(params.TextDocument.Text)
+		return nil, s.publishDiagnostics(c)
+
+	case "textDocument/didChange":
+		var params didChangeTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		path, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			s.docs[path] = []byte(params.ContentChanges[n-1].Text)
+		}
+		return nil, s.publishDiagnostics(c)
+
+	case "textDocument/didClose":
+		var params didCloseTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		path, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		delete(s.docs, path)
+		if err := c.Notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		}); err != nil {
+			return nil, err
+		}
+		return nil, s.publishDiagnostics(c)
+
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return s.definition(params)
+
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return s.hover(params)
+
+	case "textDocument/rename":
+		var params renameParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return s.rename(params)
+
+	case "initialized", "$/cancelRequest", "$/setTrace", "workspace/didChangeConfiguration":
+		return nil, nil
+
+	default:
+		return nil, &rpcError{Code: methodNotFoundCode, Message: fmt.Sprintf("unknown method %q", msg.Method)}
+	}
+}
+
+func unmarshalParams(msg *message, v interface{}) error {
+	if len(msg.Params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(msg.Params, v); err != nil {
+		return &rpcError{Code: invalidParamsCode, Message: err.Error()}
+	}
+	return nil
+}
+
+// documentFS is a compile.FS which serves the contents of open documents
+// in place of the files on disk.
+type documentFS map[string][]byte
+
+func (fs documentFS) Read(path string) ([]byte, error) {
+	if text, ok := fs[path]; ok {
+		return text, nil
+	}
+	return ioutil.ReadFile(path)
+}
+
+func (documentFS) Abs(p string) (string, error) {
+	return filepath.Abs(p)
+}
+
+// resolveInclude finds the absolute path of an included file the same way
+// the compiler does.
+func (s *Server) resolveInclude(dir, rel string) (string, bool) {
+	if filepath.IsAbs(rel) {
+		return filepath.Clean(rel), true
+	}
+	for _, d := range append([]string{dir}, s.includeDirs...) {
+		p, err := filepath.Abs(filepath.Join(d, rel))
+		if err != nil {
+			continue
+		}
+		if _, err := s.docs.Read(p); err == nil {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+func (s *Server) index(path string) (*index, error) {
+	text, err := s.docs.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	return newIndex(path, text, s.resolveInclude)
+}
+
+// publishDiagnostics publishes diagnostics for all open documents. All of
+// them are checked because a change to one file may affect the files
+// which include it.
+func (s *Server) publishDiagnostics(c *conn) error {
+	paths := make([]string, 0, len(s.docs))
+	for path := range s.docs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if err := c.Notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         pathToURI(path),
+			Diagnostics: s.diagnose(path),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diagnose returns the problems found in the open document at the given
+// path.
+func (s *Server) diagnose(path string) []diagnostic {
+	c := newContent(s.docs[path])
+	diags := []diagnostic{}
+
+	prog, err := idl.Parse(c.Text)
+	if perr, ok := err.(*idl.ParseError); ok {
+		for _, e := range perr.Errors {
+			diags = append(diags, diagnostic{
+				Range:    c.DiagnosticRange(e.Pos),
+				Severity: severityError,
+				Code:     "parse",
+				Source:   "thriftrw",
+				Message:  e.Message,
+			})
+		}
+		return diags
+	} else if err != nil {
+		return append(diags, diagnostic{
+			Severity: severityError,
+			Code:     "parse",
+			Source:   "thriftrw",
+			Message:  err.Error(),
+		})
+	}
+
+	_, err = compile.Compile(path, compile.Filesystem(s.docs), compile.IncludeDirs(s.includeDirs...))
+	if err != nil {
+		errPath, pos, reason := compile.ErrorLocation(err)
+		d := diagnostic{
+			Severity: severityError,
+			Code:     "compile",
+			Source:   "thriftrw",
+			Message:  reason.Error(),
+		}
+		if errPath == "" || errPath == path {
+			d.Range = c.DiagnosticRange(pos)
+		} else {
+			// The problem is in an included file. Report it on the
+			// include statement if it's a direct include.
+			d.Message = fmt.Sprintf("%v: %v", errPath, reason)
+			for _, h := range prog.Headers {
+				if inc, ok := h.(*ast.Include); ok {
+					if p, ok := s.resolveInclude(filepath.Dir(path), inc.Path); ok && p == errPath {
+						d.Range = c.DiagnosticRange(ast.Pos(inc))
+						break
+					}
+				}
+			}
+		}
+		diags = append(diags, d)
+	}
+
+	for _, p := range s.linter.Lint(path, prog) {
+		message := p.Message
+		if p.Fix != "" {
+			message += " (" + p.Fix + ")"
+		}
+		diags = append(diags, diagnostic{
+			Range:    c.DiagnosticRange(ast.Position{Line: p.Line, Column: p.Column}),
+			Severity: severityWarning,
+			Code:     p.Rule,
+			Source:   "thriftrw-lint",
+			Message:  message,
+		})
+	}
+	return diags
+}
+
+// lookup finds the occurrence of a symbol at the given position of a
+// document. A nil occurrence is returned if there isn't one.
+func (s *Server) lookup(uri string, pos position) (*index, *occurrence, error) {
+	path, err := uriToPath(uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	idx, err := s.index(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return idx, idx.At(idx.Content.Offset(pos)), nil
+}
+
+// resolve finds the definition of the given symbol. The returned
+// occurrence is nil if the symbol refers to a whole file.
+func (s *Server) resolve(sym symbol) (*index, *occurrence, error) {
+	idx, err := s.index(sym.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sym.Name == "" {
+		return idx, nil, nil
+	}
+	def := idx.Definition(sym.Name)
+	if def == nil {
+		return nil, nil, fmt.Errorf("%q is not defined in %v", sym.Name, sym.Path)
+	}
+	return idx, def, nil
+}
+
+func (s *Server) definition(params textDocumentPositionParams) (interface{}, error) {
+	_, occ, err := s.lookup(params.TextDocument.URI, params.Position)
+	if err != nil || occ == nil {
+		return nil, err
+	}
+
+	idx, def, err := s.resolve(occ.Target)
+	if err != nil {
+		return nil, nil // unresolved references have no definition
+	}
+
+	loc := location{URI: pathToURI(idx.Path)}
+	if def != nil {
+		loc.Range = idx.Content.Range(def.Start, def.End)
+	}
+	return []location{loc}, nil
+}
+
+func (s *Server) hover(params textDocumentPositionParams) (interface{}, error) {
+	ref, occ, err := s.lookup(params.TextDocument.URI, params.Position)
+	if err != nil || occ == nil {
+		return nil, err
+	}
+
+	idx, def, err := s.resolve(occ.Target)
+	if err != nil {
+		return nil, nil
+	}
+
+	var value string
+	if def == nil {
+		value = fmt.Sprintf("```thrift\ninclude %q\n```\n\n%v", filepath.Base(idx.Path), idx.Path)
+	} else {
+		value = s.describe(idx, occ.Target.Name)
+	}
+
+	return hover{
+		Contents: markupContent{Kind: "markdown", Value: value},
+		Range:    ref.Content.Range(occ.Start, occ.End),
+	}, nil
+}
+
+// describe renders a Markdown description of the given definition: its
+// signature, the type it resolves to if it's a typedef, and its doc
+// comment.
+func (s *Server) describe(idx *index, name string) string {
+	var sig, doc, resolved string
+	switch n := idx.Definitions[name].(type) {
+	case *ast.Struct:
+		kind := "struct"
+		switch n.Type {
+		case ast.UnionType:
+			kind = "union"
+		case ast.ExceptionType:
+			kind = "exception"
+		}
+		sig, doc = kind+" "+n.Name, n.Doc
+	case *ast.Enum:
+		sig, doc = "enum "+n.Name, n.Doc
+	case *ast.EnumItem:
+		sig, doc = name+" = "+enumItemValue(idx, name), n.Doc
+	case *ast.Typedef:
+		sig, doc = fmt.Sprintf("typedef %v %v", n.Type, n.Name), n.Doc
+		resolved = s.resolvedType(idx.Path, n.Name)
+	case *ast.Constant:
+		sig, doc = fmt.Sprintf("const %v %v", n.Type, n.Name), n.Doc
+	case *ast.Service:
+		sig, doc = "service "+n.Name, n.Doc
+		if n.Parent != nil {
+			sig += " extends " + n.Parent.Name
+		}
+	case *ast.Senum:
+		sig, doc = "senum "+n.Name, n.Doc
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "```thrift\n%v\n```", sig)
+	if resolved != "" {
+		fmt.Fprintf(&b, "\n\nResolves to `%v`.", resolved)
+	}
+	if doc != "" {
+		fmt.Fprintf(&b, "\n\n%v", doc)
+	}
+	fmt.Fprintf(&b, "\n\nDefined in `%v`.", filepath.Base(idx.Path))
+	return b.String()
+}
+
+// resolvedType returns the name of the type to which the given typedef
+// ultimately resolves. An empty string is returned if it doesn't resolve
+// through another typedef or if the file doesn't compile.
+func (s *Server) resolvedType(path, name string) string {
+	m, err := compile.Compile(path, compile.Filesystem(s.docs), compile.IncludeDirs(s.includeDirs...))
+	if err != nil {
+		return ""
+	}
+	t, ok := m.Types[name].(*compile.TypedefSpec)
+	if !ok {
+		return ""
+	}
+	if root := compile.RootTypeSpec(t); root.ThriftName() != t.Target.ThriftName() {
+		return root.ThriftName()
+	}
+	return ""
+}
+
+// enumItemValue returns the value of the given enum item as a string,
+// taking into account items whose values are implicit.
+func enumItemValue(idx *index, name string) string {
+	dot := strings.LastIndex(name, ".")
+	enum, ok := idx.Definitions[name[:dot]].(*ast.Enum)
+	if !ok {
+		return "?"
+	}
+
+	var value int
+	for _, item := range enum.Items {
+		if item.Value != nil {
+			value = *item.Value
+		}
+		if item.Name == name[dot+1:] {
+			return fmt.Sprint(value)
+		}
+		value++
+	}
+	return "?"
+}
+
+var identifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func (s *Server) rename(params renameParams) (interface{}, error) {
+	_, occ, err := s.lookup(params.TextDocument.URI, params.Position)
+	if err != nil {
+		return nil, err
+	}
+	if occ == nil {
+		return nil, errors.New("there is no Thrift identifier at this position")
+	}
+	if occ.Target.Name == "" {
+		return nil, errors.New("included files cannot be renamed")
+	}
+	if !identifierPattern.MatchString(params.NewName) {
+		return nil, &rpcError{
+			Code:    invalidParamsCode,
+			Message: fmt.Sprintf("%q is not a valid Thrift identifier", params.NewName),
+		}
+	}
+	if _, _, err := s.resolve(occ.Target); err != nil {
+		return nil, err
+	}
+
+	edit := workspaceEdit{Changes: make(map[string][]textEdit)}
+	for _, path := range s.renameScope(occ.Target.Path) {
+		idx, err := s.index(path)
+		if err != nil {
+			continue // files that don't parse can't be updated
+		}
+
+		var edits []textEdit
+		for _, o := range idx.Occurrences {
+			if o.Target == occ.Target {
+				edits = append(edits, textEdit{
+					Range:   idx.Content.Range(o.Start, o.End),
+					NewText: params.NewName,
+				})
+			}
+		}
+		if len(edits) > 0 {
+			edit.Changes[pathToURI(path)] = edits
+		}
+	}
+	return edit, nil
+}
+
+// renameScope returns the Thrift files which may refer to symbols defined
+// in the given file: the file itself, open documents, the files they
+// include, and Thrift files in the workspace.
+func (s *Server) renameScope(path string) []string {
+	seen := make(map[string]struct{})
+	var (
+		paths []string
+		visit func(string)
+	)
+	visit = func(p string) {
+		if _, ok := seen[p]; ok {
+			return
+		}
+		seen[p] = struct{}{}
+		paths = append(paths, p)
+
+		if idx, err := s.index(p); err == nil {
+			for _, inc := range idx.Includes {
+				visit(inc)
+			}
+		}
+	}
+
+	visit(path)
+	for p := range s.docs {
+		visit(p)
+	}
+	if s.root != "" {
+		filepath.Walk(s.root, func(p string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() && filepath.Ext(p) == ".thrift" {
+				visit(p)
+			}
+			return nil
+		})
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI %q: only file URIs are supported", uri)
+	}
+	return filepath.FromSlash(u.Path), nil
+}
+
+func pathToURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/version"
+)
+
+// session records the messages a client sends to the server.
+type session struct {
+	bytes.Buffer
+	nextID int
+}
+
+func (s *session) Request(method string, params interface{}) int {
+	s.nextID++
+	s.send(map[string]interface{}{"jsonrpc": "2.0", "id": s.nextID, "method": method, "params": params})
+	return s.nextID
+}
+
+func (s *session) Notify(method string, params interface{}) {
+	s.send(map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params})
+}
+
+func (s *session) send(v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(s, "Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+// serverOutput is the output of the server keyed by request ID, along
+// with the notifications it sent.
+type serverOutput struct {
+	Responses     map[int]json.RawMessage
+	Errors        map[int]rpcError
+	Notifications []json.RawMessage
+}
+
+// run serves the given session and parses the output.
+func run(t *testing.T, server *Server, s *session) serverOutput {
+	var out bytes.Buffer
+	require.NoError(t, server.Serve(&s.Buffer, &out))
+
+	result := serverOutput{
+		Responses: make(map[int]json.RawMessage),
+		Errors:    make(map[int]rpcError),
+	}
+	r := textproto.NewReader(bufio.NewReader(&out))
+	for {
+		header, err := r.ReadMIMEHeader()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		require.NoError(t, err)
+		body := make([]byte, length)
+		_, err = io.ReadFull(r.R, body)
+		require.NoError(t, err)
+
+		var msg struct {
+			ID     *int            `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+			Result json.RawMessage `json:"result"`
+			Error  *rpcError       `json:"error"`
+		}
+		require.NoError(t, json.Unmarshal(body, &msg), "invalid message %s", body)
+		switch {
+		case msg.ID == nil:
+			result.Notifications = append(result.Notifications, msg.Params)
+		case msg.Error != nil:
+			result.Errors[*msg.ID] = *msg.Error
+		default:
+			result.Responses[*msg.ID] = msg.Result
+		}
+	}
+	return result
+}
+
+func TestServer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-lsp")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sharedPath := filepath.Join(dir, "shared.thrift")
+	require.NoError(t, ioutil.WriteFile(sharedPath, []byte(
+		"typedef i64 Timestamp\n"+
+			"\n"+
+			"/** When something happened. */\n"+
+			"typedef Timestamp EventTime\n"+
+			"\n"+
+			"enum Status { ENABLED, DISABLED = 5, DELETED }\n"), 0644))
+
+	mainPath := filepath.Join(dir, "main.thrift")
+	mainText := "include \"./shared.thrift\"\n" +
+		"\n" +
+		"struct Event {\n" +
+		"  1: optional shared.EventTime time\n" +
+		"  2: optional shared.Status status = shared.Status.DELETED\n" +
+		"  3: required string name\n" +
+		"}\n"
+	require.NoError(t, ioutil.WriteFile(mainPath, []byte(mainText), 0644))
+
+	mainURI, sharedURI := pathToURI(mainPath), pathToURI(sharedPath)
+	doc := map[string]interface{}{"uri": mainURI}
+	at := func(line, char int) map[string]interface{} {
+		return map[string]interface{}{
+			"textDocument": doc,
+			"position":     map[string]int{"line": line, "character": char},
+		}
+	}
+
+	var s session
+	initID := s.Request("initialize", map[string]interface{}{"rootUri": pathToURI(dir)})
+	s.Notify("initialized", struct{}{})
+	s.Notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{
+			"uri": mainURI, "languageId": "thrift", "version": 1, "text": mainText,
+		},
+	})
+	defID := s.Request("textDocument/definition", at(3, 23))
+	includeDefID := s.Request("textDocument/definition", at(0, 12))
+	hoverID := s.Request("textDocument/hover", at(3, 25))
+	itemHoverID := s.Request("textDocument/hover", at(4, 52))
+	noHoverID := s.Request("textDocument/hover", at(5, 15))
+	renameID := s.Request("textDocument/rename", map[string]interface{}{
+		"textDocument": doc,
+		"position":     map[string]int{"line": 4, "character": 47},
+		"newName":      "State",
+	})
+	badRenameID := s.Request("textDocument/rename", map[string]interface{}{
+		"textDocument": doc,
+		"position":     map[string]int{"line": 4, "character": 47},
+		"newName":      "not-valid",
+	})
+	unknownID := s.Request("textDocument/unknown", struct{}{})
+	s.Notify("textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": mainURI, "version": 2},
+		"contentChanges": []map[string]string{{"text": "struct Event {\n  1: optional Foo foo\n"}},
+	})
+	s.Request("shutdown", nil)
+	s.Notify("exit", nil)
+
+	out := run(t, NewServer(Options{}), &s)
+
+	assert.JSONEq(t, `{
+		"capabilities": {
+			"textDocumentSync": 1,
+			"definitionProvider": true,
+			"hoverProvider": true,
+			"renameProvider": true
+		},
+		"serverInfo": {"name": "thriftrw", "version": "`+version.Version+`"}
+	}`, string(out.Responses[initID]))
+
+	require.Len(t, out.Notifications, 2)
+	assert.JSONEq(t, `{
+		"uri": "`+mainURI+`",
+		"diagnostics": [{
+			"range": {"start": {"line": 5, "character": 2}, "end": {"line": 5, "character": 3}},
+			"severity": 2,
+			"code": "required-without-default",
+			"source": "thriftrw-lint",
+			"message": "required field \"name\" of \"Event\" does not have a default value: adding it to an existing struct breaks compatibility (mark the field optional or give it a default value)"
+		}]
+	}`, string(out.Notifications[0]))
+
+	var changed publishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(out.Notifications[1], &changed))
+	if assert.Len(t, changed.Diagnostics, 1) {
+		assert.Equal(t, "parse", changed.Diagnostics[0].Code)
+		assert.Equal(t, severityError, changed.Diagnostics[0].Severity)
+	}
+
+	assert.JSONEq(t, `[{
+		"uri": "`+sharedURI+`",
+		"range": {"start": {"line": 3, "character": 18}, "end": {"line": 3, "character": 27}}
+	}]`, string(out.Responses[defID]))
+
+	assert.JSONEq(t, `[{
+		"uri": "`+sharedURI+`",
+		"range": {"start": {"line": 0, "character": 0}, "end": {"line": 0, "character": 0}}
+	}]`, string(out.Responses[includeDefID]))
+
+	var h hover
+	require.NoError(t, json.Unmarshal(out.Responses[hoverID], &h))
+	assert.Equal(t, "```thrift\ntypedef Timestamp EventTime\n```\n\n"+
+		"Resolves to `i64`.\n\n"+
+		"When something happened.\n\n"+
+		"Defined in `shared.thrift`.", h.Contents.Value)
+	assert.Equal(t, textRange{
+		Start: position{Line: 3, Character: 21},
+		End:   position{Line: 3, Character: 30},
+	}, h.Range)
+
+	require.NoError(t, json.Unmarshal(out.Responses[itemHoverID], &h))
+	assert.Equal(t, "```thrift\nStatus.DELETED = 6\n```\n\nDefined in `shared.thrift`.", h.Contents.Value)
+
+	assert.Equal(t, "null", string(out.Responses[noHoverID]))
+
+	var edit workspaceEdit
+	require.NoError(t, json.Unmarshal(out.Responses[renameID], &edit))
+	rng := func(line, start, end int) textRange {
+		return textRange{Start: position{Line: line, Character: start}, End: position{Line: line, Character: end}}
+	}
+	assert.Equal(t, map[string][]textEdit{
+		mainURI: {
+			{Range: rng(4, 21, 27), NewText: "State"},
+			{Range: rng(4, 44, 50), NewText: "State"},
+		},
+		sharedURI: {
+			{Range: rng(5, 5, 11), NewText: "State"},
+		},
+	}, edit.Changes)
+
+	assert.Equal(t, invalidParamsCode, out.Errors[badRenameID].Code)
+	assert.Equal(t, methodNotFoundCode, out.Errors[unknownID].Code)
+}
+
+func TestServerExitWithoutShutdown(t *testing.T) {
+	var s session
+	s.Notify("exit", nil)
+
+	var out bytes.Buffer
+	err := NewServer(Options{}).Serve(&s.Buffer, &out)
+	assert.EqualError(t, err, "received exit notification before shutdown")
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lsp
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// content is the text of a document, indexed by line so that offsets may
+// be converted to and from LSP positions.
+type content struct {
+	Text []byte
+
+	// Byte offsets at which each line starts.
+	lines []int
+}
+
+func newContent(text []byte) *content {
+	lines := []int{0}
+	for i, b := range text {
+		if b == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+	return &content{Text: text, lines: lines}
+}
+
+// lineEnd returns the offset of the end of the given zero-based line,
+// excluding the line terminator.
+func (c *content) lineEnd(line int) int {
+	end := len(c.Text)
+	if line+1 < len(c.lines) {
+		end = c.lines[line+1] - 1
+	}
+	if end > c.lines[line] && c.Text[end-1] == '\r' {
+		end--
+	}
+	return end
+}
+
+// Offset returns the byte offset of the given position. Positions past the
+// end of a line are clamped to the end of the line.
+func (c *content) Offset(p position) int {
+	if p.Line < 0 {
+		return 0
+	}
+	if p.Line >= len(c.lines) {
+		return len(c.Text)
+	}
+
+	off, end := c.lines[p.Line], c.lineEnd(p.Line)
+	for units := 0; off < end && units < p.Character; {
+		r, size := utf8.DecodeRune(c.Text[off:end])
+		units += utf16Len(r)
+		off += size
+	}
+	return off
+}
+
+// Position returns the position of the given byte offset.
+func (c *content) Position(off int) position {
+	line := 0
+	for line+1 < len(c.lines) && c.lines[line+1] <= off {
+		line++
+	}
+
+	var units int
+	for i := c.lines[line]; i < off; {
+		r, size := utf8.DecodeRune(c.Text[i:])
+		units += utf16Len(r)
+		i += size
+	}
+	return position{Line: line, Character: units}
+}
+
+// Range returns the range between the given byte offsets.
+func (c *content) Range(start, end int) textRange {
+	return textRange{Start: c.Position(start), End: c.Position(end)}
+}
+
+// ASTOffset returns the byte offset of a position reported by the parser.
+// Lines and columns reported by the parser start at 1.
+func (c *content) ASTOffset(p ast.Position) int {
+	if p.Line < 1 {
+		return 0
+	}
+	if p.Line > len(c.lines) {
+		return len(c.Text)
+	}
+
+	off := c.lines[p.Line-1]
+	if p.Column > 1 {
+		off += p.Column - 1
+	}
+	if end := c.lineEnd(p.Line - 1); off > end {
+		off = end
+	}
+	return off
+}
+
+// WordEnd returns the offset at which the identifier starting at the given
+// offset ends. Identifiers may contain dots.
+func (c *content) WordEnd(off int) int {
+	for off < len(c.Text) && isIdentByte(c.Text[off]) {
+		off++
+	}
+	return off
+}
+
+// DiagnosticRange returns the range reported for a problem at the given
+// position: the identifier at that position or, if there isn't one, the
+// rest of the line.
+func (c *content) DiagnosticRange(p ast.Position) textRange {
+	start := c.ASTOffset(p)
+	end := c.WordEnd(start)
+	if end == start && p.Line >= 1 && p.Line <= len(c.lines) {
+		end = c.lineEnd(p.Line - 1)
+	}
+	return c.Range(start, end)
+}
+
+func isIdentByte(b byte) bool {
+	return b == '_' || b == '.' ||
+		('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+func utf16Len(r rune) int {
+	if r1, _ := utf16.EncodeRune(r); r1 != utf8.RuneError {
+		return 2
+	}
+	return 1
+}
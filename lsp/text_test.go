@@ -0,0 +1,60 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestContentPositions(t *testing.T) {
+	// "é" is two bytes in UTF-8 and one unit in UTF-16. "😀" is four bytes
+	// in UTF-8 and two units in UTF-16.
+	c := newContent([]byte("const string a = \"é😀\" // x\r\nstruct Foo {}\n"))
+
+	tests := []struct {
+		desc   string
+		offset int
+		pos    position
+	}{
+		{desc: "start", offset: 0, pos: position{Line: 0, Character: 0}},
+		{desc: "after multi-byte", offset: 20, pos: position{Line: 0, Character: 19}},
+		{desc: "after surrogate pair", offset: 24, pos: position{Line: 0, Character: 21}},
+		{desc: "second line", offset: 39, pos: position{Line: 1, Character: 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.pos, c.Position(tt.offset))
+			assert.Equal(t, tt.offset, c.Offset(tt.pos))
+		})
+	}
+
+	assert.Equal(t, 30, c.Offset(position{Line: 0, Character: 100}),
+		"positions past the end of a line must be clamped")
+	assert.Equal(t, 39, c.ASTOffset(ast.Position{Line: 2, Column: 8}))
+	assert.Equal(t, textRange{
+		Start: position{Line: 1, Character: 7},
+		End:   position{Line: 1, Character: 10},
+	}, c.DiagnosticRange(ast.Position{Line: 2, Column: 8}))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunLSP(t *testing.T) {
+	tests := []struct {
+		desc      string
+		args      []string
+		in        string
+		wantOut   string
+		wantError string
+	}{
+		{
+			desc: "shutdown",
+			in: "Content-Length: 44\r\n\r\n" +
+				`{"jsonrpc":"2.0","id":1,"method":"shutdown"}` +
+				"Content-Length: 33\r\n\r\n" +
+				`{"jsonrpc":"2.0","method":"exit"}`,
+			wantOut: "Content-Length: 38\r\n\r\n" +
+				`{"jsonrpc":"2.0","id":1,"result":null}`,
+		},
+		{
+			desc:      "arguments",
+			args:      []string{"foo.thrift"},
+			wantError: "thriftrw lsp does not accept arguments",
+		},
+		{
+			desc:      "unknown rule",
+			args:      []string{"--disable", "foo"},
+			wantError: `unknown lint rule "foo"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runLSP(tt.args, strings.NewReader(tt.in), &out)
+			assert.Equal(t, tt.wantOut, out.String())
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantError)
+			}
+		})
+	}
+}
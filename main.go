@@ -24,11 +24,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"go.uber.org/thriftrw/codegen"
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/gen"
 	"go.uber.org/thriftrw/internal/plugin"
@@ -46,8 +49,16 @@ type options struct {
 
 type genOptions struct {
 	OutputDirectory string `long:"out" short:"o" value-name:"DIR" description:"Directory to which the generated files will be written."`
+	OutputArchive   string `long:"output-archive" value-name:"FORMAT" description:"Write the generated files to stdout as a zip or tar archive instead of writing them to the output directory. Paths in the archive are relative to the output directory."`
 	PackagePrefix   string `long:"pkg-prefix" value-name:"PREFIX" description:"Prefix for import paths of generated module. By default, this is based on the output directory's location relative to $GOPATH."`
 	ThriftRoot      string `long:"thrift-root" value-name:"DIR" description:"Directory whose descendants contain all Thrift files. The structure of the generated Go packages mirrors the paths to the Thrift files relative to this directory. By default, this is the deepest common ancestor directory of the Thrift files."`
+	PackageLayout   string `long:"package-layout" value-name:"LAYOUT" description:"Whether the Go packages mirror the paths to the Thrift files (file) or the 'namespace go' statements in them (namespace). Thrift files without a 'namespace go' statement always use their path. A go.package annotation on the 'namespace go' statement overrides the import path of the package in either case. Defaults to file."`
+
+	IncludeDirs []string `long:"include-dir" short:"I" value-name:"DIR" description:"Directory in which included Thrift files are searched for if they're not found relative to the file including them. This option may be provided multiple times. Directories are searched in the order in which they are provided."`
+	BuildTags   string   `long:"build-tags" value-name:"TAGS" description:"Comma-separated build tags to enable. Types, services, fields, enum items, and functions annotated with build.tags are compiled only if all tags listed in the annotation are enabled, and tags prefixed with '!' are not. The generated code embeds the whole Thrift file unless --no-embed-idl is used."`
+	StdinPath   string   `long:"stdin-path" value-name:"FILE" description:"Path at which the Thrift file read from stdin is placed when FILE is '-'. Its includes are resolved relative to it and its package is named after it. Defaults to stdin.thrift."`
+
+	KeywordFieldNames bool `long:"keyword-field-names" description:"Allow Thrift keywords like 'required' and 'optional' to be used as the names of struct fields and function parameters. Such Thrift files are rejected by default."`
 
 	NoRecurse bool         `long:"no-recurse" description:"Don't generate code for included Thrift files."`
 	Plugins   plugin.Flags `long:"plugin" short:"p" value-name:"PLUGIN" description:"Code generation plugin for ThriftRW. This option may be provided multiple times to apply multiple plugins."`
@@ -57,9 +68,36 @@ type genOptions struct {
 	NoTypes           bool   `long:"no-types" description:"Do not generate code for types, implies --no-service-helpers."`
 	NoConstants       bool   `long:"no-constants" description:"Do not generate code for const declarations."`
 	NoServiceHelpers  bool   `long:"no-service-helpers" description:"Do not generate service helpers."`
+	ServiceStubs      bool   `long:"service-stubs" description:"Generate typed client and server stubs for services."`
+	ServiceTests      bool   `long:"service-tests" description:"Generate fakes of the client and server stubs in a package named after each service for use in tests, implies --service-stubs."`
+	HTTPHandlers      bool   `long:"http-handlers" description:"Generate net/http handlers which serve the functions of services annotated with http.method and http.path as JSON over HTTP, implies --service-stubs. See go.uber.org/thriftrw/rest."`
+	PrometheusMetrics bool   `long:"prometheus-metrics" description:"Generate InstrumentFooServer and InstrumentFooClient for every service Foo, which wrap the client and server stubs to record Prometheus metrics for every request, implies --service-stubs. See go.uber.org/thriftrw/metrics."`
+	Benchmarks        bool   `long:"benchmarks" description:"Generate benchmarks of encoding and decoding every struct into a _benchmark_test.go file next to the generated code."`
+	FuzzTests         bool   `long:"fuzz-tests" description:"Generate native Go fuzz tests of decoding every struct into a _fuzz_test.go file next to the generated code. The file builds only with Go 1.18 or newer."`
 	NoEmbedIDL        bool   `long:"no-embed-idl" description:"Do not embed IDLs into the generated code."`
 	NoZap             bool   `long:"no-zap" description:"Do not generate code for Zap logging."`
+	Minimal           bool   `long:"minimal" description:"Generate only types and their ToWire and FromWire methods, omitting String, Equals, JSON, Zap, streaming, and envelope helpers, for size-sensitive binaries. Implies --no-zap. A go.minimal annotation on the 'namespace go' statement of a Thrift file overrides this for that file."`
+	SQL               bool   `long:"sql" description:"Generate database/sql Valuer and Scanner implementations for enums and typedefs of base types."`
+	SQLEnumNames      bool   `long:"sql-enum-names" description:"Store enums in databases by name instead of their integer value, implies --sql."`
+	CompactCodegen    bool   `long:"compact-codegen" description:"Generate smaller code for structs whose serialization is driven by tables describing their fields, at a small runtime cost."`
+	Descriptors       bool   `long:"descriptors" description:"Generate ThriftDescriptor methods which describe structs at runtime for use with the go.uber.org/thriftrw/dynamic package."`
+	SizeMethods       bool   `long:"size-methods" description:"Generate SizeInBytes methods which report the number of bytes structs, enums, and typedefs occupy when encoded with the Binary protocol."`
+	StreamEncode      bool   `long:"stream-encode" description:"Generate Encode methods which write structs, enums, and typedefs directly to a stream.Writer without building their Thrift-level intermediate representation, reducing allocations when encoding."`
+	BuilderThreshold  int    `long:"builder-threshold" value-name:"N" description:"Generate builders with chained setters for structs and exceptions with at least N fields. Builders are always generated for structs annotated with go.builder unless it is set to false."`
+	LazyConstants     bool   `long:"lazy-constants" description:"Generate functions for constants whose values are structs, lists, sets, or maps, which build the values the first time they are called, instead of variables initialized when the program starts."`
+	Casing            string `long:"casing" value-name:"CASING" description:"How Go names are derived from Thrift names: go, which upper cases known initialisms like ID and URL; literal, which only capitalizes the first letter of each word; or apache, which matches the names generated by Apache Thrift's Go generator. Names specified with go.name annotations are unaffected. Defaults to go."`
+	SetType           string `long:"set-type" value-name:"TYPE" description:"Whether sets of primitives and enums are represented as maps to empty structs (map) or slices (slice) unless they're annotated with go.type. Sets of other types are always slices. Defaults to map."`
+	SortedMaps        bool   `long:"sorted-maps" description:"Write the items of maps in the order of their keys so that equal maps are always encoded identically. Maps whose keys cannot be ordered are written in the order of their items, and maps annotated with go.sortedMap set to false are not sorted. Individual maps may be sorted with the go.sortedMap annotation instead."`
+	MinGoVersion      string `long:"min-go-version" value-name:"VERSION" description:"Oldest version of Go the generated code must build with, for example 1.18. Code generated for Go 1.18 or newer converts lists, sets, and maps with the generic helpers in go.uber.org/thriftrw/generic, which makes it much smaller, and carries a go1.18 build constraint. Defaults to 1.10."`
+	Roots             string `long:"roots" value-name:"NAMES" description:"Comma-separated names of types, constants, and services. If set, code is generated only for these and the types, constants, and services they refer to, directly or indirectly. Names of those declared in included files are qualified with the name of the include, for example shared.UUID."`
 	OutputFile        string `long:"output-file" value-name:"FILENAME" description:"Generates a single .go file as an output. Specifying an OutputFile prevents code generation for included Thrift Files."`
+	OutputLayout      string `long:"output-layout" value-name:"LAYOUT" description:"Whether the code for each Thrift file is generated into a single file (single), into constants.go, types.go, and services.go (per-kind), or into a file for each type and service (per-type). Cannot be used with --output-file. Defaults to single."`
+	CacheDir          string `long:"cache-dir" value-name:"DIR" description:"Directory in which to cache generated code, for example .thriftrw-cache. Code is regenerated only for Thrift files that changed, or whose includes changed, since the last run."`
+	Diagnostics       string `long:"diagnostics" value-name:"FORMAT" description:"Format in which problems with the Thrift files are reported: text or json. With json, parse and compile errors and problems found by plugins are written to stdout as a JSON object with a list of diagnostics. Defaults to text."`
+
+	DeterministicCheck bool   `long:"deterministic-check" description:"Generate code twice and fail without writing any files if the output of the two runs differs."`
+	NameReport         string `long:"name-report" value-name:"FILE" description:"Write the Go identifier generated for every type, constant, enum item, field, service, function, and parameter to FILE, noting parameters which were renamed because they conflict with Go keywords, predeclared identifiers, or other parameters."`
+	NoMangle           bool   `long:"no-mangle" description:"Fail instead of renaming parameters which conflict with Go keywords, predeclared identifiers, or other parameters."`
 
 	// TODO(abg): Detailed help with examples of --thrift-root, --pkg-prefix,
 	// and --plugin
@@ -74,13 +112,51 @@ func main() {
 	os.Exit(0)
 }
 
+// subcommands may be run with "thriftrw NAME [OPTIONS] ARGS" in place of
+// generating code. Each function is called with the arguments that follow
+// the name of the subcommand.
+var subcommands = map[string]func(args []string) error{
+	"bench":     func(args []string) error { return runBench(args, os.Stdin, os.Stdout) },
+	"compat":    func(args []string) error { return runCompat(args, os.Stdout) },
+	"compile":   func(args []string) error { return runCompile(args, os.Stdout) },
+	"decode":    func(args []string) error { return runDecode(args, os.Stdin, os.Stdout) },
+	"doc":       func(args []string) error { return runDoc(args, os.Stdout) },
+	"format":    func(args []string) error { return runFormat(args, os.Stdout) },
+	"fuzz":      func(args []string) error { return runFuzz(args, os.Stdout) },
+	"graph":     func(args []string) error { return runGraph(args, os.Stdout) },
+	"lint":      func(args []string) error { return runLint(args, os.Stdout) },
+	"lsp":       func(args []string) error { return runLSP(args, os.Stdin, os.Stdout) },
+	"openapi":   func(args []string) error { return runOpenAPI(args, os.Stdout) },
+	"proto-gen": func(args []string) error { return runProtoGen(args, os.Stdout) },
+	"testdata":  func(args []string) error { return runTestData(args, os.Stdout) },
+}
+
 func do() (err error) {
 	log.SetFlags(0) // don't include timestamps, etc. in the output
 
+	if len(os.Args) > 1 {
+		if cmd, ok := subcommands[os.Args[1]]; ok {
+			return cmd(os.Args[2:])
+		}
+	}
+
 	var opts options
 
 	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
-	parser.Usage = "[OPTIONS] FILE"
+	parser.Usage = "[OPTIONS] FILE\n" +
+		"  thriftrw lint [OPTIONS] FILE...\n" +
+		"  thriftrw format [OPTIONS] FILE...\n" +
+		"  thriftrw compat OLD_FILE NEW_FILE\n" +
+		"  thriftrw doc [OPTIONS] FILE\n" +
+		"  thriftrw graph [OPTIONS] FILE\n" +
+		"  thriftrw compile [OPTIONS] FILE\n" +
+		"  thriftrw decode [OPTIONS] [FILE]\n" +
+		"  thriftrw bench [OPTIONS] [PACKAGE...]\n" +
+		"  thriftrw fuzz [OPTIONS] [PACKAGE...]\n" +
+		"  thriftrw proto-gen [OPTIONS] FILE\n" +
+		"  thriftrw openapi [OPTIONS] FILE\n" +
+		"  thriftrw testdata [OPTIONS] FILE\n" +
+		"  thriftrw lsp [OPTIONS]"
 
 	args, err := parser.Parse()
 	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
@@ -101,14 +177,41 @@ func do() (err error) {
 		return errors.New(buffer.String())
 	}
 
+	gopts := opts.GOpts
+	compileOpts := []compile.Option{compile.IncludeDirs(gopts.IncludeDirs...)}
+	if gopts.BuildTags != "" {
+		compileOpts = append(compileOpts, compile.BuildTags(strings.Split(gopts.BuildTags, ",")...))
+	}
+	if gopts.KeywordFieldNames {
+		compileOpts = append(compileOpts, compile.KeywordFieldNames())
+	}
+
+	jsonDiagnostics, err := parseDiagnosticsFormat(gopts.Diagnostics)
+	if err != nil {
+		return err
+	}
+
 	inputFile := args[0]
-	if _, err := os.Stat(inputFile); err != nil {
+	if inputFile == "-" {
+		contents, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("Could not read stdin: %v", err)
+		}
+
+		if gopts.StdinPath == "" {
+			gopts.StdinPath = "stdin.thrift"
+		}
+		inputFile, err = filepath.Abs(gopts.StdinPath)
+		if err != nil {
+			return fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.StdinPath, err)
+		}
+		compileOpts = append(compileOpts, compile.Filesystem(stdinFS{Path: inputFile, Contents: contents}))
+	} else if _, err := os.Stat(inputFile); err != nil {
 		if os.IsNotExist(err) {
 			return fmt.Errorf("File %q does not exist: %v", inputFile, err)
 		}
 		return fmt.Errorf("Could not stat file %q: %v", inputFile, err)
 	}
-	gopts := opts.GOpts
 
 	if len(gopts.OutputDirectory) == 0 {
 		gopts.OutputDirectory = "."
@@ -128,15 +231,17 @@ func do() (err error) {
 		}
 	}
 
-	module, err := compile.Compile(inputFile)
-	if err != nil {
+	module, err := compile.Compile(inputFile, compileOpts...)
+	if err != nil && jsonDiagnostics {
+		return reportDiagnostics(os.Stdout, compileDiagnostics(inputFile, err))
+	} else if err != nil {
 		// TODO(abg): For nested compile errors, split causal chain across
 		// multiple lines.
 		return fmt.Errorf("Failed to compile %q: %+v", inputFile, err)
 	}
 
 	if gopts.ThriftRoot == "" {
-		gopts.ThriftRoot, err = findCommonAncestor(module)
+		gopts.ThriftRoot, err = codegen.FindCommonAncestor(module)
 		if err != nil {
 			return fmt.Errorf(
 				"Could not find a common parent directory for %q and the Thrift files "+
@@ -149,7 +254,7 @@ func do() (err error) {
 		if err != nil {
 			return fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.ThriftRoot, err)
 		}
-		if err := verifyAncestry(module, gopts.ThriftRoot); err != nil {
+		if err := codegen.VerifyAncestry(module, gopts.ThriftRoot); err != nil {
 			return fmt.Errorf(
 				"An included Thrift file is not contained in the %q directory tree: %v",
 				gopts.ThriftRoot, err)
@@ -160,111 +265,162 @@ func do() (err error) {
 		return fmt.Errorf("output-file value: %q invalid. A {FILENAME}.go name must be provided", gopts.OutputFile)
 	}
 
-	pluginHandle, err := gopts.Plugins.Handle()
-	if err != nil {
-		return fmt.Errorf("Failed to initialize plugins: %+v", err)
+	var namespacePackages bool
+	switch gopts.PackageLayout {
+	case "", "file":
+	case "namespace":
+		namespacePackages = true
+	default:
+		return fmt.Errorf("unknown package layout %q: expected file or namespace", gopts.PackageLayout)
 	}
 
-	if gopts.GeneratePluginAPI {
-		pluginHandle = append(pluginHandle, pluginapigen.Handle)
+	var sliceSets bool
+	switch gopts.SetType {
+	case "", "map":
+	case "slice":
+		sliceSets = true
+	default:
+		return fmt.Errorf("unknown set type %q: expected map or slice", gopts.SetType)
 	}
 
-	defer func() {
-		err = multierr.Append(err, pluginHandle.Close())
-	}()
+	var outputLayout gen.OutputLayout
+	switch gopts.OutputLayout {
+	case "", "single":
+	case "per-kind":
+		outputLayout = gen.PerKindLayout
+	case "per-type":
+		outputLayout = gen.PerTypeLayout
+	default:
+		return fmt.Errorf("unknown output layout %q: expected single, per-kind, or per-type", gopts.OutputLayout)
+	}
 
-	generatorOptions := gen.Options{
-		OutputDir:        gopts.OutputDirectory,
-		PackagePrefix:    gopts.PackagePrefix,
-		ThriftRoot:       gopts.ThriftRoot,
-		NoRecurse:        gopts.NoRecurse,
-		NoVersionCheck:   gopts.NoVersionCheck,
-		Plugin:           pluginHandle,
-		NoTypes:          gopts.NoTypes,
-		NoConstants:      gopts.NoConstants,
-		NoServiceHelpers: gopts.NoServiceHelpers || gopts.NoTypes,
-		NoEmbedIDL:       gopts.NoEmbedIDL,
-		NoZap:            gopts.NoZap,
-		OutputFile:       gopts.OutputFile,
+	var casing gen.Casing
+	switch gopts.Casing {
+	case "", "go":
+	case "literal":
+		casing = gen.LiteralCasing
+	case "apache":
+		casing = gen.ApacheCasing
+	default:
+		return fmt.Errorf("unknown casing %q: expected go, literal, or apache", gopts.Casing)
 	}
-	if err := gen.Generate(module, &generatorOptions); err != nil {
-		return fmt.Errorf("Failed to generate code: %+v", err)
+
+	var roots []string
+	if gopts.Roots != "" {
+		roots = strings.Split(gopts.Roots, ",")
 	}
-	return nil
-}
 
-// verifyAncestry verifies that the Thrift file for the given module and the
-// Thrift files for all imported modules are contained within the directory
-// tree rooted at the given path.
-func verifyAncestry(m *compile.Module, root string) error {
-	return m.Walk(func(m *compile.Module) error {
-		path, err := filepath.Rel(root, m.ThriftPath)
+	var generics bool
+	if gopts.MinGoVersion != "" {
+		minor, err := parseGoVersion(gopts.MinGoVersion)
 		if err != nil {
-			return fmt.Errorf(
-				"could not resolve path for %q: %v", m.ThriftPath, err)
+			return err
 		}
+		generics = minor >= 18
+	}
 
-		if strings.HasPrefix(path, "..") {
-			return fmt.Errorf(
-				"%q is not contained in the %q directory tree",
-				m.ThriftPath, root)
-		}
+	generatorOptions := gen.Options{
+		OutputDir:         gopts.OutputDirectory,
+		PackagePrefix:     gopts.PackagePrefix,
+		ThriftRoot:        gopts.ThriftRoot,
+		NoRecurse:         gopts.NoRecurse,
+		NoVersionCheck:    gopts.NoVersionCheck,
+		NoTypes:           gopts.NoTypes,
+		NoConstants:       gopts.NoConstants,
+		NoServiceHelpers:  gopts.NoServiceHelpers || gopts.NoTypes,
+		ServiceStubs:      gopts.ServiceStubs || gopts.ServiceTests || gopts.HTTPHandlers || gopts.PrometheusMetrics,
+		ServiceTests:      gopts.ServiceTests,
+		HTTPHandlers:      gopts.HTTPHandlers,
+		PrometheusMetrics: gopts.PrometheusMetrics,
+		Benchmarks:        gopts.Benchmarks,
+		FuzzTests:         gopts.FuzzTests,
+		NamespacePackages: namespacePackages,
+		NoEmbedIDL:        gopts.NoEmbedIDL,
+		NoZap:             gopts.NoZap,
+		Minimal:           gopts.Minimal,
+		SQL:               gopts.SQL || gopts.SQLEnumNames,
+		SQLEnumNames:      gopts.SQLEnumNames,
+		CompactCodegen:    gopts.CompactCodegen,
+		SliceSets:         sliceSets,
+		SortedMaps:        gopts.SortedMaps,
+		Descriptors:       gopts.Descriptors,
+		SizeMethods:       gopts.SizeMethods,
+		StreamEncode:      gopts.StreamEncode,
+		BuilderThreshold:  gopts.BuilderThreshold,
+		LazyConstants:     gopts.LazyConstants,
+		Casing:            casing,
+		Generics:          generics,
+		Roots:             roots,
+		OutputFile:        gopts.OutputFile,
+		OutputLayout:      outputLayout,
+		CacheDir:          gopts.CacheDir,
+
+		DeterministicCheck: gopts.DeterministicCheck,
+		NoMangle:           gopts.NoMangle,
+	}
 
-		return nil
-	})
-}
+	if gopts.NameReport != "" {
+		report, createErr := os.Create(gopts.NameReport)
+		if createErr != nil {
+			return fmt.Errorf("Failed to create name report: %v", createErr)
+		}
+		defer func() {
+			err = multierr.Append(err, report.Close())
+		}()
+		generatorOptions.NameReport = report
+	}
 
-// findCommonAncestor finds the deepest common ancestor for the given module
-// and all modules imported by it.
-func findCommonAncestor(m *compile.Module) (string, error) {
-	var result []string
-	var lastString string
+	pluginHandle, err := gopts.Plugins.Handle(gen.NewPluginGenerator(module, &generatorOptions))
+	if err != nil {
+		return fmt.Errorf("Failed to initialize plugins: %+v", err)
+	}
 
-	err := m.Walk(func(m *compile.Module) error {
-		thriftPath := m.ThriftPath
-		if !filepath.IsAbs(thriftPath) {
-			return fmt.Errorf(
-				"ThriftPath must be absolute: %q is not absolute", thriftPath)
-		}
+	if gopts.GeneratePluginAPI {
+		pluginHandle = append(pluginHandle, pluginapigen.Handle)
+	}
 
-		thriftDir := filepath.Dir(thriftPath)
+	defer func() {
+		err = multierr.Append(err, pluginHandle.Close())
+	}()
 
-		// Split("/foo/bar", "/") = ["", "foo", "bar"]
-		parts := strings.Split(thriftDir, string(filepath.Separator))
-		if result == nil {
-			result = parts
-			lastString = thriftPath
-			return nil
+	var archive archiveWriter
+	if gopts.OutputArchive != "" {
+		archive, err = newArchiveWriter(gopts.OutputArchive, os.Stdout)
+		if err != nil {
+			return err
 		}
+		generatorOptions.Writer = archive
+	}
 
-		result = commonPrefix(result, parts)
-		if len(result) == 1 && result[0] == "" {
-			return fmt.Errorf(
-				"%q does not share an ancestor with %q",
-				thriftPath, lastString)
+	generatorOptions.Plugin = pluginHandle
+	if err := gen.Generate(module, &generatorOptions); err != nil {
+		if verr, ok := err.(*gen.ValidationError); ok && jsonDiagnostics {
+			return reportDiagnostics(os.Stdout, problemDiagnostics(severityError, verr.Problems))
 		}
-
-		lastString = thriftPath
-		return nil
-	})
-	if err != nil {
-		return "", err
+		return fmt.Errorf("Failed to generate code: %+v", err)
 	}
 
-	return strings.Join(result, string(filepath.Separator)), nil
+	if archive != nil {
+		if err := archive.Close(); err != nil {
+			return fmt.Errorf("Failed to write archive: %v", err)
+		}
+	}
+	return nil
 }
 
-// commonPrefix finds the shortest common prefix for the two lists.
-//
-// An empty slice may be returned if the two lists don't have a common prefix.
-func commonPrefix(l, r []string) []string {
-	var i int
-	for i = 0; i < len(l) && i < len(r); i++ {
-		if l[i] != r[i] {
-			break
-		}
+// parseGoVersion parses a Go version like 1.18 or go1.18 and returns its
+// minor version.
+func parseGoVersion(v string) (int, error) {
+	parts := strings.Split(strings.TrimPrefix(v, "go"), ".")
+	if len(parts) < 2 || parts[0] != "1" {
+		return 0, fmt.Errorf("invalid Go version %q: expected a version like 1.18", v)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil || minor < 0 {
+		return 0, fmt.Errorf("invalid Go version %q: expected a version like 1.18", v)
 	}
-	return l[:i]
+	return minor, nil
 }
 
 // determinePackagePrefix determines the package prefix for Go packages
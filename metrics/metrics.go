@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package metrics records Prometheus metrics for the requests sent by
+// clients and handled by servers generated with --prometheus-metrics.
+//
+// For every service Foo, that flag generates InstrumentFooServer and
+// InstrumentFooClient, which wrap implementations of FooServer and FooClient
+// so that each request is recorded with the Metrics in this package.
+//
+//   server := foo.InstrumentFooServer(impl, prometheus.DefaultRegisterer)
+//   handler := foo.NewFooHandler(server)
+//
+// The following metrics are recorded, labeled with the side of the request
+// ("client" or "server"), the name of the Thrift service, and the method
+// name of the function.
+//
+//   thriftrw_requests_total            counter    requests made
+//   thriftrw_request_errors_total      counter    requests which failed,
+//                                                 also labeled with the
+//                                                 name of the exception
+//   thriftrw_request_duration_seconds  histogram  time taken by requests
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Values of the side label.
+const (
+	Client = "client"
+	Server = "server"
+)
+
+// UnknownException is the value of the exception label for errors which
+// are not Thrift exceptions, such as transport failures.
+const UnknownException = "unknown"
+
+var (
+	labels      = []string{"side", "service", "method"}
+	errorLabels = []string{"side", "service", "method", "exception"}
+)
+
+// Metrics holds the Prometheus collectors with which requests are recorded.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// New builds Metrics whose collectors are registered with reg, or with
+// prometheus.DefaultRegisterer if reg is nil.
+//
+// Collectors registered with reg by an earlier call to New are reused, so
+// New may be called for every service that is instrumented. New panics if
+// the collectors could not be registered otherwise.
+func New(reg prometheus.Registerer) *Metrics {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+
+	return &Metrics{
+		requests: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "thriftrw",
+			Name:      "requests_total",
+			Help:      "Number of Thrift requests.",
+		}, labels)).(*prometheus.CounterVec),
+		errors: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "thriftrw",
+			Name:      "request_errors_total",
+			Help:      "Number of Thrift requests which failed, by exception.",
+		}, errorLabels)).(*prometheus.CounterVec),
+		duration: register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "thriftrw",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken by Thrift requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels)).(*prometheus.HistogramVec),
+	}
+}
+
+// register registers c with reg, returning the collector registered
+// earlier if there is one.
+func register(reg prometheus.Registerer, c prometheus.Collector) prometheus.Collector {
+	if err := reg.Register(c); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return are.ExistingCollector
+		}
+		panic(err)
+	}
+	return c
+}
+
+// Observe records a request to the given method of the given service which
+// started at start and failed with err, if err is non-nil. side is Client or
+// Server.
+func (m *Metrics) Observe(side, service, method string, start time.Time, err error) {
+	m.requests.WithLabelValues(side, service, method).Inc()
+	m.duration.WithLabelValues(side, service, method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(side, service, method, ExceptionName(err)).Inc()
+	}
+}
+
+// ExceptionName returns the name of the Thrift exception err as defined in
+// the Thrift file, or UnknownException if err is not a Thrift exception.
+func ExceptionName(err error) string {
+	if e, ok := err.(interface{ ErrorName() string }); ok {
+		return e.ErrorName()
+	}
+	return UnknownException
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeException struct{}
+
+func (*fakeException) Error() string     { return "fakeException{}" }
+func (*fakeException) ErrorName() string { return "FakeException" }
+
+func TestObserve(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := New(reg)
+
+	start := time.Now()
+	m.Observe(Client, "KeyValue", "getValue", start, nil)
+	m.Observe(Client, "KeyValue", "getValue", start, &fakeException{})
+	m.Observe(Server, "KeyValue", "setValue", start, errors.New("great sadness"))
+
+	err := testutil.GatherAndCompare(reg, strings.NewReader(`
+		# HELP thriftrw_request_errors_total Number of Thrift requests which failed, by exception.
+		# TYPE thriftrw_request_errors_total counter
+		thriftrw_request_errors_total{exception="FakeException",method="getValue",service="KeyValue",side="client"} 1
+		thriftrw_request_errors_total{exception="unknown",method="setValue",service="KeyValue",side="server"} 1
+		# HELP thriftrw_requests_total Number of Thrift requests.
+		# TYPE thriftrw_requests_total counter
+		thriftrw_requests_total{method="getValue",service="KeyValue",side="client"} 2
+		thriftrw_requests_total{method="setValue",service="KeyValue",side="server"} 1
+	`), "thriftrw_requests_total", "thriftrw_request_errors_total")
+	assert.NoError(t, err)
+}
+
+func TestNewReusesCollectors(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	start := time.Now()
+	New(reg).Observe(Server, "KeyValue", "getValue", start, nil)
+	New(reg).Observe(Server, "KeyValue", "getValue", start, nil)
+
+	err := testutil.GatherAndCompare(reg, strings.NewReader(`
+		# HELP thriftrw_requests_total Number of Thrift requests.
+		# TYPE thriftrw_requests_total counter
+		thriftrw_requests_total{method="getValue",service="KeyValue",side="server"} 2
+	`), "thriftrw_requests_total")
+	assert.NoError(t, err)
+}
+
+func TestNewConflict(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "thriftrw",
+		Name:      "requests_total",
+		Help:      "Something else entirely.",
+	}, []string{"foo"}))
+
+	assert.Panics(t, func() { New(reg) })
+}
+
+func TestExceptionName(t *testing.T) {
+	assert.Equal(t, "FakeException", ExceptionName(&fakeException{}))
+	assert.Equal(t, UnknownException, ExceptionName(errors.New("great sadness")))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/openapi"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type openAPIOptions struct {
+	Title      string `long:"title" value-name:"TITLE" description:"Title of the API. Defaults to the name of the Thrift file."`
+	APIVersion string `long:"api-version" value-name:"VERSION" description:"Version of the API. Defaults to 1.0.0."`
+	OutputFile string `short:"o" long:"output" value-name:"FILE" description:"Write the document to FILE instead of printing it."`
+}
+
+// runOpenAPI writes an OpenAPI document describing the HTTP-annotated
+// functions of the services in the Thrift file in args. The document is
+// written to out unless the --output option was provided.
+func runOpenAPI(args []string, out io.Writer) error {
+	var opts openAPIOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw openapi"
+	parser.Usage = "[OPTIONS] FILE"
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) != 1 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	module, err := compile.Compile(files[0])
+	if err != nil {
+		return err
+	}
+
+	doc, err := openapi.Build(module, &openapi.Options{
+		Title:   opts.Title,
+		Version: opts.APIVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	if opts.OutputFile == "" {
+		_, err := out.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(opts.OutputFile, b, 0644)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package openapi generates OpenAPI 3 documents describing Thrift services
+// that are exposed over HTTP.
+//
+// Build looks for functions annotated with the HTTP method and path at
+// which they are served and describes each as an operation.
+//
+//   service Users {
+//     User getUser(1: string id) throws (1: NotFound notFound (http.status = "404")) (
+//       http.method = "GET"
+//       http.path = "/users/{id}"
+//     )
+//   }
+//
+// Arguments named in the path become path parameters. The remaining
+// arguments are passed as query parameters for methods without a request
+// body, like GET and DELETE, and as properties of a JSON object in the
+// request body otherwise. Functions without these annotations are ignored.
+//
+// The return value is described as the JSON body of a 200 response, or as an
+// empty 204 response for void functions. Exceptions are returned with the
+// status in the http.status annotation of the exception or the field
+// throwing it, and as the default response if neither is present.
+//
+// Schemas match the JSON representation of the code generated by ThriftRW,
+// including the json.name, go.tag, and go.label annotations.
+package openapi
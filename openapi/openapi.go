@@ -0,0 +1,370 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Annotations on functions and exceptions which describe how they are
+// exposed over HTTP.
+const (
+	// HTTPMethodKey is the annotation specifying the HTTP method of a
+	// function, e.g. "GET".
+	HTTPMethodKey = "http.method"
+
+	// HTTPPathKey is the annotation specifying the URL path of a function,
+	// e.g. "/users/{id}". Segments in braces refer to arguments of the
+	// function.
+	HTTPPathKey = "http.path"
+
+	// HTTPStatusKey is the annotation specifying the HTTP status code with
+	// which an exception is returned. It may be placed on the exception or
+	// on the field of a throws clause.
+	HTTPStatusKey = "http.status"
+)
+
+// Version is the version of the OpenAPI specification that generated
+// documents follow.
+const Version = "3.0.3"
+
+const _jsonContentType = "application/json"
+
+var _pathParam = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// Options controls the document built by Build.
+type Options struct {
+	// Title of the API. Defaults to the name of the Thrift module.
+	Title string
+
+	// Version of the API. Defaults to "1.0.0".
+	Version string
+}
+
+// Build builds an OpenAPI document describing the HTTP-annotated functions
+// of the services defined in the given module, including those inherited
+// from parent services.
+func Build(m *compile.Module, opts *Options) (*Document, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: opts.Title, Version: opts.Version},
+		Paths:   make(Paths),
+	}
+	if doc.Info.Title == "" {
+		doc.Info.Title = m.Name
+	}
+	if doc.Info.Version == "" {
+		doc.Info.Version = "1.0.0"
+	}
+
+	b := newSchemaBuilder()
+
+	// Services may be reached more than once through inheritance.
+	visited := make(map[*compile.ServiceSpec]struct{})
+	for _, name := range sortedServiceNames(m.Services) {
+		for s := m.Services[name]; s != nil; s = s.Parent {
+			if _, ok := visited[s]; ok {
+				break
+			}
+			visited[s] = struct{}{}
+
+			if err := addService(doc, b, s); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(b.Schemas) > 0 {
+		doc.Components = &Components{Schemas: b.Schemas}
+	}
+	return doc, nil
+}
+
+func addService(doc *Document, b *schemaBuilder, s *compile.ServiceSpec) error {
+	names := make([]string, 0, len(s.Functions))
+	for name := range s.Functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		f := s.Functions[name]
+		method, path, ok, err := httpRoute(f)
+		if err != nil {
+			return fmt.Errorf("cannot describe %s.%s: %v", s.Name, f.Name, err)
+		}
+		if !ok {
+			continue
+		}
+
+		op, err := buildOperation(b, s, f, method, path)
+		if err != nil {
+			return fmt.Errorf("cannot describe %s.%s: %v", s.Name, f.Name, err)
+		}
+
+		item := doc.Paths[path]
+		if item == nil {
+			item = make(PathItem)
+			doc.Paths[path] = item
+		}
+		key := strings.ToLower(method)
+		if other, ok := item[key]; ok {
+			return fmt.Errorf("cannot describe %s.%s: %s %s is already used by %s",
+				s.Name, f.Name, method, path, other.OperationID)
+		}
+		item[key] = op
+	}
+	return nil
+}
+
+// httpRoute returns the HTTP method and path for the given function. Returns
+// false if the function is not exposed over HTTP.
+func httpRoute(f *compile.FunctionSpec) (method, path string, ok bool, err error) {
+	method = strings.ToUpper(f.Annotations[HTTPMethodKey])
+	path = f.Annotations[HTTPPathKey]
+	switch {
+	case method == "" && path == "":
+		return "", "", false, nil
+	case method == "":
+		return "", "", false, fmt.Errorf("%v must be specified with %v", HTTPMethodKey, HTTPPathKey)
+	case path == "":
+		return "", "", false, fmt.Errorf("%v must be specified with %v", HTTPPathKey, HTTPMethodKey)
+	}
+
+	switch method {
+	case "GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH", "TRACE":
+	default:
+		return "", "", false, fmt.Errorf("unknown HTTP method %q", f.Annotations[HTTPMethodKey])
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		return "", "", false, fmt.Errorf("path %q must start with /", path)
+	}
+	return method, path, true, nil
+}
+
+// hasBody returns true if requests with the given method carry a body.
+func hasBody(method string) bool {
+	switch method {
+	case "POST", "PUT", "PATCH":
+		return true
+	default:
+		return false
+	}
+}
+
+func buildOperation(b *schemaBuilder, s *compile.ServiceSpec, f *compile.FunctionSpec, method, path string) (*Operation, error) {
+	if f.Streaming {
+		return nil, fmt.Errorf("streaming functions cannot be described")
+	}
+
+	op := &Operation{
+		OperationID: s.Name + "_" + f.Name,
+		Description: f.Doc,
+		Tags:        []string{s.Name},
+		Responses:   make(map[string]*Response),
+	}
+
+	args := compile.FieldGroup(f.ArgsSpec)
+	inPath := make(map[string]struct{})
+	for _, match := range _pathParam.FindAllStringSubmatch(path, -1) {
+		name := match[1]
+		arg, err := args.FindByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("path parameter %q does not match any argument", name)
+		}
+		if !isHashable(arg.Type) {
+			return nil, fmt.Errorf("path parameter %q must have a primitive type", name)
+		}
+
+		schema, err := b.Schema(arg.Type)
+		if err != nil {
+			return nil, err
+		}
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:        name,
+			In:          "path",
+			Description: arg.Doc,
+			Required:    true,
+			Schema:      schema,
+		})
+		inPath[name] = struct{}{}
+	}
+
+	var rest compile.FieldGroup
+	for _, arg := range args {
+		if _, ok := inPath[arg.Name]; !ok {
+			rest = append(rest, arg)
+		}
+	}
+
+	switch {
+	case len(rest) == 0:
+		// Nothing else to send.
+	case hasBody(method):
+		schema, err := b.Object(rest)
+		if err != nil {
+			return nil, err
+		}
+		op.RequestBody = &RequestBody{
+			Required: len(schema.Required) > 0,
+			Content:  map[string]*MediaType{_jsonContentType: {Schema: schema}},
+		}
+	default:
+		for _, arg := range rest {
+			if !isQueryType(arg.Type) {
+				return nil, fmt.Errorf("argument %q cannot be passed in the query string of a %v request", arg.Name, method)
+			}
+			schema, err := b.Schema(arg.Type)
+			if err != nil {
+				return nil, err
+			}
+			if set, ok := compile.RootTypeSpec(arg.Type).(*compile.SetSpec); ok {
+				// Repeated query parameters are always lists.
+				items, err := b.Schema(set.ValueSpec)
+				if err != nil {
+					return nil, err
+				}
+				schema = &Schema{Type: "array", Items: items, UniqueItems: true}
+			}
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:        arg.Name,
+				In:          "query",
+				Description: arg.Doc,
+				Required:    arg.Required,
+				Schema:      schema,
+			})
+		}
+	}
+
+	switch {
+	case f.OneWay:
+		op.Responses["202"] = &Response{Description: "Accepted"}
+	case f.ResultSpec.ReturnType == nil:
+		op.Responses["204"] = &Response{Description: "Success"}
+	default:
+		schema, err := b.Schema(f.ResultSpec.ReturnType)
+		if err != nil {
+			return nil, err
+		}
+		op.Responses["200"] = &Response{
+			Description: "Success",
+			Content:     map[string]*MediaType{_jsonContentType: {Schema: schema}},
+		}
+	}
+
+	if f.ResultSpec != nil {
+		if err := addExceptions(b, op, f.ResultSpec.Exceptions); err != nil {
+			return nil, err
+		}
+	}
+	return op, nil
+}
+
+// addExceptions adds responses for the given exceptions to op. Exceptions
+// returned with the same status are combined into one response.
+func addExceptions(b *schemaBuilder, op *Operation, exceptions compile.FieldGroup) error {
+	type response struct {
+		names   []string
+		schemas []*Schema
+	}
+
+	var statuses []string
+	responses := make(map[string]*response)
+	for _, e := range exceptions {
+		spec, ok := compile.RootTypeSpec(e.Type).(*compile.StructSpec)
+		if !ok {
+			return fmt.Errorf("exception %q is not a struct", e.Name)
+		}
+
+		status := e.Annotations[HTTPStatusKey]
+		if status == "" {
+			status = spec.Annotations[HTTPStatusKey]
+		}
+		if status == "" {
+			status = "default"
+		} else if code, err := strconv.Atoi(status); err != nil || code < 100 || code > 599 {
+			return fmt.Errorf("exception %q has invalid %v %q", e.Name, HTTPStatusKey, status)
+		}
+		if _, ok := op.Responses[status]; ok {
+			return fmt.Errorf("exception %q uses status %v which is used by a successful response", e.Name, status)
+		}
+
+		schema, err := b.Schema(spec)
+		if err != nil {
+			return err
+		}
+
+		r, ok := responses[status]
+		if !ok {
+			r = &response{}
+			responses[status] = r
+			statuses = append(statuses, status)
+		}
+		r.names = append(r.names, spec.Name)
+		r.schemas = append(r.schemas, schema)
+	}
+
+	for _, status := range statuses {
+		r := responses[status]
+		schema := r.schemas[0]
+		if len(r.schemas) > 1 {
+			schema = &Schema{OneOf: r.schemas}
+		}
+		op.Responses[status] = &Response{
+			Description: strings.Join(r.names, " or "),
+			Content:     map[string]*MediaType{_jsonContentType: {Schema: schema}},
+		}
+	}
+	return nil
+}
+
+// isQueryType returns true if arguments of the given type may be passed in
+// the query string: primitives and lists or sets of primitives.
+func isQueryType(spec compile.TypeSpec) bool {
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.ListSpec:
+		return isHashable(s.ValueSpec)
+	case *compile.SetSpec:
+		return isHashable(s.ValueSpec)
+	default:
+		return isHashable(s)
+	}
+}
+
+func sortedServiceNames(services map[string]*compile.ServiceSpec) []string {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	m := idltest.Compile(t, map[string]string{
+		"users.thrift": `
+			include "./common.thrift"
+
+			/** A registered user. */
+			struct User {
+				1: required string id
+				2: optional string name (go.label = "fullName")
+				3: optional i64 created (go.tag = 'json:"createdAt,string"')
+				4: optional common.Role role
+				5: optional set<string> groups
+				6: optional binary avatar (json.name = "photo")
+				7: optional string secret (go.tag = 'json:"-"')
+			}
+
+			union Lookup {
+				1: string id
+				2: string email
+			}
+
+			exception NotFound {
+				1: optional string message
+			}
+
+			exception Conflict {
+				1: optional string message
+			} (http.status = "409")
+
+			exception Unavailable {}
+
+			service Base {
+				void healthy() (http.method = "get", http.path = "/health")
+			}
+
+			service Users extends Base {
+				/** Returns a user. */
+				User getUser(
+					/** ID of the user. */
+					1: required string id
+					2: optional bool deleted
+					3: optional list<string> fields
+				) throws (
+					1: NotFound notFound (http.status = "404")
+					2: Unavailable unavailable
+				) (http.method = "GET", http.path = "/users/{id}")
+
+				User updateUser(1: required string id, 2: required User user, 3: optional Lookup lookup)
+					throws (1: NotFound notFound (http.status = "404"), 2: Conflict conflict)
+					(http.method = "PUT", http.path = "/users/{id}")
+
+				oneway void forget(1: required string id)
+					(http.method = "DELETE", http.path = "/users/{id}")
+
+				void internal()
+			}
+		`,
+		"common.thrift": `enum Role { ADMIN, MEMBER }`,
+	}, "users.thrift")
+
+	doc, err := Build(m, &Options{Version: "2.0.0"})
+	require.NoError(t, err)
+
+	got, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"openapi": "3.0.3",
+		"info": {"title": "users", "version": "2.0.0"},
+		"paths": {
+			"/health": {
+				"get": {
+					"operationId": "Base_healthy",
+					"tags": ["Base"],
+					"responses": {"204": {"description": "Success"}}
+				}
+			},
+			"/users/{id}": {
+				"get": {
+					"operationId": "Users_getUser",
+					"description": "Returns a user.",
+					"tags": ["Users"],
+					"parameters": [
+						{
+							"name": "id",
+							"in": "path",
+							"description": "ID of the user.",
+							"required": true,
+							"schema": {"type": "string"}
+						},
+						{"name": "deleted", "in": "query", "schema": {"type": "boolean"}},
+						{
+							"name": "fields",
+							"in": "query",
+							"schema": {"type": "array", "items": {"type": "string"}}
+						}
+					],
+					"responses": {
+						"200": {
+							"description": "Success",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/users.User"}}}
+						},
+						"404": {
+							"description": "NotFound",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/users.NotFound"}}}
+						},
+						"default": {
+							"description": "Unavailable",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/users.Unavailable"}}}
+						}
+					}
+				},
+				"put": {
+					"operationId": "Users_updateUser",
+					"tags": ["Users"],
+					"parameters": [
+						{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}
+					],
+					"requestBody": {
+						"required": true,
+						"content": {
+							"application/json": {
+								"schema": {
+									"type": "object",
+									"properties": {
+										"user": {"$ref": "#/components/schemas/users.User"},
+										"lookup": {"$ref": "#/components/schemas/users.Lookup"}
+									},
+									"required": ["user"]
+								}
+							}
+						}
+					},
+					"responses": {
+						"200": {
+							"description": "Success",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/users.User"}}}
+						},
+						"404": {
+							"description": "NotFound",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/users.NotFound"}}}
+						},
+						"409": {
+							"description": "Conflict",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/users.Conflict"}}}
+						}
+					}
+				},
+				"delete": {
+					"operationId": "Users_forget",
+					"tags": ["Users"],
+					"parameters": [
+						{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}
+					],
+					"responses": {"202": {"description": "Accepted"}}
+				}
+			}
+		},
+		"components": {
+			"schemas": {
+				"common.Role": {"type": "string", "enum": ["ADMIN", "MEMBER"]},
+				"users.User": {
+					"type": "object",
+					"description": "A registered user.",
+					"properties": {
+						"id": {"type": "string"},
+						"fullName": {"type": "string"},
+						"createdAt": {"type": "string", "format": "int64"},
+						"role": {"$ref": "#/components/schemas/common.Role"},
+						"groups": {
+							"type": "object",
+							"description": "Set of items stored as the keys of the object.",
+							"additionalProperties": {"type": "object", "maxProperties": 0}
+						},
+						"photo": {"type": "string", "format": "byte"}
+					},
+					"required": ["id"]
+				},
+				"users.Lookup": {
+					"type": "object",
+					"properties": {
+						"id": {"type": "string"},
+						"email": {"type": "string"}
+					},
+					"minProperties": 1,
+					"maxProperties": 1
+				},
+				"users.NotFound": {
+					"type": "object",
+					"properties": {"message": {"type": "string"}}
+				},
+				"users.Conflict": {
+					"type": "object",
+					"properties": {"message": {"type": "string"}}
+				},
+				"users.Unavailable": {"type": "object"}
+			}
+		}
+	}`, string(got))
+}
+
+func TestBuildDefaults(t *testing.T) {
+	m := idltest.Compile(t, map[string]string{
+		"empty.thrift": `service Empty {}`,
+	}, "empty.thrift")
+
+	doc, err := Build(m, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "empty", doc.Info.Title)
+	assert.Equal(t, "1.0.0", doc.Info.Version)
+	assert.Empty(t, doc.Paths)
+	assert.Nil(t, doc.Components)
+}
+
+func TestBuildSchemas(t *testing.T) {
+	m := idltest.Compile(t, map[string]string{
+		"schemas.thrift": `
+			typedef i32 Count
+
+			struct Node {
+				1: optional list<Node> children
+				2: optional map<string, Count> counts
+				3: optional map<list<i32>, double> points
+				4: optional set<list<i32>> paths
+				5: optional set<i16> (go.type = "slice") ids
+			}
+
+			service Nodes {
+				Node get() (http.method = "GET", http.path = "/node")
+			}
+		`,
+	}, "schemas.thrift")
+
+	doc, err := Build(m, nil)
+	require.NoError(t, err)
+
+	got, err := json.Marshal(doc.Components.Schemas["schemas.Node"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"children": {"type": "array", "items": {"$ref": "#/components/schemas/schemas.Node"}},
+			"counts": {"type": "object", "additionalProperties": {"type": "integer", "format": "int32"}},
+			"points": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"properties": {
+						"Key": {"type": "array", "items": {"type": "integer", "format": "int32"}},
+						"Value": {"type": "number", "format": "double"}
+					},
+					"required": ["Key", "Value"]
+				}
+			},
+			"paths": {
+				"type": "array",
+				"uniqueItems": true,
+				"items": {"type": "array", "items": {"type": "integer", "format": "int32"}}
+			},
+			"ids": {"type": "array", "uniqueItems": true, "items": {"type": "integer", "format": "int32"}}
+		}
+	}`, string(got))
+}
+
+func TestBuildErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    string
+		wantErr string
+	}{
+		{
+			desc:    "missing path",
+			give:    `service S { void f() (http.method = "GET") }`,
+			wantErr: "cannot describe S.f: http.path must be specified with http.method",
+		},
+		{
+			desc:    "missing method",
+			give:    `service S { void f() (http.path = "/f") }`,
+			wantErr: "cannot describe S.f: http.method must be specified with http.path",
+		},
+		{
+			desc:    "unknown method",
+			give:    `service S { void f() (http.method = "FETCH", http.path = "/f") }`,
+			wantErr: `unknown HTTP method "FETCH"`,
+		},
+		{
+			desc:    "relative path",
+			give:    `service S { void f() (http.method = "GET", http.path = "f") }`,
+			wantErr: `path "f" must start with /`,
+		},
+		{
+			desc:    "unknown path parameter",
+			give:    `service S { void f(1: string id) (http.method = "GET", http.path = "/f/{name}") }`,
+			wantErr: `path parameter "name" does not match any argument`,
+		},
+		{
+			desc: "struct path parameter",
+			give: `
+				struct Key { 1: required string id }
+				service S { void f(1: Key key) (http.method = "GET", http.path = "/f/{key}") }
+			`,
+			wantErr: `path parameter "key" must have a primitive type`,
+		},
+		{
+			desc: "struct query parameter",
+			give: `
+				struct Filter { 1: optional string name }
+				service S { void f(1: Filter filter) (http.method = "GET", http.path = "/f") }
+			`,
+			wantErr: `argument "filter" cannot be passed in the query string of a GET request`,
+		},
+		{
+			desc: "conflicting routes",
+			give: `
+				service S {
+					void f() (http.method = "GET", http.path = "/f")
+					void g() (http.method = "GET", http.path = "/f")
+				}
+			`,
+			wantErr: "cannot describe S.g: GET /f is already used by S_f",
+		},
+		{
+			desc: "invalid status",
+			give: `
+				exception E {}
+				service S { void f() throws (1: E e (http.status = "4xx")) (http.method = "GET", http.path = "/f") }
+			`,
+			wantErr: `exception "e" has invalid http.status "4xx"`,
+		},
+		{
+			desc: "status of successful response",
+			give: `
+				exception E {}
+				service S { void f() throws (1: E e (http.status = "204")) (http.method = "GET", http.path = "/f") }
+			`,
+			wantErr: `exception "e" uses status 204 which is used by a successful response`,
+		},
+		{
+			desc:    "streaming",
+			give:    `service S { stream<string> f() (http.method = "GET", http.path = "/f") }`,
+			wantErr: "streaming functions cannot be described",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := idltest.Compile(t, map[string]string{"s.thrift": tt.give}, "s.thrift")
+			_, err := Build(m, nil)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package openapi
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/fatih/structtag"
+)
+
+// Annotations that control the JSON representation of fields. These must
+// match the annotations understood by the code generator.
+const (
+	_jsonNameKey = "json.name"
+	_goTagKey    = "go.tag"
+	_goLabelKey  = "go.label"
+	_goTypeKey   = "go.type"
+)
+
+// schemaBuilder builds schemas for Thrift types. Schemas for enums, structs,
+// unions, and exceptions are added to the components of the document and
+// referenced from elsewhere.
+type schemaBuilder struct {
+	// Component schemas, keyed by name.
+	Schemas map[string]*Schema
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{Schemas: make(map[string]*Schema)}
+}
+
+// componentName returns the name of the component for a type defined in the
+// given Thrift file. Names are qualified by the name of the file so that
+// types with the same name in different files don't conflict.
+func componentName(file, name string) string {
+	return strings.TrimSuffix(filepath.Base(file), ".thrift") + "." + name
+}
+
+// Schema returns a schema for values of the given type.
+func (b *schemaBuilder) Schema(spec compile.TypeSpec) (*Schema, error) {
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return &Schema{Type: "boolean"}, nil
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec:
+		return &Schema{Type: "integer", Format: "int32"}, nil
+	case *compile.I64Spec:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case *compile.DoubleSpec:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case *compile.StringSpec:
+		return &Schema{Type: "string"}, nil
+	case *compile.BinarySpec:
+		return &Schema{Type: "string", Format: "byte"}, nil
+	case *compile.ListSpec:
+		items, err := b.Schema(s.ValueSpec)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case *compile.SetSpec:
+		if setUsesMap(s) {
+			// Sets of hashable values are represented as Go maps to empty
+			// structs so their JSON representation is an object with the
+			// items as keys.
+			empty := 0
+			return &Schema{
+				Type:                 "object",
+				Description:          "Set of items stored as the keys of the object.",
+				AdditionalProperties: &Schema{Type: "object", MaxProperties: &empty},
+			}, nil
+		}
+		items, err := b.Schema(s.ValueSpec)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items, UniqueItems: true}, nil
+	case *compile.MapSpec:
+		value, err := b.Schema(s.ValueSpec)
+		if err != nil {
+			return nil, err
+		}
+		if isHashable(s.KeySpec) {
+			return &Schema{Type: "object", AdditionalProperties: value}, nil
+		}
+
+		// Maps with unhashable keys are represented as lists of key-value
+		// pairs.
+		key, err := b.Schema(s.KeySpec)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{
+			Type: "array",
+			Items: &Schema{
+				Type:       "object",
+				Properties: map[string]*Schema{"Key": key, "Value": value},
+				Required:   []string{"Key", "Value"},
+			},
+		}, nil
+	case *compile.EnumSpec:
+		return b.enum(s), nil
+	case *compile.StructSpec:
+		return b.structure(s)
+	default:
+		return nil, fmt.Errorf("unsupported type %v", spec.ThriftName())
+	}
+}
+
+func (b *schemaBuilder) enum(spec *compile.EnumSpec) *Schema {
+	name := componentName(spec.ThriftFile(), spec.Name)
+	if _, ok := b.Schemas[name]; !ok {
+		// Enums are encoded as the names of their items.
+		s := &Schema{Type: "string", Description: spec.Doc}
+		for _, item := range spec.Items {
+			s.Enum = append(s.Enum, item.Name)
+		}
+		b.Schemas[name] = s
+	}
+	return ref(name)
+}
+
+func (b *schemaBuilder) structure(spec *compile.StructSpec) (*Schema, error) {
+	name := componentName(spec.ThriftFile(), spec.Name)
+	if _, ok := b.Schemas[name]; ok {
+		return ref(name), nil
+	}
+
+	// Register the schema before building it so that recursive references
+	// to the struct terminate.
+	s := &Schema{}
+	b.Schemas[name] = s
+
+	obj, err := b.Object(spec.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("cannot describe %q: %v", spec.Name, err)
+	}
+	*s = *obj
+	s.Description = spec.Doc
+	if spec.Type == ast.UnionType {
+		// Exactly one field of a union must be set.
+		one := 1
+		s.MinProperties = &one
+		s.MaxProperties = &one
+	}
+	return ref(name), nil
+}
+
+// Object returns a schema for a JSON object holding the given fields.
+func (b *schemaBuilder) Object(fields compile.FieldGroup) (*Schema, error) {
+	obj := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, f := range fields {
+		key, quoted, err := jsonField(f)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", f.Name, err)
+		}
+		if key == "-" {
+			continue
+		}
+
+		s, err := b.Schema(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", f.Name, err)
+		}
+		if quoted {
+			// Primitives with the ",string" option are JSON strings.
+			s = &Schema{Type: "string", Format: s.Format}
+		}
+		s = describe(s, f.Doc)
+
+		obj.Properties[key] = s
+		if f.Required {
+			obj.Required = append(obj.Required, key)
+		}
+	}
+	return obj, nil
+}
+
+// jsonField returns the key of the given field in its JSON representation
+// and whether its value is quoted. The key is "-" if the field is omitted.
+func jsonField(f *compile.FieldSpec) (key string, quoted bool, err error) {
+	key = f.Name
+	if label := f.Annotations[_goLabelKey]; label != "" {
+		key = label
+	}
+
+	if tag := f.Annotations[_goTagKey]; tag != "" {
+		tags, err := structtag.Parse(tag)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to parse tags %q: %v", tag, err)
+		}
+		if t, err := tags.Get("json"); err == nil {
+			if t.Name != "" {
+				key = t.Name
+			}
+			quoted = t.HasOption("string") && isHashable(f.Type)
+		}
+	}
+
+	if name := f.Annotations[_jsonNameKey]; name != "" {
+		key = name
+	}
+	return key, quoted, nil
+}
+
+// describe attaches the given description to a schema. References can't
+// have descriptions in OpenAPI 3.0 so they are wrapped in a oneOf.
+func describe(s *Schema, doc string) *Schema {
+	if doc == "" {
+		return s
+	}
+	if s.Ref != "" {
+		return &Schema{OneOf: []*Schema{s}, Description: doc}
+	}
+	if s.Description == "" {
+		s.Description = doc
+	}
+	return s
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// isHashable returns true for types that the code generator represents as
+// comparable Go types: primitives other than binary, and enums.
+func isHashable(spec compile.TypeSpec) bool {
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.EnumSpec:
+		return true
+	default:
+		return false
+	}
+}
+
+// setUsesMap returns true if the code generator represents the given set as
+// a Go map.
+func setUsesMap(spec *compile.SetSpec) bool {
+	return spec.Annotations[_goTypeKey] != "slice" && isHashable(spec.ValueSpec)
+}
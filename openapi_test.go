@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunOpenAPI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-openapi")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+		service Foo {
+			string get(1: string id) (http.method = "GET", http.path = "/foo/{id}")
+		}
+	`), 0644))
+
+	output := filepath.Join(dir, "foo.json")
+
+	tests := []struct {
+		desc      string
+		args      []string
+		wantOut   string
+		wantFile  string
+		wantError string
+	}{
+		{
+			desc:    "stdout",
+			args:    []string{"--title", "Foo API", file},
+			wantOut: `"title": "Foo API"`,
+		},
+		{
+			desc:     "to file",
+			args:     []string{"--api-version", "2.1.0", "-o", output, file},
+			wantFile: `"version": "2.1.0"`,
+		},
+		{
+			desc:      "missing file",
+			args:      []string{filepath.Join(dir, "bar.thrift")},
+			wantError: "bar.thrift",
+		},
+		{
+			desc:      "no files",
+			wantError: "thriftrw openapi [OPTIONS] FILE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runOpenAPI(tt.args, &out)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantError)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tt.wantOut)
+
+			got := out.Bytes()
+			if tt.wantFile != "" {
+				got, err = ioutil.ReadFile(output)
+				require.NoError(t, err)
+				assert.Contains(t, string(got), tt.wantFile)
+			}
+			assert.True(t, json.Valid(got), "output must be valid JSON")
+		})
+	}
+}
@@ -3,7 +3,7 @@
  *
  * This MUST be provided in the HandshakeResponse.
  */
-const i32 API_VERSION = 4
+const i32 API_VERSION = 5
 
 /**
  * ServiceID is an arbitrary unique identifier to reference the different
@@ -49,9 +49,48 @@ struct TypeReference {
      */
     3: optional map<string, string> annotations
 
+    /**
+     * Items of the enum this refers to. This is unset if the type is not an
+     * enum.
+     */
+    4: optional list<EnumItem> enumItems
+
     // TODO(abg): Should this just be using ModuleID instead of a package?
 }
 
+/**
+ * EnumItem is an item of an enum referenced by a TypeReference.
+ */
+struct EnumItem {
+    /**
+     * Name of the Go constant generated for this item.
+     */
+    1: required string name
+    /**
+     * Name of this item in the Thrift file.
+     */
+    2: required string thriftName
+    3: required i32 value
+    /**
+     * Annotations defined on this item.
+     *
+     * Given,
+     *
+     *   enum Status {
+     *     ACTIVE,
+     *     SUSPENDED (deprecated = "Use DISABLED instead."),
+     *     DISABLED,
+     *   }
+     *
+     * The annotations of SUSPENDED will be,
+     *
+     *   {
+     *     "deprecated": "Use DISABLED instead.",
+     *   }
+     */
+    4: optional map<string, string> annotations
+}
+
 /**
  * SimpleType is a standalone native Go type.
  */
@@ -130,6 +169,20 @@ struct Argument {
      * Argument type.
      */
     2: required Type type
+    /**
+     * Annotations defined on this argument.
+     *
+     * Given,
+     *
+     *   void setValue(1: string key (length = "16"))
+     *
+     * The annotations will be,
+     *
+     *  {
+     *    "length": "16",
+     *  }
+     */
+    3: optional map<string, string> annotations
 }
 
 /**
@@ -181,6 +234,23 @@ struct Function {
      *  }
      */
     7: optional map<string, string> annotations;
+    /**
+     * Whether this function streams its results. This should be assumed to
+     * be false unless explicitly stated otherwise. If this is true,
+     * returnType is the type of each value in the stream.
+     *
+     * Given,
+     *
+     *   stream<Event> subscribe(1: string topic)
+     *
+     * The returnType will be Event.
+     */
+    8: optional bool streaming
+    /**
+     * Documentation for this function, if any, with the comment markers
+     * removed.
+     */
+    9: optional string doc
 }
 
 /**
@@ -196,7 +266,12 @@ struct Service {
      */
     1: required string thriftName
     /**
-     * ID of the parent service.
+     * ID of the parent service, if this service extends another service.
+     *
+     * The parent service is always present in the Services of the
+     * GenerateServiceRequest, even if it's defined in a module for which
+     * code isn't being generated, so the whole inheritance chain may be
+     * followed with these IDs.
      */
     4: optional ServiceID parentID
     /**
@@ -222,6 +297,11 @@ struct Service {
      *  }
      */
     8: optional map<string, string> annotations;
+    /**
+     * Documentation for this service, if any, with the comment markers
+     * removed.
+     */
+    9: optional string doc
 }
 
 /**
@@ -263,6 +343,30 @@ enum Feature {
      */
     SERVICE_GENERATOR = 1,
 
+    /**
+     * TYPE_MAPPER specifies that the plugin may replace the Go types used
+     * for fields based on their annotations.
+     *
+     * If a plugin provides this, it MUST implement the TypeMapper service.
+     */
+    TYPE_MAPPER = 2,
+
+    /**
+     * VALIDATOR specifies that the plugin may check Thrift files for
+     * problems before code is generated for them.
+     *
+     * If a plugin provides this, it MUST implement the Validator service.
+     */
+    VALIDATOR = 3,
+
+    /**
+     * POST_PROCESSOR specifies that the plugin may modify the files
+     * generated by ThriftRW and other plugins before they are written.
+     *
+     * If a plugin provides this, it MUST implement the PostProcessor service.
+     */
+    POST_PROCESSOR = 4,
+
     // TODO: TAGGER for struct-tagging plugins
 }
 
@@ -301,6 +405,15 @@ struct HandshakeResponse {
     4: optional string libraryVersion
 }
 
+/**
+ * Plugin is implemented by all plugins.
+ *
+ * Communication with plugins is bidirectional: while a plugin is handling a
+ * request from ThriftRW, it may make requests of its own to the Generator
+ * service implemented by ThriftRW. Requests and responses in either
+ * direction are matched by the sequence IDs of their envelopes, so any
+ * number of requests may be in flight at a time.
+ */
 service Plugin {
     /**
      * handshake performs a handshake with the plugin to negotiate the
@@ -386,3 +499,366 @@ service ServiceGenerator {
      */
     GenerateServiceResponse generate(1: GenerateServiceRequest request)
 }
+
+//////////////////////////////////////////////////////////////////////////////
+
+/**
+ * FunctionReference is a reference to a top-level Go function.
+ */
+struct FunctionReference {
+    1: required string name
+    /**
+     * Import path for the package defining this function.
+     */
+    2: required string importPath
+}
+
+/**
+ * MapTypeRequest is a request to map a field to a custom Go type.
+ */
+struct MapTypeRequest {
+    /**
+     * Go type that ThriftRW would use for this field if it were required.
+     *
+     * Values of the custom type are converted to and from this type when
+     * they are serialized.
+     */
+    1: required Type type
+    /**
+     * Annotations defined on the field.
+     *
+     * Given,
+     *
+     *   struct User {
+     *     1: required string id (go.type = "uuid.UUID")
+     *   }
+     *
+     * The annotations will be,
+     *
+     *   {
+     *     "go.type": "uuid.UUID",
+     *   }
+     */
+    2: required map<string, string> annotations
+    /**
+     * Name of the field as defined in the Thrift file.
+     */
+    3: required string fieldName
+}
+
+/**
+ * TypeMapping specifies the custom Go type for a field and how to convert
+ * values of that type to and from the Go type ThriftRW would have used.
+ */
+struct TypeMapping {
+    /**
+     * Go type to use for the field.
+     *
+     * Optional fields will be generated as pointers to this type.
+     */
+    1: required Type type
+    /**
+     * Function which converts the custom type into the Go type in the
+     * request. It must have the signature,
+     *
+     *   func(Custom) (Original, error)
+     */
+    2: required FunctionReference toThrift
+    /**
+     * Function which converts the Go type in the request into the custom
+     * type. It must have the signature,
+     *
+     *   func(Original) (Custom, error)
+     */
+    3: required FunctionReference fromThrift
+    /**
+     * Function which compares two values of the custom type. It must have
+     * the signature,
+     *
+     *   func(Custom, Custom) bool
+     *
+     * If unset, values are compared using the == operator.
+     */
+    4: optional FunctionReference equals (go.name = "EqualsFunc")
+}
+
+/**
+ * MapTypeResponse is the response to a MapTypeRequest.
+ */
+struct MapTypeResponse {
+    /**
+     * Custom type for the field. This MUST be unset if the plugin does not
+     * claim any of the annotations on the field, in which case ThriftRW will
+     * generate the field as usual.
+     */
+    1: optional TypeMapping mapping
+}
+
+/**
+ * TypeMapper replaces the Go types used for fields by claiming annotations
+ * on them.
+ *
+ * This MUST be implemented if the TYPE_MAPPER feature is enabled.
+ */
+service TypeMapper {
+    /**
+     * Maps a field to a custom Go type.
+     *
+     * This is called for every field that has at least one annotation.
+     */
+    MapTypeResponse mapType(1: MapTypeRequest request)
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+/**
+ * DeclarationKind is the kind of a top-level declaration in a Thrift file.
+ */
+enum DeclarationKind {
+    CONSTANT = 1,
+    TYPEDEF,
+    ENUM,
+    STRUCT,
+    UNION,
+    EXCEPTION,
+    SERVICE,
+}
+
+/**
+ * Member is a field of a struct, union, or exception, an item of an enum,
+ * or a function of a service.
+ */
+struct Member {
+    /**
+     * Name of the member as defined in the Thrift file.
+     */
+    1: required string name
+    /**
+     * Line of the Thrift file on which the member is defined.
+     */
+    2: required i32 line
+    /**
+     * Field identifier of a field, or the value of an enum item. This is
+     * unset for functions and for enum items without explicit values.
+     */
+    3: optional i32 id (go.name = "ID")
+    /**
+     * Whether this field is marked required. This is unset for fields which
+     * are neither required nor optional, and for other members.
+     */
+    4: optional bool isRequired
+    /**
+     * Type of the field, or the return type of the function, as written in
+     * the Thrift file. For example, "list<string>" or "shared.UUID". This is
+     * unset for enum items and void functions.
+     */
+    5: optional string type
+    /**
+     * Annotations defined on this member.
+     */
+    6: optional map<string, string> annotations
+    /**
+     * Documentation for this member, if any, with the comment markers
+     * removed.
+     */
+    7: optional string doc
+}
+
+/**
+ * Declaration is a top-level declaration in a Thrift file.
+ */
+struct Declaration {
+    1: required DeclarationKind kind
+    /**
+     * Name of the declaration as defined in the Thrift file.
+     */
+    2: required string name
+    /**
+     * Path to the Thrift file which contains this declaration.
+     */
+    3: required string thriftFilePath
+    /**
+     * Line of the Thrift file on which the declaration starts.
+     */
+    4: required i32 line
+    /**
+     * Annotations defined on this declaration.
+     */
+    5: optional map<string, string> annotations
+    /**
+     * Fields, enum items, or functions of this declaration, in the order in
+     * which they are defined in the Thrift file.
+     */
+    6: optional list<Member> members
+    /**
+     * Documentation for this declaration, if any, with the comment markers
+     * removed.
+     */
+    7: optional string doc
+    /**
+     * Type of a constant, or the type aliased by a typedef, as written in the
+     * Thrift file.
+     */
+    8: optional string type
+}
+
+/**
+ * ValidateRequest is a request to check Thrift files for problems.
+ */
+struct ValidateRequest {
+    /**
+     * Paths to the Thrift files being checked.
+     */
+    1: required list<string> thriftFilePaths
+    /**
+     * Top-level declarations of these Thrift files, in the order in which
+     * they are defined.
+     */
+    2: required list<Declaration> declarations
+}
+
+/**
+ * Diagnostic is a problem found in a Thrift file.
+ */
+struct Diagnostic {
+    /**
+     * Path to the Thrift file which has the problem. This SHOULD be one of
+     * the thriftFilePaths of the request.
+     */
+    1: required string thriftFilePath
+    /**
+     * Line on which the problem was found, or 0 if it applies to the whole
+     * file.
+     */
+    2: required i32 line
+    /**
+     * Description of the problem.
+     */
+    3: required string message
+    /**
+     * Name of the rule which found the problem, if any.
+     */
+    4: optional string rule
+}
+
+/**
+ * ValidateResponse is the response to a ValidateRequest.
+ */
+struct ValidateResponse {
+    /**
+     * Problems found in the Thrift files. Code is not generated if any
+     * problems are reported.
+     */
+    1: optional list<Diagnostic> diagnostics
+}
+
+/**
+ * Validator checks Thrift files for problems, allowing organizations to
+ * enforce their own policies for Thrift files.
+ *
+ * This MUST be implemented if the VALIDATOR feature is enabled.
+ */
+service Validator {
+    /**
+     * Checks the requested Thrift files for problems.
+     */
+    ValidateResponse validate(1: ValidateRequest request)
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+/**
+ * PostProcessRequest is a request to modify generated files before they are
+ * written.
+ */
+struct PostProcessRequest {
+    /**
+     * Map of file path to file contents for all files about to be written,
+     * including those generated by ServiceGenerators.
+     *
+     * Paths are relative to the output directory into which ThriftRW is
+     * generating code.
+     */
+    1: required map<string, binary> files
+    /**
+     * Prefix for import paths of generated modules.
+     */
+    2: required string packagePrefix
+}
+
+/**
+ * PostProcessResponse is the response to a PostProcessRequest.
+ */
+struct PostProcessResponse {
+    /**
+     * Map of file path to file contents for files which should be written
+     * in place of the files in the request, or in addition to them. Files of
+     * the request that are not listed here are written unchanged.
+     *
+     * The paths MUST NOT contain the string ".." or the request will fail.
+     */
+    1: optional map<string, binary> files
+    /**
+     * Paths of files in the request which should not be written at all.
+     */
+    2: optional list<string> removedFiles
+}
+
+/**
+ * PostProcessor modifies generated files before they are written. It may
+ * add build tags, rewrite imports, append code to generated files, or drop
+ * files entirely.
+ *
+ * When multiple plugins implement PostProcessor, they are called one after
+ * another, in the order in which the plugins were specified, and each
+ * receives the files produced by the previous one.
+ *
+ * This MUST be implemented if the POST_PROCESSOR feature is enabled.
+ */
+service PostProcessor {
+    /**
+     * Modifies the requested files.
+     */
+    PostProcessResponse process(1: PostProcessRequest request)
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+/**
+ * ResolveTypeRequest is a request to resolve a Thrift type by name.
+ */
+struct ResolveTypeRequest {
+    /**
+     * Path to the Thrift file from which the type is referenced. This is the
+     * thriftFilePath of one of the modules ThriftRW provided to the plugin.
+     */
+    1: required string thriftFilePath
+    /**
+     * Name of the type as it would be referenced from that Thrift file.
+     * Types defined in included files are referenced with the name of the
+     * include as the prefix, for example, "shared.UUID".
+     */
+    2: required string name
+}
+
+/**
+ * ResolveTypeResponse is the response to a ResolveTypeRequest.
+ */
+struct ResolveTypeResponse {
+    /**
+     * Go type used by ThriftRW for required fields of the requested type.
+     */
+    1: required Type type
+}
+
+/**
+ * Generator is implemented by ThriftRW. Plugins may call it while they are
+ * handling a request from ThriftRW to learn more about the code being
+ * generated.
+ */
+service Generator {
+    /**
+     * Resolves a Thrift type to the Go type used for it.
+     */
+    ResolveTypeResponse resolveType(1: ResolveTypeRequest request)
+}
@@ -30,6 +30,8 @@ import (
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
+	stream "go.uber.org/thriftrw/protocol/stream"
+	required "go.uber.org/thriftrw/required"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -41,7 +43,7 @@ import (
 // API_VERSION is the version of the plugin API.
 //
 // This MUST be provided in the HandshakeResponse.
-const APIVersion int32 = 4
+const APIVersion int32 = 5
 
 // Argument is a single Argument inside a Function.
 // For,
@@ -59,8 +61,55 @@ type Argument struct {
 	Name string `json:"name,required"`
 	// Argument type.
 	Type *Type `json:"type,required"`
+	// Annotations defined on this argument.
+	//
+	// Given,
+	//
+	//   void setValue(1: string key (length = "16"))
+	//
+	// The annotations will be,
+	//
+	//  {
+	//    "length": "16",
+	//  }
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type _Map_String_String_MapItemList map[string]string
+
+func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
 }
 
+func (_Map_String_String_MapItemList) Close() {}
+
 // ToWire translates a Argument struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -78,7 +127,7 @@ type Argument struct {
 //   }
 func (v *Argument) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -99,6 +148,14 @@ func (v *Argument) ToWire() (wire.Value, error) {
 	}
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -109,6 +166,34 @@ func _Type_Read(w wire.Value) (*Type, error) {
 	return &v, err
 }
 
+func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a Argument struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -132,6 +217,8 @@ func (v *Argument) FromWire(w wire.Value) error {
 	nameIsSet := false
 	typeIsSet := false
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -145,14 +232,137 @@ func (v *Argument) FromWire(w wire.Value) error {
 		case 2:
 			if field.Value.Type() == wire.TStruct {
 				v.Type, err = _Type_Read(field.Value)
-				if err != nil {
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 				typeIsSet = true
 			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("Argument", "Name")
+	}
+
+	if !typeIsSet {
+		missing.Add("Argument", "Type")
+	}
+
+	return missing.Err()
+}
+
+func _Type_Decode(sr stream.Reader) (*Type, error) {
+	var v Type
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string]string, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *Argument) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	typeIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Type, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			typeIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
 		}
 	}
 
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	if !nameIsSet {
 		return errors.New("field Name of Argument is required")
 	}
@@ -164,6 +374,88 @@ func (v *Argument) FromWire(w wire.Value) error {
 	return nil
 }
 
+// MarshalJSON serializes a Argument struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Argument) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"type":`)
+		buff.Write(b)
+	}
+	if !(len(v.Annotations) == 0) {
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Argument struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Argument) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["type"]; ok {
+		if err := json.Unmarshal(r, &v.Type); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns a readable string representation of a Argument
 // struct.
 func (v *Argument) String() string {
@@ -171,16 +463,37 @@ func (v *Argument) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
 	fields[i] = fmt.Sprintf("Type: %v", v.Type)
 	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
 
 	return fmt.Sprintf("Argument{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this Argument match the
 // provided Argument.
 //
@@ -197,10 +510,53 @@ func (v *Argument) Equals(rhs *Argument) bool {
 	if !v.Type.Equals(rhs.Type) {
 		return false
 	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
 
 	return true
 }
 
+func _Map_String_String_Clone(v map[string]string) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(v))
+
+	for k, x := range v {
+		o[k] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this Argument. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Argument) Clone() *Argument {
+	if v == nil {
+		return nil
+	}
+
+	var c Argument
+	c.Name = v.Name
+	c.Type = v.Type.Clone()
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+
+	return &c
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Argument.
 func (v *Argument) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -209,6 +565,9 @@ func (v *Argument) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	}
 	enc.AddString("name", v.Name)
 	err = multierr.Append(err, enc.AddObject("type", v.Type))
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
 	return err
 }
 
@@ -235,208 +594,46 @@ func (v *Argument) IsSetType() bool {
 	return v != nil && v.Type != nil
 }
 
-// Feature is a functionality offered by a ThriftRW plugin.
-type Feature int32
-
-const (
-	// SERVICE_GENERATOR specifies that the plugin may generate arbitrary code
-	// for services defined in the Thrift file.
-	//
-	// If a plugin provides this, it MUST implement the ServiceGenerator
-	// service.
-	FeatureServiceGenerator Feature = 1
-)
-
-// Feature_Values returns all recognized values of Feature.
-func Feature_Values() []Feature {
-	return []Feature{
-		FeatureServiceGenerator,
-	}
-}
-
-// UnmarshalText tries to decode Feature from a byte slice
-// containing its name.
-//
-//   var v Feature
-//   err := v.UnmarshalText([]byte("SERVICE_GENERATOR"))
-func (v *Feature) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "SERVICE_GENERATOR":
-		*v = FeatureServiceGenerator
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Feature", err)
-		}
-		*v = Feature(val)
-		return nil
-	}
-}
-
-// MarshalText encodes Feature to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v Feature) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 1:
-		return []byte("SERVICE_GENERATOR"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Feature.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v Feature) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 1:
-		enc.AddString("name", "SERVICE_GENERATOR")
-	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v Feature) Ptr() *Feature {
-	return &v
-}
-
-// ToWire translates Feature into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v Feature) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes Feature from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return Feature(0), err
-//   }
-//
-//   var v Feature
-//   if err := v.FromWire(x); err != nil {
-//     return Feature(0), err
-//   }
-//   return v, nil
-func (v *Feature) FromWire(w wire.Value) error {
-	*v = (Feature)(w.GetI32())
-	return nil
-}
-
-// String returns a readable string representation of Feature.
-func (v Feature) String() string {
-	w := int32(v)
-	switch w {
-	case 1:
-		return "SERVICE_GENERATOR"
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *Argument) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
 	}
-	return fmt.Sprintf("Feature(%d)", w)
-}
-
-// Equals returns true if this Feature value matches the provided
-// value.
-func (v Feature) Equals(rhs Feature) bool {
-	return v == rhs
-}
 
-// MarshalJSON serializes Feature into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v Feature) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 1:
-		return ([]byte)("\"SERVICE_GENERATOR\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+	return
 }
 
-// UnmarshalJSON attempts to decode Feature from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *Feature) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Feature")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Feature")
-		}
-		*v = (Feature)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Feature")
-	}
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Argument) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
 }
 
-// Function is a single function on a Thrift service.
-type Function struct {
-	// Name of the Go function.
+// Declaration is a top-level declaration in a Thrift file.
+type Declaration struct {
+	Kind DeclarationKind `json:"kind,required"`
+	// Name of the declaration as defined in the Thrift file.
 	Name string `json:"name,required"`
-	// Name of the function as defined in the Thrift file.
-	ThriftName string `json:"thriftName,required"`
-	// List of arguments accepted by the function.
-	//
-	// This list is in the order specified by the user in the Thrift file.
-	Arguments []*Argument `json:"arguments,required"`
-	// Return type of the function, if any. If this is not set, the function
-	// is a void function.
-	ReturnType *Type `json:"returnType,omitempty"`
-	// List of exceptions raised by the function.
-	//
-	// This list is in the order specified by the user in the Thrift file.
-	Exceptions []*Argument `json:"exceptions,omitempty"`
-	// Whether this function is oneway or not. This should be assumed to be
-	// false unless explicitly stated otherwise. If this is true, the
-	// returnType and exceptions will be null or empty.
-	OneWay *bool `json:"oneWay,omitempty"`
-	// Annotations defined on this function.
-	//
-	// Given,
-	//
-	//   void setValue(1: SetValueRequest req) (cache = "false")
-	//
-	// The annotations will be,
-	//
-	//  {
-	//    "cache": "false",
-	//  }
+	// Path to the Thrift file which contains this declaration.
+	ThriftFilePath string `json:"thriftFilePath,required"`
+	// Line of the Thrift file on which the declaration starts.
+	Line int32 `json:"line,required"`
+	// Annotations defined on this declaration.
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// Fields, enum items, or functions of this declaration, in the order in
+	// which they are defined in the Thrift file.
+	Members []*Member `json:"members,omitempty"`
+	// Documentation for this declaration, if any, with the comment markers
+	// removed.
+	Doc *string `json:"doc,omitempty"`
+	// Type of a constant, or the type aliased by a typedef, as written in the
+	// Thrift file.
+	Type *string `json:"type,omitempty"`
 }
 
-type _List_Argument_ValueList []*Argument
+type _List_Member_ValueList []*Member
 
-func (v _List_Argument_ValueList) ForEach(f func(wire.Value) error) error {
+func (v _List_Member_ValueList) ForEach(f func(wire.Value) error) error {
 	for i, x := range v {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
@@ -453,52 +650,17 @@ func (v _List_Argument_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_Argument_ValueList) Size() int {
+func (v _List_Member_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_Argument_ValueList) ValueType() wire.Type {
+func (_List_Member_ValueList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_Argument_ValueList) Close() {}
-
-type _Map_String_String_MapItemList map[string]string
-
-func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := wire.NewValueString(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_String_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_String_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_String_MapItemList) ValueType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_String_MapItemList) Close() {}
+func (_List_Member_ValueList) Close() {}
 
-// ToWire translates a Function struct into a Thrift-level intermediate
+// ToWire translates a Declaration struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -513,129 +675,116 @@ func (_Map_String_String_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Function) ToWire() (wire.Value, error) {
+func (v *Declaration) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
+	w, err = v.Kind.ToWire()
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.ThriftName), error(nil)
+	w, err = wire.NewValueString(v.Name), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
-	if v.Arguments == nil {
-		return w, errors.New("field Arguments of Function is required")
-	}
-	w, err = wire.NewValueList(_List_Argument_ValueList(v.Arguments)), error(nil)
+
+	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
-	if v.ReturnType != nil {
-		w, err = v.ReturnType.ToWire()
+
+	w, err = wire.NewValueI32(v.Line), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
-	if v.Exceptions != nil {
-		w, err = wire.NewValueList(_List_Argument_ValueList(v.Exceptions)), error(nil)
+	if v.Members != nil {
+		w, err = wire.NewValueList(_List_Member_ValueList(v.Members)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
-	if v.OneWay != nil {
-		w, err = wire.NewValueBool(*(v.OneWay)), error(nil)
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
-	if v.Annotations != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+	if v.Type != nil {
+		w, err = wire.NewValueString(*(v.Type)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 7, Value: w}
+		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Argument_Read(w wire.Value) (*Argument, error) {
-	var v Argument
+func _DeclarationKind_Read(w wire.Value) (DeclarationKind, error) {
+	var v DeclarationKind
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Member_Read(w wire.Value) (*Member, error) {
+	var v Member
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _List_Argument_Read(l wire.ValueList) ([]*Argument, error) {
+func _List_Member_Read(l wire.ValueList) ([]*Member, error) {
 	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]*Argument, 0, l.Size())
+	o := make([]*Member, 0, l.Size())
+	var missing required.Errors
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Argument_Read(x)
-		if err != nil {
+		i, err := _Member_Read(x)
+		if err = missing.Merge(err); err != nil {
 			return err
 		}
 		o = append(o, i)
 		return nil
 	})
 	l.Close()
-	return o, err
-}
-
-func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TBinary {
-		return nil, nil
+	if err == nil {
+		err = missing.Err()
 	}
-
-	o := make(map[string]string, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := x.Value.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
 	return o, err
 }
 
-// FromWire deserializes a Function struct from its Thrift-level
+// FromWire deserializes a Declaration struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Function struct
+// An error is returned if we were unable to build a Declaration struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -643,73 +792,86 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 //     return nil, err
 //   }
 //
-//   var v Function
+//   var v Declaration
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Function) FromWire(w wire.Value) error {
+func (v *Declaration) FromWire(w wire.Value) error {
 	var err error
 
+	kindIsSet := false
 	nameIsSet := false
-	thriftNameIsSet := false
-	argumentsIsSet := false
+	thriftFilePathIsSet := false
+	lineIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				v.Kind, err = _DeclarationKind_Read(field.Value)
 				if err != nil {
 					return err
 				}
-				nameIsSet = true
+				kindIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.ThriftName, err = field.Value.GetString(), error(nil)
+				v.Name, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				thriftNameIsSet = true
+				nameIsSet = true
 			}
 		case 3:
-			if field.Value.Type() == wire.TList {
-				v.Arguments, err = _List_Argument_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				argumentsIsSet = true
+				thriftFilePathIsSet = true
 			}
 		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.ReturnType, err = _Type_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				v.Line, err = field.Value.GetI32(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				lineIsSet = true
 			}
 		case 5:
-			if field.Value.Type() == wire.TList {
-				v.Exceptions, err = _List_Argument_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 6:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.OneWay = &x
-				if err != nil {
+			if field.Value.Type() == wire.TList {
+				v.Members, err = _List_Member_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
 			}
 		case 7:
-			if field.Value.Type() == wire.TMap {
-				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Doc = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
@@ -718,404 +880,886 @@ func (v *Function) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !kindIsSet {
+		missing.Add("Declaration", "Kind")
+	}
+
 	if !nameIsSet {
-		return errors.New("field Name of Function is required")
+		missing.Add("Declaration", "Name")
 	}
 
-	if !thriftNameIsSet {
-		return errors.New("field ThriftName of Function is required")
+	if !thriftFilePathIsSet {
+		missing.Add("Declaration", "ThriftFilePath")
 	}
 
-	if !argumentsIsSet {
-		return errors.New("field Arguments of Function is required")
+	if !lineIsSet {
+		missing.Add("Declaration", "Line")
 	}
 
-	return nil
+	return missing.Err()
 }
 
-// String returns a readable string representation of a Function
-// struct.
-func (v *Function) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+func _DeclarationKind_Decode(sr stream.Reader) (DeclarationKind, error) {
+	var v DeclarationKind
+	err := v.Decode(sr)
+	return v, err
+}
 
-	var fields [7]string
-	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
-	i++
-	fields[i] = fmt.Sprintf("Arguments: %v", v.Arguments)
-	i++
-	if v.ReturnType != nil {
-		fields[i] = fmt.Sprintf("ReturnType: %v", v.ReturnType)
-		i++
-	}
-	if v.Exceptions != nil {
-		fields[i] = fmt.Sprintf("Exceptions: %v", v.Exceptions)
-		i++
-	}
-	if v.OneWay != nil {
-		fields[i] = fmt.Sprintf("OneWay: %v", *(v.OneWay))
-		i++
+func _Member_Decode(sr stream.Reader) (*Member, error) {
+	var v Member
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Member_Decode(sr stream.Reader) ([]*Member, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
-	if v.Annotations != nil {
-		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
-		i++
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
 	}
 
-	return fmt.Sprintf("Function{%v}", strings.Join(fields[:i], ", "))
-}
+	n := lh.Length
+	if n > 1024 {
 
-func _List_Argument_Equals(lhs, rhs []*Argument) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		n = 1024
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
+	o := make([]*Member, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Member_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return true
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
+func (v *Declaration) Decode(sr stream.Reader) error {
+	kindIsSet := false
+	nameIsSet := false
+	thriftFilePathIsSet := false
+	lineIsSet := false
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	return lhs == nil && rhs == nil
-}
 
-func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			v.Kind, err = _DeclarationKind_Decode(sr)
+			if err != nil {
+				return err
+			}
+			kindIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.ThriftFilePath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftFilePathIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			v.Line, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			lineIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TList:
+			v.Members, err = _List_Member_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Doc = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Type = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
 		}
-		if !(lv == rv) {
-			return false
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
 		}
-	}
-	return true
-}
 
-// Equals returns true if all the fields of this Function match the
-// provided Function.
-//
-// This function performs a deep comparison.
-func (v *Function) Equals(rhs *Function) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !(v.Name == rhs.Name) {
-		return false
-	}
-	if !(v.ThriftName == rhs.ThriftName) {
-		return false
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	if !_List_Argument_Equals(v.Arguments, rhs.Arguments) {
-		return false
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
-	if !((v.ReturnType == nil && rhs.ReturnType == nil) || (v.ReturnType != nil && rhs.ReturnType != nil && v.ReturnType.Equals(rhs.ReturnType))) {
-		return false
+
+	if !kindIsSet {
+		return errors.New("field Kind of Declaration is required")
 	}
-	if !((v.Exceptions == nil && rhs.Exceptions == nil) || (v.Exceptions != nil && rhs.Exceptions != nil && _List_Argument_Equals(v.Exceptions, rhs.Exceptions))) {
-		return false
+
+	if !nameIsSet {
+		return errors.New("field Name of Declaration is required")
 	}
-	if !_Bool_EqualsPtr(v.OneWay, rhs.OneWay) {
-		return false
+
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of Declaration is required")
 	}
-	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
-		return false
+
+	if !lineIsSet {
+		return errors.New("field Line of Declaration is required")
 	}
 
-	return true
+	return nil
 }
 
-type _List_Argument_Zapper []*Argument
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_Argument_Zapper.
-func (l _List_Argument_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
+// MarshalJSON serializes a Declaration struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Declaration) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
 	}
-	return err
-}
-
-type _Map_String_String_Zapper map[string]string
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_String_Zapper.
-func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		enc.AddString((string)(k), v)
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"kind":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ThriftFilePath)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftFilePath":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Line)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"line":`)
+		buff.Write(b)
+	}
+	if !(len(v.Annotations) == 0) {
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+	if !(len(v.Members) == 0) {
+		b, err := json.Marshal(v.Members)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"members":`)
+		buff.Write(b)
+	}
+	if !(v.Doc == nil) {
+		b, err := json.Marshal(v.Doc)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doc":`)
+		buff.Write(b)
+	}
+	if !(v.Type == nil) {
+		b, err := json.Marshal(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"type":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Declaration struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Declaration) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["kind"]; ok {
+		if err := json.Unmarshal(r, &v.Kind); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["thriftFilePath"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftFilePath); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["line"]; ok {
+		if err := json.Unmarshal(r, &v.Line); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["members"]; ok {
+		if err := json.Unmarshal(r, &v.Members); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["doc"]; ok {
+		if err := json.Unmarshal(r, &v.Doc); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["type"]; ok {
+		if err := json.Unmarshal(r, &v.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Declaration
+// struct.
+func (v *Declaration) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	fields[i] = fmt.Sprintf("Kind: %v", v.Kind)
+	i++
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
+	i++
+	fields[i] = fmt.Sprintf("Line: %v", v.Line)
+	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
+	if v.Members != nil {
+		fields[i] = fmt.Sprintf("Members: %v", v.Members)
+		i++
+	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
+		i++
+	}
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+
+	return fmt.Sprintf("Declaration{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Member_Equals(lhs, rhs []*Member) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Declaration match the
+// provided Declaration.
+//
+// This function performs a deep comparison.
+func (v *Declaration) Equals(rhs *Declaration) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Kind.Equals(rhs.Kind) {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+		return false
+	}
+	if !(v.Line == rhs.Line) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
+	if !((v.Members == nil && rhs.Members == nil) || (v.Members != nil && rhs.Members != nil && _List_Member_Equals(v.Members, rhs.Members))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Member_Clone(v []*Member) []*Member {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Member, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _String_ClonePtr(v *string) *string {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Declaration. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Declaration) Clone() *Declaration {
+	if v == nil {
+		return nil
+	}
+
+	var c Declaration
+	c.Kind = v.Kind
+	c.Name = v.Name
+	c.ThriftFilePath = v.ThriftFilePath
+	c.Line = v.Line
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+	c.Members = _List_Member_Clone(v.Members)
+	c.Doc = _String_ClonePtr(v.Doc)
+	c.Type = _String_ClonePtr(v.Type)
+
+	return &c
+}
+
+type _List_Member_Zapper []*Member
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Member_Zapper.
+func (l _List_Member_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Function.
-func (v *Function) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Declaration.
+func (v *Declaration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	err = multierr.Append(err, enc.AddObject("kind", v.Kind))
 	enc.AddString("name", v.Name)
-	enc.AddString("thriftName", v.ThriftName)
-	err = multierr.Append(err, enc.AddArray("arguments", (_List_Argument_Zapper)(v.Arguments)))
-	if v.ReturnType != nil {
-		err = multierr.Append(err, enc.AddObject("returnType", v.ReturnType))
+	enc.AddString("thriftFilePath", v.ThriftFilePath)
+	enc.AddInt32("line", v.Line)
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
 	}
-	if v.Exceptions != nil {
-		err = multierr.Append(err, enc.AddArray("exceptions", (_List_Argument_Zapper)(v.Exceptions)))
+	if v.Members != nil {
+		err = multierr.Append(err, enc.AddArray("members", (_List_Member_Zapper)(v.Members)))
 	}
-	if v.OneWay != nil {
-		enc.AddBool("oneWay", *v.OneWay)
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
 	}
-	if v.Annotations != nil {
-		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	if v.Type != nil {
+		enc.AddString("type", *v.Type)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
+// GetKind returns the value of Kind if it is set or its
 // zero value if it is unset.
-func (v *Function) GetName() (o string) {
+func (v *Declaration) GetKind() (o DeclarationKind) {
 	if v != nil {
-		o = v.Name
+		o = v.Kind
 	}
 	return
 }
 
-// GetThriftName returns the value of ThriftName if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *Function) GetThriftName() (o string) {
+func (v *Declaration) GetName() (o string) {
 	if v != nil {
-		o = v.ThriftName
+		o = v.Name
 	}
 	return
 }
 
-// GetArguments returns the value of Arguments if it is set or its
+// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
 // zero value if it is unset.
-func (v *Function) GetArguments() (o []*Argument) {
+func (v *Declaration) GetThriftFilePath() (o string) {
 	if v != nil {
-		o = v.Arguments
+		o = v.ThriftFilePath
 	}
 	return
 }
 
-// IsSetArguments returns true if Arguments is not nil.
-func (v *Function) IsSetArguments() bool {
-	return v != nil && v.Arguments != nil
+// GetLine returns the value of Line if it is set or its
+// zero value if it is unset.
+func (v *Declaration) GetLine() (o int32) {
+	if v != nil {
+		o = v.Line
+	}
+	return
 }
 
-// GetReturnType returns the value of ReturnType if it is set or its
+// GetAnnotations returns the value of Annotations if it is set or its
 // zero value if it is unset.
-func (v *Function) GetReturnType() (o *Type) {
-	if v != nil && v.ReturnType != nil {
-		return v.ReturnType
+func (v *Declaration) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
 	}
 
 	return
 }
 
-// IsSetReturnType returns true if ReturnType is not nil.
-func (v *Function) IsSetReturnType() bool {
-	return v != nil && v.ReturnType != nil
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Declaration) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
 }
 
-// GetExceptions returns the value of Exceptions if it is set or its
+// GetMembers returns the value of Members if it is set or its
 // zero value if it is unset.
-func (v *Function) GetExceptions() (o []*Argument) {
-	if v != nil && v.Exceptions != nil {
-		return v.Exceptions
+func (v *Declaration) GetMembers() (o []*Member) {
+	if v != nil && v.Members != nil {
+		return v.Members
 	}
 
 	return
 }
 
-// IsSetExceptions returns true if Exceptions is not nil.
-func (v *Function) IsSetExceptions() bool {
-	return v != nil && v.Exceptions != nil
+// IsSetMembers returns true if Members is not nil.
+func (v *Declaration) IsSetMembers() bool {
+	return v != nil && v.Members != nil
 }
 
-// GetOneWay returns the value of OneWay if it is set or its
+// GetDoc returns the value of Doc if it is set or its
 // zero value if it is unset.
-func (v *Function) GetOneWay() (o bool) {
-	if v != nil && v.OneWay != nil {
-		return *v.OneWay
+func (v *Declaration) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
 	}
 
 	return
 }
 
-// IsSetOneWay returns true if OneWay is not nil.
-func (v *Function) IsSetOneWay() bool {
-	return v != nil && v.OneWay != nil
+// IsSetDoc returns true if Doc is not nil.
+func (v *Declaration) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
 }
 
-// GetAnnotations returns the value of Annotations if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *Function) GetAnnotations() (o map[string]string) {
-	if v != nil && v.Annotations != nil {
-		return v.Annotations
+func (v *Declaration) GetType() (o string) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetAnnotations returns true if Annotations is not nil.
-func (v *Function) IsSetAnnotations() bool {
-	return v != nil && v.Annotations != nil
+// IsSetType returns true if Type is not nil.
+func (v *Declaration) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-// GenerateServiceRequest is a request to generate code for zero or more
-// Thrift services.
-type GenerateServiceRequest struct {
-	// IDs of services for which code should be generated.
-	//
-	// Note that the services map contains information about both, the
-	// services being generated and their transitive dependencies. Code should
-	// only be generated for service IDs listed here.
-	RootServices []ServiceID `json:"rootServices,required"`
-	// Map of service ID to service.
-	//
-	// Any service IDs present in this request will have a corresponding
-	// service definition in this map, including services for which code does
-	// not need to be generated.
-	Services map[ServiceID]*Service `json:"services,required"`
-	// Map of module ID to module.
-	//
-	// Any module IDs present in the request will have a corresponding module
-	// definition in this map.
-	Modules map[ModuleID]*Module `json:"modules,required"`
-	// Prefix for import paths of generated module. In general, plugins should
-	// not need to use the package prefix unless instantiating a new
-	// Generator for more custom plugin generation.
-	PackagePrefix string `json:"packagePrefix,required"`
-	// Directory whose descendants contain all Thrift files. In general,
-	// plugins should not need to use the thrift root unless instantiating a
-	// new Generator for more custom plugin generation.
-	ThriftRoot string `json:"thriftRoot,required"`
-}
+// DeclarationKind is the kind of a top-level declaration in a Thrift file.
+type DeclarationKind int32
 
-type _List_ServiceID_ValueList []ServiceID
+const (
+	DeclarationKindConstant  DeclarationKind = 1
+	DeclarationKindTypedef   DeclarationKind = 2
+	DeclarationKindEnum      DeclarationKind = 3
+	DeclarationKindStruct    DeclarationKind = 4
+	DeclarationKindUnion     DeclarationKind = 5
+	DeclarationKindException DeclarationKind = 6
+	DeclarationKindService   DeclarationKind = 7
+)
 
-func (v _List_ServiceID_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
+// DeclarationKind_Values returns all recognized values of DeclarationKind.
+func DeclarationKind_Values() []DeclarationKind {
+	return []DeclarationKind{
+		DeclarationKindConstant,
+		DeclarationKindTypedef,
+		DeclarationKindEnum,
+		DeclarationKindStruct,
+		DeclarationKindUnion,
+		DeclarationKindException,
+		DeclarationKindService,
+	}
+}
+
+// UnmarshalText tries to decode DeclarationKind from a byte slice
+// containing its name.
+//
+//   var v DeclarationKind
+//   err := v.UnmarshalText([]byte("CONSTANT"))
+func (v *DeclarationKind) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "CONSTANT":
+		*v = DeclarationKindConstant
+		return nil
+	case "TYPEDEF":
+		*v = DeclarationKindTypedef
+		return nil
+	case "ENUM":
+		*v = DeclarationKindEnum
+		return nil
+	case "STRUCT":
+		*v = DeclarationKindStruct
+		return nil
+	case "UNION":
+		*v = DeclarationKindUnion
+		return nil
+	case "EXCEPTION":
+		*v = DeclarationKindException
+		return nil
+	case "SERVICE":
+		*v = DeclarationKindService
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return err
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DeclarationKind", err)
 		}
+		*v = DeclarationKind(val)
+		return nil
 	}
-	return nil
 }
 
-func (v _List_ServiceID_ValueList) Size() int {
-	return len(v)
+// MarshalText encodes DeclarationKind to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v DeclarationKind) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return []byte("CONSTANT"), nil
+	case 2:
+		return []byte("TYPEDEF"), nil
+	case 3:
+		return []byte("ENUM"), nil
+	case 4:
+		return []byte("STRUCT"), nil
+	case 5:
+		return []byte("UNION"), nil
+	case 6:
+		return []byte("EXCEPTION"), nil
+	case 7:
+		return []byte("SERVICE"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func (_List_ServiceID_ValueList) ValueType() wire.Type {
-	return wire.TI32
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DeclarationKind.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v DeclarationKind) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 1:
+		enc.AddString("name", "CONSTANT")
+	case 2:
+		enc.AddString("name", "TYPEDEF")
+	case 3:
+		enc.AddString("name", "ENUM")
+	case 4:
+		enc.AddString("name", "STRUCT")
+	case 5:
+		enc.AddString("name", "UNION")
+	case 6:
+		enc.AddString("name", "EXCEPTION")
+	case 7:
+		enc.AddString("name", "SERVICE")
+	}
+	return nil
 }
 
-func (_List_ServiceID_ValueList) Close() {}
+// Ptr returns a pointer to this enum value.
+func (v DeclarationKind) Ptr() *DeclarationKind {
+	return &v
+}
 
-type _Map_ServiceID_Service_MapItemList map[ServiceID]*Service
+// ToWire translates DeclarationKind into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v DeclarationKind) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
 
-func (m _Map_ServiceID_Service_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := k.ToWire()
-		if err != nil {
-			return err
-		}
+// FromWire deserializes DeclarationKind from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return DeclarationKind(0), err
+//   }
+//
+//   var v DeclarationKind
+//   if err := v.FromWire(x); err != nil {
+//     return DeclarationKind(0), err
+//   }
+//   return v, nil
+func (v *DeclarationKind) FromWire(w wire.Value) error {
+	*v = (DeclarationKind)(w.GetI32())
+	return nil
+}
 
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
+// Decode reads off the encoded DeclarationKind directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v DeclarationKind
+//   if err := v.Decode(sReader); err != nil {
+//     return DeclarationKind(0), err
+//   }
+//   return v, nil
+func (v *DeclarationKind) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
 	}
+	*v = (DeclarationKind)(i)
 	return nil
 }
 
-func (m _Map_ServiceID_Service_MapItemList) Size() int {
-	return len(m)
+// String returns a readable string representation of DeclarationKind.
+func (v DeclarationKind) String() string {
+	w := int32(v)
+	switch w {
+	case 1:
+		return "CONSTANT"
+	case 2:
+		return "TYPEDEF"
+	case 3:
+		return "ENUM"
+	case 4:
+		return "STRUCT"
+	case 5:
+		return "UNION"
+	case 6:
+		return "EXCEPTION"
+	case 7:
+		return "SERVICE"
+	}
+	return fmt.Sprintf("DeclarationKind(%d)", w)
 }
 
-func (_Map_ServiceID_Service_MapItemList) KeyType() wire.Type {
-	return wire.TI32
+// Equals returns true if this DeclarationKind value matches the provided
+// value.
+func (v DeclarationKind) Equals(rhs DeclarationKind) bool {
+	return v == rhs
 }
 
-func (_Map_ServiceID_Service_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// MarshalJSON serializes DeclarationKind into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v DeclarationKind) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return ([]byte)("\"CONSTANT\""), nil
+	case 2:
+		return ([]byte)("\"TYPEDEF\""), nil
+	case 3:
+		return ([]byte)("\"ENUM\""), nil
+	case 4:
+		return ([]byte)("\"STRUCT\""), nil
+	case 5:
+		return ([]byte)("\"UNION\""), nil
+	case 6:
+		return ([]byte)("\"EXCEPTION\""), nil
+	case 7:
+		return ([]byte)("\"SERVICE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func (_Map_ServiceID_Service_MapItemList) Close() {}
-
-type _Map_ModuleID_Module_MapItemList map[ModuleID]*Module
+// UnmarshalJSON attempts to decode DeclarationKind from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *DeclarationKind) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
 
-func (m _Map_ModuleID_Module_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := k.ToWire()
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
 		if err != nil {
 			return err
 		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DeclarationKind")
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DeclarationKind")
 		}
+		*v = (DeclarationKind)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DeclarationKind")
 	}
-	return nil
-}
-
-func (m _Map_ModuleID_Module_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_ModuleID_Module_MapItemList) KeyType() wire.Type {
-	return wire.TI32
 }
 
-func (_Map_ModuleID_Module_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// Diagnostic is a problem found in a Thrift file.
+type Diagnostic struct {
+	// Path to the Thrift file which has the problem. This SHOULD be one of
+	// the thriftFilePaths of the request.
+	ThriftFilePath string `json:"thriftFilePath,required"`
+	// Line on which the problem was found, or 0 if it applies to the whole
+	// file.
+	Line int32 `json:"line,required"`
+	// Description of the problem.
+	Message string `json:"message,required"`
+	// Name of the rule which found the problem, if any.
+	Rule *string `json:"rule,omitempty"`
 }
 
-func (_Map_ModuleID_Module_MapItemList) Close() {}
-
-// ToWire translates a GenerateServiceRequest struct into a Thrift-level intermediate
+// ToWire translates a Diagnostic struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1130,162 +1774,51 @@ func (_Map_ModuleID_Module_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GenerateServiceRequest) ToWire() (wire.Value, error) {
+func (v *Diagnostic) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.RootServices == nil {
-		return w, errors.New("field RootServices of GenerateServiceRequest is required")
-	}
-	w, err = wire.NewValueList(_List_ServiceID_ValueList(v.RootServices)), error(nil)
+	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
-	if v.Services == nil {
-		return w, errors.New("field Services of GenerateServiceRequest is required")
-	}
-	w, err = wire.NewValueMap(_Map_ServiceID_Service_MapItemList(v.Services)), error(nil)
+
+	w, err = wire.NewValueI32(v.Line), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
-	if v.Modules == nil {
-		return w, errors.New("field Modules of GenerateServiceRequest is required")
-	}
-	w, err = wire.NewValueMap(_Map_ModuleID_Module_MapItemList(v.Modules)), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
 
-	w, err = wire.NewValueString(v.PackagePrefix), error(nil)
+	w, err = wire.NewValueString(v.Message), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 4, Value: w}
+	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
-
-	w, err = wire.NewValueString(v.ThriftRoot), error(nil)
-	if err != nil {
-		return w, err
+	if v.Rule != nil {
+		w, err = wire.NewValueString(*(v.Rule)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 5, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ServiceID_Read(w wire.Value) (ServiceID, error) {
-	var x ServiceID
-	err := x.FromWire(w)
-	return x, err
-}
-
-func _List_ServiceID_Read(l wire.ValueList) ([]ServiceID, error) {
-	if l.ValueType() != wire.TI32 {
-		return nil, nil
-	}
-
-	o := make([]ServiceID, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ServiceID_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _Service_Read(w wire.Value) (*Service, error) {
-	var v Service
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _Map_ServiceID_Service_Read(m wire.MapItemList) (map[ServiceID]*Service, error) {
-	if m.KeyType() != wire.TI32 {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[ServiceID]*Service, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := _ServiceID_Read(x.Key)
-		if err != nil {
-			return err
-		}
-
-		v, err := _Service_Read(x.Value)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-func _ModuleID_Read(w wire.Value) (ModuleID, error) {
-	var x ModuleID
-	err := x.FromWire(w)
-	return x, err
-}
-
-func _Module_Read(w wire.Value) (*Module, error) {
-	var v Module
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _Map_ModuleID_Module_Read(m wire.MapItemList) (map[ModuleID]*Module, error) {
-	if m.KeyType() != wire.TI32 {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[ModuleID]*Module, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := _ModuleID_Read(x.Key)
-		if err != nil {
-			return err
-		}
-
-		v, err := _Module_Read(x.Value)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-// FromWire deserializes a GenerateServiceRequest struct from its Thrift-level
+// FromWire deserializes a Diagnostic struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GenerateServiceRequest struct
+// An error is returned if we were unable to build a Diagnostic struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1293,373 +1826,397 @@ func _Map_ModuleID_Module_Read(m wire.MapItemList) (map[ModuleID]*Module, error)
 //     return nil, err
 //   }
 //
-//   var v GenerateServiceRequest
+//   var v Diagnostic
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GenerateServiceRequest) FromWire(w wire.Value) error {
+func (v *Diagnostic) FromWire(w wire.Value) error {
 	var err error
 
-	rootServicesIsSet := false
-	servicesIsSet := false
-	modulesIsSet := false
-	packagePrefixIsSet := false
-	thriftRootIsSet := false
+	thriftFilePathIsSet := false
+	lineIsSet := false
+	messageIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TList {
-				v.RootServices, err = _List_ServiceID_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				rootServicesIsSet = true
+				thriftFilePathIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TMap {
-				v.Services, err = _Map_ServiceID_Service_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TI32 {
+				v.Line, err = field.Value.GetI32(), error(nil)
 				if err != nil {
 					return err
 				}
-				servicesIsSet = true
+				lineIsSet = true
 			}
 		case 3:
-			if field.Value.Type() == wire.TMap {
-				v.Modules, err = _Map_ModuleID_Module_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-				modulesIsSet = true
-			}
-		case 4:
 			if field.Value.Type() == wire.TBinary {
-				v.PackagePrefix, err = field.Value.GetString(), error(nil)
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				packagePrefixIsSet = true
+				messageIsSet = true
 			}
-		case 5:
+		case 4:
 			if field.Value.Type() == wire.TBinary {
-				v.ThriftRoot, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Rule = &x
 				if err != nil {
 					return err
 				}
-				thriftRootIsSet = true
+
 			}
 		}
 	}
 
-	if !rootServicesIsSet {
-		return errors.New("field RootServices of GenerateServiceRequest is required")
+	if !thriftFilePathIsSet {
+		missing.Add("Diagnostic", "ThriftFilePath")
 	}
 
-	if !servicesIsSet {
-		return errors.New("field Services of GenerateServiceRequest is required")
+	if !lineIsSet {
+		missing.Add("Diagnostic", "Line")
 	}
 
-	if !modulesIsSet {
-		return errors.New("field Modules of GenerateServiceRequest is required")
+	if !messageIsSet {
+		missing.Add("Diagnostic", "Message")
 	}
 
-	if !packagePrefixIsSet {
-		return errors.New("field PackagePrefix of GenerateServiceRequest is required")
+	return missing.Err()
+}
+
+func (v *Diagnostic) Decode(sr stream.Reader) error {
+	thriftFilePathIsSet := false
+	lineIsSet := false
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	if !thriftRootIsSet {
-		return errors.New("field ThriftRoot of GenerateServiceRequest is required")
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return nil
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ThriftFilePath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftFilePathIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Line, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			lineIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Rule = &x
+			if err != nil {
+				return err
+			}
 
-// String returns a readable string representation of a GenerateServiceRequest
-// struct.
-func (v *GenerateServiceRequest) String() string {
-	if v == nil {
-		return "<nil>"
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	var fields [5]string
-	i := 0
-	fields[i] = fmt.Sprintf("RootServices: %v", v.RootServices)
-	i++
-	fields[i] = fmt.Sprintf("Services: %v", v.Services)
-	i++
-	fields[i] = fmt.Sprintf("Modules: %v", v.Modules)
-	i++
-	fields[i] = fmt.Sprintf("PackagePrefix: %v", v.PackagePrefix)
-	i++
-	fields[i] = fmt.Sprintf("ThriftRoot: %v", v.ThriftRoot)
-	i++
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
-	return fmt.Sprintf("GenerateServiceRequest{%v}", strings.Join(fields[:i], ", "))
-}
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of Diagnostic is required")
+	}
 
-func _List_ServiceID_Equals(lhs, rhs []ServiceID) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if !lineIsSet {
+		return errors.New("field Line of Diagnostic is required")
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
+	if !messageIsSet {
+		return errors.New("field Message of Diagnostic is required")
 	}
 
-	return true
+	return nil
 }
 
-func _Map_ServiceID_Service_Equals(lhs, rhs map[ServiceID]*Service) bool {
-	if len(lhs) != len(rhs) {
-		return false
+// MarshalJSON serializes a Diagnostic struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Diagnostic) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.ThriftFilePath)
+		if err != nil {
+			return nil, err
 		}
-		if !lv.Equals(rv) {
-			return false
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
 		}
+		buff.WriteString(`"thriftFilePath":`)
+		buff.Write(b)
 	}
-	return true
-}
-
-func _Map_ModuleID_Module_Equals(lhs, rhs map[ModuleID]*Module) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	{
+		b, err := json.Marshal(v.Line)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"line":`)
+		buff.Write(b)
 	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
+	{
+		b, err := json.Marshal(v.Message)
+		if err != nil {
+			return nil, err
 		}
-		if !lv.Equals(rv) {
-			return false
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
 		}
+		buff.WriteString(`"message":`)
+		buff.Write(b)
 	}
-	return true
+	if !(v.Rule == nil) {
+		b, err := json.Marshal(v.Rule)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"rule":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-// Equals returns true if all the fields of this GenerateServiceRequest match the
-// provided GenerateServiceRequest.
+// UnmarshalJSON deserializes a Diagnostic struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
 //
-// This function performs a deep comparison.
-func (v *GenerateServiceRequest) Equals(rhs *GenerateServiceRequest) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_List_ServiceID_Equals(v.RootServices, rhs.RootServices) {
-		return false
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Diagnostic) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
 	}
-	if !_Map_ServiceID_Service_Equals(v.Services, rhs.Services) {
-		return false
+	if r, ok := raw["thriftFilePath"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftFilePath); err != nil {
+			return err
+		}
 	}
-	if !_Map_ModuleID_Module_Equals(v.Modules, rhs.Modules) {
-		return false
+	if r, ok := raw["line"]; ok {
+		if err := json.Unmarshal(r, &v.Line); err != nil {
+			return err
+		}
 	}
-	if !(v.PackagePrefix == rhs.PackagePrefix) {
-		return false
+	if r, ok := raw["message"]; ok {
+		if err := json.Unmarshal(r, &v.Message); err != nil {
+			return err
+		}
 	}
-	if !(v.ThriftRoot == rhs.ThriftRoot) {
-		return false
+	if r, ok := raw["rule"]; ok {
+		if err := json.Unmarshal(r, &v.Rule); err != nil {
+			return err
+		}
 	}
 
-	return true
+	return nil
 }
 
-type _List_ServiceID_Zapper []ServiceID
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ServiceID_Zapper.
-func (l _List_ServiceID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendInt32((int32)(v))
+// String returns a readable string representation of a Diagnostic
+// struct.
+func (v *Diagnostic) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return err
-}
 
-type _Map_ServiceID_Service_Item_Zapper struct {
-	Key   ServiceID
-	Value *Service
-}
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
+	i++
+	fields[i] = fmt.Sprintf("Line: %v", v.Line)
+	i++
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Rule != nil {
+		fields[i] = fmt.Sprintf("Rule: %v", *(v.Rule))
+		i++
+	}
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ServiceID_Service_Item_Zapper.
-func (v _Map_ServiceID_Service_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	enc.AddInt32("key", (int32)(v.Key))
-	err = multierr.Append(err, enc.AddObject("value", v.Value))
-	return err
+	return fmt.Sprintf("Diagnostic{%v}", strings.Join(fields[:i], ", "))
 }
 
-type _Map_ServiceID_Service_Zapper map[ServiceID]*Service
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ServiceID_Service_Zapper.
-func (m _Map_ServiceID_Service_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AppendObject(_Map_ServiceID_Service_Item_Zapper{Key: k, Value: v}))
+// Equals returns true if all the fields of this Diagnostic match the
+// provided Diagnostic.
+//
+// This function performs a deep comparison.
+func (v *Diagnostic) Equals(rhs *Diagnostic) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+		return false
+	}
+	if !(v.Line == rhs.Line) {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Rule, rhs.Rule) {
+		return false
 	}
-	return err
-}
 
-type _Map_ModuleID_Module_Item_Zapper struct {
-	Key   ModuleID
-	Value *Module
+	return true
 }
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ModuleID_Module_Item_Zapper.
-func (v _Map_ModuleID_Module_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	enc.AddInt32("key", (int32)(v.Key))
-	err = multierr.Append(err, enc.AddObject("value", v.Value))
-	return err
-}
+// Clone returns a deep copy of this Diagnostic. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Diagnostic) Clone() *Diagnostic {
+	if v == nil {
+		return nil
+	}
 
-type _Map_ModuleID_Module_Zapper map[ModuleID]*Module
+	var c Diagnostic
+	c.ThriftFilePath = v.ThriftFilePath
+	c.Line = v.Line
+	c.Message = v.Message
+	c.Rule = _String_ClonePtr(v.Rule)
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_ModuleID_Module_Zapper.
-func (m _Map_ModuleID_Module_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AppendObject(_Map_ModuleID_Module_Item_Zapper{Key: k, Value: v}))
-	}
-	return err
+	return &c
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GenerateServiceRequest.
-func (v *GenerateServiceRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Diagnostic.
+func (v *Diagnostic) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	err = multierr.Append(err, enc.AddArray("rootServices", (_List_ServiceID_Zapper)(v.RootServices)))
-	err = multierr.Append(err, enc.AddArray("services", (_Map_ServiceID_Service_Zapper)(v.Services)))
-	err = multierr.Append(err, enc.AddArray("modules", (_Map_ModuleID_Module_Zapper)(v.Modules)))
-	enc.AddString("packagePrefix", v.PackagePrefix)
-	enc.AddString("thriftRoot", v.ThriftRoot)
+	enc.AddString("thriftFilePath", v.ThriftFilePath)
+	enc.AddInt32("line", v.Line)
+	enc.AddString("message", v.Message)
+	if v.Rule != nil {
+		enc.AddString("rule", *v.Rule)
+	}
 	return err
 }
 
-// GetRootServices returns the value of RootServices if it is set or its
+// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetRootServices() (o []ServiceID) {
+func (v *Diagnostic) GetThriftFilePath() (o string) {
 	if v != nil {
-		o = v.RootServices
+		o = v.ThriftFilePath
 	}
 	return
 }
 
-// IsSetRootServices returns true if RootServices is not nil.
-func (v *GenerateServiceRequest) IsSetRootServices() bool {
-	return v != nil && v.RootServices != nil
-}
-
-// GetServices returns the value of Services if it is set or its
+// GetLine returns the value of Line if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetServices() (o map[ServiceID]*Service) {
+func (v *Diagnostic) GetLine() (o int32) {
 	if v != nil {
-		o = v.Services
+		o = v.Line
 	}
 	return
 }
 
-// IsSetServices returns true if Services is not nil.
-func (v *GenerateServiceRequest) IsSetServices() bool {
-	return v != nil && v.Services != nil
-}
-
-// GetModules returns the value of Modules if it is set or its
+// GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetModules() (o map[ModuleID]*Module) {
+func (v *Diagnostic) GetMessage() (o string) {
 	if v != nil {
-		o = v.Modules
+		o = v.Message
 	}
 	return
 }
 
-// IsSetModules returns true if Modules is not nil.
-func (v *GenerateServiceRequest) IsSetModules() bool {
-	return v != nil && v.Modules != nil
-}
-
-// GetPackagePrefix returns the value of PackagePrefix if it is set or its
+// GetRule returns the value of Rule if it is set or its
 // zero value if it is unset.
-func (v *GenerateServiceRequest) GetPackagePrefix() (o string) {
-	if v != nil {
-		o = v.PackagePrefix
+func (v *Diagnostic) GetRule() (o string) {
+	if v != nil && v.Rule != nil {
+		return *v.Rule
 	}
+
 	return
 }
 
-// GetThriftRoot returns the value of ThriftRoot if it is set or its
-// zero value if it is unset.
-func (v *GenerateServiceRequest) GetThriftRoot() (o string) {
-	if v != nil {
-		o = v.ThriftRoot
-	}
-	return
+// IsSetRule returns true if Rule is not nil.
+func (v *Diagnostic) IsSetRule() bool {
+	return v != nil && v.Rule != nil
 }
 
-// GenerateServiceResponse is response to a GenerateServiceRequest.
-type GenerateServiceResponse struct {
-	// Map of file path to file contents.
+// EnumItem is an item of an enum referenced by a TypeReference.
+type EnumItem struct {
+	// Name of the Go constant generated for this item.
+	Name string `json:"name,required"`
+	// Name of this item in the Thrift file.
+	ThriftName string `json:"thriftName,required"`
+	Value      int32  `json:"value,required"`
+	// Annotations defined on this item.
 	//
-	// All paths MUST be relative to the output directory into which ThriftRW
-	// is generating code. Plugins SHOULD NOT make any assumptions about the
-	// absolute location of the directory.
+	// Given,
 	//
-	// The paths MUST NOT contain the string ".." or the request will fail.
-	Files map[string][]byte `json:"files,omitempty"`
-}
-
-type _Map_String_Binary_MapItemList map[string][]byte
-
-func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := wire.NewValueBinary(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_Binary_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
-	return wire.TBinary
+	//   enum Status {
+	//     ACTIVE,
+	//     SUSPENDED (deprecated = "Use DISABLED instead."),
+	//     DISABLED,
+	//   }
+	//
+	// The annotations of SUSPENDED will be,
+	//
+	//   {
+	//     "deprecated": "Use DISABLED instead.",
+	//   }
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
-func (_Map_String_Binary_MapItemList) Close() {}
-
-// ToWire translates a GenerateServiceResponse struct into a Thrift-level intermediate
+// ToWire translates a EnumItem struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1674,59 +2231,51 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GenerateServiceResponse) ToWire() (wire.Value, error) {
+func (v *EnumItem) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Files != nil {
-		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
+	w, err = wire.NewValueString(v.ThriftName), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
 
-	if m.ValueType() != wire.TBinary {
-		return nil, nil
+	w, err = wire.NewValueI32(v.Value), error(nil)
+	if err != nil {
+		return w, err
 	}
-
-	o := make(map[string][]byte, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := x.Value.GetBinary(), error(nil)
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GenerateServiceResponse struct from its Thrift-level
+// FromWire deserializes a EnumItem struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GenerateServiceResponse struct
+// An error is returned if we were unable to build a EnumItem struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1734,341 +2283,807 @@ func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
 //     return nil, err
 //   }
 //
-//   var v GenerateServiceResponse
+//   var v EnumItem
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GenerateServiceResponse) FromWire(w wire.Value) error {
+func (v *EnumItem) FromWire(w wire.Value) error {
 	var err error
 
+	nameIsSet := false
+	thriftNameIsSet := false
+	valueIsSet := false
+
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
-			if field.Value.Type() == wire.TMap {
-				v.Files, err = _Map_String_Binary_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				nameIsSet = true
 			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a GenerateServiceResponse
-// struct.
-func (v *GenerateServiceResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftName, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftNameIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				v.Value, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				valueIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
 
-	var fields [1]string
-	i := 0
-	if v.Files != nil {
-		fields[i] = fmt.Sprintf("Files: %v", v.Files)
-		i++
+			}
+		}
 	}
 
-	return fmt.Sprintf("GenerateServiceResponse{%v}", strings.Join(fields[:i], ", "))
-}
+	if !nameIsSet {
+		missing.Add("EnumItem", "Name")
+	}
 
-func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if !thriftNameIsSet {
+		missing.Add("EnumItem", "ThriftName")
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !bytes.Equal(lv, rv) {
-			return false
-		}
+	if !valueIsSet {
+		missing.Add("EnumItem", "Value")
 	}
-	return true
+
+	return missing.Err()
 }
 
-// Equals returns true if all the fields of this GenerateServiceResponse match the
-// provided GenerateServiceResponse.
-//
-// This function performs a deep comparison.
-func (v *GenerateServiceResponse) Equals(rhs *GenerateServiceResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+func (v *EnumItem) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	thriftNameIsSet := false
+	valueIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	if !((v.Files == nil && rhs.Files == nil) || (v.Files != nil && rhs.Files != nil && _Map_String_Binary_Equals(v.Files, rhs.Files))) {
-		return false
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return true
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ThriftName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftNameIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			v.Value, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			valueIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
 
-type _Map_String_Binary_Zapper map[string][]byte
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_Binary_Zapper.
-func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
-	}
-	return err
-}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GenerateServiceResponse.
-func (v *GenerateServiceResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	if v.Files != nil {
-		err = multierr.Append(err, enc.AddObject("files", (_Map_String_Binary_Zapper)(v.Files)))
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
-	return err
-}
 
-// GetFiles returns the value of Files if it is set or its
-// zero value if it is unset.
-func (v *GenerateServiceResponse) GetFiles() (o map[string][]byte) {
-	if v != nil && v.Files != nil {
-		return v.Files
+	if !nameIsSet {
+		return errors.New("field Name of EnumItem is required")
 	}
 
-	return
-}
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of EnumItem is required")
+	}
 
-// IsSetFiles returns true if Files is not nil.
-func (v *GenerateServiceResponse) IsSetFiles() bool {
-	return v != nil && v.Files != nil
-}
+	if !valueIsSet {
+		return errors.New("field Value of EnumItem is required")
+	}
 
-// HandshakeRequest is the initial request sent to the plugin as part of
-// establishing communication and feature negotiation.
-type HandshakeRequest struct {
+	return nil
 }
 
-// ToWire translates a HandshakeRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// MarshalJSON serializes a EnumItem struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *HandshakeRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [0]wire.Field
-		i      int = 0
-	)
+// This implements json.Marshaler.
+func (v *EnumItem) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ThriftName)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftName":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"value":`)
+		buff.Write(b)
+	}
+	if !(len(v.Annotations) == 0) {
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-// FromWire deserializes a HandshakeRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a HandshakeRequest struct
-// from the provided intermediate representation.
+// UnmarshalJSON deserializes a EnumItem struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
 //
-//   var v HandshakeRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *HandshakeRequest) FromWire(w wire.Value) error {
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
+// This implements json.Unmarshaler.
+func (v *EnumItem) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["thriftName"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftName); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["value"]; ok {
+		if err := json.Unmarshal(r, &v.Value); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a HandshakeRequest
+// String returns a readable string representation of a EnumItem
 // struct.
-func (v *HandshakeRequest) String() string {
+func (v *EnumItem) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [4]string
 	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
+	i++
+	fields[i] = fmt.Sprintf("Value: %v", v.Value)
+	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
 
-	return fmt.Sprintf("HandshakeRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("EnumItem{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this HandshakeRequest match the
-// provided HandshakeRequest.
+// Equals returns true if all the fields of this EnumItem match the
+// provided EnumItem.
 //
 // This function performs a deep comparison.
-func (v *HandshakeRequest) Equals(rhs *HandshakeRequest) bool {
+func (v *EnumItem) Equals(rhs *EnumItem) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.ThriftName == rhs.ThriftName) {
+		return false
+	}
+	if !(v.Value == rhs.Value) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
 
 	return true
 }
 
+// Clone returns a deep copy of this EnumItem. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *EnumItem) Clone() *EnumItem {
+	if v == nil {
+		return nil
+	}
+
+	var c EnumItem
+	c.Name = v.Name
+	c.ThriftName = v.ThriftName
+	c.Value = v.Value
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HandshakeRequest.
-func (v *HandshakeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of EnumItem.
+func (v *EnumItem) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	enc.AddString("name", v.Name)
+	enc.AddString("thriftName", v.ThriftName)
+	enc.AddInt32("value", v.Value)
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
 	return err
 }
 
-// HandshakeResponse is the response from the plugin for a HandshakeRequest.
-type HandshakeResponse struct {
-	// Name of the plugin. This MUST match the name of the plugin specified
-	// over the command line or the program will fail.
-	Name string `json:"name,required"`
-	// Version of the plugin API.
-	//
-	// This MUST be set to API_VERSION by the plugin.
-	APIVersion int32 `json:"apiVersion,required"`
-	// List of features the plugin provides.
-	Features []Feature `json:"features,required"`
-	// Version of ThriftRW with which the plugin was built.
-	//
-	// This MUST be set to go.uber.org/thriftrw/version.Version by the plugin
-	// explicitly.
-	LibraryVersion *string `json:"libraryVersion,omitempty"`
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *EnumItem) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
 }
 
-type _List_Feature_ValueList []Feature
+// GetThriftName returns the value of ThriftName if it is set or its
+// zero value if it is unset.
+func (v *EnumItem) GetThriftName() (o string) {
+	if v != nil {
+		o = v.ThriftName
+	}
+	return
+}
 
-func (v _List_Feature_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *EnumItem) GetValue() (o int32) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *EnumItem) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *EnumItem) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// Feature is a functionality offered by a ThriftRW plugin.
+type Feature int32
+
+const (
+	// SERVICE_GENERATOR specifies that the plugin may generate arbitrary code
+	// for services defined in the Thrift file.
+	//
+	// If a plugin provides this, it MUST implement the ServiceGenerator
+	// service.
+	FeatureServiceGenerator Feature = 1
+	// TYPE_MAPPER specifies that the plugin may replace the Go types used
+	// for fields based on their annotations.
+	//
+	// If a plugin provides this, it MUST implement the TypeMapper service.
+	FeatureTypeMapper Feature = 2
+	// VALIDATOR specifies that the plugin may check Thrift files for
+	// problems before code is generated for them.
+	//
+	// If a plugin provides this, it MUST implement the Validator service.
+	FeatureValidator Feature = 3
+	// POST_PROCESSOR specifies that the plugin may modify the files
+	// generated by ThriftRW and other plugins before they are written.
+	//
+	// If a plugin provides this, it MUST implement the PostProcessor service.
+	FeaturePostProcessor Feature = 4
+)
+
+// Feature_Values returns all recognized values of Feature.
+func Feature_Values() []Feature {
+	return []Feature{
+		FeatureServiceGenerator,
+		FeatureTypeMapper,
+		FeatureValidator,
+		FeaturePostProcessor,
+	}
+}
+
+// UnmarshalText tries to decode Feature from a byte slice
+// containing its name.
+//
+//   var v Feature
+//   err := v.UnmarshalText([]byte("SERVICE_GENERATOR"))
+func (v *Feature) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "SERVICE_GENERATOR":
+		*v = FeatureServiceGenerator
+		return nil
+	case "TYPE_MAPPER":
+		*v = FeatureTypeMapper
+		return nil
+	case "VALIDATOR":
+		*v = FeatureValidator
+		return nil
+	case "POST_PROCESSOR":
+		*v = FeaturePostProcessor
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return err
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Feature", err)
 		}
+		*v = Feature(val)
+		return nil
 	}
-	return nil
 }
 
-func (v _List_Feature_ValueList) Size() int {
-	return len(v)
+// MarshalText encodes Feature to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Feature) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return []byte("SERVICE_GENERATOR"), nil
+	case 2:
+		return []byte("TYPE_MAPPER"), nil
+	case 3:
+		return []byte("VALIDATOR"), nil
+	case 4:
+		return []byte("POST_PROCESSOR"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func (_List_Feature_ValueList) ValueType() wire.Type {
-	return wire.TI32
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Feature.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Feature) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 1:
+		enc.AddString("name", "SERVICE_GENERATOR")
+	case 2:
+		enc.AddString("name", "TYPE_MAPPER")
+	case 3:
+		enc.AddString("name", "VALIDATOR")
+	case 4:
+		enc.AddString("name", "POST_PROCESSOR")
+	}
+	return nil
 }
 
-func (_List_Feature_ValueList) Close() {}
+// Ptr returns a pointer to this enum value.
+func (v Feature) Ptr() *Feature {
+	return &v
+}
 
-// ToWire translates a HandshakeResponse struct into a Thrift-level intermediate
+// ToWire translates Feature into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// An error is returned if the struct or any of its fields failed to
-// validate.
+// Enums are represented as 32-bit integers over the wire.
+func (v Feature) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Feature from its Thrift-level
+// representation.
 //
-//   x, err := v.ToWire()
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return err
+//     return Feature(0), err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
+//   var v Feature
+//   if err := v.FromWire(x); err != nil {
+//     return Feature(0), err
 //   }
-func (v *HandshakeResponse) ToWire() (wire.Value, error) {
-	var (
-		fields [4]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+//   return v, nil
+func (v *Feature) FromWire(w wire.Value) error {
+	*v = (Feature)(w.GetI32())
+	return nil
+}
 
-	w, err = wire.NewValueString(v.Name), error(nil)
+// Decode reads off the encoded Feature directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v Feature
+//   if err := v.Decode(sReader); err != nil {
+//     return Feature(0), err
+//   }
+//   return v, nil
+func (v *Feature) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
 	if err != nil {
-		return w, err
+		return err
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
+	*v = (Feature)(i)
+	return nil
+}
 
-	w, err = wire.NewValueI32(v.APIVersion), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
-	if v.Features == nil {
-		return w, errors.New("field Features of HandshakeResponse is required")
-	}
-	w, err = wire.NewValueList(_List_Feature_ValueList(v.Features)), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
-	if v.LibraryVersion != nil {
-		w, err = wire.NewValueString(*(v.LibraryVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
+// String returns a readable string representation of Feature.
+func (v Feature) String() string {
+	w := int32(v)
+	switch w {
+	case 1:
+		return "SERVICE_GENERATOR"
+	case 2:
+		return "TYPE_MAPPER"
+	case 3:
+		return "VALIDATOR"
+	case 4:
+		return "POST_PROCESSOR"
 	}
+	return fmt.Sprintf("Feature(%d)", w)
+}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+// Equals returns true if this Feature value matches the provided
+// value.
+func (v Feature) Equals(rhs Feature) bool {
+	return v == rhs
 }
 
-func _Feature_Read(w wire.Value) (Feature, error) {
-	var v Feature
-	err := v.FromWire(w)
-	return v, err
+// MarshalJSON serializes Feature into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Feature) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return ([]byte)("\"SERVICE_GENERATOR\""), nil
+	case 2:
+		return ([]byte)("\"TYPE_MAPPER\""), nil
+	case 3:
+		return ([]byte)("\"VALIDATOR\""), nil
+	case 4:
+		return ([]byte)("\"POST_PROCESSOR\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func _List_Feature_Read(l wire.ValueList) ([]Feature, error) {
-	if l.ValueType() != wire.TI32 {
-		return nil, nil
+// UnmarshalJSON attempts to decode Feature from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Feature) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
 
-	o := make([]Feature, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Feature_Read(x)
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
 		if err != nil {
 			return err
 		}
-		o = append(o, i)
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Feature")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Feature")
+		}
+		*v = (Feature)(x)
 		return nil
-	})
-	l.Close()
-	return o, err
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Feature")
+	}
 }
 
-// FromWire deserializes a HandshakeResponse struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
+// Function is a single function on a Thrift service.
+type Function struct {
+	// Name of the Go function.
+	Name string `json:"name,required"`
+	// Name of the function as defined in the Thrift file.
+	ThriftName string `json:"thriftName,required"`
+	// List of arguments accepted by the function.
+	//
+	// This list is in the order specified by the user in the Thrift file.
+	Arguments []*Argument `json:"arguments,required"`
+	// Return type of the function, if any. If this is not set, the function
+	// is a void function.
+	ReturnType *Type `json:"returnType,omitempty"`
+	// List of exceptions raised by the function.
+	//
+	// This list is in the order specified by the user in the Thrift file.
+	Exceptions []*Argument `json:"exceptions,omitempty"`
+	// Whether this function is oneway or not. This should be assumed to be
+	// false unless explicitly stated otherwise. If this is true, the
+	// returnType and exceptions will be null or empty.
+	OneWay *bool `json:"oneWay,omitempty"`
+	// Annotations defined on this function.
+	//
+	// Given,
+	//
+	//   void setValue(1: SetValueRequest req) (cache = "false")
+	//
+	// The annotations will be,
+	//
+	//  {
+	//    "cache": "false",
+	//  }
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Whether this function streams its results. This should be assumed to
+	// be false unless explicitly stated otherwise. If this is true,
+	// returnType is the type of each value in the stream.
+	//
+	// Given,
+	//
+	//   stream<Event> subscribe(1: string topic)
+	//
+	// The returnType will be Event.
+	Streaming *bool `json:"streaming,omitempty"`
+	// Documentation for this function, if any, with the comment markers
+	// removed.
+	Doc *string `json:"doc,omitempty"`
+}
+
+type _List_Argument_ValueList []*Argument
+
+func (v _List_Argument_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Argument_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Argument_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Argument_ValueList) Close() {}
+
+// ToWire translates a Function struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Function) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ThriftName), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Arguments == nil {
+		return w, errors.New("field Arguments of Function is required")
+	}
+	w, err = wire.NewValueList(_List_Argument_ValueList(v.Arguments)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.ReturnType != nil {
+		w, err = v.ReturnType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Exceptions != nil {
+		w, err = wire.NewValueList(_List_Argument_ValueList(v.Exceptions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.OneWay != nil {
+		w, err = wire.NewValueBool(*(v.OneWay)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Streaming != nil {
+		w, err = wire.NewValueBool(*(v.Streaming)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Argument_Read(w wire.Value) (*Argument, error) {
+	var v Argument
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Argument_Read(l wire.ValueList) ([]*Argument, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Argument, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Argument_Read(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a Function struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HandshakeResponse struct
+// An error is returned if we were unable to build a Function struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2076,17 +3091,19 @@ func _List_Feature_Read(l wire.ValueList) ([]Feature, error) {
 //     return nil, err
 //   }
 //
-//   var v HandshakeResponse
+//   var v Function
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *HandshakeResponse) FromWire(w wire.Value) error {
+func (v *Function) FromWire(w wire.Value) error {
 	var err error
 
 	nameIsSet := false
-	apiVersionIsSet := false
-	featuresIsSet := false
+	thriftNameIsSet := false
+	argumentsIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -2099,26 +3116,70 @@ func (v *HandshakeResponse) FromWire(w wire.Value) error {
 				nameIsSet = true
 			}
 		case 2:
-			if field.Value.Type() == wire.TI32 {
-				v.APIVersion, err = field.Value.GetI32(), error(nil)
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftName, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				apiVersionIsSet = true
+				thriftNameIsSet = true
 			}
 		case 3:
 			if field.Value.Type() == wire.TList {
-				v.Features, err = _List_Feature_Read(field.Value.GetList())
-				if err != nil {
+				v.Arguments, err = _List_Argument_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
-				featuresIsSet = true
+				argumentsIsSet = true
 			}
 		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ReturnType, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Exceptions, err = _List_Argument_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.OneWay = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Streaming = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.LibraryVersion = &x
+				v.Doc = &x
 				if err != nil {
 					return err
 				}
@@ -2128,44 +3189,394 @@ func (v *HandshakeResponse) FromWire(w wire.Value) error {
 	}
 
 	if !nameIsSet {
-		return errors.New("field Name of HandshakeResponse is required")
+		missing.Add("Function", "Name")
 	}
 
-	if !apiVersionIsSet {
-		return errors.New("field APIVersion of HandshakeResponse is required")
+	if !thriftNameIsSet {
+		missing.Add("Function", "ThriftName")
 	}
 
-	if !featuresIsSet {
-		return errors.New("field Features of HandshakeResponse is required")
+	if !argumentsIsSet {
+		missing.Add("Function", "Arguments")
 	}
 
-	return nil
+	return missing.Err()
 }
 
-// String returns a readable string representation of a HandshakeResponse
+func _Argument_Decode(sr stream.Reader) (*Argument, error) {
+	var v Argument
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Argument_Decode(sr stream.Reader) ([]*Argument, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Argument, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Argument_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *Function) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	thriftNameIsSet := false
+	argumentsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ThriftName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftNameIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Arguments, err = _List_Argument_Decode(sr)
+			if err != nil {
+				return err
+			}
+			argumentsIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ReturnType, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Exceptions, err = _List_Argument_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.OneWay = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 8 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Streaming = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Doc = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Function is required")
+	}
+
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Function is required")
+	}
+
+	if !argumentsIsSet {
+		return errors.New("field Arguments of Function is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Function struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Function) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ThriftName)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftName":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Arguments)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"arguments":`)
+		buff.Write(b)
+	}
+	if !(v.ReturnType == nil) {
+		b, err := json.Marshal(v.ReturnType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"returnType":`)
+		buff.Write(b)
+	}
+	if !(len(v.Exceptions) == 0) {
+		b, err := json.Marshal(v.Exceptions)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"exceptions":`)
+		buff.Write(b)
+	}
+	if !(v.OneWay == nil) {
+		b, err := json.Marshal(v.OneWay)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"oneWay":`)
+		buff.Write(b)
+	}
+	if !(len(v.Annotations) == 0) {
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+	if !(v.Streaming == nil) {
+		b, err := json.Marshal(v.Streaming)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"streaming":`)
+		buff.Write(b)
+	}
+	if !(v.Doc == nil) {
+		b, err := json.Marshal(v.Doc)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doc":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Function struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Function) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["thriftName"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftName); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["arguments"]; ok {
+		if err := json.Unmarshal(r, &v.Arguments); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["returnType"]; ok {
+		if err := json.Unmarshal(r, &v.ReturnType); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["exceptions"]; ok {
+		if err := json.Unmarshal(r, &v.Exceptions); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["oneWay"]; ok {
+		if err := json.Unmarshal(r, &v.OneWay); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["streaming"]; ok {
+		if err := json.Unmarshal(r, &v.Streaming); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["doc"]; ok {
+		if err := json.Unmarshal(r, &v.Doc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Function
 // struct.
-func (v *HandshakeResponse) String() string {
+func (v *Function) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [9]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
-	fields[i] = fmt.Sprintf("APIVersion: %v", v.APIVersion)
+	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
 	i++
-	fields[i] = fmt.Sprintf("Features: %v", v.Features)
+	fields[i] = fmt.Sprintf("Arguments: %v", v.Arguments)
 	i++
-	if v.LibraryVersion != nil {
-		fields[i] = fmt.Sprintf("LibraryVersion: %v", *(v.LibraryVersion))
+	if v.ReturnType != nil {
+		fields[i] = fmt.Sprintf("ReturnType: %v", v.ReturnType)
+		i++
+	}
+	if v.Exceptions != nil {
+		fields[i] = fmt.Sprintf("Exceptions: %v", v.Exceptions)
+		i++
+	}
+	if v.OneWay != nil {
+		fields[i] = fmt.Sprintf("OneWay: %v", *(v.OneWay))
+		i++
+	}
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
+	if v.Streaming != nil {
+		fields[i] = fmt.Sprintf("Streaming: %v", *(v.Streaming))
+		i++
+	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
 		i++
 	}
 
-	return fmt.Sprintf("HandshakeResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Function{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_Feature_Equals(lhs, rhs []Feature) bool {
+func _List_Argument_Equals(lhs, rhs []*Argument) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
@@ -2180,7 +3591,7 @@ func _List_Feature_Equals(lhs, rhs []Feature) bool {
 	return true
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -2190,11 +3601,11 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this HandshakeResponse match the
-// provided HandshakeResponse.
+// Equals returns true if all the fields of this Function match the
+// provided Function.
 //
 // This function performs a deep comparison.
-func (v *HandshakeResponse) Equals(rhs *HandshakeResponse) bool {
+func (v *Function) Equals(rhs *Function) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2203,24 +3614,82 @@ func (v *HandshakeResponse) Equals(rhs *HandshakeResponse) bool {
 	if !(v.Name == rhs.Name) {
 		return false
 	}
-	if !(v.APIVersion == rhs.APIVersion) {
+	if !(v.ThriftName == rhs.ThriftName) {
 		return false
 	}
-	if !_List_Feature_Equals(v.Features, rhs.Features) {
+	if !_List_Argument_Equals(v.Arguments, rhs.Arguments) {
 		return false
 	}
-	if !_String_EqualsPtr(v.LibraryVersion, rhs.LibraryVersion) {
+	if !((v.ReturnType == nil && rhs.ReturnType == nil) || (v.ReturnType != nil && rhs.ReturnType != nil && v.ReturnType.Equals(rhs.ReturnType))) {
+		return false
+	}
+	if !((v.Exceptions == nil && rhs.Exceptions == nil) || (v.Exceptions != nil && rhs.Exceptions != nil && _List_Argument_Equals(v.Exceptions, rhs.Exceptions))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.OneWay, rhs.OneWay) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Streaming, rhs.Streaming) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
 		return false
 	}
 
 	return true
 }
 
-type _List_Feature_Zapper []Feature
+func _List_Argument_Clone(v []*Argument) []*Argument {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Argument, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _Bool_ClonePtr(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Function. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Function) Clone() *Function {
+	if v == nil {
+		return nil
+	}
+
+	var c Function
+	c.Name = v.Name
+	c.ThriftName = v.ThriftName
+	c.Arguments = _List_Argument_Clone(v.Arguments)
+	c.ReturnType = v.ReturnType.Clone()
+	c.Exceptions = _List_Argument_Clone(v.Exceptions)
+	c.OneWay = _Bool_ClonePtr(v.OneWay)
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+	c.Streaming = _Bool_ClonePtr(v.Streaming)
+	c.Doc = _String_ClonePtr(v.Doc)
+
+	return &c
+}
+
+type _List_Argument_Zapper []*Argument
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_Feature_Zapper.
-func (l _List_Feature_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_Argument_Zapper.
+func (l _List_Argument_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
 		err = multierr.Append(err, enc.AppendObject(v))
 	}
@@ -2228,84 +3697,165 @@ func (l _List_Feature_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HandshakeResponse.
-func (v *HandshakeResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Function.
+func (v *Function) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	enc.AddString("name", v.Name)
-	enc.AddInt32("apiVersion", v.APIVersion)
-	err = multierr.Append(err, enc.AddArray("features", (_List_Feature_Zapper)(v.Features)))
-	if v.LibraryVersion != nil {
-		enc.AddString("libraryVersion", *v.LibraryVersion)
-	}
-	return err
+	enc.AddString("thriftName", v.ThriftName)
+	err = multierr.Append(err, enc.AddArray("arguments", (_List_Argument_Zapper)(v.Arguments)))
+	if v.ReturnType != nil {
+		err = multierr.Append(err, enc.AddObject("returnType", v.ReturnType))
+	}
+	if v.Exceptions != nil {
+		err = multierr.Append(err, enc.AddArray("exceptions", (_List_Argument_Zapper)(v.Exceptions)))
+	}
+	if v.OneWay != nil {
+		enc.AddBool("oneWay", *v.OneWay)
+	}
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
+	if v.Streaming != nil {
+		enc.AddBool("streaming", *v.Streaming)
+	}
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
+	}
+	return err
 }
 
 // GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetName() (o string) {
+func (v *Function) GetName() (o string) {
 	if v != nil {
 		o = v.Name
 	}
 	return
 }
 
-// GetAPIVersion returns the value of APIVersion if it is set or its
+// GetThriftName returns the value of ThriftName if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetAPIVersion() (o int32) {
+func (v *Function) GetThriftName() (o string) {
 	if v != nil {
-		o = v.APIVersion
+		o = v.ThriftName
 	}
 	return
 }
 
-// GetFeatures returns the value of Features if it is set or its
+// GetArguments returns the value of Arguments if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetFeatures() (o []Feature) {
+func (v *Function) GetArguments() (o []*Argument) {
 	if v != nil {
-		o = v.Features
+		o = v.Arguments
 	}
 	return
 }
 
-// IsSetFeatures returns true if Features is not nil.
-func (v *HandshakeResponse) IsSetFeatures() bool {
-	return v != nil && v.Features != nil
+// IsSetArguments returns true if Arguments is not nil.
+func (v *Function) IsSetArguments() bool {
+	return v != nil && v.Arguments != nil
 }
 
-// GetLibraryVersion returns the value of LibraryVersion if it is set or its
+// GetReturnType returns the value of ReturnType if it is set or its
 // zero value if it is unset.
-func (v *HandshakeResponse) GetLibraryVersion() (o string) {
-	if v != nil && v.LibraryVersion != nil {
-		return *v.LibraryVersion
+func (v *Function) GetReturnType() (o *Type) {
+	if v != nil && v.ReturnType != nil {
+		return v.ReturnType
 	}
 
 	return
 }
 
-// IsSetLibraryVersion returns true if LibraryVersion is not nil.
-func (v *HandshakeResponse) IsSetLibraryVersion() bool {
-	return v != nil && v.LibraryVersion != nil
+// IsSetReturnType returns true if ReturnType is not nil.
+func (v *Function) IsSetReturnType() bool {
+	return v != nil && v.ReturnType != nil
 }
 
-// Module is a module generated from a single Thrift file. Each module
-// corresponds to exactly one Thrift file and contains all the types and
-// constants defined in that Thrift file.
-type Module struct {
-	// Import path for the package defining the types for this module.
+// GetExceptions returns the value of Exceptions if it is set or its
+// zero value if it is unset.
+func (v *Function) GetExceptions() (o []*Argument) {
+	if v != nil && v.Exceptions != nil {
+		return v.Exceptions
+	}
+
+	return
+}
+
+// IsSetExceptions returns true if Exceptions is not nil.
+func (v *Function) IsSetExceptions() bool {
+	return v != nil && v.Exceptions != nil
+}
+
+// GetOneWay returns the value of OneWay if it is set or its
+// zero value if it is unset.
+func (v *Function) GetOneWay() (o bool) {
+	if v != nil && v.OneWay != nil {
+		return *v.OneWay
+	}
+
+	return
+}
+
+// IsSetOneWay returns true if OneWay is not nil.
+func (v *Function) IsSetOneWay() bool {
+	return v != nil && v.OneWay != nil
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *Function) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Function) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// GetStreaming returns the value of Streaming if it is set or its
+// zero value if it is unset.
+func (v *Function) GetStreaming() (o bool) {
+	if v != nil && v.Streaming != nil {
+		return *v.Streaming
+	}
+
+	return
+}
+
+// IsSetStreaming returns true if Streaming is not nil.
+func (v *Function) IsSetStreaming() bool {
+	return v != nil && v.Streaming != nil
+}
+
+// GetDoc returns the value of Doc if it is set or its
+// zero value if it is unset.
+func (v *Function) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
+	}
+
+	return
+}
+
+// IsSetDoc returns true if Doc is not nil.
+func (v *Function) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
+}
+
+// FunctionReference is a reference to a top-level Go function.
+type FunctionReference struct {
+	Name string `json:"name,required"`
+	// Import path for the package defining this function.
 	ImportPath string `json:"importPath,required"`
-	// Path to the directory containing the code for this module.
-	//
-	// The path is relative to the output directory into which ThriftRW is
-	// generating code. Plugins SHOULD NOT make any assumptions about the
-	// absolute location of the directory.
-	Directory string `json:"directory,required"`
-	// Path to the Thrift file from which this module was generated.
-	ThriftFilePath string `json:"thriftFilePath,required"`
 }
 
-// ToWire translates a Module struct into a Thrift-level intermediate
+// ToWire translates a FunctionReference struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2320,43 +3870,36 @@ type Module struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Module) ToWire() (wire.Value, error) {
+func (v *FunctionReference) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.ImportPath), error(nil)
+	w, err = wire.NewValueString(v.Name), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.Directory), error(nil)
+	w, err = wire.NewValueString(v.ImportPath), error(nil)
 	if err != nil {
 		return w, err
 	}
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
-
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Module struct from its Thrift-level
+// FromWire deserializes a FunctionReference struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Module struct
+// An error is returned if we were unable to build a FunctionReference struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2364,220 +3907,366 @@ func (v *Module) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Module
+//   var v FunctionReference
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Module) FromWire(w wire.Value) error {
+func (v *FunctionReference) FromWire(w wire.Value) error {
 	var err error
 
+	nameIsSet := false
 	importPathIsSet := false
-	directoryIsSet := false
-	thriftFilePathIsSet := false
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.ImportPath, err = field.Value.GetString(), error(nil)
+				v.Name, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				importPathIsSet = true
+				nameIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.Directory, err = field.Value.GetString(), error(nil)
+				v.ImportPath, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				directoryIsSet = true
+				importPathIsSet = true
 			}
-		case 3:
-			if field.Value.Type() == wire.TBinary {
-				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				thriftFilePathIsSet = true
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("FunctionReference", "Name")
+	}
+
+	if !importPathIsSet {
+		missing.Add("FunctionReference", "ImportPath")
+	}
+
+	return missing.Err()
+}
+
+func (v *FunctionReference) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	importPathIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ImportPath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			importPathIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
 			}
 		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of FunctionReference is required")
 	}
 
 	if !importPathIsSet {
-		return errors.New("field ImportPath of Module is required")
+		return errors.New("field ImportPath of FunctionReference is required")
 	}
 
-	if !directoryIsSet {
-		return errors.New("field Directory of Module is required")
+	return nil
+}
+
+// MarshalJSON serializes a FunctionReference struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *FunctionReference) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
 	}
 
-	if !thriftFilePathIsSet {
-		return errors.New("field ThriftFilePath of Module is required")
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ImportPath)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"importPath":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a FunctionReference struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *FunctionReference) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["importPath"]; ok {
+		if err := json.Unmarshal(r, &v.ImportPath); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a Module
+// String returns a readable string representation of a FunctionReference
 // struct.
-func (v *Module) String() string {
+func (v *FunctionReference) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
-	i++
-	fields[i] = fmt.Sprintf("Directory: %v", v.Directory)
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
-	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
+	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
 	i++
 
-	return fmt.Sprintf("Module{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("FunctionReference{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Module match the
-// provided Module.
+// Equals returns true if all the fields of this FunctionReference match the
+// provided FunctionReference.
 //
 // This function performs a deep comparison.
-func (v *Module) Equals(rhs *Module) bool {
+func (v *FunctionReference) Equals(rhs *FunctionReference) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.ImportPath == rhs.ImportPath) {
-		return false
-	}
-	if !(v.Directory == rhs.Directory) {
+	if !(v.Name == rhs.Name) {
 		return false
 	}
-	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+	if !(v.ImportPath == rhs.ImportPath) {
 		return false
 	}
 
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Module.
-func (v *Module) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// Clone returns a deep copy of this FunctionReference. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *FunctionReference) Clone() *FunctionReference {
 	if v == nil {
 		return nil
 	}
+
+	var c FunctionReference
+	c.Name = v.Name
+	c.ImportPath = v.ImportPath
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of FunctionReference.
+func (v *FunctionReference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
 	enc.AddString("importPath", v.ImportPath)
-	enc.AddString("directory", v.Directory)
-	enc.AddString("thriftFilePath", v.ThriftFilePath)
 	return err
 }
 
-// GetImportPath returns the value of ImportPath if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *Module) GetImportPath() (o string) {
+func (v *FunctionReference) GetName() (o string) {
 	if v != nil {
-		o = v.ImportPath
+		o = v.Name
 	}
 	return
 }
 
-// GetDirectory returns the value of Directory if it is set or its
+// GetImportPath returns the value of ImportPath if it is set or its
 // zero value if it is unset.
-func (v *Module) GetDirectory() (o string) {
+func (v *FunctionReference) GetImportPath() (o string) {
 	if v != nil {
-		o = v.Directory
+		o = v.ImportPath
 	}
 	return
 }
 
-// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
-// zero value if it is unset.
-func (v *Module) GetThriftFilePath() (o string) {
-	if v != nil {
-		o = v.ThriftFilePath
-	}
-	return
+// GenerateServiceRequest is a request to generate code for zero or more
+// Thrift services.
+type GenerateServiceRequest struct {
+	// IDs of services for which code should be generated.
+	//
+	// Note that the services map contains information about both, the
+	// services being generated and their transitive dependencies. Code should
+	// only be generated for service IDs listed here.
+	RootServices []ServiceID `json:"rootServices,required"`
+	// Map of service ID to service.
+	//
+	// Any service IDs present in this request will have a corresponding
+	// service definition in this map, including services for which code does
+	// not need to be generated.
+	Services map[ServiceID]*Service `json:"services,required"`
+	// Map of module ID to module.
+	//
+	// Any module IDs present in the request will have a corresponding module
+	// definition in this map.
+	Modules map[ModuleID]*Module `json:"modules,required"`
+	// Prefix for import paths of generated module. In general, plugins should
+	// not need to use the package prefix unless instantiating a new
+	// Generator for more custom plugin generation.
+	PackagePrefix string `json:"packagePrefix,required"`
+	// Directory whose descendants contain all Thrift files. In general,
+	// plugins should not need to use the thrift root unless instantiating a
+	// new Generator for more custom plugin generation.
+	ThriftRoot string `json:"thriftRoot,required"`
 }
 
-// ModuleID is an arbitrary unique identifier to reference the different
-// modules in this request.
-type ModuleID int32
+type _List_ServiceID_ValueList []ServiceID
 
-// ModuleIDPtr returns a pointer to a ModuleID
-func (v ModuleID) Ptr() *ModuleID {
-	return &v
+func (v _List_ServiceID_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// ToWire translates ModuleID into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-func (v ModuleID) ToWire() (wire.Value, error) {
-	x := (int32)(v)
-	return wire.NewValueI32(x), error(nil)
+func (v _List_ServiceID_ValueList) Size() int {
+	return len(v)
 }
 
-// String returns a readable string representation of ModuleID.
-func (v ModuleID) String() string {
-	x := (int32)(v)
-	return fmt.Sprint(x)
+func (_List_ServiceID_ValueList) ValueType() wire.Type {
+	return wire.TI32
 }
 
-// FromWire deserializes ModuleID from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-func (v *ModuleID) FromWire(w wire.Value) error {
-	x, err := w.GetI32(), error(nil)
-	*v = (ModuleID)(x)
-	return err
+func (_List_ServiceID_ValueList) Close() {}
+
+type _Map_ServiceID_Service_MapItemList map[ServiceID]*Service
+
+func (m _Map_ServiceID_Service_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Equals returns true if this ModuleID is equal to the provided
-// ModuleID.
-func (lhs ModuleID) Equals(rhs ModuleID) bool {
-	return ((int32)(lhs) == (int32)(rhs))
+func (m _Map_ServiceID_Service_MapItemList) Size() int {
+	return len(m)
 }
 
-// Service is a service defined by the user in the Thrift file.
-type Service struct {
-	// Name of the Thrift service in Go code.
-	Name string `json:"name,required"`
-	// Name of the service as defined in the Thrift file.
-	ThriftName string `json:"thriftName,required"`
-	// ID of the parent service.
-	ParentID *ServiceID `json:"parentID,omitempty"`
-	// List of functions defined for this service.
-	Functions []*Function `json:"functions,required"`
-	// ID of the module where this service was declared.
-	ModuleID ModuleID `json:"moduleID,required"`
-	// Annotations defined on this service.
-	//
-	// Given,
-	//
-	//   service KeyValue {
-	//   } (private = "true")
-	//
-	// The annotations will be,
-	//
-	//  {
-	//    "private": "true",
-	//  }
-	Annotations map[string]string `json:"annotations,omitempty"`
+func (_Map_ServiceID_Service_MapItemList) KeyType() wire.Type {
+	return wire.TI32
 }
 
-type _List_Function_ValueList []*Function
+func (_Map_ServiceID_Service_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
 
-func (v _List_Function_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
+func (_Map_ServiceID_Service_MapItemList) Close() {}
+
+type _Map_ModuleID_Module_MapItemList map[ModuleID]*Module
+
+func (m _Map_ModuleID_Module_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
 		}
-		w, err := x.ToWire()
+		kw, err := k.ToWire()
 		if err != nil {
 			return err
 		}
-		err = f(w)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
 		if err != nil {
 			return err
 		}
@@ -2585,17 +4274,21 @@ func (v _List_Function_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_Function_ValueList) Size() int {
-	return len(v)
+func (m _Map_ModuleID_Module_MapItemList) Size() int {
+	return len(m)
 }
 
-func (_List_Function_ValueList) ValueType() wire.Type {
+func (_Map_ModuleID_Module_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_ModuleID_Module_MapItemList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_Function_ValueList) Close() {}
+func (_Map_ModuleID_Module_MapItemList) Close() {}
 
-// ToWire translates a Service struct into a Thrift-level intermediate
+// ToWire translates a GenerateServiceRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2610,77 +4303,73 @@ func (_List_Function_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Service) ToWire() (wire.Value, error) {
+func (v *GenerateServiceRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
+	if v.RootServices == nil {
+		return w, errors.New("field RootServices of GenerateServiceRequest is required")
+	}
+	w, err = wire.NewValueList(_List_ServiceID_ValueList(v.RootServices)), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 7, Value: w}
+	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
-
-	w, err = wire.NewValueString(v.ThriftName), error(nil)
+	if v.Services == nil {
+		return w, errors.New("field Services of GenerateServiceRequest is required")
+	}
+	w, err = wire.NewValueMap(_Map_ServiceID_Service_MapItemList(v.Services)), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
+	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
-	if v.ParentID != nil {
-		w, err = v.ParentID.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.Functions == nil {
-		return w, errors.New("field Functions of Service is required")
+	if v.Modules == nil {
+		return w, errors.New("field Modules of GenerateServiceRequest is required")
 	}
-	w, err = wire.NewValueList(_List_Function_ValueList(v.Functions)), error(nil)
+	w, err = wire.NewValueMap(_Map_ModuleID_Module_MapItemList(v.Modules)), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 5, Value: w}
+	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
 
-	w, err = v.ModuleID.ToWire()
+	w, err = wire.NewValueString(v.PackagePrefix), error(nil)
 	if err != nil {
 		return w, err
 	}
-	fields[i] = wire.Field{ID: 6, Value: w}
+	fields[i] = wire.Field{ID: 4, Value: w}
 	i++
-	if v.Annotations != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 8, Value: w}
-		i++
+
+	w, err = wire.NewValueString(v.ThriftRoot), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Function_Read(w wire.Value) (*Function, error) {
-	var v Function
-	err := v.FromWire(w)
-	return &v, err
+func _ServiceID_Read(w wire.Value) (ServiceID, error) {
+	var x ServiceID
+	err := x.FromWire(w)
+	return x, err
 }
 
-func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
-	if l.ValueType() != wire.TStruct {
+func _List_ServiceID_Read(l wire.ValueList) ([]ServiceID, error) {
+	if l.ValueType() != wire.TI32 {
 		return nil, nil
 	}
 
-	o := make([]*Function, 0, l.Size())
+	o := make([]ServiceID, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Function_Read(x)
+		i, err := _ServiceID_Read(x)
 		if err != nil {
 			return err
 		}
@@ -2691,11 +4380,93 @@ func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
 	return o, err
 }
 
-// FromWire deserializes a Service struct from its Thrift-level
+func _Service_Read(w wire.Value) (*Service, error) {
+	var v Service
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_ServiceID_Service_Read(m wire.MapItemList) (map[ServiceID]*Service, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[ServiceID]*Service, m.Size())
+	var missing required.Errors
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _ServiceID_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Service_Read(x.Value)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+func _ModuleID_Read(w wire.Value) (ModuleID, error) {
+	var x ModuleID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Module_Read(w wire.Value) (*Module, error) {
+	var v Module
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_ModuleID_Module_Read(m wire.MapItemList) (map[ModuleID]*Module, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[ModuleID]*Module, m.Size())
+	var missing required.Errors
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _ModuleID_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Module_Read(x.Value)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a GenerateServiceRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Service struct
+// An error is returned if we were unable to build a GenerateServiceRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2703,402 +4474,5941 @@ func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
 //     return nil, err
 //   }
 //
-//   var v Service
+//   var v GenerateServiceRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Service) FromWire(w wire.Value) error {
+func (v *GenerateServiceRequest) FromWire(w wire.Value) error {
 	var err error
 
-	nameIsSet := false
-	thriftNameIsSet := false
+	rootServicesIsSet := false
+	servicesIsSet := false
+	modulesIsSet := false
+	packagePrefixIsSet := false
+	thriftRootIsSet := false
 
-	functionsIsSet := false
-	moduleIDIsSet := false
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 7:
-			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				nameIsSet = true
-			}
 		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.ThriftName, err = field.Value.GetString(), error(nil)
+			if field.Value.Type() == wire.TList {
+				v.RootServices, err = _List_ServiceID_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
-				thriftNameIsSet = true
+				rootServicesIsSet = true
 			}
-		case 4:
-			if field.Value.Type() == wire.TI32 {
-				var x ServiceID
-				x, err = _ServiceID_Read(field.Value)
-				v.ParentID = &x
-				if err != nil {
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.Services, err = _Map_ServiceID_Service_Read(field.Value.GetMap())
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
-
+				servicesIsSet = true
 			}
-		case 5:
-			if field.Value.Type() == wire.TList {
-				v.Functions, err = _List_Function_Read(field.Value.GetList())
-				if err != nil {
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Modules, err = _Map_ModuleID_Module_Read(field.Value.GetMap())
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
-				functionsIsSet = true
+				modulesIsSet = true
 			}
-		case 6:
-			if field.Value.Type() == wire.TI32 {
-				v.ModuleID, err = _ModuleID_Read(field.Value)
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.PackagePrefix, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-				moduleIDIsSet = true
+				packagePrefixIsSet = true
 			}
-		case 8:
-			if field.Value.Type() == wire.TMap {
-				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftRoot, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				thriftRootIsSet = true
 			}
 		}
 	}
 
-	if !nameIsSet {
-		return errors.New("field Name of Service is required")
-	}
-
-	if !thriftNameIsSet {
-		return errors.New("field ThriftName of Service is required")
+	if !rootServicesIsSet {
+		missing.Add("GenerateServiceRequest", "RootServices")
 	}
 
-	if !functionsIsSet {
-		return errors.New("field Functions of Service is required")
+	if !servicesIsSet {
+		missing.Add("GenerateServiceRequest", "Services")
 	}
 
-	if !moduleIDIsSet {
-		return errors.New("field ModuleID of Service is required")
+	if !modulesIsSet {
+		missing.Add("GenerateServiceRequest", "Modules")
 	}
 
-	return nil
-}
-
-// String returns a readable string representation of a Service
-// struct.
-func (v *Service) String() string {
-	if v == nil {
-		return "<nil>"
+	if !packagePrefixIsSet {
+		missing.Add("GenerateServiceRequest", "PackagePrefix")
 	}
 
-	var fields [6]string
-	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
-	i++
-	if v.ParentID != nil {
-		fields[i] = fmt.Sprintf("ParentID: %v", *(v.ParentID))
-		i++
-	}
-	fields[i] = fmt.Sprintf("Functions: %v", v.Functions)
-	i++
-	fields[i] = fmt.Sprintf("ModuleID: %v", v.ModuleID)
-	i++
-	if v.Annotations != nil {
-		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
-		i++
+	if !thriftRootIsSet {
+		missing.Add("GenerateServiceRequest", "ThriftRoot")
 	}
 
-	return fmt.Sprintf("Service{%v}", strings.Join(fields[:i], ", "))
+	return missing.Err()
 }
 
-func _ServiceID_EqualsPtr(lhs, rhs *ServiceID) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+func _ServiceID_Decode(sr stream.Reader) (ServiceID, error) {
+	var x ServiceID
+	err := x.Decode(sr)
+	return x, err
 }
 
-func _List_Function_Equals(lhs, rhs []*Function) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_ServiceID_Decode(sr stream.Reader) ([]ServiceID, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadListEnd()
 	}
 
-	return true
-}
+	n := lh.Length
+	if n > 1024 {
 
-// Equals returns true if all the fields of this Service match the
-// provided Service.
-//
-// This function performs a deep comparison.
-func (v *Service) Equals(rhs *Service) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !(v.Name == rhs.Name) {
-		return false
-	}
-	if !(v.ThriftName == rhs.ThriftName) {
-		return false
-	}
-	if !_ServiceID_EqualsPtr(v.ParentID, rhs.ParentID) {
-		return false
-	}
-	if !_List_Function_Equals(v.Functions, rhs.Functions) {
-		return false
+		n = 1024
 	}
-	if !(v.ModuleID == rhs.ModuleID) {
-		return false
-	}
-	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
-		return false
+
+	o := make([]ServiceID, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ServiceID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
 	}
 
-	return true
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-type _List_Function_Zapper []*Function
+func _Service_Decode(sr stream.Reader) (*Service, error) {
+	var v Service
+	err := v.Decode(sr)
+	return &v, err
+}
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_Function_Zapper.
-func (l _List_Function_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
+func _Map_ServiceID_Service_Decode(sr stream.Reader) (map[ServiceID]*Service, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
-	return err
-}
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Service.
-func (v *Service) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	enc.AddString("name", v.Name)
-	enc.AddString("thriftName", v.ThriftName)
-	if v.ParentID != nil {
-		enc.AddInt32("parentID", (int32)(*v.ParentID))
-	}
-	err = multierr.Append(err, enc.AddArray("functions", (_List_Function_Zapper)(v.Functions)))
-	enc.AddInt32("moduleID", (int32)(v.ModuleID))
-	if v.Annotations != nil {
-		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
 	}
-	return err
-}
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *Service) GetName() (o string) {
-	if v != nil {
-		o = v.Name
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
 	}
-	return
-}
 
-// GetThriftName returns the value of ThriftName if it is set or its
-// zero value if it is unset.
-func (v *Service) GetThriftName() (o string) {
-	if v != nil {
-		o = v.ThriftName
+	o := make(map[ServiceID]*Service, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _ServiceID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _Service_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
 	}
-	return
-}
 
-// GetParentID returns the value of ParentID if it is set or its
-// zero value if it is unset.
-func (v *Service) GetParentID() (o ServiceID) {
-	if v != nil && v.ParentID != nil {
-		return *v.ParentID
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
 	}
+	return o, err
+}
 
-	return
+func _ModuleID_Decode(sr stream.Reader) (ModuleID, error) {
+	var x ModuleID
+	err := x.Decode(sr)
+	return x, err
 }
 
-// IsSetParentID returns true if ParentID is not nil.
-func (v *Service) IsSetParentID() bool {
-	return v != nil && v.ParentID != nil
+func _Module_Decode(sr stream.Reader) (*Module, error) {
+	var v Module
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// GetFunctions returns the value of Functions if it is set or its
-// zero value if it is unset.
-func (v *Service) GetFunctions() (o []*Function) {
-	if v != nil {
-		o = v.Functions
+func _Map_ModuleID_Module_Decode(sr stream.Reader) (map[ModuleID]*Module, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
-	return
-}
 
-// IsSetFunctions returns true if Functions is not nil.
-func (v *Service) IsSetFunctions() bool {
-	return v != nil && v.Functions != nil
-}
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
 
-// GetModuleID returns the value of ModuleID if it is set or its
-// zero value if it is unset.
-func (v *Service) GetModuleID() (o ModuleID) {
-	if v != nil {
-		o = v.ModuleID
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
 	}
-	return
-}
 
-// GetAnnotations returns the value of Annotations if it is set or its
-// zero value if it is unset.
-func (v *Service) GetAnnotations() (o map[string]string) {
-	if v != nil && v.Annotations != nil {
-		return v.Annotations
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
 	}
 
-	return
-}
+	o := make(map[ModuleID]*Module, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := _ModuleID_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
 
-// IsSetAnnotations returns true if Annotations is not nil.
-func (v *Service) IsSetAnnotations() bool {
-	return v != nil && v.Annotations != nil
-}
+		v, err := _Module_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
 
-// ServiceID is an arbitrary unique identifier to reference the different
-// services in this request.
-type ServiceID int32
+		o[k] = v
+	}
 
-// ServiceIDPtr returns a pointer to a ServiceID
-func (v ServiceID) Ptr() *ServiceID {
-	return &v
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// ToWire translates ServiceID into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-func (v ServiceID) ToWire() (wire.Value, error) {
-	x := (int32)(v)
-	return wire.NewValueI32(x), error(nil)
-}
+func (v *GenerateServiceRequest) Decode(sr stream.Reader) error {
+	rootServicesIsSet := false
+	servicesIsSet := false
+	modulesIsSet := false
+	packagePrefixIsSet := false
+	thriftRootIsSet := false
 
-// String returns a readable string representation of ServiceID.
-func (v ServiceID) String() string {
-	x := (int32)(v)
-	return fmt.Sprint(x)
-}
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
 
-// FromWire deserializes ServiceID from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-func (v *ServiceID) FromWire(w wire.Value) error {
-	x, err := w.GetI32(), error(nil)
-	*v = (ServiceID)(x)
-	return err
-}
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
 
-// Equals returns true if this ServiceID is equal to the provided
-// ServiceID.
-func (lhs ServiceID) Equals(rhs ServiceID) bool {
-	return ((int32)(lhs) == (int32)(rhs))
-}
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.RootServices, err = _List_ServiceID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			rootServicesIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TMap:
+			v.Services, err = _Map_ServiceID_Service_Decode(sr)
+			if err != nil {
+				return err
+			}
+			servicesIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Modules, err = _Map_ModuleID_Module_Decode(sr)
+			if err != nil {
+				return err
+			}
+			modulesIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			v.PackagePrefix, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			packagePrefixIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			v.ThriftRoot, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftRootIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// SimpleType is a standalone native Go type.
-type SimpleType int32
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-const (
-	SimpleTypeBool        SimpleType = 1
-	SimpleTypeByte        SimpleType = 2
-	SimpleTypeInt8        SimpleType = 3
-	SimpleTypeInt16       SimpleType = 4
-	SimpleTypeInt32       SimpleType = 5
-	SimpleTypeInt64       SimpleType = 6
-	SimpleTypeFloat64     SimpleType = 7
-	SimpleTypeString      SimpleType = 8
-	SimpleTypeStructEmpty SimpleType = 9
-)
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
 
-// SimpleType_Values returns all recognized values of SimpleType.
-func SimpleType_Values() []SimpleType {
-	return []SimpleType{
-		SimpleTypeBool,
-		SimpleTypeByte,
-		SimpleTypeInt8,
-		SimpleTypeInt16,
-		SimpleTypeInt32,
-		SimpleTypeInt64,
-		SimpleTypeFloat64,
-		SimpleTypeString,
-		SimpleTypeStructEmpty,
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !rootServicesIsSet {
+		return errors.New("field RootServices of GenerateServiceRequest is required")
+	}
+
+	if !servicesIsSet {
+		return errors.New("field Services of GenerateServiceRequest is required")
+	}
+
+	if !modulesIsSet {
+		return errors.New("field Modules of GenerateServiceRequest is required")
 	}
+
+	if !packagePrefixIsSet {
+		return errors.New("field PackagePrefix of GenerateServiceRequest is required")
+	}
+
+	if !thriftRootIsSet {
+		return errors.New("field ThriftRoot of GenerateServiceRequest is required")
+	}
+
+	return nil
 }
 
-// UnmarshalText tries to decode SimpleType from a byte slice
-// containing its name.
+// MarshalJSON serializes a GenerateServiceRequest struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
 //
-//   var v SimpleType
-//   err := v.UnmarshalText([]byte("BOOL"))
-func (v *SimpleType) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "BOOL":
-		*v = SimpleTypeBool
-		return nil
-	case "BYTE":
-		*v = SimpleTypeByte
-		return nil
-	case "INT8":
-		*v = SimpleTypeInt8
-		return nil
-	case "INT16":
-		*v = SimpleTypeInt16
-		return nil
-	case "INT32":
-		*v = SimpleTypeInt32
-		return nil
-	case "INT64":
-		*v = SimpleTypeInt64
-		return nil
-	case "FLOAT64":
-		*v = SimpleTypeFloat64
-		return nil
-	case "STRING":
-		*v = SimpleTypeString
-		return nil
-	case "STRUCT_EMPTY":
-		*v = SimpleTypeStructEmpty
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
+// This implements json.Marshaler.
+func (v *GenerateServiceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.RootServices)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "SimpleType", err)
+			return nil, err
 		}
-		*v = SimpleType(val)
-		return nil
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"rootServices":`)
+		buff.Write(b)
 	}
-}
-
-// MarshalText encodes SimpleType to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v SimpleType) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 1:
+	{
+		b, err := json.Marshal(v.Services)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"services":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Modules)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"modules":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.PackagePrefix)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"packagePrefix":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ThriftRoot)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftRoot":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a GenerateServiceRequest struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *GenerateServiceRequest) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["rootServices"]; ok {
+		if err := json.Unmarshal(r, &v.RootServices); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["services"]; ok {
+		if err := json.Unmarshal(r, &v.Services); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["modules"]; ok {
+		if err := json.Unmarshal(r, &v.Modules); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["packagePrefix"]; ok {
+		if err := json.Unmarshal(r, &v.PackagePrefix); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["thriftRoot"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftRoot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GenerateServiceRequest
+// struct.
+func (v *GenerateServiceRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("RootServices: %v", v.RootServices)
+	i++
+	fields[i] = fmt.Sprintf("Services: %v", v.Services)
+	i++
+	fields[i] = fmt.Sprintf("Modules: %v", v.Modules)
+	i++
+	fields[i] = fmt.Sprintf("PackagePrefix: %v", v.PackagePrefix)
+	i++
+	fields[i] = fmt.Sprintf("ThriftRoot: %v", v.ThriftRoot)
+	i++
+
+	return fmt.Sprintf("GenerateServiceRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ServiceID_Equals(lhs, rhs []ServiceID) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_ServiceID_Service_Equals(lhs, rhs map[ServiceID]*Service) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_ModuleID_Module_Equals(lhs, rhs map[ModuleID]*Module) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GenerateServiceRequest match the
+// provided GenerateServiceRequest.
+//
+// This function performs a deep comparison.
+func (v *GenerateServiceRequest) Equals(rhs *GenerateServiceRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_List_ServiceID_Equals(v.RootServices, rhs.RootServices) {
+		return false
+	}
+	if !_Map_ServiceID_Service_Equals(v.Services, rhs.Services) {
+		return false
+	}
+	if !_Map_ModuleID_Module_Equals(v.Modules, rhs.Modules) {
+		return false
+	}
+	if !(v.PackagePrefix == rhs.PackagePrefix) {
+		return false
+	}
+	if !(v.ThriftRoot == rhs.ThriftRoot) {
+		return false
+	}
+
+	return true
+}
+
+func _List_ServiceID_Clone(v []ServiceID) []ServiceID {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]ServiceID, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_ServiceID_Service_Clone(v map[ServiceID]*Service) map[ServiceID]*Service {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[ServiceID]*Service, len(v))
+
+	for k, x := range v {
+		o[k] = x.Clone()
+	}
+	return o
+}
+
+func _Map_ModuleID_Module_Clone(v map[ModuleID]*Module) map[ModuleID]*Module {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[ModuleID]*Module, len(v))
+
+	for k, x := range v {
+		o[k] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this GenerateServiceRequest. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *GenerateServiceRequest) Clone() *GenerateServiceRequest {
+	if v == nil {
+		return nil
+	}
+
+	var c GenerateServiceRequest
+	c.RootServices = _List_ServiceID_Clone(v.RootServices)
+	c.Services = _Map_ServiceID_Service_Clone(v.Services)
+	c.Modules = _Map_ModuleID_Module_Clone(v.Modules)
+	c.PackagePrefix = v.PackagePrefix
+	c.ThriftRoot = v.ThriftRoot
+
+	return &c
+}
+
+type _List_ServiceID_Zapper []ServiceID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ServiceID_Zapper.
+func (l _List_ServiceID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32((int32)(v))
+	}
+	return err
+}
+
+type _Map_ServiceID_Service_Item_Zapper struct {
+	Key   ServiceID
+	Value *Service
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ServiceID_Service_Item_Zapper.
+func (v _Map_ServiceID_Service_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", (int32)(v.Key))
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
+
+type _Map_ServiceID_Service_Zapper map[ServiceID]*Service
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ServiceID_Service_Zapper.
+func (m _Map_ServiceID_Service_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_ServiceID_Service_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_ModuleID_Module_Item_Zapper struct {
+	Key   ModuleID
+	Value *Module
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ModuleID_Module_Item_Zapper.
+func (v _Map_ModuleID_Module_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", (int32)(v.Key))
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
+
+type _Map_ModuleID_Module_Zapper map[ModuleID]*Module
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_ModuleID_Module_Zapper.
+func (m _Map_ModuleID_Module_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_ModuleID_Module_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GenerateServiceRequest.
+func (v *GenerateServiceRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddArray("rootServices", (_List_ServiceID_Zapper)(v.RootServices)))
+	err = multierr.Append(err, enc.AddArray("services", (_Map_ServiceID_Service_Zapper)(v.Services)))
+	err = multierr.Append(err, enc.AddArray("modules", (_Map_ModuleID_Module_Zapper)(v.Modules)))
+	enc.AddString("packagePrefix", v.PackagePrefix)
+	enc.AddString("thriftRoot", v.ThriftRoot)
+	return err
+}
+
+// GetRootServices returns the value of RootServices if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetRootServices() (o []ServiceID) {
+	if v != nil {
+		o = v.RootServices
+	}
+	return
+}
+
+// IsSetRootServices returns true if RootServices is not nil.
+func (v *GenerateServiceRequest) IsSetRootServices() bool {
+	return v != nil && v.RootServices != nil
+}
+
+// GetServices returns the value of Services if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetServices() (o map[ServiceID]*Service) {
+	if v != nil {
+		o = v.Services
+	}
+	return
+}
+
+// IsSetServices returns true if Services is not nil.
+func (v *GenerateServiceRequest) IsSetServices() bool {
+	return v != nil && v.Services != nil
+}
+
+// GetModules returns the value of Modules if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetModules() (o map[ModuleID]*Module) {
+	if v != nil {
+		o = v.Modules
+	}
+	return
+}
+
+// IsSetModules returns true if Modules is not nil.
+func (v *GenerateServiceRequest) IsSetModules() bool {
+	return v != nil && v.Modules != nil
+}
+
+// GetPackagePrefix returns the value of PackagePrefix if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetPackagePrefix() (o string) {
+	if v != nil {
+		o = v.PackagePrefix
+	}
+	return
+}
+
+// GetThriftRoot returns the value of ThriftRoot if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceRequest) GetThriftRoot() (o string) {
+	if v != nil {
+		o = v.ThriftRoot
+	}
+	return
+}
+
+// GenerateServiceResponse is response to a GenerateServiceRequest.
+type GenerateServiceResponse struct {
+	// Map of file path to file contents.
+	//
+	// All paths MUST be relative to the output directory into which ThriftRW
+	// is generating code. Plugins SHOULD NOT make any assumptions about the
+	// absolute location of the directory.
+	//
+	// The paths MUST NOT contain the string ".." or the request will fail.
+	Files map[string][]byte `json:"files,omitempty"`
+}
+
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
+// ToWire translates a GenerateServiceResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GenerateServiceResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Files != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a GenerateServiceResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GenerateServiceResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GenerateServiceResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GenerateServiceResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.Files, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	n := mh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make(map[string][]byte, n)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *GenerateServiceResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.Files, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a GenerateServiceResponse struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *GenerateServiceResponse) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.Files) == 0) {
+		b, err := json.Marshal(v.Files)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"files":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a GenerateServiceResponse struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *GenerateServiceResponse) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["files"]; ok {
+		if err := json.Unmarshal(r, &v.Files); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GenerateServiceResponse
+// struct.
+func (v *GenerateServiceResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Files != nil {
+		fields[i] = fmt.Sprintf("Files: %v", v.Files)
+		i++
+	}
+
+	return fmt.Sprintf("GenerateServiceResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GenerateServiceResponse match the
+// provided GenerateServiceResponse.
+//
+// This function performs a deep comparison.
+func (v *GenerateServiceResponse) Equals(rhs *GenerateServiceResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Files == nil && rhs.Files == nil) || (v.Files != nil && rhs.Files != nil && _Map_String_Binary_Equals(v.Files, rhs.Files))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Clone(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(v)), v...)
+}
+
+func _Map_String_Binary_Clone(v map[string][]byte) map[string][]byte {
+	if v == nil {
+		return nil
+	}
+
+	o := make(map[string][]byte, len(v))
+
+	for k, x := range v {
+		o[k] = _Binary_Clone(x)
+	}
+	return o
+}
+
+// Clone returns a deep copy of this GenerateServiceResponse. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *GenerateServiceResponse) Clone() *GenerateServiceResponse {
+	if v == nil {
+		return nil
+	}
+
+	var c GenerateServiceResponse
+	c.Files = _Map_String_Binary_Clone(v.Files)
+
+	return &c
+}
+
+type _Map_String_Binary_Zapper map[string][]byte
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GenerateServiceResponse.
+func (v *GenerateServiceResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Files != nil {
+		err = multierr.Append(err, enc.AddObject("files", (_Map_String_Binary_Zapper)(v.Files)))
+	}
+	return err
+}
+
+// GetFiles returns the value of Files if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceResponse) GetFiles() (o map[string][]byte) {
+	if v != nil && v.Files != nil {
+		return v.Files
+	}
+
+	return
+}
+
+// IsSetFiles returns true if Files is not nil.
+func (v *GenerateServiceResponse) IsSetFiles() bool {
+	return v != nil && v.Files != nil
+}
+
+// HandshakeRequest is the initial request sent to the plugin as part of
+// establishing communication and feature negotiation.
+type HandshakeRequest struct {
+}
+
+// ToWire translates a HandshakeRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HandshakeRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a HandshakeRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HandshakeRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HandshakeRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HandshakeRequest) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+func (v *HandshakeRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a HandshakeRequest struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *HandshakeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a HandshakeRequest struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *HandshakeRequest) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HandshakeRequest
+// struct.
+func (v *HandshakeRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("HandshakeRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this HandshakeRequest match the
+// provided HandshakeRequest.
+//
+// This function performs a deep comparison.
+func (v *HandshakeRequest) Equals(rhs *HandshakeRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this HandshakeRequest. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *HandshakeRequest) Clone() *HandshakeRequest {
+	if v == nil {
+		return nil
+	}
+
+	var c HandshakeRequest
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HandshakeRequest.
+func (v *HandshakeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// HandshakeResponse is the response from the plugin for a HandshakeRequest.
+type HandshakeResponse struct {
+	// Name of the plugin. This MUST match the name of the plugin specified
+	// over the command line or the program will fail.
+	Name string `json:"name,required"`
+	// Version of the plugin API.
+	//
+	// This MUST be set to API_VERSION by the plugin.
+	APIVersion int32 `json:"apiVersion,required"`
+	// List of features the plugin provides.
+	Features []Feature `json:"features,required"`
+	// Version of ThriftRW with which the plugin was built.
+	//
+	// This MUST be set to go.uber.org/thriftrw/version.Version by the plugin
+	// explicitly.
+	LibraryVersion *string `json:"libraryVersion,omitempty"`
+}
+
+type _List_Feature_ValueList []Feature
+
+func (v _List_Feature_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Feature_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Feature_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_Feature_ValueList) Close() {}
+
+// ToWire translates a HandshakeResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HandshakeResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.APIVersion), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Features == nil {
+		return w, errors.New("field Features of HandshakeResponse is required")
+	}
+	w, err = wire.NewValueList(_List_Feature_ValueList(v.Features)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.LibraryVersion != nil {
+		w, err = wire.NewValueString(*(v.LibraryVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Feature_Read(w wire.Value) (Feature, error) {
+	var v Feature
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_Feature_Read(l wire.ValueList) ([]Feature, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]Feature, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Feature_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a HandshakeResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HandshakeResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HandshakeResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HandshakeResponse) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	apiVersionIsSet := false
+	featuresIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.APIVersion, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				apiVersionIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Features, err = _List_Feature_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				featuresIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LibraryVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("HandshakeResponse", "Name")
+	}
+
+	if !apiVersionIsSet {
+		missing.Add("HandshakeResponse", "APIVersion")
+	}
+
+	if !featuresIsSet {
+		missing.Add("HandshakeResponse", "Features")
+	}
+
+	return missing.Err()
+}
+
+func _Feature_Decode(sr stream.Reader) (Feature, error) {
+	var v Feature
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_Feature_Decode(sr stream.Reader) ([]Feature, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]Feature, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Feature_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *HandshakeResponse) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	apiVersionIsSet := false
+	featuresIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.APIVersion, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			apiVersionIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TList:
+			v.Features, err = _List_Feature_Decode(sr)
+			if err != nil {
+				return err
+			}
+			featuresIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LibraryVersion = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of HandshakeResponse is required")
+	}
+
+	if !apiVersionIsSet {
+		return errors.New("field APIVersion of HandshakeResponse is required")
+	}
+
+	if !featuresIsSet {
+		return errors.New("field Features of HandshakeResponse is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a HandshakeResponse struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *HandshakeResponse) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"apiVersion":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Features)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"features":`)
+		buff.Write(b)
+	}
+	if !(v.LibraryVersion == nil) {
+		b, err := json.Marshal(v.LibraryVersion)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"libraryVersion":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a HandshakeResponse struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *HandshakeResponse) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["apiVersion"]; ok {
+		if err := json.Unmarshal(r, &v.APIVersion); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["features"]; ok {
+		if err := json.Unmarshal(r, &v.Features); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["libraryVersion"]; ok {
+		if err := json.Unmarshal(r, &v.LibraryVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HandshakeResponse
+// struct.
+func (v *HandshakeResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("APIVersion: %v", v.APIVersion)
+	i++
+	fields[i] = fmt.Sprintf("Features: %v", v.Features)
+	i++
+	if v.LibraryVersion != nil {
+		fields[i] = fmt.Sprintf("LibraryVersion: %v", *(v.LibraryVersion))
+		i++
+	}
+
+	return fmt.Sprintf("HandshakeResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Feature_Equals(lhs, rhs []Feature) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this HandshakeResponse match the
+// provided HandshakeResponse.
+//
+// This function performs a deep comparison.
+func (v *HandshakeResponse) Equals(rhs *HandshakeResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.APIVersion == rhs.APIVersion) {
+		return false
+	}
+	if !_List_Feature_Equals(v.Features, rhs.Features) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LibraryVersion, rhs.LibraryVersion) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Feature_Clone(v []Feature) []Feature {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]Feature, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this HandshakeResponse. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *HandshakeResponse) Clone() *HandshakeResponse {
+	if v == nil {
+		return nil
+	}
+
+	var c HandshakeResponse
+	c.Name = v.Name
+	c.APIVersion = v.APIVersion
+	c.Features = _List_Feature_Clone(v.Features)
+	c.LibraryVersion = _String_ClonePtr(v.LibraryVersion)
+
+	return &c
+}
+
+type _List_Feature_Zapper []Feature
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Feature_Zapper.
+func (l _List_Feature_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HandshakeResponse.
+func (v *HandshakeResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddInt32("apiVersion", v.APIVersion)
+	err = multierr.Append(err, enc.AddArray("features", (_List_Feature_Zapper)(v.Features)))
+	if v.LibraryVersion != nil {
+		enc.AddString("libraryVersion", *v.LibraryVersion)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetAPIVersion returns the value of APIVersion if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetAPIVersion() (o int32) {
+	if v != nil {
+		o = v.APIVersion
+	}
+	return
+}
+
+// GetFeatures returns the value of Features if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetFeatures() (o []Feature) {
+	if v != nil {
+		o = v.Features
+	}
+	return
+}
+
+// IsSetFeatures returns true if Features is not nil.
+func (v *HandshakeResponse) IsSetFeatures() bool {
+	return v != nil && v.Features != nil
+}
+
+// GetLibraryVersion returns the value of LibraryVersion if it is set or its
+// zero value if it is unset.
+func (v *HandshakeResponse) GetLibraryVersion() (o string) {
+	if v != nil && v.LibraryVersion != nil {
+		return *v.LibraryVersion
+	}
+
+	return
+}
+
+// IsSetLibraryVersion returns true if LibraryVersion is not nil.
+func (v *HandshakeResponse) IsSetLibraryVersion() bool {
+	return v != nil && v.LibraryVersion != nil
+}
+
+// MapTypeRequest is a request to map a field to a custom Go type.
+type MapTypeRequest struct {
+	// Go type that ThriftRW would use for this field if it were required.
+	//
+	// Values of the custom type are converted to and from this type when
+	// they are serialized.
+	Type *Type `json:"type,required"`
+	// Annotations defined on the field.
+	//
+	// Given,
+	//
+	//   struct User {
+	//     1: required string id (go.type = "uuid.UUID")
+	//   }
+	//
+	// The annotations will be,
+	//
+	//   {
+	//     "go.type": "uuid.UUID",
+	//   }
+	Annotations map[string]string `json:"annotations,required"`
+	// Name of the field as defined in the Thrift file.
+	FieldName string `json:"fieldName,required"`
+}
+
+// ToWire translates a MapTypeRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MapTypeRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Type == nil {
+		return w, errors.New("field Type of MapTypeRequest is required")
+	}
+	w, err = v.Type.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Annotations == nil {
+		return w, errors.New("field Annotations of MapTypeRequest is required")
+	}
+	w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.FieldName), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MapTypeRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MapTypeRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MapTypeRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MapTypeRequest) FromWire(w wire.Value) error {
+	var err error
+
+	typeIsSet := false
+	annotationsIsSet := false
+	fieldNameIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Type, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				typeIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				annotationsIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.FieldName, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				fieldNameIsSet = true
+			}
+		}
+	}
+
+	if !typeIsSet {
+		missing.Add("MapTypeRequest", "Type")
+	}
+
+	if !annotationsIsSet {
+		missing.Add("MapTypeRequest", "Annotations")
+	}
+
+	if !fieldNameIsSet {
+		missing.Add("MapTypeRequest", "FieldName")
+	}
+
+	return missing.Err()
+}
+
+func (v *MapTypeRequest) Decode(sr stream.Reader) error {
+	typeIsSet := false
+	annotationsIsSet := false
+	fieldNameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Type, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			typeIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			annotationsIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.FieldName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			fieldNameIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !typeIsSet {
+		return errors.New("field Type of MapTypeRequest is required")
+	}
+
+	if !annotationsIsSet {
+		return errors.New("field Annotations of MapTypeRequest is required")
+	}
+
+	if !fieldNameIsSet {
+		return errors.New("field FieldName of MapTypeRequest is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a MapTypeRequest struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *MapTypeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"type":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.FieldName)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"fieldName":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a MapTypeRequest struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *MapTypeRequest) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["type"]; ok {
+		if err := json.Unmarshal(r, &v.Type); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["fieldName"]; ok {
+		if err := json.Unmarshal(r, &v.FieldName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MapTypeRequest
+// struct.
+func (v *MapTypeRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Type: %v", v.Type)
+	i++
+	fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+	i++
+	fields[i] = fmt.Sprintf("FieldName: %v", v.FieldName)
+	i++
+
+	return fmt.Sprintf("MapTypeRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MapTypeRequest match the
+// provided MapTypeRequest.
+//
+// This function performs a deep comparison.
+func (v *MapTypeRequest) Equals(rhs *MapTypeRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Type.Equals(rhs.Type) {
+		return false
+	}
+	if !_Map_String_String_Equals(v.Annotations, rhs.Annotations) {
+		return false
+	}
+	if !(v.FieldName == rhs.FieldName) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this MapTypeRequest. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *MapTypeRequest) Clone() *MapTypeRequest {
+	if v == nil {
+		return nil
+	}
+
+	var c MapTypeRequest
+	c.Type = v.Type.Clone()
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+	c.FieldName = v.FieldName
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MapTypeRequest.
+func (v *MapTypeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("type", v.Type))
+	err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	enc.AddString("fieldName", v.FieldName)
+	return err
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *MapTypeRequest) GetType() (o *Type) {
+	if v != nil {
+		o = v.Type
+	}
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *MapTypeRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *MapTypeRequest) GetAnnotations() (o map[string]string) {
+	if v != nil {
+		o = v.Annotations
+	}
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *MapTypeRequest) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// GetFieldName returns the value of FieldName if it is set or its
+// zero value if it is unset.
+func (v *MapTypeRequest) GetFieldName() (o string) {
+	if v != nil {
+		o = v.FieldName
+	}
+	return
+}
+
+// MapTypeResponse is the response to a MapTypeRequest.
+type MapTypeResponse struct {
+	// Custom type for the field. This MUST be unset if the plugin does not
+	// claim any of the annotations on the field, in which case ThriftRW will
+	// generate the field as usual.
+	Mapping *TypeMapping `json:"mapping,omitempty"`
+}
+
+// ToWire translates a MapTypeResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MapTypeResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Mapping != nil {
+		w, err = v.Mapping.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TypeMapping_Read(w wire.Value) (*TypeMapping, error) {
+	var v TypeMapping
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MapTypeResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MapTypeResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MapTypeResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MapTypeResponse) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Mapping, err = _TypeMapping_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return missing.Err()
+}
+
+func _TypeMapping_Decode(sr stream.Reader) (*TypeMapping, error) {
+	var v TypeMapping
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *MapTypeResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Mapping, err = _TypeMapping_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a MapTypeResponse struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *MapTypeResponse) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Mapping == nil) {
+		b, err := json.Marshal(v.Mapping)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapping":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a MapTypeResponse struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *MapTypeResponse) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["mapping"]; ok {
+		if err := json.Unmarshal(r, &v.Mapping); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MapTypeResponse
+// struct.
+func (v *MapTypeResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Mapping != nil {
+		fields[i] = fmt.Sprintf("Mapping: %v", v.Mapping)
+		i++
+	}
+
+	return fmt.Sprintf("MapTypeResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MapTypeResponse match the
+// provided MapTypeResponse.
+//
+// This function performs a deep comparison.
+func (v *MapTypeResponse) Equals(rhs *MapTypeResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Mapping == nil && rhs.Mapping == nil) || (v.Mapping != nil && rhs.Mapping != nil && v.Mapping.Equals(rhs.Mapping))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this MapTypeResponse. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *MapTypeResponse) Clone() *MapTypeResponse {
+	if v == nil {
+		return nil
+	}
+
+	var c MapTypeResponse
+	c.Mapping = v.Mapping.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MapTypeResponse.
+func (v *MapTypeResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Mapping != nil {
+		err = multierr.Append(err, enc.AddObject("mapping", v.Mapping))
+	}
+	return err
+}
+
+// GetMapping returns the value of Mapping if it is set or its
+// zero value if it is unset.
+func (v *MapTypeResponse) GetMapping() (o *TypeMapping) {
+	if v != nil && v.Mapping != nil {
+		return v.Mapping
+	}
+
+	return
+}
+
+// IsSetMapping returns true if Mapping is not nil.
+func (v *MapTypeResponse) IsSetMapping() bool {
+	return v != nil && v.Mapping != nil
+}
+
+// Member is a field of a struct, union, or exception, an item of an enum,
+// or a function of a service.
+type Member struct {
+	// Name of the member as defined in the Thrift file.
+	Name string `json:"name,required"`
+	// Line of the Thrift file on which the member is defined.
+	Line int32 `json:"line,required"`
+	// Field identifier of a field, or the value of an enum item. This is
+	// unset for functions and for enum items without explicit values.
+	ID *int32 `json:"id,omitempty"`
+	// Whether this field is marked required. This is unset for fields which
+	// are neither required nor optional, and for other members.
+	IsRequired *bool `json:"isRequired,omitempty"`
+	// Type of the field, or the return type of the function, as written in
+	// the Thrift file. For example, "list<string>" or "shared.UUID". This is
+	// unset for enum items and void functions.
+	Type *string `json:"type,omitempty"`
+	// Annotations defined on this member.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Documentation for this member, if any, with the comment markers
+	// removed.
+	Doc *string `json:"doc,omitempty"`
+}
+
+// ToWire translates a Member struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Member) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(v.Line), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.ID != nil {
+		w, err = wire.NewValueI32(*(v.ID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.IsRequired != nil {
+		w, err = wire.NewValueBool(*(v.IsRequired)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Type != nil {
+		w, err = wire.NewValueString(*(v.Type)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Member struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Member struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Member
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Member) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	lineIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Line, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				lineIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsRequired = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Doc = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("Member", "Name")
+	}
+
+	if !lineIsSet {
+		missing.Add("Member", "Line")
+	}
+
+	return missing.Err()
+}
+
+func (v *Member) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	lineIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI32:
+			v.Line, err = sr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			lineIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsRequired = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Type = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Doc = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Member is required")
+	}
+
+	if !lineIsSet {
+		return errors.New("field Line of Member is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Member struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Member) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Line)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"line":`)
+		buff.Write(b)
+	}
+	if !(v.ID == nil) {
+		b, err := json.Marshal(v.ID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"id":`)
+		buff.Write(b)
+	}
+	if !(v.IsRequired == nil) {
+		b, err := json.Marshal(v.IsRequired)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"isRequired":`)
+		buff.Write(b)
+	}
+	if !(v.Type == nil) {
+		b, err := json.Marshal(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"type":`)
+		buff.Write(b)
+	}
+	if !(len(v.Annotations) == 0) {
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+	if !(v.Doc == nil) {
+		b, err := json.Marshal(v.Doc)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doc":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Member struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Member) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["line"]; ok {
+		if err := json.Unmarshal(r, &v.Line); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["id"]; ok {
+		if err := json.Unmarshal(r, &v.ID); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["isRequired"]; ok {
+		if err := json.Unmarshal(r, &v.IsRequired); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["type"]; ok {
+		if err := json.Unmarshal(r, &v.Type); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["doc"]; ok {
+		if err := json.Unmarshal(r, &v.Doc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Member
+// struct.
+func (v *Member) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Line: %v", v.Line)
+	i++
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.IsRequired != nil {
+		fields[i] = fmt.Sprintf("IsRequired: %v", *(v.IsRequired))
+		i++
+	}
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
+		i++
+	}
+
+	return fmt.Sprintf("Member{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Member match the
+// provided Member.
+//
+// This function performs a deep comparison.
+func (v *Member) Equals(rhs *Member) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.Line == rhs.Line) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsRequired, rhs.IsRequired) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_ClonePtr(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Member. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Member) Clone() *Member {
+	if v == nil {
+		return nil
+	}
+
+	var c Member
+	c.Name = v.Name
+	c.Line = v.Line
+	c.ID = _I32_ClonePtr(v.ID)
+	c.IsRequired = _Bool_ClonePtr(v.IsRequired)
+	c.Type = _String_ClonePtr(v.Type)
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+	c.Doc = _String_ClonePtr(v.Doc)
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Member.
+func (v *Member) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddInt32("line", v.Line)
+	if v.ID != nil {
+		enc.AddInt32("id", *v.ID)
+	}
+	if v.IsRequired != nil {
+		enc.AddBool("isRequired", *v.IsRequired)
+	}
+	if v.Type != nil {
+		enc.AddString("type", *v.Type)
+	}
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Member) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetLine returns the value of Line if it is set or its
+// zero value if it is unset.
+func (v *Member) GetLine() (o int32) {
+	if v != nil {
+		o = v.Line
+	}
+	return
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Member) GetID() (o int32) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Member) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// GetIsRequired returns the value of IsRequired if it is set or its
+// zero value if it is unset.
+func (v *Member) GetIsRequired() (o bool) {
+	if v != nil && v.IsRequired != nil {
+		return *v.IsRequired
+	}
+
+	return
+}
+
+// IsSetIsRequired returns true if IsRequired is not nil.
+func (v *Member) IsSetIsRequired() bool {
+	return v != nil && v.IsRequired != nil
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *Member) GetType() (o string) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *Member) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *Member) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Member) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// GetDoc returns the value of Doc if it is set or its
+// zero value if it is unset.
+func (v *Member) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
+	}
+
+	return
+}
+
+// IsSetDoc returns true if Doc is not nil.
+func (v *Member) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
+}
+
+// Module is a module generated from a single Thrift file. Each module
+// corresponds to exactly one Thrift file and contains all the types and
+// constants defined in that Thrift file.
+type Module struct {
+	// Import path for the package defining the types for this module.
+	ImportPath string `json:"importPath,required"`
+	// Path to the directory containing the code for this module.
+	//
+	// The path is relative to the output directory into which ThriftRW is
+	// generating code. Plugins SHOULD NOT make any assumptions about the
+	// absolute location of the directory.
+	Directory string `json:"directory,required"`
+	// Path to the Thrift file from which this module was generated.
+	ThriftFilePath string `json:"thriftFilePath,required"`
+}
+
+// ToWire translates a Module struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Module) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ImportPath), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.Directory), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Module struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Module struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Module
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Module) FromWire(w wire.Value) error {
+	var err error
+
+	importPathIsSet := false
+	directoryIsSet := false
+	thriftFilePathIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ImportPath, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				importPathIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Directory, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				directoryIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftFilePathIsSet = true
+			}
+		}
+	}
+
+	if !importPathIsSet {
+		missing.Add("Module", "ImportPath")
+	}
+
+	if !directoryIsSet {
+		missing.Add("Module", "Directory")
+	}
+
+	if !thriftFilePathIsSet {
+		missing.Add("Module", "ThriftFilePath")
+	}
+
+	return missing.Err()
+}
+
+func (v *Module) Decode(sr stream.Reader) error {
+	importPathIsSet := false
+	directoryIsSet := false
+	thriftFilePathIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ImportPath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			importPathIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Directory, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			directoryIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.ThriftFilePath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftFilePathIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !importPathIsSet {
+		return errors.New("field ImportPath of Module is required")
+	}
+
+	if !directoryIsSet {
+		return errors.New("field Directory of Module is required")
+	}
+
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of Module is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Module struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Module) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.ImportPath)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"importPath":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Directory)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"directory":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ThriftFilePath)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftFilePath":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Module struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Module) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["importPath"]; ok {
+		if err := json.Unmarshal(r, &v.ImportPath); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["directory"]; ok {
+		if err := json.Unmarshal(r, &v.Directory); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["thriftFilePath"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftFilePath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Module
+// struct.
+func (v *Module) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
+	i++
+	fields[i] = fmt.Sprintf("Directory: %v", v.Directory)
+	i++
+	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
+	i++
+
+	return fmt.Sprintf("Module{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Module match the
+// provided Module.
+//
+// This function performs a deep comparison.
+func (v *Module) Equals(rhs *Module) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ImportPath == rhs.ImportPath) {
+		return false
+	}
+	if !(v.Directory == rhs.Directory) {
+		return false
+	}
+	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Module. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Module) Clone() *Module {
+	if v == nil {
+		return nil
+	}
+
+	var c Module
+	c.ImportPath = v.ImportPath
+	c.Directory = v.Directory
+	c.ThriftFilePath = v.ThriftFilePath
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Module.
+func (v *Module) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("importPath", v.ImportPath)
+	enc.AddString("directory", v.Directory)
+	enc.AddString("thriftFilePath", v.ThriftFilePath)
+	return err
+}
+
+// GetImportPath returns the value of ImportPath if it is set or its
+// zero value if it is unset.
+func (v *Module) GetImportPath() (o string) {
+	if v != nil {
+		o = v.ImportPath
+	}
+	return
+}
+
+// GetDirectory returns the value of Directory if it is set or its
+// zero value if it is unset.
+func (v *Module) GetDirectory() (o string) {
+	if v != nil {
+		o = v.Directory
+	}
+	return
+}
+
+// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
+// zero value if it is unset.
+func (v *Module) GetThriftFilePath() (o string) {
+	if v != nil {
+		o = v.ThriftFilePath
+	}
+	return
+}
+
+// ModuleID is an arbitrary unique identifier to reference the different
+// modules in this request.
+type ModuleID int32
+
+// ModuleIDPtr returns a pointer to a ModuleID
+func (v ModuleID) Ptr() *ModuleID {
+	return &v
+}
+
+// ToWire translates ModuleID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v ModuleID) ToWire() (wire.Value, error) {
+	x := (int32)(v)
+	return wire.NewValueI32(x), error(nil)
+}
+
+// String returns a readable string representation of ModuleID.
+func (v ModuleID) String() string {
+	x := (int32)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes ModuleID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *ModuleID) FromWire(w wire.Value) error {
+	x, err := w.GetI32(), error(nil)
+	*v = (ModuleID)(x)
+	return err
+}
+
+// Decode deserializes ModuleID directly off the wire.
+func (v *ModuleID) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt32()
+	*v = (ModuleID)(x)
+	return err
+}
+
+// Equals returns true if this ModuleID is equal to the provided
+// ModuleID.
+func (lhs ModuleID) Equals(rhs ModuleID) bool {
+	return ((int32)(lhs) == (int32)(rhs))
+}
+
+// PostProcessRequest is a request to modify generated files before they are
+// written.
+type PostProcessRequest struct {
+	// Map of file path to file contents for all files about to be written,
+	// including those generated by ServiceGenerators.
+	//
+	// Paths are relative to the output directory into which ThriftRW is
+	// generating code.
+	Files map[string][]byte `json:"files,required"`
+	// Prefix for import paths of generated modules.
+	PackagePrefix string `json:"packagePrefix,required"`
+}
+
+// ToWire translates a PostProcessRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PostProcessRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Files == nil {
+		return w, errors.New("field Files of PostProcessRequest is required")
+	}
+	w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.PackagePrefix), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PostProcessRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PostProcessRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PostProcessRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PostProcessRequest) FromWire(w wire.Value) error {
+	var err error
+
+	filesIsSet := false
+	packagePrefixIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.Files, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				filesIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.PackagePrefix, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				packagePrefixIsSet = true
+			}
+		}
+	}
+
+	if !filesIsSet {
+		missing.Add("PostProcessRequest", "Files")
+	}
+
+	if !packagePrefixIsSet {
+		missing.Add("PostProcessRequest", "PackagePrefix")
+	}
+
+	return missing.Err()
+}
+
+func (v *PostProcessRequest) Decode(sr stream.Reader) error {
+	filesIsSet := false
+	packagePrefixIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.Files, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+			filesIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.PackagePrefix, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			packagePrefixIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !filesIsSet {
+		return errors.New("field Files of PostProcessRequest is required")
+	}
+
+	if !packagePrefixIsSet {
+		return errors.New("field PackagePrefix of PostProcessRequest is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a PostProcessRequest struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PostProcessRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Files)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"files":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.PackagePrefix)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"packagePrefix":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PostProcessRequest struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PostProcessRequest) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["files"]; ok {
+		if err := json.Unmarshal(r, &v.Files); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["packagePrefix"]; ok {
+		if err := json.Unmarshal(r, &v.PackagePrefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PostProcessRequest
+// struct.
+func (v *PostProcessRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Files: %v", v.Files)
+	i++
+	fields[i] = fmt.Sprintf("PackagePrefix: %v", v.PackagePrefix)
+	i++
+
+	return fmt.Sprintf("PostProcessRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PostProcessRequest match the
+// provided PostProcessRequest.
+//
+// This function performs a deep comparison.
+func (v *PostProcessRequest) Equals(rhs *PostProcessRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Map_String_Binary_Equals(v.Files, rhs.Files) {
+		return false
+	}
+	if !(v.PackagePrefix == rhs.PackagePrefix) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this PostProcessRequest. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PostProcessRequest) Clone() *PostProcessRequest {
+	if v == nil {
+		return nil
+	}
+
+	var c PostProcessRequest
+	c.Files = _Map_String_Binary_Clone(v.Files)
+	c.PackagePrefix = v.PackagePrefix
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PostProcessRequest.
+func (v *PostProcessRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("files", (_Map_String_Binary_Zapper)(v.Files)))
+	enc.AddString("packagePrefix", v.PackagePrefix)
+	return err
+}
+
+// GetFiles returns the value of Files if it is set or its
+// zero value if it is unset.
+func (v *PostProcessRequest) GetFiles() (o map[string][]byte) {
+	if v != nil {
+		o = v.Files
+	}
+	return
+}
+
+// IsSetFiles returns true if Files is not nil.
+func (v *PostProcessRequest) IsSetFiles() bool {
+	return v != nil && v.Files != nil
+}
+
+// GetPackagePrefix returns the value of PackagePrefix if it is set or its
+// zero value if it is unset.
+func (v *PostProcessRequest) GetPackagePrefix() (o string) {
+	if v != nil {
+		o = v.PackagePrefix
+	}
+	return
+}
+
+// PostProcessResponse is the response to a PostProcessRequest.
+type PostProcessResponse struct {
+	// Map of file path to file contents for files which should be written
+	// in place of the files in the request, or in addition to them. Files of
+	// the request that are not listed here are written unchanged.
+	//
+	// The paths MUST NOT contain the string ".." or the request will fail.
+	Files map[string][]byte `json:"files,omitempty"`
+	// Paths of files in the request which should not be written at all.
+	RemovedFiles []string `json:"removedFiles,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a PostProcessResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PostProcessResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Files != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.RemovedFiles != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.RemovedFiles)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a PostProcessResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PostProcessResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PostProcessResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PostProcessResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TMap {
+				v.Files, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.RemovedFiles, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]string, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *PostProcessResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TMap:
+			v.Files, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.RemovedFiles, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a PostProcessResponse struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PostProcessResponse) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.Files) == 0) {
+		b, err := json.Marshal(v.Files)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"files":`)
+		buff.Write(b)
+	}
+	if !(len(v.RemovedFiles) == 0) {
+		b, err := json.Marshal(v.RemovedFiles)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"removedFiles":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PostProcessResponse struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PostProcessResponse) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["files"]; ok {
+		if err := json.Unmarshal(r, &v.Files); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["removedFiles"]; ok {
+		if err := json.Unmarshal(r, &v.RemovedFiles); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PostProcessResponse
+// struct.
+func (v *PostProcessResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Files != nil {
+		fields[i] = fmt.Sprintf("Files: %v", v.Files)
+		i++
+	}
+	if v.RemovedFiles != nil {
+		fields[i] = fmt.Sprintf("RemovedFiles: %v", v.RemovedFiles)
+		i++
+	}
+
+	return fmt.Sprintf("PostProcessResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this PostProcessResponse match the
+// provided PostProcessResponse.
+//
+// This function performs a deep comparison.
+func (v *PostProcessResponse) Equals(rhs *PostProcessResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Files == nil && rhs.Files == nil) || (v.Files != nil && rhs.Files != nil && _Map_String_Binary_Equals(v.Files, rhs.Files))) {
+		return false
+	}
+	if !((v.RemovedFiles == nil && rhs.RemovedFiles == nil) || (v.RemovedFiles != nil && rhs.RemovedFiles != nil && _List_String_Equals(v.RemovedFiles, rhs.RemovedFiles))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Clone(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]string, len(v))
+	for i, x := range v {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this PostProcessResponse. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PostProcessResponse) Clone() *PostProcessResponse {
+	if v == nil {
+		return nil
+	}
+
+	var c PostProcessResponse
+	c.Files = _Map_String_Binary_Clone(v.Files)
+	c.RemovedFiles = _List_String_Clone(v.RemovedFiles)
+
+	return &c
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PostProcessResponse.
+func (v *PostProcessResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Files != nil {
+		err = multierr.Append(err, enc.AddObject("files", (_Map_String_Binary_Zapper)(v.Files)))
+	}
+	if v.RemovedFiles != nil {
+		err = multierr.Append(err, enc.AddArray("removedFiles", (_List_String_Zapper)(v.RemovedFiles)))
+	}
+	return err
+}
+
+// GetFiles returns the value of Files if it is set or its
+// zero value if it is unset.
+func (v *PostProcessResponse) GetFiles() (o map[string][]byte) {
+	if v != nil && v.Files != nil {
+		return v.Files
+	}
+
+	return
+}
+
+// IsSetFiles returns true if Files is not nil.
+func (v *PostProcessResponse) IsSetFiles() bool {
+	return v != nil && v.Files != nil
+}
+
+// GetRemovedFiles returns the value of RemovedFiles if it is set or its
+// zero value if it is unset.
+func (v *PostProcessResponse) GetRemovedFiles() (o []string) {
+	if v != nil && v.RemovedFiles != nil {
+		return v.RemovedFiles
+	}
+
+	return
+}
+
+// IsSetRemovedFiles returns true if RemovedFiles is not nil.
+func (v *PostProcessResponse) IsSetRemovedFiles() bool {
+	return v != nil && v.RemovedFiles != nil
+}
+
+// ResolveTypeRequest is a request to resolve a Thrift type by name.
+type ResolveTypeRequest struct {
+	// Path to the Thrift file from which the type is referenced. This is the
+	// thriftFilePath of one of the modules ThriftRW provided to the plugin.
+	ThriftFilePath string `json:"thriftFilePath,required"`
+	// Name of the type as it would be referenced from that Thrift file.
+	// Types defined in included files are referenced with the name of the
+	// include as the prefix, for example, "shared.UUID".
+	Name string `json:"name,required"`
+}
+
+// ToWire translates a ResolveTypeRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResolveTypeRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.ThriftFilePath), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResolveTypeRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResolveTypeRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResolveTypeRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResolveTypeRequest) FromWire(w wire.Value) error {
+	var err error
+
+	thriftFilePathIsSet := false
+	nameIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftFilePath, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftFilePathIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		}
+	}
+
+	if !thriftFilePathIsSet {
+		missing.Add("ResolveTypeRequest", "ThriftFilePath")
+	}
+
+	if !nameIsSet {
+		missing.Add("ResolveTypeRequest", "Name")
+	}
+
+	return missing.Err()
+}
+
+func (v *ResolveTypeRequest) Decode(sr stream.Reader) error {
+	thriftFilePathIsSet := false
+	nameIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ThriftFilePath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftFilePathIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !thriftFilePathIsSet {
+		return errors.New("field ThriftFilePath of ResolveTypeRequest is required")
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of ResolveTypeRequest is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ResolveTypeRequest struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ResolveTypeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.ThriftFilePath)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftFilePath":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ResolveTypeRequest struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ResolveTypeRequest) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["thriftFilePath"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftFilePath); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResolveTypeRequest
+// struct.
+func (v *ResolveTypeRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("ThriftFilePath: %v", v.ThriftFilePath)
+	i++
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+
+	return fmt.Sprintf("ResolveTypeRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResolveTypeRequest match the
+// provided ResolveTypeRequest.
+//
+// This function performs a deep comparison.
+func (v *ResolveTypeRequest) Equals(rhs *ResolveTypeRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ThriftFilePath == rhs.ThriftFilePath) {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this ResolveTypeRequest. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ResolveTypeRequest) Clone() *ResolveTypeRequest {
+	if v == nil {
+		return nil
+	}
+
+	var c ResolveTypeRequest
+	c.ThriftFilePath = v.ThriftFilePath
+	c.Name = v.Name
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResolveTypeRequest.
+func (v *ResolveTypeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("thriftFilePath", v.ThriftFilePath)
+	enc.AddString("name", v.Name)
+	return err
+}
+
+// GetThriftFilePath returns the value of ThriftFilePath if it is set or its
+// zero value if it is unset.
+func (v *ResolveTypeRequest) GetThriftFilePath() (o string) {
+	if v != nil {
+		o = v.ThriftFilePath
+	}
+	return
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *ResolveTypeRequest) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// ResolveTypeResponse is the response to a ResolveTypeRequest.
+type ResolveTypeResponse struct {
+	// Go type used by ThriftRW for required fields of the requested type.
+	Type *Type `json:"type,required"`
+}
+
+// ToWire translates a ResolveTypeResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResolveTypeResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Type == nil {
+		return w, errors.New("field Type of ResolveTypeResponse is required")
+	}
+	w, err = v.Type.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResolveTypeResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResolveTypeResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResolveTypeResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResolveTypeResponse) FromWire(w wire.Value) error {
+	var err error
+
+	typeIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Type, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				typeIsSet = true
+			}
+		}
+	}
+
+	if !typeIsSet {
+		missing.Add("ResolveTypeResponse", "Type")
+	}
+
+	return missing.Err()
+}
+
+func (v *ResolveTypeResponse) Decode(sr stream.Reader) error {
+	typeIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Type, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			typeIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !typeIsSet {
+		return errors.New("field Type of ResolveTypeResponse is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ResolveTypeResponse struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ResolveTypeResponse) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"type":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ResolveTypeResponse struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ResolveTypeResponse) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["type"]; ok {
+		if err := json.Unmarshal(r, &v.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResolveTypeResponse
+// struct.
+func (v *ResolveTypeResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Type: %v", v.Type)
+	i++
+
+	return fmt.Sprintf("ResolveTypeResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResolveTypeResponse match the
+// provided ResolveTypeResponse.
+//
+// This function performs a deep comparison.
+func (v *ResolveTypeResponse) Equals(rhs *ResolveTypeResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Type.Equals(rhs.Type) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this ResolveTypeResponse. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ResolveTypeResponse) Clone() *ResolveTypeResponse {
+	if v == nil {
+		return nil
+	}
+
+	var c ResolveTypeResponse
+	c.Type = v.Type.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResolveTypeResponse.
+func (v *ResolveTypeResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("type", v.Type))
+	return err
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *ResolveTypeResponse) GetType() (o *Type) {
+	if v != nil {
+		o = v.Type
+	}
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *ResolveTypeResponse) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// Service is a service defined by the user in the Thrift file.
+type Service struct {
+	// Name of the Thrift service in Go code.
+	Name string `json:"name,required"`
+	// Name of the service as defined in the Thrift file.
+	ThriftName string `json:"thriftName,required"`
+	// ID of the parent service, if this service extends another service.
+	//
+	// The parent service is always present in the Services of the
+	// GenerateServiceRequest, even if it's defined in a module for which
+	// code isn't being generated, so the whole inheritance chain may be
+	// followed with these IDs.
+	ParentID *ServiceID `json:"parentID,omitempty"`
+	// List of functions defined for this service.
+	Functions []*Function `json:"functions,required"`
+	// ID of the module where this service was declared.
+	ModuleID ModuleID `json:"moduleID,required"`
+	// Annotations defined on this service.
+	//
+	// Given,
+	//
+	//   service KeyValue {
+	//   } (private = "true")
+	//
+	// The annotations will be,
+	//
+	//  {
+	//    "private": "true",
+	//  }
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Documentation for this service, if any, with the comment markers
+	// removed.
+	Doc *string `json:"doc,omitempty"`
+}
+
+type _List_Function_ValueList []*Function
+
+func (v _List_Function_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Function_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Function_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Function_ValueList) Close() {}
+
+// ToWire translates a Service struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Service) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ThriftName), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.ParentID != nil {
+		w, err = v.ParentID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Functions == nil {
+		return w, errors.New("field Functions of Service is required")
+	}
+	w, err = wire.NewValueList(_List_Function_ValueList(v.Functions)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+
+	w, err = v.ModuleID.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 6, Value: w}
+	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Function_Read(w wire.Value) (*Function, error) {
+	var v Function
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Function, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Function_Read(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a Service struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Service struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Service
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Service) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	thriftNameIsSet := false
+
+	functionsIsSet := false
+	moduleIDIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ThriftName, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				thriftNameIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x ServiceID
+				x, err = _ServiceID_Read(field.Value)
+				v.ParentID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Functions, err = _List_Function_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				functionsIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TI32 {
+				v.ModuleID, err = _ModuleID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				moduleIDIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Doc = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("Service", "Name")
+	}
+
+	if !thriftNameIsSet {
+		missing.Add("Service", "ThriftName")
+	}
+
+	if !functionsIsSet {
+		missing.Add("Service", "Functions")
+	}
+
+	if !moduleIDIsSet {
+		missing.Add("Service", "ModuleID")
+	}
+
+	return missing.Err()
+}
+
+func _Function_Decode(sr stream.Reader) (*Function, error) {
+	var v Function
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Function_Decode(sr stream.Reader) ([]*Function, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Function, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Function_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *Service) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	thriftNameIsSet := false
+
+	functionsIsSet := false
+	moduleIDIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 7 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.ThriftName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			thriftNameIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TI32:
+			var x ServiceID
+			x, err = _ServiceID_Decode(sr)
+			v.ParentID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.Functions, err = _List_Function_Decode(sr)
+			if err != nil {
+				return err
+			}
+			functionsIsSet = true
+		case fh.ID == 6 && fh.Type == wire.TI32:
+			v.ModuleID, err = _ModuleID_Decode(sr)
+			if err != nil {
+				return err
+			}
+			moduleIDIsSet = true
+		case fh.ID == 8 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 9 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Doc = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Service is required")
+	}
+
+	if !thriftNameIsSet {
+		return errors.New("field ThriftName of Service is required")
+	}
+
+	if !functionsIsSet {
+		return errors.New("field Functions of Service is required")
+	}
+
+	if !moduleIDIsSet {
+		return errors.New("field ModuleID of Service is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Service struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Service) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ThriftName)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftName":`)
+		buff.Write(b)
+	}
+	if !(v.ParentID == nil) {
+		b, err := json.Marshal(v.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"parentID":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Functions)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"functions":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ModuleID)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"moduleID":`)
+		buff.Write(b)
+	}
+	if !(len(v.Annotations) == 0) {
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+	if !(v.Doc == nil) {
+		b, err := json.Marshal(v.Doc)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"doc":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Service struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Service) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["thriftName"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftName); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["parentID"]; ok {
+		if err := json.Unmarshal(r, &v.ParentID); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["functions"]; ok {
+		if err := json.Unmarshal(r, &v.Functions); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["moduleID"]; ok {
+		if err := json.Unmarshal(r, &v.ModuleID); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["doc"]; ok {
+		if err := json.Unmarshal(r, &v.Doc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Service
+// struct.
+func (v *Service) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("ThriftName: %v", v.ThriftName)
+	i++
+	if v.ParentID != nil {
+		fields[i] = fmt.Sprintf("ParentID: %v", *(v.ParentID))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Functions: %v", v.Functions)
+	i++
+	fields[i] = fmt.Sprintf("ModuleID: %v", v.ModuleID)
+	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
+		i++
+	}
+
+	return fmt.Sprintf("Service{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ServiceID_EqualsPtr(lhs, rhs *ServiceID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Function_Equals(lhs, rhs []*Function) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Service match the
+// provided Service.
+//
+// This function performs a deep comparison.
+func (v *Service) Equals(rhs *Service) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.ThriftName == rhs.ThriftName) {
+		return false
+	}
+	if !_ServiceID_EqualsPtr(v.ParentID, rhs.ParentID) {
+		return false
+	}
+	if !_List_Function_Equals(v.Functions, rhs.Functions) {
+		return false
+	}
+	if !(v.ModuleID == rhs.ModuleID) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
+		return false
+	}
+
+	return true
+}
+
+func _ServiceID_ClonePtr(v *ServiceID) *ServiceID {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+func _List_Function_Clone(v []*Function) []*Function {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Function, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this Service. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Service) Clone() *Service {
+	if v == nil {
+		return nil
+	}
+
+	var c Service
+	c.Name = v.Name
+	c.ThriftName = v.ThriftName
+	c.ParentID = _ServiceID_ClonePtr(v.ParentID)
+	c.Functions = _List_Function_Clone(v.Functions)
+	c.ModuleID = v.ModuleID
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+	c.Doc = _String_ClonePtr(v.Doc)
+
+	return &c
+}
+
+type _List_Function_Zapper []*Function
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Function_Zapper.
+func (l _List_Function_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Service.
+func (v *Service) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddString("thriftName", v.ThriftName)
+	if v.ParentID != nil {
+		enc.AddInt32("parentID", (int32)(*v.ParentID))
+	}
+	err = multierr.Append(err, enc.AddArray("functions", (_List_Function_Zapper)(v.Functions)))
+	enc.AddInt32("moduleID", (int32)(v.ModuleID))
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Service) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetThriftName returns the value of ThriftName if it is set or its
+// zero value if it is unset.
+func (v *Service) GetThriftName() (o string) {
+	if v != nil {
+		o = v.ThriftName
+	}
+	return
+}
+
+// GetParentID returns the value of ParentID if it is set or its
+// zero value if it is unset.
+func (v *Service) GetParentID() (o ServiceID) {
+	if v != nil && v.ParentID != nil {
+		return *v.ParentID
+	}
+
+	return
+}
+
+// IsSetParentID returns true if ParentID is not nil.
+func (v *Service) IsSetParentID() bool {
+	return v != nil && v.ParentID != nil
+}
+
+// GetFunctions returns the value of Functions if it is set or its
+// zero value if it is unset.
+func (v *Service) GetFunctions() (o []*Function) {
+	if v != nil {
+		o = v.Functions
+	}
+	return
+}
+
+// IsSetFunctions returns true if Functions is not nil.
+func (v *Service) IsSetFunctions() bool {
+	return v != nil && v.Functions != nil
+}
+
+// GetModuleID returns the value of ModuleID if it is set or its
+// zero value if it is unset.
+func (v *Service) GetModuleID() (o ModuleID) {
+	if v != nil {
+		o = v.ModuleID
+	}
+	return
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *Service) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *Service) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// GetDoc returns the value of Doc if it is set or its
+// zero value if it is unset.
+func (v *Service) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
+	}
+
+	return
+}
+
+// IsSetDoc returns true if Doc is not nil.
+func (v *Service) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
+}
+
+// ServiceID is an arbitrary unique identifier to reference the different
+// services in this request.
+type ServiceID int32
+
+// ServiceIDPtr returns a pointer to a ServiceID
+func (v ServiceID) Ptr() *ServiceID {
+	return &v
+}
+
+// ToWire translates ServiceID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v ServiceID) ToWire() (wire.Value, error) {
+	x := (int32)(v)
+	return wire.NewValueI32(x), error(nil)
+}
+
+// String returns a readable string representation of ServiceID.
+func (v ServiceID) String() string {
+	x := (int32)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes ServiceID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *ServiceID) FromWire(w wire.Value) error {
+	x, err := w.GetI32(), error(nil)
+	*v = (ServiceID)(x)
+	return err
+}
+
+// Decode deserializes ServiceID directly off the wire.
+func (v *ServiceID) Decode(sr stream.Reader) error {
+	x, err := sr.ReadInt32()
+	*v = (ServiceID)(x)
+	return err
+}
+
+// Equals returns true if this ServiceID is equal to the provided
+// ServiceID.
+func (lhs ServiceID) Equals(rhs ServiceID) bool {
+	return ((int32)(lhs) == (int32)(rhs))
+}
+
+// SimpleType is a standalone native Go type.
+type SimpleType int32
+
+const (
+	SimpleTypeBool        SimpleType = 1
+	SimpleTypeByte        SimpleType = 2
+	SimpleTypeInt8        SimpleType = 3
+	SimpleTypeInt16       SimpleType = 4
+	SimpleTypeInt32       SimpleType = 5
+	SimpleTypeInt64       SimpleType = 6
+	SimpleTypeFloat64     SimpleType = 7
+	SimpleTypeString      SimpleType = 8
+	SimpleTypeStructEmpty SimpleType = 9
+)
+
+// SimpleType_Values returns all recognized values of SimpleType.
+func SimpleType_Values() []SimpleType {
+	return []SimpleType{
+		SimpleTypeBool,
+		SimpleTypeByte,
+		SimpleTypeInt8,
+		SimpleTypeInt16,
+		SimpleTypeInt32,
+		SimpleTypeInt64,
+		SimpleTypeFloat64,
+		SimpleTypeString,
+		SimpleTypeStructEmpty,
+	}
+}
+
+// UnmarshalText tries to decode SimpleType from a byte slice
+// containing its name.
+//
+//   var v SimpleType
+//   err := v.UnmarshalText([]byte("BOOL"))
+func (v *SimpleType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "BOOL":
+		*v = SimpleTypeBool
+		return nil
+	case "BYTE":
+		*v = SimpleTypeByte
+		return nil
+	case "INT8":
+		*v = SimpleTypeInt8
+		return nil
+	case "INT16":
+		*v = SimpleTypeInt16
+		return nil
+	case "INT32":
+		*v = SimpleTypeInt32
+		return nil
+	case "INT64":
+		*v = SimpleTypeInt64
+		return nil
+	case "FLOAT64":
+		*v = SimpleTypeFloat64
+		return nil
+	case "STRING":
+		*v = SimpleTypeString
+		return nil
+	case "STRUCT_EMPTY":
+		*v = SimpleTypeStructEmpty
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "SimpleType", err)
+		}
+		*v = SimpleType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes SimpleType to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v SimpleType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 1:
 		return []byte("BOOL"), nil
 	case 2:
 		return []byte("BYTE"), nil
@@ -3117,190 +10427,4776 @@ func (v SimpleType) MarshalText() ([]byte, error) {
 	case 9:
 		return []byte("STRUCT_EMPTY"), nil
 	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SimpleType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v SimpleType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 1:
+		enc.AddString("name", "BOOL")
+	case 2:
+		enc.AddString("name", "BYTE")
+	case 3:
+		enc.AddString("name", "INT8")
+	case 4:
+		enc.AddString("name", "INT16")
+	case 5:
+		enc.AddString("name", "INT32")
+	case 6:
+		enc.AddString("name", "INT64")
+	case 7:
+		enc.AddString("name", "FLOAT64")
+	case 8:
+		enc.AddString("name", "STRING")
+	case 9:
+		enc.AddString("name", "STRUCT_EMPTY")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v SimpleType) Ptr() *SimpleType {
+	return &v
+}
+
+// ToWire translates SimpleType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v SimpleType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes SimpleType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return SimpleType(0), err
+//   }
+//
+//   var v SimpleType
+//   if err := v.FromWire(x); err != nil {
+//     return SimpleType(0), err
+//   }
+//   return v, nil
+func (v *SimpleType) FromWire(w wire.Value) error {
+	*v = (SimpleType)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded SimpleType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v SimpleType
+//   if err := v.Decode(sReader); err != nil {
+//     return SimpleType(0), err
+//   }
+//   return v, nil
+func (v *SimpleType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (SimpleType)(i)
+	return nil
+}
+
+// String returns a readable string representation of SimpleType.
+func (v SimpleType) String() string {
+	w := int32(v)
+	switch w {
+	case 1:
+		return "BOOL"
+	case 2:
+		return "BYTE"
+	case 3:
+		return "INT8"
+	case 4:
+		return "INT16"
+	case 5:
+		return "INT32"
+	case 6:
+		return "INT64"
+	case 7:
+		return "FLOAT64"
+	case 8:
+		return "STRING"
+	case 9:
+		return "STRUCT_EMPTY"
+	}
+	return fmt.Sprintf("SimpleType(%d)", w)
+}
+
+// Equals returns true if this SimpleType value matches the provided
+// value.
+func (v SimpleType) Equals(rhs SimpleType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes SimpleType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v SimpleType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 1:
+		return ([]byte)("\"BOOL\""), nil
+	case 2:
+		return ([]byte)("\"BYTE\""), nil
+	case 3:
+		return ([]byte)("\"INT8\""), nil
+	case 4:
+		return ([]byte)("\"INT16\""), nil
+	case 5:
+		return ([]byte)("\"INT32\""), nil
+	case 6:
+		return ([]byte)("\"INT64\""), nil
+	case 7:
+		return ([]byte)("\"FLOAT64\""), nil
+	case 8:
+		return ([]byte)("\"STRING\""), nil
+	case 9:
+		return ([]byte)("\"STRUCT_EMPTY\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode SimpleType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *SimpleType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "SimpleType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "SimpleType")
+		}
+		*v = (SimpleType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "SimpleType")
+	}
+}
+
+// Type is a reference to a Go type which may be native or user defined.
+type Type struct {
+	SimpleType *SimpleType `json:"simpleType,omitempty"`
+	// Slice of a type
+	//
+	// []$sliceType
+	SliceType *Type `json:"sliceType,omitempty"`
+	// Slice of key-value pairs of a pair of types.
+	//
+	// []struct{Key $left, Value $right}
+	KeyValueSliceType *TypePair `json:"keyValueSliceType,omitempty"`
+	// Map of a pair of types.
+	//
+	// map[$left]$right
+	MapType *TypePair `json:"mapType,omitempty"`
+	// Reference to a user-defined type.
+	ReferenceType *TypeReference `json:"referenceType,omitempty"`
+	// Pointer to a type.
+	PointerType *Type `json:"pointerType,omitempty"`
+}
+
+// ToWire translates a Type struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Type) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SimpleType != nil {
+		w, err = v.SimpleType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.SliceType != nil {
+		w, err = v.SliceType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.KeyValueSliceType != nil {
+		w, err = v.KeyValueSliceType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.MapType != nil {
+		w, err = v.MapType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.ReferenceType != nil {
+		w, err = v.ReferenceType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.PointerType != nil {
+		w, err = v.PointerType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Type should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _SimpleType_Read(w wire.Value) (SimpleType, error) {
+	var v SimpleType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _TypePair_Read(w wire.Value) (*TypePair, error) {
+	var v TypePair
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TypeReference_Read(w wire.Value) (*TypeReference, error) {
+	var v TypeReference
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Type struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Type struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Type
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Type) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				var x SimpleType
+				x, err = _SimpleType_Read(field.Value)
+				v.SimpleType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.SliceType, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.KeyValueSliceType, err = _TypePair_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.MapType, err = _TypePair_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.ReferenceType, err = _TypeReference_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.PointerType, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.SimpleType != nil {
+		count++
+	}
+	if v.SliceType != nil {
+		count++
+	}
+	if v.KeyValueSliceType != nil {
+		count++
+	}
+	if v.MapType != nil {
+		count++
+	}
+	if v.ReferenceType != nil {
+		count++
+	}
+	if v.PointerType != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
+	}
+
+	return missing.Err()
+}
+
+func _SimpleType_Decode(sr stream.Reader) (SimpleType, error) {
+	var v SimpleType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _TypePair_Decode(sr stream.Reader) (*TypePair, error) {
+	var v TypePair
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _TypeReference_Decode(sr stream.Reader) (*TypeReference, error) {
+	var v TypeReference
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Type) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TI32:
+			var x SimpleType
+			x, err = _SimpleType_Decode(sr)
+			v.SimpleType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.SliceType, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.KeyValueSliceType, err = _TypePair_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.MapType, err = _TypePair_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.ReferenceType, err = _TypeReference_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.PointerType, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.SimpleType != nil {
+		count++
+	}
+	if v.SliceType != nil {
+		count++
+	}
+	if v.KeyValueSliceType != nil {
+		count++
+	}
+	if v.MapType != nil {
+		count++
+	}
+	if v.ReferenceType != nil {
+		count++
+	}
+	if v.PointerType != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Type struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Type) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.SimpleType == nil) {
+		b, err := json.Marshal(v.SimpleType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"simpleType":`)
+		buff.Write(b)
+	}
+	if !(v.SliceType == nil) {
+		b, err := json.Marshal(v.SliceType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"sliceType":`)
+		buff.Write(b)
+	}
+	if !(v.KeyValueSliceType == nil) {
+		b, err := json.Marshal(v.KeyValueSliceType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"keyValueSliceType":`)
+		buff.Write(b)
+	}
+	if !(v.MapType == nil) {
+		b, err := json.Marshal(v.MapType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"mapType":`)
+		buff.Write(b)
+	}
+	if !(v.ReferenceType == nil) {
+		b, err := json.Marshal(v.ReferenceType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"referenceType":`)
+		buff.Write(b)
+	}
+	if !(v.PointerType == nil) {
+		b, err := json.Marshal(v.PointerType)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"pointerType":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Type struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Type) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["simpleType"]; ok {
+		if err := json.Unmarshal(r, &v.SimpleType); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["sliceType"]; ok {
+		if err := json.Unmarshal(r, &v.SliceType); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["keyValueSliceType"]; ok {
+		if err := json.Unmarshal(r, &v.KeyValueSliceType); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["mapType"]; ok {
+		if err := json.Unmarshal(r, &v.MapType); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["referenceType"]; ok {
+		if err := json.Unmarshal(r, &v.ReferenceType); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["pointerType"]; ok {
+		if err := json.Unmarshal(r, &v.PointerType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Type
+// struct.
+func (v *Type) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.SimpleType != nil {
+		fields[i] = fmt.Sprintf("SimpleType: %v", *(v.SimpleType))
+		i++
+	}
+	if v.SliceType != nil {
+		fields[i] = fmt.Sprintf("SliceType: %v", v.SliceType)
+		i++
+	}
+	if v.KeyValueSliceType != nil {
+		fields[i] = fmt.Sprintf("KeyValueSliceType: %v", v.KeyValueSliceType)
+		i++
+	}
+	if v.MapType != nil {
+		fields[i] = fmt.Sprintf("MapType: %v", v.MapType)
+		i++
+	}
+	if v.ReferenceType != nil {
+		fields[i] = fmt.Sprintf("ReferenceType: %v", v.ReferenceType)
+		i++
+	}
+	if v.PointerType != nil {
+		fields[i] = fmt.Sprintf("PointerType: %v", v.PointerType)
+		i++
+	}
+
+	return fmt.Sprintf("Type{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _SimpleType_EqualsPtr(lhs, rhs *SimpleType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Type match the
+// provided Type.
+//
+// This function performs a deep comparison.
+func (v *Type) Equals(rhs *Type) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_SimpleType_EqualsPtr(v.SimpleType, rhs.SimpleType) {
+		return false
+	}
+	if !((v.SliceType == nil && rhs.SliceType == nil) || (v.SliceType != nil && rhs.SliceType != nil && v.SliceType.Equals(rhs.SliceType))) {
+		return false
+	}
+	if !((v.KeyValueSliceType == nil && rhs.KeyValueSliceType == nil) || (v.KeyValueSliceType != nil && rhs.KeyValueSliceType != nil && v.KeyValueSliceType.Equals(rhs.KeyValueSliceType))) {
+		return false
+	}
+	if !((v.MapType == nil && rhs.MapType == nil) || (v.MapType != nil && rhs.MapType != nil && v.MapType.Equals(rhs.MapType))) {
+		return false
+	}
+	if !((v.ReferenceType == nil && rhs.ReferenceType == nil) || (v.ReferenceType != nil && rhs.ReferenceType != nil && v.ReferenceType.Equals(rhs.ReferenceType))) {
+		return false
+	}
+	if !((v.PointerType == nil && rhs.PointerType == nil) || (v.PointerType != nil && rhs.PointerType != nil && v.PointerType.Equals(rhs.PointerType))) {
+		return false
+	}
+
+	return true
+}
+
+func _SimpleType_ClonePtr(v *SimpleType) *SimpleType {
+	if v == nil {
+		return nil
+	}
+
+	x := *v
+	return &x
+}
+
+// Clone returns a deep copy of this Type. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Type) Clone() *Type {
+	if v == nil {
+		return nil
+	}
+
+	var c Type
+	c.SimpleType = _SimpleType_ClonePtr(v.SimpleType)
+	c.SliceType = v.SliceType.Clone()
+	c.KeyValueSliceType = v.KeyValueSliceType.Clone()
+	c.MapType = v.MapType.Clone()
+	c.ReferenceType = v.ReferenceType.Clone()
+	c.PointerType = v.PointerType.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Type.
+func (v *Type) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SimpleType != nil {
+		err = multierr.Append(err, enc.AddObject("simpleType", *v.SimpleType))
+	}
+	if v.SliceType != nil {
+		err = multierr.Append(err, enc.AddObject("sliceType", v.SliceType))
+	}
+	if v.KeyValueSliceType != nil {
+		err = multierr.Append(err, enc.AddObject("keyValueSliceType", v.KeyValueSliceType))
+	}
+	if v.MapType != nil {
+		err = multierr.Append(err, enc.AddObject("mapType", v.MapType))
+	}
+	if v.ReferenceType != nil {
+		err = multierr.Append(err, enc.AddObject("referenceType", v.ReferenceType))
+	}
+	if v.PointerType != nil {
+		err = multierr.Append(err, enc.AddObject("pointerType", v.PointerType))
+	}
+	return err
+}
+
+// GetSimpleType returns the value of SimpleType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetSimpleType() (o SimpleType) {
+	if v != nil && v.SimpleType != nil {
+		return *v.SimpleType
+	}
+
+	return
+}
+
+// IsSetSimpleType returns true if SimpleType is not nil.
+func (v *Type) IsSetSimpleType() bool {
+	return v != nil && v.SimpleType != nil
+}
+
+// GetSliceType returns the value of SliceType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetSliceType() (o *Type) {
+	if v != nil && v.SliceType != nil {
+		return v.SliceType
+	}
+
+	return
+}
+
+// IsSetSliceType returns true if SliceType is not nil.
+func (v *Type) IsSetSliceType() bool {
+	return v != nil && v.SliceType != nil
+}
+
+// GetKeyValueSliceType returns the value of KeyValueSliceType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetKeyValueSliceType() (o *TypePair) {
+	if v != nil && v.KeyValueSliceType != nil {
+		return v.KeyValueSliceType
+	}
+
+	return
+}
+
+// IsSetKeyValueSliceType returns true if KeyValueSliceType is not nil.
+func (v *Type) IsSetKeyValueSliceType() bool {
+	return v != nil && v.KeyValueSliceType != nil
+}
+
+// GetMapType returns the value of MapType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetMapType() (o *TypePair) {
+	if v != nil && v.MapType != nil {
+		return v.MapType
+	}
+
+	return
+}
+
+// IsSetMapType returns true if MapType is not nil.
+func (v *Type) IsSetMapType() bool {
+	return v != nil && v.MapType != nil
+}
+
+// GetReferenceType returns the value of ReferenceType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetReferenceType() (o *TypeReference) {
+	if v != nil && v.ReferenceType != nil {
+		return v.ReferenceType
+	}
+
+	return
+}
+
+// IsSetReferenceType returns true if ReferenceType is not nil.
+func (v *Type) IsSetReferenceType() bool {
+	return v != nil && v.ReferenceType != nil
+}
+
+// GetPointerType returns the value of PointerType if it is set or its
+// zero value if it is unset.
+func (v *Type) GetPointerType() (o *Type) {
+	if v != nil && v.PointerType != nil {
+		return v.PointerType
+	}
+
+	return
+}
+
+// IsSetPointerType returns true if PointerType is not nil.
+func (v *Type) IsSetPointerType() bool {
+	return v != nil && v.PointerType != nil
+}
+
+// TypeKind identifies the field of a Type that is set.
+type TypeKind int
+
+const (
+	// TypeKindUnset indicates that no field of a Type is set.
+	TypeKindUnset TypeKind = iota
+
+	// TypeKindSimpleType indicates that SimpleType is set.
+	TypeKindSimpleType
+
+	// TypeKindSliceType indicates that SliceType is set.
+	TypeKindSliceType
+
+	// TypeKindKeyValueSliceType indicates that KeyValueSliceType is set.
+	TypeKindKeyValueSliceType
+
+	// TypeKindMapType indicates that MapType is set.
+	TypeKindMapType
+
+	// TypeKindReferenceType indicates that ReferenceType is set.
+	TypeKindReferenceType
+
+	// TypeKindPointerType indicates that PointerType is set.
+	TypeKindPointerType
+)
+
+// String returns the Thrift name of the field identified by this
+// TypeKind.
+func (k TypeKind) String() string {
+	switch k {
+	case TypeKindUnset:
+		return "unset"
+	case TypeKindSimpleType:
+		return "simpleType"
+	case TypeKindSliceType:
+		return "sliceType"
+	case TypeKindKeyValueSliceType:
+		return "keyValueSliceType"
+	case TypeKindMapType:
+		return "mapType"
+	case TypeKindReferenceType:
+		return "referenceType"
+	case TypeKindPointerType:
+		return "pointerType"
+	default:
+		return fmt.Sprintf("TypeKind(%d)", int(k))
+	}
+}
+
+// Which returns the kind of the field of this Type that is set,
+// or TypeKindUnset if none of its fields is set.
+func (v *Type) Which() TypeKind {
+	if v == nil {
+		return TypeKindUnset
+	}
+
+	if v.SimpleType != nil {
+		return TypeKindSimpleType
+	}
+
+	if v.SliceType != nil {
+		return TypeKindSliceType
+	}
+
+	if v.KeyValueSliceType != nil {
+		return TypeKindKeyValueSliceType
+	}
+
+	if v.MapType != nil {
+		return TypeKindMapType
+	}
+
+	if v.ReferenceType != nil {
+		return TypeKindReferenceType
+	}
+
+	if v.PointerType != nil {
+		return TypeKindPointerType
+	}
+	return TypeKindUnset
+}
+
+// GetSimpleTypeOk returns the value of SimpleType and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Type) GetSimpleTypeOk() (o SimpleType, ok bool) {
+	if v == nil || v.SimpleType == nil {
+		return
+	}
+	return *v.SimpleType, true
+}
+
+// GetSliceTypeOk returns the value of SliceType and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Type) GetSliceTypeOk() (o *Type, ok bool) {
+	if v == nil || v.SliceType == nil {
+		return
+	}
+	return v.SliceType, true
+}
+
+// GetKeyValueSliceTypeOk returns the value of KeyValueSliceType and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Type) GetKeyValueSliceTypeOk() (o *TypePair, ok bool) {
+	if v == nil || v.KeyValueSliceType == nil {
+		return
+	}
+	return v.KeyValueSliceType, true
+}
+
+// GetMapTypeOk returns the value of MapType and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Type) GetMapTypeOk() (o *TypePair, ok bool) {
+	if v == nil || v.MapType == nil {
+		return
+	}
+	return v.MapType, true
+}
+
+// GetReferenceTypeOk returns the value of ReferenceType and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Type) GetReferenceTypeOk() (o *TypeReference, ok bool) {
+	if v == nil || v.ReferenceType == nil {
+		return
+	}
+	return v.ReferenceType, true
+}
+
+// GetPointerTypeOk returns the value of PointerType and true if it is
+// set, or its zero value and false if it is unset.
+func (v *Type) GetPointerTypeOk() (o *Type, ok bool) {
+	if v == nil || v.PointerType == nil {
+		return
+	}
+	return v.PointerType, true
+}
+
+// Match calls the function provided for the field of this Type
+// that is set with its value, and returns its result. An error is
+// returned if none of its fields is set.
+func (v *Type) Match(
+	onSimpleType func(SimpleType) error,
+	onSliceType func(*Type) error,
+	onKeyValueSliceType func(*TypePair) error,
+	onMapType func(*TypePair) error,
+	onReferenceType func(*TypeReference) error,
+	onPointerType func(*Type) error,
+) error {
+	switch v.Which() {
+	case TypeKindSimpleType:
+		return onSimpleType(*v.SimpleType)
+	case TypeKindSliceType:
+		return onSliceType(v.SliceType)
+	case TypeKindKeyValueSliceType:
+		return onKeyValueSliceType(v.KeyValueSliceType)
+	case TypeKindMapType:
+		return onMapType(v.MapType)
+	case TypeKindReferenceType:
+		return onReferenceType(v.ReferenceType)
+	case TypeKindPointerType:
+		return onPointerType(v.PointerType)
+	default:
+		return errors.New("Type should have exactly one field: got 0 fields")
+	}
+}
+
+// TypeMapping specifies the custom Go type for a field and how to convert
+// values of that type to and from the Go type ThriftRW would have used.
+type TypeMapping struct {
+	// Go type to use for the field.
+	//
+	// Optional fields will be generated as pointers to this type.
+	Type *Type `json:"type,required"`
+	// Function which converts the custom type into the Go type in the
+	// request. It must have the signature,
+	//
+	//   func(Custom) (Original, error)
+	ToThrift *FunctionReference `json:"toThrift,required"`
+	// Function which converts the Go type in the request into the custom
+	// type. It must have the signature,
+	//
+	//   func(Original) (Custom, error)
+	FromThrift *FunctionReference `json:"fromThrift,required"`
+	// Function which compares two values of the custom type. It must have
+	// the signature,
+	//
+	//   func(Custom, Custom) bool
+	//
+	// If unset, values are compared using the == operator.
+	EqualsFunc *FunctionReference `json:"equals,omitempty"`
+}
+
+// ToWire translates a TypeMapping struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TypeMapping) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Type == nil {
+		return w, errors.New("field Type of TypeMapping is required")
+	}
+	w, err = v.Type.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.ToThrift == nil {
+		return w, errors.New("field ToThrift of TypeMapping is required")
+	}
+	w, err = v.ToThrift.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.FromThrift == nil {
+		return w, errors.New("field FromThrift of TypeMapping is required")
+	}
+	w, err = v.FromThrift.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.EqualsFunc != nil {
+		w, err = v.EqualsFunc.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _FunctionReference_Read(w wire.Value) (*FunctionReference, error) {
+	var v FunctionReference
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a TypeMapping struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TypeMapping struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TypeMapping
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TypeMapping) FromWire(w wire.Value) error {
+	var err error
+
+	typeIsSet := false
+	toThriftIsSet := false
+	fromThriftIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Type, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				typeIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.ToThrift, err = _FunctionReference_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				toThriftIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.FromThrift, err = _FunctionReference_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				fromThriftIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.EqualsFunc, err = _FunctionReference_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !typeIsSet {
+		missing.Add("TypeMapping", "Type")
+	}
+
+	if !toThriftIsSet {
+		missing.Add("TypeMapping", "ToThrift")
+	}
+
+	if !fromThriftIsSet {
+		missing.Add("TypeMapping", "FromThrift")
+	}
+
+	return missing.Err()
+}
+
+func _FunctionReference_Decode(sr stream.Reader) (*FunctionReference, error) {
+	var v FunctionReference
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *TypeMapping) Decode(sr stream.Reader) error {
+	typeIsSet := false
+	toThriftIsSet := false
+	fromThriftIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Type, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			typeIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.ToThrift, err = _FunctionReference_Decode(sr)
+			if err != nil {
+				return err
+			}
+			toThriftIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.FromThrift, err = _FunctionReference_Decode(sr)
+			if err != nil {
+				return err
+			}
+			fromThriftIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.EqualsFunc, err = _FunctionReference_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !typeIsSet {
+		return errors.New("field Type of TypeMapping is required")
+	}
+
+	if !toThriftIsSet {
+		return errors.New("field ToThrift of TypeMapping is required")
+	}
+
+	if !fromThriftIsSet {
+		return errors.New("field FromThrift of TypeMapping is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a TypeMapping struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *TypeMapping) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"type":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ToThrift)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"toThrift":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.FromThrift)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"fromThrift":`)
+		buff.Write(b)
+	}
+	if !(v.EqualsFunc == nil) {
+		b, err := json.Marshal(v.EqualsFunc)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"equals":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a TypeMapping struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *TypeMapping) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["type"]; ok {
+		if err := json.Unmarshal(r, &v.Type); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["toThrift"]; ok {
+		if err := json.Unmarshal(r, &v.ToThrift); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["fromThrift"]; ok {
+		if err := json.Unmarshal(r, &v.FromThrift); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["equals"]; ok {
+		if err := json.Unmarshal(r, &v.EqualsFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TypeMapping
+// struct.
+func (v *TypeMapping) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Type: %v", v.Type)
+	i++
+	fields[i] = fmt.Sprintf("ToThrift: %v", v.ToThrift)
+	i++
+	fields[i] = fmt.Sprintf("FromThrift: %v", v.FromThrift)
+	i++
+	if v.EqualsFunc != nil {
+		fields[i] = fmt.Sprintf("EqualsFunc: %v", v.EqualsFunc)
+		i++
+	}
+
+	return fmt.Sprintf("TypeMapping{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TypeMapping match the
+// provided TypeMapping.
+//
+// This function performs a deep comparison.
+func (v *TypeMapping) Equals(rhs *TypeMapping) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Type.Equals(rhs.Type) {
+		return false
+	}
+	if !v.ToThrift.Equals(rhs.ToThrift) {
+		return false
+	}
+	if !v.FromThrift.Equals(rhs.FromThrift) {
+		return false
+	}
+	if !((v.EqualsFunc == nil && rhs.EqualsFunc == nil) || (v.EqualsFunc != nil && rhs.EqualsFunc != nil && v.EqualsFunc.Equals(rhs.EqualsFunc))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this TypeMapping. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *TypeMapping) Clone() *TypeMapping {
+	if v == nil {
+		return nil
+	}
+
+	var c TypeMapping
+	c.Type = v.Type.Clone()
+	c.ToThrift = v.ToThrift.Clone()
+	c.FromThrift = v.FromThrift.Clone()
+	c.EqualsFunc = v.EqualsFunc.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TypeMapping.
+func (v *TypeMapping) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("type", v.Type))
+	err = multierr.Append(err, enc.AddObject("toThrift", v.ToThrift))
+	err = multierr.Append(err, enc.AddObject("fromThrift", v.FromThrift))
+	if v.EqualsFunc != nil {
+		err = multierr.Append(err, enc.AddObject("equals", v.EqualsFunc))
+	}
+	return err
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *TypeMapping) GetType() (o *Type) {
+	if v != nil {
+		o = v.Type
+	}
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *TypeMapping) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetToThrift returns the value of ToThrift if it is set or its
+// zero value if it is unset.
+func (v *TypeMapping) GetToThrift() (o *FunctionReference) {
+	if v != nil {
+		o = v.ToThrift
+	}
+	return
+}
+
+// IsSetToThrift returns true if ToThrift is not nil.
+func (v *TypeMapping) IsSetToThrift() bool {
+	return v != nil && v.ToThrift != nil
+}
+
+// GetFromThrift returns the value of FromThrift if it is set or its
+// zero value if it is unset.
+func (v *TypeMapping) GetFromThrift() (o *FunctionReference) {
+	if v != nil {
+		o = v.FromThrift
+	}
+	return
+}
+
+// IsSetFromThrift returns true if FromThrift is not nil.
+func (v *TypeMapping) IsSetFromThrift() bool {
+	return v != nil && v.FromThrift != nil
+}
+
+// GetEqualsFunc returns the value of EqualsFunc if it is set or its
+// zero value if it is unset.
+func (v *TypeMapping) GetEqualsFunc() (o *FunctionReference) {
+	if v != nil && v.EqualsFunc != nil {
+		return v.EqualsFunc
+	}
+
+	return
+}
+
+// IsSetEqualsFunc returns true if EqualsFunc is not nil.
+func (v *TypeMapping) IsSetEqualsFunc() bool {
+	return v != nil && v.EqualsFunc != nil
+}
+
+// TypePair is a pair of two types.
+type TypePair struct {
+	Left  *Type `json:"left,required"`
+	Right *Type `json:"right,required"`
+}
+
+// ToWire translates a TypePair struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TypePair) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Left == nil {
+		return w, errors.New("field Left of TypePair is required")
+	}
+	w, err = v.Left.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Right == nil {
+		return w, errors.New("field Right of TypePair is required")
+	}
+	w, err = v.Right.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TypePair struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TypePair struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TypePair
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TypePair) FromWire(w wire.Value) error {
+	var err error
+
+	leftIsSet := false
+	rightIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Left, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				leftIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Right, err = _Type_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				rightIsSet = true
+			}
+		}
+	}
+
+	if !leftIsSet {
+		missing.Add("TypePair", "Left")
+	}
+
+	if !rightIsSet {
+		missing.Add("TypePair", "Right")
+	}
+
+	return missing.Err()
+}
+
+func (v *TypePair) Decode(sr stream.Reader) error {
+	leftIsSet := false
+	rightIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Left, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			leftIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.Right, err = _Type_Decode(sr)
+			if err != nil {
+				return err
+			}
+			rightIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !leftIsSet {
+		return errors.New("field Left of TypePair is required")
+	}
+
+	if !rightIsSet {
+		return errors.New("field Right of TypePair is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a TypePair struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *TypePair) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Left)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"left":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Right)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"right":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a TypePair struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *TypePair) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["left"]; ok {
+		if err := json.Unmarshal(r, &v.Left); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["right"]; ok {
+		if err := json.Unmarshal(r, &v.Right); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TypePair
+// struct.
+func (v *TypePair) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Left: %v", v.Left)
+	i++
+	fields[i] = fmt.Sprintf("Right: %v", v.Right)
+	i++
+
+	return fmt.Sprintf("TypePair{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TypePair match the
+// provided TypePair.
+//
+// This function performs a deep comparison.
+func (v *TypePair) Equals(rhs *TypePair) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !v.Left.Equals(rhs.Left) {
+		return false
+	}
+	if !v.Right.Equals(rhs.Right) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this TypePair. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *TypePair) Clone() *TypePair {
+	if v == nil {
+		return nil
+	}
+
+	var c TypePair
+	c.Left = v.Left.Clone()
+	c.Right = v.Right.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TypePair.
+func (v *TypePair) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("left", v.Left))
+	err = multierr.Append(err, enc.AddObject("right", v.Right))
+	return err
+}
+
+// GetLeft returns the value of Left if it is set or its
+// zero value if it is unset.
+func (v *TypePair) GetLeft() (o *Type) {
+	if v != nil {
+		o = v.Left
+	}
+	return
+}
+
+// IsSetLeft returns true if Left is not nil.
+func (v *TypePair) IsSetLeft() bool {
+	return v != nil && v.Left != nil
+}
+
+// GetRight returns the value of Right if it is set or its
+// zero value if it is unset.
+func (v *TypePair) GetRight() (o *Type) {
+	if v != nil {
+		o = v.Right
+	}
+	return
+}
+
+// IsSetRight returns true if Right is not nil.
+func (v *TypePair) IsSetRight() bool {
+	return v != nil && v.Right != nil
+}
+
+// TypeReference is a reference to a user-defined type.
+type TypeReference struct {
+	Name string `json:"name,required"`
+	// Import path for the package defining this type.
+	ImportPath string `json:"importPath,required"`
+	// Annotations defined on this type.
+	//
+	// Note that these are the Thrift annotations listed after the type
+	// declaration in the Thrift file.
+	//
+	// Given,
+	//
+	//   struct User {
+	//     1: required i32 id
+	//     2: required string name
+	//   } (key = "id", validate)
+	//
+	// The annotations will be,
+	//
+	//   {
+	//     "key": "id",
+	//     "validate": "",
+	//   }
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Items of the enum this refers to. This is unset if the type is not an
+	// enum.
+	EnumItems []*EnumItem `json:"enumItems,omitempty"`
+}
+
+type _List_EnumItem_ValueList []*EnumItem
+
+func (v _List_EnumItem_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_EnumItem_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_EnumItem_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_EnumItem_ValueList) Close() {}
+
+// ToWire translates a TypeReference struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TypeReference) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ImportPath), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Annotations != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.EnumItems != nil {
+		w, err = wire.NewValueList(_List_EnumItem_ValueList(v.EnumItems)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _EnumItem_Read(w wire.Value) (*EnumItem, error) {
+	var v EnumItem
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_EnumItem_Read(l wire.ValueList) ([]*EnumItem, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*EnumItem, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _EnumItem_Read(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a TypeReference struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TypeReference struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TypeReference
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TypeReference) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	importPathIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.ImportPath, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				importPathIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.EnumItems, err = _List_EnumItem_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		missing.Add("TypeReference", "Name")
+	}
+
+	if !importPathIsSet {
+		missing.Add("TypeReference", "ImportPath")
+	}
+
+	return missing.Err()
+}
+
+func _EnumItem_Decode(sr stream.Reader) (*EnumItem, error) {
+	var v EnumItem
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_EnumItem_Decode(sr stream.Reader) ([]*EnumItem, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*EnumItem, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _EnumItem_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *TypeReference) Decode(sr stream.Reader) error {
+	nameIsSet := false
+	importPathIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Name, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			nameIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.ImportPath, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			importPathIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TMap:
+			v.Annotations, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TList:
+			v.EnumItems, err = _List_EnumItem_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of TypeReference is required")
+	}
+
+	if !importPathIsSet {
+		return errors.New("field ImportPath of TypeReference is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a TypeReference struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *TypeReference) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"name":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.ImportPath)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"importPath":`)
+		buff.Write(b)
+	}
+	if !(len(v.Annotations) == 0) {
+		b, err := json.Marshal(v.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"annotations":`)
+		buff.Write(b)
+	}
+	if !(len(v.EnumItems) == 0) {
+		b, err := json.Marshal(v.EnumItems)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"enumItems":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a TypeReference struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *TypeReference) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["name"]; ok {
+		if err := json.Unmarshal(r, &v.Name); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["importPath"]; ok {
+		if err := json.Unmarshal(r, &v.ImportPath); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["annotations"]; ok {
+		if err := json.Unmarshal(r, &v.Annotations); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["enumItems"]; ok {
+		if err := json.Unmarshal(r, &v.EnumItems); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TypeReference
+// struct.
+func (v *TypeReference) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
+	i++
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
+	if v.EnumItems != nil {
+		fields[i] = fmt.Sprintf("EnumItems: %v", v.EnumItems)
+		i++
+	}
+
+	return fmt.Sprintf("TypeReference{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_EnumItem_Equals(lhs, rhs []*EnumItem) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this TypeReference match the
+// provided TypeReference.
+//
+// This function performs a deep comparison.
+func (v *TypeReference) Equals(rhs *TypeReference) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.ImportPath == rhs.ImportPath) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
+	if !((v.EnumItems == nil && rhs.EnumItems == nil) || (v.EnumItems != nil && rhs.EnumItems != nil && _List_EnumItem_Equals(v.EnumItems, rhs.EnumItems))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_EnumItem_Clone(v []*EnumItem) []*EnumItem {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*EnumItem, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this TypeReference. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *TypeReference) Clone() *TypeReference {
+	if v == nil {
+		return nil
+	}
+
+	var c TypeReference
+	c.Name = v.Name
+	c.ImportPath = v.ImportPath
+	c.Annotations = _Map_String_String_Clone(v.Annotations)
+	c.EnumItems = _List_EnumItem_Clone(v.EnumItems)
+
+	return &c
+}
+
+type _List_EnumItem_Zapper []*EnumItem
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_EnumItem_Zapper.
+func (l _List_EnumItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TypeReference.
+func (v *TypeReference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddString("importPath", v.ImportPath)
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	}
+	if v.EnumItems != nil {
+		err = multierr.Append(err, enc.AddArray("enumItems", (_List_EnumItem_Zapper)(v.EnumItems)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *TypeReference) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetImportPath returns the value of ImportPath if it is set or its
+// zero value if it is unset.
+func (v *TypeReference) GetImportPath() (o string) {
+	if v != nil {
+		o = v.ImportPath
+	}
+	return
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *TypeReference) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *TypeReference) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// GetEnumItems returns the value of EnumItems if it is set or its
+// zero value if it is unset.
+func (v *TypeReference) GetEnumItems() (o []*EnumItem) {
+	if v != nil && v.EnumItems != nil {
+		return v.EnumItems
+	}
+
+	return
+}
+
+// IsSetEnumItems returns true if EnumItems is not nil.
+func (v *TypeReference) IsSetEnumItems() bool {
+	return v != nil && v.EnumItems != nil
+}
+
+// ValidateRequest is a request to check Thrift files for problems.
+type ValidateRequest struct {
+	// Paths to the Thrift files being checked.
+	ThriftFilePaths []string `json:"thriftFilePaths,required"`
+	// Top-level declarations of these Thrift files, in the order in which
+	// they are defined.
+	Declarations []*Declaration `json:"declarations,required"`
+}
+
+type _List_Declaration_ValueList []*Declaration
+
+func (v _List_Declaration_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Declaration_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Declaration_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Declaration_ValueList) Close() {}
+
+// ToWire translates a ValidateRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ValidateRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ThriftFilePaths == nil {
+		return w, errors.New("field ThriftFilePaths of ValidateRequest is required")
+	}
+	w, err = wire.NewValueList(_List_String_ValueList(v.ThriftFilePaths)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Declarations == nil {
+		return w, errors.New("field Declarations of ValidateRequest is required")
+	}
+	w, err = wire.NewValueList(_List_Declaration_ValueList(v.Declarations)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Declaration_Read(w wire.Value) (*Declaration, error) {
+	var v Declaration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Declaration_Read(l wire.ValueList) ([]*Declaration, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Declaration, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Declaration_Read(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a ValidateRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ValidateRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ValidateRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ValidateRequest) FromWire(w wire.Value) error {
+	var err error
+
+	thriftFilePathsIsSet := false
+	declarationsIsSet := false
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.ThriftFilePaths, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				thriftFilePathsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Declarations, err = _List_Declaration_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+				declarationsIsSet = true
+			}
+		}
+	}
+
+	if !thriftFilePathsIsSet {
+		missing.Add("ValidateRequest", "ThriftFilePaths")
+	}
+
+	if !declarationsIsSet {
+		missing.Add("ValidateRequest", "Declarations")
+	}
+
+	return missing.Err()
+}
+
+func _Declaration_Decode(sr stream.Reader) (*Declaration, error) {
+	var v Declaration
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Declaration_Decode(sr stream.Reader) ([]*Declaration, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Declaration, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Declaration_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *ValidateRequest) Decode(sr stream.Reader) error {
+	thriftFilePathsIsSet := false
+	declarationsIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.ThriftFilePaths, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			thriftFilePathsIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TList:
+			v.Declarations, err = _List_Declaration_Decode(sr)
+			if err != nil {
+				return err
+			}
+			declarationsIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !thriftFilePathsIsSet {
+		return errors.New("field ThriftFilePaths of ValidateRequest is required")
+	}
+
+	if !declarationsIsSet {
+		return errors.New("field Declarations of ValidateRequest is required")
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ValidateRequest struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ValidateRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	{
+		b, err := json.Marshal(v.ThriftFilePaths)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"thriftFilePaths":`)
+		buff.Write(b)
+	}
+	{
+		b, err := json.Marshal(v.Declarations)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"declarations":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ValidateRequest struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ValidateRequest) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["thriftFilePaths"]; ok {
+		if err := json.Unmarshal(r, &v.ThriftFilePaths); err != nil {
+			return err
+		}
+	}
+	if r, ok := raw["declarations"]; ok {
+		if err := json.Unmarshal(r, &v.Declarations); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ValidateRequest
+// struct.
+func (v *ValidateRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("ThriftFilePaths: %v", v.ThriftFilePaths)
+	i++
+	fields[i] = fmt.Sprintf("Declarations: %v", v.Declarations)
+	i++
+
+	return fmt.Sprintf("ValidateRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Declaration_Equals(lhs, rhs []*Declaration) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ValidateRequest match the
+// provided ValidateRequest.
+//
+// This function performs a deep comparison.
+func (v *ValidateRequest) Equals(rhs *ValidateRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_List_String_Equals(v.ThriftFilePaths, rhs.ThriftFilePaths) {
+		return false
+	}
+	if !_List_Declaration_Equals(v.Declarations, rhs.Declarations) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Declaration_Clone(v []*Declaration) []*Declaration {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Declaration, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this ValidateRequest. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ValidateRequest) Clone() *ValidateRequest {
+	if v == nil {
+		return nil
+	}
+
+	var c ValidateRequest
+	c.ThriftFilePaths = _List_String_Clone(v.ThriftFilePaths)
+	c.Declarations = _List_Declaration_Clone(v.Declarations)
+
+	return &c
+}
+
+type _List_Declaration_Zapper []*Declaration
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Declaration_Zapper.
+func (l _List_Declaration_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ValidateRequest.
+func (v *ValidateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddArray("thriftFilePaths", (_List_String_Zapper)(v.ThriftFilePaths)))
+	err = multierr.Append(err, enc.AddArray("declarations", (_List_Declaration_Zapper)(v.Declarations)))
+	return err
+}
+
+// GetThriftFilePaths returns the value of ThriftFilePaths if it is set or its
+// zero value if it is unset.
+func (v *ValidateRequest) GetThriftFilePaths() (o []string) {
+	if v != nil {
+		o = v.ThriftFilePaths
+	}
+	return
+}
+
+// IsSetThriftFilePaths returns true if ThriftFilePaths is not nil.
+func (v *ValidateRequest) IsSetThriftFilePaths() bool {
+	return v != nil && v.ThriftFilePaths != nil
+}
+
+// GetDeclarations returns the value of Declarations if it is set or its
+// zero value if it is unset.
+func (v *ValidateRequest) GetDeclarations() (o []*Declaration) {
+	if v != nil {
+		o = v.Declarations
+	}
+	return
+}
+
+// IsSetDeclarations returns true if Declarations is not nil.
+func (v *ValidateRequest) IsSetDeclarations() bool {
+	return v != nil && v.Declarations != nil
+}
+
+// ValidateResponse is the response to a ValidateRequest.
+type ValidateResponse struct {
+	// Problems found in the Thrift files. Code is not generated if any
+	// problems are reported.
+	Diagnostics []*Diagnostic `json:"diagnostics,omitempty"`
+}
+
+type _List_Diagnostic_ValueList []*Diagnostic
+
+func (v _List_Diagnostic_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Diagnostic_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Diagnostic_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Diagnostic_ValueList) Close() {}
+
+// ToWire translates a ValidateResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ValidateResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Diagnostics != nil {
+		w, err = wire.NewValueList(_List_Diagnostic_ValueList(v.Diagnostics)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Diagnostic_Read(w wire.Value) (*Diagnostic, error) {
+	var v Diagnostic
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Diagnostic_Read(l wire.ValueList) ([]*Diagnostic, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Diagnostic, 0, l.Size())
+	var missing required.Errors
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Diagnostic_Read(x)
+		if err = missing.Merge(err); err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	if err == nil {
+		err = missing.Err()
+	}
+	return o, err
+}
+
+// FromWire deserializes a ValidateResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ValidateResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ValidateResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ValidateResponse) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Diagnostics, err = _List_Diagnostic_Read(field.Value.GetList())
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return missing.Err()
+}
+
+func _Diagnostic_Decode(sr stream.Reader) (*Diagnostic, error) {
+	var v Diagnostic
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Diagnostic_Decode(sr stream.Reader) ([]*Diagnostic, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	n := lh.Length
+	if n > 1024 {
+
+		n = 1024
+	}
+
+	o := make([]*Diagnostic, 0, n)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Diagnostic_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (v *ValidateResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TList:
+			v.Diagnostics, err = _List_Diagnostic_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ValidateResponse struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ValidateResponse) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(len(v.Diagnostics) == 0) {
+		b, err := json.Marshal(v.Diagnostics)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"diagnostics":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ValidateResponse struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ValidateResponse) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["diagnostics"]; ok {
+		if err := json.Unmarshal(r, &v.Diagnostics); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ValidateResponse
+// struct.
+func (v *ValidateResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Diagnostics != nil {
+		fields[i] = fmt.Sprintf("Diagnostics: %v", v.Diagnostics)
+		i++
+	}
+
+	return fmt.Sprintf("ValidateResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Diagnostic_Equals(lhs, rhs []*Diagnostic) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ValidateResponse match the
+// provided ValidateResponse.
+//
+// This function performs a deep comparison.
+func (v *ValidateResponse) Equals(rhs *ValidateResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Diagnostics == nil && rhs.Diagnostics == nil) || (v.Diagnostics != nil && rhs.Diagnostics != nil && _List_Diagnostic_Equals(v.Diagnostics, rhs.Diagnostics))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Diagnostic_Clone(v []*Diagnostic) []*Diagnostic {
+	if v == nil {
+		return nil
+	}
+
+	o := make([]*Diagnostic, len(v))
+	for i, x := range v {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this ValidateResponse. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ValidateResponse) Clone() *ValidateResponse {
+	if v == nil {
+		return nil
+	}
+
+	var c ValidateResponse
+	c.Diagnostics = _List_Diagnostic_Clone(v.Diagnostics)
+
+	return &c
+}
+
+type _List_Diagnostic_Zapper []*Diagnostic
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Diagnostic_Zapper.
+func (l _List_Diagnostic_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ValidateResponse.
+func (v *ValidateResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Diagnostics != nil {
+		err = multierr.Append(err, enc.AddArray("diagnostics", (_List_Diagnostic_Zapper)(v.Diagnostics)))
+	}
+	return err
+}
+
+// GetDiagnostics returns the value of Diagnostics if it is set or its
+// zero value if it is unset.
+func (v *ValidateResponse) GetDiagnostics() (o []*Diagnostic) {
+	if v != nil && v.Diagnostics != nil {
+		return v.Diagnostics
+	}
+
+	return
+}
+
+// IsSetDiagnostics returns true if Diagnostics is not nil.
+func (v *ValidateResponse) IsSetDiagnostics() bool {
+	return v != nil && v.Diagnostics != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "api",
+	Package:  "go.uber.org/thriftrw/plugin/api",
+	FilePath: "api.thrift",
+	SHA1:     "b812e74e3f022b36e9ae0ca6662b17dd02315e1c",
+	Version:  "1.21.0-dev",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "/**\n * API_VERSION is the version of the plugin API.\n *\n * This MUST be provided in the HandshakeResponse.\n */\nconst i32 API_VERSION = 5\n\n/**\n * ServiceID is an arbitrary unique identifier to reference the different\n * services in this request.\n */\ntypedef i32 ServiceID\n\n/**\n * ModuleID is an arbitrary unique identifier to reference the different\n * modules in this request.\n */\ntypedef i32 ModuleID\n\n/**\n * TypeReference is a reference to a user-defined type.\n */\nstruct TypeReference {\n    1: required string name\n    /**\n     * Import path for the package defining this type.\n     */\n    2: required string importPath\n\n    /**\n     * Annotations defined on this type.\n     *\n     * Note that these are the Thrift annotations listed after the type\n     * declaration in the Thrift file.\n     *\n     * Given,\n     *\n     *   struct User {\n     *     1: required i32 id\n     *     2: required string name\n     *   } (key = \"id\", validate)\n     *\n     * The annotations will be,\n     *\n     *   {\n     *     \"key\": \"id\",\n     *     \"validate\": \"\",\n     *   }\n     */\n    3: optional map<string, string> annotations\n\n    /**\n     * Items of the enum this refers to. This is unset if the type is not an\n     * enum.\n     */\n    4: optional list<EnumItem> enumItems\n\n    // TODO(abg): Should this just be using ModuleID instead of a package?\n}\n\n/**\n * EnumItem is an item of an enum referenced by a TypeReference.\n */\nstruct EnumItem {\n    /**\n     * Name of the Go constant generated for this item.\n     */\n    1: required string name\n    /**\n     * Name of this item in the Thrift file.\n     */\n    2: required string thriftName\n    3: required i32 value\n    /**\n     * Annotations defined on this item.\n     *\n     * Given,\n     *\n     *   enum Status {\n     *     ACTIVE,\n     *     SUSPENDED (deprecated = \"Use DISABLED instead.\"),\n     *     DISABLED,\n     *   }\n     *\n     * The annotations of SUSPENDED will be,\n     *\n     *   {\n     *     \"deprecated\": \"Use DISABLED instead.\",\n     *   }\n     */\n    4: optional map<string, string> annotations\n}\n\n/**\n * SimpleType is a standalone native Go type.\n */\nenum SimpleType {\n    BOOL = 1,     // bool\n    BYTE,         // byte\n    INT8,         // int8\n    INT16,        // int16\n    INT32,        // int32\n    INT64,        // int64\n    FLOAT64,      // float64\n    STRING,       // string\n    STRUCT_EMPTY, // struct{}\n}\n\n/**\n * TypePair is a pair of two types.\n */\nstruct TypePair {\n    1: required Type left\n    2: required Type right\n}\n\n/**\n * Type is a reference to a Go type which may be native or user defined.\n */\nunion Type {\n    1: SimpleType simpleType\n    /**\n     * Slice of a type\n     *\n     * []$sliceType\n     */\n    2: Type sliceType\n    /**\n     * Slice of key-value pairs of a pair of types.\n     *\n     * []struct{Key $left, Value $right}\n     */\n    3: TypePair keyValueSliceType\n    /**\n     * Map of a pair of types.\n     *\n     * map[$left]$right\n     */\n    4: TypePair mapType\n    /**\n     * Reference to a user-defined type.\n     */\n    5: TypeReference referenceType\n    /**\n     * Pointer to a type.\n     */\n    6: Type pointerType\n}\n\n/**\n * Argument is a single Argument inside a Function.\n * For,\n *\n *      void setValue(1: string key, 2: string value)\n *\n * You get the arguments,\n *\n *      Argument{Name: \"Key\", Type: Type{SimpleType: SimpleTypeString}}\n *\n *      Argument{Name: \"Value\", Type: Type{SimpleType: SimpleTypeString}}\n */\nstruct Argument {\n    /**\n     * Name of the argument. This is also the name of the argument field\n     * inside the args/result struct for that function.\n     */\n    1: required string name\n    /**\n     * Argument type.\n     */\n    2: required Type type\n    /**\n     * Annotations defined on this argument.\n     *\n     * Given,\n     *\n     *   void setValue(1: string key (length = \"16\"))\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"length\": \"16\",\n     *  }\n     */\n    3: optional map<string, string> annotations\n}\n\n/**\n * Function is a single function on a Thrift service.\n */\nstruct Function {\n    /**\n     * Name of the Go function.\n     */\n    1: required string name\n    /**\n     * Name of the function as defined in the Thrift file.\n     */\n    2: required string thriftName\n    /**\n     * List of arguments accepted by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    3: required list<Argument> arguments\n    /**\n     * Return type of the function, if any. If this is not set, the function\n     * is a void function.\n     */\n    4: optional Type returnType\n    /**\n     * List of exceptions raised by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    5: optional list<Argument> exceptions\n    /**\n     * Whether this function is oneway or not. This should be assumed to be\n     * false unless explicitly stated otherwise. If this is true, the\n     * returnType and exceptions will be null or empty.\n     */\n    6: optional bool oneWay\n    /**\n     * Annotations defined on this function.\n     *\n     * Given,\n     *\n     *   void setValue(1: SetValueRequest req) (cache = \"false\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    7: optional map<string, string> annotations;\n    /**\n     * Whether this function streams its results. This should be assumed to\n     * be false unless explicitly stated otherwise. If this is true,\n     * returnType is the type of each value in the stream.\n     *\n     * Given,\n     *\n     *   stream<Event> subscribe(1: string topic)\n     *\n     * The returnType will be Event.\n     */\n    8: optional bool streaming\n    /**\n     * Documentation for this function, if any, with the comment markers\n     * removed.\n     */\n    9: optional string doc\n}\n\n/**\n * Service is a service defined by the user in the Thrift file.\n */\nstruct Service {\n    /**\n     * Name of the Thrift service in Go code.\n     */\n    7: required string name\n    /**\n     * Name of the service as defined in the Thrift file.\n     */\n    1: required string thriftName\n    /**\n     * ID of the parent service, if this service extends another service.\n     *\n     * The parent service is always present in the Services of the\n     * GenerateServiceRequest, even if it's defined in a module for which\n     * code isn't being generated, so the whole inheritance chain may be\n     * followed with these IDs.\n     */\n    4: optional ServiceID parentID\n    /**\n     * List of functions defined for this service.\n     */\n    5: required list<Function> functions\n    /**\n     * ID of the module where this service was declared.\n     */\n    6: required ModuleID moduleID\n    /**\n     * Annotations defined on this service.\n     *\n     * Given,\n     *\n     *   service KeyValue {\n     *   } (private = \"true\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"private\": \"true\",\n     *  }\n     */\n    8: optional map<string, string> annotations;\n    /**\n     * Documentation for this service, if any, with the comment markers\n     * removed.\n     */\n    9: optional string doc\n}\n\n/**\n * Module is a module generated from a single Thrift file. Each module\n * corresponds to exactly one Thrift file and contains all the types and\n * constants defined in that Thrift file.\n */\nstruct Module {\n    /**\n     * Import path for the package defining the types for this module.\n     */\n    1: required string importPath\n    /**\n     * Path to the directory containing the code for this module.\n     *\n     * The path is relative to the output directory into which ThriftRW is\n     * generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     */\n    2: required string directory\n    /**\n     * Path to the Thrift file from which this module was generated.\n     */\n    3: required string thriftFilePath\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * Feature is a functionality offered by a ThriftRW plugin.\n */\nenum Feature {\n    /**\n     * SERVICE_GENERATOR specifies that the plugin may generate arbitrary code\n     * for services defined in the Thrift file.\n     *\n     * If a plugin provides this, it MUST implement the ServiceGenerator\n     * service.\n     */\n    SERVICE_GENERATOR = 1,\n\n    /**\n     * TYPE_MAPPER specifies that the plugin may replace the Go types used\n     * for fields based on their annotations.\n     *\n     * If a plugin provides this, it MUST implement the TypeMapper service.\n     */\n    TYPE_MAPPER = 2,\n\n    /**\n     * VALIDATOR specifies that the plugin may check Thrift files for\n     * problems before code is generated for them.\n     *\n     * If a plugin provides this, it MUST implement the Validator service.\n     */\n    VALIDATOR = 3,\n\n    /**\n     * POST_PROCESSOR specifies that the plugin may modify the files\n     * generated by ThriftRW and other plugins before they are written.\n     *\n     * If a plugin provides this, it MUST implement the PostProcessor service.\n     */\n    POST_PROCESSOR = 4,\n\n    // TODO: TAGGER for struct-tagging plugins\n}\n\n/**\n * HandshakeRequest is the initial request sent to the plugin as part of\n * establishing communication and feature negotiation.\n */\nstruct HandshakeRequest {\n}\n\n/**\n * HandshakeResponse is the response from the plugin for a HandshakeRequest.\n */\nstruct HandshakeResponse {\n    /**\n     * Name of the plugin. This MUST match the name of the plugin specified\n     * over the command line or the program will fail.\n     */\n    1: required string name\n    /**\n     * Version of the plugin API.\n     *\n     * This MUST be set to API_VERSION by the plugin.\n     */\n    2: required i32 apiVersion (go.name = \"APIVersion\")\n    /**\n     * List of features the plugin provides.\n     */\n    3: required list<Feature> features\n    /**\n     * Version of ThriftRW with which the plugin was built.\n     *\n     * This MUST be set to go.uber.org/thriftrw/version.Version by the plugin\n     * explicitly.\n     */\n    4: optional string libraryVersion\n}\n\n/**\n * Plugin is implemented by all plugins.\n *\n * Communication with plugins is bidirectional: while a plugin is handling a\n * request from ThriftRW, it may make requests of its own to the Generator\n * service implemented by ThriftRW. Requests and responses in either\n * direction are matched by the sequence IDs of their envelopes, so any\n * number of requests may be in flight at a time.\n */\nservice Plugin {\n    /**\n     * handshake performs a handshake with the plugin to negotiate the\n     * features provided by it and the version of the plugin API it expects.\n     */\n    HandshakeResponse handshake(1: HandshakeRequest request)\n\n    /**\n     * Informs the plugin process that it will not receive any more requests\n     * and it is safe for it to exit.\n     */\n    void goodbye()\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * GenerateServiceRequest is a request to generate code for zero or more\n * Thrift services.\n */\nstruct GenerateServiceRequest {\n    /**\n     * IDs of services for which code should be generated.\n     *\n     * Note that the services map contains information about both, the\n     * services being generated and their transitive dependencies. Code should\n     * only be generated for service IDs listed here.\n     */\n    1: required list<ServiceID> rootServices\n    /**\n     * Map of service ID to service.\n     *\n     * Any service IDs present in this request will have a corresponding\n     * service definition in this map, including services for which code does\n     * not need to be generated.\n     */\n    2: required map<ServiceID, Service> services\n    /**\n     * Map of module ID to module.\n     *\n     * Any module IDs present in the request will have a corresponding module\n     * definition in this map.\n     */\n    3: required map<ModuleID, Module> modules\n    /**\n     * Prefix for import paths of generated module. In general, plugins should\n     * not need to use the package prefix unless instantiating a new\n     * Generator for more custom plugin generation.\n     */\n    4: required string packagePrefix\n    /**\n     * Directory whose descendants contain all Thrift files. In general,\n     * plugins should not need to use the thrift root unless instantiating a\n     * new Generator for more custom plugin generation.\n     */\n    5: required string thriftRoot\n}\n\n/**\n * GenerateServiceResponse is response to a GenerateServiceRequest.\n */\nstruct GenerateServiceResponse {\n    /**\n     * Map of file path to file contents.\n     *\n     * All paths MUST be relative to the output directory into which ThriftRW\n     * is generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     *\n     * The paths MUST NOT contain the string \"..\" or the request will fail.\n     */\n    1: optional map<string, binary> files\n}\n\n/**\n * ServiceGenerator generates arbitrary code for services.\n *\n * This MUST be implemented if the SERVICE_GENERATOR feature is enabled.\n */\nservice ServiceGenerator {\n    /**\n     * Generates code for requested services.\n     */\n    GenerateServiceResponse generate(1: GenerateServiceRequest request)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * FunctionReference is a reference to a top-level Go function.\n */\nstruct FunctionReference {\n    1: required string name\n    /**\n     * Import path for the package defining this function.\n     */\n    2: required string importPath\n}\n\n/**\n * MapTypeRequest is a request to map a field to a custom Go type.\n */\nstruct MapTypeRequest {\n    /**\n     * Go type that ThriftRW would use for this field if it were required.\n     *\n     * Values of the custom type are converted to and from this type when\n     * they are serialized.\n     */\n    1: required Type type\n    /**\n     * Annotations defined on the field.\n     *\n     * Given,\n     *\n     *   struct User {\n     *     1: required string id (go.type = \"uuid.UUID\")\n     *   }\n     *\n     * The annotations will be,\n     *\n     *   {\n     *     \"go.type\": \"uuid.UUID\",\n     *   }\n     */\n    2: required map<string, string> annotations\n    /**\n     * Name of the field as defined in the Thrift file.\n     */\n    3: required string fieldName\n}\n\n/**\n * TypeMapping specifies the custom Go type for a field and how to convert\n * values of that type to and from the Go type ThriftRW would have used.\n */\nstruct TypeMapping {\n    /**\n     * Go type to use for the field.\n     *\n     * Optional fields will be generated as pointers to this type.\n     */\n    1: required Type type\n    /**\n     * Function which converts the custom type into the Go type in the\n     * request. It must have the signature,\n     *\n     *   func(Custom) (Original, error)\n     */\n    2: required FunctionReference toThrift\n    /**\n     * Function which converts the Go type in the request into the custom\n     * type. It must have the signature,\n     *\n     *   func(Original) (Custom, error)\n     */\n    3: required FunctionReference fromThrift\n    /**\n     * Function which compares two values of the custom type. It must have\n     * the signature,\n     *\n     *   func(Custom, Custom) bool\n     *\n     * If unset, values are compared using the == operator.\n     */\n    4: optional FunctionReference equals (go.name = \"EqualsFunc\")\n}\n\n/**\n * MapTypeResponse is the response to a MapTypeRequest.\n */\nstruct MapTypeResponse {\n    /**\n     * Custom type for the field. This MUST be unset if the plugin does not\n     * claim any of the annotations on the field, in which case ThriftRW will\n     * generate the field as usual.\n     */\n    1: optional TypeMapping mapping\n}\n\n/**\n * TypeMapper replaces the Go types used for fields by claiming annotations\n * on them.\n *\n * This MUST be implemented if the TYPE_MAPPER feature is enabled.\n */\nservice TypeMapper {\n    /**\n     * Maps a field to a custom Go type.\n     *\n     * This is called for every field that has at least one annotation.\n     */\n    MapTypeResponse mapType(1: MapTypeRequest request)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * DeclarationKind is the kind of a top-level declaration in a Thrift file.\n */\nenum DeclarationKind {\n    CONSTANT = 1,\n    TYPEDEF,\n    ENUM,\n    STRUCT,\n    UNION,\n    EXCEPTION,\n    SERVICE,\n}\n\n/**\n * Member is a field of a struct, union, or exception, an item of an enum,\n * or a function of a service.\n */\nstruct Member {\n    /**\n     * Name of the member as defined in the Thrift file.\n     */\n    1: required string name\n    /**\n     * Line of the Thrift file on which the member is defined.\n     */\n    2: required i32 line\n    /**\n     * Field identifier of a field, or the value of an enum item. This is\n     * unset for functions and for enum items without explicit values.\n     */\n    3: optional i32 id (go.name = \"ID\")\n    /**\n     * Whether this field is marked required. This is unset for fields which\n     * are neither required nor optional, and for other members.\n     */\n    4: optional bool isRequired\n    /**\n     * Type of the field, or the return type of the function, as written in\n     * the Thrift file. For example, \"list<string>\" or \"shared.UUID\". This is\n     * unset for enum items and void functions.\n     */\n    5: optional string type\n    /**\n     * Annotations defined on this member.\n     */\n    6: optional map<string, string> annotations\n    /**\n     * Documentation for this member, if any, with the comment markers\n     * removed.\n     */\n    7: optional string doc\n}\n\n/**\n * Declaration is a top-level declaration in a Thrift file.\n */\nstruct Declaration {\n    1: required DeclarationKind kind\n    /**\n     * Name of the declaration as defined in the Thrift file.\n     */\n    2: required string name\n    /**\n     * Path to the Thrift file which contains this declaration.\n     */\n    3: required string thriftFilePath\n    /**\n     * Line of the Thrift file on which the declaration starts.\n     */\n    4: required i32 line\n    /**\n     * Annotations defined on this declaration.\n     */\n    5: optional map<string, string> annotations\n    /**\n     * Fields, enum items, or functions of this declaration, in the order in\n     * which they are defined in the Thrift file.\n     */\n    6: optional list<Member> members\n    /**\n     * Documentation for this declaration, if any, with the comment markers\n     * removed.\n     */\n    7: optional string doc\n    /**\n     * Type of a constant, or the type aliased by a typedef, as written in the\n     * Thrift file.\n     */\n    8: optional string type\n}\n\n/**\n * ValidateRequest is a request to check Thrift files for problems.\n */\nstruct ValidateRequest {\n    /**\n     * Paths to the Thrift files being checked.\n     */\n    1: required list<string> thriftFilePaths\n    /**\n     * Top-level declarations of these Thrift files, in the order in which\n     * they are defined.\n     */\n    2: required list<Declaration> declarations\n}\n\n/**\n * Diagnostic is a problem found in a Thrift file.\n */\nstruct Diagnostic {\n    /**\n     * Path to the Thrift file which has the problem. This SHOULD be one of\n     * the thriftFilePaths of the request.\n     */\n    1: required string thriftFilePath\n    /**\n     * Line on which the problem was found, or 0 if it applies to the whole\n     * file.\n     */\n    2: required i32 line\n    /**\n     * Description of the problem.\n     */\n    3: required string message\n    /**\n     * Name of the rule which found the problem, if any.\n     */\n    4: optional string rule\n}\n\n/**\n * ValidateResponse is the response to a ValidateRequest.\n */\nstruct ValidateResponse {\n    /**\n     * Problems found in the Thrift files. Code is not generated if any\n     * problems are reported.\n     */\n    1: optional list<Diagnostic> diagnostics\n}\n\n/**\n * Validator checks Thrift files for problems, allowing organizations to\n * enforce their own policies for Thrift files.\n *\n * This MUST be implemented if the VALIDATOR feature is enabled.\n */\nservice Validator {\n    /**\n     * Checks the requested Thrift files for problems.\n     */\n    ValidateResponse validate(1: ValidateRequest request)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * PostProcessRequest is a request to modify generated files before they are\n * written.\n */\nstruct PostProcessRequest {\n    /**\n     * Map of file path to file contents for all files about to be written,\n     * including those generated by ServiceGenerators.\n     *\n     * Paths are relative to the output directory into which ThriftRW is\n     * generating code.\n     */\n    1: required map<string, binary> files\n    /**\n     * Prefix for import paths of generated modules.\n     */\n    2: required string packagePrefix\n}\n\n/**\n * PostProcessResponse is the response to a PostProcessRequest.\n */\nstruct PostProcessResponse {\n    /**\n     * Map of file path to file contents for files which should be written\n     * in place of the files in the request, or in addition to them. Files of\n     * the request that are not listed here are written unchanged.\n     *\n     * The paths MUST NOT contain the string \"..\" or the request will fail.\n     */\n    1: optional map<string, binary> files\n    /**\n     * Paths of files in the request which should not be written at all.\n     */\n    2: optional list<string> removedFiles\n}\n\n/**\n * PostProcessor modifies generated files before they are written. It may\n * add build tags, rewrite imports, append code to generated files, or drop\n * files entirely.\n *\n * When multiple plugins implement PostProcessor, they are called one after\n * another, in the order in which the plugins were specified, and each\n * receives the files produced by the previous one.\n *\n * This MUST be implemented if the POST_PROCESSOR feature is enabled.\n */\nservice PostProcessor {\n    /**\n     * Modifies the requested files.\n     */\n    PostProcessResponse process(1: PostProcessRequest request)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * ResolveTypeRequest is a request to resolve a Thrift type by name.\n */\nstruct ResolveTypeRequest {\n    /**\n     * Path to the Thrift file from which the type is referenced. This is the\n     * thriftFilePath of one of the modules ThriftRW provided to the plugin.\n     */\n    1: required string thriftFilePath\n    /**\n     * Name of the type as it would be referenced from that Thrift file.\n     * Types defined in included files are referenced with the name of the\n     * include as the prefix, for example, \"shared.UUID\".\n     */\n    2: required string name\n}\n\n/**\n * ResolveTypeResponse is the response to a ResolveTypeRequest.\n */\nstruct ResolveTypeResponse {\n    /**\n     * Go type used by ThriftRW for required fields of the requested type.\n     */\n    1: required Type type\n}\n\n/**\n * Generator is implemented by ThriftRW. Plugins may call it while they are\n * handling a request from ThriftRW to learn more about the code being\n * generated.\n */\nservice Generator {\n    /**\n     * Resolves a Thrift type to the Go type used for it.\n     */\n    ResolveTypeResponse resolveType(1: ResolveTypeRequest request)\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule)
+}
+
+// Generator_ResolveType_Args represents the arguments for the Generator.resolveType function.
+//
+// Resolves a Thrift type to the Go type used for it.
+//
+// The arguments for resolveType are sent and received over the wire as this struct.
+type Generator_ResolveType_Args struct {
+	Request *ResolveTypeRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a Generator_ResolveType_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Generator_ResolveType_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ResolveTypeRequest_Read(w wire.Value) (*ResolveTypeRequest, error) {
+	var v ResolveTypeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Generator_ResolveType_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Generator_ResolveType_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Generator_ResolveType_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Generator_ResolveType_Args) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ResolveTypeRequest_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return missing.Err()
+}
+
+func _ResolveTypeRequest_Decode(sr stream.Reader) (*ResolveTypeRequest, error) {
+	var v ResolveTypeRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Generator_ResolveType_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _ResolveTypeRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Generator_ResolveType_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Generator_ResolveType_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Request == nil) {
+		b, err := json.Marshal(v.Request)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"request":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Generator_ResolveType_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Generator_ResolveType_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["request"]; ok {
+		if err := json.Unmarshal(r, &v.Request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Generator_ResolveType_Args
+// struct.
+func (v *Generator_ResolveType_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("Generator_ResolveType_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Generator_ResolveType_Args match the
+// provided Generator_ResolveType_Args.
+//
+// This function performs a deep comparison.
+func (v *Generator_ResolveType_Args) Equals(rhs *Generator_ResolveType_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Generator_ResolveType_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Generator_ResolveType_Args) Clone() *Generator_ResolveType_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c Generator_ResolveType_Args
+	c.Request = v.Request.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Generator_ResolveType_Args.
+func (v *Generator_ResolveType_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *Generator_ResolveType_Args) GetRequest() (o *ResolveTypeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *Generator_ResolveType_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "resolveType" for this struct.
+func (v *Generator_ResolveType_Args) MethodName() string {
+	return "resolveType"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Generator_ResolveType_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Generator_ResolveType_Helper provides functions that aid in handling the
+// parameters and return values of the Generator.resolveType
+// function.
+var Generator_ResolveType_Helper = struct {
+	// Args accepts the parameters of resolveType in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ResolveTypeRequest,
+	) *Generator_ResolveType_Args
+
+	// IsException returns true if the given error can be thrown
+	// by resolveType.
+	//
+	// An error can be thrown by resolveType only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for resolveType
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// resolveType into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by resolveType
+	//
+	//   value, err := resolveType(args)
+	//   result, err := Generator_ResolveType_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from resolveType: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*ResolveTypeResponse, error) (*Generator_ResolveType_Result, error)
+
+	// UnwrapResponse takes the result struct for resolveType
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if resolveType threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Generator_ResolveType_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Generator_ResolveType_Result) (*ResolveTypeResponse, error)
+}{}
+
+func init() {
+	Generator_ResolveType_Helper.Args = func(
+		request *ResolveTypeRequest,
+	) *Generator_ResolveType_Args {
+		return &Generator_ResolveType_Args{
+			Request: request,
+		}
+	}
+
+	Generator_ResolveType_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Generator_ResolveType_Helper.WrapResponse = func(success *ResolveTypeResponse, err error) (*Generator_ResolveType_Result, error) {
+		if err == nil {
+			return &Generator_ResolveType_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Generator_ResolveType_Helper.UnwrapResponse = func(result *Generator_ResolveType_Result) (success *ResolveTypeResponse, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Generator_ResolveType_Result represents the result of a Generator.resolveType function call.
+//
+// The result of a resolveType execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Generator_ResolveType_Result struct {
+	// Value returned by resolveType after a successful execution.
+	Success *ResolveTypeResponse `json:"success,omitempty"`
+}
+
+// ToWire translates a Generator_ResolveType_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Generator_ResolveType_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Generator_ResolveType_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ResolveTypeResponse_Read(w wire.Value) (*ResolveTypeResponse, error) {
+	var v ResolveTypeResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Generator_ResolveType_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Generator_ResolveType_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Generator_ResolveType_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Generator_ResolveType_Result) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ResolveTypeResponse_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Generator_ResolveType_Result should have exactly one field: got %v fields", count)
+	}
+
+	return missing.Err()
+}
+
+func _ResolveTypeResponse_Decode(sr stream.Reader) (*ResolveTypeResponse, error) {
+	var v ResolveTypeResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Generator_ResolveType_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _ResolveTypeResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Generator_ResolveType_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Generator_ResolveType_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Generator_ResolveType_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Generator_ResolveType_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Generator_ResolveType_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Generator_ResolveType_Result
+// struct.
+func (v *Generator_ResolveType_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Generator_ResolveType_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Generator_ResolveType_Result match the
+// provided Generator_ResolveType_Result.
+//
+// This function performs a deep comparison.
+func (v *Generator_ResolveType_Result) Equals(rhs *Generator_ResolveType_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Generator_ResolveType_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Generator_ResolveType_Result) Clone() *Generator_ResolveType_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c Generator_ResolveType_Result
+	c.Success = v.Success.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Generator_ResolveType_Result.
+func (v *Generator_ResolveType_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Generator_ResolveType_Result) GetSuccess() (o *ResolveTypeResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Generator_ResolveType_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "resolveType" for this struct.
+func (v *Generator_ResolveType_Result) MethodName() string {
+	return "resolveType"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Generator_ResolveType_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Generator_Errors maps the names of exceptions thrown by functions
+// of the Generator service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := Generator_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var Generator_Errors = map[string]func() error{}
+
+// Plugin_Goodbye_Args represents the arguments for the Plugin.goodbye function.
+//
+// Informs the plugin process that it will not receive any more requests
+// and it is safe for it to exit.
+//
+// The arguments for goodbye are sent and received over the wire as this struct.
+type Plugin_Goodbye_Args struct {
+}
+
+// ToWire translates a Plugin_Goodbye_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Plugin_Goodbye_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Plugin_Goodbye_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Plugin_Goodbye_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Plugin_Goodbye_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Plugin_Goodbye_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+func (v *Plugin_Goodbye_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Plugin_Goodbye_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Plugin_Goodbye_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a Plugin_Goodbye_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Plugin_Goodbye_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Plugin_Goodbye_Args
+// struct.
+func (v *Plugin_Goodbye_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Plugin_Goodbye_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Plugin_Goodbye_Args match the
+// provided Plugin_Goodbye_Args.
+//
+// This function performs a deep comparison.
+func (v *Plugin_Goodbye_Args) Equals(rhs *Plugin_Goodbye_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Plugin_Goodbye_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Plugin_Goodbye_Args) Clone() *Plugin_Goodbye_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c Plugin_Goodbye_Args
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Plugin_Goodbye_Args.
+func (v *Plugin_Goodbye_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "goodbye" for this struct.
+func (v *Plugin_Goodbye_Args) MethodName() string {
+	return "goodbye"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Plugin_Goodbye_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Plugin_Goodbye_Helper provides functions that aid in handling the
+// parameters and return values of the Plugin.goodbye
+// function.
+var Plugin_Goodbye_Helper = struct {
+	// Args accepts the parameters of goodbye in-order and returns
+	// the arguments struct for the function.
+	Args func() *Plugin_Goodbye_Args
+
+	// IsException returns true if the given error can be thrown
+	// by goodbye.
+	//
+	// An error can be thrown by goodbye only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for goodbye
+	// given the error returned by it. The provided error may
+	// be nil if goodbye did not fail.
+	//
+	// This allows mapping errors returned by goodbye into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// goodbye
+	//
+	//   err := goodbye(args)
+	//   result, err := Plugin_Goodbye_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from goodbye: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*Plugin_Goodbye_Result, error)
+
+	// UnwrapResponse takes the result struct for goodbye
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if goodbye threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := Plugin_Goodbye_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Plugin_Goodbye_Result) error
+}{}
+
+func init() {
+	Plugin_Goodbye_Helper.Args = func() *Plugin_Goodbye_Args {
+		return &Plugin_Goodbye_Args{}
+	}
+
+	Plugin_Goodbye_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Plugin_Goodbye_Helper.WrapResponse = func(err error) (*Plugin_Goodbye_Result, error) {
+		if err == nil {
+			return &Plugin_Goodbye_Result{}, nil
+		}
+
+		return nil, err
+	}
+	Plugin_Goodbye_Helper.UnwrapResponse = func(result *Plugin_Goodbye_Result) (err error) {
+		return
+	}
+
+}
+
+// Plugin_Goodbye_Result represents the result of a Plugin.goodbye function call.
+//
+// The result of a goodbye execution is sent and received over the wire as this struct.
+type Plugin_Goodbye_Result struct {
+}
+
+// ToWire translates a Plugin_Goodbye_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Plugin_Goodbye_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Plugin_Goodbye_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Plugin_Goodbye_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Plugin_Goodbye_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Plugin_Goodbye_Result) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+func (v *Plugin_Goodbye_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Plugin_Goodbye_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Plugin_Goodbye_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON deserializes a Plugin_Goodbye_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Plugin_Goodbye_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Plugin_Goodbye_Result
+// struct.
+func (v *Plugin_Goodbye_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Plugin_Goodbye_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Plugin_Goodbye_Result match the
+// provided Plugin_Goodbye_Result.
+//
+// This function performs a deep comparison.
+func (v *Plugin_Goodbye_Result) Equals(rhs *Plugin_Goodbye_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Plugin_Goodbye_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Plugin_Goodbye_Result) Clone() *Plugin_Goodbye_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c Plugin_Goodbye_Result
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Plugin_Goodbye_Result.
+func (v *Plugin_Goodbye_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "goodbye" for this struct.
+func (v *Plugin_Goodbye_Result) MethodName() string {
+	return "goodbye"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Plugin_Goodbye_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Plugin_Handshake_Args represents the arguments for the Plugin.handshake function.
+//
+// handshake performs a handshake with the plugin to negotiate the
+// features provided by it and the version of the plugin API it expects.
+//
+// The arguments for handshake are sent and received over the wire as this struct.
+type Plugin_Handshake_Args struct {
+	Request *HandshakeRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a Plugin_Handshake_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Plugin_Handshake_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SimpleType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v SimpleType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 1:
-		enc.AddString("name", "BOOL")
-	case 2:
-		enc.AddString("name", "BYTE")
-	case 3:
-		enc.AddString("name", "INT8")
-	case 4:
-		enc.AddString("name", "INT16")
-	case 5:
-		enc.AddString("name", "INT32")
-	case 6:
-		enc.AddString("name", "INT64")
-	case 7:
-		enc.AddString("name", "FLOAT64")
-	case 8:
-		enc.AddString("name", "STRING")
-	case 9:
-		enc.AddString("name", "STRUCT_EMPTY")
+func _HandshakeRequest_Read(w wire.Value) (*HandshakeRequest, error) {
+	var v HandshakeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Plugin_Handshake_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Plugin_Handshake_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Plugin_Handshake_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Plugin_Handshake_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _HandshakeRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
+
 	return nil
 }
 
-// Ptr returns a pointer to this enum value.
-func (v SimpleType) Ptr() *SimpleType {
-	return &v
+func _HandshakeRequest_Decode(sr stream.Reader) (*HandshakeRequest, error) {
+	var v HandshakeRequest
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// ToWire translates SimpleType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+func (v *Plugin_Handshake_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _HandshakeRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a Plugin_Handshake_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v SimpleType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+// This implements json.Marshaler.
+func (v *Plugin_Handshake_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Request == nil) {
+		b, err := json.Marshal(v.Request)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"request":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
 }
 
-// FromWire deserializes SimpleType from its Thrift-level
-// representation.
+// UnmarshalJSON deserializes a Plugin_Handshake_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return SimpleType(0), err
-//   }
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
 //
-//   var v SimpleType
-//   if err := v.FromWire(x); err != nil {
-//     return SimpleType(0), err
-//   }
-//   return v, nil
-func (v *SimpleType) FromWire(w wire.Value) error {
-	*v = (SimpleType)(w.GetI32())
+// This implements json.Unmarshaler.
+func (v *Plugin_Handshake_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["request"]; ok {
+		if err := json.Unmarshal(r, &v.Request); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of SimpleType.
-func (v SimpleType) String() string {
-	w := int32(v)
-	switch w {
-	case 1:
-		return "BOOL"
-	case 2:
-		return "BYTE"
-	case 3:
-		return "INT8"
-	case 4:
-		return "INT16"
-	case 5:
-		return "INT32"
-	case 6:
-		return "INT64"
-	case 7:
-		return "FLOAT64"
-	case 8:
-		return "STRING"
-	case 9:
-		return "STRUCT_EMPTY"
+// String returns a readable string representation of a Plugin_Handshake_Args
+// struct.
+func (v *Plugin_Handshake_Args) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return fmt.Sprintf("SimpleType(%d)", w)
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("Plugin_Handshake_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if this SimpleType value matches the provided
-// value.
-func (v SimpleType) Equals(rhs SimpleType) bool {
-	return v == rhs
+// Equals returns true if all the fields of this Plugin_Handshake_Args match the
+// provided Plugin_Handshake_Args.
+//
+// This function performs a deep comparison.
+func (v *Plugin_Handshake_Args) Equals(rhs *Plugin_Handshake_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
 }
 
-// MarshalJSON serializes SimpleType into JSON.
+// Clone returns a deep copy of this Plugin_Handshake_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Plugin_Handshake_Args) Clone() *Plugin_Handshake_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c Plugin_Handshake_Args
+	c.Request = v.Request.Clone()
+
+	return &c
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Plugin_Handshake_Args.
+func (v *Plugin_Handshake_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *Plugin_Handshake_Args) GetRequest() (o *HandshakeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *Plugin_Handshake_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
 //
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
+// This will always be "handshake" for this struct.
+func (v *Plugin_Handshake_Args) MethodName() string {
+	return "handshake"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
 //
-// This implements json.Marshaler.
-func (v SimpleType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 1:
-		return ([]byte)("\"BOOL\""), nil
-	case 2:
-		return ([]byte)("\"BYTE\""), nil
-	case 3:
-		return ([]byte)("\"INT8\""), nil
-	case 4:
-		return ([]byte)("\"INT16\""), nil
-	case 5:
-		return ([]byte)("\"INT32\""), nil
-	case 6:
-		return ([]byte)("\"INT64\""), nil
-	case 7:
-		return ([]byte)("\"FLOAT64\""), nil
-	case 8:
-		return ([]byte)("\"STRING\""), nil
-	case 9:
-		return ([]byte)("\"STRUCT_EMPTY\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+// This will always be Call for this struct.
+func (v *Plugin_Handshake_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// UnmarshalJSON attempts to decode SimpleType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *SimpleType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
+// Plugin_Handshake_Helper provides functions that aid in handling the
+// parameters and return values of the Plugin.handshake
+// function.
+var Plugin_Handshake_Helper = struct {
+	// Args accepts the parameters of handshake in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *HandshakeRequest,
+	) *Plugin_Handshake_Args
+
+	// IsException returns true if the given error can be thrown
+	// by handshake.
+	//
+	// An error can be thrown by handshake only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for handshake
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// handshake into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by handshake
+	//
+	//   value, err := handshake(args)
+	//   result, err := Plugin_Handshake_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from handshake: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*HandshakeResponse, error) (*Plugin_Handshake_Result, error)
+
+	// UnwrapResponse takes the result struct for handshake
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if handshake threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Plugin_Handshake_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Plugin_Handshake_Result) (*HandshakeResponse, error)
+}{}
+
+func init() {
+	Plugin_Handshake_Helper.Args = func(
+		request *HandshakeRequest,
+	) *Plugin_Handshake_Args {
+		return &Plugin_Handshake_Args{
+			Request: request,
+		}
 	}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
+	Plugin_Handshake_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
 		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "SimpleType")
+	}
+
+	Plugin_Handshake_Helper.WrapResponse = func(success *HandshakeResponse, err error) (*Plugin_Handshake_Result, error) {
+		if err == nil {
+			return &Plugin_Handshake_Result{Success: success}, nil
 		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "SimpleType")
+
+		return nil, err
+	}
+	Plugin_Handshake_Helper.UnwrapResponse = func(result *Plugin_Handshake_Result) (success *HandshakeResponse, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
 		}
-		*v = (SimpleType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "SimpleType")
+
+		err = errors.New("expected a non-void result")
+		return
 	}
+
 }
 
-// Type is a reference to a Go type which may be native or user defined.
-type Type struct {
-	SimpleType *SimpleType `json:"simpleType,omitempty"`
-	// Slice of a type
-	//
-	// []$sliceType
-	SliceType *Type `json:"sliceType,omitempty"`
-	// Slice of key-value pairs of a pair of types.
-	//
-	// []struct{Key $left, Value $right}
-	KeyValueSliceType *TypePair `json:"keyValueSliceType,omitempty"`
-	// Map of a pair of types.
-	//
-	// map[$left]$right
-	MapType *TypePair `json:"mapType,omitempty"`
-	// Reference to a user-defined type.
-	ReferenceType *TypeReference `json:"referenceType,omitempty"`
-	// Pointer to a type.
-	PointerType *Type `json:"pointerType,omitempty"`
+// Plugin_Handshake_Result represents the result of a Plugin.handshake function call.
+//
+// The result of a handshake execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Plugin_Handshake_Result struct {
+	// Value returned by handshake after a successful execution.
+	Success *HandshakeResponse `json:"success,omitempty"`
 }
 
-// ToWire translates a Type struct into a Thrift-level intermediate
+// ToWire translates a Plugin_Handshake_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3315,93 +15211,41 @@ type Type struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Type) ToWire() (wire.Value, error) {
+func (v *Plugin_Handshake_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.SimpleType != nil {
-		w, err = v.SimpleType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
-	}
-	if v.SliceType != nil {
-		w, err = v.SliceType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 2, Value: w}
-		i++
-	}
-	if v.KeyValueSliceType != nil {
-		w, err = v.KeyValueSliceType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.MapType != nil {
-		w, err = v.MapType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.ReferenceType != nil {
-		w, err = v.ReferenceType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.PointerType != nil {
-		w, err = v.PointerType.ToWire()
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("Type should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("Plugin_Handshake_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SimpleType_Read(w wire.Value) (SimpleType, error) {
-	var v SimpleType
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _TypePair_Read(w wire.Value) (*TypePair, error) {
-	var v TypePair
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TypeReference_Read(w wire.Value) (*TypeReference, error) {
-	var v TypeReference
+func _HandshakeResponse_Read(w wire.Value) (*HandshakeResponse, error) {
+	var v HandshakeResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a Type struct from its Thrift-level
+// FromWire deserializes a Plugin_Handshake_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Type struct
+// An error is returned if we were unable to build a Plugin_Handshake_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3409,62 +15253,22 @@ func _TypeReference_Read(w wire.Value) (*TypeReference, error) {
 //     return nil, err
 //   }
 //
-//   var v Type
+//   var v Plugin_Handshake_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Type) FromWire(w wire.Value) error {
+func (v *Plugin_Handshake_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TI32 {
-				var x SimpleType
-				x, err = _SimpleType_Read(field.Value)
-				v.SimpleType = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 2:
-			if field.Value.Type() == wire.TStruct {
-				v.SliceType, err = _Type_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.KeyValueSliceType, err = _TypePair_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.MapType, err = _TypePair_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.ReferenceType, err = _TypeReference_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 6:
+		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.PointerType, err = _Type_Read(field.Value)
-				if err != nil {
+				v.Success, err = _HandshakeResponse_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -3473,234 +15277,231 @@ func (v *Type) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.SimpleType != nil {
+	if v.Success != nil {
 		count++
 	}
-	if v.SliceType != nil {
-		count++
+	if count != 1 {
+		return fmt.Errorf("Plugin_Handshake_Result should have exactly one field: got %v fields", count)
 	}
-	if v.KeyValueSliceType != nil {
-		count++
+
+	return missing.Err()
+}
+
+func _HandshakeResponse_Decode(sr stream.Reader) (*HandshakeResponse, error) {
+	var v HandshakeResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Plugin_Handshake_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
-	if v.MapType != nil {
-		count++
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _HandshakeResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
-	if v.ReferenceType != nil {
-		count++
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
-	if v.PointerType != nil {
+
+	count := 0
+	if v.Success != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("Type should have exactly one field: got %v fields", count)
+		return fmt.Errorf("Plugin_Handshake_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a Type
-// struct.
-func (v *Type) String() string {
+// MarshalJSON serializes a Plugin_Handshake_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Plugin_Handshake_Result) MarshalJSON() ([]byte, error) {
 	if v == nil {
-		return "<nil>"
+		return []byte("null"), nil
 	}
 
-	var fields [6]string
-	i := 0
-	if v.SimpleType != nil {
-		fields[i] = fmt.Sprintf("SimpleType: %v", *(v.SimpleType))
-		i++
-	}
-	if v.SliceType != nil {
-		fields[i] = fmt.Sprintf("SliceType: %v", v.SliceType)
-		i++
-	}
-	if v.KeyValueSliceType != nil {
-		fields[i] = fmt.Sprintf("KeyValueSliceType: %v", v.KeyValueSliceType)
-		i++
-	}
-	if v.MapType != nil {
-		fields[i] = fmt.Sprintf("MapType: %v", v.MapType)
-		i++
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
 	}
-	if v.ReferenceType != nil {
-		fields[i] = fmt.Sprintf("ReferenceType: %v", v.ReferenceType)
-		i++
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Plugin_Handshake_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Plugin_Handshake_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
 	}
-	if v.PointerType != nil {
-		fields[i] = fmt.Sprintf("PointerType: %v", v.PointerType)
-		i++
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
+		}
 	}
 
-	return fmt.Sprintf("Type{%v}", strings.Join(fields[:i], ", "))
+	return nil
 }
 
-func _SimpleType_EqualsPtr(lhs, rhs *SimpleType) bool {
-	if lhs != nil && rhs != nil {
+// String returns a readable string representation of a Plugin_Handshake_Result
+// struct.
+func (v *Plugin_Handshake_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
 	}
-	return lhs == nil && rhs == nil
+
+	return fmt.Sprintf("Plugin_Handshake_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Type match the
-// provided Type.
+// Equals returns true if all the fields of this Plugin_Handshake_Result match the
+// provided Plugin_Handshake_Result.
 //
 // This function performs a deep comparison.
-func (v *Type) Equals(rhs *Type) bool {
+func (v *Plugin_Handshake_Result) Equals(rhs *Plugin_Handshake_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_SimpleType_EqualsPtr(v.SimpleType, rhs.SimpleType) {
-		return false
-	}
-	if !((v.SliceType == nil && rhs.SliceType == nil) || (v.SliceType != nil && rhs.SliceType != nil && v.SliceType.Equals(rhs.SliceType))) {
-		return false
-	}
-	if !((v.KeyValueSliceType == nil && rhs.KeyValueSliceType == nil) || (v.KeyValueSliceType != nil && rhs.KeyValueSliceType != nil && v.KeyValueSliceType.Equals(rhs.KeyValueSliceType))) {
-		return false
-	}
-	if !((v.MapType == nil && rhs.MapType == nil) || (v.MapType != nil && rhs.MapType != nil && v.MapType.Equals(rhs.MapType))) {
-		return false
-	}
-	if !((v.ReferenceType == nil && rhs.ReferenceType == nil) || (v.ReferenceType != nil && rhs.ReferenceType != nil && v.ReferenceType.Equals(rhs.ReferenceType))) {
-		return false
-	}
-	if !((v.PointerType == nil && rhs.PointerType == nil) || (v.PointerType != nil && rhs.PointerType != nil && v.PointerType.Equals(rhs.PointerType))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
 
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Type.
-func (v *Type) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// Clone returns a deep copy of this Plugin_Handshake_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Plugin_Handshake_Result) Clone() *Plugin_Handshake_Result {
 	if v == nil {
 		return nil
 	}
-	if v.SimpleType != nil {
-		err = multierr.Append(err, enc.AddObject("simpleType", *v.SimpleType))
-	}
-	if v.SliceType != nil {
-		err = multierr.Append(err, enc.AddObject("sliceType", v.SliceType))
-	}
-	if v.KeyValueSliceType != nil {
-		err = multierr.Append(err, enc.AddObject("keyValueSliceType", v.KeyValueSliceType))
-	}
-	if v.MapType != nil {
-		err = multierr.Append(err, enc.AddObject("mapType", v.MapType))
-	}
-	if v.ReferenceType != nil {
-		err = multierr.Append(err, enc.AddObject("referenceType", v.ReferenceType))
-	}
-	if v.PointerType != nil {
-		err = multierr.Append(err, enc.AddObject("pointerType", v.PointerType))
-	}
-	return err
-}
 
-// GetSimpleType returns the value of SimpleType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetSimpleType() (o SimpleType) {
-	if v != nil && v.SimpleType != nil {
-		return *v.SimpleType
-	}
-
-	return
-}
+	var c Plugin_Handshake_Result
+	c.Success = v.Success.Clone()
 
-// IsSetSimpleType returns true if SimpleType is not nil.
-func (v *Type) IsSetSimpleType() bool {
-	return v != nil && v.SimpleType != nil
+	return &c
 }
 
-// GetSliceType returns the value of SliceType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetSliceType() (o *Type) {
-	if v != nil && v.SliceType != nil {
-		return v.SliceType
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Plugin_Handshake_Result.
+func (v *Plugin_Handshake_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-
-	return
-}
-
-// IsSetSliceType returns true if SliceType is not nil.
-func (v *Type) IsSetSliceType() bool {
-	return v != nil && v.SliceType != nil
-}
-
-// GetKeyValueSliceType returns the value of KeyValueSliceType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetKeyValueSliceType() (o *TypePair) {
-	if v != nil && v.KeyValueSliceType != nil {
-		return v.KeyValueSliceType
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-
-	return
-}
-
-// IsSetKeyValueSliceType returns true if KeyValueSliceType is not nil.
-func (v *Type) IsSetKeyValueSliceType() bool {
-	return v != nil && v.KeyValueSliceType != nil
+	return err
 }
 
-// GetMapType returns the value of MapType if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *Type) GetMapType() (o *TypePair) {
-	if v != nil && v.MapType != nil {
-		return v.MapType
+func (v *Plugin_Handshake_Result) GetSuccess() (o *HandshakeResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetMapType returns true if MapType is not nil.
-func (v *Type) IsSetMapType() bool {
-	return v != nil && v.MapType != nil
-}
-
-// GetReferenceType returns the value of ReferenceType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetReferenceType() (o *TypeReference) {
-	if v != nil && v.ReferenceType != nil {
-		return v.ReferenceType
-	}
-
-	return
+// IsSetSuccess returns true if Success is not nil.
+func (v *Plugin_Handshake_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// IsSetReferenceType returns true if ReferenceType is not nil.
-func (v *Type) IsSetReferenceType() bool {
-	return v != nil && v.ReferenceType != nil
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "handshake" for this struct.
+func (v *Plugin_Handshake_Result) MethodName() string {
+	return "handshake"
 }
 
-// GetPointerType returns the value of PointerType if it is set or its
-// zero value if it is unset.
-func (v *Type) GetPointerType() (o *Type) {
-	if v != nil && v.PointerType != nil {
-		return v.PointerType
-	}
-
-	return
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Plugin_Handshake_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
 }
 
-// IsSetPointerType returns true if PointerType is not nil.
-func (v *Type) IsSetPointerType() bool {
-	return v != nil && v.PointerType != nil
-}
+// Plugin_Errors maps the names of exceptions thrown by functions
+// of the Plugin service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := Plugin_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var Plugin_Errors = map[string]func() error{}
 
-// TypePair is a pair of two types.
-type TypePair struct {
-	Left  *Type `json:"left,required"`
-	Right *Type `json:"right,required"`
+// PostProcessor_Process_Args represents the arguments for the PostProcessor.process function.
+//
+// Modifies the requested files.
+//
+// The arguments for process are sent and received over the wire as this struct.
+type PostProcessor_Process_Args struct {
+	Request *PostProcessRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a TypePair struct into a Thrift-level intermediate
+// ToWire translates a PostProcessor_Process_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3715,41 +15516,37 @@ type TypePair struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TypePair) ToWire() (wire.Value, error) {
+func (v *PostProcessor_Process_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Left == nil {
-		return w, errors.New("field Left of TypePair is required")
-	}
-	w, err = v.Left.ToWire()
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-	if v.Right == nil {
-		return w, errors.New("field Right of TypePair is required")
-	}
-	w, err = v.Right.ToWire()
-	if err != nil {
-		return w, err
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TypePair struct from its Thrift-level
+func _PostProcessRequest_Read(w wire.Value) (*PostProcessRequest, error) {
+	var v PostProcessRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PostProcessor_Process_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TypePair struct
+// An error is returned if we were unable to build a PostProcessor_Process_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3757,152 +15554,311 @@ func (v *TypePair) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TypePair
+//   var v PostProcessor_Process_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TypePair) FromWire(w wire.Value) error {
+func (v *PostProcessor_Process_Args) FromWire(w wire.Value) error {
 	var err error
 
-	leftIsSet := false
-	rightIsSet := false
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Left, err = _Type_Read(field.Value)
-				if err != nil {
+				v.Request, err = _PostProcessRequest_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
-				leftIsSet = true
+
 			}
-		case 2:
-			if field.Value.Type() == wire.TStruct {
-				v.Right, err = _Type_Read(field.Value)
-				if err != nil {
-					return err
-				}
-				rightIsSet = true
+		}
+	}
+
+	return missing.Err()
+}
+
+func _PostProcessRequest_Decode(sr stream.Reader) (*PostProcessRequest, error) {
+	var v PostProcessRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *PostProcessor_Process_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _PostProcessRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
 			}
 		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	if !leftIsSet {
-		return errors.New("field Left of TypePair is required")
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
-	if !rightIsSet {
-		return errors.New("field Right of TypePair is required")
+	return nil
+}
+
+// MarshalJSON serializes a PostProcessor_Process_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PostProcessor_Process_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Request == nil) {
+		b, err := json.Marshal(v.Request)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"request":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PostProcessor_Process_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PostProcessor_Process_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["request"]; ok {
+		if err := json.Unmarshal(r, &v.Request); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a TypePair
+// String returns a readable string representation of a PostProcessor_Process_Args
 // struct.
-func (v *TypePair) String() string {
+func (v *PostProcessor_Process_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	fields[i] = fmt.Sprintf("Left: %v", v.Left)
-	i++
-	fields[i] = fmt.Sprintf("Right: %v", v.Right)
-	i++
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
 
-	return fmt.Sprintf("TypePair{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PostProcessor_Process_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TypePair match the
-// provided TypePair.
+// Equals returns true if all the fields of this PostProcessor_Process_Args match the
+// provided PostProcessor_Process_Args.
 //
 // This function performs a deep comparison.
-func (v *TypePair) Equals(rhs *TypePair) bool {
+func (v *PostProcessor_Process_Args) Equals(rhs *PostProcessor_Process_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !v.Left.Equals(rhs.Left) {
-		return false
-	}
-	if !v.Right.Equals(rhs.Right) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this PostProcessor_Process_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PostProcessor_Process_Args) Clone() *PostProcessor_Process_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c PostProcessor_Process_Args
+	c.Request = v.Request.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TypePair.
-func (v *TypePair) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PostProcessor_Process_Args.
+func (v *PostProcessor_Process_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	err = multierr.Append(err, enc.AddObject("left", v.Left))
-	err = multierr.Append(err, enc.AddObject("right", v.Right))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
 	return err
 }
 
-// GetLeft returns the value of Left if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *TypePair) GetLeft() (o *Type) {
-	if v != nil {
-		o = v.Left
+func (v *PostProcessor_Process_Args) GetRequest() (o *PostProcessRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
+
 	return
 }
 
-// IsSetLeft returns true if Left is not nil.
-func (v *TypePair) IsSetLeft() bool {
-	return v != nil && v.Left != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *PostProcessor_Process_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-// GetRight returns the value of Right if it is set or its
-// zero value if it is unset.
-func (v *TypePair) GetRight() (o *Type) {
-	if v != nil {
-		o = v.Right
-	}
-	return
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "process" for this struct.
+func (v *PostProcessor_Process_Args) MethodName() string {
+	return "process"
 }
 
-// IsSetRight returns true if Right is not nil.
-func (v *TypePair) IsSetRight() bool {
-	return v != nil && v.Right != nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *PostProcessor_Process_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// TypeReference is a reference to a user-defined type.
-type TypeReference struct {
-	Name string `json:"name,required"`
-	// Import path for the package defining this type.
-	ImportPath string `json:"importPath,required"`
-	// Annotations defined on this type.
+// PostProcessor_Process_Helper provides functions that aid in handling the
+// parameters and return values of the PostProcessor.process
+// function.
+var PostProcessor_Process_Helper = struct {
+	// Args accepts the parameters of process in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *PostProcessRequest,
+	) *PostProcessor_Process_Args
+
+	// IsException returns true if the given error can be thrown
+	// by process.
 	//
-	// Note that these are the Thrift annotations listed after the type
-	// declaration in the Thrift file.
+	// An error can be thrown by process only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for process
+	// given its return value and error.
 	//
-	// Given,
+	// This allows mapping values and errors returned by
+	// process into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by process
 	//
-	//   struct User {
-	//     1: required i32 id
-	//     2: required string name
-	//   } (key = "id", validate)
+	//   value, err := process(args)
+	//   result, err := PostProcessor_Process_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from process: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*PostProcessResponse, error) (*PostProcessor_Process_Result, error)
+
+	// UnwrapResponse takes the result struct for process
+	// and returns the value or error returned by it.
 	//
-	// The annotations will be,
+	// The error is non-nil only if process threw an
+	// exception.
 	//
-	//   {
-	//     "key": "id",
-	//     "validate": "",
-	//   }
-	Annotations map[string]string `json:"annotations,omitempty"`
+	//   result := deserialize(bytes)
+	//   value, err := PostProcessor_Process_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*PostProcessor_Process_Result) (*PostProcessResponse, error)
+}{}
+
+func init() {
+	PostProcessor_Process_Helper.Args = func(
+		request *PostProcessRequest,
+	) *PostProcessor_Process_Args {
+		return &PostProcessor_Process_Args{
+			Request: request,
+		}
+	}
+
+	PostProcessor_Process_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	PostProcessor_Process_Helper.WrapResponse = func(success *PostProcessResponse, err error) (*PostProcessor_Process_Result, error) {
+		if err == nil {
+			return &PostProcessor_Process_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	PostProcessor_Process_Helper.UnwrapResponse = func(result *PostProcessor_Process_Result) (success *PostProcessResponse, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
 }
 
-// ToWire translates a TypeReference struct into a Thrift-level intermediate
+// PostProcessor_Process_Result represents the result of a PostProcessor.process function call.
+//
+// The result of a process execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type PostProcessor_Process_Result struct {
+	// Value returned by process after a successful execution.
+	Success *PostProcessResponse `json:"success,omitempty"`
+}
+
+// ToWire translates a PostProcessor_Process_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3917,44 +15873,41 @@ type TypeReference struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TypeReference) ToWire() (wire.Value, error) {
+func (v *PostProcessor_Process_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	w, err = wire.NewValueString(v.ImportPath), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
-	if v.Annotations != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
 
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("PostProcessor_Process_Result should have exactly one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TypeReference struct from its Thrift-level
+func _PostProcessResponse_Read(w wire.Value) (*PostProcessResponse, error) {
+	var v PostProcessResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PostProcessor_Process_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TypeReference struct
+// An error is returned if we were unable to build a PostProcessor_Process_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3962,166 +15915,253 @@ func (v *TypeReference) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TypeReference
+//   var v PostProcessor_Process_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TypeReference) FromWire(w wire.Value) error {
+func (v *PostProcessor_Process_Result) FromWire(w wire.Value) error {
 	var err error
-
-	nameIsSet := false
-	importPathIsSet := false
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				nameIsSet = true
-			}
-		case 2:
-			if field.Value.Type() == wire.TBinary {
-				v.ImportPath, err = field.Value.GetString(), error(nil)
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _PostProcessResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
-				importPathIsSet = true
+
 			}
-		case 3:
-			if field.Value.Type() == wire.TMap {
-				v.Annotations, err = _Map_String_String_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("PostProcessor_Process_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+func _PostProcessResponse_Decode(sr stream.Reader) (*PostProcessResponse, error) {
+	var v PostProcessResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *PostProcessor_Process_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
 
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _PostProcessResponse_Decode(sr)
+			if err != nil {
+				return err
 			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
 		}
 	}
 
-	if !nameIsSet {
-		return errors.New("field Name of TypeReference is required")
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
 	}
 
-	if !importPathIsSet {
-		return errors.New("field ImportPath of TypeReference is required")
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("PostProcessor_Process_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a TypeReference
+// MarshalJSON serializes a PostProcessor_Process_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *PostProcessor_Process_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a PostProcessor_Process_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *PostProcessor_Process_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PostProcessor_Process_Result
 // struct.
-func (v *TypeReference) String() string {
+func (v *PostProcessor_Process_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [1]string
 	i := 0
-	fields[i] = fmt.Sprintf("Name: %v", v.Name)
-	i++
-	fields[i] = fmt.Sprintf("ImportPath: %v", v.ImportPath)
-	i++
-	if v.Annotations != nil {
-		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
 
-	return fmt.Sprintf("TypeReference{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PostProcessor_Process_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TypeReference match the
-// provided TypeReference.
+// Equals returns true if all the fields of this PostProcessor_Process_Result match the
+// provided PostProcessor_Process_Result.
 //
 // This function performs a deep comparison.
-func (v *TypeReference) Equals(rhs *TypeReference) bool {
+func (v *PostProcessor_Process_Result) Equals(rhs *PostProcessor_Process_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Name == rhs.Name) {
-		return false
-	}
-	if !(v.ImportPath == rhs.ImportPath) {
-		return false
-	}
-	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
 
 	return true
 }
 
+// Clone returns a deep copy of this PostProcessor_Process_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *PostProcessor_Process_Result) Clone() *PostProcessor_Process_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c PostProcessor_Process_Result
+	c.Success = v.Success.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TypeReference.
-func (v *TypeReference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PostProcessor_Process_Result.
+func (v *PostProcessor_Process_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("name", v.Name)
-	enc.AddString("importPath", v.ImportPath)
-	if v.Annotations != nil {
-		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *TypeReference) GetName() (o string) {
-	if v != nil {
-		o = v.Name
+func (v *PostProcessor_Process_Result) GetSuccess() (o *PostProcessResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
-	return
-}
 
-// GetImportPath returns the value of ImportPath if it is set or its
-// zero value if it is unset.
-func (v *TypeReference) GetImportPath() (o string) {
-	if v != nil {
-		o = v.ImportPath
-	}
 	return
 }
 
-// GetAnnotations returns the value of Annotations if it is set or its
-// zero value if it is unset.
-func (v *TypeReference) GetAnnotations() (o map[string]string) {
-	if v != nil && v.Annotations != nil {
-		return v.Annotations
-	}
-
-	return
+// IsSetSuccess returns true if Success is not nil.
+func (v *PostProcessor_Process_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// IsSetAnnotations returns true if Annotations is not nil.
-func (v *TypeReference) IsSetAnnotations() bool {
-	return v != nil && v.Annotations != nil
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "process" for this struct.
+func (v *PostProcessor_Process_Result) MethodName() string {
+	return "process"
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "api",
-	Package:  "go.uber.org/thriftrw/plugin/api",
-	FilePath: "api.thrift",
-	SHA1:     "ebc6ad9a8a9a94030fc8552588b6fb2b006ca7cc",
-	Raw:      rawIDL,
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *PostProcessor_Process_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
 }
 
-const rawIDL = "/**\n * API_VERSION is the version of the plugin API.\n *\n * This MUST be provided in the HandshakeResponse.\n */\nconst i32 API_VERSION = 4\n\n/**\n * ServiceID is an arbitrary unique identifier to reference the different\n * services in this request.\n */\ntypedef i32 ServiceID\n\n/**\n * ModuleID is an arbitrary unique identifier to reference the different\n * modules in this request.\n */\ntypedef i32 ModuleID\n\n/**\n * TypeReference is a reference to a user-defined type.\n */\nstruct TypeReference {\n    1: required string name\n    /**\n     * Import path for the package defining this type.\n     */\n    2: required string importPath\n\n    /**\n     * Annotations defined on this type.\n     *\n     * Note that these are the Thrift annotations listed after the type\n     * declaration in the Thrift file.\n     *\n     * Given,\n     *\n     *   struct User {\n     *     1: required i32 id\n     *     2: required string name\n     *   } (key = \"id\", validate)\n     *\n     * The annotations will be,\n     *\n     *   {\n     *     \"key\": \"id\",\n     *     \"validate\": \"\",\n     *   }\n     */\n    3: optional map<string, string> annotations\n\n    // TODO(abg): Should this just be using ModuleID instead of a package?\n}\n\n/**\n * SimpleType is a standalone native Go type.\n */\nenum SimpleType {\n    BOOL = 1,     // bool\n    BYTE,         // byte\n    INT8,         // int8\n    INT16,        // int16\n    INT32,        // int32\n    INT64,        // int64\n    FLOAT64,      // float64\n    STRING,       // string\n    STRUCT_EMPTY, // struct{}\n}\n\n/**\n * TypePair is a pair of two types.\n */\nstruct TypePair {\n    1: required Type left\n    2: required Type right\n}\n\n/**\n * Type is a reference to a Go type which may be native or user defined.\n */\nunion Type {\n    1: SimpleType simpleType\n    /**\n     * Slice of a type\n     *\n     * []$sliceType\n     */\n    2: Type sliceType\n    /**\n     * Slice of key-value pairs of a pair of types.\n     *\n     * []struct{Key $left, Value $right}\n     */\n    3: TypePair keyValueSliceType\n    /**\n     * Map of a pair of types.\n     *\n     * map[$left]$right\n     */\n    4: TypePair mapType\n    /**\n     * Reference to a user-defined type.\n     */\n    5: TypeReference referenceType\n    /**\n     * Pointer to a type.\n     */\n    6: Type pointerType\n}\n\n/**\n * Argument is a single Argument inside a Function.\n * For,\n *\n *      void setValue(1: string key, 2: string value)\n *\n * You get the arguments,\n *\n *      Argument{Name: \"Key\", Type: Type{SimpleType: SimpleTypeString}}\n *\n *      Argument{Name: \"Value\", Type: Type{SimpleType: SimpleTypeString}}\n */\nstruct Argument {\n    /**\n     * Name of the argument. This is also the name of the argument field\n     * inside the args/result struct for that function.\n     */\n    1: required string name\n    /**\n     * Argument type.\n     */\n    2: required Type type\n}\n\n/**\n * Function is a single function on a Thrift service.\n */\nstruct Function {\n    /**\n     * Name of the Go function.\n     */\n    1: required string name\n    /**\n     * Name of the function as defined in the Thrift file.\n     */\n    2: required string thriftName\n    /**\n     * List of arguments accepted by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    3: required list<Argument> arguments\n    /**\n     * Return type of the function, if any. If this is not set, the function\n     * is a void function.\n     */\n    4: optional Type returnType\n    /**\n     * List of exceptions raised by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    5: optional list<Argument> exceptions\n    /**\n     * Whether this function is oneway or not. This should be assumed to be\n     * false unless explicitly stated otherwise. If this is true, the\n     * returnType and exceptions will be null or empty.\n     */\n    6: optional bool oneWay\n    /**\n     * Annotations defined on this function.\n     *\n     * Given,\n     *\n     *   void setValue(1: SetValueRequest req) (cache = \"false\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    7: optional map<string, string> annotations;\n}\n\n/**\n * Service is a service defined by the user in the Thrift file.\n */\nstruct Service {\n    /**\n     * Name of the Thrift service in Go code.\n     */\n    7: required string name\n    /**\n     * Name of the service as defined in the Thrift file.\n     */\n    1: required string thriftName\n    /**\n     * ID of the parent service.\n     */\n    4: optional ServiceID parentID\n    /**\n     * List of functions defined for this service.\n     */\n    5: required list<Function> functions\n    /**\n     * ID of the module where this service was declared.\n     */\n    6: required ModuleID moduleID\n    /**\n     * Annotations defined on this service.\n     *\n     * Given,\n     *\n     *   service KeyValue {\n     *   } (private = \"true\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"private\": \"true\",\n     *  }\n     */\n    8: optional map<string, string> annotations;\n}\n\n/**\n * Module is a module generated from a single Thrift file. Each module\n * corresponds to exactly one Thrift file and contains all the types and\n * constants defined in that Thrift file.\n */\nstruct Module {\n    /**\n     * Import path for the package defining the types for this module.\n     */\n    1: required string importPath\n    /**\n     * Path to the directory containing the code for this module.\n     *\n     * The path is relative to the output directory into which ThriftRW is\n     * generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     */\n    2: required string directory\n    /**\n     * Path to the Thrift file from which this module was generated.\n     */\n    3: required string thriftFilePath\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * Feature is a functionality offered by a ThriftRW plugin.\n */\nenum Feature {\n    /**\n     * SERVICE_GENERATOR specifies that the plugin may generate arbitrary code\n     * for services defined in the Thrift file.\n     *\n     * If a plugin provides this, it MUST implement the ServiceGenerator\n     * service.\n     */\n    SERVICE_GENERATOR = 1,\n\n    // TODO: TAGGER for struct-tagging plugins\n}\n\n/**\n * HandshakeRequest is the initial request sent to the plugin as part of\n * establishing communication and feature negotiation.\n */\nstruct HandshakeRequest {\n}\n\n/**\n * HandshakeResponse is the response from the plugin for a HandshakeRequest.\n */\nstruct HandshakeResponse {\n    /**\n     * Name of the plugin. This MUST match the name of the plugin specified\n     * over the command line or the program will fail.\n     */\n    1: required string name\n    /**\n     * Version of the plugin API.\n     *\n     * This MUST be set to API_VERSION by the plugin.\n     */\n    2: required i32 apiVersion (go.name = \"APIVersion\")\n    /**\n     * List of features the plugin provides.\n     */\n    3: required list<Feature> features\n    /**\n     * Version of ThriftRW with which the plugin was built.\n     *\n     * This MUST be set to go.uber.org/thriftrw/version.Version by the plugin\n     * explicitly.\n     */\n    4: optional string libraryVersion\n}\n\nservice Plugin {\n    /**\n     * handshake performs a handshake with the plugin to negotiate the\n     * features provided by it and the version of the plugin API it expects.\n     */\n    HandshakeResponse handshake(1: HandshakeRequest request)\n\n    /**\n     * Informs the plugin process that it will not receive any more requests\n     * and it is safe for it to exit.\n     */\n    void goodbye()\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * GenerateServiceRequest is a request to generate code for zero or more\n * Thrift services.\n */\nstruct GenerateServiceRequest {\n    /**\n     * IDs of services for which code should be generated.\n     *\n     * Note that the services map contains information about both, the\n     * services being generated and their transitive dependencies. Code should\n     * only be generated for service IDs listed here.\n     */\n    1: required list<ServiceID> rootServices\n    /**\n     * Map of service ID to service.\n     *\n     * Any service IDs present in this request will have a corresponding\n     * service definition in this map, including services for which code does\n     * not need to be generated.\n     */\n    2: required map<ServiceID, Service> services\n    /**\n     * Map of module ID to module.\n     *\n     * Any module IDs present in the request will have a corresponding module\n     * definition in this map.\n     */\n    3: required map<ModuleID, Module> modules\n    /**\n     * Prefix for import paths of generated module. In general, plugins should\n     * not need to use the package prefix unless instantiating a new\n     * Generator for more custom plugin generation.\n     */\n    4: required string packagePrefix\n    /**\n     * Directory whose descendants contain all Thrift files. In general,\n     * plugins should not need to use the thrift root unless instantiating a\n     * new Generator for more custom plugin generation.\n     */\n    5: required string thriftRoot\n}\n\n/**\n * GenerateServiceResponse is response to a GenerateServiceRequest.\n */\nstruct GenerateServiceResponse {\n    /**\n     * Map of file path to file contents.\n     *\n     * All paths MUST be relative to the output directory into which ThriftRW\n     * is generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     *\n     * The paths MUST NOT contain the string \"..\" or the request will fail.\n     */\n    1: optional map<string, binary> files\n}\n\n/**\n * ServiceGenerator generates arbitrary code for services.\n *\n * This MUST be implemented if the SERVICE_GENERATOR feature is enabled.\n */\nservice ServiceGenerator {\n    /**\n     * Generates code for requested services.\n     */\n    GenerateServiceResponse generate(1: GenerateServiceRequest request)\n}\n"
+// PostProcessor_Errors maps the names of exceptions thrown by functions
+// of the PostProcessor service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := PostProcessor_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var PostProcessor_Errors = map[string]func() error{}
 
-// Plugin_Goodbye_Args represents the arguments for the Plugin.goodbye function.
+// ServiceGenerator_Generate_Args represents the arguments for the ServiceGenerator.generate function.
 //
-// The arguments for goodbye are sent and received over the wire as this struct.
-type Plugin_Goodbye_Args struct {
+// Generates code for requested services.
+//
+// The arguments for generate are sent and received over the wire as this struct.
+type ServiceGenerator_Generate_Args struct {
+	Request *GenerateServiceRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a Plugin_Goodbye_Args struct into a Thrift-level intermediate
+// ToWire translates a ServiceGenerator_Generate_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4136,20 +16176,37 @@ type Plugin_Goodbye_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Plugin_Goodbye_Args) ToWire() (wire.Value, error) {
+func (v *ServiceGenerator_Generate_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [0]wire.Field
+		fields [1]wire.Field
 		i      int = 0
+		w      wire.Value
+		err    error
 	)
 
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Plugin_Goodbye_Args struct from its Thrift-level
+func _GenerateServiceRequest_Read(w wire.Value) (*GenerateServiceRequest, error) {
+	var v GenerateServiceRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ServiceGenerator_Generate_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Plugin_Goodbye_Args struct
+// An error is returned if we were unable to build a ServiceGenerator_Generate_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4157,148 +16214,311 @@ func (v *Plugin_Goodbye_Args) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Plugin_Goodbye_Args
+//   var v ServiceGenerator_Generate_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Plugin_Goodbye_Args) FromWire(w wire.Value) error {
+func (v *ServiceGenerator_Generate_Args) FromWire(w wire.Value) error {
+	var err error
+
+	var missing required.Errors
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _GenerateServiceRequest_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return missing.Err()
+}
+
+func _GenerateServiceRequest_Decode(sr stream.Reader) (*GenerateServiceRequest, error) {
+	var v GenerateServiceRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *ServiceGenerator_Generate_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _GenerateServiceRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ServiceGenerator_Generate_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ServiceGenerator_Generate_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Request == nil) {
+		b, err := json.Marshal(v.Request)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"request":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ServiceGenerator_Generate_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ServiceGenerator_Generate_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["request"]; ok {
+		if err := json.Unmarshal(r, &v.Request); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a Plugin_Goodbye_Args
+// String returns a readable string representation of a ServiceGenerator_Generate_Args
 // struct.
-func (v *Plugin_Goodbye_Args) String() string {
+func (v *ServiceGenerator_Generate_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [1]string
 	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
 
-	return fmt.Sprintf("Plugin_Goodbye_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ServiceGenerator_Generate_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Plugin_Goodbye_Args match the
-// provided Plugin_Goodbye_Args.
+// Equals returns true if all the fields of this ServiceGenerator_Generate_Args match the
+// provided ServiceGenerator_Generate_Args.
 //
 // This function performs a deep comparison.
-func (v *Plugin_Goodbye_Args) Equals(rhs *Plugin_Goodbye_Args) bool {
+func (v *ServiceGenerator_Generate_Args) Equals(rhs *ServiceGenerator_Generate_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
 
 	return true
 }
 
+// Clone returns a deep copy of this ServiceGenerator_Generate_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ServiceGenerator_Generate_Args) Clone() *ServiceGenerator_Generate_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c ServiceGenerator_Generate_Args
+	c.Request = v.Request.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Plugin_Goodbye_Args.
-func (v *Plugin_Goodbye_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ServiceGenerator_Generate_Args.
+func (v *ServiceGenerator_Generate_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
 	return err
 }
 
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *ServiceGenerator_Generate_Args) GetRequest() (o *GenerateServiceRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *ServiceGenerator_Generate_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "goodbye" for this struct.
-func (v *Plugin_Goodbye_Args) MethodName() string {
-	return "goodbye"
+// This will always be "generate" for this struct.
+func (v *ServiceGenerator_Generate_Args) MethodName() string {
+	return "generate"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *Plugin_Goodbye_Args) EnvelopeType() wire.EnvelopeType {
+func (v *ServiceGenerator_Generate_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// Plugin_Goodbye_Helper provides functions that aid in handling the
-// parameters and return values of the Plugin.goodbye
+// ServiceGenerator_Generate_Helper provides functions that aid in handling the
+// parameters and return values of the ServiceGenerator.generate
 // function.
-var Plugin_Goodbye_Helper = struct {
-	// Args accepts the parameters of goodbye in-order and returns
+var ServiceGenerator_Generate_Helper = struct {
+	// Args accepts the parameters of generate in-order and returns
 	// the arguments struct for the function.
-	Args func() *Plugin_Goodbye_Args
+	Args func(
+		request *GenerateServiceRequest,
+	) *ServiceGenerator_Generate_Args
 
 	// IsException returns true if the given error can be thrown
-	// by goodbye.
+	// by generate.
 	//
-	// An error can be thrown by goodbye only if the
+	// An error can be thrown by generate only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for goodbye
-	// given the error returned by it. The provided error may
-	// be nil if goodbye did not fail.
+	// WrapResponse returns the result struct for generate
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by goodbye into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// goodbye
+	// This allows mapping values and errors returned by
+	// generate into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by generate
 	//
-	//   err := goodbye(args)
-	//   result, err := Plugin_Goodbye_Helper.WrapResponse(err)
+	//   value, err := generate(args)
+	//   result, err := ServiceGenerator_Generate_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from goodbye: %v", err)
+	//     return fmt.Errorf("unexpected error from generate: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*Plugin_Goodbye_Result, error)
+	WrapResponse func(*GenerateServiceResponse, error) (*ServiceGenerator_Generate_Result, error)
 
-	// UnwrapResponse takes the result struct for goodbye
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for generate
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if goodbye threw an
+	// The error is non-nil only if generate threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := Plugin_Goodbye_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*Plugin_Goodbye_Result) error
+	//   value, err := ServiceGenerator_Generate_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*ServiceGenerator_Generate_Result) (*GenerateServiceResponse, error)
 }{}
 
 func init() {
-	Plugin_Goodbye_Helper.Args = func() *Plugin_Goodbye_Args {
-		return &Plugin_Goodbye_Args{}
+	ServiceGenerator_Generate_Helper.Args = func(
+		request *GenerateServiceRequest,
+	) *ServiceGenerator_Generate_Args {
+		return &ServiceGenerator_Generate_Args{
+			Request: request,
+		}
 	}
 
-	Plugin_Goodbye_Helper.IsException = func(err error) bool {
+	ServiceGenerator_Generate_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		default:
 			return false
 		}
 	}
 
-	Plugin_Goodbye_Helper.WrapResponse = func(err error) (*Plugin_Goodbye_Result, error) {
+	ServiceGenerator_Generate_Helper.WrapResponse = func(success *GenerateServiceResponse, err error) (*ServiceGenerator_Generate_Result, error) {
 		if err == nil {
-			return &Plugin_Goodbye_Result{}, nil
+			return &ServiceGenerator_Generate_Result{Success: success}, nil
 		}
 
 		return nil, err
 	}
-	Plugin_Goodbye_Helper.UnwrapResponse = func(result *Plugin_Goodbye_Result) (err error) {
+	ServiceGenerator_Generate_Helper.UnwrapResponse = func(result *ServiceGenerator_Generate_Result) (success *GenerateServiceResponse, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// Plugin_Goodbye_Result represents the result of a Plugin.goodbye function call.
+// ServiceGenerator_Generate_Result represents the result of a ServiceGenerator.generate function call.
 //
-// The result of a goodbye execution is sent and received over the wire as this struct.
-type Plugin_Goodbye_Result struct {
+// The result of a generate execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type ServiceGenerator_Generate_Result struct {
+	// Value returned by generate after a successful execution.
+	Success *GenerateServiceResponse `json:"success,omitempty"`
 }
 
-// ToWire translates a Plugin_Goodbye_Result struct into a Thrift-level intermediate
+// ToWire translates a ServiceGenerator_Generate_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4313,20 +16533,41 @@ type Plugin_Goodbye_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Plugin_Goodbye_Result) ToWire() (wire.Value, error) {
+func (v *ServiceGenerator_Generate_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [0]wire.Field
+		fields [1]wire.Field
 		i      int = 0
+		w      wire.Value
+		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("ServiceGenerator_Generate_Result should have exactly one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Plugin_Goodbye_Result struct from its Thrift-level
+func _GenerateServiceResponse_Read(w wire.Value) (*GenerateServiceResponse, error) {
+	var v GenerateServiceResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ServiceGenerator_Generate_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Plugin_Goodbye_Result struct
+// An error is returned if we were unable to build a ServiceGenerator_Generate_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4334,80 +16575,255 @@ func (v *Plugin_Goodbye_Result) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v Plugin_Goodbye_Result
+//   var v ServiceGenerator_Generate_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Plugin_Goodbye_Result) FromWire(w wire.Value) error {
+func (v *ServiceGenerator_Generate_Result) FromWire(w wire.Value) error {
+	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GenerateServiceResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("ServiceGenerator_Generate_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+func _GenerateServiceResponse_Decode(sr stream.Reader) (*GenerateServiceResponse, error) {
+	var v GenerateServiceResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *ServiceGenerator_Generate_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _GenerateServiceResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("ServiceGenerator_Generate_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a ServiceGenerator_Generate_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *ServiceGenerator_Generate_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a ServiceGenerator_Generate_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *ServiceGenerator_Generate_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a Plugin_Goodbye_Result
+// String returns a readable string representation of a ServiceGenerator_Generate_Result
 // struct.
-func (v *Plugin_Goodbye_Result) String() string {
+func (v *ServiceGenerator_Generate_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [1]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 
-	return fmt.Sprintf("Plugin_Goodbye_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ServiceGenerator_Generate_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Plugin_Goodbye_Result match the
-// provided Plugin_Goodbye_Result.
+// Equals returns true if all the fields of this ServiceGenerator_Generate_Result match the
+// provided ServiceGenerator_Generate_Result.
 //
 // This function performs a deep comparison.
-func (v *Plugin_Goodbye_Result) Equals(rhs *Plugin_Goodbye_Result) bool {
+func (v *ServiceGenerator_Generate_Result) Equals(rhs *ServiceGenerator_Generate_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 
 	return true
 }
 
+// Clone returns a deep copy of this ServiceGenerator_Generate_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *ServiceGenerator_Generate_Result) Clone() *ServiceGenerator_Generate_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c ServiceGenerator_Generate_Result
+	c.Success = v.Success.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Plugin_Goodbye_Result.
-func (v *Plugin_Goodbye_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ServiceGenerator_Generate_Result.
+func (v *ServiceGenerator_Generate_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *ServiceGenerator_Generate_Result) GetSuccess() (o *GenerateServiceResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *ServiceGenerator_Generate_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "goodbye" for this struct.
-func (v *Plugin_Goodbye_Result) MethodName() string {
-	return "goodbye"
+// This will always be "generate" for this struct.
+func (v *ServiceGenerator_Generate_Result) MethodName() string {
+	return "generate"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *Plugin_Goodbye_Result) EnvelopeType() wire.EnvelopeType {
+func (v *ServiceGenerator_Generate_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// Plugin_Handshake_Args represents the arguments for the Plugin.handshake function.
+// ServiceGenerator_Errors maps the names of exceptions thrown by functions
+// of the ServiceGenerator service to functions which build empty values of
+// them. The names are those returned by ErrorName.
 //
-// The arguments for handshake are sent and received over the wire as this struct.
-type Plugin_Handshake_Args struct {
-	Request *HandshakeRequest `json:"request,omitempty"`
+//   if newErr, ok := ServiceGenerator_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var ServiceGenerator_Errors = map[string]func() error{}
+
+// TypeMapper_MapType_Args represents the arguments for the TypeMapper.mapType function.
+//
+// Maps a field to a custom Go type.
+//
+// This is called for every field that has at least one annotation.
+//
+// The arguments for mapType are sent and received over the wire as this struct.
+type TypeMapper_MapType_Args struct {
+	Request *MapTypeRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a Plugin_Handshake_Args struct into a Thrift-level intermediate
+// ToWire translates a TypeMapper_MapType_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4422,7 +16838,7 @@ type Plugin_Handshake_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Plugin_Handshake_Args) ToWire() (wire.Value, error) {
+func (v *TypeMapper_MapType_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -4442,17 +16858,17 @@ func (v *Plugin_Handshake_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HandshakeRequest_Read(w wire.Value) (*HandshakeRequest, error) {
-	var v HandshakeRequest
+func _MapTypeRequest_Read(w wire.Value) (*MapTypeRequest, error) {
+	var v MapTypeRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a Plugin_Handshake_Args struct from its Thrift-level
+// FromWire deserializes a TypeMapper_MapType_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Plugin_Handshake_Args struct
+// An error is returned if we were unable to build a TypeMapper_MapType_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4460,20 +16876,22 @@ func _HandshakeRequest_Read(w wire.Value) (*HandshakeRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v Plugin_Handshake_Args
+//   var v TypeMapper_MapType_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Plugin_Handshake_Args) FromWire(w wire.Value) error {
+func (v *TypeMapper_MapType_Args) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _HandshakeRequest_Read(field.Value)
-				if err != nil {
+				v.Request, err = _MapTypeRequest_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -4481,12 +16899,109 @@ func (v *Plugin_Handshake_Args) FromWire(w wire.Value) error {
 		}
 	}
 
+	return missing.Err()
+}
+
+func _MapTypeRequest_Decode(sr stream.Reader) (*MapTypeRequest, error) {
+	var v MapTypeRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *TypeMapper_MapType_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _MapTypeRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a Plugin_Handshake_Args
+// MarshalJSON serializes a TypeMapper_MapType_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *TypeMapper_MapType_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Request == nil) {
+		b, err := json.Marshal(v.Request)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"request":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a TypeMapper_MapType_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *TypeMapper_MapType_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["request"]; ok {
+		if err := json.Unmarshal(r, &v.Request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TypeMapper_MapType_Args
 // struct.
-func (v *Plugin_Handshake_Args) String() string {
+func (v *TypeMapper_MapType_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4498,14 +17013,14 @@ func (v *Plugin_Handshake_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("Plugin_Handshake_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TypeMapper_MapType_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Plugin_Handshake_Args match the
-// provided Plugin_Handshake_Args.
+// Equals returns true if all the fields of this TypeMapper_MapType_Args match the
+// provided TypeMapper_MapType_Args.
 //
 // This function performs a deep comparison.
-func (v *Plugin_Handshake_Args) Equals(rhs *Plugin_Handshake_Args) bool {
+func (v *TypeMapper_MapType_Args) Equals(rhs *TypeMapper_MapType_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4518,9 +17033,23 @@ func (v *Plugin_Handshake_Args) Equals(rhs *Plugin_Handshake_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this TypeMapper_MapType_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *TypeMapper_MapType_Args) Clone() *TypeMapper_MapType_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c TypeMapper_MapType_Args
+	c.Request = v.Request.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Plugin_Handshake_Args.
-func (v *Plugin_Handshake_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of TypeMapper_MapType_Args.
+func (v *TypeMapper_MapType_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -4532,7 +17061,7 @@ func (v *Plugin_Handshake_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *Plugin_Handshake_Args) GetRequest() (o *HandshakeRequest) {
+func (v *TypeMapper_MapType_Args) GetRequest() (o *MapTypeRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -4541,94 +17070,94 @@ func (v *Plugin_Handshake_Args) GetRequest() (o *HandshakeRequest) {
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *Plugin_Handshake_Args) IsSetRequest() bool {
+func (v *TypeMapper_MapType_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "handshake" for this struct.
-func (v *Plugin_Handshake_Args) MethodName() string {
-	return "handshake"
+// This will always be "mapType" for this struct.
+func (v *TypeMapper_MapType_Args) MethodName() string {
+	return "mapType"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *Plugin_Handshake_Args) EnvelopeType() wire.EnvelopeType {
+func (v *TypeMapper_MapType_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// Plugin_Handshake_Helper provides functions that aid in handling the
-// parameters and return values of the Plugin.handshake
+// TypeMapper_MapType_Helper provides functions that aid in handling the
+// parameters and return values of the TypeMapper.mapType
 // function.
-var Plugin_Handshake_Helper = struct {
-	// Args accepts the parameters of handshake in-order and returns
+var TypeMapper_MapType_Helper = struct {
+	// Args accepts the parameters of mapType in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *HandshakeRequest,
-	) *Plugin_Handshake_Args
+		request *MapTypeRequest,
+	) *TypeMapper_MapType_Args
 
 	// IsException returns true if the given error can be thrown
-	// by handshake.
+	// by mapType.
 	//
-	// An error can be thrown by handshake only if the
+	// An error can be thrown by mapType only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for handshake
+	// WrapResponse returns the result struct for mapType
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// handshake into a serializable result struct.
+	// mapType into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by handshake
+	// error cannot be thrown by mapType
 	//
-	//   value, err := handshake(args)
-	//   result, err := Plugin_Handshake_Helper.WrapResponse(value, err)
+	//   value, err := mapType(args)
+	//   result, err := TypeMapper_MapType_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from handshake: %v", err)
+	//     return fmt.Errorf("unexpected error from mapType: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*HandshakeResponse, error) (*Plugin_Handshake_Result, error)
+	WrapResponse func(*MapTypeResponse, error) (*TypeMapper_MapType_Result, error)
 
-	// UnwrapResponse takes the result struct for handshake
+	// UnwrapResponse takes the result struct for mapType
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if handshake threw an
+	// The error is non-nil only if mapType threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := Plugin_Handshake_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*Plugin_Handshake_Result) (*HandshakeResponse, error)
+	//   value, err := TypeMapper_MapType_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*TypeMapper_MapType_Result) (*MapTypeResponse, error)
 }{}
 
 func init() {
-	Plugin_Handshake_Helper.Args = func(
-		request *HandshakeRequest,
-	) *Plugin_Handshake_Args {
-		return &Plugin_Handshake_Args{
+	TypeMapper_MapType_Helper.Args = func(
+		request *MapTypeRequest,
+	) *TypeMapper_MapType_Args {
+		return &TypeMapper_MapType_Args{
 			Request: request,
 		}
 	}
 
-	Plugin_Handshake_Helper.IsException = func(err error) bool {
+	TypeMapper_MapType_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		default:
 			return false
 		}
 	}
 
-	Plugin_Handshake_Helper.WrapResponse = func(success *HandshakeResponse, err error) (*Plugin_Handshake_Result, error) {
+	TypeMapper_MapType_Helper.WrapResponse = func(success *MapTypeResponse, err error) (*TypeMapper_MapType_Result, error) {
 		if err == nil {
-			return &Plugin_Handshake_Result{Success: success}, nil
+			return &TypeMapper_MapType_Result{Success: success}, nil
 		}
 
 		return nil, err
 	}
-	Plugin_Handshake_Helper.UnwrapResponse = func(result *Plugin_Handshake_Result) (success *HandshakeResponse, err error) {
+	TypeMapper_MapType_Helper.UnwrapResponse = func(result *TypeMapper_MapType_Result) (success *MapTypeResponse, err error) {
 
 		if result.Success != nil {
 			success = result.Success
@@ -4641,17 +17170,17 @@ func init() {
 
 }
 
-// Plugin_Handshake_Result represents the result of a Plugin.handshake function call.
+// TypeMapper_MapType_Result represents the result of a TypeMapper.mapType function call.
 //
-// The result of a handshake execution is sent and received over the wire as this struct.
+// The result of a mapType execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type Plugin_Handshake_Result struct {
-	// Value returned by handshake after a successful execution.
-	Success *HandshakeResponse `json:"success,omitempty"`
+type TypeMapper_MapType_Result struct {
+	// Value returned by mapType after a successful execution.
+	Success *MapTypeResponse `json:"success,omitempty"`
 }
 
-// ToWire translates a Plugin_Handshake_Result struct into a Thrift-level intermediate
+// ToWire translates a TypeMapper_MapType_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4666,7 +17195,7 @@ type Plugin_Handshake_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Plugin_Handshake_Result) ToWire() (wire.Value, error) {
+func (v *TypeMapper_MapType_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -4684,23 +17213,23 @@ func (v *Plugin_Handshake_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("Plugin_Handshake_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("TypeMapper_MapType_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HandshakeResponse_Read(w wire.Value) (*HandshakeResponse, error) {
-	var v HandshakeResponse
+func _MapTypeResponse_Read(w wire.Value) (*MapTypeResponse, error) {
+	var v MapTypeResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a Plugin_Handshake_Result struct from its Thrift-level
+// FromWire deserializes a TypeMapper_MapType_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Plugin_Handshake_Result struct
+// An error is returned if we were unable to build a TypeMapper_MapType_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4708,20 +17237,22 @@ func _HandshakeResponse_Read(w wire.Value) (*HandshakeResponse, error) {
 //     return nil, err
 //   }
 //
-//   var v Plugin_Handshake_Result
+//   var v TypeMapper_MapType_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Plugin_Handshake_Result) FromWire(w wire.Value) error {
+func (v *TypeMapper_MapType_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _HandshakeResponse_Read(field.Value)
-				if err != nil {
+				v.Success, err = _MapTypeResponse_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -4729,20 +17260,125 @@ func (v *Plugin_Handshake_Result) FromWire(w wire.Value) error {
 		}
 	}
 
-	count := 0
-	if v.Success != nil {
-		count++
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("TypeMapper_MapType_Result should have exactly one field: got %v fields", count)
+	}
+
+	return missing.Err()
+}
+
+func _MapTypeResponse_Decode(sr stream.Reader) (*MapTypeResponse, error) {
+	var v MapTypeResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *TypeMapper_MapType_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _MapTypeResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("TypeMapper_MapType_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// MarshalJSON serializes a TypeMapper_MapType_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *TypeMapper_MapType_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a TypeMapper_MapType_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *TypeMapper_MapType_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
 	}
-	if count != 1 {
-		return fmt.Errorf("Plugin_Handshake_Result should have exactly one field: got %v fields", count)
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a Plugin_Handshake_Result
+// String returns a readable string representation of a TypeMapper_MapType_Result
 // struct.
-func (v *Plugin_Handshake_Result) String() string {
+func (v *TypeMapper_MapType_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4754,14 +17390,14 @@ func (v *Plugin_Handshake_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("Plugin_Handshake_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TypeMapper_MapType_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Plugin_Handshake_Result match the
-// provided Plugin_Handshake_Result.
+// Equals returns true if all the fields of this TypeMapper_MapType_Result match the
+// provided TypeMapper_MapType_Result.
 //
 // This function performs a deep comparison.
-func (v *Plugin_Handshake_Result) Equals(rhs *Plugin_Handshake_Result) bool {
+func (v *TypeMapper_MapType_Result) Equals(rhs *TypeMapper_MapType_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4774,9 +17410,23 @@ func (v *Plugin_Handshake_Result) Equals(rhs *Plugin_Handshake_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this TypeMapper_MapType_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *TypeMapper_MapType_Result) Clone() *TypeMapper_MapType_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c TypeMapper_MapType_Result
+	c.Success = v.Success.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Plugin_Handshake_Result.
-func (v *Plugin_Handshake_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of TypeMapper_MapType_Result.
+func (v *TypeMapper_MapType_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -4788,7 +17438,7 @@ func (v *Plugin_Handshake_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *Plugin_Handshake_Result) GetSuccess() (o *HandshakeResponse) {
+func (v *TypeMapper_MapType_Result) GetSuccess() (o *MapTypeResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -4797,33 +17447,45 @@ func (v *Plugin_Handshake_Result) GetSuccess() (o *HandshakeResponse) {
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *Plugin_Handshake_Result) IsSetSuccess() bool {
+func (v *TypeMapper_MapType_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "handshake" for this struct.
-func (v *Plugin_Handshake_Result) MethodName() string {
-	return "handshake"
+// This will always be "mapType" for this struct.
+func (v *TypeMapper_MapType_Result) MethodName() string {
+	return "mapType"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *Plugin_Handshake_Result) EnvelopeType() wire.EnvelopeType {
+func (v *TypeMapper_MapType_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// ServiceGenerator_Generate_Args represents the arguments for the ServiceGenerator.generate function.
+// TypeMapper_Errors maps the names of exceptions thrown by functions
+// of the TypeMapper service to functions which build empty values of
+// them. The names are those returned by ErrorName.
 //
-// The arguments for generate are sent and received over the wire as this struct.
-type ServiceGenerator_Generate_Args struct {
-	Request *GenerateServiceRequest `json:"request,omitempty"`
+//   if newErr, ok := TypeMapper_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var TypeMapper_Errors = map[string]func() error{}
+
+// Validator_Validate_Args represents the arguments for the Validator.validate function.
+//
+// Checks the requested Thrift files for problems.
+//
+// The arguments for validate are sent and received over the wire as this struct.
+type Validator_Validate_Args struct {
+	Request *ValidateRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a ServiceGenerator_Generate_Args struct into a Thrift-level intermediate
+// ToWire translates a Validator_Validate_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4838,7 +17500,7 @@ type ServiceGenerator_Generate_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ServiceGenerator_Generate_Args) ToWire() (wire.Value, error) {
+func (v *Validator_Validate_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -4858,17 +17520,17 @@ func (v *ServiceGenerator_Generate_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GenerateServiceRequest_Read(w wire.Value) (*GenerateServiceRequest, error) {
-	var v GenerateServiceRequest
+func _ValidateRequest_Read(w wire.Value) (*ValidateRequest, error) {
+	var v ValidateRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a ServiceGenerator_Generate_Args struct from its Thrift-level
+// FromWire deserializes a Validator_Validate_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ServiceGenerator_Generate_Args struct
+// An error is returned if we were unable to build a Validator_Validate_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4876,20 +17538,22 @@ func _GenerateServiceRequest_Read(w wire.Value) (*GenerateServiceRequest, error)
 //     return nil, err
 //   }
 //
-//   var v ServiceGenerator_Generate_Args
+//   var v Validator_Validate_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ServiceGenerator_Generate_Args) FromWire(w wire.Value) error {
+func (v *Validator_Validate_Args) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GenerateServiceRequest_Read(field.Value)
-				if err != nil {
+				v.Request, err = _ValidateRequest_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -4897,12 +17561,109 @@ func (v *ServiceGenerator_Generate_Args) FromWire(w wire.Value) error {
 		}
 	}
 
+	return missing.Err()
+}
+
+func _ValidateRequest_Decode(sr stream.Reader) (*ValidateRequest, error) {
+	var v ValidateRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Validator_Validate_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _ValidateRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a ServiceGenerator_Generate_Args
+// MarshalJSON serializes a Validator_Validate_Args struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Validator_Validate_Args) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Request == nil) {
+		b, err := json.Marshal(v.Request)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"request":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Validator_Validate_Args struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Validator_Validate_Args) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["request"]; ok {
+		if err := json.Unmarshal(r, &v.Request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Validator_Validate_Args
 // struct.
-func (v *ServiceGenerator_Generate_Args) String() string {
+func (v *Validator_Validate_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4914,14 +17675,14 @@ func (v *ServiceGenerator_Generate_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("ServiceGenerator_Generate_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Validator_Validate_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ServiceGenerator_Generate_Args match the
-// provided ServiceGenerator_Generate_Args.
+// Equals returns true if all the fields of this Validator_Validate_Args match the
+// provided Validator_Validate_Args.
 //
 // This function performs a deep comparison.
-func (v *ServiceGenerator_Generate_Args) Equals(rhs *ServiceGenerator_Generate_Args) bool {
+func (v *Validator_Validate_Args) Equals(rhs *Validator_Validate_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4934,9 +17695,23 @@ func (v *ServiceGenerator_Generate_Args) Equals(rhs *ServiceGenerator_Generate_A
 	return true
 }
 
+// Clone returns a deep copy of this Validator_Validate_Args. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Validator_Validate_Args) Clone() *Validator_Validate_Args {
+	if v == nil {
+		return nil
+	}
+
+	var c Validator_Validate_Args
+	c.Request = v.Request.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ServiceGenerator_Generate_Args.
-func (v *ServiceGenerator_Generate_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Validator_Validate_Args.
+func (v *Validator_Validate_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -4948,7 +17723,7 @@ func (v *ServiceGenerator_Generate_Args) MarshalLogObject(enc zapcore.ObjectEnco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *ServiceGenerator_Generate_Args) GetRequest() (o *GenerateServiceRequest) {
+func (v *Validator_Validate_Args) GetRequest() (o *ValidateRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -4957,94 +17732,94 @@ func (v *ServiceGenerator_Generate_Args) GetRequest() (o *GenerateServiceRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *ServiceGenerator_Generate_Args) IsSetRequest() bool {
+func (v *Validator_Validate_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "generate" for this struct.
-func (v *ServiceGenerator_Generate_Args) MethodName() string {
-	return "generate"
+// This will always be "validate" for this struct.
+func (v *Validator_Validate_Args) MethodName() string {
+	return "validate"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *ServiceGenerator_Generate_Args) EnvelopeType() wire.EnvelopeType {
+func (v *Validator_Validate_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// ServiceGenerator_Generate_Helper provides functions that aid in handling the
-// parameters and return values of the ServiceGenerator.generate
+// Validator_Validate_Helper provides functions that aid in handling the
+// parameters and return values of the Validator.validate
 // function.
-var ServiceGenerator_Generate_Helper = struct {
-	// Args accepts the parameters of generate in-order and returns
+var Validator_Validate_Helper = struct {
+	// Args accepts the parameters of validate in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *GenerateServiceRequest,
-	) *ServiceGenerator_Generate_Args
+		request *ValidateRequest,
+	) *Validator_Validate_Args
 
 	// IsException returns true if the given error can be thrown
-	// by generate.
+	// by validate.
 	//
-	// An error can be thrown by generate only if the
+	// An error can be thrown by validate only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for generate
+	// WrapResponse returns the result struct for validate
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// generate into a serializable result struct.
+	// validate into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by generate
+	// error cannot be thrown by validate
 	//
-	//   value, err := generate(args)
-	//   result, err := ServiceGenerator_Generate_Helper.WrapResponse(value, err)
+	//   value, err := validate(args)
+	//   result, err := Validator_Validate_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from generate: %v", err)
+	//     return fmt.Errorf("unexpected error from validate: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GenerateServiceResponse, error) (*ServiceGenerator_Generate_Result, error)
+	WrapResponse func(*ValidateResponse, error) (*Validator_Validate_Result, error)
 
-	// UnwrapResponse takes the result struct for generate
+	// UnwrapResponse takes the result struct for validate
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if generate threw an
+	// The error is non-nil only if validate threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := ServiceGenerator_Generate_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*ServiceGenerator_Generate_Result) (*GenerateServiceResponse, error)
+	//   value, err := Validator_Validate_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Validator_Validate_Result) (*ValidateResponse, error)
 }{}
 
 func init() {
-	ServiceGenerator_Generate_Helper.Args = func(
-		request *GenerateServiceRequest,
-	) *ServiceGenerator_Generate_Args {
-		return &ServiceGenerator_Generate_Args{
+	Validator_Validate_Helper.Args = func(
+		request *ValidateRequest,
+	) *Validator_Validate_Args {
+		return &Validator_Validate_Args{
 			Request: request,
 		}
 	}
 
-	ServiceGenerator_Generate_Helper.IsException = func(err error) bool {
+	Validator_Validate_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		default:
 			return false
 		}
 	}
 
-	ServiceGenerator_Generate_Helper.WrapResponse = func(success *GenerateServiceResponse, err error) (*ServiceGenerator_Generate_Result, error) {
+	Validator_Validate_Helper.WrapResponse = func(success *ValidateResponse, err error) (*Validator_Validate_Result, error) {
 		if err == nil {
-			return &ServiceGenerator_Generate_Result{Success: success}, nil
+			return &Validator_Validate_Result{Success: success}, nil
 		}
 
 		return nil, err
 	}
-	ServiceGenerator_Generate_Helper.UnwrapResponse = func(result *ServiceGenerator_Generate_Result) (success *GenerateServiceResponse, err error) {
+	Validator_Validate_Helper.UnwrapResponse = func(result *Validator_Validate_Result) (success *ValidateResponse, err error) {
 
 		if result.Success != nil {
 			success = result.Success
@@ -5057,17 +17832,17 @@ func init() {
 
 }
 
-// ServiceGenerator_Generate_Result represents the result of a ServiceGenerator.generate function call.
+// Validator_Validate_Result represents the result of a Validator.validate function call.
 //
-// The result of a generate execution is sent and received over the wire as this struct.
+// The result of a validate execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type ServiceGenerator_Generate_Result struct {
-	// Value returned by generate after a successful execution.
-	Success *GenerateServiceResponse `json:"success,omitempty"`
+type Validator_Validate_Result struct {
+	// Value returned by validate after a successful execution.
+	Success *ValidateResponse `json:"success,omitempty"`
 }
 
-// ToWire translates a ServiceGenerator_Generate_Result struct into a Thrift-level intermediate
+// ToWire translates a Validator_Validate_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5082,7 +17857,7 @@ type ServiceGenerator_Generate_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ServiceGenerator_Generate_Result) ToWire() (wire.Value, error) {
+func (v *Validator_Validate_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -5100,23 +17875,23 @@ func (v *ServiceGenerator_Generate_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("ServiceGenerator_Generate_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("Validator_Validate_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GenerateServiceResponse_Read(w wire.Value) (*GenerateServiceResponse, error) {
-	var v GenerateServiceResponse
+func _ValidateResponse_Read(w wire.Value) (*ValidateResponse, error) {
+	var v ValidateResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a ServiceGenerator_Generate_Result struct from its Thrift-level
+// FromWire deserializes a Validator_Validate_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ServiceGenerator_Generate_Result struct
+// An error is returned if we were unable to build a Validator_Validate_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5124,20 +17899,22 @@ func _GenerateServiceResponse_Read(w wire.Value) (*GenerateServiceResponse, erro
 //     return nil, err
 //   }
 //
-//   var v ServiceGenerator_Generate_Result
+//   var v Validator_Validate_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ServiceGenerator_Generate_Result) FromWire(w wire.Value) error {
+func (v *Validator_Validate_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var missing required.Errors
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GenerateServiceResponse_Read(field.Value)
-				if err != nil {
+				v.Success, err = _ValidateResponse_Read(field.Value)
+				if err = missing.Merge(err); err != nil {
 					return err
 				}
 
@@ -5150,15 +17927,120 @@ func (v *ServiceGenerator_Generate_Result) FromWire(w wire.Value) error {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("ServiceGenerator_Generate_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("Validator_Validate_Result should have exactly one field: got %v fields", count)
+	}
+
+	return missing.Err()
+}
+
+func _ValidateResponse_Decode(sr stream.Reader) (*ValidateResponse, error) {
+	var v ValidateResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func (v *Validator_Validate_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _ValidateResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Validator_Validate_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a ServiceGenerator_Generate_Result
+// MarshalJSON serializes a Validator_Validate_Result struct into JSON. Fields are keyed
+// by their Thrift names or by their json.name annotations, and
+// optional fields that are not set are omitted.
+//
+// This implements json.Marshaler.
+func (v *Validator_Validate_Result) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var buff bytes.Buffer
+	buff.WriteByte('{')
+	if !(v.Success == nil) {
+		b, err := json.Marshal(v.Success)
+		if err != nil {
+			return nil, err
+		}
+		if buff.Len() > 1 {
+			buff.WriteByte(',')
+		}
+		buff.WriteString(`"success":`)
+		buff.Write(b)
+	}
+
+	buff.WriteByte('}')
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes a Validator_Validate_Result struct from JSON. Fields are
+// looked up by the same keys used by MarshalJSON.
+//
+// Values of i64 fields may be provided as JSON numbers or as JSON
+// strings holding numbers. The latter allows clients that represent
+// all numbers as doubles to send them without a loss of precision.
+//
+// This implements json.Unmarshaler.
+func (v *Validator_Validate_Result) UnmarshalJSON(text []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return err
+	}
+	if r, ok := raw["success"]; ok {
+		if err := json.Unmarshal(r, &v.Success); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Validator_Validate_Result
 // struct.
-func (v *ServiceGenerator_Generate_Result) String() string {
+func (v *Validator_Validate_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -5170,14 +18052,14 @@ func (v *ServiceGenerator_Generate_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("ServiceGenerator_Generate_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Validator_Validate_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ServiceGenerator_Generate_Result match the
-// provided ServiceGenerator_Generate_Result.
+// Equals returns true if all the fields of this Validator_Validate_Result match the
+// provided Validator_Validate_Result.
 //
 // This function performs a deep comparison.
-func (v *ServiceGenerator_Generate_Result) Equals(rhs *ServiceGenerator_Generate_Result) bool {
+func (v *Validator_Validate_Result) Equals(rhs *Validator_Validate_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -5190,9 +18072,23 @@ func (v *ServiceGenerator_Generate_Result) Equals(rhs *ServiceGenerator_Generate
 	return true
 }
 
+// Clone returns a deep copy of this Validator_Validate_Result. Fields annotated with
+// go.shallowcopy and fields with custom types are copied
+// shallowly, sharing their contents with the original.
+func (v *Validator_Validate_Result) Clone() *Validator_Validate_Result {
+	if v == nil {
+		return nil
+	}
+
+	var c Validator_Validate_Result
+	c.Success = v.Success.Clone()
+
+	return &c
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ServiceGenerator_Generate_Result.
-func (v *ServiceGenerator_Generate_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of Validator_Validate_Result.
+func (v *Validator_Validate_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -5204,7 +18100,7 @@ func (v *ServiceGenerator_Generate_Result) MarshalLogObject(enc zapcore.ObjectEn
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *ServiceGenerator_Generate_Result) GetSuccess() (o *GenerateServiceResponse) {
+func (v *Validator_Validate_Result) GetSuccess() (o *ValidateResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -5213,21 +18109,31 @@ func (v *ServiceGenerator_Generate_Result) GetSuccess() (o *GenerateServiceRespo
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *ServiceGenerator_Generate_Result) IsSetSuccess() bool {
+func (v *Validator_Validate_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "generate" for this struct.
-func (v *ServiceGenerator_Generate_Result) MethodName() string {
-	return "generate"
+// This will always be "validate" for this struct.
+func (v *Validator_Validate_Result) MethodName() string {
+	return "validate"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *ServiceGenerator_Generate_Result) EnvelopeType() wire.EnvelopeType {
+func (v *Validator_Validate_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// Validator_Errors maps the names of exceptions thrown by functions
+// of the Validator service to functions which build empty values of
+// them. The names are those returned by ErrorName.
+//
+//   if newErr, ok := Validator_Errors[name]; ok {
+//     err := newErr()
+//     ...
+//   }
+var Validator_Errors = map[string]func() error{}
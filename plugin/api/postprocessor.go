@@ -0,0 +1,10 @@
+// Code generated by thriftrw --generate-plugin-api
+// @generated
+
+package api
+
+type PostProcessor interface {
+	Process(
+		Request *PostProcessRequest,
+	) (*PostProcessResponse, error)
+}
@@ -0,0 +1,47 @@
+// Code generated by thriftrw --generate-plugin-api
+// @generated
+
+package api
+
+import (
+	envelope "go.uber.org/thriftrw/internal/envelope"
+
+	// Client implements a PostProcessor client.
+	wire "go.uber.org/thriftrw/wire"
+)
+
+type _PostProcessor_client struct {
+	client envelope.Client
+}
+
+// NewPostProcessorClient builds a new PostProcessor client.
+func NewPostProcessorClient(c envelope.Client) PostProcessor {
+	return &_PostProcessor_client{
+		client: c,
+	}
+}
+
+func (c *_PostProcessor_client) Process(
+	_Request *PostProcessRequest,
+) (success *PostProcessResponse, err error) {
+	args := PostProcessor_Process_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = args.ToWire()
+	if err != nil {
+		return
+	}
+
+	body, err = c.client.Send("process", body)
+	if err != nil {
+		return
+	}
+
+	var result PostProcessor_Process_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = PostProcessor_Process_Helper.UnwrapResponse(&result)
+	return
+}
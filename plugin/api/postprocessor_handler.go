@@ -0,0 +1,49 @@
+// Code generated by thriftrw --generate-plugin-api
+// @generated
+
+package api
+
+import (
+	envelope "go.uber.org/thriftrw/internal/envelope"
+
+	// PostProcessorHandler serves an implementation of the PostProcessor service.
+	wire "go.uber.org/thriftrw/wire"
+)
+
+type PostProcessorHandler struct {
+	impl PostProcessor
+}
+
+// NewPostProcessorHandler builds a new PostProcessor handler.
+func NewPostProcessorHandler(service PostProcessor) PostProcessorHandler {
+	return PostProcessorHandler{
+		impl: service,
+	}
+}
+
+// Handle receives and handles a request for the PostProcessor service.
+func (h PostProcessorHandler) Handle(name string, reqValue wire.Value) (wire.Value, error) {
+	switch name {
+
+	case "process":
+
+		var args PostProcessor_Process_Args
+		if err := args.FromWire(reqValue); err != nil {
+			return wire.Value{}, err
+		}
+
+		result, err := PostProcessor_Process_Helper.WrapResponse(
+			h.impl.Process(args.Request),
+		)
+		if err != nil {
+			return wire.Value{}, err
+		}
+
+		return result.ToWire()
+
+	default:
+
+		return wire.Value{}, envelope.ErrUnknownMethod(name)
+
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by thriftrw --generate-plugin-api
+// @generated
+
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	envelope "go.uber.org/thriftrw/internal/envelope"
+	wire "go.uber.org/thriftrw/wire"
+)
+
+// Client implements a TypeMapper client.
+type _TypeMapper_client struct {
+	client envelope.Client
+}
+
+// NewTypeMapperClient builds a new TypeMapper client.
+func NewTypeMapperClient(c envelope.Client) TypeMapper {
+	return &_TypeMapper_client{
+		client: c,
+	}
+}
+
+func (c *_TypeMapper_client) MapType(
+	_Request *MapTypeRequest,
+) (success *MapTypeResponse, err error) {
+	args := TypeMapper_MapType_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = args.ToWire()
+	if err != nil {
+		return
+	}
+
+	body, err = c.client.Send("mapType", body)
+	if err != nil {
+		return
+	}
+
+	var result TypeMapper_MapType_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = TypeMapper_MapType_Helper.UnwrapResponse(&result)
+	return
+}
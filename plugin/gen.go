@@ -21,5 +21,5 @@
 package plugin
 
 //go:generate thriftrw --generate-plugin-api api.thrift
-//go:generate mockgen -destination plugintest/api.go -package plugintest go.uber.org/thriftrw/plugin/api Plugin,ServiceGenerator
+//go:generate mockgen -destination plugintest/api.go -package plugintest go.uber.org/thriftrw/plugin/api Plugin,ServiceGenerator,TypeMapper,Validator,PostProcessor,Generator
 //go:generate ../scripts/updateLicenses.sh
@@ -26,7 +26,6 @@ import (
 	"os"
 
 	"go.uber.org/thriftrw/internal/envelope"
-	"go.uber.org/thriftrw/internal/frame"
 	"go.uber.org/thriftrw/internal/multiplex"
 	"go.uber.org/thriftrw/plugin/api"
 	"go.uber.org/thriftrw/protocol"
@@ -52,6 +51,29 @@ type Plugin struct {
 	// functionality.
 	ServiceGenerator api.ServiceGenerator
 
+	// Plugins can implement a TypeMapper to replace the Go types used for
+	// fields by claiming annotations on them. This may be nil if the plugin
+	// does not provide this functionality.
+	TypeMapper api.TypeMapper
+
+	// Plugins can implement a Validator to report problems with Thrift
+	// files, such as violations of an organization's policies for them.
+	// Code is not generated if problems are found. This may be nil if the
+	// plugin does not provide this functionality.
+	Validator api.Validator
+
+	// Plugins can implement a PostProcessor to modify the files generated
+	// by ThriftRW and other plugins before they are written, for example,
+	// to add build tags or rewrite imports. This may be nil if the plugin
+	// does not provide this functionality.
+	PostProcessor api.PostProcessor
+
+	// Connected, if non-nil, is called with a client for the Generator
+	// service implemented by ThriftRW before the plugin handles any
+	// requests. Plugins may use this client to make requests back to ThriftRW
+	// while they are handling a request.
+	Connected func(api.Generator)
+
 	// Reader and Writer may be specified to change the communication channel
 	// this plugin uses. By default, plugins listen on stdin and write to
 	// stdout.
@@ -73,7 +95,8 @@ func Main(p *Plugin) {
 	// The plugin communicates with the ThriftRW process over stdout and stdin
 	// of this process. Requests and responses are Thrift envelopes with a
 	// 4-byte big-endian encoded length prefix. Envelope names contain method
-	// names prefixed with the service name and a ":".
+	// names prefixed with the service name and a ":". Requests may be sent in
+	// both directions and are matched to their responses by sequence ID.
 
 	mainHandler := multiplex.NewHandler()
 
@@ -84,6 +107,21 @@ func Main(p *Plugin) {
 		mainHandler.Put("ServiceGenerator", api.NewServiceGeneratorHandler(p.ServiceGenerator))
 	}
 
+	if p.TypeMapper != nil {
+		features = append(features, api.FeatureTypeMapper)
+		mainHandler.Put("TypeMapper", api.NewTypeMapperHandler(p.TypeMapper))
+	}
+
+	if p.Validator != nil {
+		features = append(features, api.FeatureValidator)
+		mainHandler.Put("Validator", api.NewValidatorHandler(p.Validator))
+	}
+
+	if p.PostProcessor != nil {
+		features = append(features, api.FeaturePostProcessor)
+		mainHandler.Put("PostProcessor", api.NewPostProcessorHandler(p.PostProcessor))
+	}
+
 	// TODO(abg): Check for other features and register handlers here.
 	reader := p.Reader
 	if reader == nil {
@@ -94,21 +132,25 @@ func Main(p *Plugin) {
 		writer = os.Stdout
 	}
 
-	server := frame.NewServer(reader, writer)
+	peer := envelope.NewPeer(_proto, reader, writer, mainHandler)
 	mainHandler.Put("Plugin", api.NewPluginHandler(pluginHandler{
-		server:   server,
+		peer:     peer,
 		plugin:   p,
 		features: features,
 	}))
 
-	if err := server.Serve(envelope.NewServer(_proto, mainHandler)); err != nil {
+	if p.Connected != nil {
+		p.Connected(api.NewGeneratorClient(multiplex.NewClient("Generator", peer)))
+	}
+
+	if err := peer.Serve(); err != nil {
 		log.Fatalf("plugin server failed with error: %v", err)
 	}
 }
 
 // pluginHandler implements the Plugin service.
 type pluginHandler struct {
-	server   *frame.Server
+	peer     *envelope.Peer
 	plugin   *Plugin
 	features []api.Feature
 }
@@ -123,5 +165,5 @@ func (h pluginHandler) Handshake(request *api.HandshakeRequest) (*api.HandshakeR
 }
 
 func (h pluginHandler) Goodbye() error {
-	return h.server.Stop()
+	return h.peer.Stop()
 }
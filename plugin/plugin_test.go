@@ -121,3 +121,169 @@ func TestServiceGenerator(t *testing.T) {
 		assert.Equal(t, res, gotRes)
 	}
 }
+
+func TestValidator(t *testing.T) {
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	transport := fakeEnvelopeClient(stdinWriter, stdoutReader)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	validator := plugintest.NewMockValidator(mockCtrl)
+
+	go Main(&Plugin{
+		Name:      "hello",
+		Validator: validator,
+		Writer:    stdoutWriter,
+		Reader:    stdinReader,
+	})
+
+	pluginClient := api.NewPluginClient(multiplex.NewClient("Plugin", transport))
+	defer pluginClient.Goodbye()
+
+	handshake, err := pluginClient.Handshake(&api.HandshakeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []api.Feature{api.FeatureValidator}, handshake.Features)
+
+	vClient := api.NewValidatorClient(multiplex.NewClient("Validator", transport))
+	req := &api.ValidateRequest{
+		ThriftFilePaths: []string{"foo.thrift"},
+		Declarations: []*api.Declaration{
+			{
+				Kind:           api.DeclarationKindStruct,
+				Name:           "foo",
+				ThriftFilePath: "foo.thrift",
+				Line:           1,
+				Members:        []*api.Member{{Name: "bar", Line: 2}},
+			},
+		},
+	}
+
+	res := &api.ValidateResponse{
+		Diagnostics: []*api.Diagnostic{
+			{
+				ThriftFilePath: "foo.thrift",
+				Line:           1,
+				Message:        `struct names must be capitalized: "foo"`,
+				Rule:           ptr.String("naming"),
+			},
+		},
+	}
+
+	validator.EXPECT().Validate(req).Return(res, nil)
+	gotRes, err := vClient.Validate(req)
+	if assert.NoError(t, err) {
+		assert.Equal(t, res, gotRes)
+	}
+}
+
+func TestPostProcessor(t *testing.T) {
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	transport := fakeEnvelopeClient(stdinWriter, stdoutReader)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	postProcessor := plugintest.NewMockPostProcessor(mockCtrl)
+
+	go Main(&Plugin{
+		Name:          "hello",
+		PostProcessor: postProcessor,
+		Writer:        stdoutWriter,
+		Reader:        stdinReader,
+	})
+
+	pluginClient := api.NewPluginClient(multiplex.NewClient("Plugin", transport))
+	defer pluginClient.Goodbye()
+
+	handshake, err := pluginClient.Handshake(&api.HandshakeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []api.Feature{api.FeaturePostProcessor}, handshake.Features)
+
+	ppClient := api.NewPostProcessorClient(multiplex.NewClient("PostProcessor", transport))
+	req := &api.PostProcessRequest{
+		Files:         map[string][]byte{"foo/foo.go": []byte("package foo")},
+		PackagePrefix: "example.com/idl",
+	}
+	res := &api.PostProcessResponse{
+		Files: map[string][]byte{"foo/foo.go": []byte("// +build thrift\n\npackage foo")},
+	}
+
+	postProcessor.EXPECT().Process(req).Return(res, nil)
+	gotRes, err := ppClient.Process(req)
+	if assert.NoError(t, err) {
+		assert.Equal(t, res, gotRes)
+	}
+}
+
+func TestConnected(t *testing.T) {
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	generator := plugintest.NewMockGenerator(mockCtrl)
+	serviceGenerator := plugintest.NewMockServiceGenerator(mockCtrl)
+
+	// Play the part of ThriftRW: serve the Generator service on the same
+	// connection used to make requests to the plugin.
+	handler := multiplex.NewHandler()
+	handler.Put("Generator", api.NewGeneratorHandler(generator))
+	peer := envelope.NewPeer(_proto, stdoutReader, stdinWriter, handler)
+	go peer.Serve()
+
+	var pluginGenerator api.Generator
+	go Main(&Plugin{
+		Name:             "hello",
+		ServiceGenerator: serviceGenerator,
+		Connected:        func(g api.Generator) { pluginGenerator = g },
+		Writer:           stdoutWriter,
+		Reader:           stdinReader,
+	})
+
+	pluginClient := api.NewPluginClient(multiplex.NewClient("Plugin", peer))
+	defer pluginClient.Goodbye()
+
+	_, err := pluginClient.Handshake(&api.HandshakeRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, pluginGenerator, "Connected must be called before requests are handled")
+
+	resolveReq := &api.ResolveTypeRequest{
+		ThriftFilePath: "/foo/bar.thrift",
+		Name:           "Baz",
+	}
+	resolveRes := &api.ResolveTypeResponse{
+		Type: &api.Type{ReferenceType: &api.TypeReference{
+			Name:       "Baz",
+			ImportPath: "example.com/foo/bar",
+		}},
+	}
+	generator.EXPECT().ResolveType(resolveReq).Return(resolveRes, nil)
+
+	req := &api.GenerateServiceRequest{
+		RootServices: []api.ServiceID{},
+		Services:     map[api.ServiceID]*api.Service{},
+		Modules:      map[api.ModuleID]*api.Module{},
+	}
+	serviceGenerator.EXPECT().Generate(req).DoAndReturn(
+		func(*api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+			// Call back into ThriftRW while handling the request.
+			res, err := pluginGenerator.ResolveType(resolveReq)
+			if err != nil {
+				return nil, err
+			}
+			return &api.GenerateServiceResponse{
+				Files: map[string][]byte{
+					"foo.go": []byte(res.Type.ReferenceType.ImportPath),
+				},
+			}, nil
+		})
+
+	sgClient := api.NewServiceGeneratorClient(multiplex.NewClient("ServiceGenerator", peer))
+	res, err := sgClient.Generate(req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"foo.go": []byte("example.com/foo/bar")}, res.Files)
+}
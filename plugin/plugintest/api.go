@@ -19,7 +19,7 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
-// Source: go.uber.org/thriftrw/plugin/api (interfaces: Plugin,ServiceGenerator)
+// Source: go.uber.org/thriftrw/plugin/api (interfaces: Plugin,ServiceGenerator,TypeMapper,Validator,PostProcessor,Generator)
 
 // Package plugintest is a generated GoMock package.
 package plugintest
@@ -119,3 +119,155 @@ func (mr *MockServiceGeneratorMockRecorder) Generate(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockServiceGenerator)(nil).Generate), arg0)
 }
+
+// MockTypeMapper is a mock of TypeMapper interface
+type MockTypeMapper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTypeMapperMockRecorder
+}
+
+// MockTypeMapperMockRecorder is the mock recorder for MockTypeMapper
+type MockTypeMapperMockRecorder struct {
+	mock *MockTypeMapper
+}
+
+// NewMockTypeMapper creates a new mock instance
+func NewMockTypeMapper(ctrl *gomock.Controller) *MockTypeMapper {
+	mock := &MockTypeMapper{ctrl: ctrl}
+	mock.recorder = &MockTypeMapperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTypeMapper) EXPECT() *MockTypeMapperMockRecorder {
+	return m.recorder
+}
+
+// MapType mocks base method
+func (m *MockTypeMapper) MapType(arg0 *api.MapTypeRequest) (*api.MapTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MapType", arg0)
+	ret0, _ := ret[0].(*api.MapTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MapType indicates an expected call of MapType
+func (mr *MockTypeMapperMockRecorder) MapType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapType", reflect.TypeOf((*MockTypeMapper)(nil).MapType), arg0)
+}
+
+// MockValidator is a mock of Validator interface
+type MockValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorMockRecorder
+}
+
+// MockValidatorMockRecorder is the mock recorder for MockValidator
+type MockValidatorMockRecorder struct {
+	mock *MockValidator
+}
+
+// NewMockValidator creates a new mock instance
+func NewMockValidator(ctrl *gomock.Controller) *MockValidator {
+	mock := &MockValidator{ctrl: ctrl}
+	mock.recorder = &MockValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
+	return m.recorder
+}
+
+// Validate mocks base method
+func (m *MockValidator) Validate(arg0 *api.ValidateRequest) (*api.ValidateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", arg0)
+	ret0, _ := ret[0].(*api.ValidateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate
+func (mr *MockValidatorMockRecorder) Validate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockValidator)(nil).Validate), arg0)
+}
+
+// MockPostProcessor is a mock of PostProcessor interface
+type MockPostProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostProcessorMockRecorder
+}
+
+// MockPostProcessorMockRecorder is the mock recorder for MockPostProcessor
+type MockPostProcessorMockRecorder struct {
+	mock *MockPostProcessor
+}
+
+// NewMockPostProcessor creates a new mock instance
+func NewMockPostProcessor(ctrl *gomock.Controller) *MockPostProcessor {
+	mock := &MockPostProcessor{ctrl: ctrl}
+	mock.recorder = &MockPostProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPostProcessor) EXPECT() *MockPostProcessorMockRecorder {
+	return m.recorder
+}
+
+// Process mocks base method
+func (m *MockPostProcessor) Process(arg0 *api.PostProcessRequest) (*api.PostProcessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", arg0)
+	ret0, _ := ret[0].(*api.PostProcessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process
+func (mr *MockPostProcessorMockRecorder) Process(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockPostProcessor)(nil).Process), arg0)
+}
+
+// MockGenerator is a mock of Generator interface
+type MockGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeneratorMockRecorder
+}
+
+// MockGeneratorMockRecorder is the mock recorder for MockGenerator
+type MockGeneratorMockRecorder struct {
+	mock *MockGenerator
+}
+
+// NewMockGenerator creates a new mock instance
+func NewMockGenerator(ctrl *gomock.Controller) *MockGenerator {
+	mock := &MockGenerator{ctrl: ctrl}
+	mock.recorder = &MockGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGenerator) EXPECT() *MockGeneratorMockRecorder {
+	return m.recorder
+}
+
+// ResolveType mocks base method
+func (m *MockGenerator) ResolveType(arg0 *api.ResolveTypeRequest) (*api.ResolveTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveType", arg0)
+	ret0, _ := ret[0].(*api.ResolveTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveType indicates an expected call of ResolveType
+func (mr *MockGeneratorMockRecorder) ResolveType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveType", reflect.TypeOf((*MockGenerator)(nil).ResolveType), arg0)
+}
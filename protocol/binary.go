@@ -25,6 +25,7 @@ import (
 	"io"
 
 	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
 	"go.uber.org/thriftrw/wire"
 )
 
@@ -32,6 +33,16 @@ import (
 // Binary can be cast up to EnvelopeAgnosticProtocol to support DecodeRequest.
 var Binary Protocol
 
+// NonStrictBinary implements the Thrift Binary Protocol like Binary but
+// writes envelopes in the non-strict (unversioned) format understood by
+// legacy Apache Thrift implementations.
+//
+// Both strict and non-strict envelopes are accepted when decoding, and
+// responders from DecodeRequest reply in-kind regardless of this setting.
+// NonStrictBinary can be cast up to EnvelopeAgnosticProtocol to support
+// DecodeRequest.
+var NonStrictBinary Protocol
+
 // EnvelopeAgnosticBinary implements the Thrift Binary Protocol, using
 // DecodeRequest for request bodies that may or may not have an envelope.
 // This in turn produces a responder with an EncodeResponse method so a handler
@@ -98,16 +109,39 @@ var EnvelopeAgnosticBinary EnvelopeAgnosticProtocol
 
 type errUnexpectedEnvelopeType wire.EnvelopeType
 
+// EncodedSize returns the number of bytes the given value occupies when
+// encoded with the Binary protocol, without encoding it. This may be used
+// to enforce limits on the size of payloads or to size buffers before
+// encoding values into them.
+//
+// 	size, err := protocol.EncodedSize(v)
+// 	if err != nil {
+// 		return err
+// 	}
+// 	var buf bytes.Buffer
+// 	buf.Grow(size)
+// 	err = protocol.Binary.Encode(v, &buf)
+func EncodedSize(v wire.Value) (int, error) {
+	return binary.EncodedSize(v)
+}
+
 func (e errUnexpectedEnvelopeType) Error() string {
 	return fmt.Sprintf("unexpected envelope type: %v", wire.EnvelopeType(e))
 }
 
 func init() {
 	Binary = binaryProtocol{}
+	NonStrictBinary = binaryProtocol{NonStrict: true}
 	EnvelopeAgnosticBinary = binaryProtocol{}
 }
 
-type binaryProtocol struct{}
+type binaryProtocol struct {
+	// NonStrict writes envelopes without a version if set.
+	NonStrict bool
+
+	limits   binary.Limits
+	interner *binary.Interner
+}
 
 func (binaryProtocol) Encode(v wire.Value, w io.Writer) error {
 	writer := binary.BorrowWriter(w)
@@ -116,25 +150,55 @@ func (binaryProtocol) Encode(v wire.Value, w io.Writer) error {
 	return err
 }
 
-func (binaryProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
-	reader := binary.NewReader(r)
+func (b binaryProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	reader := binary.NewLimitedReader(r, b.limits)
+	reader.SetInterner(b.interner)
 	value, _, err := reader.ReadValue(t, 0)
 	return value, err
 }
 
-func (binaryProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
+func (b binaryProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
 	writer := binary.BorrowWriter(w)
-	err := writer.WriteEnveloped(e)
+	var err error
+	if b.NonStrict {
+		err = writer.WriteLegacyEnveloped(e)
+	} else {
+		err = writer.WriteEnveloped(e)
+	}
 	binary.ReturnWriter(writer)
 	return err
 }
 
-func (binaryProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
-	reader := binary.NewReader(r)
+func (b binaryProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	reader := binary.NewLimitedReader(r, b.limits)
+	reader.SetInterner(b.interner)
 	e, err := reader.ReadEnveloped()
 	return e, err
 }
 
+// Reader returns a streaming decoder for the Binary protocol, allowing
+// Binary to be used as a stream.Protocol.
+func (b binaryProtocol) Reader(r io.Reader) stream.Reader {
+	reader := binary.NewLimitedStreamReader(r, b.limits)
+	reader.SetInterner(b.interner)
+	return reader
+}
+
+// Writer returns a streaming encoder for the Binary protocol, allowing
+// Binary to be used as a stream.Protocol. Envelopes are written in the
+// same format as EncodeEnveloped.
+func (b binaryProtocol) Writer(w io.Writer) stream.Writer {
+	writer := binary.NewStreamWriter(w)
+	writer.SetNonStrict(b.NonStrict)
+	return writer
+}
+
+func (b binaryProtocol) DecodeEnvelopedArena(a *binary.Arena, r io.ReaderAt) (wire.Envelope, error) {
+	reader := a.NewLimitedReader(r, b.limits)
+	reader.SetInterner(b.interner)
+	return reader.ReadEnveloped()
+}
+
 // DecodeRequest specializes Decode and replaces DecodeEnveloped for the
 // specific purpose of decoding request structs that may or may not have an
 // envelope.
@@ -0,0 +1,231 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"io"
+	"sync"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+const (
+	// Size of the chunks of memory from which an Arena allocates field
+	// lists and byte slices.
+	arenaFieldChunkSize = 256
+	arenaBytesChunkSize = 16384 // 16 KB
+
+	// Byte slices longer than this are allocated separately rather than
+	// from the arena's chunks so that a few large values don't waste the
+	// rest of a chunk.
+	arenaMaxBytesAlloc = 4096 // 4 KB
+
+	// Maximum number of chunks of each kind retained when an Arena is
+	// released so that a single large request doesn't keep memory around
+	// indefinitely.
+	arenaMaxRetainedChunks = 16
+)
+
+var arenaPool = sync.Pool{New: func() interface{} {
+	return &Arena{}
+}}
+
+// Arena holds memory used to decode Thrift Binary Protocol values so that
+// it may be re-used across requests.
+//
+// Readers obtained from an Arena allocate the field lists of structs and the
+// contents of binary values from memory owned by the Arena. Once the caller
+// is finished with the values, Release makes the memory available for the
+// next request.
+//
+// 	arena := binary.BorrowArena()
+// 	defer arena.Release()
+//
+// 	value, err := arena.Decode(r, wire.TStruct)
+// 	...
+//
+// Values decoded using an Arena, including any byte slices retrieved from
+// them, MUST NOT be used after the Arena is released. Copy any data that must
+// outlive the request.
+//
+// An Arena is not safe for concurrent use.
+type Arena struct {
+	readers []*Reader
+	nreader int
+
+	// Fields of a struct being decoded are accumulated here before they are
+	// copied into a field chunk. Nested structs push onto the end of this
+	// stack and pop their fields off before the enclosing struct continues.
+	fieldStack []wire.Field
+
+	fields [][]wire.Field
+	bytes  [][]byte
+}
+
+// BorrowArena fetches an Arena from the system.
+//
+// The Arena must be returned back using Release.
+func BorrowArena() *Arena {
+	return arenaPool.Get().(*Arena)
+}
+
+// Release returns the Arena back to the system. Values decoded using the
+// Arena MUST NOT be used after this call.
+func (a *Arena) Release() {
+	for i := 0; i < a.nreader; i++ {
+		a.readers[i].reader = nil
+	}
+	a.nreader = 0
+	a.fieldStack = a.fieldStack[:0]
+
+	a.fields = releaseChunks(a.fields)
+	a.bytes = releaseByteChunks(a.bytes)
+
+	arenaPool.Put(a)
+}
+
+// NewReader builds a Reader based on the given io.ReaderAt which allocates
+// from this Arena.
+func (a *Arena) NewReader(r io.ReaderAt) *Reader {
+	if a.nreader == len(a.readers) {
+		a.readers = append(a.readers, &Reader{arena: a})
+	}
+	reader := a.readers[a.nreader]
+	a.nreader++
+
+	reader.reader = r
+	reader.limits = Limits{}
+	reader.interner = nil
+	return reader
+}
+
+// NewLimitedReader builds a Reader based on the given io.ReaderAt which
+// allocates from this Arena and rejects values exceeding the given limits
+// with a LimitError.
+func (a *Arena) NewLimitedReader(r io.ReaderAt, l Limits) *Reader {
+	reader := a.NewReader(r)
+	reader.limits = l
+	return reader
+}
+
+// Decode reads a value of the given type from the given io.ReaderAt using
+// memory from this Arena.
+func (a *Arena) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	value, _, err := a.NewReader(r).ReadValue(t, 0)
+	return value, err
+}
+
+// DecodeEnveloped reads an enveloped value from the given io.ReaderAt using
+// memory from this Arena.
+func (a *Arena) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	return a.NewReader(r).ReadEnveloped()
+}
+
+// pushField records a field of the struct being decoded.
+func (a *Arena) pushField(f wire.Field) {
+	a.fieldStack = append(a.fieldStack, f)
+}
+
+// popFields removes the fields recorded since the given position of the
+// field stack, returning a copy of them allocated from the Arena.
+func (a *Arena) popFields(start int) []wire.Field {
+	n := len(a.fieldStack) - start
+	if n == 0 {
+		return nil
+	}
+
+	fields := a.allocFields(n)
+	copy(fields, a.fieldStack[start:])
+	a.fieldStack = a.fieldStack[:start]
+	return fields
+}
+
+func (a *Arena) allocFields(n int) []wire.Field {
+	if len(a.fields) > 0 {
+		last := a.fields[len(a.fields)-1]
+		if l := len(last); cap(last)-l >= n {
+			a.fields[len(a.fields)-1] = last[:l+n]
+			return last[l : l+n : l+n]
+		}
+	}
+
+	size := arenaFieldChunkSize
+	if n > size {
+		size = n
+	}
+	chunk := make([]wire.Field, n, size)
+	a.fields = append(a.fields, chunk)
+	return chunk[:n:n]
+}
+
+// allocBytes returns a byte slice of length n. Its contents are undefined.
+func (a *Arena) allocBytes(n int) []byte {
+	if n > arenaMaxBytesAlloc {
+		return make([]byte, n)
+	}
+
+	if len(a.bytes) > 0 {
+		last := a.bytes[len(a.bytes)-1]
+		if l := len(last); cap(last)-l >= n {
+			a.bytes[len(a.bytes)-1] = last[:l+n]
+			return last[l : l+n : l+n]
+		}
+	}
+
+	chunk := make([]byte, n, arenaBytesChunkSize)
+	a.bytes = append(a.bytes, chunk)
+	return chunk[:n:n]
+}
+
+// releaseChunks empties the given chunks, retaining at most
+// arenaMaxRetainedChunks of them.
+func releaseChunks(chunks [][]wire.Field) [][]wire.Field {
+	kept := chunks[:0]
+	for _, c := range chunks {
+		// Clear the fields so that we don't hold onto the values they
+		// reference.
+		for i := range c {
+			c[i] = wire.Field{}
+		}
+		if cap(c) == arenaFieldChunkSize && len(kept) < arenaMaxRetainedChunks {
+			kept = append(kept, c[:0])
+		}
+	}
+	for i := len(kept); i < len(chunks); i++ {
+		chunks[i] = nil
+	}
+	return kept
+}
+
+// releaseByteChunks empties the given chunks, retaining at most
+// arenaMaxRetainedChunks of them.
+func releaseByteChunks(chunks [][]byte) [][]byte {
+	kept := chunks[:0]
+	for _, c := range chunks {
+		if len(kept) < arenaMaxRetainedChunks {
+			kept = append(kept, c[:0])
+		}
+	}
+	for i := len(kept); i < len(chunks); i++ {
+		chunks[i] = nil
+	}
+	return kept
+}
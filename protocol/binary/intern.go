@@ -0,0 +1,130 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import "sync"
+
+// Interner de-duplicates short strings and binary values decoded by
+// Readers and StreamReaders so that payloads with highly repetitive
+// content, for example maps of tags or enum-like string fields, share
+// memory instead of allocating a copy of every occurrence.
+//
+// 	interner := binary.NewInterner(4096, 64)
+//
+// 	reader := binary.NewReader(r)
+// 	reader.SetInterner(interner)
+//
+// The Interner holds at most a fixed number of values. Once it is full, it
+// forgets all of them and starts over so that a stream of unique values
+// can't grow it without bound.
+//
+// Binary values returned by an Interner are shared between all values that
+// were decoded with the same contents and MUST NOT be modified.
+//
+// An Interner is safe for concurrent use.
+type Interner struct {
+	maxEntries int
+	maxLength  int
+
+	mu      sync.Mutex
+	entries map[string]*internEntry
+}
+
+type internEntry struct {
+	s string
+	b []byte // filled lazily from s
+}
+
+// NewInterner builds an Interner which holds up to maxEntries values, each
+// of at most maxLength bytes. Longer values are not interned.
+func NewInterner(maxEntries, maxLength int) *Interner {
+	return &Interner{
+		maxEntries: maxEntries,
+		maxLength:  maxLength,
+		entries:    make(map[string]*internEntry),
+	}
+}
+
+// MaxLength returns the length of the longest value that will be interned.
+func (i *Interner) MaxLength() int {
+	return i.maxLength
+}
+
+// Interns reports whether values of the given length are interned. Readers
+// of other protocols use this to decide whether to read values through the
+// Interner.
+func (i *Interner) Interns(n int) bool {
+	return n > 0 && n <= i.maxLength && i.maxEntries > 0
+}
+
+// String returns a string with the given contents, re-using a previously
+// returned string if possible. The given slice is not retained.
+func (i *Interner) String(b []byte) string {
+	if !i.Interns(len(b)) {
+		return string(b)
+	}
+	return i.lookup(b).s
+}
+
+// Bytes returns a byte slice with the given contents, re-using a previously
+// returned slice if possible. The given slice is not retained, so callers
+// may re-use it for the next value.
+//
+// The returned slice MUST NOT be modified.
+func (i *Interner) Bytes(b []byte) []byte {
+	if !i.Interns(len(b)) {
+		return append([]byte(nil), b...)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	e := i.lookupLocked(b)
+	if e.b == nil {
+		// Limit the capacity so that appending to the slice can't write
+		// into memory shared with other values.
+		bs := []byte(e.s)
+		e.b = bs[:len(bs):len(bs)]
+	}
+	return e.b
+}
+
+func (i *Interner) lookup(b []byte) *internEntry {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.lookupLocked(b)
+}
+
+func (i *Interner) lookupLocked(b []byte) *internEntry {
+	// The compiler doesn't allocate for string(b) when it's only used as a
+	// map key.
+	if e, ok := i.entries[string(b)]; ok {
+		return e
+	}
+
+	if len(i.entries) >= i.maxEntries {
+		i.entries = make(map[string]*internEntry)
+	}
+
+	e := &internEntry{s: string(b)}
+	i.entries[e.s] = e
+	return e
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import "fmt"
+
+// Limits restricts the values accepted by a decoder so that malicious or
+// corrupt payloads can't exhaust memory or the stack. Zero values mean
+// that the corresponding limit is not enforced.
+type Limits struct {
+	// Maximum number of items in a list, set, or map.
+	MaxContainerSize int
+
+	// Maximum nesting depth of structs and containers. A struct with only
+	// primitive fields has a depth of 1.
+	MaxDepth int
+
+	// Maximum length of a binary or string value in bytes.
+	MaxStringLength int
+
+	// Maximum number of bytes consumed while skipping over a single value,
+	// for example a field unknown to a streaming decoder.
+	MaxSkipBytes int64
+}
+
+// LimitError is returned by decoders when a value exceeds one of their
+// Limits.
+type LimitError struct {
+	// Name of the limit that was exceeded, for example "MaxDepth".
+	Limit string
+
+	// Size, length, or depth that was requested.
+	Value int64
+
+	// Maximum allowed by the limit.
+	Max int64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v exceeded: %d is greater than %d", e.Limit, e.Value, e.Max)
+}
+
+// CheckDepth returns a LimitError if depth exceeds l.MaxDepth.
+func (l *Limits) CheckDepth(depth int) error {
+	if l.MaxDepth > 0 && depth > l.MaxDepth {
+		return &LimitError{Limit: "MaxDepth", Value: int64(depth), Max: int64(l.MaxDepth)}
+	}
+	return nil
+}
+
+// CheckContainerSize returns a LimitError if size exceeds
+// l.MaxContainerSize.
+func (l *Limits) CheckContainerSize(size int32) error {
+	if l.MaxContainerSize > 0 && int64(size) > int64(l.MaxContainerSize) {
+		return &LimitError{Limit: "MaxContainerSize", Value: int64(size), Max: int64(l.MaxContainerSize)}
+	}
+	return nil
+}
+
+// CheckStringLength returns a LimitError if length exceeds
+// l.MaxStringLength.
+func (l *Limits) CheckStringLength(length int32) error {
+	if l.MaxStringLength > 0 && int64(length) > int64(l.MaxStringLength) {
+		return &LimitError{Limit: "MaxStringLength", Value: int64(length), Max: int64(l.MaxStringLength)}
+	}
+	return nil
+}
+
+// CheckSkipBytes returns a LimitError if n exceeds l.MaxSkipBytes.
+func (l *Limits) CheckSkipBytes(n int64) error {
+	if l.MaxSkipBytes > 0 && n > l.MaxSkipBytes {
+		return &LimitError{Limit: "MaxSkipBytes", Value: n, Max: l.MaxSkipBytes}
+	}
+	return nil
+}
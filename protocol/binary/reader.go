@@ -39,6 +39,19 @@ type Reader struct {
 
 	// This buffer is re-used every time we need a slice of up to 8 bytes.
 	buffer [8]byte
+
+	// If non-nil, struct fields and binary values are allocated from this
+	// arena.
+	arena *Arena
+
+	limits Limits
+	depth  int // nesting depth of the value being read
+
+	// If non-nil, short binary values are de-duplicated through this
+	// Interner. They're read into scratch first so that values which were
+	// seen before don't allocate.
+	interner *Interner
+	scratch  []byte
 }
 
 // NewReader builds a new Reader based on the given io.ReaderAt.
@@ -46,6 +59,34 @@ func NewReader(r io.ReaderAt) Reader {
 	return Reader{reader: r}
 }
 
+// NewLimitedReader builds a new Reader based on the given io.ReaderAt which
+// rejects values exceeding the given limits with a LimitError.
+func NewLimitedReader(r io.ReaderAt, l Limits) Reader {
+	return Reader{reader: r, limits: l}
+}
+
+// SetInterner configures the Reader to de-duplicate short strings and
+// binary values through the given Interner. Binary values decoded by the
+// Reader may then share memory and MUST NOT be modified.
+func (br *Reader) SetInterner(i *Interner) {
+	br.interner = i
+}
+
+// enter records that a struct or container is being read, failing if it
+// is nested too deeply. Calls to enter must be paired with calls to leave
+// if they succeed.
+func (br *Reader) enter() error {
+	if err := br.limits.CheckDepth(br.depth + 1); err != nil {
+		return err
+	}
+	br.depth++
+	return nil
+}
+
+func (br *Reader) leave() {
+	br.depth--
+}
+
 // For the reader, we keep track of the read offset manually everywhere so
 // that we can implement lazy collections without extra allocations
 
@@ -112,6 +153,9 @@ func (br *Reader) skipMap(off int64) (int64, error) {
 	if count < 0 {
 		return off, decodeErrorf("negative length %d requested for map", count)
 	}
+	if err := br.limits.CheckContainerSize(count); err != nil {
+		return off, err
+	}
 
 	kw := fixedWidth(kt)
 	vw := fixedWidth(vt)
@@ -149,6 +193,9 @@ func (br *Reader) skipList(off int64) (int64, error) {
 	if count < 0 {
 		return off, decodeErrorf("negative length %d requested for collection", count)
 	}
+	if err := br.limits.CheckContainerSize(count); err != nil {
+		return off, err
+	}
 
 	vw := fixedWidth(vt)
 	if vw > 0 {
@@ -182,18 +229,31 @@ func (br *Reader) skipValue(t wire.Type, off int64) (int64, error) {
 				"negative length %d requested for binary value", length,
 			)
 		}
+		if err := br.limits.CheckStringLength(length); err != nil {
+			return off, err
+		}
 		off += int64(length)
 		return off, err
+	case wire.TStruct, wire.TMap, wire.TSet, wire.TList:
+		if err := br.enter(); err != nil {
+			return off, err
+		}
+		defer br.leave()
+		return br.skipContainer(t, off)
+	default:
+		return off, decodeErrorf("unknown ttype %v", t)
+	}
+}
+
+// skipContainer skips over a struct, map, set, or list.
+func (br *Reader) skipContainer(t wire.Type, off int64) (int64, error) {
+	switch t {
 	case wire.TStruct:
 		return br.skipStruct(off)
 	case wire.TMap:
 		return br.skipMap(off)
-	case wire.TSet:
-		return br.skipList(off)
-	case wire.TList:
-		return br.skipList(off)
 	default:
-		return off, decodeErrorf("unknown ttype %v", t)
+		return br.skipList(off)
 	}
 }
 
@@ -253,9 +313,15 @@ func (br *Reader) readBytes(off int64) ([]byte, int64, error) {
 			"negative length %d requested for binary value", length,
 		)
 	}
+	if err := br.limits.CheckStringLength(length); err != nil {
+		return nil, off, err
+	}
 	if length == 0 {
 		return nil, off, nil
 	}
+	if br.interner != nil && br.interner.Interns(int(length)) {
+		return br.readInterned(int(length), off)
+	}
 
 	// Use a dynamically resizing buffer for requests larger than
 	// bytesAllocThreshold. We don't want bad requests to lock the system up.
@@ -268,17 +334,43 @@ func (br *Reader) readBytes(off int64) ([]byte, int64, error) {
 		return buff.Bytes(), off, err
 	}
 
-	bs := make([]byte, length)
+	var bs []byte
+	if br.arena != nil {
+		bs = br.arena.allocBytes(int(length))
+	} else {
+		bs = make([]byte, length)
+	}
 	off, err = br.read(bs, off)
 	return bs, off, err
 }
 
+// readInterned reads a binary value of the given length through the
+// Reader's Interner.
+func (br *Reader) readInterned(length int, off int64) ([]byte, int64, error) {
+	if cap(br.scratch) < length {
+		br.scratch = make([]byte, br.interner.MaxLength())
+	}
+	bs := br.scratch[:length]
+	off, err := br.read(bs, off)
+	if err != nil {
+		return nil, off, err
+	}
+	return br.interner.Bytes(bs), off, nil
+}
+
 func (br *Reader) readString(off int64) (string, int64, error) {
 	v, off, err := br.readBytes(off)
+	if br.interner != nil {
+		return br.interner.String(v), off, err
+	}
 	return string(v), off, err
 }
 
 func (br *Reader) readStruct(off int64) (wire.Struct, int64, error) {
+	if br.arena != nil {
+		return br.readArenaStruct(off)
+	}
+
 	var fields []wire.Field
 	// TODO(abg) add a lazy FieldList type instead of []Field.
 
@@ -311,6 +403,38 @@ func (br *Reader) readStruct(off int64) (wire.Struct, int64, error) {
 	return wire.Struct{Fields: fields}, off, err
 }
 
+// readArenaStruct is a variant of readStruct which allocates the field list
+// from the Reader's arena.
+func (br *Reader) readArenaStruct(off int64) (wire.Struct, int64, error) {
+	a := br.arena
+	start := len(a.fieldStack)
+
+	typ, off, err := br.readByte(off)
+	for err == nil && typ != 0 {
+		var fid int16
+		var val wire.Value
+
+		fid, off, err = br.readInt16(off)
+		if err != nil {
+			break
+		}
+
+		val, off, err = br.ReadValue(wire.Type(typ), off)
+		if err != nil {
+			break
+		}
+
+		a.pushField(wire.Field{ID: fid, Value: val})
+		typ, off, err = br.readByte(off)
+	}
+
+	if err != nil {
+		a.fieldStack = a.fieldStack[:start]
+		return wire.Struct{}, off, err
+	}
+	return wire.Struct{Fields: a.popFields(start)}, off, nil
+}
+
 func (br *Reader) readMap(off int64) (wire.MapItemList, int64, error) {
 	ktByte, off, err := br.readByte(off)
 	if err != nil {
@@ -329,6 +453,9 @@ func (br *Reader) readMap(off int64) (wire.MapItemList, int64, error) {
 	if count < 0 {
 		return nil, off, decodeErrorf("negative length %d requested for map", count)
 	}
+	if err := br.limits.CheckContainerSize(count); err != nil {
+		return nil, off, err
+	}
 
 	kt := wire.Type(ktByte)
 	vt := wire.Type(vtByte)
@@ -369,6 +496,9 @@ func (br *Reader) readSet(off int64) (wire.ValueList, int64, error) {
 	if count < 0 {
 		return nil, off, decodeErrorf("negative length %d requested for set", count)
 	}
+	if err := br.limits.CheckContainerSize(count); err != nil {
+		return nil, off, err
+	}
 
 	start := off
 	for i := int32(0); i < count; i++ {
@@ -400,6 +530,9 @@ func (br *Reader) readList(off int64) (wire.ValueList, int64, error) {
 	if count < 0 {
 		return nil, off, decodeErrorf("negative length %d requested for list", count)
 	}
+	if err := br.limits.CheckContainerSize(count); err != nil {
+		return nil, off, err
+	}
 
 	start := off
 	for i := int32(0); i < count; i++ {
@@ -418,6 +551,27 @@ func (br *Reader) readList(off int64) (wire.ValueList, int64, error) {
 	return items, off, err
 }
 
+// readContainer reads a struct, map, set, or list.
+func (br *Reader) readContainer(t wire.Type, off int64) (wire.Value, int64, error) {
+	switch t {
+	case wire.TStruct:
+		s, off, err := br.readStruct(off)
+		return wire.NewValueStruct(s), off, err
+
+	case wire.TMap:
+		m, off, err := br.readMap(off)
+		return wire.NewValueMap(m), off, err
+
+	case wire.TSet:
+		s, off, err := br.readSet(off)
+		return wire.NewValueSet(s), off, err
+
+	default:
+		l, off, err := br.readList(off)
+		return wire.NewValueList(l), off, err
+	}
+}
+
 // ReadValue reads a value off the given type off the wire starting at the
 // given offset.
 //
@@ -463,21 +617,12 @@ func (br *Reader) ReadValue(t wire.Type, off int64) (wire.Value, int64, error) {
 		v, off, err := br.readBytes(off)
 		return wire.NewValueBinary(v), off, err
 
-	case wire.TStruct:
-		s, off, err := br.readStruct(off)
-		return wire.NewValueStruct(s), off, err
-
-	case wire.TMap:
-		m, off, err := br.readMap(off)
-		return wire.NewValueMap(m), off, err
-
-	case wire.TSet:
-		s, off, err := br.readSet(off)
-		return wire.NewValueSet(s), off, err
-
-	case wire.TList:
-		l, off, err := br.readList(off)
-		return wire.NewValueList(l), off, err
+	case wire.TStruct, wire.TMap, wire.TSet, wire.TList:
+		if err := br.enter(); err != nil {
+			return wire.Value{}, off, err
+		}
+		defer br.leave()
+		return br.readContainer(t, off)
 
 	default:
 		return wire.Value{}, off, decodeErrorf("unknown ttype %v", t)
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// EncodedSize returns the number of bytes the given value occupies when
+// encoded with the Thrift Binary Protocol, without encoding it.
+//
+// An error is returned if the value could not be encoded, for example
+// because a lazily evaluated list failed.
+func EncodedSize(v wire.Value) (int, error) {
+	switch v.Type() {
+	case wire.TBool, wire.TI8:
+		return 1, nil
+
+	case wire.TI16:
+		return 2, nil
+
+	case wire.TI32:
+		return 4, nil
+
+	case wire.TI64, wire.TDouble:
+		return 8, nil
+
+	case wire.TBinary:
+		// length:4 bytes
+		return 4 + len(v.GetBinary()), nil
+
+	case wire.TStruct:
+		return structSize(v.GetStruct())
+
+	case wire.TMap:
+		return mapSize(v.GetMap())
+
+	case wire.TSet:
+		return listSize(v.GetSet())
+
+	case wire.TList:
+		return listSize(v.GetList())
+
+	default:
+		return 0, fmt.Errorf("unknown ttype %v", v.Type())
+	}
+}
+
+func structSize(s wire.Struct) (int, error) {
+	size := 1 // stop:1
+	for _, f := range s.Fields {
+		// type:1 id:2 value
+		n, err := EncodedSize(f.Value)
+		if err != nil {
+			return 0, fmt.Errorf(
+				"failed to size field %d (%v): %s",
+				f.ID, f.Value.Type(), err,
+			)
+		}
+		size += 3 + n
+	}
+	return size, nil
+}
+
+func mapSize(m wire.MapItemList) (int, error) {
+	size := 6 // ktype:1 vtype:1 length:4
+	err := m.ForEach(func(item wire.MapItem) error {
+		k, err := EncodedSize(item.Key)
+		if err != nil {
+			return err
+		}
+		v, err := EncodedSize(item.Value)
+		if err != nil {
+			return err
+		}
+		size += k + v
+		return nil
+	})
+	return size, err
+}
+
+func listSize(l wire.ValueList) (int, error) {
+	size := 5 // vtype:1 length:4
+	err := l.ForEach(func(v wire.Value) error {
+		n, err := EncodedSize(v)
+		size += n
+		return err
+	})
+	return size, err
+}
@@ -0,0 +1,500 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math"
+
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+)
+
+var _ stream.Reader = (*StreamReader)(nil)
+
+// StreamReader implements a streaming parser for the Thrift Binary Protocol
+// based on an io.Reader.
+//
+// Unlike Reader, StreamReader does not build intermediate wire.Value
+// objects.
+type StreamReader struct {
+	reader io.Reader
+
+	// This buffer is re-used every time we need a slice of up to 8 bytes.
+	buffer [8]byte
+
+	limits Limits
+	depth  int // nesting depth of the value being read
+
+	// Number of bytes consumed by the value being skipped, if any.
+	skipping bool
+	skipped  int64
+
+	// If non-nil, short binary values are de-duplicated through this
+	// Interner.
+	interner *Interner
+	scratch  []byte
+}
+
+// NewStreamReader builds a new StreamReader based on the given io.Reader.
+func NewStreamReader(r io.Reader) *StreamReader {
+	return &StreamReader{reader: r}
+}
+
+// NewLimitedStreamReader builds a new StreamReader based on the given
+// io.Reader which rejects values exceeding the given limits with a
+// LimitError.
+func NewLimitedStreamReader(r io.Reader, l Limits) *StreamReader {
+	return &StreamReader{reader: r, limits: l}
+}
+
+// SetInterner configures the StreamReader to de-duplicate short strings
+// and binary values through the given Interner. Binary values decoded by
+// the StreamReader may then share memory and MUST NOT be modified.
+func (sr *StreamReader) SetInterner(i *Interner) {
+	sr.interner = i
+}
+
+// consume records that n bytes are about to be read, failing if that
+// exceeds the number of bytes we may skip over.
+func (sr *StreamReader) consume(n int64) error {
+	if !sr.skipping {
+		return nil
+	}
+	sr.skipped += n
+	return sr.limits.CheckSkipBytes(sr.skipped)
+}
+
+func (sr *StreamReader) enter() error {
+	if err := sr.limits.CheckDepth(sr.depth + 1); err != nil {
+		return err
+	}
+	sr.depth++
+	return nil
+}
+
+func (sr *StreamReader) leave() {
+	sr.depth--
+}
+
+func (sr *StreamReader) read(bs []byte) error {
+	if err := sr.consume(int64(len(bs))); err != nil {
+		return err
+	}
+	_, err := io.ReadFull(sr.reader, bs)
+	if err == io.EOF {
+		// All EOFs are unexpected for the decoder
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (sr *StreamReader) discard(n int64) error {
+	if err := sr.consume(n); err != nil {
+		return err
+	}
+	_, err := io.CopyN(ioutil.Discard, sr.reader, n)
+	if err == io.EOF {
+		// All EOFs are unexpected for the decoder
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (sr *StreamReader) readByte() (byte, error) {
+	bs := sr.buffer[0:1]
+	err := sr.read(bs)
+	return bs[0], err
+}
+
+func (sr *StreamReader) readLength(kind string) (int, error) {
+	n, err := sr.ReadInt32()
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, decodeErrorf("negative length %d requested for %v", n, kind)
+	}
+	return int(n), nil
+}
+
+func (sr *StreamReader) readBinaryLength() (int, error) {
+	n, err := sr.readLength("binary value")
+	if err != nil {
+		return 0, err
+	}
+	return n, sr.limits.CheckStringLength(int32(n))
+}
+
+func (sr *StreamReader) readContainerSize(kind string) (int, error) {
+	n, err := sr.readLength(kind)
+	if err != nil {
+		return 0, err
+	}
+	return n, sr.limits.CheckContainerSize(int32(n))
+}
+
+// ReadBool reads a Thrift encoded bool value.
+func (sr *StreamReader) ReadBool() (bool, error) {
+	b, err := sr.readByte()
+	if err != nil {
+		return false, err
+	}
+
+	if b != 0 && b != 1 {
+		return false, decodeErrorf("invalid value %q for bool field", b)
+	}
+	return b == 1, nil
+}
+
+// ReadInt8 reads a Thrift encoded int8 value.
+func (sr *StreamReader) ReadInt8() (int8, error) {
+	b, err := sr.readByte()
+	return int8(b), err
+}
+
+// ReadInt16 reads a Thrift encoded int16 value.
+func (sr *StreamReader) ReadInt16() (int16, error) {
+	bs := sr.buffer[0:2]
+	err := sr.read(bs)
+	return int16(bigEndian.Uint16(bs)), err
+}
+
+// ReadInt32 reads a Thrift encoded int32 value.
+func (sr *StreamReader) ReadInt32() (int32, error) {
+	bs := sr.buffer[0:4]
+	err := sr.read(bs)
+	return int32(bigEndian.Uint32(bs)), err
+}
+
+// ReadInt64 reads a Thrift encoded int64 value.
+func (sr *StreamReader) ReadInt64() (int64, error) {
+	bs := sr.buffer[0:8]
+	err := sr.read(bs)
+	return int64(bigEndian.Uint64(bs)), err
+}
+
+// ReadDouble reads a Thrift encoded double value.
+func (sr *StreamReader) ReadDouble() (float64, error) {
+	n, err := sr.ReadInt64()
+	return math.Float64frombits(uint64(n)), err
+}
+
+// ReadBinary reads a Thrift encoded binary value.
+func (sr *StreamReader) ReadBinary() ([]byte, error) {
+	length, err := sr.readBinaryLength()
+	if err != nil {
+		return nil, err
+	}
+	if length == 0 {
+		// Match wire.NewValueBinary: empty binary values are non-nil so that
+		// they can be told apart from unset optional fields.
+		return []byte{}, nil
+	}
+
+	if sr.interner != nil && sr.interner.Interns(length) {
+		bs, err := sr.readInterned(length)
+		if err != nil {
+			return nil, err
+		}
+		return sr.interner.Bytes(bs), nil
+	}
+	return sr.readBytes(length)
+}
+
+// readBytes reads the contents of a binary value of the given length.
+func (sr *StreamReader) readBytes(length int) ([]byte, error) {
+	// Use a dynamically resizing buffer for requests larger than
+	// bytesAllocThreshold. We don't want bad requests to lock the system up.
+	if length > bytesAllocThreshold {
+		var buff bytes.Buffer
+		if _, err := io.CopyN(&buff, sr.reader, int64(length)); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		return buff.Bytes(), nil
+	}
+
+	bs := make([]byte, length)
+	err := sr.read(bs)
+	return bs, err
+}
+
+// readInterned reads the contents of a binary value of the given length
+// into the StreamReader's scratch buffer. The result is valid only until
+// the next call.
+func (sr *StreamReader) readInterned(length int) ([]byte, error) {
+	if cap(sr.scratch) < length {
+		sr.scratch = make([]byte, sr.interner.MaxLength())
+	}
+	bs := sr.scratch[:length]
+	return bs, sr.read(bs)
+}
+
+// ReadString reads a Thrift encoded string value.
+func (sr *StreamReader) ReadString() (string, error) {
+	length, err := sr.readBinaryLength()
+	if err != nil || length == 0 {
+		return "", err
+	}
+
+	if sr.interner != nil && sr.interner.Interns(length) {
+		bs, err := sr.readInterned(length)
+		if err != nil {
+			return "", err
+		}
+		return sr.interner.String(bs), nil
+	}
+
+	bs, err := sr.readBytes(length)
+	return string(bs), err
+}
+
+// ReadStructBegin reads the beginning of a struct. Binary structs have no
+// header so this only checks that the struct isn't nested too deeply.
+func (sr *StreamReader) ReadStructBegin() error {
+	return sr.enter()
+}
+
+// ReadStructEnd reads the end of a struct. The end of a struct is marked by
+// a stop field which is consumed by ReadFieldBegin, so this reads nothing.
+func (sr *StreamReader) ReadStructEnd() error {
+	sr.leave()
+	return nil
+}
+
+// ReadFieldBegin reads the header of the next field in a struct. The
+// returned boolean is false if there are no more fields in the struct.
+func (sr *StreamReader) ReadFieldBegin() (stream.FieldHeader, bool, error) {
+	typ, err := sr.readByte()
+	if err != nil {
+		return stream.FieldHeader{}, false, err
+	}
+
+	// STOP
+	if typ == 0 {
+		return stream.FieldHeader{}, false, nil
+	}
+
+	id, err := sr.ReadInt16()
+	if err != nil {
+		return stream.FieldHeader{}, false, err
+	}
+
+	return stream.FieldHeader{ID: id, Type: wire.Type(typ)}, true, nil
+}
+
+// ReadFieldEnd reads the end of a field. This is a no-op for the Binary
+// protocol.
+func (sr *StreamReader) ReadFieldEnd() error {
+	return nil
+}
+
+// ReadListBegin reads the header of a list.
+func (sr *StreamReader) ReadListBegin() (stream.ListHeader, error) {
+	if err := sr.enter(); err != nil {
+		return stream.ListHeader{}, err
+	}
+
+	typ, err := sr.readByte()
+	if err != nil {
+		return stream.ListHeader{}, err
+	}
+
+	length, err := sr.readContainerSize("list")
+	if err != nil {
+		return stream.ListHeader{}, err
+	}
+
+	return stream.ListHeader{Type: wire.Type(typ), Length: length}, nil
+}
+
+// ReadListEnd reads the end of a list. Nothing is read for the Binary
+// protocol.
+func (sr *StreamReader) ReadListEnd() error {
+	sr.leave()
+	return nil
+}
+
+// ReadSetBegin reads the header of a set.
+func (sr *StreamReader) ReadSetBegin() (stream.SetHeader, error) {
+	if err := sr.enter(); err != nil {
+		return stream.SetHeader{}, err
+	}
+
+	typ, err := sr.readByte()
+	if err != nil {
+		return stream.SetHeader{}, err
+	}
+
+	length, err := sr.readContainerSize("set")
+	if err != nil {
+		return stream.SetHeader{}, err
+	}
+
+	return stream.SetHeader{Type: wire.Type(typ), Length: length}, nil
+}
+
+// ReadSetEnd reads the end of a set. Nothing is read for the Binary
+// protocol.
+func (sr *StreamReader) ReadSetEnd() error {
+	sr.leave()
+	return nil
+}
+
+// ReadMapBegin reads the header of a map.
+func (sr *StreamReader) ReadMapBegin() (stream.MapHeader, error) {
+	if err := sr.enter(); err != nil {
+		return stream.MapHeader{}, err
+	}
+
+	kt, err := sr.readByte()
+	if err != nil {
+		return stream.MapHeader{}, err
+	}
+
+	vt, err := sr.readByte()
+	if err != nil {
+		return stream.MapHeader{}, err
+	}
+
+	length, err := sr.readContainerSize("map")
+	if err != nil {
+		return stream.MapHeader{}, err
+	}
+
+	return stream.MapHeader{
+		KeyType:   wire.Type(kt),
+		ValueType: wire.Type(vt),
+		Length:    length,
+	}, nil
+}
+
+// ReadMapEnd reads the end of a map. Nothing is read for the Binary
+// protocol.
+func (sr *StreamReader) ReadMapEnd() error {
+	sr.leave()
+	return nil
+}
+
+// Skip skips over the next value of the given type.
+func (sr *StreamReader) Skip(t wire.Type) error {
+	if !sr.skipping {
+		sr.skipping = true
+		sr.skipped = 0
+		defer func() { sr.skipping = false }()
+	}
+
+	if w := fixedWidth(t); w > 0 {
+		return sr.discard(w)
+	}
+
+	switch t {
+	case wire.TBinary:
+		length, err := sr.readBinaryLength()
+		if err != nil {
+			return err
+		}
+		return sr.discard(int64(length))
+
+	case wire.TStruct:
+		return sr.skipStruct()
+
+	case wire.TMap:
+		h, err := sr.ReadMapBegin()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < h.Length; i++ {
+			if err := sr.Skip(h.KeyType); err != nil {
+				return err
+			}
+			if err := sr.Skip(h.ValueType); err != nil {
+				return err
+			}
+		}
+		return sr.ReadMapEnd()
+
+	case wire.TSet:
+		h, err := sr.ReadSetBegin()
+		if err != nil {
+			return err
+		}
+		if err := sr.skipN(h.Type, h.Length); err != nil {
+			return err
+		}
+		return sr.ReadSetEnd()
+
+	case wire.TList:
+		h, err := sr.ReadListBegin()
+		if err != nil {
+			return err
+		}
+		if err := sr.skipN(h.Type, h.Length); err != nil {
+			return err
+		}
+		return sr.ReadListEnd()
+
+	default:
+		return decodeErrorf("unknown ttype %v", t)
+	}
+}
+
+// skipN skips over n values of the given type.
+func (sr *StreamReader) skipN(t wire.Type, n int) error {
+	if w := fixedWidth(t); w > 0 {
+		// values are fixed width. skip over all of them at once.
+		return sr.discard(int64(n) * w)
+	}
+
+	for i := 0; i < n; i++ {
+		if err := sr.Skip(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sr *StreamReader) skipStruct() error {
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	for ok && err == nil {
+		if err := sr.Skip(fh.Type); err != nil {
+			return err
+		}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+		fh, ok, err = sr.ReadFieldBegin()
+	}
+	if err != nil {
+		return err
+	}
+
+	return sr.ReadStructEnd()
+}
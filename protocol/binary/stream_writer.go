@@ -0,0 +1,244 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"io"
+	"math"
+
+	"go.uber.org/thriftrw/protocol/stream"
+)
+
+var _ stream.Writer = (*StreamWriter)(nil)
+
+// StreamWriter implements a streaming encoder for the Thrift Binary Protocol
+// based on an io.Writer.
+//
+// Unlike Writer, StreamWriter does not require values to be built into
+// intermediate wire.Value objects before they're written.
+type StreamWriter struct {
+	writer io.Writer
+
+	// This buffer is re-used every time we need a slice of up to 8 bytes.
+	buffer [8]byte
+
+	// Whether envelopes are written in the non-strict format.
+	nonStrict bool
+}
+
+// NewStreamWriter builds a new StreamWriter which writes to the given
+// io.Writer.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{writer: w}
+}
+
+// SetNonStrict configures the StreamWriter to write envelopes in the
+// non-strict (unversioned) format understood by legacy Apache Thrift
+// implementations.
+func (sw *StreamWriter) SetNonStrict(nonStrict bool) {
+	sw.nonStrict = nonStrict
+}
+
+func (sw *StreamWriter) write(bs []byte) error {
+	_, err := sw.writer.Write(bs)
+	return err
+}
+
+func (sw *StreamWriter) writeByte(b byte) error {
+	bs := sw.buffer[0:1]
+	bs[0] = b
+	return sw.write(bs)
+}
+
+// WriteBool writes a Thrift encoded bool value.
+func (sw *StreamWriter) WriteBool(b bool) error {
+	if b {
+		return sw.writeByte(1)
+	}
+	return sw.writeByte(0)
+}
+
+// WriteInt8 writes a Thrift encoded int8 value.
+func (sw *StreamWriter) WriteInt8(i int8) error {
+	return sw.writeByte(byte(i))
+}
+
+// WriteInt16 writes a Thrift encoded int16 value.
+func (sw *StreamWriter) WriteInt16(i int16) error {
+	bs := sw.buffer[0:2]
+	bigEndian.PutUint16(bs, uint16(i))
+	return sw.write(bs)
+}
+
+// WriteInt32 writes a Thrift encoded int32 value.
+func (sw *StreamWriter) WriteInt32(i int32) error {
+	bs := sw.buffer[0:4]
+	bigEndian.PutUint32(bs, uint32(i))
+	return sw.write(bs)
+}
+
+// WriteInt64 writes a Thrift encoded int64 value.
+func (sw *StreamWriter) WriteInt64(i int64) error {
+	bs := sw.buffer[0:8]
+	bigEndian.PutUint64(bs, uint64(i))
+	return sw.write(bs)
+}
+
+// WriteString writes a Thrift encoded string.
+func (sw *StreamWriter) WriteString(s string) error {
+	if err := sw.WriteInt32(int32(len(s))); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(sw.writer, s)
+	return err
+}
+
+// WriteDouble writes a Thrift encoded double.
+func (sw *StreamWriter) WriteDouble(f float64) error {
+	return sw.WriteInt64(int64(math.Float64bits(f)))
+}
+
+// WriteBinary writes a Thrift encoded binary value.
+func (sw *StreamWriter) WriteBinary(b []byte) error {
+	if err := sw.WriteInt32(int32(len(b))); err != nil {
+		return err
+	}
+	return sw.write(b)
+}
+
+// WriteStructBegin writes the beginning of a struct. This is a no-op for
+// the Binary Protocol.
+func (sw *StreamWriter) WriteStructBegin() error {
+	return nil
+}
+
+// WriteStructEnd writes the end of a struct.
+func (sw *StreamWriter) WriteStructEnd() error {
+	return sw.writeByte(0) // end struct
+}
+
+// WriteFieldBegin writes the header of a field.
+func (sw *StreamWriter) WriteFieldBegin(fh stream.FieldHeader) error {
+	// type:1
+	if err := sw.writeByte(byte(fh.Type)); err != nil {
+		return err
+	}
+
+	// id:2
+	return sw.WriteInt16(fh.ID)
+}
+
+// WriteFieldEnd writes the end of a field. This is a no-op for the Binary
+// Protocol.
+func (sw *StreamWriter) WriteFieldEnd() error {
+	return nil
+}
+
+// WriteListBegin writes the header of a list.
+func (sw *StreamWriter) WriteListBegin(lh stream.ListHeader) error {
+	// vtype:1
+	if err := sw.writeByte(byte(lh.Type)); err != nil {
+		return err
+	}
+
+	// length:4
+	return sw.WriteInt32(int32(lh.Length))
+}
+
+// WriteListEnd writes the end of a list. This is a no-op for the Binary
+// Protocol.
+func (sw *StreamWriter) WriteListEnd() error {
+	return nil
+}
+
+// WriteSetBegin writes the header of a set.
+func (sw *StreamWriter) WriteSetBegin(sh stream.SetHeader) error {
+	// vtype:1
+	if err := sw.writeByte(byte(sh.Type)); err != nil {
+		return err
+	}
+
+	// length:4
+	return sw.WriteInt32(int32(sh.Length))
+}
+
+// WriteSetEnd writes the end of a set. This is a no-op for the Binary
+// Protocol.
+func (sw *StreamWriter) WriteSetEnd() error {
+	return nil
+}
+
+// WriteMapBegin writes the header of a map.
+func (sw *StreamWriter) WriteMapBegin(mh stream.MapHeader) error {
+	// ktype:1
+	if err := sw.writeByte(byte(mh.KeyType)); err != nil {
+		return err
+	}
+
+	// vtype:1
+	if err := sw.writeByte(byte(mh.ValueType)); err != nil {
+		return err
+	}
+
+	// length:4
+	return sw.WriteInt32(int32(mh.Length))
+}
+
+// WriteMapEnd writes the end of a map. This is a no-op for the Binary
+// Protocol.
+func (sw *StreamWriter) WriteMapEnd() error {
+	return nil
+}
+
+// WriteEnvelopeBegin writes the header of an envelope using the strict
+// envelope format, or the non-strict format if configured with
+// SetNonStrict.
+func (sw *StreamWriter) WriteEnvelopeBegin(eh stream.EnvelopeHeader) error {
+	if sw.nonStrict {
+		if err := sw.WriteString(eh.Name); err != nil {
+			return err
+		}
+
+		if err := sw.writeByte(uint8(eh.Type)); err != nil {
+			return err
+		}
+
+		return sw.WriteInt32(eh.SeqID)
+	}
+
+	version := uint32(version1) | uint32(eh.Type)
+	if err := sw.WriteInt32(int32(version)); err != nil {
+		return err
+	}
+
+	if err := sw.WriteString(eh.Name); err != nil {
+		return err
+	}
+
+	return sw.WriteInt32(eh.SeqID)
+}
+
+// WriteEnvelopeEnd writes the end of an envelope. This is a no-op for the
+// Binary Protocol.
+func (sw *StreamWriter) WriteEnvelopeEnd() error {
+	return nil
+}
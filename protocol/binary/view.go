@@ -0,0 +1,158 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import (
+	"bytes"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// StructView provides access to the fields of a struct encoded with the
+// Thrift Binary protocol without decoding the whole struct. Fields are
+// found by scanning the field headers of the struct each time they're
+// accessed, skipping over the values of other fields.
+//
+// The zero value of StructView is a view of an empty struct.
+type StructView struct {
+	buf []byte
+	r   *bytes.Reader
+	off int64
+}
+
+// NewStructView builds a view of the struct encoded in the given bytes. The
+// bytes must not be modified while the view, or binary values read from it,
+// are in use.
+func NewStructView(b []byte) StructView {
+	return StructView{buf: b, r: bytes.NewReader(b)}
+}
+
+// find returns the offset of the value of the field with the given ID and
+// type. Fields with the given ID but a different type are ignored, and the
+// last of multiple fields with the same ID wins, as they would be by
+// FromWire. This means that the whole struct is always scanned.
+func (v StructView) find(id int16, t wire.Type) (int64, bool, error) {
+	if v.r == nil {
+		return 0, false, nil
+	}
+
+	var (
+		found bool
+		value int64
+	)
+
+	br := NewReader(v.r)
+	off := v.off
+	for {
+		typ, next, err := br.readByte(off)
+		if err != nil {
+			return 0, false, err
+		}
+		if typ == 0 {
+			return value, found, nil
+		}
+
+		fid, next, err := br.readInt16(next)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if fid == id && wire.Type(typ) == t {
+			found, value = true, next
+		}
+
+		off, err = br.skipValue(wire.Type(typ), next)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+}
+
+// HasField returns true if the struct has a field with the given ID and
+// type. Structs which fail to decode are treated as if they don't have the
+// field.
+func (v StructView) HasField(id int16, t wire.Type) bool {
+	_, ok, err := v.find(id, t)
+	return ok && err == nil
+}
+
+// Field decodes the value of the field with the given ID and type. It
+// returns false if the struct doesn't have such a field.
+func (v StructView) Field(id int16, t wire.Type) (wire.Value, bool, error) {
+	off, ok, err := v.find(id, t)
+	if err != nil || !ok {
+		return wire.Value{}, false, err
+	}
+
+	br := NewReader(v.r)
+	w, _, err := br.ReadValue(t, off)
+	return w, err == nil, err
+}
+
+// FieldBinary returns the value of the binary field with the given ID. The
+// value is not copied: it references the bytes the view was built from.
+func (v StructView) FieldBinary(id int16) ([]byte, bool, error) {
+	off, ok, err := v.find(id, wire.TBinary)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	br := NewReader(v.r)
+	length, off, err := br.readInt32(off)
+	if err != nil {
+		return nil, false, err
+	}
+	if length < 0 {
+		return nil, false, decodeErrorf(
+			"negative length %d requested for binary value", length,
+		)
+	}
+
+	end := off + int64(length)
+	if end > int64(len(v.buf)) {
+		return nil, false, decodeErrorf(
+			"binary value of length %d exceeds the remaining %d bytes",
+			length, int64(len(v.buf))-off)
+	}
+	return v.buf[off:end:end], true, nil
+}
+
+// FieldStruct returns a view of the struct field with the given ID. The
+// returned view is the zero value if the struct doesn't have such a
+// field.
+func (v StructView) FieldStruct(id int16) (StructView, bool, error) {
+	off, ok, err := v.find(id, wire.TStruct)
+	if err != nil || !ok {
+		return StructView{}, false, err
+	}
+	return StructView{buf: v.buf, r: v.r, off: off}, true, nil
+}
+
+// Wire decodes the whole struct into its Thrift-level representation.
+func (v StructView) Wire() (wire.Value, error) {
+	if v.r == nil {
+		return wire.NewValueStruct(wire.Struct{}), nil
+	}
+
+	br := NewReader(v.r)
+	w, _, err := br.ReadValue(wire.TStruct, v.off)
+	return w, err
+}
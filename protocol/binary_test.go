@@ -22,6 +22,7 @@ package protocol
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -50,6 +51,12 @@ func checkEncodeDecode(t *testing.T, typ wire.Type, tests []encodeDecodeTest) {
 			assert.Equal(t, tt.encoded, buffer.Bytes())
 		}
 
+		// the size must match the encoded bytes
+		size, err := EncodedSize(tt.value)
+		if assert.NoError(t, err, "EncodedSize failed:\n%s", tt.value) {
+			assert.Equal(t, len(tt.encoded), size, "EncodedSize mismatch:\n%s", tt.value)
+		}
+
 		// decode and match value
 		value, err := Binary.Decode(bytes.NewReader(tt.encoded), typ)
 		if assert.NoError(t, err, "Decode failed:\n%s", tt.value) {
@@ -65,6 +72,17 @@ func checkEncodeDecode(t *testing.T, typ wire.Type, tests []encodeDecodeTest) {
 		if assert.NoError(t, err, "Encode of decoded value failed:\n%s", tt.value) {
 			assert.Equal(t, tt.encoded, buffer.Bytes())
 		}
+
+		// decode using an arena and match value
+		arena := binary.BorrowArena()
+		value, err = arena.Decode(bytes.NewReader(tt.encoded), typ)
+		if assert.NoError(t, err, "Arena decode failed:\n%s", tt.value) {
+			assert.True(
+				t, wire.ValuesAreEqual(tt.value, value),
+				fmt.Sprintf("\n\t   %v (expected)\n\t!= %v (actual)", tt.value, value),
+			)
+		}
+		arena.Release()
 	}
 }
 
@@ -87,6 +105,22 @@ func checkDecodeFailure(t *testing.T, typ wire.Type, tests []failureTest) {
 				err,
 			)
 		}
+
+		arena := binary.BorrowArena()
+		value, err = arena.Decode(bytes.NewReader(tt), typ)
+		if err == nil {
+			err = wire.EvaluateValue(value)
+		}
+		if assert.Error(t, err, "Expected arena failure parsing %x, got %s", tt, value) {
+			assert.True(
+				t,
+				binary.IsDecodeError(err),
+				"Expected decode error while parsing %x with arena, got %s",
+				tt,
+				err,
+			)
+		}
+		arena.Release()
 	}
 }
 
@@ -642,6 +676,21 @@ func TestStructOfContainers(t *testing.T) {
 	checkEncodeDecode(t, wire.TStruct, tests)
 }
 
+type failingValueList struct{ err error }
+
+func (failingValueList) Size() int                              { return 1 }
+func (failingValueList) ValueType() wire.Type                   { return wire.TI32 }
+func (failingValueList) Close()                                 {}
+func (l failingValueList) ForEach(func(wire.Value) error) error { return l.err }
+
+func TestEncodedSizeFailure(t *testing.T) {
+	_, err := EncodedSize(vstruct(
+		vfield(1, wire.NewValueList(failingValueList{err: errors.New("great sadness")})),
+	))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "great sadness")
+}
+
 func TestBinaryEnvelopeErrors(t *testing.T) {
 	tests := []struct {
 		encoded []byte
@@ -822,6 +871,47 @@ func TestBinaryEnvelopeSuccessful(t *testing.T) {
 	}
 }
 
+func TestNonStrictBinaryEnvelope(t *testing.T) {
+	e := wire.Envelope{
+		Name:  "abc",
+		Type:  wire.Call,
+		SeqID: 5436,
+		Value: vstruct(vfield(1, vi16(100))),
+	}
+
+	nonStrict := []byte{
+		0x00, 0x00, 0x00, 0x03, 'a', 'b', 'c', // name~4 = "abc"
+		0x01,                   // type:1 = Call
+		0x00, 0x00, 0x15, 0x3c, // seqID:4 = 5436
+
+		// <struct>
+		0x06,       // type:1 = i16
+		0x00, 0x01, // id:2 = 1
+		0x00, 0x64, // value = 100
+		0x00, // stop
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, NonStrictBinary.EncodeEnveloped(e, &buf), "failed to encode")
+	assert.Equal(t, nonStrict, buf.Bytes(), "encoded envelope must be non-strict")
+
+	buf.Reset()
+	require.NoError(t, Binary.EncodeEnveloped(e, &buf), "failed to encode")
+	strict := buf.Bytes()
+
+	for _, encoded := range [][]byte{nonStrict, strict} {
+		got, err := NonStrictBinary.DecodeEnveloped(bytes.NewReader(encoded))
+		require.NoError(t, err, "failed to decode %x", encoded)
+		assert.Equal(t, e, got, "decoded envelope mismatch for %x", encoded)
+	}
+
+	// Responders reply in-kind even if the protocol writes non-strict
+	// envelopes by default.
+	_, responder, err := NonStrictBinary.(EnvelopeAgnosticProtocol).DecodeRequest(wire.Call, bytes.NewReader(strict))
+	require.NoError(t, err, "failed to decode request")
+	assert.IsType(t, &EnvelopeV1Responder{}, responder)
+}
+
 func tbinary(v wire.Value) []byte {
 	buf := &bytes.Buffer{}
 	Binary.Encode(v, buf)
@@ -955,3 +1045,146 @@ func TestReqRes(t *testing.T) {
 
 	}
 }
+
+func TestArenaReuse(t *testing.T) {
+	nested := vstruct(
+		vfield(1, vstruct(
+			vfield(1, vbinary("foo")),
+			vfield(2, vstruct(vfield(1, vi32(42)))),
+		)),
+		vfield(2, vlist(wire.TStruct,
+			vstruct(vfield(1, vbinary("bar"))),
+			vstruct(vfield(1, vbinary("baz")), vfield(2, vbool(true))),
+		)),
+		vfield(3, vbinary(string(make([]byte, 8192)))),
+	)
+
+	var buffer bytes.Buffer
+	require.NoError(t, Binary.Encode(nested, &buffer))
+	encoded := buffer.Bytes()
+
+	for i := 0; i < 3; i++ {
+		arena := binary.BorrowArena()
+
+		// Decode the same value multiple times from the same arena to make
+		// sure values don't share memory.
+		var values []wire.Value
+		for j := 0; j < 3; j++ {
+			value, err := arena.Decode(bytes.NewReader(encoded), wire.TStruct)
+			require.NoError(t, err)
+			values = append(values, value)
+		}
+
+		for _, value := range values {
+			assert.True(
+				t, wire.ValuesAreEqual(nested, value),
+				"\n\t   %v (expected)\n\t!= %v (actual)", nested, value,
+			)
+		}
+
+		arena.Release()
+	}
+}
+
+func TestArenaDecodeEnveloped(t *testing.T) {
+	envelope := wire.Envelope{
+		Name:  "foo",
+		Type:  wire.Call,
+		SeqID: 42,
+		Value: vstruct(vfield(1, vbinary("bar"))),
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, Binary.EncodeEnveloped(envelope, &buffer))
+
+	arena := binary.BorrowArena()
+	defer arena.Release()
+
+	got, err := arena.DecodeEnveloped(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, envelope.Name, got.Name)
+	assert.Equal(t, envelope.Type, got.Type)
+	assert.Equal(t, envelope.SeqID, got.SeqID)
+	assert.True(t, wire.ValuesAreEqual(envelope.Value, got.Value))
+}
+
+func TestArenaProtocol(t *testing.T) {
+	envelope := wire.Envelope{
+		Name:  "foo",
+		Type:  wire.Call,
+		SeqID: 42,
+		Value: vstruct(vfield(1, vbinary("bar"))),
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, Binary.EncodeEnveloped(envelope, &buffer))
+
+	t.Run("Binary", func(t *testing.T) {
+		p, ok := Binary.(ArenaProtocol)
+		require.True(t, ok, "Binary must implement ArenaProtocol")
+
+		arena := binary.BorrowArena()
+		defer arena.Release()
+
+		got, err := p.DecodeEnvelopedArena(arena, bytes.NewReader(buffer.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, envelope.Name, got.Name)
+		assert.True(t, wire.ValuesAreEqual(envelope.Value, got.Value))
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		p, ok := NewBinary(Options{MaxStringLength: 2}).(ArenaProtocol)
+		require.True(t, ok, "NewBinary must implement ArenaProtocol")
+
+		arena := binary.BorrowArena()
+		defer arena.Release()
+
+		_, err := p.DecodeEnvelopedArena(arena, bytes.NewReader(buffer.Bytes()))
+		require.Error(t, err)
+		_, ok = err.(*LimitError)
+		assert.True(t, ok, "expected LimitError, got %T", err)
+	})
+}
+
+func benchmarkDecodeValue() []byte {
+	var fields []wire.Field
+	for i := int16(1); i <= 10; i++ {
+		fields = append(fields, vfield(i, vstruct(
+			vfield(1, vbinary("hello world")),
+			vfield(2, vi64(int64(i))),
+			vfield(3, vstruct(vfield(1, vbinary("nested")))),
+		)))
+	}
+
+	var buffer bytes.Buffer
+	if err := Binary.Encode(vstruct(fields...), &buffer); err != nil {
+		panic(err)
+	}
+	return buffer.Bytes()
+}
+
+func BenchmarkBinaryDecode(b *testing.B) {
+	encoded := benchmarkDecodeValue()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := Binary.Decode(bytes.NewReader(encoded), wire.TStruct); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBinaryDecodeArena(b *testing.B) {
+	encoded := benchmarkDecodeValue()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		arena := binary.BorrowArena()
+		if _, err := arena.Decode(bytes.NewReader(encoded), wire.TStruct); err != nil {
+			b.Fatal(err)
+		}
+		arena.Release()
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/compact"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Compact implements the Thrift Compact Protocol.
+//
+// Compact uses variable-length integers, delta-encoded field identifiers,
+// and packs boolean struct fields into their field headers for a smaller
+// encoding than Binary.
+var Compact Protocol
+
+func init() {
+	Compact = compactProtocol{}
+}
+
+type compactProtocol struct {
+	limits   binary.Limits
+	interner *binary.Interner
+}
+
+func (compactProtocol) Encode(v wire.Value, w io.Writer) error {
+	writer := compact.BorrowWriter(w)
+	err := writer.WriteValue(v)
+	compact.ReturnWriter(writer)
+	return err
+}
+
+func (c compactProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	reader := compact.NewLimitedReader(r, c.limits)
+	reader.SetInterner(c.interner)
+	value, _, err := reader.ReadValue(t, 0)
+	return value, err
+}
+
+func (compactProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
+	writer := compact.BorrowWriter(w)
+	err := writer.WriteEnveloped(e)
+	compact.ReturnWriter(writer)
+	return err
+}
+
+func (c compactProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	reader := compact.NewLimitedReader(r, c.limits)
+	reader.SetInterner(c.interner)
+	return reader.ReadEnveloped()
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compact
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+var littleEndian = binary.LittleEndian
+
+// Type identifiers used by the Compact protocol. These differ from the type
+// identifiers used by wire.Type.
+const (
+	typeStop         byte = 0x00
+	typeBooleanTrue  byte = 0x01
+	typeBooleanFalse byte = 0x02
+	typeByte         byte = 0x03
+	typeI16          byte = 0x04
+	typeI32          byte = 0x05
+	typeI64          byte = 0x06
+	typeDouble       byte = 0x07
+	typeBinary       byte = 0x08
+	typeList         byte = 0x09
+	typeSet          byte = 0x0a
+	typeMap          byte = 0x0b
+	typeStruct       byte = 0x0c
+)
+
+const (
+	protocolID   = 0x82
+	version      = 0x01
+	versionMask  = 0x1f
+	typeShift    = 5
+	typeBitsMask = 0x07
+)
+
+// compactType returns the Compact protocol type identifier for the given
+// wire.Type.
+//
+// Booleans are always reported as typeBooleanTrue. Struct fields of type
+// bool encode their value inside the field header instead.
+func compactType(t wire.Type) (byte, error) {
+	switch t {
+	case wire.TBool:
+		return typeBooleanTrue, nil
+	case wire.TI8:
+		return typeByte, nil
+	case wire.TI16:
+		return typeI16, nil
+	case wire.TI32:
+		return typeI32, nil
+	case wire.TI64:
+		return typeI64, nil
+	case wire.TDouble:
+		return typeDouble, nil
+	case wire.TBinary:
+		return typeBinary, nil
+	case wire.TList:
+		return typeList, nil
+	case wire.TSet:
+		return typeSet, nil
+	case wire.TMap:
+		return typeMap, nil
+	case wire.TStruct:
+		return typeStruct, nil
+	default:
+		return 0, fmt.Errorf("unknown ttype %v", t)
+	}
+}
+
+// wireType returns the wire.Type for the given Compact protocol type
+// identifier.
+func wireType(t byte) (wire.Type, error) {
+	switch t {
+	case typeBooleanTrue, typeBooleanFalse:
+		return wire.TBool, nil
+	case typeByte:
+		return wire.TI8, nil
+	case typeI16:
+		return wire.TI16, nil
+	case typeI32:
+		return wire.TI32, nil
+	case typeI64:
+		return wire.TI64, nil
+	case typeDouble:
+		return wire.TDouble, nil
+	case typeBinary:
+		return wire.TBinary, nil
+	case typeList:
+		return wire.TList, nil
+	case typeSet:
+		return wire.TSet, nil
+	case typeMap:
+		return wire.TMap, nil
+	case typeStruct:
+		return wire.TStruct, nil
+	default:
+		return 0, decodeErrorf("unknown compact type %d", t)
+	}
+}
+
+func zigzag32(n int32) uint32 {
+	return uint32((n << 1) ^ (n >> 31))
+}
+
+func zigzag64(n int64) uint64 {
+	return uint64((n << 1) ^ (n >> 63))
+}
+
+func unzigzag32(n uint32) int32 {
+	return int32(n>>1) ^ -int32(n&1)
+}
+
+func unzigzag64(n uint64) int64 {
+	return int64(n>>1) ^ -int64(n&1)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compact
+
+import "go.uber.org/thriftrw/wire"
+
+// WriteEnveloped writes enveloped value using the Compact protocol envelope.
+//
+// The envelope is laid out as follows.
+//
+//   protocolID:1 versionAndType:1 seqID:varint name:varint-prefixed-string
+//
+// Where versionAndType holds the envelope type in the top 3 bits and the
+// protocol version in the bottom 5 bits.
+func (cw *Writer) WriteEnveloped(e wire.Envelope) error {
+	if err := cw.writeByte(protocolID); err != nil {
+		return err
+	}
+
+	versionAndType := (byte(e.Type) << typeShift) | (version & versionMask)
+	if err := cw.writeByte(versionAndType); err != nil {
+		return err
+	}
+
+	if err := cw.writeVarint(uint64(uint32(e.SeqID))); err != nil {
+		return err
+	}
+
+	if err := cw.writeString(e.Name); err != nil {
+		return err
+	}
+
+	return cw.WriteValue(e.Value)
+}
+
+// ReadEnveloped reads a Compact protocol envelope.
+func (cr *Reader) ReadEnveloped() (wire.Envelope, error) {
+	var e wire.Envelope
+
+	id, off, err := cr.readByte(0)
+	if err != nil {
+		return e, err
+	}
+	if id != protocolID {
+		return e, decodeErrorf("unexpected protocol ID %#x, expected %#x", id, protocolID)
+	}
+
+	versionAndType, off, err := cr.readByte(off)
+	if err != nil {
+		return e, err
+	}
+	if v := versionAndType & versionMask; v != version {
+		return e, decodeErrorf("cannot decode envelope of version: %v", v)
+	}
+	e.Type = wire.EnvelopeType((versionAndType >> typeShift) & typeBitsMask)
+
+	seqID, off, err := cr.readVarint(off)
+	if err != nil {
+		return e, err
+	}
+	e.SeqID = int32(seqID)
+
+	e.Name, off, err = cr.readString(off)
+	if err != nil {
+		return e, err
+	}
+
+	e.Value, _, err = cr.ReadValue(wire.TStruct, off)
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+
+	return e, nil
+}
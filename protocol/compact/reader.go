@@ -0,0 +1,461 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compact
+
+import (
+	"bytes"
+	"io"
+	"math"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Requests for byte slices longer than this will use a dynamically resizing
+// buffer.
+const bytesAllocThreshold = 1048576 // 1 MB
+
+// Reader implements a parser for the Thrift Compact Protocol based on an
+// io.ReaderAt.
+//
+// Unlike the Binary protocol, the size of a value in the Compact protocol
+// cannot be determined without parsing it, so collections are decoded
+// eagerly.
+type Reader struct {
+	reader io.ReaderAt
+
+	// This buffer is re-used every time we need a slice of up to 8 bytes.
+	buffer [8]byte
+
+	limits binary.Limits
+	depth  int // nesting depth of the value being read
+
+	// If non-nil, short binary values are de-duplicated through this
+	// Interner.
+	interner *binary.Interner
+	scratch  []byte
+}
+
+// NewReader builds a new Reader based on the given io.ReaderAt.
+func NewReader(r io.ReaderAt) Reader {
+	return Reader{reader: r}
+}
+
+// NewLimitedReader builds a new Reader based on the given io.ReaderAt which
+// rejects values exceeding the given limits with a *binary.LimitError.
+// MaxSkipBytes does not apply because this Reader doesn't skip values.
+func NewLimitedReader(r io.ReaderAt, l binary.Limits) Reader {
+	return Reader{reader: r, limits: l}
+}
+
+// SetInterner configures the Reader to de-duplicate short strings and
+// binary values through the given Interner. Binary values decoded by the
+// Reader may then share memory and MUST NOT be modified.
+func (cr *Reader) SetInterner(i *binary.Interner) {
+	cr.interner = i
+}
+
+func (cr *Reader) read(bs []byte, off int64) (int64, error) {
+	n, err := cr.reader.ReadAt(bs, off)
+	off += int64(n)
+	if err == io.EOF {
+		// All EOFs are unexpected for the decoder
+		err = io.ErrUnexpectedEOF
+	}
+	return off, err
+}
+
+// copyN copies n bytes starting at offset off into the given Writer.
+func (cr *Reader) copyN(w io.Writer, off int64, n int64) (int64, error) {
+	src := io.NewSectionReader(cr.reader, off, n)
+	copied, err := io.CopyN(w, src, n)
+	off += copied
+	if err == io.EOF {
+		// All EOFs are unexpected for the decoder
+		err = io.ErrUnexpectedEOF
+	}
+	return off, err
+}
+
+func (cr *Reader) readByte(off int64) (byte, int64, error) {
+	bs := cr.buffer[0:1]
+	off, err := cr.read(bs, off)
+	return bs[0], off, err
+}
+
+func (cr *Reader) readVarint(off int64) (uint64, int64, error) {
+	var (
+		n     uint64
+		shift uint
+	)
+	for {
+		b, newOff, err := cr.readByte(off)
+		if err != nil {
+			return 0, newOff, err
+		}
+		off = newOff
+
+		if shift >= 64 || (shift == 63 && b&0x7f > 1) {
+			return 0, off, decodeErrorf("varint overflows a 64-bit integer")
+		}
+
+		n |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return n, off, nil
+		}
+		shift += 7
+	}
+}
+
+func (cr *Reader) readInt16(off int64) (int16, int64, error) {
+	n, off, err := cr.readInt32(off)
+	if err != nil {
+		return 0, off, err
+	}
+	if n < math.MinInt16 || n > math.MaxInt16 {
+		return 0, off, decodeErrorf("value %d overflows a 16-bit integer", n)
+	}
+	return int16(n), off, nil
+}
+
+func (cr *Reader) readInt32(off int64) (int32, int64, error) {
+	n, off, err := cr.readVarint(off)
+	if err != nil {
+		return 0, off, err
+	}
+	if n > math.MaxUint32 {
+		return 0, off, decodeErrorf("value %d overflows a 32-bit integer", n)
+	}
+	return unzigzag32(uint32(n)), off, nil
+}
+
+func (cr *Reader) readInt64(off int64) (int64, int64, error) {
+	n, off, err := cr.readVarint(off)
+	return unzigzag64(n), off, err
+}
+
+func (cr *Reader) readDouble(off int64) (float64, int64, error) {
+	bs := cr.buffer[0:8]
+	off, err := cr.read(bs, off)
+	return math.Float64frombits(littleEndian.Uint64(bs)), off, err
+}
+
+// readSize reads a non-negative varint-encoded collection or binary size.
+func (cr *Reader) readSize(off int64, kind string) (int32, int64, error) {
+	n, off, err := cr.readVarint(off)
+	if err != nil {
+		return 0, off, err
+	}
+	if n > math.MaxInt32 {
+		return 0, off, decodeErrorf("invalid length %d requested for %v", n, kind)
+	}
+	return int32(n), off, nil
+}
+
+func (cr *Reader) readBytes(off int64) ([]byte, int64, error) {
+	length, off, err := cr.readSize(off, "binary value")
+	if err != nil {
+		return nil, off, err
+	}
+	if err := cr.limits.CheckStringLength(length); err != nil {
+		return nil, off, err
+	}
+	if length == 0 {
+		return nil, off, nil
+	}
+	if cr.interner != nil && cr.interner.Interns(int(length)) {
+		return cr.readInterned(int(length), off)
+	}
+
+	// Use a dynamically resizing buffer for requests larger than
+	// bytesAllocThreshold. We don't want bad requests to lock the system up.
+	if length > bytesAllocThreshold {
+		var buff bytes.Buffer
+		off, err = cr.copyN(&buff, off, int64(length))
+		if err != nil {
+			return nil, off, err
+		}
+		return buff.Bytes(), off, err
+	}
+
+	bs := make([]byte, length)
+	off, err = cr.read(bs, off)
+	return bs, off, err
+}
+
+// readInterned reads a binary value of the given length through the
+// Reader's Interner.
+func (cr *Reader) readInterned(length int, off int64) ([]byte, int64, error) {
+	if cap(cr.scratch) < length {
+		cr.scratch = make([]byte, cr.interner.MaxLength())
+	}
+	bs := cr.scratch[:length]
+	off, err := cr.read(bs, off)
+	if err != nil {
+		return nil, off, err
+	}
+	return cr.interner.Bytes(bs), off, nil
+}
+
+func (cr *Reader) readString(off int64) (string, int64, error) {
+	v, off, err := cr.readBytes(off)
+	if cr.interner != nil {
+		return cr.interner.String(v), off, err
+	}
+	return string(v), off, err
+}
+
+func (cr *Reader) readBool(off int64) (bool, int64, error) {
+	b, off, err := cr.readByte(off)
+	if err != nil {
+		return false, off, err
+	}
+
+	// Some implementations write 0 instead of typeBooleanFalse for false
+	// values in collections, so both are accepted.
+	switch b {
+	case typeBooleanTrue:
+		return true, off, nil
+	case typeBooleanFalse, 0:
+		return false, off, nil
+	default:
+		return false, off, decodeErrorf("invalid value %q for bool field", b)
+	}
+}
+
+func (cr *Reader) readStruct(off int64) (wire.Struct, int64, error) {
+	var (
+		fields      []wire.Field
+		lastFieldID int16
+	)
+
+	for {
+		header, newOff, err := cr.readByte(off)
+		if err != nil {
+			return wire.Struct{}, newOff, err
+		}
+		off = newOff
+
+		if header == typeStop {
+			break
+		}
+
+		var fid int16
+		if delta := int16(header >> 4); delta != 0 {
+			fid = lastFieldID + delta
+		} else {
+			fid, off, err = cr.readInt16(off)
+			if err != nil {
+				return wire.Struct{}, off, err
+			}
+		}
+		lastFieldID = fid
+
+		var val wire.Value
+		switch typ := header & 0x0f; typ {
+		case typeBooleanTrue, typeBooleanFalse:
+			// Boolean fields carry their value in the field header.
+			val = wire.NewValueBool(typ == typeBooleanTrue)
+		default:
+			t, err := wireType(typ)
+			if err != nil {
+				return wire.Struct{}, off, err
+			}
+
+			val, off, err = cr.ReadValue(t, off)
+			if err != nil {
+				return wire.Struct{}, off, err
+			}
+		}
+
+		fields = append(fields, wire.Field{ID: fid, Value: val})
+	}
+
+	return wire.Struct{Fields: fields}, off, nil
+}
+
+func (cr *Reader) readMap(off int64) (wire.MapItemList, int64, error) {
+	count, off, err := cr.readSize(off, "map")
+	if err != nil {
+		return nil, off, err
+	}
+	if err := cr.limits.CheckContainerSize(count); err != nil {
+		return nil, off, err
+	}
+
+	// Empty maps don't specify their key and value types on the wire. We
+	// report them as maps of binary values; decoders that expect other types
+	// will treat them as empty.
+	if count == 0 {
+		return wire.MapItemListFromSlice(wire.TBinary, wire.TBinary, nil), off, nil
+	}
+
+	types, off, err := cr.readByte(off)
+	if err != nil {
+		return nil, off, err
+	}
+
+	kt, err := wireType(types >> 4)
+	if err != nil {
+		return nil, off, err
+	}
+
+	vt, err := wireType(types & 0x0f)
+	if err != nil {
+		return nil, off, err
+	}
+
+	items := make([]wire.MapItem, 0, minCapacity(count))
+	for i := int32(0); i < count; i++ {
+		var k, v wire.Value
+
+		k, off, err = cr.ReadValue(kt, off)
+		if err != nil {
+			return nil, off, err
+		}
+
+		v, off, err = cr.ReadValue(vt, off)
+		if err != nil {
+			return nil, off, err
+		}
+
+		items = append(items, wire.MapItem{Key: k, Value: v})
+	}
+
+	return wire.MapItemListFromSlice(kt, vt, items), off, nil
+}
+
+func (cr *Reader) readList(off int64) (wire.ValueList, int64, error) {
+	header, off, err := cr.readByte(off)
+	if err != nil {
+		return nil, off, err
+	}
+
+	count := int32(header >> 4)
+	if count == 0x0f {
+		count, off, err = cr.readSize(off, "list")
+		if err != nil {
+			return nil, off, err
+		}
+	}
+
+	if err := cr.limits.CheckContainerSize(count); err != nil {
+		return nil, off, err
+	}
+
+	typ, err := wireType(header & 0x0f)
+	if err != nil {
+		return nil, off, err
+	}
+
+	values := make([]wire.Value, 0, minCapacity(count))
+	for i := int32(0); i < count; i++ {
+		var v wire.Value
+
+		v, off, err = cr.ReadValue(typ, off)
+		if err != nil {
+			return nil, off, err
+		}
+
+		values = append(values, v)
+	}
+
+	return wire.ValueListFromSlice(typ, values), off, nil
+}
+
+// minCapacity limits the amount of memory we pre-allocate for a collection
+// based on a length read off the wire. We don't want bad requests to lock
+// the system up.
+func minCapacity(count int32) int {
+	const maxPrealloc = 1024
+	if count > maxPrealloc {
+		return maxPrealloc
+	}
+	return int(count)
+}
+
+// readContainer reads a struct, map, set, or list.
+func (cr *Reader) readContainer(t wire.Type, off int64) (wire.Value, int64, error) {
+	switch t {
+	case wire.TStruct:
+		s, off, err := cr.readStruct(off)
+		return wire.NewValueStruct(s), off, err
+
+	case wire.TMap:
+		m, off, err := cr.readMap(off)
+		return wire.NewValueMap(m), off, err
+
+	case wire.TSet:
+		s, off, err := cr.readList(off)
+		return wire.NewValueSet(s), off, err
+
+	default:
+		l, off, err := cr.readList(off)
+		return wire.NewValueList(l), off, err
+	}
+}
+
+// ReadValue reads a value off the given type off the wire starting at the
+// given offset.
+//
+// Returns the Value, the new offset, and an error if there was a decode error.
+func (cr *Reader) ReadValue(t wire.Type, off int64) (wire.Value, int64, error) {
+	switch t {
+	case wire.TBool:
+		b, off, err := cr.readBool(off)
+		return wire.NewValueBool(b), off, err
+
+	case wire.TI8:
+		b, off, err := cr.readByte(off)
+		return wire.NewValueI8(int8(b)), off, err
+
+	case wire.TDouble:
+		d, off, err := cr.readDouble(off)
+		return wire.NewValueDouble(d), off, err
+
+	case wire.TI16:
+		n, off, err := cr.readInt16(off)
+		return wire.NewValueI16(n), off, err
+
+	case wire.TI32:
+		n, off, err := cr.readInt32(off)
+		return wire.NewValueI32(n), off, err
+
+	case wire.TI64:
+		n, off, err := cr.readInt64(off)
+		return wire.NewValueI64(n), off, err
+
+	case wire.TBinary:
+		v, off, err := cr.readBytes(off)
+		return wire.NewValueBinary(v), off, err
+
+	case wire.TStruct, wire.TMap, wire.TSet, wire.TList:
+		if err := cr.limits.CheckDepth(cr.depth + 1); err != nil {
+			return wire.Value{}, off, err
+		}
+		cr.depth++
+		defer func() { cr.depth-- }()
+		return cr.readContainer(t, off)
+
+	default:
+		return wire.Value{}, off, decodeErrorf("unknown ttype %v", t)
+	}
+}
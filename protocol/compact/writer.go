@@ -0,0 +1,306 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compact
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+var writerPool = sync.Pool{New: func() interface{} {
+	writer := &Writer{}
+	writer.writeValue = writer.WriteValue
+	writer.writeMapItem = writer.realWriteMapItem
+	return writer
+}}
+
+// Writer implements basic logic for writing the Thrift Compact Protocol to
+// an io.Writer.
+type Writer struct {
+	writer io.Writer
+
+	// This buffer is re-used every time we need a slice of up to 10 bytes.
+	// This is the maximum size of a 64-bit varint.
+	buffer [10]byte
+
+	// lastFieldID is the ID of the last field written in the struct
+	// currently being written. Field IDs are delta-encoded relative to this.
+	lastFieldID int16
+
+	// NOTE:
+	// This is a hack to avoid memory allocation in closures. Passing the
+	// bound WriteValue or realWriteMapItem methods into a function results in
+	// a memory allocation because the system doesn't know we're going to
+	// reuse the closure. So we create that bound reference in advance when
+	// the writer is created.
+	writeValue   func(wire.Value) error
+	writeMapItem func(wire.MapItem) error
+}
+
+// BorrowWriter fetches a Writer from the system that will write its output to
+// the given io.Writer.
+//
+// This Writer must be returned back using ReturnWriter.
+func BorrowWriter(w io.Writer) *Writer {
+	writer := writerPool.Get().(*Writer)
+	writer.writer = w
+	writer.lastFieldID = 0
+	return writer
+}
+
+// ReturnWriter returns a previously borrowed Writer back to the system.
+func ReturnWriter(w *Writer) {
+	w.writer = nil
+	writerPool.Put(w)
+}
+
+func (cw *Writer) write(bs []byte) error {
+	_, err := cw.writer.Write(bs)
+	return err
+}
+
+func (cw *Writer) writeByte(b byte) error {
+	bs := cw.buffer[0:1]
+	bs[0] = b
+	return cw.write(bs)
+}
+
+func (cw *Writer) writeVarint(n uint64) error {
+	i := 0
+	for n >= 0x80 {
+		cw.buffer[i] = byte(n) | 0x80
+		n >>= 7
+		i++
+	}
+	cw.buffer[i] = byte(n)
+	return cw.write(cw.buffer[0 : i+1])
+}
+
+func (cw *Writer) writeInt16(n int16) error {
+	return cw.writeVarint(uint64(zigzag32(int32(n))))
+}
+
+func (cw *Writer) writeInt32(n int32) error {
+	return cw.writeVarint(uint64(zigzag32(n)))
+}
+
+func (cw *Writer) writeInt64(n int64) error {
+	return cw.writeVarint(zigzag64(n))
+}
+
+func (cw *Writer) writeDouble(d float64) error {
+	bs := cw.buffer[0:8]
+	littleEndian.PutUint64(bs, math.Float64bits(d))
+	return cw.write(bs)
+}
+
+func (cw *Writer) writeBinary(b []byte) error {
+	if err := cw.writeVarint(uint64(len(b))); err != nil {
+		return err
+	}
+	return cw.write(b)
+}
+
+func (cw *Writer) writeString(s string) error {
+	if err := cw.writeVarint(uint64(len(s))); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(cw.writer, s)
+	return err
+}
+
+// writeFieldHeader writes the header for a field with the given ID and
+// compact type.
+//
+// If the field ID is within 15 of the previous field ID, only the delta is
+// written, packed into the same byte as the type.
+func (cw *Writer) writeFieldHeader(id int16, typ byte) error {
+	if id > cw.lastFieldID && id-cw.lastFieldID <= 15 {
+		if err := cw.writeByte(byte(id-cw.lastFieldID)<<4 | typ); err != nil {
+			return err
+		}
+	} else {
+		if err := cw.writeByte(typ); err != nil {
+			return err
+		}
+		if err := cw.writeInt16(id); err != nil {
+			return err
+		}
+	}
+	cw.lastFieldID = id
+	return nil
+}
+
+func (cw *Writer) writeField(f wire.Field) error {
+	// Boolean fields are packed into the field header.
+	if f.Value.Type() == wire.TBool {
+		typ := typeBooleanFalse
+		if f.Value.GetBool() {
+			typ = typeBooleanTrue
+		}
+		return cw.writeFieldHeader(f.ID, typ)
+	}
+
+	typ, err := compactType(f.Value.Type())
+	if err != nil {
+		return err
+	}
+
+	if err := cw.writeFieldHeader(f.ID, typ); err != nil {
+		return err
+	}
+
+	if err := cw.WriteValue(f.Value); err != nil {
+		return fmt.Errorf(
+			"failed to write field %d (%v): %s",
+			f.ID, f.Value.Type(), err,
+		)
+	}
+
+	return nil
+}
+
+func (cw *Writer) writeStruct(s wire.Struct) error {
+	// Field IDs are delta-encoded relative to the enclosing struct only, so
+	// we need to restore the parent's state when we're done.
+	lastFieldID := cw.lastFieldID
+	cw.lastFieldID = 0
+
+	for _, f := range s.Fields {
+		if err := cw.writeField(f); err != nil {
+			return err
+		}
+	}
+
+	cw.lastFieldID = lastFieldID
+	return cw.writeByte(typeStop)
+}
+
+func (cw *Writer) realWriteMapItem(item wire.MapItem) error {
+	if err := cw.WriteValue(item.Key); err != nil {
+		return err
+	}
+	return cw.WriteValue(item.Value)
+}
+
+func (cw *Writer) writeMap(m wire.MapItemList) error {
+	size := m.Size()
+	if size == 0 {
+		return cw.writeByte(0)
+	}
+
+	kt, err := compactType(m.KeyType())
+	if err != nil {
+		return err
+	}
+
+	vt, err := compactType(m.ValueType())
+	if err != nil {
+		return err
+	}
+
+	// length:varint
+	if err := cw.writeVarint(uint64(size)); err != nil {
+		return err
+	}
+
+	// ktype:4 vtype:4
+	if err := cw.writeByte(kt<<4 | vt); err != nil {
+		return err
+	}
+
+	return m.ForEach(cw.writeMapItem)
+}
+
+func (cw *Writer) writeList(l wire.ValueList) error {
+	vt, err := compactType(l.ValueType())
+	if err != nil {
+		return err
+	}
+
+	// Lists with fewer than 15 items pack the size into the same byte as the
+	// type.
+	size := l.Size()
+	if size < 15 {
+		if err := cw.writeByte(byte(size)<<4 | vt); err != nil {
+			return err
+		}
+	} else {
+		if err := cw.writeByte(0xf0 | vt); err != nil {
+			return err
+		}
+		if err := cw.writeVarint(uint64(size)); err != nil {
+			return err
+		}
+	}
+
+	return l.ForEach(cw.writeValue)
+}
+
+// WriteValue writes the given Thrift value to the underlying stream using the
+// Thrift Compact Protocol.
+func (cw *Writer) WriteValue(v wire.Value) error {
+	switch v.Type() {
+	case wire.TBool:
+		if v.GetBool() {
+			return cw.writeByte(typeBooleanTrue)
+		}
+		return cw.writeByte(typeBooleanFalse)
+
+	case wire.TI8:
+		return cw.writeByte(byte(v.GetI8()))
+
+	case wire.TDouble:
+		return cw.writeDouble(v.GetDouble())
+
+	case wire.TI16:
+		return cw.writeInt16(v.GetI16())
+
+	case wire.TI32:
+		return cw.writeInt32(v.GetI32())
+
+	case wire.TI64:
+		return cw.writeInt64(v.GetI64())
+
+	case wire.TBinary:
+		return cw.writeBinary(v.GetBinary())
+
+	case wire.TStruct:
+		return cw.writeStruct(v.GetStruct())
+
+	case wire.TMap:
+		return cw.writeMap(v.GetMap())
+
+	case wire.TSet:
+		return cw.writeList(v.GetSet())
+
+	case wire.TList:
+		return cw.writeList(v.GetList())
+
+	default:
+		return fmt.Errorf("unknown ttype %v", v.Type())
+	}
+}
@@ -0,0 +1,496 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/compact"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checkCompactEncodeDecode(t *testing.T, typ wire.Type, tests []encodeDecodeTest) {
+	for _, tt := range tests {
+		buffer := bytes.Buffer{}
+
+		// encode and match bytes
+		err := Compact.Encode(tt.value, &buffer)
+		if assert.NoError(t, err, "Encode failed:\n%s", tt.value) {
+			assert.Equal(t, tt.encoded, buffer.Bytes())
+		}
+
+		// decode and match value
+		value, err := Compact.Decode(bytes.NewReader(tt.encoded), typ)
+		if assert.NoError(t, err, "Decode failed:\n%s", tt.value) {
+			assert.True(
+				t, wire.ValuesAreEqual(tt.value, value),
+				fmt.Sprintf("\n\t   %v (expected)\n\t!= %v (actual)", tt.value, value),
+			)
+		}
+
+		// encode the decoded value again
+		buffer = bytes.Buffer{}
+		err = Compact.Encode(value, &buffer)
+		if assert.NoError(t, err, "Encode of decoded value failed:\n%s", tt.value) {
+			assert.Equal(t, tt.encoded, buffer.Bytes())
+		}
+	}
+}
+
+func checkCompactDecodeFailure(t *testing.T, typ wire.Type, tests []failureTest) {
+	for _, tt := range tests {
+		value, err := Compact.Decode(bytes.NewReader(tt), typ)
+		if assert.Error(t, err, "Expected failure parsing %x, got %s", tt, value) {
+			assert.True(
+				t,
+				compact.IsDecodeError(err),
+				"Expected decode error while parsing %x, got %s",
+				tt,
+				err,
+			)
+		}
+	}
+}
+
+func checkCompactEOFError(t *testing.T, typ wire.Type, tests []failureTest) {
+	for _, tt := range tests {
+		value, err := Compact.Decode(bytes.NewReader(tt), typ)
+		if assert.Error(t, err, "Expected failure parsing %x, got %s", tt, value) {
+			assert.Equal(
+				t, io.ErrUnexpectedEOF, err,
+				"Expected EOF error while parsing %x, got %s", tt, err,
+			)
+		}
+	}
+}
+
+func TestCompactBool(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TBool, []encodeDecodeTest{
+		{vbool(true), []byte{0x01}},
+		{vbool(false), []byte{0x02}},
+	})
+	checkCompactDecodeFailure(t, wire.TBool, []failureTest{
+		{0x03},
+	})
+	checkCompactEOFError(t, wire.TBool, []failureTest{{}})
+
+	// Readers must accept 0 as false as well.
+	v, err := Compact.Decode(bytes.NewReader([]byte{0x00}), wire.TBool)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(vbool(false), v), "expected false, got %v", v)
+
+	v, err = Compact.Decode(bytes.NewReader([]byte{0x31, 0x01, 0x00, 0x02}), wire.TList)
+	require.NoError(t, err)
+	assert.True(t,
+		wire.ValuesAreEqual(vlist(wire.TBool, vbool(true), vbool(false), vbool(false)), v),
+		"expected [true, false, false], got %v", v)
+}
+
+func TestCompactI8(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TI8, []encodeDecodeTest{
+		{vi8(0), []byte{0x00}},
+		{vi8(1), []byte{0x01}},
+		{vi8(-1), []byte{0xff}},
+		{vi8(math.MaxInt8), []byte{0x7f}},
+		{vi8(math.MinInt8), []byte{0x80}},
+	})
+	checkCompactEOFError(t, wire.TI8, []failureTest{{}})
+}
+
+func TestCompactI16(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TI16, []encodeDecodeTest{
+		{vi16(0), []byte{0x00}},
+		{vi16(1), []byte{0x02}},
+		{vi16(-1), []byte{0x01}},
+		{vi16(64), []byte{0x80, 0x01}},
+		{vi16(math.MaxInt16), []byte{0xfe, 0xff, 0x03}},
+		{vi16(math.MinInt16), []byte{0xff, 0xff, 0x03}},
+	})
+	checkCompactDecodeFailure(t, wire.TI16, []failureTest{
+		{0x80, 0x80, 0x04}, // 32768
+	})
+	checkCompactEOFError(t, wire.TI16, []failureTest{
+		{},
+		{0x80},
+	})
+}
+
+func TestCompactI32(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TI32, []encodeDecodeTest{
+		{vi32(0), []byte{0x00}},
+		{vi32(1), []byte{0x02}},
+		{vi32(-1), []byte{0x01}},
+		{vi32(150), []byte{0xac, 0x02}},
+		{vi32(math.MaxInt32), []byte{0xfe, 0xff, 0xff, 0xff, 0x0f}},
+		{vi32(math.MinInt32), []byte{0xff, 0xff, 0xff, 0xff, 0x0f}},
+	})
+	checkCompactDecodeFailure(t, wire.TI32, []failureTest{
+		{0x80, 0x80, 0x80, 0x80, 0x10}, // 2^32
+	})
+	checkCompactEOFError(t, wire.TI32, []failureTest{
+		{},
+		{0xff, 0xff},
+	})
+}
+
+func TestCompactI64(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TI64, []encodeDecodeTest{
+		{vi64(0), []byte{0x00}},
+		{vi64(1), []byte{0x02}},
+		{vi64(-1), []byte{0x01}},
+		{
+			vi64(math.MaxInt64),
+			[]byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+		},
+		{
+			vi64(math.MinInt64),
+			[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+		},
+	})
+	checkCompactDecodeFailure(t, wire.TI64, []failureTest{
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	})
+	checkCompactEOFError(t, wire.TI64, []failureTest{
+		{},
+		{0xff, 0xff, 0xff},
+	})
+}
+
+func TestCompactDouble(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TDouble, []encodeDecodeTest{
+		{vdouble(0.0), []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{vdouble(1.0), []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f}},
+		{vdouble(-1.0), []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0xbf}},
+		{vdouble(math.Inf(1)), []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x7f}},
+	})
+	checkCompactEOFError(t, wire.TDouble, []failureTest{
+		{},
+		{0x00, 0x00, 0x00, 0x00},
+	})
+}
+
+func TestCompactBinary(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TBinary, []encodeDecodeTest{
+		{vbinary(""), []byte{0x00}},
+		{vbinary("hello"), []byte{0x05, 'h', 'e', 'l', 'l', 'o'}},
+	})
+	checkCompactEOFError(t, wire.TBinary, []failureTest{
+		{},
+		{0x05, 'h', 'e'},
+	})
+}
+
+func TestCompactStruct(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TStruct, []encodeDecodeTest{
+		{vstruct(), []byte{0x00}},
+		{
+			vstruct(vfield(1, vbool(true))),
+			[]byte{
+				0x11, // delta:4 = 1, type:4 = true
+				0x00, // stop
+			},
+		},
+		{
+			vstruct(vfield(1, vbool(false))),
+			[]byte{
+				0x12, // delta:4 = 1, type:4 = false
+				0x00, // stop
+			},
+		},
+		{
+			vstruct(vfield(1, vi32(1)), vfield(16, vi8(2))),
+			[]byte{
+				0x15, 0x02, // delta:4 = 1, type:4 = i32, value = 1
+				0xf3, 0x02, // delta:4 = 15, type:4 = byte, value = 2
+				0x00, // stop
+			},
+		},
+		{
+			vstruct(vfield(1, vi8(1)), vfield(17, vi8(2))),
+			[]byte{
+				0x13, 0x01, // delta:4 = 1, type:4 = byte, value = 1
+				0x03, 0x22, 0x02, // type = byte, id = 17, value = 2
+				0x00, // stop
+			},
+		},
+		{
+			vstruct(vfield(5, vi8(1)), vfield(2, vi8(2))),
+			[]byte{
+				0x53, 0x01, // delta:4 = 5, type:4 = byte, value = 1
+				0x03, 0x04, 0x02, // type = byte, id = 2, value = 2
+				0x00, // stop
+			},
+		},
+		{
+			vstruct(vfield(-1, vbinary("a"))),
+			[]byte{
+				0x08, 0x01, // type = binary, id = -1
+				0x01, 'a', // value = "a"
+				0x00, // stop
+			},
+		},
+		{
+			vstruct(
+				vfield(1, vstruct(vfield(3, vi8(1)))),
+				vfield(2, vi8(2)),
+			),
+			[]byte{
+				0x1c,       // delta:4 = 1, type:4 = struct
+				0x33, 0x01, // delta:4 = 3, type:4 = byte, value = 1
+				0x00,       // stop
+				0x13, 0x02, // delta:4 = 1, type:4 = byte, value = 2
+				0x00, // stop
+			},
+		},
+	})
+
+	checkCompactDecodeFailure(t, wire.TStruct, []failureTest{
+		{0x1d, 0x00},       // unknown type
+		{0x15, 0x02, 0x1e}, // unknown type after a valid field
+	})
+
+	checkCompactEOFError(t, wire.TStruct, []failureTest{
+		{},
+		{0x15},
+		{0x15, 0x02},
+		{0x05},
+	})
+}
+
+func TestCompactList(t *testing.T) {
+	fifteen := make([]wire.Value, 15)
+	fifteenEncoded := []byte{0xf3, 0x0f}
+	for i := range fifteen {
+		fifteen[i] = vi8(int8(i))
+		fifteenEncoded = append(fifteenEncoded, byte(i))
+	}
+
+	checkCompactEncodeDecode(t, wire.TList, []encodeDecodeTest{
+		{vlist(wire.TI32), []byte{0x05}},
+		{
+			vlist(wire.TI32, vi32(1), vi32(2), vi32(3)),
+			[]byte{
+				0x35,             // size:4 = 3, type:4 = i32
+				0x02, 0x04, 0x06, // 1, 2, 3
+			},
+		},
+		{
+			vlist(wire.TBool, vbool(true), vbool(false)),
+			[]byte{
+				0x21,       // size:4 = 2, type:4 = bool
+				0x01, 0x02, // true, false
+			},
+		},
+		{
+			vlist(wire.TStruct, vstruct(vfield(1, vi8(1)))),
+			[]byte{
+				0x1c,             // size:4 = 1, type:4 = struct
+				0x13, 0x01, 0x00, // {1: 1}
+			},
+		},
+		{vlist(wire.TI8, fifteen...), fifteenEncoded},
+	})
+
+	checkCompactDecodeFailure(t, wire.TList, []failureTest{
+		{0x1d, 0x00},
+		{0x21, 0x03},
+	})
+
+	checkCompactEOFError(t, wire.TList, []failureTest{
+		{},
+		{0x35, 0x02},
+		{0xf3},
+	})
+}
+
+func TestCompactSet(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TSet, []encodeDecodeTest{
+		{vset(wire.TBinary), []byte{0x08}},
+		{
+			vset(wire.TBinary, vbinary("a"), vbinary("b")),
+			[]byte{
+				0x28,      // size:4 = 2, type:4 = binary
+				0x01, 'a', // "a"
+				0x01, 'b', // "b"
+			},
+		},
+	})
+}
+
+func TestCompactMap(t *testing.T) {
+	checkCompactEncodeDecode(t, wire.TMap, []encodeDecodeTest{
+		{
+			vmap(wire.TI32, wire.TBinary, vitem(vi32(1), vbinary("a"))),
+			[]byte{
+				0x01,      // size = 1
+				0x58,      // ktype:4 = i32, vtype:4 = binary
+				0x02,      // 1
+				0x01, 'a', // "a"
+			},
+		},
+		{
+			vmap(
+				wire.TBinary, wire.TBool,
+				vitem(vbinary("x"), vbool(true)),
+				vitem(vbinary("y"), vbool(false)),
+			),
+			[]byte{
+				0x02,            // size = 2
+				0x81,            // ktype:4 = binary, vtype:4 = bool
+				0x01, 'x', 0x01, // "x": true
+				0x01, 'y', 0x02, // "y": false
+			},
+		},
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var buffer bytes.Buffer
+		require.NoError(t, Compact.Encode(vmap(wire.TI32, wire.TI64), &buffer))
+		assert.Equal(t, []byte{0x00}, buffer.Bytes())
+
+		v, err := Compact.Decode(bytes.NewReader(buffer.Bytes()), wire.TMap)
+		require.NoError(t, err)
+		assert.Equal(t, 0, v.GetMap().Size())
+	})
+
+	checkCompactDecodeFailure(t, wire.TMap, []failureTest{
+		{0x01, 0xd8},
+		{0x01, 0x5d},
+	})
+
+	checkCompactEOFError(t, wire.TMap, []failureTest{
+		{},
+		{0x01},
+		{0x01, 0x58},
+		{0x01, 0x58, 0x02},
+	})
+}
+
+func TestCompactEnvelope(t *testing.T) {
+	tests := []struct {
+		msg     string
+		encoded []byte
+		want    wire.Envelope
+	}{
+		{
+			msg: "call",
+			encoded: []byte{
+				0x82,                // protocol ID
+				0x21,                // type:3 = call, version:5 = 1
+				0x2a,                // seqID = 42
+				0x03, 'a', 'b', 'c', // name = "abc"
+
+				// <struct>
+				0x14, 0xc8, 0x01, // delta:4 = 1, type:4 = i16, value = 100
+				0x00, // stop
+			},
+			want: wire.Envelope{
+				Name:  "abc",
+				Type:  wire.Call,
+				SeqID: 42,
+				Value: vstruct(vfield(1, vi16(100))),
+			},
+		},
+		{
+			msg: "oneway",
+			encoded: []byte{
+				0x82,                         // protocol ID
+				0x81,                         // type:3 = oneway, version:5 = 1
+				0xff, 0xff, 0xff, 0xff, 0x0f, // seqID = -1
+				0x00, // name = ""
+				0x00, // stop
+			},
+			want: wire.Envelope{
+				Type:  wire.OneWay,
+				SeqID: -1,
+				Value: vstruct(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			var buffer bytes.Buffer
+			require.NoError(t, Compact.EncodeEnveloped(tt.want, &buffer))
+			assert.Equal(t, tt.encoded, buffer.Bytes())
+
+			e, err := Compact.DecodeEnveloped(bytes.NewReader(tt.encoded))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Name, e.Name)
+			assert.Equal(t, tt.want.Type, e.Type)
+			assert.Equal(t, tt.want.SeqID, e.SeqID)
+			assert.True(t, wire.ValuesAreEqual(tt.want.Value, e.Value))
+		})
+	}
+}
+
+func TestCompactEnvelopeErrors(t *testing.T) {
+	tests := []struct {
+		encoded []byte
+		errMsg  string
+	}{
+		{
+			encoded: []byte{0x80, 0x21, 0x00, 0x00, 0x00},
+			errMsg:  "unexpected protocol ID",
+		},
+		{
+			encoded: []byte{0x82, 0x22, 0x00, 0x00, 0x00},
+			errMsg:  "cannot decode envelope of version",
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := Compact.DecodeEnveloped(bytes.NewReader(tt.encoded))
+		if assert.Error(t, err, "%v: should fail", tt.errMsg) {
+			assert.Contains(t, err.Error(), tt.errMsg)
+		}
+	}
+}
+
+func TestCompactStructOfContainers(t *testing.T) {
+	v := vstruct(
+		vfield(1, vlist(wire.TMap,
+			vmap(wire.TI32, wire.TSet,
+				vitem(vi32(1), vset(wire.TBinary, vbinary("foo"))),
+			),
+		)),
+		vfield(2, vbool(true)),
+		vfield(100, vstruct(vfield(1, vdouble(1.5)))),
+	)
+
+	var buffer bytes.Buffer
+	require.NoError(t, Compact.Encode(v, &buffer))
+
+	got, err := Compact.Decode(bytes.NewReader(buffer.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(v, got), "%v != %v", v, got)
+
+	// The compact encoding must be smaller than the binary encoding.
+	assert.True(t, buffer.Len() < len(tbinary(v)))
+}
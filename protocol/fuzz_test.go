@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package protocol
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// fuzzOptions limits the values decoded by the fuzz tests so that inputs
+// declaring huge containers don't exhaust memory.
+var fuzzOptions = Options{
+	MaxContainerSize: 1 << 16,
+	MaxDepth:         64,
+	MaxStringLength:  1 << 20,
+}
+
+// fuzzSeeds are encoded with each protocol to seed the corpus of its fuzz
+// test.
+var fuzzSeeds = []wire.Value{
+	wire.NewValueStruct(wire.Struct{}),
+	wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBool(true)},
+		{ID: 2, Value: wire.NewValueI8(-1)},
+		{ID: 3, Value: wire.NewValueI16(1000)},
+		{ID: 4, Value: wire.NewValueI32(-100000)},
+		{ID: 5, Value: wire.NewValueI64(1 << 40)},
+		{ID: 6, Value: wire.NewValueDouble(3.14)},
+		{ID: 7, Value: wire.NewValueString("hello")},
+		{ID: 8, Value: wire.NewValueBinary([]byte{1, 2, 3})},
+	}}),
+	wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+			wire.NewValueI32(1), wire.NewValueI32(2),
+		}))},
+		{ID: 2, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("a"), wire.NewValueString("b"),
+		}))},
+		{ID: 3, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TI16, wire.TStruct, []wire.MapItem{
+			{Key: wire.NewValueI16(1), Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueBool(false)},
+			}})},
+		}))},
+	}}),
+}
+
+func FuzzBinaryDecode(f *testing.F) {
+	fuzzDecode(f, NewBinary(fuzzOptions))
+}
+
+func FuzzCompactDecode(f *testing.F) {
+	fuzzDecode(f, NewCompact(fuzzOptions))
+}
+
+// fuzzDecode fuzzes decoding structs with the given protocol. Values which
+// decode successfully must encode to bytes which decode to a value with the
+// same encoding.
+func fuzzDecode(f *testing.F, p Protocol) {
+	for _, v := range fuzzSeeds {
+		var buf bytes.Buffer
+		if err := p.Encode(v, &buf); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(buf.Bytes())
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v, err := p.Decode(bytes.NewReader(data), wire.TStruct)
+		if err != nil {
+			return
+		}
+
+		// Containers are decoded lazily so errors in their items surface
+		// only when they're evaluated.
+		if err := wire.EvaluateValue(v); err != nil {
+			return
+		}
+
+		// EvaluateValue releases the containers it evaluated so the input
+		// is decoded again to encode it.
+		v, err = p.Decode(bytes.NewReader(data), wire.TStruct)
+		if err != nil {
+			t.Fatalf("could not decode %x a second time: %v", data, err)
+		}
+
+		var first bytes.Buffer
+		if err := p.Encode(v, &first); err != nil {
+			t.Fatalf("could not encode decoded value %v: %v", v, err)
+		}
+
+		v, err = p.Decode(bytes.NewReader(first.Bytes()), wire.TStruct)
+		if err != nil {
+			t.Fatalf("could not decode %x: %v", first.Bytes(), err)
+		}
+
+		var second bytes.Buffer
+		if err := p.Encode(v, &second); err != nil {
+			t.Fatalf("could not encode re-decoded value %v: %v", v, err)
+		}
+
+		if !bytes.Equal(first.Bytes(), second.Bytes()) {
+			t.Fatalf("encoding changed after a round trip:\n\tfirst:  %x\n\tsecond: %x", first.Bytes(), second.Bytes())
+		}
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/protocol/json"
+	"go.uber.org/thriftrw/wire"
+)
+
+// JSON implements the Thrift JSON Protocol, known as TJSONProtocol in the
+// Apache Thrift libraries.
+//
+// JSON is significantly larger and slower than Binary but its output is
+// human-readable, which makes it useful for debugging and for transports
+// like HTTP that call for text.
+//
+// Values of type TBinary are encoded as JSON strings and must hold valid
+// UTF-8. Other implementations of this protocol encode Thrift binary
+// fields in base64, so only string fields interoperate with them.
+var JSON Protocol
+
+func init() {
+	JSON = jsonProtocol{}
+}
+
+type jsonProtocol struct{}
+
+func (jsonProtocol) Encode(v wire.Value, w io.Writer) error {
+	writer := json.BorrowWriter(w)
+	err := writer.WriteValue(v)
+	json.ReturnWriter(writer)
+	return err
+}
+
+func (jsonProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	reader := json.NewReader(r)
+	return reader.ReadValue(t)
+}
+
+func (jsonProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
+	writer := json.BorrowWriter(w)
+	err := writer.WriteEnveloped(e)
+	json.ReturnWriter(writer)
+	return err
+}
+
+func (jsonProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	reader := json.NewReader(r)
+	return reader.ReadEnveloped()
+}
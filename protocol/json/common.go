@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package json
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Type identifiers used by the JSON protocol.
+const (
+	typeBool   = "tf"
+	typeI8     = "i8"
+	typeI16    = "i16"
+	typeI32    = "i32"
+	typeI64    = "i64"
+	typeDouble = "dbl"
+	typeBinary = "str"
+	typeStruct = "rec"
+	typeMap    = "map"
+	typeList   = "lst"
+	typeSet    = "set"
+)
+
+// version is the version of the JSON protocol envelope.
+const version = 1
+
+// Special values of doubles. These are encoded as JSON strings because JSON
+// numbers cannot represent them.
+const (
+	nan              = "NaN"
+	positiveInfinity = "Infinity"
+	negativeInfinity = "-Infinity"
+)
+
+// jsonType returns the JSON protocol type identifier for the given
+// wire.Type.
+func jsonType(t wire.Type) (string, error) {
+	switch t {
+	case wire.TBool:
+		return typeBool, nil
+	case wire.TI8:
+		return typeI8, nil
+	case wire.TI16:
+		return typeI16, nil
+	case wire.TI32:
+		return typeI32, nil
+	case wire.TI64:
+		return typeI64, nil
+	case wire.TDouble:
+		return typeDouble, nil
+	case wire.TBinary:
+		return typeBinary, nil
+	case wire.TStruct:
+		return typeStruct, nil
+	case wire.TMap:
+		return typeMap, nil
+	case wire.TList:
+		return typeList, nil
+	case wire.TSet:
+		return typeSet, nil
+	default:
+		return "", fmt.Errorf("unknown ttype %v", t)
+	}
+}
+
+// wireType returns the wire.Type for the given JSON protocol type
+// identifier.
+func wireType(t string) (wire.Type, error) {
+	switch t {
+	case typeBool:
+		return wire.TBool, nil
+	case typeI8:
+		return wire.TI8, nil
+	case typeI16:
+		return wire.TI16, nil
+	case typeI32:
+		return wire.TI32, nil
+	case typeI64:
+		return wire.TI64, nil
+	case typeDouble:
+		return wire.TDouble, nil
+	case typeBinary:
+		return wire.TBinary, nil
+	case typeStruct:
+		return wire.TStruct, nil
+	case typeMap:
+		return wire.TMap, nil
+	case typeList:
+		return wire.TList, nil
+	case typeSet:
+		return wire.TSet, nil
+	default:
+		return 0, decodeErrorf("unknown JSON protocol type %q", t)
+	}
+}
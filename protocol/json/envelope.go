@@ -0,0 +1,107 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package json
+
+import "go.uber.org/thriftrw/wire"
+
+// WriteEnveloped writes enveloped value using the JSON protocol envelope.
+//
+// The envelope is laid out as follows.
+//
+//   [version,"name",type,seqID,value]
+func (jw *Writer) WriteEnveloped(e wire.Envelope) error {
+	if err := jw.writeRaw("["); err != nil {
+		return err
+	}
+	if err := jw.writeInt(version, false); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	if err := jw.writeString([]byte(e.Name)); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	if err := jw.writeInt(int64(e.Type), false); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	if err := jw.writeInt(int64(e.SeqID), false); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	if err := jw.WriteValue(e.Value); err != nil {
+		return err
+	}
+	return jw.writeRaw("]")
+}
+
+// ReadEnveloped reads a JSON protocol envelope.
+func (jr *Reader) ReadEnveloped() (wire.Envelope, error) {
+	var e wire.Envelope
+
+	if err := jr.expectDelim('['); err != nil {
+		return e, err
+	}
+
+	v, err := jr.readInt(32)
+	if err != nil {
+		return e, err
+	}
+	if v != version {
+		return e, decodeErrorf("cannot decode envelope of version: %v", v)
+	}
+
+	e.Name, err = jr.readString()
+	if err != nil {
+		return e, err
+	}
+
+	typ, err := jr.readInt(8)
+	if err != nil {
+		return e, err
+	}
+	e.Type = wire.EnvelopeType(typ)
+
+	seqID, err := jr.readInt(32)
+	if err != nil {
+		return e, err
+	}
+	e.SeqID = int32(seqID)
+
+	e.Value, err = jr.ReadValue(wire.TStruct)
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+
+	if err := jr.expectDelim(']'); err != nil {
+		return wire.Envelope{}, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,403 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package json
+
+import (
+	gojson "encoding/json"
+	"io"
+	"math"
+	"strconv"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// errTruncated is the message of the syntax error returned by encoding/json
+// when the input ends in the middle of a value.
+const errTruncated = "unexpected end of JSON input"
+
+// Reader implements a parser for the Thrift JSON Protocol based on an
+// io.ReaderAt.
+//
+// Collections are decoded eagerly because their contents cannot be located
+// without parsing them.
+type Reader struct {
+	decoder *gojson.Decoder
+}
+
+// NewReader builds a new Reader based on the given io.ReaderAt.
+func NewReader(r io.ReaderAt) Reader {
+	decoder := gojson.NewDecoder(io.NewSectionReader(r, 0, math.MaxInt64))
+	decoder.UseNumber()
+	return Reader{decoder: decoder}
+}
+
+func (jr *Reader) token() (gojson.Token, error) {
+	tok, err := jr.decoder.Token()
+	switch err.(type) {
+	case nil:
+		return tok, nil
+	case *gojson.SyntaxError:
+		if err.Error() != errTruncated {
+			return nil, decodeErrorf("invalid JSON: %v", err)
+		}
+		err = io.EOF
+	}
+	if err == io.EOF {
+		// All EOFs are unexpected for the decoder
+		err = io.ErrUnexpectedEOF
+	}
+	return nil, err
+}
+
+func (jr *Reader) expectDelim(d gojson.Delim) error {
+	tok, err := jr.token()
+	if err != nil {
+		return err
+	}
+	if tok != d {
+		return decodeErrorf("expected %q, got %v", rune(d), tok)
+	}
+	return nil
+}
+
+func (jr *Reader) readString() (string, error) {
+	tok, err := jr.token()
+	if err != nil {
+		return "", err
+	}
+	s, ok := tok.(string)
+	if !ok {
+		return "", decodeErrorf("expected a string, got %v", tok)
+	}
+	return s, nil
+}
+
+// readInt reads a JSON number holding an integer that fits in the given
+// number of bits.
+func (jr *Reader) readInt(bits int) (int64, error) {
+	tok, err := jr.token()
+	if err != nil {
+		return 0, err
+	}
+	n, ok := tok.(gojson.Number)
+	if !ok {
+		return 0, decodeErrorf("expected a number, got %v", tok)
+	}
+	return parseInt(string(n), bits)
+}
+
+func (jr *Reader) readType() (wire.Type, error) {
+	name, err := jr.readString()
+	if err != nil {
+		return 0, err
+	}
+	return wireType(name)
+}
+
+// readSize reads the number of items in a collection.
+func (jr *Reader) readSize() (int, error) {
+	n, err := jr.readInt(32)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, decodeErrorf("got negative length: %v", n)
+	}
+	return int(n), nil
+}
+
+// minCapacity limits the amount of memory we pre-allocate for a collection
+// based on its declared size. We don't want bad requests to lock the system
+// up.
+func minCapacity(size int) int {
+	const maxPrealloc = 1024
+	if size > maxPrealloc {
+		return maxPrealloc
+	}
+	return size
+}
+
+func parseInt(s string, bits int) (int64, error) {
+	n, err := strconv.ParseInt(s, 10, bits)
+	if err != nil {
+		return 0, decodeErrorf("invalid %d-bit integer %q", bits, s)
+	}
+	return n, nil
+}
+
+func parseDouble(s string) (float64, error) {
+	switch s {
+	case nan:
+		return math.NaN(), nil
+	case positiveInfinity:
+		return math.Inf(1), nil
+	case negativeInfinity:
+		return math.Inf(-1), nil
+	}
+
+	d, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, decodeErrorf("invalid double %q", s)
+	}
+	return d, nil
+}
+
+func boolValue(n int64) (wire.Value, error) {
+	switch n {
+	case 0:
+		return wire.NewValueBool(false), nil
+	case 1:
+		return wire.NewValueBool(true), nil
+	default:
+		return wire.Value{}, decodeErrorf("invalid value %v for bool", n)
+	}
+}
+
+// parseKey parses a map key of the given type from its string form.
+func parseKey(t wire.Type, s string) (wire.Value, error) {
+	switch t {
+	case wire.TBool:
+		n, err := parseInt(s, 8)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return boolValue(n)
+	case wire.TI8:
+		n, err := parseInt(s, 8)
+		return wire.NewValueI8(int8(n)), err
+	case wire.TI16:
+		n, err := parseInt(s, 16)
+		return wire.NewValueI16(int16(n)), err
+	case wire.TI32:
+		n, err := parseInt(s, 32)
+		return wire.NewValueI32(int32(n)), err
+	case wire.TI64:
+		n, err := parseInt(s, 64)
+		return wire.NewValueI64(n), err
+	case wire.TDouble:
+		d, err := parseDouble(s)
+		return wire.NewValueDouble(d), err
+	case wire.TBinary:
+		return wire.NewValueBinary([]byte(s)), nil
+	default:
+		return wire.Value{}, decodeErrorf("map keys of type %v are not supported", t)
+	}
+}
+
+func (jr *Reader) readStruct() (wire.Struct, error) {
+	var fields []wire.Field
+
+	if err := jr.expectDelim('{'); err != nil {
+		return wire.Struct{}, err
+	}
+
+	for jr.decoder.More() {
+		key, err := jr.readString()
+		if err != nil {
+			return wire.Struct{}, err
+		}
+		id, err := parseInt(key, 16)
+		if err != nil {
+			return wire.Struct{}, err
+		}
+
+		if err := jr.expectDelim('{'); err != nil {
+			return wire.Struct{}, err
+		}
+		t, err := jr.readType()
+		if err != nil {
+			return wire.Struct{}, err
+		}
+		value, err := jr.ReadValue(t)
+		if err != nil {
+			return wire.Struct{}, err
+		}
+		if err := jr.expectDelim('}'); err != nil {
+			return wire.Struct{}, err
+		}
+
+		fields = append(fields, wire.Field{ID: int16(id), Value: value})
+	}
+
+	if err := jr.expectDelim('}'); err != nil {
+		return wire.Struct{}, err
+	}
+	return wire.Struct{Fields: fields}, nil
+}
+
+func (jr *Reader) readMap() (wire.MapItemList, error) {
+	if err := jr.expectDelim('['); err != nil {
+		return nil, err
+	}
+
+	kt, err := jr.readType()
+	if err != nil {
+		return nil, err
+	}
+	vt, err := jr.readType()
+	if err != nil {
+		return nil, err
+	}
+	size, err := jr.readSize()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jr.expectDelim('{'); err != nil {
+		return nil, err
+	}
+
+	items := make([]wire.MapItem, 0, minCapacity(size))
+	for jr.decoder.More() {
+		s, err := jr.readString()
+		if err != nil {
+			return nil, err
+		}
+		k, err := parseKey(kt, s)
+		if err != nil {
+			return nil, err
+		}
+		v, err := jr.ReadValue(vt)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, wire.MapItem{Key: k, Value: v})
+	}
+
+	if err := jr.expectDelim('}'); err != nil {
+		return nil, err
+	}
+	if err := jr.expectDelim(']'); err != nil {
+		return nil, err
+	}
+
+	if len(items) != size {
+		return nil, decodeErrorf("expected %v map items, got %v", size, len(items))
+	}
+	return wire.MapItemListFromSlice(kt, vt, items), nil
+}
+
+func (jr *Reader) readList() (wire.ValueList, error) {
+	if err := jr.expectDelim('['); err != nil {
+		return nil, err
+	}
+
+	t, err := jr.readType()
+	if err != nil {
+		return nil, err
+	}
+	size, err := jr.readSize()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]wire.Value, 0, minCapacity(size))
+	for jr.decoder.More() {
+		v, err := jr.ReadValue(t)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+
+	if err := jr.expectDelim(']'); err != nil {
+		return nil, err
+	}
+
+	if len(values) != size {
+		return nil, decodeErrorf("expected %v items, got %v", size, len(values))
+	}
+	return wire.ValueListFromSlice(t, values), nil
+}
+
+// ReadValue reads a value of the given type.
+func (jr *Reader) ReadValue(t wire.Type) (wire.Value, error) {
+	switch t {
+	case wire.TBool:
+		n, err := jr.readInt(8)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return boolValue(n)
+
+	case wire.TI8:
+		n, err := jr.readInt(8)
+		return wire.NewValueI8(int8(n)), err
+
+	case wire.TI16:
+		n, err := jr.readInt(16)
+		return wire.NewValueI16(int16(n)), err
+
+	case wire.TI32:
+		n, err := jr.readInt(32)
+		return wire.NewValueI32(int32(n)), err
+
+	case wire.TI64:
+		n, err := jr.readInt(64)
+		return wire.NewValueI64(n), err
+
+	case wire.TDouble:
+		tok, err := jr.token()
+		if err != nil {
+			return wire.Value{}, err
+		}
+
+		var d float64
+		switch tok := tok.(type) {
+		case gojson.Number:
+			d, err = parseDouble(string(tok))
+		case string:
+			// Only special values are expected to be quoted.
+			switch tok {
+			case nan, positiveInfinity, negativeInfinity:
+				d, err = parseDouble(tok)
+			default:
+				err = decodeErrorf("invalid double %q", tok)
+			}
+		default:
+			err = decodeErrorf("expected a double, got %v", tok)
+		}
+		return wire.NewValueDouble(d), err
+
+	case wire.TBinary:
+		s, err := jr.readString()
+		return wire.NewValueBinary([]byte(s)), err
+
+	case wire.TStruct:
+		s, err := jr.readStruct()
+		return wire.NewValueStruct(s), err
+
+	case wire.TMap:
+		m, err := jr.readMap()
+		return wire.NewValueMap(m), err
+
+	case wire.TSet:
+		s, err := jr.readList()
+		return wire.NewValueSet(s), err
+
+	case wire.TList:
+		l, err := jr.readList()
+		return wire.NewValueList(l), err
+
+	default:
+		return wire.Value{}, decodeErrorf("unknown ttype %v", t)
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package json
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+var writerPool = sync.Pool{New: func() interface{} {
+	writer := &Writer{}
+	writer.writeListElement = writer.realWriteListElement
+	writer.writeField = writer.realWriteField
+	writer.writeMapItem = writer.realWriteMapItem
+	return writer
+}}
+
+const hex = "0123456789abcdef"
+
+// Writer implements basic logic for writing the Thrift JSON Protocol to an
+// io.Writer.
+type Writer struct {
+	writer io.Writer
+
+	// This buffer is re-used every time we need to format a number or
+	// escape a string.
+	buffer []byte
+
+	// Whether the next element being written is the first one in its
+	// container. Elements after the first one are preceded by a comma.
+	first bool
+
+	// NOTE:
+	// This is a hack to avoid memory allocation in closures. Passing bound
+	// methods into a function results in a memory allocation because the
+	// system doesn't know we're going to reuse the closure. So we create
+	// those bound references in advance when the writer is created.
+	writeListElement func(wire.Value) error
+	writeField       func(wire.Field) error
+	writeMapItem     func(wire.MapItem) error
+}
+
+// BorrowWriter fetches a Writer from the system that will write its output to
+// the given io.Writer.
+//
+// This Writer must be returned back using ReturnWriter.
+func BorrowWriter(w io.Writer) *Writer {
+	writer := writerPool.Get().(*Writer)
+	writer.writer = w
+	return writer
+}
+
+// ReturnWriter returns a previously borrowed Writer back to the system.
+func ReturnWriter(w *Writer) {
+	w.writer = nil
+	w.buffer = w.buffer[:0]
+	writerPool.Put(w)
+}
+
+func (jw *Writer) write(bs []byte) error {
+	_, err := jw.writer.Write(bs)
+	return err
+}
+
+func (jw *Writer) writeRaw(s string) error {
+	jw.buffer = append(jw.buffer[:0], s...)
+	return jw.write(jw.buffer)
+}
+
+// writeSeparator writes a comma unless this is the first element of its
+// container.
+func (jw *Writer) writeSeparator() error {
+	if jw.first {
+		jw.first = false
+		return nil
+	}
+	return jw.writeRaw(",")
+}
+
+// writeInt writes the given integer, wrapping it in quotes if quoted is
+// true.
+func (jw *Writer) writeInt(n int64, quoted bool) error {
+	bs := jw.buffer[:0]
+	if quoted {
+		bs = append(bs, '"')
+	}
+	bs = strconv.AppendInt(bs, n, 10)
+	if quoted {
+		bs = append(bs, '"')
+	}
+	jw.buffer = bs
+	return jw.write(bs)
+}
+
+func (jw *Writer) writeDouble(d float64, quoted bool) error {
+	switch {
+	case math.IsNaN(d):
+		return jw.writeRaw(`"` + nan + `"`)
+	case math.IsInf(d, 1):
+		return jw.writeRaw(`"` + positiveInfinity + `"`)
+	case math.IsInf(d, -1):
+		return jw.writeRaw(`"` + negativeInfinity + `"`)
+	}
+
+	bs := jw.buffer[:0]
+	if quoted {
+		bs = append(bs, '"')
+	}
+	bs = strconv.AppendFloat(bs, d, 'g', -1, 64)
+	if quoted {
+		bs = append(bs, '"')
+	}
+	jw.buffer = bs
+	return jw.write(bs)
+}
+
+// writeString writes the given bytes as a JSON string.
+//
+// Quotes, backslashes, and control characters are escaped. All other
+// characters are written as-is so b must be valid UTF-8.
+func (jw *Writer) writeString(b []byte) error {
+	if !utf8.Valid(b) {
+		return fmt.Errorf("cannot encode %q as a JSON string: value is not valid UTF-8", b)
+	}
+
+	bs := append(jw.buffer[:0], '"')
+	for _, c := range b {
+		switch c {
+		case '"', '\\':
+			bs = append(bs, '\\', c)
+		case '\b':
+			bs = append(bs, '\\', 'b')
+		case '\f':
+			bs = append(bs, '\\', 'f')
+		case '\n':
+			bs = append(bs, '\\', 'n')
+		case '\r':
+			bs = append(bs, '\\', 'r')
+		case '\t':
+			bs = append(bs, '\\', 't')
+		default:
+			if c < 0x20 {
+				bs = append(bs, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				bs = append(bs, c)
+			}
+		}
+	}
+	bs = append(bs, '"')
+	jw.buffer = bs
+	return jw.write(bs)
+}
+
+func (jw *Writer) writeType(t wire.Type) error {
+	name, err := jsonType(t)
+	if err != nil {
+		return err
+	}
+	return jw.writeRaw(`"` + name + `"`)
+}
+
+// writeTypedValue writes the given value wrapped in an object keyed by its
+// type.
+//
+//   {"i32":42}
+func (jw *Writer) writeTypedValue(v wire.Value) error {
+	name, err := jsonType(v.Type())
+	if err != nil {
+		return err
+	}
+	if err := jw.writeRaw(`{"` + name + `":`); err != nil {
+		return err
+	}
+	if err := jw.WriteValue(v); err != nil {
+		return err
+	}
+	return jw.writeRaw("}")
+}
+
+func (jw *Writer) realWriteField(f wire.Field) error {
+	if err := jw.writeSeparator(); err != nil {
+		return err
+	}
+	if err := jw.writeInt(int64(f.ID), true); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(":"); err != nil {
+		return err
+	}
+
+	if err := jw.writeTypedValue(f.Value); err != nil {
+		return err
+	}
+	jw.first = false
+	return nil
+}
+
+func (jw *Writer) writeStruct(s wire.Struct) error {
+	if err := jw.writeRaw("{"); err != nil {
+		return err
+	}
+
+	jw.first = true
+	for _, f := range s.Fields {
+		if err := jw.writeField(f); err != nil {
+			return err
+		}
+	}
+
+	return jw.writeRaw("}")
+}
+
+// writeKey writes the given value as a JSON object key. Only values of
+// primitive types may be used as keys.
+func (jw *Writer) writeKey(v wire.Value) error {
+	switch v.Type() {
+	case wire.TBool:
+		var n int64
+		if v.GetBool() {
+			n = 1
+		}
+		return jw.writeInt(n, true)
+	case wire.TI8:
+		return jw.writeInt(int64(v.GetI8()), true)
+	case wire.TI16:
+		return jw.writeInt(int64(v.GetI16()), true)
+	case wire.TI32:
+		return jw.writeInt(int64(v.GetI32()), true)
+	case wire.TI64:
+		return jw.writeInt(v.GetI64(), true)
+	case wire.TDouble:
+		return jw.writeDouble(v.GetDouble(), true)
+	case wire.TBinary:
+		return jw.writeString(v.GetBinary())
+	default:
+		return fmt.Errorf("map keys of type %v are not supported by the JSON protocol", v.Type())
+	}
+}
+
+func (jw *Writer) realWriteMapItem(item wire.MapItem) error {
+	if err := jw.writeSeparator(); err != nil {
+		return err
+	}
+	if err := jw.writeKey(item.Key); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(":"); err != nil {
+		return err
+	}
+	if err := jw.WriteValue(item.Value); err != nil {
+		return err
+	}
+	jw.first = false
+	return nil
+}
+
+// writeMap writes a map in the form,
+//
+//   [keyType,valueType,size,{key:value,...}]
+func (jw *Writer) writeMap(m wire.MapItemList) error {
+	if err := jw.writeRaw("["); err != nil {
+		return err
+	}
+	if err := jw.writeType(m.KeyType()); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	if err := jw.writeType(m.ValueType()); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	if err := jw.writeInt(int64(m.Size()), false); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(",{"); err != nil {
+		return err
+	}
+
+	jw.first = true
+	if err := m.ForEach(jw.writeMapItem); err != nil {
+		return err
+	}
+
+	return jw.writeRaw("}]")
+}
+
+func (jw *Writer) realWriteListElement(v wire.Value) error {
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	return jw.WriteValue(v)
+}
+
+// writeList writes a list or a set in the form,
+//
+//   [valueType,size,value,...]
+func (jw *Writer) writeList(l wire.ValueList) error {
+	if err := jw.writeRaw("["); err != nil {
+		return err
+	}
+	if err := jw.writeType(l.ValueType()); err != nil {
+		return err
+	}
+	if err := jw.writeRaw(","); err != nil {
+		return err
+	}
+	if err := jw.writeInt(int64(l.Size()), false); err != nil {
+		return err
+	}
+	if err := l.ForEach(jw.writeListElement); err != nil {
+		return err
+	}
+	return jw.writeRaw("]")
+}
+
+// WriteValue writes the given Thrift value to the underlying stream using
+// the Thrift JSON Protocol.
+func (jw *Writer) WriteValue(v wire.Value) error {
+	switch v.Type() {
+	case wire.TBool:
+		if v.GetBool() {
+			return jw.writeRaw("1")
+		}
+		return jw.writeRaw("0")
+
+	case wire.TI8:
+		return jw.writeInt(int64(v.GetI8()), false)
+
+	case wire.TI16:
+		return jw.writeInt(int64(v.GetI16()), false)
+
+	case wire.TI32:
+		return jw.writeInt(int64(v.GetI32()), false)
+
+	case wire.TI64:
+		return jw.writeInt(v.GetI64(), false)
+
+	case wire.TDouble:
+		return jw.writeDouble(v.GetDouble(), false)
+
+	case wire.TBinary:
+		return jw.writeString(v.GetBinary())
+
+	case wire.TStruct:
+		return jw.writeStruct(v.GetStruct())
+
+	case wire.TMap:
+		return jw.writeMap(v.GetMap())
+
+	case wire.TSet:
+		return jw.writeList(v.GetSet())
+
+	case wire.TList:
+		return jw.writeList(v.GetList())
+
+	default:
+		return fmt.Errorf("unknown ttype %v", v.Type())
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/json"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonEncodeDecodeTest struct {
+	value   wire.Value
+	encoded string
+}
+
+func checkJSONEncodeDecode(t *testing.T, typ wire.Type, tests []jsonEncodeDecodeTest) {
+	for _, tt := range tests {
+		buffer := bytes.Buffer{}
+
+		// encode and match bytes
+		err := JSON.Encode(tt.value, &buffer)
+		if assert.NoError(t, err, "Encode failed:\n%s", tt.value) {
+			assert.Equal(t, tt.encoded, buffer.String())
+		}
+
+		// decode and match value
+		value, err := JSON.Decode(bytes.NewReader([]byte(tt.encoded)), typ)
+		if assert.NoError(t, err, "Decode failed:\n%s", tt.value) {
+			assert.True(
+				t, wire.ValuesAreEqual(tt.value, value),
+				fmt.Sprintf("\n\t   %v (expected)\n\t!= %v (actual)", tt.value, value),
+			)
+		}
+	}
+}
+
+func checkJSONDecodeFailure(t *testing.T, typ wire.Type, tests []string) {
+	for _, tt := range tests {
+		value, err := JSON.Decode(bytes.NewReader([]byte(tt)), typ)
+		if assert.Error(t, err, "Expected failure parsing %q, got %s", tt, value) {
+			assert.True(
+				t,
+				json.IsDecodeError(err),
+				"Expected decode error while parsing %q, got %s",
+				tt,
+				err,
+			)
+		}
+	}
+}
+
+func checkJSONEOFError(t *testing.T, typ wire.Type, tests []string) {
+	for _, tt := range tests {
+		value, err := JSON.Decode(bytes.NewReader([]byte(tt)), typ)
+		if assert.Error(t, err, "Expected failure parsing %q, got %s", tt, value) {
+			assert.Equal(
+				t, io.ErrUnexpectedEOF, err,
+				"Expected EOF error while parsing %q, got %s", tt, err,
+			)
+		}
+	}
+}
+
+func TestJSONBool(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TBool, []jsonEncodeDecodeTest{
+		{vbool(true), `1`},
+		{vbool(false), `0`},
+	})
+	checkJSONDecodeFailure(t, wire.TBool, []string{`2`, `true`, `"1"`})
+	checkJSONEOFError(t, wire.TBool, []string{``})
+}
+
+func TestJSONIntegers(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TI8, []jsonEncodeDecodeTest{
+		{vi8(0), `0`},
+		{vi8(math.MaxInt8), `127`},
+		{vi8(math.MinInt8), `-128`},
+	})
+	checkJSONDecodeFailure(t, wire.TI8, []string{`128`, `1.5`, `"1"`})
+
+	checkJSONEncodeDecode(t, wire.TI16, []jsonEncodeDecodeTest{
+		{vi16(math.MaxInt16), `32767`},
+		{vi16(math.MinInt16), `-32768`},
+	})
+	checkJSONDecodeFailure(t, wire.TI16, []string{`32768`})
+
+	checkJSONEncodeDecode(t, wire.TI32, []jsonEncodeDecodeTest{
+		{vi32(math.MaxInt32), `2147483647`},
+		{vi32(math.MinInt32), `-2147483648`},
+	})
+	checkJSONDecodeFailure(t, wire.TI32, []string{`2147483648`})
+
+	checkJSONEncodeDecode(t, wire.TI64, []jsonEncodeDecodeTest{
+		{vi64(math.MaxInt64), `9223372036854775807`},
+		{vi64(math.MinInt64), `-9223372036854775808`},
+	})
+	checkJSONDecodeFailure(t, wire.TI64, []string{`9223372036854775808`, `1e3`})
+	checkJSONEOFError(t, wire.TI64, []string{``})
+}
+
+func TestJSONDouble(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TDouble, []jsonEncodeDecodeTest{
+		{vdouble(0), `0`},
+		{vdouble(1.5), `1.5`},
+		{vdouble(-1e100), `-1e+100`},
+		{vdouble(math.Inf(1)), `"Infinity"`},
+		{vdouble(math.Inf(-1)), `"-Infinity"`},
+	})
+
+	var buffer bytes.Buffer
+	require.NoError(t, JSON.Encode(vdouble(math.NaN()), &buffer))
+	assert.Equal(t, `"NaN"`, buffer.String())
+
+	v, err := JSON.Decode(bytes.NewReader(buffer.Bytes()), wire.TDouble)
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(v.GetDouble()))
+
+	checkJSONDecodeFailure(t, wire.TDouble, []string{`"1.5"`, `[]`})
+}
+
+func TestJSONBinary(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TBinary, []jsonEncodeDecodeTest{
+		{vbinary(""), `""`},
+		{vbinary("hello"), `"hello"`},
+		{vbinary(`"quoted" \ back`), `"\"quoted\" \\ back"`},
+		{vbinary("a\nb\tc\x01"), `"a\nb\tc\u0001"`},
+		{vbinary("héllo 世界"), `"héllo 世界"`},
+	})
+
+	value, err := JSON.Decode(bytes.NewReader([]byte(`"é😀"`)), wire.TBinary)
+	require.NoError(t, err)
+	assert.Equal(t, "é😀", value.GetString())
+
+	checkJSONDecodeFailure(t, wire.TBinary, []string{`1`, `[]`})
+	checkJSONEOFError(t, wire.TBinary, []string{``, `"foo`})
+
+	var buffer bytes.Buffer
+	err = JSON.Encode(wire.NewValueBinary([]byte{0xff, 0xfe}), &buffer)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "not valid UTF-8")
+	}
+}
+
+func TestJSONStruct(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TStruct, []jsonEncodeDecodeTest{
+		{vstruct(), `{}`},
+		{
+			vstruct(vfield(1, vbool(true))),
+			`{"1":{"tf":1}}`,
+		},
+		{
+			vstruct(
+				vfield(1, vi16(42)),
+				vfield(2, vbinary("foo")),
+				vfield(-3, vstruct(vfield(1, vdouble(1.5)))),
+			),
+			`{"1":{"i16":42},"2":{"str":"foo"},"-3":{"rec":{"1":{"dbl":1.5}}}}`,
+		},
+	})
+	checkJSONDecodeFailure(t, wire.TStruct, []string{
+		`[]`,
+		`{"foo":{"i32":1}}`,
+		`{"1":{"bar":1}}`,
+		`{"1":{"i32":"1"}}`,
+		`{"1":{"i32":1,"i64":2}}`,
+	})
+	checkJSONEOFError(t, wire.TStruct, []string{``, `{`, `{"1":{"i32":1}`})
+}
+
+func TestJSONList(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TList, []jsonEncodeDecodeTest{
+		{vlist(wire.TI32), `["i32",0]`},
+		{vlist(wire.TI32, vi32(1), vi32(2), vi32(3)), `["i32",3,1,2,3]`},
+		{
+			vlist(wire.TStruct, vstruct(vfield(1, vbinary("a"))), vstruct()),
+			`["rec",2,{"1":{"str":"a"}},{}]`,
+		},
+		{
+			vlist(wire.TList, vlist(wire.TBool, vbool(true))),
+			`["lst",1,["tf",1,1]]`,
+		},
+	})
+	checkJSONDecodeFailure(t, wire.TList, []string{
+		`["i32",-1]`,
+		`["i32",2,1]`,
+		`["i32",1,1,2]`,
+		`["foo",0]`,
+		`["i32",2000000000]`,
+	})
+	checkJSONEOFError(t, wire.TList, []string{`[`, `["i32",2,1`})
+}
+
+func TestJSONSet(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TSet, []jsonEncodeDecodeTest{
+		{vset(wire.TBinary, vbinary("a"), vbinary("b")), `["str",2,"a","b"]`},
+	})
+}
+
+func TestJSONMap(t *testing.T) {
+	checkJSONEncodeDecode(t, wire.TMap, []jsonEncodeDecodeTest{
+		{vmap(wire.TBinary, wire.TI32), `["str","i32",0,{}]`},
+		{
+			vmap(wire.TBinary, wire.TI32,
+				vitem(vbinary("a"), vi32(1)),
+				vitem(vbinary("b"), vi32(2)),
+			),
+			`["str","i32",2,{"a":1,"b":2}]`,
+		},
+		{
+			vmap(wire.TI64, wire.TList,
+				vitem(vi64(-1), vlist(wire.TDouble, vdouble(0.5))),
+			),
+			`["i64","lst",1,{"-1":["dbl",1,0.5]}]`,
+		},
+		{
+			vmap(wire.TBool, wire.TDouble,
+				vitem(vbool(true), vdouble(1)),
+				vitem(vbool(false), vdouble(math.Inf(1))),
+			),
+			`["tf","dbl",2,{"1":1,"0":"Infinity"}]`,
+		},
+		{
+			vmap(wire.TDouble, wire.TI8, vitem(vdouble(1.5), vi8(1))),
+			`["dbl","i8",1,{"1.5":1}]`,
+		},
+	})
+	checkJSONDecodeFailure(t, wire.TMap, []string{
+		`["str","i32",1,{}]`,
+		`["i32","i32",1,{"a":1}]`,
+		`["rec","i32",1,{"a":1}]`,
+		`["str","i32",2000000000,{}]`,
+	})
+	checkJSONEOFError(t, wire.TMap, []string{`["str"`, `["str","i32",1,{"a":1}`})
+
+	var buffer bytes.Buffer
+	err := JSON.Encode(vmap(wire.TStruct, wire.TI32, vitem(vstruct(), vi32(1))), &buffer)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "map keys of type TStruct are not supported")
+	}
+}
+
+func TestJSONEnvelope(t *testing.T) {
+	tests := []struct {
+		msg     string
+		encoded string
+		want    wire.Envelope
+	}{
+		{
+			msg:     "call",
+			encoded: `[1,"abc",1,42,{"1":{"i16":100}}]`,
+			want: wire.Envelope{
+				Name:  "abc",
+				Type:  wire.Call,
+				SeqID: 42,
+				Value: vstruct(vfield(1, vi16(100))),
+			},
+		},
+		{
+			msg:     "oneway",
+			encoded: `[1,"",4,-1,{}]`,
+			want: wire.Envelope{
+				Type:  wire.OneWay,
+				SeqID: -1,
+				Value: vstruct(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			var buffer bytes.Buffer
+			require.NoError(t, JSON.EncodeEnveloped(tt.want, &buffer))
+			assert.Equal(t, tt.encoded, buffer.String())
+
+			e, err := JSON.DecodeEnveloped(bytes.NewReader([]byte(tt.encoded)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Name, e.Name)
+			assert.Equal(t, tt.want.Type, e.Type)
+			assert.Equal(t, tt.want.SeqID, e.SeqID)
+			assert.True(t, wire.ValuesAreEqual(tt.want.Value, e.Value))
+		})
+	}
+}
+
+func TestJSONEnvelopeErrors(t *testing.T) {
+	tests := []struct {
+		encoded string
+		errMsg  string
+	}{
+		{
+			encoded: `[2,"abc",1,42,{}]`,
+			errMsg:  "cannot decode envelope of version",
+		},
+		{
+			encoded: `[1,"abc",1,42,{},1]`,
+			errMsg:  `expected ']'`,
+		},
+		{
+			encoded: `{"1":{"i32":1}}`,
+			errMsg:  `expected '['`,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := JSON.DecodeEnveloped(bytes.NewReader([]byte(tt.encoded)))
+		if assert.Error(t, err, "%v: should fail", tt.errMsg) {
+			assert.Contains(t, err.Error(), tt.errMsg)
+		}
+	}
+}
+
+func TestJSONStructOfContainers(t *testing.T) {
+	v := vstruct(
+		vfield(1, vlist(wire.TMap,
+			vmap(wire.TI32, wire.TSet,
+				vitem(vi32(1), vset(wire.TBinary, vbinary("foo"))),
+			),
+		)),
+		vfield(2, vbool(true)),
+		vfield(100, vstruct(vfield(1, vdouble(1.5)))),
+	)
+
+	var buffer bytes.Buffer
+	require.NoError(t, JSON.Encode(v, &buffer))
+	assert.Equal(t,
+		`{"1":{"lst":["map",1,["i32","set",1,{"1":["str",1,"foo"]}]]},"2":{"tf":1},"100":{"rec":{"1":{"dbl":1.5}}}}`,
+		buffer.String())
+
+	got, err := JSON.Decode(bytes.NewReader(buffer.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(v, got), "%v != %v", v, got)
+}
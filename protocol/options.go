@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+)
+
+// Options configures limits enforced by protocols built with NewBinary,
+// NewCompact, and NewBinaryStreamer when decoding values. These protect
+// servers from payloads crafted to exhaust their memory or stack, for
+// example a list claiming billions of items or thousands of nested
+// structs.
+//
+// Zero values mean that the corresponding limit is not enforced.
+//
+// 	p := protocol.NewBinary(protocol.Options{
+// 		MaxContainerSize: 10000,
+// 		MaxDepth:         64,
+// 		MaxStringLength:  1 << 20,
+// 	})
+type Options struct {
+	// Maximum number of items in a list, set, or map.
+	MaxContainerSize int
+
+	// Maximum nesting depth of structs and containers. A struct with only
+	// primitive fields has a depth of 1.
+	MaxDepth int
+
+	// Maximum length of a binary or string value in bytes.
+	MaxStringLength int
+
+	// Maximum number of bytes a streaming decoder may consume while
+	// skipping over a single value, for example a field that was added to
+	// the IDL after the code was generated. This does not apply to
+	// decoders which produce wire.Values because they don't skip values.
+	MaxSkipBytes int64
+
+	// If non-nil, decoded strings and binary values no longer than the
+	// Interner's maximum length are de-duplicated through it. This cuts
+	// memory for payloads with highly repetitive content such as maps of
+	// tags. Binary values decoded this way may share memory and MUST NOT
+	// be modified.
+	//
+	// The same Interner may be shared between protocols.
+	Interner *Interner
+}
+
+func (o Options) limits() binary.Limits {
+	return binary.Limits{
+		MaxContainerSize: o.MaxContainerSize,
+		MaxDepth:         o.MaxDepth,
+		MaxStringLength:  o.MaxStringLength,
+		MaxSkipBytes:     o.MaxSkipBytes,
+	}
+}
+
+// LimitError is returned by protocols built with Options when a decoded
+// value exceeds one of the limits. The Limit field names the Options field
+// that was exceeded.
+type LimitError = binary.LimitError
+
+// Interner de-duplicates short strings and binary values decoded by
+// protocols built with Options. See binary.Interner for details.
+type Interner = binary.Interner
+
+// NewInterner builds an Interner which holds up to maxEntries values, each
+// of at most maxLength bytes.
+//
+// 	p := protocol.NewBinary(protocol.Options{
+// 		Interner: protocol.NewInterner(4096, 64),
+// 	})
+func NewInterner(maxEntries, maxLength int) *Interner {
+	return binary.NewInterner(maxEntries, maxLength)
+}
+
+// NewBinary builds an implementation of the Thrift Binary Protocol which
+// enforces the given limits when decoding values. Like Binary, it can be
+// cast up to EnvelopeAgnosticProtocol.
+func NewBinary(opts Options) Protocol {
+	return binaryProtocol{limits: opts.limits(), interner: opts.Interner}
+}
+
+// NewCompact builds an implementation of the Thrift Compact Protocol which
+// enforces the given limits when decoding values.
+func NewCompact(opts Options) Protocol {
+	return compactProtocol{limits: opts.limits(), interner: opts.Interner}
+}
+
+// NewBinaryStreamer builds an implementation of the Thrift Binary Protocol
+// for streaming decoders which enforces the given limits.
+func NewBinaryStreamer(opts Options) stream.Protocol {
+	return binaryStreamer{limits: opts.limits(), interner: opts.Interner}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/compact"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsLimits(t *testing.T) {
+	nested := vstruct(vfield(1, vstruct(vfield(1, vstruct(vfield(1, vi32(42)))))))
+	nestedLists := vstruct(vfield(1, vlist(wire.TList, vlist(wire.TList, vlist(wire.TI32, vi32(1))))))
+
+	tests := []struct {
+		desc string
+		opts Options
+		give wire.Value
+
+		// Expected error, if any.
+		wantLimit string
+		wantValue int64
+	}{
+		{
+			desc: "no limits",
+			give: vstruct(
+				vfield(1, vlist(wire.TI32, vi32(1), vi32(2), vi32(3))),
+				vfield(2, vbinary("hello")),
+				vfield(3, nested),
+			),
+		},
+		{
+			desc:      "list too large",
+			opts:      Options{MaxContainerSize: 2},
+			give:      vstruct(vfield(1, vlist(wire.TI32, vi32(1), vi32(2), vi32(3)))),
+			wantLimit: "MaxContainerSize",
+			wantValue: 3,
+		},
+		{
+			desc:      "set too large",
+			opts:      Options{MaxContainerSize: 1},
+			give:      vstruct(vfield(1, vset(wire.TBinary, vbinary("a"), vbinary("b")))),
+			wantLimit: "MaxContainerSize",
+			wantValue: 2,
+		},
+		{
+			desc: "map too large",
+			opts: Options{MaxContainerSize: 1},
+			give: vstruct(vfield(1, vmap(wire.TI32, wire.TI32,
+				vitem(vi32(1), vi32(2)),
+				vitem(vi32(3), vi32(4)),
+			))),
+			wantLimit: "MaxContainerSize",
+			wantValue: 2,
+		},
+		{
+			desc: "containers within limits",
+			opts: Options{MaxContainerSize: 3},
+			give: vstruct(vfield(1, vlist(wire.TI32, vi32(1), vi32(2), vi32(3)))),
+		},
+		{
+			desc:      "string too long",
+			opts:      Options{MaxStringLength: 4},
+			give:      vstruct(vfield(1, vbinary("hello"))),
+			wantLimit: "MaxStringLength",
+			wantValue: 5,
+		},
+		{
+			desc:      "string in list too long",
+			opts:      Options{MaxStringLength: 4},
+			give:      vstruct(vfield(1, vlist(wire.TBinary, vbinary("hi"), vbinary("hello")))),
+			wantLimit: "MaxStringLength",
+			wantValue: 5,
+		},
+		{
+			desc:      "structs nested too deeply",
+			opts:      Options{MaxDepth: 2},
+			give:      nested,
+			wantLimit: "MaxDepth",
+			wantValue: 3,
+		},
+		{
+			desc: "structs nested within limits",
+			opts: Options{MaxDepth: 3},
+			give: nested,
+		},
+		{
+			desc:      "lists nested too deeply",
+			opts:      Options{MaxDepth: 3},
+			give:      nestedLists,
+			wantLimit: "MaxDepth",
+			wantValue: 4,
+		},
+	}
+
+	protocols := []struct {
+		name  string
+		build func(Options) Protocol
+		plain Protocol
+	}{
+		{"Binary", NewBinary, Binary},
+		{"Compact", NewCompact, Compact},
+	}
+
+	for _, p := range protocols {
+		for _, tt := range tests {
+			t.Run(p.name+"/"+tt.desc, func(t *testing.T) {
+				var buf bytes.Buffer
+				require.NoError(t, p.plain.Encode(tt.give, &buf))
+
+				got, err := p.build(tt.opts).Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+				if tt.wantLimit == "" {
+					require.NoError(t, err)
+					// Lazy values are evaluated by the comparison.
+					assert.True(t, wire.ValuesAreEqual(tt.give, got))
+					return
+				}
+
+				require.Error(t, err)
+				assert.Equal(t, &LimitError{
+					Limit: tt.wantLimit,
+					Value: tt.wantValue,
+					Max:   limitMax(tt.opts, tt.wantLimit),
+				}, err)
+				assert.True(t, binary.IsDecodeError(err), "limit errors must be decode errors")
+				assert.True(t, compact.IsDecodeError(err), "limit errors must be decode errors")
+			})
+		}
+	}
+}
+
+func limitMax(o Options, limit string) int64 {
+	switch limit {
+	case "MaxContainerSize":
+		return int64(o.MaxContainerSize)
+	case "MaxDepth":
+		return int64(o.MaxDepth)
+	case "MaxStringLength":
+		return int64(o.MaxStringLength)
+	default:
+		return o.MaxSkipBytes
+	}
+}
+
+func TestOptionsEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Binary.EncodeEnveloped(wire.Envelope{
+		Name:  strings.Repeat("a", 100),
+		Type:  wire.Call,
+		Value: vstruct(),
+	}, &buf))
+
+	_, err := NewBinary(Options{MaxStringLength: 10}).DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+	assert.Equal(t, &LimitError{Limit: "MaxStringLength", Value: 100, Max: 10}, err)
+
+	_, err = NewBinary(Options{MaxStringLength: 100}).DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+}
+
+func TestOptionsStreamer(t *testing.T) {
+	encode := func(v wire.Value) *bytes.Reader {
+		var buf bytes.Buffer
+		require.NoError(t, Binary.Encode(v, &buf))
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	unknown := vstruct(
+		vfield(1, vbinary(strings.Repeat("x", 100))),
+		vfield(2, vlist(wire.TI64, vi64(1), vi64(2))),
+	)
+
+	t.Run("skip too many bytes", func(t *testing.T) {
+		sr := NewBinaryStreamer(Options{MaxSkipBytes: 50}).Reader(encode(unknown))
+		err := sr.Skip(wire.TStruct)
+		require.Error(t, err)
+		limitErr, ok := err.(*LimitError)
+		require.True(t, ok, "expected LimitError, got %T", err)
+		assert.Equal(t, "MaxSkipBytes", limitErr.Limit)
+	})
+
+	t.Run("skip within limits", func(t *testing.T) {
+		// 3 + 4 + 100 bytes for the binary field, 3 + 5 + 16 bytes for the
+		// list, and 1 byte for the end of the struct.
+		sr := NewBinaryStreamer(Options{MaxSkipBytes: 132}).Reader(encode(unknown))
+		require.NoError(t, sr.Skip(wire.TStruct))
+
+		// The budget applies to each skipped value separately.
+		sr = NewBinaryStreamer(Options{MaxSkipBytes: 132}).Reader(
+			encode(vlist(wire.TStruct, unknown, unknown)))
+		h, err := sr.ReadListBegin()
+		require.NoError(t, err)
+		for i := 0; i < h.Length; i++ {
+			require.NoError(t, sr.Skip(wire.TStruct))
+		}
+	})
+
+	t.Run("skip nested too deeply", func(t *testing.T) {
+		sr := NewBinaryStreamer(Options{MaxDepth: 2}).Reader(
+			encode(vstruct(vfield(1, vstruct(vfield(1, vstruct()))))))
+		err := sr.Skip(wire.TStruct)
+		assert.Equal(t, &LimitError{Limit: "MaxDepth", Value: 3, Max: 2}, err)
+	})
+
+	t.Run("read nested", func(t *testing.T) {
+		sr := NewBinaryStreamer(Options{MaxDepth: 2}).Reader(
+			encode(vlist(wire.TList, vlist(wire.TI32), vlist(wire.TI32))))
+		h, err := sr.ReadListBegin()
+		require.NoError(t, err)
+		for i := 0; i < h.Length; i++ {
+			// Ending a list must restore the depth for its siblings.
+			_, err := sr.ReadListBegin()
+			require.NoError(t, err)
+			require.NoError(t, sr.ReadListEnd())
+		}
+		require.NoError(t, sr.ReadListEnd())
+	})
+
+	t.Run("container too large", func(t *testing.T) {
+		sr := NewBinaryStreamer(Options{MaxContainerSize: 1}).Reader(
+			encode(vmap(wire.TI32, wire.TI32, vitem(vi32(1), vi32(2)), vitem(vi32(3), vi32(4)))))
+		_, err := sr.ReadMapBegin()
+		assert.Equal(t, &LimitError{Limit: "MaxContainerSize", Value: 2, Max: 1}, err)
+	})
+
+	t.Run("string too long", func(t *testing.T) {
+		sr := NewBinaryStreamer(Options{MaxStringLength: 4}).Reader(encode(vbinary("hello")))
+		_, err := sr.ReadString()
+		assert.Equal(t, &LimitError{Limit: "MaxStringLength", Value: 5, Max: 4}, err)
+	})
+}
+
+func TestOptionsInterner(t *testing.T) {
+	long := strings.Repeat("x", 20)
+	give := vstruct(
+		vfield(1, vlist(wire.TBinary, vbinary("tag"), vbinary("tag"), vbinary(long), vbinary(long))),
+		vfield(2, vbinary("")),
+	)
+
+	protocols := []struct {
+		name  string
+		build func(Options) Protocol
+		plain Protocol
+	}{
+		{"Binary", NewBinary, Binary},
+		{"Compact", NewCompact, Compact},
+	}
+
+	for _, p := range protocols {
+		t.Run(p.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, p.plain.Encode(give, &buf))
+
+			interner := NewInterner(16, 8)
+			got, err := p.build(Options{Interner: interner}).Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+			require.NoError(t, err)
+			require.True(t, wire.ValuesAreEqual(give, got))
+
+			var items [][]byte
+			require.NoError(t, got.GetStruct().Fields[0].Value.GetList().ForEach(func(v wire.Value) error {
+				items = append(items, v.GetBinary())
+				return nil
+			}))
+			require.Len(t, items, 4)
+			assert.True(t, &items[0][0] == &items[1][0], "short values must be shared")
+			assert.False(t, &items[2][0] == &items[3][0], "long values must not be interned")
+
+			// Values decoded separately share memory too.
+			again, err := p.build(Options{Interner: interner}).Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+			require.NoError(t, err)
+			first := wire.ValueListToSlice(again.GetStruct().Fields[0].Value.GetList())
+			assert.True(t, &items[0][0] == &first[0].GetBinary()[0], "short values must be shared")
+		})
+	}
+
+	t.Run("Envelope", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Binary.EncodeEnveloped(wire.Envelope{
+			Name:  "getValue",
+			Type:  wire.Call,
+			Value: vstruct(),
+		}, &buf))
+
+		e, err := NewBinary(Options{Interner: NewInterner(16, 8)}).DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, "getValue", e.Name)
+	})
+
+	t.Run("Streamer", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Binary.Encode(
+			vlist(wire.TBinary, vbinary("tag"), vbinary("tag"), vbinary(""), vbinary(long)), &buf))
+
+		sr := NewBinaryStreamer(Options{Interner: NewInterner(16, 8)}).Reader(bytes.NewReader(buf.Bytes()))
+		_, err := sr.ReadListBegin()
+		require.NoError(t, err)
+
+		a, err := sr.ReadBinary()
+		require.NoError(t, err)
+		b, err := sr.ReadBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("tag"), a)
+		assert.True(t, &a[0] == &b[0], "short values must be shared")
+
+		s, err := sr.ReadString()
+		require.NoError(t, err)
+		assert.Equal(t, "", s)
+
+		s, err = sr.ReadString()
+		require.NoError(t, err)
+		assert.Equal(t, long, s)
+		require.NoError(t, sr.ReadListEnd())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		interner := NewInterner(0, 8)
+		assert.False(t, interner.Interns(3))
+
+		for _, p := range protocols {
+			var buf bytes.Buffer
+			require.NoError(t, p.plain.Encode(give, &buf))
+
+			got, err := p.build(Options{Interner: interner}).Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+			require.NoError(t, err, p.name)
+			require.True(t, wire.ValuesAreEqual(give, got), p.name)
+
+			items := wire.ValueListToSlice(got.GetStruct().Fields[0].Value.GetList())
+			assert.False(t, &items[0].GetBinary()[0] == &items[1].GetBinary()[0],
+				"%v: values must not be shared", p.name)
+		}
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		interner := NewInterner(2, 8)
+		a := interner.Bytes([]byte("a"))
+		assert.True(t, &a[0] == &interner.Bytes([]byte("a"))[0])
+
+		interner.Bytes([]byte("b"))
+		interner.Bytes([]byte("c")) // evicts everything
+		assert.False(t, &a[0] == &interner.Bytes([]byte("a"))[0])
+		assert.Equal(t, "a", interner.String([]byte("a")))
+	})
+
+	t.Run("does not retain input", func(t *testing.T) {
+		interner := NewInterner(2, 8)
+		in := []byte("abc")
+		out := interner.Bytes(in)
+		in[0] = 'x'
+		assert.Equal(t, []byte("abc"), out)
+
+		in = []byte(long)
+		out = interner.Bytes(in)
+		in[0] = 'y'
+		assert.Equal(t, []byte(long), out)
+	})
+}
+
+func TestLimitErrorMessage(t *testing.T) {
+	err := &LimitError{Limit: "MaxDepth", Value: 65, Max: 64}
+	assert.Equal(t, "MaxDepth exceeded: 65 is greater than 64", err.Error())
+}
@@ -24,6 +24,7 @@ package protocol
 import (
 	"io"
 
+	"go.uber.org/thriftrw/protocol/binary"
 	"go.uber.org/thriftrw/wire"
 )
 
@@ -45,6 +46,18 @@ type Protocol interface {
 	DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error)
 }
 
+// ArenaProtocol is a Protocol which can decode values into memory borrowed
+// from a binary.Arena. Binary, NonStrictBinary, and protocols built with
+// NewBinary can be cast up to ArenaProtocol.
+type ArenaProtocol interface {
+	Protocol
+
+	// DecodeEnvelopedArena reads an enveloped value from the given Reader,
+	// allocating its struct fields and binary values from the given Arena.
+	// The value MUST NOT be used after the Arena is released.
+	DecodeEnvelopedArena(a *binary.Arena, r io.ReaderAt) (wire.Envelope, error)
+}
+
 // EnvelopeAgnosticProtocol defines a specific way for a Thrift value to be
 // encoded or decoded, additionally being able to decode requests without prior
 // knowledge of whether the request is enveloped.
@@ -0,0 +1,66 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+)
+
+// BinaryStreamer implements the Thrift Binary Protocol for streaming
+// decoders and encoders.
+//
+// Generated types may be decoded directly from a byte stream using their
+// Decode methods, skipping the intermediate wire.Value representation.
+//
+//   var v MyStruct
+//   if err := v.Decode(protocol.BinaryStreamer.Reader(r)); err != nil {
+//     return err
+//   }
+//
+// Types generated with the --stream-encode option may similarly be encoded
+// directly to a byte stream using their Encode methods.
+//
+//   if err := v.Encode(protocol.BinaryStreamer.Writer(w)); err != nil {
+//     return err
+//   }
+var BinaryStreamer stream.Protocol
+
+func init() {
+	BinaryStreamer = binaryStreamer{}
+}
+
+type binaryStreamer struct {
+	limits   binary.Limits
+	interner *binary.Interner
+}
+
+func (b binaryStreamer) Reader(r io.Reader) stream.Reader {
+	reader := binary.NewLimitedStreamReader(r, b.limits)
+	reader.SetInterner(b.interner)
+	return reader
+}
+
+func (binaryStreamer) Writer(w io.Writer) stream.Writer {
+	return binary.NewStreamWriter(w)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package stream provides streaming implementations of encoding and decoding
+// Thrift values.
+//
+// Unlike the Protocol interface in the protocol package, which operates on
+// intermediate wire.Value trees, types in this package read Thrift values
+// directly from the underlying byte stream.
+package stream
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Protocol defines a specific way for a Thrift value to be streamed to or
+// from a byte stream.
+type Protocol interface {
+	// Reader returns a streaming implementation of a decoder for a
+	// Thrift value from the given io.Reader.
+	Reader(r io.Reader) Reader
+
+	// Writer returns a streaming implementation of an encoder for a
+	// Thrift value to the given io.Writer.
+	Writer(w io.Writer) Writer
+}
+
+// EnvelopeHeader defines the metadata needed to define the beginning of an
+// enveloped Thrift value.
+type EnvelopeHeader struct {
+	Name  string
+	Type  wire.EnvelopeType
+	SeqID int32
+}
+
+// FieldHeader defines the metadata needed to define the beginning of a
+// field in a Thrift value.
+type FieldHeader struct {
+	ID   int16
+	Type wire.Type
+}
+
+// MapHeader defines the metadata needed to define the beginning of a map in
+// a Thrift value.
+type MapHeader struct {
+	KeyType   wire.Type
+	ValueType wire.Type
+	Length    int
+}
+
+// SetHeader defines the metadata needed to define the beginning of a set in
+// a Thrift value.
+type SetHeader struct {
+	Type   wire.Type
+	Length int
+}
+
+// ListHeader defines the metadata needed to define the beginning of a list
+// in a Thrift value.
+type ListHeader struct {
+	Type   wire.Type
+	Length int
+}
+
+// Reader defines a decoder for a Thrift value, implemented in a streaming
+// fashion.
+//
+// Structs are read as follows.
+//
+//   if err := r.ReadStructBegin(); err != nil {
+//     return err
+//   }
+//
+//   fh, ok, err := r.ReadFieldBegin()
+//   for ok && err == nil {
+//     // Read or Skip a value of type fh.Type.
+//     if err := r.ReadFieldEnd(); err != nil {
+//       return err
+//     }
+//     fh, ok, err = r.ReadFieldBegin()
+//   }
+//   if err != nil {
+//     return err
+//   }
+//
+//   return r.ReadStructEnd()
+//
+// Maps, sets, and lists are read by reading their headers, followed by
+// exactly Length items, followed by the corresponding End method.
+type Reader interface {
+	ReadBool() (bool, error)
+	ReadInt8() (int8, error)
+	ReadInt16() (int16, error)
+	ReadInt32() (int32, error)
+	ReadInt64() (int64, error)
+	ReadString() (string, error)
+	ReadDouble() (float64, error)
+	ReadBinary() ([]byte, error)
+
+	// ReadStructBegin reads the beginning of a struct.
+	ReadStructBegin() error
+
+	// ReadStructEnd reads the end of a struct.
+	ReadStructEnd() error
+
+	// ReadFieldBegin reads the header of the next field in the struct.
+	//
+	// The returned boolean is false if there are no more fields left in the
+	// struct.
+	ReadFieldBegin() (FieldHeader, bool, error)
+
+	// ReadFieldEnd reads the end of a field.
+	ReadFieldEnd() error
+
+	// ReadListBegin reads the header of a list.
+	ReadListBegin() (ListHeader, error)
+
+	// ReadListEnd reads the end of a list.
+	ReadListEnd() error
+
+	// ReadSetBegin reads the header of a set.
+	ReadSetBegin() (SetHeader, error)
+
+	// ReadSetEnd reads the end of a set.
+	ReadSetEnd() error
+
+	// ReadMapBegin reads the header of a map.
+	ReadMapBegin() (MapHeader, error)
+
+	// ReadMapEnd reads the end of a map.
+	ReadMapEnd() error
+
+	// Skip skips over the next value of the given type.
+	Skip(t wire.Type) error
+}
+
+// Writer defines an encoder for a Thrift value, implemented in a streaming
+// fashion.
+//
+// Structs are written as follows.
+//
+//   if err := w.WriteStructBegin(); err != nil {
+//     return err
+//   }
+//
+//   // For each field:
+//   if err := w.WriteFieldBegin(FieldHeader{ID: 1, Type: wire.TI32}); err != nil {
+//     return err
+//   }
+//   if err := w.WriteInt32(42); err != nil {
+//     return err
+//   }
+//   if err := w.WriteFieldEnd(); err != nil {
+//     return err
+//   }
+//
+//   return w.WriteStructEnd()
+//
+// Maps, sets, and lists are written by writing their headers, followed by
+// exactly Length items, followed by the corresponding End method.
+type Writer interface {
+	WriteBool(b bool) error
+	WriteInt8(i int8) error
+	WriteInt16(i int16) error
+	WriteInt32(i int32) error
+	WriteInt64(i int64) error
+	WriteString(s string) error
+	WriteDouble(f float64) error
+	WriteBinary(b []byte) error
+
+	// WriteStructBegin writes the beginning of a struct.
+	WriteStructBegin() error
+
+	// WriteStructEnd writes the end of a struct.
+	WriteStructEnd() error
+
+	// WriteFieldBegin writes the header of a field in a struct.
+	WriteFieldBegin(fh FieldHeader) error
+
+	// WriteFieldEnd writes the end of a field.
+	WriteFieldEnd() error
+
+	// WriteListBegin writes the header of a list.
+	WriteListBegin(lh ListHeader) error
+
+	// WriteListEnd writes the end of a list.
+	WriteListEnd() error
+
+	// WriteSetBegin writes the header of a set.
+	WriteSetBegin(sh SetHeader) error
+
+	// WriteSetEnd writes the end of a set.
+	WriteSetEnd() error
+
+	// WriteMapBegin writes the header of a map.
+	WriteMapBegin(mh MapHeader) error
+
+	// WriteMapEnd writes the end of a map.
+	WriteMapEnd() error
+
+	// WriteEnvelopeBegin writes the header of an envelope. The enveloped
+	// value is written after it, followed by WriteEnvelopeEnd.
+	WriteEnvelopeBegin(eh EnvelopeHeader) error
+
+	// WriteEnvelopeEnd writes the end of an envelope.
+	WriteEnvelopeEnd() error
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stream
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// WriteValue writes the given Thrift-level intermediate representation of a
+// value to the Writer.
+//
+// This allows values which were already built as wire.Values to be written
+// alongside values encoded directly to the Writer.
+func WriteValue(w Writer, v wire.Value) error {
+	switch v.Type() {
+	case wire.TBool:
+		return w.WriteBool(v.GetBool())
+	case wire.TI8:
+		return w.WriteInt8(v.GetI8())
+	case wire.TDouble:
+		return w.WriteDouble(v.GetDouble())
+	case wire.TI16:
+		return w.WriteInt16(v.GetI16())
+	case wire.TI32:
+		return w.WriteInt32(v.GetI32())
+	case wire.TI64:
+		return w.WriteInt64(v.GetI64())
+	case wire.TBinary:
+		return w.WriteBinary(v.GetBinary())
+	case wire.TStruct:
+		return writeStruct(w, v.GetStruct())
+	case wire.TMap:
+		return writeMap(w, v.GetMap())
+	case wire.TSet:
+		return writeSet(w, v.GetSet())
+	case wire.TList:
+		return writeList(w, v.GetList())
+	default:
+		return fmt.Errorf("unknown ttype %v", v.Type())
+	}
+}
+
+func writeStruct(w Writer, s wire.Struct) error {
+	if err := w.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	for _, f := range s.Fields {
+		if err := w.WriteFieldBegin(FieldHeader{ID: f.ID, Type: f.Value.Type()}); err != nil {
+			return err
+		}
+		if err := WriteValue(w, f.Value); err != nil {
+			return err
+		}
+		if err := w.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return w.WriteStructEnd()
+}
+
+func writeMap(w Writer, m wire.MapItemList) error {
+	err := w.WriteMapBegin(MapHeader{
+		KeyType:   m.KeyType(),
+		ValueType: m.ValueType(),
+		Length:    m.Size(),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.ForEach(func(item wire.MapItem) error {
+		if err := WriteValue(w, item.Key); err != nil {
+			return err
+		}
+		return WriteValue(w, item.Value)
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.WriteMapEnd()
+}
+
+func writeSet(w Writer, s wire.ValueList) error {
+	if err := w.WriteSetBegin(SetHeader{Type: s.ValueType(), Length: s.Size()}); err != nil {
+		return err
+	}
+
+	err := s.ForEach(func(v wire.Value) error {
+		return WriteValue(w, v)
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.WriteSetEnd()
+}
+
+func writeList(w Writer, l wire.ValueList) error {
+	if err := w.WriteListBegin(ListHeader{Type: l.ValueType(), Length: l.Size()}); err != nil {
+		return err
+	}
+
+	err := l.ForEach(func(v wire.Value) error {
+		return WriteValue(w, v)
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.WriteListEnd()
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protocol
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryStreamerSkip(t *testing.T) {
+	tests := []struct {
+		desc  string
+		value wire.Value
+	}{
+		{"bool", vbool(true)},
+		{"i64", vi64(42)},
+		{"binary", vbinary("hello")},
+		{
+			"struct",
+			vstruct(
+				vfield(1, vi32(1)),
+				vfield(2, vstruct(vfield(3, vbinary("foo")))),
+			),
+		},
+		{"list", vlist(wire.TI16, vi16(1), vi16(2))},
+		{"set", vset(wire.TBinary, vbinary("a"))},
+		{
+			"map",
+			vmap(wire.TI32, wire.TList,
+				vitem(vi32(1), vlist(wire.TBool, vbool(false))),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buff bytes.Buffer
+			require.NoError(t, Binary.Encode(tt.value, &buff))
+			buff.WriteByte(0x7f)
+
+			sr := BinaryStreamer.Reader(&buff)
+			require.NoError(t, sr.Skip(tt.value.Type()))
+
+			// Only the trailing byte should remain.
+			got, err := sr.ReadInt8()
+			require.NoError(t, err)
+			assert.Equal(t, int8(0x7f), got)
+		})
+	}
+}
+
+func TestBinaryStreamerReadStruct(t *testing.T) {
+	var buff bytes.Buffer
+	require.NoError(t, Binary.Encode(vstruct(
+		vfield(1, vbinary("foo")),
+		vfield(2, vmap(wire.TI8, wire.TDouble, vitem(vi8(1), vdouble(1.5)))),
+	), &buff))
+
+	sr := BinaryStreamer.Reader(&buff)
+	require.NoError(t, sr.ReadStructBegin())
+
+	fh, ok, err := sr.ReadFieldBegin()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, stream.FieldHeader{ID: 1, Type: wire.TBinary}, fh)
+	s, err := sr.ReadString()
+	require.NoError(t, err)
+	assert.Equal(t, "foo", s)
+	require.NoError(t, sr.ReadFieldEnd())
+
+	fh, ok, err = sr.ReadFieldBegin()
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, stream.FieldHeader{ID: 2, Type: wire.TMap}, fh)
+	mh, err := sr.ReadMapBegin()
+	require.NoError(t, err)
+	assert.Equal(t, stream.MapHeader{KeyType: wire.TI8, ValueType: wire.TDouble, Length: 1}, mh)
+	k, err := sr.ReadInt8()
+	require.NoError(t, err)
+	assert.Equal(t, int8(1), k)
+	d, err := sr.ReadDouble()
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, d)
+	require.NoError(t, sr.ReadMapEnd())
+	require.NoError(t, sr.ReadFieldEnd())
+
+	_, ok, err = sr.ReadFieldBegin()
+	require.NoError(t, err)
+	assert.False(t, ok)
+	require.NoError(t, sr.ReadStructEnd())
+}
+
+func TestBinaryStreamerFailures(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    []byte
+		read    func(stream.Reader) error
+		wantEOF bool
+	}{
+		{
+			desc:    "truncated i32",
+			give:    []byte{0x00, 0x01},
+			read:    func(sr stream.Reader) error { _, err := sr.ReadInt32(); return err },
+			wantEOF: true,
+		},
+		{
+			desc:    "truncated binary",
+			give:    []byte{0x00, 0x00, 0x00, 0x05, 'a'},
+			read:    func(sr stream.Reader) error { _, err := sr.ReadBinary(); return err },
+			wantEOF: true,
+		},
+		{
+			desc: "negative binary length",
+			give: []byte{0xff, 0xff, 0xff, 0xff},
+			read: func(sr stream.Reader) error { _, err := sr.ReadBinary(); return err },
+		},
+		{
+			desc: "negative list length",
+			give: []byte{0x08, 0xff, 0xff, 0xff, 0xff},
+			read: func(sr stream.Reader) error { _, err := sr.ReadListBegin(); return err },
+		},
+		{
+			desc: "invalid bool",
+			give: []byte{0x02},
+			read: func(sr stream.Reader) error { _, err := sr.ReadBool(); return err },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.read(BinaryStreamer.Reader(bytes.NewReader(tt.give)))
+			require.Error(t, err)
+			if tt.wantEOF {
+				assert.Equal(t, io.ErrUnexpectedEOF, err)
+			} else {
+				assert.True(t, binary.IsDecodeError(err), "expected decode error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBinaryStreamerWrite(t *testing.T) {
+	tests := []struct {
+		desc  string
+		value wire.Value
+	}{
+		{"bool", vbool(true)},
+		{"i8", vi8(-1)},
+		{"i16", vi16(42)},
+		{"i32", vi32(-42)},
+		{"i64", vi64(1 << 40)},
+		{"double", vdouble(3.14)},
+		{"binary", vbinary("hello")},
+		{
+			"struct",
+			vstruct(
+				vfield(1, vi32(1)),
+				vfield(2, vstruct(vfield(3, vbinary("foo")))),
+			),
+		},
+		{"list", vlist(wire.TI16, vi16(1), vi16(2))},
+		{"set", vset(wire.TBinary, vbinary("a"))},
+		{
+			"map",
+			vmap(wire.TI32, wire.TList,
+				vitem(vi32(1), vlist(wire.TBool, vbool(false))),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var want bytes.Buffer
+			require.NoError(t, Binary.Encode(tt.value, &want))
+
+			var got bytes.Buffer
+			require.NoError(t, stream.WriteValue(BinaryStreamer.Writer(&got), tt.value))
+			assert.Equal(t, want.Bytes(), got.Bytes())
+
+			got.Reset()
+			require.NoError(t, stream.WriteValue(Binary.(stream.Protocol).Writer(&got), tt.value))
+			assert.Equal(t, want.Bytes(), got.Bytes())
+		})
+	}
+}
+
+func TestBinaryStreamerWriteEnvelope(t *testing.T) {
+	envelope := wire.Envelope{
+		Name:  "foo",
+		Type:  wire.Reply,
+		SeqID: 42,
+		Value: vstruct(vfield(1, vbinary("bar"))),
+	}
+
+	tests := []struct {
+		desc     string
+		protocol Protocol
+	}{
+		{"strict", Binary},
+		{"non-strict", NonStrictBinary},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var want bytes.Buffer
+			require.NoError(t, tt.protocol.EncodeEnveloped(envelope, &want))
+
+			var got bytes.Buffer
+			sw := tt.protocol.(stream.Protocol).Writer(&got)
+			require.NoError(t, sw.WriteEnvelopeBegin(stream.EnvelopeHeader{
+				Name:  envelope.Name,
+				Type:  envelope.Type,
+				SeqID: envelope.SeqID,
+			}))
+			require.NoError(t, stream.WriteValue(sw, envelope.Value))
+			require.NoError(t, sw.WriteEnvelopeEnd())
+			assert.Equal(t, want.Bytes(), got.Bytes())
+
+			decoded, err := tt.protocol.DecodeEnveloped(bytes.NewReader(got.Bytes()))
+			require.NoError(t, err)
+			assert.Equal(t, envelope.Name, decoded.Name)
+			assert.Equal(t, envelope.SeqID, decoded.SeqID)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package theader implements the THeader message format.
+//
+// THeader wraps messages encoded with another Thrift protocol and attaches
+// metadata to them: a sequence ID, the ID of the protocol used for the
+// payload, transforms applied to the payload, and string key-value headers
+// used to carry information like tracing and authentication with each
+// request.
+//
+// 	+--------+--------+--------+--------+
+// 	|  magic (0x0FFF) |      flags      |
+// 	+--------+--------+--------+--------+
+// 	|            sequence ID            |
+// 	+--------+--------+--------+--------+
+// 	| header size / 4 |   header ...
+// 	+--------+--------+--------+--------+
+// 	|              payload ...
+// 	+--------+--------+--------+--------+
+//
+// The header holds the protocol ID, the list of transforms, and the
+// key-value headers, padded with zeroes to a multiple of four bytes.
+//
+// On the wire, THeader messages are preceded by their length as a 4-byte
+// big-endian integer. Encode and Decode do not handle this prefix; it is
+// added and removed by framed transports.
+//
+// See "go.uber.org/thriftrw/rpc".NewTHeaderClient and NewTHeaderServer for
+// sending and receiving requests in this format.
+package theader
@@ -0,0 +1,353 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package theader
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+)
+
+var bigEndian = binary.BigEndian
+
+// Magic identifies THeader messages. Every message starts with it.
+const Magic = 0x0FFF
+
+// ProtocolID identifies the protocol used to encode the payload of a
+// message.
+type ProtocolID int32
+
+// Protocols which may be used for the payload of a message.
+const (
+	BinaryProtocol  ProtocolID = 0
+	JSONProtocol    ProtocolID = 1
+	CompactProtocol ProtocolID = 2
+)
+
+// TransformID identifies a transform applied to the payload of a message.
+type TransformID int32
+
+// ZlibTransform compresses the payload with zlib. It is the only transform
+// supported by this package.
+const ZlibTransform TransformID = 1
+
+// Types of the info blocks in the header.
+const (
+	infoPadding            = 0
+	infoKeyValue           = 1
+	infoPersistentKeyValue = 2
+)
+
+// DefaultMaxPayloadSize is the maximum size in bytes of a payload after
+// its transforms are reversed, unless a different maximum is given to
+// DecodeLimit. This matches the default maximum frame size of Apache Thrift
+// and transport.DefaultMaxMessageSize.
+const DefaultMaxPayloadSize = 16384000
+
+// prefixSize is the size of the fixed-size portion of a message which
+// precedes the header.
+const prefixSize = 10
+
+// Header is the metadata attached to a THeader message.
+type Header struct {
+	// Flags are passed through as-is.
+	Flags uint16
+
+	// SeqID is the sequence ID of the message. Responses use the sequence
+	// ID of the request they are for.
+	SeqID int32
+
+	// ProtocolID is the protocol used to encode the payload.
+	ProtocolID ProtocolID
+
+	// Transforms applied to the payload, in the order in which they were
+	// applied.
+	Transforms []TransformID
+
+	// Headers are key-value pairs sent with this message only.
+	Headers map[string]string
+
+	// PersistentHeaders are key-value pairs which apply to all following
+	// messages on the same connection.
+	PersistentHeaders map[string]string
+}
+
+// IsTHeader reports whether the given data starts like a THeader message.
+// Use this to tell THeader messages apart from messages encoded with the
+// Binary and Compact protocols directly.
+func IsTHeader(data []byte) bool {
+	return len(data) >= 2 && bigEndian.Uint16(data) == Magic
+}
+
+// Encode writes a message with the given header and payload to the given
+// Writer. The payload must already be encoded with the protocol identified
+// by the header. Transforms listed in the header are applied to it.
+func Encode(h Header, payload []byte, w io.Writer) error {
+	payload, err := transform(h.Transforms, payload)
+	if err != nil {
+		return err
+	}
+
+	var header []byte
+	header = appendVarint(header, uint32(h.ProtocolID))
+	header = appendVarint(header, uint32(len(h.Transforms)))
+	for _, t := range h.Transforms {
+		header = appendVarint(header, uint32(t))
+	}
+	header = appendInfo(header, infoKeyValue, h.Headers)
+	header = appendInfo(header, infoPersistentKeyValue, h.PersistentHeaders)
+	for len(header)%4 != 0 {
+		header = append(header, infoPadding)
+	}
+	if len(header)/4 > math.MaxUint16 {
+		return fmt.Errorf("THeader header is too large: %d bytes", len(header))
+	}
+
+	var prefix [prefixSize]byte
+	bigEndian.PutUint16(prefix[0:], Magic)
+	bigEndian.PutUint16(prefix[2:], h.Flags)
+	bigEndian.PutUint32(prefix[4:], uint32(h.SeqID))
+	bigEndian.PutUint16(prefix[8:], uint16(len(header)/4))
+
+	for _, bs := range [][]byte{prefix[:], header, payload} {
+		if _, err := w.Write(bs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode reads a THeader message, returning its header and its payload
+// with all transforms reversed. Messages with payloads that decompress to
+// more than DefaultMaxPayloadSize bytes are rejected.
+func Decode(data []byte) (Header, []byte, error) {
+	return DecodeLimit(data, DefaultMaxPayloadSize)
+}
+
+// DecodeLimit is like Decode but rejects messages with payloads that
+// decompress to more than maxSize bytes instead. If maxSize is zero or
+// negative, DefaultMaxPayloadSize is used.
+func DecodeLimit(data []byte, maxSize int) (Header, []byte, error) {
+	var h Header
+	if len(data) < prefixSize {
+		return h, nil, decodeErrorf("THeader message is too short: got %d bytes", len(data))
+	}
+	if magic := bigEndian.Uint16(data[0:]); magic != Magic {
+		return h, nil, decodeErrorf("not a THeader message: unexpected magic %#04x", magic)
+	}
+	h.Flags = bigEndian.Uint16(data[2:])
+	h.SeqID = int32(bigEndian.Uint32(data[4:]))
+
+	end := prefixSize + int(bigEndian.Uint16(data[8:]))*4
+	if end > len(data) {
+		return h, nil, decodeErrorf(
+			"THeader header ends at offset %d but the message has only %d bytes", end, len(data))
+	}
+
+	r := headerReader{buf: data[prefixSize:end]}
+	id, err := r.readVarint()
+	if err != nil {
+		return h, nil, err
+	}
+	h.ProtocolID = ProtocolID(id)
+
+	n, err := r.readCount()
+	if err != nil {
+		return h, nil, err
+	}
+	for i := 0; i < n; i++ {
+		id, err := r.readVarint()
+		if err != nil {
+			return h, nil, err
+		}
+		if t := TransformID(id); t != ZlibTransform {
+			return h, nil, decodeErrorf("unsupported THeader transform %d", t)
+		}
+		h.Transforms = append(h.Transforms, TransformID(id))
+	}
+
+	// Info blocks run until the padding or the end of the header. Unknown
+	// info blocks can't be skipped because their size is unknown so they
+	// end the header too.
+infoLoop:
+	for len(r.buf) > 0 {
+		info, err := r.readVarint()
+		if err != nil {
+			return h, nil, err
+		}
+
+		switch info {
+		case infoKeyValue:
+			h.Headers, err = r.readKeyValues(h.Headers)
+		case infoPersistentKeyValue:
+			h.PersistentHeaders, err = r.readKeyValues(h.PersistentHeaders)
+		default:
+			break infoLoop
+		}
+		if err != nil {
+			return h, nil, err
+		}
+	}
+
+	if maxSize <= 0 {
+		maxSize = DefaultMaxPayloadSize
+	}
+	payload, err := untransform(h.Transforms, data[end:], maxSize)
+	return h, payload, err
+}
+
+func appendVarint(bs []byte, n uint32) []byte {
+	var buf [binary.MaxVarintLen32]byte
+	return append(bs, buf[:binary.PutUvarint(buf[:], uint64(n))]...)
+}
+
+func appendString(bs []byte, s string) []byte {
+	return append(appendVarint(bs, uint32(len(s))), s...)
+}
+
+// appendInfo appends an info block with the given key-value pairs, if any.
+// Keys are written in sorted order so that the output is deterministic.
+func appendInfo(bs []byte, info uint32, kvs map[string]string) []byte {
+	if len(kvs) == 0 {
+		return bs
+	}
+
+	keys := make([]string, 0, len(kvs))
+	for k := range kvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	bs = appendVarint(bs, info)
+	bs = appendVarint(bs, uint32(len(keys)))
+	for _, k := range keys {
+		bs = appendString(bs, k)
+		bs = appendString(bs, kvs[k])
+	}
+	return bs
+}
+
+// headerReader reads the variable-sized portion of the header.
+type headerReader struct {
+	buf []byte
+}
+
+func (r *headerReader) readVarint() (uint32, error) {
+	n, size := binary.Uvarint(r.buf)
+	if size <= 0 || n > math.MaxUint32 {
+		return 0, decodeErrorf("invalid varint in THeader header")
+	}
+	r.buf = r.buf[size:]
+	return uint32(n), nil
+}
+
+// readCount reads the number of items that follow. Every item takes at
+// least one byte so counts larger than the rest of the header are invalid.
+func (r *headerReader) readCount() (int, error) {
+	n, err := r.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	if int64(n) > int64(len(r.buf)) {
+		return 0, decodeErrorf("THeader header is too short for %d items", n)
+	}
+	return int(n), nil
+}
+
+func (r *headerReader) readString() (string, error) {
+	n, err := r.readCount()
+	if err != nil {
+		return "", err
+	}
+	s := string(r.buf[:n])
+	r.buf = r.buf[n:]
+	return s, nil
+}
+
+func (r *headerReader) readKeyValues(kvs map[string]string) (map[string]string, error) {
+	n, err := r.readCount()
+	if err != nil {
+		return kvs, err
+	}
+
+	if kvs == nil && n > 0 {
+		kvs = make(map[string]string, n)
+	}
+	for i := 0; i < n; i++ {
+		k, err := r.readString()
+		if err != nil {
+			return kvs, err
+		}
+		v, err := r.readString()
+		if err != nil {
+			return kvs, err
+		}
+		kvs[k] = v
+	}
+	return kvs, nil
+}
+
+func transform(ts []TransformID, payload []byte) ([]byte, error) {
+	for _, t := range ts {
+		if t != ZlibTransform {
+			return nil, fmt.Errorf("unsupported THeader transform %d", t)
+		}
+
+		var buff bytes.Buffer
+		w := zlib.NewWriter(&buff)
+		if _, err := w.Write(payload); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		payload = buff.Bytes()
+	}
+	return payload, nil
+}
+
+// untransform reverses the given transforms. Payloads may not grow past
+// maxSize bytes.
+func untransform(ts []TransformID, payload []byte, maxSize int) ([]byte, error) {
+	for i := len(ts) - 1; i >= 0; i-- {
+		// Decode only accepts known transforms so this must be zlib.
+		r, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, decodeErrorf("invalid zlib payload: %v", err)
+		}
+		// Read one byte past the maximum to tell payloads which are too
+		// large apart from those that are exactly the maximum size.
+		payload, err = ioutil.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+		if err != nil {
+			return nil, decodeErrorf("invalid zlib payload: %v", err)
+		}
+		if len(payload) > maxSize {
+			return nil, decodeErrorf(
+				"THeader payload exceeds the maximum size of %d bytes", maxSize)
+		}
+	}
+	return payload, nil
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package theader
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		desc    string
+		header  Header
+		payload []byte
+		want    []byte // optional
+	}{
+		{
+			desc: "headers",
+			header: Header{
+				Flags:      1,
+				SeqID:      42,
+				ProtocolID: CompactProtocol,
+				Headers:    map[string]string{"k": "v"},
+			},
+			payload: []byte("hi"),
+			want: []byte{
+				0x0f, 0xff, // magic
+				0x00, 0x01, // flags
+				0x00, 0x00, 0x00, 0x2a, // seq ID
+				0x00, 0x02, // header size / 4
+				0x02,                 // protocol ID
+				0x00,                 // number of transforms
+				0x01,                 // key-value info
+				0x01,                 // number of headers
+				0x01, 'k', 0x01, 'v', // k = v
+				'h', 'i', // payload
+			},
+		},
+		{
+			desc:    "padding",
+			header:  Header{SeqID: -1},
+			payload: []byte{1, 2, 3},
+			want: []byte{
+				0x0f, 0xff,
+				0x00, 0x00,
+				0xff, 0xff, 0xff, 0xff,
+				0x00, 0x01,
+				0x00, 0x00, 0x00, 0x00,
+				1, 2, 3,
+			},
+		},
+		{
+			desc: "persistent headers",
+			header: Header{
+				ProtocolID:        BinaryProtocol,
+				Headers:           map[string]string{"trace": "abc", "auth": "token"},
+				PersistentHeaders: map[string]string{"client": "foo"},
+			},
+			payload: []byte("payload"),
+		},
+		{
+			desc: "zlib",
+			header: Header{
+				SeqID:      1,
+				ProtocolID: BinaryProtocol,
+				Transforms: []TransformID{ZlibTransform},
+			},
+			payload: bytes.Repeat([]byte("hello"), 100),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buff bytes.Buffer
+			require.NoError(t, Encode(tt.header, tt.payload, &buff))
+			if tt.want != nil {
+				assert.Equal(t, tt.want, buff.Bytes())
+			}
+			assert.True(t, IsTHeader(buff.Bytes()))
+
+			h, payload, err := Decode(buff.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, tt.header, h)
+			assert.Equal(t, tt.payload, payload)
+		})
+	}
+}
+
+func TestEncodeUnsupportedTransform(t *testing.T) {
+	err := Encode(Header{Transforms: []TransformID{3}}, nil, new(bytes.Buffer))
+	assert.EqualError(t, err, "unsupported THeader transform 3")
+}
+
+func TestDecodeIgnoresUnknownInfo(t *testing.T) {
+	h, payload, err := Decode([]byte{
+		0x0f, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01,
+		0x00, 0x00, 0x09, 0x01,
+		'x',
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Header{SeqID: 1}, h)
+	assert.Equal(t, []byte("x"), payload)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    []byte
+		wantErr string
+	}{
+		{
+			desc:    "too short",
+			give:    []byte{0x0f, 0xff, 0x00},
+			wantErr: "THeader message is too short: got 3 bytes",
+		},
+		{
+			desc:    "not THeader",
+			give:    []byte{0x80, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x03, 'f', 'o', 'o'},
+			wantErr: "not a THeader message: unexpected magic 0x8001",
+		},
+		{
+			desc:    "header out of bounds",
+			give:    []byte{0x0f, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00},
+			wantErr: "THeader header ends at offset 18 but the message has only 14 bytes",
+		},
+		{
+			desc:    "truncated varint",
+			give:    []byte{0x0f, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01, 0x80, 0x80, 0x80, 0x80},
+			wantErr: "invalid varint in THeader header",
+		},
+		{
+			desc:    "unsupported transform",
+			give:    []byte{0x0f, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x03, 0x00},
+			wantErr: "unsupported THeader transform 3",
+		},
+		{
+			desc:    "too many headers",
+			give:    []byte{0x0f, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x00, 0x01, 0x7f},
+			wantErr: "THeader header is too short for 127 items",
+		},
+		{
+			desc:    "truncated header value",
+			give:    []byte{0x0f, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x00, 0x01, 0x01, 0x01, 'k', 0x05, 'v'},
+			wantErr: "THeader header is too short for 5 items",
+		},
+		{
+			desc:    "invalid zlib payload",
+			give:    []byte{0x0f, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x01, 0x00, 'x'},
+			wantErr: "invalid zlib payload: unexpected EOF",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, _, err := Decode(tt.give)
+			assert.EqualError(t, err, tt.wantErr)
+			assert.True(t, IsDecodeError(err), "must be a decode error")
+		})
+	}
+}
+
+func TestDecodePayloadTooLarge(t *testing.T) {
+	zlibHeader := Header{Transforms: []TransformID{ZlibTransform, ZlibTransform}}
+
+	// A megabyte of zeroes compresses down to about a kilobyte.
+	var buff bytes.Buffer
+	require.NoError(t, Encode(zlibHeader, make([]byte, 1<<20), &buff))
+	require.True(t, buff.Len() < 1<<10, "payload must compress well: got %d bytes", buff.Len())
+
+	t.Run("default", func(t *testing.T) {
+		var buff bytes.Buffer
+		require.NoError(t, Encode(zlibHeader, make([]byte, DefaultMaxPayloadSize+1), &buff))
+
+		_, _, err := Decode(buff.Bytes())
+		assert.EqualError(t, err, "THeader payload exceeds the maximum size of 16384000 bytes")
+		assert.True(t, IsDecodeError(err), "must be a decode error")
+	})
+
+	t.Run("exceeded", func(t *testing.T) {
+		_, _, err := DecodeLimit(buff.Bytes(), 1<<20-1)
+		assert.EqualError(t, err, "THeader payload exceeds the maximum size of 1048575 bytes")
+		assert.True(t, IsDecodeError(err), "must be a decode error")
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		_, payload, err := DecodeLimit(buff.Bytes(), 1<<20)
+		require.NoError(t, err)
+		assert.Len(t, payload, 1<<20)
+	})
+}
+
+func TestIsTHeader(t *testing.T) {
+	assert.True(t, IsTHeader([]byte{0x0f, 0xff}))
+	assert.False(t, IsTHeader([]byte{0x0f}))
+	assert.False(t, IsTHeader([]byte{0x80, 0x01, 0x00, 0x01}))
+	assert.False(t, IsTHeader([]byte{0x82, 0x21}))
+}
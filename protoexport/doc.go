@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package protoexport converts compiled Thrift modules into Protocol Buffers
+// descriptors.
+//
+// Convert produces a FileDescriptorSet with one proto3 file for the given
+// module and each module it includes. The result may be consumed by protoc
+// or other Protocol Buffers tooling to keep schemas of services migrating
+// between Thrift and gRPC in sync.
+//
+//   module, err := compile.Compile("service.thrift")
+//   if err != nil {
+//     return err
+//   }
+//   set, err := protoexport.Convert(module)
+//
+// The mapping is best-effort since the two languages don't have the same
+// features.
+//
+// Structs and exceptions become messages. Fields keep their Thrift IDs as
+// field numbers, and optional fields of scalar and enum types are declared
+// optional. Unions become messages whose non-repeated fields are part of a
+// single oneof. Default values are dropped.
+//
+// Lists and sets become repeated fields. Maps become map fields if their
+// keys are integers, strings, or bools, and repeated key-value messages
+// otherwise. Containers nested inside other containers are wrapped in
+// messages because Protocol Buffers doesn't support them directly.
+//
+// Enum values are prefixed with the name of the enum because they share a
+// namespace with the enum. An UNSPECIFIED value is added to enums that don't
+// have a value for 0.
+//
+// Each function of a service becomes a method whose request and response
+// are messages named ${Service}_${function}_Args and ${Service}_${function}_Result.
+// The Result message holds the return value in field 1, if any. Exceptions
+// are omitted from the response since gRPC reports errors with status codes.
+// Functions inherited from parent services are included, and functions
+// returning a stream become server-streaming methods.
+//
+// Constants, typedefs, and annotations have no equivalent and are not
+// exported. Typedefs are replaced by their targets.
+package protoexport
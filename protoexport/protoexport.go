@@ -0,0 +1,510 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protoexport
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Convert builds Protocol Buffers descriptors for the given module and all
+// modules it includes, directly or indirectly.
+//
+// The .proto files are named after the Thrift files, relative to their
+// deepest common ancestor directory. Files appear in the set after the files
+// they depend on.
+func Convert(m *compile.Module) (*descriptorpb.FileDescriptorSet, error) {
+	var modules []*compile.Module
+	if err := m.Walk(func(m *compile.Module) error {
+		modules = append(modules, m)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	c := converter{
+		root:  commonDir(modules),
+		files: make(map[string]*descriptorpb.FileDescriptorProto),
+	}
+
+	var set descriptorpb.FileDescriptorSet
+	visited := make(map[string]struct{})
+	var visit func(*compile.Module) error
+	visit = func(m *compile.Module) error {
+		if _, ok := visited[m.ThriftPath]; ok {
+			return nil
+		}
+		visited[m.ThriftPath] = struct{}{}
+
+		for _, name := range sortedKeys(m.Includes) {
+			if err := visit(m.Includes[name].Module); err != nil {
+				return err
+			}
+		}
+
+		f, err := c.file(m)
+		if err != nil {
+			return fmt.Errorf("could not convert %q: %v", m.ThriftPath, err)
+		}
+		set.File = append(set.File, f)
+		return nil
+	}
+
+	if err := visit(m); err != nil {
+		return nil, err
+	}
+	return &set, nil
+}
+
+type converter struct {
+	// Directory relative to which the .proto files are named.
+	root string
+
+	// Converted files, keyed by the path of the Thrift file.
+	files map[string]*descriptorpb.FileDescriptorProto
+}
+
+// scope is a message into which the converter may add nested messages.
+type scope struct {
+	msg      *descriptorpb.DescriptorProto
+	fullName string // fully qualified name, starting with "."
+}
+
+func (c *converter) fileName(thriftPath string) string {
+	rel, err := filepath.Rel(c.root, thriftPath)
+	if err != nil {
+		// The root is an ancestor of all files so this can't fail.
+		panic(fmt.Sprintf("%q is not inside %q: %v", thriftPath, c.root, err))
+	}
+	return filepath.ToSlash(strings.TrimSuffix(rel, ".thrift")) + ".proto"
+}
+
+// packageName returns the proto package for the given Thrift file. The
+// package is derived from the path to the file so that files with the same
+// name in different directories don't conflict.
+func (c *converter) packageName(thriftPath string) string {
+	parts := strings.Split(strings.TrimSuffix(c.fileName(thriftPath), ".proto"), "/")
+	for i, part := range parts {
+		parts[i] = identifier(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// qualify returns the fully qualified name of a message or enum defined in
+// the given Thrift file.
+func (c *converter) qualify(thriftPath, name string) string {
+	return "." + c.packageName(thriftPath) + "." + name
+}
+
+func (c *converter) file(m *compile.Module) (*descriptorpb.FileDescriptorProto, error) {
+	f := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(c.fileName(m.ThriftPath)),
+		Package: proto.String(c.packageName(m.ThriftPath)),
+		Syntax:  proto.String("proto3"),
+	}
+
+	deps := make(map[string]struct{})
+	for _, inc := range m.Includes {
+		deps[c.fileName(inc.Module.ThriftPath)] = struct{}{}
+	}
+	f.Dependency = sortedKeys(deps)
+
+	for _, name := range sortedKeys(m.Types) {
+		switch t := m.Types[name].(type) {
+		case *compile.EnumSpec:
+			f.EnumType = append(f.EnumType, enum(t))
+		case *compile.StructSpec:
+			msg, err := c.message(c.qualify(m.ThriftPath, t.Name), t.Name, t.Fields, t.Type == ast.UnionType)
+			if err != nil {
+				return nil, err
+			}
+			f.MessageType = append(f.MessageType, msg)
+		}
+	}
+
+	for _, name := range sortedKeys(m.Services) {
+		svc, msgs, err := c.service(m.Services[name])
+		if err != nil {
+			return nil, err
+		}
+		f.Service = append(f.Service, svc)
+		f.MessageType = append(f.MessageType, msgs...)
+	}
+
+	return f, nil
+}
+
+func enum(spec *compile.EnumSpec) *descriptorpb.EnumDescriptorProto {
+	prefix := upperSnake(spec.Name) + "_"
+	e := &descriptorpb.EnumDescriptorProto{Name: proto.String(spec.Name)}
+
+	// proto3 requires the first value of an enum to be zero.
+	var zero *descriptorpb.EnumValueDescriptorProto
+	values := make(map[int32]struct{})
+	var aliased bool
+	for _, item := range spec.Items {
+		if _, ok := values[item.Value]; ok {
+			aliased = true
+		}
+		values[item.Value] = struct{}{}
+
+		v := &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(prefix + item.Name),
+			Number: proto.Int32(item.Value),
+		}
+		if item.Value == 0 && zero == nil {
+			zero = v
+			continue
+		}
+		e.Value = append(e.Value, v)
+	}
+
+	if zero == nil {
+		zero = &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(prefix + "UNSPECIFIED"),
+			Number: proto.Int32(0),
+		}
+	}
+	e.Value = append([]*descriptorpb.EnumValueDescriptorProto{zero}, e.Value...)
+
+	if aliased {
+		e.Options = &descriptorpb.EnumOptions{AllowAlias: proto.Bool(true)}
+	}
+	return e
+}
+
+// message builds a message with the given fields. If union is true, the
+// fields are placed inside a oneof.
+func (c *converter) message(fullName, name string, fields compile.FieldGroup, union bool) (*descriptorpb.DescriptorProto, error) {
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(name)}
+	sc := scope{msg: msg, fullName: fullName}
+
+	if union {
+		msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{
+			Name: proto.String("value"),
+		})
+	}
+
+	for _, f := range fields {
+		// Field numbers 19000 through 19999 are reserved by Protocol
+		// Buffers. The compiler already rejects IDs outside [1, 32767].
+		if f.ID >= 19000 && f.ID <= 19999 {
+			return nil, fmt.Errorf(
+				"field %q of %q has ID %d which is reserved by Protocol Buffers", f.Name, name, f.ID)
+		}
+
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(f.Name),
+			Number: proto.Int32(int32(f.ID)),
+		}
+		if err := c.setType(sc, fd, f.Type); err != nil {
+			return nil, fmt.Errorf("field %q of %q: %v", f.Name, name, err)
+		}
+		msg.Field = append(msg.Field, fd)
+
+		if fd.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			// Repeated fields can't be part of a oneof, and always allow
+			// checking for presence.
+			continue
+		}
+
+		switch {
+		case union:
+			fd.OneofIndex = proto.Int32(0)
+		case !f.Required && fd.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+			// proto3 optional fields are placed in synthetic oneofs which
+			// must follow all other oneofs. Unions never get here so there
+			// are no other oneofs.
+			fd.Proto3Optional = proto.Bool(true)
+			fd.OneofIndex = proto.Int32(int32(len(msg.OneofDecl)))
+			msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{
+				Name: proto.String("_" + f.Name),
+			})
+		}
+	}
+
+	return msg, nil
+}
+
+// setType sets the type of fd to the given Thrift type, adding nested
+// messages to sc if necessary.
+func (c *converter) setType(sc scope, fd *descriptorpb.FieldDescriptorProto, spec compile.TypeSpec) error {
+	fd.Label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.ListSpec:
+		fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		return c.setElementType(sc, fd, camelCase(fd.GetName())+"Item", s.ValueSpec)
+	case *compile.SetSpec:
+		fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		return c.setElementType(sc, fd, camelCase(fd.GetName())+"Item", s.ValueSpec)
+	case *compile.MapSpec:
+		name := camelCase(fd.GetName())
+		entry := &descriptorpb.DescriptorProto{Name: proto.String(name + "Entry")}
+		key := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String("key"),
+			Number: proto.Int32(1),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+		value := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String("value"),
+			Number: proto.Int32(2),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+
+		// Map entries can't have nested messages so wrappers for nested
+		// containers are added next to the entry.
+		if err := c.setElementType(sc, key, name+"Key", s.KeySpec); err != nil {
+			return err
+		}
+		if err := c.setElementType(sc, value, name+"Value", s.ValueSpec); err != nil {
+			return err
+		}
+		entry.Field = []*descriptorpb.FieldDescriptorProto{key, value}
+		if isMapKey(key.GetType()) {
+			entry.Options = &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)}
+		}
+
+		sc.msg.NestedType = append(sc.msg.NestedType, entry)
+		fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		fd.TypeName = proto.String(sc.fullName + "." + entry.GetName())
+		return nil
+	default:
+		return c.setScalarType(fd, s)
+	}
+}
+
+// setElementType sets the type of fd to the type of an element of a
+// container. Elements which are containers themselves are wrapped in a
+// message with the given name.
+func (c *converter) setElementType(sc scope, fd *descriptorpb.FieldDescriptorProto, wrapper string, spec compile.TypeSpec) error {
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.ListSpec, *compile.SetSpec, *compile.MapSpec:
+		// Handled below.
+	default:
+		return c.setScalarType(fd, spec)
+	}
+
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(wrapper)}
+	wrapperScope := scope{msg: msg, fullName: sc.fullName + "." + wrapper}
+	value := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String("value"),
+		Number: proto.Int32(1),
+	}
+	if err := c.setType(wrapperScope, value, spec); err != nil {
+		return err
+	}
+	msg.Field = []*descriptorpb.FieldDescriptorProto{value}
+	sc.msg.NestedType = append(sc.msg.NestedType, msg)
+
+	fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	fd.TypeName = proto.String(wrapperScope.fullName)
+	return nil
+}
+
+// setScalarType sets the type of fd to the given non-container Thrift type.
+func (c *converter) setScalarType(fd *descriptorpb.FieldDescriptorProto, spec compile.TypeSpec) error {
+	var t descriptorpb.FieldDescriptorProto_Type
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_BOOL
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_INT32
+	case *compile.I64Spec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_INT64
+	case *compile.DoubleSpec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_DOUBLE
+	case *compile.StringSpec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_STRING
+	case *compile.BinarySpec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_BYTES
+	case *compile.EnumSpec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_ENUM
+		fd.TypeName = proto.String(c.qualify(s.ThriftFile(), s.Name))
+	case *compile.StructSpec:
+		t = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+		fd.TypeName = proto.String(c.qualify(s.ThriftFile(), s.Name))
+	default:
+		return fmt.Errorf("unsupported type %v", spec.ThriftName())
+	}
+	fd.Type = t.Enum()
+	return nil
+}
+
+// service builds a service descriptor for the given service along with the
+// request and response messages for its functions.
+func (c *converter) service(spec *compile.ServiceSpec) (*descriptorpb.ServiceDescriptorProto, []*descriptorpb.DescriptorProto, error) {
+	svc := &descriptorpb.ServiceDescriptorProto{Name: proto.String(spec.Name)}
+
+	var msgs []*descriptorpb.DescriptorProto
+	for _, name := range sortedKeys(spec.Functions) {
+		fn := spec.Functions[name]
+		prefix := spec.Name + "_" + fn.Name
+
+		args, err := c.message(
+			c.qualify(spec.ThriftFile(), prefix+"_Args"), prefix+"_Args",
+			compile.FieldGroup(fn.ArgsSpec), false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resultName := c.qualify(spec.ThriftFile(), prefix+"_Result")
+		result := &descriptorpb.DescriptorProto{Name: proto.String(prefix + "_Result")}
+		if fn.ResultSpec != nil && fn.ResultSpec.ReturnType != nil {
+			success := &descriptorpb.FieldDescriptorProto{
+				Name:   proto.String("success"),
+				Number: proto.Int32(1),
+			}
+			sc := scope{msg: result, fullName: resultName}
+			if err := c.setType(sc, success, fn.ResultSpec.ReturnType); err != nil {
+				return nil, nil, fmt.Errorf("result of %q: %v", prefix, err)
+			}
+			result.Field = append(result.Field, success)
+		}
+		msgs = append(msgs, args, result)
+	}
+
+	// Inherited functions refer to messages declared alongside their
+	// services. Functions of the service take precedence over those of its
+	// parents.
+	seen := make(map[string]struct{})
+	for s := spec; s != nil; s = s.Parent {
+		for _, name := range sortedKeys(s.Functions) {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+
+			fn := s.Functions[name]
+			prefix := s.Name + "_" + fn.Name
+			method := &descriptorpb.MethodDescriptorProto{
+				Name:       proto.String(fn.Name),
+				InputType:  proto.String(c.qualify(s.ThriftFile(), prefix+"_Args")),
+				OutputType: proto.String(c.qualify(s.ThriftFile(), prefix+"_Result")),
+			}
+			if fn.Streaming {
+				method.ServerStreaming = proto.Bool(true)
+			}
+			svc.Method = append(svc.Method, method)
+		}
+	}
+
+	return svc, msgs, nil
+}
+
+// isMapKey returns true if fields of the given type may be used as map keys.
+func isMapKey(t descriptorpb.FieldDescriptorProto_Type) bool {
+	switch t {
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+		descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return true
+	default:
+		return false
+	}
+}
+
+// commonDir returns the deepest directory containing all the given modules.
+func commonDir(modules []*compile.Module) string {
+	root := filepath.Dir(modules[0].ThriftPath)
+	for _, m := range modules[1:] {
+		dir := filepath.Dir(m.ThriftPath)
+		for {
+			if rel, err := filepath.Rel(root, dir); err == nil && rel != ".." &&
+				!strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				break
+			}
+			root = filepath.Dir(root)
+		}
+	}
+	return root
+}
+
+// identifier replaces characters that aren't allowed in Protocol Buffers
+// identifiers with underscores.
+func identifier(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r == '_' || r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return '_'
+	}, s)
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "_" + s
+	}
+	return s
+}
+
+// camelCase converts a field name like "user_id" into "UserId", the form
+// protoc uses for the names of map entry messages.
+func camelCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// upperSnake converts a name like "UserRole" into "USER_ROLE".
+func upperSnake(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && r != '_' &&
+			(unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) &&
+			runes[i-1] != '_' {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+// sortedKeys returns the keys of the given map[string]* in sorted order.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	sorted := make([]string, 0, len(keys))
+	for _, k := range keys {
+		sorted = append(sorted, k.String())
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protoexport
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// convert converts the given files and validates the result with the
+// Protocol Buffers runtime.
+func convert(t *testing.T, files map[string]string, root string) *protoregistry.Files {
+	set, err := Convert(idltest.Compile(t, files, root))
+	require.NoError(t, err)
+
+	registry, err := protodesc.NewFiles(set)
+	require.NoError(t, err, "descriptors must be valid")
+	return registry
+}
+
+func findMessage(t *testing.T, r *protoregistry.Files, name string) protoreflect.MessageDescriptor {
+	d, err := r.FindDescriptorByName(protoreflect.FullName(name))
+	require.NoError(t, err)
+	md, ok := d.(protoreflect.MessageDescriptor)
+	require.True(t, ok, "%v is not a message", name)
+	return md
+}
+
+var _testFiles = map[string]string{
+	"service.thrift": `
+		include "./common/types.thrift"
+
+		struct GetUserRequest {
+			1: required types.UserID id
+			2: optional bool includeDeleted
+			3: optional list<list<string>> groups
+			4: optional map<string, types.User> byName
+			5: optional map<types.User, i64> counts
+			6: optional types.Role role
+		}
+
+		union Lookup {
+			1: string name
+			2: i64 id
+			3: list<string> tags
+		}
+
+		exception NotFound { 1: optional string message }
+
+		service Base {
+			void ping()
+		}
+
+		service Users extends Base {
+			types.User getUser(1: GetUserRequest request) throws (1: NotFound notFound)
+			stream<types.User> listUsers()
+			oneway void forget(1: types.UserID id)
+		}
+	`,
+	"common/types.thrift": `
+		typedef string UserID
+
+		enum Role { Admin = 1, Member = 2 }
+
+		enum Status { UNKNOWN = 0, ACTIVE = 1 }
+
+		struct User {
+			1: required UserID id
+			2: optional Role role
+		}
+	`,
+}
+
+func TestConvertFiles(t *testing.T) {
+	set, err := Convert(idltest.Compile(t, _testFiles, "service.thrift"))
+	require.NoError(t, err)
+	require.Len(t, set.File, 2)
+
+	types, service := set.File[0], set.File[1]
+	assert.Equal(t, "common/types.proto", types.GetName(), "dependencies must come first")
+	assert.Equal(t, "common.types", types.GetPackage())
+	assert.Equal(t, "proto3", types.GetSyntax())
+
+	assert.Equal(t, "service.proto", service.GetName())
+	assert.Equal(t, "service", service.GetPackage())
+	assert.Equal(t, []string{"common/types.proto"}, service.GetDependency())
+}
+
+func TestConvertEnums(t *testing.T) {
+	r := convert(t, _testFiles, "service.thrift")
+
+	tests := []struct {
+		name string
+		want map[string]int32
+	}{
+		{
+			name: "common.types.Role",
+			want: map[string]int32{"ROLE_UNSPECIFIED": 0, "ROLE_Admin": 1, "ROLE_Member": 2},
+		},
+		{
+			name: "common.types.Status",
+			want: map[string]int32{"STATUS_UNKNOWN": 0, "STATUS_ACTIVE": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := r.FindDescriptorByName(protoreflect.FullName(tt.name))
+			require.NoError(t, err)
+			values := d.(protoreflect.EnumDescriptor).Values()
+
+			got := make(map[string]int32)
+			for i := 0; i < values.Len(); i++ {
+				got[string(values.Get(i).Name())] = int32(values.Get(i).Number())
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, protoreflect.EnumNumber(0), values.Get(0).Number(),
+				"first value must be zero")
+		})
+	}
+}
+
+func TestConvertMessages(t *testing.T) {
+	r := convert(t, _testFiles, "service.thrift")
+	req := findMessage(t, r, "service.GetUserRequest")
+	fields := req.Fields()
+
+	id := fields.ByName("id")
+	assert.Equal(t, protoreflect.StringKind, id.Kind(), "typedefs must be resolved")
+	assert.False(t, id.HasPresence())
+
+	includeDeleted := fields.ByName("includeDeleted")
+	assert.Equal(t, protoreflect.BoolKind, includeDeleted.Kind())
+	assert.True(t, includeDeleted.HasOptionalKeyword())
+
+	groups := fields.ByName("groups")
+	assert.True(t, groups.IsList())
+	assert.Equal(t, protoreflect.FullName("service.GetUserRequest.GroupsItem"), groups.Message().FullName())
+	assert.True(t, groups.Message().Fields().ByName("value").IsList(),
+		"nested lists must be wrapped")
+
+	byName := fields.ByName("byName")
+	assert.True(t, byName.IsMap())
+	assert.Equal(t, protoreflect.StringKind, byName.MapKey().Kind())
+	assert.Equal(t, protoreflect.FullName("common.types.User"), byName.MapValue().Message().FullName())
+
+	counts := fields.ByName("counts")
+	assert.False(t, counts.IsMap(), "maps with message keys must not be proto maps")
+	assert.True(t, counts.IsList())
+	assert.Equal(t, protoreflect.FullName("service.GetUserRequest.CountsEntry"), counts.Message().FullName())
+
+	role := fields.ByName("role")
+	assert.Equal(t, protoreflect.FullName("common.types.Role"), role.Enum().FullName())
+
+	lookup := findMessage(t, r, "service.Lookup")
+	require.Equal(t, 1, lookup.Oneofs().Len())
+	oneof := lookup.Oneofs().Get(0)
+	assert.Equal(t, 2, oneof.Fields().Len(), "repeated fields can't be part of the oneof")
+	assert.Nil(t, lookup.Fields().ByName("tags").ContainingOneof())
+
+	findMessage(t, r, "service.NotFound")
+}
+
+func TestConvertServices(t *testing.T) {
+	r := convert(t, _testFiles, "service.thrift")
+
+	d, err := r.FindDescriptorByName("service.Users")
+	require.NoError(t, err)
+	methods := d.(protoreflect.ServiceDescriptor).Methods()
+
+	tests := []struct {
+		method    string
+		input     protoreflect.FullName
+		output    protoreflect.FullName
+		streaming bool
+	}{
+		{
+			method: "getUser",
+			input:  "service.Users_getUser_Args",
+			output: "service.Users_getUser_Result",
+		},
+		{
+			method:    "listUsers",
+			input:     "service.Users_listUsers_Args",
+			output:    "service.Users_listUsers_Result",
+			streaming: true,
+		},
+		{
+			method: "forget",
+			input:  "service.Users_forget_Args",
+			output: "service.Users_forget_Result",
+		},
+		{
+			method: "ping",
+			input:  "service.Base_ping_Args",
+			output: "service.Base_ping_Result",
+		},
+	}
+
+	require.Equal(t, len(tests), methods.Len())
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			m := methods.ByName(protoreflect.Name(tt.method))
+			require.NotNil(t, m, "method %v not found", tt.method)
+			assert.Equal(t, tt.input, m.Input().FullName())
+			assert.Equal(t, tt.output, m.Output().FullName())
+			assert.Equal(t, tt.streaming, m.IsStreamingServer())
+		})
+	}
+
+	result := findMessage(t, r, "service.Users_getUser_Result")
+	require.Equal(t, 1, result.Fields().Len(), "exceptions must be omitted")
+	assert.Equal(t, protoreflect.FullName("common.types.User"), result.Fields().ByNumber(1).Message().FullName())
+
+	assert.Equal(t, 0, findMessage(t, r, "service.Users_forget_Result").Fields().Len())
+	assert.Equal(t, 0, findMessage(t, r, "service.Base_ping_Result").Fields().Len())
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			desc: "reserved field ID",
+			files: map[string]string{
+				"foo.thrift": `struct Foo { 19000: optional string bar }`,
+			},
+			wantErr: `field "bar" of "Foo" has ID 19000 which is reserved`,
+		},
+		{
+			desc: "reserved argument ID",
+			files: map[string]string{
+				"foo.thrift": `service Foo { void bar(19999: string baz) }`,
+			},
+			wantErr: `field "baz" of "Foo_bar_Args" has ID 19999 which is reserved`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Convert(idltest.Compile(t, tt.files, "foo.thrift"))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpperSnake(t *testing.T) {
+	tests := []struct{ give, want string }{
+		{"Role", "ROLE"},
+		{"UserRole", "USER_ROLE"},
+		{"HTTPStatus", "HTTP_STATUS"},
+		{"user_role", "USER_ROLE"},
+		{"ID", "ID"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, upperSnake(tt.give), "upperSnake(%q)", tt.give)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protoexport"
+
+	flags "github.com/jessevdk/go-flags"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+type protoGenOptions struct {
+	Format     string `short:"f" long:"format" value-name:"FORMAT" default:"binary" description:"Encoding of the FileDescriptorSet. Must be one of binary or text."`
+	OutputFile string `short:"o" long:"output" value-name:"FILE" description:"Write the FileDescriptorSet to FILE instead of printing it."`
+}
+
+// runProtoGen converts the Thrift file in args and all the files it
+// includes into a Protocol Buffers FileDescriptorSet. The descriptors are
+// written to out unless the --output option was provided.
+func runProtoGen(args []string, out io.Writer) error {
+	var opts protoGenOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw proto-gen"
+	parser.Usage = "[OPTIONS] FILE"
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) != 1 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	var marshal func(proto.Message) ([]byte, error)
+	switch opts.Format {
+	case "binary":
+		marshal = proto.MarshalOptions{Deterministic: true}.Marshal
+	case "text":
+		marshal = prototext.MarshalOptions{Multiline: true}.Marshal
+	default:
+		return fmt.Errorf("unknown descriptor format %q: must be binary or text", opts.Format)
+	}
+
+	module, err := compile.Compile(files[0])
+	if err != nil {
+		return err
+	}
+
+	set, err := protoexport.Convert(module)
+	if err != nil {
+		return err
+	}
+
+	b, err := marshal(set)
+	if err != nil {
+		return err
+	}
+
+	if opts.OutputFile == "" {
+		_, err := out.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(opts.OutputFile, b, 0644)
+}
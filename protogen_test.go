@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestRunProtoGen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-proto-gen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+		struct Bar { 1: required string name }
+		service Foo { Bar get() }
+	`), 0644))
+
+	output := filepath.Join(dir, "foo.pb")
+
+	t.Run("binary to file", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runProtoGen([]string{"-o", output, file}, &out))
+		assert.Empty(t, out.String())
+
+		b, err := ioutil.ReadFile(output)
+		require.NoError(t, err)
+
+		var set descriptorpb.FileDescriptorSet
+		require.NoError(t, proto.Unmarshal(b, &set))
+		require.Len(t, set.File, 1)
+		assert.Equal(t, "foo.proto", set.File[0].GetName())
+		assert.Equal(t, "Foo", set.File[0].Service[0].GetName())
+	})
+
+	tests := []struct {
+		desc      string
+		args      []string
+		wantOut   string
+		wantError string
+	}{
+		{
+			desc:    "text",
+			args:    []string{"--format", "text", file},
+			wantOut: `"foo.proto"`,
+		},
+		{
+			desc:      "unknown format",
+			args:      []string{"-f", "json", file},
+			wantError: `unknown descriptor format "json"`,
+		},
+		{
+			desc:      "missing file",
+			args:      []string{filepath.Join(dir, "bar.thrift")},
+			wantError: "bar.thrift",
+		},
+		{
+			desc:      "no files",
+			wantError: "thriftrw proto-gen [OPTIONS] FILE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runProtoGen(tt.args, &out)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantError)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tt.wantOut)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package required reports required fields missing from values decoded by
+// the FromWire methods of generated types.
+//
+// FromWire decodes every field it finds even if some required fields are
+// missing, including those of nested structs. It then returns Errors, which
+// lists all missing fields. A Mode chooses how callers handle these errors:
+// a single error for the first missing field, no error, or all of them.
+//
+//   var user User
+//   if err := required.FromWire(&user, w, required.Lenient); err != nil {
+//     return err
+//   }
+//
+// The Decode methods of generated types always fail at the first missing
+// required field. Modes do not apply to them.
+package required
+
+import (
+	"bytes"
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Mode controls how required fields missing from a decoded value are
+// handled.
+type Mode int
+
+const (
+	// Strict reports the first missing required field as a *FieldError.
+	Strict Mode = iota
+
+	// Lenient ignores missing required fields. They hold their zero values.
+	Lenient
+
+	// Collect reports all missing required fields together as Errors.
+	Collect
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Strict:
+		return "strict"
+	case Lenient:
+		return "lenient"
+	case Collect:
+		return "collect"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// Check applies this mode to an error returned by a FromWire method.
+// Errors other than Errors are returned unchanged.
+func (m Mode) Check(err error) error {
+	es, ok := err.(Errors)
+	if !ok {
+		return err
+	}
+
+	switch m {
+	case Lenient:
+		return nil
+	case Collect:
+		return es
+	default:
+		return es[0]
+	}
+}
+
+// FromWirer is implemented by generated types.
+type FromWirer interface {
+	FromWire(wire.Value) error
+}
+
+// FromWire decodes v from the given Thrift-level representation, handling
+// missing required fields according to the given mode.
+func FromWire(v FromWirer, w wire.Value, m Mode) error {
+	return m.Check(v.FromWire(w))
+}
+
+// FieldError is a required field missing from a decoded struct.
+type FieldError struct {
+	// Names of the struct and the field in Go.
+	Struct string
+	Field  string
+}
+
+func (e *FieldError) Error() string {
+	return "field " + e.Field + " of " + e.Struct + " is required"
+}
+
+// Errors is a list of required fields missing from a decoded value and the
+// values nested inside it.
+type Errors []*FieldError
+
+func (es Errors) Error() string {
+	if len(es) == 1 {
+		return es[0].Error()
+	}
+
+	var buff bytes.Buffer
+	buff.WriteString("missing required fields: ")
+	for i, e := range es {
+		if i > 0 {
+			buff.WriteString("; ")
+		}
+		buff.WriteString(e.Error())
+	}
+	return buff.String()
+}
+
+// Errors returns the missing fields as a list of errors. This allows
+// go.uber.org/multierr to treat Errors as a combination of errors.
+func (es Errors) Errors() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Add records that the given field of the given struct is missing.
+func (es *Errors) Add(structName, field string) {
+	*es = append(*es, &FieldError{Struct: structName, Field: field})
+}
+
+// Merge records the fields reported missing by the given error, which was
+// returned while decoding a value nested inside the value being decoded.
+// Other errors are returned as-is and decoding must stop.
+func (es *Errors) Merge(err error) error {
+	if other, ok := err.(Errors); ok {
+		*es = append(*es, other...)
+		return nil
+	}
+	return err
+}
+
+// Err returns the recorded fields as an error, or nil if no fields are
+// missing.
+func (es Errors) Err() error {
+	if len(es) == 0 {
+		return nil
+	}
+	return es
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package required
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModeCheck(t *testing.T) {
+	missing := Errors{
+		{Struct: "Point", Field: "X"},
+		{Struct: "Edge", Field: "EndPoint"},
+	}
+	other := errors.New("great sadness")
+
+	tests := []struct {
+		mode Mode
+		give error
+		want error
+	}{
+		{mode: Strict, give: missing, want: missing[0]},
+		{mode: Lenient, give: missing, want: nil},
+		{mode: Collect, give: missing, want: missing},
+		{mode: Strict, give: other, want: other},
+		{mode: Lenient, give: other, want: other},
+		{mode: Collect, give: other, want: other},
+		{mode: Lenient, give: nil, want: nil},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.mode.Check(tt.give), "%v: %v", tt.mode, tt.give)
+	}
+}
+
+func TestModeString(t *testing.T) {
+	assert.Equal(t, "strict", Strict.String())
+	assert.Equal(t, "lenient", Lenient.String())
+	assert.Equal(t, "collect", Collect.String())
+	assert.Equal(t, "Mode(42)", Mode(42).String())
+}
+
+func TestErrors(t *testing.T) {
+	var es Errors
+	assert.NoError(t, es.Err())
+
+	es.Add("Point", "X")
+	assert.EqualError(t, es.Err(), "field X of Point is required")
+
+	assert.NoError(t, es.Merge(nil))
+	assert.NoError(t, es.Merge(Errors{{Struct: "Edge", Field: "EndPoint"}}))
+	err := es.Err()
+	assert.EqualError(t, err, "missing required fields: "+
+		"field X of Point is required; field EndPoint of Edge is required")
+	assert.Equal(t, []error{es[0], es[1]}, es.Errors())
+
+	other := errors.New("great sadness")
+	assert.Equal(t, other, es.Merge(other))
+	assert.Len(t, es, 2)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rest
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+)
+
+// Request is a request being handled by a Route.
+type Request struct {
+	HTTP *http.Request
+
+	params map[string]string
+}
+
+// RequestError is returned by Request if a value could not be decoded from
+// the request. Handlers answer it with status 400.
+type RequestError struct {
+	// Where the value was read from: "path", "query", or "body".
+	Source string
+
+	// Name of the path or query parameter. Empty for the body.
+	Name string
+
+	Err error
+}
+
+func (e *RequestError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("invalid request %v: %v", e.Source, e.Err)
+	}
+	return fmt.Sprintf("invalid %v parameter %q: %v", e.Source, e.Name, e.Err)
+}
+
+// Path decodes the segment of the path matching the parameter with the
+// given name into v, which must be a pointer.
+func (r *Request) Path(name string, v interface{}) error {
+	s, ok := r.params[name]
+	if !ok {
+		return &RequestError{Source: "path", Name: name, Err: fmt.Errorf("not found in %q", r.HTTP.URL.Path)}
+	}
+	if err := decodeText(s, reflect.ValueOf(v).Elem()); err != nil {
+		return &RequestError{Source: "path", Name: name, Err: err}
+	}
+	return nil
+}
+
+// Query decodes the query parameter with the given name into v, which must
+// be a pointer. v is left unchanged if the parameter is absent. All values
+// of parameters repeated in the query string are decoded if v points to a
+// slice.
+func (r *Request) Query(name string, v interface{}) error {
+	values, ok := r.HTTP.URL.Query()[name]
+	if !ok || len(values) == 0 {
+		return nil
+	}
+	if err := decodeValues(values, reflect.ValueOf(v).Elem()); err != nil {
+		return &RequestError{Source: "query", Name: name, Err: err}
+	}
+	return nil
+}
+
+// Body decodes the JSON request body into v, which must be a pointer. v is
+// left unchanged if the body is empty.
+func (r *Request) Body(v interface{}) error {
+	if r.HTTP.Body == nil {
+		return nil
+	}
+	if err := json.NewDecoder(r.HTTP.Body).Decode(v); err != nil && err != io.EOF {
+		return &RequestError{Source: "body", Err: err}
+	}
+	return nil
+}
+
+var _textUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// decodeValues decodes the given values into v. Only the last value is used
+// unless v is a slice other than []byte.
+func decodeValues(values []string, v reflect.Value) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+		return decodeText(values[len(values)-1], v)
+	}
+
+	items := reflect.MakeSlice(v.Type(), len(values), len(values))
+	for i, s := range values {
+		if err := decodeText(s, items.Index(i)); err != nil {
+			return err
+		}
+	}
+	v.Set(items)
+	return nil
+}
+
+// decodeText decodes the given text into v. Strings, numbers, and booleans
+// are parsed from their text representation, types implementing
+// encoding.TextUnmarshaler (like enums) decode themselves, and all other
+// values are decoded from JSON.
+func decodeText(s string, v reflect.Value) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(_textUnmarshaler) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		i, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(s))
+			return nil
+		}
+		return json.Unmarshal([]byte(s), v.Addr().Interface())
+	default:
+		return json.Unmarshal([]byte(s), v.Addr().Interface())
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rest serves Thrift services as JSON over HTTP.
+//
+// Code generated with --http-handlers uses this package to expose the
+// functions of a service annotated with http.method and http.path as REST
+// endpoints.
+//
+//   service Users {
+//     User getUser(1: required string id, 2: optional bool verbose)
+//       throws (1: NotFound notFound (http.status = "404"))
+//       (http.method = "GET", http.path = "/users/{id}", http.query = "verbose")
+//
+//     void putUser(1: required string id, 2: required User user)
+//       (http.method = "PUT", http.path = "/users/{id}", http.body = "user")
+//   }
+//
+// The generated NewUsersHTTPHandler serves a UsersServer as an http.Handler.
+//
+//   http.Handle("/", users.NewUsersHTTPHandler(&usersServer{}))
+//
+// Arguments named in braces in http.path are decoded from those segments of
+// the path, and the comma-separated arguments listed in http.query from the
+// query string. http.body names the argument decoded from the JSON request
+// body; if it's "*", the body is a JSON object holding all arguments which
+// are not decoded from the path or query string.
+//
+// Results are encoded as JSON. Exceptions declared by the function are
+// encoded as a JSON object keyed by the name of the exception, for example
+// {"notFound": {...}}, with the status given by the http.status annotation
+// on the exception, or 400 if there isn't one. Other errors are answered
+// with status 500.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Route maps requests with the given method and path to a function.
+type Route struct {
+	// HTTP method of the requests, e.g. "GET".
+	Method string
+
+	// Path of the requests. Segments of the form {name} match any
+	// non-empty segment, whose value is available with Request.Path.
+	Path string
+
+	// Handle decodes the arguments of the function from the request, calls
+	// it, and returns the value to be encoded as the JSON response body.
+	// The response has no body if the value is nil.
+	//
+	// Errors returned by the Request are answered with status 400, *Error
+	// with its Status, and all others with status 500.
+	Handle func(ctx context.Context, r *Request) (interface{}, error)
+}
+
+// Error is an error answered with a specific status and JSON body.
+type Error struct {
+	Status int
+	Body   interface{}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: %v", e.Status, http.StatusText(e.Status))
+}
+
+// Handler is an http.Handler which dispatches requests to Routes.
+type Handler struct {
+	routes []route
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+type route struct {
+	Route
+
+	segments []string
+}
+
+// NewHandler builds a Handler serving the given routes. Requests are
+// dispatched to the first route matching their method and path.
+func NewHandler(routes ...Route) *Handler {
+	h := &Handler{routes: make([]route, len(routes))}
+	for i, r := range routes {
+		h.routes[i] = route{Route: r, segments: splitPath(r.Path)}
+	}
+	return h
+}
+
+// ServeHTTP dispatches the given request to the matching route. Requests
+// for paths without routes are answered with status 404, and those for
+// paths with routes for other methods with status 405.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	segments := splitPath(req.URL.Path)
+
+	var allowed []string
+	for _, r := range h.routes {
+		params, ok := r.match(segments)
+		if !ok {
+			continue
+		}
+		if r.Method != req.Method {
+			allowed = append(allowed, r.Method)
+			continue
+		}
+
+		body, err := r.Handle(req.Context(), &Request{HTTP: req, params: params})
+		writeResponse(w, body, err)
+		return
+	}
+
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	http.NotFound(w, req)
+}
+
+// match returns the values of the parameters of this route if it matches
+// the given segments of a path.
+func (r route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(r.segments) {
+		return nil, false
+	}
+
+	var params map[string]string
+	for i, s := range r.segments {
+		if name, ok := pathParam(s); ok {
+			if segments[i] == "" {
+				return nil, false
+			}
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[name] = segments[i]
+		} else if s != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func writeResponse(w http.ResponseWriter, body interface{}, err error) {
+	status := http.StatusOK
+	if err != nil {
+		switch e := err.(type) {
+		case *Error:
+			status, body = e.Status, e.Body
+		case *RequestError:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// pathParam returns the name of the parameter if the given segment of a
+// route's path is of the form {name}.
+func pathParam(segment string) (string, bool) {
+	if len(segment) > 2 && segment[0] == '{' && segment[len(segment)-1] == '}' {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
+
+// PathParams returns the names of the parameters in the given path, in the
+// order in which they appear.
+//
+//   PathParams("/users/{id}/items/{item}") // ["id", "item"]
+func PathParams(path string) []string {
+	var names []string
+	for _, s := range splitPath(path) {
+		if name, ok := pathParam(s); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
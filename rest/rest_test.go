@@ -0,0 +1,215 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type color int32
+
+func (c *color) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "RED":
+		*c = 1
+	case "BLUE":
+		*c = 2
+	default:
+		return errors.New("unknown color")
+	}
+	return nil
+}
+
+type item struct {
+	ID    string   `json:"id"`
+	Count *int32   `json:"count,omitempty"`
+	Color *color   `json:"color,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+	Note  string   `json:"note,omitempty"`
+}
+
+func newTestHandler() *Handler {
+	return NewHandler(
+		Route{
+			Method: "GET",
+			Path:   "/items/{id}",
+			Handle: func(ctx context.Context, r *Request) (interface{}, error) {
+				var it item
+				if err := r.Path("id", &it.ID); err != nil {
+					return nil, err
+				}
+				if err := r.Query("count", &it.Count); err != nil {
+					return nil, err
+				}
+				if err := r.Query("color", &it.Color); err != nil {
+					return nil, err
+				}
+				if err := r.Query("tag", &it.Tags); err != nil {
+					return nil, err
+				}
+				switch it.ID {
+				case "missing":
+					return nil, &Error{Status: http.StatusNotFound, Body: map[string]string{"id": it.ID}}
+				case "broken":
+					return nil, errors.New("great sadness")
+				}
+				return &it, nil
+			},
+		},
+		Route{
+			Method: "PUT",
+			Path:   "/items/{id}",
+			Handle: func(ctx context.Context, r *Request) (interface{}, error) {
+				var it item
+				if err := r.Body(&it); err != nil {
+					return nil, err
+				}
+				return nil, r.Path("id", &it.ID)
+			},
+		},
+	)
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		desc       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+		wantAllow  string
+	}{
+		{
+			desc:       "path only",
+			method:     "GET",
+			target:     "/items/foo",
+			wantStatus: 200,
+			wantBody:   `{"id":"foo"}`,
+		},
+		{
+			desc:       "query",
+			method:     "GET",
+			target:     "/items/foo?count=3&color=BLUE&tag=a&tag=b",
+			wantStatus: 200,
+			wantBody:   `{"id":"foo","count":3,"color":2,"tags":["a","b"]}`,
+		},
+		{
+			desc:       "invalid query",
+			method:     "GET",
+			target:     "/items/foo?count=many",
+			wantStatus: 400,
+			wantBody:   `invalid query parameter "count": strconv.ParseInt: parsing "many": invalid syntax`,
+		},
+		{
+			desc:       "invalid enum",
+			method:     "GET",
+			target:     "/items/foo?color=GREEN",
+			wantStatus: 400,
+			wantBody:   `invalid query parameter "color": unknown color`,
+		},
+		{
+			desc:       "error with status",
+			method:     "GET",
+			target:     "/items/missing",
+			wantStatus: 404,
+			wantBody:   `{"id":"missing"}`,
+		},
+		{
+			desc:       "other error",
+			method:     "GET",
+			target:     "/items/broken",
+			wantStatus: 500,
+			wantBody:   "great sadness",
+		},
+		{
+			desc:       "body",
+			method:     "PUT",
+			target:     "/items/foo",
+			body:       `{"note": "hello"}`,
+			wantStatus: 200,
+		},
+		{
+			desc:       "invalid body",
+			method:     "PUT",
+			target:     "/items/foo",
+			body:       `{`,
+			wantStatus: 400,
+			wantBody:   "invalid request body: unexpected EOF",
+		},
+		{
+			desc:       "unknown path",
+			method:     "GET",
+			target:     "/items",
+			wantStatus: 404,
+			wantBody:   "404 page not found",
+		},
+		{
+			desc:       "empty path parameter",
+			method:     "GET",
+			target:     "/items//",
+			wantStatus: 404,
+			wantBody:   "404 page not found",
+		},
+		{
+			desc:       "method not allowed",
+			method:     "POST",
+			target:     "/items/foo",
+			wantStatus: 405,
+			wantBody:   "method not allowed",
+			wantAllow:  "GET, PUT",
+		},
+	}
+
+	h := newTestHandler()
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantBody, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tt.wantAllow, w.Header().Get("Allow"))
+		})
+	}
+}
+
+func TestPathParams(t *testing.T) {
+	assert.Equal(t, []string{"id", "item"}, PathParams("/users/{id}/items/{item}"))
+	assert.Empty(t, PathParams("/users"))
+}
+
+func TestRequestPathMissing(t *testing.T) {
+	r := &Request{HTTP: httptest.NewRequest("GET", "/items", nil)}
+	var s string
+	err := r.Path("id", &s)
+	require.Error(t, err)
+	assert.Equal(t, `invalid path parameter "id": not found in "/items"`, err.Error())
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Transport sends serialized requests and receives serialized responses.
+//
+// Implementations must be safe for concurrent use if the clients built on
+// top of them are used concurrently.
+type Transport interface {
+	// Send sends the given request and returns the response to it.
+	//
+	// The response is ignored for oneway requests.
+	Send(ctx context.Context, req []byte) ([]byte, error)
+}
+
+// OnewayTransport is a Transport which can send requests without waiting
+// for a response. Clients use it for requests to oneway methods if the
+// Transport implements it.
+type OnewayTransport interface {
+	Transport
+
+	// SendOneway sends the given request and returns as soon as it has been
+	// sent.
+	//
+	// On the server side, such requests must be handled with Server.Handle,
+	// which produces no response for them.
+	SendOneway(ctx context.Context, req []byte) error
+}
+
+// StreamTransport is a Transport which also supports requests whose
+// responses are streamed back.
+type StreamTransport interface {
+	Transport
+
+	// SendStream sends the given request and returns the stream of
+	// responses to it.
+	//
+	// On the server side, such requests must be handled with
+	// Server.HandleStream.
+	SendStream(ctx context.Context, req []byte) (MessageStream, error)
+}
+
+// MessageStream is a stream of serialized responses received by a
+// StreamTransport.
+type MessageStream interface {
+	// Receive returns the next response in the stream. It returns io.EOF
+	// once the stream has ended.
+	Receive() ([]byte, error)
+
+	// Close stops receiving responses and releases resources associated
+	// with the stream. It may be called before the stream has ended.
+	Close() error
+}
+
+// Client sends Thrift requests and returns their responses. Generated
+// service clients are built on top of a Client.
+type Client interface {
+	// Call sends a request to the method with the given name and returns the
+	// body of the response.
+	Call(ctx context.Context, method string, body wire.Value) (wire.Value, error)
+
+	// CallOneway sends a request to the oneway method with the given name.
+	//
+	// If the Transport is an OnewayTransport, this does not wait for the
+	// request to be handled.
+	CallOneway(ctx context.Context, method string, body wire.Value) error
+
+	// CallStream sends a request to the streaming method with the given
+	// name and returns the stream of responses to it.
+	//
+	// This fails if the Transport used by the Client is not a
+	// StreamTransport.
+	CallStream(ctx context.Context, method string, body wire.Value) (Stream, error)
+}
+
+// Stream is a stream of responses to a request made with
+// Client.CallStream.
+type Stream interface {
+	// Receive returns the body of the next response in the stream. It
+	// returns io.EOF once the stream has ended.
+	Receive() (wire.Value, error)
+
+	// Close stops receiving responses and releases resources associated
+	// with the stream. It may be called before the stream has ended.
+	Close() error
+}
+
+// NewClient builds a new Client which sends requests over the given
+// transport, encoding them using the given protocol.
+//
+// The Client is safe for concurrent use if the Transport is.
+func NewClient(p protocol.Protocol, t Transport) Client {
+	return &client{p: p, t: t}
+}
+
+type client struct {
+	p     protocol.Protocol
+	t     Transport
+	seqID int32 // accessed atomically
+}
+
+func (c *client) Call(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	seqID := atomic.AddInt32(&c.seqID, 1)
+	resBody, err := c.send(ctx, wire.Envelope{
+		Name:  method,
+		Type:  wire.Call,
+		SeqID: seqID,
+		Value: body,
+	})
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	return c.decodeReply(method, seqID, resBody)
+}
+
+func (c *client) CallOneway(ctx context.Context, method string, body wire.Value) error {
+	e := wire.Envelope{
+		Name:  method,
+		Type:  wire.OneWay,
+		SeqID: atomic.AddInt32(&c.seqID, 1),
+		Value: body,
+	}
+
+	t, ok := c.t.(OnewayTransport)
+	if !ok {
+		_, err := c.send(ctx, e)
+		return err
+	}
+
+	req, err := c.encode(e)
+	if err != nil {
+		return err
+	}
+	return t.SendOneway(ctx, req)
+}
+
+func (c *client) CallStream(ctx context.Context, method string, body wire.Value) (Stream, error) {
+	t, ok := c.t.(StreamTransport)
+	if !ok {
+		return nil, fmt.Errorf(
+			"cannot call streaming method %q: transport %T does not support streaming", method, c.t)
+	}
+
+	seqID := atomic.AddInt32(&c.seqID, 1)
+	req, err := c.encode(wire.Envelope{
+		Name:  method,
+		Type:  wire.Call,
+		SeqID: seqID,
+		Value: body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ms, err := t.SendStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stream{c: c, ms: ms, method: method, seqID: seqID}, nil
+}
+
+func (c *client) send(ctx context.Context, e wire.Envelope) ([]byte, error) {
+	req, err := c.encode(e)
+	if err != nil {
+		return nil, err
+	}
+	return c.t.Send(ctx, req)
+}
+
+func (c *client) encode(e wire.Envelope) ([]byte, error) {
+	var buff bytes.Buffer
+	if err := c.p.EncodeEnveloped(e, &buff); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// decodeReply decodes a response to the request with the given method name
+// and sequence ID.
+func (c *client) decodeReply(method string, seqID int32, resBody []byte) (wire.Value, error) {
+	res, err := c.p.DecodeEnveloped(bytes.NewReader(resBody))
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	if res.SeqID != seqID {
+		return wire.Value{}, fmt.Errorf(
+			"received response with sequence ID %d for request %q with sequence ID %d",
+			res.SeqID, method, seqID)
+	}
+
+	if !isReplyName(method, res.Name) {
+		return wire.Value{}, fmt.Errorf(
+			"received response for method %q to request %q", res.Name, method)
+	}
+
+	switch res.Type {
+	case wire.Reply:
+		return res.Value, nil
+
+	case wire.Exception:
+		var exc exception.TApplicationException
+		if err := exc.FromWire(res.Value); err != nil {
+			return wire.Value{}, err
+		}
+		return wire.Value{}, &exc
+
+	default:
+		return wire.Value{}, fmt.Errorf("unknown envelope type for reply, got %v", res.Type)
+	}
+}
+
+// isReplyName reports whether name is a valid method name for responses to
+// requests to the given method. Multiplexed servers respond without the
+// service name, as expected by Apache Thrift clients.
+func isReplyName(method, name string) bool {
+	if name == method {
+		return true
+	}
+	parts := strings.SplitN(method, MultiplexedSeparator, 2)
+	return len(parts) == 2 && name == parts[1]
+}
+
+type stream struct {
+	c      *client
+	ms     MessageStream
+	method string
+	seqID  int32
+}
+
+func (s *stream) Receive() (wire.Value, error) {
+	resBody, err := s.ms.Receive()
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return s.c.decodeReply(s.method, s.seqID, resBody)
+}
+
+func (s *stream) Close() error {
+	return s.ms.Close()
+}
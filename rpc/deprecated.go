@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// DeprecatedMethodLister is a Handler which reports which of its methods
+// are deprecated. Generated service handlers implement
+// DeprecatedMethodLister if the service or one of the services it extends
+// has functions annotated with (deprecated).
+type DeprecatedMethodLister interface {
+	Handler
+
+	// DeprecatedMethods returns the names of the deprecated methods handled
+	// by this Handler, mapped to their deprecation messages. The message is
+	// empty if the annotation did not specify one.
+	DeprecatedMethods() map[string]string
+}
+
+// DeprecationFunc is called by handlers built with NotifyDeprecated before
+// a request for a deprecated method is handled.
+type DeprecationFunc func(ctx context.Context, method, message string)
+
+// NotifyDeprecated builds a Handler which calls notify for each request
+// to a deprecated method of the given Handler before handling it. Use this
+// to log or count callers of deprecated methods.
+//
+//   h := rpc.NotifyDeprecated(
+//   	keyvalue.NewKeyValueHandler(impl),
+//   	func(ctx context.Context, method, message string) {
+//   		logger.Warn("deprecated method called", zap.String("method", method))
+//   	},
+//   )
+//
+// The Handler is returned unchanged if it does not implement
+// DeprecatedMethodLister or has no deprecated methods.
+func NotifyDeprecated(h Handler, notify DeprecationFunc) Handler {
+	dl, ok := h.(DeprecatedMethodLister)
+	if !ok {
+		return h
+	}
+
+	deprecated := dl.DeprecatedMethods()
+	if len(deprecated) == 0 {
+		return h
+	}
+
+	return deprecationNotifier{h: dl, deprecated: deprecated, notify: notify}
+}
+
+type deprecationNotifier struct {
+	h          DeprecatedMethodLister
+	deprecated map[string]string
+	notify     DeprecationFunc
+}
+
+var (
+	_ StreamHandler          = deprecationNotifier{}
+	_ MethodLister           = deprecationNotifier{}
+	_ DeprecatedMethodLister = deprecationNotifier{}
+)
+
+func (d deprecationNotifier) check(ctx context.Context, method string) {
+	if msg, ok := d.deprecated[method]; ok {
+		d.notify(ctx, method, msg)
+	}
+}
+
+func (d deprecationNotifier) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	d.check(ctx, method)
+	return d.h.Handle(ctx, method, body)
+}
+
+func (d deprecationNotifier) HandleStream(ctx context.Context, method string, body wire.Value, send func(wire.Value) error) error {
+	sh, ok := d.h.(StreamHandler)
+	if !ok {
+		return ErrUnknownMethod(method)
+	}
+
+	d.check(ctx, method)
+	return sh.HandleStream(ctx, method, body, send)
+}
+
+func (d deprecationNotifier) Methods() []string {
+	if ml, ok := d.h.(MethodLister); ok {
+		return ml.Methods()
+	}
+	return nil
+}
+
+func (d deprecationNotifier) DeprecatedMethods() map[string]string {
+	return d.deprecated
+}
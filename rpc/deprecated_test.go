@@ -0,0 +1,94 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deprecatedHandler is a StreamHandler with the given deprecated methods.
+type deprecatedHandler struct {
+	streamHandler
+
+	deprecated map[string]string
+}
+
+func (h deprecatedHandler) Methods() []string {
+	return []string{"echo", "old", "stream"}
+}
+
+func (h deprecatedHandler) DeprecatedMethods() map[string]string {
+	return h.deprecated
+}
+
+func TestNotifyDeprecated(t *testing.T) {
+	echo := handlerFunc(func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+		return body, nil
+	})
+
+	type call struct{ method, message string }
+	var calls []call
+	notify := func(ctx context.Context, method, message string) {
+		calls = append(calls, call{method, message})
+	}
+
+	h := NotifyDeprecated(deprecatedHandler{
+		streamHandler: streamHandler{handlerFunc: echo, items: []wire.Value{item(1)}},
+		deprecated:    map[string]string{"old": "use echo", "stream": ""},
+	}, notify)
+
+	body := wire.NewValueStruct(wire.Struct{})
+	for _, method := range []string{"echo", "old", "old"} {
+		got, err := h.Handle(context.Background(), method, body)
+		require.NoError(t, err, "Handle(%q)", method)
+		assert.Equal(t, body, got, "Handle(%q)", method)
+	}
+
+	sh, ok := h.(StreamHandler)
+	require.True(t, ok, "must implement StreamHandler")
+	var items []wire.Value
+	require.NoError(t, sh.HandleStream(context.Background(), "stream", body, func(v wire.Value) error {
+		items = append(items, v)
+		return nil
+	}))
+	assert.Equal(t, []wire.Value{item(1)}, items)
+
+	assert.Equal(t, []call{
+		{"old", "use echo"},
+		{"old", "use echo"},
+		{"stream", ""},
+	}, calls)
+
+	ml, ok := h.(MethodLister)
+	require.True(t, ok, "must implement MethodLister")
+	assert.Equal(t, []string{"echo", "old", "stream"}, ml.Methods())
+
+	t.Run("not deprecated", func(t *testing.T) {
+		h := deprecatedHandler{streamHandler: streamHandler{handlerFunc: echo}}
+		assert.IsType(t, h, NotifyDeprecated(h, notify), "handler must be returned unchanged")
+	})
+}
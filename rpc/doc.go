@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rpc provides the runtime used by generated service clients and
+// servers.
+//
+// Code generated with --service-stubs includes a FooClient and a FooServer
+// interface for every service Foo. Clients are built on top of an rpc.Client
+// with NewFooClient, and implementations of FooServer are turned into an
+// rpc.Handler with NewFooHandler.
+//
+// NewClient and NewServer connect these to a Transport, which carries
+// serialized requests and responses between the two ends. Users provide
+// their own Transport to send requests over TCP, HTTP, or any other medium.
+//
+//   client := keyvalue.NewKeyValueClient(rpc.NewClient(protocol.Binary, transport))
+//   value, err := client.GetValue(ctx, "foo")
+//
+// On the server side,
+//
+//   server := rpc.NewServer(protocol.Binary, keyvalue.NewKeyValueHandler(impl))
+//   resBody, err := server.Handle(ctx, reqBody)
+//
+// Middleware from the envelope/dispatch package may be passed to NewFooClient
+// and NewFooHandler to add logging, authentication, metrics, or panic
+// recovery around each request.
+//
+//   handler := keyvalue.NewKeyValueHandler(impl, dispatch.Recover, logRequests)
+//
+// Requests to oneway functions are sent with the OneWay message type and no
+// response is read for them. Transports which implement OnewayTransport
+// send such requests without waiting for the server to handle them.
+//
+// Functions which return stream<T> require a StreamTransport. Their
+// requests are handled with Server.HandleStream, which sends a separate
+// response for each value in the stream.
+//
+// Multiple services may share a single Transport using the multiplexed
+// protocol, which prefixes method names with the service name. Clients for
+// each service are built with NewMultiplexedClient, and a Multiplexer
+// dispatches their requests to the Handler registered for that service.
+//
+//   mux := rpc.NewMultiplexer()
+//   mux.Register("KeyValue", keyvalue.NewKeyValueHandler(kvImpl))
+//   mux.Register("Meta", meta.NewMetaHandler(metaImpl))
+//   server := rpc.NewServer(protocol.Binary, mux)
+//
+// NewTHeaderClient and NewTHeaderServer exchange requests in the THeader
+// format used by fbthrift and Apache Thrift, which carries key-value headers
+// alongside each request and response. Headers are sent with WithHeaders
+// and received with ReceiveHeaders; handlers read them with RequestHeaders
+// and reply with SetResponseHeader.
+//
+//   ctx = rpc.WithHeaders(ctx, map[string]string{"trace-id": traceID})
+//   client := keyvalue.NewKeyValueClient(rpc.NewTHeaderClient(protocol.Compact, transport))
+//   value, err := client.GetValue(ctx, "foo")
+package rpc
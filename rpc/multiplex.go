@@ -0,0 +1,151 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// MultiplexedSeparator separates the service name from the method name in
+// requests sent over a multiplexed connection.
+const MultiplexedSeparator = ":"
+
+// NewMultiplexedClient builds a Client which sends requests for the given
+// service over a connection shared with other services. Method names are
+// prefixed with the service name and MultiplexedSeparator.
+//
+// This is compatible with TMultiplexedProtocol from Apache Thrift.
+//
+//   kv := keyvalue.NewKeyValueClient(rpc.NewMultiplexedClient("KeyValue", client))
+//   health := meta.NewMetaClient(rpc.NewMultiplexedClient("Meta", client))
+func NewMultiplexedClient(service string, c Client) Client {
+	return multiplexedClient{service: service, c: c}
+}
+
+type multiplexedClient struct {
+	service string
+	c       Client
+}
+
+func (c multiplexedClient) method(name string) string {
+	return c.service + MultiplexedSeparator + name
+}
+
+func (c multiplexedClient) Call(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	return c.c.Call(ctx, c.method(method), body)
+}
+
+func (c multiplexedClient) CallOneway(ctx context.Context, method string, body wire.Value) error {
+	return c.c.CallOneway(ctx, c.method(method), body)
+}
+
+func (c multiplexedClient) CallStream(ctx context.Context, method string, body wire.Value) (Stream, error) {
+	return c.c.CallStream(ctx, c.method(method), body)
+}
+
+// Multiplexer is a Handler which dispatches requests to one of several
+// registered Handlers based on the service name prefixed to the method
+// name. Use it with NewServer to serve requests sent by clients built with
+// NewMultiplexedClient.
+//
+// This is compatible with TMultiplexedProcessor from Apache Thrift.
+//
+//   mux := rpc.NewMultiplexer()
+//   mux.Register("KeyValue", keyvalue.NewKeyValueHandler(kvImpl))
+//   mux.Register("Meta", meta.NewMetaHandler(metaImpl))
+//   server := rpc.NewServer(protocol.Binary, mux)
+type Multiplexer struct {
+	services map[string]Handler
+}
+
+var (
+	_ StreamHandler = (*Multiplexer)(nil)
+	_ ReplyNamer    = (*Multiplexer)(nil)
+)
+
+// NewMultiplexer builds a new Multiplexer with no services.
+func NewMultiplexer() *Multiplexer {
+	return &Multiplexer{services: make(map[string]Handler)}
+}
+
+// Register adds a Handler for the service with the given name, replacing
+// the previous Handler for that service, if any.
+//
+// Register is not safe for concurrent use with itself or with requests
+// being handled by this Multiplexer.
+func (m *Multiplexer) Register(service string, h Handler) {
+	m.services[service] = h
+}
+
+// Handle dispatches the request to the Handler for the service named in the
+// method name. ErrUnknownMethod is returned if the method name is not
+// prefixed with the name of a registered service.
+func (m *Multiplexer) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	h, name, ok := m.lookup(method)
+	if !ok {
+		return wire.Value{}, ErrUnknownMethod(method)
+	}
+	return h.Handle(ctx, name, body)
+}
+
+// HandleStream dispatches the streaming request to the Handler for the
+// service named in the method name. ErrUnknownMethod is returned if the
+// method name is not prefixed with the name of a registered service or if
+// that service does not support streaming.
+func (m *Multiplexer) HandleStream(ctx context.Context, method string, body wire.Value, send func(wire.Value) error) error {
+	h, name, ok := m.lookup(method)
+	if !ok {
+		return ErrUnknownMethod(method)
+	}
+
+	sh, ok := h.(StreamHandler)
+	if !ok {
+		return ErrUnknownMethod(method)
+	}
+	return sh.HandleStream(ctx, name, body, send)
+}
+
+// ReplyName returns the method name used in responses to requests for the
+// given method.
+//
+// Apache Thrift clients expect responses to use the method name without
+// the service name.
+func (m *Multiplexer) ReplyName(method string) string {
+	if _, name, ok := m.lookup(method); ok {
+		return name
+	}
+	return method
+}
+
+// lookup returns the Handler for the given multiplexed method name and the
+// name of the method without the service name.
+func (m *Multiplexer) lookup(method string) (_ Handler, name string, ok bool) {
+	parts := strings.SplitN(method, MultiplexedSeparator, 2)
+	if len(parts) < 2 {
+		return nil, "", false
+	}
+
+	h, ok := m.services[parts[0]]
+	return h, parts[1], ok
+}
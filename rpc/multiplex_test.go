@@ -0,0 +1,185 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// namedHandler is a Handler which responds to every request with a struct
+// containing its name and the method that was called.
+func namedHandler(name string) handlerFunc {
+	return func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueBinary([]byte(name))},
+			{ID: 2, Value: wire.NewValueBinary([]byte(method))},
+		}}), nil
+	}
+}
+
+func item(i int32) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 0, Value: wire.NewValueI32(i)},
+	}})
+}
+
+func TestMultiplexedClientServer(t *testing.T) {
+	mux := NewMultiplexer()
+	mux.Register("KeyValue", namedHandler("KeyValue"))
+	mux.Register("Meta", namedHandler("Meta"))
+	mux.Register("Stream", streamHandler{
+		handlerFunc: namedHandler("Stream"),
+		items:       []wire.Value{item(1), item(2)},
+	})
+
+	transport := serverStreamTransport{NewServer(protocol.Binary, mux)}
+	client := NewClient(protocol.Binary, transport)
+
+	tests := []struct {
+		service    string
+		method     string
+		wantName   string
+		wantMethod string
+	}{
+		{service: "KeyValue", method: "getValue", wantName: "KeyValue", wantMethod: "getValue"},
+		{service: "Meta", method: "health", wantName: "Meta", wantMethod: "health"},
+		{service: "Meta", method: "foo:bar", wantName: "Meta", wantMethod: "foo:bar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.service+":"+tt.method, func(t *testing.T) {
+			res, err := NewMultiplexedClient(tt.service, client).
+				Call(context.Background(), tt.method, wire.NewValueStruct(wire.Struct{}))
+			require.NoError(t, err)
+
+			fields := res.GetStruct().Fields
+			require.Len(t, fields, 2)
+			assert.Equal(t, tt.wantName, string(fields[0].Value.GetBinary()))
+			assert.Equal(t, tt.wantMethod, string(fields[1].Value.GetBinary()))
+		})
+	}
+
+	t.Run("unknown service", func(t *testing.T) {
+		_, err := NewMultiplexedClient("Foo", client).
+			Call(context.Background(), "getValue", wire.NewValueStruct(wire.Struct{}))
+		require.Error(t, err)
+
+		exc, ok := err.(*exception.TApplicationException)
+		require.True(t, ok, "expected TApplicationException, got %T", err)
+		assert.Equal(t, exception.ExceptionTypeUnknownMethod, exc.GetType())
+		assert.Equal(t, `unknown method "Foo:getValue"`, exc.GetMessage())
+	})
+
+	t.Run("no service", func(t *testing.T) {
+		_, err := client.Call(context.Background(), "getValue", wire.NewValueStruct(wire.Struct{}))
+		require.Error(t, err)
+
+		exc, ok := err.(*exception.TApplicationException)
+		require.True(t, ok, "expected TApplicationException, got %T", err)
+		assert.Equal(t, exception.ExceptionTypeUnknownMethod, exc.GetType())
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		s, err := NewMultiplexedClient("Stream", client).
+			CallStream(context.Background(), "stream", wire.NewValueStruct(wire.Struct{}))
+		require.NoError(t, err)
+		defer s.Close()
+
+		for _, want := range []int32{1, 2} {
+			v, err := s.Receive()
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(item(want), v), "item %d must match", want)
+		}
+	})
+
+	t.Run("stream unsupported", func(t *testing.T) {
+		s, err := NewMultiplexedClient("Meta", client).
+			CallStream(context.Background(), "stream", wire.NewValueStruct(wire.Struct{}))
+		require.NoError(t, err)
+		defer s.Close()
+
+		_, err = s.Receive()
+		exc, ok := err.(*exception.TApplicationException)
+		require.True(t, ok, "expected TApplicationException, got %T", err)
+		assert.Equal(t, exception.ExceptionTypeUnknownMethod, exc.GetType())
+		assert.Equal(t, `unknown method "Meta:stream"`, exc.GetMessage())
+	})
+}
+
+func TestMultiplexedOneway(t *testing.T) {
+	var got []string
+	mux := NewMultiplexer()
+	mux.Register("Log", handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			got = append(got, method)
+			return wire.Value{}, nil
+		}))
+
+	client := NewMultiplexedClient("Log",
+		NewClient(protocol.Binary, serverTransport(NewServer(protocol.Binary, mux))))
+	require.NoError(t, client.CallOneway(context.Background(), "log", wire.NewValueStruct(wire.Struct{})))
+	assert.Equal(t, []string{"log"}, got)
+}
+
+func TestMultiplexerReplyName(t *testing.T) {
+	mux := NewMultiplexer()
+	mux.Register("KeyValue", namedHandler("KeyValue"))
+	server := NewServer(protocol.Binary, mux)
+
+	tests := []struct {
+		desc string
+		give string
+		want string
+	}{
+		{desc: "registered service", give: "KeyValue:getValue", want: "getValue"},
+		{desc: "unknown service", give: "Foo:getValue", want: "Foo:getValue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buff bytes.Buffer
+			require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+				Name:  tt.give,
+				Type:  wire.Call,
+				SeqID: 42,
+				Value: wire.NewValueStruct(wire.Struct{}),
+			}, &buff))
+
+			res, err := server.Handle(context.Background(), buff.Bytes())
+			require.NoError(t, err)
+
+			e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(res))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, e.Name,
+				"responses must not include the service name")
+			assert.Equal(t, int32(42), e.SeqID)
+		})
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type handlerFunc func(context.Context, string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	return f(ctx, method, body)
+}
+
+type transportFunc func(context.Context, []byte) ([]byte, error)
+
+func (f transportFunc) Send(ctx context.Context, req []byte) ([]byte, error) {
+	return f(ctx, req)
+}
+
+// serverTransport is a Transport which sends requests directly to a Server.
+func serverTransport(s Server) Transport {
+	return transportFunc(s.Handle)
+}
+
+// streamHandler is a StreamHandler which streams the given items for the
+// "stream" method before failing with err, if set.
+type streamHandler struct {
+	handlerFunc
+
+	items []wire.Value
+	err   error
+}
+
+func (h streamHandler) HandleStream(ctx context.Context, method string, body wire.Value, send func(wire.Value) error) error {
+	if method != "stream" {
+		return ErrUnknownMethod(method)
+	}
+	for _, item := range h.items {
+		if err := send(item); err != nil {
+			return err
+		}
+	}
+	return h.err
+}
+
+// serverStreamTransport is a StreamTransport which sends requests directly
+// to a Server.
+type serverStreamTransport struct{ Server }
+
+func (t serverStreamTransport) Send(ctx context.Context, req []byte) ([]byte, error) {
+	return t.Server.Handle(ctx, req)
+}
+
+func (t serverStreamTransport) SendStream(ctx context.Context, req []byte) (MessageStream, error) {
+	var msgs [][]byte
+	err := t.Server.HandleStream(ctx, req, func(msg []byte) error {
+		msgs = append(msgs, msg)
+		return nil
+	})
+	return &sliceStream{msgs: msgs}, err
+}
+
+type sliceStream struct {
+	msgs   [][]byte
+	closed bool
+}
+
+func (s *sliceStream) Receive() ([]byte, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *sliceStream) Close() error {
+	s.closed = true
+	return nil
+}
+
+type ctxKey struct{}
+
+func TestClientServer(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "hello")
+	hello := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("hello")},
+	}})
+	world := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("world")},
+	}})
+
+	var oneways []string
+	server := NewServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			assert.Equal(t, "hello", ctx.Value(ctxKey{}), "context must be propagated")
+			switch method {
+			case "echo":
+				return body, nil
+			case "fail":
+				return wire.Value{}, errors.New("great sadness")
+			case "invalid":
+				return wire.Value{}, ErrInvalidRequest{Method: method, Err: errors.New("missing field")}
+			case "notify":
+				oneways = append(oneways, body.GetStruct().Fields[0].Value.GetString())
+				return wire.Value{}, nil
+			default:
+				return wire.Value{}, ErrUnknownMethod(method)
+			}
+		}))
+	client := NewClient(protocol.Binary, serverTransport(server))
+
+	t.Run("reply", func(t *testing.T) {
+		res, err := client.Call(ctx, "echo", world)
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(world, res), "response must match")
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		_, err := client.Call(ctx, "fail", hello)
+		require.Error(t, err)
+
+		exc, ok := err.(*exception.TApplicationException)
+		require.True(t, ok, "expected TApplicationException, got %T", err)
+		assert.Equal(t, exception.ExceptionTypeInternalError, exc.GetType())
+		assert.Equal(t, "great sadness", exc.GetMessage())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := client.Call(ctx, "invalid", hello)
+		require.Error(t, err)
+
+		exc, ok := err.(*exception.TApplicationException)
+		require.True(t, ok, "expected TApplicationException, got %T", err)
+		assert.Equal(t, exception.ExceptionTypeProtocolError, exc.GetType())
+		assert.Equal(t, `invalid request to "invalid": missing field`, exc.GetMessage())
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		_, err := client.Call(ctx, "foo", hello)
+		require.Error(t, err)
+
+		exc, ok := err.(*exception.TApplicationException)
+		require.True(t, ok, "expected TApplicationException, got %T", err)
+		assert.Equal(t, exception.ExceptionTypeUnknownMethod, exc.GetType())
+		assert.Equal(t, `unknown method "foo"`, exc.GetMessage())
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		require.NoError(t, client.CallOneway(ctx, "notify", hello))
+		assert.Equal(t, []string{"hello"}, oneways)
+	})
+}
+
+func TestServerOnewayError(t *testing.T) {
+	server := NewServer(protocol.Binary, handlerFunc(
+		func(context.Context, string, wire.Value) (wire.Value, error) {
+			return wire.Value{}, errors.New("great sadness")
+		}))
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  "notify",
+		Type:  wire.OneWay,
+		Value: wire.NewValueStruct(wire.Struct{}),
+	}, &buff))
+
+	res, err := server.Handle(context.Background(), buff.Bytes())
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "great sadness")
+}
+
+// onewayTransport is an OnewayTransport which queues oneway requests
+// instead of sending them.
+type onewayTransport struct {
+	transportFunc
+
+	queue [][]byte
+}
+
+func (t *onewayTransport) SendOneway(ctx context.Context, req []byte) error {
+	t.queue = append(t.queue, req)
+	return nil
+}
+
+func TestClientOnewayTransport(t *testing.T) {
+	var notified []string
+	server := NewServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			assert.Equal(t, "notify", method)
+			notified = append(notified, body.GetStruct().Fields[0].Value.GetString())
+			return wire.Value{}, nil
+		}))
+
+	transport := &onewayTransport{
+		transportFunc: func(context.Context, []byte) ([]byte, error) {
+			t.Fatal("Send must not be called for oneway requests")
+			return nil, nil
+		},
+	}
+	client := NewClient(protocol.Binary, transport)
+
+	ctx := context.Background()
+	for _, s := range []string{"foo", "bar"} {
+		require.NoError(t, client.CallOneway(ctx, "notify", wire.NewValueStruct(wire.Struct{
+			Fields: []wire.Field{{ID: 1, Value: wire.NewValueString(s)}},
+		})))
+	}
+	assert.Empty(t, notified, "requests must not be handled before they're delivered")
+	require.Len(t, transport.queue, 2)
+
+	for _, req := range transport.queue {
+		e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(req))
+		require.NoError(t, err)
+		assert.Equal(t, wire.OneWay, e.Type)
+
+		res, err := server.Handle(ctx, req)
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	}
+	assert.Equal(t, []string{"foo", "bar"}, notified)
+}
+
+func TestServerDecodeError(t *testing.T) {
+	server := NewServer(protocol.Binary, handlerFunc(
+		func(context.Context, string, wire.Value) (wire.Value, error) {
+			t.Fatal("handler must not be called")
+			return wire.Value{}, nil
+		}))
+
+	_, err := server.Handle(context.Background(), []byte{0x00})
+	assert.Error(t, err)
+}
+
+func TestArenaServer(t *testing.T) {
+	ctx := context.Background()
+	hello := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("hello")},
+	}})
+
+	var oneways []string
+	server := NewArenaServer(protocol.Binary, streamHandler{
+		handlerFunc: func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			switch method {
+			case "echo":
+				return body, nil
+			case "notify":
+				// Values decoded from the arena must be copied to be
+				// retained.
+				s := body.GetStruct().Fields[0].Value.GetString()
+				oneways = append(oneways, string([]byte(s)))
+				return wire.Value{}, nil
+			default:
+				return wire.Value{}, ErrUnknownMethod(method)
+			}
+		},
+		items: []wire.Value{item(1), item(2)},
+	})
+	client := NewClient(protocol.Binary, serverStreamTransport{server})
+
+	for i := 0; i < 3; i++ {
+		// Repeated requests re-use the memory of released arenas.
+		res, err := client.Call(ctx, "echo", hello)
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(hello, res), "response must match")
+	}
+
+	require.NoError(t, client.CallOneway(ctx, "notify", hello))
+	assert.Equal(t, []string{"hello"}, oneways)
+
+	stream, err := client.CallStream(ctx, "stream", hello)
+	require.NoError(t, err)
+	defer stream.Close()
+	for _, want := range []wire.Value{item(1), item(2)} {
+		got, err := stream.Receive()
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(want, got), "stream item must match")
+	}
+
+	_, err = server.Handle(ctx, []byte{0x00})
+	assert.Error(t, err, "decode errors must be returned")
+}
+
+func TestArenaServerLimits(t *testing.T) {
+	server := NewArenaServer(protocol.NewBinary(protocol.Options{MaxStringLength: 4}), handlerFunc(
+		func(context.Context, string, wire.Value) (wire.Value, error) {
+			t.Fatal("handler must not be called")
+			return wire.Value{}, nil
+		}))
+	client := NewClient(protocol.Binary, serverTransport(server))
+
+	_, err := client.Call(context.Background(), "echo", wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("hello")},
+	}}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "MaxStringLength")
+}
+
+func TestClientErrors(t *testing.T) {
+	body := wire.NewValueStruct(wire.Struct{})
+
+	reply := func(e wire.Envelope) Transport {
+		return transportFunc(func(context.Context, []byte) ([]byte, error) {
+			var buff bytes.Buffer
+			err := protocol.Binary.EncodeEnveloped(e, &buff)
+			return buff.Bytes(), err
+		})
+	}
+
+	tests := []struct {
+		desc      string
+		transport Transport
+		wantError string
+	}{
+		{
+			desc: "transport error",
+			transport: transportFunc(func(context.Context, []byte) ([]byte, error) {
+				return nil, errors.New("great sadness")
+			}),
+			wantError: "great sadness",
+		},
+		{
+			desc: "invalid response",
+			transport: transportFunc(func(context.Context, []byte) ([]byte, error) {
+				return []byte{0x00}, nil
+			}),
+			wantError: "unexpected EOF",
+		},
+		{
+			desc:      "sequence ID mismatch",
+			transport: reply(wire.Envelope{Name: "foo", Type: wire.Reply, SeqID: 42, Value: body}),
+			wantError: `received response with sequence ID 42 for request "foo" with sequence ID 1`,
+		},
+		{
+			desc:      "method name mismatch",
+			transport: reply(wire.Envelope{Name: "bar", Type: wire.Reply, SeqID: 1, Value: body}),
+			wantError: `received response for method "bar" to request "foo"`,
+		},
+		{
+			desc:      "unexpected envelope type",
+			transport: reply(wire.Envelope{Name: "foo", Type: wire.Call, SeqID: 1, Value: body}),
+			wantError: "unknown envelope type for reply, got Call",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := NewClient(protocol.Binary, tt.transport).Call(context.Background(), "foo", body)
+			assert.EqualError(t, err, tt.wantError)
+		})
+	}
+}
+
+func TestClientServerStream(t *testing.T) {
+	items := []wire.Value{
+		wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 0, Value: wire.NewValueString("foo")},
+		}}),
+		wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 0, Value: wire.NewValueString("bar")},
+		}}),
+	}
+
+	tests := []struct {
+		desc      string
+		handler   Handler
+		method    string
+		wantItems []wire.Value
+		wantError string
+	}{
+		{
+			desc:      "success",
+			handler:   streamHandler{items: items},
+			method:    "stream",
+			wantItems: items,
+		},
+		{
+			desc:      "error after items",
+			handler:   streamHandler{items: items, err: errors.New("great sadness")},
+			method:    "stream",
+			wantItems: items,
+			wantError: "great sadness",
+		},
+		{
+			desc:      "unknown method",
+			handler:   streamHandler{},
+			method:    "foo",
+			wantError: `unknown method "foo"`,
+		},
+		{
+			desc:      "handler does not stream",
+			handler:   handlerFunc(nil),
+			method:    "stream",
+			wantError: `unknown method "stream"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			transport := serverStreamTransport{NewServer(protocol.Binary, tt.handler)}
+			client := NewClient(protocol.Binary, transport)
+
+			stream, err := client.CallStream(context.Background(), tt.method, wire.NewValueStruct(wire.Struct{}))
+			require.NoError(t, err)
+			defer stream.Close()
+
+			var got []wire.Value
+			for {
+				item, err := stream.Receive()
+				if err == io.EOF {
+					assert.Empty(t, tt.wantError, "expected an error")
+					break
+				}
+				if err != nil {
+					exc, ok := err.(*exception.TApplicationException)
+					require.True(t, ok, "expected TApplicationException, got %T", err)
+					assert.Equal(t, tt.wantError, exc.GetMessage())
+					break
+				}
+				got = append(got, item)
+			}
+			assert.Equal(t, tt.wantItems, got)
+		})
+	}
+}
+
+func TestClientStreamUnsupportedTransport(t *testing.T) {
+	client := NewClient(protocol.Binary, transportFunc(nil))
+	_, err := client.CallStream(context.Background(), "stream", wire.NewValueStruct(wire.Struct{}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `cannot call streaming method "stream"`)
+}
+
+func TestServerHandleStreamSendError(t *testing.T) {
+	server := NewServer(protocol.Binary, streamHandler{
+		items: []wire.Value{wire.NewValueStruct(wire.Struct{})},
+	})
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  "stream",
+		Type:  wire.Call,
+		Value: wire.NewValueStruct(wire.Struct{}),
+	}, &buff))
+
+	var calls int
+	err := server.HandleStream(context.Background(), buff.Bytes(), func([]byte) error {
+		calls++
+		return errors.New("great sadness")
+	})
+	assert.EqualError(t, err, "great sadness")
+	assert.Equal(t, 1, calls, "no exception must be sent after a failed send")
+}
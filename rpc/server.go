@@ -0,0 +1,282 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+)
+
+// ErrUnknownMethod is returned by Handlers to indicate that they do not
+// recognize the requested method.
+type ErrUnknownMethod string
+
+func (e ErrUnknownMethod) Error() string {
+	return fmt.Sprintf("unknown method %q", string(e))
+}
+
+// ErrInvalidRequest is returned by Handlers to indicate that the body of a
+// request to the given method could not be decoded. It is sent to the
+// client as a protocol error.
+type ErrInvalidRequest struct {
+	Method string
+	Err    error
+}
+
+func (e ErrInvalidRequest) Error() string {
+	return fmt.Sprintf("invalid request to %q: %v", e.Method, e.Err)
+}
+
+// Unwrap returns the error encountered while decoding the request.
+func (e ErrInvalidRequest) Unwrap() error {
+	return e.Err
+}
+
+// Handler handles Thrift requests. Generated service handlers implement
+// Handler.
+type Handler interface {
+	// Handle receives a request to the method with the given name and
+	// returns the body of the response. The response is ignored for oneway
+	// requests.
+	//
+	// Implementations should return ErrUnknownMethod if the method is not
+	// recognized.
+	Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error)
+}
+
+// StreamHandler is a Handler which also handles requests to streaming
+// methods. Generated handlers for services with streaming functions
+// implement StreamHandler.
+type StreamHandler interface {
+	Handler
+
+	// HandleStream receives a request to the streaming method with the
+	// given name and calls send with the body of each response in the
+	// stream. The stream ends when HandleStream returns.
+	//
+	// Implementations should return ErrUnknownMethod if the method is not
+	// recognized.
+	HandleStream(ctx context.Context, method string, body wire.Value, send func(wire.Value) error) error
+}
+
+// MethodLister is a Handler which lists the names of the methods it
+// handles. Generated service handlers implement MethodLister.
+type MethodLister interface {
+	Handler
+
+	// Methods returns the names of the methods handled by this Handler,
+	// including streaming and oneway methods.
+	Methods() []string
+}
+
+// Server decodes serialized requests, dispatches them to a Handler, and
+// encodes their responses.
+type Server struct {
+	p     protocol.Protocol
+	h     Handler
+	arena bool
+}
+
+// NewServer builds a new Server which decodes requests with the given
+// protocol and dispatches them to the given Handler.
+func NewServer(p protocol.Protocol, h Handler) Server {
+	return Server{p: p, h: h}
+}
+
+// NewArenaServer builds a Server like NewServer which decodes each request
+// into memory borrowed from a binary.Arena. The Arena is released in one
+// call once the response has been encoded, which saves the garbage
+// collector from tracking the many small allocations of large requests.
+//
+// Strings and byte slices decoded by generated FromWire methods share the
+// memory of the Arena. Handlers MUST NOT retain the request, or any values
+// decoded from it, after they return; copy anything that must outlive the
+// request. Errors returned for oneway requests must not reference them
+// either.
+//
+// Requests are decoded without an Arena if the protocol does not implement
+// protocol.ArenaProtocol.
+func NewArenaServer(p protocol.Protocol, h Handler) Server {
+	_, arena := p.(protocol.ArenaProtocol)
+	return Server{p: p, h: h, arena: arena}
+}
+
+// Handle handles the given serialized request and returns the serialized
+// response.
+//
+// Errors returned by the Handler are sent to the client as exceptions. For
+// oneway requests, no response is produced and errors returned by the
+// Handler are returned as-is. Transports must not send anything back to the
+// client if the response is nil.
+func (s Server) Handle(ctx context.Context, data []byte) ([]byte, error) {
+	req, release, err := s.decode(data)
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+
+	resValue, err := s.h.Handle(ctx, req.Name, req.Value)
+	if req.Type == wire.OneWay {
+		return nil, err
+	}
+
+	if err != nil {
+		return s.encodeException(req, err)
+	}
+	return s.encodeReply(req, resValue)
+}
+
+// HandleStream handles the given serialized request to a streaming method
+// and calls send with each serialized response. Transports must use
+// HandleStream for requests sent with StreamTransport.SendStream.
+//
+// Errors returned by the Handler are sent to the client as an exception
+// which ends the stream. An error is returned only if the request could not
+// be decoded or a response could not be sent.
+func (s Server) HandleStream(ctx context.Context, data []byte, send func([]byte) error) error {
+	req, release, err := s.decode(data)
+	defer release()
+	if err != nil {
+		return err
+	}
+
+	h, ok := s.h.(StreamHandler)
+	if !ok {
+		return s.sendException(req, ErrUnknownMethod(req.Name), send)
+	}
+
+	// Errors from send are recorded separately so that we don't attempt to
+	// send an exception over a broken stream.
+	var sendErr error
+	err = h.HandleStream(ctx, req.Name, req.Value, func(v wire.Value) error {
+		res, err := s.encodeReply(req, v)
+		if err != nil {
+			return err
+		}
+
+		if err := send(res); err != nil {
+			sendErr = err
+			return err
+		}
+		return nil
+	})
+
+	switch {
+	case sendErr != nil:
+		return sendErr
+	case err != nil:
+		return s.sendException(req, err, send)
+	default:
+		return nil
+	}
+}
+
+// decode decodes the given serialized request. release must be called once
+// the request is no longer used.
+func (s Server) decode(data []byte) (req wire.Envelope, release func(), err error) {
+	if !s.arena {
+		req, err = s.p.DecodeEnveloped(bytes.NewReader(data))
+		return req, func() {}, err
+	}
+
+	a := binary.BorrowArena()
+	req, err = s.p.(protocol.ArenaProtocol).DecodeEnvelopedArena(a, bytes.NewReader(data))
+	return req, a.Release, err
+}
+
+func (s Server) sendException(req wire.Envelope, err error, send func([]byte) error) error {
+	res, err := s.encodeException(req, err)
+	if err != nil {
+		return err
+	}
+	return send(res)
+}
+
+// encodeReply encodes a response with the given body to the given request.
+func (s Server) encodeReply(req wire.Envelope, v wire.Value) ([]byte, error) {
+	return s.encode(wire.Envelope{
+		Name:  s.replyName(req.Name),
+		SeqID: req.SeqID,
+		Type:  wire.Reply,
+		Value: v,
+	})
+}
+
+// encodeException encodes an exception response for the given error to
+// the given request.
+func (s Server) encodeException(req wire.Envelope, err error) ([]byte, error) {
+	typ := exception.ExceptionTypeInternalError
+	switch err.(type) {
+	case ErrUnknownMethod:
+		typ = exception.ExceptionTypeUnknownMethod
+	case ErrInvalidRequest:
+		typ = exception.ExceptionTypeProtocolError
+	}
+
+	v, err := (&exception.TApplicationException{
+		Message: ptr.String(err.Error()),
+		Type:    &typ,
+	}).ToWire()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.encode(wire.Envelope{
+		Name:  s.replyName(req.Name),
+		SeqID: req.SeqID,
+		Type:  wire.Exception,
+		Value: v,
+	})
+}
+
+// ReplyNamer is implemented by Handlers whose responses use a different
+// method name than the requests they receive, such as Multiplexer.
+type ReplyNamer interface {
+	Handler
+
+	// ReplyName returns the method name used in responses to requests for
+	// the given method.
+	ReplyName(method string) string
+}
+
+// replyName returns the method name used in responses to requests for the
+// given method.
+func (s Server) replyName(method string) string {
+	if rn, ok := s.h.(ReplyNamer); ok {
+		return rn.ReplyName(method)
+	}
+	return method
+}
+
+func (s Server) encode(e wire.Envelope) ([]byte, error) {
+	var buff bytes.Buffer
+	if err := s.p.EncodeEnveloped(e, &buff); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/theader"
+)
+
+type (
+	headersKey        struct{}
+	receiveHeadersKey struct{}
+	handlerHeadersKey struct{}
+)
+
+// WithHeaders returns a copy of the context which carries the given
+// headers. Clients built with NewTHeaderClient send these headers with
+// requests made with this context. Headers already attached to the context
+// are kept unless they are overwritten.
+//
+//	ctx = rpc.WithHeaders(ctx, map[string]string{"trace-id": traceID})
+//	value, err := client.GetValue(ctx, "foo")
+func WithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := make(map[string]string)
+	for k, v := range outgoingHeaders(ctx) {
+		merged[k] = v
+	}
+	for k, v := range headers {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, headersKey{}, merged)
+}
+
+func outgoingHeaders(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(headersKey{}).(map[string]string)
+	return headers
+}
+
+// ReceiveHeaders returns a copy of the context which records the headers of
+// responses to requests made with it into the given map. This only has an
+// effect on clients built with NewTHeaderClient.
+//
+//	var headers map[string]string
+//	value, err := client.GetValue(rpc.ReceiveHeaders(ctx, &headers), "foo")
+func ReceiveHeaders(ctx context.Context, headers *map[string]string) context.Context {
+	return context.WithValue(ctx, receiveHeadersKey{}, headers)
+}
+
+// RequestHeaders returns the headers of the request being handled with the
+// given context by a THeaderServer, or nil if the request has no headers.
+func RequestHeaders(ctx context.Context) map[string]string {
+	if hh, ok := ctx.Value(handlerHeadersKey{}).(*handlerHeaders); ok {
+		return hh.request
+	}
+	return nil
+}
+
+// SetResponseHeader adds a header to the response to the request being
+// handled with the given context. An error is returned if the request is
+// not being handled by a THeaderServer.
+func SetResponseHeader(ctx context.Context, key, value string) error {
+	hh, ok := ctx.Value(handlerHeadersKey{}).(*handlerHeaders)
+	if !ok {
+		return fmt.Errorf("cannot set response header %q: "+
+			"the request is not being handled by a THeaderServer", key)
+	}
+
+	hh.mu.Lock()
+	defer hh.mu.Unlock()
+	if hh.response == nil {
+		hh.response = make(map[string]string)
+	}
+	hh.response[key] = value
+	return nil
+}
+
+// handlerHeaders holds the headers of a request handled by a THeaderServer
+// and of the response to it.
+type handlerHeaders struct {
+	request map[string]string
+
+	mu       sync.Mutex
+	response map[string]string
+}
+
+// theaderProtocolID returns the THeader protocol ID for the given protocol.
+func theaderProtocolID(p protocol.Protocol) (theader.ProtocolID, error) {
+	switch p {
+	case protocol.Binary, protocol.NonStrictBinary, protocol.EnvelopeAgnosticBinary:
+		return theader.BinaryProtocol, nil
+	case protocol.Compact:
+		return theader.CompactProtocol, nil
+	case protocol.JSON:
+		return theader.JSONProtocol, nil
+	default:
+		return 0, fmt.Errorf("protocol %T cannot be used with THeader", p)
+	}
+}
+
+// theaderProtocol returns the protocol with the given THeader protocol ID.
+func theaderProtocol(id theader.ProtocolID) (protocol.Protocol, error) {
+	switch id {
+	case theader.BinaryProtocol:
+		return protocol.Binary, nil
+	case theader.CompactProtocol:
+		return protocol.Compact, nil
+	case theader.JSONProtocol:
+		return protocol.JSON, nil
+	default:
+		return nil, fmt.Errorf("unknown THeader protocol ID %d", id)
+	}
+}
+
+// NewTHeaderClient builds a new Client which sends requests over the given
+// transport in the THeader format, encoding their payloads using the given
+// protocol. The protocol must be Binary, Compact, or JSON.
+//
+// Headers attached to the context with WithHeaders are sent with each
+// request, and headers of responses are recorded with ReceiveHeaders.
+// Streaming is not supported.
+//
+//	client := keyvalue.NewKeyValueClient(rpc.NewTHeaderClient(protocol.Compact, transport))
+//
+// This is compatible with servers which use THeader in fbthrift and Apache
+// Thrift.
+func NewTHeaderClient(p protocol.Protocol, t Transport) Client {
+	id, err := theaderProtocolID(p)
+	return NewClient(p, &theaderTransport{t: t, protocolID: id, err: err})
+}
+
+// theaderTransport is a Transport which wraps requests in THeader messages
+// and unwraps their responses.
+type theaderTransport struct {
+	t          Transport
+	protocolID theader.ProtocolID
+	err        error // set if the protocol is not supported
+	seqID      int32 // accessed atomically
+}
+
+var _ OnewayTransport = (*theaderTransport)(nil)
+
+func (t *theaderTransport) Send(ctx context.Context, req []byte) ([]byte, error) {
+	seqID := atomic.AddInt32(&t.seqID, 1)
+	msg, err := t.wrap(ctx, seqID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.t.Send(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	h, body, err := theader.Decode(res)
+	if err != nil {
+		return nil, err
+	}
+	if h.SeqID != seqID {
+		return nil, fmt.Errorf(
+			"received THeader response with sequence ID %d for request with sequence ID %d",
+			h.SeqID, seqID)
+	}
+	if h.ProtocolID != t.protocolID {
+		return nil, fmt.Errorf(
+			"received THeader response with protocol ID %d for request with protocol ID %d",
+			h.ProtocolID, t.protocolID)
+	}
+
+	if headers, ok := ctx.Value(receiveHeadersKey{}).(*map[string]string); ok {
+		*headers = h.Headers
+	}
+	return body, nil
+}
+
+func (t *theaderTransport) SendOneway(ctx context.Context, req []byte) error {
+	msg, err := t.wrap(ctx, atomic.AddInt32(&t.seqID, 1), req)
+	if err != nil {
+		return err
+	}
+
+	if ot, ok := t.t.(OnewayTransport); ok {
+		return ot.SendOneway(ctx, msg)
+	}
+
+	// Servers don't respond to oneway requests so there's nothing to
+	// unwrap.
+	_, err = t.t.Send(ctx, msg)
+	return err
+}
+
+func (t *theaderTransport) wrap(ctx context.Context, seqID int32, req []byte) ([]byte, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	var buff bytes.Buffer
+	err := theader.Encode(theader.Header{
+		SeqID:      seqID,
+		ProtocolID: t.protocolID,
+		Headers:    outgoingHeaders(ctx),
+	}, req, &buff)
+	return buff.Bytes(), err
+}
+
+// THeaderServer decodes THeader requests, dispatches them to a Handler, and
+// encodes their responses in the same format.
+//
+// The payload of each response is encoded with the protocol used by the
+// request, and transforms applied to the request are applied to the
+// response too. Handlers access the headers of requests with RequestHeaders
+// and add headers to responses with SetResponseHeader.
+//
+// This is compatible with clients which use THeader in fbthrift and Apache
+// Thrift.
+type THeaderServer struct {
+	p protocol.Protocol
+	h Handler
+}
+
+// NewTHeaderServer builds a new THeaderServer which dispatches requests to
+// the given Handler.
+//
+// Requests which are not in the THeader format are decoded with the given
+// protocol and handled like Server does, so that clients which do not
+// support THeader may use the same server.
+func NewTHeaderServer(p protocol.Protocol, h Handler) THeaderServer {
+	return THeaderServer{p: p, h: h}
+}
+
+// Handle handles the given serialized request and returns the serialized
+// response. Like Server.Handle, no response is produced for oneway
+// requests.
+func (s THeaderServer) Handle(ctx context.Context, data []byte) ([]byte, error) {
+	if !theader.IsTHeader(data) {
+		return NewServer(s.p, s.h).Handle(ctx, data)
+	}
+
+	req, body, err := theader.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := theaderProtocol(req.ProtocolID)
+	if err != nil {
+		return nil, err
+	}
+
+	hh := &handlerHeaders{request: req.Headers}
+	res, err := NewServer(p, s.h).Handle(context.WithValue(ctx, handlerHeadersKey{}, hh), body)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	hh.mu.Lock()
+	defer hh.mu.Unlock()
+
+	var buff bytes.Buffer
+	err = theader.Encode(theader.Header{
+		Flags:      req.Flags,
+		SeqID:      req.SeqID,
+		ProtocolID: req.ProtocolID,
+		Transforms: req.Transforms,
+		Headers:    hh.response,
+	}, res, &buff)
+	return buff.Bytes(), err
+}
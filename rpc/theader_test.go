@@ -0,0 +1,236 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/theader"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// theaderServerTransport is a Transport which sends requests directly to a
+// THeaderServer.
+func theaderServerTransport(s THeaderServer) Transport {
+	return transportFunc(s.Handle)
+}
+
+func TestTHeaderClientServer(t *testing.T) {
+	hello := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("hello")},
+	}})
+
+	var notified []string
+	server := NewTHeaderServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			switch method {
+			case "echo":
+				for k, v := range RequestHeaders(ctx) {
+					require.NoError(t, SetResponseHeader(ctx, "echo-"+k, v))
+				}
+				return body, nil
+			case "notify":
+				notified = append(notified, RequestHeaders(ctx)["from"])
+				return wire.Value{}, nil
+			default:
+				return wire.Value{}, ErrUnknownMethod(method)
+			}
+		}))
+
+	for _, p := range []protocol.Protocol{protocol.Binary, protocol.Compact} {
+		client := NewTHeaderClient(p, theaderServerTransport(server))
+
+		t.Run("headers", func(t *testing.T) {
+			ctx := WithHeaders(context.Background(), map[string]string{"a": "1"})
+			ctx = WithHeaders(ctx, map[string]string{"b": "2"})
+
+			var headers map[string]string
+			res, err := client.Call(ReceiveHeaders(ctx, &headers), "echo", hello)
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(hello, res), "response must match")
+			assert.Equal(t, map[string]string{"echo-a": "1", "echo-b": "2"}, headers)
+		})
+
+		t.Run("no headers", func(t *testing.T) {
+			var headers map[string]string
+			_, err := client.Call(ReceiveHeaders(context.Background(), &headers), "echo", hello)
+			require.NoError(t, err)
+			assert.Empty(t, headers)
+		})
+
+		t.Run("unknown method", func(t *testing.T) {
+			_, err := client.Call(context.Background(), "foo", hello)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("oneway", func(t *testing.T) {
+		client := NewTHeaderClient(protocol.Binary, theaderServerTransport(server))
+		ctx := WithHeaders(context.Background(), map[string]string{"from": "foo"})
+		require.NoError(t, client.CallOneway(ctx, "notify", hello))
+		assert.Equal(t, []string{"foo"}, notified)
+	})
+}
+
+func TestTHeaderServerNegotiatesProtocol(t *testing.T) {
+	server := NewTHeaderServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			return body, nil
+		}))
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Compact.EncodeEnveloped(wire.Envelope{
+		Name:  "echo",
+		Type:  wire.Call,
+		SeqID: 1,
+		Value: wire.NewValueStruct(wire.Struct{}),
+	}, &buff))
+
+	var req bytes.Buffer
+	require.NoError(t, theader.Encode(theader.Header{
+		SeqID:      42,
+		ProtocolID: theader.CompactProtocol,
+		Transforms: []theader.TransformID{theader.ZlibTransform},
+	}, buff.Bytes(), &req))
+
+	res, err := server.Handle(context.Background(), req.Bytes())
+	require.NoError(t, err)
+
+	h, body, err := theader.Decode(res)
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), h.SeqID)
+	assert.Equal(t, theader.CompactProtocol, h.ProtocolID)
+	assert.Equal(t, []theader.TransformID{theader.ZlibTransform}, h.Transforms)
+
+	e, err := protocol.Compact.DecodeEnveloped(bytes.NewReader(body))
+	require.NoError(t, err)
+	assert.Equal(t, "echo", e.Name)
+	assert.Equal(t, wire.Reply, e.Type)
+}
+
+func TestTHeaderServerFallback(t *testing.T) {
+	server := NewTHeaderServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			assert.Nil(t, RequestHeaders(ctx))
+			assert.Error(t, SetResponseHeader(ctx, "foo", "bar"))
+			return body, nil
+		}))
+
+	client := NewClient(protocol.Binary, theaderServerTransport(server))
+	_, err := client.Call(context.Background(), "echo", wire.NewValueStruct(wire.Struct{}))
+	assert.NoError(t, err)
+}
+
+func TestTHeaderClientErrors(t *testing.T) {
+	body := wire.NewValueStruct(wire.Struct{})
+
+	t.Run("unsupported protocol", func(t *testing.T) {
+		client := NewTHeaderClient(struct{ protocol.Protocol }{protocol.Binary}, transportFunc(
+			func(context.Context, []byte) ([]byte, error) {
+				t.Fatal("Send must not be called")
+				return nil, nil
+			}))
+		_, err := client.Call(context.Background(), "echo", body)
+		assert.EqualError(t, err, "protocol struct { protocol.Protocol } cannot be used with THeader")
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		client := NewTHeaderClient(protocol.Binary, transportFunc(
+			func(context.Context, []byte) ([]byte, error) {
+				return nil, errors.New("great sadness")
+			}))
+		_, err := client.Call(context.Background(), "echo", body)
+		assert.EqualError(t, err, "great sadness")
+	})
+
+	t.Run("not a THeader response", func(t *testing.T) {
+		client := NewTHeaderClient(protocol.Binary, transportFunc(
+			func(context.Context, []byte) ([]byte, error) {
+				return []byte{0x80, 0x01, 0x00, 0x02}, nil
+			}))
+		_, err := client.Call(context.Background(), "echo", body)
+		require.Error(t, err)
+		assert.True(t, theader.IsDecodeError(err), "expected decode error, got %v", err)
+	})
+
+	respond := func(h theader.Header) Transport {
+		server := NewServer(protocol.Binary, handlerFunc(
+			func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+				return body, nil
+			}))
+		return transportFunc(func(ctx context.Context, data []byte) ([]byte, error) {
+			_, req, err := theader.Decode(data)
+			require.NoError(t, err)
+			res, err := server.Handle(ctx, req)
+			require.NoError(t, err)
+
+			var buff bytes.Buffer
+			err = theader.Encode(h, res, &buff)
+			return buff.Bytes(), err
+		})
+	}
+
+	t.Run("sequence ID mismatch", func(t *testing.T) {
+		client := NewTHeaderClient(protocol.Binary, respond(theader.Header{SeqID: 42}))
+		_, err := client.Call(context.Background(), "echo", body)
+		assert.EqualError(t, err,
+			"received THeader response with sequence ID 42 for request with sequence ID 1")
+	})
+
+	t.Run("protocol mismatch", func(t *testing.T) {
+		client := NewTHeaderClient(protocol.Binary, respond(theader.Header{
+			SeqID:      1,
+			ProtocolID: theader.CompactProtocol,
+		}))
+		_, err := client.Call(context.Background(), "echo", body)
+		assert.EqualError(t, err,
+			"received THeader response with protocol ID 2 for request with protocol ID 0")
+	})
+}
+
+func TestTHeaderClientOnewayTransport(t *testing.T) {
+	transport := &onewayTransport{
+		transportFunc: func(context.Context, []byte) ([]byte, error) {
+			t.Fatal("Send must not be called for oneway requests")
+			return nil, nil
+		},
+	}
+	client := NewTHeaderClient(protocol.Binary, transport)
+
+	ctx := WithHeaders(context.Background(), map[string]string{"foo": "bar"})
+	require.NoError(t, client.CallOneway(ctx, "notify", wire.NewValueStruct(wire.Struct{})))
+	require.Len(t, transport.queue, 1)
+
+	h, body, err := theader.Decode(transport.queue[0])
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "bar"}, h.Headers)
+
+	e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(body))
+	require.NoError(t, err)
+	assert.Equal(t, wire.OneWay, e.Type)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package schema exports compiled Thrift files in a form that tools written
+// in other languages can consume without parsing Thrift.
+//
+// Build resolves a compiled module and everything it includes into a Schema:
+// types with their fields, IDs, defaults, and annotations; typedefs along
+// with the types they resolve to; constants with their values; and the
+// signatures of services.
+//
+//   s, err := schema.Build(module, &schema.Options{
+//     ThriftRoot: "/path/to/idl",
+//   })
+//
+// Schemas may be marshaled to JSON or written as YAML with WriteYAML. The
+// output is stable: everything is ordered by name, and fields by ID, so
+// that the schema only changes when the Thrift files do.
+package schema
@@ -0,0 +1,611 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Options controls how a Schema is built.
+type Options struct {
+	// ThriftRoot is the directory containing all Thrift files in the
+	// schema. Paths in the schema are relative to this directory, which
+	// must be absolute.
+	ThriftRoot string
+}
+
+// Schema is the compiled form of a Thrift file and the files it includes.
+type Schema struct {
+	// Path to the Thrift file from which the schema was built.
+	Root string `json:"root"`
+
+	// Modules reachable from Root, including itself, ordered by path.
+	Modules []*Module `json:"modules"`
+}
+
+// Module is a single compiled Thrift file.
+type Module struct {
+	// Path to the Thrift file relative to the ThriftRoot.
+	Path string `json:"path"`
+
+	// Name of the module, which is the name of the Thrift file without its
+	// extension.
+	Name string `json:"name"`
+
+	// Namespace statements of the file, ordered by scope.
+	Namespaces []*Namespace `json:"namespaces,omitempty"`
+
+	// Files included by this file, ordered by name.
+	Includes []*Include `json:"includes,omitempty"`
+
+	// Constants, types, and services defined in this file, ordered by
+	// name.
+	Constants []*Constant `json:"constants,omitempty"`
+	Types     []*Type     `json:"types,omitempty"`
+	Services  []*Service  `json:"services,omitempty"`
+}
+
+// Namespace is a namespace statement of a Thrift file.
+type Namespace struct {
+	Scope       string            `json:"scope"`
+	Name        string            `json:"name"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Include is an include statement of a Thrift file.
+type Include struct {
+	// Name under which the included file is referenced.
+	Name string `json:"name"`
+
+	// Path to the included file relative to the ThriftRoot.
+	Path string `json:"path"`
+}
+
+// Constant is a constant defined in a Thrift file.
+type Constant struct {
+	Name string   `json:"name"`
+	Type *TypeRef `json:"type"`
+
+	// Value of the constant with references to other constants and enum
+	// items resolved. See Field.Default for how values are represented.
+	Value interface{} `json:"value"`
+
+	Doc string `json:"doc,omitempty"`
+}
+
+// Type is a struct, union, exception, enum, or typedef defined in a Thrift
+// file.
+type Type struct {
+	Name string `json:"name"`
+
+	// One of "struct", "union", "exception", "enum", or "typedef".
+	Kind string `json:"kind"`
+
+	// Fields of structs, unions, and exceptions, ordered by ID.
+	Fields []*Field `json:"fields,omitempty"`
+
+	// Items of enums, ordered by value.
+	Items []*EnumItem `json:"items,omitempty"`
+
+	// Type to which a typedef refers.
+	Target *TypeRef `json:"target,omitempty"`
+
+	Doc         string            `json:"doc,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Field is a field of a struct, union, or exception, or an argument or
+// exception of a function.
+type Field struct {
+	ID       int16    `json:"id"`
+	Name     string   `json:"name"`
+	Type     *TypeRef `json:"type"`
+	Required bool     `json:"required"`
+
+	// Default value of the field, if any. Values are represented by the JSON
+	// values closest to them: lists and sets are arrays; maps are arrays of
+	// MapItems because their keys need not be strings; structs are objects
+	// keyed by field name; enum items are their integer values; and
+	// references to services are their names.
+	Default interface{} `json:"default,omitempty"`
+
+	Doc         string            `json:"doc,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// MapItem is an item of a map value.
+type MapItem struct {
+	Key   interface{} `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// EnumItem is an item of an enum.
+type EnumItem struct {
+	Name        string            `json:"name"`
+	Value       int32             `json:"value"`
+	Doc         string            `json:"doc,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Service is a service defined in a Thrift file.
+type Service struct {
+	Name string `json:"name"`
+
+	// Service which this service extends, if any.
+	Parent *ServiceRef `json:"parent,omitempty"`
+
+	// Functions defined by this service, ordered by name. Functions
+	// inherited from the parent are not included.
+	Functions []*Function `json:"functions,omitempty"`
+
+	Doc         string            `json:"doc,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ServiceRef refers to a service defined in a Thrift file.
+type ServiceRef struct {
+	Name string `json:"name"`
+
+	// Path to the Thrift file which defines the service relative to the
+	// ThriftRoot.
+	Module string `json:"module"`
+}
+
+// Function is a function of a service.
+type Function struct {
+	Name string `json:"name"`
+
+	// Arguments of the function, ordered by ID.
+	Arguments []*Field `json:"arguments,omitempty"`
+
+	// Type returned by the function, or nil if it returns void.
+	Result *TypeRef `json:"result,omitempty"`
+
+	// Exceptions thrown by the function, ordered by ID.
+	Exceptions []*Field `json:"exceptions,omitempty"`
+
+	OneWay    bool `json:"oneway,omitempty"`
+	Streaming bool `json:"streaming,omitempty"`
+
+	Doc         string            `json:"doc,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// TypeRef refers to a type.
+type TypeRef struct {
+	// One of "bool", "i8", "i16", "i32", "i64", "double", "string",
+	// "binary", "list", "set", "map", "struct", "union", "exception",
+	// "enum", or "typedef".
+	Kind string `json:"kind"`
+
+	// Name of user-defined types, and the path to the Thrift file which
+	// defines them relative to the ThriftRoot.
+	Name   string `json:"name,omitempty"`
+	Module string `json:"module,omitempty"`
+
+	// Types of the keys and values of maps, and the values of lists and
+	// sets.
+	KeyType   *TypeRef `json:"keyType,omitempty"`
+	ValueType *TypeRef `json:"valueType,omitempty"`
+
+	// For typedefs, the type to which the typedef resolves after following
+	// all typedefs.
+	Root *TypeRef `json:"root,omitempty"`
+
+	// Annotations of primitive and container types given where they're
+	// referenced, for example, (go.type = "string") on binary. Annotations
+	// of user-defined types are part of their Type.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Build builds the schema of the given module and the modules it includes.
+func Build(m *compile.Module, o *Options) (*Schema, error) {
+	if !filepath.IsAbs(o.ThriftRoot) {
+		return nil, fmt.Errorf(
+			"ThriftRoot must be an absolute path: %q is not absolute", o.ThriftRoot)
+	}
+
+	b := builder{thriftRoot: o.ThriftRoot}
+	root, err := b.relPath(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+	s := &Schema{Root: root}
+
+	err = m.Walk(func(m *compile.Module) error {
+		module, err := b.module(m)
+		if err != nil {
+			return err
+		}
+		s.Modules = append(s.Modules, module)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(s.Modules, func(i, j int) bool {
+		return s.Modules[i].Path < s.Modules[j].Path
+	})
+	return s, nil
+}
+
+type builder struct {
+	thriftRoot string
+}
+
+func (b *builder) relPath(path string) (string, error) {
+	rel, err := filepath.Rel(b.thriftRoot, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf(
+			"%q is not contained in the %q directory tree", path, b.thriftRoot)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func (b *builder) module(m *compile.Module) (*Module, error) {
+	path, err := b.relPath(m.ThriftPath)
+	if err != nil {
+		return nil, err
+	}
+	module := &Module{Path: path, Name: m.Name}
+
+	for _, scope := range sortedKeys(m.Namespaces) {
+		ns := m.Namespaces[scope]
+		module.Namespaces = append(module.Namespaces, &Namespace{
+			Scope:       ns.Scope,
+			Name:        ns.Name,
+			Annotations: annotations(ns.Annotations),
+		})
+	}
+
+	for _, name := range sortedKeys(m.Includes) {
+		path, err := b.relPath(m.Includes[name].Module.ThriftPath)
+		if err != nil {
+			return nil, err
+		}
+		module.Includes = append(module.Includes, &Include{Name: name, Path: path})
+	}
+
+	for _, name := range sortedKeys(m.Constants) {
+		c, err := b.constant(m.Constants[name])
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", path, err)
+		}
+		module.Constants = append(module.Constants, c)
+	}
+
+	for _, name := range sortedKeys(m.Types) {
+		t, err := b.typeDefinition(m.Types[name])
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", path, err)
+		}
+		module.Types = append(module.Types, t)
+	}
+
+	for _, name := range sortedKeys(m.Services) {
+		s, err := b.service(m.Services[name])
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", path, err)
+		}
+		module.Services = append(module.Services, s)
+	}
+
+	return module, nil
+}
+
+func (b *builder) constant(c *compile.Constant) (*Constant, error) {
+	t, err := b.typeRef(c.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := constantValue(c.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for constant %q: %v", c.Name, err)
+	}
+
+	return &Constant{Name: c.Name, Type: t, Value: v, Doc: c.Doc}, nil
+}
+
+func (b *builder) typeDefinition(spec compile.TypeSpec) (*Type, error) {
+	t := &Type{
+		Name:        spec.ThriftName(),
+		Kind:        kind(spec),
+		Annotations: annotations(spec.ThriftAnnotations()),
+	}
+
+	var err error
+	switch s := spec.(type) {
+	case *compile.StructSpec:
+		t.Doc = s.Doc
+		t.Fields, err = b.fields(s.Fields)
+	case *compile.EnumSpec:
+		t.Doc = s.Doc
+		for _, item := range s.Items {
+			t.Items = append(t.Items, &EnumItem{
+				Name:        item.Name,
+				Value:       item.Value,
+				Doc:         item.Doc,
+				Annotations: annotations(item.Annotations),
+			})
+		}
+		sort.SliceStable(t.Items, func(i, j int) bool {
+			return t.Items[i].Value < t.Items[j].Value
+		})
+	case *compile.TypedefSpec:
+		t.Doc = s.Doc
+		t.Target, err = b.typeRef(s.Target)
+	default:
+		err = fmt.Errorf("unknown type definition %T", spec)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not export %q: %v", t.Name, err)
+	}
+	return t, nil
+}
+
+func (b *builder) fields(fields compile.FieldGroup) ([]*Field, error) {
+	out := make([]*Field, 0, len(fields))
+	for _, f := range fields {
+		t, err := b.typeRef(f.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		var def interface{}
+		if f.Default != nil {
+			def, err = constantValue(f.Default)
+			if err != nil {
+				return nil, fmt.Errorf("invalid default value for field %q: %v", f.Name, err)
+			}
+		}
+
+		out = append(out, &Field{
+			ID:          f.ID,
+			Name:        f.Name,
+			Type:        t,
+			Required:    f.Required,
+			Default:     def,
+			Doc:         f.Doc,
+			Annotations: annotations(f.Annotations),
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+func (b *builder) service(spec *compile.ServiceSpec) (*Service, error) {
+	s := &Service{
+		Name:        spec.Name,
+		Doc:         spec.Doc,
+		Annotations: annotations(spec.Annotations),
+	}
+
+	if spec.Parent != nil {
+		path, err := b.relPath(spec.Parent.File)
+		if err != nil {
+			return nil, err
+		}
+		s.Parent = &ServiceRef{Name: spec.Parent.Name, Module: path}
+	}
+
+	for _, name := range sortedKeys(spec.Functions) {
+		f, err := b.function(spec.Functions[name])
+		if err != nil {
+			return nil, fmt.Errorf("could not export %q.%q: %v", spec.Name, name, err)
+		}
+		s.Functions = append(s.Functions, f)
+	}
+	return s, nil
+}
+
+func (b *builder) function(spec *compile.FunctionSpec) (*Function, error) {
+	f := &Function{
+		Name:        spec.Name,
+		OneWay:      spec.OneWay,
+		Streaming:   spec.Streaming,
+		Doc:         spec.Doc,
+		Annotations: annotations(spec.Annotations),
+	}
+
+	var err error
+	f.Arguments, err = b.fields(compile.FieldGroup(spec.ArgsSpec))
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.ResultSpec != nil {
+		if spec.ResultSpec.ReturnType != nil {
+			f.Result, err = b.typeRef(spec.ResultSpec.ReturnType)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		f.Exceptions, err = b.fields(spec.ResultSpec.Exceptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (b *builder) typeRef(spec compile.TypeSpec) (*TypeRef, error) {
+	ref := &TypeRef{Kind: kind(spec)}
+
+	var err error
+	switch s := spec.(type) {
+	case *compile.MapSpec:
+		if ref.KeyType, err = b.typeRef(s.KeySpec); err != nil {
+			return nil, err
+		}
+		if ref.ValueType, err = b.typeRef(s.ValueSpec); err != nil {
+			return nil, err
+		}
+	case *compile.ListSpec:
+		if ref.ValueType, err = b.typeRef(s.ValueSpec); err != nil {
+			return nil, err
+		}
+	case *compile.SetSpec:
+		if ref.ValueType, err = b.typeRef(s.ValueSpec); err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.ThriftFile() == "" {
+		// Annotations of user-defined types are exported with their
+		// definitions.
+		ref.Annotations = annotations(spec.ThriftAnnotations())
+		return ref, nil
+	}
+
+	ref.Name = spec.ThriftName()
+	if ref.Module, err = b.relPath(spec.ThriftFile()); err != nil {
+		return nil, err
+	}
+
+	if _, ok := spec.(*compile.TypedefSpec); ok {
+		if ref.Root, err = b.typeRef(compile.RootTypeSpec(spec)); err != nil {
+			return nil, err
+		}
+	}
+	return ref, nil
+}
+
+// kind returns the value of TypeRef.Kind for the given type.
+func kind(spec compile.TypeSpec) string {
+	switch s := spec.(type) {
+	case *compile.I8Spec:
+		// ThriftName of i8 is "byte".
+		return "i8"
+	case *compile.MapSpec:
+		return "map"
+	case *compile.ListSpec:
+		return "list"
+	case *compile.SetSpec:
+		return "set"
+	case *compile.EnumSpec:
+		return "enum"
+	case *compile.TypedefSpec:
+		return "typedef"
+	case *compile.StructSpec:
+		switch s.Type {
+		case ast.UnionType:
+			return "union"
+		case ast.ExceptionType:
+			return "exception"
+		default:
+			return "struct"
+		}
+	default:
+		return spec.ThriftName()
+	}
+}
+
+// constantValue returns the JSON representation of the given value. See
+// Field.Default.
+func constantValue(v compile.ConstantValue) (interface{}, error) {
+	switch v := v.(type) {
+	case compile.ConstantBool:
+		return bool(v), nil
+	case compile.ConstantInt:
+		return int64(v), nil
+	case compile.ConstantDouble:
+		return float64(v), nil
+	case compile.ConstantString:
+		return string(v), nil
+	case *compile.ConstantStruct:
+		fields := make(map[string]interface{}, len(v.Fields))
+		for name, value := range v.Fields {
+			f, err := constantValue(value)
+			if err != nil {
+				return nil, err
+			}
+			fields[name] = f
+		}
+		return fields, nil
+	case compile.ConstantMap:
+		items := make([]MapItem, 0, len(v))
+		for _, pair := range v {
+			key, err := constantValue(pair.Key)
+			if err != nil {
+				return nil, err
+			}
+			value, err := constantValue(pair.Value)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, MapItem{Key: key, Value: value})
+		}
+		return items, nil
+	case compile.ConstantSet:
+		return constantValues(v)
+	case compile.ConstantList:
+		return constantValues(v)
+	case compile.ConstReference:
+		return constantValue(v.Target.Value)
+	case compile.EnumItemReference:
+		return v.Item.Value, nil
+	case compile.ServiceReference:
+		return v.Service.Name, nil
+	default:
+		return nil, fmt.Errorf("unknown constant value %v of type %T", v, v)
+	}
+}
+
+func constantValues(vs []compile.ConstantValue) ([]interface{}, error) {
+	out := make([]interface{}, 0, len(vs))
+	for _, v := range vs {
+		value, err := constantValue(v)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+func annotations(anns compile.Annotations) map[string]string {
+	if len(anns) == 0 {
+		return nil
+	}
+	return map[string]string(anns)
+}
+
+// sortedKeys returns the keys of the given map[string]* in sorted order.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	sorted := make([]string, 0, len(keys))
+	for _, k := range keys {
+		sorted = append(sorted, k.String())
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/idltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	dir := idltest.TempDir(t, map[string]string{
+		"api.thrift": `
+			namespace go example.api
+			include "common/types.thrift"
+
+			const list<types.Status> DEFAULT_STATUSES = [types.Status.ACTIVE]
+			const types.UserID ADMIN = types.ROOT
+
+			/** A user. */
+			struct User {
+				2: optional map<string, i32> (go.type = "slice") scores
+				1: required types.UserID id
+				3: optional Options options = {"verbose": true}
+			}
+
+			struct Options {
+				1: optional bool verbose
+			}
+
+			service Users extends types.Base {
+				User get(1: types.UserID id) throws (1: types.NotFound notFound)
+				oneway void ping()
+			}
+		`,
+		"common/types.thrift": `
+			typedef ID UserID (validate = "true")
+			typedef string ID
+
+			const ID ROOT = "root"
+
+			enum Status { DISABLED = 2, ACTIVE = 1 }
+
+			exception NotFound {}
+
+			service Base {}
+		`,
+	})
+	defer os.RemoveAll(dir)
+
+	module, err := compile.Compile(filepath.Join(dir, "api.thrift"))
+	require.NoError(t, err)
+
+	s, err := Build(module, &Options{ThriftRoot: dir})
+	require.NoError(t, err)
+
+	userID := &TypeRef{
+		Kind:   "typedef",
+		Name:   "UserID",
+		Module: "common/types.thrift",
+		Root:   &TypeRef{Kind: "string"},
+	}
+
+	assert.Equal(t, &Schema{
+		Root: "api.thrift",
+		Modules: []*Module{
+			{
+				Path:       "api.thrift",
+				Name:       "api",
+				Namespaces: []*Namespace{{Scope: "go", Name: "example.api"}},
+				Includes:   []*Include{{Name: "types", Path: "common/types.thrift"}},
+				Constants: []*Constant{
+					{Name: "ADMIN", Type: userID, Value: "root"},
+					{
+						Name: "DEFAULT_STATUSES",
+						Type: &TypeRef{
+							Kind:      "list",
+							ValueType: &TypeRef{Kind: "enum", Name: "Status", Module: "common/types.thrift"},
+						},
+						Value: []interface{}{int32(1)},
+					},
+				},
+				Types: []*Type{
+					{
+						Name:   "Options",
+						Kind:   "struct",
+						Fields: []*Field{{ID: 1, Name: "verbose", Type: &TypeRef{Kind: "bool"}}},
+					},
+					{
+						Name: "User",
+						Kind: "struct",
+						Doc:  "A user.",
+						Fields: []*Field{
+							{ID: 1, Name: "id", Type: userID, Required: true},
+							{
+								ID:   2,
+								Name: "scores",
+								Type: &TypeRef{
+									Kind:        "map",
+									KeyType:     &TypeRef{Kind: "string"},
+									ValueType:   &TypeRef{Kind: "i32"},
+									Annotations: map[string]string{"go.type": "slice"},
+								},
+							},
+							{
+								ID:      3,
+								Name:    "options",
+								Type:    &TypeRef{Kind: "struct", Name: "Options", Module: "api.thrift"},
+								Default: map[string]interface{}{"verbose": true},
+							},
+						},
+					},
+				},
+				Services: []*Service{
+					{
+						Name:   "Users",
+						Parent: &ServiceRef{Name: "Base", Module: "common/types.thrift"},
+						Functions: []*Function{
+							{
+								Name:      "get",
+								Arguments: []*Field{{ID: 1, Name: "id", Type: userID}},
+								Result:    &TypeRef{Kind: "struct", Name: "User", Module: "api.thrift"},
+								Exceptions: []*Field{{
+									ID:   1,
+									Name: "notFound",
+									Type: &TypeRef{Kind: "exception", Name: "NotFound", Module: "common/types.thrift"},
+								}},
+							},
+							{Name: "ping", Arguments: []*Field{}, OneWay: true},
+						},
+					},
+				},
+			},
+			{
+				Path: "common/types.thrift",
+				Name: "types",
+				Constants: []*Constant{{
+					Name:  "ROOT",
+					Type:  &TypeRef{Kind: "typedef", Name: "ID", Module: "common/types.thrift", Root: &TypeRef{Kind: "string"}},
+					Value: "root",
+				}},
+				Types: []*Type{
+					{Name: "ID", Kind: "typedef", Target: &TypeRef{Kind: "string"}},
+					{Name: "NotFound", Kind: "exception", Fields: []*Field{}},
+					{
+						Name: "Status",
+						Kind: "enum",
+						Items: []*EnumItem{
+							{Name: "ACTIVE", Value: 1},
+							{Name: "DISABLED", Value: 2},
+						},
+					},
+					{
+						Name:        "UserID",
+						Kind:        "typedef",
+						Target:      &TypeRef{Kind: "typedef", Name: "ID", Module: "common/types.thrift", Root: &TypeRef{Kind: "string"}},
+						Annotations: map[string]string{"validate": "true"},
+					},
+				},
+				Services: []*Service{{Name: "Base"}},
+			},
+		},
+	}, s)
+}
+
+func TestBuildRelativeRoot(t *testing.T) {
+	_, err := Build(&compile.Module{ThriftPath: "/foo.thrift"}, &Options{ThriftRoot: "idl"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ThriftRoot must be an absolute path")
+}
+
+func TestWriteYAML(t *testing.T) {
+	s := &Schema{
+		Root: "api.thrift",
+		Modules: []*Module{{
+			Path: "api.thrift",
+			Name: "api",
+			Constants: []*Constant{
+				{
+					Name: "NESTED",
+					Type: &TypeRef{Kind: "list"},
+					Value: []interface{}{
+						[]interface{}{1, 2},
+						[]interface{}{},
+						map[string]interface{}{"a b": "c\n", "yes": nil},
+					},
+				},
+			},
+			Types: []*Type{{Name: "Empty", Kind: "struct", Fields: []*Field{}}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteYAML(&buf, s))
+	assert.Equal(t, `root: "api.thrift"
+modules:
+  - path: "api.thrift"
+    name: "api"
+    constants:
+      - name: "NESTED"
+        type:
+          kind: "list"
+        value:
+          -
+            - 1
+            - 2
+          - []
+          - "a b": "c\n"
+            "yes": null
+    types:
+      - name: "Empty"
+        kind: "struct"
+`, buf.String())
+}
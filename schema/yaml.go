@@ -0,0 +1,176 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// WriteYAML writes the given schema to w as YAML. The document has the same
+// structure and key order as the schema marshaled to JSON.
+func WriteYAML(w io.Writer, s *Schema) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	v, err := readYAMLNode(dec)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	writeYAMLNode(bw, v, 0, "")
+	return bw.Flush()
+}
+
+// yamlNode is a JSON value with the order of object keys retained.
+type yamlNode struct {
+	kind json.Delim // '{', '[', or 0 for scalars
+
+	// Keys and values of objects, and the items of arrays.
+	keys   []string
+	values []*yamlNode
+
+	// Scalars are kept in the form they're written in.
+	scalar string
+}
+
+func readYAMLNode(dec *json.Decoder) (*yamlNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		n := &yamlNode{kind: tok}
+		for dec.More() {
+			if tok == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				n.keys = append(n.keys, key.(string))
+			}
+
+			v, err := readYAMLNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			n.values = append(n.values, v)
+		}
+		_, err := dec.Token() // closing delimiter
+		return n, err
+	case string:
+		return &yamlNode{scalar: yamlQuote(tok)}, nil
+	case json.Number:
+		return &yamlNode{scalar: tok.String()}, nil
+	case bool:
+		return &yamlNode{scalar: fmt.Sprint(tok)}, nil
+	case nil:
+		return &yamlNode{scalar: "null"}, nil
+	default:
+		return nil, fmt.Errorf("unexpected JSON token %v", tok)
+	}
+}
+
+// isInline returns true if the node is written on the same line as its key
+// or list item marker.
+func (n *yamlNode) isInline() bool {
+	return n.kind == 0 || len(n.values) == 0
+}
+
+func (n *yamlNode) inline() string {
+	switch {
+	case n.kind == '{':
+		return "{}"
+	case n.kind == '[':
+		return "[]"
+	default:
+		return n.scalar
+	}
+}
+
+// writeYAMLNode writes a node which isn't inline, indenting all lines
+// except the first by indent spaces. The first line is prefixed with
+// first instead.
+func writeYAMLNode(w *bufio.Writer, n *yamlNode, indent int, first string) {
+	prefix := strings.Repeat(" ", indent)
+	if n.isInline() {
+		fmt.Fprintf(w, "%v%v\n", first, n.inline())
+		return
+	}
+
+	for i, v := range n.values {
+		p := prefix
+		if i == 0 {
+			p = first
+		}
+
+		var head string
+		if n.kind == '{' {
+			head = p + yamlKey(n.keys[i]) + ":"
+		} else {
+			head = p + "-"
+		}
+
+		switch {
+		case v.isInline():
+			fmt.Fprintf(w, "%v %v\n", head, v.inline())
+		case n.kind == '[' && v.kind == '{':
+			// Objects in lists start on the same line as their marker.
+			writeYAMLNode(w, v, indent+2, head+" ")
+		default:
+			fmt.Fprintf(w, "%v\n", head)
+			writeYAMLNode(w, v, indent+2, prefix+"  ")
+		}
+	}
+}
+
+var yamlPlainKey = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// yamlKey returns the given key quoted if it can't be written as is.
+func yamlKey(key string) string {
+	switch strings.ToLower(key) {
+	case "y", "n", "yes", "no", "on", "off", "true", "false", "null":
+		return yamlQuote(key)
+	}
+	if yamlPlainKey.MatchString(key) {
+		return key
+	}
+	return yamlQuote(key)
+}
+
+// yamlQuote returns the given string as a double-quoted YAML scalar. JSON
+// strings are valid double-quoted YAML scalars.
+func yamlQuote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package server serves the services registered in a Registry over TCP and
+// HTTP.
+//
+// A Registry maps service names to the rpc.Handlers which implement them.
+// Services may be registered and unregistered while requests are being
+// served, so new services can be mounted without rebuilding the server.
+// Packages implementing a service typically register it with the Default
+// registry when they are initialized.
+//
+//   func init() {
+//     server.Register("KeyValue", keyvalue.NewKeyValueHandler(&kvStore{}))
+//   }
+//
+// A Server dispatches each request to the registered service which handles
+// the requested method. Requests sent by clients built with
+// rpc.NewMultiplexedClient name the service explicitly. Other requests are
+// dispatched by method name alone if exactly one registered service lists
+// that method; generated service handlers list their methods.
+//
+//   s := server.New(nil /* Default registry */, nil /* options */)
+//   go s.ServeTCP(listener)
+//   defer s.Close()
+//
+//   http.Handle("/thrift", s)
+package server
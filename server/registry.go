@@ -0,0 +1,175 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Default is the Registry used by Register and by Servers built without a
+// Registry.
+var Default = NewRegistry()
+
+// Register adds a Handler for the service with the given name to the
+// Default registry.
+func Register(service string, h rpc.Handler) {
+	Default.Register(service, h)
+}
+
+// Registry is an rpc.Handler which dispatches requests to the services
+// registered with it. It is safe for concurrent use.
+//
+// Requests for a method prefixed with a service name and
+// rpc.MultiplexedSeparator are dispatched to that service. Requests for a
+// method without a service name are dispatched to the only service whose
+// Handler lists that method through rpc.MethodLister. Methods offered by
+// more than one service must be requested with the service name.
+type Registry struct {
+	mu       sync.RWMutex
+	services map[string]rpc.Handler
+	methods  map[string][]string // method name -> services offering it
+}
+
+var (
+	_ rpc.StreamHandler = (*Registry)(nil)
+	_ rpc.ReplyNamer    = (*Registry)(nil)
+)
+
+// NewRegistry builds a new Registry with no services.
+func NewRegistry() *Registry {
+	return &Registry{
+		services: make(map[string]rpc.Handler),
+		methods:  make(map[string][]string),
+	}
+}
+
+// Register adds a Handler for the service with the given name, replacing
+// the previous Handler for that service, if any. Requests already being
+// handled by the previous Handler are not interrupted.
+func (r *Registry) Register(service string, h rpc.Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.services[service] = h
+	r.reindex()
+}
+
+// Unregister removes the service with the given name. It reports whether
+// the service was registered.
+func (r *Registry) Unregister(service string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.services[service]; !ok {
+		return false
+	}
+	delete(r.services, service)
+	r.reindex()
+	return true
+}
+
+// Services returns the names of all registered services in sorted order.
+func (r *Registry) Services() []string {
+	r.mu.RLock()
+	services := make([]string, 0, len(r.services))
+	for name := range r.services {
+		services = append(services, name)
+	}
+	r.mu.RUnlock()
+
+	sort.Strings(services)
+	return services
+}
+
+// Lookup returns the Handler for requests to the given method and the name
+// of the method without the service name, if any.
+func (r *Registry) Lookup(method string) (_ rpc.Handler, name string, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if parts := strings.SplitN(method, rpc.MultiplexedSeparator, 2); len(parts) == 2 {
+		if h, ok := r.services[parts[0]]; ok {
+			return h, parts[1], true
+		}
+	}
+
+	if services := r.methods[method]; len(services) == 1 {
+		return r.services[services[0]], method, true
+	}
+	return nil, "", false
+}
+
+// Handle dispatches the request to the Handler for the requested method.
+// rpc.ErrUnknownMethod is returned if no registered service handles it.
+func (r *Registry) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	h, name, ok := r.Lookup(method)
+	if !ok {
+		return wire.Value{}, rpc.ErrUnknownMethod(method)
+	}
+	return h.Handle(ctx, name, body)
+}
+
+// HandleStream dispatches the streaming request to the Handler for the
+// requested method. rpc.ErrUnknownMethod is returned if no registered
+// service handles it or if that service does not support streaming.
+func (r *Registry) HandleStream(ctx context.Context, method string, body wire.Value, send func(wire.Value) error) error {
+	h, name, ok := r.Lookup(method)
+	if !ok {
+		return rpc.ErrUnknownMethod(method)
+	}
+
+	sh, ok := h.(rpc.StreamHandler)
+	if !ok {
+		return rpc.ErrUnknownMethod(method)
+	}
+	return sh.HandleStream(ctx, name, body, send)
+}
+
+// ReplyName returns the method name used in responses to requests for the
+// given method. Like rpc.Multiplexer, responses to requests which name the
+// service use the method name without the service name.
+func (r *Registry) ReplyName(method string) string {
+	if _, name, ok := r.Lookup(method); ok {
+		return name
+	}
+	return method
+}
+
+// reindex rebuilds the index of method names. r.mu must be held.
+func (r *Registry) reindex() {
+	methods := make(map[string][]string)
+	for service, h := range r.services {
+		ml, ok := h.(rpc.MethodLister)
+		if !ok {
+			continue
+		}
+		for _, m := range ml.Methods() {
+			methods[m] = append(methods[m], service)
+		}
+	}
+	r.methods = methods
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// service is a Handler which lists the given methods and responds to
+// requests for them with a struct containing its name and the method that
+// was called.
+type service struct {
+	name    string
+	methods []string
+}
+
+var _ rpc.MethodLister = service{}
+
+func (s service) Methods() []string { return s.methods }
+
+func (s service) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	for _, m := range s.methods {
+		if m == method {
+			return response(s.name, method), nil
+		}
+	}
+	return wire.Value{}, rpc.ErrUnknownMethod(method)
+}
+
+// handlerFunc is a Handler which does not list its methods.
+type handlerFunc func(context.Context, string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	return f(ctx, method, body)
+}
+
+func response(service, method string) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(service)},
+		{ID: 2, Value: wire.NewValueString(method)},
+	}})
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	r.Register("KeyValue", service{name: "KeyValue", methods: []string{"getValue", "healthy"}})
+	r.Register("Meta", service{name: "Meta", methods: []string{"healthy", "version"}})
+	r.Register("Opaque", handlerFunc(func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+		return response("Opaque", method), nil
+	}))
+	assert.Equal(t, []string{"KeyValue", "Meta", "Opaque"}, r.Services())
+
+	tests := []struct {
+		desc string
+		give string
+
+		wantService string
+		wantMethod  string
+	}{
+		{desc: "method", give: "getValue", wantService: "KeyValue", wantMethod: "getValue"},
+		{desc: "other method", give: "version", wantService: "Meta", wantMethod: "version"},
+		{desc: "ambiguous method", give: "healthy"},
+		{desc: "unknown method", give: "foo"},
+		{desc: "service", give: "Meta:healthy", wantService: "Meta", wantMethod: "healthy"},
+		{desc: "unlisted method", give: "Opaque:anything", wantService: "Opaque", wantMethod: "anything"},
+		{desc: "unknown service", give: "Foo:healthy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			res, err := r.Handle(context.Background(), tt.give, wire.NewValueStruct(wire.Struct{}))
+			if tt.wantService == "" {
+				assert.Equal(t, rpc.ErrUnknownMethod(tt.give), err)
+				assert.Equal(t, tt.give, r.ReplyName(tt.give))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, response(tt.wantService, tt.wantMethod), res)
+			assert.Equal(t, tt.wantMethod, r.ReplyName(tt.give))
+		})
+	}
+}
+
+func TestRegistryReplaceAndUnregister(t *testing.T) {
+	r := NewRegistry()
+	r.Register("KeyValue", service{name: "v1", methods: []string{"getValue"}})
+	r.Register("KeyValue", service{name: "v2", methods: []string{"getValue", "setValue"}})
+
+	res, err := r.Handle(context.Background(), "setValue", wire.NewValueStruct(wire.Struct{}))
+	require.NoError(t, err)
+	assert.Equal(t, response("v2", "setValue"), res)
+
+	assert.True(t, r.Unregister("KeyValue"))
+	assert.False(t, r.Unregister("KeyValue"))
+	assert.Empty(t, r.Services())
+
+	_, err = r.Handle(context.Background(), "getValue", wire.NewValueStruct(wire.Struct{}))
+	assert.Equal(t, rpc.ErrUnknownMethod("getValue"), err)
+}
+
+func TestRegistryHandleStream(t *testing.T) {
+	r := NewRegistry()
+	r.Register("KeyValue", service{name: "KeyValue", methods: []string{"scan"}})
+
+	err := r.HandleStream(context.Background(), "scan", wire.NewValueStruct(wire.Struct{}), nil)
+	assert.Equal(t, rpc.ErrUnknownMethod("scan"), err, "service does not support streaming")
+
+	err = r.HandleStream(context.Background(), "foo", wire.NewValueStruct(wire.Struct{}), nil)
+	assert.Equal(t, rpc.ErrUnknownMethod("foo"), err)
+}
+
+func TestRegistryConcurrentRegister(t *testing.T) {
+	r := NewRegistry()
+	r.Register("KeyValue", service{name: "KeyValue", methods: []string{"getValue"}})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			r.Register("Meta", service{name: "Meta", methods: []string{"version"}})
+			r.Unregister("Meta")
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := r.Handle(context.Background(), "getValue", wire.NewValueStruct(wire.Struct{}))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package server
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/transport"
+)
+
+// ContentType is the media type of requests and responses sent over HTTP.
+// This matches THttpClient in Apache Thrift.
+const ContentType = "application/x-thrift"
+
+// Options configures a Server.
+type Options struct {
+	// Protocol used to decode requests and encode responses. Defaults to
+	// protocol.Binary.
+	Protocol protocol.Protocol
+
+	// Arena decodes each request into memory borrowed from a binary.Arena
+	// which is released after the response is encoded. Handlers MUST NOT
+	// retain requests or values decoded from them. See rpc.NewArenaServer.
+	Arena bool
+
+	// Transport configures connections accepted by ServeTCP. Its
+	// MaxMessageSize also limits the size of HTTP requests.
+	Transport transport.Options
+}
+
+// Server serves the services of a Registry over TCP and HTTP.
+//
+// Services registered with the Registry after the Server was built are
+// served as well.
+type Server struct {
+	rpc     rpc.Server
+	tcp     *transport.Server
+	maxSize int
+}
+
+var _ http.Handler = (*Server)(nil)
+
+// New builds a Server for the services registered with the given Registry.
+// The Default registry is used if r is nil. opts may be nil.
+func New(r *Registry, opts *Options) *Server {
+	if r == nil {
+		r = Default
+	}
+
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Protocol == nil {
+		o.Protocol = protocol.Binary
+	}
+
+	maxSize := o.Transport.MaxMessageSize
+	if maxSize <= 0 {
+		maxSize = transport.DefaultMaxMessageSize
+	}
+
+	s := rpc.NewServer(o.Protocol, r)
+	if o.Arena {
+		s = rpc.NewArenaServer(o.Protocol, r)
+	}
+	return &Server{
+		rpc:     s,
+		tcp:     transport.NewServer(s, &o.Transport),
+		maxSize: maxSize,
+	}
+}
+
+// ServeTCP accepts connections from the given listener and serves requests
+// over them until the listener fails or the server is closed. The listener
+// is closed when ServeTCP returns.
+//
+// ServeTCP returns nil if it stopped because the server was closed.
+func (s *Server) ServeTCP(l net.Listener) error {
+	return s.tcp.Serve(l)
+}
+
+// ServeHTTP handles a request sent over HTTP. The request body holds a
+// single serialized request and the response body holds its serialized
+// response. Responses to oneway requests have an empty body.
+//
+// Only POST requests are accepted. Requests which could not be decoded and
+// failed oneway requests are answered with status 500.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Read one byte more than allowed to find out if the body is too large.
+	body, err := ioutil.ReadAll(io.LimitReader(req.Body, int64(s.maxSize)+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > s.maxSize {
+		err := &transport.MessageTooLargeError{Size: -1, Max: s.maxSize}
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	res, err := s.rpc.Handle(req.Context(), body)
+	if res == nil && err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(res)))
+	w.Write(res)
+}
+
+// Close stops all listeners passed to ServeTCP, closes all open TCP
+// connections, and waits for requests being handled over them to finish.
+func (s *Server) Close() error {
+	return s.tcp.Close()
+}
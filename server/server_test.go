@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/transport"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeRequest(t *testing.T, method string) []byte {
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  method,
+		Type:  wire.Call,
+		SeqID: 42,
+		Value: wire.NewValueStruct(wire.Struct{}),
+	}, &buff))
+	return buff.Bytes()
+}
+
+func TestServerTCP(t *testing.T) {
+	r := NewRegistry()
+	s := New(r, nil)
+	defer s.Close()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.ServeTCP(l)
+
+	ctx := context.Background()
+	tr, err := transport.Dial(ctx, "tcp", l.Addr().String(), nil)
+	require.NoError(t, err)
+	defer tr.Close()
+	client := rpc.NewClient(protocol.Binary, tr)
+
+	_, err = client.Call(ctx, "getValue", wire.NewValueStruct(wire.Struct{}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown method "getValue"`)
+
+	// Services registered after the server started are served too.
+	r.Register("KeyValue", service{name: "KeyValue", methods: []string{"getValue"}})
+	res, err := client.Call(ctx, "getValue", wire.NewValueStruct(wire.Struct{}))
+	require.NoError(t, err)
+	assert.Equal(t, response("KeyValue", "getValue"), res)
+
+	kv := rpc.NewMultiplexedClient("KeyValue", client)
+	res, err = kv.Call(ctx, "getValue", wire.NewValueStruct(wire.Struct{}))
+	require.NoError(t, err)
+	assert.Equal(t, response("KeyValue", "getValue"), res)
+}
+
+func TestServerHTTP(t *testing.T) {
+	r := NewRegistry()
+	r.Register("KeyValue", service{name: "KeyValue", methods: []string{"getValue"}})
+	hs := httptest.NewServer(New(r, &Options{
+		Arena:     true,
+		Transport: transport.Options{MaxMessageSize: 1024},
+	}))
+	defer hs.Close()
+
+	t.Run("success", func(t *testing.T) {
+		res, err := http.Post(hs.URL, ContentType, bytes.NewReader(encodeRequest(t, "KeyValue:getValue")))
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, ContentType, res.Header.Get("Content-Type"))
+
+		body, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(body))
+		require.NoError(t, err)
+		assert.Equal(t, wire.Envelope{
+			Name:  "getValue",
+			Type:  wire.Reply,
+			SeqID: 42,
+			Value: response("KeyValue", "getValue"),
+		}, e)
+	})
+
+	t.Run("not POST", func(t *testing.T) {
+		res, err := http.Get(hs.URL)
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+		assert.Equal(t, http.MethodPost, res.Header.Get("Allow"))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		res, err := http.Post(hs.URL, ContentType, strings.NewReader(strings.Repeat("a", 1025)))
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusRequestEntityTooLarge, res.StatusCode)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		res, err := http.Post(hs.URL, ContentType, strings.NewReader("foo"))
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+func TestServerDefaultRegistry(t *testing.T) {
+	Register("DefaultTest", service{name: "DefaultTest", methods: []string{"ping"}})
+	defer Default.Unregister("DefaultTest")
+
+	rec := httptest.NewRecorder()
+	New(nil, nil).ServeHTTP(rec, httptest.NewRequest("POST", "/", bytes.NewReader(encodeRequest(t, "ping"))))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(rec.Body.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, response("DefaultTest", "ping"), e.Value)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/thriftrw/gen"
+)
+
+// stdinFS is a compile.FS which serves the contents of the Thrift file read
+// from stdin at the given path, and other files from the filesystem.
+type stdinFS struct {
+	Path     string // absolute path
+	Contents []byte
+}
+
+func (fs stdinFS) Read(p string) ([]byte, error) {
+	if p == fs.Path {
+		return fs.Contents, nil
+	}
+	return ioutil.ReadFile(p)
+}
+
+func (stdinFS) Abs(p string) (string, error) {
+	return filepath.Abs(p)
+}
+
+// archiveWriter writes generated files into an archive. The archive is
+// complete only once it has been closed.
+type archiveWriter interface {
+	gen.FileWriter
+	io.Closer
+}
+
+// newArchiveWriter builds an archiveWriter for the given format, zip or
+// tar, which writes the archive to the given Writer.
+func newArchiveWriter(format string, w io.Writer) (archiveWriter, error) {
+	switch format {
+	case "zip":
+		return zipWriter{w: zip.NewWriter(w)}, nil
+	case "tar":
+		return tarWriter{w: tar.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown archive format %q: expected zip or tar", format)
+	}
+}
+
+// Files in tar archives are stamped with this time regardless of when they
+// were generated so that the archives are reproducible. Files in zip
+// archives don't record a modification time at all.
+var archiveModTime = time.Unix(0, 0).UTC()
+
+type zipWriter struct{ w *zip.Writer }
+
+func (z zipWriter) WriteFile(path string, contents []byte) error {
+	h := &zip.FileHeader{Name: filepath.ToSlash(path), Method: zip.Deflate}
+	h.SetMode(0644)
+	f, err := z.w.CreateHeader(h)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(contents)
+	return err
+}
+
+func (z zipWriter) Close() error {
+	return z.w.Close()
+}
+
+type tarWriter struct{ w *tar.Writer }
+
+func (t tarWriter) WriteFile(path string, contents []byte) error {
+	err := t.w.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     filepath.ToSlash(path),
+		Mode:     0644,
+		Size:     int64(len(contents)),
+		ModTime:  archiveModTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = t.w.Write(contents)
+	return err
+}
+
+func (t tarWriter) Close() error {
+	return t.w.Close()
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+var archiveFiles = []struct {
+	Path     string
+	Contents string
+}{
+	{"foo/foo.go", "package foo\n"},
+	{"foo/bar/bar.go", "package bar\n"},
+}
+
+func writeArchive(t *testing.T, format string) []byte {
+	var buf bytes.Buffer
+	w, err := newArchiveWriter(format, &buf)
+	require.NoError(t, err)
+	for _, f := range archiveFiles {
+		require.NoError(t, w.WriteFile(filepath.FromSlash(f.Path), []byte(f.Contents)))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestZipArchive(t *testing.T) {
+	b := writeArchive(t, "zip")
+	assert.Equal(t, b, writeArchive(t, "zip"), "archives must be reproducible")
+
+	r, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+	require.Len(t, r.File, len(archiveFiles))
+
+	for i, f := range r.File {
+		assert.Equal(t, archiveFiles[i].Path, f.Name)
+		assert.Equal(t, os.FileMode(0644), f.Mode())
+
+		rc, err := f.Open()
+		require.NoError(t, err)
+		contents, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, archiveFiles[i].Contents, string(contents))
+	}
+}
+
+func TestTarArchive(t *testing.T) {
+	b := writeArchive(t, "tar")
+	assert.Equal(t, b, writeArchive(t, "tar"), "archives must be reproducible")
+
+	r := tar.NewReader(bytes.NewReader(b))
+	for _, want := range archiveFiles {
+		h, err := r.Next()
+		require.NoError(t, err)
+		assert.Equal(t, want.Path, h.Name)
+		assert.Equal(t, int64(0644), h.Mode)
+
+		contents, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, want.Contents, string(contents))
+	}
+
+	_, err := r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestUnknownArchiveFormat(t *testing.T) {
+	_, err := newArchiveWriter("rar", ioutil.Discard)
+	assert.EqualError(t, err, `unknown archive format "rar": expected zip or tar`)
+}
+
+func TestStdinFS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-stdin")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "shared"), 0755))
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(dir, "shared", "common.thrift"), []byte("typedef string UUID"), 0644))
+
+	path := filepath.Join(dir, "idl", "service.thrift")
+	module, err := compile.Compile(path,
+		compile.Filesystem(stdinFS{
+			Path:     path,
+			Contents: []byte(`include "common.thrift"  struct User { 1: optional common.UUID id }`),
+		}),
+		compile.IncludeDirs(filepath.Join(dir, "shared")))
+	require.NoError(t, err)
+
+	assert.Equal(t, "service", module.Name)
+	assert.Contains(t, module.Types, "User")
+	assert.Equal(t, filepath.Join(dir, "shared", "common.thrift"), module.Includes["common"].Module.ThriftPath)
+}
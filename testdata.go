@@ -0,0 +1,120 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"go.uber.org/thriftrw/codegen"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type testDataOptions struct {
+	PackagePrefix    string   `long:"pkg-prefix" value-name:"PREFIX" description:"Prefix for import paths of the packages generated for the Thrift files, as passed to thriftrw when generating them."`
+	ThriftRoot       string   `long:"thrift-root" value-name:"DIR" description:"Directory whose descendants contain all Thrift files, as passed to thriftrw when generating them. By default, this is the deepest common ancestor directory of the Thrift files."`
+	PackageLayout    string   `long:"package-layout" value-name:"LAYOUT" description:"Package layout passed to thriftrw when generating the Thrift files: file or namespace. Defaults to file."`
+	IncludeDirs      []string `long:"include-dir" short:"I" value-name:"DIR" description:"Directory in which included Thrift files are searched for if they're not found relative to the file including them. This option may be provided multiple times."`
+	PackageName      string   `long:"package" value-name:"NAME" description:"Name of the generated package. Defaults to the name of the package generated for the Thrift file followed by testdata."`
+	MaxContainerSize int      `long:"max-container-size" value-name:"N" description:"Number of items in the containers, strings, and binaries of the max fixtures. Defaults to 100."`
+	OutputFile       string   `short:"o" long:"output" value-name:"FILE" description:"Write the Go file to FILE instead of printing it."`
+}
+
+// runTestData generates a Go package with valid and invalid fixtures of
+// every struct, union, and exception in the Thrift file in args. The
+// package is written to out unless the --output option was provided.
+func runTestData(args []string, out io.Writer) error {
+	var opts testDataOptions
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Name = "thriftrw testdata"
+	parser.Usage = "[OPTIONS] FILE"
+
+	files, err := parser.ParseArgs(args)
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(out)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(files) != 1 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	if opts.PackagePrefix == "" {
+		return errors.New("--pkg-prefix is required to import the packages generated for the Thrift files")
+	}
+
+	var namespacePackages bool
+	switch opts.PackageLayout {
+	case "", "file":
+	case "namespace":
+		namespacePackages = true
+	default:
+		return fmt.Errorf("unknown package layout %q: expected file or namespace", opts.PackageLayout)
+	}
+
+	if opts.MaxContainerSize < 0 {
+		return fmt.Errorf("--max-container-size must not be negative: got %d", opts.MaxContainerSize)
+	}
+
+	module, err := compile.Compile(files[0], compile.IncludeDirs(opts.IncludeDirs...))
+	if err != nil {
+		return err
+	}
+
+	thriftRoot := opts.ThriftRoot
+	if thriftRoot == "" {
+		thriftRoot, err = codegen.FindCommonAncestor(module)
+	} else {
+		thriftRoot, err = filepath.Abs(thriftRoot)
+	}
+	if err != nil {
+		return err
+	}
+
+	b, err := gen.GenerateTestData(module, &gen.TestDataOptions{
+		PackagePrefix:     opts.PackagePrefix,
+		ThriftRoot:        thriftRoot,
+		NamespacePackages: namespacePackages,
+		PackageName:       opts.PackageName,
+		MaxContainerSize:  opts.MaxContainerSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.OutputFile == "" {
+		_, err := out.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(opts.OutputFile, b, 0644)
+}
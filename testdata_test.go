@@ -0,0 +1,132 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTestData(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-testdata")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+		struct Foo {
+			1: required string name
+			2: optional list<i32> counts
+		}
+	`), 0644))
+
+	output := filepath.Join(dir, "footestdata.go")
+
+	tests := []struct {
+		desc      string
+		args      []string
+		wantOut   []string
+		wantFile  []string
+		wantError string
+	}{
+		{
+			desc: "stdout",
+			args: []string{"--pkg-prefix", "example.com/idl", file},
+			wantOut: []string{
+				"package footestdata",
+				`foo "example.com/idl/foo"`,
+				"func FooFixtures() map[string]*foo.Foo {",
+				"max: all fields are set to their largest values and sizes (100 items)",
+				`"missing name": []byte(`,
+			},
+		},
+		{
+			desc: "to file",
+			args: []string{
+				"--pkg-prefix", "example.com/idl",
+				"--package", "fixtures",
+				"--max-container-size", "2",
+				"-o", output, file,
+			},
+			wantFile: []string{
+				"package fixtures",
+				"max: all fields are set to their largest values and sizes (2 items)",
+			},
+		},
+		{
+			desc:      "no prefix",
+			args:      []string{file},
+			wantError: "--pkg-prefix",
+		},
+		{
+			desc:      "unknown layout",
+			args:      []string{"--pkg-prefix", "example.com/idl", "--package-layout", "foo", file},
+			wantError: `unknown package layout "foo"`,
+		},
+		{
+			desc:      "negative size",
+			args:      []string{"--pkg-prefix", "example.com/idl", "--max-container-size", "-1", file},
+			wantError: "--max-container-size must not be negative: got -1",
+		},
+		{
+			desc:      "missing file",
+			args:      []string{"--pkg-prefix", "example.com/idl", filepath.Join(dir, "bar.thrift")},
+			wantError: "bar.thrift",
+		},
+		{
+			desc:      "no files",
+			args:      []string{"--pkg-prefix", "example.com/idl"},
+			wantError: "thriftrw testdata [OPTIONS] FILE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			err := runTestData(tt.args, &out)
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantError)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+
+			if len(tt.wantFile) > 0 {
+				got, err := ioutil.ReadFile(output)
+				require.NoError(t, err)
+				for _, want := range tt.wantFile {
+					assert.Contains(t, string(got), want)
+				}
+			}
+		})
+	}
+}
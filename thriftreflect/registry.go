@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+
+	// Mapping of Go packages to their modules.
+	modules map[string]*ThriftModule
+}{modules: make(map[string]*ThriftModule)}
+
+// Register records the given module in the registry of Thrift modules
+// compiled into this binary. Generated packages register their modules when
+// they are initialized.
+//
+// Registering a module for a package that already has one replaces it.
+func Register(m *ThriftModule) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	registry.modules[m.Package] = m
+}
+
+// LookupPackage returns the module registered for the Go package with the
+// given import path, if any.
+func LookupPackage(pkg string) (m *ThriftModule, ok bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	m, ok = registry.modules[pkg]
+	return m, ok
+}
+
+// Modules returns all registered modules, sorted by their Go packages.
+func Modules() []*ThriftModule {
+	registry.RLock()
+	modules := make([]*ThriftModule, 0, len(registry.modules))
+	for _, m := range registry.modules {
+		modules = append(modules, m)
+	}
+	registry.RUnlock()
+
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Package < modules[j].Package
+	})
+	return modules
+}
+
+// Dump writes the IDL of all registered modules to w, sorted by their Go
+// packages. This is intended for debugging and for discovering the schemas
+// compiled into a binary at runtime.
+//
+// Each module is preceded by a header describing it:
+//
+// 	# go.uber.org/foo/bar (bar.thrift)
+// 	# sha1: 0a1b2c3d...
+// 	# thriftrw: v1.21.0
+// 	# includes: go.uber.org/foo/baz
+// 	<contents of bar.thrift>
+func Dump(w io.Writer) error {
+	for i, m := range Modules() {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if err := dumpModule(w, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpModule(w io.Writer, m *ThriftModule) error {
+	includes := make([]string, len(m.Includes))
+	for i, inc := range m.Includes {
+		includes[i] = inc.Package
+	}
+
+	if _, err := fmt.Fprintf(w, "# %v (%v)\n# sha1: %v\n", m.Package, m.FilePath, m.SHA1); err != nil {
+		return err
+	}
+	if m.Version != "" {
+		if _, err := fmt.Fprintf(w, "# thriftrw: v%v\n", m.Version); err != nil {
+			return err
+		}
+	}
+	if len(includes) > 0 {
+		if _, err := fmt.Fprintf(w, "# includes: %v\n", strings.Join(includes, ", ")); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, m.Raw); err != nil {
+		return err
+	}
+	if !strings.HasSuffix(m.Raw, "\n") {
+		_, err := io.WriteString(w, "\n")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	baz := &ThriftModule{
+		Name:     "baz",
+		Package:  "example.com/registrytest/baz",
+		FilePath: "baz.thrift",
+		SHA1:     "2",
+		Raw:      "typedef string UUID",
+	}
+	bar := &ThriftModule{
+		Name:     "bar",
+		Package:  "example.com/registrytest/bar",
+		FilePath: "bar.thrift",
+		SHA1:     "1",
+		Version:  "1.2.3",
+		Includes: []*ThriftModule{baz},
+		Raw:      "include \"./baz.thrift\"\n",
+	}
+
+	Register(baz)
+	Register(bar)
+
+	m, ok := LookupPackage("example.com/registrytest/bar")
+	if assert.True(t, ok) {
+		assert.Equal(t, bar, m)
+	}
+
+	_, ok = LookupPackage("example.com/registrytest/qux")
+	assert.False(t, ok)
+
+	var got []*ThriftModule
+	for _, m := range Modules() {
+		if m == bar || m == baz {
+			got = append(got, m)
+		}
+	}
+	assert.Equal(t, []*ThriftModule{bar, baz}, got, "modules must be sorted by package")
+
+	var buff bytes.Buffer
+	assert.NoError(t, dumpModule(&buff, bar))
+	buff.WriteString("\n")
+	assert.NoError(t, dumpModule(&buff, baz))
+	assert.Equal(t, `# example.com/registrytest/bar (bar.thrift)
+# sha1: 1
+# thriftrw: v1.2.3
+# includes: example.com/registrytest/baz
+include "./baz.thrift"
+
+# example.com/registrytest/baz (baz.thrift)
+# sha1: 2
+typedef string UUID
+`, buff.String())
+
+	buff.Reset()
+	assert.NoError(t, Dump(&buff))
+	assert.Contains(t, buff.String(), "# example.com/registrytest/bar (bar.thrift)\n")
+}
@@ -28,5 +28,6 @@ type ThriftModule struct {
 	FilePath string          // The thrift file path (relative to--thrift-root=).
 	Includes []*ThriftModule // A reference to every included thrift modules.
 	SHA1     string          // The SHA1 of the thrift content.
+	Version  string          // The version of ThriftRW that generated the package.
 	Raw      string          // The full content of the thrift file.
 }
@@ -0,0 +1,145 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/wire"
+)
+
+const (
+	binaryVersionMask = 0xffff0000
+	binaryVersion1    = 0x80010000
+)
+
+// NewBufferedConn builds a Conn which writes messages back to back without
+// delimiters, buffering writes until the end of each message. Messages must
+// be enveloped with the Binary protocol, using either the strict or the
+// non-strict envelope, so that their ends can be found when reading them.
+//
+// Messages larger than maxSize bytes are rejected. If maxSize is zero,
+// DefaultMaxMessageSize is used.
+func NewBufferedConn(rw io.ReadWriteCloser, maxSize int) Conn {
+	return &bufferedConn{
+		c:   rw,
+		r:   bufio.NewReader(rw),
+		w:   bufio.NewWriter(rw),
+		max: maxSize,
+	}
+}
+
+type bufferedConn struct {
+	c   io.Closer
+	r   *bufio.Reader
+	w   *bufio.Writer
+	max int
+}
+
+func (c *bufferedConn) ReadMessage() ([]byte, error) {
+	if _, err := c.r.Peek(1); err != nil {
+		return nil, err // io.EOF if the stream ended between messages
+	}
+
+	// Bytes are recorded as the message is decoded.
+	var msg bytes.Buffer
+	lr := &limitedReader{r: c.r, n: maxMessageSize(c.max)}
+	sr := binary.NewStreamReader(io.TeeReader(lr, &msg))
+	if err := skipEnvelope(sr); err != nil {
+		if lr.n < 0 {
+			return nil, &MessageTooLargeError{Size: -1, Max: maxMessageSize(c.max)}
+		}
+		return nil, err
+	}
+	return msg.Bytes(), nil
+}
+
+// skipEnvelope reads past a Binary protocol envelope.
+func skipEnvelope(sr *binary.StreamReader) error {
+	v, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+
+	if v < 0 {
+		// Strict envelope: version and type, name, sequence ID.
+		if version := uint32(v) & binaryVersionMask; version != binaryVersion1 {
+			return fmt.Errorf("cannot decode envelope of unknown version %x", version)
+		}
+		if _, err := sr.ReadString(); err != nil {
+			return err
+		}
+	} else {
+		// Non-strict envelope: name, type, sequence ID. The name's length
+		// has already been read.
+		for ; v > 0; v-- {
+			if _, err := sr.ReadInt8(); err != nil {
+				return err
+			}
+		}
+		if _, err := sr.ReadInt8(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := sr.ReadInt32(); err != nil {
+		return err
+	}
+	return sr.Skip(wire.TStruct)
+}
+
+func (c *bufferedConn) WriteMessage(msg []byte) error {
+	if max := maxMessageSize(c.max); len(msg) > max {
+		return &MessageTooLargeError{Size: int64(len(msg)), Max: max}
+	}
+	if _, err := c.w.Write(msg); err != nil {
+		return err
+	}
+	return c.w.Flush()
+}
+
+func (c *bufferedConn) Close() error {
+	return c.c.Close()
+}
+
+// limitedReader reads from r until n bytes have been read and fails after
+// that. n becomes negative once the limit has been exceeded.
+type limitedReader struct {
+	r io.Reader
+	n int
+}
+
+func (l *limitedReader) Read(b []byte) (int, error) {
+	if l.n <= 0 {
+		l.n = -1
+		return 0, fmt.Errorf("message exceeds the maximum size")
+	}
+	if len(b) > l.n {
+		b = b[:l.n]
+	}
+	n, err := l.r.Read(b)
+	l.n -= n
+	return n, err
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"go.uber.org/thriftrw/rpc"
+)
+
+// Client is an rpc.Transport which sends requests over a single
+// connection. Requests are sent one at a time; concurrent calls to Send
+// wait for the requests before them to finish.
+//
+// If a request fails to be written or its response fails to be read,
+// including because its context expired, the connection is closed because
+// it may be left in the middle of a message. All later requests fail with a
+// BrokenConnError; a new Client must be built to continue.
+//
+// 	t, err := transport.Dial(ctx, "tcp", "localhost:9090", nil)
+// 	if err != nil {
+// 		return err
+// 	}
+// 	defer t.Close()
+// 	client := rpc.NewClient(protocol.Binary, t)
+type Client struct {
+	mu   sync.Mutex
+	rw   io.ReadWriteCloser
+	conn Conn
+	err  error // non-nil once the connection is unusable
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+var _ rpc.OnewayTransport = (*Client)(nil)
+
+// BrokenConnError is returned by a Client for requests sent after an
+// earlier request left its connection unusable.
+type BrokenConnError struct {
+	// Err is the error which broke the connection.
+	Err error
+}
+
+func (e *BrokenConnError) Error() string {
+	return fmt.Sprintf("connection is unusable after an earlier failure: %v", e.Err)
+}
+
+// Unwrap returns the error which broke the connection.
+func (e *BrokenConnError) Unwrap() error {
+	return e.Err
+}
+
+// NewClient builds a Client which sends requests over the given stream.
+// opts may be nil.
+//
+// If the stream is a net.Conn, context deadlines are applied to it.
+func NewClient(rw io.ReadWriteCloser, opts *Options) (*Client, error) {
+	conn, err := NewConn(rw, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{rw: rw, conn: conn}, nil
+}
+
+// Dial connects to the given address and returns a Client for it. See
+// net.Dial for the supported networks and address formats. opts may be
+// nil.
+func Dial(ctx context.Context, network, address string, opts *Options) (*Client, error) {
+	var d net.Dialer
+	c, err := d.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(c, opts)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// Send sends the given request and waits for its response.
+func (c *Client) Send(ctx context.Context, req []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.prepare(ctx); err != nil {
+		return nil, err
+	}
+	if err := c.write(req); err != nil {
+		return nil, err
+	}
+
+	res, err := c.conn.ReadMessage()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, c.fail(err)
+	}
+	return res, nil
+}
+
+// SendOneway sends the given request without waiting for a response.
+func (c *Client) SendOneway(ctx context.Context, req []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.prepare(ctx); err != nil {
+		return err
+	}
+	return c.write(req)
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		c.closeErr = c.conn.Close()
+	})
+	return c.closeErr
+}
+
+// prepare verifies that a request may be sent with the given context and
+// applies its deadline to the connection.
+func (c *Client) prepare(ctx context.Context) error {
+	if c.err != nil {
+		return &BrokenConnError{Err: c.err}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	nc, ok := c.rw.(net.Conn)
+	if !ok {
+		return nil
+	}
+
+	// A zero deadline clears any deadline left over from an earlier
+	// request.
+	deadline, _ := ctx.Deadline()
+	if err := nc.SetDeadline(deadline); err != nil {
+		return c.fail(err)
+	}
+	return nil
+}
+
+// write writes the given request, breaking the connection if that fails.
+func (c *Client) write(req []byte) error {
+	err := c.conn.WriteMessage(req)
+	if _, ok := err.(*MessageTooLargeError); ok {
+		// Requests that are too large are rejected before anything is
+		// written, so the connection is still usable.
+		return err
+	}
+	if err != nil {
+		return c.fail(err)
+	}
+	return nil
+}
+
+// fail marks the connection unusable because of the given error and closes
+// it. The given error is returned as-is.
+func (c *Client) fail(err error) error {
+	c.err = err
+	c.Close()
+	return err
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/rpc"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type handlerFunc func(context.Context, string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+	return f(ctx, method, body)
+}
+
+func stringStruct(s string) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(s)},
+	}})
+}
+
+func TestClientServer(t *testing.T) {
+	tests := []struct {
+		desc     string
+		protocol protocol.Protocol
+		opts     *Options
+	}{
+		{desc: "default", protocol: protocol.Binary},
+		{desc: "framed/compact", protocol: protocol.Compact, opts: &Options{Framing: Framed}},
+		{desc: "buffered", protocol: protocol.Binary, opts: &Options{Framing: Buffered}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			notified := make(chan string, 1)
+			unblock := make(chan struct{})
+			handler := handlerFunc(func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+				switch method {
+				case "echo":
+					return body, nil
+				case "fail":
+					return wire.Value{}, errors.New("great sadness")
+				case "notify":
+					notified <- body.GetStruct().Fields[0].Value.GetString()
+					return wire.Value{}, nil
+				case "block":
+					<-unblock
+					return body, nil
+				default:
+					return wire.Value{}, rpc.ErrUnknownMethod(method)
+				}
+			})
+
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			server := NewServer(rpc.NewServer(tt.protocol, handler), tt.opts)
+			served := make(chan error, 1)
+			go func() { served <- server.Serve(l) }()
+
+			ctx := context.Background()
+			transport, err := Dial(ctx, "tcp", l.Addr().String(), tt.opts)
+			require.NoError(t, err)
+			client := rpc.NewClient(tt.protocol, transport)
+
+			for _, s := range []string{"hello", "world"} {
+				res, err := client.Call(ctx, "echo", stringStruct(s))
+				require.NoError(t, err, "echo %q failed", s)
+				assert.True(t, wire.ValuesAreEqual(stringStruct(s), res), "echo %q: unexpected response", s)
+			}
+
+			_, err = client.Call(ctx, "fail", stringStruct("hello"))
+			assert.Error(t, err, "expected failure")
+
+			require.NoError(t, client.CallOneway(ctx, "notify", stringStruct("hi")))
+			select {
+			case got := <-notified:
+				assert.Equal(t, "hi", got)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for oneway request")
+			}
+
+			// The connection is still usable after a oneway request.
+			_, err = client.Call(ctx, "echo", stringStruct("again"))
+			require.NoError(t, err)
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			_, err = client.Call(timeoutCtx, "block", stringStruct("hello"))
+			cancel()
+			assert.Error(t, err, "expected timeout")
+			close(unblock)
+
+			require.NoError(t, transport.Close())
+			require.NoError(t, server.Close())
+			select {
+			case err := <-served:
+				assert.NoError(t, err, "Serve must not fail after Close")
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for Serve to return")
+			}
+		})
+	}
+}
+
+func TestClientMessageTooLarge(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var called bool
+	server := NewServer(rpc.NewServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			called = true
+			return body, nil
+		})), &Options{MaxMessageSize: 16})
+	go server.Serve(l)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	t.Run("client side", func(t *testing.T) {
+		transport, err := Dial(ctx, "tcp", l.Addr().String(), &Options{MaxMessageSize: 16})
+		require.NoError(t, err)
+		defer transport.Close()
+
+		_, err = rpc.NewClient(protocol.Binary, transport).Call(ctx, "echo", stringStruct("hello world"))
+		require.Error(t, err)
+		assert.IsType(t, &MessageTooLargeError{}, err)
+	})
+
+	t.Run("server side", func(t *testing.T) {
+		transport, err := Dial(ctx, "tcp", l.Addr().String(), nil)
+		require.NoError(t, err)
+		defer transport.Close()
+
+		// The server hangs up on clients that send messages that are too
+		// large.
+		_, err = rpc.NewClient(protocol.Binary, transport).Call(ctx, "echo", stringStruct("hello world"))
+		assert.Error(t, err)
+	})
+
+	assert.False(t, called, "handler must not be called")
+}
+
+func TestServeAfterClose(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := NewServer(rpc.NewServer(protocol.Binary, handlerFunc(nil)), nil)
+	require.NoError(t, server.Close())
+	assert.NoError(t, server.Serve(l))
+
+	_, err = l.Accept()
+	assert.Error(t, err, "listener must be closed")
+}
+
+func TestDialError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	_, err = Dial(context.Background(), "tcp", addr, nil)
+	assert.Error(t, err)
+}
+
+func TestClientBrokenAfterTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	unblock := make(chan struct{})
+	server := NewServer(rpc.NewServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			if method == "block" {
+				<-unblock
+			}
+			return body, nil
+		})), nil)
+	go server.Serve(l)
+	defer server.Close()
+	defer close(unblock)
+
+	ctx := context.Background()
+	transport, err := Dial(ctx, "tcp", l.Addr().String(), nil)
+	require.NoError(t, err)
+	defer transport.Close()
+	client := rpc.NewClient(protocol.Binary, transport)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	_, err = client.Call(timeoutCtx, "block", stringStruct("hello"))
+	cancel()
+	require.Error(t, err, "expected timeout")
+
+	// The response to the timed out request may still arrive over the
+	// connection so it must not be used again.
+	_, err = client.Call(ctx, "echo", stringStruct("hello"))
+	require.Error(t, err)
+	if assert.IsType(t, &BrokenConnError{}, err) {
+		ne, ok := err.(*BrokenConnError).Err.(net.Error)
+		assert.True(t, ok && ne.Timeout(), "expected a timeout to break the connection: %v", err)
+	}
+
+	err = client.CallOneway(ctx, "echo", stringStruct("hello"))
+	assert.IsType(t, &BrokenConnError{}, err)
+
+	assert.NoError(t, transport.Close(), "Close must succeed after the connection broke")
+}
+
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "temporary failure" }
+func (temporaryError) Timeout() bool   { return false }
+func (temporaryError) Temporary() bool { return true }
+
+// flakyListener fails the first n calls to Accept with a temporary error.
+type flakyListener struct {
+	net.Listener
+
+	n int
+}
+
+func (l *flakyListener) Accept() (net.Conn, error) {
+	if l.n > 0 {
+		l.n--
+		return nil, temporaryError{}
+	}
+	return l.Listener.Accept()
+}
+
+func TestServeRetriesTemporaryErrors(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := NewServer(rpc.NewServer(protocol.Binary, handlerFunc(
+		func(ctx context.Context, method string, body wire.Value) (wire.Value, error) {
+			return body, nil
+		})), nil)
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(&flakyListener{Listener: l, n: 3}) }()
+
+	ctx := context.Background()
+	transport, err := Dial(ctx, "tcp", l.Addr().String(), nil)
+	require.NoError(t, err)
+	defer transport.Close()
+
+	res, err := rpc.NewClient(protocol.Binary, transport).Call(ctx, "echo", stringStruct("hello"))
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(stringStruct("hello"), res))
+
+	require.NoError(t, server.Close())
+	select {
+	case err := <-served:
+		assert.NoError(t, err, "Serve must not fail after Close")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Serve to return")
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transport
+
+import (
+	"fmt"
+	"io"
+)
+
+// DefaultMaxMessageSize is the maximum size of messages read or written by
+// a Conn if a maximum is not specified. This matches the default maximum
+// frame size of Apache Thrift.
+const DefaultMaxMessageSize = 16384000
+
+// Conn reads and writes serialized Thrift messages over a byte stream.
+//
+// Reads and writes may happen concurrently with each other, but concurrent
+// calls to ReadMessage or to WriteMessage must be synchronized by the
+// caller.
+type Conn interface {
+	io.Closer
+
+	// ReadMessage reads the next message. It returns io.EOF if the stream
+	// ended cleanly before the message started.
+	ReadMessage() ([]byte, error)
+
+	// WriteMessage writes the given message.
+	WriteMessage(msg []byte) error
+}
+
+// Framing specifies how messages are delimited on a byte stream.
+type Framing int
+
+const (
+	// Framed prefixes each message with its length as a 4-byte big-endian
+	// integer.
+	Framed Framing = iota
+
+	// Buffered writes messages without delimiters. Messages must be
+	// encoded with the Binary protocol.
+	Buffered
+)
+
+func (f Framing) String() string {
+	switch f {
+	case Framed:
+		return "framed"
+	case Buffered:
+		return "buffered"
+	default:
+		return fmt.Sprintf("Framing(%d)", int(f))
+	}
+}
+
+// Options configures the connections made by Dial and accepted by a
+// Server.
+type Options struct {
+	// Framing of messages. Defaults to Framed.
+	Framing Framing
+
+	// Maximum size of a message in bytes. Defaults to
+	// DefaultMaxMessageSize.
+	MaxMessageSize int
+}
+
+// NewConn builds a Conn over the given stream with the framing and maximum
+// message size specified by the options. opts may be nil.
+func NewConn(rw io.ReadWriteCloser, opts *Options) (Conn, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	switch opts.Framing {
+	case Framed:
+		return NewFramedConn(rw, opts.MaxMessageSize), nil
+	case Buffered:
+		return NewBufferedConn(rw, opts.MaxMessageSize), nil
+	default:
+		return nil, fmt.Errorf("unknown framing %v", opts.Framing)
+	}
+}
+
+// MessageTooLargeError is returned when reading or writing a message larger
+// than the maximum allowed size.
+type MessageTooLargeError struct {
+	Size int64 // size of the message, if known
+	Max  int
+}
+
+func (e *MessageTooLargeError) Error() string {
+	if e.Size < 0 {
+		return fmt.Sprintf("message exceeds the maximum size of %d bytes", e.Max)
+	}
+	return fmt.Sprintf("message of %d bytes exceeds the maximum size of %d bytes", e.Size, e.Max)
+}
+
+func maxMessageSize(max int) int {
+	if max <= 0 {
+		return DefaultMaxMessageSize
+	}
+	return max
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transport
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bufferCloser is an in-memory io.ReadWriteCloser.
+type bufferCloser struct {
+	bytes.Buffer
+
+	closed bool
+}
+
+func (b *bufferCloser) Close() error {
+	b.closed = true
+	return nil
+}
+
+func encodeMessage(t *testing.T, p protocol.Protocol, name string, body wire.Value) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, p.EncodeEnveloped(wire.Envelope{
+		Name:  name,
+		Type:  wire.Call,
+		SeqID: 42,
+		Value: body,
+	}, &buf), "failed to encode message")
+	return buf.Bytes()
+}
+
+func TestNewConn(t *testing.T) {
+	var b bufferCloser
+
+	conn, err := NewConn(&b, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &framedConn{}, conn)
+
+	conn, err = NewConn(&b, &Options{Framing: Buffered})
+	require.NoError(t, err)
+	assert.IsType(t, &bufferedConn{}, conn)
+
+	_, err = NewConn(&b, &Options{Framing: Framing(42)})
+	assert.EqualError(t, err, "unknown framing Framing(42)")
+}
+
+func TestConnRoundTrip(t *testing.T) {
+	body := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("hello")},
+		{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(
+			wire.TI32, []wire.Value{wire.NewValueI32(1), wire.NewValueI32(2)},
+		))},
+	}})
+
+	tests := []struct {
+		desc     string
+		framing  Framing
+		protocol protocol.Protocol
+	}{
+		{"framed", Framed, protocol.Binary},
+		{"framed/compact", Framed, protocol.Compact},
+		{"buffered/strict", Buffered, protocol.Binary},
+		{"buffered/non-strict", Buffered, protocol.NonStrictBinary},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var b bufferCloser
+			conn, err := NewConn(&b, &Options{Framing: tt.framing})
+			require.NoError(t, err)
+
+			msgs := [][]byte{
+				encodeMessage(t, tt.protocol, "foo", body),
+				encodeMessage(t, tt.protocol, "bar", wire.NewValueStruct(wire.Struct{})),
+			}
+			for _, msg := range msgs {
+				require.NoError(t, conn.WriteMessage(msg))
+			}
+
+			for _, want := range msgs {
+				got, err := conn.ReadMessage()
+				require.NoError(t, err)
+				assert.Equal(t, want, got)
+			}
+
+			_, err = conn.ReadMessage()
+			assert.Equal(t, io.EOF, err, "expected EOF at the end of the stream")
+
+			require.NoError(t, conn.Close())
+			assert.True(t, b.closed, "underlying stream must be closed")
+		})
+	}
+}
+
+func TestFramedConnFrameFormat(t *testing.T) {
+	var b bufferCloser
+	conn := NewFramedConn(&b, 0)
+
+	require.NoError(t, conn.WriteMessage([]byte("hello")))
+	assert.Equal(t, []byte{0, 0, 0, 5, 'h', 'e', 'l', 'l', 'o'}, b.Bytes())
+}
+
+func TestFramedConnErrors(t *testing.T) {
+	t.Run("write too large", func(t *testing.T) {
+		var b bufferCloser
+		err := NewFramedConn(&b, 4).WriteMessage([]byte("hello"))
+		assert.EqualError(t, err, "message of 5 bytes exceeds the maximum size of 4 bytes")
+		assert.Equal(t, 0, b.Len(), "nothing must be written")
+	})
+
+	t.Run("read too large", func(t *testing.T) {
+		var b bufferCloser
+		b.Write([]byte{0, 0, 0, 5, 'h', 'e', 'l', 'l', 'o'})
+		_, err := NewFramedConn(&b, 4).ReadMessage()
+		assert.EqualError(t, err, "message of 5 bytes exceeds the maximum size of 4 bytes")
+	})
+
+	t.Run("negative size", func(t *testing.T) {
+		var b bufferCloser
+		b.Write([]byte{0xff, 0xff, 0xff, 0xff})
+		_, err := NewFramedConn(&b, 0).ReadMessage()
+		assert.Error(t, err)
+	})
+
+	t.Run("truncated header", func(t *testing.T) {
+		var b bufferCloser
+		b.Write([]byte{0, 0})
+		_, err := NewFramedConn(&b, 0).ReadMessage()
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+	})
+
+	t.Run("truncated body", func(t *testing.T) {
+		var b bufferCloser
+		b.Write([]byte{0, 0, 0, 5, 'h', 'e'})
+		_, err := NewFramedConn(&b, 0).ReadMessage()
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+	})
+}
+
+func TestBufferedConnErrors(t *testing.T) {
+	msg := encodeMessage(t, protocol.Binary, "hello", wire.NewValueStruct(wire.Struct{
+		Fields: []wire.Field{{ID: 1, Value: wire.NewValueString("world")}},
+	}))
+
+	t.Run("write too large", func(t *testing.T) {
+		var b bufferCloser
+		err := NewBufferedConn(&b, len(msg)-1).WriteMessage(msg)
+		assert.IsType(t, &MessageTooLargeError{}, err)
+		assert.Equal(t, 0, b.Len(), "nothing must be written")
+	})
+
+	t.Run("read too large", func(t *testing.T) {
+		var b bufferCloser
+		b.Write(msg)
+		_, err := NewBufferedConn(&b, len(msg)-1).ReadMessage()
+		assert.EqualError(t, err, "message exceeds the maximum size of 29 bytes")
+	})
+
+	t.Run("exactly the maximum size", func(t *testing.T) {
+		var b bufferCloser
+		b.Write(msg)
+		got, err := NewBufferedConn(&b, len(msg)).ReadMessage()
+		require.NoError(t, err)
+		assert.Equal(t, msg, got)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		var b bufferCloser
+		b.Write([]byte{0x80, 0x02, 0x00, 0x01})
+		_, err := NewBufferedConn(&b, 0).ReadMessage()
+		assert.EqualError(t, err, "cannot decode envelope of unknown version 80020000")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		var b bufferCloser
+		b.Write(msg[:len(msg)-2])
+		_, err := NewBufferedConn(&b, 0).ReadMessage()
+		assert.Error(t, err)
+	})
+}
+
+func TestMessageTooLargeError(t *testing.T) {
+	assert.Equal(t,
+		"message of 10 bytes exceeds the maximum size of 5 bytes",
+		(&MessageTooLargeError{Size: 10, Max: 5}).Error())
+	assert.Equal(t,
+		"message exceeds the maximum size of 5 bytes",
+		(&MessageTooLargeError{Size: -1, Max: 5}).Error())
+}
+
+func TestFramingString(t *testing.T) {
+	assert.Equal(t, "framed", Framed.String())
+	assert.Equal(t, "buffered", Buffered.String())
+	assert.Equal(t, "Framing(3)", Framing(3).String())
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package transport carries serialized Thrift messages over byte streams
+// such as TCP connections.
+//
+// A Conn reads and writes whole messages. NewFramedConn prefixes each
+// message with its length, like TFramedTransport in Apache Thrift.
+// NewBufferedConn writes messages back to back, like TBufferedTransport,
+// and finds where each message ends by decoding its Binary protocol
+// envelope. Both refuse to read or write messages larger than a maximum
+// size so that a corrupt or malicious length can't exhaust memory.
+//
+// Client and Server compose a Conn with the rpc package to make and serve
+// requests over TCP.
+//
+//   server := transport.NewServer(
+//     rpc.NewServer(protocol.Binary, keyvalue.NewKeyValueHandler(impl)),
+//     nil /* options */)
+//   go server.Serve(listener)
+//   defer server.Close()
+//
+//   t, err := transport.Dial(ctx, "tcp", listener.Addr().String(), nil /* options */)
+//   if err != nil {
+//     return err
+//   }
+//   defer t.Close()
+//
+//   client := keyvalue.NewKeyValueClient(rpc.NewClient(protocol.Binary, t))
+//   value, err := client.GetValue(ctx, "foo")
+//
+// Both ends of a connection must agree on the framing. Framed connections
+// are used by default; set Options.Framing to Buffered for the unframed
+// format.
+package transport
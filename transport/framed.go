@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transport
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// NewFramedConn builds a Conn which prefixes each message with its length
+// as a 4-byte big-endian integer. Messages larger than maxSize bytes are
+// rejected. If maxSize is zero, DefaultMaxMessageSize is used.
+//
+// Frames are read directly from rw. Callers may wrap rw in a buffered
+// reader if it doesn't buffer its reads.
+func NewFramedConn(rw io.ReadWriteCloser, maxSize int) Conn {
+	return &framedConn{rw: rw, max: maxSize}
+}
+
+type framedConn struct {
+	rw  io.ReadWriteCloser
+	max int
+
+	rbuf [4]byte
+}
+
+func (c *framedConn) ReadMessage() ([]byte, error) {
+	// io.ReadFull returns io.EOF only if nothing was read.
+	if _, err := io.ReadFull(c.rw, c.rbuf[:]); err != nil {
+		return nil, err
+	}
+
+	size := int64(binary.BigEndian.Uint32(c.rbuf[:]))
+	if max := maxMessageSize(c.max); size > int64(max) {
+		return nil, &MessageTooLargeError{Size: size, Max: max}
+	}
+
+	msg := make([]byte, size)
+	if _, err := io.ReadFull(c.rw, msg); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (c *framedConn) WriteMessage(msg []byte) error {
+	max := maxMessageSize(c.max)
+	if len(msg) > max || int64(len(msg)) > math.MaxUint32 {
+		return &MessageTooLargeError{Size: int64(len(msg)), Max: max}
+	}
+
+	// The header and the message are written with a single call so that
+	// unbuffered streams don't send them in separate packets.
+	frame := make([]byte, 4+len(msg))
+	binary.BigEndian.PutUint32(frame, uint32(len(msg)))
+	copy(frame[4:], msg)
+	_, err := c.rw.Write(frame)
+	return err
+}
+
+func (c *framedConn) Close() error {
+	return c.rw.Close()
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transport
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/rpc"
+)
+
+// Bounds of the backoff between attempts to accept connections after
+// temporary errors. These match net/http.
+const (
+	minAcceptDelay = 5 * time.Millisecond
+	maxAcceptDelay = time.Second
+)
+
+// Server accepts connections from one or more listeners and handles the
+// requests received over them with an rpc.Server.
+//
+// Requests received over the same connection are handled in order, one at
+// a time. Requests to streaming methods are not supported.
+//
+// 	server := transport.NewServer(rpc.NewServer(protocol.Binary, handler), nil)
+// 	go server.Serve(listener)
+// 	defer server.Close()
+type Server struct {
+	s    rpc.Server
+	opts Options
+
+	mu        sync.Mutex
+	closed    bool
+	listeners map[net.Listener]struct{}
+	conns     map[Conn]struct{}
+	wg        sync.WaitGroup
+}
+
+// NewServer builds a Server which dispatches requests to the given
+// rpc.Server. opts may be nil.
+func NewServer(s rpc.Server, opts *Options) *Server {
+	server := Server{
+		s:         s,
+		listeners: make(map[net.Listener]struct{}),
+		conns:     make(map[Conn]struct{}),
+	}
+	if opts != nil {
+		server.opts = *opts
+	}
+	return &server
+}
+
+// Serve accepts connections from the given listener and serves requests
+// over them until the listener fails or the server is closed. The listener
+// is closed when Serve returns.
+//
+// Temporary errors accepting connections, like running out of file
+// descriptors, are retried with an exponential backoff of up to a second.
+// Serve returns nil if it stopped because the server was closed.
+func (s *Server) Serve(l net.Listener) error {
+	defer l.Close()
+
+	if !s.track(l) {
+		return nil
+	}
+	defer s.untrack(l)
+
+	var delay time.Duration // how long to wait after a temporary error
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return nil
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				if delay == 0 {
+					delay = minAcceptDelay
+				} else {
+					delay *= 2
+				}
+				if delay > maxAcceptDelay {
+					delay = maxAcceptDelay
+				}
+				time.Sleep(delay)
+				continue
+			}
+			return err
+		}
+		delay = 0
+
+		conn, err := NewConn(c, &s.opts)
+		if err != nil {
+			c.Close()
+			return err
+		}
+
+		if !s.trackConn(conn) {
+			conn.Close()
+			return nil
+		}
+		go s.serveConn(conn)
+	}
+}
+
+// Close stops all listeners, closes all open connections, and waits for
+// requests being handled to finish. Only errors encountered while closing
+// listeners are returned.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+
+	var errs []error
+	for l := range s.listeners {
+		if err := l.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (s *Server) serveConn(conn Conn) {
+	defer s.wg.Done()
+	defer conn.Close()
+	defer s.untrackConn(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		req, err := conn.ReadMessage()
+		if err != nil {
+			// io.EOF means that the client hung up; anything else is a
+			// broken or misbehaving connection. Either way, we're done.
+			return
+		}
+
+		// Oneway requests and requests that could not be decoded don't
+		// produce responses.
+		res, _ := s.s.Handle(ctx, req)
+		if res == nil {
+			continue
+		}
+
+		if err := conn.WriteMessage(res); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+func (s *Server) track(l net.Listener) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.listeners[l] = struct{}{}
+	return true
+}
+
+func (s *Server) untrack(l net.Listener) {
+	s.mu.Lock()
+	delete(s.listeners, l)
+	s.mu.Unlock()
+}
+
+func (s *Server) trackConn(c Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.conns[c] = struct{}{}
+	s.wg.Add(1)
+	return true
+}
+
+func (s *Server) untrackConn(c Conn) {
+	s.mu.Lock()
+	delete(s.conns, c)
+	s.mu.Unlock()
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package validation holds the errors returned by the Validate methods of
+// generated types.
+//
+// Fields may declare constraints on their values with annotations.
+//
+//   struct User {
+//     1: required string name (validate.minLen = "1", validate.maxLen = "255")
+//     2: optional i32 age (validate.min = "0")
+//     3: optional string email (validate.pattern = "^[^@]+@[^@]+$")
+//   }
+//
+// Validate reports all fields violating their constraints at once as
+// Errors. Fields of nested structs are validated as well.
+//
+//   if err := user.Validate(); err != nil {
+//     for _, e := range err.(validation.Errors) {
+//       log.Printf("%v: %v", e.Field, e.Message)
+//     }
+//   }
+package validation
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// FieldError is a violation of a constraint of a single field.
+type FieldError struct {
+	// Path to the invalid field from the struct being validated, using the
+	// Thrift names of the fields, e.g. "address.street".
+	Field string
+
+	// Description of the violated constraint, e.g. "must be at least 1".
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Field, e.Message)
+}
+
+// Errors is a list of constraint violations.
+type Errors []*FieldError
+
+func (es Errors) Error() string {
+	var buff bytes.Buffer
+	buff.WriteString("invalid fields: ")
+	for i, e := range es {
+		if i > 0 {
+			buff.WriteString("; ")
+		}
+		buff.WriteString(e.Error())
+	}
+	return buff.String()
+}
+
+// Add records a violation of a constraint of the given field.
+func (es *Errors) Add(field, message string) {
+	*es = append(*es, &FieldError{Field: field, Message: message})
+}
+
+// Nest records the error returned by the Validate method of the value of
+// the given field. Paths of fields inside the value are prefixed with the
+// name of the field.
+func (es *Errors) Nest(field string, err error) {
+	switch err := err.(type) {
+	case nil:
+		return
+	case Errors:
+		for _, e := range err {
+			es.Add(field+"."+e.Field, e.Message)
+		}
+	default:
+		es.Add(field, err.Error())
+	}
+}
+
+// Err returns the recorded violations as an error, or nil if there were
+// none.
+func (es Errors) Err() error {
+	if len(es) == 0 {
+		return nil
+	}
+	return es
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors(t *testing.T) {
+	var errs Errors
+	assert.NoError(t, errs.Err(), "no violations must not be an error")
+
+	errs.Add("name", "length must be at most 3")
+	errs.Nest("address", nil)
+	errs.Nest("address", Errors{
+		{Field: "street", Message: "must not be empty"},
+		{Field: "unit.number", Message: "must be at least 1"},
+	})
+	errs.Nest("owner", errors.New("great sadness"))
+
+	err := errs.Err()
+	if assert.Error(t, err) {
+		assert.Equal(t,
+			"invalid fields: name: length must be at most 3; "+
+				"address.street: must not be empty; "+
+				"address.unit.number: must be at least 1; "+
+				"owner: great sadness",
+			err.Error())
+	}
+
+	assert.Equal(t, Errors{
+		{Field: "name", Message: "length must be at most 3"},
+		{Field: "address.street", Message: "must not be empty"},
+		{Field: "address.unit.number", Message: "must be at least 1"},
+		{Field: "owner", Message: "great sadness"},
+	}, err)
+}
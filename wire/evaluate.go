@@ -56,3 +56,69 @@ func EvaluateValue(v Value) error {
 		return fmt.Errorf("unknown type %s", v.Type())
 	}
 }
+
+// DetachValue returns a fully evaluated copy of the given Value which may be
+// read any number of times, including concurrently. Lazy lists of the Value
+// are read into slices and closed, so the given Value must not be used
+// afterwards. Any errors raised by them are returned.
+func DetachValue(v Value) (Value, error) {
+	switch v.Type() {
+	case TBool, TI8, TDouble, TI16, TI32, TI64, TBinary:
+		return v, nil
+	case TStruct:
+		fields := make([]Field, len(v.GetStruct().Fields))
+		for i, f := range v.GetStruct().Fields {
+			value, err := DetachValue(f.Value)
+			if err != nil {
+				return Value{}, err
+			}
+			fields[i] = Field{ID: f.ID, Value: value}
+		}
+		return NewValueStruct(Struct{Fields: fields}), nil
+	case TMap:
+		m := v.GetMap()
+		defer m.Close()
+
+		items := make([]MapItem, 0, m.Size())
+		err := m.ForEach(func(item MapItem) error {
+			key, err := DetachValue(item.Key)
+			if err != nil {
+				return err
+			}
+			value, err := DetachValue(item.Value)
+			if err != nil {
+				return err
+			}
+			items = append(items, MapItem{Key: key, Value: value})
+			return nil
+		})
+		return NewValueMap(MapItemListFromSlice(m.KeyType(), m.ValueType(), items)), err
+	case TSet:
+		s := v.GetSet()
+		defer s.Close()
+
+		values, err := detachValueList(s)
+		return NewValueSet(ValueListFromSlice(s.ValueType(), values)), err
+	case TList:
+		l := v.GetList()
+		defer l.Close()
+
+		values, err := detachValueList(l)
+		return NewValueList(ValueListFromSlice(l.ValueType(), values)), err
+	default:
+		return Value{}, fmt.Errorf("unknown type %s", v.Type())
+	}
+}
+
+func detachValueList(l ValueList) ([]Value, error) {
+	values := make([]Value, 0, l.Size())
+	err := l.ForEach(func(v Value) error {
+		v, err := DetachValue(v)
+		if err != nil {
+			return err
+		}
+		values = append(values, v)
+		return nil
+	})
+	return values, err
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// onceValueList is a ValueList which may only be read until it's closed,
+// like the lazy lists of protocol implementations.
+type onceValueList struct {
+	ValueList
+
+	closed bool
+}
+
+func (l *onceValueList) ForEach(f func(Value) error) error {
+	if l.closed {
+		return errors.New("list was closed")
+	}
+	return l.ValueList.ForEach(f)
+}
+
+func (l *onceValueList) Close() { l.closed = true }
+
+func TestDetachValue(t *testing.T) {
+	inner := &onceValueList{ValueList: ValueListFromSlice(TI32, []Value{
+		NewValueI32(1),
+		NewValueI32(2),
+	})}
+	outer := &onceValueList{ValueList: ValueListFromSlice(TList, []Value{
+		NewValueList(inner),
+	})}
+	give := NewValueStruct(Struct{Fields: []Field{
+		{ID: 1, Value: NewValueString("foo")},
+		{ID: 2, Value: NewValueList(outer)},
+		{ID: 3, Value: NewValueMap(MapItemListFromSlice(TBinary, TI64, []MapItem{
+			{Key: NewValueString("bar"), Value: NewValueI64(42)},
+		}))},
+	}})
+
+	want := NewValueStruct(Struct{Fields: []Field{
+		{ID: 1, Value: NewValueString("foo")},
+		{ID: 2, Value: NewValueList(ValueListFromSlice(TList, []Value{
+			NewValueList(ValueListFromSlice(TI32, []Value{
+				NewValueI32(1),
+				NewValueI32(2),
+			})),
+		}))},
+		{ID: 3, Value: NewValueMap(MapItemListFromSlice(TBinary, TI64, []MapItem{
+			{Key: NewValueString("bar"), Value: NewValueI64(42)},
+		}))},
+	}})
+
+	got, err := DetachValue(give)
+	require.NoError(t, err)
+	assert.True(t, inner.closed, "lazy lists must be closed")
+	assert.True(t, outer.closed, "lazy lists must be closed")
+
+	// The detached value may be read more than once.
+	assert.True(t, ValuesAreEqual(want, got))
+	assert.True(t, ValuesAreEqual(want, got))
+	require.NoError(t, EvaluateValue(got))
+	assert.True(t, ValuesAreEqual(want, got))
+}
+
+func TestDetachValueError(t *testing.T) {
+	l := &onceValueList{ValueList: ValueListFromSlice(TI32, nil)}
+	l.Close()
+
+	_, err := DetachValue(NewValueStruct(Struct{Fields: []Field{
+		{ID: 1, Value: NewValueSet(l)},
+	}}))
+	assert.EqualError(t, err, "list was closed")
+}